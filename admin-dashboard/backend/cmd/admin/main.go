@@ -32,6 +32,33 @@
 //
 // @tag.name        traces
 // @tag.description Jaeger distributed tracing proxy
+//
+// @tag.name        audit
+// @tag.description Admin action audit log
+//
+// @tag.name        users
+// @tag.description Admin account and role management
+//
+// @tag.name        drift
+// @tag.description Container config drift detection
+//
+// @tag.name        inbox
+// @tag.description Unified moderation inbox
+//
+// @tag.name        probes
+// @tag.description Operator-defined synthetic monitoring probes
+
+// @tag.name        alerts
+// @tag.description Alert rules, firing alerts and notification history
+//
+// @tag.name        metrics
+// @tag.description Short-term bot metrics for dashboard charts
+//
+// @tag.name        latency
+// @tag.description Per-command bot response latency SLA reports
+//
+// @tag.name        bot-config
+// @tag.description Central bot runtime settings with push and change history
 package main
 
 import (
@@ -45,11 +72,21 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/valkey-io/valkey-go"
 
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/activity"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/alerts"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/bootstrap"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/botconfig"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/config"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/inbox"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/latency"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logging"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/maintenance"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/metrics"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/probe"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/proxy"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/server"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
@@ -89,10 +126,6 @@ func main() {
 	}
 
 	// 필수 설정 검증 (운영 파손 방지: 누락 시 즉시 종료)
-	if strings.TrimSpace(cfg.AdminPassHash) == "" {
-		logger.Error("config_missing_admin_pass_hash", slog.String("expected_env", "ADMIN_PASS_HASH or ADMIN_PASS_BCRYPT"))
-		os.Exit(1)
-	}
 	if strings.TrimSpace(cfg.AdminSecretKey) == "" {
 		logger.Error("config_missing_session_secret", slog.String("expected_env", "SESSION_SECRET or ADMIN_SECRET_KEY"))
 		os.Exit(1)
@@ -168,7 +201,7 @@ func main() {
 }
 
 // initializeApp: 애플리케이션 구성 요소를 초기화합니다.
-func initializeApp(_ context.Context, cfg *config.Config, logger *slog.Logger) (*bootstrap.ServerApp, func(), error) {
+func initializeApp(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*bootstrap.ServerApp, func(), error) {
 	var cleanupFns []func()
 	cleanup := func() {
 		for i := len(cleanupFns) - 1; i >= 0; i-- {
@@ -193,14 +226,103 @@ func initializeApp(_ context.Context, cfg *config.Config, logger *slog.Logger) (
 	// 세션 저장소 초기화
 	sessions := auth.NewValkeySessionStore(valkeyClient, logger)
 
+	// 계정 저장소 초기화 (비어 있으면 ADMIN_USER/ADMIN_PASS_HASH로 초기 admin 생성)
+	users := auth.NewValkeyUserStore(valkeyClient, logger)
+	if err := auth.EnsureBootstrapAdmin(ctx, users, cfg.AdminUser, cfg.AdminPassHash, logger); err != nil {
+		logger.Error("bootstrap_admin_failed",
+			slog.Any("error", err),
+			slog.String("expected_env", "ADMIN_PASS_HASH or ADMIN_PASS_BCRYPT"),
+		)
+		return nil, cleanup, err
+	}
+
+	// 2단계 인증(TOTP) 저장소 초기화
+	twoFactor := auth.NewValkeyTwoFactorStore(valkeyClient, logger)
+
+	// 감사 로그 저장소 초기화
+	auditStore := audit.NewValkeyStore(valkeyClient, cfg.AuditMaxEntries, logger)
+
 	// Docker 서비스 초기화 (선택적)
 	var dockerSvc *docker.Service
-	dockerSvc, err = docker.NewService(logger, "llm-bot")
+	restartChains, err := docker.ParseRestartChains(cfg.DockerRestartChains)
+	if err != nil {
+		// 잘못된 체인 설정은 무시하고 단일 재시작만 사용
+		logger.Warn("docker_restart_chains_invalid", slog.Any("error", err))
+		restartChains = nil
+	}
+	dockerSvc, err = docker.NewService(logger, "llm-bot", restartChains)
 	if err != nil {
 		// Docker 서비스를 사용할 수 없어도 서버는 계속 동작함
 		logger.Warn("docker_init_failed", slog.Any("error", err))
 	} else {
-		logger.Info("docker_initialized")
+		logger.Info("docker_initialized", slog.Int("restart_chains", len(restartChains)))
+	}
+
+	// 종료된 일회성 컨테이너 예약 정리 (삭제 내역은 감사 로그에 system 수행자로 기록)
+	if dockerSvc != nil {
+		dockerSvc.SetPrunePolicy(docker.PrunePolicy{
+			Labels: docker.ParsePruneLabels(cfg.DockerPruneLabels),
+			MinAge: cfg.DockerPruneMinAge,
+		})
+		if cfg.DockerPruneInterval > 0 {
+			pruneCtx, stopPrune := context.WithCancel(context.WithoutCancel(ctx))
+			go dockerSvc.RunStalePrune(pruneCtx, cfg.DockerPruneInterval, func(ctx context.Context, result docker.PruneResult) {
+				target, summary := result.AuditSummary()
+				if err := auditStore.Append(ctx, audit.SystemEntry("docker.prune", target, summary)); err != nil {
+					logger.Warn("docker_prune_audit_failed", slog.Any("error", err))
+				}
+			})
+			cleanupFns = append(cleanupFns, stopPrune)
+			logger.Info("docker_prune_started",
+				slog.Duration("interval", cfg.DockerPruneInterval),
+				slog.Duration("min_age", dockerSvc.PrunePolicy().MinAge),
+			)
+		}
+	}
+
+	// 리소스 워치독: 정책 임계값을 연속으로 넘은 컨테이너 재시작 (재시작 내역은 감사 로그에 system 수행자로 기록)
+	if dockerSvc != nil && cfg.DockerResourcePolicies != "" {
+		policies, err := docker.ParseResourcePolicies(cfg.DockerResourcePolicies)
+		if err != nil {
+			logger.Warn("docker_resource_policies_invalid", slog.Any("error", err))
+		} else {
+			watchdogCtx, stopWatchdog := context.WithCancel(context.WithoutCancel(ctx))
+			go dockerSvc.RunResourceWatchdog(watchdogCtx, cfg.DockerWatchdogInterval, policies, func(ctx context.Context, event docker.ResourceRestart) {
+				target, summary := event.AuditSummary()
+				if err := auditStore.Append(ctx, audit.SystemEntry("docker.resource_restart", target, summary)); err != nil {
+					logger.Warn("docker_resource_restart_audit_failed", slog.Any("error", err))
+				}
+			})
+			cleanupFns = append(cleanupFns, stopWatchdog)
+			logger.Info("docker_watchdog_started",
+				slog.Duration("interval", cfg.DockerWatchdogInterval),
+				slog.Int("policies", len(policies)),
+			)
+		}
+	}
+
+	// 설정 드리프트 감지기 초기화 (Docker 사용 가능 시)
+	var driftDetector *drift.Detector
+	if dockerSvc != nil {
+		var notifier drift.Notifier
+		if cfg.DriftWebhookURL != "" {
+			notifier = drift.NewWebhookNotifier(cfg.DriftWebhookURL)
+		}
+		driftDetector = drift.NewDetector(
+			dockerSvc,
+			drift.NewValkeyStore(valkeyClient, logger),
+			notifier,
+			cfg.AdminSecretKey,
+			cfg.DriftDeployWindow,
+			logger,
+		)
+
+		if cfg.DriftCheckInterval > 0 {
+			driftCtx, stopDrift := context.WithCancel(context.WithoutCancel(ctx))
+			go driftDetector.Run(driftCtx, cfg.DriftCheckInterval)
+			cleanupFns = append(cleanupFns, stopDrift)
+			logger.Info("drift_detector_started", slog.Duration("interval", cfg.DriftCheckInterval))
+		}
 	}
 
 	// Jaeger 클라이언트 초기화 (선택적)
@@ -217,26 +339,158 @@ func initializeApp(_ context.Context, cfg *config.Config, logger *slog.Logger) (
 		if err != nil {
 			logger.Warn("bot_proxy_init_failed", slog.Any("error", err))
 		} else {
+			botProxies.SetCache(proxy.NewResponseCache(cfg.ProxyCacheTTL, cfg.ProxyCacheMaxEntries, logger))
+			validationMode, modeErr := proxy.ParseValidationMode(cfg.ProxySpecValidation)
+			if modeErr != nil {
+				logger.Warn("proxy_spec_validation_mode_invalid", slog.Any("error", modeErr))
+			}
+			validator, specErr := proxy.NewSpecValidator(validationMode, logger)
+			if specErr != nil {
+				// 내장 명세는 테스트로 검증되므로 여기까지 오면 빌드 결함이다. 검증 없이 계속 동작한다.
+				logger.Error("proxy_spec_load_failed", slog.Any("error", specErr))
+			}
+			botProxies.SetValidator(validator)
 			logger.Info("bot_proxy_initialized",
 				slog.String("holo", cfg.HoloBotURL),
 				slog.String("twentyq", cfg.TwentyQBotURL),
 				slog.String("turtle", cfg.TurtleBotURL),
+				slog.Duration("cache_ttl", cfg.ProxyCacheTTL),
+				slog.String("spec_validation", string(validationMode)),
 			)
 		}
 	}
 
+	var llmUsageProxy *proxy.LLMUsageProxy
+	if cfg.LLMServerURL != "" {
+		llmUsageProxy, err = proxy.NewLLMUsageProxy(cfg.LLMServerURL, cfg.LLMAPIKey, logger)
+		if err != nil {
+			logger.Warn("llm_usage_proxy_init_failed", slog.Any("error", err))
+		}
+	}
+
 	// 통합 시스템 상태 수집기 초기화
+	// 의존 그래프: 봇 → LLM 서버 → valkey/postgres (장애 시 근본 원인 표시용)
+	infra := []string{"postgres", "valkey-cache", "valkey-mq"}
+	gameBotDeps := append([]string{"mcp-llm-server"}, infra...)
 	statusEndpoints := []status.ServiceEndpoint{
-		{Name: "hololive-bot", HealthURL: cfg.HoloBotURL + "/health", StatsURL: cfg.HoloBotURL + "/api/holo/stats"},
-		{Name: "twentyq-bot", HealthURL: cfg.TwentyQBotURL + "/health"},
-		{Name: "turtle-soup-bot", HealthURL: cfg.TurtleBotURL + "/health"},
-		{Name: "mcp-llm-server", HealthURL: cfg.LLMServerURL + "/health"},
+		{Name: "hololive-bot", HealthURL: cfg.HoloBotURL + "/health", StatsURL: cfg.HoloBotURL + "/api/holo/stats", DetailURL: cfg.HoloBotURL + "/health/detail", DependsOn: infra},
+		{Name: "twentyq-bot", HealthURL: cfg.TwentyQBotURL + "/health", DetailURL: cfg.TwentyQBotURL + "/health/detail", DependsOn: gameBotDeps},
+		{Name: "turtle-soup-bot", HealthURL: cfg.TurtleBotURL + "/health", DetailURL: cfg.TurtleBotURL + "/health/detail", DependsOn: gameBotDeps},
+		{Name: "mcp-llm-server", HealthURL: cfg.LLMServerURL + "/health", DependsOn: []string{"postgres", "valkey-cache"}},
+	}
+	for _, node := range []status.ServiceEndpoint{
+		{Name: "postgres", TCPAddr: cfg.StatusPostgresAddr},
+		{Name: "valkey-cache", TCPAddr: cfg.ValkeyURL},
+		{Name: "valkey-mq", TCPAddr: cfg.StatusValkeyMQAddr},
+	} {
+		if node.TCPAddr != "" {
+			statusEndpoints = append(statusEndpoints, node)
+		}
 	}
 	statusCollector := status.NewCollector(statusEndpoints, Version, logger)
 	logger.Info("status_collector_initialized", slog.Int("endpoints", len(statusEndpoints)))
+	if cfg.StatusHistoryInterval > 0 {
+		statusCollector.SetHistoryStore(status.NewValkeyHistoryStore(valkeyClient, logger))
+		historyCtx, stopHistory := context.WithCancel(context.WithoutCancel(ctx))
+		go statusCollector.RunHistory(historyCtx, cfg.StatusHistoryInterval)
+		cleanupFns = append(cleanupFns, stopHistory)
+		logger.Info("status_history_started", slog.Duration("interval", cfg.StatusHistoryInterval))
+	}
+
+	// 합성 모니터링 프로브 스케줄러 (URL이 설정된 봇만 대상)
+	probeService := probe.NewService(probe.NewValkeyStore(valkeyClient, logger), map[string]string{
+		"holo":    cfg.HoloBotURL,
+		"twentyq": cfg.TwentyQBotURL,
+		"turtle":  cfg.TurtleBotURL,
+		"llm":     cfg.LLMServerURL,
+	}, logger)
+	probeCtx, stopProbes := context.WithCancel(context.WithoutCancel(ctx))
+	go probeService.Run(probeCtx, probe.DefaultTick)
+	cleanupFns = append(cleanupFns, stopProbes)
+	logger.Info("probe_scheduler_started", slog.Any("targets", probeService.Targets()))
+
+	// 알림 규칙 평가기 (데이터 제공자가 없는 종류의 규칙은 평가하지 않음)
+	alertSources := alerts.Sources{Status: statusCollector}
+	if tracesClient != nil {
+		alertSources.Metrics = tracesClient
+	}
+	if dockerSvc != nil {
+		alertSources.Restarts = dockerSvc
+	}
+	alertService := alerts.NewService(alerts.NewValkeyStore(valkeyClient, logger), alertSources, alerts.NewWebhookNotifiers(cfg.AlertWebhookURLs), logger)
+	if cfg.AlertEvalInterval > 0 {
+		alertCtx, stopAlerts := context.WithCancel(context.WithoutCancel(ctx))
+		go alertService.Run(alertCtx, cfg.AlertEvalInterval)
+		cleanupFns = append(cleanupFns, stopAlerts)
+		logger.Info("alert_evaluator_started", slog.Duration("interval", cfg.AlertEvalInterval))
+	}
+
+	// 봇 메트릭 단기 수집기 (선택적, 대시보드 차트용)
+	var metricsScraper *metrics.Scraper
+	if cfg.MetricsScrapeInterval > 0 {
+		metricsScraper = metrics.NewScraper(map[string]string{
+			"holo":    cfg.HoloBotURL,
+			"twentyq": cfg.TwentyQBotURL,
+			"turtle":  cfg.TurtleBotURL,
+			"llm":     cfg.LLMServerURL,
+		}, cfg.MetricsScrapeInterval, cfg.MetricsRetention, logger)
+		scrapeCtx, stopScrape := context.WithCancel(context.WithoutCancel(ctx))
+		go metricsScraper.Run(scrapeCtx)
+		cleanupFns = append(cleanupFns, stopScrape)
+		logger.Info("metrics_scraper_started",
+			slog.Duration("interval", cfg.MetricsScrapeInterval),
+			slog.Duration("retention", cfg.MetricsRetention),
+		)
+	}
+
+	// 운영 인박스 초기화 (설정된 소스만 수집)
+	inboxSources := []inbox.Source{inbox.NewAuditSource(auditStore), inbox.NewProbeSource(probeService)}
+	if driftDetector != nil {
+		inboxSources = append(inboxSources, inbox.NewDriftSource(driftDetector))
+	}
+	if cfg.TurtleBotURL != "" {
+		inboxSources = append(inboxSources, inbox.NewSupervisionSource(cfg.TurtleBotURL), inbox.NewPuzzleSource(cfg.TurtleBotURL))
+	}
+	if cfg.TwentyQBotURL != "" {
+		inboxSources = append(inboxSources, inbox.NewGameSource(cfg.TwentyQBotURL))
+	}
+	inboxService := inbox.NewService(inbox.NewValkeyStore(valkeyClient, logger), logger, inboxSources...)
+
+	// 봇 명령어 응답 지연 리포트 집계기 (URL이 설정된 봇만 조회)
+	latencyReports := latency.NewAggregator(map[string]string{
+		"holo":    cfg.HoloBotURL,
+		"twentyq": cfg.TwentyQBotURL,
+		"turtle":  cfg.TurtleBotURL,
+	}, logger)
+
+	// 봇 런타임 설정 관리 (봇 재시작으로 사라진 오버라이드는 주기적으로 다시 푸시)
+	botConfig := botconfig.NewService(botconfig.NewValkeyStore(valkeyClient, logger), map[string]string{
+		"holo":    cfg.HoloBotURL,
+		"twentyq": cfg.TwentyQBotURL,
+		"turtle":  cfg.TurtleBotURL,
+	}, logger)
+	if cfg.BotConfigSyncInterval > 0 {
+		botConfigCtx, stopBotConfig := context.WithCancel(context.WithoutCancel(ctx))
+		go botConfig.Run(botConfigCtx, cfg.BotConfigSyncInterval)
+		cleanupFns = append(cleanupFns, stopBotConfig)
+		logger.Info("bot_config_sync_started", slog.Duration("interval", cfg.BotConfigSyncInterval))
+	}
+
+	// 활동 피드: 게임 봇 이벤트 버스(게임 시작/종료, 알림 발송)와 컨테이너 재시작을 모아 WebSocket으로 전달
+	activityHub := activity.NewHub(activity.NewValkeyStore(valkeyClient, cfg.ActivityHistorySize, logger), logger)
+	activityCtx, stopActivity := context.WithCancel(context.WithoutCancel(ctx))
+	go activityHub.RunGameEvents(activityCtx, valkeyClient)
+	if dockerSvc != nil {
+		go activityHub.RunContainerEvents(activityCtx, dockerSvc)
+	}
+	cleanupFns = append(cleanupFns, stopActivity)
+	logger.Info("activity_feed_started", slog.Int("history_size", cfg.ActivityHistorySize))
+
+	// 점검 공지 배너 (SSR 주입, 상태 API 포함)
+	maintenanceSvc := maintenance.NewService(maintenance.NewValkeyStore(valkeyClient, logger))
 
 	// HTTP 서버 생성
-	httpServer := server.New(cfg, logger, sessions, dockerSvc, tracesClient, botProxies, statusCollector)
+	httpServer := server.New(cfg, logger, sessions, users, twoFactor, dockerSvc, tracesClient, botProxies, statusCollector, auditStore, driftDetector, inboxService, probeService, alertService, metricsScraper, llmUsageProxy, latencyReports, botConfig, activityHub, maintenanceSvc)
 
 	// ServerApp 생성
 	serverApp := bootstrap.NewServerApp(
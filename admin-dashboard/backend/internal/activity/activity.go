@@ -0,0 +1,128 @@
+// Package activity: 게임 봇 이벤트 버스와 Docker 이벤트를 하나로 모은 실시간 활동 피드
+package activity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Type 상수 목록입니다.
+const (
+	TypeGameStarted        = "game_started"
+	TypeGameCompleted      = "game_completed"
+	TypeAlarmFired         = "alarm_fired"
+	TypeContainerRestarted = "container_restarted"
+	// TypeContainerDied: 컨테이너가 0이 아닌 종료 코드로 멈춤 (재시작 정책에 의한 재시작 직전)
+	TypeContainerDied = "container_died"
+)
+
+// SourceDocker: 컨테이너 이벤트의 출처 (게임 이벤트는 봇 이름: twentyq, turtlesoup, hololive)
+const SourceDocker = "docker"
+
+const (
+	// DefaultHistorySize: Valkey에 보관하는 최근 이벤트 수
+	DefaultHistorySize = 500
+	// subscriberBuffer: 구독자별 전송 대기 이벤트 수 (넘치면 그 구독자에게는 버림)
+	subscriberBuffer = 64
+)
+
+// Event: 활동 피드 항목
+type Event struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Source     string         `json:"source"`
+	ChatID     string         `json:"chatId,omitempty"`
+	UserID     string         `json:"userId,omitempty"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// Hub: 활동 이벤트를 보관하고 연결된 구독자에게 전달합니다.
+type Hub struct {
+	store  Store
+	logger *slog.Logger
+
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+// NewHub: 활동 피드 허브 생성 (store가 nil이면 과거 이벤트 없이 실시간 전달만 함)
+func NewHub(store Store, logger *slog.Logger) *Hub {
+	return &Hub{
+		store:  store,
+		logger: logger,
+		subs:   make(map[chan Event]struct{}),
+	}
+}
+
+// Publish: 이벤트를 보관하고 모든 구독자에게 보냅니다.
+// 느린 구독자 때문에 다른 구독자나 수집이 막히지 않도록, 버퍼가 찬 구독자에게는 이벤트를 버립니다.
+func (h *Hub) Publish(ctx context.Context, event Event) {
+	if event.ID == "" {
+		event.ID = newEventID()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	if h.store != nil {
+		if err := h.store.Append(ctx, event); err != nil {
+			h.logger.Warn("activity_store_append_failed", slog.String("type", event.Type), slog.Any("error", err))
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe: 이후 발행되는 이벤트를 받는 채널과 구독 해제 함수를 반환합니다.
+func (h *Hub) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subs, ch)
+			h.mu.Unlock()
+		})
+	}
+}
+
+// Recent: 최근 이벤트를 오래된 순으로 반환합니다. (WebSocket 연결 직후 백필용)
+func (h *Hub) Recent(ctx context.Context, limit int) ([]Event, error) {
+	if h.store == nil || limit <= 0 {
+		return nil, nil
+	}
+	events, err := h.store.Recent(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
+
+func newEventID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,157 @@
+package activity
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memStore: 최신순 리스트를 흉내 내는 메모리 저장소
+type memStore struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (m *memStore) Append(_ context.Context, event Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append([]Event{event}, m.events...)
+	return nil
+}
+
+func (m *memStore) Recent(_ context.Context, limit int) ([]Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Event(nil), m.events[:min(limit, len(m.events))]...), nil
+}
+
+func newTestHub() *Hub {
+	return NewHub(&memStore{}, slog.New(slog.NewTextHandler(os.Stderr, nil)))
+}
+
+func TestHub_PublishStoresAndFansOut(t *testing.T) {
+	hub := newTestHub()
+	ctx := context.Background()
+
+	hub.Publish(ctx, Event{Type: TypeGameStarted, Source: "twentyq"})
+
+	first, unsubFirst := hub.Subscribe()
+	second, unsubSecond := hub.Subscribe()
+	defer unsubSecond()
+
+	hub.Publish(ctx, Event{Type: TypeGameCompleted, Source: "twentyq"})
+	for _, ch := range []<-chan Event{first, second} {
+		select {
+		case event := <-ch:
+			if event.Type != TypeGameCompleted || event.ID == "" || event.OccurredAt.IsZero() {
+				t.Fatalf("unexpected event: %+v", event)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("subscriber did not receive event")
+		}
+	}
+
+	unsubFirst()
+	unsubFirst()
+	hub.Publish(ctx, Event{Type: TypeAlarmFired, Source: "hololive"})
+	select {
+	case event := <-first:
+		t.Fatalf("unsubscribed channel received %+v", event)
+	default:
+	}
+
+	recent, err := hub.Recent(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 2 || recent[0].Type != TypeGameCompleted || recent[1].Type != TypeAlarmFired {
+		t.Fatalf("expected last two events oldest first, got %+v", recent)
+	}
+}
+
+func TestHub_SlowSubscriberDoesNotBlock(t *testing.T) {
+	hub := newTestHub()
+	_, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		for range subscriberBuffer + 10 {
+			hub.Publish(context.Background(), Event{Type: TypeGameStarted, Source: "turtlesoup"})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("publish blocked on a full subscriber")
+	}
+}
+
+func TestParseBusEvent(t *testing.T) {
+	event, ok := parseBusEvent(`{"id":"e1","type":"game_completed","game":"twentyq","chatId":"room","userId":"u1","occurredAt":"2026-10-18T12:00:00Z","data":{"result":"correct"}}`)
+	if !ok || event.Source != "twentyq" || event.ChatID != "room" || event.Data["result"] != "correct" {
+		t.Fatalf("unexpected event: %+v ok=%v", event, ok)
+	}
+
+	for _, payload := range []string{
+		`{"id":"e2","type":"question_answered","game":"twentyq"}`,
+		`{"id":"e3","type":"game_started"}`,
+		`not json`,
+	} {
+		if _, ok := parseBusEvent(payload); ok {
+			t.Errorf("expected %s to be skipped", payload)
+		}
+	}
+}
+
+// fakeWatcher: 정해진 이벤트를 보낸 뒤 ctx가 끝날 때까지 기다리는 감시자
+type fakeWatcher struct{}
+
+func (fakeWatcher) WatchContainerEvents(ctx context.Context, handler func(name, action, exitCode string, at time.Time)) error {
+	handler("twentyq-bot", "restart", "", time.Now())
+	handler("twentyq-bot", "die", "137", time.Now())
+	handler("twentyq-bot", "start", "", time.Now())
+	<-ctx.Done()
+	return nil
+}
+
+func TestHub_RunContainerEvents(t *testing.T) {
+	hub := newTestHub()
+	events, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hub.RunContainerEvents(ctx, fakeWatcher{})
+		close(done)
+	}()
+
+	var got []Event
+	for len(got) < 2 {
+		select {
+		case event := <-events:
+			got = append(got, event)
+		case <-time.After(time.Second):
+			t.Fatalf("expected 2 container events, got %+v", got)
+		}
+	}
+	cancel()
+	<-done
+
+	if got[0].Type != TypeContainerRestarted || got[0].Source != SourceDocker || got[0].Data["container"] != "twentyq-bot" {
+		t.Fatalf("unexpected restart event: %+v", got[0])
+	}
+	if got[1].Type != TypeContainerDied || got[1].Data["exitCode"] != "137" {
+		t.Fatalf("unexpected die event: %+v", got[1])
+	}
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected extra event: %+v", event)
+	default:
+	}
+}
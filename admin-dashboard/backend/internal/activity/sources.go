@@ -0,0 +1,113 @@
+package activity
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+// GameEventsPattern: 게임 봇 이벤트 버스 채널 패턴 (game-bot-go eventbus.AllEventsPattern과 동일)
+// 채널 형식은 "game-events:{game}:{type}"이며 hololive 봇의 알림 발송도 같은 형식으로 발행된다.
+const GameEventsPattern = "game-events:*"
+
+// reconnectDelay: 구독/감시 연결이 끊겼을 때 다시 연결하기까지의 대기 시간
+const reconnectDelay = 5 * time.Second
+
+// feedTypes: 이벤트 버스에서 활동 피드로 옮기는 이벤트 종류 (질문/힌트처럼 잦은 이벤트는 제외)
+var feedTypes = map[string]bool{
+	TypeGameStarted:   true,
+	TypeGameCompleted: true,
+	TypeAlarmFired:    true,
+}
+
+// busEvent: 이벤트 버스 메시지 (game-bot-go eventbus.Event JSON)
+type busEvent struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Game       string         `json:"game"`
+	ChatID     string         `json:"chatId"`
+	UserID     string         `json:"userId"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data"`
+}
+
+// parseBusEvent: 이벤트 버스 메시지를 활동 이벤트로 변환합니다. 피드 대상이 아니거나 해석할 수 없으면 false
+func parseBusEvent(payload string) (Event, bool) {
+	var msg busEvent
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return Event{}, false
+	}
+	if !feedTypes[msg.Type] || strings.TrimSpace(msg.Game) == "" {
+		return Event{}, false
+	}
+	return Event{
+		ID:         msg.ID,
+		Type:       msg.Type,
+		Source:     msg.Game,
+		ChatID:     msg.ChatID,
+		UserID:     msg.UserID,
+		OccurredAt: msg.OccurredAt,
+		Data:       msg.Data,
+	}, true
+}
+
+// RunGameEvents: ctx가 끝날 때까지 게임 봇 이벤트 버스를 구독해 허브로 보냅니다. 연결이 끊기면 다시 구독합니다.
+func (h *Hub) RunGameEvents(ctx context.Context, client valkey.Client) {
+	for {
+		cmd := client.B().Psubscribe().Pattern(GameEventsPattern).Build()
+		err := client.Receive(ctx, cmd, func(msg valkey.PubSubMessage) {
+			if event, ok := parseBusEvent(msg.Message); ok {
+				h.Publish(ctx, event)
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		h.logger.Warn("activity_game_events_disconnected", slog.Any("error", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// ContainerWatcher: 관리 대상 컨테이너의 라이프사이클 이벤트를 감시합니다. (docker.Service)
+// 스트림이 끊기면 오류를 반환하고, ctx가 끝나면 nil을 반환합니다.
+type ContainerWatcher interface {
+	WatchContainerEvents(ctx context.Context, handler func(name, action, exitCode string, at time.Time)) error
+}
+
+// RunContainerEvents: ctx가 끝날 때까지 컨테이너 재시작/비정상 종료를 허브로 보냅니다.
+func (h *Hub) RunContainerEvents(ctx context.Context, watcher ContainerWatcher) {
+	for {
+		err := watcher.WatchContainerEvents(ctx, func(name, action, exitCode string, at time.Time) {
+			event := Event{Source: SourceDocker, OccurredAt: at, Data: map[string]any{"container": name}}
+			switch action {
+			case "restart":
+				event.Type = TypeContainerRestarted
+			case "die":
+				event.Type = TypeContainerDied
+				event.Data["exitCode"] = exitCode
+			default:
+				return
+			}
+			h.Publish(ctx, event)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		h.logger.Warn("activity_container_events_disconnected", slog.Any("error", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
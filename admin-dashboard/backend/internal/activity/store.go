@@ -0,0 +1,84 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const eventsKey = "activity:events"
+
+// Store: 최근 활동 이벤트 저장소
+type Store interface {
+	Append(ctx context.Context, event Event) error
+	// Recent: 최신순 이벤트
+	Recent(ctx context.Context, limit int) ([]Event, error)
+}
+
+// ValkeyStore: Valkey 리스트 기반 활동 이벤트 저장소 (최근 size개만 유지)
+type ValkeyStore struct {
+	client valkey.Client
+	size   int
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 활동 이벤트 저장소 생성 (size가 0 이하면 DefaultHistorySize)
+func NewValkeyStore(client valkey.Client, size int, logger *slog.Logger) *ValkeyStore {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &ValkeyStore{client: client, size: size, logger: logger}
+}
+
+// Append: 이벤트를 리스트 앞에 넣고 보관 개수를 넘는 오래된 이벤트를 잘라냅니다.
+func (s *ValkeyStore) Append(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal activity event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	cmds := valkey.Commands{
+		s.client.B().Lpush().Key(eventsKey).Element(string(data)).Build(),
+		s.client.B().Ltrim().Key(eventsKey).Start(0).Stop(int64(s.size - 1)).Build(),
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append activity event: %w", err)
+		}
+	}
+	return nil
+}
+
+// Recent: 최신순으로 최대 limit개 조회
+func (s *ValkeyStore) Recent(ctx context.Context, limit int) ([]Event, error) {
+	limit = min(limit, s.size)
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Lrange().Key(eventsKey).Start(0).Stop(int64(limit-1)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list activity events: %w", err)
+	}
+
+	events := make([]Event, 0, len(raw))
+	for _, item := range raw {
+		var event Event
+		if err := json.Unmarshal([]byte(item), &event); err != nil {
+			s.logger.Warn("activity_event_decode_failed", slog.Any("error", err))
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,159 @@
+// Package alerts: 운영자가 정의한 알림 규칙(서비스 다운, 에러율, 컨테이너 재시작 루프)의 주기 평가와 웹훅 알림
+package alerts
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultInterval: 규칙 평가 주기 기본값
+	DefaultInterval = 30 * time.Second
+	// MaxForMinutes: 조건 지속 시간 상한
+	MaxForMinutes = 24 * 60
+	// MaxWindowMinutes: 집계 기간 상한
+	MaxWindowMinutes = 24 * 60
+	// MaxHistory: 보관할 최대 발생/해소 이력 수
+	MaxHistory = 200
+
+	defaultServiceDownMinutes = 5
+	defaultErrorRateWindow    = 5
+	defaultRestartWindow      = 10
+	defaultRestartThreshold   = 3
+)
+
+var (
+	// ErrNotFound: 규칙 없음
+	ErrNotFound = errors.New("alert rule not found")
+	// ErrExists: 같은 ID의 규칙이 이미 있음
+	ErrExists = errors.New("alert rule already exists")
+	// ErrInvalid: 규칙 정의 검증 실패
+	ErrInvalid = errors.New("invalid alert rule")
+)
+
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// RuleType: 알림 조건 종류
+type RuleType string
+
+const (
+	// RuleServiceDown: 상태 수집기 기준 서비스가 ForMinutes 이상 응답하지 않음
+	RuleServiceDown RuleType = "service_down"
+	// RuleErrorRate: Jaeger SPM 기준 WindowMinutes 동안의 에러율이 Threshold(0~1) 초과
+	RuleErrorRate RuleType = "error_rate"
+	// RuleRestartLoop: WindowMinutes 안에 컨테이너가 Threshold회 이상 재시작
+	RuleRestartLoop RuleType = "restart_loop"
+)
+
+// RuleTypes: 지원하는 규칙 종류 목록
+var RuleTypes = []RuleType{RuleServiceDown, RuleErrorRate, RuleRestartLoop}
+
+// Rule: 알림 규칙
+type Rule struct {
+	ID   string   `json:"id"`
+	Name string   `json:"name"`
+	Type RuleType `json:"type"`
+	// Target: service_down은 상태 서비스 이름, error_rate는 Jaeger 서비스 이름, restart_loop는 컨테이너 이름
+	Target string `json:"target"`
+	// Threshold: error_rate는 에러율(0~1), restart_loop는 재시작 횟수 (service_down은 사용 안 함)
+	Threshold float64 `json:"threshold,omitempty"`
+	// ForMinutes: 조건이 이 시간 이상 계속되어야 발생 (0이면 즉시)
+	ForMinutes int `json:"forMinutes"`
+	// WindowMinutes: error_rate 조회 기간 / restart_loop 재시작 집계 기간
+	WindowMinutes int       `json:"windowMinutes,omitempty"`
+	Enabled       bool      `json:"enabled"`
+	CreatedBy     string    `json:"createdBy,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// For: 조건 지속 시간
+func (r Rule) For() time.Duration {
+	return time.Duration(r.ForMinutes) * time.Minute
+}
+
+// Window: 집계 기간
+func (r Rule) Window() time.Duration {
+	return time.Duration(r.WindowMinutes) * time.Minute
+}
+
+// Normalize: 종류별 기본값을 채우고 정의를 검증합니다.
+func (r *Rule) Normalize() error {
+	r.ID = strings.TrimSpace(r.ID)
+	r.Name = strings.TrimSpace(r.Name)
+	r.Target = strings.TrimSpace(r.Target)
+	r.Type = RuleType(strings.ToLower(strings.TrimSpace(string(r.Type))))
+	if r.Name == "" {
+		r.Name = r.ID
+	}
+
+	switch r.Type {
+	case RuleServiceDown:
+		r.Threshold, r.WindowMinutes = 0, 0
+		if r.ForMinutes == 0 {
+			r.ForMinutes = defaultServiceDownMinutes
+		}
+	case RuleErrorRate:
+		if r.WindowMinutes == 0 {
+			r.WindowMinutes = defaultErrorRateWindow
+		}
+		if r.Threshold <= 0 || r.Threshold > 1 {
+			return fmt.Errorf("%w: error_rate threshold must be in (0, 1]", ErrInvalid)
+		}
+	case RuleRestartLoop:
+		if r.WindowMinutes == 0 {
+			r.WindowMinutes = defaultRestartWindow
+		}
+		if r.Threshold == 0 {
+			r.Threshold = defaultRestartThreshold
+		}
+		if r.Threshold < 1 || r.Threshold != float64(int(r.Threshold)) {
+			return fmt.Errorf("%w: restart_loop threshold must be a positive restart count", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: type must be one of %v", ErrInvalid, RuleTypes)
+	}
+
+	switch {
+	case !idPattern.MatchString(r.ID):
+		return fmt.Errorf("%w: id must match %s", ErrInvalid, idPattern.String())
+	case r.Target == "":
+		return fmt.Errorf("%w: target is required", ErrInvalid)
+	case r.ForMinutes < 0 || r.ForMinutes > MaxForMinutes:
+		return fmt.Errorf("%w: forMinutes must be between 0 and %d", ErrInvalid, MaxForMinutes)
+	case r.WindowMinutes < 0 || r.WindowMinutes > MaxWindowMinutes:
+		return fmt.Errorf("%w: windowMinutes must be at most %d", ErrInvalid, MaxWindowMinutes)
+	}
+	return nil
+}
+
+// State: 알림 상태
+type State string
+
+const (
+	// StateFiring: 조건 충족 중
+	StateFiring State = "firing"
+	// StateResolved: 조건 해소
+	StateResolved State = "resolved"
+)
+
+// Alert: 규칙 하나의 발생/해소 기록
+type Alert struct {
+	RuleID     string     `json:"ruleId"`
+	RuleName   string     `json:"ruleName"`
+	Type       RuleType   `json:"type"`
+	Target     string     `json:"target"`
+	State      State      `json:"state"`
+	Value      float64    `json:"value"`
+	Message    string     `json:"message"`
+	FiredAt    time.Time  `json:"firedAt"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// Summary: 웹훅 text 필드용 한 줄 요약
+func (a Alert) Summary() string {
+	return fmt.Sprintf("[%s] %s: %s", strings.ToUpper(string(a.State)), a.RuleName, a.Message)
+}
@@ -0,0 +1,360 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/traces"
+)
+
+func TestNormalize(t *testing.T) {
+	down := Rule{ID: "holo-down", Type: "Service_Down", Target: "hololive-bot", Threshold: 3, WindowMinutes: 7}
+	if err := down.Normalize(); err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	if down.Type != RuleServiceDown || down.ForMinutes != defaultServiceDownMinutes || down.Threshold != 0 || down.WindowMinutes != 0 || down.Name != down.ID {
+		t.Fatalf("service_down defaults not applied: %+v", down)
+	}
+
+	loop := Rule{ID: "valkey-loop", Type: RuleRestartLoop, Target: "valkey-cache"}
+	if err := loop.Normalize(); err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	if loop.Threshold != defaultRestartThreshold || loop.WindowMinutes != defaultRestartWindow {
+		t.Fatalf("restart_loop defaults not applied: %+v", loop)
+	}
+
+	valid := func() Rule {
+		return Rule{ID: "llm-errors", Type: RuleErrorRate, Target: "mcp-llm-server", Threshold: 0.05}
+	}
+	cases := map[string]func(*Rule){
+		"bad id":             func(r *Rule) { r.ID = "Bad ID" },
+		"unknown type":       func(r *Rule) { r.Type = "cpu" },
+		"missing target":     func(r *Rule) { r.Target = " " },
+		"zero error rate":    func(r *Rule) { r.Threshold = 0 },
+		"error rate over 1":  func(r *Rule) { r.Threshold = 5 },
+		"negative for":       func(r *Rule) { r.ForMinutes = -1 },
+		"long window":        func(r *Rule) { r.WindowMinutes = MaxWindowMinutes + 1 },
+		"fractional restart": func(r *Rule) { r.Type, r.Threshold = RuleRestartLoop, 1.5 },
+	}
+	for name, mutate := range cases {
+		r := valid()
+		mutate(&r)
+		if err := r.Normalize(); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: expected ErrInvalid, got %v", name, err)
+		}
+	}
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	rules   map[string]Rule
+	active  map[string]Alert
+	history []Alert
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{rules: make(map[string]Rule), active: make(map[string]Alert)}
+}
+
+func (m *memoryStore) ListRules(context.Context) ([]Rule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rules := make([]Rule, 0, len(m.rules))
+	for _, r := range m.rules {
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func (m *memoryStore) GetRule(_ context.Context, id string) (*Rule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.rules[id]
+	if !ok {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+func (m *memoryStore) SaveRule(_ context.Context, rule Rule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rules[rule.ID] = rule
+	return nil
+}
+
+func (m *memoryStore) DeleteRule(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.rules[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.rules, id)
+	delete(m.active, id)
+	return nil
+}
+
+func (m *memoryStore) ListActive(context.Context) ([]Alert, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	alerts := make([]Alert, 0, len(m.active))
+	for _, a := range m.active {
+		alerts = append(alerts, a)
+	}
+	return alerts, nil
+}
+
+func (m *memoryStore) SaveActive(_ context.Context, alert Alert) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.active[alert.RuleID] = alert
+	return nil
+}
+
+func (m *memoryStore) DeleteActive(_ context.Context, ruleID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.active, ruleID)
+	return nil
+}
+
+func (m *memoryStore) AppendHistory(_ context.Context, alert Alert) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.history = append([]Alert{alert}, m.history...)
+	return nil
+}
+
+func (m *memoryStore) ListHistory(_ context.Context, limit int) ([]Alert, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	history := m.history
+	if limit > 0 && len(history) > limit {
+		history = history[:limit]
+	}
+	return append([]Alert(nil), history...), nil
+}
+
+type fakeStatus struct{ services []status.ServiceStatus }
+
+func (f *fakeStatus) GetAggregatedStatus(context.Context) *status.AggregatedStatus {
+	return &status.AggregatedStatus{Services: f.services}
+}
+
+type fakeMetrics struct {
+	rate   float64
+	params traces.MetricsParams
+}
+
+func (f *fakeMetrics) GetServiceMetrics(_ context.Context, params traces.MetricsParams) (*traces.ServiceMetricsResult, error) {
+	f.params = params
+	return &traces.ServiceMetricsResult{
+		Service: params.Service,
+		Metrics: traces.ServiceMetrics{ErrorRate: f.rate},
+		Errors:  []traces.MetricPoint{{Value: f.rate}},
+	}, nil
+}
+
+type fakeRestarts struct{ counts map[string]int }
+
+func (f *fakeRestarts) RestartCounts(context.Context) (map[string]int, error) {
+	return f.counts, nil
+}
+
+type recordingNotifier struct{ alerts []Alert }
+
+func (r *recordingNotifier) Notify(_ context.Context, alert Alert) error {
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func newTestService(sources Sources) (*Service, *memoryStore, *recordingNotifier, *time.Time) {
+	store := newMemoryStore()
+	notifier := &recordingNotifier{}
+	svc := NewService(store, sources, []Notifier{notifier}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	return svc, store, notifier, &now
+}
+
+func TestServiceDownFiresAfterForAndResolves(t *testing.T) {
+	src := &fakeStatus{services: []status.ServiceStatus{{Name: "twentyq-bot", Available: false}}}
+	svc, store, notifier, now := newTestService(Sources{Status: src})
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, Rule{ID: "twentyq-down", Type: RuleServiceDown, Target: "twentyq-bot", ForMinutes: 2, Enabled: true}, "admin"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := svc.Create(ctx, Rule{ID: "twentyq-down", Type: RuleServiceDown, Target: "twentyq-bot"}, "admin"); !errors.Is(err, ErrExists) {
+		t.Fatalf("expected ErrExists, got %v", err)
+	}
+
+	evaluate := func() {
+		t.Helper()
+		if err := svc.Evaluate(ctx); err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+	}
+
+	evaluate()
+	*now = now.Add(time.Minute)
+	evaluate()
+	if len(store.active) != 0 || len(notifier.alerts) != 0 {
+		t.Fatalf("alert fired before ForMinutes elapsed: %v", store.active)
+	}
+
+	*now = now.Add(time.Minute)
+	evaluate()
+	evaluate()
+	if len(store.active) != 1 || len(notifier.alerts) != 1 || notifier.alerts[0].State != StateFiring {
+		t.Fatalf("expected one firing notification, got active=%v notified=%v", store.active, notifier.alerts)
+	}
+
+	src.services[0].Available = true
+	*now = now.Add(time.Minute)
+	evaluate()
+	if len(store.active) != 0 || len(notifier.alerts) != 2 {
+		t.Fatalf("expected resolve, got active=%v notified=%v", store.active, notifier.alerts)
+	}
+	resolved := notifier.alerts[1]
+	if resolved.State != StateResolved || resolved.ResolvedAt == nil || !resolved.ResolvedAt.Equal(*now) || len(store.history) != 2 {
+		t.Fatalf("unexpected resolve record: %+v (history %d)", resolved, len(store.history))
+	}
+}
+
+func TestErrorRateThreshold(t *testing.T) {
+	src := &fakeMetrics{rate: 0.02}
+	svc, store, notifier, _ := newTestService(Sources{Metrics: src})
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, Rule{ID: "llm-errors", Type: RuleErrorRate, Target: "mcp-llm-server", Threshold: 0.05, WindowMinutes: 15, Enabled: true}, "admin"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := svc.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if src.params.Lookback != "15m" || src.params.Service != "mcp-llm-server" {
+		t.Fatalf("unexpected metrics query: %+v", src.params)
+	}
+	if len(store.active) != 0 {
+		t.Fatalf("fired below threshold: %v", store.active)
+	}
+
+	src.rate = 0.2
+	if err := svc.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if len(notifier.alerts) != 1 || notifier.alerts[0].Value != 0.2 {
+		t.Fatalf("expected error rate alert, got %v", notifier.alerts)
+	}
+}
+
+func TestRestartLoopCountsWithinWindow(t *testing.T) {
+	src := &fakeRestarts{counts: map[string]int{"valkey-cache": 10}}
+	svc, store, notifier, now := newTestService(Sources{Restarts: src})
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, Rule{ID: "valkey-loop", Type: RuleRestartLoop, Target: "valkey-cache", Threshold: 3, WindowMinutes: 10, Enabled: true}, "admin"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	step := func(count int, advance time.Duration) {
+		t.Helper()
+		*now = now.Add(advance)
+		src.counts["valkey-cache"] = count
+		if err := svc.Evaluate(ctx); err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+	}
+
+	// 누적 횟수가 이미 높아도 관측 이후 증가분만 센다
+	step(10, 0)
+	step(12, 5*time.Minute)
+	if len(store.active) != 0 {
+		t.Fatalf("fired on restarts before first sample: %v", store.active)
+	}
+
+	// 컨테이너 재생성으로 횟수가 줄면 표본을 새로 시작
+	step(1, time.Minute)
+	step(3, time.Minute)
+	if len(store.active) != 0 {
+		t.Fatalf("fired after counter reset: %v", store.active)
+	}
+
+	step(4, time.Minute)
+	if len(notifier.alerts) != 1 || notifier.alerts[0].Value != 3 {
+		t.Fatalf("expected restart loop alert, got %v", notifier.alerts)
+	}
+
+	// window가 지나 증가가 멈추면 해소
+	step(4, 11*time.Minute)
+	if len(store.active) != 0 || len(notifier.alerts) != 2 {
+		t.Fatalf("expected resolve after window, got active=%v notified=%d", store.active, len(notifier.alerts))
+	}
+}
+
+func TestUpdateDisableClearsActive(t *testing.T) {
+	src := &fakeStatus{services: []status.ServiceStatus{{Name: "hololive-bot"}}}
+	svc, store, notifier, _ := newTestService(Sources{Status: src})
+	ctx := context.Background()
+
+	rule := Rule{ID: "holo-down", Type: RuleServiceDown, Target: "hololive-bot", ForMinutes: 0, Enabled: true}
+	if _, err := svc.Create(ctx, rule, "admin"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	// ForMinutes 0은 기본값(5분)으로 채워지므로 시간을 당겨 발생시킴
+	svc.pending["holo-down"] = svc.now().Add(-10 * time.Minute)
+	if err := svc.Evaluate(ctx); err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if len(store.active) != 1 {
+		t.Fatalf("expected firing alert, got %v", store.active)
+	}
+
+	rule.Enabled = false
+	if _, err := svc.Update(ctx, "holo-down", rule, "admin"); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(store.active) != 0 || len(notifier.alerts) != 1 {
+		t.Fatalf("disable should clear active alert silently: active=%v notified=%d", store.active, len(notifier.alerts))
+	}
+	if err := svc.Delete(ctx, "missing", "admin"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	notifiers := NewWebhookNotifiers(" " + srv.URL + ", ,")
+	if len(notifiers) != 1 {
+		t.Fatalf("expected 1 notifier, got %d", len(notifiers))
+	}
+	alert := Alert{RuleID: "holo-down", RuleName: "홀로 봇 다운", State: StateFiring, Message: "hololive-bot is unavailable"}
+	if err := notifiers[0].Notify(context.Background(), alert); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if text, _ := got["text"].(string); !strings.HasPrefix(text, "[FIRING] 홀로 봇 다운") {
+		t.Fatalf("unexpected webhook text: %v", got["text"])
+	}
+}
@@ -0,0 +1,70 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Notifier: 알림 발생/해소 전송자
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// WebhookNotifier: 알림을 JSON으로 웹훅 URL에 POST
+type WebhookNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhookNotifier: 웹훅 알림 전송자 생성
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:        url,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// NewWebhookNotifiers: 쉼표로 구분된 URL 목록으로 전송자 목록 생성 (빈 항목은 무시)
+func NewWebhookNotifiers(urls string) []Notifier {
+	var notifiers []Notifier
+	for url := range strings.SplitSeq(urls, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			notifiers = append(notifiers, NewWebhookNotifier(url))
+		}
+	}
+	return notifiers
+}
+
+// Notify: {"text": 요약, "alert": 알림} 형태로 전송
+func (n *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(map[string]any{
+		"text":  alert.Summary(),
+		"alert": alert,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal alert notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create alert notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send alert notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("alert notification rejected: status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/traces"
+)
+
+// notifyTimeout: 웹훅 하나당 전송 제한 시간
+const notifyTimeout = 5 * time.Second
+
+// errNoData: 평가에 필요한 데이터가 없어 이번 평가를 건너뜀 (상태 유지)
+var errNoData = errors.New("no data")
+
+// StatusSource: 서비스 가용성 제공자 (status.Collector)
+type StatusSource interface {
+	GetAggregatedStatus(ctx context.Context) *status.AggregatedStatus
+}
+
+// MetricsSource: Jaeger SPM 메트릭 제공자 (traces.Client)
+type MetricsSource interface {
+	GetServiceMetrics(ctx context.Context, params traces.MetricsParams) (*traces.ServiceMetricsResult, error)
+}
+
+// RestartSource: 컨테이너별 누적 재시작 횟수 제공자 (docker.Service)
+type RestartSource interface {
+	RestartCounts(ctx context.Context) (map[string]int, error)
+}
+
+// Sources: 규칙 종류별 데이터 제공자 (nil이면 해당 종류 규칙은 평가하지 않음)
+type Sources struct {
+	Status   StatusSource
+	Metrics  MetricsSource
+	Restarts RestartSource
+}
+
+// restartSample: 재시작 루프 판단용 누적 재시작 횟수 표본
+type restartSample struct {
+	at    time.Time
+	count int
+}
+
+// Service: 알림 규칙 관리와 주기 평가를 담당합니다.
+// 조건 지속 시간과 재시작 표본은 메모리에만 두므로 재시작하면 처음부터 다시 잽니다.
+type Service struct {
+	store     Store
+	sources   Sources
+	notifiers []Notifier
+	logger    *slog.Logger
+
+	mu       sync.Mutex // 주기 평가와 규칙 변경의 동시 실행 방지
+	pending  map[string]time.Time
+	restarts map[string][]restartSample
+	now      func() time.Time
+}
+
+// NewService: 알림 서비스 생성 (notifiers가 비어 있으면 로그와 API로만 알림)
+func NewService(store Store, sources Sources, notifiers []Notifier, logger *slog.Logger) *Service {
+	return &Service{
+		store:     store,
+		sources:   sources,
+		notifiers: notifiers,
+		logger:    logger.With(slog.String("component", "alerts")),
+		pending:   make(map[string]time.Time),
+		restarts:  make(map[string][]restartSample),
+		now:       time.Now,
+	}
+}
+
+// ListRules: 전체 규칙 조회
+func (s *Service) ListRules(ctx context.Context) ([]Rule, error) {
+	rules, err := s.store.ListRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list alert rules: %w", err)
+	}
+	return rules, nil
+}
+
+// Active: 발생 중인 알림 조회
+func (s *Service) Active(ctx context.Context) ([]Alert, error) {
+	alerts, err := s.store.ListActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list active alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// History: 최신순 발생/해소 이력 조회
+func (s *Service) History(ctx context.Context, limit int) ([]Alert, error) {
+	alerts, err := s.store.ListHistory(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list alert history: %w", err)
+	}
+	return alerts, nil
+}
+
+// Create: 새 규칙 등록
+func (s *Service) Create(ctx context.Context, rule Rule, actor string) (Rule, error) {
+	if err := rule.Normalize(); err != nil {
+		return Rule{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.store.GetRule(ctx, rule.ID)
+	if err != nil {
+		return Rule{}, fmt.Errorf("get alert rule: %w", err)
+	}
+	if existing != nil {
+		return Rule{}, ErrExists
+	}
+
+	now := s.now()
+	rule.CreatedBy, rule.CreatedAt, rule.UpdatedAt = actor, now, now
+	if err := s.store.SaveRule(ctx, rule); err != nil {
+		return Rule{}, fmt.Errorf("save alert rule: %w", err)
+	}
+	s.logger.Info("alert_rule_created", slog.String("rule", rule.ID), slog.String("actor", actor))
+	return rule, nil
+}
+
+// Update: 규칙 변경 (조건이 바뀌므로 지속 시간은 다시 재고, 비활성화하면 발생 중 알림을 알림 없이 내림)
+func (s *Service) Update(ctx context.Context, id string, rule Rule, actor string) (Rule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.store.GetRule(ctx, id)
+	if err != nil {
+		return Rule{}, fmt.Errorf("get alert rule: %w", err)
+	}
+	if existing == nil {
+		return Rule{}, ErrNotFound
+	}
+
+	rule.ID = id
+	if err := rule.Normalize(); err != nil {
+		return Rule{}, err
+	}
+	rule.CreatedBy, rule.CreatedAt, rule.UpdatedAt = existing.CreatedBy, existing.CreatedAt, s.now()
+	if err := s.store.SaveRule(ctx, rule); err != nil {
+		return Rule{}, fmt.Errorf("save alert rule: %w", err)
+	}
+	if !rule.Enabled {
+		if err := s.store.DeleteActive(ctx, id); err != nil {
+			return Rule{}, fmt.Errorf("clear active alert: %w", err)
+		}
+	}
+	delete(s.pending, id)
+
+	s.logger.Info("alert_rule_updated", slog.String("rule", id), slog.String("actor", actor))
+	return rule, nil
+}
+
+// Delete: 규칙과 발생 중 알림 삭제 (이력은 보존)
+func (s *Service) Delete(ctx context.Context, id, actor string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.store.DeleteRule(ctx, id); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("delete alert rule: %w", err)
+	}
+	delete(s.pending, id)
+
+	s.logger.Info("alert_rule_deleted", slog.String("rule", id), slog.String("actor", actor))
+	return nil
+}
+
+// Run: interval마다 규칙을 평가 (ctx 종료 시 반환)
+func (s *Service) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Evaluate(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Warn("alert_evaluate_failed", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate: 활성 규칙을 한 번 평가하고, 상태가 바뀐 규칙은 기록 후 웹훅으로 알립니다.
+func (s *Service) Evaluate(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules, err := s.store.ListRules(ctx)
+	if err != nil {
+		return fmt.Errorf("list alert rules: %w", err)
+	}
+	activeList, err := s.store.ListActive(ctx)
+	if err != nil {
+		return fmt.Errorf("list active alerts: %w", err)
+	}
+	active := make(map[string]Alert, len(activeList))
+	for _, alert := range activeList {
+		active[alert.RuleID] = alert
+	}
+
+	now := s.now()
+	snap := s.snapshot(ctx, rules, now)
+
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		breached, value, message, err := s.check(ctx, rule, snap, now)
+		if err != nil {
+			if !errors.Is(err, errNoData) {
+				s.logger.Warn("alert_rule_check_failed", slog.String("rule", rule.ID), slog.Any("error", err))
+			}
+			continue
+		}
+
+		current, firing := active[rule.ID]
+		if !breached {
+			delete(s.pending, rule.ID)
+			if firing {
+				resolvedAt := now
+				current.State, current.Value, current.Message, current.ResolvedAt = StateResolved, value, message, &resolvedAt
+				if err := s.store.DeleteActive(ctx, rule.ID); err != nil {
+					return fmt.Errorf("resolve alert %s: %w", rule.ID, err)
+				}
+				s.record(ctx, current)
+			}
+			continue
+		}
+
+		since, ok := s.pending[rule.ID]
+		if !ok {
+			since = now
+			s.pending[rule.ID] = since
+		}
+		if firing || now.Sub(since) < rule.For() {
+			continue
+		}
+
+		alert := Alert{
+			RuleID:   rule.ID,
+			RuleName: rule.Name,
+			Type:     rule.Type,
+			Target:   rule.Target,
+			State:    StateFiring,
+			Value:    value,
+			Message:  message,
+			FiredAt:  now,
+		}
+		if err := s.store.SaveActive(ctx, alert); err != nil {
+			return fmt.Errorf("fire alert %s: %w", rule.ID, err)
+		}
+		s.record(ctx, alert)
+	}
+	return nil
+}
+
+// evalSnapshot: 한 번의 평가에서 여러 규칙이 함께 쓰는 조회 결과
+type evalSnapshot struct {
+	services    map[string]bool // 서비스 이름 → 가용 여부
+	restarts    map[string]int
+	restartsErr error
+}
+
+// snapshot: 필요한 종류의 규칙이 있을 때만 상태/재시작 횟수를 한 번씩 조회하고 재시작 표본을 쌓습니다.
+func (s *Service) snapshot(ctx context.Context, rules []Rule, now time.Time) evalSnapshot {
+	var snap evalSnapshot
+	var needStatus bool
+	var restartWindow time.Duration
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		switch rule.Type {
+		case RuleServiceDown:
+			needStatus = true
+		case RuleRestartLoop:
+			restartWindow = max(restartWindow, rule.Window())
+		}
+	}
+
+	if needStatus && s.sources.Status != nil {
+		if aggregated := s.sources.Status.GetAggregatedStatus(ctx); aggregated != nil {
+			snap.services = make(map[string]bool, len(aggregated.Services))
+			for _, svc := range aggregated.Services {
+				snap.services[svc.Name] = svc.Available
+			}
+		}
+	}
+
+	if restartWindow > 0 && s.sources.Restarts != nil {
+		snap.restarts, snap.restartsErr = s.sources.Restarts.RestartCounts(ctx)
+		if snap.restartsErr == nil {
+			s.recordRestarts(snap.restarts, now, restartWindow)
+		}
+	}
+	return snap
+}
+
+// recordRestarts: 재시작 표본을 추가하고 window보다 오래된 표본은 버립니다.
+// 누적 횟수가 줄었으면 컨테이너가 다시 만들어진 것이므로 표본을 새로 시작합니다.
+func (s *Service) recordRestarts(counts map[string]int, now time.Time, window time.Duration) {
+	for name, count := range counts {
+		samples := s.restarts[name]
+		if n := len(samples); n > 0 && count < samples[n-1].count {
+			samples = nil
+		}
+		samples = append(samples, restartSample{at: now, count: count})
+
+		cutoff := now.Add(-window)
+		drop := 0
+		for drop < len(samples)-1 && samples[drop].at.Before(cutoff) {
+			drop++
+		}
+		s.restarts[name] = samples[drop:]
+	}
+	for name := range s.restarts {
+		if _, ok := counts[name]; !ok {
+			delete(s.restarts, name)
+		}
+	}
+}
+
+// check: 규칙 조건 충족 여부와 측정값, 메시지를 반환합니다.
+func (s *Service) check(ctx context.Context, rule Rule, snap evalSnapshot, now time.Time) (bool, float64, string, error) {
+	switch rule.Type {
+	case RuleServiceDown:
+		if snap.services == nil {
+			return false, 0, "", errNoData
+		}
+		available, ok := snap.services[rule.Target]
+		if !ok {
+			return false, 0, "", fmt.Errorf("service %q not monitored", rule.Target)
+		}
+		if available {
+			return false, 0, fmt.Sprintf("%s is available", rule.Target), nil
+		}
+		return true, 1, fmt.Sprintf("%s is unavailable", rule.Target), nil
+
+	case RuleErrorRate:
+		if s.sources.Metrics == nil {
+			return false, 0, "", errNoData
+		}
+		result, err := s.sources.Metrics.GetServiceMetrics(ctx, traces.MetricsParams{
+			Service:  rule.Target,
+			Lookback: fmt.Sprintf("%dm", rule.WindowMinutes),
+			Step:     "1m",
+			RatePer:  "minute",
+		})
+		if err != nil {
+			return false, 0, "", fmt.Errorf("get service metrics: %w", err)
+		}
+		if len(result.Errors) == 0 {
+			return false, 0, "", errNoData
+		}
+		rate := result.Metrics.ErrorRate
+		message := fmt.Sprintf("%s error rate %.1f%% over %dm (threshold %.1f%%)", rule.Target, rate*100, rule.WindowMinutes, rule.Threshold*100)
+		return rate > rule.Threshold, rate, message, nil
+
+	case RuleRestartLoop:
+		if snap.restartsErr != nil {
+			return false, 0, "", fmt.Errorf("get restart counts: %w", snap.restartsErr)
+		}
+		if snap.restarts == nil {
+			return false, 0, "", errNoData
+		}
+		if _, ok := snap.restarts[rule.Target]; !ok {
+			return false, 0, "", fmt.Errorf("container %q not found", rule.Target)
+		}
+		restarts := s.restartsWithin(rule.Target, now, rule.Window())
+		message := fmt.Sprintf("%s restarted %d times in %dm (threshold %d)", rule.Target, restarts, rule.WindowMinutes, int(rule.Threshold))
+		return float64(restarts) >= rule.Threshold, float64(restarts), message, nil
+	}
+	return false, 0, "", fmt.Errorf("unknown rule type %q", rule.Type)
+}
+
+// restartsWithin: window 안의 가장 오래된 표본 대비 재시작 증가량
+func (s *Service) restartsWithin(name string, now time.Time, window time.Duration) int {
+	samples := s.restarts[name]
+	if len(samples) == 0 {
+		return 0
+	}
+	cutoff := now.Add(-window)
+	for _, sample := range samples {
+		if !sample.at.Before(cutoff) {
+			return samples[len(samples)-1].count - sample.count
+		}
+	}
+	return 0
+}
+
+// record: 이력에 남기고 로그와 웹훅으로 알립니다. (전송 실패는 로그만 남김)
+func (s *Service) record(ctx context.Context, alert Alert) {
+	if err := s.store.AppendHistory(ctx, alert); err != nil {
+		s.logger.Warn("alert_history_append_failed", slog.String("rule", alert.RuleID), slog.Any("error", err))
+	}
+
+	if alert.State == StateFiring {
+		s.logger.Warn("alert_firing", slog.String("rule", alert.RuleID), slog.String("message", alert.Message))
+	} else {
+		s.logger.Info("alert_resolved", slog.String("rule", alert.RuleID), slog.String("message", alert.Message))
+	}
+
+	for _, notifier := range s.notifiers {
+		notifyCtx, cancel := context.WithTimeout(ctx, notifyTimeout)
+		if err := notifier.Notify(notifyCtx, alert); err != nil {
+			s.logger.Warn("alert_notify_failed", slog.String("rule", alert.RuleID), slog.Any("error", err))
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,227 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	rulesKey   = "alerts:rules"
+	activeKey  = "alerts:active"
+	historyKey = "alerts:history"
+)
+
+// Store: 알림 규칙/발생 상태/이력 저장소 인터페이스
+type Store interface {
+	ListRules(ctx context.Context) ([]Rule, error)
+	GetRule(ctx context.Context, id string) (*Rule, error)
+	SaveRule(ctx context.Context, rule Rule) error
+	// DeleteRule: 규칙과 발생 중 알림을 함께 삭제 (없으면 ErrNotFound)
+	DeleteRule(ctx context.Context, id string) error
+
+	// ListActive: 발생 중인 알림 (발생 시각 최신순)
+	ListActive(ctx context.Context) ([]Alert, error)
+	SaveActive(ctx context.Context, alert Alert) error
+	DeleteActive(ctx context.Context, ruleID string) error
+
+	AppendHistory(ctx context.Context, alert Alert) error
+	// ListHistory: 최신순 발생/해소 이력
+	ListHistory(ctx context.Context, limit int) ([]Alert, error)
+}
+
+// ValkeyStore: Valkey 기반 알림 저장소 (규칙/발생 중 알림은 해시 필드, 이력은 리스트)
+type ValkeyStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 알림 저장소 생성
+func NewValkeyStore(client valkey.Client, logger *slog.Logger) *ValkeyStore {
+	return &ValkeyStore{client: client, logger: logger}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+// ListRules: 전체 규칙 조회 (ID 순)
+func (s *ValkeyStore) ListRules(ctx context.Context) ([]Rule, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hgetall().Key(rulesKey).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("list alert rules: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(raw))
+	for id, item := range raw {
+		var rule Rule
+		if err := json.Unmarshal([]byte(item), &rule); err != nil {
+			s.logger.Warn("alert_rule_decode_failed", slog.String("rule", id), slog.Any("error", err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+	return rules, nil
+}
+
+// GetRule: 규칙 조회 (없으면 nil)
+func (s *ValkeyStore) GetRule(ctx context.Context, id string) (*Rule, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hget().Key(rulesKey).Field(id).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get alert rule: %w", err)
+	}
+
+	var rule Rule
+	if err := json.Unmarshal([]byte(raw), &rule); err != nil {
+		return nil, fmt.Errorf("decode alert rule: %w", err)
+	}
+	return &rule, nil
+}
+
+// SaveRule: 규칙 저장 (덮어쓰기)
+func (s *ValkeyStore) SaveRule(ctx context.Context, rule Rule) error {
+	return s.hset(ctx, rulesKey, rule.ID, rule)
+}
+
+// DeleteRule: 규칙과 발생 중 알림 삭제
+func (s *ValkeyStore) DeleteRule(ctx context.Context, id string) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	resps := s.client.DoMulti(ctx,
+		s.client.B().Hdel().Key(rulesKey).Field(id).Build(),
+		s.client.B().Hdel().Key(activeKey).Field(id).Build(),
+	)
+	removed, err := resps[0].AsInt64()
+	if err != nil {
+		return fmt.Errorf("delete alert rule: %w", err)
+	}
+	if err := resps[1].Error(); err != nil {
+		return fmt.Errorf("delete active alert: %w", err)
+	}
+	if removed == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListActive: 발생 중인 알림 조회
+func (s *ValkeyStore) ListActive(ctx context.Context) ([]Alert, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hgetall().Key(activeKey).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("list active alerts: %w", err)
+	}
+
+	alerts := make([]Alert, 0, len(raw))
+	for id, item := range raw {
+		var alert Alert
+		if err := json.Unmarshal([]byte(item), &alert); err != nil {
+			s.logger.Warn("active_alert_decode_failed", slog.String("rule", id), slog.Any("error", err))
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].FiredAt.After(alerts[j].FiredAt)
+	})
+	return alerts, nil
+}
+
+// SaveActive: 발생 중 알림 저장
+func (s *ValkeyStore) SaveActive(ctx context.Context, alert Alert) error {
+	return s.hset(ctx, activeKey, alert.RuleID, alert)
+}
+
+// DeleteActive: 발생 중 알림 삭제
+func (s *ValkeyStore) DeleteActive(ctx context.Context, ruleID string) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if err := s.client.Do(ctx, s.client.B().Hdel().Key(activeKey).Field(ruleID).Build()).Error(); err != nil {
+		return fmt.Errorf("delete active alert: %w", err)
+	}
+	return nil
+}
+
+// AppendHistory: 이력 앞에 추가하고 최대 길이로 자름
+func (s *ValkeyStore) AppendHistory(ctx context.Context, alert Alert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("marshal alert: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmds := valkey.Commands{
+		s.client.B().Lpush().Key(historyKey).Element(string(data)).Build(),
+		s.client.B().Ltrim().Key(historyKey).Start(0).Stop(MaxHistory - 1).Build(),
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append alert history: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListHistory: 최신순 이력 조회
+func (s *ValkeyStore) ListHistory(ctx context.Context, limit int) ([]Alert, error) {
+	if limit <= 0 || limit > MaxHistory {
+		limit = MaxHistory
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Lrange().Key(historyKey).Start(0).Stop(int64(limit-1)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list alert history: %w", err)
+	}
+
+	alerts := make([]Alert, 0, len(raw))
+	for _, item := range raw {
+		var alert Alert
+		if err := json.Unmarshal([]byte(item), &alert); err != nil {
+			s.logger.Warn("alert_history_decode_failed", slog.Any("error", err))
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+func (s *ValkeyStore) hset(ctx context.Context, key, field string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", key, err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if err := s.client.Do(ctx, s.client.B().Hset().Key(key).FieldValue().FieldValue(field, string(data)).Build()).Error(); err != nil {
+		return fmt.Errorf("save %s: %w", key, err)
+	}
+	return nil
+}
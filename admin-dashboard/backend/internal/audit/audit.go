@@ -0,0 +1,167 @@
+// Package audit: 관리자 변경 작업 감사 로그
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	logKey = "audit:admin:log"
+	// DefaultMaxEntries: 보관할 최대 감사 로그 수 (초과분은 오래된 순으로 삭제)
+	DefaultMaxEntries = 10000
+	// SystemActor: 예약 작업 등 서버가 직접 수행한 작업의 수행자
+	SystemActor = "system"
+)
+
+// Entry: 감사 로그 항목
+type Entry struct {
+	ID         string    `json:"id"`
+	Timestamp  time.Time `json:"timestamp"`
+	Actor      string    `json:"actor"`
+	SessionID  string    `json:"sessionId,omitempty"`
+	IP         string    `json:"ip"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Action     string    `json:"action"`
+	Target     string    `json:"target,omitempty"`
+	Status     int       `json:"status"`
+	DurationMs int64     `json:"durationMs"`
+	Payload    string    `json:"payload,omitempty"`
+}
+
+// Filter: 감사 로그 조회 조건
+type Filter struct {
+	Actor  string
+	Action string
+	Method string
+	IP     string
+	Target string
+	From   time.Time
+	To     time.Time
+	Limit  int
+	Offset int
+}
+
+// Match: 항목이 조회 조건을 만족하는지 확인
+func (f Filter) Match(e Entry) bool {
+	if f.Actor != "" && !strings.EqualFold(e.Actor, f.Actor) {
+		return false
+	}
+	// Action은 접두사 매칭 (예: "docker" → docker.restart, docker.stop)
+	if f.Action != "" && !strings.HasPrefix(e.Action, f.Action) {
+		return false
+	}
+	if f.Method != "" && !strings.EqualFold(e.Method, f.Method) {
+		return false
+	}
+	if f.IP != "" && e.IP != f.IP {
+		return false
+	}
+	if f.Target != "" && !strings.Contains(e.Target, f.Target) {
+		return false
+	}
+	if !f.From.IsZero() && e.Timestamp.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && e.Timestamp.After(f.To) {
+		return false
+	}
+	return true
+}
+
+// Store: 감사 로그 저장소 인터페이스
+type Store interface {
+	Append(ctx context.Context, entry Entry) error
+	// List: 최신순으로 필터링된 항목과 전체 매칭 건수를 반환
+	List(ctx context.Context, filter Filter) ([]Entry, int, error)
+}
+
+// ValkeyStore: Valkey 리스트 기반 감사 로그 저장소
+type ValkeyStore struct {
+	client     valkey.Client
+	logger     *slog.Logger
+	maxEntries int
+}
+
+// NewValkeyStore: Valkey 감사 로그 저장소 생성
+func NewValkeyStore(client valkey.Client, maxEntries int, logger *slog.Logger) *ValkeyStore {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	return &ValkeyStore{
+		client:     client,
+		logger:     logger,
+		maxEntries: maxEntries,
+	}
+}
+
+// Append: 항목을 리스트 앞에 추가하고 최대 길이로 자름
+func (s *ValkeyStore) Append(ctx context.Context, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %w", err)
+	}
+
+	appendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+
+	cmds := valkey.Commands{
+		s.client.B().Lpush().Key(logKey).Element(string(data)).Build(),
+		s.client.B().Ltrim().Key(logKey).Start(0).Stop(int64(s.maxEntries - 1)).Build(),
+	}
+	for _, resp := range s.client.DoMulti(appendCtx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append audit entry: %w", err)
+		}
+	}
+	return nil
+}
+
+// List: 최신순으로 항목을 조회합니다. (보관 개수가 제한되므로 메모리 필터링)
+func (s *ValkeyStore) List(ctx context.Context, filter Filter) ([]Entry, int, error) {
+	listCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	raw, err := s.client.Do(listCtx, s.client.B().Lrange().Key(logKey).Start(0).Stop(-1).Build()).AsStrSlice()
+	if err != nil {
+		return nil, 0, fmt.Errorf("list audit entries: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(raw))
+	for _, item := range raw {
+		var entry Entry
+		if err := json.Unmarshal([]byte(item), &entry); err != nil {
+			s.logger.Warn("audit_entry_decode_failed", slog.Any("error", err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	page, total := Paginate(entries, filter)
+	return page, total, nil
+}
+
+// Paginate: 필터를 적용한 뒤 Offset/Limit 구간과 전체 매칭 건수를 반환
+func Paginate(entries []Entry, filter Filter) ([]Entry, int) {
+	matched := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		if filter.Match(entry) {
+			matched = append(matched, entry)
+		}
+	}
+
+	total := len(matched)
+	start := min(max(filter.Offset, 0), total)
+	end := total
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, total)
+	}
+	return matched[start:end], total
+}
@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+func (m *memoryStore) Append(_ context.Context, entry Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append([]Entry{entry}, m.entries...)
+	return nil
+}
+
+func (m *memoryStore) List(_ context.Context, filter Filter) ([]Entry, int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	page, total := Paginate(m.entries, filter)
+	return page, total, nil
+}
+
+func newTestEngine(store Store) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	recorder := NewRecorder(store, func(*gin.Context) (string, string) {
+		return "admin", "0123456789abcdef"
+	}, logger)
+
+	engine := gin.New()
+	api := engine.Group("/admin/api")
+	api.Use(recorder.Middleware())
+	api.POST("/docker/containers/:name/restart", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	})
+	api.POST("/docker/prune", func(c *gin.Context) {
+		Annotate(c, "tool-run-1,tool-run-2", `{"removed":2}`)
+		c.Status(http.StatusOK)
+	})
+	api.Any("/twentyq/*path", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return engine
+}
+
+func TestMiddleware_RecordsMutatingRequests(t *testing.T) {
+	t.Parallel()
+
+	store := &memoryStore{}
+	engine := newTestEngine(store)
+
+	body := `{"reason":"deploy","password":"hunter2"}`
+	req := httptest.NewRequest(http.MethodPost, "/admin/api/docker/containers/twentyq-bot/restart", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+
+	if rec.Body.String() != body {
+		t.Fatalf("handler should receive original body, got %q", rec.Body.String())
+	}
+	if len(store.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(store.entries))
+	}
+
+	entry := store.entries[0]
+	if entry.Action != "docker.restart" || entry.Target != "twentyq-bot" {
+		t.Fatalf("unexpected action/target: %s %s", entry.Action, entry.Target)
+	}
+	if entry.Actor != "admin" || entry.Status != http.StatusOK {
+		t.Fatalf("unexpected actor/status: %s %d", entry.Actor, entry.Status)
+	}
+	if strings.Contains(entry.Payload, "hunter2") || !strings.Contains(entry.Payload, redactedValue) {
+		t.Fatalf("payload not redacted: %s", entry.Payload)
+	}
+	if !strings.HasPrefix(entry.SessionID, "01234567") || len(entry.SessionID) > 16 {
+		t.Fatalf("session id should be truncated, got %q", entry.SessionID)
+	}
+}
+
+func TestMiddleware_SkipsReadRequests(t *testing.T) {
+	t.Parallel()
+
+	store := &memoryStore{}
+	engine := newTestEngine(store)
+
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/admin/api/twentyq/admin/sessions", nil))
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, "/admin/api/twentyq/admin/sessions/room-1", nil))
+
+	if len(store.entries) != 1 {
+		t.Fatalf("expected only DELETE recorded, got %d", len(store.entries))
+	}
+	entry := store.entries[0]
+	if entry.Action != "proxy.twentyq.delete" || entry.Target != "/admin/sessions/room-1" {
+		t.Fatalf("unexpected proxy classification: %s %s", entry.Action, entry.Target)
+	}
+}
+
+func TestMiddleware_UsesAnnotation(t *testing.T) {
+	t.Parallel()
+
+	store := &memoryStore{}
+	engine := newTestEngine(store)
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/admin/api/docker/prune", strings.NewReader(`{"dryRun":false}`)))
+
+	if len(store.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(store.entries))
+	}
+	entry := store.entries[0]
+	if entry.Action != "docker.prune" || entry.Target != "tool-run-1,tool-run-2" || entry.Payload != `{"removed":2}` {
+		t.Fatalf("annotation not applied: %+v", entry)
+	}
+}
+
+func TestSystemEntry(t *testing.T) {
+	t.Parallel()
+
+	entry := SystemEntry("docker.prune", "tool-run-1", strings.Repeat("x", maxPayloadSummary+10))
+	if entry.Actor != SystemActor || entry.ID == "" || entry.Timestamp.IsZero() {
+		t.Fatalf("unexpected system entry: %+v", entry)
+	}
+	if len(entry.Payload) > maxPayloadSummary+len("…") || !strings.HasSuffix(entry.Payload, "…") {
+		t.Fatalf("payload should be truncated, got %d bytes", len(entry.Payload))
+	}
+}
+
+func TestPaginate_FiltersNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{ID: "4", Action: "docker.stop", Timestamp: base.Add(4 * time.Hour)},
+		{ID: "3", Action: "proxy.holo.post", Timestamp: base.Add(3 * time.Hour)},
+		{ID: "2", Action: "docker.restart", Timestamp: base.Add(2 * time.Hour)},
+		{ID: "1", Action: "docker.restart", Timestamp: base.Add(1 * time.Hour)},
+	}
+
+	page, total := Paginate(entries, Filter{Action: "docker", Limit: 2})
+	if total != 3 || len(page) != 2 || page[0].ID != "4" || page[1].ID != "2" {
+		t.Fatalf("unexpected page: total=%d page=%+v", total, page)
+	}
+
+	page, total = Paginate(entries, Filter{Action: "docker", Limit: 2, Offset: 2})
+	if total != 3 || len(page) != 1 || page[0].ID != "1" {
+		t.Fatalf("unexpected second page: total=%d page=%+v", total, page)
+	}
+
+	page, _ = Paginate(entries, Filter{From: base.Add(90 * time.Minute), To: base.Add(3 * time.Hour)})
+	if len(page) != 2 || page[0].ID != "3" || page[1].ID != "2" {
+		t.Fatalf("unexpected time range result: %+v", page)
+	}
+
+	if page, total = Paginate(entries, Filter{Offset: 10}); total != 4 || len(page) != 0 {
+		t.Fatalf("offset beyond total should be empty, got %d/%d", len(page), total)
+	}
+}
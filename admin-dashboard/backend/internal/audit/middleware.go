@@ -0,0 +1,244 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+)
+
+const (
+	// maxPayloadBytes: 요약 대상으로 읽는 요청 본문 최대 크기
+	maxPayloadBytes = 4096
+	// maxPayloadSummary: 저장되는 페이로드 요약 최대 길이
+	maxPayloadSummary = 512
+	redactedValue     = "[REDACTED]"
+
+	// contextKeyTarget, contextKeyResult: 핸들러가 Annotate로 남긴 대상/결과 요약
+	contextKeyTarget = "audit.target"
+	contextKeyResult = "audit.result"
+)
+
+// sensitiveKeys: 페이로드 요약에서 값을 가리는 키 (부분 일치, 소문자)
+var sensitiveKeys = []string{"password", "secret", "token", "apikey", "api_key", "authorization", "cookie", "otp"}
+
+// Recorder: 변경 요청을 감사 로그로 기록하는 미들웨어 제공자
+type Recorder struct {
+	store    Store
+	identify IdentifyFunc
+	logger   *slog.Logger
+}
+
+// IdentifyFunc: 요청의 수행자와 세션 ID를 반환
+type IdentifyFunc func(c *gin.Context) (actor, sessionID string)
+
+// NewRecorder: 감사 로그 기록기 생성
+// identify가 nil이거나 빈 값을 반환하면 수행자는 "unknown"으로 기록됩니다.
+func NewRecorder(store Store, identify IdentifyFunc, logger *slog.Logger) *Recorder {
+	return &Recorder{
+		store:    store,
+		identify: identify,
+		logger:   logger,
+	}
+}
+
+// Middleware: GET/HEAD/OPTIONS를 제외한 요청을 처리 후 기록
+// 저장 실패는 요청 결과에 영향을 주지 않고 경고 로그만 남깁니다.
+func (r *Recorder) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if r == nil || r.store == nil || !isMutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		payload := readPayload(c.Request)
+		start := time.Now()
+
+		c.Next()
+
+		action, target := classify(c.Request.Method, c.FullPath(), c)
+		if annotated := c.GetString(contextKeyTarget); annotated != "" {
+			target = annotated
+		}
+		summary := summarizePayload(payload)
+		if result := c.GetString(contextKeyResult); result != "" {
+			summary = truncate(result, maxPayloadSummary)
+		}
+		actor, sessionID := r.resolveIdentity(c)
+		entry := Entry{
+			ID:         newEntryID(),
+			Timestamp:  start.UTC(),
+			Actor:      actor,
+			SessionID:  truncate(sessionID, 8),
+			IP:         c.ClientIP(),
+			Method:     c.Request.Method,
+			Path:       c.Request.URL.Path,
+			Action:     action,
+			Target:     target,
+			Status:     c.Writer.Status(),
+			DurationMs: time.Since(start).Milliseconds(),
+			Payload:    summary,
+		}
+
+		if err := r.store.Append(c.Request.Context(), entry); err != nil {
+			r.logger.Warn("audit_append_failed",
+				slog.String("action", entry.Action),
+				slog.String("path", entry.Path),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// Annotate: 요청 본문 대신 처리 결과를 감사 로그에 남기도록 대상과 결과 요약을 지정합니다.
+// (예: 수동 정리 요청에서 실제로 삭제된 컨테이너 목록)
+func Annotate(c *gin.Context, target, result string) {
+	if target != "" {
+		c.Set(contextKeyTarget, target)
+	}
+	if result != "" {
+		c.Set(contextKeyResult, result)
+	}
+}
+
+// SystemEntry: 요청 없이 서버가 스스로 수행한 작업(예약 작업 등)의 감사 로그 항목을 만듭니다.
+func SystemEntry(action, target, result string) Entry {
+	return Entry{
+		ID:        newEntryID(),
+		Timestamp: time.Now().UTC(),
+		Actor:     SystemActor,
+		Method:    "SYSTEM",
+		Action:    action,
+		Target:    target,
+		Payload:   truncate(result, maxPayloadSummary),
+	}
+}
+
+func (r *Recorder) resolveIdentity(c *gin.Context) (string, string) {
+	var actor, sessionID string
+	if r.identify != nil {
+		actor, sessionID = r.identify(c)
+	}
+	if actor = strings.TrimSpace(actor); actor == "" {
+		actor = "unknown"
+	}
+	return actor, sessionID
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// classify: 라우트 패턴에서 액션 이름과 대상을 도출
+func classify(method, fullPath string, c *gin.Context) (string, string) {
+	switch {
+	case strings.HasPrefix(fullPath, "/admin/api/docker/containers/:name/"):
+		return "docker." + strings.TrimPrefix(fullPath, "/admin/api/docker/containers/:name/"), c.Param("name")
+	case strings.HasSuffix(fullPath, "/*path"):
+		bot := strings.TrimSuffix(strings.TrimPrefix(fullPath, "/admin/api/"), "/*path")
+		return "proxy." + bot + "." + strings.ToLower(method), c.Param("path")
+	case fullPath == "":
+		return "unknown", c.Request.URL.Path
+	default:
+		name := strings.Trim(strings.TrimPrefix(fullPath, "/admin/api"), "/")
+		return strings.ReplaceAll(name, "/", "."), ""
+	}
+}
+
+// readPayload: 요청 본문 앞부분을 읽고 핸들러가 다시 읽을 수 있도록 복원
+func readPayload(req *http.Request) []byte {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	head, err := io.ReadAll(io.LimitReader(req.Body, maxPayloadBytes))
+	if err != nil {
+		return nil
+	}
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), req.Body), req.Body}
+	return head
+}
+
+// summarizePayload: JSON 본문은 민감 키를 가린 뒤 직렬화하고, 그 외에는 길이만 기록
+func summarizePayload(payload []byte) string {
+	payload = bytes.TrimSpace(payload)
+	if len(payload) == 0 {
+		return ""
+	}
+
+	var decoded any
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return "(" + http.DetectContentType(payload) + ", " + strconv.Itoa(len(payload)) + " bytes)"
+	}
+
+	redacted, err := json.Marshal(redact(decoded))
+	if err != nil {
+		return ""
+	}
+	return truncate(string(redacted), maxPayloadSummary)
+}
+
+func redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, inner := range v {
+			if isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(inner)
+		}
+		return v
+	case []any:
+		for i, inner := range v {
+			v[i] = redact(inner)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, candidate := range sensitiveKeys {
+		if strings.Contains(lower, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// truncate: UTF-8 경계를 지키며 limit 바이트 이내로 자름
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(value[cut]) {
+		cut--
+	}
+	return value[:cut] + "…"
+}
+
+func newEntryID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
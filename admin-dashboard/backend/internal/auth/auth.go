@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -25,26 +26,46 @@ import (
 const (
 	SessionCookieName = "admin_session"
 	sessionKeyPrefix  = "session:admin:"
+	// sessionUserIndexPrefix: 사용자별 세션 ID 인덱스 (SET, 만료된 ID는 목록 조회 시 정리)
+	sessionUserIndexPrefix = "session:admin:user:"
+	// sessionUsersKey: 세션 인덱스를 가진 사용자명 목록 (SET)
+	sessionUsersKey = "session:admin:users"
+	// ContextKeySessionID: AuthMiddleware가 검증된 세션 ID를 저장하는 gin 컨텍스트 키
+	ContextKeySessionID = "admin_session_id"
 )
 
 // Session: 관리자 세션 정보
 type Session struct {
 	ID                string    `json:"id"`
+	Username          string    `json:"username,omitempty"`
 	CreatedAt         time.Time `json:"created_at"`
 	ExpiresAt         time.Time `json:"expires_at"`
 	AbsoluteExpiresAt time.Time `json:"absolute_expires_at"`
 	LastRotatedAt     time.Time `json:"last_rotated_at,omitempty"`
+	LastSeenAt        time.Time `json:"last_seen_at,omitempty"`
+	IP                string    `json:"ip,omitempty"`
+	UserAgent         string    `json:"user_agent,omitempty"`
+	// TwoFactorPending: 2단계 인증 필수인데 아직 등록하지 않은 계정의 세션 (등록 API만 허용)
+	TwoFactorPending bool `json:"two_factor_pending,omitempty"`
+}
+
+// SessionMeta: 로그인 시점의 접속 정보 (세션 관리 화면 표시용)
+type SessionMeta struct {
+	IP               string
+	UserAgent        string
+	TwoFactorPending bool
 }
 
 // SessionProvider: 세션 저장소 인터페이스
 type SessionProvider interface {
-	CreateSession(ctx context.Context) (*Session, error)
+	CreateSession(ctx context.Context, username string, meta SessionMeta) (*Session, error)
 	GetSession(ctx context.Context, sessionID string) (*Session, error)
 	ValidateSession(ctx context.Context, sessionID string) bool
 	DeleteSession(ctx context.Context, sessionID string)
 	RefreshSession(ctx context.Context, sessionID string) bool
 	RefreshSessionWithValidation(ctx context.Context, sessionID string, idle bool) (refreshed bool, absoluteExpired bool, err error)
 	RotateSession(ctx context.Context, oldSessionID string) (*Session, error)
+	ListSessions(ctx context.Context) ([]Session, error)
 }
 
 // ValkeySessionStore: Valkey 기반 세션 저장소
@@ -64,19 +85,25 @@ func NewValkeySessionStore(client valkey.Client, logger *slog.Logger) *ValkeySes
 }
 
 // CreateSession: 새 세션 생성
-func (s *ValkeySessionStore) CreateSession(ctx context.Context) (*Session, error) {
+func (s *ValkeySessionStore) CreateSession(ctx context.Context, username string, meta SessionMeta) (*Session, error) {
 	sessionID := generateSessionID()
 	now := time.Now()
 	session := &Session{
 		ID:                sessionID,
+		Username:          username,
 		CreatedAt:         now,
 		ExpiresAt:         now.Add(s.ttl),
 		AbsoluteExpiresAt: now.Add(config.SessionConfig.AbsoluteTimeout),
+		LastSeenAt:        now,
+		IP:                meta.IP,
+		UserAgent:         truncateUserAgent(meta.UserAgent),
+		TwoFactorPending:  meta.TwoFactorPending,
 	}
 
 	if err := s.storeSession(ctx, session); err != nil {
 		return nil, err
 	}
+	s.indexSession(ctx, session.Username, session.ID)
 
 	s.logger.Debug("Session created",
 		slog.String("session_id", truncateSessionID(sessionID)),
@@ -171,6 +198,11 @@ func (s *ValkeySessionStore) DeleteSession(ctx context.Context, sessionID string
 	deleteCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
 	defer cancel()
 
+	// 인덱스 정리를 위해 사용자명을 먼저 확인 (실패해도 삭제는 진행, 남은 ID는 목록 조회 시 정리됨)
+	if session, err := s.GetSession(deleteCtx, sessionID); err == nil && session != nil {
+		s.unindexSession(deleteCtx, session.Username, sessionID)
+	}
+
 	key := sessionKeyPrefix + sessionID
 	if err := s.client.Do(deleteCtx, s.client.B().Del().Key(key).Build()).Error(); err != nil {
 		s.logger.Error("Failed to delete session", slog.String("session_id", truncateSessionID(sessionID)), slog.Any("error", err))
@@ -204,7 +236,9 @@ func (s *ValkeySessionStore) RefreshSessionWithValidation(ctx context.Context, s
 		return false, false, nil
 	}
 
-	if err := s.expireSession(ctx, sessionID, s.ttl); err != nil {
+	// 활동 중인 하트비트만 마지막 접속 시각으로 기록 (storeSession이 TTL도 함께 갱신)
+	session.LastSeenAt = time.Now()
+	if err := s.storeSession(ctx, session); err != nil {
 		return false, false, err
 	}
 	return true, false, nil
@@ -234,15 +268,23 @@ func (s *ValkeySessionStore) RotateSession(ctx context.Context, oldSessionID str
 	now := time.Now()
 	newSession := &Session{
 		ID:                newSessionID,
+		Username:          oldSession.Username,
 		CreatedAt:         oldSession.CreatedAt,
 		ExpiresAt:         now.Add(s.ttl),
 		AbsoluteExpiresAt: oldSession.AbsoluteExpiresAt,
 		LastRotatedAt:     now,
+		LastSeenAt:        now,
+		IP:                oldSession.IP,
+		UserAgent:         oldSession.UserAgent,
+		TwoFactorPending:  oldSession.TwoFactorPending,
 	}
 
 	if err := s.storeSession(ctx, newSession); err != nil {
 		return nil, err
 	}
+	// 유예 기간 동안 남는 이전 세션은 목록에서 바로 숨김
+	s.indexSession(ctx, newSession.Username, newSessionID)
+	s.unindexSession(ctx, oldSession.Username, oldSessionID)
 
 	gracePeriod := config.SessionConfig.GracePeriod
 	_ = s.expireSession(ctx, oldSessionID, gracePeriod)
@@ -254,6 +296,92 @@ func (s *ValkeySessionStore) RotateSession(ctx context.Context, oldSessionID str
 	return newSession, nil
 }
 
+// ListSessions: 활성 세션 전체를 마지막 접속 최신순으로 반환합니다.
+// 인덱스에 남은 만료 세션 ID는 이때 함께 정리합니다.
+func (s *ValkeySessionStore) ListSessions(ctx context.Context) ([]Session, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	usernames, err := s.client.Do(ctx, s.client.B().Smembers().Key(sessionUsersKey).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list session users: %w", err)
+	}
+
+	sessions := make([]Session, 0)
+	for _, username := range usernames {
+		indexKey := sessionUserIndexPrefix + username
+		ids, err := s.client.Do(ctx, s.client.B().Smembers().Key(indexKey).Build()).AsStrSlice()
+		if err != nil {
+			return nil, fmt.Errorf("list sessions of %s: %w", username, err)
+		}
+
+		stale := make([]string, 0)
+		for _, id := range ids {
+			session, err := s.GetSession(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if session == nil || time.Now().After(session.AbsoluteExpiresAt) {
+				stale = append(stale, id)
+				continue
+			}
+			sessions = append(sessions, *session)
+		}
+
+		if len(stale) > 0 {
+			_ = s.client.Do(ctx, s.client.B().Srem().Key(indexKey).Member(stale...).Build()).Error()
+		}
+		if len(stale) == len(ids) {
+			_ = s.client.Do(ctx, s.client.B().Srem().Key(sessionUsersKey).Member(username).Build()).Error()
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessionLastSeen(sessions[i]).After(sessionLastSeen(sessions[j]))
+	})
+	return sessions, nil
+}
+
+// indexSession: 사용자별 인덱스에 세션 ID를 추가합니다. 인덱스는 절대 만료 시간까지만 유지합니다.
+func (s *ValkeySessionStore) indexSession(ctx context.Context, username, sessionID string) {
+	indexCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+
+	indexKey := sessionUserIndexPrefix + sessionIndexName(username)
+	for _, resp := range s.client.DoMulti(indexCtx,
+		s.client.B().Sadd().Key(indexKey).Member(sessionID).Build(),
+		s.client.B().Expire().Key(indexKey).Seconds(int64(config.SessionConfig.AbsoluteTimeout.Seconds())).Build(),
+		s.client.B().Sadd().Key(sessionUsersKey).Member(sessionIndexName(username)).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			s.logger.Warn("Failed to index session", slog.String("session_id", truncateSessionID(sessionID)), slog.Any("error", err))
+			return
+		}
+	}
+}
+
+func (s *ValkeySessionStore) unindexSession(ctx context.Context, username, sessionID string) {
+	indexKey := sessionUserIndexPrefix + sessionIndexName(username)
+	if err := s.client.Do(ctx, s.client.B().Srem().Key(indexKey).Member(sessionID).Build()).Error(); err != nil {
+		s.logger.Warn("Failed to unindex session", slog.String("session_id", truncateSessionID(sessionID)), slog.Any("error", err))
+	}
+}
+
+// SessionHandle: 세션 목록/폐기 API에서 쓰는 세션 식별자 (세션 ID의 SHA-256 앞 16자)
+// 실제 세션 ID는 쿠키 값과 같으므로 API 응답에 노출하지 않는다.
+func SessionHandle(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// SessionIDPrefix: 로그와 같은 형식의 세션 ID 앞부분 (표시용)
+func SessionIDPrefix(sessionID string) string {
+	return truncateSessionID(sessionID)
+}
+
 // ===== Security Utilities =====
 
 // SignSessionID: HMAC 서명 추가
@@ -315,13 +443,18 @@ func SecurityHeadersMiddleware() gin.HandlerFunc {
 }
 
 // AuthMiddleware: 인증 미들웨어
-func AuthMiddleware(sessions SessionProvider, sessionSecret string, forceHTTPS bool) gin.HandlerFunc {
+// 세션의 사용자를 매 요청마다 계정 저장소에서 확인하므로 역할 변경/계정 비활성화가 즉시 반영됩니다.
+func AuthMiddleware(sessions SessionProvider, users UserStore, sessionSecret string, forceHTTPS bool) gin.HandlerFunc {
+	unauthorized := func(c *gin.Context) {
+		c.JSON(401, gin.H{"error": "Unauthorized"})
+		c.Abort()
+	}
+
 	return func(c *gin.Context) {
 		signedSessionID, err := c.Cookie(SessionCookieName)
 		if err != nil || signedSessionID == "" {
 			slog.Warn("auth_failed_no_cookie", slog.String("path", c.Request.URL.Path), slog.Any("err", err))
-			c.JSON(401, gin.H{"error": "Unauthorized"})
-			c.Abort()
+			unauthorized(c)
 			return
 		}
 
@@ -331,26 +464,71 @@ func AuthMiddleware(sessions SessionProvider, sessionSecret string, forceHTTPS b
 				slog.String("path", c.Request.URL.Path),
 				slog.String("session_prefix", truncateSessionID(signedSessionID)),
 			)
-			c.JSON(401, gin.H{"error": "Unauthorized"})
-			c.Abort()
+			unauthorized(c)
 			return
 		}
 
-		if !sessions.ValidateSession(c.Request.Context(), sessionID) {
+		ctx := c.Request.Context()
+		if !sessions.ValidateSession(ctx, sessionID) {
 			slog.Warn("auth_failed_session_invalid",
 				slog.String("path", c.Request.URL.Path),
 				slog.String("session_id", truncateSessionID(sessionID)),
 			)
 			ClearSecureCookie(c, SessionCookieName, forceHTTPS)
-			c.JSON(401, gin.H{"error": "Unauthorized"})
+			unauthorized(c)
+			return
+		}
+
+		session, err := sessions.GetSession(ctx, sessionID)
+		if err != nil || session == nil {
+			unauthorized(c)
+			return
+		}
+
+		// 사용자명이 없는 세션은 단일 관리자 시절 세션이므로 재로그인 유도
+		user, err := users.GetUser(ctx, session.Username)
+		if err != nil {
+			slog.Error("auth_user_lookup_failed", slog.String("username", session.Username), slog.Any("error", err))
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User store unavailable"})
+			c.Abort()
+			return
+		}
+		if user == nil || user.Disabled {
+			slog.Warn("auth_failed_user_inactive",
+				slog.String("path", c.Request.URL.Path),
+				slog.String("username", session.Username),
+			)
+			sessions.DeleteSession(ctx, sessionID)
+			ClearSecureCookie(c, SessionCookieName, forceHTTPS)
+			unauthorized(c)
+			return
+		}
+
+		if session.TwoFactorPending && !twoFactorSetupPath(c.FullPath()) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Two-factor setup required", "two_factor_setup_required": true})
 			c.Abort()
 			return
 		}
 
+		c.Set(ContextKeySessionID, sessionID)
+		c.Set(ContextKeyUsername, user.Username)
+		c.Set(ContextKeyRole, user.Role)
 		c.Next()
 	}
 }
 
+// twoFactorSetupPaths: 2단계 인증 등록 전 세션이 호출할 수 있는 경로 (라우트 패턴 접미사)
+var twoFactorSetupPaths = []string{"/auth/me", "/auth/2fa", "/auth/2fa/setup", "/auth/2fa/enable"}
+
+func twoFactorSetupPath(fullPath string) bool {
+	for _, suffix := range twoFactorSetupPaths {
+		if strings.HasSuffix(fullPath, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
 // ===== Rate Limiter =====
 
 // LoginRateLimiter: 로그인 시도 횟수 제한
@@ -467,6 +645,27 @@ func truncateSessionID(sessionID string) string {
 	return sessionID[:8] + "..."
 }
 
+// sessionIndexName: 사용자명은 대소문자를 구분하지 않음 (계정 저장소와 동일)
+func sessionIndexName(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+func sessionLastSeen(session Session) time.Time {
+	if session.LastSeenAt.IsZero() {
+		return session.CreatedAt
+	}
+	return session.LastSeenAt
+}
+
+// truncateUserAgent: 비정상적으로 긴 User-Agent가 세션 값 크기를 키우지 않도록 자름
+func truncateUserAgent(userAgent string) string {
+	const maxLen = 256
+	if len(userAgent) <= maxLen {
+		return userAgent
+	}
+	return userAgent[:maxLen]
+}
+
 func isValkeyNil(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "nil")
 }
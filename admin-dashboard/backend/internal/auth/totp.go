@@ -0,0 +1,342 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 기본 알고리즘 (인증 앱 호환)
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	twoFactorKeyPrefix        = "admin:2fa:"          // STRING(JSON): 활성화된 TOTP 설정
+	twoFactorPendingKeyPrefix = "admin:2fa:pending:"  // STRING: 확인 전 비밀키 (TTL TwoFactorSetupTTL)
+	recoveryCodesKeyPrefix    = "admin:2fa:recovery:" // SET: 복구 코드 SHA-256 해시
+	totpUsedKeyPrefix         = "admin:2fa:used:"     // STRING: 이미 쓴 TOTP 코드 (시간 구간별, 재사용 방지)
+
+	// TOTPIssuer: 인증 앱에 표시되는 발급자 이름
+	TOTPIssuer = "LLM Bot Admin"
+
+	totpPeriod        = 30 * time.Second
+	totpDigits        = 6
+	totpSkew          = 1 // 앞뒤로 허용하는 시간 구간 수 (시계 오차)
+	recoveryCodeCount = 10
+
+	// TwoFactorSetupTTL: 등록 시작 후 코드 확인까지 허용하는 시간
+	TwoFactorSetupTTL = 10 * time.Minute
+)
+
+var (
+	// ErrTwoFactorNotPending: 등록 확인 전에 발급한 비밀키가 없거나 만료됨
+	ErrTwoFactorNotPending = errors.New("two-factor setup not started or expired")
+	// ErrInvalidOTP: TOTP 코드/복구 코드 불일치
+	ErrInvalidOTP = errors.New("invalid one-time code")
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorSetup: 등록 시작 시 발급하는 비밀키와 QR 코드 내용 (otpauth URI)
+type TwoFactorSetup struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauthUrl"`
+}
+
+// TwoFactorStatus: 계정의 2단계 인증 상태
+type TwoFactorStatus struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabledAt,omitempty"`
+	RecoveryCodesRemaining int        `json:"recoveryCodesRemaining"`
+}
+
+type twoFactorRecord struct {
+	Secret    string    `json:"secret"`
+	EnabledAt time.Time `json:"enabledAt"`
+}
+
+// TwoFactorStore: TOTP 2단계 인증 저장소 인터페이스
+type TwoFactorStore interface {
+	Status(ctx context.Context, username string) (TwoFactorStatus, error)
+	BeginSetup(ctx context.Context, username string) (*TwoFactorSetup, error)
+	Enable(ctx context.Context, username, code string) ([]string, error)
+	Verify(ctx context.Context, username, code string) (usedRecoveryCode bool, err error)
+	Disable(ctx context.Context, username string) error
+}
+
+// ValkeyTwoFactorStore: Valkey 기반 TOTP 저장소
+// 복구 코드는 SHA-256 해시로만 저장하며, 사용하면 SREM으로 지워 한 번만 쓸 수 있다.
+type ValkeyTwoFactorStore struct {
+	client valkey.Client
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewValkeyTwoFactorStore: Valkey TOTP 저장소 생성
+func NewValkeyTwoFactorStore(client valkey.Client, logger *slog.Logger) *ValkeyTwoFactorStore {
+	return &ValkeyTwoFactorStore{
+		client: client,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Status: 2단계 인증 활성화 여부와 남은 복구 코드 수
+func (s *ValkeyTwoFactorStore) Status(ctx context.Context, username string) (TwoFactorStatus, error) {
+	record, err := s.getRecord(ctx, username)
+	if err != nil || record == nil {
+		return TwoFactorStatus{}, err
+	}
+
+	remaining, err := s.client.Do(ctx, s.client.B().Scard().Key(recoveryCodesKeyPrefix+userField(username)).Build()).AsInt64()
+	if err != nil {
+		return TwoFactorStatus{}, fmt.Errorf("count recovery codes: %w", err)
+	}
+	enabledAt := record.EnabledAt
+	return TwoFactorStatus{Enabled: true, EnabledAt: &enabledAt, RecoveryCodesRemaining: int(remaining)}, nil
+}
+
+// BeginSetup: 새 비밀키를 발급합니다. Enable로 코드를 확인하기 전까지는 로그인에 적용되지 않는다.
+func (s *ValkeyTwoFactorStore) BeginSetup(ctx context.Context, username string) (*TwoFactorSetup, error) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	key := twoFactorPendingKeyPrefix + userField(username)
+	if err := s.client.Do(ctx, s.client.B().Set().Key(key).Value(secret).Ex(TwoFactorSetupTTL).Build()).Error(); err != nil {
+		return nil, fmt.Errorf("store pending secret: %w", err)
+	}
+	return &TwoFactorSetup{Secret: secret, OTPAuthURL: TOTPAuthURL(TOTPIssuer, username, secret)}, nil
+}
+
+// Enable: 발급한 비밀키로 만든 코드를 확인하고 2단계 인증을 켭니다.
+// 새 복구 코드를 평문으로 한 번만 반환하며, 기존 복구 코드는 모두 폐기된다.
+func (s *ValkeyTwoFactorStore) Enable(ctx context.Context, username, code string) ([]string, error) {
+	name := userField(username)
+	pendingKey := twoFactorPendingKeyPrefix + name
+	secret, err := s.client.Do(ctx, s.client.B().Get().Key(pendingKey).Build()).ToString()
+	if isValkeyNil(err) {
+		return nil, ErrTwoFactorNotPending
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get pending secret: %w", err)
+	}
+	if !s.consumeTOTP(ctx, name, secret, code) {
+		return nil, ErrInvalidOTP
+	}
+
+	codes, hashes, err := generateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(twoFactorRecord{Secret: secret, EnabledAt: s.now().UTC()})
+	if err != nil {
+		return nil, fmt.Errorf("marshal two-factor record: %w", err)
+	}
+
+	recoveryKey := recoveryCodesKeyPrefix + name
+	for _, resp := range s.client.DoMulti(ctx,
+		s.client.B().Set().Key(twoFactorKeyPrefix+name).Value(string(data)).Build(),
+		s.client.B().Del().Key(recoveryKey).Build(),
+		s.client.B().Sadd().Key(recoveryKey).Member(hashes...).Build(),
+		s.client.B().Del().Key(pendingKey).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return nil, fmt.Errorf("enable two-factor: %w", err)
+		}
+	}
+	return codes, nil
+}
+
+// Verify: 로그인 시 TOTP 코드 또는 복구 코드를 확인합니다. 복구 코드로 통과하면 usedRecoveryCode가 true.
+// 2단계 인증이 꺼진 계정이면 항상 ErrInvalidOTP.
+func (s *ValkeyTwoFactorStore) Verify(ctx context.Context, username, code string) (bool, error) {
+	name := userField(username)
+	record, err := s.getRecord(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if record == nil {
+		return false, ErrInvalidOTP
+	}
+	if s.consumeTOTP(ctx, name, record.Secret, code) {
+		return false, nil
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, ErrInvalidOTP
+	}
+	removed, err := s.client.Do(ctx, s.client.B().Srem().Key(recoveryCodesKeyPrefix+name).Member(hashRecoveryCode(normalized)).Build()).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("consume recovery code: %w", err)
+	}
+	if removed == 0 {
+		return false, ErrInvalidOTP
+	}
+	s.logger.Warn("two_factor_recovery_code_used", slog.String("username", username))
+	return true, nil
+}
+
+// Disable: 2단계 인증과 복구 코드를 삭제합니다 (본인 해제 또는 admin 초기화).
+func (s *ValkeyTwoFactorStore) Disable(ctx context.Context, username string) error {
+	name := userField(username)
+	for _, resp := range s.client.DoMulti(ctx,
+		s.client.B().Del().Key(twoFactorKeyPrefix+name).Build(),
+		s.client.B().Del().Key(recoveryCodesKeyPrefix+name).Build(),
+		s.client.B().Del().Key(twoFactorPendingKeyPrefix+name).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("disable two-factor: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *ValkeyTwoFactorStore) getRecord(ctx context.Context, username string) (*twoFactorRecord, error) {
+	data, err := s.client.Do(ctx, s.client.B().Get().Key(twoFactorKeyPrefix+userField(username)).Build()).ToString()
+	if isValkeyNil(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get two-factor record: %w", err)
+	}
+	var record twoFactorRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return nil, fmt.Errorf("unmarshal two-factor record: %w", err)
+	}
+	return &record, nil
+}
+
+// consumeTOTP: 코드가 맞고 같은 시간 구간에서 아직 쓰이지 않았으면 사용 처리하고 true를 반환합니다.
+func (s *ValkeyTwoFactorStore) consumeTOTP(ctx context.Context, username, secret, code string) bool {
+	step, ok := MatchTOTP(secret, code, s.now())
+	if !ok {
+		return false
+	}
+	// 코드까지 키에 넣어 재등록 직후 새 비밀키의 같은 구간 코드는 막지 않음
+	key := totpUsedKeyPrefix + username + ":" + strconv.FormatInt(step, 10) + ":" + strings.TrimSpace(strings.ReplaceAll(code, " ", ""))
+	ttl := totpPeriod * time.Duration(2*totpSkew+1)
+	fresh, err := s.client.Do(ctx, s.client.B().Set().Key(key).Value("1").Nx().Ex(ttl).Build()).AsBool()
+	if isValkeyNil(err) {
+		return false
+	}
+	if err != nil {
+		s.logger.Warn("two_factor_replay_check_failed", slog.String("username", username), slog.Any("error", err))
+		return false
+	}
+	return fresh
+}
+
+// ===== TOTP (RFC 6238, HMAC-SHA1, 6자리, 30초) =====
+
+// GenerateTOTPSecret: 160비트 무작위 비밀키 (Base32, 패딩 없음)
+func GenerateTOTPSecret() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate totp secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(buf), nil
+}
+
+// TOTPAuthURL: 인증 앱 QR 코드용 otpauth:// URI
+func TOTPAuthURL(issuer, account, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", strconv.Itoa(totpDigits))
+	params.Set("period", strconv.Itoa(int(totpPeriod.Seconds())))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// TOTPCode: now가 속한 시간 구간의 코드
+func TOTPCode(secret string, now time.Time) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("decode totp secret: %w", err)
+	}
+	return hotp(key, now.Unix()/int64(totpPeriod.Seconds())), nil
+}
+
+// MatchTOTP: 코드가 now 기준 ±totpSkew 구간 중 하나와 맞으면 그 구간 번호를 반환합니다.
+func MatchTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for offset := int64(-totpSkew); offset <= totpSkew; offset++ {
+		step := current + offset
+		if subtle.ConstantTimeCompare([]byte(hotp(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1_000_000)
+}
+
+// ===== 복구 코드 =====
+
+// generateRecoveryCodes: "xxxxx-xxxxx" 형식 복구 코드와 저장용 해시를 생성합니다.
+func generateRecoveryCodes(n int) ([]string, []string, error) {
+	codes := make([]string, 0, n)
+	hashes := make([]string, 0, n)
+	for range n {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		raw := hex.EncodeToString(buf)
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		hashes = append(hashes, hashRecoveryCode(raw))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode: 하이픈/공백/대소문자를 무시합니다. 형식이 다르면 빈 문자열.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != 10 {
+		return ""
+	}
+	if _, err := hex.DecodeString(code); err != nil {
+		return ""
+	}
+	return code
+}
+
+func hashRecoveryCode(normalized string) string {
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
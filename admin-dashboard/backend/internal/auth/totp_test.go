@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	// RFC 6238 부록 B의 SHA1 비밀키 "12345678901234567890" (8자리 값의 끝 6자리)
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range cases {
+		got, err := TOTPCode(secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatalf("TOTPCode: %v", err)
+		}
+		if got != want {
+			t.Errorf("T=%d: got %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestMatchTOTP_AllowsOneStepSkew(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("GenerateTOTPSecret: %v", err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	code, _ := TOTPCode(secret, now)
+
+	if step, ok := MatchTOTP(secret, code, now.Add(totpPeriod)); !ok || step != now.Unix()/30 {
+		t.Fatalf("expected previous step accepted, got step=%d ok=%v", step, ok)
+	}
+	if _, ok := MatchTOTP(secret, code, now.Add(2*totpPeriod)); ok {
+		t.Fatal("code two steps old must be rejected")
+	}
+	if _, ok := MatchTOTP(secret, code[:3]+" "+code[3:], now); !ok {
+		t.Fatal("spaces in code should be ignored")
+	}
+	if _, ok := MatchTOTP(secret, "12345", now); ok {
+		t.Fatal("short code must be rejected")
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes(3)
+	if err != nil {
+		t.Fatalf("generateRecoveryCodes: %v", err)
+	}
+	if len(codes) != 3 || len(hashes) != 3 {
+		t.Fatalf("unexpected counts: %d %d", len(codes), len(hashes))
+	}
+	for i, code := range codes {
+		if strings.Contains(hashes[i], strings.ReplaceAll(code, "-", "")) {
+			t.Fatal("hash must not contain the plain code")
+		}
+		if got := hashRecoveryCode(normalizeRecoveryCode(strings.ToUpper(code))); got != hashes[i] {
+			t.Fatalf("normalized code %q does not match stored hash", code)
+		}
+	}
+	if normalizeRecoveryCode("123456") != "" || normalizeRecoveryCode("zzzzz-zzzzz") != "" {
+		t.Fatal("TOTP-like or non-hex codes must not be treated as recovery codes")
+	}
+}
+
+func TestOTPAuthURL(t *testing.T) {
+	got := TOTPAuthURL(TOTPIssuer, "alice", "JBSWY3DPEHPK3PXP")
+	if !strings.HasPrefix(got, "otpauth://totp/LLM%20Bot%20Admin:alice?") || !strings.Contains(got, "secret=JBSWY3DPEHPK3PXP") {
+		t.Fatalf("unexpected otpauth url: %s", got)
+	}
+}
@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	usersKey = "admin:users"
+
+	// ContextKeyUsername: AuthMiddleware가 인증된 사용자명을 저장하는 gin 컨텍스트 키
+	ContextKeyUsername = "admin_username"
+	// ContextKeyRole: AuthMiddleware가 사용자 역할을 저장하는 gin 컨텍스트 키
+	ContextKeyRole = "admin_role"
+)
+
+// Role: 관리자 계정 역할 (viewer < operator < admin)
+type Role string
+
+const (
+	// RoleViewer: 조회 전용
+	RoleViewer Role = "viewer"
+	// RoleOperator: 컨테이너 제어, 봇 관리 API 변경 요청 가능
+	RoleOperator Role = "operator"
+	// RoleAdmin: 계정 관리, 감사 로그 조회 포함 전체 권한
+	RoleAdmin Role = "admin"
+)
+
+var roleRank = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// ParseRole: 문자열을 역할로 변환합니다.
+func ParseRole(value string) (Role, bool) {
+	role := Role(strings.ToLower(strings.TrimSpace(value)))
+	_, ok := roleRank[role]
+	return role, ok
+}
+
+// Allows: 현재 역할이 required 이상의 권한인지 확인합니다.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRank[r]
+	return ok && rank >= roleRank[required]
+}
+
+var (
+	// ErrUserExists: 이미 존재하는 사용자명
+	ErrUserExists = errors.New("user already exists")
+	// ErrUserNotFound: 존재하지 않는 사용자
+	ErrUserNotFound = errors.New("user not found")
+	// ErrNoBootstrapAdmin: 사용자 저장소가 비어 있고 초기 관리자 설정도 없음
+	ErrNoBootstrapAdmin = errors.New("no admin users and no bootstrap admin configured")
+)
+
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,32}$`)
+
+// ValidUsername: 사용자명 형식 검증 (영문/숫자/_.- 3~32자)
+func ValidUsername(username string) bool {
+	return usernamePattern.MatchString(username)
+}
+
+// User: 관리자 계정
+type User struct {
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"passwordHash"`
+	Role         Role      `json:"role"`
+	Disabled     bool      `json:"disabled,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// UserStore: 관리자 계정 저장소 인터페이스
+type UserStore interface {
+	GetUser(ctx context.Context, username string) (*User, error)
+	ListUsers(ctx context.Context) ([]User, error)
+	CreateUser(ctx context.Context, user User) error
+	UpdateUser(ctx context.Context, user User) error
+	DeleteUser(ctx context.Context, username string) error
+}
+
+// ValkeyUserStore: Valkey 해시(admin:users) 기반 계정 저장소
+type ValkeyUserStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyUserStore: Valkey 계정 저장소 생성
+func NewValkeyUserStore(client valkey.Client, logger *slog.Logger) *ValkeyUserStore {
+	return &ValkeyUserStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// userField: 사용자명은 대소문자를 구분하지 않음
+func userField(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// GetUser: 계정 조회 (없으면 nil)
+func (s *ValkeyUserStore) GetUser(ctx context.Context, username string) (*User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp := s.client.Do(ctx, s.client.B().Hget().Key(usersKey).Field(userField(username)).Build())
+	if isValkeyNil(resp.Error()) {
+		return nil, nil
+	}
+	data, err := resp.ToString()
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	var user User
+	if err := json.Unmarshal([]byte(data), &user); err != nil {
+		return nil, fmt.Errorf("unmarshal user: %w", err)
+	}
+	return &user, nil
+}
+
+// ListUsers: 전체 계정을 사용자명 순으로 반환
+func (s *ValkeyUserStore) ListUsers(ctx context.Context) ([]User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	values, err := s.client.Do(ctx, s.client.B().Hvals().Key(usersKey).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+
+	users := make([]User, 0, len(values))
+	for _, value := range values {
+		var user User
+		if err := json.Unmarshal([]byte(value), &user); err != nil {
+			s.logger.Warn("admin_user_decode_failed", slog.Any("error", err))
+			continue
+		}
+		users = append(users, user)
+	}
+	slices.SortFunc(users, func(a, b User) int {
+		return strings.Compare(userField(a.Username), userField(b.Username))
+	})
+	return users, nil
+}
+
+// CreateUser: 계정 생성 (HSETNX, 중복 시 ErrUserExists)
+func (s *ValkeyUserStore) CreateUser(ctx context.Context, user User) error {
+	data, err := json.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("marshal user: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+
+	created, err := s.client.Do(ctx, s.client.B().Hsetnx().Key(usersKey).Field(userField(user.Username)).Value(string(data)).Build()).AsBool()
+	if err != nil {
+		return fmt.Errorf("create user: %w", err)
+	}
+	if !created {
+		return ErrUserExists
+	}
+	return nil
+}
+
+// UpdateUser: 기존 계정 갱신 (없으면 ErrUserNotFound)
+func (s *ValkeyUserStore) UpdateUser(ctx context.Context, user User) error {
+	existing, err := s.GetUser(ctx, user.Username)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrUserNotFound
+	}
+
+	data, err := json.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("marshal user: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+
+	if err := s.client.Do(ctx, s.client.B().Hset().Key(usersKey).FieldValue().FieldValue(userField(user.Username), string(data)).Build()).Error(); err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	return nil
+}
+
+// DeleteUser: 계정 삭제 (없으면 ErrUserNotFound)
+func (s *ValkeyUserStore) DeleteUser(ctx context.Context, username string) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+
+	removed, err := s.client.Do(ctx, s.client.B().Hdel().Key(usersKey).Field(userField(username)).Build()).AsInt64()
+	if err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+	if removed == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// EnsureBootstrapAdmin: 계정이 하나도 없으면 ADMIN_USER/ADMIN_PASS_HASH로 초기 관리자를 생성합니다.
+// 기존 단일 관리자 설정에서 계정 저장소로 이전하기 위한 용도이며, 계정이 있으면 아무것도 하지 않습니다.
+func EnsureBootstrapAdmin(ctx context.Context, users UserStore, username, passHash string, logger *slog.Logger) error {
+	existing, err := users.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return nil
+	}
+
+	username = strings.TrimSpace(username)
+	passHash = strings.TrimSpace(passHash)
+	if username == "" || passHash == "" {
+		return ErrNoBootstrapAdmin
+	}
+
+	now := time.Now()
+	err = users.CreateUser(ctx, User{
+		Username:     username,
+		PasswordHash: passHash,
+		Role:         RoleAdmin,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil && !errors.Is(err, ErrUserExists) {
+		return err
+	}
+	logger.Info("admin_bootstrap_user_created", slog.String("username", username))
+	return nil
+}
+
+// ===== Role Middleware =====
+
+// RoleFromContext: AuthMiddleware가 저장한 역할 반환 (없으면 빈 값)
+func RoleFromContext(c *gin.Context) Role {
+	role, _ := c.Get(ContextKeyRole)
+	r, _ := role.(Role)
+	return r
+}
+
+// RequireRole: 최소 역할 미달 시 403 응답
+func RequireRole(required Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !RoleFromContext(c).Allows(required) {
+			slog.Warn("auth_forbidden",
+				slog.String("path", c.Request.URL.Path),
+				slog.String("username", c.GetString(ContextKeyUsername)),
+				slog.String("required_role", string(required)),
+			)
+			c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden", "required_role": required})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireRoleForWrites: 조회(GET/HEAD/OPTIONS)는 통과시키고 변경 요청에만 역할을 요구
+// 봇 관리 API 프록시처럼 메서드별로 권한이 달라지는 라우트에 사용합니다.
+func RequireRoleForWrites(required Role) gin.HandlerFunc {
+	requireRole := RequireRole(required)
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+		default:
+			requireRole(c)
+		}
+	}
+}
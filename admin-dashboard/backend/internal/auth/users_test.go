@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Role
+		ok    bool
+	}{
+		{"viewer", RoleViewer, true},
+		{" Operator ", RoleOperator, true},
+		{"ADMIN", RoleAdmin, true},
+		{"root", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseRole(tt.input)
+		if ok != tt.ok {
+			t.Errorf("ParseRole(%q) ok = %v, want %v", tt.input, ok, tt.ok)
+			continue
+		}
+		if ok && got != tt.want {
+			t.Errorf("ParseRole(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	if !RoleAdmin.Allows(RoleOperator) || !RoleOperator.Allows(RoleOperator) {
+		t.Error("higher or equal role should be allowed")
+	}
+	if RoleViewer.Allows(RoleOperator) || RoleOperator.Allows(RoleAdmin) {
+		t.Error("lower role should not be allowed")
+	}
+	if Role("unknown").Allows(RoleViewer) {
+		t.Error("unknown role should not be allowed")
+	}
+}
+
+func newRoleTestRouter(role Role, guard gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if role != "" {
+			c.Set(ContextKeyRole, role)
+		}
+		c.Next()
+	})
+	r.Any("/target", guard, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name string
+		role Role
+		want int
+	}{
+		{"admin allowed", RoleAdmin, http.StatusOK},
+		{"operator allowed", RoleOperator, http.StatusOK},
+		{"viewer forbidden", RoleViewer, http.StatusForbidden},
+		{"missing role forbidden", "", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRoleTestRouter(tt.role, RequireRole(RoleOperator))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/target", nil))
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequireRoleForWrites(t *testing.T) {
+	r := newRoleTestRouter(RoleViewer, RequireRoleForWrites(RoleOperator))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/target", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("GET status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/target", nil))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("DELETE status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,107 @@
+// Package botconfig: 봇 런타임 설정(알람 주기, 게임 제한, LLM 판정 임계값 등)의 중앙 저장과 봇 푸시
+// 설정 목록과 범위는 각 봇의 런타임 설정 API가 알려주고, 대시보드는 운영자가 바꾼 값(오버라이드)만 보관합니다.
+package botconfig
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+)
+
+var (
+	// ErrUnknownService: 설정 관리 대상이 아닌 서비스
+	ErrUnknownService = errors.New("unknown service")
+	// ErrInvalid: 봇이 거부한 설정 값 (알 수 없는 키, 범위 밖 값)
+	ErrInvalid = errors.New("invalid bot config")
+	// ErrUnavailable: 봇에 연결할 수 없거나 런타임 설정 API가 없음
+	ErrUnavailable = errors.New("bot config unavailable")
+)
+
+// Setting: 봇이 보고한 설정 정의와 현재 적용 값 (Default는 봇 환경 변수 값)
+type Setting struct {
+	Key         string   `json:"key"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Default     string   `json:"default"`
+	Value       string   `json:"value"`
+	Overridden  bool     `json:"overridden"`
+}
+
+// EffectiveSetting: 봇 적용 값과 대시보드가 원하는 값 비교
+type EffectiveSetting struct {
+	Setting
+	// Desired: 중앙 저장소 기준으로 적용되어야 할 값 (오버라이드가 없으면 Default)
+	Desired string `json:"desired"`
+	InSync  bool   `json:"inSync"`
+}
+
+// Effective: 서비스의 유효 설정 (봇에 연결할 수 없으면 Settings 없이 Error만 채움)
+type Effective struct {
+	Service   string             `json:"service"`
+	Reachable bool               `json:"reachable"`
+	Error     string             `json:"error,omitempty"`
+	Overrides map[string]string  `json:"overrides"`
+	Settings  []EffectiveSetting `json:"settings"`
+	AppliedAt *time.Time         `json:"appliedAt,omitempty"` // 봇이 마지막으로 설정을 적용한 시각
+}
+
+// FieldChange: 키 하나의 오버라이드 변경 (빈 값은 오버라이드 없음 = 봇 기본값)
+type FieldChange struct {
+	Key    string `json:"key"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// Change: 변경 이력 한 건
+type Change struct {
+	ID      string        `json:"id"`
+	Service string        `json:"service"`
+	Actor   string        `json:"actor,omitempty"`
+	Note    string        `json:"note,omitempty"`
+	At      time.Time     `json:"at"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// buildEffective: 봇 보고 값과 오버라이드를 합쳐 키별 동기화 여부를 계산
+func buildEffective(service string, overrides map[string]string, settings []Setting) Effective {
+	effective := Effective{
+		Service:   service,
+		Reachable: true,
+		Overrides: overrides,
+		Settings:  make([]EffectiveSetting, 0, len(settings)),
+	}
+	for _, setting := range settings {
+		desired, ok := overrides[setting.Key]
+		if !ok {
+			desired = setting.Default
+		}
+		effective.Settings = append(effective.Settings, EffectiveSetting{
+			Setting: setting,
+			Desired: desired,
+			InSync:  setting.Value == desired,
+		})
+	}
+	return effective
+}
+
+// diffOverrides: 두 오버라이드 집합의 키별 변경 (키 이름순)
+func diffOverrides(before, after map[string]string) []FieldChange {
+	keys := make(map[string]struct{}, len(before)+len(after))
+	for key := range before {
+		keys[key] = struct{}{}
+	}
+	for key := range after {
+		keys[key] = struct{}{}
+	}
+
+	changes := make([]FieldChange, 0, len(keys))
+	for _, key := range slices.Sorted(maps.Keys(keys)) {
+		if before[key] != after[key] {
+			changes = append(changes, FieldChange{Key: key, Before: before[key], After: after[key]})
+		}
+	}
+	return changes
+}
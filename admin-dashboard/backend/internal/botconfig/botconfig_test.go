@@ -0,0 +1,217 @@
+package botconfig
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+type memoryStore struct {
+	mu        sync.Mutex
+	overrides map[string]map[string]string
+	changes   []Change
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{overrides: map[string]map[string]string{}}
+}
+
+func (m *memoryStore) GetOverrides(_ context.Context, service string) (map[string]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := map[string]string{}
+	for k, v := range m.overrides[service] {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (m *memoryStore) SaveOverrides(_ context.Context, service string, overrides map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.overrides[service] = overrides
+	return nil
+}
+
+func (m *memoryStore) AppendChange(_ context.Context, change Change) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changes = append([]Change{change}, m.changes...)
+	return nil
+}
+
+func (m *memoryStore) ListChanges(_ context.Context, service string, limit int) ([]Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Change
+	for _, change := range m.changes {
+		if change.Service == service && len(out) < limit {
+			out = append(out, change)
+		}
+	}
+	return out, nil
+}
+
+// fakeBot: 게임 봇 런타임 설정 API를 흉내 냄 (정수 설정 하나, 5~600)
+type fakeBot struct {
+	mu     sync.Mutex
+	value  int
+	over   bool
+	pushes int
+}
+
+func (b *fakeBot) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/runtime-config", func(w http.ResponseWriter, _ *http.Request) {
+		b.write(w)
+	})
+	mux.HandleFunc("PUT /admin/runtime-config", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Values map[string]string `json:"values"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		b.mu.Lock()
+		b.pushes++
+		raw, ok := req.Values["guess.cooldownSeconds"]
+		value := 30
+		if ok {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 5 || n > 600 {
+				b.mu.Unlock()
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"INVALID_REQUEST","message":"guess.cooldownSeconds out of range"}`))
+				return
+			}
+			value = n
+		}
+		b.value, b.over = value, ok
+		b.mu.Unlock()
+		b.write(w)
+	})
+	return mux
+}
+
+func (b *fakeBot) write(w http.ResponseWriter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	minV, maxV := 5.0, 600.0
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"status": "ok",
+		"settings": []Setting{{
+			Key: "guess.cooldownSeconds", Type: "int", Min: &minV, Max: &maxV,
+			Default: "30", Value: strconv.Itoa(b.value), Overridden: b.over,
+		}},
+	})
+}
+
+func newTestService(t *testing.T, bot *fakeBot) (*Service, *memoryStore) {
+	t.Helper()
+	server := httptest.NewServer(bot.handler())
+	t.Cleanup(server.Close)
+
+	store := newMemoryStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewService(store, map[string]string{"twentyq": server.URL + "/", "turtle": ""}, logger), store
+}
+
+func ptr(s string) *string { return &s }
+
+func TestServiceUpdate(t *testing.T) {
+	bot := &fakeBot{value: 30}
+	svc, store := newTestService(t, bot)
+	ctx := context.Background()
+
+	if got := svc.Services(); len(got) != 1 || got[0] != "twentyq" {
+		t.Fatalf("services without URL must be skipped: %v", got)
+	}
+
+	change, effective, err := svc.Update(ctx, "twentyq", map[string]*string{"guess.cooldownSeconds": ptr(" 60 ")}, "admin", "busy room")
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(change.Changes) != 1 || change.Changes[0].After != "60" || change.Actor != "admin" {
+		t.Fatalf("unexpected change: %+v", change)
+	}
+	if !effective.Reachable || len(effective.Settings) != 1 || !effective.Settings[0].InSync || effective.Settings[0].Value != "60" {
+		t.Fatalf("unexpected effective config: %+v", effective)
+	}
+
+	// 봇이 거부한 값은 저장하지 않음
+	if _, _, err := svc.Update(ctx, "twentyq", map[string]*string{"guess.cooldownSeconds": ptr("1")}, "admin", ""); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+	if overrides, _ := store.GetOverrides(ctx, "twentyq"); overrides["guess.cooldownSeconds"] != "60" {
+		t.Fatalf("rejected value must not be stored: %v", overrides)
+	}
+
+	// nil 값은 오버라이드 제거 → 봇 기본값
+	if _, effective, err = svc.Update(ctx, "twentyq", map[string]*string{"guess.cooldownSeconds": nil}, "admin", ""); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if len(effective.Overrides) != 0 || effective.Settings[0].Value != "30" {
+		t.Fatalf("reset must restore the default: %+v", effective)
+	}
+
+	history, err := svc.History(ctx, "twentyq", 10)
+	if err != nil || len(history) != 2 || history[0].Changes[0].Before != "60" {
+		t.Fatalf("unexpected history: %+v, %v", history, err)
+	}
+
+	if _, _, err := svc.Update(ctx, "holo", map[string]*string{"x": ptr("1")}, "admin", ""); !errors.Is(err, ErrUnknownService) {
+		t.Fatalf("expected ErrUnknownService, got %v", err)
+	}
+}
+
+func TestServiceSync_RepushesAfterRestart(t *testing.T) {
+	bot := &fakeBot{value: 30}
+	svc, store := newTestService(t, bot)
+	ctx := context.Background()
+
+	_ = store.SaveOverrides(ctx, "twentyq", map[string]string{"guess.cooldownSeconds": "90", "removed.key": "1"})
+
+	effective, err := svc.Effective(ctx, "twentyq")
+	if err != nil {
+		t.Fatalf("Effective: %v", err)
+	}
+	if effective.Settings[0].InSync || effective.Settings[0].Desired != "90" {
+		t.Fatalf("restarted bot must be reported out of sync: %+v", effective.Settings[0])
+	}
+
+	svc.Sync(ctx)
+	if bot.value != 90 || !bot.over {
+		t.Fatalf("sync must re-push stored overrides, bot value=%d", bot.value)
+	}
+
+	pushes := bot.pushes
+	svc.Sync(ctx)
+	if bot.pushes != pushes {
+		t.Fatalf("in-sync bot must not be pushed again")
+	}
+}
+
+func TestServiceEffective_Unreachable(t *testing.T) {
+	store := newMemoryStore()
+	_ = store.SaveOverrides(context.Background(), "turtle", map[string]string{"a": "1"})
+	svc := NewService(store, map[string]string{"turtle": "http://127.0.0.1:1"}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	effective, err := svc.Effective(context.Background(), "turtle")
+	if err != nil {
+		t.Fatalf("Effective: %v", err)
+	}
+	if effective.Reachable || effective.Error == "" || effective.Overrides["a"] != "1" {
+		t.Fatalf("unreachable bot must still report overrides: %+v", effective)
+	}
+
+	if _, _, err := svc.Update(context.Background(), "turtle", map[string]*string{"a": ptr("2")}, "admin", ""); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+}
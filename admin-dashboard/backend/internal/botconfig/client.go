@@ -0,0 +1,106 @@
+package botconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+const (
+	// defaultRuntimePath: 게임 봇 런타임 설정 API 경로
+	defaultRuntimePath = "/admin/runtime-config"
+	// maxResponseBytes: 봇 응답 본문 상한
+	maxResponseBytes = 1 << 20
+	requestTimeout   = 5 * time.Second
+)
+
+// runtimePaths: 기본 경로와 다른 봇의 런타임 설정 API 경로 (holo는 /api/holo 아래에 관리 API를 둠)
+var runtimePaths = map[string]string{
+	"holo": "/api/holo/runtime-config",
+}
+
+// botSnapshot: 봇 런타임 설정 API 응답
+type botSnapshot struct {
+	Settings  []Setting  `json:"settings"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// botError: 봇 에러 응답 (게임 봇은 error=코드/message=설명, holo는 error=설명)
+type botError struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+func runtimeURL(service, baseURL string) string {
+	path, ok := runtimePaths[service]
+	if !ok {
+		path = defaultRuntimePath
+	}
+	return baseURL + path
+}
+
+// fetch: 봇의 설정 정의와 현재 값 조회
+func (s *Service) fetch(ctx context.Context, service string) (botSnapshot, error) {
+	return s.call(ctx, service, http.MethodGet, nil)
+}
+
+// push: 오버라이드 전체를 봇에 적용 (빠진 키는 봇이 기본값으로 되돌림)
+func (s *Service) push(ctx context.Context, service string, overrides map[string]string) (botSnapshot, error) {
+	body, err := json.Marshal(map[string]any{"values": overrides})
+	if err != nil {
+		return botSnapshot{}, fmt.Errorf("marshal bot config: %w", err)
+	}
+	return s.call(ctx, service, http.MethodPut, body)
+}
+
+func (s *Service) call(ctx context.Context, service, method string, body []byte) (botSnapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, runtimeURL(service, s.targets[service]), bytes.NewReader(body))
+	if err != nil {
+		return botSnapshot{}, fmt.Errorf("create bot config request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return botSnapshot{}, fmt.Errorf("%w: %s: %v", ErrUnavailable, service, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return botSnapshot{}, fmt.Errorf("%w: %s: read body: %v", ErrUnavailable, service, err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusBadRequest:
+		var botErr botError
+		_ = json.Unmarshal(data, &botErr)
+		message := strings.TrimSpace(botErr.Message)
+		if message == "" {
+			message = strings.TrimSpace(botErr.Error)
+		}
+		return botSnapshot{}, fmt.Errorf("%w: %s", ErrInvalid, message)
+	case resp.StatusCode == http.StatusNotFound:
+		return botSnapshot{}, fmt.Errorf("%w: %s does not expose runtime config", ErrUnavailable, service)
+	default:
+		return botSnapshot{}, fmt.Errorf("%w: %s: status %d", ErrUnavailable, service, resp.StatusCode)
+	}
+
+	var snapshot botSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return botSnapshot{}, fmt.Errorf("%w: %s: decode response: %v", ErrUnavailable, service, err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,225 @@
+package botconfig
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// DefaultSyncInterval: 봇 재시작 등으로 어긋난 설정을 다시 푸시하는 점검 주기 기본값
+const DefaultSyncInterval = time.Minute
+
+// Service: 오버라이드 저장, 봇 푸시, 유효 설정/이력 조회를 담당합니다.
+type Service struct {
+	store      Store
+	targets    map[string]string // 서비스 이름 → base URL
+	httpClient *http.Client
+	logger     *slog.Logger
+
+	mu  sync.Mutex // 변경과 주기 동기화의 동시 실행 방지
+	now func() time.Time
+}
+
+// NewService: 봇 설정 서비스 생성 (targets의 빈 URL은 제외)
+func NewService(store Store, targets map[string]string, logger *slog.Logger) *Service {
+	available := make(map[string]string, len(targets))
+	for name, baseURL := range targets {
+		if baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/"); baseURL != "" {
+			available[name] = baseURL
+		}
+	}
+	return &Service{
+		store:      store,
+		targets:    available,
+		httpClient: &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
+		logger:     logger.With(slog.String("component", "botconfig")),
+		now:        time.Now,
+	}
+}
+
+// Services: 설정 관리 대상 서비스 이름 목록 (이름순)
+func (s *Service) Services() []string {
+	return slices.Sorted(maps.Keys(s.targets))
+}
+
+// Effective: 오버라이드와 봇의 현재 적용 값을 함께 조회합니다.
+// 봇에 연결할 수 없어도 저장된 오버라이드는 반환합니다.
+func (s *Service) Effective(ctx context.Context, service string) (Effective, error) {
+	if _, ok := s.targets[service]; !ok {
+		return Effective{}, ErrUnknownService
+	}
+
+	overrides, err := s.store.GetOverrides(ctx, service)
+	if err != nil {
+		return Effective{}, fmt.Errorf("load overrides: %w", err)
+	}
+
+	snapshot, err := s.fetch(ctx, service)
+	if err != nil {
+		return Effective{Service: service, Error: err.Error(), Overrides: overrides, Settings: []EffectiveSetting{}}, nil
+	}
+	effective := buildEffective(service, overrides, snapshot.Settings)
+	effective.AppliedAt = snapshot.UpdatedAt
+	return effective, nil
+}
+
+// Update: 오버라이드를 바꾸고 봇에 푸시합니다. 값이 nil이거나 비어 있으면 오버라이드를 지워 봇 기본값으로 되돌립니다.
+// 봇이 거부하면(ErrInvalid) 저장하지 않고, 봇에 연결할 수 없으면(ErrUnavailable) 변경하지 않습니다.
+func (s *Service) Update(ctx context.Context, service string, values map[string]*string, actor, note string) (Change, Effective, error) {
+	if _, ok := s.targets[service]; !ok {
+		return Change{}, Effective{}, ErrUnknownService
+	}
+	if len(values) == 0 {
+		return Change{}, Effective{}, fmt.Errorf("%w: values required", ErrInvalid)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, err := s.store.GetOverrides(ctx, service)
+	if err != nil {
+		return Change{}, Effective{}, fmt.Errorf("load overrides: %w", err)
+	}
+
+	next := maps.Clone(current)
+	for key, value := range values {
+		key = strings.TrimSpace(key)
+		if value == nil || strings.TrimSpace(*value) == "" {
+			delete(next, key)
+			continue
+		}
+		next[key] = strings.TrimSpace(*value)
+	}
+
+	snapshot, err := s.push(ctx, service, next)
+	if err != nil {
+		return Change{}, Effective{}, err
+	}
+	// 봇이 정규화한 값(예: "0.950" → "0.95")으로 저장해 동기화 비교가 어긋나지 않게 함
+	for _, setting := range snapshot.Settings {
+		if _, ok := next[setting.Key]; ok {
+			next[setting.Key] = setting.Value
+		}
+	}
+
+	change := Change{
+		ID:      newChangeID(),
+		Service: service,
+		Actor:   actor,
+		Note:    note,
+		At:      s.now().UTC(),
+		Changes: diffOverrides(current, next),
+	}
+	if len(change.Changes) > 0 {
+		if err := s.store.SaveOverrides(ctx, service, next); err != nil {
+			return Change{}, Effective{}, fmt.Errorf("save overrides: %w", err)
+		}
+		if err := s.store.AppendChange(ctx, change); err != nil {
+			s.logger.Warn("bot_config_history_append_failed", slog.String("service", service), slog.Any("error", err))
+		}
+		s.logger.Info("bot_config_updated",
+			slog.String("service", service),
+			slog.String("actor", actor),
+			slog.Int("changes", len(change.Changes)),
+		)
+	}
+
+	effective := buildEffective(service, next, snapshot.Settings)
+	effective.AppliedAt = snapshot.UpdatedAt
+	return change, effective, nil
+}
+
+// History: 서비스의 최신순 변경 이력
+func (s *Service) History(ctx context.Context, service string, limit int) ([]Change, error) {
+	if _, ok := s.targets[service]; !ok {
+		return nil, ErrUnknownService
+	}
+	changes, err := s.store.ListChanges(ctx, service, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list changes: %w", err)
+	}
+	return changes, nil
+}
+
+// Sync: 봇 적용 값이 저장된 오버라이드와 다르면 다시 푸시합니다. (봇 재시작 시 오버라이드가 사라지므로)
+// 봇이 더 이상 제공하지 않는 키는 푸시에서 제외합니다.
+func (s *Service) Sync(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, service := range s.Services() {
+		overrides, err := s.store.GetOverrides(ctx, service)
+		if err != nil {
+			s.logger.Warn("bot_config_sync_load_failed", slog.String("service", service), slog.Any("error", err))
+			continue
+		}
+
+		snapshot, err := s.fetch(ctx, service)
+		if err != nil {
+			if len(overrides) > 0 {
+				s.logger.Warn("bot_config_sync_fetch_failed", slog.String("service", service), slog.Any("error", err))
+			}
+			continue
+		}
+
+		effective := buildEffective(service, overrides, snapshot.Settings)
+		known := make(map[string]bool, len(effective.Settings))
+		inSync := true
+		for _, setting := range effective.Settings {
+			known[setting.Key] = true
+			inSync = inSync && setting.InSync
+		}
+		if inSync {
+			continue
+		}
+
+		values := make(map[string]string, len(overrides))
+		for key, value := range overrides {
+			if known[key] {
+				values[key] = value
+			}
+		}
+		if _, err := s.push(ctx, service, values); err != nil {
+			s.logger.Warn("bot_config_sync_push_failed", slog.String("service", service), slog.Any("error", err))
+			continue
+		}
+		s.logger.Info("bot_config_resynced", slog.String("service", service), slog.Int("overrides", len(values)))
+	}
+}
+
+// Run: 주기적으로 Sync 실행 (ctx 종료 시 반환)
+func (s *Service) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSyncInterval
+	}
+	s.Sync(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Sync(ctx)
+		}
+	}
+}
+
+func newChangeID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,137 @@
+package botconfig
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	overridesKey     = "botconfig:overrides"
+	historyKeyPrefix = "botconfig:history:"
+
+	// maxHistory: 서비스별로 보관할 최대 변경 이력 수
+	maxHistory = 200
+)
+
+// Store: 오버라이드/변경 이력 저장소 인터페이스
+type Store interface {
+	// GetOverrides: 서비스의 오버라이드 (없으면 빈 맵)
+	GetOverrides(ctx context.Context, service string) (map[string]string, error)
+	SaveOverrides(ctx context.Context, service string, overrides map[string]string) error
+
+	AppendChange(ctx context.Context, change Change) error
+	// ListChanges: 최신순 변경 이력
+	ListChanges(ctx context.Context, service string, limit int) ([]Change, error)
+}
+
+// ValkeyStore: Valkey 기반 저장소 (오버라이드는 서비스별 해시 필드, 이력은 서비스별 리스트)
+type ValkeyStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 봇 설정 저장소 생성
+func NewValkeyStore(client valkey.Client, logger *slog.Logger) *ValkeyStore {
+	return &ValkeyStore{client: client, logger: logger}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+// GetOverrides: 서비스 오버라이드 조회
+func (s *ValkeyStore) GetOverrides(ctx context.Context, service string) (map[string]string, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hget().Key(overridesKey).Field(service).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("get bot config overrides: %w", err)
+	}
+
+	overrides := map[string]string{}
+	if err := json.Unmarshal([]byte(raw), &overrides); err != nil {
+		return nil, fmt.Errorf("decode bot config overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+// SaveOverrides: 서비스 오버라이드 전체 교체 (비어 있으면 필드 삭제)
+func (s *ValkeyStore) SaveOverrides(ctx context.Context, service string, overrides map[string]string) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if len(overrides) == 0 {
+		if err := s.client.Do(ctx, s.client.B().Hdel().Key(overridesKey).Field(service).Build()).Error(); err != nil {
+			return fmt.Errorf("delete bot config overrides: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(overrides)
+	if err != nil {
+		return fmt.Errorf("marshal bot config overrides: %w", err)
+	}
+	cmd := s.client.B().Hset().Key(overridesKey).FieldValue().FieldValue(service, string(data)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("save bot config overrides: %w", err)
+	}
+	return nil
+}
+
+// AppendChange: 이력을 리스트 앞에 추가하고 최대 길이로 자름
+func (s *ValkeyStore) AppendChange(ctx context.Context, change Change) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("marshal bot config change: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	key := historyKeyPrefix + change.Service
+	cmds := valkey.Commands{
+		s.client.B().Lpush().Key(key).Element(string(data)).Build(),
+		s.client.B().Ltrim().Key(key).Start(0).Stop(maxHistory - 1).Build(),
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append bot config change: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListChanges: 최신순 이력 조회
+func (s *ValkeyStore) ListChanges(ctx context.Context, service string, limit int) ([]Change, error) {
+	if limit <= 0 || limit > maxHistory {
+		limit = maxHistory
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Lrange().Key(historyKeyPrefix+service).Start(0).Stop(int64(limit-1)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list bot config changes: %w", err)
+	}
+
+	changes := make([]Change, 0, len(raw))
+	for _, item := range raw {
+		var change Change
+		if err := json.Unmarshal([]byte(item), &change); err != nil {
+			s.logger.Warn("bot_config_change_decode_failed", slog.Any("error", err))
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
@@ -27,20 +27,66 @@ type Config struct {
 	AdminPassHash        string
 	AdminSecretKey       string
 	SessionTokenRotation bool
+	Admin2FARequired     bool // 모든 계정에 TOTP 2단계 인증 강제 (미등록 계정은 로그인 후 등록 API만 사용 가능)
 
-	// Metrics 설정
-	MetricsAPIKey string
+	// Metrics 설정 (MetricsScrapeInterval 0이면 봇 메트릭 수집 비활성화)
+	MetricsAPIKey         string
+	MetricsScrapeInterval time.Duration
+	MetricsRetention      time.Duration
+
+	// 감사 로그 설정
+	AuditMaxEntries int
+
+	// 설정 드리프트 감지 설정 (DriftCheckInterval 0이면 주기 점검 비활성화)
+	DriftCheckInterval time.Duration
+	DriftDeployWindow  time.Duration
+	DriftWebhookURL    string
+
+	// 알림 규칙 평가 설정 (AlertEvalInterval 0이면 주기 평가 비활성화, 웹훅은 쉼표로 구분)
+	AlertEvalInterval time.Duration
+	AlertWebhookURLs  string
+
+	// 봇 런타임 설정 재동기화 주기 (BotConfigSyncInterval 0이면 주기 재푸시 비활성화, 조회/변경은 가능)
+	BotConfigSyncInterval time.Duration
+
+	// 활동 피드: Valkey에 보관하는 최근 이벤트 수 (WebSocket 연결 시 백필용)
+	ActivityHistorySize int
+
+	// 서비스 헬스체크 이력 기록 주기 (StatusHistoryInterval 0이면 이력 기록/조회 비활성화)
+	StatusHistoryInterval time.Duration
+	// 상태 의존 그래프의 인프라 노드 주소 (host:port, 비우면 해당 노드 제외)
+	StatusPostgresAddr string
+	StatusValkeyMQAddr string
 
 	// 외부 서비스 URL
 	ValkeyURL      string
 	JaegerQueryURL string
 	DockerHost     string
+	// DockerRestartChains: 컨테이너 재시작 후 이어서 재시작할 의존 컨테이너 ("valkey-cache=hololive-bot:5s,...;...")
+	DockerRestartChains string
+	// 종료된 일회성 컨테이너 정리 (DockerPruneInterval 0이면 예약 정리 비활성화, 수동 정리는 가능)
+	DockerPruneInterval time.Duration
+	DockerPruneLabels   string // 모두 일치해야 정리 ("key=value,key2", 비우면 llm-bot compose run 컨테이너)
+	DockerPruneMinAge   time.Duration
+	// 리소스 워치독 정책 ("hololive-bot=rss:512,cpu:90,n:5;...", 비우면 비활성화)
+	DockerResourcePolicies string
+	DockerWatchdogInterval time.Duration
 
 	// 각 봇 프록시 URL
 	HoloBotURL    string
 	TwentyQBotURL string
 	TurtleBotURL  string
 	LLMServerURL  string
+	LLMAPIKey     string // LLM 서버 X-API-Key (실시간 사용량 스트림 프록시용)
+
+	// 봇 프록시 GET 응답 캐시 (ProxyCacheTTL 0이면 비활성화)
+	ProxyCacheTTL        time.Duration
+	ProxyCacheMaxEntries int
+	// 봇 관리 API 요청의 내장 OpenAPI 명세 검증 (enforce|warn|off)
+	ProxySpecValidation string
+
+	// 대시보드 집계용 GraphQL 엔드포인트 (/admin/api/graphql, 기본 비활성화)
+	GraphQLEnabled bool
 
 	// OTEL 설정
 	OTELEnabled     bool
@@ -81,17 +127,51 @@ func Load() *Config {
 		AdminPassHash:        getEnvAny("ADMIN_PASS_HASH", "ADMIN_PASS_BCRYPT"),
 		AdminSecretKey:       getEnvAny("SESSION_SECRET", "ADMIN_SECRET_KEY"),
 		SessionTokenRotation: getEnvBool("SESSION_TOKEN_ROTATION", true),
+		Admin2FARequired:     getEnvBool("ADMIN_2FA_REQUIRED", false),
+
+		MetricsAPIKey:         getEnv("METRICS_API_KEY", ""),
+		MetricsScrapeInterval: getEnvDuration("METRICS_SCRAPE_INTERVAL", 15*time.Second),
+		MetricsRetention:      getEnvDuration("METRICS_RETENTION", time.Hour),
+
+		AuditMaxEntries: getEnvInt("AUDIT_MAX_ENTRIES", 10000),
+
+		DriftCheckInterval: getEnvDuration("DRIFT_CHECK_INTERVAL", 5*time.Minute),
+		DriftDeployWindow:  getEnvDuration("DRIFT_DEPLOY_WINDOW", 30*time.Minute),
+		DriftWebhookURL:    getEnv("DRIFT_WEBHOOK_URL", ""),
+
+		AlertEvalInterval: getEnvDuration("ALERT_EVAL_INTERVAL", 30*time.Second),
+		AlertWebhookURLs:  getEnv("ALERT_WEBHOOK_URLS", ""),
+
+		BotConfigSyncInterval: getEnvDuration("BOT_CONFIG_SYNC_INTERVAL", time.Minute),
+
+		ActivityHistorySize: getEnvInt("ACTIVITY_HISTORY_SIZE", 500),
+
+		StatusHistoryInterval: getEnvDuration("STATUS_HISTORY_INTERVAL", 30*time.Second),
+		StatusPostgresAddr:    getEnv("STATUS_POSTGRES_ADDR", "postgres:5432"),
+		StatusValkeyMQAddr:    getEnv("STATUS_VALKEY_MQ_ADDR", "valkey-mq:1833"),
 
-		MetricsAPIKey: getEnv("METRICS_API_KEY", ""),
+		ValkeyURL:           getEnv("VALKEY_URL", "valkey-cache:6379"),
+		JaegerQueryURL:      getEnv("JAEGER_QUERY_URL", "http://jaeger:16686"),
+		DockerHost:          getEnv("DOCKER_HOST", "tcp://docker-proxy:2375"),
+		DockerRestartChains: getEnv("DOCKER_RESTART_CHAINS", ""),
+		DockerPruneInterval: getEnvDuration("DOCKER_PRUNE_INTERVAL", time.Hour),
+		DockerPruneLabels:   getEnv("DOCKER_PRUNE_LABELS", ""),
+		DockerPruneMinAge:   getEnvDuration("DOCKER_PRUNE_MIN_AGE", 24*time.Hour),
 
-		ValkeyURL:      getEnv("VALKEY_URL", "valkey-cache:6379"),
-		JaegerQueryURL: getEnv("JAEGER_QUERY_URL", "http://jaeger:16686"),
-		DockerHost:     getEnv("DOCKER_HOST", "tcp://docker-proxy:2375"),
+		DockerResourcePolicies: getEnv("DOCKER_RESOURCE_POLICIES", ""),
+		DockerWatchdogInterval: getEnvDuration("DOCKER_WATCHDOG_INTERVAL", 30*time.Second),
 
 		HoloBotURL:    getEnv("HOLO_BOT_URL", "http://hololive-bot:30001"),
 		TwentyQBotURL: getEnv("TWENTYQ_BOT_URL", "http://twentyq-bot:30081"),
 		TurtleBotURL:  getEnv("TURTLE_BOT_URL", "http://turtle-soup-bot:30082"),
 		LLMServerURL:  getEnv("LLM_SERVER_URL", "http://mcp-llm-server:40527"), // LLM 서버 포트 수정
+		LLMAPIKey:     getEnv("LLM_API_KEY", getEnv("HTTP_API_KEY", "")),
+
+		ProxyCacheTTL:        getEnvDuration("PROXY_CACHE_TTL", 0),
+		ProxyCacheMaxEntries: getEnvInt("PROXY_CACHE_MAX_ENTRIES", 1000),
+		ProxySpecValidation:  getEnv("PROXY_SPEC_VALIDATION", "enforce"),
+
+		GraphQLEnabled: getEnvBool("GRAPHQL_ENABLED", false),
 
 		OTELEnabled:     getEnvBool("OTEL_ENABLED", false),
 		OTELEndpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "jaeger:4317"),
@@ -116,6 +196,26 @@ func getEnvAny(keys ...string) string {
 	return ""
 }
 
+func getEnvInt(key string, fallback int) int {
+	if val := os.Getenv(key); val != "" {
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if val := os.Getenv(key); val != "" {
+		d, err := time.ParseDuration(strings.TrimSpace(val))
+		if err == nil && d >= 0 {
+			return d
+		}
+	}
+	return fallback
+}
+
 func getEnvBool(key string, fallback bool) bool {
 	if val := os.Getenv(key); val != "" {
 		b, err := strconv.ParseBool(val)
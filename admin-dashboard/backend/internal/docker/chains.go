@@ -0,0 +1,114 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+)
+
+// GroupRestartChain: 컨테이너 재시작 후 의존 컨테이너를 이어서 재시작하는 작업
+const GroupRestartChain GroupAction = "restart_chain"
+
+// chainJobPrefix: 재시작 체인 작업의 진행 중 표시 키 (compose 프로젝트 키와 구분)
+const chainJobPrefix = "chain:"
+
+// ChainStep: 재시작 체인의 의존 컨테이너 하나와 재시작 전 대기 시간
+type ChainStep struct {
+	Container string        `json:"container"`
+	Delay     time.Duration `json:"-"`
+}
+
+// RestartChains: 컨테이너 이름 → 재시작 후 순서대로 재시작할 의존 컨테이너 목록
+type RestartChains map[string][]ChainStep
+
+// ParseRestartChains: "valkey-cache=hololive-bot:5s,twentyq-bot:3s;postgres=hololive-bot" 형식을 파싱합니다.
+// 대기 시간을 생략하면 0이며, 체인은 이어지지 않습니다 (의존 컨테이너의 체인은 따로 실행하지 않음).
+func ParseRestartChains(spec string) (RestartChains, error) {
+	chains := make(RestartChains)
+	for entry := range strings.SplitSeq(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		primary, deps, ok := strings.Cut(entry, "=")
+		primary = strings.TrimSpace(primary)
+		if !ok || primary == "" {
+			return nil, fmt.Errorf("invalid restart chain %q: expected container=dependent[:delay],...", entry)
+		}
+		if _, dup := chains[primary]; dup {
+			return nil, fmt.Errorf("duplicate restart chain for %s", primary)
+		}
+
+		var steps []ChainStep
+		for dep := range strings.SplitSeq(deps, ",") {
+			name, rawDelay, hasDelay := strings.Cut(strings.TrimSpace(dep), ":")
+			if name == "" {
+				continue
+			}
+			if name == primary || slices.ContainsFunc(steps, func(s ChainStep) bool { return s.Container == name }) {
+				return nil, fmt.Errorf("restart chain for %s lists %s twice", primary, name)
+			}
+			step := ChainStep{Container: name}
+			if hasDelay {
+				delay, err := time.ParseDuration(rawDelay)
+				if err != nil || delay < 0 {
+					return nil, fmt.Errorf("invalid delay %q for %s in restart chain %s", rawDelay, name, primary)
+				}
+				step.Delay = delay
+			}
+			steps = append(steps, step)
+		}
+		if len(steps) == 0 {
+			return nil, fmt.Errorf("restart chain for %s has no dependents", primary)
+		}
+		chains[primary] = steps
+	}
+	return chains, nil
+}
+
+// RestartChain: 컨테이너의 재시작 체인 (없으면 nil)
+func (s *Service) RestartChain(name string) []ChainStep {
+	if s == nil {
+		return nil
+	}
+	return s.chains[name]
+}
+
+// chainStages: 재시작 대상 다음에 의존 컨테이너를 한 단계씩 배치
+func chainStages(name string, steps []ChainStep) [][]GroupMember {
+	stages := make([][]GroupMember, 0, len(steps)+1)
+	stages = append(stages, []GroupMember{{Name: name}})
+	for _, step := range steps {
+		stages = append(stages, []GroupMember{{Name: step.Container, RestartDelay: step.Delay}})
+	}
+	return stages
+}
+
+// StartRestartChain: 컨테이너를 재시작하고, 준비 상태가 되면 체인의 의존 컨테이너를 순서대로 재시작하는 작업을 시작합니다.
+// 의존 컨테이너가 죽은 연결을 붙잡고 있지 않도록 하기 위한 것이며, 진행 상황은 GroupJob.Subscribe로 구독합니다.
+func (s *Service) StartRestartChain(ctx context.Context, name string) (*GroupJob, error) {
+	steps := s.RestartChain(name)
+	if len(steps) == 0 {
+		return nil, errors.New("no restart chain configured for " + name)
+	}
+	job := &GroupJob{
+		ID:        newJobID(),
+		Project:   name,
+		Action:    GroupRestartChain,
+		StartedAt: time.Now(),
+		Stages:    chainStages(name, steps),
+		subs:      make(map[chan GroupProgress]struct{}),
+	}
+	if err := s.startJob(ctx, chainJobPrefix+name, job); err != nil {
+		return nil, err
+	}
+	s.logger.Info("restart chain started",
+		slog.String("container", name),
+		slog.Int("dependents", len(steps)),
+		slog.String("job", job.ID))
+	return job, nil
+}
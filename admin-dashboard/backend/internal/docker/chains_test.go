@@ -0,0 +1,59 @@
+package docker
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseRestartChains(t *testing.T) {
+	chains, err := ParseRestartChains(" valkey-cache = hololive-bot:5s, twentyq-bot ,turtle-soup-bot:1m ; postgres=hololive-bot;")
+	if err != nil {
+		t.Fatalf("ParseRestartChains: %v", err)
+	}
+	want := []ChainStep{
+		{Container: "hololive-bot", Delay: 5 * time.Second},
+		{Container: "twentyq-bot"},
+		{Container: "turtle-soup-bot", Delay: time.Minute},
+	}
+	if !slices.Equal(chains["valkey-cache"], want) {
+		t.Fatalf("valkey chain = %+v, want %+v", chains["valkey-cache"], want)
+	}
+	if len(chains["postgres"]) != 1 || len(chains) != 2 {
+		t.Fatalf("unexpected chains: %+v", chains)
+	}
+
+	if empty, err := ParseRestartChains(""); err != nil || len(empty) != 0 {
+		t.Fatalf("empty spec must yield no chains, got %+v, %v", empty, err)
+	}
+
+	for _, spec := range []string{
+		"valkey-cache",
+		"=hololive-bot",
+		"valkey-cache=",
+		"valkey-cache=hololive-bot:soon",
+		"valkey-cache=hololive-bot:-1s",
+		"valkey-cache=valkey-cache",
+		"valkey-cache=hololive-bot,hololive-bot",
+		"valkey-cache=hololive-bot;valkey-cache=twentyq-bot",
+	} {
+		if _, err := ParseRestartChains(spec); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+}
+
+func TestChainStages(t *testing.T) {
+	stages := chainStages("valkey-cache", []ChainStep{
+		{Container: "hololive-bot", Delay: 5 * time.Second},
+		{Container: "twentyq-bot"},
+	})
+	got := stageNames(stages)
+	want := [][]string{{"valkey-cache"}, {"hololive-bot"}, {"twentyq-bot"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Fatalf("chainStages = %v, want %v", got, want)
+	}
+	if stages[1][0].RestartDelay != 5*time.Second || stages[0][0].RestartDelay != 0 {
+		t.Fatalf("delays must be attached to dependents: %+v", stages)
+	}
+}
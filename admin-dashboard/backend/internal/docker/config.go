@@ -0,0 +1,134 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// ContainerConfig: 설정 드리프트 비교용 컨테이너 구성 정보 (docker inspect 요약)
+type ContainerConfig struct {
+	Name        string        `json:"name"`
+	Image       string        `json:"image"`
+	ImageID     string        `json:"imageId"`
+	Env         []string      `json:"env"`
+	Mounts      []MountConfig `json:"mounts"`
+	NetworkMode string        `json:"networkMode"`
+	Networks    []string      `json:"networks"`
+}
+
+// MountConfig: 컨테이너 마운트 정보
+type MountConfig struct {
+	Type        string `json:"type"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	RW          bool   `json:"rw"`
+}
+
+// InspectConfigs: 관리 대상 컨테이너 전체의 구성 정보를 조회합니다.
+// 조회 중 사라진 컨테이너는 건너뜁니다.
+func (s *Service) InspectConfigs(ctx context.Context) ([]ContainerConfig, error) {
+	containers, err := s.ListContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	result := make([]ContainerConfig, 0, len(containers))
+	for _, c := range containers {
+		inspect, err := s.client.ContainerInspect(ctx, c.Name)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("inspect container %s: %w", c.Name, err)
+			}
+			s.logger.Warn("container inspect failed",
+				slog.String("container", c.Name),
+				slog.String("error", err.Error()))
+			continue
+		}
+		result = append(result, toContainerConfig(c.Name, &inspect))
+	}
+	return result, nil
+}
+
+// redactedEnvValue: InspectJSON에서 민감한 환경 변수 값을 대체하는 문자열
+const redactedEnvValue = "[REDACTED]"
+
+// InspectJSON: 컨테이너 하나의 docker inspect 결과 전체를 들여쓴 JSON으로 반환합니다.
+// sensitive가 true를 반환하는 환경 변수는 값을 가립니다. (관리 대상 여부는 호출자가 확인)
+func (s *Service) InspectJSON(ctx context.Context, name string, sensitive func(key string) bool) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	inspect, err := s.client.ContainerInspect(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("inspect container %s: %w", name, err)
+	}
+
+	if inspect.Config != nil && sensitive != nil {
+		env := make([]string, len(inspect.Config.Env))
+		for i, kv := range inspect.Config.Env {
+			key, _, _ := strings.Cut(kv, "=")
+			if sensitive(key) {
+				kv = key + "=" + redactedEnvValue
+			}
+			env[i] = kv
+		}
+		inspect.Config.Env = env
+	}
+
+	data, err := json.MarshalIndent(inspect, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal inspect %s: %w", name, err)
+	}
+	return data, nil
+}
+
+func toContainerConfig(name string, inspect *container.InspectResponse) ContainerConfig {
+	cfg := ContainerConfig{Name: name}
+	if inspect.ContainerJSONBase != nil {
+		cfg.ImageID = inspect.Image
+		if inspect.HostConfig != nil {
+			cfg.NetworkMode = string(inspect.HostConfig.NetworkMode)
+		}
+	}
+	if inspect.Config != nil {
+		cfg.Image = inspect.Config.Image
+		cfg.Env = append([]string(nil), inspect.Config.Env...)
+		sort.Strings(cfg.Env)
+	}
+
+	for _, m := range inspect.Mounts {
+		// 익명 볼륨 소스 경로는 재생성마다 바뀌므로 볼륨 이름 기준으로 비교
+		source := m.Source
+		if m.Name != "" {
+			source = m.Name
+		}
+		cfg.Mounts = append(cfg.Mounts, MountConfig{
+			Type:        string(m.Type),
+			Source:      source,
+			Destination: m.Destination,
+			RW:          m.RW,
+		})
+	}
+	sort.Slice(cfg.Mounts, func(i, j int) bool {
+		return cfg.Mounts[i].Destination < cfg.Mounts[j].Destination
+	})
+
+	if inspect.NetworkSettings != nil {
+		for network := range inspect.NetworkSettings.Networks {
+			cfg.Networks = append(cfg.Networks, network)
+		}
+		sort.Strings(cfg.Networks)
+	}
+
+	return cfg
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -34,10 +35,14 @@ type Service struct {
 	projectName    string
 	managedFilters []string
 	excludeFilters []string // 관리 대상에서 제외할 패턴
+	groups         *groupJobs
+	chains         RestartChains
+	prune          pruneState
+	updates        updateState
 }
 
-// NewService: Docker 서비스 생성
-func NewService(logger *slog.Logger, projectName string) (*Service, error) {
+// NewService: Docker 서비스 생성 (chains: 단일 컨테이너 재시작 시 이어서 재시작할 의존 컨테이너)
+func NewService(logger *slog.Logger, projectName string, chains RestartChains) (*Service, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		return nil, fmt.Errorf("create docker client: %w", err)
@@ -47,6 +52,9 @@ func NewService(logger *slog.Logger, projectName string) (*Service, error) {
 		client:      cli,
 		logger:      logger.With(slog.String("component", "docker")),
 		projectName: projectName,
+		groups:      newGroupJobs(),
+		chains:      chains,
+		prune:       pruneState{policy: PrunePolicy{Labels: slices.Clone(DefaultPruneLabels), MinAge: DefaultPruneMinAge}},
 		managedFilters: []string{
 			"hololive",
 			"mcp-llm",
@@ -0,0 +1,51 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// WatchContainerEvents: 관리 대상 컨테이너의 재시작(restart)과 비정상 종료(die, 종료 코드 0 제외)를 handler로 전달합니다.
+// 재시작 정책에 의한 재시작은 restart 이벤트 없이 die → start로만 나타나므로 비정상 종료로 감지합니다.
+// ctx가 끝나면 nil을, 이벤트 스트림이 끊기면 오류를 반환합니다.
+func (s *Service) WatchContainerEvents(ctx context.Context, handler func(name, action, exitCode string, at time.Time)) error {
+	msgs, errs := s.client.Events(ctx, events.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", string(events.ContainerEventType)),
+			filters.Arg("event", string(events.ActionRestart)),
+			filters.Arg("event", string(events.ActionDie)),
+		),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("docker events: %w", err)
+		case msg := <-msgs:
+			if name, ok := s.containerEventName(msg); ok {
+				handler(name, string(msg.Action), msg.Actor.Attributes["exitCode"], time.Unix(0, msg.TimeNano))
+			}
+		}
+	}
+}
+
+// containerEventName: 전달할 이벤트면 컨테이너 이름을 반환합니다. (관리 대상 아님, 정상 종료는 제외)
+func (s *Service) containerEventName(msg events.Message) (string, bool) {
+	name := msg.Actor.Attributes["name"]
+	if name == "" || !s.isManaged(name) {
+		return "", false
+	}
+	if msg.Action == events.ActionDie && msg.Actor.Attributes["exitCode"] == "0" {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,31 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/events"
+)
+
+func TestContainerEventName(t *testing.T) {
+	s := &Service{managedFilters: []string{"twentyq"}, excludeFilters: []string{"-init"}}
+
+	for _, tc := range []struct {
+		name   string
+		action events.Action
+		attrs  map[string]string
+		want   string
+	}{
+		{name: "restart", action: events.ActionRestart, attrs: map[string]string{"name": "twentyq-bot"}, want: "twentyq-bot"},
+		{name: "crash", action: events.ActionDie, attrs: map[string]string{"name": "twentyq-bot", "exitCode": "137"}, want: "twentyq-bot"},
+		{name: "clean exit", action: events.ActionDie, attrs: map[string]string{"name": "twentyq-bot", "exitCode": "0"}},
+		{name: "unmanaged", action: events.ActionRestart, attrs: map[string]string{"name": "other"}},
+		{name: "excluded", action: events.ActionRestart, attrs: map[string]string{"name": "twentyq-init"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := s.containerEventName(events.Message{Action: tc.action, Actor: events.Actor{Attributes: tc.attrs}})
+			if got != tc.want || ok != (tc.want != "") {
+				t.Fatalf("got %q ok=%v, want %q", got, ok, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package docker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// 진행 상황 상태값
+const (
+	ProgressRunning   = "running"
+	ProgressDone      = "done"
+	ProgressFailed    = "failed"
+	ProgressSkipped   = "skipped"
+	ProgressCompleted = "completed" // 작업 전체 종료 (Final=true)
+)
+
+// maxGroupJobs: 진행 상황 재조회를 위해 보관하는 최근 작업 수
+const maxGroupJobs = 20
+
+// ErrGroupBusy: 같은 프로젝트에 진행 중인 일괄 작업이 있음
+var ErrGroupBusy = errors.New("group operation already in progress")
+
+// GroupProgress: 일괄 작업 진행 이벤트 (WebSocket 전송 단위)
+type GroupProgress struct {
+	JobID     string      `json:"jobId"`
+	Project   string      `json:"project"`
+	Action    GroupAction `json:"action"`
+	Step      int         `json:"step"`
+	Total     int         `json:"total"`
+	Stage     int         `json:"stage"`
+	Container string      `json:"container,omitempty"`
+	Service   string      `json:"service,omitempty"`
+	Status    string      `json:"status"`
+	Error     string      `json:"error,omitempty"`
+	Final     bool        `json:"final"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+// GroupJob: compose 프로젝트 일괄 작업 한 건
+type GroupJob struct {
+	ID        string          `json:"id"`
+	Project   string          `json:"project"`
+	Action    GroupAction     `json:"action"`
+	StartedAt time.Time       `json:"startedAt"`
+	Stages    [][]GroupMember `json:"stages"`
+
+	mu     sync.Mutex
+	events []GroupProgress
+	done   bool
+	subs   map[chan GroupProgress]struct{}
+}
+
+// Subscribe: 지금까지의 이벤트와 이후 이벤트 채널을 반환합니다. 작업이 끝나면 채널이 닫힙니다.
+func (j *GroupJob) Subscribe() ([]GroupProgress, <-chan GroupProgress, func()) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	history := append([]GroupProgress(nil), j.events...)
+	ch := make(chan GroupProgress, 64)
+	if j.done {
+		close(ch)
+		return history, ch, func() {}
+	}
+	j.subs[ch] = struct{}{}
+	return history, ch, func() {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		if _, ok := j.subs[ch]; ok {
+			delete(j.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+func (j *GroupJob) publish(progress GroupProgress) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	progress.JobID = j.ID
+	progress.Project = j.Project
+	progress.Action = j.Action
+	progress.Timestamp = time.Now()
+	j.events = append(j.events, progress)
+	for ch := range j.subs {
+		select {
+		case ch <- progress:
+		default:
+			// 느린 구독자는 끊고 재구독(이력 재전송)에 맡김
+			delete(j.subs, ch)
+			close(ch)
+		}
+	}
+	if progress.Final {
+		j.done = true
+		for ch := range j.subs {
+			close(ch)
+		}
+		clear(j.subs)
+	}
+}
+
+// groupJobs: 진행 중/최근 일괄 작업 레지스트리
+type groupJobs struct {
+	mu     sync.Mutex
+	jobs   map[string]*GroupJob
+	order  []string
+	active map[string]string // project -> job id
+}
+
+func newGroupJobs() *groupJobs {
+	return &groupJobs{
+		jobs:   make(map[string]*GroupJob),
+		active: make(map[string]string),
+	}
+}
+
+// StartGroup: 프로젝트 컨테이너를 의존 순서대로 재시작/중지하는 작업을 백그라운드에서 시작합니다.
+// 진행 상황은 GroupJob.Subscribe로 구독합니다.
+func (s *Service) StartGroup(ctx context.Context, project string, action GroupAction) (*GroupJob, error) {
+	members, err := s.ListGroup(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &GroupJob{
+		ID:        newJobID(),
+		Project:   project,
+		Action:    action,
+		StartedAt: time.Now(),
+		Stages:    actionStages(action, members),
+		subs:      make(map[chan GroupProgress]struct{}),
+	}
+
+	if err := s.startJob(ctx, project, job); err != nil {
+		return nil, err
+	}
+	s.logger.Info("compose group operation started",
+		slog.String("project", project),
+		slog.String("action", string(action)),
+		slog.String("job", job.ID))
+	return job, nil
+}
+
+// startJob: 작업을 등록하고 백그라운드에서 단계별로 실행합니다. key에 진행 중인 작업이 있으면 ErrGroupBusy.
+func (s *Service) startJob(ctx context.Context, key string, job *GroupJob) error {
+	s.groups.mu.Lock()
+	if _, busy := s.groups.active[key]; busy {
+		s.groups.mu.Unlock()
+		return ErrGroupBusy
+	}
+	s.groups.active[key] = job.ID
+	s.groups.jobs[job.ID] = job
+	s.groups.order = append(s.groups.order, job.ID)
+	if len(s.groups.order) > maxGroupJobs {
+		delete(s.groups.jobs, s.groups.order[0])
+		s.groups.order = s.groups.order[1:]
+	}
+	s.groups.mu.Unlock()
+
+	// 요청이 끝나도 작업은 계속 진행
+	runCtx := context.WithoutCancel(ctx)
+	go func() {
+		err := s.runGroup(runCtx, job.Action, job.Stages, job.publish)
+
+		final := GroupProgress{Status: ProgressCompleted, Final: true}
+		for _, stage := range job.Stages {
+			final.Total += len(stage)
+		}
+		final.Step = final.Total
+		if err != nil {
+			final.Status = ProgressFailed
+			final.Error = err.Error()
+			s.logger.Error("compose group operation failed",
+				slog.String("project", job.Project),
+				slog.String("action", string(job.Action)),
+				slog.String("error", err.Error()))
+		}
+		job.publish(final)
+
+		s.groups.mu.Lock()
+		delete(s.groups.active, key)
+		s.groups.mu.Unlock()
+	}()
+	return nil
+}
+
+// GroupJob: 작업 ID로 최근 일괄 작업을 조회합니다.
+func (s *Service) GroupJob(id string) (*GroupJob, bool) {
+	s.groups.mu.Lock()
+	defer s.groups.mu.Unlock()
+	job, ok := s.groups.jobs[id]
+	return job, ok
+}
+
+func newJobID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
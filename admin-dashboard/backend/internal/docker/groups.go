@@ -0,0 +1,275 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// docker compose가 컨테이너에 붙이는 라벨
+const (
+	ComposeProjectLabel   = "com.docker.compose.project"
+	composeServiceLabel   = "com.docker.compose.service"
+	composeDependsOnLabel = "com.docker.compose.depends_on" // "svc:condition:restart,svc2:..."
+)
+
+// readyTimeout: 재시작 후 다음 단계로 넘어가기 전 준비 상태(running + healthy)를 기다리는 최대 시간
+const readyTimeout = 90 * time.Second
+
+// ErrGroupNotFound: 프로젝트 라벨에 해당하는 관리 대상 컨테이너가 없음
+var ErrGroupNotFound = errors.New("compose project not found")
+
+// GroupAction: 그룹 일괄 작업 종류
+type GroupAction string
+
+const (
+	GroupRestart GroupAction = "restart"
+	GroupStop    GroupAction = "stop"
+)
+
+// GroupMember: compose 프로젝트에 속한 관리 대상 컨테이너
+type GroupMember struct {
+	Name      string   `json:"name"`
+	Service   string   `json:"service"`
+	State     string   `json:"state"`
+	DependsOn []string `json:"dependsOn,omitempty"` // compose 서비스명
+	// RestartDelay: 재시작 전 대기 시간 (재시작 체인의 의존 컨테이너만 사용)
+	RestartDelay time.Duration `json:"-"`
+}
+
+// ListGroup: compose 프로젝트 라벨로 관리 대상 컨테이너를 찾습니다.
+func (s *Service) ListGroup(ctx context.Context, project string) ([]GroupMember, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	containers, err := s.client.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", ComposeProjectLabel+"="+project)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list compose project %s: %w", project, err)
+	}
+
+	members := make([]GroupMember, 0, len(containers))
+	for i := range containers {
+		c := &containers[i]
+		if len(c.Names) == 0 {
+			continue
+		}
+		name := strings.TrimPrefix(c.Names[0], "/")
+		if !s.isManaged(name) {
+			continue
+		}
+		members = append(members, GroupMember{
+			Name:      name,
+			Service:   c.Labels[composeServiceLabel],
+			State:     c.State,
+			DependsOn: parseDependsOn(c.Labels[composeDependsOnLabel]),
+		})
+	}
+	if len(members) == 0 {
+		return nil, ErrGroupNotFound
+	}
+	return members, nil
+}
+
+// parseDependsOn: compose depends_on 라벨에서 서비스명만 추출
+func parseDependsOn(label string) []string {
+	var deps []string
+	for entry := range strings.SplitSeq(label, ",") {
+		service, _, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		if service != "" {
+			deps = append(deps, service)
+		}
+	}
+	return deps
+}
+
+// serviceTier: depends_on 라벨이 없을 때를 위한 기본 순서 (저장소 → LLM 서버 → 나머지)
+func serviceTier(m GroupMember) int {
+	key := m.Service + " " + m.Name
+	switch {
+	case strings.Contains(key, "valkey"), strings.Contains(key, "postgres"):
+		return 0
+	case strings.Contains(key, "mcp-llm"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// OrderGroup: 의존 대상이 먼저 오도록 컨테이너를 단계별로 묶습니다.
+// 단계 = max(기본 순서, 의존 대상 단계 + 1). 그룹 밖 의존성과 순환 의존은 무시합니다.
+func OrderGroup(members []GroupMember) [][]GroupMember {
+	byService := make(map[string][]int, len(members))
+	for i, m := range members {
+		if m.Service != "" {
+			byService[m.Service] = append(byService[m.Service], i)
+		}
+	}
+
+	levels := make([]int, len(members))
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	marks := make([]int, len(members))
+	var visit func(i int) int
+	visit = func(i int) int {
+		switch marks[i] {
+		case visited:
+			return levels[i]
+		case visiting:
+			return -1 // 순환: 이 간선은 순서에 반영하지 않음
+		}
+		marks[i] = visiting
+		level := serviceTier(members[i])
+		for _, dep := range members[i].DependsOn {
+			for _, j := range byService[dep] {
+				if j == i {
+					continue
+				}
+				level = max(level, visit(j)+1)
+			}
+		}
+		marks[i] = visited
+		levels[i] = level
+		return level
+	}
+	for i := range members {
+		visit(i)
+	}
+
+	maxLevel := slices.Max(append([]int{0}, levels...))
+	stages := make([][]GroupMember, 0, maxLevel+1)
+	for level := 0; level <= maxLevel; level++ {
+		var stage []GroupMember
+		for i, m := range members {
+			if levels[i] == level {
+				stage = append(stage, m)
+			}
+		}
+		if len(stage) == 0 {
+			continue
+		}
+		slices.SortFunc(stage, func(a, b GroupMember) int { return strings.Compare(a.Name, b.Name) })
+		stages = append(stages, stage)
+	}
+	return stages
+}
+
+// actionStages: 재시작은 의존 대상부터, 중지는 의존하는 쪽부터 진행
+func actionStages(action GroupAction, members []GroupMember) [][]GroupMember {
+	stages := OrderGroup(members)
+	if action == GroupStop {
+		slices.Reverse(stages)
+	}
+	return stages
+}
+
+// runGroup: 단계 순서대로 작업을 수행하고 진행 상황을 emit으로 전달합니다.
+// 재시작은 단계가 끝날 때마다 준비 상태를 확인하며, 실패하면 남은 컨테이너는 건너뜁니다.
+func (s *Service) runGroup(ctx context.Context, action GroupAction, stages [][]GroupMember, emit func(GroupProgress)) error {
+	total := 0
+	for _, stage := range stages {
+		total += len(stage)
+	}
+
+	step := 0
+	var runErr error
+	for stageIdx, stage := range stages {
+		for _, m := range stage {
+			step++
+			progress := GroupProgress{Step: step, Total: total, Stage: stageIdx + 1, Container: m.Name, Service: m.Service}
+			if runErr != nil {
+				progress.Status = ProgressSkipped
+				emit(progress)
+				continue
+			}
+
+			progress.Status = ProgressRunning
+			emit(progress)
+
+			var err error
+			switch action {
+			case GroupRestart, GroupRestartChain:
+				if err = sleepCtx(ctx, m.RestartDelay); err != nil {
+					break
+				}
+				err = s.RestartContainer(ctx, m.Name)
+			case GroupStop:
+				err = s.StopContainer(ctx, m.Name)
+			default:
+				err = fmt.Errorf("unsupported group action %q", action)
+			}
+			if err != nil {
+				runErr = err
+				progress.Status = ProgressFailed
+				progress.Error = err.Error()
+				emit(progress)
+				continue
+			}
+			progress.Status = ProgressDone
+			emit(progress)
+		}
+
+		if runErr != nil || action == GroupStop || stageIdx == len(stages)-1 {
+			continue
+		}
+		for _, m := range stage {
+			if err := s.waitReady(ctx, m.Name); err != nil {
+				runErr = err
+				emit(GroupProgress{Step: step, Total: total, Stage: stageIdx + 1, Container: m.Name, Service: m.Service, Status: ProgressFailed, Error: err.Error()})
+				break
+			}
+		}
+	}
+	return runErr
+}
+
+// sleepCtx: d만큼 대기 (컨텍스트 취소 시 중단)
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait before restart: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// waitReady: 컨테이너가 running이고 헬스체크가 있다면 healthy가 될 때까지 대기
+func (s *Service) waitReady(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		inspect, err := s.client.ContainerInspect(ctx, name)
+		if err == nil && inspect.ContainerJSONBase != nil && inspect.State != nil && inspect.State.Running {
+			if inspect.State.Health == nil || inspect.State.Health.Status == container.Healthy {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.Warn("container not ready after restart", slog.String("container", name))
+			return fmt.Errorf("container %s not ready within %s", name, readyTimeout)
+		case <-ticker.C:
+		}
+	}
+}
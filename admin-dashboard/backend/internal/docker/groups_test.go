@@ -0,0 +1,111 @@
+package docker
+
+import (
+	"slices"
+	"testing"
+)
+
+func stageNames(stages [][]GroupMember) [][]string {
+	names := make([][]string, 0, len(stages))
+	for _, stage := range stages {
+		var row []string
+		for _, m := range stage {
+			row = append(row, m.Name)
+		}
+		names = append(names, row)
+	}
+	return names
+}
+
+func TestParseDependsOn(t *testing.T) {
+	got := parseDependsOn("valkey-cache:service_healthy:false, mcp-llm-server:service_started:true,")
+	want := []string{"valkey-cache", "mcp-llm-server"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("parseDependsOn = %v, want %v", got, want)
+	}
+	if deps := parseDependsOn(""); deps != nil {
+		t.Fatalf("expected no deps, got %v", deps)
+	}
+}
+
+func TestOrderGroup_DependsOnLabels(t *testing.T) {
+	members := []GroupMember{
+		{Name: "twentyq-bot", Service: "twentyq", DependsOn: []string{"mcp-llm-server", "valkey-cache"}},
+		{Name: "mcp-llm-server", Service: "mcp-llm-server", DependsOn: []string{"valkey-cache"}},
+		{Name: "turtle-soup-bot", Service: "turtle-soup", DependsOn: []string{"mcp-llm-server"}},
+		{Name: "valkey-cache", Service: "valkey-cache"},
+		{Name: "hololive-bot", Service: "hololive", DependsOn: []string{"external-db"}}, // 그룹 밖 의존성은 무시
+	}
+
+	got := stageNames(OrderGroup(members))
+	want := [][]string{
+		{"valkey-cache"},
+		{"mcp-llm-server"},
+		{"hololive-bot", "turtle-soup-bot", "twentyq-bot"},
+	}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Fatalf("OrderGroup = %v, want %v", got, want)
+	}
+
+	// 중지는 역순
+	stop := stageNames(actionStages(GroupStop, members))
+	if stop[0][0] != "hololive-bot" || stop[len(stop)-1][0] != "valkey-cache" {
+		t.Fatalf("stop order = %v", stop)
+	}
+}
+
+func TestOrderGroup_FallbackTierAndCycle(t *testing.T) {
+	// 라벨이 없으면 저장소 → LLM 서버 → 봇 순, 순환 의존은 무시
+	members := []GroupMember{
+		{Name: "a-bot", Service: "a", DependsOn: []string{"b"}},
+		{Name: "b-bot", Service: "b", DependsOn: []string{"a"}},
+		{Name: "mcp-llm-server", Service: "mcp-llm"},
+		{Name: "postgres", Service: "postgres"},
+	}
+
+	got := OrderGroup(members)
+	if len(got) < 3 {
+		t.Fatalf("expected at least 3 stages, got %v", stageNames(got))
+	}
+	if got[0][0].Name != "postgres" || got[1][0].Name != "mcp-llm-server" {
+		t.Fatalf("unexpected order: %v", stageNames(got))
+	}
+	total := 0
+	for _, stage := range got {
+		total += len(stage)
+	}
+	if total != len(members) {
+		t.Fatalf("expected all members ordered, got %v", stageNames(got))
+	}
+}
+
+func TestGroupJobSubscribe(t *testing.T) {
+	job := &GroupJob{ID: "job1", Project: "llm-bot", Action: GroupRestart, subs: make(map[chan GroupProgress]struct{})}
+	job.publish(GroupProgress{Step: 1, Total: 2, Container: "valkey-cache", Status: ProgressDone})
+
+	history, events, unsubscribe := job.Subscribe()
+	defer unsubscribe()
+	if len(history) != 1 || history[0].JobID != "job1" || history[0].Project != "llm-bot" {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+
+	job.publish(GroupProgress{Step: 2, Total: 2, Container: "twentyq-bot", Status: ProgressDone})
+	job.publish(GroupProgress{Step: 2, Total: 2, Status: ProgressCompleted, Final: true})
+
+	var received []GroupProgress
+	for progress := range events {
+		received = append(received, progress)
+	}
+	if len(received) != 2 || !received[1].Final {
+		t.Fatalf("expected 2 events ending with final, got %+v", received)
+	}
+
+	// 종료된 작업은 이력만 반환하고 채널은 닫혀 있어야 함
+	history, events, _ = job.Subscribe()
+	if len(history) != 3 {
+		t.Fatalf("expected full history, got %d", len(history))
+	}
+	if _, ok := <-events; ok {
+		t.Fatal("expected closed channel for finished job")
+	}
+}
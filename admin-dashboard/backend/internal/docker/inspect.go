@@ -0,0 +1,219 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+)
+
+// ErrContainerNotFound: 이름에 해당하는 컨테이너가 없음
+var ErrContainerNotFound = errors.New("container not found")
+
+// EnvSource: 환경 변수 값의 출처 (이미지 기본값과의 비교 결과)
+type EnvSource string
+
+// EnvSource 상수 목록.
+const (
+	// EnvFromImage: 이미지 기본값을 그대로 사용
+	EnvFromImage EnvSource = "image"
+	// EnvOverridden: 이미지에 있는 키를 컨테이너 설정이 다른 값으로 덮어씀
+	EnvOverridden EnvSource = "override"
+	// EnvAdded: 이미지에 없는 키를 컨테이너 설정이 추가
+	EnvAdded EnvSource = "container"
+)
+
+const (
+	// inspectHealthLogLimit: 응답에 포함하는 최근 헬스체크 결과 수
+	inspectHealthLogLimit = 5
+	// inspectHealthOutputLimit: 헬스체크 출력 최대 길이
+	inspectHealthOutputLimit = 500
+)
+
+// EnvVar: 컨테이너 환경 변수 (민감한 키는 값 대신 Masked만 표시)
+type EnvVar struct {
+	Key    string    `json:"key"`
+	Value  string    `json:"value,omitempty"`
+	Masked bool      `json:"masked,omitempty"`
+	Source EnvSource `json:"source"`
+}
+
+// RestartPolicyInfo: 재시작 정책
+type RestartPolicyInfo struct {
+	Name              string `json:"name"`
+	MaximumRetryCount int    `json:"maximumRetryCount,omitempty"`
+}
+
+// StateInfo: 컨테이너 실행 상태
+type StateInfo struct {
+	Status       string      `json:"status"`
+	Running      bool        `json:"running"`
+	ExitCode     int         `json:"exitCode"`
+	OOMKilled    bool        `json:"oomKilled"`
+	Error        string      `json:"error,omitempty"`
+	StartedAt    time.Time   `json:"startedAt,omitempty"`
+	FinishedAt   time.Time   `json:"finishedAt,omitempty"`
+	RestartCount int         `json:"restartCount"`
+	Health       *HealthInfo `json:"health,omitempty"` // 헬스체크가 없는 컨테이너는 nil
+}
+
+// HealthInfo: 헬스체크 상태와 최근 결과
+type HealthInfo struct {
+	Status        string        `json:"status"`
+	FailingStreak int           `json:"failingStreak"`
+	Log           []HealthProbe `json:"log"`
+}
+
+// HealthProbe: 헬스체크 1회 결과 (최신순)
+type HealthProbe struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	ExitCode int       `json:"exitCode"`
+	Output   string    `json:"output"`
+}
+
+// ContainerInspect: 디버깅용으로 정리한 컨테이너 설정 (민감한 환경 변수 값은 가림)
+type ContainerInspect struct {
+	Name          string            `json:"name"`
+	ID            string            `json:"id"`
+	Image         string            `json:"image"`
+	ImageID       string            `json:"imageId"`
+	Created       time.Time         `json:"created,omitempty"`
+	Env           []EnvVar          `json:"env"`
+	Mounts        []MountConfig     `json:"mounts"`
+	RestartPolicy RestartPolicyInfo `json:"restartPolicy"`
+	State         StateInfo         `json:"state"`
+	NetworkMode   string            `json:"networkMode"`
+	Networks      []string          `json:"networks"`
+	Labels        map[string]string `json:"labels"`
+}
+
+// InspectContainer: 컨테이너 설정을 정리해 반환합니다. 환경 변수는 이미지 기본값과 비교한 출처를 함께 표시하고,
+// sensitive가 true를 반환하는 키는 값을 가립니다. (관리 대상 여부는 호출자가 확인)
+func (s *Service) InspectContainer(ctx context.Context, name string, sensitive func(key string) bool) (ContainerInspect, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	inspect, err := s.client.ContainerInspect(ctx, name)
+	if err != nil {
+		if cerrdefs.IsNotFound(err) {
+			return ContainerInspect{}, ErrContainerNotFound
+		}
+		return ContainerInspect{}, fmt.Errorf("inspect container %s: %w", name, err)
+	}
+	if inspect.ContainerJSONBase == nil || inspect.Config == nil {
+		return ContainerInspect{}, fmt.Errorf("inspect container %s: config unavailable", name)
+	}
+
+	// 이미지가 삭제되었거나 조회에 실패하면 기본값 없이 모두 컨테이너 설정으로 표시
+	var imageEnv []string
+	if img, err := s.client.ImageInspect(ctx, inspect.Image); err == nil && img.Config != nil {
+		imageEnv = img.Config.Env
+	}
+
+	summary := toContainerConfig(name, &inspect)
+	result := ContainerInspect{
+		Name:        name,
+		ID:          inspect.ID[:12],
+		Image:       summary.Image,
+		ImageID:     summary.ImageID,
+		Created:     parseDockerTime(inspect.Created),
+		Env:         DiffEnv(inspect.Config.Env, imageEnv, sensitive),
+		Mounts:      summary.Mounts,
+		NetworkMode: summary.NetworkMode,
+		Networks:    summary.Networks,
+		Labels:      inspect.Config.Labels,
+	}
+	if result.Mounts == nil {
+		result.Mounts = []MountConfig{}
+	}
+	if result.Networks == nil {
+		result.Networks = []string{}
+	}
+	if inspect.HostConfig != nil {
+		result.RestartPolicy = RestartPolicyInfo{
+			Name:              string(inspect.HostConfig.RestartPolicy.Name),
+			MaximumRetryCount: inspect.HostConfig.RestartPolicy.MaximumRetryCount,
+		}
+	}
+	result.State.RestartCount = inspect.RestartCount
+	if inspect.State != nil {
+		result.State = toStateInfo(inspect.State, inspect.RestartCount)
+	}
+	return result, nil
+}
+
+// DiffEnv: 컨테이너 환경 변수를 이미지 기본값과 비교해 키 순으로 반환합니다.
+// sensitive가 true를 반환하는 키는 값을 비우고 Masked로 표시합니다. (출처 판정은 원래 값으로 함)
+func DiffEnv(containerEnv, imageEnv []string, sensitive func(key string) bool) []EnvVar {
+	defaults := make(map[string]string, len(imageEnv))
+	for _, kv := range imageEnv {
+		key, value, _ := strings.Cut(kv, "=")
+		defaults[key] = value
+	}
+
+	result := make([]EnvVar, 0, len(containerEnv))
+	for _, kv := range containerEnv {
+		key, value, _ := strings.Cut(kv, "=")
+		env := EnvVar{Key: key, Value: value, Source: EnvAdded}
+		if imageValue, ok := defaults[key]; ok {
+			env.Source = EnvOverridden
+			if imageValue == value {
+				env.Source = EnvFromImage
+			}
+		}
+		if sensitive != nil && sensitive(key) {
+			env.Value = ""
+			env.Masked = true
+		}
+		result = append(result, env)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+func toStateInfo(state *container.State, restartCount int) StateInfo {
+	info := StateInfo{
+		Status:       string(state.Status),
+		Running:      state.Running,
+		ExitCode:     state.ExitCode,
+		OOMKilled:    state.OOMKilled,
+		Error:        state.Error,
+		StartedAt:    parseDockerTime(state.StartedAt),
+		FinishedAt:   parseDockerTime(state.FinishedAt),
+		RestartCount: restartCount,
+	}
+	if state.Health == nil {
+		return info
+	}
+
+	health := &HealthInfo{
+		Status:        string(state.Health.Status),
+		FailingStreak: state.Health.FailingStreak,
+		Log:           []HealthProbe{},
+	}
+	// Docker는 오래된 순으로 주므로 뒤에서부터 최근 결과만 담음
+	for i := len(state.Health.Log) - 1; i >= 0 && len(health.Log) < inspectHealthLogLimit; i-- {
+		probe := state.Health.Log[i]
+		if probe == nil {
+			continue
+		}
+		output := strings.TrimSpace(probe.Output)
+		if len(output) > inspectHealthOutputLimit {
+			output = output[:inspectHealthOutputLimit]
+		}
+		health.Log = append(health.Log, HealthProbe{
+			Start:    probe.Start,
+			End:      probe.End,
+			ExitCode: probe.ExitCode,
+			Output:   output,
+		})
+	}
+	info.Health = health
+	return info
+}
@@ -0,0 +1,90 @@
+package docker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestDiffEnv(t *testing.T) {
+	t.Parallel()
+
+	imageEnv := []string{"PATH=/usr/local/bin:/usr/bin", "LANG=C.UTF-8", "DB_PASSWORD=default"}
+	containerEnv := []string{
+		"PATH=/usr/local/bin:/usr/bin",
+		"LANG=ko_KR.UTF-8",
+		"DB_PASSWORD=s3cret",
+		"API_TOKEN=abc",
+		"LOG_LEVEL=debug",
+		"EMPTY=",
+	}
+	sensitive := func(key string) bool {
+		return strings.Contains(key, "PASSWORD") || strings.Contains(key, "TOKEN")
+	}
+
+	got := DiffEnv(containerEnv, imageEnv, sensitive)
+	want := []EnvVar{
+		{Key: "API_TOKEN", Masked: true, Source: EnvAdded},
+		{Key: "DB_PASSWORD", Masked: true, Source: EnvOverridden},
+		{Key: "EMPTY", Source: EnvAdded},
+		{Key: "LANG", Value: "ko_KR.UTF-8", Source: EnvOverridden},
+		{Key: "LOG_LEVEL", Value: "debug", Source: EnvAdded},
+		{Key: "PATH", Value: "/usr/local/bin:/usr/bin", Source: EnvFromImage},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d env vars, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("env[%d]: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestDiffEnv_WithoutImageDefaults(t *testing.T) {
+	t.Parallel()
+
+	got := DiffEnv([]string{"A=1"}, nil, nil)
+	if len(got) != 1 || got[0].Source != EnvAdded || got[0].Value != "1" {
+		t.Fatalf("expected container-only env, got %+v", got)
+	}
+}
+
+func TestToStateInfo_KeepsRecentHealthProbes(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	var log []*container.HealthcheckResult
+	for i := range 7 {
+		log = append(log, &container.HealthcheckResult{
+			Start:    base.Add(time.Duration(i) * time.Minute),
+			ExitCode: i % 2,
+			Output:   "  probe " + strings.Repeat("x", 600) + "  ",
+		})
+	}
+	state := &container.State{
+		Status:    "running",
+		Running:   true,
+		StartedAt: "2026-10-18T11:00:00Z",
+		Health:    &container.Health{Status: "unhealthy", FailingStreak: 3, Log: log},
+	}
+
+	info := toStateInfo(state, 2)
+	if info.RestartCount != 2 || !info.Running || info.StartedAt.IsZero() || !info.FinishedAt.IsZero() {
+		t.Fatalf("unexpected state: %+v", info)
+	}
+	if info.Health == nil || info.Health.Status != "unhealthy" || info.Health.FailingStreak != 3 {
+		t.Fatalf("unexpected health: %+v", info.Health)
+	}
+	if len(info.Health.Log) != inspectHealthLogLimit {
+		t.Fatalf("expected %d probes, got %d", inspectHealthLogLimit, len(info.Health.Log))
+	}
+	if !info.Health.Log[0].Start.Equal(base.Add(6 * time.Minute)) {
+		t.Fatalf("expected newest probe first, got %s", info.Health.Log[0].Start)
+	}
+	if out := info.Health.Log[0].Output; len(out) != inspectHealthOutputLimit || !strings.HasPrefix(out, "probe") {
+		t.Fatalf("expected trimmed and truncated output, got %d chars", len(out))
+	}
+}
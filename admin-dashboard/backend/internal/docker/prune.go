@@ -0,0 +1,274 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// composeOneoffLabel: docker compose run으로 띄운 일회성 컨테이너에 붙는 라벨
+const composeOneoffLabel = "com.docker.compose.oneoff"
+
+// DefaultPruneLabels: 정리 대상 기본 라벨 (llm-bot 프로젝트의 compose run 컨테이너)
+var DefaultPruneLabels = []string{composeOneoffLabel + "=True", ComposeProjectLabel + "=llm-bot"}
+
+// DefaultPruneMinAge: 종료 후 이 시간이 지난 컨테이너만 정리
+const DefaultPruneMinAge = 24 * time.Hour
+
+// ErrPruneBusy: 다른 정리 작업이 진행 중
+var ErrPruneBusy = errors.New("container prune already in progress")
+
+// PrunePolicy: 종료된 일회성 컨테이너 정리 정책
+type PrunePolicy struct {
+	// Labels: 모두 일치해야 정리 대상 ("key" 또는 "key=value")
+	Labels []string `json:"labels"`
+	// MinAge: 종료(FinishedAt) 후 경과 시간 하한
+	MinAge time.Duration `json:"-"`
+}
+
+// ParsePruneLabels: "key=value,key2" 형식의 라벨 목록을 파싱합니다. (비어 있으면 기본 라벨)
+func ParsePruneLabels(spec string) []string {
+	var labels []string
+	for label := range strings.SplitSeq(spec, ",") {
+		label = strings.TrimSpace(label)
+		if label != "" && !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	if len(labels) == 0 {
+		return slices.Clone(DefaultPruneLabels)
+	}
+	return labels
+}
+
+// PrunedContainer: 정리한(또는 dry run에서 정리 대상인) 컨테이너
+type PrunedContainer struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Image      string    `json:"image"`
+	ExitCode   int       `json:"exitCode"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Error      string    `json:"error,omitempty"` // 삭제 실패 사유
+}
+
+// PruneResult: 정리 작업 결과
+type PruneResult struct {
+	DryRun    bool              `json:"dryRun"`
+	Labels    []string          `json:"labels"`
+	MinAge    string            `json:"minAge"`
+	Checked   int               `json:"checked"` // 라벨이 일치한 종료 컨테이너 수
+	Removed   []PrunedContainer `json:"removed"`
+	Failed    []PrunedContainer `json:"failed,omitempty"`
+	StartedAt time.Time         `json:"startedAt"`
+}
+
+// AuditSummary: 감사 로그용 대상(삭제한 컨테이너 이름 목록)과 결과 요약(JSON)을 반환합니다.
+func (r PruneResult) AuditSummary() (string, string) {
+	names := make([]string, 0, len(r.Removed))
+	for _, c := range r.Removed {
+		names = append(names, c.Name)
+	}
+	failed := make([]string, 0, len(r.Failed))
+	for _, c := range r.Failed {
+		failed = append(failed, c.Name)
+	}
+	summary, err := json.Marshal(map[string]any{
+		"dryRun":  r.DryRun,
+		"checked": r.Checked,
+		"removed": names,
+		"failed":  failed,
+	})
+	if err != nil {
+		return strings.Join(names, ","), ""
+	}
+	return strings.Join(names, ","), string(summary)
+}
+
+// pruneState: 정리 정책과 동시 실행 방지 (예약 실행과 수동 실행이 겹치지 않도록)
+type pruneState struct {
+	mu      sync.Mutex
+	running bool
+	policy  PrunePolicy
+}
+
+// SetPrunePolicy: 정리 정책을 설정합니다. (MinAge가 0 이하면 기본값)
+func (s *Service) SetPrunePolicy(policy PrunePolicy) {
+	if len(policy.Labels) == 0 {
+		policy.Labels = slices.Clone(DefaultPruneLabels)
+	}
+	if policy.MinAge <= 0 {
+		policy.MinAge = DefaultPruneMinAge
+	}
+	s.prune.mu.Lock()
+	s.prune.policy = policy
+	s.prune.mu.Unlock()
+}
+
+// PrunePolicy: 현재 정리 정책
+func (s *Service) PrunePolicy() PrunePolicy {
+	s.prune.mu.Lock()
+	defer s.prune.mu.Unlock()
+	return s.prune.policy
+}
+
+// PruneStaleContainers: 정책 라벨이 모두 붙은 종료(exited/dead/created) 컨테이너 중 MinAge가 지난 것을 삭제합니다.
+// dryRun이면 삭제하지 않고 대상만 반환합니다. 실행 중인 컨테이너는 라벨과 무관하게 건드리지 않습니다.
+func (s *Service) PruneStaleContainers(ctx context.Context, dryRun bool) (PruneResult, error) {
+	s.prune.mu.Lock()
+	if s.prune.running {
+		s.prune.mu.Unlock()
+		return PruneResult{}, ErrPruneBusy
+	}
+	s.prune.running = true
+	policy := s.prune.policy
+	s.prune.mu.Unlock()
+	defer func() {
+		s.prune.mu.Lock()
+		s.prune.running = false
+		s.prune.mu.Unlock()
+	}()
+
+	now := time.Now()
+	result := PruneResult{
+		DryRun:    dryRun,
+		Labels:    policy.Labels,
+		MinAge:    policy.MinAge.String(),
+		Removed:   []PrunedContainer{},
+		StartedAt: now,
+	}
+
+	candidates, err := s.listPruneCandidates(ctx, policy.Labels)
+	if err != nil {
+		return result, err
+	}
+	result.Checked = len(candidates)
+
+	for _, candidate := range SelectStale(candidates, policy.MinAge, now) {
+		if dryRun {
+			result.Removed = append(result.Removed, candidate)
+			continue
+		}
+		removeCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		err := s.client.ContainerRemove(removeCtx, candidate.ID, container.RemoveOptions{})
+		cancel()
+		if err != nil {
+			candidate.Error = err.Error()
+			result.Failed = append(result.Failed, candidate)
+			s.logger.Warn("stale container remove failed",
+				slog.String("container", candidate.Name),
+				slog.String("error", err.Error()))
+			continue
+		}
+		result.Removed = append(result.Removed, candidate)
+	}
+
+	if !dryRun && (len(result.Removed) > 0 || len(result.Failed) > 0) {
+		s.logger.Info("stale containers pruned",
+			slog.Int("removed", len(result.Removed)),
+			slog.Int("failed", len(result.Failed)))
+	}
+	return result, nil
+}
+
+// listPruneCandidates: 라벨이 일치하는 종료 컨테이너를 찾아 종료 시각과 함께 반환합니다.
+func (s *Service) listPruneCandidates(ctx context.Context, labels []string) ([]PrunedContainer, error) {
+	listCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	args := filters.NewArgs(
+		filters.Arg("status", "exited"),
+		filters.Arg("status", "dead"),
+		filters.Arg("status", "created"),
+	)
+	for _, label := range labels {
+		args.Add("label", label)
+	}
+	containers, err := s.client.ContainerList(listCtx, container.ListOptions{All: true, Filters: args})
+	if err != nil {
+		return nil, fmt.Errorf("list stale containers: %w", err)
+	}
+
+	candidates := make([]PrunedContainer, 0, len(containers))
+	for i := range containers {
+		c := &containers[i]
+		candidate := PrunedContainer{
+			ID:    c.ID[:12],
+			Name:  strings.TrimPrefix(c.Names[0], "/"),
+			Image: c.Image,
+		}
+
+		inspect, err := s.client.ContainerInspect(listCtx, c.ID)
+		if err != nil {
+			// 목록 조회 후 이미 삭제된 경우 등: 다음 실행에서 다시 확인
+			s.logger.Debug("stale container inspect failed", slog.String("container", candidate.Name), slog.String("error", err.Error()))
+			continue
+		}
+		if inspect.ContainerJSONBase != nil && inspect.State != nil {
+			candidate.ExitCode = inspect.State.ExitCode
+			candidate.FinishedAt = parseDockerTime(inspect.State.FinishedAt)
+		}
+		if candidate.FinishedAt.IsZero() {
+			// 한 번도 실행되지 않은(created) 컨테이너는 생성 시각 기준
+			candidate.FinishedAt = time.Unix(c.Created, 0)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// SelectStale: 종료 후 minAge가 지난 컨테이너를 오래된 순으로 반환합니다.
+func SelectStale(candidates []PrunedContainer, minAge time.Duration, now time.Time) []PrunedContainer {
+	stale := make([]PrunedContainer, 0, len(candidates))
+	for _, c := range candidates {
+		if c.FinishedAt.IsZero() || now.Sub(c.FinishedAt) < minAge {
+			continue
+		}
+		stale = append(stale, c)
+	}
+	slices.SortFunc(stale, func(a, b PrunedContainer) int { return a.FinishedAt.Compare(b.FinishedAt) })
+	return stale
+}
+
+// RunStalePrune: interval마다 정리를 실행하고 결과를 report로 넘깁니다. (ctx 종료 시 반환)
+// 아무것도 삭제하지 않은 실행은 report를 호출하지 않습니다.
+func (s *Service) RunStalePrune(ctx context.Context, interval time.Duration, report func(context.Context, PruneResult)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result, err := s.PruneStaleContainers(ctx, false)
+		if err != nil {
+			if ctx.Err() == nil && !errors.Is(err, ErrPruneBusy) {
+				s.logger.Warn("stale container prune failed", slog.String("error", err.Error()))
+			}
+			continue
+		}
+		if report != nil && (len(result.Removed) > 0 || len(result.Failed) > 0) {
+			report(ctx, result)
+		}
+	}
+}
+
+// parseDockerTime: Docker API 시각 문자열 파싱 (0001-01-01 등 미설정 값은 zero)
+func parseDockerTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil || t.Year() <= 1 {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,68 @@
+package docker
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParsePruneLabels(t *testing.T) {
+	t.Parallel()
+
+	if got := ParsePruneLabels(" "); !slices.Equal(got, DefaultPruneLabels) {
+		t.Fatalf("expected default labels, got %v", got)
+	}
+	got := ParsePruneLabels("tool=oneoff, com.docker.compose.project=llm-bot,tool=oneoff")
+	want := []string{"tool=oneoff", "com.docker.compose.project=llm-bot"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSelectStale(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	candidates := []PrunedContainer{
+		{Name: "recent", FinishedAt: now.Add(-time.Hour)},
+		{Name: "old", FinishedAt: now.Add(-48 * time.Hour)},
+		{Name: "older", FinishedAt: now.Add(-72 * time.Hour)},
+		{Name: "unknown"},
+	}
+
+	stale := SelectStale(candidates, 24*time.Hour, now)
+	names := make([]string, 0, len(stale))
+	for _, c := range stale {
+		names = append(names, c.Name)
+	}
+	if !slices.Equal(names, []string{"older", "old"}) {
+		t.Fatalf("expected oldest first without recent/unknown, got %v", names)
+	}
+}
+
+func TestPruneResultAuditSummary(t *testing.T) {
+	t.Parallel()
+
+	result := PruneResult{
+		Checked: 3,
+		Removed: []PrunedContainer{{Name: "llm-bot-fetcher-run-1"}, {Name: "llm-bot-warmer-run-2"}},
+		Failed:  []PrunedContainer{{Name: "llm-bot-fetcher-run-3", Error: "conflict"}},
+	}
+	target, summary := result.AuditSummary()
+	if target != "llm-bot-fetcher-run-1,llm-bot-warmer-run-2" {
+		t.Fatalf("unexpected target %q", target)
+	}
+
+	var decoded struct {
+		Checked int      `json:"checked"`
+		Removed []string `json:"removed"`
+		Failed  []string `json:"failed"`
+	}
+	if err := json.Unmarshal([]byte(summary), &decoded); err != nil {
+		t.Fatalf("summary is not json: %v", err)
+	}
+	if decoded.Checked != 3 || len(decoded.Removed) != 2 || len(decoded.Failed) != 1 {
+		t.Fatalf("unexpected summary %s", summary)
+	}
+}
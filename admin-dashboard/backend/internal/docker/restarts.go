@@ -0,0 +1,38 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// RestartCounts: 관리 대상 컨테이너별 누적 재시작 횟수를 조회합니다. (재시작 루프 감지용)
+// 조회 중 사라진 컨테이너는 건너뜁니다.
+func (s *Service) RestartCounts(ctx context.Context) (map[string]int, error) {
+	containers, err := s.ListContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	counts := make(map[string]int, len(containers))
+	for _, c := range containers {
+		inspect, err := s.client.ContainerInspect(ctx, c.Name)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("inspect container %s: %w", c.Name, err)
+			}
+			s.logger.Warn("container inspect failed",
+				slog.String("container", c.Name),
+				slog.String("error", err.Error()))
+			continue
+		}
+		if inspect.ContainerJSONBase != nil {
+			counts[c.Name] = inspect.RestartCount
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,119 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// DefaultStatsInterval: 컨테이너 리소스 샘플 전송 주기
+const DefaultStatsInterval = 2 * time.Second
+
+// Stats: 컨테이너 리소스 사용량 샘플
+type Stats struct {
+	Timestamp     time.Time `json:"timestamp"`
+	CPUPercent    float64   `json:"cpuPercent"`
+	OnlineCPUs    uint32    `json:"onlineCpus"`
+	MemoryUsage   uint64    `json:"memoryUsage"`
+	MemoryLimit   uint64    `json:"memoryLimit"`
+	MemoryPercent float64   `json:"memoryPercent"`
+	NetworkRx     uint64    `json:"networkRx"`
+	NetworkTx     uint64    `json:"networkTx"`
+	PIDs          uint64    `json:"pids"`
+}
+
+// StatsStream: 컨테이너 리소스 통계 스트림
+// Docker stats API(약 1초 주기)를 구독해 interval마다 최신 샘플 하나를 채널로 전달합니다.
+// ctx 취소 또는 스트림 종료 시 채널이 닫힙니다.
+func (s *Service) StatsStream(ctx context.Context, name string, interval time.Duration) (<-chan Stats, error) {
+	if interval <= 0 {
+		interval = DefaultStatsInterval
+	}
+
+	resp, err := s.client.ContainerStats(ctx, name, true)
+	if err != nil {
+		return nil, fmt.Errorf("container %s stats: %w", name, err)
+	}
+
+	out := make(chan Stats, 1)
+	go func() {
+		defer close(out)
+		defer func() { _ = resp.Body.Close() }()
+
+		decoder := json.NewDecoder(resp.Body)
+		var lastSent time.Time
+		for {
+			var raw container.StatsResponse
+			if err := decoder.Decode(&raw); err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					s.logger.Debug("container stats stream ended",
+						slog.String("container", name),
+						slog.String("error", err.Error()))
+				}
+				return
+			}
+
+			sample := computeStats(&raw)
+			if !lastSent.IsZero() && sample.Timestamp.Sub(lastSent) < interval {
+				continue
+			}
+			lastSent = sample.Timestamp
+
+			select {
+			case out <- sample:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// computeStats: Docker stats 응답을 샘플로 변환 (docker stats CLI와 동일한 계산식)
+func computeStats(raw *container.StatsResponse) Stats {
+	sample := Stats{
+		Timestamp:   raw.Read,
+		OnlineCPUs:  raw.CPUStats.OnlineCPUs,
+		MemoryLimit: raw.MemoryStats.Limit,
+		PIDs:        raw.PidsStats.Current,
+	}
+	if sample.Timestamp.IsZero() {
+		sample.Timestamp = time.Now()
+	}
+
+	// CPU: 직전 샘플 대비 컨테이너 사용량 / 시스템 사용량 × 온라인 CPU 수
+	if sample.OnlineCPUs == 0 {
+		sample.OnlineCPUs = uint32(len(raw.CPUStats.CPUUsage.PercpuUsage))
+	}
+	cpuDelta := float64(raw.CPUStats.CPUUsage.TotalUsage) - float64(raw.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(raw.CPUStats.SystemUsage) - float64(raw.PreCPUStats.SystemUsage)
+	if cpuDelta > 0 && systemDelta > 0 {
+		sample.CPUPercent = cpuDelta / systemDelta * float64(sample.OnlineCPUs) * 100
+	}
+
+	// 메모리: 페이지 캐시 제외 (cgroup v2는 inactive_file, v1은 total_inactive_file)
+	usage := raw.MemoryStats.Usage
+	cache, ok := raw.MemoryStats.Stats["inactive_file"]
+	if !ok {
+		cache = raw.MemoryStats.Stats["total_inactive_file"]
+	}
+	if cache < usage {
+		usage -= cache
+	}
+	sample.MemoryUsage = usage
+	if sample.MemoryLimit > 0 {
+		sample.MemoryPercent = float64(usage) / float64(sample.MemoryLimit) * 100
+	}
+
+	for _, nw := range raw.Networks {
+		sample.NetworkRx += nw.RxBytes
+		sample.NetworkTx += nw.TxBytes
+	}
+	return sample
+}
@@ -0,0 +1,78 @@
+package docker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestComputeStats(t *testing.T) {
+	read := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	raw := &container.StatsResponse{
+		Read: read,
+		CPUStats: container.CPUStats{
+			CPUUsage:    container.CPUUsage{TotalUsage: 3_000},
+			SystemUsage: 20_000,
+			OnlineCPUs:  4,
+		},
+		PreCPUStats: container.CPUStats{
+			CPUUsage:    container.CPUUsage{TotalUsage: 1_000},
+			SystemUsage: 10_000,
+		},
+		MemoryStats: container.MemoryStats{
+			Usage: 600,
+			Limit: 1_000,
+			Stats: map[string]uint64{"inactive_file": 100},
+		},
+		Networks: map[string]container.NetworkStats{
+			"eth0": {RxBytes: 10, TxBytes: 20},
+			"eth1": {RxBytes: 1, TxBytes: 2},
+		},
+		PidsStats: container.PidsStats{Current: 7},
+	}
+
+	got := computeStats(raw)
+	if !got.Timestamp.Equal(read) {
+		t.Fatalf("timestamp = %v, want %v", got.Timestamp, read)
+	}
+	// (2000 / 10000) * 4 * 100 = 80
+	if math.Abs(got.CPUPercent-80) > 1e-9 {
+		t.Fatalf("cpu percent = %v, want 80", got.CPUPercent)
+	}
+	if got.MemoryUsage != 500 || math.Abs(got.MemoryPercent-50) > 1e-9 {
+		t.Fatalf("memory = %d (%v%%), want 500 (50%%)", got.MemoryUsage, got.MemoryPercent)
+	}
+	if got.NetworkRx != 11 || got.NetworkTx != 22 {
+		t.Fatalf("network = rx %d tx %d, want rx 11 tx 22", got.NetworkRx, got.NetworkTx)
+	}
+	if got.PIDs != 7 {
+		t.Fatalf("pids = %d, want 7", got.PIDs)
+	}
+}
+
+func TestComputeStats_FirstFrameHasNoCPU(t *testing.T) {
+	// 첫 프레임은 precpu가 비어 있어 시스템 델타만 존재 → CPU는 0이 아닌 값이 나오면 안 됨
+	raw := &container.StatsResponse{
+		CPUStats: container.CPUStats{
+			CPUUsage:    container.CPUUsage{TotalUsage: 0, PercpuUsage: []uint64{1, 2}},
+			SystemUsage: 10_000,
+		},
+		MemoryStats: container.MemoryStats{Usage: 100, Stats: map[string]uint64{"total_inactive_file": 300}},
+	}
+
+	got := computeStats(raw)
+	if got.CPUPercent != 0 {
+		t.Fatalf("cpu percent = %v, want 0", got.CPUPercent)
+	}
+	if got.OnlineCPUs != 2 {
+		t.Fatalf("online cpus = %d, want fallback 2", got.OnlineCPUs)
+	}
+	if got.MemoryUsage != 100 || got.MemoryPercent != 0 {
+		t.Fatalf("memory = %d (%v%%), want 100 (0%%)", got.MemoryUsage, got.MemoryPercent)
+	}
+	if got.Timestamp.IsZero() {
+		t.Fatal("timestamp should default to now")
+	}
+}
@@ -0,0 +1,414 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+)
+
+// ErrUpdateBusy: 같은 컨테이너의 이미지 업데이트가 이미 진행 중
+var ErrUpdateBusy = errors.New("container update already in progress")
+
+const (
+	// updateCheckTimeout: 레지스트리 digest 조회 1건당 제한 시간
+	updateCheckTimeout = 15 * time.Second
+	// imagePullTimeout: 이미지 pull 제한 시간
+	imagePullTimeout = 10 * time.Minute
+	// oldContainerSuffix: 재생성 중 기존 컨테이너에 임시로 붙이는 이름 접미사 (실패 시 원래 이름으로 복구)
+	oldContainerSuffix = "-update-old"
+)
+
+// ImageUpdate: 실행 중인 컨테이너 이미지와 레지스트리 최신 이미지 비교 결과
+type ImageUpdate struct {
+	Name          string `json:"name"`
+	Image         string `json:"image"`
+	CurrentDigest string `json:"currentDigest,omitempty"`
+	LatestDigest  string `json:"latestDigest,omitempty"`
+	Outdated      bool   `json:"outdated"`
+	Error         string `json:"error,omitempty"` // 비교하지 못한 사유 (로컬 빌드 이미지, 레지스트리 조회 실패 등)
+}
+
+// ImageUpdateResult: 업데이트 확인 결과 (Outdated가 true인 항목만 Outdated에 모음)
+type ImageUpdateResult struct {
+	Checked   int           `json:"checked"`
+	Outdated  []ImageUpdate `json:"outdated"`
+	Skipped   []ImageUpdate `json:"skipped"`
+	CheckedAt time.Time     `json:"checkedAt"`
+}
+
+// ContainerUpdateResult: 컨테이너 이미지 업데이트 결과
+type ContainerUpdateResult struct {
+	Name        string `json:"name"`
+	Image       string `json:"image"`
+	OldImageID  string `json:"oldImageId"`
+	NewImageID  string `json:"newImageId"`
+	Updated     bool   `json:"updated"` // false면 pull 후에도 이미지가 같아 재생성하지 않음
+	ContainerID string `json:"containerId,omitempty"`
+}
+
+// AuditSummary: 감사 로그용 대상(컨테이너 이름)과 결과 요약(JSON)을 반환합니다.
+func (r ContainerUpdateResult) AuditSummary() (string, string) {
+	summary, err := json.Marshal(map[string]any{
+		"image":   r.Image,
+		"from":    r.OldImageID,
+		"to":      r.NewImageID,
+		"updated": r.Updated,
+	})
+	if err != nil {
+		return r.Name, ""
+	}
+	return r.Name, string(summary)
+}
+
+// updateState: 컨테이너별 업데이트 동시 실행 방지
+type updateState struct {
+	mu      sync.Mutex
+	running map[string]struct{}
+}
+
+func (u *updateState) acquire(name string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.running == nil {
+		u.running = make(map[string]struct{})
+	}
+	if _, ok := u.running[name]; ok {
+		return false
+	}
+	u.running[name] = struct{}{}
+	return true
+}
+
+func (u *updateState) release(name string) {
+	u.mu.Lock()
+	delete(u.running, name)
+	u.mu.Unlock()
+}
+
+// CheckImageUpdates: 실행 중인 관리 대상 컨테이너의 로컬 이미지 digest를 레지스트리의 현재 태그 digest와 비교합니다.
+// 레지스트리 조회는 익명으로 하므로 인증이 필요한 이미지는 Skipped에 사유와 함께 들어갑니다.
+func (s *Service) CheckImageUpdates(ctx context.Context) (ImageUpdateResult, error) {
+	containers, err := s.ListContainers(ctx)
+	if err != nil {
+		return ImageUpdateResult{}, err
+	}
+
+	result := ImageUpdateResult{Outdated: []ImageUpdate{}, Skipped: []ImageUpdate{}, CheckedAt: time.Now()}
+	latestByRef := make(map[string]string)
+	for _, c := range containers {
+		if c.State != "running" {
+			continue
+		}
+		update := s.checkContainerImage(ctx, c.Name, latestByRef)
+		result.Checked++
+		switch {
+		case update.Error != "":
+			result.Skipped = append(result.Skipped, update)
+		case update.Outdated:
+			result.Outdated = append(result.Outdated, update)
+		}
+	}
+	return result, nil
+}
+
+// checkContainerImage: 컨테이너 하나의 이미지 digest를 비교합니다. (latestByRef: 같은 이미지 참조의 레지스트리 조회 결과 재사용)
+func (s *Service) checkContainerImage(ctx context.Context, name string, latestByRef map[string]string) ImageUpdate {
+	update := ImageUpdate{Name: name}
+
+	inspectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	info, err := s.client.ContainerInspect(inspectCtx, name)
+	if err != nil {
+		update.Error = err.Error()
+		return update
+	}
+	if info.Config == nil || info.ContainerJSONBase == nil {
+		update.Error = "container config unavailable"
+		return update
+	}
+	ref := info.Config.Image
+	update.Image = ref
+	if strings.Contains(ref, "@") {
+		update.Error = "image pinned by digest"
+		return update
+	}
+
+	local, err := s.client.ImageInspect(inspectCtx, info.Image)
+	if err != nil {
+		update.Error = err.Error()
+		return update
+	}
+	if len(local.RepoDigests) == 0 {
+		update.Error = "locally built image (no registry digest)"
+		return update
+	}
+	update.CurrentDigest = digestOf(local.RepoDigests[0])
+
+	latest, ok := latestByRef[ref]
+	if !ok {
+		distCtx, distCancel := context.WithTimeout(ctx, updateCheckTimeout)
+		dist, err := s.client.DistributionInspect(distCtx, ref, "")
+		distCancel()
+		if err != nil {
+			update.Error = err.Error()
+			return update
+		}
+		latest = dist.Descriptor.Digest.String()
+		latestByRef[ref] = latest
+	}
+	update.LatestDigest = latest
+	update.Outdated = IsImageOutdated(local.RepoDigests, latest)
+	if !update.Outdated {
+		update.CurrentDigest = latest
+	}
+	return update
+}
+
+// IsImageOutdated: 로컬 이미지의 RepoDigests("repo@sha256:...") 중 레지스트리 digest와 같은 것이 없으면 true
+func IsImageOutdated(repoDigests []string, latestDigest string) bool {
+	if latestDigest == "" {
+		return false
+	}
+	return !slices.ContainsFunc(repoDigests, func(repoDigest string) bool {
+		return digestOf(repoDigest) == latestDigest
+	})
+}
+
+// digestOf: "repo@sha256:..."에서 digest 부분만 반환합니다.
+func digestOf(repoDigest string) string {
+	if _, digest, ok := strings.Cut(repoDigest, "@"); ok {
+		return digest
+	}
+	return repoDigest
+}
+
+// UpdateContainer: 컨테이너 이미지 태그를 다시 pull하고, 이미지가 바뀌었으면 같은 설정(환경 변수, 마운트, 네트워크, 라벨)으로
+// 컨테이너를 재생성합니다. 새 컨테이너 생성/시작에 실패하면 기존 컨테이너를 원래 이름으로 되돌려 다시 시작합니다.
+func (s *Service) UpdateContainer(ctx context.Context, name string) (ContainerUpdateResult, error) {
+	if !s.updates.acquire(name) {
+		return ContainerUpdateResult{}, ErrUpdateBusy
+	}
+	defer s.updates.release(name)
+
+	info, err := s.client.ContainerInspect(ctx, name)
+	if err != nil {
+		return ContainerUpdateResult{}, fmt.Errorf("inspect container %s: %w", name, err)
+	}
+	if info.Config == nil || info.ContainerJSONBase == nil || info.HostConfig == nil {
+		return ContainerUpdateResult{}, fmt.Errorf("inspect container %s: config unavailable", name)
+	}
+	result := ContainerUpdateResult{Name: name, Image: info.Config.Image, OldImageID: info.Image}
+
+	if err := s.pullImage(ctx, result.Image); err != nil {
+		return result, err
+	}
+	pulled, err := s.client.ImageInspect(ctx, result.Image)
+	if err != nil {
+		return result, fmt.Errorf("inspect image %s: %w", result.Image, err)
+	}
+	result.NewImageID = pulled.ID
+	if pulled.ID == info.Image {
+		s.logger.Info("container image already up to date", slog.String("container", name), slog.String("image", result.Image))
+		return result, nil
+	}
+
+	containerID, err := s.recreateContainer(ctx, info)
+	if err != nil {
+		return result, err
+	}
+	result.Updated = true
+	result.ContainerID = containerID[:12]
+	s.logger.Info("container updated",
+		slog.String("container", name),
+		slog.String("image", result.Image),
+		slog.String("from", result.OldImageID),
+		slog.String("to", result.NewImageID))
+	return result, nil
+}
+
+// pullImage: 이미지를 pull하고 진행 스트림을 끝까지 읽어 완료를 기다립니다.
+func (s *Service) pullImage(ctx context.Context, ref string) error {
+	pullCtx, cancel := context.WithTimeout(ctx, imagePullTimeout)
+	defer cancel()
+
+	s.logger.Info("pulling image", slog.String("image", ref))
+	reader, err := s.client.ImagePull(pullCtx, ref, image.PullOptions{})
+	if err != nil {
+		return fmt.Errorf("pull image %s: %w", ref, err)
+	}
+	defer reader.Close()
+
+	// pull 실패는 HTTP 상태가 아니라 스트림 중간의 error 메시지로 전달됨
+	decoder := json.NewDecoder(reader)
+	for {
+		var msg struct {
+			Error string `json:"error"`
+		}
+		if err := decoder.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("pull image %s: %w", ref, err)
+		}
+		if msg.Error != "" {
+			return fmt.Errorf("pull image %s: %s", ref, msg.Error)
+		}
+	}
+}
+
+// recreateContainer: 기존 컨테이너를 중지/이름 변경한 뒤 같은 설정으로 새 컨테이너를 만들고 시작합니다.
+// 성공하면 기존 컨테이너를 삭제하고 새 컨테이너 ID를 반환합니다.
+func (s *Service) recreateContainer(ctx context.Context, info container.InspectResponse) (string, error) {
+	name := strings.TrimPrefix(info.Name, "/")
+	oldName := name + oldContainerSuffix
+	wasRunning := info.State != nil && info.State.Running
+
+	timeout := 30
+	if err := s.client.ContainerStop(ctx, info.ID, container.StopOptions{Timeout: &timeout}); err != nil {
+		return "", fmt.Errorf("stop container %s: %w", name, err)
+	}
+	if err := s.client.ContainerRename(ctx, info.ID, oldName); err != nil {
+		s.restoreContainer(ctx, info.ID, "", wasRunning)
+		return "", fmt.Errorf("rename container %s: %w", name, err)
+	}
+
+	cfg := *info.Config
+	cfg.Hostname = recreateHostname(cfg.Hostname, info.ID)
+	hostCfg := *info.HostConfig
+	hostCfg.Binds = append(slices.Clone(hostCfg.Binds), PreservedVolumeBinds(info.Mounts, hostCfg.Binds, hostCfg.Mounts)...)
+	primary, extra := EndpointsForRecreate(info.NetworkSettings, string(hostCfg.NetworkMode), info.ID)
+
+	created, err := s.client.ContainerCreate(ctx, &cfg, &hostCfg, primary, nil, name)
+	if err != nil {
+		s.restoreContainer(ctx, info.ID, name, wasRunning)
+		return "", fmt.Errorf("create container %s: %w", name, err)
+	}
+	rollback := func(cause error) (string, error) {
+		if removeErr := s.client.ContainerRemove(ctx, created.ID, container.RemoveOptions{Force: true}); removeErr != nil {
+			s.logger.Warn("failed to remove new container on rollback", slog.String("container", name), slog.String("error", removeErr.Error()))
+		}
+		s.restoreContainer(ctx, info.ID, name, wasRunning)
+		return "", cause
+	}
+
+	for networkName, endpoint := range extra {
+		if err := s.client.NetworkConnect(ctx, networkName, created.ID, endpoint); err != nil {
+			return rollback(fmt.Errorf("connect network %s to %s: %w", networkName, name, err))
+		}
+	}
+	if wasRunning {
+		if err := s.client.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+			return rollback(fmt.Errorf("start container %s: %w", name, err))
+		}
+	}
+
+	if err := s.client.ContainerRemove(ctx, info.ID, container.RemoveOptions{}); err != nil {
+		// 새 컨테이너는 정상 동작 중이므로 실패로 보지 않음 (이전 컨테이너는 수동 정리)
+		s.logger.Warn("failed to remove replaced container", slog.String("container", oldName), slog.String("error", err.Error()))
+	}
+	return created.ID, nil
+}
+
+// restoreContainer: 재생성 실패 시 기존 컨테이너 이름을 되돌리고(name이 비어 있으면 생략) 원래 실행 중이었으면 다시 시작합니다.
+func (s *Service) restoreContainer(ctx context.Context, id, name string, wasRunning bool) {
+	if name != "" {
+		if err := s.client.ContainerRename(ctx, id, name); err != nil {
+			s.logger.Error("failed to restore container name", slog.String("container", name), slog.String("error", err.Error()))
+		}
+	}
+	if wasRunning {
+		if err := s.client.ContainerStart(ctx, id, container.StartOptions{}); err != nil {
+			s.logger.Error("failed to restart original container", slog.String("container", id[:12]), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// recreateHostname: 호스트 이름이 기본값(컨테이너 ID 앞 12자)이면 새 컨테이너가 자기 ID를 쓰도록 비웁니다.
+func recreateHostname(hostname, containerID string) string {
+	if len(containerID) >= 12 && hostname == containerID[:12] {
+		return ""
+	}
+	return hostname
+}
+
+// PreservedVolumeBinds: 설정(Binds/Mounts)에 없는 볼륨 마운트(익명 볼륨)를 "볼륨:경로" Bind로 바꿔 반환합니다.
+// 재생성한 컨테이너가 새 익명 볼륨 대신 기존 데이터를 그대로 쓰도록 하기 위함입니다.
+func PreservedVolumeBinds(mounts []container.MountPoint, binds []string, configured []mount.Mount) []string {
+	declared := make(map[string]struct{}, len(binds)+len(configured))
+	for _, bind := range binds {
+		parts := strings.Split(bind, ":")
+		if len(parts) >= 2 {
+			declared[parts[1]] = struct{}{}
+		}
+	}
+	for _, m := range configured {
+		declared[m.Target] = struct{}{}
+	}
+
+	var preserved []string
+	for _, m := range mounts {
+		if m.Type != mount.TypeVolume || m.Name == "" {
+			continue
+		}
+		if _, ok := declared[m.Destination]; ok {
+			continue
+		}
+		bind := m.Name + ":" + m.Destination
+		if !m.RW {
+			bind += ":ro"
+		}
+		preserved = append(preserved, bind)
+	}
+	return preserved
+}
+
+// EndpointsForRecreate: 기존 네트워크 연결을 생성 시 연결할 기본 네트워크(networkMode)와 생성 후 추가로 연결할 네트워크로 나눕니다.
+// 런타임에 붙은 값(IP, 엔드포인트 ID, 컨테이너 ID 별칭)은 빼고 설정값(별칭, 고정 IP, 링크)만 옮깁니다.
+func EndpointsForRecreate(settings *container.NetworkSettings, networkMode, containerID string) (*network.NetworkingConfig, map[string]*network.EndpointSettings) {
+	primary := &network.NetworkingConfig{EndpointsConfig: map[string]*network.EndpointSettings{}}
+	extra := make(map[string]*network.EndpointSettings)
+	if settings == nil {
+		return primary, extra
+	}
+
+	for _, networkName := range slices.Sorted(maps.Keys(settings.Networks)) {
+		endpoint := settings.Networks[networkName]
+		if endpoint == nil {
+			continue
+		}
+		aliases := slices.DeleteFunc(slices.Clone(endpoint.Aliases), func(alias string) bool {
+			return len(containerID) >= 12 && alias == containerID[:12]
+		})
+		copied := &network.EndpointSettings{
+			IPAMConfig: endpoint.IPAMConfig,
+			Links:      endpoint.Links,
+			Aliases:    aliases,
+			DriverOpts: endpoint.DriverOpts,
+		}
+		if networkName == networkMode || (len(primary.EndpointsConfig) == 0 && !isNamedNetwork(networkMode, settings)) {
+			primary.EndpointsConfig[networkName] = copied
+			continue
+		}
+		extra[networkName] = copied
+	}
+	return primary, extra
+}
+
+// isNamedNetwork: networkMode가 컨테이너가 연결된 네트워크 이름 중 하나인지 확인합니다. (default/bridge 등 모드 값이면 false)
+func isNamedNetwork(networkMode string, settings *container.NetworkSettings) bool {
+	_, ok := settings.Networks[networkMode]
+	return ok
+}
@@ -0,0 +1,108 @@
+package docker
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+)
+
+func TestIsImageOutdated(t *testing.T) {
+	t.Parallel()
+
+	repoDigests := []string{
+		"ghcr.io/park285/twentyq@sha256:aaa",
+		"docker.io/library/valkey@sha256:bbb",
+	}
+	if IsImageOutdated(repoDigests, "sha256:bbb") {
+		t.Fatal("expected matching digest to be up to date")
+	}
+	if !IsImageOutdated(repoDigests, "sha256:ccc") {
+		t.Fatal("expected different registry digest to be outdated")
+	}
+	if IsImageOutdated(repoDigests, "") {
+		t.Fatal("expected unknown registry digest not to be reported")
+	}
+}
+
+func TestUpdateState_RejectsConcurrentUpdateOfSameContainer(t *testing.T) {
+	t.Parallel()
+
+	var state updateState
+	if !state.acquire("twentyq") || !state.acquire("turtle-soup") {
+		t.Fatal("expected different containers to update concurrently")
+	}
+	if state.acquire("twentyq") {
+		t.Fatal("expected second update of the same container to be rejected")
+	}
+	state.release("twentyq")
+	if !state.acquire("twentyq") {
+		t.Fatal("expected update to be allowed after release")
+	}
+}
+
+func TestRecreateHostname(t *testing.T) {
+	t.Parallel()
+
+	id := "0123456789abcdef0123"
+	if got := recreateHostname("0123456789ab", id); got != "" {
+		t.Fatalf("expected default hostname to be cleared, got %q", got)
+	}
+	if got := recreateHostname("valkey", id); got != "valkey" {
+		t.Fatalf("expected custom hostname to be kept, got %q", got)
+	}
+}
+
+func TestPreservedVolumeBinds(t *testing.T) {
+	t.Parallel()
+
+	mounts := []container.MountPoint{
+		{Type: mount.TypeVolume, Name: "named", Destination: "/data", RW: true},
+		{Type: mount.TypeVolume, Name: "anon123", Destination: "/var/lib/cache", RW: true},
+		{Type: mount.TypeVolume, Name: "anon456", Destination: "/seed", RW: false},
+		{Type: mount.TypeVolume, Name: "configured", Destination: "/config", RW: true},
+		{Type: mount.TypeBind, Source: "/host/logs", Destination: "/logs", RW: true},
+	}
+	binds := []string{"named:/data", "/host/logs:/logs:rw"}
+	configured := []mount.Mount{{Type: mount.TypeVolume, Source: "configured", Target: "/config"}}
+
+	got := PreservedVolumeBinds(mounts, binds, configured)
+	want := []string{"anon123:/var/lib/cache", "anon456:/seed:ro"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestEndpointsForRecreate(t *testing.T) {
+	t.Parallel()
+
+	id := "0123456789abcdef0123"
+	settings := &container.NetworkSettings{Networks: map[string]*network.EndpointSettings{
+		"llm-bot_default": {
+			Aliases:   []string{"twentyq", "0123456789ab"},
+			IPAddress: "172.18.0.5",
+			NetworkID: "net1",
+		},
+		"monitoring": {Aliases: []string{"twentyq-metrics"}},
+	}}
+
+	primary, extra := EndpointsForRecreate(settings, "llm-bot_default", id)
+	endpoint, ok := primary.EndpointsConfig["llm-bot_default"]
+	if !ok || len(primary.EndpointsConfig) != 1 {
+		t.Fatalf("expected network mode to be the create-time network, got %v", primary.EndpointsConfig)
+	}
+	if !slices.Equal(endpoint.Aliases, []string{"twentyq"}) || endpoint.IPAddress != "" || endpoint.NetworkID != "" {
+		t.Fatalf("expected only configured endpoint settings, got %+v", endpoint)
+	}
+	if len(extra) != 1 || extra["monitoring"] == nil {
+		t.Fatalf("expected monitoring to be connected after create, got %v", extra)
+	}
+
+	// 기본 모드(default)면 이름순 첫 네트워크로 생성
+	primary, extra = EndpointsForRecreate(settings, "default", id)
+	if _, ok := primary.EndpointsConfig["llm-bot_default"]; !ok || len(extra) != 1 {
+		t.Fatalf("expected first network as primary, got %v / %v", primary.EndpointsConfig, extra)
+	}
+}
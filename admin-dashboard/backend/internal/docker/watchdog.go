@@ -0,0 +1,233 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+const (
+	// DefaultWatchdogInterval: 리소스 워치독 샘플링 기본 주기
+	DefaultWatchdogInterval = 30 * time.Second
+	// DefaultResourceConsecutive: 재시작 전 연속으로 임계값을 넘어야 하는 샘플 수
+	DefaultResourceConsecutive = 3
+)
+
+// ResourcePolicy: 컨테이너별 리소스 임계값 (0이면 해당 항목은 검사하지 않음)
+type ResourcePolicy struct {
+	Container     string  `json:"container"`
+	MaxRSSMB      uint64  `json:"maxRssMb,omitempty"`
+	MaxCPUPercent float64 `json:"maxCpuPercent,omitempty"`
+	Consecutive   int     `json:"consecutive"`
+}
+
+// ResourceRestart: 워치독이 임계값 초과로 컨테이너를 재시작한 기록
+type ResourceRestart struct {
+	Container   string         `json:"container"`
+	Policy      ResourcePolicy `json:"policy"`
+	RSSMB       float64        `json:"rssMb"`      // 마지막 샘플의 메모리 (페이지 캐시 제외)
+	CPUPercent  float64        `json:"cpuPercent"` // 마지막 샘플의 CPU 사용률
+	Exceeded    []string       `json:"exceeded"`   // 마지막 샘플에서 넘은 항목 (rss, cpu)
+	Consecutive int            `json:"consecutive"`
+	RestartedAt time.Time      `json:"restartedAt"`
+	Error       string         `json:"error,omitempty"` // 재시작 실패 사유
+}
+
+// AuditSummary: 감사 로그용 대상(컨테이너 이름)과 결과 요약(JSON)을 반환합니다.
+func (r ResourceRestart) AuditSummary() (string, string) {
+	data, _ := json.Marshal(r)
+	return r.Container, string(data)
+}
+
+// ParseResourcePolicies: "hololive-bot=rss:512,cpu:90,n:5;twentyq-bot=cpu:150" 형식을 파싱합니다.
+// rss는 MB, cpu는 % (멀티코어면 100 초과 가능), n은 연속 초과 샘플 수이며 생략하면 기본값입니다.
+func ParseResourcePolicies(spec string) ([]ResourcePolicy, error) {
+	var policies []ResourcePolicy
+	seen := make(map[string]bool)
+	for entry := range strings.SplitSeq(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, limits, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid resource policy %q: expected container=rss:MB,cpu:PERCENT[,n:COUNT]", entry)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate resource policy for %s", name)
+		}
+		seen[name] = true
+
+		policy := ResourcePolicy{Container: name, Consecutive: DefaultResourceConsecutive}
+		for limit := range strings.SplitSeq(limits, ",") {
+			key, raw, _ := strings.Cut(strings.TrimSpace(limit), ":")
+			if key == "" {
+				continue
+			}
+			if err := policy.set(key, strings.TrimSpace(raw)); err != nil {
+				return nil, fmt.Errorf("resource policy for %s: %w", name, err)
+			}
+		}
+		if policy.MaxRSSMB == 0 && policy.MaxCPUPercent == 0 {
+			return nil, fmt.Errorf("resource policy for %s has no rss or cpu limit", name)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func (p *ResourcePolicy) set(key, raw string) error {
+	switch key {
+	case "rss":
+		mb, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil || mb == 0 {
+			return fmt.Errorf("invalid rss limit %q", raw)
+		}
+		p.MaxRSSMB = mb
+	case "cpu":
+		pct, err := strconv.ParseFloat(raw, 64)
+		if err != nil || pct <= 0 || math.IsInf(pct, 0) || math.IsNaN(pct) {
+			return fmt.Errorf("invalid cpu limit %q", raw)
+		}
+		p.MaxCPUPercent = pct
+	case "n":
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid consecutive count %q", raw)
+		}
+		p.Consecutive = n
+	default:
+		return fmt.Errorf("unknown limit %q (rss|cpu|n)", key)
+	}
+	return nil
+}
+
+// exceeded: 샘플이 넘은 임계값 항목
+func (p ResourcePolicy) exceeded(sample Stats) []string {
+	var over []string
+	if p.MaxRSSMB > 0 && sample.MemoryUsage > p.MaxRSSMB<<20 {
+		over = append(over, "rss")
+	}
+	if p.MaxCPUPercent > 0 && sample.CPUPercent > p.MaxCPUPercent {
+		over = append(over, "cpu")
+	}
+	return over
+}
+
+// resourceStreaks: 컨테이너별 연속 초과 샘플 수 (워치독 루프 안에서만 사용)
+type resourceStreaks map[string]int
+
+// observe: 샘플을 반영해 연속 초과 수를 갱신하고, 재시작할 때가 되면 true를 반환합니다.
+// 임계값 안쪽 샘플이 하나라도 나오면 연속 수는 0으로 돌아갑니다.
+func (s resourceStreaks) observe(policy ResourcePolicy, sample Stats) ([]string, bool) {
+	over := policy.exceeded(sample)
+	if len(over) == 0 {
+		delete(s, policy.Container)
+		return nil, false
+	}
+	s[policy.Container]++
+	return over, s[policy.Container] >= max(policy.Consecutive, 1)
+}
+
+// SampleStats: 컨테이너 리소스 샘플 하나를 조회합니다. (CPU 계산용 직전 값을 받느라 약 1초 걸림)
+func (s *Service) SampleStats(ctx context.Context, name string) (Stats, error) {
+	resp, err := s.client.ContainerStats(ctx, name, false)
+	if err != nil {
+		return Stats{}, fmt.Errorf("container %s stats: %w", name, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var raw container.StatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return Stats{}, fmt.Errorf("decode container %s stats: %w", name, err)
+	}
+	return computeStats(&raw), nil
+}
+
+// RunResourceWatchdog: interval마다 정책 대상 컨테이너를 샘플링하고, 연속 N회 임계값을 넘으면 재시작합니다.
+// 재시작(실패 포함)마다 resource_restart 로그를 남기고 report로 넘깁니다. (ctx 종료 시 반환)
+func (s *Service) RunResourceWatchdog(ctx context.Context, interval time.Duration, policies []ResourcePolicy, report func(context.Context, ResourceRestart)) {
+	// 관리 대상이 아닌 컨테이너는 재시작하지 않음
+	policies = slices.DeleteFunc(slices.Clone(policies), func(p ResourcePolicy) bool {
+		if s.isManaged(p.Container) {
+			return false
+		}
+		s.logger.Warn("watchdog policy ignored: container not managed", slog.String("container", p.Container))
+		return true
+	})
+	if len(policies) == 0 {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultWatchdogInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	streaks := make(resourceStreaks)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, policy := range policies {
+			if ctx.Err() != nil {
+				return
+			}
+			sample, err := s.SampleStats(ctx, policy.Container)
+			if err != nil {
+				// 중지/삭제된 컨테이너는 연속 수를 이어가지 않음
+				delete(streaks, policy.Container)
+				if ctx.Err() == nil {
+					s.logger.Debug("watchdog sample failed",
+						slog.String("container", policy.Container),
+						slog.String("error", err.Error()))
+				}
+				continue
+			}
+
+			over, restart := streaks.observe(policy, sample)
+			if !restart {
+				continue
+			}
+			count := streaks[policy.Container]
+			delete(streaks, policy.Container)
+
+			event := ResourceRestart{
+				Container:   policy.Container,
+				Policy:      policy,
+				RSSMB:       math.Round(float64(sample.MemoryUsage)/(1<<20)*10) / 10,
+				CPUPercent:  math.Round(sample.CPUPercent*10) / 10,
+				Exceeded:    over,
+				Consecutive: count,
+				RestartedAt: time.Now(),
+			}
+			if err := s.RestartContainer(ctx, policy.Container); err != nil {
+				event.Error = err.Error()
+			}
+			s.logger.Warn("resource_restart",
+				slog.String("container", event.Container),
+				slog.Float64("rss_mb", event.RSSMB),
+				slog.Float64("cpu_percent", event.CPUPercent),
+				slog.Any("exceeded", event.Exceeded),
+				slog.Int("consecutive", event.Consecutive),
+				slog.Uint64("max_rss_mb", policy.MaxRSSMB),
+				slog.Float64("max_cpu_percent", policy.MaxCPUPercent),
+				slog.Bool("restarted", event.Error == ""))
+			if report != nil {
+				report(ctx, event)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package docker
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseResourcePolicies(t *testing.T) {
+	policies, err := ParseResourcePolicies(" hololive-bot = rss:512, cpu:90.5 ,n:5 ; twentyq-bot=cpu:150;")
+	if err != nil {
+		t.Fatalf("ParseResourcePolicies: %v", err)
+	}
+	want := []ResourcePolicy{
+		{Container: "hololive-bot", MaxRSSMB: 512, MaxCPUPercent: 90.5, Consecutive: 5},
+		{Container: "twentyq-bot", MaxCPUPercent: 150, Consecutive: DefaultResourceConsecutive},
+	}
+	if !slices.Equal(policies, want) {
+		t.Fatalf("policies = %+v, want %+v", policies, want)
+	}
+
+	if empty, err := ParseResourcePolicies(""); err != nil || len(empty) != 0 {
+		t.Fatalf("empty spec must yield no policies, got %+v, %v", empty, err)
+	}
+
+	for _, spec := range []string{
+		"hololive-bot",
+		"=rss:512",
+		"hololive-bot=n:3",
+		"hololive-bot=rss:0",
+		"hololive-bot=rss:big",
+		"hololive-bot=cpu:-1",
+		"hololive-bot=rss:512,n:0",
+		"hololive-bot=mem:512",
+		"hololive-bot=rss:512;hololive-bot=cpu:90",
+	} {
+		if _, err := ParseResourcePolicies(spec); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+}
+
+func TestResourceStreaks_RestartAfterConsecutiveBreaches(t *testing.T) {
+	policy := ResourcePolicy{Container: "twentyq-bot", MaxRSSMB: 256, MaxCPUPercent: 80, Consecutive: 3}
+	hot := Stats{MemoryUsage: 300 << 20, CPUPercent: 10}
+	calm := Stats{MemoryUsage: 100 << 20, CPUPercent: 10}
+	streaks := make(resourceStreaks)
+
+	for i, sample := range []Stats{hot, hot, calm, hot, hot} {
+		if _, restart := streaks.observe(policy, sample); restart {
+			t.Fatalf("sample %d: a calm sample must reset the streak", i)
+		}
+	}
+	over, restart := streaks.observe(policy, Stats{MemoryUsage: 300 << 20, CPUPercent: 95})
+	if !restart || !slices.Equal(over, []string{"rss", "cpu"}) {
+		t.Fatalf("third consecutive breach should restart: %v %v", over, restart)
+	}
+	if streaks["twentyq-bot"] != 3 {
+		t.Fatalf("streak = %d, want 3", streaks["twentyq-bot"])
+	}
+}
@@ -0,0 +1,242 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+)
+
+// DefaultDeployWindow: 배포 기록 시각 기준으로 변경을 배포로 간주하는 여유 시간
+const DefaultDeployWindow = 30 * time.Minute
+
+// Source: 컨테이너 구성 정보 제공자 (docker.Service)
+type Source interface {
+	InspectConfigs(ctx context.Context) ([]docker.ContainerConfig, error)
+}
+
+// Notifier: 예상치 못한 드리프트 알림 전송자
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// Detector: 주기적으로 스냅샷을 찍어 이전 스냅샷과 비교하는 드리프트 감지기
+type Detector struct {
+	source       Source
+	store        Store
+	notifier     Notifier
+	redactKey    []byte
+	deployWindow time.Duration
+	logger       *slog.Logger
+
+	mu  sync.Mutex // 주기 점검과 수동 점검의 동시 실행 방지
+	now func() time.Time
+}
+
+// NewDetector: 드리프트 감지기 생성 (notifier가 nil이면 로그로만 알림)
+func NewDetector(source Source, store Store, notifier Notifier, redactKey string, deployWindow time.Duration, logger *slog.Logger) *Detector {
+	if deployWindow <= 0 {
+		deployWindow = DefaultDeployWindow
+	}
+	return &Detector{
+		source:       source,
+		store:        store,
+		notifier:     notifier,
+		redactKey:    []byte(redactKey),
+		deployWindow: deployWindow,
+		logger:       logger.With(slog.String("component", "drift")),
+		now:          time.Now,
+	}
+}
+
+// Run: interval마다 Check를 실행 (ctx 종료 시 반환)
+func (d *Detector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.Check(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Warn("drift_check_failed", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check: 현재 구성을 스냅샷으로 저장하고, 이전 스냅샷 대비 변경이 있으면 이벤트로 기록합니다.
+// 최초 관찰된 컨테이너는 기준 스냅샷만 저장합니다.
+func (d *Detector) Check(ctx context.Context) ([]Event, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	configs, err := d.source.InspectConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("inspect containers: %w", err)
+	}
+
+	now := d.now()
+	var events []Event
+	for _, cfg := range configs {
+		current := BuildSnapshot(cfg, d.redactKey, now)
+
+		prev, err := d.store.GetSnapshot(ctx, current.Container)
+		if err != nil {
+			return events, err
+		}
+
+		if prev != nil {
+			if changes := Diff(*prev, current); len(changes) > 0 {
+				event, err := d.recordDrift(ctx, *prev, current, changes)
+				if err != nil {
+					return events, err
+				}
+				events = append(events, event)
+			}
+		}
+
+		if err := d.store.SaveSnapshot(ctx, current); err != nil {
+			return events, err
+		}
+	}
+	return events, nil
+}
+
+func (d *Detector) recordDrift(ctx context.Context, prev, current Snapshot, changes []Change) (Event, error) {
+	event := Event{
+		ID:         newEventID(),
+		Container:  current.Container,
+		DetectedAt: current.CapturedAt,
+		Changes:    changes,
+	}
+
+	deploy, err := d.store.LatestDeploy(ctx, current.Container)
+	if err != nil {
+		return event, err
+	}
+	// 이전 스냅샷 직전(여유 시간 포함)부터 지금까지 기록된 배포가 있으면 정상 변경으로 처리
+	if deploy != nil && deploy.RecordedAt.After(prev.CapturedAt.Add(-d.deployWindow)) && !deploy.RecordedAt.After(current.CapturedAt) {
+		event.Expected = true
+		event.Deploy = deploy
+	}
+
+	if err := d.store.AppendEvent(ctx, event); err != nil {
+		return event, err
+	}
+
+	if event.Expected {
+		d.logger.Info("drift_during_deploy",
+			slog.String("container", event.Container),
+			slog.Int("changes", len(changes)),
+		)
+		return event, nil
+	}
+
+	d.logger.Warn("config_drift_detected",
+		slog.String("container", event.Container),
+		slog.String("summary", event.Summary()),
+	)
+	if d.notifier != nil {
+		if err := d.notifier.Notify(ctx, event); err != nil {
+			d.logger.Warn("drift_notify_failed", slog.String("container", event.Container), slog.Any("error", err))
+		}
+	}
+	return event, nil
+}
+
+// Events: 최신순 드리프트 이벤트 조회
+func (d *Detector) Events(ctx context.Context, limit int) ([]Event, error) {
+	events, err := d.store.ListEvents(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list drift events: %w", err)
+	}
+	return events, nil
+}
+
+// Snapshots: 컨테이너별 마지막 스냅샷 조회
+func (d *Detector) Snapshots(ctx context.Context) ([]Snapshot, error) {
+	snapshots, err := d.store.ListSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list drift snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// RecordDeploy: 컨테이너 배포를 기록하여 배포 시간대의 변경을 알림 대상에서 제외
+func (d *Detector) RecordDeploy(ctx context.Context, containers []string, actor, note string) ([]Deploy, error) {
+	now := d.now()
+	deploys := make([]Deploy, 0, len(containers))
+	for _, container := range containers {
+		deploy := Deploy{Container: container, RecordedAt: now, Actor: actor, Note: note}
+		if err := d.store.RecordDeploy(ctx, deploy); err != nil {
+			return deploys, err
+		}
+		deploys = append(deploys, deploy)
+	}
+	d.logger.Info("deploy_recorded",
+		slog.Any("containers", containers),
+		slog.String("actor", actor),
+	)
+	return deploys, nil
+}
+
+func newEventID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// WebhookNotifier: 드리프트 이벤트를 JSON으로 웹훅 URL에 POST
+type WebhookNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhookNotifier: 웹훅 알림 전송자 생성
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:        url,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Notify: {"text": 요약, "event": 이벤트} 형태로 전송
+func (n *WebhookNotifier) Notify(ctx context.Context, event Event) error {
+	body, err := json.Marshal(map[string]any{
+		"text":  event.Summary(),
+		"event": event,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal drift notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create drift notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send drift notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("drift notification rejected: status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Package drift: 관리 대상 컨테이너의 설정 드리프트(배포 외 구성 변경) 감지
+package drift
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+)
+
+// Snapshot: 특정 시점의 컨테이너 구성 (민감한 환경 변수 값은 해시로 대체)
+type Snapshot struct {
+	Container   string            `json:"container"`
+	Image       string            `json:"image"`
+	ImageID     string            `json:"imageId"`
+	Env         map[string]string `json:"env"`
+	Mounts      map[string]string `json:"mounts"` // destination → "type:source (rw|ro)"
+	NetworkMode string            `json:"networkMode"`
+	Networks    []string          `json:"networks"`
+	CapturedAt  time.Time         `json:"capturedAt"`
+}
+
+// Change: 필드 단위 변경 내역 (추가/삭제 시 Before 또는 After가 빈 값)
+type Change struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// Deploy: 기록된 배포 (배포 시간대의 변경은 드리프트로 알리지 않음)
+type Deploy struct {
+	Container  string    `json:"container"`
+	RecordedAt time.Time `json:"recordedAt"`
+	Actor      string    `json:"actor,omitempty"`
+	Note       string    `json:"note,omitempty"`
+}
+
+// Event: 스냅샷 비교 결과 감지된 변경
+type Event struct {
+	ID         string    `json:"id"`
+	Container  string    `json:"container"`
+	DetectedAt time.Time `json:"detectedAt"`
+	Changes    []Change  `json:"changes"`
+	// Expected: 기록된 배포 시간대의 변경이면 true (알림 대상 아님)
+	Expected bool    `json:"expected"`
+	Deploy   *Deploy `json:"deploy,omitempty"`
+}
+
+const redactedPrefix = "redacted:"
+
+var sensitiveEnvMarkers = []string{
+	"PASSWORD", "PASSWD", "PASS_HASH", "SECRET", "TOKEN", "API_KEY", "APIKEY",
+	"PRIVATE", "CREDENTIAL", "DSN", "AUTH",
+}
+
+// IsSensitiveEnv: 값 노출 없이 변경 여부만 추적해야 하는 환경 변수인지 확인
+func IsSensitiveEnv(key string) bool {
+	upper := strings.ToUpper(key)
+	for _, marker := range sensitiveEnvMarkers {
+		if strings.Contains(upper, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactValue: 민감한 값을 HMAC 해시 접두어로 대체 (값 자체는 저장하지 않고 변경 여부만 비교 가능)
+func redactValue(value string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return redactedPrefix + hex.EncodeToString(mac.Sum(nil))[:12]
+}
+
+// BuildSnapshot: docker inspect 요약에서 비교용 스냅샷 생성
+func BuildSnapshot(cfg docker.ContainerConfig, redactKey []byte, now time.Time) Snapshot {
+	env := make(map[string]string, len(cfg.Env))
+	for _, kv := range cfg.Env {
+		key, value, _ := strings.Cut(kv, "=")
+		if key == "" {
+			continue
+		}
+		if IsSensitiveEnv(key) {
+			value = redactValue(value, redactKey)
+		}
+		env[key] = value
+	}
+
+	mounts := make(map[string]string, len(cfg.Mounts))
+	for _, m := range cfg.Mounts {
+		mode := "ro"
+		if m.RW {
+			mode = "rw"
+		}
+		mounts[m.Destination] = fmt.Sprintf("%s:%s (%s)", m.Type, m.Source, mode)
+	}
+
+	networks := slices.Clone(cfg.Networks)
+	sort.Strings(networks)
+
+	return Snapshot{
+		Container:   cfg.Name,
+		Image:       cfg.Image,
+		ImageID:     cfg.ImageID,
+		Env:         env,
+		Mounts:      mounts,
+		NetworkMode: cfg.NetworkMode,
+		Networks:    networks,
+		CapturedAt:  now,
+	}
+}
+
+// Diff: 두 스냅샷의 변경 필드 목록 (필드명 기준 정렬)
+func Diff(prev, curr Snapshot) []Change {
+	var changes []Change
+	addScalar := func(field, before, after string) {
+		if before != after {
+			changes = append(changes, Change{Field: field, Before: before, After: after})
+		}
+	}
+
+	addScalar("image", prev.Image, curr.Image)
+	addScalar("imageId", prev.ImageID, curr.ImageID)
+	addScalar("networkMode", prev.NetworkMode, curr.NetworkMode)
+	addScalar("networks", strings.Join(prev.Networks, ","), strings.Join(curr.Networks, ","))
+	changes = append(changes, diffMap("env.", prev.Env, curr.Env)...)
+	changes = append(changes, diffMap("mounts.", prev.Mounts, curr.Mounts)...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+func diffMap(prefix string, prev, curr map[string]string) []Change {
+	var changes []Change
+	for key, before := range prev {
+		after, ok := curr[key]
+		if !ok || after != before {
+			changes = append(changes, Change{Field: prefix + key, Before: before, After: after})
+		}
+	}
+	for key, after := range curr {
+		if _, ok := prev[key]; !ok {
+			changes = append(changes, Change{Field: prefix + key, After: after})
+		}
+	}
+	return changes
+}
+
+// Summary: 알림용 한 줄 요약 (변경 필드명만 나열)
+func (e Event) Summary() string {
+	fields := make([]string, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		fields = append(fields, c.Field)
+	}
+	return fmt.Sprintf("config drift detected on %s: %s", e.Container, strings.Join(fields, ", "))
+}
@@ -0,0 +1,207 @@
+package drift
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+)
+
+type memoryStore struct {
+	snapshots map[string]Snapshot
+	events    []Event
+	deploys   map[string]Deploy
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{snapshots: map[string]Snapshot{}, deploys: map[string]Deploy{}}
+}
+
+func (m *memoryStore) GetSnapshot(_ context.Context, container string) (*Snapshot, error) {
+	s, ok := m.snapshots[container]
+	if !ok {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func (m *memoryStore) SaveSnapshot(_ context.Context, snapshot Snapshot) error {
+	m.snapshots[snapshot.Container] = snapshot
+	return nil
+}
+
+func (m *memoryStore) ListSnapshots(context.Context) ([]Snapshot, error) {
+	out := make([]Snapshot, 0, len(m.snapshots))
+	for _, s := range m.snapshots {
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func (m *memoryStore) AppendEvent(_ context.Context, event Event) error {
+	m.events = append([]Event{event}, m.events...)
+	return nil
+}
+
+func (m *memoryStore) ListEvents(context.Context, int) ([]Event, error) {
+	return m.events, nil
+}
+
+func (m *memoryStore) RecordDeploy(_ context.Context, deploy Deploy) error {
+	m.deploys[deploy.Container] = deploy
+	return nil
+}
+
+func (m *memoryStore) LatestDeploy(_ context.Context, container string) (*Deploy, error) {
+	d, ok := m.deploys[container]
+	if !ok {
+		return nil, nil
+	}
+	return &d, nil
+}
+
+type staticSource struct {
+	configs []docker.ContainerConfig
+}
+
+func (s *staticSource) InspectConfigs(context.Context) ([]docker.ContainerConfig, error) {
+	return s.configs, nil
+}
+
+type recordingNotifier struct {
+	events []Event
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, event Event) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+func baseConfig() docker.ContainerConfig {
+	return docker.ContainerConfig{
+		Name:    "twentyq-bot",
+		Image:   "twentyq-bot:latest",
+		ImageID: "sha256:aaa",
+		Env:     []string{"LOG_LEVEL=info", "DB_PASSWORD=hunter2"},
+		Mounts: []docker.MountConfig{
+			{Type: "bind", Source: "/srv/logs", Destination: "/app/logs", RW: true},
+		},
+		NetworkMode: "llm-bot_default",
+		Networks:    []string{"llm-bot_default"},
+	}
+}
+
+func TestBuildSnapshot_RedactsSensitiveEnv(t *testing.T) {
+	snapshot := BuildSnapshot(baseConfig(), []byte("key"), time.Now())
+
+	if snapshot.Env["LOG_LEVEL"] != "info" {
+		t.Errorf("plain env should be kept, got %q", snapshot.Env["LOG_LEVEL"])
+	}
+	pass := snapshot.Env["DB_PASSWORD"]
+	if !strings.HasPrefix(pass, redactedPrefix) || strings.Contains(pass, "hunter2") {
+		t.Errorf("sensitive env should be redacted, got %q", pass)
+	}
+	if snapshot.Mounts["/app/logs"] != "bind:/srv/logs (rw)" {
+		t.Errorf("unexpected mount summary: %q", snapshot.Mounts["/app/logs"])
+	}
+}
+
+func TestDiff(t *testing.T) {
+	key := []byte("key")
+	prev := BuildSnapshot(baseConfig(), key, time.Now())
+
+	cfg := baseConfig()
+	cfg.ImageID = "sha256:bbb"
+	cfg.Env = []string{"LOG_LEVEL=debug", "DB_PASSWORD=changed", "NEW_FLAG=1"}
+	cfg.Mounts = nil
+	curr := BuildSnapshot(cfg, key, time.Now())
+
+	changes := Diff(prev, curr)
+	fields := make([]string, 0, len(changes))
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+	want := "env.DB_PASSWORD,env.LOG_LEVEL,env.NEW_FLAG,imageId,mounts./app/logs"
+	if got := strings.Join(fields, ","); got != want {
+		t.Errorf("changed fields = %s, want %s", got, want)
+	}
+
+	if len(Diff(prev, prev)) != 0 {
+		t.Error("identical snapshots should have no changes")
+	}
+}
+
+func newTestDetector(source Source, store Store, notifier Notifier) *Detector {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewDetector(source, store, notifier, "key", 10*time.Minute, logger)
+}
+
+func TestDetector_NotifiesUnexpectedDrift(t *testing.T) {
+	ctx := context.Background()
+	source := &staticSource{configs: []docker.ContainerConfig{baseConfig()}}
+	store := newMemoryStore()
+	notifier := &recordingNotifier{}
+	d := newTestDetector(source, store, notifier)
+
+	events, err := d.Check(ctx)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("baseline check: events=%d err=%v", len(events), err)
+	}
+
+	cfg := baseConfig()
+	cfg.Env = append(cfg.Env, "FEATURE_X=on")
+	source.configs = []docker.ContainerConfig{cfg}
+
+	events, err = d.Check(ctx)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(events) != 1 || events[0].Expected {
+		t.Fatalf("expected one unexpected drift event, got %+v", events)
+	}
+	if len(notifier.events) != 1 {
+		t.Errorf("notifications = %d, want 1", len(notifier.events))
+	}
+
+	// 변경이 스냅샷에 반영되었으므로 다음 점검에서는 이벤트 없음
+	events, _ = d.Check(ctx)
+	if len(events) != 0 {
+		t.Errorf("drift should not repeat, got %d events", len(events))
+	}
+}
+
+func TestDetector_DeployedChangeIsExpected(t *testing.T) {
+	ctx := context.Background()
+	source := &staticSource{configs: []docker.ContainerConfig{baseConfig()}}
+	store := newMemoryStore()
+	notifier := &recordingNotifier{}
+	d := newTestDetector(source, store, notifier)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return start }
+	if _, err := d.Check(ctx); err != nil {
+		t.Fatalf("baseline check: %v", err)
+	}
+
+	_ = store.RecordDeploy(ctx, Deploy{Container: "twentyq-bot", RecordedAt: start.Add(2 * time.Minute), Actor: "admin"})
+
+	cfg := baseConfig()
+	cfg.ImageID = "sha256:new"
+	source.configs = []docker.ContainerConfig{cfg}
+	d.now = func() time.Time { return start.Add(5 * time.Minute) }
+
+	events, err := d.Check(ctx)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(events) != 1 || !events[0].Expected || events[0].Deploy == nil {
+		t.Fatalf("expected deploy-covered event, got %+v", events)
+	}
+	if len(notifier.events) != 0 {
+		t.Errorf("deploy-covered drift should not notify, got %d", len(notifier.events))
+	}
+}
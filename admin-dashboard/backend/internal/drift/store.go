@@ -0,0 +1,197 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	snapshotsKey = "drift:snapshots"
+	eventsKey    = "drift:events"
+	deploysKey   = "drift:deploys"
+
+	// maxEvents: 보관할 최대 드리프트 이벤트 수
+	maxEvents = 500
+)
+
+// Store: 스냅샷/이벤트/배포 기록 저장소 인터페이스
+type Store interface {
+	GetSnapshot(ctx context.Context, container string) (*Snapshot, error)
+	SaveSnapshot(ctx context.Context, snapshot Snapshot) error
+	ListSnapshots(ctx context.Context) ([]Snapshot, error)
+
+	AppendEvent(ctx context.Context, event Event) error
+	// ListEvents: 최신순 이벤트 목록
+	ListEvents(ctx context.Context, limit int) ([]Event, error)
+
+	RecordDeploy(ctx context.Context, deploy Deploy) error
+	LatestDeploy(ctx context.Context, container string) (*Deploy, error)
+}
+
+// ValkeyStore: Valkey 기반 드리프트 저장소 (스냅샷/배포는 컨테이너별 해시 필드, 이벤트는 리스트)
+type ValkeyStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 드리프트 저장소 생성
+func NewValkeyStore(client valkey.Client, logger *slog.Logger) *ValkeyStore {
+	return &ValkeyStore{client: client, logger: logger}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+// GetSnapshot: 컨테이너의 마지막 스냅샷 조회 (없으면 nil)
+func (s *ValkeyStore) GetSnapshot(ctx context.Context, container string) (*Snapshot, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hget().Key(snapshotsKey).Field(container).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get drift snapshot: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal([]byte(raw), &snapshot); err != nil {
+		return nil, fmt.Errorf("decode drift snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// SaveSnapshot: 컨테이너 스냅샷 저장 (덮어쓰기)
+func (s *ValkeyStore) SaveSnapshot(ctx context.Context, snapshot Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshal drift snapshot: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmd := s.client.B().Hset().Key(snapshotsKey).FieldValue().FieldValue(snapshot.Container, string(data)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("save drift snapshot: %w", err)
+	}
+	return nil
+}
+
+// ListSnapshots: 전체 컨테이너 스냅샷 조회 (컨테이너명 순)
+func (s *ValkeyStore) ListSnapshots(ctx context.Context) ([]Snapshot, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hgetall().Key(snapshotsKey).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("list drift snapshots: %w", err)
+	}
+
+	snapshots := make([]Snapshot, 0, len(raw))
+	for container, item := range raw {
+		var snapshot Snapshot
+		if err := json.Unmarshal([]byte(item), &snapshot); err != nil {
+			s.logger.Warn("drift_snapshot_decode_failed", slog.String("container", container), slog.Any("error", err))
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Container < snapshots[j].Container
+	})
+	return snapshots, nil
+}
+
+// AppendEvent: 이벤트를 리스트 앞에 추가하고 최대 길이로 자름
+func (s *ValkeyStore) AppendEvent(ctx context.Context, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal drift event: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmds := valkey.Commands{
+		s.client.B().Lpush().Key(eventsKey).Element(string(data)).Build(),
+		s.client.B().Ltrim().Key(eventsKey).Start(0).Stop(maxEvents - 1).Build(),
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append drift event: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListEvents: 최신순 이벤트 조회
+func (s *ValkeyStore) ListEvents(ctx context.Context, limit int) ([]Event, error) {
+	if limit <= 0 || limit > maxEvents {
+		limit = maxEvents
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Lrange().Key(eventsKey).Start(0).Stop(int64(limit-1)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list drift events: %w", err)
+	}
+
+	events := make([]Event, 0, len(raw))
+	for _, item := range raw {
+		var event Event
+		if err := json.Unmarshal([]byte(item), &event); err != nil {
+			s.logger.Warn("drift_event_decode_failed", slog.Any("error", err))
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// RecordDeploy: 컨테이너 배포 기록 (컨테이너별 최신 1건만 유지)
+func (s *ValkeyStore) RecordDeploy(ctx context.Context, deploy Deploy) error {
+	data, err := json.Marshal(deploy)
+	if err != nil {
+		return fmt.Errorf("marshal deploy: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmd := s.client.B().Hset().Key(deploysKey).FieldValue().FieldValue(deploy.Container, string(data)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("record deploy: %w", err)
+	}
+	return nil
+}
+
+// LatestDeploy: 컨테이너의 최근 배포 기록 조회 (없으면 nil)
+func (s *ValkeyStore) LatestDeploy(ctx context.Context, container string) (*Deploy, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hget().Key(deploysKey).Field(container).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get deploy: %w", err)
+	}
+
+	var deploy Deploy
+	if err := json.Unmarshal([]byte(raw), &deploy); err != nil {
+		return nil, fmt.Errorf("decode deploy: %w", err)
+	}
+	return &deploy, nil
+}
@@ -0,0 +1,120 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// botRequestTimeout: 봇 관리 API 호출 하나의 제한 시간 (느린 봇 하나가 전체 응답을 붙잡지 않도록 짧게 둠)
+	botRequestTimeout = 3 * time.Second
+	// maxBotResponseBytes: 필드 하나로 받는 봇 응답 본문 상한
+	maxBotResponseBytes = 4 << 20
+)
+
+// JSON: 봇 관리 API 응답 본문을 그대로 담는 스칼라 (봇 쪽 응답 형식이 바뀌어도 스키마를 고치지 않음)
+type JSON struct {
+	raw json.RawMessage
+}
+
+// ImplementsGraphQLType: 스키마의 JSON 스칼라와 연결
+func (JSON) ImplementsGraphQLType(name string) bool { return name == "JSON" }
+
+// UnmarshalGraphQL: 입력 인자로는 쓰지 않지만 스칼라 인터페이스를 위해 구현합니다.
+func (j *JSON) UnmarshalGraphQL(input any) error {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("marshal JSON scalar: %w", err)
+	}
+	j.raw = raw
+	return nil
+}
+
+// MarshalJSON: 받은 본문을 다시 인코딩하지 않고 그대로 씁니다.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if len(j.raw) == 0 {
+		return []byte("null"), nil
+	}
+	return j.raw, nil
+}
+
+// queryParams: nil이 아닌 값만 쿼리 문자열로 붙이는 선택 인자
+type queryParams map[string]any
+
+func (q queryParams) encode() string {
+	values := url.Values{}
+	for key, value := range q {
+		switch v := value.(type) {
+		case *string:
+			if v != nil && strings.TrimSpace(*v) != "" {
+				values.Set(key, strings.TrimSpace(*v))
+			}
+		case *int32:
+			if v != nil {
+				values.Set(key, strconv.Itoa(int(*v)))
+			}
+		}
+	}
+	return values.Encode()
+}
+
+// botClient: 게임 봇 관리 API 조회 클라이언트 (SPA가 프록시로 부르는 경로와 같음)
+type botClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// newBotClient: URL이 비어 있으면 nil (해당 봇 필드는 null)
+func newBotClient(baseURL string) *botClient {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if baseURL == "" {
+		return nil
+	}
+	return &botClient{
+		baseURL: baseURL,
+		httpClient: &http.Client{
+			Timeout:   botRequestTimeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}
+}
+
+func (c *botClient) get(ctx context.Context, path string, params queryParams) (*JSON, error) {
+	target := c.baseURL + path
+	if query := params.encode(); query != "" {
+		target += "?" + query
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBotResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request %s: status %d", path, resp.StatusCode)
+	}
+	if len(body) > maxBotResponseBytes {
+		return nil, fmt.Errorf("request %s: response exceeds %d bytes", path, maxBotResponseBytes)
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("request %s: response is not JSON", path)
+	}
+	return &JSON{raw: body}, nil
+}
@@ -0,0 +1,167 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
+)
+
+type fakeStatus struct{}
+
+func (fakeStatus) GetAggregatedStatus(context.Context) *status.AggregatedStatus {
+	return &status.AggregatedStatus{
+		Version:           "1.2.3",
+		AvailableServices: 1,
+		TotalServices:     2,
+		Services: []status.ServiceStatus{
+			{Name: "twentyq-bot", Available: true, LatencyMs: 12},
+			{Name: "turtle-soup-bot", Cause: "down"},
+		},
+	}
+}
+
+type fakeDocker struct {
+	err error
+}
+
+func (fakeDocker) Available(context.Context) bool { return true }
+
+func (f fakeDocker) ListContainers(context.Context) ([]docker.Container, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []docker.Container{{Name: "twentyq-bot", State: "running", StartedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}}, nil
+}
+
+func execQuery(t *testing.T, deps Deps, query string) (map[string]any, []map[string]any) {
+	t.Helper()
+	schema, err := NewSchema(deps)
+	if err != nil {
+		t.Fatalf("NewSchema: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST("/graphql", Handler(schema, slog.New(slog.NewTextHandler(io.Discard, nil))))
+
+	body, _ := json.Marshal(map[string]any{"query": query})
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Data   map[string]any   `json:"data"`
+		Errors []map[string]any `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return resp.Data, resp.Errors
+}
+
+func TestQuery_AggregatesSourcesInOneRequest(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+	twentyq := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.RequestURI())
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/admin/stats":
+			_, _ = io.WriteString(w, `{"status":"ok","stats":{"totalGames":7}}`)
+		case "/admin/leaderboard":
+			_, _ = io.WriteString(w, `{"status":"ok","entries":[]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer twentyq.Close()
+
+	data, errs := execQuery(t, Deps{
+		Status:        fakeStatus{},
+		Docker:        fakeDocker{},
+		TwentyQBotURL: twentyq.URL,
+	}, `{
+		status { version totalServices services { name latencyMs cause } outages { rootCause } }
+		docker { available containers { name startedAt } }
+		twentyq { stats leaderboard(limit: 5) }
+		turtlesoup { stats }
+	}`)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	got, _ := json.Marshal(data)
+	want := `{"status":{"version":"1.2.3","totalServices":2,"services":[{"name":"twentyq-bot","latencyMs":12,"cause":null},{"name":"turtle-soup-bot","latencyMs":0,"cause":"down"}],"outages":[]},` +
+		`"docker":{"available":true,"containers":[{"name":"twentyq-bot","startedAt":"2026-10-18T12:00:00Z"}]},` +
+		`"twentyq":{"stats":{"status":"ok","stats":{"totalGames":7}},"leaderboard":{"status":"ok","entries":[]}},` +
+		`"turtlesoup":null}`
+	var wantData map[string]any
+	if err := json.Unmarshal([]byte(want), &wantData); err != nil {
+		t.Fatal(err)
+	}
+	// 맵을 다시 인코딩해 키 순서를 맞춤
+	normalized, _ := json.Marshal(wantData)
+	if string(got) != string(normalized) {
+		t.Fatalf("unexpected data:\n got %s\nwant %s", got, want)
+	}
+	if len(paths) != 2 || !strings.Contains(strings.Join(paths, " "), "/admin/leaderboard?limit=5") {
+		t.Fatalf("unexpected bot requests: %v", paths)
+	}
+}
+
+func TestQuery_FailingFieldIsPartial(t *testing.T) {
+	turtle := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/admin/stats" {
+			_, _ = io.WriteString(w, `{"status":"ok"}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer turtle.Close()
+
+	data, errs := execQuery(t, Deps{
+		Docker:       fakeDocker{err: errors.New("docker down")},
+		TurtleBotURL: turtle.URL,
+	}, `{ docker { available containers { name } } turtlesoup { stats sessions } }`)
+
+	if len(errs) != 2 {
+		t.Fatalf("expected docker and sessions errors, got %v", errs)
+	}
+	turtlesoup, _ := data["turtlesoup"].(map[string]any)
+	if turtlesoup == nil || turtlesoup["sessions"] != nil || turtlesoup["stats"] == nil {
+		t.Fatalf("expected stats to survive a failing sibling field: %v", data)
+	}
+}
+
+func TestHandler_RejectsInvalidRequest(t *testing.T) {
+	schema, err := NewSchema(Deps{})
+	if err != nil {
+		t.Fatalf("NewSchema: %v", err)
+	}
+	engine := gin.New()
+	engine.POST("/graphql", Handler(schema, slog.New(slog.NewTextHandler(io.Discard, nil))))
+
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"variables":{}}`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without query, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,42 @@
+package gql
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// maxRequestBytes: GraphQL 요청 본문 상한 (쿼리 문서 + 변수)
+const maxRequestBytes = 64 << 10
+
+// request: GraphQL over HTTP 요청 본문
+type request struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Handler: POST 요청의 쿼리를 실행합니다. 일부 필드만 실패해도 200으로 data와 errors를 함께 응답합니다.
+func Handler(schema *graphql.Schema, logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxRequestBytes)
+
+		var req request
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid graphql request"})
+			return
+		}
+
+		resp := schema.Exec(c.Request.Context(), req.Query, req.OperationName, req.Variables)
+		if len(resp.Errors) > 0 {
+			logger.Warn("graphql_query_errors",
+				slog.String("operation", req.OperationName),
+				slog.Int("errors", len(resp.Errors)),
+				slog.String("first_error", resp.Errors[0].Message),
+			)
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,225 @@
+package gql
+
+import (
+	"context"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
+)
+
+// rootResolver: Query 타입 리졸버
+type rootResolver struct {
+	deps    Deps
+	twentyq *botClient
+	turtle  *botClient
+}
+
+func newRootResolver(deps Deps) *rootResolver {
+	return &rootResolver{
+		deps:    deps,
+		twentyq: newBotClient(deps.TwentyQBotURL),
+		turtle:  newBotClient(deps.TurtleBotURL),
+	}
+}
+
+// Status: 통합 시스템 상태 (GET /status와 같은 수집기 사용)
+func (r *rootResolver) Status(ctx context.Context) *statusResolver {
+	if r.deps.Status == nil {
+		return &statusResolver{s: &status.AggregatedStatus{}}
+	}
+	return &statusResolver{s: r.deps.Status.GetAggregatedStatus(ctx)}
+}
+
+// Docker: Docker 가용성과 관리 대상 컨테이너 (각 필드를 선택했을 때만 Docker API 호출)
+func (r *rootResolver) Docker() *dockerResolver {
+	return &dockerResolver{src: r.deps.Docker}
+}
+
+// Twentyq: 스무고개 봇 관리 API 조회
+func (r *rootResolver) Twentyq() *twentyQResolver {
+	if r.twentyq == nil {
+		return nil
+	}
+	return &twentyQResolver{client: r.twentyq}
+}
+
+// Turtlesoup: 바다거북 봇 관리 API 조회
+func (r *rootResolver) Turtlesoup() *turtleSoupResolver {
+	if r.turtle == nil {
+		return nil
+	}
+	return &turtleSoupResolver{client: r.turtle}
+}
+
+// ===== Status =====
+
+type statusResolver struct {
+	s *status.AggregatedStatus
+}
+
+func (r *statusResolver) Version() string          { return r.s.Version }
+func (r *statusResolver) Uptime() string           { return r.s.Uptime }
+func (r *statusResolver) StartedAt() float64       { return float64(r.s.StartedAt) }
+func (r *statusResolver) AvailableServices() int32 { return int32(r.s.AvailableServices) }
+func (r *statusResolver) TotalServices() int32     { return int32(r.s.TotalServices) }
+func (r *statusResolver) TotalGoroutines() int32   { return int32(r.s.TotalGoroutines) }
+func (r *statusResolver) AdminGoroutines() int32   { return int32(r.s.AdminGoroutines) }
+
+func (r *statusResolver) Services() []*serviceResolver {
+	out := make([]*serviceResolver, 0, len(r.s.Services))
+	for i := range r.s.Services {
+		out = append(out, &serviceResolver{s: &r.s.Services[i]})
+	}
+	return out
+}
+
+func (r *statusResolver) Outages() []*outageResolver {
+	out := make([]*outageResolver, 0, len(r.s.Outages))
+	for i := range r.s.Outages {
+		out = append(out, &outageResolver{o: &r.s.Outages[i]})
+	}
+	return out
+}
+
+type serviceResolver struct {
+	s *status.ServiceStatus
+}
+
+func (r *serviceResolver) Name() string         { return r.s.Name }
+func (r *serviceResolver) Available() bool      { return r.s.Available }
+func (r *serviceResolver) Version() *string     { return optional(r.s.Version) }
+func (r *serviceResolver) Uptime() *string      { return optional(r.s.Uptime) }
+func (r *serviceResolver) Goroutines() int32    { return int32(r.s.Goroutines) }
+func (r *serviceResolver) LatencyMs() int32     { return int32(r.s.LatencyMs) }
+func (r *serviceResolver) Health() *string      { return optional(r.s.Health) }
+func (r *serviceResolver) Issues() []string     { return nonNil(r.s.Issues) }
+func (r *serviceResolver) DependsOn() []string  { return nonNil(r.s.DependsOn) }
+func (r *serviceResolver) RootCauses() []string { return nonNil(r.s.RootCauses) }
+func (r *serviceResolver) Cause() *string       { return optional(r.s.Cause) }
+
+type outageResolver struct {
+	o *status.Outage
+}
+
+func (r *outageResolver) RootCause() string  { return r.o.RootCause }
+func (r *outageResolver) Status() string     { return r.o.Status }
+func (r *outageResolver) Impacted() []string { return nonNil(r.o.Impacted) }
+func (r *outageResolver) Summary() string    { return r.o.Summary }
+
+// ===== Docker =====
+
+type dockerResolver struct {
+	src ContainerSource
+}
+
+func (r *dockerResolver) Available(ctx context.Context) bool {
+	return r.src != nil && r.src.Available(ctx)
+}
+
+// Containers: Docker 서비스가 없으면 빈 목록, 조회가 실패하면 null과 오류로 응답합니다.
+func (r *dockerResolver) Containers(ctx context.Context) (*[]*containerResolver, error) {
+	out := make([]*containerResolver, 0)
+	if r.src == nil {
+		return &out, nil
+	}
+	containers, err := r.src.ListContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range containers {
+		out = append(out, &containerResolver{c: &containers[i]})
+	}
+	return &out, nil
+}
+
+type containerResolver struct {
+	c *docker.Container
+}
+
+func (r *containerResolver) ID() string     { return r.c.ID }
+func (r *containerResolver) Name() string   { return r.c.Name }
+func (r *containerResolver) Image() string  { return r.c.Image }
+func (r *containerResolver) State() string  { return r.c.State }
+func (r *containerResolver) Status() string { return r.c.Status }
+func (r *containerResolver) Health() string { return r.c.Health }
+func (r *containerResolver) Managed() bool  { return r.c.Managed }
+func (r *containerResolver) Paused() bool   { return r.c.Paused }
+
+func (r *containerResolver) StartedAt() *string {
+	if r.c.StartedAt.IsZero() {
+		return nil
+	}
+	startedAt := r.c.StartedAt.UTC().Format(time.RFC3339)
+	return &startedAt
+}
+
+// ===== Bots =====
+
+type twentyQResolver struct {
+	client *botClient
+}
+
+func (r *twentyQResolver) Stats(ctx context.Context) (*JSON, error) {
+	return r.client.get(ctx, "/admin/stats", nil)
+}
+
+func (r *twentyQResolver) CategoryStats(ctx context.Context) (*JSON, error) {
+	return r.client.get(ctx, "/admin/stats/categories", nil)
+}
+
+func (r *twentyQResolver) Sessions(ctx context.Context) (*JSON, error) {
+	return r.client.get(ctx, "/admin/sessions", nil)
+}
+
+func (r *twentyQResolver) Leaderboard(ctx context.Context, args struct {
+	ChatID *string
+	Limit  *int32
+}) (*JSON, error) {
+	return r.client.get(ctx, "/admin/leaderboard", queryParams{
+		"chatId": args.ChatID,
+		"limit":  args.Limit,
+	})
+}
+
+type turtleSoupResolver struct {
+	client *botClient
+}
+
+func (r *turtleSoupResolver) Stats(ctx context.Context) (*JSON, error) {
+	return r.client.get(ctx, "/admin/stats", nil)
+}
+
+func (r *turtleSoupResolver) Sessions(ctx context.Context) (*JSON, error) {
+	return r.client.get(ctx, "/admin/sessions", nil)
+}
+
+func (r *turtleSoupResolver) PuzzleStats(ctx context.Context) (*JSON, error) {
+	return r.client.get(ctx, "/admin/puzzles/stats", nil)
+}
+
+func (r *turtleSoupResolver) Archives(ctx context.Context, args struct {
+	Result *string
+	Limit  *int32
+	Offset *int32
+}) (*JSON, error) {
+	return r.client.get(ctx, "/admin/archives", queryParams{
+		"result": args.Result,
+		"limit":  args.Limit,
+		"offset": args.Offset,
+	})
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,135 @@
+// Package gql: 대시보드 화면용 GraphQL 조회 계층 (상태, Docker, 스무고개, 바다거북 데이터를 한 번의 요청으로 집계)
+package gql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
+)
+
+const (
+	// maxDepth: 중첩 선택 깊이 제한 (스키마 최대 깊이 4에 여유를 둠)
+	maxDepth = 8
+	// maxParallelism: 한 요청에서 동시에 실행하는 리졸버 수 (봇 API 동시 호출 수 상한)
+	maxParallelism = 8
+)
+
+// schemaSDL: 조회 전용 스키마. 봇 관리 API 응답은 봇 쪽 형식을 그대로 JSON 스칼라로 전달합니다.
+const schemaSDL = `
+schema {
+	query: Query
+}
+
+"임의의 JSON 값 (봇 관리 API 응답 본문)"
+scalar JSON
+
+type Query {
+	status: Status!
+	docker: Docker!
+	"스무고개 봇 URL이 설정되지 않았으면 null"
+	twentyq: TwentyQ
+	"바다거북 봇 URL이 설정되지 않았으면 null"
+	turtlesoup: TurtleSoup
+}
+
+type Status {
+	version: String!
+	uptime: String!
+	startedAt: Float!
+	availableServices: Int!
+	totalServices: Int!
+	totalGoroutines: Int!
+	adminGoroutines: Int!
+	services: [Service!]!
+	outages: [Outage!]!
+}
+
+type Service {
+	name: String!
+	available: Boolean!
+	version: String
+	uptime: String
+	goroutines: Int!
+	latencyMs: Int!
+	health: String
+	issues: [String!]!
+	dependsOn: [String!]!
+	rootCauses: [String!]!
+	cause: String
+}
+
+type Outage {
+	rootCause: String!
+	status: String!
+	impacted: [String!]!
+	summary: String!
+}
+
+type Docker {
+	available: Boolean!
+	"Docker 조회가 실패하면 null (errors에 사유)"
+	containers: [Container!]
+}
+
+type Container {
+	id: String!
+	name: String!
+	image: String!
+	state: String!
+	status: String!
+	health: String!
+	managed: Boolean!
+	paused: Boolean!
+	startedAt: String
+}
+
+type TwentyQ {
+	stats: JSON
+	categoryStats: JSON
+	sessions: JSON
+	leaderboard(chatId: String, limit: Int): JSON
+}
+
+type TurtleSoup {
+	stats: JSON
+	sessions: JSON
+	puzzleStats: JSON
+	archives(result: String, limit: Int, offset: Int): JSON
+}
+`
+
+// StatusSource: 통합 시스템 상태 제공자 (status.Collector)
+type StatusSource interface {
+	GetAggregatedStatus(ctx context.Context) *status.AggregatedStatus
+}
+
+// ContainerSource: 관리 대상 컨테이너 제공자 (docker.Service)
+type ContainerSource interface {
+	Available(ctx context.Context) bool
+	ListContainers(ctx context.Context) ([]docker.Container, error)
+}
+
+// Deps: 필드별 리졸버가 호출하는 기존 서비스. 비어 있는 항목은 빈 값(또는 null)으로 응답합니다.
+type Deps struct {
+	Status        StatusSource
+	Docker        ContainerSource
+	TwentyQBotURL string
+	TurtleBotURL  string
+}
+
+// NewSchema: 스키마를 파싱하고 리졸버를 연결합니다.
+func NewSchema(deps Deps) (*graphql.Schema, error) {
+	schema, err := graphql.ParseSchema(schemaSDL, newRootResolver(deps),
+		graphql.UseStringDescriptions(),
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parse graphql schema: %w", err)
+	}
+	return schema, nil
+}
@@ -0,0 +1,439 @@
+// Package inbox: 여러 운영 소스(감사 로그, 드리프트, 신고, 프로브 실패, 퍼즐 검수, 의심 게임 등)의 처리 대기 항목 통합 인박스
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Category: 인박스 항목 분류 (대시보드 배지 단위)
+type Category string
+
+// 인박스 항목 분류
+const (
+	CategoryAudit       Category = "audit"       // 실패/거부된 관리자 변경 요청
+	CategoryDrift       Category = "drift"       // 배포 외 설정 변경
+	CategoryReport      Category = "report"      // 운영자가 직접 등록한 신고
+	CategorySupervision Category = "supervision" // 감독 모드에서 승인 대기 중인 답변
+	CategoryProbe       Category = "probe"       // 실패 중인 합성 모니터링 프로브
+	CategoryPuzzle      Category = "puzzle"      // 검수 대기(draft) 퍼즐
+	CategoryGame        Category = "game"        // 비정상적으로 빠르게 끝난 게임
+)
+
+// Categories: 전체 분류 목록 (건수 집계 순서)
+var Categories = []Category{
+	CategorySupervision, CategoryProbe, CategoryDrift, CategoryReport, CategoryAudit, CategoryGame, CategoryPuzzle,
+}
+
+// State: 항목 처리 상태
+type State string
+
+// 항목 처리 상태
+const (
+	StateOpen       State = "open"
+	StateInProgress State = "in_progress"
+	StateResolved   State = "resolved"
+)
+
+// Severity: 항목 심각도
+type Severity string
+
+// 항목 심각도
+const (
+	SeverityLow    Severity = "low"
+	SeverityMedium Severity = "medium"
+	SeverityHigh   Severity = "high"
+)
+
+var (
+	// ErrNotFound: 현재 수집된 항목 중 ID가 없음
+	ErrNotFound = errors.New("inbox item not found")
+	// ErrInvalidTransition: 허용되지 않는 상태 전이
+	ErrInvalidTransition = errors.New("invalid state transition")
+)
+
+// Item: 인박스 항목 (소스가 채우는 내용 + 저장된 처리 상태)
+type Item struct {
+	ID        string    `json:"id"`
+	Category  Category  `json:"category"`
+	Severity  Severity  `json:"severity"`
+	Title     string    `json:"title"`
+	Detail    string    `json:"detail,omitempty"`
+	Target    string    `json:"target,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	Priority  int       `json:"priority"`
+
+	State     State      `json:"state"`
+	Assignee  string     `json:"assignee,omitempty"`
+	Note      string     `json:"note,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy string     `json:"updatedBy,omitempty"`
+}
+
+// Record: 항목별로 저장되는 처리 상태 (소스 내용은 매번 다시 수집)
+type Record struct {
+	State     State     `json:"state"`
+	Assignee  string    `json:"assignee,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	UpdatedBy string    `json:"updatedBy,omitempty"`
+}
+
+// Source: 처리 대기 항목 제공자
+type Source interface {
+	Name() string
+	Collect(ctx context.Context) ([]Item, error)
+}
+
+// Filter: 항목 조회 조건 (State가 비어 있으면 미해결 항목만)
+type Filter struct {
+	State    State
+	Category Category
+	Assignee string
+}
+
+// Match: 항목이 조회 조건을 만족하는지 확인
+func (f Filter) Match(item Item) bool {
+	if f.State == "" && item.State == StateResolved {
+		return false
+	}
+	if f.State != "" && item.State != f.State {
+		return false
+	}
+	if f.Category != "" && item.Category != f.Category {
+		return false
+	}
+	if f.Assignee != "" && !strings.EqualFold(item.Assignee, f.Assignee) {
+		return false
+	}
+	return true
+}
+
+// Counts: 분류별 미해결 항목 수 (대시보드 배지용)
+type Counts struct {
+	Total      int              `json:"total"`
+	Open       int              `json:"open"`
+	InProgress int              `json:"inProgress"`
+	ByCategory map[Category]int `json:"byCategory"`
+}
+
+// Snapshot: 수집 결과와 수집에 실패한 소스 목록
+type Snapshot struct {
+	Items       []Item
+	Unavailable []string
+}
+
+// Service: 소스별 항목을 수집해 저장된 처리 상태를 덧씌우고 우선순위로 정렬하는 인박스
+type Service struct {
+	sources []Source
+	store   Store
+	logger  *slog.Logger
+
+	mu  sync.Mutex // 상태 전이의 읽기-검증-쓰기 직렬화
+	now func() time.Time
+}
+
+// NewService: 인박스 생성 (store의 신고 항목은 자동으로 소스에 포함)
+func NewService(store Store, logger *slog.Logger, sources ...Source) *Service {
+	all := append([]Source{&reportSource{store: store}}, sources...)
+	return &Service{
+		sources: all,
+		store:   store,
+		logger:  logger.With(slog.String("component", "inbox")),
+		now:     time.Now,
+	}
+}
+
+// List: 조건에 맞는 항목을 우선순위 내림차순으로 조회
+func (s *Service) List(ctx context.Context, filter Filter) (Snapshot, error) {
+	snapshot, err := s.collect(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	matched := make([]Item, 0, len(snapshot.Items))
+	for _, item := range snapshot.Items {
+		if filter.Match(item) {
+			matched = append(matched, item)
+		}
+	}
+	snapshot.Items = matched
+	return snapshot, nil
+}
+
+// Counts: 분류별 미해결(open, in_progress) 항목 수 집계
+func (s *Service) Counts(ctx context.Context) (Counts, []string, error) {
+	snapshot, err := s.collect(ctx)
+	if err != nil {
+		return Counts{}, nil, err
+	}
+	return countItems(snapshot.Items), snapshot.Unavailable, nil
+}
+
+// CreateReport: 운영자 신고 항목 등록
+func (s *Service) CreateReport(ctx context.Context, title, detail, target string, severity Severity, actor string) (Item, error) {
+	if !validSeverity(severity) {
+		severity = SeverityMedium
+	}
+	now := s.now()
+	item := Item{
+		ID:        string(CategoryReport) + ":" + newID(),
+		Category:  CategoryReport,
+		Severity:  severity,
+		Title:     title,
+		Detail:    detail,
+		Target:    target,
+		CreatedAt: now,
+		State:     StateOpen,
+		UpdatedAt: &now,
+		UpdatedBy: actor,
+	}
+	if err := s.store.SaveReport(ctx, item); err != nil {
+		return Item{}, fmt.Errorf("save report: %w", err)
+	}
+	item.Priority = Score(item, now)
+	return item, nil
+}
+
+// Assign: 항목 담당자 지정 (빈 값이면 담당 해제). 열린 항목에 담당자를 지정하면 처리 중으로 전환
+func (s *Service) Assign(ctx context.Context, id, assignee, actor string) (Item, error) {
+	return s.update(ctx, id, actor, func(item *Item) error {
+		item.Assignee = assignee
+		if assignee != "" && item.State == StateOpen {
+			item.State = StateInProgress
+		}
+		return nil
+	})
+}
+
+// Transition: 항목 상태 전이 (resolved → open 재오픈 포함)
+func (s *Service) Transition(ctx context.Context, id string, to State, note, actor string) (Item, error) {
+	return s.update(ctx, id, actor, func(item *Item) error {
+		if !CanTransition(item.State, to) {
+			return fmt.Errorf("%w: %s → %s", ErrInvalidTransition, item.State, to)
+		}
+		item.State = to
+		if note != "" {
+			item.Note = note
+		}
+		// 처리 중 전환 시 담당자가 없으면 전환한 운영자가 맡음
+		if to == StateInProgress && item.Assignee == "" {
+			item.Assignee = actor
+		}
+		return nil
+	})
+}
+
+// CanTransition: 상태 전이 허용 여부
+func CanTransition(from, to State) bool {
+	switch from {
+	case StateOpen:
+		return to == StateInProgress || to == StateResolved
+	case StateInProgress:
+		return to == StateOpen || to == StateResolved
+	case StateResolved:
+		return to == StateOpen
+	default:
+		return false
+	}
+}
+
+func (s *Service) update(ctx context.Context, id, actor string, apply func(item *Item) error) (Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot, err := s.collect(ctx)
+	if err != nil {
+		return Item{}, err
+	}
+
+	var item *Item
+	for i := range snapshot.Items {
+		if snapshot.Items[i].ID == id {
+			item = &snapshot.Items[i]
+			break
+		}
+	}
+	if item == nil {
+		return Item{}, ErrNotFound
+	}
+
+	if err := apply(item); err != nil {
+		return Item{}, err
+	}
+	now := s.now()
+	item.UpdatedAt = &now
+	item.UpdatedBy = actor
+	item.Priority = Score(*item, now)
+
+	record := Record{
+		State:     item.State,
+		Assignee:  item.Assignee,
+		Note:      item.Note,
+		UpdatedAt: now,
+		UpdatedBy: actor,
+	}
+	if err := s.store.SaveRecord(ctx, id, record); err != nil {
+		return Item{}, fmt.Errorf("save inbox record: %w", err)
+	}
+	s.logger.Info("inbox_item_updated",
+		slog.String("id", id),
+		slog.String("state", string(item.State)),
+		slog.String("assignee", item.Assignee),
+		slog.String("actor", actor),
+	)
+	return *item, nil
+}
+
+// collect: 모든 소스에서 항목을 모으고 처리 상태를 덧씌움 (일부 소스 실패는 Unavailable로 보고)
+func (s *Service) collect(ctx context.Context) (Snapshot, error) {
+	type result struct {
+		items []Item
+		err   error
+	}
+	results := make([]result, len(s.sources))
+
+	var wg sync.WaitGroup
+	for i, source := range s.sources {
+		wg.Go(func() {
+			items, err := source.Collect(ctx)
+			results[i] = result{items: items, err: err}
+		})
+	}
+	wg.Wait()
+
+	var snapshot Snapshot
+	seen := make(map[string]struct{})
+	for i, r := range results {
+		if r.err != nil {
+			s.logger.Warn("inbox_source_failed", slog.String("source", s.sources[i].Name()), slog.Any("error", r.err))
+			snapshot.Unavailable = append(snapshot.Unavailable, s.sources[i].Name())
+			continue
+		}
+		for _, item := range r.items {
+			if _, dup := seen[item.ID]; dup {
+				continue
+			}
+			seen[item.ID] = struct{}{}
+			snapshot.Items = append(snapshot.Items, item)
+		}
+	}
+
+	ids := make([]string, len(snapshot.Items))
+	for i, item := range snapshot.Items {
+		ids[i] = item.ID
+	}
+	records, err := s.store.GetRecords(ctx, ids)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("load inbox records: %w", err)
+	}
+
+	now := s.now()
+	for i := range snapshot.Items {
+		item := &snapshot.Items[i]
+		if record, ok := records[item.ID]; ok {
+			item.State = record.State
+			item.Assignee = record.Assignee
+			item.Note = record.Note
+			item.UpdatedAt = &record.UpdatedAt
+			item.UpdatedBy = record.UpdatedBy
+		}
+		if item.State == "" {
+			item.State = StateOpen
+		}
+		item.Priority = Score(*item, now)
+	}
+	SortByPriority(snapshot.Items)
+	return snapshot, nil
+}
+
+func countItems(items []Item) Counts {
+	counts := Counts{ByCategory: make(map[Category]int, len(Categories))}
+	for _, category := range Categories {
+		counts.ByCategory[category] = 0
+	}
+	for _, item := range items {
+		switch item.State {
+		case StateOpen:
+			counts.Open++
+		case StateInProgress:
+			counts.InProgress++
+		default:
+			continue
+		}
+		counts.Total++
+		counts.ByCategory[item.Category]++
+	}
+	return counts
+}
+
+func validSeverity(severity Severity) bool {
+	switch severity {
+	case SeverityLow, SeverityMedium, SeverityHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidState: 요청으로 받은 상태 값 검증
+func ValidState(state State) bool {
+	switch state {
+	case StateOpen, StateInProgress, StateResolved:
+		return true
+	default:
+		return false
+	}
+}
+
+var severityScores = map[Severity]int{
+	SeverityLow:    100,
+	SeverityMedium: 200,
+	SeverityHigh:   300,
+}
+
+// categoryScores: 같은 심각도 안에서 시간 민감도가 높은 분류를 앞에 둠 (대기 답변은 만료 전 처리 필요)
+var categoryScores = map[Category]int{
+	CategorySupervision: 60,
+	CategoryProbe:       50,
+	CategoryDrift:       40,
+	CategoryReport:      30,
+	CategoryAudit:       20,
+	CategoryGame:        10,
+	CategoryPuzzle:      0,
+}
+
+// maxAgeScore: 오래된 항목 가산점 상한 (시간당 1점)
+const maxAgeScore = 48
+
+// Score: 심각도 + 분류 가중치 + 경과 시간(시간당 1점, 최대 48점)으로 우선순위 점수 계산.
+// 해결된 항목은 0점, 처리 중인 항목은 이미 담당자가 있으므로 절반으로 낮춤
+func Score(item Item, now time.Time) int {
+	if item.State == StateResolved {
+		return 0
+	}
+	score := severityScores[item.Severity] + categoryScores[item.Category]
+	if !item.CreatedAt.IsZero() && now.After(item.CreatedAt) {
+		score += min(int(now.Sub(item.CreatedAt)/time.Hour), maxAgeScore)
+	}
+	if item.State == StateInProgress {
+		score /= 2
+	}
+	return score
+}
+
+// SortByPriority: 우선순위 내림차순, 같으면 오래된 항목 우선
+func SortByPriority(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Priority != items[j].Priority {
+			return items[i].Priority > items[j].Priority
+		}
+		return items[i].CreatedAt.Before(items[j].CreatedAt)
+	})
+}
@@ -0,0 +1,330 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/probe"
+)
+
+type memoryStore struct {
+	records map[string]Record
+	reports []Item
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[string]Record{}}
+}
+
+func (m *memoryStore) GetRecords(_ context.Context, ids []string) (map[string]Record, error) {
+	out := make(map[string]Record)
+	for _, id := range ids {
+		if r, ok := m.records[id]; ok {
+			out[id] = r
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryStore) SaveRecord(_ context.Context, id string, record Record) error {
+	m.records[id] = record
+	return nil
+}
+
+func (m *memoryStore) SaveReport(_ context.Context, item Item) error {
+	m.reports = append(m.reports, item)
+	return nil
+}
+
+func (m *memoryStore) ListReports(context.Context) ([]Item, error) {
+	return m.reports, nil
+}
+
+type staticSource struct {
+	name  string
+	items []Item
+	err   error
+}
+
+func (s *staticSource) Name() string { return s.name }
+
+func (s *staticSource) Collect(context.Context) ([]Item, error) {
+	return s.items, s.err
+}
+
+func newTestService(now time.Time, sources ...Source) (*Service, *memoryStore) {
+	store := newMemoryStore()
+	svc := NewService(store, slog.New(slog.NewTextHandler(io.Discard, nil)), sources...)
+	svc.now = func() time.Time { return now }
+	return svc, store
+}
+
+func TestScore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	high := Item{Category: CategoryDrift, Severity: SeverityHigh, State: StateOpen, CreatedAt: now}
+	low := Item{Category: CategoryPuzzle, Severity: SeverityLow, State: StateOpen, CreatedAt: now.Add(-100 * time.Hour)}
+	if Score(high, now) <= Score(low, now) {
+		t.Fatalf("high severity should outrank old low severity: %d <= %d", Score(high, now), Score(low, now))
+	}
+
+	// 경과 시간 가산점은 상한이 있음
+	if got, want := Score(low, now), 100+maxAgeScore; got != want {
+		t.Fatalf("aged low score = %d, want %d", got, want)
+	}
+
+	inProgress := high
+	inProgress.State = StateInProgress
+	if Score(inProgress, now) >= Score(high, now) {
+		t.Fatal("in-progress item should rank below the same open item")
+	}
+
+	resolved := high
+	resolved.State = StateResolved
+	if Score(resolved, now) != 0 {
+		t.Fatal("resolved item should score 0")
+	}
+}
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to State
+		want     bool
+	}{
+		{StateOpen, StateInProgress, true},
+		{StateOpen, StateResolved, true},
+		{StateInProgress, StateOpen, true},
+		{StateInProgress, StateResolved, true},
+		{StateResolved, StateOpen, true},
+		{StateResolved, StateInProgress, false},
+		{StateOpen, StateOpen, false},
+	}
+	for _, tc := range cases {
+		if got := CanTransition(tc.from, tc.to); got != tc.want {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestServiceListSortsAndReportsUnavailableSources(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc, _ := newTestService(now,
+		&staticSource{name: "puzzle", items: []Item{
+			{ID: "puzzle:1", Category: CategoryPuzzle, Severity: SeverityLow, CreatedAt: now},
+		}},
+		&staticSource{name: "drift", items: []Item{
+			{ID: "drift:a", Category: CategoryDrift, Severity: SeverityHigh, CreatedAt: now},
+		}},
+		&staticSource{name: "game", err: errors.New("twentyq down")},
+	)
+
+	snapshot, err := svc.List(context.Background(), Filter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(snapshot.Items) != 2 || snapshot.Items[0].ID != "drift:a" {
+		t.Fatalf("unexpected order: %+v", snapshot.Items)
+	}
+	if snapshot.Items[1].State != StateOpen {
+		t.Fatalf("items without record should be open, got %q", snapshot.Items[1].State)
+	}
+	if len(snapshot.Unavailable) != 1 || snapshot.Unavailable[0] != "game" {
+		t.Fatalf("unavailable = %v", snapshot.Unavailable)
+	}
+}
+
+func TestServiceAssignAndTransition(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc, store := newTestService(now, &staticSource{name: "audit", items: []Item{
+		{ID: "audit:1", Category: CategoryAudit, Severity: SeverityMedium, CreatedAt: now},
+	}})
+	ctx := context.Background()
+
+	item, err := svc.Assign(ctx, "audit:1", "operator1", "admin")
+	if err != nil {
+		t.Fatalf("Assign: %v", err)
+	}
+	if item.State != StateInProgress || item.Assignee != "operator1" {
+		t.Fatalf("assign should move open item to in_progress: %+v", item)
+	}
+
+	item, err = svc.Transition(ctx, "audit:1", StateResolved, "retried", "operator1")
+	if err != nil {
+		t.Fatalf("Transition: %v", err)
+	}
+	if item.State != StateResolved || item.Note != "retried" {
+		t.Fatalf("unexpected item: %+v", item)
+	}
+	if store.records["audit:1"].State != StateResolved {
+		t.Fatalf("record not saved: %+v", store.records["audit:1"])
+	}
+
+	// 해결된 항목은 기본 조회에서 빠지고 state=resolved로만 조회
+	snapshot, _ := svc.List(ctx, Filter{})
+	if len(snapshot.Items) != 0 {
+		t.Fatalf("resolved item listed by default: %+v", snapshot.Items)
+	}
+	snapshot, _ = svc.List(ctx, Filter{State: StateResolved})
+	if len(snapshot.Items) != 1 {
+		t.Fatalf("resolved item not listed: %+v", snapshot.Items)
+	}
+
+	if _, err := svc.Transition(ctx, "audit:1", StateInProgress, "", "operator1"); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("resolved → in_progress should fail, got %v", err)
+	}
+	if _, err := svc.Transition(ctx, "missing", StateResolved, "", "operator1"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("missing item should return ErrNotFound, got %v", err)
+	}
+}
+
+func TestServiceCountsAndReports(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc, _ := newTestService(now, &staticSource{name: "drift", items: []Item{
+		{ID: "drift:a", Category: CategoryDrift, Severity: SeverityHigh, CreatedAt: now},
+		{ID: "drift:b", Category: CategoryDrift, Severity: SeverityHigh, CreatedAt: now},
+	}})
+	ctx := context.Background()
+
+	report, err := svc.CreateReport(ctx, "욕설 신고", "", "chat-1", "unknown", "operator1")
+	if err != nil {
+		t.Fatalf("CreateReport: %v", err)
+	}
+	if report.Severity != SeverityMedium {
+		t.Fatalf("invalid severity should default to medium, got %q", report.Severity)
+	}
+	if _, err := svc.Transition(ctx, "drift:b", StateResolved, "", "operator1"); err != nil {
+		t.Fatalf("Transition: %v", err)
+	}
+	if _, err := svc.Transition(ctx, report.ID, StateInProgress, "", "operator1"); err != nil {
+		t.Fatalf("Transition report: %v", err)
+	}
+
+	counts, _, err := svc.Counts(ctx)
+	if err != nil {
+		t.Fatalf("Counts: %v", err)
+	}
+	if counts.Total != 2 || counts.Open != 1 || counts.InProgress != 1 {
+		t.Fatalf("unexpected counts: %+v", counts)
+	}
+	if counts.ByCategory[CategoryDrift] != 1 || counts.ByCategory[CategoryReport] != 1 || counts.ByCategory[CategoryGame] != 0 {
+		t.Fatalf("unexpected category counts: %+v", counts.ByCategory)
+	}
+}
+
+type staticAuditStore struct {
+	entries []audit.Entry
+}
+
+func (s *staticAuditStore) Append(context.Context, audit.Entry) error { return nil }
+
+func (s *staticAuditStore) List(_ context.Context, filter audit.Filter) ([]audit.Entry, int, error) {
+	page, total := audit.Paginate(s.entries, filter)
+	return page, total, nil
+}
+
+type staticDriftEvents struct {
+	events []drift.Event
+}
+
+func (s *staticDriftEvents) Events(context.Context, int) ([]drift.Event, error) {
+	return s.events, nil
+}
+
+func TestAuditAndDriftSources(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	auditSource := NewAuditSource(&staticAuditStore{entries: []audit.Entry{
+		{ID: "ok", Timestamp: now, Status: http.StatusOK},
+		{ID: "denied", Timestamp: now, Status: http.StatusForbidden, Action: "docker.restart"},
+		{ID: "failed", Timestamp: now, Status: http.StatusBadGateway, Action: "holo.alarm"},
+		{ID: "old", Timestamp: now.Add(-collectWindow - time.Hour), Status: http.StatusInternalServerError},
+	}})
+	auditSource.now = func() time.Time { return now }
+	items, err := auditSource.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("audit Collect: %v", err)
+	}
+	if len(items) != 2 || items[0].Severity != SeverityHigh || items[1].Severity != SeverityMedium {
+		t.Fatalf("unexpected audit items: %+v", items)
+	}
+
+	driftSource := NewDriftSource(&staticDriftEvents{events: []drift.Event{
+		{ID: "e1", Container: "twentyq-bot", DetectedAt: now, Changes: []drift.Change{{Field: "image"}, {Field: "env.LOG_LEVEL"}}},
+		{ID: "e2", Container: "twentyq-bot", DetectedAt: now, Expected: true},
+	}})
+	driftSource.now = func() time.Time { return now }
+	items, err = driftSource.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("drift Collect: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != "drift:e1" || items[0].Detail != "image, env.LOG_LEVEL" {
+		t.Fatalf("unexpected drift items: %+v", items)
+	}
+}
+
+func TestGameSourceFlagsFastSolves(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/games" || r.URL.Query().Get("result") != "success" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok","games":[
+			{"sessionId":"s1","chatId":"c1","target":"사과","questionCount":2,"hintCount":0,"completedAt":"2026-01-09T23:00:00Z"},
+			{"sessionId":"s2","chatId":"c1","target":"배","questionCount":2,"hintCount":1,"completedAt":"2026-01-09T23:00:00Z"},
+			{"sessionId":"s3","chatId":"c2","target":"감","questionCount":12,"hintCount":0,"completedAt":"2026-01-09T23:00:00Z"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	source := NewGameSource(srv.URL)
+	source.now = func() time.Time { return now }
+	items, err := source.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != "game:s1" {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+}
+
+type staticProbeSummaries struct {
+	summaries []probe.Summary
+}
+
+func (s *staticProbeSummaries) List(context.Context) ([]probe.Summary, error) {
+	return s.summaries, nil
+}
+
+func TestProbeSourceEscalatesConsecutiveFailures(t *testing.T) {
+	since := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	failed := &probe.Result{Error: "status 503, want 200"}
+	source := NewProbeSource(&staticProbeSummaries{summaries: []probe.Summary{
+		{Probe: probe.Probe{ID: "healthy"}, Last: &probe.Result{Success: true}},
+		{Probe: probe.Probe{ID: "fresh"}},
+		{Probe: probe.Probe{ID: "flaky", Name: "flaky", Method: "GET", Target: "holo", Path: "/health"}, Last: failed, Failures: 1, FailingSince: &since},
+		{Probe: probe.Probe{ID: "down", Name: "down", Method: "GET", Target: "llm", Path: "/health"}, Last: failed, Failures: 3, FailingSince: &since},
+	}})
+
+	items, err := source.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 failing probes, got %+v", items)
+	}
+	if items[0].Severity != SeverityMedium || items[1].Severity != SeverityHigh {
+		t.Fatalf("unexpected severities: %+v", items)
+	}
+	if want := "probe:down:1768003200"; items[1].ID != want || items[1].Detail != "GET llm/health: status 503, want 200" {
+		t.Fatalf("unexpected item: %+v (want id %s)", items[1], want)
+	}
+}
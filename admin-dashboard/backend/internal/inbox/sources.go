@@ -0,0 +1,371 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/probe"
+)
+
+const (
+	// collectWindow: 감사 로그/드리프트/게임 기록에서 항목을 수집하는 기간
+	collectWindow = 7 * 24 * time.Hour
+	// suspiciousMaxQuestions: 힌트 없이 이 질문 수 이하로 정답을 맞힌 스무고개 게임은 의심 항목으로 분류
+	suspiciousMaxQuestions = 3
+	// maxSupervisedSessions: 대기 답변을 확인할 최대 감독 세션 수 (세션마다 요청 1회)
+	maxSupervisedSessions = 20
+	// probeEscalateFailures: 이 횟수 이상 연속 실패한 프로브는 높은 심각도로 분류
+	probeEscalateFailures = 3
+)
+
+// ===== Audit =====
+
+// AuditSource: 실패(5xx)하거나 권한 부족으로 거부(403)된 관리자 변경 요청
+type AuditSource struct {
+	store audit.Store
+	now   func() time.Time
+}
+
+// NewAuditSource: 감사 로그 소스 생성
+func NewAuditSource(store audit.Store) *AuditSource {
+	return &AuditSource{store: store, now: time.Now}
+}
+
+// Name: 소스 이름
+func (a *AuditSource) Name() string { return string(CategoryAudit) }
+
+// Collect: 수집 기간 내 실패/거부 요청을 항목으로 변환
+func (a *AuditSource) Collect(ctx context.Context) ([]Item, error) {
+	entries, _, err := a.store.List(ctx, audit.Filter{From: a.now().Add(-collectWindow)})
+	if err != nil {
+		return nil, fmt.Errorf("collect audit entries: %w", err)
+	}
+
+	items := make([]Item, 0)
+	for _, entry := range entries {
+		var severity Severity
+		var title string
+		switch {
+		case entry.Status == http.StatusForbidden:
+			severity, title = SeverityHigh, "권한 없는 변경 시도: "+entry.Action
+		case entry.Status >= http.StatusInternalServerError:
+			severity, title = SeverityMedium, "관리 작업 실패: "+entry.Action
+		default:
+			continue
+		}
+		items = append(items, Item{
+			ID:        string(CategoryAudit) + ":" + entry.ID,
+			Category:  CategoryAudit,
+			Severity:  severity,
+			Title:     title,
+			Detail:    fmt.Sprintf("%s %s %s (%s, status %d)", entry.Actor, entry.Method, entry.Path, entry.IP, entry.Status),
+			Target:    entry.Target,
+			CreatedAt: entry.Timestamp,
+		})
+	}
+	return items, nil
+}
+
+// ===== Drift =====
+
+// DriftEvents: 드리프트 이벤트 조회자 (drift.Detector)
+type DriftEvents interface {
+	Events(ctx context.Context, limit int) ([]drift.Event, error)
+}
+
+// DriftSource: 배포 기록으로 설명되지 않는 설정 변경
+type DriftSource struct {
+	events DriftEvents
+	now    func() time.Time
+}
+
+// NewDriftSource: 드리프트 소스 생성
+func NewDriftSource(events DriftEvents) *DriftSource {
+	return &DriftSource{events: events, now: time.Now}
+}
+
+// Name: 소스 이름
+func (d *DriftSource) Name() string { return string(CategoryDrift) }
+
+// Collect: 수집 기간 내 예상치 못한 드리프트 이벤트를 항목으로 변환
+func (d *DriftSource) Collect(ctx context.Context) ([]Item, error) {
+	events, err := d.events.Events(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("collect drift events: %w", err)
+	}
+
+	since := d.now().Add(-collectWindow)
+	items := make([]Item, 0)
+	for _, event := range events {
+		if event.Expected || event.DetectedAt.Before(since) {
+			continue
+		}
+		fields := make([]string, 0, len(event.Changes))
+		for _, change := range event.Changes {
+			fields = append(fields, change.Field)
+		}
+		items = append(items, Item{
+			ID:        string(CategoryDrift) + ":" + event.ID,
+			Category:  CategoryDrift,
+			Severity:  SeverityHigh,
+			Title:     event.Container + " 설정 변경 감지",
+			Detail:    strings.Join(fields, ", "),
+			Target:    event.Container,
+			CreatedAt: event.DetectedAt,
+		})
+	}
+	return items, nil
+}
+
+// ===== Probes =====
+
+// ProbeSummaries: 프로브 요약 조회자 (probe.Service)
+type ProbeSummaries interface {
+	List(ctx context.Context) ([]probe.Summary, error)
+}
+
+// ProbeSource: 마지막 실행이 실패한 합성 모니터링 프로브.
+// 실패 시작 시각을 ID에 포함하므로, 복구 후 다시 실패하면 새 항목으로 올라온다.
+type ProbeSource struct {
+	probes ProbeSummaries
+}
+
+// NewProbeSource: 프로브 실패 소스 생성
+func NewProbeSource(probes ProbeSummaries) *ProbeSource {
+	return &ProbeSource{probes: probes}
+}
+
+// Name: 소스 이름
+func (p *ProbeSource) Name() string { return string(CategoryProbe) }
+
+// Collect: 현재 실패 중인 프로브를 항목으로 변환
+func (p *ProbeSource) Collect(ctx context.Context) ([]Item, error) {
+	summaries, err := p.probes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collect probes: %w", err)
+	}
+
+	items := make([]Item, 0)
+	for _, summary := range summaries {
+		if summary.Healthy() || summary.FailingSince == nil {
+			continue
+		}
+		severity := SeverityMedium
+		if summary.Failures >= probeEscalateFailures {
+			severity = SeverityHigh
+		}
+		def := summary.Probe
+		items = append(items, Item{
+			ID:        fmt.Sprintf("%s:%s:%d", CategoryProbe, def.ID, summary.FailingSince.Unix()),
+			Category:  CategoryProbe,
+			Severity:  severity,
+			Title:     fmt.Sprintf("프로브 실패: %s (연속 %d회)", def.Name, summary.Failures),
+			Detail:    fmt.Sprintf("%s %s%s: %s", def.Method, def.Target, def.Path, summary.Last.Error),
+			Target:    def.ID,
+			CreatedAt: *summary.FailingSince,
+		})
+	}
+	return items, nil
+}
+
+// ===== Bots =====
+
+// botClient: 게임 봇 Admin API JSON 조회 클라이언트
+type botClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newBotClient(baseURL string) botClient {
+	return botClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout:   3 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}
+}
+
+func (c botClient) getJSON(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request %s: status %d", path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// pendingAnswer: 바다거북 감독 모드 대기 답변 (turtlesoup model.PendingAnswer)
+type pendingAnswer struct {
+	ID             string    `json:"id"`
+	SessionID      string    `json:"sessionId"`
+	QuestionNumber int       `json:"questionNumber"`
+	Question       string    `json:"question"`
+	Answer         string    `json:"answer"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// SupervisionSource: 감독 모드 세션에서 GM 승인을 기다리는 답변 (바다거북 봇)
+type SupervisionSource struct {
+	client botClient
+}
+
+// NewSupervisionSource: 감독 대기 답변 소스 생성
+func NewSupervisionSource(turtleBotURL string) *SupervisionSource {
+	return &SupervisionSource{client: newBotClient(turtleBotURL)}
+}
+
+// Name: 소스 이름
+func (s *SupervisionSource) Name() string { return string(CategorySupervision) }
+
+// Collect: 감독 세션별 대기 답변 조회
+func (s *SupervisionSource) Collect(ctx context.Context) ([]Item, error) {
+	var sessions struct {
+		Sessions []string `json:"sessions"`
+	}
+	if err := s.client.getJSON(ctx, "/admin/gamemaster/sessions", &sessions); err != nil {
+		return nil, fmt.Errorf("collect supervised sessions: %w", err)
+	}
+
+	items := make([]Item, 0)
+	for _, sessionID := range sessions.Sessions[:min(len(sessions.Sessions), maxSupervisedSessions)] {
+		var supervision struct {
+			Pending *pendingAnswer `json:"pending"`
+		}
+		if err := s.client.getJSON(ctx, "/admin/sessions/"+url.PathEscape(sessionID)+"/supervision", &supervision); err != nil {
+			return nil, fmt.Errorf("collect pending answer: %w", err)
+		}
+		pending := supervision.Pending
+		if pending == nil {
+			continue
+		}
+		items = append(items, Item{
+			ID:        string(CategorySupervision) + ":" + pending.ID,
+			Category:  CategorySupervision,
+			Severity:  SeverityHigh,
+			Title:     fmt.Sprintf("답변 승인 대기 (Q%d)", pending.QuestionNumber),
+			Detail:    pending.Question + " → " + pending.Answer,
+			Target:    pending.SessionID,
+			CreatedAt: pending.CreatedAt,
+		})
+	}
+	return items, nil
+}
+
+// PuzzleSource: 검수 대기(draft) 상태의 바다거북 퍼즐
+type PuzzleSource struct {
+	client botClient
+}
+
+// NewPuzzleSource: 검수 대기 퍼즐 소스 생성
+func NewPuzzleSource(turtleBotURL string) *PuzzleSource {
+	return &PuzzleSource{client: newBotClient(turtleBotURL)}
+}
+
+// Name: 소스 이름
+func (p *PuzzleSource) Name() string { return string(CategoryPuzzle) }
+
+// Collect: draft 퍼즐 목록 조회
+func (p *PuzzleSource) Collect(ctx context.Context) ([]Item, error) {
+	var resp struct {
+		Puzzles []struct {
+			ID        uint64    `json:"id"`
+			Title     string    `json:"title"`
+			AuthorID  string    `json:"authorId"`
+			CreatedAt time.Time `json:"createdAt"`
+		} `json:"puzzles"`
+	}
+	if err := p.client.getJSON(ctx, "/admin/puzzles?status=draft&limit=100", &resp); err != nil {
+		return nil, fmt.Errorf("collect draft puzzles: %w", err)
+	}
+
+	items := make([]Item, 0, len(resp.Puzzles))
+	for _, puzzle := range resp.Puzzles {
+		id := strconv.FormatUint(puzzle.ID, 10)
+		detail := ""
+		if puzzle.AuthorID != "" {
+			detail = "작성자: " + puzzle.AuthorID
+		}
+		items = append(items, Item{
+			ID:        string(CategoryPuzzle) + ":" + id,
+			Category:  CategoryPuzzle,
+			Severity:  SeverityLow,
+			Title:     "퍼즐 검수 대기: " + puzzle.Title,
+			Detail:    detail,
+			Target:    id,
+			CreatedAt: puzzle.CreatedAt,
+		})
+	}
+	return items, nil
+}
+
+// GameSource: 힌트 없이 지나치게 적은 질문으로 정답을 맞힌 스무고개 게임 (정답 유출/부정 의심)
+type GameSource struct {
+	client botClient
+	now    func() time.Time
+}
+
+// NewGameSource: 의심 게임 소스 생성
+func NewGameSource(twentyqBotURL string) *GameSource {
+	return &GameSource{client: newBotClient(twentyqBotURL), now: time.Now}
+}
+
+// Name: 소스 이름
+func (g *GameSource) Name() string { return string(CategoryGame) }
+
+// Collect: 최근 성공 게임 중 의심 게임 선별
+func (g *GameSource) Collect(ctx context.Context) ([]Item, error) {
+	var resp struct {
+		Games []struct {
+			SessionID     string    `json:"sessionId"`
+			ChatID        string    `json:"chatId"`
+			Category      string    `json:"category"`
+			Target        string    `json:"target"`
+			QuestionCount int       `json:"questionCount"`
+			HintCount     int       `json:"hintCount"`
+			CompletedAt   time.Time `json:"completedAt"`
+		} `json:"games"`
+	}
+	if err := g.client.getJSON(ctx, "/admin/games?result=success&limit=100", &resp); err != nil {
+		return nil, fmt.Errorf("collect games: %w", err)
+	}
+
+	since := g.now().Add(-collectWindow)
+	items := make([]Item, 0)
+	for _, game := range resp.Games {
+		if game.CompletedAt.Before(since) || game.HintCount > 0 || game.QuestionCount > suspiciousMaxQuestions {
+			continue
+		}
+		items = append(items, Item{
+			ID:        string(CategoryGame) + ":" + game.SessionID,
+			Category:  CategoryGame,
+			Severity:  SeverityMedium,
+			Title:     fmt.Sprintf("질문 %d개 만에 정답: %s", game.QuestionCount, game.Target),
+			Detail:    fmt.Sprintf("방 %s, 카테고리 %s", game.ChatID, game.Category),
+			Target:    game.SessionID,
+			CreatedAt: game.CompletedAt,
+		})
+	}
+	return items, nil
+}
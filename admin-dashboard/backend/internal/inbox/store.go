@@ -0,0 +1,189 @@
+package inbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	recordKeyPrefix = "inbox:record:"
+	reportKeyPrefix = "inbox:report:"
+	reportIndexKey  = "inbox:reports"
+
+	// retention: 처리 상태/신고 보관 기간 (마지막 변경 기준, 소스 수집 기간보다 길어야 함)
+	retention = 30 * 24 * time.Hour
+)
+
+// Store: 처리 상태와 신고 항목 저장소 인터페이스
+type Store interface {
+	// GetRecords: ID별 처리 상태 조회 (기록이 없는 ID는 결과에서 빠짐)
+	GetRecords(ctx context.Context, ids []string) (map[string]Record, error)
+	SaveRecord(ctx context.Context, id string, record Record) error
+
+	SaveReport(ctx context.Context, item Item) error
+	ListReports(ctx context.Context) ([]Item, error)
+}
+
+// ValkeyStore: Valkey 기반 인박스 저장소 (항목별 키 + 만료, 신고는 ID 집합으로 색인)
+type ValkeyStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 인박스 저장소 생성
+func NewValkeyStore(client valkey.Client, logger *slog.Logger) *ValkeyStore {
+	return &ValkeyStore{client: client, logger: logger}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+// GetRecords: MGET으로 처리 상태 일괄 조회
+func (s *ValkeyStore) GetRecords(ctx context.Context, ids []string) (map[string]Record, error) {
+	records := make(map[string]Record, len(ids))
+	if len(ids) == 0 {
+		return records, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = recordKeyPrefix + id
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	values, err := s.client.Do(ctx, s.client.B().Mget().Key(keys...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("get inbox records: %w", err)
+	}
+	for i, value := range values {
+		raw, err := value.ToString()
+		if err != nil {
+			continue // 기록 없음 (nil)
+		}
+		var record Record
+		if err := json.Unmarshal([]byte(raw), &record); err != nil {
+			s.logger.Warn("inbox_record_decode_failed", slog.String("id", ids[i]), slog.Any("error", err))
+			continue
+		}
+		records[ids[i]] = record
+	}
+	return records, nil
+}
+
+// SaveRecord: 처리 상태 저장 (보관 기간 갱신)
+func (s *ValkeyStore) SaveRecord(ctx context.Context, id string, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal inbox record: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmd := s.client.B().Set().Key(recordKeyPrefix + id).Value(string(data)).Ex(retention).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("save inbox record: %w", err)
+	}
+	return nil
+}
+
+// SaveReport: 신고 항목 저장 및 색인 등록
+func (s *ValkeyStore) SaveReport(ctx context.Context, item Item) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("marshal inbox report: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmds := valkey.Commands{
+		s.client.B().Set().Key(reportKeyPrefix + item.ID).Value(string(data)).Ex(retention).Build(),
+		s.client.B().Sadd().Key(reportIndexKey).Member(item.ID).Build(),
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("save inbox report: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListReports: 보관 중인 신고 항목 조회 (만료된 신고는 색인에서 제거)
+func (s *ValkeyStore) ListReports(ctx context.Context) ([]Item, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	ids, err := s.client.Do(ctx, s.client.B().Smembers().Key(reportIndexKey).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list inbox report ids: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = reportKeyPrefix + id
+	}
+	values, err := s.client.Do(ctx, s.client.B().Mget().Key(keys...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("list inbox reports: %w", err)
+	}
+
+	items := make([]Item, 0, len(values))
+	expired := make([]string, 0)
+	for i, value := range values {
+		raw, err := value.ToString()
+		if err != nil {
+			expired = append(expired, ids[i])
+			continue
+		}
+		var item Item
+		if err := json.Unmarshal([]byte(raw), &item); err != nil {
+			s.logger.Warn("inbox_report_decode_failed", slog.String("id", ids[i]), slog.Any("error", err))
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if len(expired) > 0 {
+		if err := s.client.Do(ctx, s.client.B().Srem().Key(reportIndexKey).Member(expired...).Build()).Error(); err != nil {
+			s.logger.Warn("inbox_report_prune_failed", slog.Any("error", err))
+		}
+	}
+	return items, nil
+}
+
+// reportSource: 저장소의 신고 항목을 인박스 소스로 노출
+type reportSource struct {
+	store Store
+}
+
+func (r *reportSource) Name() string { return string(CategoryReport) }
+
+func (r *reportSource) Collect(ctx context.Context) ([]Item, error) {
+	items, err := r.store.ListReports(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collect reports: %w", err)
+	}
+	return items, nil
+}
+
+func newID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,178 @@
+package latency
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// DefaultDays: 기본 조회 기간(일)
+	DefaultDays = 7
+	// MaxDays: 봇 리포트 API가 허용하는 최대 조회 기간(일)
+	MaxDays = 90
+	// fetchTimeout: 봇 1곳 리포트 조회 타임아웃
+	fetchTimeout = 5 * time.Second
+)
+
+// reportPaths: 봇별 지연 리포트 경로 (게임 봇은 Admin API, 홀로 봇은 /api/holo)
+var reportPaths = map[string]string{
+	"holo":    "/api/holo/latency",
+	"twentyq": "/admin/latency",
+	"turtle":  "/admin/latency",
+}
+
+// Daily: 하루치 명령어 지연 집계 (봇 응답 그대로)
+type Daily struct {
+	Day       string `json:"day"`
+	Samples   int    `json:"samples"`
+	P50Ms     int64  `json:"p50Ms"`
+	P95Ms     int64  `json:"p95Ms"`
+	P99Ms     int64  `json:"p99Ms"`
+	MaxMs     int64  `json:"maxMs"`
+	SlowCount int    `json:"slowCount"`
+}
+
+// CommandReport: 봇 하나의 명령어별 기간 집계
+type CommandReport struct {
+	Bot        string  `json:"bot"`
+	Command    string  `json:"command"`
+	Samples    int     `json:"samples"`
+	Days       int     `json:"days"`
+	BreachDays int     `json:"breachDays"`
+	WorstP95Ms int64   `json:"worstP95Ms"`
+	SlowRatio  float64 `json:"slowRatio"`
+	Daily      []Daily `json:"daily"`
+}
+
+// BotSLA: 봇별 SLA 기준
+type BotSLA struct {
+	Bot   string `json:"bot"`
+	SLAMs int64  `json:"slaMs"`
+}
+
+// Unavailable: 리포트를 가져오지 못한 봇
+type Unavailable struct {
+	Bot   string `json:"bot"`
+	Error string `json:"error"`
+}
+
+// Report: 전체 봇의 명령어 지연 리포트 (SLA 위반 일수가 많은 명령어가 앞에 옴)
+type Report struct {
+	Days        int             `json:"days"`
+	Bots        []BotSLA        `json:"bots"`
+	Commands    []CommandReport `json:"commands"`
+	Unavailable []Unavailable   `json:"unavailable"`
+}
+
+type botReport struct {
+	SLAMs    int64           `json:"slaMs"`
+	Commands []CommandReport `json:"commands"`
+}
+
+// Aggregator: 각 봇의 명령어 지연 리포트를 모아 하나로 합칩니다.
+type Aggregator struct {
+	targets    map[string]string // 봇 이름 → base URL
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewAggregator: 집계기 생성 (빈 URL과 리포트 경로를 모르는 봇은 제외)
+func NewAggregator(targets map[string]string, logger *slog.Logger) *Aggregator {
+	available := make(map[string]string, len(targets))
+	for name, baseURL := range targets {
+		baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+		if _, ok := reportPaths[name]; ok && baseURL != "" {
+			available[name] = baseURL
+		}
+	}
+	return &Aggregator{
+		targets: available,
+		httpClient: &http.Client{
+			Timeout:   fetchTimeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+		logger: logger,
+	}
+}
+
+// Report: 모든 봇에서 최근 days일 리포트를 동시에 조회해 합칩니다. 실패한 봇은 Unavailable에 남깁니다.
+func (a *Aggregator) Report(ctx context.Context, days int) Report {
+	type result struct {
+		bot    string
+		report botReport
+		err    error
+	}
+
+	results := make([]result, 0, len(a.targets))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for bot, baseURL := range a.targets {
+		wg.Go(func() {
+			report, err := a.fetch(ctx, baseURL+reportPaths[bot]+"?days="+strconv.Itoa(days))
+			mu.Lock()
+			results = append(results, result{bot: bot, report: report, err: err})
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	out := Report{Days: days, Bots: []BotSLA{}, Commands: []CommandReport{}, Unavailable: []Unavailable{}}
+	for _, r := range results {
+		if r.err != nil {
+			a.logger.Warn("latency_report_fetch_failed", slog.String("bot", r.bot), slog.Any("error", r.err))
+			out.Unavailable = append(out.Unavailable, Unavailable{Bot: r.bot, Error: r.err.Error()})
+			continue
+		}
+		out.Bots = append(out.Bots, BotSLA{Bot: r.bot, SLAMs: r.report.SLAMs})
+		for _, command := range r.report.Commands {
+			command.Bot = r.bot
+			out.Commands = append(out.Commands, command)
+		}
+	}
+
+	slices.SortFunc(out.Bots, func(x, y BotSLA) int { return cmp.Compare(x.Bot, y.Bot) })
+	slices.SortFunc(out.Unavailable, func(x, y Unavailable) int { return cmp.Compare(x.Bot, y.Bot) })
+	slices.SortFunc(out.Commands, func(x, y CommandReport) int {
+		return cmp.Or(
+			cmp.Compare(y.BreachDays, x.BreachDays),
+			cmp.Compare(y.WorstP95Ms, x.WorstP95Ms),
+			cmp.Compare(x.Bot, y.Bot),
+			cmp.Compare(x.Command, y.Command),
+		)
+	})
+	return out
+}
+
+func (a *Aggregator) fetch(ctx context.Context, url string) (botReport, error) {
+	var report botReport
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return report, fmt.Errorf("create request: %w", err)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return report, fmt.Errorf("request latency report: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return report, fmt.Errorf("request latency report: status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return report, fmt.Errorf("decode latency report: %w", err)
+	}
+	return report, nil
+}
@@ -0,0 +1,72 @@
+package latency
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAggregator_MergesBotReports(t *testing.T) {
+	holo := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/holo/latency" || r.URL.Query().Get("days") != "3" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, `{"bot":"holo","slaMs":5000,"commands":[
+			{"command":"schedule","samples":10,"days":3,"breachDays":1,"worstP95Ms":7000,"daily":[{"day":"2026-10-18","p95Ms":7000}]},
+			{"command":"help","samples":4,"days":2,"breachDays":0,"worstP95Ms":40,"daily":[]}
+		]}`)
+	}))
+	defer holo.Close()
+
+	twentyq := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/latency" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, `{"bot":"twentyq","slaMs":3000,"commands":[
+			{"command":"hints","samples":20,"days":3,"breachDays":2,"worstP95Ms":6000,"daily":[]}
+		]}`)
+	}))
+	defer twentyq.Close()
+
+	turtle := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer turtle.Close()
+
+	aggregator := NewAggregator(map[string]string{
+		"holo":    holo.URL + "/",
+		"twentyq": twentyq.URL,
+		"turtle":  turtle.URL,
+		"llm":     "http://llm:40527",
+		"other":   "",
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if len(aggregator.targets) != 3 {
+		t.Fatalf("only bots with report paths must be targeted: %v", aggregator.targets)
+	}
+
+	report := aggregator.Report(context.Background(), 3)
+	if report.Days != 3 || len(report.Bots) != 2 || report.Bots[0].Bot != "holo" || report.Bots[1].SLAMs != 3000 {
+		t.Fatalf("unexpected bots: %+v", report.Bots)
+	}
+	if len(report.Unavailable) != 1 || report.Unavailable[0].Bot != "turtle" {
+		t.Fatalf("failing bot must be reported unavailable: %+v", report.Unavailable)
+	}
+
+	want := []string{"twentyq/hints", "holo/schedule", "holo/help"}
+	if len(report.Commands) != len(want) {
+		t.Fatalf("unexpected commands: %+v", report.Commands)
+	}
+	for i, command := range report.Commands {
+		if got := command.Bot + "/" + command.Command; got != want[i] {
+			t.Fatalf("command %d: got %s, want %s", i, got, want[i])
+		}
+	}
+	if report.Commands[1].Daily[0].P95Ms != 7000 {
+		t.Fatalf("daily rows must be preserved: %+v", report.Commands[1])
+	}
+}
@@ -0,0 +1,206 @@
+package logs
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	// DefaultBundleFileBytes: 파일당 기본 수집 크기 (끝에서부터)
+	DefaultBundleFileBytes int64 = 5 << 20
+	// MaxBundleFileBytes: 파일당 수집 크기 상한
+	MaxBundleFileBytes int64 = 50 << 20
+	// MaxBundleTotalBytes: 번들 하나에 담는 원본 크기 합계 상한 (압축 전)
+	MaxBundleTotalBytes int64 = 200 << 20
+)
+
+// ErrBundleFull: 번들 전체 크기 상한에 도달해 더 담을 수 없음
+var ErrBundleFull = errors.New("bundle size limit reached")
+
+// BundleEntry: 번들에 담긴(또는 담지 못한) 항목 정보 (manifest.json에 기록)
+type BundleEntry struct {
+	Name string `json:"name"`
+	// Source: 원본 로그 파일 경로 또는 컨테이너 이름
+	Source string `json:"source,omitempty"`
+	Size   int64  `json:"size"`
+	// OriginalSize: 원본 파일 크기 (끝부분만 담았으면 Size보다 큼)
+	OriginalSize int64  `json:"originalSize,omitempty"`
+	Truncated    bool   `json:"truncated,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// BundleManifest: 번들 구성 요약
+type BundleManifest struct {
+	GeneratedAt time.Time     `json:"generatedAt"`
+	GeneratedBy string        `json:"generatedBy,omitempty"`
+	MaxFileSize int64         `json:"maxFileSize"`
+	MaxTotal    int64         `json:"maxTotal"`
+	Entries     []BundleEntry `json:"entries"`
+}
+
+// BundleWriter: 로그 파일 끝부분과 부가 자료를 tar.gz로 묶어 스트리밍하는 작성기
+// 원본 크기 합계가 상한을 넘으면 이후 항목은 잘라 담거나 건너뛰고 매니페스트에 남깁니다.
+type BundleWriter struct {
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	now      time.Time
+	manifest BundleManifest
+	written  int64
+}
+
+// NewBundleWriter: w로 tar.gz 번들을 쓰는 작성기를 생성합니다. 다 쓴 뒤 Close를 호출해야 합니다.
+func NewBundleWriter(w io.Writer, maxFileSize, maxTotal int64, generatedBy string, now time.Time) *BundleWriter {
+	gz := gzip.NewWriter(w)
+	return &BundleWriter{
+		gz:  gz,
+		tw:  tar.NewWriter(gz),
+		now: now,
+		manifest: BundleManifest{
+			GeneratedAt: now,
+			GeneratedBy: generatedBy,
+			MaxFileSize: maxFileSize,
+			MaxTotal:    maxTotal,
+			Entries:     []BundleEntry{},
+		},
+	}
+}
+
+// remaining: 전체 상한까지 남은 크기
+func (b *BundleWriter) remaining() int64 {
+	return max(b.manifest.MaxTotal-b.written, 0)
+}
+
+// AddFileTail: 파일 끝에서 최대 MaxFileSize만큼 담습니다.
+// 중간부터 읽게 되면 첫 줄바꿈 이후부터 담아 잘린 줄이 생기지 않도록 합니다.
+// 파일이 없거나 읽을 수 없으면 오류를 매니페스트에 남기고 nil을 반환합니다. (쓰기 실패만 오류로 반환)
+func (b *BundleWriter) AddFileTail(name, path string) error {
+	entry := BundleEntry{Name: name, Source: path}
+
+	limit := min(b.manifest.MaxFileSize, b.remaining())
+	if limit <= 0 {
+		entry.Error = ErrBundleFull.Error()
+		b.manifest.Entries = append(b.manifest.Entries, entry)
+		return nil
+	}
+
+	data, size, err := readTail(path, limit)
+	if err != nil {
+		entry.Error = err.Error()
+		b.manifest.Entries = append(b.manifest.Entries, entry)
+		return nil
+	}
+	entry.OriginalSize = size
+	entry.Truncated = int64(len(data)) < size
+
+	return b.add(entry, data)
+}
+
+// AddBytes: 메모리의 자료(컨테이너 inspect 결과 등)를 담습니다. 전체 상한을 넘으면 건너뜁니다.
+func (b *BundleWriter) AddBytes(name, source string, data []byte) error {
+	entry := BundleEntry{Name: name, Source: source}
+	if int64(len(data)) > b.remaining() {
+		entry.Error = ErrBundleFull.Error()
+		b.manifest.Entries = append(b.manifest.Entries, entry)
+		return nil
+	}
+	return b.add(entry, data)
+}
+
+// AddError: 수집에 실패한 항목을 매니페스트에만 남깁니다.
+func (b *BundleWriter) AddError(name, source string, err error) {
+	b.manifest.Entries = append(b.manifest.Entries, BundleEntry{Name: name, Source: source, Error: err.Error()})
+}
+
+func (b *BundleWriter) add(entry BundleEntry, data []byte) error {
+	if err := b.writeFile(entry.Name, data); err != nil {
+		return err
+	}
+	entry.Size = int64(len(data))
+	b.written += entry.Size
+	b.manifest.Entries = append(b.manifest.Entries, entry)
+	return nil
+}
+
+func (b *BundleWriter) writeFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: b.now,
+	}
+	if err := b.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("write tar header %s: %w", name, err)
+	}
+	if _, err := b.tw.Write(data); err != nil {
+		return fmt.Errorf("write tar entry %s: %w", name, err)
+	}
+	return nil
+}
+
+// Manifest: 지금까지 담은 항목 요약을 반환합니다.
+func (b *BundleWriter) Manifest() BundleManifest {
+	return b.manifest
+}
+
+// Close: manifest.json을 마지막 항목으로 쓰고 tar/gzip 스트림을 닫습니다.
+func (b *BundleWriter) Close() error {
+	manifest, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := b.writeFile("manifest.json", manifest); err != nil {
+		return err
+	}
+	if err := b.tw.Close(); err != nil {
+		return fmt.Errorf("close tar: %w", err)
+	}
+	if err := b.gz.Close(); err != nil {
+		return fmt.Errorf("close gzip: %w", err)
+	}
+	return nil
+}
+
+// readTail: 파일 끝에서 최대 limit 바이트를 읽고 원본 크기와 함께 반환합니다.
+func readTail(path string, limit int64) ([]byte, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("open log file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, fmt.Errorf("stat log file: %w", err)
+	}
+	size := info.Size()
+
+	offset := max(size-limit, 0)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, 0, fmt.Errorf("seek log file: %w", err)
+	}
+
+	reader := bufio.NewReader(io.LimitReader(f, size-offset))
+	if offset > 0 {
+		// 잘린 첫 줄은 버림
+		if _, err := reader.ReadBytes('\n'); err != nil {
+			if errors.Is(err, io.EOF) {
+				return []byte{}, size, nil
+			}
+			return nil, 0, fmt.Errorf("read log file: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(reader); err != nil {
+		return nil, 0, fmt.Errorf("read log file: %w", err)
+	}
+	return buf.Bytes(), size, nil
+}
@@ -0,0 +1,99 @@
+package logs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func readBundle(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip reader: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	files := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("tar next: %v", err)
+		}
+		body, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("read %s: %v", header.Name, err)
+		}
+		files[header.Name] = string(body)
+	}
+	return files
+}
+
+func TestBundleWriter(t *testing.T) {
+	dir := t.TempDir()
+	small := filepath.Join(dir, "bot.log")
+	if err := os.WriteFile(small, []byte("line1\nline2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	large := filepath.Join(dir, "llm.log")
+	if err := os.WriteFile(large, []byte(strings.Repeat("0123456789\n", 10)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	b := NewBundleWriter(&buf, 30, 50, "admin", time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+	if err := b.AddFileTail("logs/bot.log", small); err != nil {
+		t.Fatal(err)
+	}
+	// 30바이트 끝부분: 잘린 첫 줄을 버리고 온전한 줄만 남아야 함
+	if err := b.AddFileTail("logs/llm.log", large); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddFileTail("logs/missing.log", filepath.Join(dir, "missing.log")); err != nil {
+		t.Fatal(err)
+	}
+	// 전체 상한(50) 초과
+	if err := b.AddBytes("inspect/bot.json", "bot", bytes.Repeat([]byte("x"), 40)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	files := readBundle(t, buf.Bytes())
+	if files["logs/bot.log"] != "line1\nline2\n" {
+		t.Errorf("unexpected small log: %q", files["logs/bot.log"])
+	}
+	if got := files["logs/llm.log"]; got != strings.Repeat("0123456789\n", 2) {
+		t.Errorf("tail must start at a line boundary, got %q", got)
+	}
+	if _, ok := files["inspect/bot.json"]; ok {
+		t.Error("entry over total cap must be skipped")
+	}
+
+	var manifest BundleManifest
+	if err := json.Unmarshal([]byte(files["manifest.json"]), &manifest); err != nil {
+		t.Fatalf("manifest: %v", err)
+	}
+	if manifest.GeneratedBy != "admin" || len(manifest.Entries) != 4 {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+	if e := manifest.Entries[1]; !e.Truncated || e.OriginalSize != 110 || e.Size != 22 {
+		t.Errorf("unexpected truncated entry: %+v", e)
+	}
+	if manifest.Entries[2].Error == "" {
+		t.Error("missing file must be recorded with an error")
+	}
+	if manifest.Entries[3].Error != ErrBundleFull.Error() {
+		t.Errorf("unexpected over-cap entry: %+v", manifest.Entries[3])
+	}
+}
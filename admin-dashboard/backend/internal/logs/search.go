@@ -0,0 +1,162 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+const (
+	// DefaultSearchLimit: 검색 결과 기본 개수
+	DefaultSearchLimit = 200
+	// MaxSearchLimit: 검색 결과 최대 개수 (스트리밍 응답 기준)
+	MaxSearchLimit = 10000
+	// maxSearchLineBytes: 한 줄 최대 길이 (초과하는 줄은 잘라서 검사)
+	maxSearchLineBytes = 1 << 20
+)
+
+// SearchOptions: 로그 검색 조건 (비어 있는 조건은 적용하지 않음)
+type SearchOptions struct {
+	Pattern *regexp.Regexp
+	// Levels: 포함할 레벨 (DEBUG/INFO/WARN/ERROR, 대문자)
+	Levels []string
+	From   time.Time
+	To     time.Time
+	Limit  int
+}
+
+// SearchMatch: 조건에 맞는 로그 한 줄
+type SearchMatch struct {
+	Line  int        `json:"line"`
+	Time  *time.Time `json:"time,omitempty"`
+	Level string     `json:"level,omitempty"`
+	Text  string     `json:"text"`
+}
+
+// SearchSummary: 검색 결과 요약
+type SearchSummary struct {
+	Scanned   int  `json:"scanned"`
+	Matched   int  `json:"matched"`
+	Truncated bool `json:"truncated"`
+}
+
+// Search: 로그 파일을 처음부터 읽으며 조건에 맞는 줄을 fn으로 전달합니다.
+// slog JSON 줄은 time/level 필드를, 텍스트 줄은 "RFC3339시각 레벨" 머리말을 해석하며,
+// 시각이나 레벨을 알 수 없는 줄은 해당 조건이 있으면 제외합니다. Limit에 도달하면 Truncated로 표시하고 멈춥니다.
+func Search(ctx context.Context, path string, opts SearchOptions, fn func(SearchMatch) error) (SearchSummary, error) {
+	var summary SearchSummary
+	file, err := os.Open(path)
+	if err != nil {
+		return summary, fmt.Errorf("open log file: %w", err)
+	}
+	defer file.Close()
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxSearchLineBytes)
+	for scanner.Scan() {
+		summary.Scanned++
+		if summary.Scanned%1000 == 0 && ctx.Err() != nil {
+			return summary, fmt.Errorf("search log file: %w", ctx.Err())
+		}
+
+		text := scanner.Text()
+		if opts.Pattern != nil && !opts.Pattern.MatchString(text) {
+			continue
+		}
+		at, level := parseLine(text)
+		if !matchesLevel(level, opts.Levels) || !matchesRange(at, opts.From, opts.To) {
+			continue
+		}
+
+		if summary.Matched == limit {
+			summary.Truncated = true
+			break
+		}
+		summary.Matched++
+		match := SearchMatch{Line: summary.Scanned, Level: level, Text: text}
+		if !at.IsZero() {
+			match.Time = &at
+		}
+		if err := fn(match); err != nil {
+			return summary, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return summary, fmt.Errorf("scan log file: %w", err)
+	}
+	return summary, nil
+}
+
+// NormalizeLevel: 레벨 이름을 slog 표기(DEBUG/INFO/WARN/ERROR)로 맞춥니다. 알 수 없으면 빈 문자열.
+func NormalizeLevel(level string) string {
+	switch strings.ToUpper(strings.TrimSpace(level)) {
+	case "DEBUG", "DBG":
+		return "DEBUG"
+	case "INFO", "INF":
+		return "INFO"
+	case "WARN", "WARNING", "WRN":
+		return "WARN"
+	case "ERROR", "ERR":
+		return "ERROR"
+	default:
+		return ""
+	}
+}
+
+// parseLine: 줄에서 시각과 레벨을 추출 (slog JSON 또는 tint 텍스트 형식)
+func parseLine(text string) (time.Time, string) {
+	if strings.HasPrefix(text, "{") {
+		var entry struct {
+			Time  time.Time `json:"time"`
+			Level string    `json:"level"`
+		}
+		if err := json.Unmarshal([]byte(text), &entry); err == nil {
+			return entry.Time, NormalizeLevel(entry.Level)
+		}
+		return time.Time{}, ""
+	}
+
+	rawTime, rest, _ := strings.Cut(text, " ")
+	at, err := time.Parse(time.RFC3339Nano, rawTime)
+	if err != nil {
+		return time.Time{}, ""
+	}
+	rawLevel, _, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	// "WARN+2" 같은 사용자 정의 레벨은 기본 레벨로 취급
+	rawLevel, _, _ = strings.Cut(rawLevel, "+")
+	return at, NormalizeLevel(rawLevel)
+}
+
+func matchesLevel(level string, levels []string) bool {
+	if len(levels) == 0 {
+		return true
+	}
+	for _, want := range levels {
+		if level == want {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRange(at, from, to time.Time) bool {
+	if from.IsZero() && to.IsZero() {
+		return true
+	}
+	if at.IsZero() {
+		return false
+	}
+	return (from.IsZero() || !at.Before(from)) && (to.IsZero() || !at.After(to))
+}
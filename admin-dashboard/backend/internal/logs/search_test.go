@@ -0,0 +1,94 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleLog = `{"time":"2026-10-18T10:00:00+09:00","level":"INFO","msg":"server started"}
+{"time":"2026-10-18T10:05:00+09:00","level":"ERROR","msg":"valkey dial failed","error":"connection refused"}
+2026-10-18T10:06:00+09:00 WRN cache.go:12 valkey reconnecting attempt=2
+not a structured line mentioning valkey
+2026-10-18T10:10:00+09:00 ERR cache.go:40 valkey gave up
+`
+
+func writeSampleLog(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "combined.log")
+	if err := os.WriteFile(path, []byte(sampleLog), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func collect(t *testing.T, path string, opts SearchOptions) ([]SearchMatch, SearchSummary) {
+	t.Helper()
+	var matches []SearchMatch
+	summary, err := Search(context.Background(), path, opts, func(m SearchMatch) error {
+		matches = append(matches, m)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	return matches, summary
+}
+
+func TestSearch_PatternLevelAndRange(t *testing.T) {
+	path := writeSampleLog(t)
+
+	matches, summary := collect(t, path, SearchOptions{Pattern: regexp.MustCompile(`valkey`)})
+	if len(matches) != 4 || summary.Scanned != 5 || summary.Truncated {
+		t.Fatalf("unexpected pattern matches: %+v %+v", matches, summary)
+	}
+	if matches[2].Time != nil || matches[2].Level != "" || matches[2].Line != 4 {
+		t.Fatalf("unstructured line must keep no time/level: %+v", matches[2])
+	}
+
+	matches, _ = collect(t, path, SearchOptions{Levels: []string{"ERROR"}})
+	if len(matches) != 2 || matches[0].Line != 2 || matches[1].Line != 5 {
+		t.Fatalf("level filter must match JSON and text lines: %+v", matches)
+	}
+
+	kst := time.FixedZone("KST", 9*3600)
+	matches, _ = collect(t, path, SearchOptions{
+		From: time.Date(2026, 10, 18, 10, 5, 0, 0, kst),
+		To:   time.Date(2026, 10, 18, 1, 6, 0, 0, time.UTC),
+	})
+	if len(matches) != 2 || matches[0].Level != "ERROR" || matches[1].Level != "WARN" {
+		t.Fatalf("time range must be inclusive and skip unknown times: %+v", matches)
+	}
+}
+
+func TestSearch_LimitAndCallbackError(t *testing.T) {
+	path := writeSampleLog(t)
+
+	matches, summary := collect(t, path, SearchOptions{Limit: 2})
+	if len(matches) != 2 || !summary.Truncated || summary.Matched != 2 {
+		t.Fatalf("limit must truncate: %+v %+v", matches, summary)
+	}
+
+	stop := errors.New("client gone")
+	_, err := Search(context.Background(), path, SearchOptions{}, func(SearchMatch) error { return stop })
+	if !errors.Is(err, stop) {
+		t.Fatalf("callback error must stop search, got %v", err)
+	}
+
+	if _, err := Search(context.Background(), filepath.Join(t.TempDir(), "missing.log"), SearchOptions{}, nil); err == nil || !strings.Contains(err.Error(), "open log file") {
+		t.Fatalf("expected open error, got %v", err)
+	}
+}
+
+func TestNormalizeLevel(t *testing.T) {
+	for in, want := range map[string]string{"inf": "INFO", "Warning": "WARN", "ERR": "ERROR", "debug": "DEBUG", "trace": ""} {
+		if got := NormalizeLevel(in); got != want {
+			t.Errorf("NormalizeLevel(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
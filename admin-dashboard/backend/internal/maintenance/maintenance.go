@@ -0,0 +1,129 @@
+// Package maintenance: 전역 점검 공지 (대시보드 배너와 상태 API에 함께 노출)
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxMessageLength: 공지 문구 최대 길이 (문자 수)
+const MaxMessageLength = 500
+
+// 공지 심각도
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// ErrInvalid: 공지 검증 실패
+var ErrInvalid = errors.New("invalid maintenance announcement")
+
+// Announcement: 현재 점검 공지
+type Announcement struct {
+	Message  string `json:"message"`
+	Severity string `json:"severity"` // info | warning | critical
+	// ETA: 예상 종료 시각 (안내용, 지나도 공지는 해제할 때까지 유지)
+	ETA   *time.Time `json:"eta,omitempty"`
+	SetBy string     `json:"setBy,omitempty"`
+	SetAt time.Time  `json:"setAt"`
+}
+
+// Input: 공지 설정 요청 값
+type Input struct {
+	Message  string
+	Severity string // 비우면 info
+	ETA      *time.Time
+}
+
+// Store: 점검 공지 저장소 인터페이스 (공지는 전역에 하나)
+type Store interface {
+	// Get: 현재 공지 (없으면 nil)
+	Get(ctx context.Context) (*Announcement, error)
+	Set(ctx context.Context, announcement Announcement) error
+	// Clear: 공지 해제 (해제할 공지가 있었는지 반환)
+	Clear(ctx context.Context) (bool, error)
+}
+
+// Service: 점검 공지 설정/해제/조회
+type Service struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewService: 점검 공지 서비스 생성
+func NewService(store Store) *Service {
+	return &Service{store: store, now: time.Now}
+}
+
+// Current: 현재 공지 (없으면 nil)
+func (s *Service) Current(ctx context.Context) (*Announcement, error) {
+	announcement, err := s.store.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get maintenance announcement: %w", err)
+	}
+	return announcement, nil
+}
+
+// Set: 공지를 검증해 설정합니다. 기존 공지는 덮어씁니다.
+func (s *Service) Set(ctx context.Context, input Input, actor string) (*Announcement, error) {
+	now := s.now()
+	announcement, err := input.toAnnouncement(now)
+	if err != nil {
+		return nil, err
+	}
+	announcement.SetBy = actor
+
+	if err := s.store.Set(ctx, announcement); err != nil {
+		return nil, fmt.Errorf("set maintenance announcement: %w", err)
+	}
+	return &announcement, nil
+}
+
+// Clear: 공지 해제 (공지가 없었으면 false)
+func (s *Service) Clear(ctx context.Context) (bool, error) {
+	cleared, err := s.store.Clear(ctx)
+	if err != nil {
+		return false, fmt.Errorf("clear maintenance announcement: %w", err)
+	}
+	return cleared, nil
+}
+
+func (in Input) toAnnouncement(now time.Time) (Announcement, error) {
+	message := strings.TrimSpace(in.Message)
+	if message == "" {
+		return Announcement{}, fmt.Errorf("%w: message is required", ErrInvalid)
+	}
+	if utf8.RuneCountInString(message) > MaxMessageLength {
+		return Announcement{}, fmt.Errorf("%w: message exceeds %d characters", ErrInvalid, MaxMessageLength)
+	}
+
+	severity := strings.ToLower(strings.TrimSpace(in.Severity))
+	switch severity {
+	case "":
+		severity = SeverityInfo
+	case SeverityInfo, SeverityWarning, SeverityCritical:
+	default:
+		return Announcement{}, fmt.Errorf("%w: severity must be info, warning or critical", ErrInvalid)
+	}
+
+	var eta *time.Time
+	if in.ETA != nil {
+		if !in.ETA.After(now) {
+			return Announcement{}, fmt.Errorf("%w: eta must be in the future", ErrInvalid)
+		}
+		utc := in.ETA.UTC()
+		eta = &utc
+	}
+
+	return Announcement{
+		Message:  message,
+		Severity: severity,
+		ETA:      eta,
+		SetAt:    now.UTC(),
+	}, nil
+}
@@ -0,0 +1,99 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	mu           sync.Mutex
+	announcement *Announcement
+}
+
+func (m *memoryStore) Get(context.Context) (*Announcement, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.announcement == nil {
+		return nil, nil
+	}
+	copied := *m.announcement
+	return &copied, nil
+}
+
+func (m *memoryStore) Set(_ context.Context, announcement Announcement) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.announcement = &announcement
+	return nil
+}
+
+func (m *memoryStore) Clear(context.Context) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	had := m.announcement != nil
+	m.announcement = nil
+	return had, nil
+}
+
+func TestService_SetAndClear(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	svc := NewService(&memoryStore{})
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if current, err := svc.Current(ctx); err != nil || current != nil {
+		t.Fatalf("expected no announcement, got %+v, %v", current, err)
+	}
+
+	eta := now.Add(30 * time.Minute).In(time.FixedZone("KST", 9*60*60))
+	set, err := svc.Set(ctx, Input{Message: "  DB 점검 중입니다  ", Severity: "Warning", ETA: &eta}, "alice")
+	if err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if set.Message != "DB 점검 중입니다" || set.Severity != SeverityWarning || set.SetBy != "alice" || !set.SetAt.Equal(now) {
+		t.Fatalf("unexpected announcement: %+v", set)
+	}
+	if set.ETA == nil || !set.ETA.Equal(eta) || set.ETA.Location() != time.UTC {
+		t.Fatalf("eta must be stored in UTC: %v", set.ETA)
+	}
+
+	current, err := svc.Current(ctx)
+	if err != nil || current == nil || current.Message != set.Message {
+		t.Fatalf("expected stored announcement, got %+v, %v", current, err)
+	}
+
+	if cleared, err := svc.Clear(ctx); err != nil || !cleared {
+		t.Fatalf("expected clear, got %v, %v", cleared, err)
+	}
+	if cleared, err := svc.Clear(ctx); err != nil || cleared {
+		t.Fatalf("second clear must report nothing to clear, got %v, %v", cleared, err)
+	}
+}
+
+func TestService_SetValidation(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	svc := NewService(&memoryStore{})
+	svc.now = func() time.Time { return now }
+	past := now.Add(-time.Minute)
+
+	tests := map[string]Input{
+		"empty message":    {Message: "   "},
+		"too long message": {Message: strings.Repeat("점", MaxMessageLength+1)},
+		"unknown severity": {Message: "점검", Severity: "fatal"},
+		"past eta":         {Message: "점검", ETA: &past},
+	}
+	for name, input := range tests {
+		if _, err := svc.Set(context.Background(), input, "alice"); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: expected ErrInvalid, got %v", name, err)
+		}
+	}
+
+	set, err := svc.Set(context.Background(), Input{Message: strings.Repeat("점", MaxMessageLength)}, "")
+	if err != nil || set.Severity != SeverityInfo || set.ETA != nil {
+		t.Fatalf("expected default info announcement, got %+v, %v", set, err)
+	}
+}
@@ -0,0 +1,78 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const announcementKey = "admin:maintenance"
+
+// ValkeyStore: Valkey 기반 점검 공지 저장소 (JSON 문자열 키 하나)
+type ValkeyStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 점검 공지 저장소 생성
+func NewValkeyStore(client valkey.Client, logger *slog.Logger) *ValkeyStore {
+	return &ValkeyStore{client: client, logger: logger}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+// Get: 현재 공지 (없으면 nil)
+func (s *ValkeyStore) Get(ctx context.Context) (*Announcement, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Get().Key(announcementKey).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get announcement: %w", err)
+	}
+
+	var announcement Announcement
+	if err := json.Unmarshal([]byte(raw), &announcement); err != nil {
+		// 깨진 값 때문에 배너/상태 응답이 매번 실패하지 않도록 공지 없음으로 취급
+		s.logger.Warn("maintenance_decode_failed", slog.Any("error", err))
+		return nil, nil
+	}
+	return &announcement, nil
+}
+
+// Set: 공지 저장 (덮어쓰기)
+func (s *ValkeyStore) Set(ctx context.Context, announcement Announcement) error {
+	data, err := json.Marshal(announcement)
+	if err != nil {
+		return fmt.Errorf("marshal announcement: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if err := s.client.Do(ctx, s.client.B().Set().Key(announcementKey).Value(string(data)).Build()).Error(); err != nil {
+		return fmt.Errorf("set announcement: %w", err)
+	}
+	return nil
+}
+
+// Clear: 공지 삭제
+func (s *ValkeyStore) Clear(ctx context.Context) (bool, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	deleted, err := s.client.Do(ctx, s.client.B().Del().Key(announcementKey).Build()).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("clear announcement: %w", err)
+	}
+	return deleted > 0, nil
+}
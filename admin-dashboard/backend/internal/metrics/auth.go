@@ -1,4 +1,4 @@
-// Package metrics: Prometheus 메트릭 엔드포인트 보호 유틸리티
+// Package metrics: Prometheus 메트릭 엔드포인트 보호 및 봇 메트릭 단기 수집/범위 조회
 package metrics
 
 import (
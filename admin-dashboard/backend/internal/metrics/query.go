@@ -0,0 +1,300 @@
+package metrics
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// 조회 함수
+const (
+	// FuncRaw: 각 시점의 마지막 샘플 값
+	FuncRaw = "raw"
+	// FuncRate: 카운터의 초당 증가율 (리셋 보정)
+	FuncRate = "rate"
+	// FuncQuantile: 히스토그램 _bucket 증가율로 계산한 분위수 (모든 시계열 합산)
+	FuncQuantile = "quantile"
+)
+
+const (
+	// MaxPoints: 시계열당 최대 반환 지점 수
+	MaxPoints = 1000
+	// DefaultRange: start 미지정 시 조회 범위
+	DefaultRange = 15 * time.Minute
+	// defaultTargetPoints: step 미지정 시 목표 지점 수
+	defaultTargetPoints = 240
+)
+
+var (
+	// ErrUnknownTarget: 수집 대상이 아님
+	ErrUnknownTarget = errors.New("unknown metrics target")
+	// ErrInvalidQuery: 조회 조건 검증 실패
+	ErrInvalidQuery = errors.New("invalid metrics query")
+)
+
+// Query: 범위 조회 조건
+type Query struct {
+	Target   string
+	Metric   string
+	Func     string
+	Quantile float64           // FuncQuantile 전용 (0 < q < 1)
+	Matchers map[string]string // 레이블 일치 조건
+	Sum      bool              // 일치한 시계열을 하나로 합산 (FuncQuantile은 항상 합산)
+	Start    time.Time
+	End      time.Time
+	Step     time.Duration
+}
+
+// SeriesResult: 조회 결과 시계열
+type SeriesResult struct {
+	Labels map[string]string `json:"labels"`
+	Points []Point           `json:"points"`
+}
+
+// normalize: 기본값(함수/범위/step)을 채우고 조건을 검증합니다.
+func (q *Query) normalize(now time.Time, interval time.Duration) error {
+	if q.Func == "" {
+		q.Func = FuncRaw
+	}
+	if q.End.IsZero() {
+		q.End = now
+	}
+	if q.Start.IsZero() {
+		q.Start = q.End.Add(-DefaultRange)
+	}
+	if q.Step <= 0 {
+		q.Step = max(interval, (q.End.Sub(q.Start) / defaultTargetPoints).Truncate(time.Second))
+	}
+
+	switch {
+	case q.Metric == "":
+		return fmt.Errorf("%w: metric is required", ErrInvalidQuery)
+	case q.Func != FuncRaw && q.Func != FuncRate && q.Func != FuncQuantile:
+		return fmt.Errorf("%w: fn must be one of raw, rate, quantile", ErrInvalidQuery)
+	case q.Func == FuncQuantile && (q.Quantile <= 0 || q.Quantile >= 1):
+		return fmt.Errorf("%w: q must be between 0 and 1", ErrInvalidQuery)
+	case !q.End.After(q.Start):
+		return fmt.Errorf("%w: end must be after start", ErrInvalidQuery)
+	case q.Step < time.Second:
+		return fmt.Errorf("%w: step must be at least 1s", ErrInvalidQuery)
+	case int(q.End.Sub(q.Start)/q.Step) >= MaxPoints:
+		return fmt.Errorf("%w: too many points (max %d), increase step", ErrInvalidQuery, MaxPoints)
+	}
+	return nil
+}
+
+// Query: 보관 중인 시계열을 step 간격으로 평가합니다.
+// rate/quantile 계산 창은 step과 수집 주기 4배 중 큰 값입니다.
+func (s *Scraper) Query(q Query) ([]SeriesResult, Query, error) {
+	if _, ok := s.targets[q.Target]; !ok {
+		return nil, q, fmt.Errorf("%w: %q", ErrUnknownTarget, q.Target)
+	}
+	if err := q.normalize(s.now(), s.interval); err != nil {
+		return nil, q, err
+	}
+
+	name := q.Metric
+	if q.Func == FuncQuantile {
+		name += "_bucket"
+	}
+	matched := s.matchSeries(q.Target, name, q.Matchers)
+
+	window := max(q.Step, 4*s.interval)
+	steps := stepTimes(q.Start, q.End, q.Step)
+
+	if q.Func == FuncQuantile {
+		return []SeriesResult{evalQuantile(matched, steps, window, q.Quantile)}, q, nil
+	}
+
+	results := make([]SeriesResult, 0, len(matched))
+	for _, ser := range matched {
+		points := make([]Point, 0, len(steps))
+		for _, t := range steps {
+			var value float64
+			var ok bool
+			if q.Func == FuncRate {
+				value, ok = rate(ser.points, t-int64(window.Seconds()), t)
+			} else {
+				value, ok = lastValue(ser.points, t-int64(window.Seconds()), t)
+			}
+			if ok {
+				points = append(points, Point{T: t, V: value})
+			}
+		}
+		results = append(results, SeriesResult{Labels: ser.labels, Points: points})
+	}
+
+	if q.Sum {
+		return []SeriesResult{sumSeries(results, q.Matchers)}, q, nil
+	}
+	slices.SortFunc(results, func(a, b SeriesResult) int {
+		return strings.Compare(seriesKey("", a.Labels), seriesKey("", b.Labels))
+	})
+	return results, q, nil
+}
+
+// matchSeries: 이름과 레이블 조건이 일치하는 시계열 복사본
+func (s *Scraper) matchSeries(target, name string, matchers map[string]string) []series {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []series
+	for _, ser := range s.state[target].series {
+		if ser.name != name || !matchLabels(ser.labels, matchers) {
+			continue
+		}
+		matched = append(matched, series{name: ser.name, labels: ser.labels, points: slices.Clone(ser.points)})
+	}
+	return matched
+}
+
+func matchLabels(labels, matchers map[string]string) bool {
+	for k, v := range matchers {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func stepTimes(start, end time.Time, step time.Duration) []int64 {
+	var steps []int64
+	for t := start; !t.After(end); t = t.Add(step) {
+		steps = append(steps, t.Unix())
+	}
+	return steps
+}
+
+// inRange: (from, to] 구간 샘플
+func inRange(points []Point, from, to int64) []Point {
+	lo := sort.Search(len(points), func(i int) bool { return points[i].T > from })
+	hi := sort.Search(len(points), func(i int) bool { return points[i].T > to })
+	return points[lo:hi]
+}
+
+// lastValue: 구간 내 마지막 샘플 값
+func lastValue(points []Point, from, to int64) (float64, bool) {
+	in := inRange(points, from, to)
+	if len(in) == 0 {
+		return 0, false
+	}
+	return in[len(in)-1].V, true
+}
+
+// rate: 구간 내 카운터 증가량 / 경과 시간 (값이 줄면 리셋으로 보고 새 값을 증가량에 더함)
+func rate(points []Point, from, to int64) (float64, bool) {
+	in := inRange(points, from, to)
+	if len(in) < 2 {
+		return 0, false
+	}
+
+	var increase float64
+	for i := 1; i < len(in); i++ {
+		if delta := in[i].V - in[i-1].V; delta >= 0 {
+			increase += delta
+		} else {
+			increase += in[i].V
+		}
+	}
+	elapsed := float64(in[len(in)-1].T - in[0].T)
+	if elapsed <= 0 {
+		return 0, false
+	}
+	return increase / elapsed, true
+}
+
+// sumSeries: 같은 시점의 값을 합산한 단일 시계열
+func sumSeries(results []SeriesResult, matchers map[string]string) SeriesResult {
+	totals := make(map[int64]float64)
+	for _, r := range results {
+		for _, p := range r.Points {
+			totals[p.T] += p.V
+		}
+	}
+
+	points := make([]Point, 0, len(totals))
+	for t, v := range totals {
+		points = append(points, Point{T: t, V: v})
+	}
+	slices.SortFunc(points, func(a, b Point) int { return cmp.Compare(a.T, b.T) })
+
+	labels := make(map[string]string, len(matchers))
+	maps.Copy(labels, matchers)
+	return SeriesResult{Labels: labels, Points: points}
+}
+
+type bucket struct {
+	upperBound float64
+	count      float64
+}
+
+// evalQuantile: 시점별로 le 버킷 증가율을 합산해 분위수를 계산
+func evalQuantile(matched []series, steps []int64, window time.Duration, q float64) SeriesResult {
+	result := SeriesResult{
+		Labels: map[string]string{model.QuantileLabel: formatFloat(q)},
+		Points: make([]Point, 0, len(steps)),
+	}
+
+	for _, t := range steps {
+		byBound := make(map[float64]float64)
+		for _, ser := range matched {
+			upper, err := strconv.ParseFloat(ser.labels[model.BucketLabel], 64)
+			if err != nil {
+				continue
+			}
+			if r, ok := rate(ser.points, t-int64(window.Seconds()), t); ok {
+				byBound[upper] += r
+			}
+		}
+
+		buckets := make([]bucket, 0, len(byBound))
+		for upper, count := range byBound {
+			buckets = append(buckets, bucket{upperBound: upper, count: count})
+		}
+		if v := histogramQuantile(q, buckets); !math.IsNaN(v) {
+			result.Points = append(result.Points, Point{T: t, V: v})
+		}
+	}
+	return result
+}
+
+// histogramQuantile: 누적 버킷에서 선형 보간으로 분위수 추정 (Prometheus histogram_quantile과 동일한 방식)
+func histogramQuantile(q float64, buckets []bucket) float64 {
+	slices.SortFunc(buckets, func(a, b bucket) int { return cmp.Compare(a.upperBound, b.upperBound) })
+	if len(buckets) < 2 || !math.IsInf(buckets[len(buckets)-1].upperBound, 1) {
+		return math.NaN()
+	}
+
+	total := buckets[len(buckets)-1].count
+	if total == 0 {
+		return math.NaN()
+	}
+	rank := q * total
+	b := sort.Search(len(buckets)-1, func(i int) bool { return buckets[i].count >= rank })
+
+	if b == len(buckets)-1 {
+		return buckets[len(buckets)-2].upperBound
+	}
+	if b == 0 && buckets[0].upperBound <= 0 {
+		return buckets[0].upperBound
+	}
+
+	var bucketStart, countStart float64
+	if b > 0 {
+		bucketStart, countStart = buckets[b-1].upperBound, buckets[b-1].count
+	}
+	count := buckets[b].count - countStart
+	if count <= 0 {
+		return buckets[b].upperBound
+	}
+	return bucketStart + (buckets[b].upperBound-bucketStart)*((rank-countStart)/count)
+}
@@ -0,0 +1,300 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+)
+
+const (
+	// MaxSeriesPerTarget: 대상별 보관할 최대 시계열 수 (초과분은 버리고 상태에 표시)
+	MaxSeriesPerTarget = 5000
+	// scrapeTimeout: 대상 1회 수집 타임아웃 상한
+	scrapeTimeout = 10 * time.Second
+	// maxScrapeBytes: /metrics 응답 본문 상한
+	maxScrapeBytes = 8 << 20
+)
+
+// Point: 시계열 샘플 (T는 Unix 초)
+type Point struct {
+	T int64   `json:"t"`
+	V float64 `json:"v"`
+}
+
+type series struct {
+	name   string
+	labels map[string]string
+	points []Point // 시간순
+}
+
+type targetState struct {
+	series     map[string]*series
+	lastScrape time.Time
+	lastError  string
+	dropped    int
+}
+
+// TargetStatus: 대상별 수집 상태
+type TargetStatus struct {
+	Name       string     `json:"name"`
+	LastScrape *time.Time `json:"lastScrape,omitempty"`
+	LastError  string     `json:"lastError,omitempty"`
+	Series     int        `json:"series"`
+	Dropped    int        `json:"droppedSeries,omitempty"`
+	Metrics    []string   `json:"metrics"`
+}
+
+// Scraper: 각 봇의 /metrics를 주기적으로 수집해 보관 기간만큼 메모리에 유지합니다.
+// Prometheus 전체 배포 없이 대시보드 차트용 최근 시계열만 제공하는 용도입니다.
+type Scraper struct {
+	targets    map[string]string // 대상 이름 → base URL
+	interval   time.Duration
+	retention  time.Duration
+	httpClient *http.Client
+	logger     *slog.Logger
+
+	mu    sync.RWMutex
+	state map[string]*targetState
+	now   func() time.Time
+}
+
+// NewScraper: 수집기 생성 (targets의 빈 URL은 제외)
+func NewScraper(targets map[string]string, interval, retention time.Duration, logger *slog.Logger) *Scraper {
+	available := make(map[string]string, len(targets))
+	state := make(map[string]*targetState, len(targets))
+	for name, baseURL := range targets {
+		if baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/"); baseURL != "" {
+			available[name] = baseURL
+			state[name] = &targetState{series: make(map[string]*series)}
+		}
+	}
+	if retention < interval {
+		retention = interval
+	}
+	return &Scraper{
+		targets:    available,
+		interval:   interval,
+		retention:  retention,
+		httpClient: &http.Client{Timeout: min(interval, scrapeTimeout)},
+		logger:     logger.With(slog.String("component", "metrics_scraper")),
+		state:      state,
+		now:        time.Now,
+	}
+}
+
+// Interval: 수집 주기
+func (s *Scraper) Interval() time.Duration {
+	return s.interval
+}
+
+// Run: interval마다 모든 대상을 병렬 수집 (ctx 종료 시 반환)
+func (s *Scraper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.ScrapeAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScrapeAll: 모든 대상을 1회 수집
+func (s *Scraper) ScrapeAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for name, baseURL := range s.targets {
+		wg.Go(func() {
+			s.scrapeTarget(ctx, name, baseURL)
+		})
+	}
+	wg.Wait()
+}
+
+func (s *Scraper) scrapeTarget(ctx context.Context, name, baseURL string) {
+	families, err := s.fetch(ctx, baseURL+"/metrics")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.state[name]
+	if err != nil {
+		if st.lastError == "" && ctx.Err() == nil {
+			s.logger.Warn("metrics_scrape_failed", slog.String("target", name), slog.Any("error", err))
+		}
+		st.lastError = err.Error()
+		return
+	}
+
+	now := s.now()
+	ts := now.Unix()
+	st.lastScrape, st.lastError, st.dropped = now, "", 0
+	for _, mf := range families {
+		expandFamily(mf, func(sampleName string, labels map[string]string, value float64) {
+			key := seriesKey(sampleName, labels)
+			ser, ok := st.series[key]
+			if !ok {
+				if len(st.series) >= MaxSeriesPerTarget {
+					st.dropped++
+					return
+				}
+				ser = &series{name: sampleName, labels: labels}
+				st.series[key] = ser
+			}
+			ser.points = append(ser.points, Point{T: ts, V: value})
+		})
+	}
+	st.prune(now.Add(-s.retention).Unix())
+}
+
+func (s *Scraper) fetch(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	// 텍스트 포맷만 요청 (protobuf/OpenMetrics 협상 방지)
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("scrape: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scrape: unexpected status %d", resp.StatusCode)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(io.LimitReader(resp.Body, maxScrapeBytes))
+	if err != nil {
+		return nil, fmt.Errorf("parse metrics: %w", err)
+	}
+	return families, nil
+}
+
+// prune: 보관 기간이 지난 샘플과 빈 시계열 제거
+func (st *targetState) prune(cutoff int64) {
+	for key, ser := range st.series {
+		idx := sort.Search(len(ser.points), func(i int) bool { return ser.points[i].T >= cutoff })
+		if idx == len(ser.points) {
+			delete(st.series, key)
+			continue
+		}
+		if idx > 0 {
+			ser.points = slices.Clone(ser.points[idx:])
+		}
+	}
+}
+
+// Targets: 대상별 수집 상태 (이름순)
+func (s *Scraper) Targets() []TargetStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]TargetStatus, 0, len(s.state))
+	for name, st := range s.state {
+		status := TargetStatus{Name: name, LastError: st.lastError, Series: len(st.series), Dropped: st.dropped}
+		if !st.lastScrape.IsZero() {
+			last := st.lastScrape
+			status.LastScrape = &last
+		}
+		names := make(map[string]struct{})
+		for _, ser := range st.series {
+			names[ser.name] = struct{}{}
+		}
+		status.Metrics = make([]string, 0, len(names))
+		for metricName := range names {
+			status.Metrics = append(status.Metrics, metricName)
+		}
+		slices.Sort(status.Metrics)
+		result = append(result, status)
+	}
+	slices.SortFunc(result, func(a, b TargetStatus) int { return strings.Compare(a.Name, b.Name) })
+	return result
+}
+
+// expandFamily: 메트릭 패밀리를 Prometheus 노출 형식의 샘플 이름(_bucket/_sum/_count 포함)으로 펼칩니다.
+func expandFamily(mf *dto.MetricFamily, emit func(name string, labels map[string]string, value float64)) {
+	name := mf.GetName()
+	for _, m := range mf.GetMetric() {
+		labels := make(map[string]string, len(m.GetLabel()))
+		for _, lp := range m.GetLabel() {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			emit(name, labels, m.GetCounter().GetValue())
+		case dto.MetricType_GAUGE:
+			emit(name, labels, m.GetGauge().GetValue())
+		case dto.MetricType_UNTYPED:
+			emit(name, labels, m.GetUntyped().GetValue())
+		case dto.MetricType_HISTOGRAM:
+			h := m.GetHistogram()
+			for _, b := range h.GetBucket() {
+				if math.IsInf(b.GetUpperBound(), 1) {
+					continue
+				}
+				emit(name+"_bucket", withLabel(labels, model.BucketLabel, formatFloat(b.GetUpperBound())), float64(b.GetCumulativeCount()))
+			}
+			emit(name+"_bucket", withLabel(labels, model.BucketLabel, "+Inf"), float64(h.GetSampleCount()))
+			emit(name+"_sum", labels, h.GetSampleSum())
+			emit(name+"_count", labels, float64(h.GetSampleCount()))
+		case dto.MetricType_SUMMARY:
+			sm := m.GetSummary()
+			for _, q := range sm.GetQuantile() {
+				emit(name, withLabel(labels, model.QuantileLabel, formatFloat(q.GetQuantile())), q.GetValue())
+			}
+			emit(name+"_sum", labels, sm.GetSampleSum())
+			emit(name+"_count", labels, float64(sm.GetSampleCount()))
+		}
+	}
+}
+
+func withLabel(labels map[string]string, name, value string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	maps.Copy(result, labels)
+	result[name] = value
+	return result
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// seriesKey: 이름과 정렬된 레이블로 시계열 식별자 생성
+func seriesKey(name string, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	b.WriteString(name)
+	for _, k := range keys {
+		b.WriteByte(0xff)
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(labels[k])
+	}
+	return b.String()
+}
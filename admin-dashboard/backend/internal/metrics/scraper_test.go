@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestScraperQuery(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			http.NotFound(w, r)
+			return
+		}
+		requests++
+		// 스크랩마다 GET 요청 30건(1초당 2건), 모두 0.1초 이하 버킷
+		fmt.Fprintf(w, `# TYPE http_requests_total counter
+http_requests_total{method="GET"} %d
+http_requests_total{method="POST"} 5
+# TYPE goroutines gauge
+goroutines 42
+# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{le="0.1"} %d
+http_request_duration_seconds_bucket{le="1"} %d
+http_request_duration_seconds_bucket{le="+Inf"} %d
+http_request_duration_seconds_sum 1
+http_request_duration_seconds_count %d
+`, requests*30, requests*30, requests*30, requests*30, requests*30)
+	}))
+	defer srv.Close()
+
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	scraper := NewScraper(map[string]string{"holo": srv.URL, "llm": ""}, 15*time.Second, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scraper.now = func() time.Time { return now }
+	for range 5 {
+		scraper.ScrapeAll(context.Background())
+		now = now.Add(15 * time.Second)
+	}
+	now = now.Add(-15 * time.Second) // 마지막 스크랩 시각
+
+	targets := scraper.Targets()
+	if len(targets) != 1 || targets[0].Name != "holo" || targets[0].LastError != "" || targets[0].Series != 8 {
+		t.Fatalf("unexpected targets: %+v", targets)
+	}
+
+	results, q, err := scraper.Query(Query{Target: "holo", Metric: "http_requests_total", Func: FuncRate, Start: now.Add(-time.Minute), End: now})
+	if err != nil {
+		t.Fatalf("rate query: %v", err)
+	}
+	if q.Step != 15*time.Second || len(results) != 2 || results[0].Labels["method"] != "GET" {
+		t.Fatalf("unexpected rate results: %+v (step %s)", results, q.Step)
+	}
+	last := results[0].Points[len(results[0].Points)-1]
+	if last.T != now.Unix() || last.V != 2 {
+		t.Fatalf("GET rate = %+v, want 2/s at %d", last, now.Unix())
+	}
+
+	summed, _, err := scraper.Query(Query{Target: "holo", Metric: "http_requests_total", Func: FuncRate, Sum: true, Start: now.Add(-time.Minute), End: now})
+	if err != nil || len(summed) != 1 || summed[0].Points[len(summed[0].Points)-1].V != 2 {
+		t.Fatalf("unexpected summed rate: %+v (%v)", summed, err)
+	}
+
+	raw, _, err := scraper.Query(Query{Target: "holo", Metric: "goroutines", Start: now.Add(-time.Minute), End: now})
+	if err != nil || len(raw) != 1 || len(raw[0].Points) != 5 || raw[0].Points[0].V != 42 {
+		t.Fatalf("unexpected raw results: %+v (%v)", raw, err)
+	}
+
+	p95, _, err := scraper.Query(Query{Target: "holo", Metric: "http_request_duration_seconds", Func: FuncQuantile, Quantile: 0.95, Start: now.Add(-time.Minute), End: now})
+	if err != nil || len(p95) != 1 || len(p95[0].Points) == 0 {
+		t.Fatalf("unexpected quantile results: %+v (%v)", p95, err)
+	}
+	if v := p95[0].Points[len(p95[0].Points)-1].V; math.Abs(v-0.095) > 1e-9 {
+		t.Fatalf("p95 = %v, want 0.095", v)
+	}
+
+	if _, _, err := scraper.Query(Query{Target: "llm", Metric: "goroutines"}); !errors.Is(err, ErrUnknownTarget) {
+		t.Fatalf("expected ErrUnknownTarget, got %v", err)
+	}
+	if _, _, err := scraper.Query(Query{Target: "holo", Metric: "goroutines", Step: time.Second, Start: now.Add(-time.Hour), End: now}); !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("expected too many points error, got %v", err)
+	}
+}
+
+func TestScraperRetentionAndErrors(t *testing.T) {
+	healthy := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("# TYPE up gauge\nup 1\n"))
+	}))
+	defer srv.Close()
+
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	scraper := NewScraper(map[string]string{"twentyq": srv.URL}, 10*time.Second, time.Minute, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scraper.now = func() time.Time { return now }
+
+	scraper.ScrapeAll(context.Background())
+	healthy = false
+	now = now.Add(30 * time.Second)
+	scraper.ScrapeAll(context.Background())
+	if st := scraper.Targets()[0]; st.LastError == "" || st.Series != 1 {
+		t.Fatalf("failed scrape should keep old series and report error: %+v", st)
+	}
+
+	healthy = true
+	now = now.Add(time.Minute)
+	scraper.ScrapeAll(context.Background())
+	if got := len(scraper.state["twentyq"].series["up"].points); got != 1 {
+		t.Fatalf("samples older than retention should be pruned, got %d points", got)
+	}
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	buckets := []bucket{
+		{upperBound: math.Inf(1), count: 100},
+		{upperBound: 0.5, count: 50},
+		{upperBound: 1, count: 90},
+	}
+	if v := histogramQuantile(0.5, buckets); v != 0.5 {
+		t.Fatalf("p50 = %v, want 0.5", v)
+	}
+	if v := histogramQuantile(0.7, buckets); math.Abs(v-0.75) > 1e-9 {
+		t.Fatalf("p70 = %v, want 0.75", v)
+	}
+	if v := histogramQuantile(0.99, buckets); v != 1 {
+		t.Fatalf("p99 beyond last finite bucket = %v, want 1", v)
+	}
+	if v := histogramQuantile(0.5, []bucket{{upperBound: math.Inf(1)}}); !math.IsNaN(v) {
+		t.Fatalf("single bucket should be NaN, got %v", v)
+	}
+}
+
+func TestRateHandlesCounterReset(t *testing.T) {
+	points := []Point{{T: 0, V: 10}, {T: 10, V: 20}, {T: 20, V: 5}, {T: 30, V: 15}}
+	// 증가량 10 + 5(리셋) + 10 = 25, 30초
+	if v, ok := rate(points, -1, 30); !ok || math.Abs(v-25.0/30) > 1e-9 {
+		t.Fatalf("rate = %v (%v)", v, ok)
+	}
+	if _, ok := rate(points, 25, 30); ok {
+		t.Fatal("single sample should not produce a rate")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -30,7 +31,8 @@ func (w *etagWriter) Write(b []byte) (int, error) {
 // ETag: GET 요청에 대해 ETag 헤더 추가 및 조건부 요청 처리
 // - 응답 본문의 SHA256 해시를 ETag로 사용
 // - If-None-Match 헤더와 일치하면 304 Not Modified 반환
-func ETag() gin.HandlerFunc {
+// - skipPaths: 응답이 커서 버퍼에 쌓으면 안 되는 경로 (파일 다운로드 등)
+func ETag(skipPaths ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// GET 요청만 처리
 		if c.Request.Method != http.MethodGet {
@@ -40,13 +42,13 @@ func ETag() gin.HandlerFunc {
 
 		// API 경로만 처리 (정적 자산 제외)
 		path := c.Request.URL.Path
-		if !strings.HasPrefix(path, "/admin/api/") {
+		if !strings.HasPrefix(path, "/admin/api/") || slices.Contains(skipPaths, path) {
 			c.Next()
 			return
 		}
 
-		// WebSocket 제외
-		if c.GetHeader("Upgrade") == "websocket" {
+		// WebSocket/SSE/NDJSON 스트림 제외 (끝나지 않거나 큰 응답을 버퍼에 쌓지 않도록)
+		if c.GetHeader("Upgrade") == "websocket" || WantsEventStream(c.Request) || WantsNDJSON(c.Request) {
 			c.Next()
 			return
 		}
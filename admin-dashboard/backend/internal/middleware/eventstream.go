@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// EventStreamQueryParam: 스트리밍 엔드포인트에서 SSE 전송을 고르는 쿼리 파라미터 (?transport=sse)
+const EventStreamQueryParam = "transport"
+
+// WantsEventStream: 요청이 WebSocket 대신 Server-Sent Events 응답을 원하는지 확인합니다.
+// EventSource가 보내는 Accept: text/event-stream 또는 ?transport=sse로 판단한다.
+func WantsEventStream(r *http.Request) bool {
+	if strings.EqualFold(r.URL.Query().Get(EventStreamQueryParam), "sse") {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// NDJSONContentType: 줄 단위 JSON 스트리밍 응답 타입
+const NDJSONContentType = "application/x-ndjson"
+
+// WantsNDJSON: 요청이 결과를 줄 단위 JSON(NDJSON)으로 스트리밍받기를 원하는지 확인합니다.
+// Accept: application/x-ndjson 또는 ?format=ndjson으로 판단한다.
+func WantsNDJSON(r *http.Request) bool {
+	if strings.EqualFold(r.URL.Query().Get("format"), "ndjson") {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), NDJSONContentType)
+}
@@ -0,0 +1,222 @@
+// Package probe: 운영자가 정의한 합성 모니터링 프로브(봇 엔드포인트 주기 호출 및 응답 검증)
+package probe
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+const (
+	// MinInterval: 프로브 최소 실행 주기
+	MinInterval = 10 * time.Second
+	// MaxInterval: 프로브 최대 실행 주기
+	MaxInterval = 24 * time.Hour
+	// DefaultTimeout: 요청 타임아웃 기본값
+	DefaultTimeout = 5 * time.Second
+	// MaxTimeout: 요청 타임아웃 상한
+	MaxTimeout = 30 * time.Second
+)
+
+var (
+	// ErrNotFound: 프로브 없음
+	ErrNotFound = errors.New("probe not found")
+	// ErrInvalid: 프로브 정의 검증 실패
+	ErrInvalid = errors.New("invalid probe")
+)
+
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Probe: 운영자가 정의한 프로브 (GET/HEAD만 허용하여 봇 상태를 변경하지 않음)
+type Probe struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Target string `json:"target"` // 봇 이름 (holo, twentyq, turtle, llm)
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// ExpectedStatus: 기대 HTTP 상태 코드 (0이면 200)
+	ExpectedStatus int `json:"expectedStatus"`
+	// JSONPath: 응답 본문에서 검사할 값의 경로 (예: "status", "data.items.0.id"). 비어 있으면 검사 안 함
+	JSONPath string `json:"jsonPath,omitempty"`
+	// ExpectedValue: JSONPath 값의 기대 문자열 표현 (비어 있으면 값 존재만 확인)
+	ExpectedValue   string    `json:"expectedValue,omitempty"`
+	IntervalSeconds int       `json:"intervalSeconds"`
+	TimeoutSeconds  int       `json:"timeoutSeconds"`
+	Enabled         bool      `json:"enabled"`
+	CreatedBy       string    `json:"createdBy,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// Interval: 실행 주기
+func (p Probe) Interval() time.Duration {
+	return time.Duration(p.IntervalSeconds) * time.Second
+}
+
+// Timeout: 요청 타임아웃
+func (p Probe) Timeout() time.Duration {
+	return time.Duration(p.TimeoutSeconds) * time.Second
+}
+
+// Result: 프로브 1회 실행 결과
+type Result struct {
+	ProbeID   string    `json:"probeId"`
+	At        time.Time `json:"at"`
+	Success   bool      `json:"success"`
+	Status    int       `json:"status,omitempty"`
+	LatencyMs int64     `json:"latencyMs"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Summary: 프로브와 최근 실행 이력 요약 (상태 페이지/인박스용)
+type Summary struct {
+	Probe        Probe      `json:"probe"`
+	Last         *Result    `json:"last,omitempty"`
+	Checks       int        `json:"checks"`      // 이력에 남은 실행 수
+	SuccessRate  float64    `json:"successRate"` // 0.0 ~ 1.0 (이력 기준)
+	AvgLatencyMs int64      `json:"avgLatencyMs"`
+	Failures     int        `json:"consecutiveFailures"`
+	FailingSince *time.Time `json:"failingSince,omitempty"`
+}
+
+// Healthy: 마지막 실행이 성공했거나 아직 실행 전이면 true
+func (s Summary) Healthy() bool {
+	return s.Last == nil || s.Last.Success
+}
+
+// Normalize: 기본값을 채우고 정의를 검증합니다. targets는 사용 가능한 봇 이름 목록입니다.
+func (p *Probe) Normalize(targets map[string]string) error {
+	p.ID = strings.TrimSpace(p.ID)
+	p.Name = strings.TrimSpace(p.Name)
+	p.Target = strings.TrimSpace(p.Target)
+	p.Method = strings.ToUpper(strings.TrimSpace(p.Method))
+	p.Path = strings.TrimSpace(p.Path)
+	p.JSONPath = strings.TrimSpace(p.JSONPath)
+
+	if p.Method == "" {
+		p.Method = http.MethodGet
+	}
+	if p.ExpectedStatus == 0 {
+		p.ExpectedStatus = http.StatusOK
+	}
+	if p.TimeoutSeconds <= 0 {
+		p.TimeoutSeconds = int(DefaultTimeout / time.Second)
+	}
+	if p.Name == "" {
+		p.Name = p.ID
+	}
+
+	switch {
+	case !idPattern.MatchString(p.ID):
+		return fmt.Errorf("%w: id must match %s", ErrInvalid, idPattern.String())
+	case targets[p.Target] == "":
+		return fmt.Errorf("%w: unknown target %q", ErrInvalid, p.Target)
+	case p.Method != http.MethodGet && p.Method != http.MethodHead:
+		return fmt.Errorf("%w: method must be GET or HEAD", ErrInvalid)
+	case !strings.HasPrefix(p.Path, "/") || strings.Contains(p.Path, "://") || strings.Contains(p.Path, ".."):
+		return fmt.Errorf("%w: path must be an absolute path on the target", ErrInvalid)
+	case p.ExpectedStatus < 100 || p.ExpectedStatus > 599:
+		return fmt.Errorf("%w: expectedStatus out of range", ErrInvalid)
+	case p.Interval() < MinInterval || p.Interval() > MaxInterval:
+		return fmt.Errorf("%w: interval must be between %s and %s", ErrInvalid, MinInterval, MaxInterval)
+	case p.Timeout() > MaxTimeout || p.Timeout() >= p.Interval():
+		return fmt.Errorf("%w: timeout must be shorter than interval and at most %s", ErrInvalid, MaxTimeout)
+	case p.Method == http.MethodHead && p.JSONPath != "":
+		return fmt.Errorf("%w: jsonPath requires GET", ErrInvalid)
+	}
+	return nil
+}
+
+// Summarize: 최신순 실행 이력으로 요약을 만듭니다.
+func Summarize(p Probe, history []Result) Summary {
+	summary := Summary{Probe: p, Checks: len(history)}
+	if len(history) == 0 {
+		return summary
+	}
+
+	last := history[0]
+	summary.Last = &last
+
+	var successes int
+	var latencyTotal int64
+	for _, r := range history {
+		if r.Success {
+			successes++
+		}
+		latencyTotal += r.LatencyMs
+	}
+	summary.SuccessRate = float64(successes) / float64(len(history))
+	summary.AvgLatencyMs = latencyTotal / int64(len(history))
+
+	for _, r := range history {
+		if r.Success {
+			break
+		}
+		summary.Failures++
+		since := r.At
+		summary.FailingSince = &since
+	}
+	return summary
+}
+
+// checkJSONPath: 본문에서 경로 값을 찾아 기대값과 비교합니다.
+func checkJSONPath(body []byte, path, expected string) error {
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return fmt.Errorf("response is not JSON: %w", err)
+	}
+
+	value, ok := lookup(doc, path)
+	if !ok {
+		return fmt.Errorf("json path %q not found", path)
+	}
+	if expected == "" {
+		return nil
+	}
+	if got := stringify(value); got != expected {
+		return fmt.Errorf("json path %q = %q, want %q", path, got, expected)
+	}
+	return nil
+}
+
+// lookup: 점(.)으로 구분된 경로를 따라 객체 키 또는 배열 인덱스를 탐색합니다.
+func lookup(doc any, path string) (any, bool) {
+	current := doc
+	for segment := range strings.SplitSeq(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			next, ok := node[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			current = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// stringify: JSON 값을 비교용 문자열로 변환합니다. (문자열은 그대로, 나머지는 JSON 표현)
+func stringify(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
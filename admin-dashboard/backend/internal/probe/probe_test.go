@@ -0,0 +1,238 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testTargets = map[string]string{"holo": "http://holo:30001"}
+
+func validProbe() Probe {
+	return Probe{ID: "holo-health", Target: "holo", Path: "/health", IntervalSeconds: 60}
+}
+
+func TestNormalize(t *testing.T) {
+	p := validProbe()
+	if err := p.Normalize(testTargets); err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	if p.Method != http.MethodGet || p.ExpectedStatus != http.StatusOK || p.TimeoutSeconds != 5 || p.Name != p.ID {
+		t.Fatalf("defaults not applied: %+v", p)
+	}
+
+	cases := map[string]func(*Probe){
+		"bad id":         func(p *Probe) { p.ID = "Bad ID" },
+		"unknown target": func(p *Probe) { p.Target = "twentyq" },
+		"post method":    func(p *Probe) { p.Method = "post" },
+		"absolute url":   func(p *Probe) { p.Path = "http://evil/" },
+		"path traversal": func(p *Probe) { p.Path = "/../admin" },
+		"short interval": func(p *Probe) { p.IntervalSeconds = 5 },
+		"long timeout":   func(p *Probe) { p.TimeoutSeconds = 60 },
+		"head with json": func(p *Probe) { p.Method = http.MethodHead; p.JSONPath = "status" },
+	}
+	for name, mutate := range cases {
+		p := validProbe()
+		mutate(&p)
+		if err := p.Normalize(testTargets); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: expected ErrInvalid, got %v", name, err)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	base := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	history := []Result{ // 최신순
+		{At: base.Add(3 * time.Minute), LatencyMs: 40},
+		{At: base.Add(2 * time.Minute), LatencyMs: 20},
+		{At: base.Add(time.Minute), Success: true, LatencyMs: 30},
+		{At: base, Success: true, LatencyMs: 10},
+	}
+
+	summary := Summarize(validProbe(), history)
+	if summary.Healthy() || summary.Checks != 4 || summary.SuccessRate != 0.5 || summary.AvgLatencyMs != 25 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if summary.Failures != 2 || summary.FailingSince == nil || !summary.FailingSince.Equal(base.Add(2*time.Minute)) {
+		t.Fatalf("unexpected failure streak: %d since %v", summary.Failures, summary.FailingSince)
+	}
+	if empty := Summarize(validProbe(), nil); !empty.Healthy() || empty.Last != nil {
+		t.Fatalf("probe without history should be healthy: %+v", empty)
+	}
+}
+
+func TestCheckJSONPath(t *testing.T) {
+	body := []byte(`{"status":"ok","data":{"items":[{"id":7,"live":true}]}}`)
+	if err := checkJSONPath(body, "status", "ok"); err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if err := checkJSONPath(body, "data.items.0.id", "7"); err != nil {
+		t.Fatalf("array index: %v", err)
+	}
+	if err := checkJSONPath(body, "data.items.0.live", ""); err != nil {
+		t.Fatalf("existence only: %v", err)
+	}
+	if err := checkJSONPath(body, "data.items.1.id", ""); err == nil {
+		t.Fatal("expected missing index error")
+	}
+	if err := checkJSONPath(body, "status", "degraded"); err == nil {
+		t.Fatal("expected value mismatch error")
+	}
+	if err := checkJSONPath([]byte("plain"), "status", ""); err == nil {
+		t.Fatal("expected non-JSON error")
+	}
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	probes  map[string]Probe
+	results map[string][]Result
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{probes: make(map[string]Probe), results: make(map[string][]Result)}
+}
+
+func (m *memoryStore) ListProbes(context.Context) ([]Probe, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	probes := make([]Probe, 0, len(m.probes))
+	for _, p := range m.probes {
+		probes = append(probes, p)
+	}
+	return probes, nil
+}
+
+func (m *memoryStore) GetProbe(_ context.Context, id string) (*Probe, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.probes[id]
+	if !ok {
+		return nil, nil
+	}
+	return &p, nil
+}
+
+func (m *memoryStore) SaveProbe(_ context.Context, p Probe) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.probes[p.ID] = p
+	return nil
+}
+
+func (m *memoryStore) DeleteProbe(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.probes[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.probes, id)
+	delete(m.results, id)
+	return nil
+}
+
+func (m *memoryStore) AppendResult(_ context.Context, result Result) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.results[result.ProbeID] = append([]Result{result}, m.results[result.ProbeID]...)
+	return nil
+}
+
+func (m *memoryStore) ListResults(_ context.Context, id string, limit int) ([]Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	results := m.results[id]
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return append([]Result(nil), results...), nil
+}
+
+func TestServiceRunNow(t *testing.T) {
+	status := "ok"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"` + status + `"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	svc := NewService(newMemoryStore(), map[string]string{"holo": srv.URL + "/", "llm": ""}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if targets := svc.Targets(); len(targets) != 1 || targets[0] != "holo" {
+		t.Fatalf("empty target URLs should be dropped: %v", targets)
+	}
+
+	p := validProbe()
+	p.JSONPath, p.ExpectedValue = "status", "ok"
+	if _, err := svc.Create(ctx, p, "admin"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := svc.Create(ctx, p, "admin"); !errors.Is(err, ErrExists) {
+		t.Fatalf("expected ErrExists, got %v", err)
+	}
+
+	if result, err := svc.RunNow(ctx, p.ID); err != nil || !result.Success || result.Status != http.StatusOK {
+		t.Fatalf("expected success, got %+v (%v)", result, err)
+	}
+	status = "degraded"
+	if result, err := svc.RunNow(ctx, p.ID); err != nil || result.Success || result.Error == "" {
+		t.Fatalf("expected JSON value failure, got %+v (%v)", result, err)
+	}
+
+	summary, history, err := svc.Get(ctx, p.ID, 1)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(history) != 1 || summary.Checks != 2 || summary.Failures != 1 || summary.SuccessRate != 0.5 {
+		t.Fatalf("unexpected summary %+v / history %+v", summary, history)
+	}
+
+	p.Path = "/missing"
+	if _, err := svc.Update(ctx, p.ID, p, "admin"); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if result, _ := svc.RunNow(ctx, p.ID); result.Status != http.StatusNotFound || result.Success {
+		t.Fatalf("expected status mismatch, got %+v", result)
+	}
+
+	if err := svc.Delete(ctx, p.ID, "admin"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := svc.RunNow(ctx, p.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestServiceDueRespectsIntervalAndEnabled(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	svc := NewService(newMemoryStore(), testTargets, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	svc.now = func() time.Time { return now }
+
+	enabled := validProbe()
+	enabled.Enabled = true
+	disabled := validProbe()
+	disabled.ID = "disabled"
+
+	if due := svc.due([]Probe{enabled, disabled}); len(due) != 1 || due[0].ID != enabled.ID {
+		t.Fatalf("expected only enabled probe, got %+v", due)
+	}
+	svc.finish(enabled.ID)
+
+	now = now.Add(30 * time.Second)
+	if due := svc.due([]Probe{enabled}); len(due) != 0 {
+		t.Fatalf("probe should wait for its interval, got %+v", due)
+	}
+	now = now.Add(30 * time.Second)
+	if due := svc.due([]Probe{enabled}); len(due) != 1 {
+		t.Fatalf("probe should be due after interval, got %+v", due)
+	}
+}
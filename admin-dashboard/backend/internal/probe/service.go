@@ -0,0 +1,327 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// DefaultTick: 실행 시각이 된 프로브를 찾는 스케줄러 주기
+	DefaultTick = 5 * time.Second
+	// summaryWindow: 요약(성공률/평균 지연)에 사용하는 최근 실행 수
+	summaryWindow = 50
+	// maxBodyBytes: JSON 검사를 위해 읽는 응답 본문 상한
+	maxBodyBytes = 1 << 20
+)
+
+// ErrExists: 같은 ID의 프로브가 이미 있음
+var ErrExists = errors.New("probe already exists")
+
+// Service: 프로브 정의 관리와 주기 실행을 담당합니다.
+type Service struct {
+	store      Store
+	targets    map[string]string // 봇 이름 → base URL
+	httpClient *http.Client
+	logger     *slog.Logger
+
+	mu       sync.Mutex
+	lastRun  map[string]time.Time // 프로브별 마지막 실행 시각 (스케줄러용, 재시작 시 즉시 실행)
+	inFlight map[string]bool
+	failing  map[string]bool // 실패/복구 전환 로그용
+	now      func() time.Time
+}
+
+// NewService: 프로브 서비스 생성 (targets의 빈 URL은 제외)
+func NewService(store Store, targets map[string]string, logger *slog.Logger) *Service {
+	available := make(map[string]string, len(targets))
+	for name, baseURL := range targets {
+		if baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/"); baseURL != "" {
+			available[name] = baseURL
+		}
+	}
+	return &Service{
+		store:   store,
+		targets: available,
+		// 리다이렉트는 따라가지 않고 응답 코드 그대로 검사
+		httpClient: &http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		logger:   logger.With(slog.String("component", "probe")),
+		lastRun:  make(map[string]time.Time),
+		inFlight: make(map[string]bool),
+		failing:  make(map[string]bool),
+		now:      time.Now,
+	}
+}
+
+// Targets: 프로브 대상으로 사용할 수 있는 봇 이름 목록 (이름순)
+func (s *Service) Targets() []string {
+	names := make([]string, 0, len(s.targets))
+	for name := range s.targets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// List: 전체 프로브 요약 조회
+func (s *Service) List(ctx context.Context) ([]Summary, error) {
+	probes, err := s.store.ListProbes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list probes: %w", err)
+	}
+
+	summaries := make([]Summary, 0, len(probes))
+	for _, p := range probes {
+		history, err := s.store.ListResults(ctx, p.ID, summaryWindow)
+		if err != nil {
+			return nil, fmt.Errorf("list probe results: %w", err)
+		}
+		summaries = append(summaries, Summarize(p, history))
+	}
+	return summaries, nil
+}
+
+// Get: 프로브 요약과 최신순 실행 이력 조회
+func (s *Service) Get(ctx context.Context, id string, limit int) (Summary, []Result, error) {
+	p, err := s.store.GetProbe(ctx, id)
+	if err != nil {
+		return Summary{}, nil, fmt.Errorf("get probe: %w", err)
+	}
+	if p == nil {
+		return Summary{}, nil, ErrNotFound
+	}
+
+	history, err := s.store.ListResults(ctx, id, max(limit, summaryWindow))
+	if err != nil {
+		return Summary{}, nil, fmt.Errorf("list probe results: %w", err)
+	}
+	summary := Summarize(*p, history[:min(len(history), summaryWindow)])
+	if limit > 0 && len(history) > limit {
+		history = history[:limit]
+	}
+	return summary, history, nil
+}
+
+// Create: 새 프로브 등록
+func (s *Service) Create(ctx context.Context, p Probe, actor string) (Probe, error) {
+	if err := p.Normalize(s.targets); err != nil {
+		return Probe{}, err
+	}
+
+	existing, err := s.store.GetProbe(ctx, p.ID)
+	if err != nil {
+		return Probe{}, fmt.Errorf("get probe: %w", err)
+	}
+	if existing != nil {
+		return Probe{}, ErrExists
+	}
+
+	now := s.now()
+	p.CreatedBy, p.CreatedAt, p.UpdatedAt = actor, now, now
+	if err := s.store.SaveProbe(ctx, p); err != nil {
+		return Probe{}, fmt.Errorf("save probe: %w", err)
+	}
+	s.logger.Info("probe_created", slog.String("probe", p.ID), slog.String("actor", actor))
+	return p, nil
+}
+
+// Update: 프로브 정의 변경 (ID/생성 정보는 유지, 이력은 보존)
+func (s *Service) Update(ctx context.Context, id string, p Probe, actor string) (Probe, error) {
+	existing, err := s.store.GetProbe(ctx, id)
+	if err != nil {
+		return Probe{}, fmt.Errorf("get probe: %w", err)
+	}
+	if existing == nil {
+		return Probe{}, ErrNotFound
+	}
+
+	p.ID = id
+	if err := p.Normalize(s.targets); err != nil {
+		return Probe{}, err
+	}
+	p.CreatedBy, p.CreatedAt, p.UpdatedAt = existing.CreatedBy, existing.CreatedAt, s.now()
+	if err := s.store.SaveProbe(ctx, p); err != nil {
+		return Probe{}, fmt.Errorf("save probe: %w", err)
+	}
+	s.logger.Info("probe_updated", slog.String("probe", id), slog.String("actor", actor))
+	return p, nil
+}
+
+// Delete: 프로브와 이력 삭제
+func (s *Service) Delete(ctx context.Context, id, actor string) error {
+	if err := s.store.DeleteProbe(ctx, id); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("delete probe: %w", err)
+	}
+
+	s.mu.Lock()
+	delete(s.lastRun, id)
+	delete(s.failing, id)
+	s.mu.Unlock()
+
+	s.logger.Info("probe_deleted", slog.String("probe", id), slog.String("actor", actor))
+	return nil
+}
+
+// RunNow: 프로브를 즉시 1회 실행하고 결과를 이력에 기록
+func (s *Service) RunNow(ctx context.Context, id string) (Result, error) {
+	p, err := s.store.GetProbe(ctx, id)
+	if err != nil {
+		return Result{}, fmt.Errorf("get probe: %w", err)
+	}
+	if p == nil {
+		return Result{}, ErrNotFound
+	}
+	return s.runAndRecord(ctx, *p)
+}
+
+// Run: tick마다 실행 시각이 된 활성 프로브를 병렬 실행 (ctx 종료 시 반환)
+func (s *Service) Run(ctx context.Context, tick time.Duration) {
+	if tick <= 0 {
+		tick = DefaultTick
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		probes, err := s.store.ListProbes(ctx)
+		if err != nil && ctx.Err() == nil {
+			s.logger.Warn("probe_list_failed", slog.Any("error", err))
+		}
+		for _, p := range s.due(probes) {
+			wg.Go(func() {
+				defer s.finish(p.ID)
+				if _, err := s.runAndRecord(ctx, p); err != nil && ctx.Err() == nil {
+					s.logger.Warn("probe_record_failed", slog.String("probe", p.ID), slog.Any("error", err))
+				}
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// due: 실행 시각이 되었고 실행 중이 아닌 활성 프로브를 골라 실행 중으로 표시
+func (s *Service) due(probes []Probe) []Probe {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var result []Probe
+	for _, p := range probes {
+		if !p.Enabled || s.inFlight[p.ID] {
+			continue
+		}
+		if last, ok := s.lastRun[p.ID]; ok && now.Sub(last) < p.Interval() {
+			continue
+		}
+		s.inFlight[p.ID] = true
+		s.lastRun[p.ID] = now
+		result = append(result, p)
+	}
+	return result
+}
+
+func (s *Service) finish(id string) {
+	s.mu.Lock()
+	delete(s.inFlight, id)
+	s.mu.Unlock()
+}
+
+func (s *Service) runAndRecord(ctx context.Context, p Probe) (Result, error) {
+	result := s.execute(ctx, p)
+	if err := s.store.AppendResult(ctx, result); err != nil {
+		return result, fmt.Errorf("append probe result: %w", err)
+	}
+
+	s.mu.Lock()
+	wasFailing := s.failing[p.ID]
+	s.failing[p.ID] = !result.Success
+	s.mu.Unlock()
+
+	switch {
+	case !result.Success && !wasFailing:
+		s.logger.Warn("probe_failed",
+			slog.String("probe", p.ID),
+			slog.String("target", p.Target),
+			slog.String("path", p.Path),
+			slog.String("error", result.Error),
+		)
+	case result.Success && wasFailing:
+		s.logger.Info("probe_recovered", slog.String("probe", p.ID), slog.Int64("latency_ms", result.LatencyMs))
+	}
+	return result, nil
+}
+
+// execute: 프로브 요청을 보내고 상태 코드와 JSON 경로 값을 검사
+func (s *Service) execute(ctx context.Context, p Probe) Result {
+	result := Result{ProbeID: p.ID, At: s.now()}
+
+	baseURL, ok := s.targets[p.Target]
+	if !ok {
+		result.Error = fmt.Sprintf("target %q not configured", p.Target)
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, p.Method, baseURL+p.Path, nil)
+	if err != nil {
+		result.Error = fmt.Sprintf("create request: %v", err)
+		return result
+	}
+
+	start := time.Now()
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		result.LatencyMs = time.Since(start).Milliseconds()
+		result.Error = err.Error()
+		return result
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	result.LatencyMs = time.Since(start).Milliseconds()
+	result.Status = resp.StatusCode
+	if err != nil {
+		result.Error = fmt.Sprintf("read body: %v", err)
+		return result
+	}
+
+	if resp.StatusCode != p.ExpectedStatus {
+		result.Error = fmt.Sprintf("status %d, want %d", resp.StatusCode, p.ExpectedStatus)
+		return result
+	}
+	if p.JSONPath != "" {
+		if err := checkJSONPath(body, p.JSONPath, p.ExpectedValue); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+	result.Success = true
+	return result
+}
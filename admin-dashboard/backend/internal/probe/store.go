@@ -0,0 +1,185 @@
+package probe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	definitionsKey   = "probe:definitions"
+	historyKeyPrefix = "probe:history:"
+
+	// MaxHistory: 프로브별 보관할 최대 실행 결과 수
+	MaxHistory = 200
+)
+
+// Store: 프로브 정의/실행 이력 저장소 인터페이스
+type Store interface {
+	ListProbes(ctx context.Context) ([]Probe, error)
+	GetProbe(ctx context.Context, id string) (*Probe, error)
+	SaveProbe(ctx context.Context, probe Probe) error
+	// DeleteProbe: 정의와 이력을 함께 삭제 (없으면 ErrNotFound)
+	DeleteProbe(ctx context.Context, id string) error
+
+	AppendResult(ctx context.Context, result Result) error
+	// ListResults: 최신순 실행 이력
+	ListResults(ctx context.Context, id string, limit int) ([]Result, error)
+}
+
+// ValkeyStore: Valkey 기반 프로브 저장소 (정의는 해시 필드, 이력은 프로브별 리스트)
+type ValkeyStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyStore: Valkey 프로브 저장소 생성
+func NewValkeyStore(client valkey.Client, logger *slog.Logger) *ValkeyStore {
+	return &ValkeyStore{client: client, logger: logger}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, 3*time.Second)
+}
+
+func historyKey(id string) string {
+	return historyKeyPrefix + id
+}
+
+// ListProbes: 전체 프로브 정의 조회 (ID 순)
+func (s *ValkeyStore) ListProbes(ctx context.Context) ([]Probe, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hgetall().Key(definitionsKey).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("list probes: %w", err)
+	}
+
+	probes := make([]Probe, 0, len(raw))
+	for id, item := range raw {
+		var probe Probe
+		if err := json.Unmarshal([]byte(item), &probe); err != nil {
+			s.logger.Warn("probe_decode_failed", slog.String("probe", id), slog.Any("error", err))
+			continue
+		}
+		probes = append(probes, probe)
+	}
+	sort.Slice(probes, func(i, j int) bool {
+		return probes[i].ID < probes[j].ID
+	})
+	return probes, nil
+}
+
+// GetProbe: 프로브 정의 조회 (없으면 nil)
+func (s *ValkeyStore) GetProbe(ctx context.Context, id string) (*Probe, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Hget().Key(definitionsKey).Field(id).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get probe: %w", err)
+	}
+
+	var probe Probe
+	if err := json.Unmarshal([]byte(raw), &probe); err != nil {
+		return nil, fmt.Errorf("decode probe: %w", err)
+	}
+	return &probe, nil
+}
+
+// SaveProbe: 프로브 정의 저장 (덮어쓰기)
+func (s *ValkeyStore) SaveProbe(ctx context.Context, probe Probe) error {
+	data, err := json.Marshal(probe)
+	if err != nil {
+		return fmt.Errorf("marshal probe: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cmd := s.client.B().Hset().Key(definitionsKey).FieldValue().FieldValue(probe.ID, string(data)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return fmt.Errorf("save probe: %w", err)
+	}
+	return nil
+}
+
+// DeleteProbe: 프로브 정의와 이력 삭제
+func (s *ValkeyStore) DeleteProbe(ctx context.Context, id string) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	resps := s.client.DoMulti(ctx,
+		s.client.B().Hdel().Key(definitionsKey).Field(id).Build(),
+		s.client.B().Del().Key(historyKey(id)).Build(),
+	)
+	removed, err := resps[0].AsInt64()
+	if err != nil {
+		return fmt.Errorf("delete probe: %w", err)
+	}
+	if err := resps[1].Error(); err != nil {
+		return fmt.Errorf("delete probe history: %w", err)
+	}
+	if removed == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// AppendResult: 실행 결과를 이력 앞에 추가하고 최대 길이로 자름
+func (s *ValkeyStore) AppendResult(ctx context.Context, result Result) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("marshal probe result: %w", err)
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	key := historyKey(result.ProbeID)
+	cmds := valkey.Commands{
+		s.client.B().Lpush().Key(key).Element(string(data)).Build(),
+		s.client.B().Ltrim().Key(key).Start(0).Stop(MaxHistory - 1).Build(),
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append probe result: %w", err)
+		}
+	}
+	return nil
+}
+
+// ListResults: 최신순 실행 이력 조회
+func (s *ValkeyStore) ListResults(ctx context.Context, id string, limit int) ([]Result, error) {
+	if limit <= 0 || limit > MaxHistory {
+		limit = MaxHistory
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	raw, err := s.client.Do(ctx, s.client.B().Lrange().Key(historyKey(id)).Start(0).Stop(int64(limit-1)).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list probe results: %w", err)
+	}
+
+	results := make([]Result, 0, len(raw))
+	for _, item := range raw {
+		var result Result
+		if err := json.Unmarshal([]byte(item), &result); err != nil {
+			s.logger.Warn("probe_result_decode_failed", slog.String("probe", id), slog.Any("error", err))
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,266 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// CacheStatusHeader: 캐시 처리 결과(HIT/MISS/SHARED/BYPASS)를 알려주는 응답 헤더
+	CacheStatusHeader = "X-Proxy-Cache"
+
+	// cacheMaxBodyBytes: 이보다 큰 응답은 합류한 요청에만 나눠주고 저장하지 않는다
+	cacheMaxBodyBytes = 1 << 20
+	// cacheFetchTimeout: 합류한 요청이 있으므로 첫 요청자가 끊겨도 upstream 조회는 이 시간까지 이어간다
+	cacheFetchTimeout = 30 * time.Second
+)
+
+// ResponseCache: 봇 GET 응답의 단기 read-through 캐시
+// 같은 경로 요청이 동시에 몰리면 upstream에는 한 번만 보내고(single-flight) 결과를 나눠준다.
+// 대시보드 새로고침이 몰려도 작은 봇 서비스가 같은 조회를 반복하지 않게 하는 용도다.
+type ResponseCache struct {
+	ttl        time.Duration
+	maxEntries int
+	logger     *slog.Logger
+	now        func() time.Time
+
+	group singleflight.Group
+
+	mu          sync.Mutex
+	entries     map[string]cachedResponse
+	generations map[string]uint64 // 봇별 무효화 세대 (쓰기 요청마다 증가)
+}
+
+type cachedResponse struct {
+	status    int
+	header    http.Header
+	body      []byte
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+// NewResponseCache: 응답 캐시 생성. ttl이 0 이하이면 nil(캐시 비활성화)을 반환한다.
+func NewResponseCache(ttl time.Duration, maxEntries int, logger *slog.Logger) *ResponseCache {
+	if ttl <= 0 {
+		return nil
+	}
+	if maxEntries <= 0 {
+		maxEntries = 1000
+	}
+	return &ResponseCache{
+		ttl:         ttl,
+		maxEntries:  maxEntries,
+		logger:      logger.With(slog.String("component", "proxy_cache")),
+		now:         time.Now,
+		entries:     make(map[string]cachedResponse),
+		generations: make(map[string]uint64),
+	}
+}
+
+// ServeHTTP: 캐시 가능한 GET은 캐시/합류를 거쳐, 나머지는 그대로 next로 보낸다.
+// 쓰기 요청이 끝나면 해당 봇의 캐시를 비워 변경 결과가 바로 보이게 한다.
+func (c *ResponseCache) ServeHTTP(w http.ResponseWriter, r *http.Request, bot string, next http.Handler) {
+	if !isCacheableRequest(r) {
+		if isWriteMethod(r.Method) {
+			defer c.Invalidate(bot)
+		}
+		w.Header().Set(CacheStatusHeader, "BYPASS")
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	key := bot + " " + r.URL.RequestURI()
+	if !requestsRevalidation(r) {
+		if entry, ok := c.get(key); ok {
+			c.write(w, entry, "HIT")
+			return
+		}
+	}
+
+	gen := c.generation(bot)
+	v, _, shared := c.group.Do(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), cacheFetchTimeout)
+		defer cancel()
+
+		rec := &responseRecorder{header: make(http.Header), status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		now := c.now()
+		entry := cachedResponse{
+			status:    rec.status,
+			header:    rec.header,
+			body:      rec.body.Bytes(),
+			storedAt:  now,
+			expiresAt: now.Add(c.ttl),
+		}
+		if isStorableResponse(entry) {
+			c.put(key, bot, gen, entry)
+		}
+		return entry, nil
+	})
+
+	status := "MISS"
+	if shared {
+		status = "SHARED"
+	}
+	c.write(w, v.(cachedResponse), status)
+}
+
+// Invalidate: 봇의 캐시 항목을 모두 지우고, 진행 중인 조회 결과도 저장되지 않게 한다.
+func (c *ResponseCache) Invalidate(bot string) {
+	prefix := bot + " "
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generations[bot]++
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Len: 저장된 캐시 항목 수 (만료 대기 항목 포함)
+func (c *ResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+func (c *ResponseCache) get(key string) (cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return cachedResponse{}, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return cachedResponse{}, false
+	}
+	return entry, true
+}
+
+func (c *ResponseCache) generation(bot string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generations[bot]
+}
+
+func (c *ResponseCache) put(key, bot string, gen uint64, entry cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// 조회 중에 쓰기 요청이 있었으면 변경 전 응답일 수 있으므로 버린다
+	if c.generations[bot] != gen {
+		return
+	}
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evictLocked()
+	}
+	c.entries[key] = entry
+}
+
+// evictLocked: 만료 항목을 먼저 지우고, 그래도 가득 차 있으면 가장 오래된 항목을 지운다.
+func (c *ResponseCache) evictLocked() {
+	now := c.now()
+	oldestKey := ""
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.storedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.storedAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
+
+func (c *ResponseCache) write(w http.ResponseWriter, entry cachedResponse, status string) {
+	header := w.Header()
+	for key, values := range entry.header {
+		header[key] = append([]string(nil), values...)
+	}
+	header.Set(CacheStatusHeader, status)
+	if status == "HIT" {
+		header.Set("Age", strconv.Itoa(int(c.now().Sub(entry.storedAt).Seconds())))
+	}
+	w.WriteHeader(entry.status)
+	if _, err := w.Write(entry.body); err != nil {
+		c.logger.Debug("proxy_cache_write_failed", slog.String("error", err.Error()))
+	}
+}
+
+// isCacheableRequest: 캐시 대상은 WebSocket/SSE가 아닌 GET 요청뿐이다.
+func isCacheableRequest(r *http.Request) bool {
+	if r.Method != http.MethodGet || isWebSocketRequest(r) {
+		return false
+	}
+	return !strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// requestsRevalidation: 클라이언트가 no-cache를 보내면 저장된 응답 대신 새로 조회한다. (강제 새로고침)
+func requestsRevalidation(r *http.Request) bool {
+	cc := strings.ToLower(r.Header.Get("Cache-Control"))
+	return strings.Contains(cc, "no-cache") || strings.Contains(cc, "no-store")
+}
+
+// isStorableResponse: 200 응답 중 쿠키를 설정하지 않고 저장 금지가 아닌 작은 응답만 저장한다.
+func isStorableResponse(entry cachedResponse) bool {
+	if entry.status != http.StatusOK || len(entry.body) > cacheMaxBodyBytes {
+		return false
+	}
+	if len(entry.header.Values("Set-Cookie")) > 0 {
+		return false
+	}
+	cc := strings.ToLower(entry.header.Get("Cache-Control"))
+	return !strings.Contains(cc, "no-store") && !strings.Contains(cc, "private")
+}
+
+// responseRecorder: upstream 응답을 메모리에 받아 두는 ResponseWriter
+type responseRecorder struct {
+	header      http.Header
+	body        bytes.Buffer
+	status      int
+	wroteHeader bool
+}
+
+func (r *responseRecorder) Header() http.Header { return r.header }
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.status = status
+	r.wroteHeader = true
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	r.wroteHeader = true
+	return r.body.Write(p)
+}
+
+// Flush: ReverseProxy의 주기적 플러시 호출을 받아 주기만 한다. (응답은 조회가 끝난 뒤 한 번에 전달)
+func (r *responseRecorder) Flush() {}
@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestCache(t *testing.T, ttl time.Duration) (*ResponseCache, *time.Time) {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cache := NewResponseCache(ttl, 2, logger)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func serveCached(cache *ResponseCache, method, target string, next http.Handler) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	cache.ServeHTTP(w, httptest.NewRequest(method, target, nil), "holo", next)
+	return w
+}
+
+func TestNewResponseCache_DisabledWithoutTTL(t *testing.T) {
+	t.Parallel()
+
+	if cache := NewResponseCache(0, 10, slog.Default()); cache != nil {
+		t.Fatalf("expected nil cache for zero ttl")
+	}
+}
+
+func TestResponseCache_HitUntilExpiry(t *testing.T) {
+	t.Parallel()
+
+	cache, now := newTestCache(t, 2*time.Second)
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	first := serveCached(cache, http.MethodGet, "/api/holo/stats?x=1", next)
+	if got := first.Header().Get(CacheStatusHeader); got != "MISS" {
+		t.Fatalf("first request: got %q, want MISS", got)
+	}
+
+	*now = now.Add(time.Second)
+	second := serveCached(cache, http.MethodGet, "/api/holo/stats?x=1", next)
+	if got := second.Header().Get(CacheStatusHeader); got != "HIT" {
+		t.Fatalf("second request: got %q, want HIT", got)
+	}
+	if second.Body.String() != `{"ok":true}` || second.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("cached response mismatch: %q %v", second.Body.String(), second.Header())
+	}
+	if second.Header().Get("Age") != "1" {
+		t.Fatalf("expected Age 1, got %q", second.Header().Get("Age"))
+	}
+
+	// 쿼리가 다르면 다른 항목
+	serveCached(cache, http.MethodGet, "/api/holo/stats?x=2", next)
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 upstream calls, got %d", calls.Load())
+	}
+
+	*now = now.Add(2 * time.Second)
+	serveCached(cache, http.MethodGet, "/api/holo/stats?x=1", next)
+	if calls.Load() != 3 {
+		t.Fatalf("expired entry should refetch, got %d calls", calls.Load())
+	}
+}
+
+func TestResponseCache_CoalescesConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := newTestCache(t, time.Minute)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		<-release
+		_, _ = w.Write([]byte("slow"))
+	})
+
+	const clients = 8
+	var wg sync.WaitGroup
+	results := make([]*httptest.ResponseRecorder, clients)
+	for i := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = serveCached(cache, http.MethodGet, "/api/holo/members", next)
+		}()
+	}
+
+	// 모든 요청이 합류할 시간을 준 뒤 upstream 응답
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 upstream call, got %d", calls.Load())
+	}
+	for i, w := range results {
+		if w.Body.String() != "slow" {
+			t.Fatalf("client %d got %q", i, w.Body.String())
+		}
+	}
+}
+
+func TestResponseCache_WriteInvalidatesBot(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := newTestCache(t, time.Minute)
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serveCached(cache, http.MethodGet, "/api/holo/alarms", next)
+	if cache.Len() != 1 {
+		t.Fatalf("expected 1 cached entry, got %d", cache.Len())
+	}
+
+	post := serveCached(cache, http.MethodPost, "/api/holo/alarms", next)
+	if got := post.Header().Get(CacheStatusHeader); got != "BYPASS" {
+		t.Fatalf("write request: got %q, want BYPASS", got)
+	}
+	if cache.Len() != 0 {
+		t.Fatalf("write should invalidate bot cache, got %d entries", cache.Len())
+	}
+
+	serveCached(cache, http.MethodGet, "/api/holo/alarms", next)
+	if calls.Load() != 3 {
+		t.Fatalf("expected refetch after write, got %d calls", calls.Load())
+	}
+}
+
+func TestResponseCache_SkipsUnstorableResponses(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := newTestCache(t, time.Minute)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/error":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/cookie":
+			http.SetCookie(w, &http.Cookie{Name: "a", Value: "b"})
+		case "/private":
+			w.Header().Set("Cache-Control", "private")
+		}
+	})
+
+	for _, path := range []string{"/error", "/cookie", "/private"} {
+		serveCached(cache, http.MethodGet, path, next)
+	}
+	if cache.Len() != 0 {
+		t.Fatalf("expected nothing cached, got %d entries", cache.Len())
+	}
+
+	// 클라이언트 강제 새로고침은 저장된 응답을 건너뛴다
+	serveCached(cache, http.MethodGet, "/ok", next)
+	req := httptest.NewRequest(http.MethodGet, "/ok", nil)
+	req.Header.Set("Cache-Control", "no-cache")
+	w := httptest.NewRecorder()
+	cache.ServeHTTP(w, req, "holo", next)
+	if got := w.Header().Get(CacheStatusHeader); got != "MISS" {
+		t.Fatalf("no-cache request: got %q, want MISS", got)
+	}
+}
+
+func TestResponseCache_EvictsOldestWhenFull(t *testing.T) {
+	t.Parallel()
+
+	cache, now := newTestCache(t, time.Minute)
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {})
+
+	for _, path := range []string{"/a", "/b", "/c"} {
+		serveCached(cache, http.MethodGet, path, next)
+		*now = now.Add(time.Second)
+	}
+	if cache.Len() != 2 {
+		t.Fatalf("expected max 2 entries, got %d", cache.Len())
+	}
+	if _, ok := cache.get("holo /a"); ok {
+		t.Fatalf("oldest entry should be evicted")
+	}
+}
@@ -0,0 +1,69 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// llmUsageLivePath: LLM 서버의 실시간 토큰 사용량 스트림 경로
+const llmUsageLivePath = "/api/usage/live"
+
+// LLMUsageProxy: LLM 서버 실시간 사용량 스트림(SSE) 전용 프록시
+// 봇 프록시와 달리 단일 경로만 노출하고, 대시보드 세션 대신 LLM 서버 API 키로 인증한다.
+type LLMUsageProxy struct {
+	proxy  *httputil.ReverseProxy
+	logger *slog.Logger
+}
+
+// NewLLMUsageProxy: LLM 사용량 스트림 프록시 생성
+func NewLLMUsageProxy(llmURL, apiKey string, logger *slog.Logger) (*LLMUsageProxy, error) {
+	target, _, err := normalizeProxyTargetURL(llmURL)
+	if err != nil {
+		return nil, err
+	}
+	proxyLogger := logger.With(slog.String("component", "proxy"))
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	// 장시간 스트림은 H2C 멀티플렉싱 이점이 없으므로 HTTP/1.1 사용
+	proxy.Transport = otelhttp.NewTransport(http.DefaultTransport)
+	// 5초 단위 틱이 버퍼에 머물지 않도록 즉시 플러시
+	proxy.FlushInterval = -1
+
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		r.URL.Path = llmUsageLivePath
+		r.URL.RawPath = ""
+		r.URL.RawQuery = ""
+		// 대시보드 세션 쿠키는 LLM 서버로 넘기지 않음
+		r.Header.Del("Cookie")
+		r.Header.Del("Authorization")
+		r.Header.Del(OperatorHeader)
+		if apiKey != "" {
+			r.Header.Set("X-API-Key", apiKey)
+		} else {
+			r.Header.Del("X-API-Key")
+		}
+	}
+
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		if r.Context().Err() != nil {
+			return // 클라이언트가 스트림을 닫은 경우
+		}
+		proxyLogger.Warn("llm_usage_stream_proxy_error", slog.String("error", err.Error()))
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(`{"error":"LLM usage stream unavailable"}`))
+	}
+
+	return &LLMUsageProxy{proxy: proxy, logger: proxyLogger}, nil
+}
+
+// ServeLive: /admin/api/llm/usage/live → LLM 서버 /api/usage/live
+func (p *LLMUsageProxy) ServeLive(c *gin.Context) {
+	p.logger.Debug("proxy llm usage stream", slog.String("original", c.Request.URL.Path))
+	p.proxy.ServeHTTP(c.Writer, c.Request)
+}
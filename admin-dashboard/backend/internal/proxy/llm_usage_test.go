@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLLMUsageProxy_StreamsWithAPIKey(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	type seen struct{ path, query, apiKey, cookie string }
+	seenCh := make(chan seen, 1)
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenCh <- seen{r.URL.Path, r.URL.RawQuery, r.Header.Get("X-API-Key"), r.Header.Get("Cookie")}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = io.WriteString(w, "event: usage\ndata: {\"total_tokens\":10}\n\n")
+		w.(http.Flusher).Flush()
+		<-release // 스트림이 열린 상태에서도 첫 틱이 전달되어야 함
+	}))
+	t.Cleanup(upstream.Close)
+	t.Cleanup(func() { close(release) })
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	usageProxy, err := NewLLMUsageProxy(upstream.URL, "llm-secret", logger)
+	if err != nil {
+		t.Fatalf("NewLLMUsageProxy error: %v", err)
+	}
+
+	router := gin.New()
+	router.GET("/admin/api/llm/usage/live", usageProxy.ServeLive)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/admin/api/llm/usage/live?transport=sse", nil)
+	req.Header.Set("Cookie", "admin_session=abc")
+	req.Header.Set("X-API-Key", "client-supplied")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	got := <-seenCh
+	if got.path != llmUsageLivePath || got.query != "" {
+		t.Fatalf("unexpected upstream target: %+v", got)
+	}
+	if got.apiKey != "llm-secret" || got.cookie != "" {
+		t.Fatalf("unexpected upstream headers: %+v", got)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	if err != nil || strings.TrimSpace(line) != "event: usage" {
+		t.Fatalf("expected flushed event line, got %q (err=%v)", line, err)
+	}
+}
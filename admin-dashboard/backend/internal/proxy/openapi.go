@@ -0,0 +1,553 @@
+package proxy
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// specFS: 빌드 시 내장되는 봇별 관리 API OpenAPI 3.0 명세
+//
+//go:embed specs/*.json
+var specFS embed.FS
+
+// botSpecFiles: 봇 이름 → 내장 명세 파일. 경로는 프록시가 봇 경로로 바꾼 뒤 기준이다.
+var botSpecFiles = map[string]string{
+	"holo":    "specs/holo.json",
+	"twentyq": "specs/twentyq.json",
+	"turtle":  "specs/turtle.json",
+}
+
+// specMethods: 명세에서 읽는 HTTP 메서드 (pathItem 필드 순서와 같다)
+var specMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// openAPIDocument: 검증에 필요한 OpenAPI 3.0 문서의 부분 집합
+type openAPIDocument struct {
+	Paths      map[string]*pathItem `json:"paths"`
+	Components struct {
+		Schemas    map[string]*schema    `json:"schemas"`
+		Parameters map[string]*parameter `json:"parameters"`
+	} `json:"components"`
+}
+
+type pathItem struct {
+	Parameters []*parameter `json:"parameters"`
+	Get        *operation   `json:"get"`
+	Post       *operation   `json:"post"`
+	Put        *operation   `json:"put"`
+	Patch      *operation   `json:"patch"`
+	Delete     *operation   `json:"delete"`
+}
+
+func (p *pathItem) operations() []*operation {
+	return []*operation{p.Get, p.Post, p.Put, p.Patch, p.Delete}
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Parameters  []*parameter         `json:"parameters"`
+	RequestBody *requestBody         `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Content map[string]mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+// schema: JSON Schema 중 봇 API 계약에 쓰는 키워드만 지원한다.
+type schema struct {
+	Ref                  string                `json:"$ref"`
+	Type                 string                `json:"type"`
+	Format               string                `json:"format"`
+	Nullable             bool                  `json:"nullable"`
+	Enum                 []any                 `json:"enum"`
+	Required             []string              `json:"required"`
+	Properties           map[string]*schema    `json:"properties"`
+	AdditionalProperties *additionalProperties `json:"additionalProperties"`
+	Items                *schema               `json:"items"`
+	MinLength            *int                  `json:"minLength"`
+	MaxLength            *int                  `json:"maxLength"`
+	MinItems             *int                  `json:"minItems"`
+	MaxItems             *int                  `json:"maxItems"`
+	Minimum              *float64              `json:"minimum"`
+	Maximum              *float64              `json:"maximum"`
+	ExclusiveMinimum     bool                  `json:"exclusiveMinimum"`
+	ExclusiveMaximum     bool                  `json:"exclusiveMaximum"`
+}
+
+// additionalProperties: true/false 또는 값 스키마
+type additionalProperties struct {
+	allowed bool
+	schema  *schema
+}
+
+func (a *additionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.allowed); err == nil {
+		return nil
+	}
+	a.allowed = true
+	return json.Unmarshal(data, &a.schema)
+}
+
+// botSpec: 봇 하나의 검증 라우트 목록 (리터럴 세그먼트가 많은 라우트부터 매칭)
+type botSpec struct {
+	routes []*specRoute
+}
+
+type specRoute struct {
+	method      string
+	template    string
+	segments    []string
+	literals    int
+	operationID string
+	params      []*parameter
+	body        *schema
+	bodyNeeded  bool
+	response    *schema // 200 application/json 응답 스키마 (없으면 응답 검증 생략)
+}
+
+// loadBotSpecs: 내장 명세를 모두 읽어 $ref를 풀어 둔다.
+func loadBotSpecs() (map[string]*botSpec, error) {
+	specs := make(map[string]*botSpec, len(botSpecFiles))
+	for bot, file := range botSpecFiles {
+		data, err := specFS.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s spec: %w", bot, err)
+		}
+		spec, err := parseBotSpec(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s spec: %w", bot, err)
+		}
+		specs[bot] = spec
+	}
+	return specs, nil
+}
+
+func parseBotSpec(data []byte) (*botSpec, error) {
+	var doc openAPIDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	r := refResolver{doc: &doc, resolved: make(map[*schema]bool)}
+	spec := &botSpec{}
+	for template, item := range doc.Paths {
+		if !strings.HasPrefix(template, "/") {
+			return nil, fmt.Errorf("path %q must start with /", template)
+		}
+		shared, err := r.parameters(item.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", template, err)
+		}
+		for i, op := range item.operations() {
+			if op == nil {
+				continue
+			}
+			route, err := r.route(specMethods[i], template, shared, op)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", specMethods[i], template, err)
+			}
+			spec.routes = append(spec.routes, route)
+		}
+	}
+
+	// /admin/sessions/cleanup 이 /admin/sessions/{id} 보다 먼저 매칭되도록 정렬
+	sort.SliceStable(spec.routes, func(i, j int) bool {
+		if spec.routes[i].literals != spec.routes[j].literals {
+			return spec.routes[i].literals > spec.routes[j].literals
+		}
+		return spec.routes[i].template < spec.routes[j].template
+	})
+	return spec, nil
+}
+
+// match: 메서드와 봇 경로에 맞는 라우트와 경로 파라미터 값을 찾는다.
+func (s *botSpec) match(method, path string) (*specRoute, map[string]string) {
+	segments := splitPath(path)
+	for _, route := range s.routes {
+		if route.method != method || len(route.segments) != len(segments) {
+			continue
+		}
+		values := make(map[string]string)
+		matched := true
+		for i, seg := range route.segments {
+			if name, ok := pathParamName(seg); ok {
+				values[name] = segments[i]
+				continue
+			}
+			if seg != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return route, values
+		}
+	}
+	return nil, nil
+}
+
+type refResolver struct {
+	doc      *openAPIDocument
+	resolved map[*schema]bool
+}
+
+func (r refResolver) route(method, template string, shared []*parameter, op *operation) (*specRoute, error) {
+	own, err := r.parameters(op.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	// 작업 수준 파라미터가 경로 수준 파라미터를 덮어쓴다
+	params := make([]*parameter, 0, len(shared)+len(own))
+	for _, p := range shared {
+		if !slices.ContainsFunc(own, func(o *parameter) bool { return o.Name == p.Name && o.In == p.In }) {
+			params = append(params, p)
+		}
+	}
+	params = append(params, own...)
+
+	route := &specRoute{
+		method:      method,
+		template:    template,
+		segments:    splitPath(template),
+		operationID: op.OperationID,
+		params:      params,
+	}
+	for _, seg := range route.segments {
+		if _, ok := pathParamName(seg); !ok {
+			route.literals++
+		}
+	}
+
+	if op.RequestBody != nil {
+		media, ok := op.RequestBody.Content["application/json"]
+		if !ok {
+			return nil, fmt.Errorf("requestBody must declare application/json")
+		}
+		if route.body, err = r.schema(media.Schema); err != nil {
+			return nil, err
+		}
+		route.bodyNeeded = op.RequestBody.Required
+	}
+	if resp := op.Responses["200"]; resp != nil {
+		if media, ok := resp.Content["application/json"]; ok {
+			if route.response, err = r.schema(media.Schema); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return route, nil
+}
+
+func (r refResolver) parameters(params []*parameter) ([]*parameter, error) {
+	out := make([]*parameter, 0, len(params))
+	for _, p := range params {
+		if p.Ref != "" {
+			name, ok := strings.CutPrefix(p.Ref, "#/components/parameters/")
+			target := r.doc.Components.Parameters[name]
+			if !ok || target == nil {
+				return nil, fmt.Errorf("unresolved parameter ref %q", p.Ref)
+			}
+			p = target
+		}
+		if p.In != "path" && p.In != "query" {
+			return nil, fmt.Errorf("parameter %q: unsupported location %q", p.Name, p.In)
+		}
+		s, err := r.schema(p.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", p.Name, err)
+		}
+		p.Schema = s
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// schema: $ref를 component 스키마 포인터로 바꾸고 하위 스키마도 같은 방식으로 푼다.
+func (r refResolver) schema(s *schema) (*schema, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if s.Ref != "" {
+		name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/")
+		target := r.doc.Components.Schemas[name]
+		if !ok || target == nil {
+			return nil, fmt.Errorf("unresolved schema ref %q", s.Ref)
+		}
+		s = target
+	}
+	if r.resolved[s] {
+		return s, nil
+	}
+	r.resolved[s] = true
+
+	for name, prop := range s.Properties {
+		resolved, err := r.schema(prop)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", name, err)
+		}
+		s.Properties[name] = resolved
+	}
+	items, err := r.schema(s.Items)
+	if err != nil {
+		return nil, fmt.Errorf("items: %w", err)
+	}
+	s.Items = items
+	if s.AdditionalProperties != nil {
+		if s.AdditionalProperties.schema, err = r.schema(s.AdditionalProperties.schema); err != nil {
+			return nil, fmt.Errorf("additionalProperties: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func pathParamName(segment string) (string, bool) {
+	if len(segment) > 2 && segment[0] == '{' && segment[len(segment)-1] == '}' {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+// ValidationIssue: 명세와 맞지 않는 요청 항목 하나
+type ValidationIssue struct {
+	In      string `json:"in"` // path, query, body
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// validateValue: JSON 값(json.Number 디코딩)을 스키마와 비교해 위반 항목을 쌓는다.
+func validateValue(s *schema, v any, in, field string, issues *[]ValidationIssue) {
+	if s == nil {
+		return
+	}
+	add := func(format string, args ...any) {
+		*issues = append(*issues, ValidationIssue{In: in, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if v == nil {
+		if !s.Nullable && s.Type != "" {
+			add("must be %s, got null", typeLabel(s.Type))
+		}
+		return
+	}
+
+	switch s.Type {
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			add("must be a string")
+			return
+		}
+		n := utf8.RuneCountInString(str)
+		if s.MinLength != nil && n < *s.MinLength {
+			add("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			add("must be at most %d characters", *s.MaxLength)
+		}
+		if msg := checkFormat(s.Format, str); msg != "" {
+			add("%s", msg)
+		}
+	case "integer", "number":
+		num, ok := v.(json.Number)
+		if !ok {
+			add("must be %s", typeLabel(s.Type))
+			return
+		}
+		if s.Type == "integer" {
+			if _, err := strconv.ParseInt(num.String(), 10, 64); err != nil {
+				add("must be an integer")
+				return
+			}
+		}
+		f, err := num.Float64()
+		if err != nil {
+			add("must be a number")
+			return
+		}
+		checkRange(s, f, add)
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			add("must be a boolean")
+			return
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			add("must be an array")
+			return
+		}
+		if s.MinItems != nil && len(items) < *s.MinItems {
+			add("must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(items) > *s.MaxItems {
+			add("must have at most %d items", *s.MaxItems)
+		}
+		for i, item := range items {
+			validateValue(s.Items, item, in, fmt.Sprintf("%s[%d]", field, i), issues)
+		}
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			add("must be an object")
+			return
+		}
+		validateObject(s, obj, in, field, issues)
+	}
+
+	if len(s.Enum) > 0 && !enumContains(s.Enum, v) {
+		add("must be one of %s", enumLabel(s.Enum))
+	}
+}
+
+func validateObject(s *schema, obj map[string]any, in, field string, issues *[]ValidationIssue) {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			*issues = append(*issues, ValidationIssue{In: in, Field: joinField(field, name), Message: "is required"})
+		}
+	}
+
+	// 맵 순회 순서와 무관하게 같은 오류 순서를 내도록 키를 정렬한다
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if prop, ok := s.Properties[key]; ok {
+			validateValue(prop, obj[key], in, joinField(field, key), issues)
+			continue
+		}
+		switch extra := s.AdditionalProperties; {
+		case extra == nil || (extra.allowed && extra.schema == nil):
+		case !extra.allowed:
+			*issues = append(*issues, ValidationIssue{In: in, Field: joinField(field, key), Message: "is not allowed"})
+		default:
+			validateValue(extra.schema, obj[key], in, joinField(field, key), issues)
+		}
+	}
+}
+
+// parseParamValue: 경로/쿼리 문자열을 스키마 타입의 JSON 값으로 바꾼다. 바꿀 수 없으면 false.
+func parseParamValue(s *schema, raw string) (any, bool) {
+	if s == nil {
+		return raw, true
+	}
+	switch s.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(raw, 10, 64); err != nil {
+			return nil, false
+		}
+		return json.Number(raw), true
+	case "number":
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return nil, false
+		}
+		return json.Number(raw), true
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	default:
+		return raw, true
+	}
+}
+
+func checkRange(s *schema, f float64, add func(string, ...any)) {
+	if s.Minimum != nil {
+		if s.ExclusiveMinimum && f <= *s.Minimum {
+			add("must be > %s", formatNumber(*s.Minimum))
+		} else if f < *s.Minimum {
+			add("must be >= %s", formatNumber(*s.Minimum))
+		}
+	}
+	if s.Maximum != nil {
+		if s.ExclusiveMaximum && f >= *s.Maximum {
+			add("must be < %s", formatNumber(*s.Maximum))
+		} else if f > *s.Maximum {
+			add("must be <= %s", formatNumber(*s.Maximum))
+		}
+	}
+}
+
+func checkFormat(format, value string) string {
+	switch format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return "must be an RFC 3339 date-time"
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	}
+	return ""
+}
+
+func enumContains(enum []any, v any) bool {
+	got := fmt.Sprint(v)
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == got {
+			return true
+		}
+	}
+	return false
+}
+
+func enumLabel(enum []any) string {
+	parts := make([]string, len(enum))
+	for i, v := range enum {
+		parts[i] = fmt.Sprint(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+func typeLabel(t string) string {
+	switch t {
+	case "integer", "object", "array":
+		return "an " + t
+	default:
+		return "a " + t
+	}
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -17,6 +17,9 @@ import (
 	"golang.org/x/net/http2"
 )
 
+// OperatorHeader: 봇 서비스에 인증된 관리자 사용자명을 전달하는 헤더
+const OperatorHeader = "X-Admin-User"
+
 // BotProxies: 각 봇에 대한 리버스 프록시
 // 일반 API는 H2C, WebSocket은 HTTP/1.1 Transport를 사용한다.
 type BotProxies struct {
@@ -26,6 +29,8 @@ type BotProxies struct {
 	TwentyQWS *httputil.ReverseProxy
 	Turtle    *httputil.ReverseProxy
 	TurtleWS  *httputil.ReverseProxy
+	cache     *ResponseCache // 응답 캐시 비활성화 시 nil
+	validator *SpecValidator // 명세 검증 비활성화 시 nil
 	logger    *slog.Logger
 }
 
@@ -72,6 +77,32 @@ func NewBotProxies(holoURL, twentyqURL, turtleURL string, logger *slog.Logger) (
 	}, nil
 }
 
+// SetCache: GET 응답 캐시를 연결합니다. nil이면 모든 요청을 그대로 전달합니다.
+func (p *BotProxies) SetCache(cache *ResponseCache) {
+	p.cache = cache
+}
+
+// SetValidator: 봇 API 명세 검증기를 연결합니다. nil이면 검증 없이 전달합니다.
+func (p *BotProxies) SetValidator(validator *SpecValidator) {
+	p.validator = validator
+}
+
+// serve: 명세 검증 → 캐시 → 봇 순서로 전달합니다. 거절된 요청은 캐시도 무효화하지 않습니다.
+func (p *BotProxies) serve(c *gin.Context, bot string, proxy *httputil.ReverseProxy) {
+	var next http.Handler = proxy
+	if p.cache != nil {
+		cache := p.cache
+		next = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cache.ServeHTTP(w, r, bot, proxy)
+		})
+	}
+	if p.validator == nil {
+		next.ServeHTTP(c.Writer, c.Request)
+		return
+	}
+	p.validator.ServeHTTP(c.Writer, c.Request, bot, next)
+}
+
 func normalizeProxyTargetURL(targetURL string) (*url.URL, bool, error) {
 	targetURL = strings.TrimSpace(targetURL)
 	target, err := url.Parse(targetURL)
@@ -227,7 +258,7 @@ func (p *BotProxies) ProxyHolo(c *gin.Context) {
 		slog.String("original", originalPath),
 		slog.String("target", newPath),
 	)
-	p.Holo.ServeHTTP(c.Writer, c.Request)
+	p.serve(c, "holo", p.Holo)
 }
 
 // ProxyTwentyQ: twentyq-bot으로 프록시
@@ -259,7 +290,7 @@ func (p *BotProxies) ProxyTwentyQ(c *gin.Context) {
 		slog.String("original", originalPath),
 		slog.String("target", newPath),
 	)
-	p.TwentyQ.ServeHTTP(c.Writer, c.Request)
+	p.serve(c, "twentyq", p.TwentyQ)
 }
 
 // ProxyTurtle: turtle-soup-bot으로 프록시
@@ -291,5 +322,5 @@ func (p *BotProxies) ProxyTurtle(c *gin.Context) {
 		slog.String("original", originalPath),
 		slog.String("target", newPath),
 	)
-	p.Turtle.ServeHTTP(c.Writer, c.Request)
+	p.serve(c, "turtle", p.Turtle)
 }
@@ -0,0 +1,278 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// ValidationMode: 봇 API 명세 검증 동작
+type ValidationMode string
+
+// ValidationMode 상수 목록.
+const (
+	// ValidationEnforce: 명세와 맞지 않는 요청을 봇에 보내지 않고 400으로 거절
+	ValidationEnforce ValidationMode = "enforce"
+	// ValidationWarn: 위반을 로그로만 남기고 그대로 전달
+	ValidationWarn ValidationMode = "warn"
+	// ValidationOff: 검증하지 않음
+	ValidationOff ValidationMode = "off"
+)
+
+const (
+	// validationErrorCode: 검증 실패 응답의 error 값 (봇 오류 응답과 같은 error/message 형태)
+	validationErrorCode = "VALIDATION_FAILED"
+	// specMaxBodyBytes: 검증을 위해 읽는 요청/응답 본문 상한
+	specMaxBodyBytes = 1 << 20
+	// maxLoggedIssues: 로그 한 줄에 남기는 위반 항목 수
+	maxLoggedIssues = 5
+)
+
+// ParseValidationMode: 환경변수 값을 ValidationMode로 바꿉니다. 비어 있으면 enforce.
+func ParseValidationMode(raw string) (ValidationMode, error) {
+	switch mode := ValidationMode(strings.ToLower(strings.TrimSpace(raw))); mode {
+	case "":
+		return ValidationEnforce, nil
+	case ValidationEnforce, ValidationWarn, ValidationOff:
+		return mode, nil
+	default:
+		return ValidationEnforce, fmt.Errorf("unknown validation mode %q (enforce|warn|off)", raw)
+	}
+}
+
+// SpecValidator: 내장 OpenAPI 명세로 봇 관리 API 요청/응답을 검증한다.
+// 요청 위반은 모드에 따라 봇에 닿기 전에 거절하고, 응답 위반은 봇과 명세가 어긋났다는 신호로 로그만 남긴다.
+// 명세에 없는 경로는 검증 없이 그대로 전달한다.
+type SpecValidator struct {
+	mode   ValidationMode
+	specs  map[string]*botSpec
+	logger *slog.Logger
+}
+
+// ValidationErrorResponse: 요청 검증 실패 응답 본문
+type ValidationErrorResponse struct {
+	Error     string            `json:"error"`
+	Message   string            `json:"message"`
+	Operation string            `json:"operation,omitempty"`
+	Details   []ValidationIssue `json:"details"`
+}
+
+// NewSpecValidator: 내장 명세를 읽어 검증기를 생성합니다. off 모드이면 nil을 반환합니다.
+func NewSpecValidator(mode ValidationMode, logger *slog.Logger) (*SpecValidator, error) {
+	if mode == ValidationOff {
+		return nil, nil
+	}
+	specs, err := loadBotSpecs()
+	if err != nil {
+		return nil, err
+	}
+	return &SpecValidator{
+		mode:   mode,
+		specs:  specs,
+		logger: logger.With(slog.String("component", "proxy_spec")),
+	}, nil
+}
+
+// ServeHTTP: 요청을 명세와 비교한 뒤 next로 보냅니다. r.URL.Path는 봇 경로로 바뀐 상태여야 합니다.
+func (v *SpecValidator) ServeHTTP(w http.ResponseWriter, r *http.Request, bot string, next http.Handler) {
+	spec, ok := v.specs[bot]
+	if !ok {
+		next.ServeHTTP(w, r)
+		return
+	}
+	route, pathValues := spec.match(r.Method, r.URL.Path)
+	if route == nil {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	issues := route.validateRequest(r, pathValues)
+	if len(issues) > 0 {
+		v.logIssues("proxy_request_spec_mismatch", bot, route, r, issues)
+		if v.mode == ValidationEnforce {
+			writeValidationError(w, bot, route, issues)
+			return
+		}
+	}
+
+	if route.response == nil {
+		next.ServeHTTP(w, r)
+		return
+	}
+	tee := &teeResponseWriter{ResponseWriter: w, status: http.StatusOK}
+	next.ServeHTTP(tee, r)
+	if issues := route.validateResponse(tee); len(issues) > 0 {
+		v.logIssues("proxy_response_spec_mismatch", bot, route, r, issues)
+	}
+}
+
+func (v *SpecValidator) logIssues(msg, bot string, route *specRoute, r *http.Request, issues []ValidationIssue) {
+	logged := issues[:min(len(issues), maxLoggedIssues)]
+	v.logger.Warn(msg,
+		slog.String("bot", bot),
+		slog.String("operation", route.operationID),
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Int("issues", len(issues)),
+		slog.Any("details", logged),
+		slog.String("mode", string(v.mode)),
+	)
+}
+
+// validateRequest: 경로/쿼리 파라미터와 JSON 본문을 검사한다. 본문은 읽은 뒤 다시 채워 둔다.
+func (rt *specRoute) validateRequest(r *http.Request, pathValues map[string]string) []ValidationIssue {
+	var issues []ValidationIssue
+	query := r.URL.Query()
+	for _, p := range rt.params {
+		raw, present := pathValues[p.Name], true
+		if p.In == "query" {
+			raw, present = query.Get(p.Name), query.Has(p.Name)
+		}
+		if !present {
+			if p.Required {
+				issues = append(issues, ValidationIssue{In: p.In, Field: p.Name, Message: "is required"})
+			}
+			continue
+		}
+		value, ok := parseParamValue(p.Schema, raw)
+		if !ok {
+			issues = append(issues, ValidationIssue{In: p.In, Field: p.Name, Message: "must be " + typeLabel(p.Schema.Type)})
+			continue
+		}
+		validateValue(p.Schema, value, p.In, p.Name, &issues)
+	}
+
+	if rt.body != nil {
+		issues = append(issues, rt.validateBody(r)...)
+	}
+	return issues
+}
+
+func (rt *specRoute) validateBody(r *http.Request) []ValidationIssue {
+	bodyIssue := func(msg string) []ValidationIssue {
+		return []ValidationIssue{{In: "body", Message: msg}}
+	}
+
+	var body []byte
+	if r.Body != nil {
+		data, err := io.ReadAll(io.LimitReader(r.Body, specMaxBodyBytes+1))
+		_ = r.Body.Close()
+		if err != nil {
+			return bodyIssue("failed to read request body")
+		}
+		body = data
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if rt.bodyNeeded {
+			return bodyIssue("request body is required")
+		}
+		return nil
+	}
+	if len(body) > specMaxBodyBytes {
+		return bodyIssue(fmt.Sprintf("request body exceeds %d bytes", specMaxBodyBytes))
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mediaType, _, err := mime.ParseMediaType(ct); err != nil || mediaType != "application/json" {
+			return bodyIssue("content type must be application/json")
+		}
+	}
+
+	value, err := decodeJSONValue(body)
+	if err != nil {
+		return bodyIssue("invalid JSON: " + err.Error())
+	}
+	var issues []ValidationIssue
+	validateValue(rt.body, value, "body", "", &issues)
+	return issues
+}
+
+// validateResponse: 200 JSON 응답을 응답 스키마와 비교한다. 큰 응답이나 JSON이 아닌 응답은 건너뛴다.
+func (rt *specRoute) validateResponse(tee *teeResponseWriter) []ValidationIssue {
+	if tee.status != http.StatusOK || tee.overflow || tee.body.Len() == 0 {
+		return nil
+	}
+	if mediaType, _, err := mime.ParseMediaType(tee.Header().Get("Content-Type")); err != nil || mediaType != "application/json" {
+		return nil
+	}
+	value, err := decodeJSONValue(tee.body.Bytes())
+	if err != nil {
+		return []ValidationIssue{{In: "response", Message: "invalid JSON: " + err.Error()}}
+	}
+	var issues []ValidationIssue
+	validateValue(rt.response, value, "response", "", &issues)
+	return issues
+}
+
+func decodeJSONValue(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+func writeValidationError(w http.ResponseWriter, bot string, route *specRoute, issues []ValidationIssue) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(ValidationErrorResponse{
+		Error:     validationErrorCode,
+		Message:   fmt.Sprintf("request does not match %s API spec", bot),
+		Operation: route.operationID,
+		Details:   issues,
+	})
+}
+
+// teeResponseWriter: 응답을 그대로 클라이언트에 쓰면서 검증용 사본을 상한까지 남긴다.
+type teeResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+	overflow    bool
+}
+
+func (t *teeResponseWriter) WriteHeader(status int) {
+	if !t.wroteHeader {
+		t.status = status
+		t.wroteHeader = true
+	}
+	t.ResponseWriter.WriteHeader(status)
+}
+
+func (t *teeResponseWriter) Write(p []byte) (int, error) {
+	t.wroteHeader = true
+	if !t.overflow {
+		if t.body.Len()+len(p) > specMaxBodyBytes {
+			t.overflow = true
+			t.body.Reset()
+		} else {
+			t.body.Write(p)
+		}
+	}
+	return t.ResponseWriter.Write(p)
+}
+
+// Flush: ReverseProxy의 스트리밍 플러시를 원래 Writer로 넘긴다.
+func (t *teeResponseWriter) Flush() {
+	if f, ok := t.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap: http.ResponseController가 원래 Writer에 접근할 수 있게 한다.
+func (t *teeResponseWriter) Unwrap() http.ResponseWriter {
+	return t.ResponseWriter
+}
@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newTestValidator(t *testing.T, mode ValidationMode) (*SpecValidator, *bytes.Buffer) {
+	t.Helper()
+	var logs bytes.Buffer
+	validator, err := NewSpecValidator(mode, slog.New(slog.NewTextHandler(&logs, nil)))
+	if err != nil {
+		t.Fatalf("NewSpecValidator error: %v", err)
+	}
+	return validator, &logs
+}
+
+func serveValidated(v *SpecValidator, bot, method, target, body string, next http.Handler) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	v.ServeHTTP(w, req, bot, next)
+	return w
+}
+
+func decodeValidationError(t *testing.T, w *httptest.ResponseRecorder) ValidationErrorResponse {
+	t.Helper()
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d (%s)", w.Code, w.Body.String())
+	}
+	var resp ValidationErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode validation error: %v", err)
+	}
+	return resp
+}
+
+func TestLoadBotSpecs_EmbeddedSpecsResolve(t *testing.T) {
+	t.Parallel()
+
+	specs, err := loadBotSpecs()
+	if err != nil {
+		t.Fatalf("loadBotSpecs error: %v", err)
+	}
+	for bot := range botSpecFiles {
+		if spec := specs[bot]; spec == nil || len(spec.routes) == 0 {
+			t.Fatalf("%s spec has no routes", bot)
+		}
+	}
+}
+
+func TestParseValidationMode(t *testing.T) {
+	t.Parallel()
+
+	for raw, want := range map[string]ValidationMode{"": ValidationEnforce, " WARN ": ValidationWarn, "off": ValidationOff} {
+		got, err := ParseValidationMode(raw)
+		if err != nil || got != want {
+			t.Fatalf("ParseValidationMode(%q) = %q, %v; want %q", raw, got, err, want)
+		}
+	}
+	if _, err := ParseValidationMode("strict"); err == nil {
+		t.Fatalf("expected error for unknown mode")
+	}
+	if v, err := NewSpecValidator(ValidationOff, slog.Default()); v != nil || err != nil {
+		t.Fatalf("off mode should return nil validator")
+	}
+}
+
+func TestSpecValidator_RejectsMalformedBodyBeforeBot(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := newTestValidator(t, ValidationEnforce)
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { calls.Add(1) })
+
+	w := serveValidated(validator, "holo", http.MethodPost, "/api/holo/members/7/aliases", `{"type":"en","extra":1}`, next)
+	resp := decodeValidationError(t, w)
+	if calls.Load() != 0 {
+		t.Fatalf("invalid request must not reach bot")
+	}
+	if resp.Error != validationErrorCode || resp.Operation != "addMemberAlias" {
+		t.Fatalf("unexpected error envelope: %+v", resp)
+	}
+	want := []ValidationIssue{
+		{In: "body", Field: "alias", Message: "is required"},
+		{In: "body", Field: "type", Message: "must be one of [ko, ja]"},
+	}
+	if len(resp.Details) != len(want) {
+		t.Fatalf("details mismatch: %+v", resp.Details)
+	}
+	for i := range want {
+		if resp.Details[i] != want[i] {
+			t.Fatalf("detail %d: got %+v, want %+v", i, resp.Details[i], want[i])
+		}
+	}
+}
+
+func TestSpecValidator_ChecksPathAndQueryParams(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := newTestValidator(t, ValidationEnforce)
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {})
+
+	w := serveValidated(validator, "holo", http.MethodPatch, "/api/holo/members/abc/name", `{"name":"페코라"}`, next)
+	if resp := decodeValidationError(t, w); resp.Details[0].In != "path" || resp.Details[0].Field != "id" {
+		t.Fatalf("expected path id issue, got %+v", resp.Details)
+	}
+
+	w = serveValidated(validator, "twentyq", http.MethodPost, "/admin/analytics/exports", "", next)
+	if resp := decodeValidationError(t, w); resp.Details[0].Field != "date" || resp.Details[0].Message != "is required" {
+		t.Fatalf("expected missing date issue, got %+v", resp.Details)
+	}
+
+	w = serveValidated(validator, "turtle", http.MethodGet, "/admin/puzzles?status=deleted&limit=0", "", next)
+	if resp := decodeValidationError(t, w); len(resp.Details) != 2 {
+		t.Fatalf("expected status and limit issues, got %+v", resp.Details)
+	}
+
+	// 리터럴 경로가 파라미터 경로보다 먼저 매칭된다
+	w = serveValidated(validator, "twentyq", http.MethodPost, "/admin/sessions/cleanup", `{"olderThanHours":"x"}`, next)
+	if resp := decodeValidationError(t, w); resp.Operation != "cleanupSessions" {
+		t.Fatalf("expected cleanupSessions, got %+v", resp)
+	}
+}
+
+func TestSpecValidator_PassesValidAndUnknownRequests(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := newTestValidator(t, ValidationEnforce)
+	var received string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	body := `{"name":"봄맞이","startsAt":"2026-03-01T00:00:00+09:00","endsAt":"2026-03-08T00:00:00+09:00","categoryWeights":{"FOOD":2}}`
+	w := serveValidated(validator, "twentyq", http.MethodPost, "/admin/theme-events", body, next)
+	if w.Code != http.StatusNoContent || received != body {
+		t.Fatalf("valid request should reach bot with body intact: %d %q", w.Code, received)
+	}
+
+	w = serveValidated(validator, "holo", http.MethodPost, "/api/holo/unknown", `not json`, next)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("unknown route should pass through, got %d", w.Code)
+	}
+}
+
+func TestSpecValidator_WarnModeForwardsAndLogs(t *testing.T) {
+	t.Parallel()
+
+	validator, logs := newTestValidator(t, ValidationWarn)
+	var calls atomic.Int32
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { calls.Add(1) })
+
+	serveValidated(validator, "turtle", http.MethodPut, "/admin/sessions/s1/supervision", `{"enabled":"yes"}`, next)
+	if calls.Load() != 1 {
+		t.Fatalf("warn mode should forward request")
+	}
+	if !strings.Contains(logs.String(), "proxy_request_spec_mismatch") {
+		t.Fatalf("expected mismatch log, got %q", logs.String())
+	}
+}
+
+func TestSpecValidator_LogsResponseMismatch(t *testing.T) {
+	t.Parallel()
+
+	validator, logs := newTestValidator(t, ValidationEnforce)
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message":"done"}`))
+	})
+
+	w := serveValidated(validator, "turtle", http.MethodPost, "/admin/sessions/cleanup", `{"olderThanHours":6}`, next)
+	if w.Code != http.StatusOK || w.Body.String() != `{"message":"done"}` {
+		t.Fatalf("response should be forwarded unchanged: %d %q", w.Code, w.Body.String())
+	}
+	if !strings.Contains(logs.String(), "proxy_response_spec_mismatch") || !strings.Contains(logs.String(), "deletedCount") {
+		t.Fatalf("expected response mismatch log, got %q", logs.String())
+	}
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/activity"
+)
+
+const (
+	// activityDefaultBackfill/activityMaxBackfill: 연결 직후 보내는 과거 이벤트 수 (?backfill=)
+	activityDefaultBackfill = 50
+	activityMaxBackfill     = 200
+)
+
+// activityMessage: 활동 피드 스트림 메시지 (연결 직후 보내는 과거 이벤트는 backfill=true)
+type activityMessage struct {
+	activity.Event
+	Backfill bool `json:"backfill,omitempty"`
+}
+
+// handleActivityStream: 게임 시작/종료, 알림 발송, 컨테이너 재시작을 하나로 모은 실시간 활동 피드
+// 연결하면 최근 이벤트를 오래된 순으로 먼저 보낸 뒤 새 이벤트를 이어서 보낸다. (?backfill=50, 0이면 생략)
+func (s *Server) handleActivityStream(c *gin.Context) {
+	if s.activityHub == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Activity feed not initialized"})
+		return
+	}
+
+	backfill := activityDefaultBackfill
+	if raw := c.Query("backfill"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid backfill"})
+			return
+		}
+		backfill = min(n, activityMaxBackfill)
+	}
+
+	stream, ok := openStream(c)
+	if !ok {
+		return
+	}
+	defer func() { _ = stream.Close() }()
+
+	// 백필을 읽는 사이에 들어온 이벤트를 놓치지 않도록 먼저 구독하고, 겹치는 이벤트는 ID로 거른다
+	events, unsubscribe := s.activityHub.Subscribe()
+	defer unsubscribe()
+
+	ctx := c.Request.Context()
+	recent, err := s.activityHub.Recent(ctx, backfill)
+	if err != nil {
+		s.logger.Warn("activity_backfill_failed", slog.Any("error", err))
+	}
+	sent := make(map[string]bool, len(recent))
+	for _, event := range recent {
+		sent[event.ID] = true
+		if err := stream.WriteJSON(activityMessage{Event: event, Backfill: true}); err != nil {
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stream.Done():
+			return
+		case event := <-events:
+			if sent[event.ID] {
+				delete(sent, event.ID)
+				continue
+			}
+			if err := stream.WriteJSON(activityMessage{Event: event}); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/alerts"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+)
+
+const alertDefaultHistoryLimit = 50
+
+// handleAlertList godoc
+// @Summary      List alerts
+// @Description  Get currently firing alerts and recent fire/resolve history (newest first)
+// @Tags         alerts
+// @Produce      json
+// @Security     SessionCookie
+// @Param        limit  query     int  false  "Max history entries (default 50, max 200)"
+// @Success      200    {object}  AlertListResponse
+// @Failure      400    {object}  ErrorResponse  "Invalid limit"
+// @Failure      503    {object}  ErrorResponse  "Alerts unavailable"
+// @Router       /alerts [get]
+func (s *Server) handleAlertList(c *gin.Context) {
+	if s.alertService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Alerts not available"})
+		return
+	}
+
+	limit := alertDefaultHistoryLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": "limit must be a positive integer"})
+			return
+		}
+		limit = min(parsed, alerts.MaxHistory)
+	}
+
+	ctx := c.Request.Context()
+	active, err := s.alertService.Active(ctx)
+	if err != nil {
+		s.logger.Error("alert_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load alerts"})
+		return
+	}
+	history, err := s.alertService.History(ctx, limit)
+	if err != nil {
+		s.logger.Error("alert_history_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load alerts"})
+		return
+	}
+	c.JSON(http.StatusOK, AlertListResponse{Status: "ok", Active: active, History: history})
+}
+
+// handleAlertRuleList godoc
+// @Summary      List alert rules
+// @Description  Get all alert rules
+// @Tags         alerts
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  AlertRuleListResponse
+// @Failure      503  {object}  ErrorResponse  "Alerts unavailable"
+// @Router       /alerts/rules [get]
+func (s *Server) handleAlertRuleList(c *gin.Context) {
+	if s.alertService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Alerts not available"})
+		return
+	}
+
+	rules, err := s.alertService.ListRules(c.Request.Context())
+	if err != nil {
+		s.logger.Error("alert_rule_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load alert rules"})
+		return
+	}
+	c.JSON(http.StatusOK, AlertRuleListResponse{Status: "ok", Rules: rules})
+}
+
+// handleAlertRuleCreate godoc
+// @Summary      Create alert rule
+// @Description  Define an alert rule (service down for N minutes, Jaeger SPM error rate above threshold, or container restart loop)
+// @Tags         alerts
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      AlertRuleRequest  true  "Alert rule definition"
+// @Success      201      {object}  AlertRuleResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid alert rule"
+// @Failure      409      {object}  ErrorResponse  "Alert rule already exists"
+// @Failure      503      {object}  ErrorResponse  "Alerts unavailable"
+// @Router       /alerts/rules [post]
+func (s *Server) handleAlertRuleCreate(c *gin.Context) {
+	if s.alertService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Alerts not available"})
+		return
+	}
+
+	var req AlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	created, err := s.alertService.Create(c.Request.Context(), req.toRule(), c.GetString(auth.ContextKeyUsername))
+	s.respondAlertRuleUpdate(c, http.StatusCreated, created, err)
+}
+
+// handleAlertRuleUpdate godoc
+// @Summary      Update alert rule
+// @Description  Replace an alert rule definition (pending duration restarts; disabling clears a firing alert without notification)
+// @Tags         alerts
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id       path      string            true  "Alert rule ID"
+// @Param        request  body      AlertRuleRequest  true  "Alert rule definition (id is ignored)"
+// @Success      200      {object}  AlertRuleResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid alert rule"
+// @Failure      404      {object}  ErrorResponse  "Alert rule not found"
+// @Failure      503      {object}  ErrorResponse  "Alerts unavailable"
+// @Router       /alerts/rules/{id} [put]
+func (s *Server) handleAlertRuleUpdate(c *gin.Context) {
+	if s.alertService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Alerts not available"})
+		return
+	}
+
+	var req AlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	updated, err := s.alertService.Update(c.Request.Context(), c.Param("id"), req.toRule(), c.GetString(auth.ContextKeyUsername))
+	s.respondAlertRuleUpdate(c, http.StatusOK, updated, err)
+}
+
+func (s *Server) respondAlertRuleUpdate(c *gin.Context, okStatus int, rule alerts.Rule, err error) {
+	switch {
+	case err == nil:
+		c.JSON(okStatus, AlertRuleResponse{Status: "ok", Rule: rule})
+	case errors.Is(err, alerts.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid alert rule", "details": err.Error()})
+	case errors.Is(err, alerts.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Alert rule not found"})
+	case errors.Is(err, alerts.ErrExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "Alert rule already exists"})
+	default:
+		s.logger.Error("alert_rule_save_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save alert rule"})
+	}
+}
+
+// handleAlertRuleDelete godoc
+// @Summary      Delete alert rule
+// @Description  Delete an alert rule and its firing alert (history is kept)
+// @Tags         alerts
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id   path      string  true  "Alert rule ID"
+// @Success      200  {object}  StatusResponse
+// @Failure      404  {object}  ErrorResponse  "Alert rule not found"
+// @Failure      503  {object}  ErrorResponse  "Alerts unavailable"
+// @Router       /alerts/rules/{id} [delete]
+func (s *Server) handleAlertRuleDelete(c *gin.Context) {
+	if s.alertService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Alerts not available"})
+		return
+	}
+
+	err := s.alertService.Delete(c.Request.Context(), c.Param("id"), c.GetString(auth.ContextKeyUsername))
+	switch {
+	case errors.Is(err, alerts.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Alert rule not found"})
+	case err != nil:
+		s.logger.Error("alert_rule_delete_failed", slog.String("id", c.Param("id")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete alert rule"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+)
+
+// handleDockerInspect godoc
+// @Summary      Inspect container config
+// @Description  Return a sanitized view of a managed container: image, env vars (secret values masked) with their source compared to the image defaults (image, override, container), mounts, restart policy, labels and state including the latest health check results
+// @Tags         docker
+// @Produce      json
+// @Security     SessionCookie
+// @Param        name  path      string  true  "Container name"
+// @Success      200   {object}  DockerInspectResponse
+// @Failure      404   {object}  ErrorResponse  "Container not found"
+// @Failure      503   {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/containers/{name}/inspect [get]
+func (s *Server) handleDockerInspect(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+	name := c.Param("name")
+	if !s.dockerSvc.IsManaged(name) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "container not found"})
+		return
+	}
+
+	inspect, err := s.dockerSvc.InspectContainer(c.Request.Context(), name, drift.IsSensitiveEnv)
+	if err != nil {
+		if errors.Is(err, docker.ErrContainerNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "container not found"})
+			return
+		}
+		s.logger.Error("Failed to inspect container", slog.String("container", name), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, DockerInspectResponse{Status: "ok", Container: inspect})
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+)
+
+// handleDockerPrune godoc
+// @Summary      Prune stale one-off containers
+// @Description  Remove exited containers that carry all prune policy labels (default: llm-bot compose run containers) and finished longer ago than the policy age. With dry_run=true only lists the targets. Removed containers are recorded in the audit log
+// @Tags         docker
+// @Produce      json
+// @Security     SessionCookie
+// @Param        dry_run  query     bool  false  "List targets without removing"  default(false)
+// @Success      200      {object}  DockerPruneResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid query"
+// @Failure      409      {object}  ErrorResponse  "Prune already in progress"
+// @Failure      503      {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/prune [post]
+func (s *Server) handleDockerPrune(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+
+	dryRun := false
+	if raw := c.Query("dry_run"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": "dry_run must be a boolean"})
+			return
+		}
+		dryRun = parsed
+	}
+
+	result, err := s.dockerSvc.PruneStaleContainers(c.Request.Context(), dryRun)
+	if err != nil {
+		if errors.Is(err, docker.ErrPruneBusy) {
+			c.JSON(http.StatusConflict, gin.H{"error": "prune already in progress"})
+			return
+		}
+		s.logger.Error("Failed to prune stale containers", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// 요청 본문 대신 실제 삭제 내역을 감사 로그에 남김
+	target, summary := result.AuditSummary()
+	audit.Annotate(c, target, summary)
+
+	c.JSON(http.StatusOK, DockerPruneResponse{Status: "ok", Result: result})
+}
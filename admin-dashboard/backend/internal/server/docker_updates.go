@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+)
+
+// handleDockerUpdates godoc
+// @Summary      Check container image updates
+// @Description  Compare the image digest of each running managed container with the registry digest of its tag. Images that are locally built, pinned by digest or need registry auth are listed under skipped
+// @Tags         docker
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  DockerImageUpdatesResponse
+// @Failure      503  {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/updates [get]
+func (s *Server) handleDockerUpdates(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+	result, err := s.dockerSvc.CheckImageUpdates(c.Request.Context())
+	if err != nil {
+		s.logger.Error("Failed to check image updates", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, DockerImageUpdatesResponse{Status: "ok", Result: result})
+}
+
+// handleDockerUpdate godoc
+// @Summary      Update container image
+// @Description  Pull the container's image tag and, if the image changed, recreate the container with the same config, mounts and networks. On failure the original container is restored. The pulled image IDs are recorded in the audit log
+// @Tags         docker
+// @Produce      json
+// @Security     SessionCookie
+// @Param        name  path      string  true  "Container name"
+// @Success      200   {object}  DockerContainerUpdateResponse
+// @Failure      404   {object}  ErrorResponse  "Container not found"
+// @Failure      409   {object}  ErrorResponse  "Update already in progress"
+// @Failure      503   {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/containers/{name}/update [post]
+func (s *Server) handleDockerUpdate(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+	name := c.Param("name")
+	if !s.dockerSvc.IsManaged(name) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "container not found"})
+		return
+	}
+
+	// 요청이 끊겨도 재생성 도중에 멈추지 않도록 취소를 전파하지 않음
+	result, err := s.dockerSvc.UpdateContainer(context.WithoutCancel(c.Request.Context()), name)
+	if err != nil {
+		if errors.Is(err, docker.ErrUpdateBusy) {
+			c.JSON(http.StatusConflict, gin.H{"error": "update already in progress"})
+			return
+		}
+		s.logger.Error("Failed to update container", slog.String("container", name), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	target, summary := result.AuditSummary()
+	audit.Annotate(c, target, summary)
+
+	c.JSON(http.StatusOK, DockerContainerUpdateResponse{Status: "ok", Result: result})
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/gql"
+)
+
+// setupGraphQLRoutes: 대시보드 화면 데이터를 한 번에 조회하는 GraphQL 엔드포인트 (GRAPHQL_ENABLED일 때만)
+// 조회 전용 스키마라 POST여도 변경 요청이 아니므로 감사 로그 미들웨어 없이 인증만 거칩니다. (viewer 이상)
+func (s *Server) setupGraphQLRoutes(api *gin.RouterGroup) {
+	if !s.cfg.GraphQLEnabled {
+		return
+	}
+
+	deps := gql.Deps{
+		TwentyQBotURL: s.cfg.TwentyQBotURL,
+		TurtleBotURL:  s.cfg.TurtleBotURL,
+	}
+	if s.statusCollector != nil {
+		deps.Status = s.statusCollector
+	}
+	if s.dockerSvc != nil {
+		deps.Docker = s.dockerSvc
+	}
+	schema, err := gql.NewSchema(deps)
+	if err != nil {
+		s.logger.Error("graphql_schema_failed", slog.Any("error", err))
+		return
+	}
+
+	api.POST("/graphql",
+		auth.AuthMiddleware(s.sessions, s.users, s.cfg.AdminSecretKey, s.cfg.ForceHTTPS),
+		s.handleGraphQL(schema),
+	)
+	s.logger.Info("graphql_endpoint_enabled", slog.String("path", "/admin/api/graphql"))
+}
+
+// handleGraphQL godoc
+// @Summary      Dashboard GraphQL query
+// @Description  Run a read-only GraphQL query that aggregates status, docker, twentyq and turtlesoup data in one round trip. Each field resolves independently; a failing field is returned as null with an entry in errors. Enabled with GRAPHQL_ENABLED.
+// @Tags         graphql
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      object  true  "GraphQL request (query, operationName, variables)"
+// @Success      200      {object}  object  "GraphQL response (data, errors)"
+// @Failure      400      {object}  ErrorResponse  "Invalid request body"
+// @Router       /graphql [post]
+func (s *Server) handleGraphQL(schema *graphql.Schema) gin.HandlerFunc {
+	return gql.Handler(schema, s.logger)
+}
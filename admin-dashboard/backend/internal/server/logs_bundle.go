@@ -0,0 +1,247 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logs"
+)
+
+const (
+	// logBundlePath: 번들 다운로드 경로 (ETag 버퍼링 제외 대상)
+	logBundlePath = "/admin/api/logs/bundle"
+	// logBundleCooldown: 같은 사용자가 번들을 다시 받을 수 있기까지의 간격
+	logBundleCooldown = 30 * time.Second
+)
+
+// logBundleLimiter: 로그 번들 생성 빈도 제한 (사용자별 쿨다운 + 서버 전체 동시 1건)
+// 번들은 수십 MB를 읽고 압축하므로 동시에 여러 건이 돌지 않도록 합니다.
+type logBundleLimiter struct {
+	mu       sync.Mutex
+	last     map[string]time.Time
+	inFlight bool
+	cooldown time.Duration
+}
+
+func newLogBundleLimiter(cooldown time.Duration) *logBundleLimiter {
+	return &logBundleLimiter{
+		last:     make(map[string]time.Time),
+		cooldown: cooldown,
+	}
+}
+
+// acquire: 번들 생성을 시작할 수 있으면 true, 아니면 다시 시도할 때까지 남은 시간을 반환합니다.
+// true를 받은 호출자는 끝난 뒤 release를 호출해야 합니다.
+func (l *logBundleLimiter) acquire(user string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inFlight {
+		return false, time.Second
+	}
+	if last, ok := l.last[user]; ok {
+		if wait := l.cooldown - now.Sub(last); wait > 0 {
+			return false, wait
+		}
+	}
+
+	// 쿨다운이 지난 기록 정리 (사용자 수가 적어 요청 시점에 정리해도 충분)
+	for name, at := range l.last {
+		if now.Sub(at) >= l.cooldown {
+			delete(l.last, name)
+		}
+	}
+
+	l.inFlight = true
+	l.last[user] = now
+	return true, 0
+}
+
+func (l *logBundleLimiter) release() {
+	l.mu.Lock()
+	l.inFlight = false
+	l.mu.Unlock()
+}
+
+// logBundleRequest: 번들 요청 파라미터
+type logBundleRequest struct {
+	files      []string
+	fileBytes  int64
+	containers []string
+	inspect    bool
+}
+
+// handleLogBundle godoc
+// @Summary      Download log bundle
+// @Description  Stream a tar.gz with the tail of selected log files and redacted docker inspect output of managed containers, plus manifest.json. Limited to one bundle at a time and one per user every 30 seconds
+// @Tags         logs
+// @Produce      application/gzip
+// @Security     SessionCookie
+// @Param        files       query     string  false  "Log file keys, comma separated (default: all)"
+// @Param        mb          query     int     false  "Tail size per file in MB (1-50)"  default(5)
+// @Param        containers  query     string  false  "Container names, comma separated (default: all managed)"
+// @Param        inspect     query     bool    false  "Include docker inspect output"  default(true)
+// @Success      200         {file}    file
+// @Failure      400         {object}  ErrorResponse  "Invalid query"
+// @Failure      429         {object}  ErrorResponse  "Bundle rate limited"
+// @Router       /logs/bundle [get]
+func (s *Server) handleLogBundle(c *gin.Context) {
+	req, err := parseLogBundle(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+		return
+	}
+
+	if req.inspect {
+		for _, name := range req.containers {
+			if !s.dockerSvc.IsManaged(name) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Container not managed", "container": name})
+				return
+			}
+		}
+	}
+
+	username := c.GetString(auth.ContextKeyUsername)
+	ok, retryAfter := s.logBundles.acquire(username, time.Now())
+	if !ok {
+		seconds := max(int(retryAfter.Round(time.Second).Seconds()), 1)
+		c.Header("Retry-After", strconv.Itoa(seconds))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Bundle rate limited", "retry_after": seconds})
+		return
+	}
+	defer s.logBundles.release()
+
+	ctx := c.Request.Context()
+	if req.inspect && len(req.containers) == 0 && s.dockerSvc != nil && s.dockerSvc.Available(ctx) {
+		containers, err := s.dockerSvc.ListContainers(ctx)
+		if err != nil {
+			s.logger.Warn("Failed to list containers for log bundle", slog.Any("error", err))
+		}
+		for _, ct := range containers {
+			req.containers = append(req.containers, ct.Name)
+		}
+	}
+
+	now := time.Now()
+	filename := "logs-" + now.Format("20060102-150405") + ".tar.gz"
+	c.Header("Content-Type", "application/gzip")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	bundle := logs.NewBundleWriter(c.Writer, req.fileBytes, logs.MaxBundleTotalBytes, username, now)
+	if err := s.writeLogBundle(c, bundle, req); err != nil {
+		// 헤더를 이미 보냈으므로 상태 코드를 바꿀 수 없음: 불완전한 아카이브로 끝남
+		s.logger.Error("Failed to write log bundle", slog.String("user", username), slog.Any("error", err))
+		return
+	}
+
+	manifest := bundle.Manifest()
+	s.logger.Info("Log bundle downloaded",
+		slog.String("user", username),
+		slog.Int("entries", len(manifest.Entries)),
+		slog.Int64("file_bytes", req.fileBytes),
+	)
+}
+
+// writeLogBundle: 로그 파일과 inspect 결과를 번들에 쓰고 닫습니다.
+func (s *Server) writeLogBundle(c *gin.Context, bundle *logs.BundleWriter, req logBundleRequest) error {
+	ctx := c.Request.Context()
+
+	for _, key := range req.files {
+		path, _ := logs.GetLogFilePath(key)
+		if err := bundle.AddFileTail("logs/"+key+".log", path); err != nil {
+			return err
+		}
+	}
+
+	if req.inspect {
+		for _, name := range req.containers {
+			entryName := "inspect/" + name + ".json"
+			if s.dockerSvc == nil {
+				bundle.AddError(entryName, name, fmt.Errorf("docker service unavailable"))
+				continue
+			}
+			data, err := s.dockerSvc.InspectJSON(ctx, name, drift.IsSensitiveEnv)
+			if err != nil {
+				if ctx.Err() != nil {
+					return fmt.Errorf("log bundle canceled: %w", ctx.Err())
+				}
+				bundle.AddError(entryName, name, err)
+				continue
+			}
+			if err := bundle.AddBytes(entryName, name, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := bundle.Close(); err != nil {
+		return fmt.Errorf("close log bundle: %w", err)
+	}
+	return nil
+}
+
+// parseLogBundle: 번들 쿼리 파라미터를 해석합니다.
+func parseLogBundle(c *gin.Context) (logBundleRequest, error) {
+	req := logBundleRequest{
+		fileBytes: logs.DefaultBundleFileBytes,
+		inspect:   true,
+	}
+
+	if raw := c.Query("files"); raw != "" {
+		for key := range strings.SplitSeq(raw, ",") {
+			key = strings.TrimSpace(key)
+			if key == "" || slices.Contains(req.files, key) {
+				continue
+			}
+			if _, ok := logs.GetLogFilePath(key); !ok {
+				return req, fmt.Errorf("unknown log file %q (allowed: %s)", key, strings.Join(logs.GetLogFileKeys(), ", "))
+			}
+			req.files = append(req.files, key)
+		}
+	} else {
+		req.files = logs.GetLogFileKeys()
+	}
+
+	if raw := c.Query("mb"); raw != "" {
+		mb, err := strconv.ParseInt(raw, 10, 64)
+		maxMB := logs.MaxBundleFileBytes >> 20
+		if err != nil || mb < 1 || mb > maxMB {
+			return req, fmt.Errorf("mb must be between 1 and %d", maxMB)
+		}
+		req.fileBytes = mb << 20
+	}
+
+	if raw := c.Query("inspect"); raw != "" {
+		inspect, err := strconv.ParseBool(raw)
+		if err != nil {
+			return req, fmt.Errorf("invalid inspect flag %q", raw)
+		}
+		req.inspect = inspect
+	}
+
+	if raw := c.Query("containers"); raw != "" {
+		for name := range strings.SplitSeq(raw, ",") {
+			name = strings.TrimSpace(name)
+			if name != "" && !slices.Contains(req.containers, name) {
+				req.containers = append(req.containers, name)
+			}
+		}
+	}
+
+	if len(req.files) == 0 && !req.inspect {
+		return req, fmt.Errorf("nothing to bundle")
+	}
+	return req, nil
+}
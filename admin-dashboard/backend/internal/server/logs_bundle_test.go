@@ -0,0 +1,67 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logs"
+)
+
+func TestParseLogBundle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	parse := func(target string) (logBundleRequest, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		return parseLogBundle(c)
+	}
+
+	req, err := parse("/admin/api/logs/bundle?mb=12&containers=bot,%20llm,bot")
+	if err != nil {
+		t.Fatalf("parseLogBundle: %v", err)
+	}
+	if req.fileBytes != 12<<20 || !req.inspect || len(req.containers) != 2 || req.containers[1] != "llm" {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	if req, _ := parse("/admin/api/logs/bundle"); req.fileBytes != logs.DefaultBundleFileBytes {
+		t.Fatalf("unexpected default size: %d", req.fileBytes)
+	}
+
+	for _, target := range []string{
+		"/admin/api/logs/bundle?mb=0",
+		"/admin/api/logs/bundle?mb=51",
+		"/admin/api/logs/bundle?inspect=maybe",
+		"/admin/api/logs/bundle?files=no-such-log",
+	} {
+		if _, err := parse(target); err == nil {
+			t.Errorf("expected error for %s", target)
+		}
+	}
+}
+
+func TestLogBundleLimiter(t *testing.T) {
+	l := newLogBundleLimiter(30 * time.Second)
+	now := time.Now()
+
+	if ok, _ := l.acquire("alice", now); !ok {
+		t.Fatal("first bundle must be allowed")
+	}
+	if ok, _ := l.acquire("bob", now); ok {
+		t.Fatal("only one bundle may be generated at a time")
+	}
+	l.release()
+
+	if ok, wait := l.acquire("alice", now.Add(10*time.Second)); ok || wait != 20*time.Second {
+		t.Fatalf("expected cooldown for alice, got ok=%v wait=%v", ok, wait)
+	}
+	if ok, _ := l.acquire("bob", now.Add(10*time.Second)); !ok {
+		t.Fatal("other users must not share the cooldown")
+	}
+	l.release()
+	if ok, _ := l.acquire("alice", now.Add(31*time.Second)); !ok {
+		t.Fatal("bundle must be allowed after cooldown")
+	}
+}
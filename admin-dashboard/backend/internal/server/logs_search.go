@@ -0,0 +1,169 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logs"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/middleware"
+)
+
+const (
+	// maxLogSearchPatternLen: 검색 정규식 최대 길이
+	maxLogSearchPatternLen = 512
+	// logSearchFlushEvery: NDJSON 스트리밍 시 이 개수마다 응답을 내보냄
+	logSearchFlushEvery = 100
+)
+
+// handleLogSearch godoc
+// @Summary      Search system logs
+// @Description  Scan a system log file with regex, level and time-range filters. Send Accept: application/x-ndjson (or ?format=ndjson) to stream matches line by line, followed by a summary line
+// @Tags         logs
+// @Produce      json
+// @Security     SessionCookie
+// @Param        file   query     string  false  "Log file key"  default(combined)
+// @Param        q      query     string  false  "Regular expression (RE2)"
+// @Param        level  query     string  false  "Levels, comma separated (debug, info, warn, error)"
+// @Param        from   query     string  false  "Start time (RFC3339 or unix seconds)"
+// @Param        to     query     string  false  "End time (RFC3339 or unix seconds)"
+// @Param        limit  query     int     false  "Max matches (default 200; 1000 for JSON, 10000 for NDJSON)"
+// @Success      200    {object}  LogSearchResponse
+// @Failure      400    {object}  ErrorResponse  "Invalid query"
+// @Router       /logs/search [get]
+func (s *Server) handleLogSearch(c *gin.Context) {
+	fileKey := c.Query("file")
+	if fileKey == "" {
+		fileKey = "combined"
+	}
+	logPath, ok := logs.GetLogFilePath(fileKey)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid log file", "allowed_keys": logs.GetLogFileKeys()})
+		return
+	}
+
+	stream := middleware.WantsNDJSON(c.Request)
+	opts, err := parseLogSearch(c, stream)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+		return
+	}
+
+	if stream {
+		s.streamLogSearch(c, fileKey, logPath, opts)
+		return
+	}
+
+	matches := make([]logs.SearchMatch, 0)
+	summary, err := logs.Search(c.Request.Context(), logPath, opts, func(m logs.SearchMatch) error {
+		matches = append(matches, m)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			c.JSON(http.StatusOK, gin.H{"status": "ok", "file": fileKey, "matches": matches, "error": "Log file not found"})
+			return
+		}
+		s.logger.Error("Failed to search log file", slog.String("file", logPath), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search log file"})
+		return
+	}
+	c.JSON(http.StatusOK, LogSearchResponse{Status: "ok", File: fileKey, Matches: matches, SearchSummary: summary})
+}
+
+// streamLogSearch: 일치하는 줄을 찾는 대로 NDJSON으로 보내고, 마지막에 요약 줄을 보냅니다.
+// 헤더를 보낸 뒤의 오류는 상태 코드로 알릴 수 없으므로 요약 줄의 error 필드로 전달합니다.
+func (s *Server) streamLogSearch(c *gin.Context, fileKey, logPath string, opts logs.SearchOptions) {
+	c.Header("Content-Type", middleware.NDJSONContentType)
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	written := 0
+	summary, err := logs.Search(c.Request.Context(), logPath, opts, func(m logs.SearchMatch) error {
+		if err := encoder.Encode(m); err != nil {
+			return fmt.Errorf("write match: %w", err)
+		}
+		if written++; written%logSearchFlushEvery == 0 {
+			c.Writer.Flush()
+		}
+		return nil
+	})
+
+	result := gin.H{"status": "ok", "file": fileKey, "scanned": summary.Scanned, "matched": summary.Matched, "truncated": summary.Truncated, "done": true}
+	if err != nil {
+		if c.Request.Context().Err() != nil {
+			return
+		}
+		if errors.Is(err, fs.ErrNotExist) {
+			result["error"] = "Log file not found"
+		} else {
+			s.logger.Error("Failed to stream log search", slog.String("file", logPath), slog.Any("error", err))
+			result["status"] = "error"
+			result["error"] = "Failed to search log file"
+		}
+	}
+	_ = encoder.Encode(result)
+	c.Writer.Flush()
+}
+
+// parseLogSearch: 검색 조건 쿼리 파싱 (limit 상한은 JSON 응답 1000, 스트리밍 10000)
+func parseLogSearch(c *gin.Context, stream bool) (logs.SearchOptions, error) {
+	var opts logs.SearchOptions
+
+	if pattern := c.Query("q"); pattern != "" {
+		if len(pattern) > maxLogSearchPatternLen {
+			return opts, fmt.Errorf("q must be at most %d characters", maxLogSearchPatternLen)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return opts, fmt.Errorf("invalid q: %w", err)
+		}
+		opts.Pattern = re
+	}
+
+	for raw := range strings.SplitSeq(c.Query("level"), ",") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		level := logs.NormalizeLevel(raw)
+		if level == "" {
+			return opts, fmt.Errorf("invalid level %q", raw)
+		}
+		opts.Levels = append(opts.Levels, level)
+	}
+
+	var err error
+	if opts.From, err = parseAuditTime(c.Query("from")); err != nil {
+		return opts, errors.New("invalid from")
+	}
+	if opts.To, err = parseAuditTime(c.Query("to")); err != nil {
+		return opts, errors.New("invalid to")
+	}
+	if !opts.From.IsZero() && !opts.To.IsZero() && opts.To.Before(opts.From) {
+		return opts, errors.New("to must not be before from")
+	}
+
+	maxLimit := logs.MaxLogLines
+	if stream {
+		maxLimit = logs.MaxSearchLimit
+	}
+	opts.Limit = logs.DefaultSearchLimit
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return opts, errors.New("limit must be a positive integer")
+		}
+		opts.Limit = min(limit, maxLimit)
+	}
+	return opts, nil
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logs"
+)
+
+func TestParseLogSearch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	parse := func(target string, stream bool) (logs.SearchOptions, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		return parseLogSearch(c, stream)
+	}
+
+	opts, err := parse("/admin/api/logs/search?q=valkey.*refused&level=warn,ERR&from=1760745600&to=2026-10-18T12:00:00Z&limit=50000", false)
+	if err != nil {
+		t.Fatalf("parseLogSearch: %v", err)
+	}
+	if opts.Pattern == nil || !opts.Pattern.MatchString("valkey dial: connection refused") {
+		t.Fatalf("unexpected pattern: %v", opts.Pattern)
+	}
+	if !slices.Equal(opts.Levels, []string{"WARN", "ERROR"}) || opts.From.Unix() != 1760745600 || opts.To.IsZero() {
+		t.Fatalf("unexpected options: %+v", opts)
+	}
+	if opts.Limit != logs.MaxLogLines {
+		t.Fatalf("JSON responses must cap limit at %d, got %d", logs.MaxLogLines, opts.Limit)
+	}
+
+	if opts, _ := parse("/admin/api/logs/search?limit=50000", true); opts.Limit != logs.MaxSearchLimit {
+		t.Fatalf("streaming must cap limit at %d, got %d", logs.MaxSearchLimit, opts.Limit)
+	}
+	if opts, _ := parse("/admin/api/logs/search", false); opts.Limit != logs.DefaultSearchLimit || opts.Pattern != nil {
+		t.Fatalf("unexpected defaults: %+v", opts)
+	}
+
+	for _, target := range []string{
+		"/admin/api/logs/search?q=(",
+		"/admin/api/logs/search?level=trace",
+		"/admin/api/logs/search?from=yesterday",
+		"/admin/api/logs/search?from=2026-10-18T12:00:00Z&to=2026-10-18T11:00:00Z",
+		"/admin/api/logs/search?limit=0",
+	} {
+		if _, err := parse(target, false); err == nil {
+			t.Errorf("expected error for %s", target)
+		}
+	}
+}
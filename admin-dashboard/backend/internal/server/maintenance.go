@@ -0,0 +1,116 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/maintenance"
+)
+
+// setupMaintenanceRoutes: 점검 공지 배너 라우트 (조회는 viewer, 설정/해제는 operator 이상)
+func (s *Server) setupMaintenanceRoutes(authenticated *gin.RouterGroup) {
+	group := authenticated.Group("/maintenance")
+	group.GET("", s.handleMaintenanceGet)
+	group.PUT("", auth.RequireRole(auth.RoleOperator), s.handleMaintenanceSet)
+	group.DELETE("", auth.RequireRole(auth.RoleOperator), s.handleMaintenanceClear)
+}
+
+// handleMaintenanceGet godoc
+// @Summary      Get maintenance announcement
+// @Description  Return the current global maintenance announcement (null when none is set)
+// @Tags         maintenance
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  MaintenanceResponse
+// @Failure      503  {object}  ErrorResponse  "Maintenance announcements unavailable"
+// @Router       /maintenance [get]
+func (s *Server) handleMaintenanceGet(c *gin.Context) {
+	if s.maintenance == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Maintenance announcements not available"})
+		return
+	}
+
+	notice, err := s.maintenance.Current(c.Request.Context())
+	if err != nil {
+		s.logger.Error("maintenance_get_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load maintenance announcement"})
+		return
+	}
+	c.JSON(http.StatusOK, MaintenanceResponse{Status: "ok", Maintenance: notice})
+}
+
+// handleMaintenanceSet godoc
+// @Summary      Set maintenance announcement
+// @Description  Set or replace the global maintenance announcement shown as a dashboard banner (also on the login page) and included in the status API. The ETA is informational; the announcement stays until cleared.
+// @Tags         maintenance
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      MaintenanceRequest  true  "Announcement"
+// @Success      200      {object}  MaintenanceResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid announcement"
+// @Failure      503      {object}  ErrorResponse  "Maintenance announcements unavailable"
+// @Router       /maintenance [put]
+func (s *Server) handleMaintenanceSet(c *gin.Context) {
+	if s.maintenance == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Maintenance announcements not available"})
+		return
+	}
+
+	var req MaintenanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	notice, err := s.maintenance.Set(c.Request.Context(), maintenance.Input{
+		Message:  req.Message,
+		Severity: req.Severity,
+		ETA:      req.ETA,
+	}, c.GetString(auth.ContextKeyUsername))
+	if err != nil {
+		if errors.Is(err, maintenance.ErrInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		s.logger.Error("maintenance_set_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set maintenance announcement"})
+		return
+	}
+	s.logger.Info("maintenance_set", slog.String("severity", notice.Severity), slog.String("by", notice.SetBy))
+	c.JSON(http.StatusOK, MaintenanceResponse{Status: "ok", Maintenance: notice})
+}
+
+// handleMaintenanceClear godoc
+// @Summary      Clear maintenance announcement
+// @Description  Remove the global maintenance announcement
+// @Tags         maintenance
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  MaintenanceResponse
+// @Failure      404  {object}  ErrorResponse  "No announcement set"
+// @Failure      503  {object}  ErrorResponse  "Maintenance announcements unavailable"
+// @Router       /maintenance [delete]
+func (s *Server) handleMaintenanceClear(c *gin.Context) {
+	if s.maintenance == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Maintenance announcements not available"})
+		return
+	}
+
+	cleared, err := s.maintenance.Clear(c.Request.Context())
+	if err != nil {
+		s.logger.Error("maintenance_clear_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to clear maintenance announcement"})
+		return
+	}
+	if !cleared {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no maintenance announcement"})
+		return
+	}
+	s.logger.Info("maintenance_cleared", slog.String("by", c.GetString(auth.ContextKeyUsername)))
+	c.JSON(http.StatusOK, MaintenanceResponse{Status: "ok"})
+}
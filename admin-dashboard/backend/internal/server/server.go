@@ -2,11 +2,14 @@
 package server
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,12 +21,21 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/activity"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/alerts"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/botconfig"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/config"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/inbox"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/latency"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logs"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/maintenance"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/metrics"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/middleware"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/probe"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/proxy"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/ssr"
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/static"
@@ -37,11 +49,26 @@ type Server struct {
 	cfg             *config.Config
 	logger          *slog.Logger
 	sessions        auth.SessionProvider
+	users           auth.UserStore
+	twoFactor       auth.TwoFactorStore
 	rateLimiter     *auth.LoginRateLimiter
+	logBundles      *logBundleLimiter
 	dockerSvc       *docker.Service
 	tracesClient    *traces.Client
 	botProxies      *proxy.BotProxies
+	llmUsageProxy   *proxy.LLMUsageProxy
 	statusCollector *status.Collector
+	auditStore      audit.Store
+	auditRecorder   *audit.Recorder
+	driftDetector   *drift.Detector
+	inboxService    *inbox.Service
+	probeService    *probe.Service
+	alertService    *alerts.Service
+	metricsScraper  *metrics.Scraper
+	latencyReports  *latency.Aggregator
+	botConfig       *botconfig.Service
+	activityHub     *activity.Hub
+	maintenance     *maintenance.Service
 	ssrInjector     *ssr.Injector
 	ssrConfig       ssr.Config
 }
@@ -51,10 +78,23 @@ func New(
 	cfg *config.Config,
 	logger *slog.Logger,
 	sessions auth.SessionProvider,
+	users auth.UserStore,
+	twoFactor auth.TwoFactorStore,
 	dockerSvc *docker.Service,
 	tracesClient *traces.Client,
 	botProxies *proxy.BotProxies,
 	statusCollector *status.Collector,
+	auditStore audit.Store,
+	driftDetector *drift.Detector,
+	inboxService *inbox.Service,
+	probeService *probe.Service,
+	alertService *alerts.Service,
+	metricsScraper *metrics.Scraper,
+	llmUsageProxy *proxy.LLMUsageProxy,
+	latencyReports *latency.Aggregator,
+	botConfig *botconfig.Service,
+	activityHub *activity.Hub,
+	maintenanceSvc *maintenance.Service,
 ) *Server {
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -89,8 +129,8 @@ func New(
 
 	// 압축: Cloudflare Tunnel Edge에서 Brotli/Gzip 처리 (서버 CPU 자원 보호)
 
-	// ETag: API GET 응답에 조건부 요청 지원 (304 Not Modified)
-	engine.Use(middleware.ETag())
+	// ETag: API GET 응답에 조건부 요청 지원 (304 Not Modified), 로그 번들 다운로드는 버퍼링하지 않음
+	engine.Use(middleware.ETag(logBundlePath))
 
 	// Early Hints: 비활성화 - Cloudflare Tunnel과 호환 문제로 임시 비활성화
 	// TODO: Cloudflare Tunnel 환경에서 103 응답이 제대로 전달되는지 확인 필요
@@ -107,6 +147,9 @@ func New(
 	// SSR 설정
 	ssrConfig := ssr.DefaultConfig()
 	ssrInjector := ssr.NewInjector(dockerSvc, cfg.HoloBotURL, logger)
+	if maintenanceSvc != nil {
+		ssrInjector.SetMaintenance(maintenanceSvc)
+	}
 
 	// HTML 캐시 로드: 임베디드 우선, 파일시스템 폴백
 	if static.HasEmbedded() {
@@ -127,15 +170,33 @@ func New(
 		cfg:             cfg,
 		logger:          logger,
 		sessions:        sessions,
+		users:           users,
+		twoFactor:       twoFactor,
 		rateLimiter:     auth.NewLoginRateLimiter(),
+		logBundles:      newLogBundleLimiter(logBundleCooldown),
 		dockerSvc:       dockerSvc,
 		tracesClient:    tracesClient,
 		botProxies:      botProxies,
+		llmUsageProxy:   llmUsageProxy,
 		statusCollector: statusCollector,
+		auditStore:      auditStore,
+		driftDetector:   driftDetector,
+		inboxService:    inboxService,
+		probeService:    probeService,
+		alertService:    alertService,
+		metricsScraper:  metricsScraper,
+		latencyReports:  latencyReports,
+		botConfig:       botConfig,
+		activityHub:     activityHub,
+		maintenance:     maintenanceSvc,
 		ssrInjector:     ssrInjector,
 		ssrConfig:       ssrConfig,
 	}
 
+	if auditStore != nil {
+		s.auditRecorder = audit.NewRecorder(auditStore, s.auditIdentity, logger)
+	}
+
 	s.setupRoutes()
 	return s
 }
@@ -148,13 +209,27 @@ func (s *Server) setupRoutes() {
 
 	// 인증 필요 라우트
 	authenticated := api.Group("")
-	authenticated.Use(auth.AuthMiddleware(s.sessions, s.cfg.AdminSecretKey, s.cfg.ForceHTTPS))
+	authenticated.Use(auth.AuthMiddleware(s.sessions, s.users, s.cfg.AdminSecretKey, s.cfg.ForceHTTPS))
+	// 감사 로그: 인증된 변경 요청(POST/PUT/DELETE 등)을 모두 기록
+	authenticated.Use(s.auditRecorder.Middleware())
 
 	s.setupDockerRoutes(authenticated)
 	s.setupLogsRoutes(authenticated)
 	s.setupTracesRoutes(authenticated)
 	s.setupStatusRoutes(authenticated)
 	s.setupProxyRoutes(authenticated)
+	s.setupAuditRoutes(authenticated)
+	s.setupUserRoutes(authenticated)
+	s.setupTwoFactorRoutes(authenticated)
+	s.setupDriftRoutes(authenticated)
+	s.setupInboxRoutes(authenticated)
+	s.setupProbeRoutes(authenticated)
+	s.setupAlertRoutes(authenticated)
+	s.setupMetricsQueryRoutes(authenticated)
+	s.setupLatencyRoutes(authenticated)
+	s.setupBotConfigRoutes(authenticated)
+	s.setupMaintenanceRoutes(authenticated)
+	s.setupGraphQLRoutes(api)
 
 	// Health & Static
 	s.setupHealthRoute()
@@ -175,16 +250,35 @@ func (s *Server) setupDockerRoutes(authenticated *gin.RouterGroup) {
 	dockerGroup := authenticated.Group("/docker")
 	dockerGroup.GET("/health", s.handleDockerHealth)
 	dockerGroup.GET("/containers", s.handleDockerContainers)
-	dockerGroup.POST("/containers/:name/restart", s.handleDockerRestart)
-	dockerGroup.POST("/containers/:name/stop", s.handleDockerStop)
-	dockerGroup.POST("/containers/:name/start", s.handleDockerStart)
+	dockerGroup.GET("/updates", s.handleDockerUpdates)
+
+	// 컨테이너 제어: operator 이상
+	controlGroup := dockerGroup.Group("/containers/:name", auth.RequireRole(auth.RoleOperator))
+	controlGroup.POST("/restart", s.handleDockerRestart)
+	controlGroup.POST("/stop", s.handleDockerStop)
+	controlGroup.POST("/start", s.handleDockerStart)
+	controlGroup.POST("/update", s.handleDockerUpdate)
+	controlGroup.GET("/inspect", s.handleDockerInspect)
 	dockerGroup.GET("/containers/:name/logs/stream", s.handleDockerLogStream)
+	dockerGroup.GET("/containers/:name/stats/stream", s.handleDockerStatsStream)
+
+	// compose 프로젝트 단위 일괄 작업: 의존 순서대로 진행, 진행 상황은 WebSocket으로 구독
+	dockerGroup.GET("/groups/:project", s.handleDockerGroup)
+	groupControl := dockerGroup.Group("/groups/:project", auth.RequireRole(auth.RoleOperator))
+	groupControl.POST("/restart", s.handleDockerGroupRestart)
+	groupControl.POST("/stop", s.handleDockerGroupStop)
+	dockerGroup.GET("/jobs/:id/progress", s.handleDockerGroupProgress)
+
+	// 종료된 일회성 컨테이너 수동 정리 (예약 정리와 같은 정책)
+	dockerGroup.POST("/prune", auth.RequireRole(auth.RoleOperator), s.handleDockerPrune)
 }
 
 // setupLogsRoutes: 시스템 로그 라우트
 func (s *Server) setupLogsRoutes(authenticated *gin.RouterGroup) {
 	logsGroup := authenticated.Group("/logs")
 	logsGroup.GET("/files", s.handleLogFiles)
+	logsGroup.GET("/search", s.handleLogSearch)
+	logsGroup.GET("/bundle", auth.RequireRole(auth.RoleOperator), s.handleLogBundle)
 	logsGroup.GET("", s.handleSystemLogs)
 }
 
@@ -195,6 +289,7 @@ func (s *Server) setupTracesRoutes(authenticated *gin.RouterGroup) {
 	tracesGroup.GET("/services", s.handleTracesServices)
 	tracesGroup.GET("/operations/:service", s.handleTracesOperations)
 	tracesGroup.GET("", s.handleTracesSearch)
+	tracesGroup.GET("/compare", s.handleTracesCompare)
 	tracesGroup.GET("/:traceId", s.handleTraceDetail)
 	tracesGroup.GET("/dependencies", s.handleTracesDependencies)
 	tracesGroup.GET("/metrics/:service", s.handleTracesMetrics)
@@ -204,23 +299,125 @@ func (s *Server) setupTracesRoutes(authenticated *gin.RouterGroup) {
 func (s *Server) setupStatusRoutes(authenticated *gin.RouterGroup) {
 	statusGroup := authenticated.Group("/status")
 	statusGroup.GET("", s.handleAggregatedStatus)
+	statusGroup.GET("/history", s.handleStatusHistory)
 
 	// WebSocket: 실시간 시스템 리소스 스트리밍 (CPU, Memory, Goroutines)
 	// 기존 /admin/api/holo/ws/system-stats → /admin/api/ws/system-stats로 이관
+	// WebSocket 업그레이드가 막힌 환경을 위해 같은 경로에서 SSE도 제공 (Accept: text/event-stream 또는 ?transport=sse)
 	wsGroup := authenticated.Group("/ws")
 	wsGroup.GET("/system-stats", s.handleSystemStatsStream)
+	// 게임 시작/종료, 알림 발송, 컨테이너 재시작 활동 피드 (최근 이벤트 백필 후 실시간)
+	wsGroup.GET("/activity", s.handleActivityStream)
 }
 
 // setupProxyRoutes: 도메인 봇 프록시 라우트
 func (s *Server) setupProxyRoutes(authenticated *gin.RouterGroup) {
+	// LLM 실시간 토큰 사용량 (SSE, 5초 단위 증분)
+	if s.llmUsageProxy != nil {
+		authenticated.GET("/llm/usage/live", s.llmUsageProxy.ServeLive)
+	}
+
 	if s.botProxies == nil {
 		return
 	}
 
-	// 도메인별 프록시
-	authenticated.Any("/holo/*path", s.botProxies.ProxyHolo)
-	authenticated.Any("/twentyq/*path", s.botProxies.ProxyTwentyQ)
-	authenticated.Any("/turtle/*path", s.botProxies.ProxyTurtle)
+	// 도메인별 프록시: 조회는 viewer, 변경 요청은 operator 이상
+	// 봇 측 감사 기록(GM 답변 수정 등)을 위해 로그인 사용자명을 헤더로 전달
+	requireOperatorForWrites := auth.RequireRoleForWrites(auth.RoleOperator)
+	authenticated.Any("/holo/*path", requireOperatorForWrites, forwardOperator, s.botProxies.ProxyHolo)
+	authenticated.Any("/twentyq/*path", requireOperatorForWrites, forwardOperator, s.botProxies.ProxyTwentyQ)
+	authenticated.Any("/turtle/*path", requireOperatorForWrites, forwardOperator, s.botProxies.ProxyTurtle)
+}
+
+// forwardOperator: 인증된 사용자명을 proxy.OperatorHeader로 전달 (클라이언트가 보낸 값은 덮어씀)
+func forwardOperator(c *gin.Context) {
+	if username := c.GetString(auth.ContextKeyUsername); username != "" {
+		c.Request.Header.Set(proxy.OperatorHeader, username)
+	} else {
+		c.Request.Header.Del(proxy.OperatorHeader)
+	}
+	c.Next()
+}
+
+// setupAuditRoutes: 관리자 감사 로그 조회 라우트 (admin 전용)
+func (s *Server) setupAuditRoutes(authenticated *gin.RouterGroup) {
+	authenticated.GET("/audit", auth.RequireRole(auth.RoleAdmin), s.handleAuditList)
+}
+
+// setupDriftRoutes: 설정 드리프트 조회/배포 기록 라우트 (변경은 operator 이상)
+func (s *Server) setupDriftRoutes(authenticated *gin.RouterGroup) {
+	driftGroup := authenticated.Group("/drift")
+	driftGroup.GET("/events", s.handleDriftEvents)
+	driftGroup.GET("/snapshots", s.handleDriftSnapshots)
+	driftGroup.POST("/deploys", auth.RequireRole(auth.RoleOperator), s.handleDriftRecordDeploy)
+	driftGroup.POST("/check", auth.RequireRole(auth.RoleOperator), s.handleDriftCheck)
+}
+
+// setupInboxRoutes: 운영 처리 대기 항목 인박스 라우트 (등록/담당/상태 변경은 operator 이상)
+func (s *Server) setupInboxRoutes(authenticated *gin.RouterGroup) {
+	inboxGroup := authenticated.Group("/inbox")
+	inboxGroup.GET("", s.handleInboxList)
+	inboxGroup.GET("/counts", s.handleInboxCounts)
+	inboxGroup.POST("", auth.RequireRole(auth.RoleOperator), s.handleInboxCreateReport)
+	inboxGroup.PUT("/:id/assign", auth.RequireRole(auth.RoleOperator), s.handleInboxAssign)
+	inboxGroup.PUT("/:id/state", auth.RequireRole(auth.RoleOperator), s.handleInboxState)
+}
+
+// setupAlertRoutes: 알림 조회/규칙 관리 라우트 (규칙 변경은 operator 이상)
+func (s *Server) setupAlertRoutes(authenticated *gin.RouterGroup) {
+	alertGroup := authenticated.Group("/alerts")
+	alertGroup.GET("", s.handleAlertList)
+	alertGroup.GET("/rules", s.handleAlertRuleList)
+	alertGroup.POST("/rules", auth.RequireRole(auth.RoleOperator), s.handleAlertRuleCreate)
+	alertGroup.PUT("/rules/:id", auth.RequireRole(auth.RoleOperator), s.handleAlertRuleUpdate)
+	alertGroup.DELETE("/rules/:id", auth.RequireRole(auth.RoleOperator), s.handleAlertRuleDelete)
+}
+
+// setupProbeRoutes: 합성 모니터링 프로브 조회/관리 라우트 (정의 변경과 수동 실행은 operator 이상)
+func (s *Server) setupProbeRoutes(authenticated *gin.RouterGroup) {
+	probeGroup := authenticated.Group("/probes")
+	probeGroup.GET("", s.handleProbeList)
+	probeGroup.GET("/:id", s.handleProbeGet)
+	probeGroup.POST("", auth.RequireRole(auth.RoleOperator), s.handleProbeCreate)
+	probeGroup.PUT("/:id", auth.RequireRole(auth.RoleOperator), s.handleProbeUpdate)
+	probeGroup.DELETE("/:id", auth.RequireRole(auth.RoleOperator), s.handleProbeDelete)
+	probeGroup.POST("/:id/run", auth.RequireRole(auth.RoleOperator), s.handleProbeRun)
+}
+
+// setupMetricsQueryRoutes: 수집한 봇 메트릭 조회 라우트 (SPA 차트용)
+func (s *Server) setupMetricsQueryRoutes(authenticated *gin.RouterGroup) {
+	metricsGroup := authenticated.Group("/metrics")
+	metricsGroup.GET("", s.handleMetricsTargets)
+	metricsGroup.GET("/query", s.handleMetricsQuery)
+}
+
+// setupLatencyRoutes: 봇 명령어 응답 지연 SLA 리포트 라우트
+func (s *Server) setupLatencyRoutes(authenticated *gin.RouterGroup) {
+	authenticated.GET("/latency", s.handleLatencyReport)
+}
+
+// setupBotConfigRoutes: 봇 런타임 설정 조회/변경 라우트 (변경은 operator 이상)
+func (s *Server) setupBotConfigRoutes(authenticated *gin.RouterGroup) {
+	botConfigGroup := authenticated.Group("/bot-config")
+	botConfigGroup.GET("", s.handleBotConfigServices)
+	botConfigGroup.GET("/:service", s.handleBotConfigGet)
+	botConfigGroup.PUT("/:service", auth.RequireRole(auth.RoleOperator), s.handleBotConfigUpdate)
+	botConfigGroup.GET("/:service/history", s.handleBotConfigHistory)
+}
+
+// setupUserRoutes: 현재 사용자 조회 및 계정 관리 라우트 (계정 관리는 admin 전용)
+func (s *Server) setupUserRoutes(authenticated *gin.RouterGroup) {
+	authenticated.GET("/auth/me", s.handleCurrentUser)
+
+	usersGroup := authenticated.Group("/users", auth.RequireRole(auth.RoleAdmin))
+	usersGroup.GET("", s.handleUserList)
+	usersGroup.POST("", s.handleUserCreate)
+	usersGroup.PUT("/:username", s.handleUserUpdate)
+	usersGroup.DELETE("/:username", s.handleUserDelete)
+
+	sessionsGroup := authenticated.Group("/auth/sessions", auth.RequireRole(auth.RoleAdmin))
+	sessionsGroup.GET("", s.handleSessionList)
+	sessionsGroup.DELETE("/:id", s.handleSessionRevoke)
 }
 
 // setupHealthRoute: 헬스체크 라우트 (인증 없음)
@@ -323,7 +520,7 @@ func (s *Server) HTTPServer() *http.Server {
 
 // handleLogin godoc
 // @Summary      User login
-// @Description  Authenticate with username and password. Returns session cookie on success.
+// @Description  Authenticate with username and password (plus otp when two-factor is enabled). Returns session cookie on success.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -346,6 +543,7 @@ func (s *Server) handleLogin(c *gin.Context) {
 	var req struct {
 		Username string `json:"username" binding:"required"`
 		Password string `json:"password" binding:"required"`
+		OTP      string `json:"otp"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -353,138 +551,1444 @@ func (s *Server) handleLogin(c *gin.Context) {
 		return
 	}
 
-	if req.Username != s.cfg.AdminUser {
-		s.handleLoginFailure(c, ip, req.Username, "invalid_username")
+	user, err := s.users.GetUser(c.Request.Context(), req.Username)
+	if err != nil {
+		s.logger.Error("Failed to load user", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "User store unavailable"})
+		return
+	}
+
+	// 존재하지 않는 계정도 bcrypt 비교를 수행하여 응답 시간으로 계정 존재 여부가 드러나지 않게 함
+	passHash := loginTimingHash
+	if user != nil {
+		passHash = user.PasswordHash
+	}
+	passwordErr := bcrypt.CompareHashAndPassword([]byte(passHash), []byte(req.Password))
+
+	switch {
+	case user == nil:
+		s.handleLoginFailure(c, ip, req.Username, "invalid_username")
+		return
+	case passwordErr != nil:
+		s.handleLoginFailure(c, ip, req.Username, "invalid_password")
+		return
+	case user.Disabled:
+		s.handleLoginFailure(c, ip, req.Username, "user_disabled")
+		return
+	}
+
+	setupPending, recoveryRemaining, ok := s.verifyLoginSecondFactor(c, ip, user, req.OTP)
+	if !ok {
+		return
+	}
+
+	s.rateLimiter.RecordSuccess(ip)
+
+	session, err := s.sessions.CreateSession(c.Request.Context(), user.Username, auth.SessionMeta{
+		IP:               ip,
+		UserAgent:        c.Request.UserAgent(),
+		TwoFactorPending: setupPending,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create session", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Session store unavailable"})
+		return
+	}
+
+	signedSessionID := auth.SignSessionID(session.ID, s.cfg.AdminSecretKey)
+	auth.SetSecureCookie(c, auth.SessionCookieName, signedSessionID, 0, s.cfg.ForceHTTPS)
+
+	s.logger.Info("Admin logged in", slog.String("username", user.Username), slog.String("role", string(user.Role)), slog.String("ip", ip))
+	response := gin.H{"status": "ok", "message": "Login successful", "username": user.Username, "role": user.Role}
+	if setupPending {
+		response["two_factor_setup_required"] = true
+	}
+	if recoveryRemaining != nil {
+		response["recovery_codes_remaining"] = *recoveryRemaining
+	}
+	c.JSON(200, response)
+}
+
+// loginTimingHash: 존재하지 않는 계정 로그인 시 비교용 bcrypt 해시 (실제 비밀번호 아님)
+const loginTimingHash = "$2a$10$z1u6Hnx.D1iFA03EYNJfuuMbJZi0.y5no2yyJGuHFN5y2v0tnkVO2"
+
+func (s *Server) handleLoginFailure(c *gin.Context, ip, username, reason string) {
+	failCount := s.rateLimiter.RecordFailure(ip)
+
+	s.logger.Warn("Failed login attempt",
+		slog.String("username", username),
+		slog.String("ip", ip),
+		slog.String("reason", reason),
+		slog.Int("fail_count", failCount),
+	)
+
+	delay := time.Duration(failCount) * 500 * time.Millisecond
+	if delay > 3*time.Second {
+		delay = 3 * time.Second
+	}
+	time.Sleep(delay)
+
+	c.JSON(200, gin.H{"success": false, "error": "Authentication failed"})
+}
+
+// handleLogout godoc
+// @Summary      User logout
+// @Description  Invalidate session and clear cookies
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  StatusResponse
+// @Router       /auth/logout [post]
+func (s *Server) handleLogout(c *gin.Context) {
+	signedSessionID, _ := c.Cookie(auth.SessionCookieName)
+	if signedSessionID != "" {
+		if sessionID, valid := auth.ValidateSessionSignature(signedSessionID, s.cfg.AdminSecretKey); valid {
+			s.sessions.DeleteSession(c.Request.Context(), sessionID)
+		}
+	}
+
+	auth.ClearSecureCookie(c, auth.SessionCookieName, s.cfg.ForceHTTPS)
+	c.JSON(200, gin.H{"status": "ok", "message": "Logout successful"})
+}
+
+// handleHeartbeat godoc
+// @Summary      Session heartbeat
+// @Description  Keep session alive and optionally rotate session token
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      HeartbeatRequest  false  "Heartbeat options"
+// @Success      200      {object}  HeartbeatResponse
+// @Failure      401      {object}  ErrorResponse  "Session expired or invalid"
+// @Router       /auth/heartbeat [post]
+func (s *Server) handleHeartbeat(c *gin.Context) {
+	signedSessionID, err := c.Cookie(auth.SessionCookieName)
+	if err != nil || signedSessionID == "" {
+		c.JSON(401, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	sessionID, valid := auth.ValidateSessionSignature(signedSessionID, s.cfg.AdminSecretKey)
+	if !valid {
+		c.JSON(401, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		Idle bool `json:"idle"`
+	}
+	_ = c.ShouldBindJSON(&req)
+
+	ctx := c.Request.Context()
+	refreshed, absoluteExpired, err := s.sessions.RefreshSessionWithValidation(ctx, sessionID, req.Idle)
+	if err != nil {
+		c.JSON(500, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	if absoluteExpired {
+		auth.ClearSecureCookie(c, auth.SessionCookieName, s.cfg.ForceHTTPS)
+		c.JSON(401, gin.H{"error": "Session expired", "absolute_expired": true})
+		return
+	}
+
+	if req.Idle && !refreshed {
+		c.JSON(200, gin.H{"status": "idle", "idle_rejected": true})
+		return
+	}
+
+	if !refreshed {
+		auth.ClearSecureCookie(c, auth.SessionCookieName, s.cfg.ForceHTTPS)
+		c.JSON(401, gin.H{"error": "Session expired"})
+		return
+	}
+
+	response := gin.H{"status": "ok"}
+
+	if s.cfg.SessionTokenRotation {
+		newSession, rotateErr := s.sessions.RotateSession(ctx, sessionID)
+		if rotateErr == nil {
+			newSignedSessionID := auth.SignSessionID(newSession.ID, s.cfg.AdminSecretKey)
+			auth.SetSecureCookie(c, auth.SessionCookieName, newSignedSessionID, 0, s.cfg.ForceHTTPS)
+			response["rotated"] = true
+			response["absolute_expires_at"] = newSession.AbsoluteExpiresAt.Unix()
+		}
+	}
+
+	c.JSON(200, response)
+}
+
+// auditIdentity: 감사 로그 수행자 식별 (로그인 계정 + 세션 ID)
+func (s *Server) auditIdentity(c *gin.Context) (string, string) {
+	return c.GetString(auth.ContextKeyUsername), c.GetString(auth.ContextKeySessionID)
+}
+
+// ===== Audit Handlers =====
+
+const (
+	auditDefaultLimit = 50
+	auditMaxLimit     = 200
+)
+
+// handleAuditList godoc
+// @Summary      List audit log
+// @Description  Get admin audit log entries (newest first) with filtering and pagination
+// @Tags         audit
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        actor   query     string  false  "Actor username"
+// @Param        action  query     string  false  "Action prefix (e.g. docker, proxy.twentyq)"
+// @Param        method  query     string  false  "HTTP method"
+// @Param        ip      query     string  false  "Client IP"
+// @Param        target  query     string  false  "Target substring"
+// @Param        from    query     string  false  "Start time (RFC3339 or unix seconds)"
+// @Param        to      query     string  false  "End time (RFC3339 or unix seconds)"
+// @Param        limit   query     int     false  "Page size (default 50, max 200)"
+// @Param        offset  query     int     false  "Offset"
+// @Success      200     {object}  AuditListResponse
+// @Failure      400     {object}  ErrorResponse  "Invalid query"
+// @Failure      503     {object}  ErrorResponse  "Audit store unavailable"
+// @Router       /audit [get]
+func (s *Server) handleAuditList(c *gin.Context) {
+	if s.auditStore == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Audit log not available"})
+		return
+	}
+
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+		return
+	}
+
+	entries, total, err := s.auditStore.List(c.Request.Context(), filter)
+	if err != nil {
+		s.logger.Error("audit_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load audit log"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "ok",
+		"entries": entries,
+		"total":   total,
+		"limit":   filter.Limit,
+		"offset":  filter.Offset,
+	})
+}
+
+func parseAuditFilter(c *gin.Context) (audit.Filter, error) {
+	filter := audit.Filter{
+		Actor:  strings.TrimSpace(c.Query("actor")),
+		Action: strings.TrimSpace(c.Query("action")),
+		Method: strings.TrimSpace(c.Query("method")),
+		IP:     strings.TrimSpace(c.Query("ip")),
+		Target: strings.TrimSpace(c.Query("target")),
+		Limit:  auditDefaultLimit,
+	}
+
+	var err error
+	if filter.From, err = parseAuditTime(c.Query("from")); err != nil {
+		return filter, errors.New("invalid from")
+	}
+	if filter.To, err = parseAuditTime(c.Query("to")); err != nil {
+		return filter, errors.New("invalid to")
+	}
+	if v := c.Query("limit"); v != "" {
+		limit, convErr := strconv.Atoi(v)
+		if convErr != nil || limit <= 0 {
+			return filter, errors.New("invalid limit")
+		}
+		filter.Limit = min(limit, auditMaxLimit)
+	}
+	if v := c.Query("offset"); v != "" {
+		offset, convErr := strconv.Atoi(v)
+		if convErr != nil || offset < 0 {
+			return filter, errors.New("invalid offset")
+		}
+		filter.Offset = offset
+	}
+	return filter, nil
+}
+
+// parseAuditTime: RFC3339 또는 unix 초 단위 시각 파싱 (빈 값은 zero time)
+func parseAuditTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(unix, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time: %w", err)
+	}
+	return t, nil
+}
+
+// ===== Drift Handlers =====
+
+const driftDefaultEventLimit = 50
+
+// handleDriftEvents godoc
+// @Summary      List config drift events
+// @Description  Get detected container config changes (newest first). Expected=true means the change was covered by a recorded deploy.
+// @Tags         drift
+// @Produce      json
+// @Security     SessionCookie
+// @Param        limit  query     int  false  "Max events (default 50, max 500)"
+// @Success      200    {object}  DriftEventsResponse
+// @Failure      503    {object}  ErrorResponse  "Drift detection unavailable"
+// @Router       /drift/events [get]
+func (s *Server) handleDriftEvents(c *gin.Context) {
+	if s.driftDetector == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Drift detection not available"})
+		return
+	}
+
+	limit := driftDefaultEventLimit
+	if v := c.Query("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+
+	events, err := s.driftDetector.Events(c.Request.Context(), limit)
+	if err != nil {
+		s.logger.Error("drift_events_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load drift events"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "events": events})
+}
+
+// handleDriftSnapshots godoc
+// @Summary      List config snapshots
+// @Description  Get the latest config snapshot of each managed container (sensitive env values are hashed)
+// @Tags         drift
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  DriftSnapshotsResponse
+// @Failure      503  {object}  ErrorResponse  "Drift detection unavailable"
+// @Router       /drift/snapshots [get]
+func (s *Server) handleDriftSnapshots(c *gin.Context) {
+	if s.driftDetector == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Drift detection not available"})
+		return
+	}
+
+	snapshots, err := s.driftDetector.Snapshots(c.Request.Context())
+	if err != nil {
+		s.logger.Error("drift_snapshots_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load snapshots"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "snapshots": snapshots})
+}
+
+// handleDriftRecordDeploy godoc
+// @Summary      Record deploy
+// @Description  Record a deploy so config changes of the given containers are not reported as drift
+// @Tags         drift
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      RecordDeployRequest  true  "Deployed containers"
+// @Success      200      {object}  RecordDeployResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid request"
+// @Failure      503      {object}  ErrorResponse  "Drift detection unavailable"
+// @Router       /drift/deploys [post]
+func (s *Server) handleDriftRecordDeploy(c *gin.Context) {
+	if s.driftDetector == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Drift detection not available"})
+		return
+	}
+
+	var req RecordDeployRequest
+	if err := c.ShouldBindJSON(&req); err != nil || len(req.Containers) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	for _, name := range req.Containers {
+		if !s.dockerSvc.IsManaged(name) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Container is not managed", "container": name})
+			return
+		}
+	}
+
+	deploys, err := s.driftDetector.RecordDeploy(c.Request.Context(), req.Containers, c.GetString(auth.ContextKeyUsername), strings.TrimSpace(req.Note))
+	if err != nil {
+		s.logger.Error("drift_record_deploy_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record deploy"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "deploys": deploys})
+}
+
+// handleDriftCheck godoc
+// @Summary      Run drift check
+// @Description  Snapshot all managed containers now and return detected changes
+// @Tags         drift
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  DriftEventsResponse
+// @Failure      503  {object}  ErrorResponse  "Drift detection unavailable"
+// @Router       /drift/check [post]
+func (s *Server) handleDriftCheck(c *gin.Context) {
+	if s.driftDetector == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Drift detection not available"})
+		return
+	}
+
+	events, err := s.driftDetector.Check(c.Request.Context())
+	if err != nil {
+		s.logger.Error("drift_check_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Drift check failed"})
+		return
+	}
+	if events == nil {
+		events = []drift.Event{}
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "events": events})
+}
+
+// ===== Inbox Handlers =====
+
+// handleInboxList godoc
+// @Summary      List inbox items
+// @Description  Get open moderation items aggregated from audit log, drift, reports, supervised answers, draft puzzles and suspicious games (highest priority first). Resolved items are listed only with state=resolved.
+// @Tags         inbox
+// @Produce      json
+// @Security     SessionCookie
+// @Param        state     query     string  false  "open, in_progress or resolved (default: unresolved)"
+// @Param        category  query     string  false  "audit, drift, report, supervision, puzzle or game"
+// @Param        assignee  query     string  false  "Assigned operator"
+// @Success      200       {object}  InboxListResponse
+// @Failure      400       {object}  ErrorResponse  "Invalid query"
+// @Failure      503       {object}  ErrorResponse  "Inbox unavailable"
+// @Router       /inbox [get]
+func (s *Server) handleInboxList(c *gin.Context) {
+	if s.inboxService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Inbox not available"})
+		return
+	}
+
+	filter := inbox.Filter{
+		State:    inbox.State(strings.TrimSpace(c.Query("state"))),
+		Category: inbox.Category(strings.TrimSpace(c.Query("category"))),
+		Assignee: strings.TrimSpace(c.Query("assignee")),
+	}
+	if filter.State != "" && !inbox.ValidState(filter.State) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": "invalid state"})
+		return
+	}
+
+	snapshot, err := s.inboxService.List(c.Request.Context(), filter)
+	if err != nil {
+		s.logger.Error("inbox_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load inbox"})
+		return
+	}
+
+	items := snapshot.Items
+	if items == nil {
+		items = []inbox.Item{}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status":      "ok",
+		"items":       items,
+		"total":       len(items),
+		"unavailable": snapshot.Unavailable,
+	})
+}
+
+// handleInboxCounts godoc
+// @Summary      Inbox counts
+// @Description  Get unresolved item counts per category for the dashboard badge
+// @Tags         inbox
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  InboxCountsResponse
+// @Failure      503  {object}  ErrorResponse  "Inbox unavailable"
+// @Router       /inbox/counts [get]
+func (s *Server) handleInboxCounts(c *gin.Context) {
+	if s.inboxService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Inbox not available"})
+		return
+	}
+
+	counts, unavailable, err := s.inboxService.Counts(c.Request.Context())
+	if err != nil {
+		s.logger.Error("inbox_counts_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load inbox counts"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "counts": counts, "unavailable": unavailable})
+}
+
+// handleInboxCreateReport godoc
+// @Summary      Create report
+// @Description  Add a manual moderation report to the inbox
+// @Tags         inbox
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      InboxReportRequest  true  "Report"
+// @Success      200      {object}  InboxItemResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid request"
+// @Failure      503      {object}  ErrorResponse  "Inbox unavailable"
+// @Router       /inbox [post]
+func (s *Server) handleInboxCreateReport(c *gin.Context) {
+	if s.inboxService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Inbox not available"})
+		return
+	}
+
+	var req InboxReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.Title) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	item, err := s.inboxService.CreateReport(
+		c.Request.Context(),
+		strings.TrimSpace(req.Title),
+		strings.TrimSpace(req.Detail),
+		strings.TrimSpace(req.Target),
+		inbox.Severity(req.Severity),
+		c.GetString(auth.ContextKeyUsername),
+	)
+	if err != nil {
+		s.logger.Error("inbox_create_report_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create report"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "item": item})
+}
+
+// handleInboxAssign godoc
+// @Summary      Assign inbox item
+// @Description  Assign an item to an operator (empty assignee unassigns). Assigning an open item moves it to in_progress.
+// @Tags         inbox
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id       path      string              true  "Item ID"
+// @Param        request  body      InboxAssignRequest  true  "Assignee"
+// @Success      200      {object}  InboxItemResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid request"
+// @Failure      404      {object}  ErrorResponse  "Item not found"
+// @Failure      503      {object}  ErrorResponse  "Inbox unavailable"
+// @Router       /inbox/{id}/assign [put]
+func (s *Server) handleInboxAssign(c *gin.Context) {
+	if s.inboxService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Inbox not available"})
+		return
+	}
+
+	var req InboxAssignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	// 담당자는 항목을 처리할 수 있는(operator 이상) 활성 계정이어야 함
+	assignee := strings.TrimSpace(req.Assignee)
+	if assignee != "" {
+		user, err := s.users.GetUser(c.Request.Context(), assignee)
+		if err != nil {
+			s.logger.Error("user_get_failed", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load user"})
+			return
+		}
+		if user == nil || user.Disabled || !user.Role.Allows(auth.RoleOperator) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Assignee must be an active operator", "assignee": assignee})
+			return
+		}
+	}
+
+	item, err := s.inboxService.Assign(c.Request.Context(), c.Param("id"), assignee, c.GetString(auth.ContextKeyUsername))
+	s.respondInboxUpdate(c, item, err)
+}
+
+// handleInboxState godoc
+// @Summary      Change inbox item state
+// @Description  Move an item between open, in_progress and resolved (resolved items can only be reopened)
+// @Tags         inbox
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id       path      string             true  "Item ID"
+// @Param        request  body      InboxStateRequest  true  "Target state"
+// @Success      200      {object}  InboxItemResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid request"
+// @Failure      404      {object}  ErrorResponse  "Item not found"
+// @Failure      409      {object}  ErrorResponse  "Invalid transition"
+// @Failure      503      {object}  ErrorResponse  "Inbox unavailable"
+// @Router       /inbox/{id}/state [put]
+func (s *Server) handleInboxState(c *gin.Context) {
+	if s.inboxService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Inbox not available"})
+		return
+	}
+
+	var req InboxStateRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !inbox.ValidState(inbox.State(req.State)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	item, err := s.inboxService.Transition(
+		c.Request.Context(),
+		c.Param("id"),
+		inbox.State(req.State),
+		strings.TrimSpace(req.Note),
+		c.GetString(auth.ContextKeyUsername),
+	)
+	s.respondInboxUpdate(c, item, err)
+}
+
+func (s *Server) respondInboxUpdate(c *gin.Context, item inbox.Item, err error) {
+	switch {
+	case err == nil:
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "item": item})
+	case errors.Is(err, inbox.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+	case errors.Is(err, inbox.ErrInvalidTransition):
+		c.JSON(http.StatusConflict, gin.H{"error": "Invalid transition", "details": err.Error()})
+	default:
+		s.logger.Error("inbox_update_failed", slog.String("id", c.Param("id")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update inbox item"})
+	}
+}
+
+// ===== Probe Handlers =====
+
+const probeDefaultHistoryLimit = 50
+
+// handleProbeList godoc
+// @Summary      List probes
+// @Description  Get all synthetic monitoring probes with their last result, success rate and average latency
+// @Tags         probes
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  ProbeListResponse
+// @Failure      503  {object}  ErrorResponse  "Probes unavailable"
+// @Router       /probes [get]
+func (s *Server) handleProbeList(c *gin.Context) {
+	if s.probeService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Probes not available"})
+		return
+	}
+
+	summaries, err := s.probeService.List(c.Request.Context())
+	if err != nil {
+		s.logger.Error("probe_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load probes"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "probes": summaries, "targets": s.probeService.Targets()})
+}
+
+// handleProbeGet godoc
+// @Summary      Get probe
+// @Description  Get a probe summary and its recent results (newest first)
+// @Tags         probes
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id     path      string  true   "Probe ID"
+// @Param        limit  query     int     false  "Max results (default 50, max 200)"
+// @Success      200    {object}  ProbeDetailResponse
+// @Failure      404    {object}  ErrorResponse  "Probe not found"
+// @Failure      503    {object}  ErrorResponse  "Probes unavailable"
+// @Router       /probes/{id} [get]
+func (s *Server) handleProbeGet(c *gin.Context) {
+	if s.probeService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Probes not available"})
+		return
+	}
+
+	limit := probeDefaultHistoryLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": "limit must be a positive integer"})
+			return
+		}
+		limit = min(parsed, probe.MaxHistory)
+	}
+
+	summary, results, err := s.probeService.Get(c.Request.Context(), c.Param("id"), limit)
+	switch {
+	case errors.Is(err, probe.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Probe not found"})
+	case err != nil:
+		s.logger.Error("probe_get_failed", slog.String("id", c.Param("id")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load probe"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "summary": summary, "results": results})
+	}
+}
+
+// handleProbeCreate godoc
+// @Summary      Create probe
+// @Description  Define a probe (GET/HEAD request to a bot endpoint with expected status and optional JSON path value)
+// @Tags         probes
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      ProbeRequest  true  "Probe definition"
+// @Success      201      {object}  ProbeResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid probe"
+// @Failure      409      {object}  ErrorResponse  "Probe already exists"
+// @Failure      503      {object}  ErrorResponse  "Probes unavailable"
+// @Router       /probes [post]
+func (s *Server) handleProbeCreate(c *gin.Context) {
+	if s.probeService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Probes not available"})
+		return
+	}
+
+	var req ProbeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	created, err := s.probeService.Create(c.Request.Context(), req.toProbe(), c.GetString(auth.ContextKeyUsername))
+	s.respondProbeUpdate(c, http.StatusCreated, created, err)
+}
+
+// handleProbeUpdate godoc
+// @Summary      Update probe
+// @Description  Replace a probe definition (history is kept)
+// @Tags         probes
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id       path      string        true  "Probe ID"
+// @Param        request  body      ProbeRequest  true  "Probe definition (id is ignored)"
+// @Success      200      {object}  ProbeResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid probe"
+// @Failure      404      {object}  ErrorResponse  "Probe not found"
+// @Failure      503      {object}  ErrorResponse  "Probes unavailable"
+// @Router       /probes/{id} [put]
+func (s *Server) handleProbeUpdate(c *gin.Context) {
+	if s.probeService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Probes not available"})
+		return
+	}
+
+	var req ProbeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	updated, err := s.probeService.Update(c.Request.Context(), c.Param("id"), req.toProbe(), c.GetString(auth.ContextKeyUsername))
+	s.respondProbeUpdate(c, http.StatusOK, updated, err)
+}
+
+func (s *Server) respondProbeUpdate(c *gin.Context, okStatus int, p probe.Probe, err error) {
+	switch {
+	case err == nil:
+		c.JSON(okStatus, gin.H{"status": "ok", "probe": p})
+	case errors.Is(err, probe.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid probe", "details": err.Error()})
+	case errors.Is(err, probe.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Probe not found"})
+	case errors.Is(err, probe.ErrExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "Probe already exists"})
+	default:
+		s.logger.Error("probe_save_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save probe"})
+	}
+}
+
+// handleProbeDelete godoc
+// @Summary      Delete probe
+// @Description  Delete a probe and its history
+// @Tags         probes
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id   path      string  true  "Probe ID"
+// @Success      200  {object}  StatusResponse
+// @Failure      404  {object}  ErrorResponse  "Probe not found"
+// @Failure      503  {object}  ErrorResponse  "Probes unavailable"
+// @Router       /probes/{id} [delete]
+func (s *Server) handleProbeDelete(c *gin.Context) {
+	if s.probeService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Probes not available"})
+		return
+	}
+
+	err := s.probeService.Delete(c.Request.Context(), c.Param("id"), c.GetString(auth.ContextKeyUsername))
+	switch {
+	case errors.Is(err, probe.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Probe not found"})
+	case err != nil:
+		s.logger.Error("probe_delete_failed", slog.String("id", c.Param("id")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete probe"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
+
+// handleProbeRun godoc
+// @Summary      Run probe now
+// @Description  Execute a probe once (regardless of interval or enabled flag) and record the result
+// @Tags         probes
+// @Produce      json
+// @Security     SessionCookie
+// @Param        id   path      string  true  "Probe ID"
+// @Success      200  {object}  ProbeRunResponse
+// @Failure      404  {object}  ErrorResponse  "Probe not found"
+// @Failure      503  {object}  ErrorResponse  "Probes unavailable"
+// @Router       /probes/{id}/run [post]
+func (s *Server) handleProbeRun(c *gin.Context) {
+	if s.probeService == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Probes not available"})
+		return
+	}
+
+	result, err := s.probeService.RunNow(c.Request.Context(), c.Param("id"))
+	switch {
+	case errors.Is(err, probe.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Probe not found"})
+	case err != nil:
+		s.logger.Error("probe_run_failed", slog.String("id", c.Param("id")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to run probe"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "result": result})
+	}
+}
+
+// ===== Metrics Query Handlers =====
+
+// handleMetricsTargets godoc
+// @Summary      List metrics targets
+// @Description  Get scrape status and available metric names for each bot
+// @Tags         metrics
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  MetricsTargetsResponse
+// @Failure      503  {object}  ErrorResponse  "Metrics scraping disabled"
+// @Router       /metrics [get]
+func (s *Server) handleMetricsTargets(c *gin.Context) {
+	if s.metricsScraper == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Metrics scraping not enabled"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status":          "ok",
+		"intervalSeconds": int(s.metricsScraper.Interval().Seconds()),
+		"targets":         s.metricsScraper.Targets(),
+	})
+}
+
+// handleMetricsQuery godoc
+// @Summary      Query metrics range
+// @Description  Evaluate a scraped metric over a time range (raw value, counter rate, or histogram quantile)
+// @Tags         metrics
+// @Produce      json
+// @Security     SessionCookie
+// @Param        target  query     string  true   "Scrape target (holo, twentyq, turtle, llm)"
+// @Param        metric  query     string  true   "Metric name (histogram base name for fn=quantile)"
+// @Param        fn      query     string  false  "raw (default), rate, quantile"
+// @Param        q       query     number  false  "Quantile for fn=quantile (e.g. 0.95)"
+// @Param        label   query     []string  false  "Label matcher name=value (repeatable)"
+// @Param        sum     query     bool    false  "Sum matched series into one"
+// @Param        start   query     string  false  "Start time (RFC3339 or unix seconds, default end-15m)"
+// @Param        end     query     string  false  "End time (RFC3339 or unix seconds, default now)"
+// @Param        step    query     string  false  "Step (duration like 30s or seconds)"
+// @Success      200     {object}  MetricsQueryResponse
+// @Failure      400     {object}  ErrorResponse  "Invalid query"
+// @Failure      404     {object}  ErrorResponse  "Unknown target"
+// @Failure      503     {object}  ErrorResponse  "Metrics scraping disabled"
+// @Router       /metrics/query [get]
+func (s *Server) handleMetricsQuery(c *gin.Context) {
+	if s.metricsScraper == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Metrics scraping not enabled"})
+		return
+	}
+
+	query, err := parseMetricsQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+		return
+	}
+
+	results, query, err := s.metricsScraper.Query(query)
+	switch {
+	case errors.Is(err, metrics.ErrUnknownTarget):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown metrics target"})
+	case errors.Is(err, metrics.ErrInvalidQuery):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+	case err != nil:
+		s.logger.Error("metrics_query_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to query metrics"})
+	default:
+		c.JSON(http.StatusOK, gin.H{
+			"status":      "ok",
+			"target":      query.Target,
+			"metric":      query.Metric,
+			"fn":          query.Func,
+			"start":       query.Start.Unix(),
+			"end":         query.End.Unix(),
+			"stepSeconds": int(query.Step.Seconds()),
+			"series":      results,
+		})
+	}
+}
+
+func parseMetricsQuery(c *gin.Context) (metrics.Query, error) {
+	query := metrics.Query{
+		Target: strings.TrimSpace(c.Query("target")),
+		Metric: strings.TrimSpace(c.Query("metric")),
+		Func:   strings.ToLower(strings.TrimSpace(c.Query("fn"))),
+		Sum:    c.Query("sum") == "true" || c.Query("sum") == "1",
+	}
+
+	var err error
+	if query.Start, err = parseAuditTime(c.Query("start")); err != nil {
+		return query, errors.New("invalid start")
+	}
+	if query.End, err = parseAuditTime(c.Query("end")); err != nil {
+		return query, errors.New("invalid end")
+	}
+	if v := strings.TrimSpace(c.Query("step")); v != "" {
+		if seconds, convErr := strconv.Atoi(v); convErr == nil {
+			query.Step = time.Duration(seconds) * time.Second
+		} else if query.Step, err = time.ParseDuration(v); err != nil {
+			return query, errors.New("invalid step")
+		}
+	}
+	if v := c.Query("q"); v != "" {
+		if query.Quantile, err = strconv.ParseFloat(v, 64); err != nil {
+			return query, errors.New("invalid q")
+		}
+	}
+	for _, matcher := range c.QueryArray("label") {
+		name, value, ok := strings.Cut(matcher, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return query, fmt.Errorf("invalid label %q (want name=value)", matcher)
+		}
+		if query.Matchers == nil {
+			query.Matchers = make(map[string]string)
+		}
+		query.Matchers[strings.TrimSpace(name)] = value
+	}
+	return query, nil
+}
+
+// ===== Latency Handlers =====
+
+// handleLatencyReport godoc
+// @Summary      Command latency SLA report
+// @Description  Merge per-command response latency reports from every bot, most frequent SLA breaches first
+// @Tags         latency
+// @Produce      json
+// @Security     SessionCookie
+// @Param        days  query     int  false  "Days to include (1-90, default 7)"
+// @Success      200   {object}  LatencyReportResponse
+// @Failure      400   {object}  ErrorResponse  "Invalid query"
+// @Failure      503   {object}  ErrorResponse  "Latency reporting unavailable"
+// @Router       /latency [get]
+func (s *Server) handleLatencyReport(c *gin.Context) {
+	if s.latencyReports == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Latency reporting not available"})
+		return
+	}
+
+	days := latency.DefaultDays
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > latency.MaxDays {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": "days must be between 1 and 90"})
+			return
+		}
+		days = parsed
+	}
+
+	c.JSON(http.StatusOK, LatencyReportResponse{Status: "ok", Report: s.latencyReports.Report(c.Request.Context(), days)})
+}
+
+// ===== Bot Config Handlers =====
+
+const botConfigDefaultHistoryLimit = 50
+
+// handleBotConfigServices godoc
+// @Summary      List bot config services
+// @Description  List bots whose runtime settings are managed centrally
+// @Tags         bot-config
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  BotConfigServicesResponse
+// @Failure      503  {object}  ErrorResponse  "Bot config unavailable"
+// @Router       /bot-config [get]
+func (s *Server) handleBotConfigServices(c *gin.Context) {
+	if s.botConfig == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Bot config not available"})
+		return
+	}
+	c.JSON(http.StatusOK, BotConfigServicesResponse{Status: "ok", Services: s.botConfig.Services()})
+}
+
+// handleBotConfigGet godoc
+// @Summary      Get effective bot config
+// @Description  Get the bot's runtime settings with the stored overrides and whether each value is applied
+// @Tags         bot-config
+// @Produce      json
+// @Security     SessionCookie
+// @Param        service  path      string  true  "Service (holo, twentyq, turtle)"
+// @Success      200      {object}  BotConfigResponse
+// @Failure      404      {object}  ErrorResponse  "Unknown service"
+// @Failure      503      {object}  ErrorResponse  "Bot config unavailable"
+// @Router       /bot-config/{service} [get]
+func (s *Server) handleBotConfigGet(c *gin.Context) {
+	if s.botConfig == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Bot config not available"})
+		return
+	}
+
+	effective, err := s.botConfig.Effective(c.Request.Context(), c.Param("service"))
+	switch {
+	case errors.Is(err, botconfig.ErrUnknownService):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown service"})
+	case err != nil:
+		s.logger.Error("bot_config_get_failed", slog.String("service", c.Param("service")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load bot config"})
+	default:
+		c.JSON(http.StatusOK, BotConfigResponse{Status: "ok", Effective: effective})
+	}
+}
+
+// handleBotConfigUpdate godoc
+// @Summary      Update bot config
+// @Description  Change runtime setting overrides and push them to the bot. A null or empty value removes the override (bot default). Values the bot rejects are not stored.
+// @Tags         bot-config
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        service  path      string                  true  "Service (holo, twentyq, turtle)"
+// @Param        request  body      BotConfigUpdateRequest  true  "Changed values"
+// @Success      200      {object}  BotConfigUpdateResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid value"
+// @Failure      404      {object}  ErrorResponse  "Unknown service"
+// @Failure      502      {object}  ErrorResponse  "Bot unreachable"
+// @Failure      503      {object}  ErrorResponse  "Bot config unavailable"
+// @Router       /bot-config/{service} [put]
+func (s *Server) handleBotConfigUpdate(c *gin.Context) {
+	if s.botConfig == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Bot config not available"})
+		return
+	}
+
+	var req BotConfigUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	service := c.Param("service")
+	change, effective, err := s.botConfig.Update(c.Request.Context(), service, req.Values, c.GetString(auth.ContextKeyUsername), strings.TrimSpace(req.Note))
+	switch {
+	case errors.Is(err, botconfig.ErrUnknownService):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown service"})
+	case errors.Is(err, botconfig.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bot config", "details": err.Error()})
+	case errors.Is(err, botconfig.ErrUnavailable):
+		s.logger.Warn("bot_config_push_failed", slog.String("service", service), slog.Any("error", err))
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Bot unreachable", "details": err.Error()})
+	case err != nil:
+		s.logger.Error("bot_config_update_failed", slog.String("service", service), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update bot config"})
+	default:
+		c.JSON(http.StatusOK, BotConfigUpdateResponse{Status: "ok", Change: change, Effective: effective})
+	}
+}
+
+// handleBotConfigHistory godoc
+// @Summary      Bot config history
+// @Description  Get override changes for a bot (newest first)
+// @Tags         bot-config
+// @Produce      json
+// @Security     SessionCookie
+// @Param        service  path      string  true   "Service (holo, twentyq, turtle)"
+// @Param        limit    query     int     false  "Max changes (default 50, max 200)"
+// @Success      200      {object}  BotConfigHistoryResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid query"
+// @Failure      404      {object}  ErrorResponse  "Unknown service"
+// @Failure      503      {object}  ErrorResponse  "Bot config unavailable"
+// @Router       /bot-config/{service}/history [get]
+func (s *Server) handleBotConfigHistory(c *gin.Context) {
+	if s.botConfig == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Bot config not available"})
+		return
+	}
+
+	limit := botConfigDefaultHistoryLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": "limit must be a positive integer"})
+			return
+		}
+		limit = parsed
+	}
+
+	changes, err := s.botConfig.History(c.Request.Context(), c.Param("service"), limit)
+	switch {
+	case errors.Is(err, botconfig.ErrUnknownService):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown service"})
+	case err != nil:
+		s.logger.Error("bot_config_history_failed", slog.String("service", c.Param("service")), slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load bot config history"})
+	default:
+		c.JSON(http.StatusOK, BotConfigHistoryResponse{Status: "ok", Changes: changes})
+	}
+}
+
+// ===== User Handlers =====
+
+const minPasswordLength = 8
+
+// handleCurrentUser godoc
+// @Summary      Current user
+// @Description  Get the logged-in account and its role
+// @Tags         auth
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  CurrentUserResponse
+// @Router       /auth/me [get]
+func (s *Server) handleCurrentUser(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":   "ok",
+		"username": c.GetString(auth.ContextKeyUsername),
+		"role":     auth.RoleFromContext(c),
+	})
+}
+
+// handleUserList godoc
+// @Summary      List users
+// @Description  Get all admin accounts (admin only)
+// @Tags         users
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  UserListResponse
+// @Failure      403  {object}  ErrorResponse  "Forbidden"
+// @Router       /users [get]
+func (s *Server) handleUserList(c *gin.Context) {
+	users, err := s.users.ListUsers(c.Request.Context())
+	if err != nil {
+		s.logger.Error("user_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list users"})
+		return
+	}
+
+	infos := make([]UserInfo, 0, len(users))
+	for _, user := range users {
+		infos = append(infos, newUserInfo(user))
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "users": infos})
+}
+
+// handleUserCreate godoc
+// @Summary      Create user
+// @Description  Create an admin account with a role (admin only)
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      CreateUserRequest  true  "New account"
+// @Success      201      {object}  UserResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid request"
+// @Failure      409      {object}  ErrorResponse  "User already exists"
+// @Router       /users [post]
+func (s *Server) handleUserCreate(c *gin.Context) {
+	var req CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	username := strings.TrimSpace(req.Username)
+	if !auth.ValidUsername(username) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid username"})
+		return
+	}
+	role, ok := auth.ParseRole(req.Role)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid role"})
+		return
+	}
+	hash, err := hashPassword(req.Password)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	now := time.Now()
+	user := auth.User{
+		Username:     username,
+		PasswordHash: hash,
+		Role:         role,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.users.CreateUser(c.Request.Context(), user); err != nil {
+		if errors.Is(err, auth.ErrUserExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "User already exists"})
+			return
+		}
+		s.logger.Error("user_create_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+		return
+	}
+
+	s.logger.Info("admin_user_created",
+		slog.String("username", username),
+		slog.String("role", string(role)),
+		slog.String("by", c.GetString(auth.ContextKeyUsername)),
+	)
+	c.JSON(http.StatusCreated, gin.H{"status": "ok", "user": newUserInfo(user)})
+}
+
+// handleUserUpdate godoc
+// @Summary      Update user
+// @Description  Change role, password or disabled state of an account (admin only)
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        username  path      string             true  "Username"
+// @Param        request   body      UpdateUserRequest  true  "Fields to change"
+// @Success      200       {object}  UserResponse
+// @Failure      400       {object}  ErrorResponse  "Invalid request"
+// @Failure      404       {object}  ErrorResponse  "User not found"
+// @Failure      409       {object}  ErrorResponse  "Last admin cannot be demoted"
+// @Router       /users/{username} [put]
+func (s *Server) handleUserUpdate(c *gin.Context) {
+	var req UpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	user, err := s.users.GetUser(ctx, c.Param("username"))
+	if err != nil {
+		s.logger.Error("user_get_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load user"})
+		return
+	}
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	updated := *user
+	if req.Role != nil {
+		role, ok := auth.ParseRole(*req.Role)
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid role"})
+			return
+		}
+		updated.Role = role
+	}
+	if req.Password != nil {
+		hash, hashErr := hashPassword(*req.Password)
+		if hashErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": hashErr.Error()})
+			return
+		}
+		updated.PasswordHash = hash
+	}
+	if req.Disabled != nil {
+		updated.Disabled = *req.Disabled
+	}
+
+	if isActiveAdmin(*user) && !isActiveAdmin(updated) {
+		if last, checkErr := s.isLastActiveAdmin(ctx, user.Username); checkErr != nil || last {
+			c.JSON(http.StatusConflict, gin.H{"error": "At least one active admin is required"})
+			return
+		}
+	}
+
+	updated.UpdatedAt = time.Now()
+	if err := s.users.UpdateUser(ctx, updated); err != nil {
+		s.logger.Error("user_update_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		return
+	}
+
+	s.logger.Info("admin_user_updated",
+		slog.String("username", updated.Username),
+		slog.String("role", string(updated.Role)),
+		slog.Bool("disabled", updated.Disabled),
+		slog.Bool("password_changed", req.Password != nil),
+		slog.String("by", c.GetString(auth.ContextKeyUsername)),
+	)
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "user": newUserInfo(updated)})
+}
+
+// handleUserDelete godoc
+// @Summary      Delete user
+// @Description  Delete an admin account (admin only, cannot delete yourself or the last admin)
+// @Tags         users
+// @Produce      json
+// @Security     SessionCookie
+// @Param        username  path      string  true  "Username"
+// @Success      200       {object}  StatusResponse
+// @Failure      400       {object}  ErrorResponse  "Cannot delete yourself"
+// @Failure      404       {object}  ErrorResponse  "User not found"
+// @Failure      409       {object}  ErrorResponse  "Last admin cannot be deleted"
+// @Router       /users/{username} [delete]
+func (s *Server) handleUserDelete(c *gin.Context) {
+	ctx := c.Request.Context()
+	username := c.Param("username")
+	if strings.EqualFold(username, c.GetString(auth.ContextKeyUsername)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot delete yourself"})
+		return
+	}
+
+	user, err := s.users.GetUser(ctx, username)
+	if err != nil {
+		s.logger.Error("user_get_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load user"})
 		return
 	}
-
-	if err := bcrypt.CompareHashAndPassword([]byte(s.cfg.AdminPassHash), []byte(req.Password)); err != nil {
-		s.handleLoginFailure(c, ip, req.Username, "invalid_password")
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
 		return
 	}
+	if isActiveAdmin(*user) {
+		if last, checkErr := s.isLastActiveAdmin(ctx, user.Username); checkErr != nil || last {
+			c.JSON(http.StatusConflict, gin.H{"error": "At least one active admin is required"})
+			return
+		}
+	}
 
-	s.rateLimiter.RecordSuccess(ip)
-
-	session, err := s.sessions.CreateSession(c.Request.Context())
-	if err != nil {
-		s.logger.Error("Failed to create session", slog.Any("error", err))
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Session store unavailable"})
+	if err := s.users.DeleteUser(ctx, user.Username); err != nil {
+		if errors.Is(err, auth.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		s.logger.Error("user_delete_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
 		return
 	}
 
-	signedSessionID := auth.SignSessionID(session.ID, s.cfg.AdminSecretKey)
-	auth.SetSecureCookie(c, auth.SessionCookieName, signedSessionID, 0, s.cfg.ForceHTTPS)
-
-	s.logger.Info("Admin logged in", slog.String("username", req.Username), slog.String("ip", ip))
-	c.JSON(200, gin.H{"status": "ok", "message": "Login successful"})
-}
-
-func (s *Server) handleLoginFailure(c *gin.Context, ip, username, reason string) {
-	failCount := s.rateLimiter.RecordFailure(ip)
-
-	s.logger.Warn("Failed login attempt",
-		slog.String("username", username),
-		slog.String("ip", ip),
-		slog.String("reason", reason),
-		slog.Int("fail_count", failCount),
+	s.logger.Info("admin_user_deleted",
+		slog.String("username", user.Username),
+		slog.String("by", c.GetString(auth.ContextKeyUsername)),
 	)
-
-	delay := time.Duration(failCount) * 500 * time.Millisecond
-	if delay > 3*time.Second {
-		delay = 3 * time.Second
-	}
-	time.Sleep(delay)
-
-	c.JSON(200, gin.H{"success": false, "error": "Authentication failed"})
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "User deleted"})
 }
 
-// handleLogout godoc
-// @Summary      User logout
-// @Description  Invalidate session and clear cookies
+// handleSessionList godoc
+// @Summary      List active sessions
+// @Description  List all active admin sessions, most recently seen first (admin only)
 // @Tags         auth
-// @Accept       json
 // @Produce      json
 // @Security     SessionCookie
-// @Success      200  {object}  StatusResponse
-// @Router       /auth/logout [post]
-func (s *Server) handleLogout(c *gin.Context) {
-	signedSessionID, _ := c.Cookie(auth.SessionCookieName)
-	if signedSessionID != "" {
-		if sessionID, valid := auth.ValidateSessionSignature(signedSessionID, s.cfg.AdminSecretKey); valid {
-			s.sessions.DeleteSession(c.Request.Context(), sessionID)
-		}
+// @Success      200  {object}  SessionListResponse
+// @Failure      503  {object}  ErrorResponse  "Session store unavailable"
+// @Router       /auth/sessions [get]
+func (s *Server) handleSessionList(c *gin.Context) {
+	sessions, err := s.sessions.ListSessions(c.Request.Context())
+	if err != nil {
+		s.logger.Error("session_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Session store unavailable"})
+		return
 	}
 
-	auth.ClearSecureCookie(c, auth.SessionCookieName, s.cfg.ForceHTTPS)
-	c.JSON(200, gin.H{"status": "ok", "message": "Logout successful"})
+	currentID := c.GetString(auth.ContextKeySessionID)
+	infos := make([]SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		infos = append(infos, newSessionInfo(session, currentID))
+	}
+	c.JSON(http.StatusOK, SessionListResponse{Status: "ok", Sessions: infos})
 }
 
-// handleHeartbeat godoc
-// @Summary      Session heartbeat
-// @Description  Keep session alive and optionally rotate session token
+// handleSessionRevoke godoc
+// @Summary      Revoke session
+// @Description  Revoke an active admin session by its handle (admin only, use logout for the current session)
 // @Tags         auth
-// @Accept       json
 // @Produce      json
 // @Security     SessionCookie
-// @Param        request  body      HeartbeatRequest  false  "Heartbeat options"
-// @Success      200      {object}  HeartbeatResponse
-// @Failure      401      {object}  ErrorResponse  "Session expired or invalid"
-// @Router       /auth/heartbeat [post]
-func (s *Server) handleHeartbeat(c *gin.Context) {
-	signedSessionID, err := c.Cookie(auth.SessionCookieName)
-	if err != nil || signedSessionID == "" {
-		c.JSON(401, gin.H{"error": "Unauthorized"})
-		return
-	}
-
-	sessionID, valid := auth.ValidateSessionSignature(signedSessionID, s.cfg.AdminSecretKey)
-	if !valid {
-		c.JSON(401, gin.H{"error": "Unauthorized"})
-		return
-	}
-
-	var req struct {
-		Idle bool `json:"idle"`
-	}
-	_ = c.ShouldBindJSON(&req)
-
+// @Param        id   path      string  true  "Session handle from the session list"
+// @Success      200  {object}  StatusResponse
+// @Failure      400  {object}  ErrorResponse  "Cannot revoke current session"
+// @Failure      404  {object}  ErrorResponse  "Session not found"
+// @Failure      503  {object}  ErrorResponse  "Session store unavailable"
+// @Router       /auth/sessions/{id} [delete]
+func (s *Server) handleSessionRevoke(c *gin.Context) {
 	ctx := c.Request.Context()
-	refreshed, absoluteExpired, err := s.sessions.RefreshSessionWithValidation(ctx, sessionID, req.Idle)
+	sessions, err := s.sessions.ListSessions(ctx)
 	if err != nil {
-		c.JSON(500, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	if absoluteExpired {
-		auth.ClearSecureCookie(c, auth.SessionCookieName, s.cfg.ForceHTTPS)
-		c.JSON(401, gin.H{"error": "Session expired", "absolute_expired": true})
+		s.logger.Error("session_list_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Session store unavailable"})
 		return
 	}
 
-	if req.Idle && !refreshed {
-		c.JSON(200, gin.H{"status": "idle", "idle_rejected": true})
+	handle := c.Param("id")
+	idx := slices.IndexFunc(sessions, func(session auth.Session) bool {
+		return auth.SessionHandle(session.ID) == handle
+	})
+	if idx < 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Session not found"})
 		return
 	}
-
-	if !refreshed {
-		auth.ClearSecureCookie(c, auth.SessionCookieName, s.cfg.ForceHTTPS)
-		c.JSON(401, gin.H{"error": "Session expired"})
+	target := sessions[idx]
+	if target.ID == c.GetString(auth.ContextKeySessionID) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot revoke current session, use logout instead"})
 		return
 	}
 
-	response := gin.H{"status": "ok"}
+	s.sessions.DeleteSession(ctx, target.ID)
+	s.logger.Info("admin_session_revoked",
+		slog.String("session", auth.SessionIDPrefix(target.ID)),
+		slog.String("username", target.Username),
+		slog.String("by", c.GetString(auth.ContextKeyUsername)),
+	)
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "Session revoked"})
+}
 
-	if s.cfg.SessionTokenRotation {
-		newSession, rotateErr := s.sessions.RotateSession(ctx, sessionID)
-		if rotateErr == nil {
-			newSignedSessionID := auth.SignSessionID(newSession.ID, s.cfg.AdminSecretKey)
-			auth.SetSecureCookie(c, auth.SessionCookieName, newSignedSessionID, 0, s.cfg.ForceHTTPS)
-			response["rotated"] = true
-			response["absolute_expires_at"] = newSession.AbsoluteExpiresAt.Unix()
+// isLastActiveAdmin: username 외에 활성 admin 계정이 없는지 확인
+func (s *Server) isLastActiveAdmin(ctx context.Context, username string) (bool, error) {
+	users, err := s.users.ListUsers(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list users: %w", err)
+	}
+	for _, user := range users {
+		if isActiveAdmin(user) && !strings.EqualFold(user.Username, username) {
+			return false, nil
 		}
 	}
+	return true, nil
+}
 
-	c.JSON(200, response)
+func isActiveAdmin(user auth.User) bool {
+	return user.Role == auth.RoleAdmin && !user.Disabled
+}
+
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength {
+		return "", fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return string(hash), nil
 }
 
 // ===== Docker Handlers =====
@@ -536,14 +2040,16 @@ func (s *Server) handleDockerContainers(c *gin.Context) {
 
 // handleDockerRestart godoc
 // @Summary      Restart container
-// @Description  Restart a managed Docker container by name
+// @Description  Restart a managed Docker container by name. If a restart chain is configured, dependents are restarted afterwards in order and 202 is returned with a job streamed via /docker/jobs/{id}/progress
 // @Tags         docker
 // @Accept       json
 // @Produce      json
 // @Security     SessionCookie
 // @Param        name  path      string  true  "Container name"
 // @Success      200   {object}  StatusResponse
+// @Success      202   {object}  DockerGroupJobResponse
 // @Failure      404   {object}  ErrorResponse  "Container not found"
+// @Failure      409   {object}  ErrorResponse  "Restart chain already in progress"
 // @Failure      503   {object}  ErrorResponse  "Docker service unavailable"
 // @Router       /docker/containers/{name}/restart [post]
 func (s *Server) handleDockerRestart(c *gin.Context) {
@@ -556,6 +2062,15 @@ func (s *Server) handleDockerRestart(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "container not found"})
 		return
 	}
+	if len(s.dockerSvc.RestartChain(name)) > 0 {
+		job, err := s.dockerSvc.StartRestartChain(c.Request.Context(), name)
+		if err != nil {
+			respondDockerGroupError(c, err)
+			return
+		}
+		c.JSON(http.StatusAccepted, DockerGroupJobResponse{Status: "ok", Job: job})
+		return
+	}
 	if err := s.dockerSvc.RestartContainer(c.Request.Context(), name); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -621,6 +2136,134 @@ func (s *Server) handleDockerStart(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "Container started"})
 }
 
+// handleDockerGroup godoc
+// @Summary      Preview compose project order
+// @Description  List managed containers of a docker-compose project grouped into dependency-ordered stages
+// @Tags         docker
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        project  path      string  true  "Compose project name"
+// @Success      200      {object}  DockerGroupResponse
+// @Failure      404      {object}  ErrorResponse  "Project not found"
+// @Failure      503      {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/groups/{project} [get]
+func (s *Server) handleDockerGroup(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+	project := c.Param("project")
+	members, err := s.dockerSvc.ListGroup(c.Request.Context(), project)
+	if err != nil {
+		respondDockerGroupError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, DockerGroupResponse{Status: "ok", Project: project, Stages: docker.OrderGroup(members)})
+}
+
+// handleDockerGroupRestart godoc
+// @Summary      Restart compose project
+// @Description  Restart managed containers of a docker-compose project in dependency order (e.g. valkey before bots). Progress is streamed via /docker/jobs/{id}/progress
+// @Tags         docker
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        project  path      string  true  "Compose project name"
+// @Success      202      {object}  DockerGroupJobResponse
+// @Failure      404      {object}  ErrorResponse  "Project not found"
+// @Failure      409      {object}  ErrorResponse  "Operation already in progress"
+// @Failure      503      {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/groups/{project}/restart [post]
+func (s *Server) handleDockerGroupRestart(c *gin.Context) {
+	s.startDockerGroup(c, docker.GroupRestart)
+}
+
+// handleDockerGroupStop godoc
+// @Summary      Stop compose project
+// @Description  Stop managed containers of a docker-compose project in reverse dependency order. Progress is streamed via /docker/jobs/{id}/progress
+// @Tags         docker
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        project  path      string  true  "Compose project name"
+// @Success      202      {object}  DockerGroupJobResponse
+// @Failure      404      {object}  ErrorResponse  "Project not found"
+// @Failure      409      {object}  ErrorResponse  "Operation already in progress"
+// @Failure      503      {object}  ErrorResponse  "Docker service unavailable"
+// @Router       /docker/groups/{project}/stop [post]
+func (s *Server) handleDockerGroupStop(c *gin.Context) {
+	s.startDockerGroup(c, docker.GroupStop)
+}
+
+func (s *Server) startDockerGroup(c *gin.Context, action docker.GroupAction) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+	job, err := s.dockerSvc.StartGroup(c.Request.Context(), c.Param("project"), action)
+	if err != nil {
+		respondDockerGroupError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, DockerGroupJobResponse{Status: "ok", Job: job})
+}
+
+func respondDockerGroupError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, docker.ErrGroupNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "compose project not found"})
+	case errors.Is(err, docker.ErrGroupBusy):
+		c.JSON(http.StatusConflict, gin.H{"error": "group operation already in progress"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// handleDockerGroupProgress: WebSocket으로 일괄 작업 진행 상황을 전송합니다.
+// 연결 시 지금까지의 이벤트를 먼저 보내고, final 이벤트 후 연결을 닫습니다.
+func (s *Server) handleDockerGroupProgress(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+	job, ok := s.dockerSvc.GroupJob(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "job not found"})
+		return
+	}
+
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	history, events, unsubscribe := job.Subscribe()
+	defer unsubscribe()
+
+	for _, progress := range history {
+		if err := conn.WriteJSON(progress); err != nil {
+			return
+		}
+	}
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case progress, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(progress); err != nil {
+				return
+			}
+		}
+	}
+}
+
 var wsUpgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -629,6 +2272,8 @@ var wsUpgrader = websocket.Upgrader{
 	},
 }
 
+// handleDockerLogStream: WebSocket 또는 SSE로 컨테이너 로그를 스트리밍합니다.
+// Docker 멀티플렉스 헤더를 걷어낸 로그 청크를 텍스트 메시지로 전송합니다.
 func (s *Server) handleDockerLogStream(c *gin.Context) {
 	if s.dockerSvc == nil {
 		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
@@ -641,16 +2286,16 @@ func (s *Server) handleDockerLogStream(c *gin.Context) {
 		return
 	}
 
-	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
-	if err != nil {
+	stream, ok := openStream(c)
+	if !ok {
 		return
 	}
-	defer func() { _ = conn.Close() }()
+	defer func() { _ = stream.Close() }()
 
 	ctx := c.Request.Context()
 	logReader, err := s.dockerSvc.GetLogStream(ctx, name)
 	if err != nil {
-		_ = conn.WriteJSON(gin.H{"error": err.Error()})
+		_ = stream.WriteJSON(gin.H{"error": err.Error()})
 		return
 	}
 	defer func() { _ = logReader.Close() }()
@@ -689,8 +2334,50 @@ func (s *Server) handleDockerLogStream(c *gin.Context) {
 			return
 		}
 
-		if err := conn.WriteMessage(websocket.TextMessage, payload[:n]); err != nil {
+		if err := stream.WriteText(payload[:n]); err != nil {
+			return
+		}
+	}
+}
+
+// handleDockerStatsStream: WebSocket 또는 SSE로 컨테이너 리소스 사용량을 스트리밍합니다.
+// 2초마다 CPU/메모리/네트워크 IO 샘플(docker.Stats)을 JSON으로 전송합니다.
+func (s *Server) handleDockerStatsStream(c *gin.Context) {
+	if s.dockerSvc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Docker service not available"})
+		return
+	}
+
+	name := c.Param("name")
+	if !s.dockerSvc.IsManaged(name) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "container not found"})
+		return
+	}
+
+	stream, ok := openStream(c)
+	if !ok {
+		return
+	}
+	defer func() { _ = stream.Close() }()
+
+	ctx := c.Request.Context()
+	statsChan, err := s.dockerSvc.StatsStream(ctx, name, docker.DefaultStatsInterval)
+	if err != nil {
+		_ = stream.WriteJSON(gin.H{"error": err.Error()})
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
 			return
+		case stats, ok := <-statsChan:
+			if !ok {
+				return
+			}
+			if err := stream.WriteJSON(stats); err != nil {
+				return
+			}
 		}
 	}
 }
@@ -1031,12 +2718,12 @@ func (s *Server) handleTracesMetrics(c *gin.Context) {
 
 // handleAggregatedStatus godoc
 // @Summary      통합 시스템 상태
-// @Description  모든 서비스(Admin, Holo Bot, Game Bots, LLM Server)의 상태를 집계하여 반환
+// @Description  모든 서비스(Admin, Holo Bot, Game Bots, LLM Server)의 상태와 합성 모니터링 프로브 결과를 집계하여 반환
 // @Tags         status
 // @Accept       json
 // @Produce      json
 // @Security     SessionCookie
-// @Success      200  {object}  status.AggregatedStatus
+// @Success      200  {object}  AggregatedStatusResponse
 // @Router       /status [get]
 func (s *Server) handleAggregatedStatus(c *gin.Context) {
 	if s.statusCollector == nil {
@@ -1044,24 +2731,76 @@ func (s *Server) handleAggregatedStatus(c *gin.Context) {
 		return
 	}
 
-	result := s.statusCollector.GetAggregatedStatus(c.Request.Context())
+	result := AggregatedStatusResponse{AggregatedStatus: *s.statusCollector.GetAggregatedStatus(c.Request.Context())}
+	if s.probeService != nil {
+		// 프로브 조회 실패는 서비스 상태 응답을 막지 않음
+		summaries, err := s.probeService.List(c.Request.Context())
+		if err != nil {
+			s.logger.Warn("status_probes_failed", slog.Any("error", err))
+		}
+		result.Probes = summaries
+	}
+	if s.maintenance != nil {
+		// 점검 공지 조회 실패도 상태 응답을 막지 않음
+		notice, err := s.maintenance.Current(c.Request.Context())
+		if err != nil {
+			s.logger.Warn("status_maintenance_failed", slog.Any("error", err))
+		}
+		result.Maintenance = notice
+	}
 	c.JSON(http.StatusOK, result)
 }
 
-// handleSystemStatsStream: WebSocket을 통해 시스템 리소스 사용량을 실시간 스트리밍합니다.
-// 2초마다 CPU/메모리/고루틴 통계를 전송합니다.
+// handleStatusHistory godoc
+// @Summary      서비스 가동률 이력
+// @Description  주기 헬스체크 이력으로 최근 24h/7d 가동률과 응답 시간 백분위(ms)를 계산. service를 비우면 전체 서비스
+// @Tags         status
+// @Produce      json
+// @Security     SessionCookie
+// @Param        service  query     string  false  "서비스 이름 (예: twentyq-bot)"
+// @Param        range    query     string  false  "조회 범위 (24h | 7d, 기본 24h)"
+// @Success      200  {object}  StatusHistoryResponse
+// @Failure      400  {object}  map[string]string
+// @Failure      503  {object}  map[string]string
+// @Router       /status/history [get]
+func (s *Server) handleStatusHistory(c *gin.Context) {
+	if s.statusCollector == nil || !s.statusCollector.HistoryEnabled() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Status history not enabled"})
+		return
+	}
+	rangeName, _, err := status.ParseHistoryRange(c.Query("range"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+		return
+	}
+
+	services, err := s.statusCollector.History(c.Request.Context(), strings.TrimSpace(c.Query("service")), rangeName)
+	if err != nil {
+		if errors.Is(err, status.ErrUnknownService) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query", "details": err.Error()})
+			return
+		}
+		s.logger.Error("status_history_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load status history"})
+		return
+	}
+	c.JSON(http.StatusOK, StatusHistoryResponse{Status: "ok", Range: rangeName, Services: services})
+}
+
+// handleSystemStatsStream: WebSocket 또는 SSE로 시스템 리소스 사용량을 실시간 스트리밍합니다.
+// 2초마다 CPU/메모리/고루틴 통계를 전송합니다. (SSE는 Accept: text/event-stream 또는 ?transport=sse)
 func (s *Server) handleSystemStatsStream(c *gin.Context) {
 	if s.statusCollector == nil {
 		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Status collector not initialized"})
 		return
 	}
 
-	// WebSocket 업그레이드
-	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
-	if err != nil {
+	// WebSocket 업그레이드 또는 SSE 응답 시작
+	stream, ok := openStream(c)
+	if !ok {
 		return
 	}
-	defer func() { _ = conn.Close() }()
+	defer func() { _ = stream.Close() }()
 
 	ctx := c.Request.Context()
 	statsChan := make(chan *status.SystemStats, 1)
@@ -1077,7 +2816,7 @@ func (s *Server) handleSystemStatsStream(c *gin.Context) {
 			if !ok {
 				return
 			}
-			if err := conn.WriteJSON(stats); err != nil {
+			if err := stream.WriteJSON(stats); err != nil {
 				return
 			}
 		}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+)
+
+// fakeSessions: 세션 목록/폐기 핸들러 테스트용 인메모리 SessionProvider
+type fakeSessions struct {
+	auth.SessionProvider
+	sessions []auth.Session
+	deleted  []string
+}
+
+func (f *fakeSessions) ListSessions(context.Context) ([]auth.Session, error) {
+	return f.sessions, nil
+}
+
+func (f *fakeSessions) DeleteSession(_ context.Context, sessionID string) {
+	f.deleted = append(f.deleted, sessionID)
+}
+
+func TestSessionListAndRevoke(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Now()
+	store := &fakeSessions{sessions: []auth.Session{
+		{ID: "current-session-id", Username: "alice", CreatedAt: now, IP: "10.0.0.1", UserAgent: "Firefox"},
+		{ID: "other-session-id", Username: "bob", CreatedAt: now.Add(-time.Hour), LastSeenAt: now.Add(-time.Minute)},
+	}}
+	s := &Server{sessions: store, logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	call := func(method, target, id string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(method, target, nil)
+		c.Set(auth.ContextKeyUsername, "alice")
+		c.Set(auth.ContextKeySessionID, "current-session-id")
+		if id != "" {
+			c.Params = gin.Params{{Key: "id", Value: id}}
+			s.handleSessionRevoke(c)
+		} else {
+			s.handleSessionList(c)
+		}
+		return w
+	}
+
+	w := call(http.MethodGet, "/admin/api/auth/sessions", "")
+	var resp SessionListResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("unexpected list response: %d %s", w.Code, w.Body.String())
+	}
+	if len(resp.Sessions) != 2 || !resp.Sessions[0].Current || resp.Sessions[1].Current {
+		t.Fatalf("unexpected sessions: %+v", resp.Sessions)
+	}
+	first := resp.Sessions[0]
+	if first.ID == "current-session-id" || first.IDPrefix != "current-..." || !first.LastSeenAt.Equal(first.CreatedAt) || first.IP != "10.0.0.1" {
+		t.Fatalf("session id must not leak and last seen falls back to created: %+v", first)
+	}
+
+	if w := call(http.MethodDelete, "/admin/api/auth/sessions/x", "unknown"); w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+	if w := call(http.MethodDelete, "/admin/api/auth/sessions/x", first.ID); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected current session to be protected, got %d", w.Code)
+	}
+	if w := call(http.MethodDelete, "/admin/api/auth/sessions/x", resp.Sessions[1].ID); w.Code != http.StatusOK {
+		t.Fatalf("expected revoke, got %d", w.Code)
+	}
+	if len(store.deleted) != 1 || store.deleted[0] != "other-session-id" {
+		t.Fatalf("unexpected deleted sessions: %v", store.deleted)
+	}
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/middleware"
+)
+
+const (
+	// sseKeepAliveInterval: 유휴 연결을 끊는 프록시(Cloudflare Tunnel 등)를 피하기 위한 SSE 주석 전송 주기
+	sseKeepAliveInterval = 15 * time.Second
+	// sseRetryMillis: 연결이 끊겼을 때 EventSource가 재연결을 시도하기까지의 대기 시간
+	sseRetryMillis = 3000
+)
+
+// streamSink: 실시간 스트림 전송 계층. WebSocket과 SSE가 같은 핸들러 루프를 공유하도록 한다.
+type streamSink interface {
+	WriteJSON(v any) error
+	WriteText(payload []byte) error
+	// Done: 클라이언트가 연결을 끊으면 닫히는 채널 (SSE는 요청 컨텍스트로 감지하므로 nil)
+	Done() <-chan struct{}
+	Close() error
+}
+
+// openStream: 요청에 맞는 전송 계층을 엽니다.
+// Accept: text/event-stream 또는 ?transport=sse면 SSE로, 그 밖에는 WebSocket으로 업그레이드한다.
+// 실패 시 응답은 이미 처리된 상태이므로 호출자는 그대로 반환하면 된다.
+func openStream(c *gin.Context) (streamSink, bool) {
+	if middleware.WantsEventStream(c.Request) {
+		sink, err := newSSESink(c.Writer, sseKeepAliveInterval)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return nil, false
+		}
+		return sink, true
+	}
+
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return nil, false
+	}
+	sink := &wsSink{conn: conn, done: make(chan struct{})}
+	go sink.readLoop()
+	return sink, true
+}
+
+// wsSink: gorilla/websocket 연결 기반 전송 계층
+type wsSink struct {
+	conn *websocket.Conn
+	done chan struct{}
+}
+
+// readLoop: 클라이언트 메시지는 버리고 제어 프레임(ping/close)만 처리하다가 연결이 끊기면 done을 닫습니다.
+// 업그레이드된 연결은 요청 컨텍스트가 끊김을 알려주지 않으므로, 이벤트가 드문 스트림도 끊김을 바로 알 수 있게 한다.
+func (w *wsSink) readLoop() {
+	defer close(w.done)
+	for {
+		if _, _, err := w.conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+func (w *wsSink) Done() <-chan struct{} {
+	return w.done
+}
+
+func (w *wsSink) WriteJSON(v any) error {
+	if err := w.conn.WriteJSON(v); err != nil {
+		return fmt.Errorf("write websocket json: %w", err)
+	}
+	return nil
+}
+
+func (w *wsSink) WriteText(payload []byte) error {
+	if err := w.conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+		return fmt.Errorf("write websocket text: %w", err)
+	}
+	return nil
+}
+
+func (w *wsSink) Close() error {
+	if err := w.conn.Close(); err != nil {
+		return fmt.Errorf("close websocket: %w", err)
+	}
+	return nil
+}
+
+// sseSink: text/event-stream 응답 기반 전송 계층
+// 메시지는 기본(message) 이벤트의 data 필드로 보내며, 여러 줄 텍스트는 줄마다 data:를 붙여 EventSource가 원문을 복원하게 한다.
+type sseSink struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+	stop    chan struct{}
+	once    sync.Once
+}
+
+func newSSESink(w http.ResponseWriter, keepAlive time.Duration) (*sseSink, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("streaming not supported")
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache, no-transform")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no") // nginx 계열 프록시 버퍼링 비활성
+	w.WriteHeader(http.StatusOK)
+
+	sink := &sseSink{w: w, flusher: flusher, stop: make(chan struct{})}
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", sseRetryMillis); err != nil {
+		return nil, fmt.Errorf("write sse preamble: %w", err)
+	}
+	flusher.Flush()
+
+	if keepAlive > 0 {
+		go sink.keepAlive(keepAlive)
+	}
+	return sink, nil
+}
+
+func (s *sseSink) WriteJSON(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal sse data: %w", err)
+	}
+	return s.WriteText(data)
+}
+
+func (s *sseSink) WriteText(payload []byte) error {
+	normalized := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(string(payload))
+	normalized = strings.TrimSuffix(normalized, "\n")
+
+	var b strings.Builder
+	for _, line := range strings.Split(normalized, "\n") {
+		b.WriteString("data: ")
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+
+	return s.write(b.String())
+}
+
+func (s *sseSink) Done() <-chan struct{} {
+	return nil
+}
+
+// Close: keep-alive 전송을 멈춥니다. 반환 이후에는 응답에 아무것도 쓰지 않는다.
+func (s *sseSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.once.Do(func() { close(s.stop) })
+	return nil
+}
+
+func (s *sseSink) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.write(": ping\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *sseSink) write(frame string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.stop:
+		return fmt.Errorf("sse stream closed")
+	default:
+	}
+
+	if _, err := s.w.Write([]byte(frame)); err != nil {
+		return fmt.Errorf("write sse frame: %w", err)
+	}
+	s.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+func TestOpenStream_SSEFraming(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range []struct {
+		name   string
+		target string
+		accept string
+	}{
+		{name: "accept header", target: "/admin/api/ws/system-stats", accept: "text/event-stream"},
+		{name: "query param", target: "/admin/api/ws/system-stats?transport=sse"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.accept != "" {
+				c.Request.Header.Set("Accept", tc.accept)
+			}
+
+			stream, ok := openStream(c)
+			if !ok {
+				t.Fatal("expected SSE stream to open")
+			}
+			if err := stream.WriteJSON(map[string]int{"cpu": 3}); err != nil {
+				t.Fatalf("write json: %v", err)
+			}
+			if err := stream.WriteText([]byte("line one\r\nline two\n")); err != nil {
+				t.Fatalf("write text: %v", err)
+			}
+			_ = stream.Close()
+
+			if got := rec.Header().Get("Content-Type"); got != "text/event-stream" {
+				t.Fatalf("unexpected content type %q", got)
+			}
+			want := "retry: 3000\n\n" +
+				"data: {\"cpu\":3}\n\n" +
+				"data: line one\ndata: line two\n\n"
+			if rec.Body.String() != want {
+				t.Fatalf("unexpected body:\n%q\nwant:\n%q", rec.Body.String(), want)
+			}
+			if err := stream.WriteText([]byte("late")); err == nil {
+				t.Fatal("expected write after close to fail")
+			}
+		})
+	}
+}
+
+func TestSSESink_KeepAlive(t *testing.T) {
+	rec := httptest.NewRecorder()
+	sink, err := newSSESink(rec, 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("new sse sink: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		sink.mu.Lock()
+		body := rec.Body.String()
+		sink.mu.Unlock()
+		if strings.Contains(body, ": ping\n\n") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected keep-alive comment, got %q", body)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	_ = sink.Close()
+}
+
+func TestOpenStream_WebSocketDoneOnClientClose(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	closed := make(chan struct{})
+	engine := gin.New()
+	engine.GET("/ws", func(c *gin.Context) {
+		stream, ok := openStream(c)
+		if !ok {
+			return
+		}
+		defer func() { _ = stream.Close() }()
+		if err := stream.WriteJSON(map[string]string{"hello": "world"}); err != nil {
+			return
+		}
+		select {
+		case <-stream.Done():
+			close(closed)
+		case <-time.After(2 * time.Second):
+		}
+	})
+	srv := httptest.NewServer(engine)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	if _, _, err := conn.ReadMessage(); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	_ = conn.Close()
+
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Done to fire after client disconnect")
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/traces"
+)
+
+// handleTracesCompare godoc
+// @Summary      Compare two traces
+// @Description  Fetch two traces and return a structural diff: span counts, per-operation duration deltas (B - A, microseconds) and spans missing on either side
+// @Tags         traces
+// @Produce      json
+// @Security     SessionCookie
+// @Param        traceA  query     string  true  "Baseline trace ID"
+// @Param        traceB  query     string  true  "Trace ID to compare against the baseline"
+// @Success      200     {object}  TraceCompareResponse
+// @Failure      400     {object}  ErrorResponse  "traceA and traceB are required"
+// @Failure      404     {object}  ErrorResponse  "Trace not found"
+// @Failure      503     {object}  ErrorResponse  "Jaeger unavailable"
+// @Router       /traces/compare [get]
+func (s *Server) handleTracesCompare(c *gin.Context) {
+	if s.tracesClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Jaeger service unavailable"})
+		return
+	}
+
+	traceA, traceB := c.Query("traceA"), c.Query("traceB")
+	if traceA == "" || traceB == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid parameter: traceA and traceB are required"})
+		return
+	}
+
+	details := make([]*traces.TraceDetail, 0, 2)
+	for _, traceID := range []string{traceA, traceB} {
+		detail, err := s.tracesClient.GetTrace(c.Request.Context(), traceID)
+		if err != nil {
+			if errors.Is(err, traces.ErrTraceNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Trace not found", "traceId": traceID})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch from Jaeger", "details": err.Error()})
+			return
+		}
+		details = append(details, detail)
+	}
+
+	c.JSON(http.StatusOK, TraceCompareResponse{Status: "ok", TraceDiff: traces.CompareTraces(details[0], details[1])})
+}
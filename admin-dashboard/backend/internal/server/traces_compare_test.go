@@ -0,0 +1,65 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/traces"
+)
+
+func TestHandleTracesCompare(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jaeger := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceID := strings.TrimPrefix(r.URL.Path, "/api/traces/")
+		duration := map[string]int{"fast": 100, "slow": 400}[traceID]
+		if duration == 0 {
+			_, _ = w.Write([]byte(`{"data":[]}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[{"traceID":%q,"spans":[`+
+			`{"traceID":%q,"spanID":"1","operationName":"HandleMessage","startTime":1000,"duration":%d,"processID":"p1"}`+
+			`],"processes":{"p1":{"serviceName":"game-bot"}}}]}`, traceID, traceID, duration)
+	}))
+	defer jaeger.Close()
+
+	s := &Server{tracesClient: traces.NewClient(jaeger.URL, time.Second, slog.Default())}
+	call := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		s.handleTracesCompare(c)
+		return rec
+	}
+
+	rec := call("/admin/api/traces/compare?traceA=fast&traceB=slow")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		Status        string                 `json:"status"`
+		DurationDelta int64                  `json:"durationDelta"`
+		Operations    []traces.OperationDiff `json:"operations"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.Status != "ok" || resp.DurationDelta != 300 || len(resp.Operations) != 1 || resp.Operations[0].Service != "game-bot" {
+		t.Fatalf("unexpected response: %s", rec.Body.String())
+	}
+
+	if rec := call("/admin/api/traces/compare?traceA=fast"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without traceB, got %d", rec.Code)
+	}
+	if rec := call("/admin/api/traces/compare?traceA=fast&traceB=gone"); rec.Code != http.StatusNotFound || !strings.Contains(rec.Body.String(), "gone") {
+		t.Fatalf("expected 404 naming the missing trace, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
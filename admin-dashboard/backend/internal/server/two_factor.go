@@ -0,0 +1,269 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+)
+
+// setupTwoFactorRoutes: 본인 2단계 인증 관리 라우트와 admin 전용 초기화 라우트
+// 2단계 인증 필수 환경에서 미등록 세션은 /auth/2fa, /auth/2fa/setup, /auth/2fa/enable만 호출할 수 있습니다.
+func (s *Server) setupTwoFactorRoutes(authenticated *gin.RouterGroup) {
+	twoFactorGroup := authenticated.Group("/auth/2fa")
+	twoFactorGroup.GET("", s.handleTwoFactorStatus)
+	twoFactorGroup.POST("/setup", s.handleTwoFactorSetup)
+	twoFactorGroup.POST("/enable", s.handleTwoFactorEnable)
+	twoFactorGroup.POST("/disable", s.handleTwoFactorDisable)
+
+	authenticated.DELETE("/users/:username/2fa", auth.RequireRole(auth.RoleAdmin), s.handleTwoFactorReset)
+}
+
+// handleTwoFactorStatus godoc
+// @Summary      Two-factor status
+// @Description  Get TOTP two-factor status of the current user
+// @Tags         auth
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  TwoFactorStatusResponse
+// @Failure      503  {object}  ErrorResponse  "Two-factor store unavailable"
+// @Router       /auth/2fa [get]
+func (s *Server) handleTwoFactorStatus(c *gin.Context) {
+	status, err := s.twoFactor.Status(c.Request.Context(), c.GetString(auth.ContextKeyUsername))
+	if err != nil {
+		s.logger.Error("two_factor_status_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+	c.JSON(http.StatusOK, TwoFactorStatusResponse{
+		Status:                 "ok",
+		Enabled:                status.Enabled,
+		EnabledAt:              status.EnabledAt,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		Required:               s.cfg.Admin2FARequired,
+	})
+}
+
+// handleTwoFactorSetup godoc
+// @Summary      Start two-factor setup
+// @Description  Issue a new TOTP secret and otpauth URI (render as QR code). Confirm with /auth/2fa/enable within the expiry
+// @Tags         auth
+// @Produce      json
+// @Security     SessionCookie
+// @Success      200  {object}  TwoFactorSetupResponse
+// @Failure      409  {object}  ErrorResponse  "Two-factor already enabled"
+// @Failure      503  {object}  ErrorResponse  "Two-factor store unavailable"
+// @Router       /auth/2fa/setup [post]
+func (s *Server) handleTwoFactorSetup(c *gin.Context) {
+	ctx := c.Request.Context()
+	username := c.GetString(auth.ContextKeyUsername)
+
+	status, err := s.twoFactor.Status(ctx, username)
+	if err != nil {
+		s.logger.Error("two_factor_status_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+	// 기존 설정을 덮어쓰려면 먼저 해제해야 함 (세션 탈취만으로 인증 앱을 바꾸지 못하게)
+	if status.Enabled {
+		c.JSON(http.StatusConflict, gin.H{"error": "Two-factor already enabled, disable it first"})
+		return
+	}
+
+	setup, err := s.twoFactor.BeginSetup(ctx, username)
+	if err != nil {
+		s.logger.Error("two_factor_setup_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+	c.JSON(http.StatusOK, TwoFactorSetupResponse{
+		Status:     "ok",
+		Secret:     setup.Secret,
+		OTPAuthURL: setup.OTPAuthURL,
+		ExpiresIn:  int(auth.TwoFactorSetupTTL.Seconds()),
+	})
+}
+
+// handleTwoFactorEnable godoc
+// @Summary      Confirm two-factor setup
+// @Description  Verify a code from the authenticator app and enable two-factor login. Returns recovery codes once. A setup-only session (ADMIN_2FA_REQUIRED) is replaced by a full session
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      TwoFactorCodeRequest  true  "TOTP code"
+// @Success      200      {object}  TwoFactorEnableResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid code or setup expired"
+// @Failure      503      {object}  ErrorResponse  "Two-factor store unavailable"
+// @Router       /auth/2fa/enable [post]
+func (s *Server) handleTwoFactorEnable(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	username := c.GetString(auth.ContextKeyUsername)
+	codes, err := s.twoFactor.Enable(ctx, username, req.OTP)
+	switch {
+	case errors.Is(err, auth.ErrTwoFactorNotPending):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Two-factor setup expired, start again"})
+		return
+	case errors.Is(err, auth.ErrInvalidOTP):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid code"})
+		return
+	case err != nil:
+		s.logger.Error("two_factor_enable_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+	s.logger.Info("two_factor_enabled", slog.String("username", username))
+
+	// 등록 전용 세션이었다면 일반 세션으로 교체
+	sessionID := c.GetString(auth.ContextKeySessionID)
+	if session, getErr := s.sessions.GetSession(ctx, sessionID); getErr == nil && session != nil && session.TwoFactorPending {
+		newSession, createErr := s.sessions.CreateSession(ctx, session.Username, auth.SessionMeta{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		})
+		if createErr != nil {
+			s.logger.Error("Failed to create session", slog.Any("error", createErr))
+		} else {
+			s.sessions.DeleteSession(ctx, sessionID)
+			auth.SetSecureCookie(c, auth.SessionCookieName, auth.SignSessionID(newSession.ID, s.cfg.AdminSecretKey), 0, s.cfg.ForceHTTPS)
+		}
+	}
+
+	c.JSON(http.StatusOK, TwoFactorEnableResponse{Status: "ok", RecoveryCodes: codes})
+}
+
+// handleTwoFactorDisable godoc
+// @Summary      Disable two-factor
+// @Description  Disable two-factor login for the current user after verifying a TOTP or recovery code. Not allowed when ADMIN_2FA_REQUIRED is set
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     SessionCookie
+// @Param        request  body      TwoFactorCodeRequest  true  "TOTP or recovery code"
+// @Success      200      {object}  StatusResponse
+// @Failure      400      {object}  ErrorResponse  "Invalid code"
+// @Failure      409      {object}  ErrorResponse  "Two-factor is required"
+// @Failure      503      {object}  ErrorResponse  "Two-factor store unavailable"
+// @Router       /auth/2fa/disable [post]
+func (s *Server) handleTwoFactorDisable(c *gin.Context) {
+	if s.cfg.Admin2FARequired {
+		c.JSON(http.StatusConflict, gin.H{"error": "Two-factor is required for all accounts"})
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	username := c.GetString(auth.ContextKeyUsername)
+	if _, err := s.twoFactor.Verify(ctx, username, req.OTP); err != nil {
+		if errors.Is(err, auth.ErrInvalidOTP) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid code"})
+			return
+		}
+		s.logger.Error("two_factor_verify_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+	if err := s.twoFactor.Disable(ctx, username); err != nil {
+		s.logger.Error("two_factor_disable_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+
+	s.logger.Info("two_factor_disabled", slog.String("username", username))
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "Two-factor disabled"})
+}
+
+// handleTwoFactorReset godoc
+// @Summary      Reset user two-factor
+// @Description  Remove two-factor settings of another account, e.g. after losing the authenticator and recovery codes (admin only)
+// @Tags         users
+// @Produce      json
+// @Security     SessionCookie
+// @Param        username  path      string  true  "Username"
+// @Success      200       {object}  StatusResponse
+// @Failure      400       {object}  ErrorResponse  "Cannot reset yourself"
+// @Failure      404       {object}  ErrorResponse  "User not found"
+// @Failure      503       {object}  ErrorResponse  "Two-factor store unavailable"
+// @Router       /users/{username}/2fa [delete]
+func (s *Server) handleTwoFactorReset(c *gin.Context) {
+	ctx := c.Request.Context()
+	username := c.Param("username")
+	if strings.EqualFold(username, c.GetString(auth.ContextKeyUsername)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot reset your own two-factor, use disable instead"})
+		return
+	}
+
+	user, err := s.users.GetUser(ctx, username)
+	if err != nil {
+		s.logger.Error("user_get_failed", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load user"})
+		return
+	}
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	if err := s.twoFactor.Disable(ctx, user.Username); err != nil {
+		s.logger.Error("two_factor_reset_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return
+	}
+
+	s.logger.Info("two_factor_reset",
+		slog.String("username", user.Username),
+		slog.String("by", c.GetString(auth.ContextKeyUsername)),
+	)
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "Two-factor reset"})
+}
+
+// verifyLoginSecondFactor: 비밀번호 확인 후 2단계 인증을 처리합니다.
+// 응답을 이미 썼으면 ok=false. 2단계 인증이 필수인데 미등록이면 setupPending=true (등록 전용 세션 발급).
+func (s *Server) verifyLoginSecondFactor(c *gin.Context, ip string, user *auth.User, otp string) (setupPending bool, recoveryRemaining *int, ok bool) {
+	ctx := c.Request.Context()
+	status, err := s.twoFactor.Status(ctx, user.Username)
+	if err != nil {
+		s.logger.Error("two_factor_status_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return false, nil, false
+	}
+	if !status.Enabled {
+		return s.cfg.Admin2FARequired, nil, true
+	}
+
+	if strings.TrimSpace(otp) == "" {
+		c.JSON(http.StatusOK, gin.H{"success": false, "two_factor_required": true, "error": "Two-factor code required"})
+		return false, nil, false
+	}
+
+	usedRecovery, err := s.twoFactor.Verify(ctx, user.Username, otp)
+	if errors.Is(err, auth.ErrInvalidOTP) {
+		s.handleLoginFailure(c, ip, user.Username, "invalid_otp")
+		return false, nil, false
+	}
+	if err != nil {
+		s.logger.Error("two_factor_verify_failed", slog.Any("error", err))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Two-factor store unavailable"})
+		return false, nil, false
+	}
+	if usedRecovery {
+		remaining := max(status.RecoveryCodesRemaining-1, 0)
+		return false, &remaining, true
+	}
+	return false, nil, true
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/config"
+)
+
+type fakeUsers struct {
+	auth.UserStore
+	user auth.User
+}
+
+func (f *fakeUsers) GetUser(_ context.Context, username string) (*auth.User, error) {
+	if !strings.EqualFold(username, f.user.Username) {
+		return nil, nil
+	}
+	user := f.user
+	return &user, nil
+}
+
+type fakeTwoFactor struct {
+	auth.TwoFactorStore
+	enabled bool
+	code    string
+}
+
+func (f *fakeTwoFactor) Status(context.Context, string) (auth.TwoFactorStatus, error) {
+	return auth.TwoFactorStatus{Enabled: f.enabled, RecoveryCodesRemaining: 10}, nil
+}
+
+func (f *fakeTwoFactor) Verify(_ context.Context, _, code string) (bool, error) {
+	if code != f.code {
+		return false, auth.ErrInvalidOTP
+	}
+	return false, nil
+}
+
+type recordingSessions struct {
+	fakeSessions
+	created []auth.SessionMeta
+}
+
+func (r *recordingSessions) CreateSession(_ context.Context, username string, meta auth.SessionMeta) (*auth.Session, error) {
+	r.created = append(r.created, meta)
+	return &auth.Session{ID: "new-session", Username: username}, nil
+}
+
+func TestLogin_TwoFactor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	hash, err := bcrypt.GenerateFromPassword([]byte("pw"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt: %v", err)
+	}
+
+	newServer := func(required bool, twoFactor *fakeTwoFactor) (*Server, *recordingSessions) {
+		sessions := &recordingSessions{}
+		return &Server{
+			cfg:         &config.Config{AdminSecretKey: "secret", Admin2FARequired: required},
+			logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+			sessions:    sessions,
+			users:       &fakeUsers{user: auth.User{Username: "alice", PasswordHash: string(hash), Role: auth.RoleAdmin}},
+			twoFactor:   twoFactor,
+			rateLimiter: auth.NewLoginRateLimiter(),
+		}, sessions
+	}
+	login := func(s *Server, body string) map[string]any {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/admin/api/auth/login", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		s.handleLogin(c)
+		var resp map[string]any
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response: %v (%s)", err, w.Body.String())
+		}
+		return resp
+	}
+
+	s, sessions := newServer(false, &fakeTwoFactor{enabled: true, code: "123456"})
+	if resp := login(s, `{"username":"alice","password":"pw"}`); resp["two_factor_required"] != true || len(sessions.created) != 0 {
+		t.Fatalf("expected otp prompt without session, got %v", resp)
+	}
+	if resp := login(s, `{"username":"alice","password":"pw","otp":"123456"}`); resp["status"] != "ok" || len(sessions.created) != 1 || sessions.created[0].TwoFactorPending {
+		t.Fatalf("expected full session, got %v", resp)
+	}
+
+	s, sessions = newServer(true, &fakeTwoFactor{})
+	resp := login(s, `{"username":"alice","password":"pw"}`)
+	if resp["two_factor_setup_required"] != true || len(sessions.created) != 1 || !sessions.created[0].TwoFactorPending {
+		t.Fatalf("expected setup-only session when 2FA is required, got %v", resp)
+	}
+}
@@ -1,6 +1,25 @@
 // Package server: HTTP 서버 요청/응답 타입 정의
 package server
 
+import (
+	"time"
+
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/alerts"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/audit"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/auth"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/botconfig"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/drift"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/inbox"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/latency"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/logs"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/maintenance"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/metrics"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/probe"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/status"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/traces"
+)
+
 // ===== Common Types =====
 
 // ErrorResponse: 공통 에러 응답
@@ -21,12 +40,46 @@ type StatusResponse struct {
 type LoginRequest struct {
 	Username string `json:"username" binding:"required" example:"admin"`
 	Password string `json:"password" binding:"required" example:"password123"`
+	OTP      string `json:"otp,omitempty" example:"123456"` // 2단계 인증 코드 또는 복구 코드
 }
 
 // LoginResponse: 로그인 응답
 type LoginResponse struct {
-	Status  string `json:"status" example:"ok"`
-	Message string `json:"message" example:"Login successful"`
+	Status                 string `json:"status" example:"ok"`
+	Message                string `json:"message" example:"Login successful"`
+	Username               string `json:"username" example:"admin"`
+	Role                   string `json:"role" example:"admin"`
+	TwoFactorRequired      bool   `json:"two_factor_required,omitempty" example:"false"`       // 코드 없이 요청해 OTP 입력이 필요함 (세션 미발급)
+	TwoFactorSetupRequired bool   `json:"two_factor_setup_required,omitempty" example:"false"` // 등록 전까지 2FA 등록 API만 사용 가능
+	RecoveryCodesRemaining *int   `json:"recovery_codes_remaining,omitempty" example:"9"`      // 복구 코드로 로그인한 경우
+}
+
+// TwoFactorCodeRequest: TOTP 코드 확인 요청 (등록 확인, 해제)
+type TwoFactorCodeRequest struct {
+	OTP string `json:"otp" binding:"required" example:"123456"`
+}
+
+// TwoFactorStatusResponse: 2단계 인증 상태 응답
+type TwoFactorStatusResponse struct {
+	Status                 string     `json:"status" example:"ok"`
+	Enabled                bool       `json:"enabled" example:"true"`
+	EnabledAt              *time.Time `json:"enabledAt,omitempty"`
+	RecoveryCodesRemaining int        `json:"recoveryCodesRemaining" example:"10"`
+	Required               bool       `json:"required" example:"false"` // ADMIN_2FA_REQUIRED
+}
+
+// TwoFactorSetupResponse: 등록 시작 응답 (otpauthUrl을 QR 코드로 표시)
+type TwoFactorSetupResponse struct {
+	Status     string `json:"status" example:"ok"`
+	Secret     string `json:"secret" example:"JBSWY3DPEHPK3PXP"`
+	OTPAuthURL string `json:"otpauthUrl" example:"otpauth://totp/LLM%20Bot%20Admin:admin?secret=JBSWY3DPEHPK3PXP"`
+	ExpiresIn  int    `json:"expiresIn" example:"600"` // 비밀키 확인 제한 시간 (초)
+}
+
+// TwoFactorEnableResponse: 등록 완료 응답 (복구 코드는 이때 한 번만 표시)
+type TwoFactorEnableResponse struct {
+	Status        string   `json:"status" example:"ok"`
+	RecoveryCodes []string `json:"recoveryCodes"`
 }
 
 // HeartbeatRequest: 하트비트 요청
@@ -42,6 +95,364 @@ type HeartbeatResponse struct {
 	IdleRejected      bool   `json:"idle_rejected,omitempty" example:"false"`
 }
 
+// ===== User Types =====
+
+// CurrentUserResponse: 현재 로그인 사용자 응답
+type CurrentUserResponse struct {
+	Status   string `json:"status" example:"ok"`
+	Username string `json:"username" example:"admin"`
+	Role     string `json:"role" example:"admin"`
+}
+
+// UserInfo: 계정 정보 (비밀번호 해시 제외)
+type UserInfo struct {
+	Username  string    `json:"username" example:"operator1"`
+	Role      auth.Role `json:"role" swaggertype:"string" example:"operator"`
+	Disabled  bool      `json:"disabled" example:"false"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func newUserInfo(user auth.User) UserInfo {
+	return UserInfo{
+		Username:  user.Username,
+		Role:      user.Role,
+		Disabled:  user.Disabled,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
+
+// UserListResponse: 계정 목록 응답
+type UserListResponse struct {
+	Status string     `json:"status" example:"ok"`
+	Users  []UserInfo `json:"users"`
+}
+
+// UserResponse: 단일 계정 응답
+type UserResponse struct {
+	Status string   `json:"status" example:"ok"`
+	User   UserInfo `json:"user"`
+}
+
+// SessionInfo: 활성 관리자 세션 정보 (세션 ID 원문은 노출하지 않음)
+type SessionInfo struct {
+	ID         string    `json:"id" example:"3f2a9c1d0b7e4a55"` // 폐기 API에 쓰는 세션 핸들
+	IDPrefix   string    `json:"idPrefix" example:"a1b2c3d4..."`
+	Username   string    `json:"username" example:"operator1"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	IP         string    `json:"ip,omitempty" example:"10.0.0.5"`
+	UserAgent  string    `json:"userAgent,omitempty" example:"Mozilla/5.0"`
+	Current    bool      `json:"current" example:"false"` // 요청한 세션 자신인지 여부
+}
+
+func newSessionInfo(session auth.Session, currentSessionID string) SessionInfo {
+	lastSeen := session.LastSeenAt
+	if lastSeen.IsZero() {
+		lastSeen = session.CreatedAt
+	}
+	return SessionInfo{
+		ID:         auth.SessionHandle(session.ID),
+		IDPrefix:   auth.SessionIDPrefix(session.ID),
+		Username:   session.Username,
+		CreatedAt:  session.CreatedAt,
+		LastSeenAt: lastSeen,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		Current:    session.ID == currentSessionID,
+	}
+}
+
+// SessionListResponse: 활성 세션 목록 응답
+type SessionListResponse struct {
+	Status   string        `json:"status" example:"ok"`
+	Sessions []SessionInfo `json:"sessions"`
+}
+
+// CreateUserRequest: 계정 생성 요청
+type CreateUserRequest struct {
+	Username string `json:"username" binding:"required" example:"operator1"`
+	Password string `json:"password" binding:"required" example:"change-me-please"`
+	Role     string `json:"role" binding:"required" example:"operator" enums:"viewer,operator,admin"`
+}
+
+// UpdateUserRequest: 계정 수정 요청 (지정한 필드만 변경)
+type UpdateUserRequest struct {
+	Password *string `json:"password,omitempty" example:"new-password"`
+	Role     *string `json:"role,omitempty" example:"viewer" enums:"viewer,operator,admin"`
+	Disabled *bool   `json:"disabled,omitempty" example:"false"`
+}
+
+// ===== Drift Types =====
+
+// DriftEventsResponse: 드리프트 이벤트 목록 응답
+type DriftEventsResponse struct {
+	Status string        `json:"status" example:"ok"`
+	Events []drift.Event `json:"events"`
+}
+
+// DriftSnapshotsResponse: 컨테이너 스냅샷 목록 응답
+type DriftSnapshotsResponse struct {
+	Status    string           `json:"status" example:"ok"`
+	Snapshots []drift.Snapshot `json:"snapshots"`
+}
+
+// RecordDeployRequest: 배포 기록 요청
+type RecordDeployRequest struct {
+	Containers []string `json:"containers" binding:"required" example:"twentyq-bot"`
+	Note       string   `json:"note,omitempty" example:"v1.4.2 release"`
+}
+
+// RecordDeployResponse: 배포 기록 응답
+type RecordDeployResponse struct {
+	Status  string         `json:"status" example:"ok"`
+	Deploys []drift.Deploy `json:"deploys"`
+}
+
+// ===== Inbox Types =====
+
+// InboxListResponse: 인박스 항목 목록 응답 (우선순위 내림차순)
+type InboxListResponse struct {
+	Status      string       `json:"status" example:"ok"`
+	Items       []inbox.Item `json:"items"`
+	Total       int          `json:"total" example:"12"`
+	Unavailable []string     `json:"unavailable,omitempty" example:"supervision"`
+}
+
+// InboxCountsResponse: 분류별 미해결 항목 수 응답
+type InboxCountsResponse struct {
+	Status      string       `json:"status" example:"ok"`
+	Counts      inbox.Counts `json:"counts"`
+	Unavailable []string     `json:"unavailable,omitempty" example:"supervision"`
+}
+
+// InboxReportRequest: 신고 항목 등록 요청
+type InboxReportRequest struct {
+	Title    string `json:"title" binding:"required" example:"욕설 반복 사용자"`
+	Detail   string `json:"detail,omitempty" example:"방 A에서 질문으로 욕설 반복"`
+	Target   string `json:"target,omitempty" example:"chat-123"`
+	Severity string `json:"severity,omitempty" example:"medium"`
+}
+
+// InboxAssignRequest: 담당자 지정 요청 (빈 값이면 담당 해제)
+type InboxAssignRequest struct {
+	Assignee string `json:"assignee" example:"operator1"`
+}
+
+// InboxStateRequest: 상태 전이 요청
+type InboxStateRequest struct {
+	State string `json:"state" binding:"required" example:"resolved"`
+	Note  string `json:"note,omitempty" example:"false positive"`
+}
+
+// InboxItemResponse: 단일 인박스 항목 응답
+type InboxItemResponse struct {
+	Status string     `json:"status" example:"ok"`
+	Item   inbox.Item `json:"item"`
+}
+
+// ===== Probe Types =====
+
+// ProbeRequest: 프로브 정의 요청
+type ProbeRequest struct {
+	ID              string `json:"id" example:"twentyq-health"`
+	Name            string `json:"name,omitempty" example:"스무고개 헬스"`
+	Target          string `json:"target" binding:"required" example:"twentyq" enums:"holo,twentyq,turtle,llm"`
+	Method          string `json:"method,omitempty" example:"GET" enums:"GET,HEAD"`
+	Path            string `json:"path" binding:"required" example:"/health"`
+	ExpectedStatus  int    `json:"expectedStatus,omitempty" example:"200"`
+	JSONPath        string `json:"jsonPath,omitempty" example:"status"`
+	ExpectedValue   string `json:"expectedValue,omitempty" example:"ok"`
+	IntervalSeconds int    `json:"intervalSeconds" binding:"required" example:"60"`
+	TimeoutSeconds  int    `json:"timeoutSeconds,omitempty" example:"5"`
+	Enabled         *bool  `json:"enabled,omitempty" example:"true"`
+}
+
+func (r ProbeRequest) toProbe() probe.Probe {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return probe.Probe{
+		ID:              r.ID,
+		Name:            r.Name,
+		Target:          r.Target,
+		Method:          r.Method,
+		Path:            r.Path,
+		ExpectedStatus:  r.ExpectedStatus,
+		JSONPath:        r.JSONPath,
+		ExpectedValue:   r.ExpectedValue,
+		IntervalSeconds: r.IntervalSeconds,
+		TimeoutSeconds:  r.TimeoutSeconds,
+		Enabled:         enabled,
+	}
+}
+
+// ProbeListResponse: 프로브 요약 목록 응답
+type ProbeListResponse struct {
+	Status  string          `json:"status" example:"ok"`
+	Probes  []probe.Summary `json:"probes"`
+	Targets []string        `json:"targets" example:"twentyq"`
+}
+
+// ProbeDetailResponse: 프로브 요약과 최근 실행 이력 응답
+type ProbeDetailResponse struct {
+	Status  string         `json:"status" example:"ok"`
+	Summary probe.Summary  `json:"summary"`
+	Results []probe.Result `json:"results"`
+}
+
+// ProbeResponse: 단일 프로브 정의 응답
+type ProbeResponse struct {
+	Status string      `json:"status" example:"ok"`
+	Probe  probe.Probe `json:"probe"`
+}
+
+// ProbeRunResponse: 수동 실행 결과 응답
+type ProbeRunResponse struct {
+	Status string       `json:"status" example:"ok"`
+	Result probe.Result `json:"result"`
+}
+
+// ===== Alert Types =====
+
+// AlertRuleRequest: 알림 규칙 정의 요청
+type AlertRuleRequest struct {
+	ID            string  `json:"id" example:"twentyq-down"`
+	Name          string  `json:"name,omitempty" example:"스무고개 봇 다운"`
+	Type          string  `json:"type" binding:"required" example:"service_down" enums:"service_down,error_rate,restart_loop"`
+	Target        string  `json:"target" binding:"required" example:"twentyq-bot"`
+	Threshold     float64 `json:"threshold,omitempty" example:"0.05"`
+	ForMinutes    int     `json:"forMinutes,omitempty" example:"5"`
+	WindowMinutes int     `json:"windowMinutes,omitempty" example:"10"`
+	Enabled       *bool   `json:"enabled,omitempty" example:"true"`
+}
+
+func (r AlertRuleRequest) toRule() alerts.Rule {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return alerts.Rule{
+		ID:            r.ID,
+		Name:          r.Name,
+		Type:          alerts.RuleType(r.Type),
+		Target:        r.Target,
+		Threshold:     r.Threshold,
+		ForMinutes:    r.ForMinutes,
+		WindowMinutes: r.WindowMinutes,
+		Enabled:       enabled,
+	}
+}
+
+// AlertListResponse: 발생 중 알림과 최근 발생/해소 이력 응답
+type AlertListResponse struct {
+	Status  string         `json:"status" example:"ok"`
+	Active  []alerts.Alert `json:"active"`
+	History []alerts.Alert `json:"history"`
+}
+
+// AlertRuleListResponse: 알림 규칙 목록 응답
+type AlertRuleListResponse struct {
+	Status string        `json:"status" example:"ok"`
+	Rules  []alerts.Rule `json:"rules"`
+}
+
+// AlertRuleResponse: 단일 알림 규칙 응답
+type AlertRuleResponse struct {
+	Status string      `json:"status" example:"ok"`
+	Rule   alerts.Rule `json:"rule"`
+}
+
+// AggregatedStatusResponse: 통합 시스템 상태 + 프로브 요약 응답
+type AggregatedStatusResponse struct {
+	status.AggregatedStatus
+	Probes []probe.Summary `json:"probes,omitempty"`
+	// Maintenance: 설정된 점검 공지 (없으면 생략)
+	Maintenance *maintenance.Announcement `json:"maintenance,omitempty"`
+}
+
+// StatusHistoryResponse: 서비스별 가동률/응답 시간 이력 요약 응답
+type StatusHistoryResponse struct {
+	Status   string                  `json:"status" example:"ok"`
+	Range    string                  `json:"range" example:"24h"`
+	Services []status.HistorySummary `json:"services"`
+}
+
+// ===== Metrics Query Types =====
+
+// MetricsTargetsResponse: 메트릭 수집 대상 상태 응답
+type MetricsTargetsResponse struct {
+	Status          string                 `json:"status" example:"ok"`
+	IntervalSeconds int                    `json:"intervalSeconds" example:"15"`
+	Targets         []metrics.TargetStatus `json:"targets"`
+}
+
+// MetricsQueryResponse: 메트릭 범위 조회 응답
+type MetricsQueryResponse struct {
+	Status      string                 `json:"status" example:"ok"`
+	Target      string                 `json:"target" example:"llm"`
+	Metric      string                 `json:"metric" example:"http_requests_total"`
+	Fn          string                 `json:"fn" example:"rate"`
+	Start       int64                  `json:"start" example:"1767225600"`
+	End         int64                  `json:"end" example:"1767226500"`
+	StepSeconds int                    `json:"stepSeconds" example:"15"`
+	Series      []metrics.SeriesResult `json:"series"`
+}
+
+// ===== Audit Types =====
+
+// AuditListResponse: 감사 로그 조회 응답
+type AuditListResponse struct {
+	Status  string        `json:"status" example:"ok"`
+	Entries []audit.Entry `json:"entries"`
+	Total   int           `json:"total" example:"120"`
+	Limit   int           `json:"limit" example:"50"`
+	Offset  int           `json:"offset" example:"0"`
+}
+
+// ===== Latency Types =====
+
+// LatencyReportResponse: 봇 명령어 응답 지연 리포트 응답
+type LatencyReportResponse struct {
+	Status string `json:"status" example:"ok"`
+	latency.Report
+}
+
+// ===== Bot Config Types =====
+
+// BotConfigServicesResponse: 설정 관리 대상 봇 목록 응답
+type BotConfigServicesResponse struct {
+	Status   string   `json:"status" example:"ok"`
+	Services []string `json:"services" example:"holo,twentyq"`
+}
+
+// BotConfigResponse: 봇 유효 설정 응답
+type BotConfigResponse struct {
+	Status string `json:"status" example:"ok"`
+	botconfig.Effective
+}
+
+// BotConfigUpdateRequest: 봇 설정 변경 요청 (값이 null/빈 문자열이면 오버라이드 제거)
+type BotConfigUpdateRequest struct {
+	Values map[string]*string `json:"values" binding:"required"`
+	Note   string             `json:"note" example:"weekend event"`
+}
+
+// BotConfigUpdateResponse: 봇 설정 변경 응답
+type BotConfigUpdateResponse struct {
+	Status    string              `json:"status" example:"ok"`
+	Change    botconfig.Change    `json:"change"`
+	Effective botconfig.Effective `json:"effective"`
+}
+
+// BotConfigHistoryResponse: 봇 설정 변경 이력 응답
+type BotConfigHistoryResponse struct {
+	Status  string             `json:"status" example:"ok"`
+	Changes []botconfig.Change `json:"changes"`
+}
+
 // ===== Docker Types =====
 
 // DockerHealthResponse: Docker 헬스 응답
@@ -74,6 +485,43 @@ type ContainerListResponse struct {
 	Containers []ContainerInfo `json:"containers"`
 }
 
+// DockerGroupResponse: compose 프로젝트 작업 순서 미리보기 응답
+type DockerGroupResponse struct {
+	Status  string                 `json:"status" example:"ok"`
+	Project string                 `json:"project" example:"llm-bot"`
+	Stages  [][]docker.GroupMember `json:"stages"` // 재시작 순서 (중지는 역순)
+}
+
+// DockerGroupJobResponse: compose 프로젝트 일괄 작업 시작 응답
+type DockerGroupJobResponse struct {
+	Status string           `json:"status" example:"ok"`
+	Job    *docker.GroupJob `json:"job"`
+}
+
+// DockerPruneResponse: 종료된 일회성 컨테이너 정리 결과 응답
+type DockerPruneResponse struct {
+	Status string             `json:"status" example:"ok"`
+	Result docker.PruneResult `json:"result"`
+}
+
+// DockerImageUpdatesResponse: 컨테이너 이미지 업데이트 확인 결과 응답
+type DockerImageUpdatesResponse struct {
+	Status string                   `json:"status" example:"ok"`
+	Result docker.ImageUpdateResult `json:"result"`
+}
+
+// DockerContainerUpdateResponse: 컨테이너 이미지 업데이트(pull + 재생성) 결과 응답
+type DockerContainerUpdateResponse struct {
+	Status string                       `json:"status" example:"ok"`
+	Result docker.ContainerUpdateResult `json:"result"`
+}
+
+// DockerInspectResponse: 컨테이너 설정 조회 응답 (민감한 환경 변수 값 제외)
+type DockerInspectResponse struct {
+	Status    string                  `json:"status" example:"ok"`
+	Container docker.ContainerInspect `json:"container"`
+}
+
 // ===== Logs Types =====
 
 // LogFile: 로그 파일 정보
@@ -97,6 +545,14 @@ type SystemLogsResponse struct {
 	Count  int      `json:"count" example:"100"`
 }
 
+// LogSearchResponse: 로그 검색 응답 (NDJSON 스트리밍 시에는 match 줄 다음에 요약 줄 하나)
+type LogSearchResponse struct {
+	Status  string             `json:"status" example:"ok"`
+	File    string             `json:"file" example:"combined"`
+	Matches []logs.SearchMatch `json:"matches"`
+	logs.SearchSummary
+}
+
 // ===== Traces Types =====
 // 참조: internal/traces/types.go (TraceSummary, TraceDetail 등)
 
@@ -136,6 +592,12 @@ type TraceDetailResponse struct {
 	Processes map[string]any `json:"processes"`
 }
 
+// TraceCompareResponse: 트레이스 비교 응답
+type TraceCompareResponse struct {
+	Status string `json:"status" example:"ok"`
+	*traces.TraceDiff
+}
+
 // DependenciesResponse: 의존성 응답
 type DependenciesResponse struct {
 	Status       string `json:"status" example:"ok"`
@@ -153,3 +615,18 @@ type MetricsResponse struct {
 	Calls      []any  `json:"calls,omitempty"`
 	Errors     []any  `json:"errors,omitempty"`
 }
+
+// ===== Maintenance Types =====
+
+// MaintenanceRequest: 점검 공지 설정 요청
+type MaintenanceRequest struct {
+	Message  string     `json:"message" binding:"required" example:"DB 점검으로 게임 봇이 10분간 응답하지 않습니다"`
+	Severity string     `json:"severity,omitempty" example:"warning" enums:"info,warning,critical"`
+	ETA      *time.Time `json:"eta,omitempty" example:"2026-10-18T13:00:00Z"`
+}
+
+// MaintenanceResponse: 현재 점검 공지 응답 (공지가 없으면 maintenance가 null)
+type MaintenanceResponse struct {
+	Status      string                    `json:"status" example:"ok"`
+	Maintenance *maintenance.Announcement `json:"maintenance"`
+}
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/docker"
+	"github.com/park285/llm-kakao-bots/admin-dashboard/internal/maintenance"
 )
 
 // Config: SSR 서빙 설정
@@ -48,15 +49,23 @@ type SSRData struct {
 	// holo-specific 데이터는 프록시를 통해 가져옴
 	Members  json.RawMessage `json:"members,omitempty"`
 	Settings json.RawMessage `json:"settings,omitempty"`
+	// Maintenance: 점검 공지 배너 (로그인 화면에서도 보이도록 인증과 관계없이 주입)
+	Maintenance *maintenance.Announcement `json:"maintenance,omitempty"`
+}
+
+// MaintenanceSource: 현재 점검 공지 제공자 (maintenance.Service)
+type MaintenanceSource interface {
+	Current(ctx context.Context) (*maintenance.Announcement, error)
 }
 
 // Injector: SSR 데이터를 HTML에 주입하는 서비스
 type Injector struct {
-	dockerSvc  *docker.Service
-	holoBotURL string
-	htmlCache  []byte
-	httpClient *http.Client
-	logger     *slog.Logger
+	dockerSvc   *docker.Service
+	maintenance MaintenanceSource
+	holoBotURL  string
+	htmlCache   []byte
+	httpClient  *http.Client
+	logger      *slog.Logger
 }
 
 // NewInjector: 새로운 SSR 데이터 인젝터 생성
@@ -76,6 +85,11 @@ func NewInjector(
 	}
 }
 
+// SetMaintenance: 점검 공지 제공자 설정 (nil이면 배너를 주입하지 않음)
+func (s *Injector) SetMaintenance(source MaintenanceSource) {
+	s.maintenance = source
+}
+
 // LoadHTMLCache: 파일 시스템에서 index.html 파일을 캐시 (개발 모드용)
 func (s *Injector) LoadHTMLCache(indexPath string) error {
 	htmlData, err := os.ReadFile(indexPath)
@@ -110,18 +124,29 @@ func (s *Injector) InjectForPath(ctx context.Context, path string, isAuthenticat
 		return nil, nil
 	}
 
-	// 인증되지 않은 사용자에게는 SSR 데이터 주입 안함
+	notice := s.currentMaintenance(ctx)
+
+	// 인증되지 않은 사용자에게는 점검 공지 외의 SSR 데이터 주입 안함
 	if !isAuthenticated {
-		return s.htmlCache, nil
+		if notice == nil {
+			return s.htmlCache, nil
+		}
+		return s.injectData(&SSRData{Maintenance: notice})
 	}
 
 	// SSR 대상 경로 확인 및 데이터 프리페칭
 	ssrData, err := s.fetchDataForPath(ctx, path, sessionCookie)
 	if err != nil {
 		s.logger.Warn("SSR data fetch failed", slog.String("path", path), slog.Any("error", err))
-		return s.htmlCache, nil
+		ssrData = nil
 	}
 
+	if notice != nil {
+		if ssrData == nil {
+			ssrData = &SSRData{}
+		}
+		ssrData.Maintenance = notice
+	}
 	if ssrData == nil {
 		return s.htmlCache, nil
 	}
@@ -129,6 +154,19 @@ func (s *Injector) InjectForPath(ctx context.Context, path string, isAuthenticat
 	return s.injectData(ssrData)
 }
 
+// currentMaintenance: 현재 점검 공지 (조회 실패 시 배너 없이 페이지를 서빙)
+func (s *Injector) currentMaintenance(ctx context.Context) *maintenance.Announcement {
+	if s.maintenance == nil {
+		return nil
+	}
+	notice, err := s.maintenance.Current(ctx)
+	if err != nil {
+		s.logger.Warn("SSR maintenance fetch failed", slog.Any("error", err))
+		return nil
+	}
+	return notice
+}
+
 // fetchDataForPath: 경로에 맞는 데이터를 프리페칭
 func (s *Injector) fetchDataForPath(ctx context.Context, path string, sessionCookie string) (*SSRData, error) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -0,0 +1,133 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+)
+
+// tcpCheckTimeout: 인프라 노드(TCP) 연결 확인 제한 시간
+const tcpCheckTimeout = 2 * time.Second
+
+// Outage: 근본 원인 서비스 하나와 그 때문에 영향을 받는 서비스 묶음
+type Outage struct {
+	RootCause string   `json:"rootCause"`
+	Status    string   `json:"status"` // down | degraded
+	Impacted  []string `json:"impacted,omitempty"`
+	Summary   string   `json:"summary"`
+}
+
+// serviceState: 의존성 반영 전 서비스 자체 상태 (ok | degraded | down)
+func serviceState(svc ServiceStatus) string {
+	switch {
+	case !svc.Available:
+		return SampleDown
+	case svc.Health == "degraded":
+		return SampleDegraded
+	default:
+		return "ok"
+	}
+}
+
+// fetchTCPStatus: 헬스 API가 없는 인프라 노드는 TCP 연결 성공 여부로만 판단한다.
+func (c *Collector) fetchTCPStatus(ctx context.Context, endpoint ServiceEndpoint) ServiceStatus {
+	status := ServiceStatus{Name: endpoint.Name}
+
+	dialer := net.Dialer{Timeout: tcpCheckTimeout}
+	started := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint.TCPAddr)
+	status.LatencyMs = time.Since(started).Milliseconds()
+	if err != nil {
+		return status
+	}
+	_ = conn.Close()
+	status.Available = true
+	return status
+}
+
+// attributeRootCauses: 선언된 의존 그래프를 따라 문제 서비스의 근본 원인을 찾아 표시하고 장애 묶음을 반환한다.
+// 자신은 정상이지만 의존 서비스가 문제인 서비스는 degraded로 바꾼다. (예: LLM 서버가 죽으면 게임 봇도 degraded)
+func attributeRootCauses(services []ServiceStatus, endpoints []ServiceEndpoint) []Outage {
+	states := make(map[string]string, len(services))
+	for _, svc := range services {
+		states[svc.Name] = serviceState(svc)
+	}
+	deps := make(map[string][]string, len(endpoints))
+	for _, ep := range endpoints {
+		for _, dep := range ep.DependsOn {
+			// 수집 대상에 없는 의존성(주소 미설정 인프라 등)은 판단할 수 없으므로 무시
+			if _, ok := states[dep]; ok {
+				deps[ep.Name] = append(deps[ep.Name], dep)
+			}
+		}
+	}
+
+	impacted := make(map[string][]string)
+	for i := range services {
+		svc := &services[i]
+		svc.DependsOn = deps[svc.Name]
+		roots := rootCausesOf(svc.Name, deps, states, map[string]bool{svc.Name: true})
+		if len(roots) == 0 {
+			continue
+		}
+		svc.RootCauses = roots
+		own := states[svc.Name]
+		if own == "ok" {
+			svc.Health = SampleDegraded
+			own = SampleDegraded
+		}
+		svc.Cause = own + " because " + describeRoots(roots, states)
+		for _, root := range roots {
+			impacted[root] = append(impacted[root], svc.Name)
+		}
+	}
+
+	var outages []Outage
+	for _, svc := range services {
+		state := states[svc.Name]
+		if state == "ok" || len(svc.RootCauses) > 0 {
+			continue
+		}
+		outage := Outage{RootCause: svc.Name, Status: state, Impacted: impacted[svc.Name]}
+		outage.Summary = svc.Name + " is " + state
+		if len(outage.Impacted) > 0 {
+			outage.Summary += fmt.Sprintf(", affecting %s", strings.Join(outage.Impacted, ", "))
+		}
+		outages = append(outages, outage)
+	}
+	return outages
+}
+
+// rootCausesOf: 의존 서비스를 끝까지 따라가 문제의 가장 깊은 원인을 모은다.
+// 문제 있는 의존 서비스 아래에 또 문제가 있으면 더 아래쪽을 원인으로 본다. (순환 의존은 visited로 끊는다)
+func rootCausesOf(name string, deps map[string][]string, states map[string]string, visited map[string]bool) []string {
+	var roots []string
+	for _, dep := range deps[name] {
+		if visited[dep] {
+			continue
+		}
+		visited[dep] = true
+		deeper := rootCausesOf(dep, deps, states, visited)
+		delete(visited, dep)
+
+		switch {
+		case len(deeper) > 0:
+			roots = append(roots, deeper...)
+		case states[dep] != "ok":
+			roots = append(roots, dep)
+		}
+	}
+	slices.Sort(roots)
+	return slices.Compact(roots)
+}
+
+func describeRoots(roots []string, states map[string]string) string {
+	parts := make([]string, len(roots))
+	for i, root := range roots {
+		parts[i] = root + " is " + states[root]
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,85 @@
+package status
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"slices"
+	"testing"
+)
+
+func TestAttributeRootCauses_FollowsChainToDeepestFailure(t *testing.T) {
+	endpoints := []ServiceEndpoint{
+		{Name: "twentyq", DependsOn: []string{"llm", "valkey"}},
+		{Name: "holo", DependsOn: []string{"valkey", "postgres"}},
+		{Name: "llm", DependsOn: []string{"valkey"}},
+		{Name: "valkey"},
+	}
+	services := []ServiceStatus{
+		{Name: "twentyq", Available: true, Health: "ok"},
+		{Name: "holo", Available: false},
+		{Name: "llm", Available: false},
+		{Name: "valkey", Available: false},
+	}
+
+	outages := attributeRootCauses(services, endpoints)
+
+	if got := services[0]; got.Health != SampleDegraded || !slices.Equal(got.RootCauses, []string{"valkey"}) ||
+		got.Cause != "degraded because valkey is down" {
+		t.Fatalf("twentyq should be degraded by valkey: %+v", got)
+	}
+	// 수집 대상이 아닌 의존성(postgres)은 무시
+	if got := services[1]; !slices.Equal(got.DependsOn, []string{"valkey"}) || got.Cause != "down because valkey is down" {
+		t.Fatalf("unexpected holo attribution: %+v", got)
+	}
+	if len(outages) != 1 || outages[0].RootCause != "valkey" || outages[0].Summary != "valkey is down, affecting twentyq, holo, llm" {
+		t.Fatalf("expected single valkey outage, got %+v", outages)
+	}
+}
+
+func TestAttributeRootCauses_CycleAndHealthy(t *testing.T) {
+	endpoints := []ServiceEndpoint{
+		{Name: "a", DependsOn: []string{"b"}},
+		{Name: "b", DependsOn: []string{"a"}},
+	}
+	services := []ServiceStatus{
+		{Name: "a", Available: true, Health: "ok"},
+		{Name: "b", Available: true, Health: "ok"},
+	}
+	if outages := attributeRootCauses(services, endpoints); outages != nil || services[0].Cause != "" {
+		t.Fatalf("healthy graph should report nothing: %+v %+v", outages, services)
+	}
+
+	services[1].Available = false
+	outages := attributeRootCauses(services, endpoints)
+	if !slices.Equal(services[0].RootCauses, []string{"b"}) || len(services[1].RootCauses) != 0 {
+		t.Fatalf("cycle should stop at b: %+v", services)
+	}
+	if len(outages) != 1 || outages[0].RootCause != "b" || !slices.Equal(outages[0].Impacted, []string{"a"}) {
+		t.Fatalf("unexpected outages: %+v", outages)
+	}
+}
+
+func TestCollector_TCPNodeStatus(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+
+	collector := NewCollector([]ServiceEndpoint{
+		{Name: "valkey", TCPAddr: addr},
+		{Name: "bot", TCPAddr: addr, DependsOn: []string{"valkey"}},
+	}, "test", slog.Default())
+
+	services, outages := collector.fetchAllServiceStatus(context.Background())
+	if !services[0].Available || len(outages) != 0 {
+		t.Fatalf("listening node should be up: %+v %+v", services, outages)
+	}
+
+	_ = ln.Close()
+	services, outages = collector.fetchAllServiceStatus(context.Background())
+	if services[0].Available || len(outages) != 1 || outages[0].RootCause != "valkey" {
+		t.Fatalf("closed node should be root cause: %+v %+v", services, outages)
+	}
+}
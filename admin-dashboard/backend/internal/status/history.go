@@ -0,0 +1,319 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	historyKeyPrefix = "status:history:"
+	// historyBucket: 이력 키 하나가 담는 시간 범위 (UTC 정시 기준)
+	historyBucket = time.Hour
+	// historyRetention: 가장 긴 조회 범위(7d)에 여유를 둔 버킷 보관 기간
+	historyRetention = 8 * 24 * time.Hour
+
+	// DefaultHistoryInterval: 상태 이력 기록 기본 주기
+	DefaultHistoryInterval = 30 * time.Second
+)
+
+// 헬스체크 결과 상태 값
+const (
+	SampleUp       = "up"
+	SampleDegraded = "degraded"
+	SampleDown     = "down"
+)
+
+// ErrUnknownService: 수집 대상에 없는 서비스 이름
+var ErrUnknownService = errors.New("unknown service")
+
+// historyRanges: 조회 가능한 이력 범위
+var historyRanges = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+}
+
+// Sample: 헬스체크 한 번의 결과
+type Sample struct {
+	Service   string `json:"-"`
+	Timestamp int64  `json:"t"` // Unix 초
+	LatencyMs int64  `json:"l"` // /health 응답 시간 (down이면 실패까지 걸린 시간)
+	Status    string `json:"s"` // up | degraded | down
+}
+
+// HistoryStore: 헬스체크 이력 저장소 인터페이스
+type HistoryStore interface {
+	Append(ctx context.Context, samples []Sample) error
+	// Range: [from, to] 구간의 서비스 이력 (시간순)
+	Range(ctx context.Context, service string, from, to time.Time) ([]Sample, error)
+}
+
+// LatencyPercentiles: 응답 시간 분포 (ms, 응답한 체크만 집계)
+type LatencyPercentiles struct {
+	P50 int64   `json:"p50"`
+	P90 int64   `json:"p90"`
+	P95 int64   `json:"p95"`
+	P99 int64   `json:"p99"`
+	Max int64   `json:"max"`
+	Avg float64 `json:"avg"`
+}
+
+// HistorySummary: 서비스별 기간 가동률/지연 요약
+type HistorySummary struct {
+	Service       string             `json:"service"`
+	Range         string             `json:"range"`
+	From          int64              `json:"from"`
+	To            int64              `json:"to"`
+	Samples       int                `json:"samples"`
+	Up            int                `json:"up"`
+	Degraded      int                `json:"degraded"`
+	Down          int                `json:"down"`
+	UptimePercent *float64           `json:"uptimePercent"` // 기록이 없으면 null
+	LatencyMs     LatencyPercentiles `json:"latencyMs"`
+}
+
+// ParseHistoryRange: range 쿼리 값 검증 (비어 있으면 24h)
+func ParseHistoryRange(raw string) (string, time.Duration, error) {
+	if raw == "" {
+		raw = "24h"
+	}
+	d, ok := historyRanges[raw]
+	if !ok {
+		return "", 0, fmt.Errorf("range must be 24h or 7d")
+	}
+	return raw, d, nil
+}
+
+// SetHistoryStore: 헬스체크 이력 저장소 연결 (nil이면 이력 기록/조회 비활성화)
+func (c *Collector) SetHistoryStore(store HistoryStore) {
+	c.history = store
+}
+
+// HistoryEnabled: 이력 저장소가 연결되어 있는지 여부
+func (c *Collector) HistoryEnabled() bool {
+	return c.history != nil
+}
+
+// RunHistory: interval마다 모든 서비스를 점검해 이력에 기록 (ctx 종료 시 반환)
+func (c *Collector) RunHistory(ctx context.Context, interval time.Duration) {
+	if c.history == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultHistoryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.RecordHistory(ctx); err != nil && ctx.Err() == nil {
+			c.logger.Warn("status_history_record_failed", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecordHistory: 서비스 상태를 한 번 수집해 이력에 추가
+func (c *Collector) RecordHistory(ctx context.Context) error {
+	if c.history == nil {
+		return nil
+	}
+	now := c.now().Unix()
+	services, _ := c.fetchAllServiceStatus(ctx)
+	samples := make([]Sample, 0, len(services))
+	for _, svc := range services {
+		samples = append(samples, Sample{
+			Service:   svc.Name,
+			Timestamp: now,
+			LatencyMs: svc.LatencyMs,
+			Status:    sampleStatus(svc),
+		})
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+	if err := c.history.Append(ctx, samples); err != nil {
+		return fmt.Errorf("append status history: %w", err)
+	}
+	return nil
+}
+
+// History: 서비스들의 최근 기간 가동률/지연 백분위 (service가 비면 전체 서비스)
+func (c *Collector) History(ctx context.Context, service, rangeName string) ([]HistorySummary, error) {
+	if c.history == nil {
+		return nil, fmt.Errorf("status history disabled")
+	}
+	rangeName, window, err := ParseHistoryRange(rangeName)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(c.endpoints))
+	for _, ep := range c.endpoints {
+		if service == "" || ep.Name == service {
+			names = append(names, ep.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownService, service)
+	}
+
+	to := c.now()
+	from := to.Add(-window)
+	summaries := make([]HistorySummary, 0, len(names))
+	for _, name := range names {
+		samples, err := c.history.Range(ctx, name, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("load %s history: %w", name, err)
+		}
+		summary := summarizeSamples(samples)
+		summary.Service, summary.Range = name, rangeName
+		summary.From, summary.To = from.Unix(), to.Unix()
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+func sampleStatus(svc ServiceStatus) string {
+	switch {
+	case !svc.Available:
+		return SampleDown
+	case svc.Health == "degraded":
+		return SampleDegraded
+	default:
+		return SampleUp
+	}
+}
+
+// summarizeSamples: 가동률은 응답한 체크(up+degraded) 비율, 지연은 응답한 체크만으로 계산
+func summarizeSamples(samples []Sample) HistorySummary {
+	var summary HistorySummary
+	latencies := make([]int64, 0, len(samples))
+	for _, s := range samples {
+		switch s.Status {
+		case SampleUp:
+			summary.Up++
+		case SampleDegraded:
+			summary.Degraded++
+		default:
+			summary.Down++
+			continue
+		}
+		latencies = append(latencies, s.LatencyMs)
+	}
+	summary.Samples = len(samples)
+	if summary.Samples > 0 {
+		uptime := math.Round(float64(summary.Up+summary.Degraded)/float64(summary.Samples)*10000) / 100
+		summary.UptimePercent = &uptime
+	}
+	if len(latencies) == 0 {
+		return summary
+	}
+
+	slices.Sort(latencies)
+	var total int64
+	for _, l := range latencies {
+		total += l
+	}
+	summary.LatencyMs = LatencyPercentiles{
+		P50: percentile(latencies, 50),
+		P90: percentile(latencies, 90),
+		P95: percentile(latencies, 95),
+		P99: percentile(latencies, 99),
+		Max: latencies[len(latencies)-1],
+		Avg: math.Round(float64(total)/float64(len(latencies))*10) / 10,
+	}
+	return summary
+}
+
+// percentile: 정렬된 값의 nearest-rank 백분위
+func percentile(sorted []int64, p int) int64 {
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// ValkeyHistoryStore: 서비스별 정시 버킷 리스트에 이력을 쌓는 Valkey 저장소
+// 키: status:history:{service}:{버킷 시작 Unix 초}, 보관 기간이 지나면 키가 통째로 만료된다.
+type ValkeyHistoryStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewValkeyHistoryStore: Valkey 이력 저장소 생성
+func NewValkeyHistoryStore(client valkey.Client, logger *slog.Logger) *ValkeyHistoryStore {
+	return &ValkeyHistoryStore{client: client, logger: logger}
+}
+
+func historyKey(service string, bucket time.Time) string {
+	return historyKeyPrefix + service + ":" + strconv.FormatInt(bucket.Unix(), 10)
+}
+
+// Append: 샘플을 각 버킷 리스트 뒤에 추가하고 보관 기간을 갱신
+func (s *ValkeyHistoryStore) Append(ctx context.Context, samples []Sample) error {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	cmds := make(valkey.Commands, 0, len(samples)*2)
+	for _, sample := range samples {
+		data, err := json.Marshal(sample)
+		if err != nil {
+			return fmt.Errorf("marshal status sample: %w", err)
+		}
+		key := historyKey(sample.Service, time.Unix(sample.Timestamp, 0).UTC().Truncate(historyBucket))
+		cmds = append(cmds,
+			s.client.B().Rpush().Key(key).Element(string(data)).Build(),
+			s.client.B().Expire().Key(key).Seconds(int64(historyRetention.Seconds())).Build(),
+		)
+	}
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("append status history: %w", err)
+		}
+	}
+	return nil
+}
+
+// Range: 구간에 걸친 버킷을 한 번에 읽어 구간 안의 샘플만 반환
+func (s *ValkeyHistoryStore) Range(ctx context.Context, service string, from, to time.Time) ([]Sample, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	var cmds valkey.Commands
+	for bucket := from.UTC().Truncate(historyBucket); !bucket.After(to); bucket = bucket.Add(historyBucket) {
+		cmds = append(cmds, s.client.B().Lrange().Key(historyKey(service, bucket)).Start(0).Stop(-1).Build())
+	}
+
+	fromUnix, toUnix := from.Unix(), to.Unix()
+	var samples []Sample
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		raw, err := resp.AsStrSlice()
+		if err != nil {
+			return nil, fmt.Errorf("read status history: %w", err)
+		}
+		for _, item := range raw {
+			var sample Sample
+			if err := json.Unmarshal([]byte(item), &sample); err != nil {
+				s.logger.Warn("status_history_decode_failed", slog.String("service", service), slog.Any("error", err))
+				continue
+			}
+			if sample.Timestamp < fromUnix || sample.Timestamp > toUnix {
+				continue
+			}
+			sample.Service = service
+			samples = append(samples, sample)
+		}
+	}
+	return samples, nil
+}
@@ -0,0 +1,125 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryHistoryStore struct {
+	mu      sync.Mutex
+	samples []Sample
+}
+
+func (m *memoryHistoryStore) Append(_ context.Context, samples []Sample) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.samples = append(m.samples, samples...)
+	return nil
+}
+
+func (m *memoryHistoryStore) Range(_ context.Context, service string, from, to time.Time) ([]Sample, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Sample
+	for _, s := range m.samples {
+		if s.Service == service && s.Timestamp >= from.Unix() && s.Timestamp <= to.Unix() {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func TestCollector_RecordHistoryAndSummarize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/health":
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		case "/health/detail":
+			_, _ = w.Write([]byte(`{"status":"degraded","components":{"db":{"status":"down"}}}`))
+		default:
+			http.Error(w, "down", http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	collector := NewCollector([]ServiceEndpoint{
+		{Name: "holo", HealthURL: srv.URL + "/health"},
+		{Name: "twentyq", HealthURL: srv.URL + "/health", DetailURL: srv.URL + "/health/detail"},
+		{Name: "llm", HealthURL: srv.URL + "/broken"},
+	}, "test", slog.Default())
+	store := &memoryHistoryStore{}
+	collector.SetHistoryStore(store)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	collector.now = func() time.Time { return now }
+
+	if err := collector.RecordHistory(context.Background()); err != nil {
+		t.Fatalf("RecordHistory error: %v", err)
+	}
+	// 8일 전 기록은 7d 범위에서 빠진다
+	store.samples = append(store.samples, Sample{Service: "holo", Timestamp: now.Add(-8 * 24 * time.Hour).Unix(), Status: SampleDown})
+
+	summaries, err := collector.History(context.Background(), "", "7d")
+	if err != nil {
+		t.Fatalf("History error: %v", err)
+	}
+	want := map[string]string{"holo": SampleUp, "twentyq": SampleDegraded, "llm": SampleDown}
+	if len(summaries) != len(want) {
+		t.Fatalf("expected %d services, got %+v", len(want), summaries)
+	}
+	for _, s := range summaries {
+		if s.Samples != 1 || s.Range != "7d" {
+			t.Fatalf("unexpected summary: %+v", s)
+		}
+		switch want[s.Service] {
+		case SampleUp, SampleDegraded:
+			if s.UptimePercent == nil || *s.UptimePercent != 100 {
+				t.Fatalf("%s: expected 100%% uptime, got %+v", s.Service, s)
+			}
+		case SampleDown:
+			if s.Down != 1 || s.UptimePercent == nil || *s.UptimePercent != 0 {
+				t.Fatalf("%s: expected 0%% uptime, got %+v", s.Service, s)
+			}
+		}
+	}
+	if summaries[1].Degraded != 1 {
+		t.Fatalf("twentyq should be recorded as degraded: %+v", summaries[1])
+	}
+
+	if _, err := collector.History(context.Background(), "nope", "24h"); !errors.Is(err, ErrUnknownService) {
+		t.Fatalf("expected ErrUnknownService, got %v", err)
+	}
+	if _, err := collector.History(context.Background(), "holo", "30d"); err == nil {
+		t.Fatalf("expected range error")
+	}
+}
+
+func TestSummarizeSamples_PercentilesIgnoreDownChecks(t *testing.T) {
+	samples := make([]Sample, 0, 101)
+	for i := 1; i <= 100; i++ {
+		samples = append(samples, Sample{LatencyMs: int64(i), Status: SampleUp})
+	}
+	samples = append(samples, Sample{LatencyMs: 3000, Status: SampleDown})
+
+	got := summarizeSamples(samples)
+	if got.Samples != 101 || got.Up != 100 || got.Down != 1 {
+		t.Fatalf("unexpected counts: %+v", got)
+	}
+	if got.UptimePercent == nil || *got.UptimePercent != 99.01 {
+		t.Fatalf("unexpected uptime: %v", got.UptimePercent)
+	}
+	want := LatencyPercentiles{P50: 50, P90: 90, P95: 95, P99: 99, Max: 100, Avg: 50.5}
+	if got.LatencyMs != want {
+		t.Fatalf("latency mismatch: got %+v, want %+v", got.LatencyMs, want)
+	}
+
+	if empty := summarizeSamples(nil); empty.UptimePercent != nil {
+		t.Fatalf("no samples should report null uptime")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,6 +21,16 @@ type ServiceStatus struct {
 	Version    string `json:"version,omitempty"`
 	Uptime     string `json:"uptime,omitempty"`
 	Goroutines int    `json:"goroutines"`
+	LatencyMs  int64  `json:"latencyMs"` // /health 응답 시간
+
+	// 상세 헬스체크 결과 (DetailURL이 설정된 서비스만)
+	Health string   `json:"health,omitempty"` // ok | degraded
+	Issues []string `json:"issues,omitempty"` // 예: "schema: column:game_logs.turn_count"
+
+	// 의존 그래프 기반 장애 원인 (의존 서비스가 문제일 때만)
+	DependsOn  []string `json:"dependsOn,omitempty"`
+	RootCauses []string `json:"rootCauses,omitempty"`
+	Cause      string   `json:"cause,omitempty"` // 예: "degraded because mcp-llm-server is down"
 }
 
 // AggregatedStatus: 통합 시스템 상태 응답
@@ -31,6 +42,8 @@ type AggregatedStatus struct {
 
 	// 서비스별 상태
 	Services []ServiceStatus `json:"services"`
+	// 근본 원인별 장애 묶음 (모두 정상이면 생략)
+	Outages []Outage `json:"outages,omitempty"`
 
 	// 집계 통계
 	TotalGoroutines   int `json:"totalGoroutines"`
@@ -41,9 +54,12 @@ type AggregatedStatus struct {
 
 // ServiceEndpoint: 봇 서비스 엔드포인트 정보
 type ServiceEndpoint struct {
-	Name      string // 서비스 이름 (hololive-bot, twentyq-bot 등)
-	HealthURL string // /health 엔드포인트 URL
-	StatsURL  string // /api/holo/stats 등 상세 상태 URL (선택 사항)
+	Name      string   // 서비스 이름 (hololive-bot, twentyq-bot 등)
+	HealthURL string   // /health 엔드포인트 URL
+	StatsURL  string   // /api/holo/stats 등 상세 상태 URL (선택 사항)
+	DetailURL string   // /health/detail 구성 요소별 점검 URL (선택 사항, 스키마 누락 등)
+	TCPAddr   string   // 헬스 API 없이 TCP 연결만 확인하는 인프라 노드 주소 (valkey, postgres 등)
+	DependsOn []string // 의존하는 서비스 이름 (장애 근본 원인 추적용)
 }
 
 // Collector: 멀티 서비스 상태 수집기
@@ -53,6 +69,8 @@ type Collector struct {
 	logger     *slog.Logger
 	startTime  time.Time
 	version    string
+	history    HistoryStore // nil이면 이력 기록 안 함
+	now        func() time.Time
 }
 
 // NewCollector: 상태 수집기 생성
@@ -66,6 +84,7 @@ func NewCollector(endpoints []ServiceEndpoint, version string, logger *slog.Logg
 		logger:    logger,
 		startTime: time.Now(),
 		version:   version,
+		now:       time.Now,
 	}
 }
 
@@ -76,7 +95,7 @@ func (c *Collector) GetAggregatedStatus(ctx context.Context) *AggregatedStatus {
 	adminGoroutines := runtime.NumGoroutine()
 
 	// 서비스 상태 병렬 수집
-	services := c.fetchAllServiceStatus(ctx)
+	services, outages := c.fetchAllServiceStatus(ctx)
 
 	// 집계 계산
 	totalGoroutines := adminGoroutines
@@ -103,6 +122,7 @@ func (c *Collector) GetAggregatedStatus(ctx context.Context) *AggregatedStatus {
 		Uptime:            formatDuration(uptime),
 		StartedAt:         c.startTime.Unix(),
 		Services:          allServices,
+		Outages:           outages,
 		TotalGoroutines:   totalGoroutines,
 		AvailableServices: availableCount + 1, // +1 for admin-dashboard
 		TotalServices:     len(allServices),
@@ -110,17 +130,17 @@ func (c *Collector) GetAggregatedStatus(ctx context.Context) *AggregatedStatus {
 	}
 }
 
-// fetchAllServiceStatus: 모든 서비스 상태 병렬 수집
-func (c *Collector) fetchAllServiceStatus(ctx context.Context) []ServiceStatus {
+// fetchAllServiceStatus: 모든 서비스 상태 병렬 수집 후 의존 그래프로 장애 원인 표시
+func (c *Collector) fetchAllServiceStatus(ctx context.Context) ([]ServiceStatus, []Outage) {
 	if len(c.endpoints) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	results := make([]ServiceStatus, len(c.endpoints))
 	var wg sync.WaitGroup
 
 	for i, ep := range c.endpoints {
-		if ep.HealthURL == "" {
+		if ep.HealthURL == "" && ep.TCPAddr == "" {
 			results[i] = ServiceStatus{Name: ep.Name, Available: false}
 			continue
 		}
@@ -128,12 +148,16 @@ func (c *Collector) fetchAllServiceStatus(ctx context.Context) []ServiceStatus {
 		wg.Add(1)
 		go func(idx int, endpoint ServiceEndpoint) {
 			defer wg.Done()
+			if endpoint.HealthURL == "" {
+				results[idx] = c.fetchTCPStatus(ctx, endpoint)
+				return
+			}
 			results[idx] = c.fetchServiceStatus(ctx, endpoint)
 		}(i, ep)
 	}
 
 	wg.Wait()
-	return results
+	return results, attributeRootCauses(results, c.endpoints)
 }
 
 // healthResponse: /health 엔드포인트 응답 파싱용
@@ -143,6 +167,7 @@ type healthResponse struct {
 	Uptime     string `json:"uptime"`
 	Goroutines int    `json:"goroutines"`
 	Components map[string]struct {
+		Status string         `json:"status"`
 		Detail map[string]any `json:"detail"`
 	} `json:"components"`
 }
@@ -161,7 +186,9 @@ func (c *Collector) fetchServiceStatus(ctx context.Context, endpoint ServiceEndp
 	}
 
 	// Health 체크
+	started := time.Now()
 	healthResp, ok := c.fetchHealthResponse(ctx, endpoint.HealthURL)
+	status.LatencyMs = time.Since(started).Milliseconds()
 	if !ok {
 		return status
 	}
@@ -213,9 +240,55 @@ func (c *Collector) fetchServiceStatus(ctx context.Context, endpoint ServiceEndp
 		}
 	}
 
+	// 상세 헬스체크 (구성 요소별 문제 목록)
+	if endpoint.DetailURL != "" {
+		if detailResp, ok := c.fetchHealthResponse(ctx, endpoint.DetailURL); ok {
+			status.Health, status.Issues = summarizeComponents(detailResp)
+		}
+	}
+
 	return status
 }
 
+// summarizeComponents: 상세 헬스 응답에서 전체 상태와 구성 요소별 문제(누락 객체, 점검 오류)를 추출
+func summarizeComponents(resp healthResponse) (string, []string) {
+	names := make([]string, 0, len(resp.Components))
+	for name := range resp.Components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []string
+	for _, name := range names {
+		component := resp.Components[name]
+		if component.Status == "" || component.Status == "ok" {
+			continue
+		}
+		if missing, ok := component.Detail["missing"].([]any); ok && len(missing) > 0 {
+			for _, item := range missing {
+				if s, ok := item.(string); ok {
+					issues = append(issues, name+": "+s)
+				}
+			}
+			continue
+		}
+		if errMsg, ok := component.Detail["error"].(string); ok && errMsg != "" {
+			issues = append(issues, name+": "+errMsg)
+			continue
+		}
+		issues = append(issues, name+": "+component.Status)
+	}
+
+	health := resp.Status
+	if health == "" {
+		health = "ok"
+	}
+	if len(issues) > 0 && health == "ok" {
+		health = "degraded"
+	}
+	return health, issues
+}
+
 // fetchHealthResponse: Health 응답 조회
 func (c *Collector) fetchHealthResponse(ctx context.Context, url string) (healthResponse, bool) {
 	var result healthResponse
@@ -0,0 +1,61 @@
+package status
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestCollector_ReportsSchemaIssuesFromDetailEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/health":
+			_, _ = w.Write([]byte(`{"status":"ok","version":"1.2.3","uptime":"5m0s","goroutines":12}`))
+		case "/health/detail":
+			_, _ = w.Write([]byte(`{"status":"degraded","components":{"schema":{"status":"degraded",` +
+				`"detail":{"missing":["table:turtle_puzzles","column:game_logs.turn_count"]}}}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	collector := NewCollector([]ServiceEndpoint{
+		{Name: "twentyq-bot", HealthURL: srv.URL + "/health", DetailURL: srv.URL + "/health/detail"},
+		{Name: "llm", HealthURL: srv.URL + "/health"},
+	}, "test", slog.Default())
+
+	got := collector.GetAggregatedStatus(context.Background())
+	bot := got.Services[1]
+	if !bot.Available || bot.Version != "1.2.3" || bot.Health != "degraded" {
+		t.Fatalf("unexpected bot status: %+v", bot)
+	}
+	want := []string{"schema: table:turtle_puzzles", "schema: column:game_logs.turn_count"}
+	if !slices.Equal(bot.Issues, want) {
+		t.Fatalf("unexpected issues: %v", bot.Issues)
+	}
+
+	if llm := got.Services[2]; llm.Health != "" || len(llm.Issues) != 0 {
+		t.Fatalf("service without detail URL must not report health: %+v", llm)
+	}
+}
+
+func TestSummarizeComponents_ReportsCheckErrors(t *testing.T) {
+	var resp healthResponse
+	resp.Status = "degraded"
+	resp.Components = map[string]struct {
+		Status string         `json:"status"`
+		Detail map[string]any `json:"detail"`
+	}{
+		"schema": {Status: "down", Detail: map[string]any{"error": "connection refused"}},
+	}
+
+	health, issues := summarizeComponents(resp)
+	if health != "degraded" || !slices.Equal(issues, []string{"schema: connection refused"}) {
+		t.Fatalf("unexpected summary: %s %v", health, issues)
+	}
+}
@@ -48,7 +48,7 @@ func (c *Collector) GetSystemStats(ctx context.Context) (*SystemStats, error) {
 	adminGoroutines := runtime.NumGoroutine()
 
 	// 서비스 상태 병렬 수집
-	services := c.fetchAllServiceStatus(ctx)
+	services, _ := c.fetchAllServiceStatus(ctx)
 
 	// ServiceGoroutines 변환 및 합계 계산
 	serviceGoroutines := make([]ServiceGoroutines, 0, len(services)+1)
@@ -61,7 +61,11 @@ func (c *Collector) GetSystemStats(ctx context.Context) (*SystemStats, error) {
 	})
 
 	totalGoroutines := adminGoroutines
-	for _, svc := range services {
+	for i, svc := range services {
+		// TCP로만 확인하는 인프라 노드는 고루틴 정보가 없다
+		if c.endpoints[i].TCPAddr != "" && c.endpoints[i].HealthURL == "" {
+			continue
+		}
 		serviceGoroutines = append(serviceGoroutines, ServiceGoroutines{
 			Name:       svc.Name,
 			Goroutines: svc.Goroutines,
@@ -0,0 +1,148 @@
+package traces
+
+import (
+	"cmp"
+	"slices"
+)
+
+// TraceOverview: 비교 대상 트레이스 한 쪽의 요약
+type TraceOverview struct {
+	TraceID   string   `json:"traceId"`
+	SpanCount int      `json:"spanCount"`
+	Duration  int64    `json:"duration"`
+	Services  []string `json:"services"`
+	HasError  bool     `json:"hasError"`
+}
+
+// OperationDiff: 서비스/Operation 단위 비교 결과 (Duration은 마이크로초 합계)
+type OperationDiff struct {
+	Service       string `json:"service"`
+	Operation     string `json:"operation"`
+	CountA        int    `json:"countA"`
+	CountB        int    `json:"countB"`
+	DurationA     int64  `json:"durationA"`
+	DurationB     int64  `json:"durationB"`
+	DurationDelta int64  `json:"durationDelta"`
+}
+
+// MissingSpan: 한쪽 트레이스에만 있거나 더 많이 나타난 Operation
+type MissingSpan struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	Count     int    `json:"count"`
+}
+
+// TraceDiff: 두 트레이스의 구조 비교 결과. Delta는 모두 B - A 입니다.
+type TraceDiff struct {
+	TraceA         TraceOverview   `json:"traceA"`
+	TraceB         TraceOverview   `json:"traceB"`
+	SpanCountDelta int             `json:"spanCountDelta"`
+	DurationDelta  int64           `json:"durationDelta"`
+	Operations     []OperationDiff `json:"operations"`
+	MissingInA     []MissingSpan   `json:"missingInA"`
+	MissingInB     []MissingSpan   `json:"missingInB"`
+}
+
+type operationKey struct {
+	service   string
+	operation string
+}
+
+// CompareTraces: 두 트레이스를 서비스/Operation 기준으로 비교합니다.
+// Operations는 소요 시간 변화가 큰 순으로 정렬됩니다.
+func CompareTraces(a, b *TraceDetail) *TraceDiff {
+	diff := &TraceDiff{
+		TraceA:     overview(a),
+		TraceB:     overview(b),
+		MissingInA: []MissingSpan{},
+		MissingInB: []MissingSpan{},
+	}
+	diff.SpanCountDelta = diff.TraceB.SpanCount - diff.TraceA.SpanCount
+	diff.DurationDelta = diff.TraceB.Duration - diff.TraceA.Duration
+
+	ops := make(map[operationKey]*OperationDiff)
+	get := func(s *Span) *OperationDiff {
+		key := operationKey{service: s.ServiceName, operation: s.OperationName}
+		op, ok := ops[key]
+		if !ok {
+			op = &OperationDiff{Service: s.ServiceName, Operation: s.OperationName}
+			ops[key] = op
+		}
+		return op
+	}
+	for i := range a.Spans {
+		op := get(&a.Spans[i])
+		op.CountA++
+		op.DurationA += a.Spans[i].Duration
+	}
+	for i := range b.Spans {
+		op := get(&b.Spans[i])
+		op.CountB++
+		op.DurationB += b.Spans[i].Duration
+	}
+
+	diff.Operations = make([]OperationDiff, 0, len(ops))
+	for _, op := range ops {
+		op.DurationDelta = op.DurationB - op.DurationA
+		diff.Operations = append(diff.Operations, *op)
+
+		switch {
+		case op.CountA > op.CountB:
+			diff.MissingInB = append(diff.MissingInB, MissingSpan{Service: op.Service, Operation: op.Operation, Count: op.CountA - op.CountB})
+		case op.CountB > op.CountA:
+			diff.MissingInA = append(diff.MissingInA, MissingSpan{Service: op.Service, Operation: op.Operation, Count: op.CountB - op.CountA})
+		}
+	}
+
+	slices.SortFunc(diff.Operations, func(x, y OperationDiff) int {
+		if c := cmp.Compare(absInt64(y.DurationDelta), absInt64(x.DurationDelta)); c != 0 {
+			return c
+		}
+		return compareOperation(x.Service, x.Operation, y.Service, y.Operation)
+	})
+	sortMissing := func(x, y MissingSpan) int {
+		return compareOperation(x.Service, x.Operation, y.Service, y.Operation)
+	}
+	slices.SortFunc(diff.MissingInA, sortMissing)
+	slices.SortFunc(diff.MissingInB, sortMissing)
+
+	return diff
+}
+
+// overview: 트레이스 요약. Duration은 첫 Span 시작부터 마지막 Span 종료까지입니다.
+func overview(t *TraceDetail) TraceOverview {
+	o := TraceOverview{TraceID: t.TraceID, SpanCount: len(t.Spans), Services: []string{}}
+	if len(t.Spans) == 0 {
+		return o
+	}
+
+	start, end := t.Spans[0].StartTime, t.Spans[0].StartTime+t.Spans[0].Duration
+	for i := range t.Spans {
+		s := &t.Spans[i]
+		start = min(start, s.StartTime)
+		end = max(end, s.StartTime+s.Duration)
+		if s.HasError {
+			o.HasError = true
+		}
+		if !slices.Contains(o.Services, s.ServiceName) {
+			o.Services = append(o.Services, s.ServiceName)
+		}
+	}
+	o.Duration = end - start
+	slices.Sort(o.Services)
+	return o
+}
+
+func compareOperation(serviceA, operationA, serviceB, operationB string) int {
+	if c := cmp.Compare(serviceA, serviceB); c != 0 {
+		return c
+	}
+	return cmp.Compare(operationA, operationB)
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
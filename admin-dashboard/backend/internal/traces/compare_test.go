@@ -0,0 +1,64 @@
+package traces
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompareTraces(t *testing.T) {
+	a := &TraceDetail{
+		TraceID: "a",
+		Spans: []Span{
+			{ServiceName: "game-bot", OperationName: "HandleMessage", StartTime: 1000, Duration: 500},
+			{ServiceName: "mcp-llm", OperationName: "TwentyQAnswerQuestion", StartTime: 1100, Duration: 300},
+			{ServiceName: "valkey", OperationName: "GET", StartTime: 1050, Duration: 10},
+			{ServiceName: "valkey", OperationName: "GET", StartTime: 1060, Duration: 10},
+		},
+	}
+	b := &TraceDetail{
+		TraceID: "b",
+		Spans: []Span{
+			{ServiceName: "game-bot", OperationName: "HandleMessage", StartTime: 5000, Duration: 900},
+			{ServiceName: "mcp-llm", OperationName: "TwentyQAnswerQuestion", StartTime: 5100, Duration: 700, HasError: true},
+			{ServiceName: "valkey", OperationName: "GET", StartTime: 5050, Duration: 12},
+			{ServiceName: "mcp-llm", OperationName: "GuardIsMalicious", StartTime: 5060, Duration: 30},
+		},
+	}
+
+	diff := CompareTraces(a, b)
+
+	if diff.TraceA.Duration != 500 || diff.TraceB.Duration != 900 || diff.DurationDelta != 400 {
+		t.Fatalf("unexpected durations: a=%d b=%d delta=%d", diff.TraceA.Duration, diff.TraceB.Duration, diff.DurationDelta)
+	}
+	if diff.SpanCountDelta != 0 || diff.TraceA.HasError || !diff.TraceB.HasError {
+		t.Fatalf("unexpected overview: %+v / %+v", diff.TraceA, diff.TraceB)
+	}
+	if !slices.Equal(diff.TraceB.Services, []string{"game-bot", "mcp-llm", "valkey"}) {
+		t.Fatalf("unexpected services: %v", diff.TraceB.Services)
+	}
+
+	if len(diff.Operations) != 4 {
+		t.Fatalf("expected 4 operations, got %d", len(diff.Operations))
+	}
+	first := diff.Operations[0]
+	// 변화량이 같으면 서비스 이름순
+	if first.DurationDelta != 400 || first.Operation != "HandleMessage" || diff.Operations[1].Operation != "TwentyQAnswerQuestion" {
+		t.Fatalf("expected largest delta first, got %+v", diff.Operations[:2])
+	}
+	if last := diff.Operations[3]; last.Operation != "GET" || last.CountA != 2 || last.CountB != 1 || last.DurationDelta != -8 {
+		t.Fatalf("unexpected GET diff: %+v", last)
+	}
+
+	wantMissingInA := []MissingSpan{{Service: "mcp-llm", Operation: "GuardIsMalicious", Count: 1}}
+	wantMissingInB := []MissingSpan{{Service: "valkey", Operation: "GET", Count: 1}}
+	if !slices.Equal(diff.MissingInA, wantMissingInA) || !slices.Equal(diff.MissingInB, wantMissingInB) {
+		t.Fatalf("unexpected missing spans: inA=%v inB=%v", diff.MissingInA, diff.MissingInB)
+	}
+}
+
+func TestCompareTraces_Empty(t *testing.T) {
+	diff := CompareTraces(&TraceDetail{TraceID: "a"}, &TraceDetail{TraceID: "b"})
+	if diff.TraceA.Duration != 0 || len(diff.Operations) != 0 || diff.MissingInA == nil || diff.MissingInB == nil {
+		t.Fatalf("unexpected diff for empty traces: %+v", diff)
+	}
+}
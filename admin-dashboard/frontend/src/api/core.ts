@@ -414,6 +414,19 @@ export interface ServiceStatus {
     version?: string
     uptime?: string
     goroutines: number
+    health?: 'ok' | 'degraded'
+    issues?: string[] // 상세 헬스체크 문제 목록 (예: "schema: index:alarms.idx_alarms_channel")
+    latencyMs?: number
+    dependsOn?: string[]
+    rootCauses?: string[] // 의존 그래프로 찾은 근본 원인 서비스
+    cause?: string // 예: "degraded because mcp-llm-server is down"
+}
+
+export interface Outage {
+    rootCause: string
+    status: 'down' | 'degraded'
+    impacted?: string[]
+    summary: string
 }
 
 export interface AggregatedStatus {
@@ -421,6 +434,7 @@ export interface AggregatedStatus {
     uptime: string
     startedAt: number
     services: ServiceStatus[]
+    outages?: Outage[]
     totalGoroutines: number
     availableServices: number
     totalServices: number
@@ -18,6 +18,7 @@ import type {
     TurtleSoupPuzzle,
     TurtleSoupPuzzleCreateRequest,
     TurtleSoupPuzzleUpdateRequest,
+    TurtleSoupPuzzleReviewRequest,
     TurtleSoupPuzzleStatsResponse,
     TurtleSoupArchivesResponse,
     HintInjectRequest,
@@ -25,9 +26,20 @@ import type {
     SessionCleanupResponse,
 } from '@/types/gameBots'
 import type { ApiResponse } from '@/types'
+import { filenameFromDisposition, saveBlob } from '@/utils/download'
 
 const TWENTYQ_BASE = '/twentyq/admin'
 
+// downloadCSV: 목록 API를 ?format=csv 로 호출해 필터에 맞는 전체 행을 파일로 저장합니다. (limit/offset 무시)
+const downloadCSV = async (path: string, params: object | undefined, fallbackName: string): Promise<void> => {
+    const response = await apiClient.get<Blob>(path, {
+        params: { ...params, format: 'csv' },
+        responseType: 'blob',
+    })
+    const disposition = response.headers['content-disposition'] as string | undefined
+    saveBlob(response.data, filenameFromDisposition(disposition, fallbackName))
+}
+
 export const twentyQApi = {
     getStats: async (): Promise<TwentyQStatsResponse> => {
         const response = await apiClient.get<TwentyQStatsResponse>(`${TWENTYQ_BASE}/stats`)
@@ -85,6 +97,13 @@ export const twentyQApi = {
         return response.data
     },
 
+    exportGames: async (params?: {
+        category?: string
+        result?: string
+    }): Promise<void> => {
+        await downloadCSV(`${TWENTYQ_BASE}/games`, params, 'twentyq-games.csv')
+    },
+
     getGameDetail: async (sessionId: string): Promise<TwentyQGameDetailResponse> => {
         const response = await apiClient.get<TwentyQGameDetailResponse>(
             `${TWENTYQ_BASE}/games/${encodeURIComponent(sessionId)}`
@@ -149,6 +168,10 @@ export const twentyQApi = {
         return response.data
     },
 
+    exportUserStats: async (params?: { chatId?: string }): Promise<void> => {
+        await downloadCSV(`${TWENTYQ_BASE}/users/stats`, params, 'twentyq-user-stats.csv')
+    },
+
     getUserStats: async (userId: string, chatId?: string): Promise<{ status: string; stats: unknown[] }> => {
         const response = await apiClient.get<{ status: string; stats: unknown[] }>(
             `${TWENTYQ_BASE}/users/${encodeURIComponent(userId)}/stats`,
@@ -292,6 +315,33 @@ export const turtleSoupApi = {
         return response.data
     },
 
+    getPuzzleReviewQueue: async (params?: {
+        limit?: number
+        offset?: number
+    }): Promise<TurtleSoupPuzzlesResponse> => {
+        const response = await apiClient.get<TurtleSoupPuzzlesResponse>(
+            `${TURTLE_BASE}/puzzles/review`,
+            { params }
+        )
+        return response.data
+    },
+
+    approvePuzzle: async (id: number, data?: TurtleSoupPuzzleReviewRequest): Promise<{ status: string; puzzle: TurtleSoupPuzzle }> => {
+        const response = await apiClient.post<{ status: string; puzzle: TurtleSoupPuzzle }>(
+            `${TURTLE_BASE}/puzzles/${String(id)}/approve`,
+            data ?? {}
+        )
+        return response.data
+    },
+
+    rejectPuzzle: async (id: number, data?: TurtleSoupPuzzleReviewRequest): Promise<{ status: string; puzzle: TurtleSoupPuzzle }> => {
+        const response = await apiClient.post<{ status: string; puzzle: TurtleSoupPuzzle }>(
+            `${TURTLE_BASE}/puzzles/${String(id)}/reject`,
+            data ?? {}
+        )
+        return response.data
+    },
+
     getPuzzleStats: async (): Promise<TurtleSoupPuzzleStatsResponse> => {
         const response = await apiClient.get<TurtleSoupPuzzleStatsResponse>(`${TURTLE_BASE}/puzzles/stats`)
         return response.data
@@ -308,4 +358,8 @@ export const turtleSoupApi = {
         )
         return response.data
     },
+
+    exportArchives: async (params?: { result?: string }): Promise<void> => {
+        await downloadCSV(`${TURTLE_BASE}/archives`, params, 'turtlesoup-archives.csv')
+    },
 }
@@ -17,6 +17,7 @@ export {
   // Types
   type AggregatedStatus,
   type ServiceStatus,
+  type Outage,
   type HeartbeatResponse,
   type DockerContainer,
   type TraceSummary,
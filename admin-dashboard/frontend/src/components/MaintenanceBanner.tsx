@@ -0,0 +1,38 @@
+import { AlertTriangle, Info } from 'lucide-react'
+import clsx from 'clsx'
+import { getSSRDataFor } from '@/utils/ssr'
+
+const severityStyles = {
+  info: 'bg-sky-50 border-sky-200 text-sky-800',
+  warning: 'bg-amber-50 border-amber-200 text-amber-800',
+  critical: 'bg-rose-50 border-rose-200 text-rose-800',
+} as const
+
+/**
+ * 서버가 SSR로 주입한 전역 점검 공지를 배너로 표시합니다.
+ * 공지가 없으면 아무것도 렌더링하지 않습니다.
+ */
+export const MaintenanceBanner = ({ className }: { className?: string }) => {
+  const notice = getSSRDataFor('maintenance')
+  if (!notice) return null
+
+  const Icon = notice.severity === 'info' ? Info : AlertTriangle
+  const eta = notice.eta ? new Date(notice.eta).toLocaleString('ko-KR') : undefined
+
+  return (
+    <div
+      role="status"
+      className={clsx(
+        'flex items-start gap-3 border rounded-xl px-4 py-3 text-sm',
+        severityStyles[notice.severity] ?? severityStyles.info,
+        className,
+      )}
+    >
+      <Icon size={18} className="mt-0.5 shrink-0" />
+      <div className="flex flex-col">
+        <span className="font-semibold whitespace-pre-line">{notice.message}</span>
+        {eta && <span className="text-xs opacity-80 mt-0.5">예상 종료: {eta}</span>}
+      </div>
+    </div>
+  )
+}
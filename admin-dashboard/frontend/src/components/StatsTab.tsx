@@ -19,7 +19,7 @@ import {
   ChevronDown
 } from 'lucide-react'
 import { motion } from 'framer-motion'
-import { SystemStatsChart, ChannelStatsTable } from '@/components/dashboard'
+import { SystemStatsChart, ChannelStatsTable, LiveTokenTicker } from '@/components/dashboard'
 
 const StatsTab = () => {
   const navigate = useNavigate()
@@ -246,6 +246,7 @@ const StatsTab = () => {
           </div>
 
           <SystemStatsChart />
+          <LiveTokenTicker />
         </div>
 
         {/* 4. 바로가기 */}
@@ -0,0 +1,153 @@
+import { useEffect, useState } from 'react'
+import { BarChart, Bar, XAxis, YAxis, Tooltip, ResponsiveContainer, CartesianGrid } from 'recharts'
+import { Card } from '@/components/ui'
+import { Coins, Zap } from 'lucide-react'
+import * as z from 'zod'
+
+// LLM 서버가 5초 단위로 집계해 보내는 사용량 증분
+const liveUsageTickSchema = z.object({
+    window_start: z.string(),
+    window_end: z.string(),
+    input_tokens: z.coerce.number(),
+    output_tokens: z.coerce.number(),
+    reasoning_tokens: z.coerce.number(),
+    total_tokens: z.coerce.number(),
+    request_count: z.coerce.number(),
+    model: z.string(),
+})
+
+type LiveUsageTick = z.infer<typeof liveUsageTickSchema>
+
+interface LiveUsagePoint {
+    time: string
+    tokens: number
+    requests: number
+}
+
+interface LiveUsageTotals {
+    tokens: number
+    inputTokens: number
+    outputTokens: number
+    requests: number
+    since: number
+}
+
+const MAX_DATA_POINTS = 60 // 5초 × 60 = 최근 5분
+const STREAM_URL = '/admin/api/llm/usage/live'
+
+const formatNumber = (value: number) => value.toLocaleString('ko-KR')
+
+export const LiveTokenTicker = () => {
+    const [history, setHistory] = useState<LiveUsagePoint[]>([])
+    const [totals, setTotals] = useState<LiveUsageTotals>({ tokens: 0, inputTokens: 0, outputTokens: 0, requests: 0, since: Date.now() })
+    const [model, setModel] = useState('')
+    const [isConnected, setIsConnected] = useState(false)
+
+    useEffect(() => {
+        // EventSource는 끊기면 서버가 보낸 retry 간격으로 자동 재연결함
+        const source = new EventSource(STREAM_URL, { withCredentials: true })
+
+        source.onopen = () => { setIsConnected(true) }
+        source.onerror = () => { setIsConnected(false) }
+        source.addEventListener('usage', (event: MessageEvent<string>) => {
+            let tick: LiveUsageTick
+            try {
+                const parsed = liveUsageTickSchema.safeParse(JSON.parse(event.data))
+                if (!parsed.success) return
+                tick = parsed.data
+            } catch {
+                return
+            }
+
+            const time = new Date(tick.window_end).toLocaleTimeString('ko-KR', { hour12: false, hour: '2-digit', minute: '2-digit', second: '2-digit' })
+            setModel(tick.model)
+            setTotals(prev => ({
+                ...prev,
+                tokens: prev.tokens + tick.total_tokens,
+                inputTokens: prev.inputTokens + tick.input_tokens,
+                outputTokens: prev.outputTokens + tick.output_tokens,
+                requests: prev.requests + tick.request_count,
+            }))
+            setHistory(prev => [...prev, { time, tokens: tick.total_tokens, requests: tick.request_count }].slice(-MAX_DATA_POINTS))
+        })
+
+        return () => { source.close() }
+    }, [])
+
+    const recentTokens = history.slice(-12).reduce((sum, point) => sum + point.tokens, 0) // 최근 1분
+
+    return (
+        <Card className="overflow-hidden">
+            <Card.Header className="flex flex-row items-center justify-between border-b border-slate-100 pb-4 bg-slate-50/50">
+                <div className="flex items-center gap-2">
+                    <Coins className="text-slate-500" size={20} />
+                    <h3 className="text-lg font-bold text-slate-800">실시간 토큰 사용량</h3>
+                    {isConnected ? (
+                        <span className="flex h-2 w-2 relative ml-2">
+                            <span className="animate-ping absolute inline-flex h-full w-full rounded-full bg-emerald-400 opacity-75"></span>
+                            <span className="relative inline-flex rounded-full h-2 w-2 bg-emerald-500"></span>
+                        </span>
+                    ) : (
+                        <span className="h-2 w-2 rounded-full bg-slate-300 ml-2"></span>
+                    )}
+                    {model && <span className="text-xs font-mono text-slate-400 ml-1">{model}</span>}
+                </div>
+
+                <div className="flex gap-4 text-xs font-mono">
+                    <div className="flex items-center gap-1.5 px-2 py-1 bg-white rounded border border-slate-100 shadow-sm">
+                        <Zap size={14} className="text-amber-500" />
+                        <span className="font-bold text-slate-700">{formatNumber(recentTokens)} /분</span>
+                    </div>
+                    <div className="flex items-center gap-1.5 px-2 py-1 bg-white rounded border border-slate-100 shadow-sm hidden sm:flex">
+                        <span className="font-bold text-slate-500">{formatNumber(totals.requests)} 요청</span>
+                    </div>
+                </div>
+            </Card.Header>
+
+            <Card.Body className="p-0">
+                <div className="grid grid-cols-3 gap-2 px-4 pt-4 text-center">
+                    <div>
+                        <p className="text-[10px] text-slate-400 font-bold uppercase">누적 토큰</p>
+                        <p className="font-mono font-bold text-slate-800">{formatNumber(totals.tokens)}</p>
+                    </div>
+                    <div>
+                        <p className="text-[10px] text-slate-400 font-bold uppercase">입력</p>
+                        <p className="font-mono text-sky-600">{formatNumber(totals.inputTokens)}</p>
+                    </div>
+                    <div>
+                        <p className="text-[10px] text-slate-400 font-bold uppercase">출력</p>
+                        <p className="font-mono text-violet-600">{formatNumber(totals.outputTokens)}</p>
+                    </div>
+                </div>
+                <p className="px-4 pt-1 text-[10px] text-slate-400 text-center">
+                    {new Date(totals.since).toLocaleTimeString('ko-KR', { hour12: false })} 이후 (페이지 열람 기준)
+                </p>
+
+                <div className="w-full h-[140px] mt-2">
+                    <ResponsiveContainer width="100%" height="100%">
+                        <BarChart data={history} margin={{ top: 10, right: 10, left: 0, bottom: 0 }}>
+                            <CartesianGrid strokeDasharray="3 3" vertical={false} stroke="#f1f5f9" />
+                            <XAxis
+                                dataKey="time"
+                                tick={{ fontSize: 10, fill: '#94a3b8' }}
+                                tickLine={false}
+                                axisLine={false}
+                                interval="preserveStartEnd"
+                                minTickGap={30}
+                            />
+                            <YAxis
+                                domain={[0, 'auto']}
+                                tick={{ fontSize: 10, fill: '#94a3b8' }}
+                                tickLine={false}
+                                axisLine={false}
+                                width={40}
+                            />
+                            <Tooltip formatter={(value: number | string) => [formatNumber(Number(value)), '토큰 (5초)']} />
+                            <Bar dataKey="tokens" fill="#f59e0b" isAnimationActive={false} />
+                        </BarChart>
+                    </ResponsiveContainer>
+                </div>
+            </Card.Body>
+        </Card>
+    )
+}
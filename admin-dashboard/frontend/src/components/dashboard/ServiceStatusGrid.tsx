@@ -44,6 +44,9 @@ export const ServiceStatusGrid = ({ services }: ServiceStatusGridProps) => {
                                                     <span className="relative inline-flex rounded-full h-2 w-2 bg-emerald-500"></span>
                                                 </span>
                                                 <span className="text-xs font-bold text-emerald-600">Online</span>
+                                                {service.health === 'degraded' && (
+                                                    <span className="text-[10px] font-bold text-amber-700 bg-amber-50 px-1.5 py-0.5 rounded">Degraded</span>
+                                                )}
                                             </>
                                         ) : (
                                             <>
@@ -79,6 +82,23 @@ export const ServiceStatusGrid = ({ services }: ServiceStatusGridProps) => {
                                 </div>
                             </div>
                         )}
+
+                        {service.cause && (
+                            <p
+                                className={`mt-2 text-[11px] font-medium truncate ${service.available ? 'text-amber-700' : 'text-rose-600'}`}
+                                title={service.cause}
+                            >
+                                {service.cause}
+                            </p>
+                        )}
+
+                        {service.available && service.issues && service.issues.length > 0 && (
+                            <ul className="mt-2 space-y-0.5 text-[11px] font-mono text-amber-700">
+                                {service.issues.map((issue) => (
+                                    <li key={issue} className="truncate" title={issue}>{issue}</li>
+                                ))}
+                            </ul>
+                        )}
                     </div>
                 </motion.div>
             ))}
@@ -1,3 +1,4 @@
 export { SystemStatsChart } from '@/components/dashboard/SystemStatsChart'
 export { ChannelStatsTable } from '@/components/dashboard/ChannelStatsTable'
 export { ServiceStatusGrid } from '@/components/dashboard/ServiceStatusGrid'
+export { LiveTokenTicker } from '@/components/dashboard/LiveTokenTicker'
@@ -21,6 +21,7 @@ import {
 } from 'lucide-react';
 import { useState } from 'react';
 import clsx from 'clsx';
+import { MaintenanceBanner } from '@/components/MaintenanceBanner';
 
 export const AppLayout = () => {
     const navigate = useNavigate();
@@ -232,6 +233,7 @@ export const AppLayout = () => {
 
                 <div className="flex-1 overflow-auto p-6 sm:p-10 scroll-smooth">
                     <div className="max-w-7xl mx-auto w-full">
+                        <MaintenanceBanner className="mb-6" />
                         <Outlet />
                     </div>
                 </div>
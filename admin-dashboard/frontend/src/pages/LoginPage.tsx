@@ -6,6 +6,7 @@ import { authApi } from '@/api'
 import { useAuthStore } from '@/stores/authStore'
 import { motion } from 'framer-motion'
 import { Loader2, ArrowRight, Lock, User, Play } from 'lucide-react'
+import { MaintenanceBanner } from '@/components/MaintenanceBanner'
 
 const LoginPage = () => {
   const navigate = useNavigate()
@@ -72,6 +73,7 @@ const LoginPage = () => {
         className="w-full max-w-[400px] z-10 px-6"
       >
         <div className="relative">
+          <MaintenanceBanner className="mb-6" />
           {/* Logo Section */}
           <div className="text-center mb-10">
             <motion.div
@@ -267,6 +267,8 @@ export interface TurtleSoupActiveSessionsResponse {
     count: number
 }
 
+export type TurtleSoupPuzzleStatus = 'draft' | 'test' | 'published' | 'pending_review' | 'rejected'
+
 export interface TurtleSoupPuzzle {
     id: number
     title: string
@@ -275,13 +277,17 @@ export interface TurtleSoupPuzzle {
     category: string
     difficulty: number
     hintsJson: string
-    status: 'draft' | 'test' | 'published'
+    status: TurtleSoupPuzzleStatus
     authorId: string
     playCount: number
     solveCount: number
     avgQuestion: number
     createdAt: string
     updatedAt: string
+    source: 'manual' | 'generated'
+    reviewedAt?: string
+    reviewedBy?: string
+    reviewNote?: string
 }
 
 export interface TurtleSoupPuzzlesResponse {
@@ -308,17 +314,32 @@ export interface TurtleSoupPuzzleUpdateRequest {
     solution?: string
     category?: string
     difficulty?: number
-    status?: 'draft' | 'test' | 'published'
+    status?: TurtleSoupPuzzleStatus
     hints?: string[]
 }
 
+// TurtleSoupPuzzleReviewRequest: 생성 퍼즐 승인/반려 (승인 시 비어 있지 않은 필드로 고쳐서 공개)
+export interface TurtleSoupPuzzleReviewRequest {
+    title?: string
+    scenario?: string
+    solution?: string
+    category?: string
+    difficulty?: number
+    hints?: string[]
+    note?: string
+    reviewedBy?: string
+}
+
 export interface TurtleSoupPuzzleStats {
     totalPuzzles: number
     publishedCount: number
     draftCount: number
+    pendingReviewCount: number
     totalPlays: number
     totalSolves: number
     overallSolveRate: number
+    totalRatings: number
+    avgRating: number
 }
 
 export interface TurtleSoupCategoryStats {
@@ -328,10 +349,19 @@ export interface TurtleSoupCategoryStats {
     solveRate: number
 }
 
+export interface TurtleSoupPuzzleRatingStats {
+    puzzleId: number
+    title: string
+    status: string
+    ratingCount: number
+    avgRating: number
+}
+
 export interface TurtleSoupPuzzleStatsResponse {
     status: string
     stats: TurtleSoupPuzzleStats
     categoryStats: TurtleSoupCategoryStats[]
+    ratingStats: TurtleSoupPuzzleRatingStats[] | null
 }
 
 export interface TurtleSoupGameArchive {
@@ -343,6 +373,7 @@ export interface TurtleSoupGameArchive {
     hintsUsed: number
     result: 'solved' | 'surrendered' | 'timeout'
     historyJson: string
+    contributionsJson?: string
     startedAt: string
     completedAt: string
     createdAt: string
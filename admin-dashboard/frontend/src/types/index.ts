@@ -176,6 +176,25 @@ export interface SystemStats {
   serviceGoroutines: ServiceGoroutines[]
 }
 
+export type ActivityEventType =
+  | 'game_started'
+  | 'game_completed'
+  | 'alarm_fired'
+  | 'container_restarted'
+  | 'container_died'
+
+// 활동 피드 이벤트 (/admin/api/ws/activity)
+export interface ActivityEvent {
+  id: string
+  type: ActivityEventType
+  source: string
+  chatId?: string
+  userId?: string
+  occurredAt: string
+  data?: Record<string, unknown>
+  backfill?: boolean
+}
+
 // Docker Types
 export interface DockerContainer {
   name: string
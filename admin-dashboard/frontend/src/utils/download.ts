@@ -0,0 +1,30 @@
+/**
+ * 파일 다운로드 유틸리티
+ *
+ * 관리 API의 ?format=csv 내보내기처럼 Blob으로 받은 응답을 브라우저 다운로드로 저장합니다.
+ */
+
+/** Content-Disposition 헤더에서 파일명을 꺼냅니다. 없으면 fallback을 사용합니다. */
+export function filenameFromDisposition(disposition: string | undefined, fallback: string): string {
+    if (!disposition) {
+        return fallback
+    }
+    const encoded = /filename\*=(?:UTF-8'')?([^;]+)/i.exec(disposition)
+    if (encoded?.[1]) {
+        return decodeURIComponent(encoded[1].replace(/"/g, ''))
+    }
+    const plain = /filename="?([^";]+)"?/i.exec(disposition)
+    return plain?.[1] ?? fallback
+}
+
+/** Blob을 파일로 저장합니다. */
+export function saveBlob(blob: Blob, filename: string): void {
+    const url = URL.createObjectURL(blob)
+    const link = document.createElement('a')
+    link.href = url
+    link.download = filename
+    document.body.appendChild(link)
+    link.click()
+    link.remove()
+    URL.revokeObjectURL(url)
+}
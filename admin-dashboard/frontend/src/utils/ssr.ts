@@ -12,6 +12,7 @@ export interface SSRData {
     settings?: SettingsSSRData
     docker?: DockerHealthSSRData
     containers?: ContainersSSRData
+    maintenance?: MaintenanceSSRData
 }
 
 interface MembersSSRData {
@@ -36,6 +37,15 @@ interface ContainersSSRData {
     containers?: DockerContainer[]
 }
 
+// 점검 공지 (로그인 전에도 주입되며 consume하지 않음)
+export interface MaintenanceSSRData {
+    message: string
+    severity: 'info' | 'warning' | 'critical'
+    eta?: string
+    setBy?: string
+    setAt: string
+}
+
 // 타입 선언 (window에 __SSR_DATA__ 추가)
 declare global {
     interface Window {
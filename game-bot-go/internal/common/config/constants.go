@@ -45,3 +45,45 @@ const (
 	// DefaultOutboundStreamKey: 봇 응답 스트림 키
 	DefaultOutboundStreamKey = "kakao:bot:reply"
 )
+
+// 명령어 Rate Limit 기본값.
+const (
+	// RateLimitUserBurst: 사용자별 연속 허용 명령어 수
+	RateLimitUserBurst = 5
+	// RateLimitUserPerMinute: 사용자별 분당 충전량
+	RateLimitUserPerMinute = 10
+	// RateLimitChatBurst: 채팅방별 연속 허용 명령어 수
+	RateLimitChatBurst = 20
+	// RateLimitChatPerMinute: 채팅방별 분당 충전량
+	RateLimitChatPerMinute = 30
+	// RateLimitNoticeCooldownSeconds: 같은 사용자에게 제한 안내를 다시 보내기까지의 간격(초)
+	RateLimitNoticeCooldownSeconds = 30
+)
+
+// 웹훅 서명 검증 기본값.
+const (
+	// IngressMaxSkewSeconds: 브릿지 요청 타임스탬프 허용 오차(초)
+	IngressMaxSkewSeconds = 300
+)
+
+// 명령어 응답 지연 SLA 기본값.
+const (
+	// LatencySLAMillis: 카카오톡에서 사용자가 답을 기다려 줄 수 있는 체감 한계(ms)
+	LatencySLAMillis = 5000
+	// LatencyRollupAtMinute: 전날 지연 집계를 실행하는 시각 (자정 이후 분, 00:10)
+	LatencyRollupAtMinute = 10
+	// LatencySampleRetentionDays: 집계 후 원본 지연 샘플 보관 일수
+	LatencySampleRetentionDays = 14
+)
+
+// 응답 재전송 대기열(outbox) 기본값.
+const (
+	// OutboxMaxAttempts: 최초 발행을 포함한 최대 전송 시도 횟수 (넘으면 dead-letter로 이동)
+	OutboxMaxAttempts = 6
+	// OutboxBaseDelayMillis: 첫 재시도까지의 대기 시간(ms). 시도마다 두 배로 늘어난다.
+	OutboxBaseDelayMillis = 2000
+	// OutboxMaxDelaySeconds: 재시도 간격 상한(초)
+	OutboxMaxDelaySeconds = 300
+	// OutboxDeadLetterSize: dead-letter 보관 개수
+	OutboxDeadLetterSize = 500
+)
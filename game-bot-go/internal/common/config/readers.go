@@ -413,3 +413,158 @@ func ReadTelemetryConfigFromEnv(serviceName string) (TelemetryConfig, error) {
 		SampleRate:     sampleRate,
 	}, nil
 }
+
+// ReadRateLimitConfigFromEnv: 채팅 명령어 Rate Limit 설정을 환경 변수에서 읽어옵니다.
+// envPrefix(예: "TWENTYQ_")가 붙은 키를 먼저 보고, 없으면 공통 키를 사용합니다.
+func ReadRateLimitConfigFromEnv(envPrefix string) (RateLimitConfig, error) {
+	keys := func(name string) []string {
+		return []string{envPrefix + name, name}
+	}
+
+	enabled, err := BoolFromEnvFirstNonEmpty(keys("RATE_LIMIT_ENABLED"), true)
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("read RATE_LIMIT_ENABLED failed: %w", err)
+	}
+
+	userBurst, err := IntFromEnvFirstNonEmpty(keys("RATE_LIMIT_USER_BURST"), RateLimitUserBurst)
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("read RATE_LIMIT_USER_BURST failed: %w", err)
+	}
+	userPerMinute, err := IntFromEnvFirstNonEmpty(keys("RATE_LIMIT_USER_PER_MINUTE"), RateLimitUserPerMinute)
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("read RATE_LIMIT_USER_PER_MINUTE failed: %w", err)
+	}
+
+	chatBurst, err := IntFromEnvFirstNonEmpty(keys("RATE_LIMIT_CHAT_BURST"), RateLimitChatBurst)
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("read RATE_LIMIT_CHAT_BURST failed: %w", err)
+	}
+	chatPerMinute, err := IntFromEnvFirstNonEmpty(keys("RATE_LIMIT_CHAT_PER_MINUTE"), RateLimitChatPerMinute)
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("read RATE_LIMIT_CHAT_PER_MINUTE failed: %w", err)
+	}
+
+	cooldownSeconds, err := IntFromEnvFirstNonEmpty(keys("RATE_LIMIT_NOTICE_COOLDOWN_SECONDS"), RateLimitNoticeCooldownSeconds)
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("read RATE_LIMIT_NOTICE_COOLDOWN_SECONDS failed: %w", err)
+	}
+	if cooldownSeconds < 0 {
+		return RateLimitConfig{}, fmt.Errorf("invalid RATE_LIMIT_NOTICE_COOLDOWN_SECONDS: %d", cooldownSeconds)
+	}
+
+	return RateLimitConfig{
+		Enabled:        enabled,
+		UserBurst:      userBurst,
+		UserPerMinute:  userPerMinute,
+		ChatBurst:      chatBurst,
+		ChatPerMinute:  chatPerMinute,
+		NoticeCooldown: time.Duration(cooldownSeconds) * time.Second,
+	}, nil
+}
+
+// ReadIngressConfigFromEnv: 웹훅 서명 검증 설정을 환경 변수에서 읽어옵니다. (봇별 prefix 우선)
+func ReadIngressConfigFromEnv(envPrefix string) (IngressConfig, error) {
+	keys := func(name string) []string {
+		return []string{envPrefix + name, name}
+	}
+
+	secret := StringFromEnvFirstNonEmpty(keys("INGRESS_HMAC_SECRET"), "")
+	skewSeconds, err := IntFromEnvFirstNonEmpty(keys("INGRESS_MAX_SKEW_SECONDS"), IngressMaxSkewSeconds)
+	if err != nil {
+		return IngressConfig{}, fmt.Errorf("read INGRESS_MAX_SKEW_SECONDS failed: %w", err)
+	}
+	if skewSeconds <= 0 {
+		return IngressConfig{}, fmt.Errorf("invalid INGRESS_MAX_SKEW_SECONDS: %d", skewSeconds)
+	}
+
+	maxSkew := time.Duration(skewSeconds) * time.Second
+	return IngressConfig{
+		Secret:   secret,
+		MaxSkew:  maxSkew,
+		NonceTTL: 2 * maxSkew,
+	}, nil
+}
+
+// ReadOutboxConfigFromEnv: 응답 재전송 대기열 설정을 환경 변수에서 읽어옵니다. (봇별 prefix 우선)
+func ReadOutboxConfigFromEnv(envPrefix string) (OutboxConfig, error) {
+	keys := func(name string) []string {
+		return []string{envPrefix + name, name}
+	}
+
+	enabled, err := BoolFromEnvFirstNonEmpty(keys("OUTBOX_ENABLED"), true)
+	if err != nil {
+		return OutboxConfig{}, fmt.Errorf("read OUTBOX_ENABLED failed: %w", err)
+	}
+	maxAttempts, err := IntFromEnvFirstNonEmpty(keys("OUTBOX_MAX_ATTEMPTS"), OutboxMaxAttempts)
+	if err != nil {
+		return OutboxConfig{}, fmt.Errorf("read OUTBOX_MAX_ATTEMPTS failed: %w", err)
+	}
+	if maxAttempts < 2 {
+		return OutboxConfig{}, fmt.Errorf("invalid OUTBOX_MAX_ATTEMPTS: %d", maxAttempts)
+	}
+	baseDelayMillis, err := IntFromEnvFirstNonEmpty(keys("OUTBOX_BASE_DELAY_MS"), OutboxBaseDelayMillis)
+	if err != nil {
+		return OutboxConfig{}, fmt.Errorf("read OUTBOX_BASE_DELAY_MS failed: %w", err)
+	}
+	if baseDelayMillis <= 0 {
+		return OutboxConfig{}, fmt.Errorf("invalid OUTBOX_BASE_DELAY_MS: %d", baseDelayMillis)
+	}
+	maxDelaySeconds, err := IntFromEnvFirstNonEmpty(keys("OUTBOX_MAX_DELAY_SECONDS"), OutboxMaxDelaySeconds)
+	if err != nil {
+		return OutboxConfig{}, fmt.Errorf("read OUTBOX_MAX_DELAY_SECONDS failed: %w", err)
+	}
+	if maxDelaySeconds <= 0 {
+		return OutboxConfig{}, fmt.Errorf("invalid OUTBOX_MAX_DELAY_SECONDS: %d", maxDelaySeconds)
+	}
+	deadLetterSize, err := IntFromEnvFirstNonEmpty(keys("OUTBOX_DEAD_LETTER_SIZE"), OutboxDeadLetterSize)
+	if err != nil {
+		return OutboxConfig{}, fmt.Errorf("read OUTBOX_DEAD_LETTER_SIZE failed: %w", err)
+	}
+	if deadLetterSize <= 0 {
+		return OutboxConfig{}, fmt.Errorf("invalid OUTBOX_DEAD_LETTER_SIZE: %d", deadLetterSize)
+	}
+
+	return OutboxConfig{
+		Enabled:        enabled,
+		MaxAttempts:    maxAttempts,
+		BaseDelay:      time.Duration(baseDelayMillis) * time.Millisecond,
+		MaxDelay:       time.Duration(maxDelaySeconds) * time.Second,
+		DeadLetterSize: deadLetterSize,
+	}, nil
+}
+
+// ReadLatencyConfigFromEnv: 명령어 응답 지연 SLA 집계 설정을 환경 변수에서 읽어옵니다.
+func ReadLatencyConfigFromEnv() (LatencyConfig, error) {
+	enabled, err := BoolFromEnv("LATENCY_SLA_ENABLED", true)
+	if err != nil {
+		return LatencyConfig{}, fmt.Errorf("read LATENCY_SLA_ENABLED failed: %w", err)
+	}
+	slaMillis, err := IntFromEnv("LATENCY_SLA_MS", LatencySLAMillis)
+	if err != nil {
+		return LatencyConfig{}, fmt.Errorf("read LATENCY_SLA_MS failed: %w", err)
+	}
+	if slaMillis <= 0 {
+		return LatencyConfig{}, fmt.Errorf("invalid LATENCY_SLA_MS: %d", slaMillis)
+	}
+	rollupAt, err := IntFromEnv("LATENCY_ROLLUP_AT_MINUTE", LatencyRollupAtMinute)
+	if err != nil {
+		return LatencyConfig{}, fmt.Errorf("read LATENCY_ROLLUP_AT_MINUTE failed: %w", err)
+	}
+	if rollupAt < 0 || rollupAt >= 24*60 {
+		return LatencyConfig{}, fmt.Errorf("invalid LATENCY_ROLLUP_AT_MINUTE: %d", rollupAt)
+	}
+	retentionDays, err := IntFromEnv("LATENCY_SAMPLE_RETENTION_DAYS", LatencySampleRetentionDays)
+	if err != nil {
+		return LatencyConfig{}, fmt.Errorf("read LATENCY_SAMPLE_RETENTION_DAYS failed: %w", err)
+	}
+	if retentionDays < 1 {
+		return LatencyConfig{}, fmt.Errorf("invalid LATENCY_SAMPLE_RETENTION_DAYS: %d", retentionDays)
+	}
+
+	return LatencyConfig{
+		Enabled:        enabled,
+		SLA:            time.Duration(slaMillis) * time.Millisecond,
+		RollupAtMinute: rollupAt,
+		RetentionDays:  retentionDays,
+	}, nil
+}
@@ -19,7 +19,8 @@ type LlmConfig struct {
 	APIKey         string
 	Timeout        time.Duration
 	ConnectTimeout time.Duration
-	EnableOTel     bool // gRPC 클라이언트 OpenTelemetry 계측 활성화
+	EnableOTel     bool   // gRPC 클라이언트 OpenTelemetry 계측 활성화
+	BotID          string // LLM 서버 할당량 식별자 (x-bot-id)
 }
 
 // RedisConfig: Redis/Valkey 캐시 연결 설정입니다.
@@ -97,3 +98,39 @@ type TelemetryConfig struct {
 	OTLPInsecure   bool    // TLS 없이 연결 (내부망 전용)
 	SampleRate     float64 // 샘플링 비율 (0.0 ~ 1.0)
 }
+
+// RateLimitConfig: 채팅 명령어 빈도 제한(토큰 버킷) 설정입니다.
+// Burst 또는 PerMinute가 0 이하인 범위(사용자/채팅방)는 제한하지 않습니다.
+type RateLimitConfig struct {
+	Enabled        bool
+	UserBurst      int           // 사용자별 버킷 크기 (연속 허용 횟수)
+	UserPerMinute  int           // 사용자별 분당 충전량
+	ChatBurst      int           // 채팅방별 버킷 크기
+	ChatPerMinute  int           // 채팅방별 분당 충전량
+	NoticeCooldown time.Duration // 제한 안내 메시지 재전송 간격 (도배 방지)
+}
+
+// IngressConfig: 카카오톡 브릿지가 호출하는 게임 HTTP API(/api/)의 서명 검증 설정입니다.
+// Secret이 비어 있으면 검증하지 않습니다.
+type IngressConfig struct {
+	Secret   string        // HMAC-SHA256 공유 비밀키
+	MaxSkew  time.Duration // 요청 타임스탬프 허용 오차
+	NonceTTL time.Duration // 재전송 방지용 nonce 보관 시간 (MaxSkew의 2배 이상)
+}
+
+// OutboxConfig: 발행에 실패한 응답 메시지를 Valkey에 보관했다가 지수 백오프로 다시 보내는 설정입니다.
+type OutboxConfig struct {
+	Enabled        bool
+	MaxAttempts    int           // 최초 발행을 포함한 최대 시도 횟수
+	BaseDelay      time.Duration // 첫 재시도까지의 대기 시간 (시도마다 두 배)
+	MaxDelay       time.Duration // 재시도 간격 상한
+	DeadLetterSize int           // dead-letter 보관 개수 (오래된 것부터 삭제)
+}
+
+// LatencyConfig: 명령어별 응답 지연(수신→첫 응답) 기록과 일별 집계 설정입니다.
+type LatencyConfig struct {
+	Enabled        bool
+	SLA            time.Duration // 이 시간을 넘긴 응답을 느린 응답으로 집계
+	RollupAtMinute int           // 매일 전날 집계를 실행하는 시각 (자정 이후 분)
+	RetentionDays  int           // 원본 샘플 보관 일수
+}
@@ -0,0 +1,32 @@
+package dbutil
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// EachRow: 쿼리 결과를 한 행씩 스캔해 fn에 넘깁니다. 결과 전체를 메모리에 올리지 않으며 쿼리의 정렬을 그대로 따릅니다.
+// (FindInBatches는 기본키 기준으로 정렬을 덮어쓰므로 completed_at 정렬 내보내기에는 쓸 수 없음)
+// fn이 에러를 반환하면 순회를 멈추고 그 에러를 반환합니다.
+func EachRow[T any](db *gorm.DB, fn func(row *T) error) error {
+	rows, err := db.Rows()
+	if err != nil {
+		return fmt.Errorf("query rows failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := db.ScanRows(rows, &row); err != nil {
+			return fmt.Errorf("scan row failed: %w", err)
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate rows failed: %w", err)
+	}
+	return nil
+}
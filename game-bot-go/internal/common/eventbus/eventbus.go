@@ -0,0 +1,165 @@
+// Package eventbus: 게임 라이프사이클 이벤트를 Valkey Pub/Sub 채널로 발행/구독합니다.
+// 관리자 대시보드나 분석 소비자가 DB 폴링 없이 게임 진행 상황을 받아볼 수 있도록 합니다.
+package eventbus
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+)
+
+// ChannelPrefix: 이벤트 채널 접두사. 채널 형식은 "game-events:{game}:{type}" 입니다.
+const ChannelPrefix = "game-events"
+
+// AllEventsPattern: 모든 게임의 모든 이벤트를 구독하는 PSUBSCRIBE 패턴
+const AllEventsPattern = ChannelPrefix + ":*"
+
+// EventType: 게임 라이프사이클 이벤트 종류
+type EventType string
+
+// EventType 상수 목록입니다.
+const (
+	EventGameStarted      EventType = "game_started"
+	EventQuestionAnswered EventType = "question_answered"
+	EventHintUsed         EventType = "hint_used"
+	EventGameCompleted    EventType = "game_completed"
+	// EventAnswerPending: 감독 모드에서 AI 답변이 운영자 검토를 기다리는 중
+	EventAnswerPending EventType = "answer_pending"
+	// EventAnswerReviewed: 운영자 검토(승인/수정/시간 초과)가 끝나 답변이 확정됨
+	EventAnswerReviewed EventType = "answer_reviewed"
+	// EventBonusCompleted: 정답 후 보너스 라운드가 끝남 (답변 또는 건너뛰기)
+	EventBonusCompleted EventType = "bonus_completed"
+)
+
+// Game 식별자 상수 목록입니다.
+const (
+	GameTwentyQ    = "twentyq"
+	GameTurtleSoup = "turtlesoup"
+)
+
+// Event: 채널로 발행되는 게임 이벤트 (JSON 직렬화)
+type Event struct {
+	ID         string         `json:"id"`
+	Type       EventType      `json:"type"`
+	Game       string         `json:"game"`
+	ChatID     string         `json:"chatId"`
+	SessionID  string         `json:"sessionId,omitempty"`
+	UserID     string         `json:"userId,omitempty"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// Channel: 게임/이벤트 종류별 채널 이름을 반환합니다.
+func Channel(game string, eventType EventType) string {
+	return ChannelPrefix + ":" + game + ":" + string(eventType)
+}
+
+// GamePattern: 특정 게임의 모든 이벤트를 구독하는 PSUBSCRIBE 패턴을 반환합니다.
+func GamePattern(game string) string {
+	return ChannelPrefix + ":" + game + ":*"
+}
+
+// publishTimeout: 이벤트 발행이 게임 응답을 지연시키지 않도록 짧게 제한
+const publishTimeout = 500 * time.Millisecond
+
+// Publisher: 특정 게임의 이벤트를 발행하는 발행자. nil이면 아무 것도 하지 않습니다.
+type Publisher struct {
+	client valkey.Client
+	game   string
+	logger *slog.Logger
+}
+
+// NewPublisher: 새로운 Publisher 인스턴스를 생성합니다.
+func NewPublisher(client valkey.Client, game string, logger *slog.Logger) *Publisher {
+	if client == nil {
+		return nil
+	}
+	return &Publisher{
+		client: client,
+		game:   game,
+		logger: logger,
+	}
+}
+
+// Publish: 이벤트를 PUBLISH로 발행합니다.
+// 이벤트는 부가 정보이므로 실패해도 게임 진행에는 영향을 주지 않고 경고 로그만 남깁니다.
+func (p *Publisher) Publish(ctx context.Context, eventType EventType, chatID string, userID string, data map[string]any) {
+	p.PublishEvent(ctx, Event{
+		Type:   eventType,
+		ChatID: chatID,
+		UserID: userID,
+		Data:   data,
+	})
+}
+
+// PublishEvent: 채워진 Event를 발행합니다. ID/Game/OccurredAt이 비어 있으면 채웁니다.
+func (p *Publisher) PublishEvent(ctx context.Context, event Event) {
+	if p == nil {
+		return
+	}
+
+	if event.ID == "" {
+		event.ID = newEventID()
+	}
+	if event.Game == "" {
+		event.Game = p.game
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	event.ChatID = strings.TrimSpace(event.ChatID)
+	event.UserID = strings.TrimSpace(event.UserID)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Warn("game_event_marshal_failed", "type", event.Type, "err", err)
+		return
+	}
+
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+	defer cancel()
+
+	channel := Channel(event.Game, event.Type)
+	cmd := p.client.B().Publish().Channel(channel).Message(string(payload)).Build()
+	if err := p.client.Do(publishCtx, cmd).Error(); err != nil {
+		p.logger.Warn("game_event_publish_failed", "channel", channel, "chat_id", event.ChatID, "err", err)
+		return
+	}
+	p.logger.Debug("game_event_published", "channel", channel, "chat_id", event.ChatID)
+}
+
+// Subscribe: pattern(예: AllEventsPattern)에 해당하는 채널을 구독하고 이벤트마다 handler를 호출합니다.
+// ctx가 종료되거나 연결이 끊기면 반환합니다. 파싱할 수 없는 메시지는 건너뜁니다.
+func Subscribe(ctx context.Context, client valkey.Client, pattern string, handler func(Event)) error {
+	if client == nil {
+		return fmt.Errorf("valkey client is nil")
+	}
+
+	cmd := client.B().Psubscribe().Pattern(pattern).Build()
+	err := client.Receive(ctx, cmd, func(msg valkey.PubSubMessage) {
+		var event Event
+		if err := json.Unmarshal([]byte(msg.Message), &event); err != nil {
+			return
+		}
+		handler(event)
+	})
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("subscribe game events failed pattern=%s: %w", pattern, err)
+	}
+	return nil
+}
+
+func newEventID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,62 @@
+package eventbus
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func TestChannel(t *testing.T) {
+	got := Channel(GameTwentyQ, EventGameStarted)
+	if got != "game-events:twentyq:game_started" {
+		t.Fatalf("unexpected channel: %s", got)
+	}
+}
+
+func TestNilPublisher_NoPanic(t *testing.T) {
+	p := NewPublisher(nil, GameTurtleSoup, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if p != nil {
+		t.Fatalf("expected nil publisher for nil client")
+	}
+	p.Publish(context.Background(), EventHintUsed, "chat", "user", nil)
+}
+
+func TestPublishSubscribe_RoundTrip(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	received := make(chan Event, 1)
+	go func() {
+		_ = Subscribe(ctx, client, AllEventsPattern, func(e Event) {
+			received <- e
+		})
+	}()
+
+	p := NewPublisher(client, GameTwentyQ, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	// 구독이 등록될 때까지 재발행
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		p.Publish(ctx, EventQuestionAnswered, "chat-1", "user-1", map[string]any{"questionNumber": 1})
+		select {
+		case e := <-received:
+			if e.Type != EventQuestionAnswered || e.Game != GameTwentyQ || e.ChatID != "chat-1" || e.UserID != "user-1" {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+			if e.ID == "" || e.OccurredAt.IsZero() {
+				t.Fatalf("expected id/occurredAt to be filled: %+v", e)
+			}
+			return
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for event")
+		case <-ticker.C:
+		}
+	}
+}
@@ -31,16 +31,47 @@ type Response struct {
 	Goroutines int    `json:"goroutines"`
 }
 
+// 상태 값
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Component: 상세 헬스 응답의 구성 요소별 상태
+type Component struct {
+	Status string         `json:"status"`
+	Detail map[string]any `json:"detail"`
+}
+
+// DetailedResponse: /health/detail 엔드포인트 응답 (구성 요소 중 하나라도 ok가 아니면 degraded)
+type DetailedResponse struct {
+	Response
+	Components map[string]Component `json:"components"`
+}
+
 // Get: 현재 상태 반환
 func Get() Response {
 	return Response{
-		Status:     "ok",
+		Status:     StatusOK,
 		Version:    version,
 		Uptime:     formatDuration(time.Since(startTime)),
 		Goroutines: runtime.NumGoroutine(),
 	}
 }
 
+// Detailed: 기본 상태에 구성 요소별 점검 결과를 더한 응답을 반환합니다.
+func Detailed(components map[string]Component) DetailedResponse {
+	resp := DetailedResponse{Response: Get(), Components: components}
+	for _, c := range components {
+		if c.Status != StatusOK {
+			resp.Status = StatusDegraded
+			break
+		}
+	}
+	return resp
+}
+
 // formatDuration: Duration을 사람이 읽기 쉬운 형식으로 변환
 func formatDuration(d time.Duration) string {
 	d = d.Round(time.Second)
@@ -0,0 +1,193 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultSchemaCheckTTL: 카탈로그 조회 결과 캐시 기간 (상태 수집 주기보다 길게 잡아 DB 부하를 줄입니다)
+const DefaultSchemaCheckTTL = time.Minute
+
+// SchemaExpectation: 반드시 존재해야 하는 테이블과 그 테이블의 컬럼/인덱스 목록
+type SchemaExpectation struct {
+	Table   string
+	Columns []string
+	Indexes []string
+}
+
+// ModelExpectations: GORM 모델 정의에서 테이블/컬럼/인덱스 기대값을 추출합니다.
+// AutoMigrate와 같은 모델 목록을 넘기면 마이그레이션 결과를 그대로 검증할 수 있습니다.
+func ModelExpectations(db *gorm.DB, models ...any) ([]SchemaExpectation, error) {
+	out := make([]SchemaExpectation, 0, len(models))
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("parse model schema failed: %w", err)
+		}
+
+		exp := SchemaExpectation{Table: stmt.Schema.Table}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" {
+				exp.Columns = append(exp.Columns, field.DBName)
+			}
+		}
+		for _, idx := range stmt.Schema.ParseIndexes() {
+			exp.Indexes = append(exp.Indexes, idx.Name)
+		}
+		slices.Sort(exp.Indexes)
+		out = append(out, exp)
+	}
+	return out, nil
+}
+
+// schemaCatalog: 현재 스키마의 테이블별 컬럼/인덱스 집합
+type schemaCatalog map[string]*tableCatalog
+
+type tableCatalog struct {
+	columns map[string]struct{}
+	indexes map[string]struct{}
+}
+
+func (c schemaCatalog) table(name string) *tableCatalog {
+	t, ok := c[name]
+	if !ok {
+		t = &tableCatalog{columns: make(map[string]struct{}), indexes: make(map[string]struct{})}
+		c[name] = t
+	}
+	return t
+}
+
+// SchemaChecker: Postgres 카탈로그를 조회해 필수 스키마 객체가 모두 있는지 확인합니다.
+// 부분 마이그레이션 이후에도 봇이 정상으로 보고되는 상황을 잡아내기 위한 점검으로, 결과는 TTL 동안 캐시합니다.
+type SchemaChecker struct {
+	expectations []SchemaExpectation
+	ttl          time.Duration
+	load         func(ctx context.Context) (schemaCatalog, error)
+	now          func() time.Time
+
+	mu       sync.Mutex
+	cached   Component
+	cachedAt time.Time
+}
+
+// NewSchemaChecker: 새로운 SchemaChecker 인스턴스를 생성합니다. ttl이 0 이하이면 DefaultSchemaCheckTTL을 사용합니다.
+func NewSchemaChecker(db *sql.DB, ttl time.Duration, expectations []SchemaExpectation) *SchemaChecker {
+	if ttl <= 0 {
+		ttl = DefaultSchemaCheckTTL
+	}
+	return &SchemaChecker{
+		expectations: expectations,
+		ttl:          ttl,
+		load: func(ctx context.Context) (schemaCatalog, error) {
+			return loadSchemaCatalog(ctx, db)
+		},
+		now: time.Now,
+	}
+}
+
+// Check: 스키마 점검 결과를 반환합니다. 캐시가 유효하면 카탈로그를 다시 조회하지 않습니다.
+func (c *SchemaChecker) Check(ctx context.Context) Component {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if !c.cachedAt.IsZero() && now.Sub(c.cachedAt) < c.ttl {
+		return c.cached
+	}
+
+	detail := map[string]any{
+		"checked_at": now.UTC().Format(time.RFC3339),
+		"tables":     len(c.expectations),
+	}
+	catalog, err := c.load(ctx)
+	if err != nil {
+		detail["error"] = err.Error()
+		// 조회 실패는 일시적일 수 있으므로 캐시하지 않습니다.
+		return Component{Status: StatusDown, Detail: detail}
+	}
+
+	status := StatusOK
+	if missing := missingObjects(c.expectations, catalog); len(missing) > 0 {
+		status = StatusDegraded
+		detail["missing"] = missing
+	}
+
+	c.cached = Component{Status: status, Detail: detail}
+	c.cachedAt = now
+	return c.cached
+}
+
+// missingObjects: 기대값과 카탈로그를 비교해 없는 객체를 "table:x", "column:x.y", "index:x.y" 형식으로 나열합니다.
+func missingObjects(expectations []SchemaExpectation, catalog schemaCatalog) []string {
+	var missing []string
+	for _, exp := range expectations {
+		table, ok := catalog[exp.Table]
+		if !ok {
+			// 테이블이 없으면 하위 객체는 당연히 없으므로 테이블만 보고합니다.
+			missing = append(missing, "table:"+exp.Table)
+			continue
+		}
+		for _, col := range exp.Columns {
+			if _, ok := table.columns[col]; !ok {
+				missing = append(missing, "column:"+exp.Table+"."+col)
+			}
+		}
+		for _, idx := range exp.Indexes {
+			if _, ok := table.indexes[idx]; !ok {
+				missing = append(missing, "index:"+exp.Table+"."+idx)
+			}
+		}
+	}
+	return missing
+}
+
+const (
+	schemaColumnsQuery = `SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = current_schema()`
+	schemaIndexesQuery = `SELECT tablename, indexname FROM pg_indexes WHERE schemaname = current_schema()`
+)
+
+// loadSchemaCatalog: information_schema/pg_indexes에서 현재 스키마의 컬럼과 인덱스를 한 번에 읽습니다.
+func loadSchemaCatalog(ctx context.Context, db *sql.DB) (schemaCatalog, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+
+	catalog := make(schemaCatalog)
+	if err := scanCatalogPairs(ctx, db, schemaColumnsQuery, func(table, column string) {
+		catalog.table(table).columns[column] = struct{}{}
+	}); err != nil {
+		return nil, fmt.Errorf("query columns failed: %w", err)
+	}
+	if err := scanCatalogPairs(ctx, db, schemaIndexesQuery, func(table, index string) {
+		catalog.table(table).indexes[index] = struct{}{}
+	}); err != nil {
+		return nil, fmt.Errorf("query indexes failed: %w", err)
+	}
+	return catalog, nil
+}
+
+func scanCatalogPairs(ctx context.Context, db *sql.DB, query string, fn func(a, b string)) error {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("query failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var a, b string
+		if err := rows.Scan(&a, &b); err != nil {
+			return fmt.Errorf("scan failed: %w", err)
+		}
+		fn(a, b)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package httputil
+
+import (
+	"encoding/csv"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatCSV: 목록 API의 format 쿼리 값 (전체 행을 CSV로 내려받기)
+	FormatCSV = "csv"
+	// ContentTypeCSV: CSV 응답을 위한 Content-Type 헤더 값
+	ContentTypeCSV = "text/csv; charset=utf-8"
+	// HeaderContentDisposition: 다운로드 파일명을 지정하는 헤더 이름
+	HeaderContentDisposition = "Content-Disposition"
+)
+
+// utf8BOM: Excel이 UTF-8 CSV의 한글을 깨뜨리지 않도록 앞에 붙이는 BOM
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// WantsCSV: 요청이 ?format=csv 로 CSV 내보내기를 원하는지 확인합니다.
+func WantsCSV(r *http.Request) bool {
+	return strings.EqualFold(strings.TrimSpace(r.URL.Query().Get("format")), FormatCSV)
+}
+
+// CSVStream: 행 단위로 인코딩하며 바로 전송하는 CSV 응답 작성기
+// 페이지네이션 없이 전체 행을 보내므로 메모리에 결과를 모으지 않고 streamFlushEvery 행마다 Flush 합니다.
+type CSVStream struct {
+	w       *csv.Writer
+	flusher http.Flusher
+	rows    int
+}
+
+// NewCSVStream: 다운로드 헤더와 BOM, 헤더 행을 먼저 보낸 뒤 작성기를 반환합니다.
+// filename에는 확장자를 포함하며, 헤더를 먼저 보내므로 이후 실패해도 상태 코드는 200으로 유지됩니다.
+func NewCSVStream(w http.ResponseWriter, filename string, header []string) (*CSVStream, error) {
+	w.Header().Set(HeaderContentType, ContentTypeCSV)
+	w.Header().Set(HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(utf8BOM); err != nil {
+		return nil, fmt.Errorf("write csv bom failed: %w", err)
+	}
+	flusher, _ := w.(http.Flusher)
+	s := &CSVStream{w: csv.NewWriter(w), flusher: flusher}
+	if err := s.w.Write(header); err != nil {
+		return nil, fmt.Errorf("write csv header failed: %w", err)
+	}
+	return s, nil
+}
+
+// Write: 행 하나를 씁니다. 스프레드시트 수식으로 해석될 수 있는 셀은 무력화합니다.
+func (s *CSVStream) Write(record []string) error {
+	escaped := make([]string, len(record))
+	for i, cell := range record {
+		escaped[i] = neutralizeFormula(cell)
+	}
+	if err := s.w.Write(escaped); err != nil {
+		return fmt.Errorf("write csv row failed: %w", err)
+	}
+	s.rows++
+	if s.rows%streamFlushEvery == 0 {
+		s.w.Flush()
+		if s.flusher != nil {
+			s.flusher.Flush()
+		}
+	}
+	return nil
+}
+
+// Rows: 지금까지 쓴 데이터 행 수 (헤더 제외)
+func (s *CSVStream) Rows() int {
+	return s.rows
+}
+
+// Close: 버퍼에 남은 행을 내보냅니다.
+func (s *CSVStream) Close() error {
+	s.w.Flush()
+	if err := s.w.Error(); err != nil {
+		return fmt.Errorf("flush csv failed: %w", err)
+	}
+	if s.flusher != nil {
+		s.flusher.Flush()
+	}
+	return nil
+}
+
+// neutralizeFormula: =, +, -, @, 탭, CR 로 시작하는 셀 앞에 '를 붙여 CSV 수식 주입을 막습니다.
+// 음수 같은 순수 숫자는 그대로 둡니다.
+func neutralizeFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
+
+// CSVTime: 시각을 RFC3339(UTC)로 포맷합니다. 영값이면 빈 셀입니다.
+func CSVTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// CSVTimePtr: nil 가능 시각을 포맷합니다.
+func CSVTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return CSVTime(*t)
+}
+
+// CSVInt: 정수를 셀 문자열로 바꿉니다.
+func CSVInt[T ~int | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// CSVIntPtr: nil 가능 정수를 포맷합니다.
+func CSVIntPtr[T ~int | ~int32 | ~int64](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return CSVInt(*v)
+}
+
+// CSVStringPtr: nil 가능 문자열을 포맷합니다.
+func CSVStringPtr(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
@@ -0,0 +1,76 @@
+package httputil
+
+import (
+	"encoding/csv"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCSVStream_EscapesAndNeutralizesFormulas(t *testing.T) {
+	rr := httptest.NewRecorder()
+	stream, err := NewCSVStream(rr, "games.csv", []string{"name", "note", "score"})
+	if err != nil {
+		t.Fatalf("NewCSVStream failed: %v", err)
+	}
+	rows := [][]string{
+		{"사과, 배", "say \"hi\"\nbye", "-3"},
+		{"=HYPERLINK(\"x\")", "+1+2", "@SUM(A1)"},
+	}
+	for _, row := range rows {
+		if err := stream.Write(row); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	if got := rr.Header().Get(HeaderContentType); got != ContentTypeCSV {
+		t.Fatalf("content type = %q", got)
+	}
+	if got := rr.Header().Get(HeaderContentDisposition); got != `attachment; filename=games.csv` {
+		t.Fatalf("content disposition = %q", got)
+	}
+	body := rr.Body.String()
+	if !strings.HasPrefix(body, string(utf8BOM)) {
+		t.Fatalf("expected UTF-8 BOM prefix")
+	}
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(body, string(utf8BOM)))).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid csv: %v", err)
+	}
+	want := [][]string{
+		{"name", "note", "score"},
+		{"사과, 배", "say \"hi\"\nbye", "-3"},
+		{"'=HYPERLINK(\"x\")", "'+1+2", "'@SUM(A1)"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("records = %v", records)
+	}
+	for i := range want {
+		for j := range want[i] {
+			if records[i][j] != want[i][j] {
+				t.Fatalf("record[%d][%d] = %q, want %q", i, j, records[i][j], want[i][j])
+			}
+		}
+	}
+	if stream.Rows() != 2 {
+		t.Fatalf("Rows() = %d, want 2", stream.Rows())
+	}
+}
+
+func TestWantsCSV(t *testing.T) {
+	cases := map[string]bool{
+		"/admin/games?format=csv":  true,
+		"/admin/games?format=CSV":  true,
+		"/admin/games?format=json": false,
+		"/admin/games":             false,
+	}
+	for target, want := range cases {
+		if got := WantsCSV(httptest.NewRequest("GET", target, nil)); got != want {
+			t.Fatalf("WantsCSV(%s) = %v, want %v", target, got, want)
+		}
+	}
+}
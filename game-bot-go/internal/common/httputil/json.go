@@ -42,16 +42,9 @@ func ReadJSON(r *http.Request, out any, maxBytes int64) error {
 }
 
 // WriteJSON: 데이터를 JSON으로 인코딩하여 HTTP 응답으로 전송합니다.
+// 헤더를 보내기 전에 인코딩하므로 실패하거나 DefaultMaxResponseBytes를 넘으면 500 에러 응답으로 대체됩니다.
 func WriteJSON(w http.ResponseWriter, status int, v any) error {
-	w.Header().Set("Content-Type", ContentTypeJSON)
-	w.WriteHeader(status)
-
-	enc := json.NewEncoder(w)
-	enc.SetEscapeHTML(false)
-	if err := enc.Encode(v); err != nil {
-		return fmt.Errorf("encode json failed: %w", err)
-	}
-	return nil
+	return WriteJSONLimit(w, status, v, DefaultMaxResponseBytes)
 }
 
 // ErrorResponse: 표준 에러 응답 구조체
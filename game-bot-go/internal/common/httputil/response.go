@@ -0,0 +1,177 @@
+package httputil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	json "github.com/goccy/go-json"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// 응답 크기 제한 상수
+const (
+	// DefaultMaxResponseBytes: JSON 응답 본문 기본 상한 (대시보드 프록시/카카오 브릿지 보호)
+	DefaultMaxResponseBytes = 1 << 20
+	// streamFlushEvery: 배열 스트리밍 시 몇 개 항목마다 Flush 할지 (chunked 전송)
+	streamFlushEvery = 64
+)
+
+// ErrorResponseTooLarge: 응답이 상한을 넘어 보낼 수 없을 때의 에러 코드
+const ErrorResponseTooLarge = "RESPONSE_TOO_LARGE"
+
+// ErrResponseTooLarge: 인코딩 결과가 응답 크기 상한을 넘었을 때 발생하는 에러
+var ErrResponseTooLarge = errors.New("response too large")
+
+// responseTruncatedTotal: 크기 상한 때문에 잘리거나 거절된 응답 수
+// mode: paginated(배열을 잘라 nextOffset 안내), rejected(객체 응답을 에러로 대체)
+var responseTruncatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "game_bot_http_response_truncated_total",
+	Help: "Number of JSON responses truncated or rejected because they exceeded the size limit.",
+}, []string{"mode"})
+
+// EncodeJSON: 값을 JSON으로 인코딩합니다. 인코더 내부 panic은 에러로 변환합니다.
+// WriteJSON과 같이 HTML 이스케이프를 하지 않으며 끝에 개행을 붙이지 않습니다.
+func EncodeJSON(v any) (data []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			data = nil
+			err = fmt.Errorf("encode json panicked: %v", r)
+		}
+	}()
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("encode json failed: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// WriteJSONLimit: 값을 인코딩해 maxBytes 이하일 때만 전송합니다.
+// 인코딩에 실패하면 500, 상한을 넘으면 500 RESPONSE_TOO_LARGE 에러 응답으로 대체합니다.
+// 객체 응답은 안전하게 자를 수 없으므로 큰 목록은 StreamJSONArray를 사용해야 합니다.
+func WriteJSONLimit(w http.ResponseWriter, status int, v any, maxBytes int) error {
+	data, err := EncodeJSON(v)
+	if err != nil {
+		writeRawJSON(w, http.StatusInternalServerError, mustEncodeError("INTERNAL_ERROR", "failed to encode response"))
+		return err
+	}
+	if maxBytes > 0 && len(data) > maxBytes {
+		responseTruncatedTotal.WithLabelValues("rejected").Inc()
+		writeRawJSON(w, http.StatusInternalServerError, mustEncodeError(
+			ErrorResponseTooLarge,
+			fmt.Sprintf("response exceeds %d bytes; narrow the query or use pagination", maxBytes),
+		))
+		return fmt.Errorf("%w: %d > %d bytes", ErrResponseTooLarge, len(data), maxBytes)
+	}
+	writeRawJSON(w, status, data)
+	return nil
+}
+
+// StreamJSONArray: fields에 items 배열(key)을 더한 JSON 객체를 항목 단위로 인코딩하며 스트리밍 전송합니다.
+// 누적 크기가 maxBytes를 넘으면 배열을 거기서 끊고 "truncated": true와 다음 요청 위치("nextOffset")를 함께 보냅니다.
+// offset은 items[0]의 전체 목록 기준 위치이며, 헤더를 먼저 보내므로 도중에 실패해도 상태 코드는 바뀌지 않습니다.
+func StreamJSONArray[T any](w http.ResponseWriter, status int, fields map[string]any, key string, items []T, offset int, maxBytes int) error {
+	head, err := encodeObjectFields(fields, key)
+	if err != nil {
+		writeRawJSON(w, http.StatusInternalServerError, mustEncodeError("INTERNAL_ERROR", "failed to encode response"))
+		return err
+	}
+
+	w.Header().Set(HeaderContentType, ContentTypeJSON)
+	w.WriteHeader(status)
+	flusher, _ := w.(http.Flusher)
+
+	written := len(head)
+	if _, err := w.Write(head); err != nil {
+		return fmt.Errorf("write response failed: %w", err)
+	}
+
+	var tail []byte
+	for i, item := range items {
+		data, encErr := EncodeJSON(item)
+		if encErr != nil {
+			tail = []byte(`],"truncated":true,"error":"ENCODE_FAILED"}` + "\n")
+			err = encErr
+			break
+		}
+		if maxBytes > 0 && written+len(data)+1 > maxBytes {
+			responseTruncatedTotal.WithLabelValues("paginated").Inc()
+			tail = []byte(`],"truncated":true,"nextOffset":` + strconv.Itoa(offset+i) + "}\n")
+			break
+		}
+		if i > 0 {
+			data = append([]byte{','}, data...)
+		}
+		if _, writeErr := w.Write(data); writeErr != nil {
+			return fmt.Errorf("write response failed: %w", writeErr)
+		}
+		written += len(data)
+		if flusher != nil && (i+1)%streamFlushEvery == 0 {
+			flusher.Flush()
+		}
+	}
+	if tail == nil {
+		tail = []byte(`],"truncated":false}` + "\n")
+	}
+	if _, writeErr := w.Write(tail); writeErr != nil {
+		return fmt.Errorf("write response failed: %w", writeErr)
+	}
+	return err
+}
+
+// encodeObjectFields: 배열 앞부분 `{"a":1,...,"key":[` 를 키 순서대로 만듭니다.
+func encodeObjectFields(fields map[string]any, key string) ([]byte, error) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		if k != key {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, k := range keys {
+		name, err := EncodeJSON(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := EncodeJSON(fields[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+		buf.WriteByte(',')
+	}
+	name, err := EncodeJSON(key)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(name)
+	buf.WriteString(":[")
+	return buf.Bytes(), nil
+}
+
+func writeRawJSON(w http.ResponseWriter, status int, data []byte) {
+	w.Header().Set(HeaderContentType, ContentTypeJSON)
+	w.WriteHeader(status)
+	_, _ = w.Write(append(data, '\n'))
+}
+
+// mustEncodeError: 고정 필드 에러 응답은 인코딩에 실패하지 않습니다.
+func mustEncodeError(code string, message string) []byte {
+	data, err := EncodeJSON(ErrorResponse{Error: code, Message: message})
+	if err != nil {
+		return []byte(`{"error":"INTERNAL_ERROR","message":"failed to encode response"}`)
+	}
+	return data
+}
@@ -0,0 +1,100 @@
+package httputil
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	json "github.com/goccy/go-json"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type panicMarshaler struct{}
+
+func (panicMarshaler) MarshalJSON() ([]byte, error) { panic("boom") }
+
+func TestEncodeJSON_RecoversPanic(t *testing.T) {
+	if _, err := EncodeJSON(panicMarshaler{}); err == nil || !strings.Contains(err.Error(), "panicked") {
+		t.Fatalf("expected recovered panic error, got %v", err)
+	}
+}
+
+func TestWriteJSONLimit_RejectsOversized(t *testing.T) {
+	before := testutil.ToFloat64(responseTruncatedTotal.WithLabelValues("rejected"))
+
+	rr := httptest.NewRecorder()
+	err := WriteJSONLimit(rr, http.StatusOK, map[string]string{"data": strings.Repeat("x", 200)}, 100)
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+	if rr.Code != http.StatusInternalServerError || !strings.Contains(rr.Body.String(), ErrorResponseTooLarge) {
+		t.Fatalf("unexpected response: %d %s", rr.Code, rr.Body.String())
+	}
+	if got := testutil.ToFloat64(responseTruncatedTotal.WithLabelValues("rejected")); got != before+1 {
+		t.Fatalf("expected rejected metric to increase, got %v -> %v", before, got)
+	}
+}
+
+func TestWriteJSON_EncodeFailureReturns500(t *testing.T) {
+	rr := httptest.NewRecorder()
+	if err := WriteJSON(rr, http.StatusOK, panicMarshaler{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d", rr.Code)
+	}
+}
+
+func TestStreamJSONArray_Complete(t *testing.T) {
+	rr := httptest.NewRecorder()
+	items := []map[string]int{{"n": 1}, {"n": 2}}
+	if err := StreamJSONArray(rr, http.StatusOK, map[string]any{"status": "ok", "total": 2}, "items", items, 0, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out struct {
+		Status    string           `json:"status"`
+		Total     int              `json:"total"`
+		Items     []map[string]int `json:"items"`
+		Truncated bool             `json:"truncated"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &out); err != nil {
+		t.Fatalf("invalid json %q: %v", rr.Body.String(), err)
+	}
+	if out.Status != "ok" || out.Total != 2 || len(out.Items) != 2 || out.Truncated {
+		t.Fatalf("unexpected body: %s", rr.Body.String())
+	}
+}
+
+func TestStreamJSONArray_TruncatesWithNextOffset(t *testing.T) {
+	before := testutil.ToFloat64(responseTruncatedTotal.WithLabelValues("paginated"))
+
+	items := make([]string, 50)
+	for i := range items {
+		items[i] = strings.Repeat("y", 20)
+	}
+	rr := httptest.NewRecorder()
+	if err := StreamJSONArray(rr, http.StatusOK, map[string]any{"status": "ok"}, "items", items, 100, 200); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out struct {
+		Items      []string `json:"items"`
+		Truncated  bool     `json:"truncated"`
+		NextOffset int      `json:"nextOffset"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &out); err != nil {
+		t.Fatalf("invalid json %q: %v", rr.Body.String(), err)
+	}
+	if !out.Truncated || len(out.Items) == 0 || len(out.Items) >= len(items) || out.NextOffset != 100+len(out.Items) {
+		t.Fatalf("unexpected truncation: items=%d truncated=%v next=%d", len(out.Items), out.Truncated, out.NextOffset)
+	}
+	if rr.Body.Len() > 200+len(`],"truncated":true,"nextOffset":1000}`)+1 {
+		t.Fatalf("body exceeds limit: %d bytes", rr.Body.Len())
+	}
+	if got := testutil.ToFloat64(responseTruncatedTotal.WithLabelValues("paginated")); got != before+1 {
+		t.Fatalf("expected paginated metric to increase, got %v -> %v", before, got)
+	}
+}
@@ -0,0 +1,199 @@
+// Package ingress: 카카오톡 브릿지가 게임 봇 HTTP API로 보내는 웹훅 요청의 서명을 검증합니다.
+//
+// 브릿지는 요청마다 다음 헤더를 붙입니다.
+//
+//	X-Kakao-Timestamp: 유닉스 초
+//	X-Kakao-Nonce:     요청마다 다른 임의 문자열
+//	X-Kakao-Signature: sha256=<hex(HMAC-SHA256(secret, timestamp + "." + nonce + "." + body))>
+//
+// 타임스탬프가 허용 오차를 벗어나거나 같은 nonce가 다시 들어오면(재전송) 거절합니다.
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/valkey-io/valkey-go"
+
+	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+)
+
+// 서명 헤더 이름
+const (
+	HeaderTimestamp = "X-Kakao-Timestamp"
+	HeaderNonce     = "X-Kakao-Nonce"
+	HeaderSignature = "X-Kakao-Signature"
+)
+
+const (
+	// ProtectedPathPrefix: 서명을 요구하는 경로 (관리자/헬스체크/메트릭 경로는 제외)
+	ProtectedPathPrefix = "/api/"
+	// maxBodyBytes: 서명 검증을 위해 읽는 요청 본문 상한
+	maxBodyBytes = 1 << 20
+	// maxNonceLength: nonce 최대 길이 (키 크기 제한)
+	maxNonceLength  = 128
+	signaturePrefix = "sha256="
+)
+
+// 거절 사유 (에러 코드와 메트릭 라벨에 사용)
+const (
+	ReasonMissingHeaders   = "MISSING_SIGNATURE"
+	ReasonInvalidSignature = "INVALID_SIGNATURE"
+	ReasonStaleTimestamp   = "STALE_TIMESTAMP"
+	ReasonReplayed         = "REPLAYED_REQUEST"
+	ReasonBodyTooLarge     = "BODY_TOO_LARGE"
+	ReasonNonceStore       = "NONCE_STORE_UNAVAILABLE"
+)
+
+var rejectedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "game_bot_ingress_rejected_total",
+	Help: "Number of inbound bridge requests rejected by signature verification.",
+}, []string{"bot", "reason"})
+
+// Verifier: /api/ 요청의 HMAC 서명, 타임스탬프, nonce 재사용 여부를 검사하는 HTTP 미들웨어입니다.
+type Verifier struct {
+	secret    []byte
+	maxSkew   time.Duration
+	nonceTTL  time.Duration
+	client    valkey.Client
+	keyPrefix string
+	bot       string
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewVerifier: Verifier를 생성합니다. 비밀키가 없으면 검증을 끄고 nil을 반환합니다.
+// keyPrefix는 봇별 Valkey 키 prefix이며 nonce는 {keyPrefix}:ingress:nonce:{nonce}에 보관합니다.
+func NewVerifier(cfg commonconfig.IngressConfig, client valkey.Client, keyPrefix string, bot string, logger *slog.Logger) *Verifier {
+	if strings.TrimSpace(cfg.Secret) == "" {
+		logger.Warn("ingress_signature_disabled", "bot", bot, "reason", "INGRESS_HMAC_SECRET not set")
+		return nil
+	}
+	return &Verifier{
+		secret:    []byte(cfg.Secret),
+		maxSkew:   cfg.MaxSkew,
+		nonceTTL:  max(cfg.NonceTTL, 2*cfg.MaxSkew),
+		client:    client,
+		keyPrefix: keyPrefix,
+		bot:       bot,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Wrap: next 앞에 서명 검증을 붙입니다. nil Verifier(검증 비활성화)면 next를 그대로 반환합니다.
+func (v *Verifier) Wrap(next http.Handler) http.Handler {
+	if v == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, ProtectedPathPrefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if reason, err := v.verify(r); reason != "" {
+			v.reject(w, r, reason, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verify: 요청을 검증하고 실패하면 거절 사유를 반환합니다. 성공하면 본문을 다시 읽을 수 있게 되돌려 둡니다.
+func (v *Verifier) verify(r *http.Request) (string, error) {
+	timestamp := strings.TrimSpace(r.Header.Get(HeaderTimestamp))
+	nonce := strings.TrimSpace(r.Header.Get(HeaderNonce))
+	signature := strings.TrimSpace(r.Header.Get(HeaderSignature))
+	if timestamp == "" || nonce == "" || signature == "" || len(nonce) > maxNonceLength {
+		return ReasonMissingHeaders, nil
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ReasonStaleTimestamp, fmt.Errorf("parse timestamp: %w", err)
+	}
+	skew := v.now().Sub(time.Unix(unix, 0))
+	if skew > v.maxSkew || skew < -v.maxSkew {
+		return ReasonStaleTimestamp, fmt.Errorf("timestamp skew %s", skew)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+	if err != nil {
+		return ReasonInvalidSignature, fmt.Errorf("read body: %w", err)
+	}
+	if len(body) > maxBodyBytes {
+		return ReasonBodyTooLarge, nil
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	expected := Sign(v.secret, timestamp, nonce, body)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ReasonInvalidSignature, nil
+	}
+
+	// 서명이 맞는 요청만 nonce를 기록해 위조 요청으로 nonce 공간을 채우지 못하게 함
+	fresh, err := v.claimNonce(r.Context(), nonce)
+	if err != nil {
+		return ReasonNonceStore, err
+	}
+	if !fresh {
+		return ReasonReplayed, nil
+	}
+	return "", nil
+}
+
+// claimNonce: nonce를 처음 보는 경우에만 기록하고 true를 반환합니다. (SET NX)
+func (v *Verifier) claimNonce(ctx context.Context, nonce string) (bool, error) {
+	key := valkeyx.BuildKey(v.keyPrefix+":ingress:nonce", nonce)
+	cmd := v.client.B().Set().Key(key).Value("1").Nx().Ex(v.nonceTTL).Build()
+	if err := v.client.Do(ctx, cmd).Error(); err != nil {
+		if valkeyx.IsNil(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("claim nonce failed: %w", err)
+	}
+	return true, nil
+}
+
+func (v *Verifier) reject(w http.ResponseWriter, r *http.Request, reason string, err error) {
+	rejectedTotal.WithLabelValues(v.bot, reason).Inc()
+	v.logger.Warn("ingress_rejected", "bot", v.bot, "path", r.URL.Path, "reason", reason, "remote", r.RemoteAddr, "err", err)
+
+	status := http.StatusUnauthorized
+	switch reason {
+	case ReasonReplayed:
+		status = http.StatusConflict
+	case ReasonBodyTooLarge:
+		status = http.StatusRequestEntityTooLarge
+	case ReasonNonceStore:
+		// nonce를 확인할 수 없으면 재전송 여부를 알 수 없으므로 받지 않음 (브릿지가 재시도)
+		status = http.StatusServiceUnavailable
+	}
+	_ = commonhttputil.WriteErrorJSON(w, status, reason, "request rejected by ingress verification")
+}
+
+// Sign: 요청 서명 헤더 값을 계산합니다. (브릿지 클라이언트와 테스트에서도 사용)
+func Sign(secret []byte, timestamp string, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write([]byte(nonce))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
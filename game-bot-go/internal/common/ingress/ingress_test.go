@@ -0,0 +1,97 @@
+package ingress
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func TestVerifier_Wrap(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := "ingress_test_" + strings.ReplaceAll(testhelper.UniqueTestPrefix(t), ":", "_")
+	defer testhelper.CleanupTestKeys(t, client, prefix+":")
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	secret := "bridge-secret"
+	verifier := NewVerifier(commonconfig.IngressConfig{Secret: secret, MaxSkew: time.Minute}, client, prefix, "test", logger)
+	now := time.Unix(1_760_000_000, 0)
+	verifier.now = func() time.Time { return now }
+
+	var received string
+	handler := verifier.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	send := func(path string, body string, timestamp time.Time, nonce string, signature string) int {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		ts := strconv.FormatInt(timestamp.Unix(), 10)
+		if signature == "" {
+			signature = Sign([]byte(secret), ts, nonce, []byte(body))
+		}
+		req.Header.Set(HeaderTimestamp, ts)
+		req.Header.Set(HeaderNonce, nonce)
+		req.Header.Set(HeaderSignature, signature)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	body := `{"sessionId":"s1","question":"살아 있나요?"}`
+	if code := send("/api/game/question", body, now, "n1", ""); code != http.StatusOK || received != body {
+		t.Fatalf("valid request: status=%d body=%q", code, received)
+	}
+	if code := send("/api/game/question", body, now, "n1", ""); code != http.StatusConflict {
+		t.Fatalf("replayed nonce must be rejected, got %d", code)
+	}
+	if code := send("/api/game/question", body, now.Add(-2*time.Minute), "n2", ""); code != http.StatusUnauthorized {
+		t.Fatalf("stale timestamp must be rejected, got %d", code)
+	}
+	if code := send("/api/game/question", body, now, "n3", "sha256=deadbeef"); code != http.StatusUnauthorized {
+		t.Fatalf("bad signature must be rejected, got %d", code)
+	}
+
+	// 서명이 틀린 요청은 nonce를 소모하지 않음
+	if code := send("/api/game/question", body, now, "n3", ""); code != http.StatusOK {
+		t.Fatalf("nonce of rejected request must stay usable, got %d", code)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/game/hint", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("unsigned api request must be rejected, got %d", rec.Code)
+	}
+
+	// /api/ 밖의 경로(헬스체크, 관리자 API)는 검증하지 않음
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("health must pass through, got %d", rec.Code)
+	}
+}
+
+func TestNewVerifier_DisabledWithoutSecret(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	verifier := NewVerifier(commonconfig.IngressConfig{MaxSkew: time.Minute}, nil, "x", "test", logger)
+	if verifier != nil {
+		t.Fatalf("expected nil verifier without secret")
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	rec := httptest.NewRecorder()
+	verifier.Wrap(next).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/game/start", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("disabled verifier must pass through, got %d", rec.Code)
+	}
+}
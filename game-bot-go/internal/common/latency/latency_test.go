@@ -0,0 +1,206 @@
+package latency
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	json "github.com/goccy/go-json"
+	"gorm.io/gorm"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(Models()...); err != nil {
+		t.Fatal(err)
+	}
+	return NewStore(db)
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func samplesOf(bot, command string, at time.Time, durations ...int64) []Sample {
+	out := make([]Sample, 0, len(durations))
+	for i, d := range durations {
+		out = append(out, Sample{Bot: bot, Command: command, DurationMs: d, RecordedAt: at.Add(time.Duration(i) * time.Second).UTC()})
+	}
+	return out
+}
+
+func TestSummarize_NearestRank(t *testing.T) {
+	durations := make([]int64, 0, 100)
+	for i := 100; i >= 1; i-- {
+		durations = append(durations, int64(i*100))
+	}
+
+	stats := Summarize(durations, 5*time.Second)
+	want := Stats{Samples: 100, P50Ms: 5000, P95Ms: 9500, P99Ms: 9900, MaxMs: 10000, SlowCount: 50}
+	if stats != want {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if single := Summarize([]int64{700}, time.Second); single.P50Ms != 700 || single.P99Ms != 700 || single.SlowCount != 0 {
+		t.Fatalf("unexpected single-sample stats: %+v", single)
+	}
+	if empty := Summarize(nil, time.Second); empty != (Stats{}) {
+		t.Fatalf("expected empty stats, got %+v", empty)
+	}
+}
+
+func TestSpan_RecordsFirstReplyOnly(t *testing.T) {
+	store := newTestStore(t)
+	recorder := NewRecorder("twentyq", store, discardLogger())
+	received := time.Now().Add(-2 * time.Second)
+
+	ctx, span := recorder.Start(context.Background(), "ask", received)
+	MarkReply(ctx)
+	time.Sleep(10 * time.Millisecond)
+	MarkReply(ctx)
+	span.Finish()
+
+	// 대기열 처리 등 떼어낸 컨텍스트의 응답과 응답 없는 구간은 기록되지 않음
+	ctx, span = recorder.Start(context.Background(), "hints", received)
+	MarkReply(Detach(ctx))
+	span.Finish()
+
+	var nilRecorder *Recorder
+	_, nilSpan := nilRecorder.Start(context.Background(), "help", received)
+	nilSpan.Finish()
+
+	recorder.Shutdown()
+
+	samples, err := store.LoadSamples(context.Background(), "twentyq", received.Add(-time.Minute), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(samples) != 1 || samples[0].Command != "ask" {
+		t.Fatalf("expected one ask sample, got %+v", samples)
+	}
+	if d := samples[0].DurationMs; d < 2000 || d > 2500 {
+		t.Fatalf("duration must be measured from receive to first reply, got %dms", d)
+	}
+}
+
+func TestRollupAndReport(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, Timezone)
+	yesterday := now.AddDate(0, 0, -1)
+	twoDaysAgo := now.AddDate(0, 0, -2)
+
+	var samples []Sample
+	samples = append(samples, samplesOf("twentyq", "hints", twoDaysAgo, 6000, 7000, 8000)...)
+	samples = append(samples, samplesOf("twentyq", "hints", yesterday, 1000, 6500)...)
+	samples = append(samples, samplesOf("twentyq", "status", yesterday, 100, 200)...)
+	samples = append(samples, samplesOf("twentyq", "ask", now.Add(-time.Hour), 9000)...)
+	samples = append(samples, samplesOf("turtlesoup", "hints", yesterday, 9000)...)
+	if err := store.InsertSamples(ctx, samples); err != nil {
+		t.Fatal(err)
+	}
+
+	scheduler := NewScheduler(store, "twentyq", 5*time.Second, 10, 1, discardLogger())
+	scheduler.tick(ctx, now)
+
+	daily, err := store.LoadDaily(ctx, "twentyq", "2026-10-16", "2026-10-17")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(daily) != 3 {
+		t.Fatalf("expected 3 daily rows, got %+v", daily)
+	}
+
+	// 보관 기간(최소 backfill+1일)보다 오래된 샘플만 정리됨
+	remaining, err := store.LoadSamples(ctx, "twentyq", now.AddDate(0, 0, -10), now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 8 {
+		t.Fatalf("recent samples must be kept, got %d", len(remaining))
+	}
+
+	report, err := NewReporter(store, "twentyq", 5*time.Second).Report(ctx, 7, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.From != "2026-10-12" || report.To != "2026-10-18" || report.SLAMs != 5000 {
+		t.Fatalf("unexpected report range: %+v", report)
+	}
+	if len(report.Commands) != 3 {
+		t.Fatalf("expected 3 commands, got %+v", report.Commands)
+	}
+
+	hints := report.Commands[0]
+	if hints.Command != "hints" || hints.BreachDays != 2 || hints.Days != 2 || hints.Samples != 5 || hints.WorstP95Ms != 8000 {
+		t.Fatalf("hints must lead with two breach days: %+v", hints)
+	}
+	if hints.SlowRatio != 0.8 {
+		t.Fatalf("unexpected slow ratio: %v", hints.SlowRatio)
+	}
+	// 오늘은 원본 샘플에서 바로 계산
+	ask := report.Commands[1]
+	if ask.Command != "ask" || ask.BreachDays != 1 || ask.Daily[0].Day != "2026-10-18" {
+		t.Fatalf("today's samples must be included: %+v", ask)
+	}
+	if status := report.Commands[2]; status.Command != "status" || status.BreachDays != 0 || status.Daily[0].P95Ms != 200 {
+		t.Fatalf("unexpected status report: %+v", status)
+	}
+}
+
+func TestScheduler_WaitsForRunMinute(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 0, 5, 0, 0, Timezone)
+	if err := store.InsertSamples(ctx, samplesOf("twentyq", "ask", now.AddDate(0, 0, -1), 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	scheduler := NewScheduler(store, "twentyq", 5*time.Second, 10, 14, discardLogger())
+	scheduler.tick(ctx, now)
+	if ok, _ := store.HasDaily(ctx, "twentyq", "2026-10-17"); ok {
+		t.Fatalf("rollup must not run before run minute")
+	}
+
+	scheduler.tick(ctx, now.Add(10*time.Minute))
+	if ok, _ := store.HasDaily(ctx, "twentyq", "2026-10-17"); !ok {
+		t.Fatalf("rollup must run after run minute")
+	}
+}
+
+func TestHandleReport(t *testing.T) {
+	reporter := NewReporter(newTestStore(t), "turtlesoup", 5*time.Second)
+
+	rec := httptest.NewRecorder()
+	reporter.HandleReport(rec, httptest.NewRequest(http.MethodGet, "/admin/latency?days=0", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	reporter.HandleReport(rec, httptest.NewRequest(http.MethodGet, "/admin/latency", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var report Report
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Bot != "turtlesoup" || report.Commands == nil {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
@@ -0,0 +1,47 @@
+// Package latency: 명령어별 응답 지연(메시지 수신 → 첫 응답 발행)을 기록하고 일별 분위수로 집계합니다.
+package latency
+
+import "time"
+
+// Timezone: 일별 집계 날짜 계산 기준 시간대 (KST)
+var Timezone = time.FixedZone("KST", 9*60*60)
+
+// DayOf: 시각이 속한 KST 날짜의 자정을 반환합니다.
+func DayOf(t time.Time) time.Time {
+	local := t.In(Timezone)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, Timezone)
+}
+
+// Sample: 명령어 1회 처리의 응답 지연 원본 샘플
+type Sample struct {
+	ID         uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	Bot        string    `gorm:"column:bot;not null;index:idx_command_latency_samples_bot_time,priority:1"`
+	Command    string    `gorm:"column:command;not null"`
+	DurationMs int64     `gorm:"column:duration_ms;not null"`
+	RecordedAt time.Time `gorm:"column:recorded_at;not null;index:idx_command_latency_samples_bot_time,priority:2"`
+}
+
+// TableName: 원본 샘플 테이블 이름
+func (Sample) TableName() string { return "command_latency_samples" }
+
+// Daily: 봇/명령어별 하루 지연 집계 (날짜는 KST 기준 YYYY-MM-DD)
+type Daily struct {
+	Day       string    `gorm:"column:day;primaryKey;size:10" json:"day"`
+	Bot       string    `gorm:"column:bot;primaryKey" json:"-"`
+	Command   string    `gorm:"column:command;primaryKey" json:"-"`
+	Samples   int       `gorm:"column:samples;not null" json:"samples"`
+	P50Ms     int64     `gorm:"column:p50_ms;not null" json:"p50Ms"`
+	P95Ms     int64     `gorm:"column:p95_ms;not null" json:"p95Ms"`
+	P99Ms     int64     `gorm:"column:p99_ms;not null" json:"p99Ms"`
+	MaxMs     int64     `gorm:"column:max_ms;not null" json:"maxMs"`
+	SlowCount int       `gorm:"column:slow_count;not null" json:"slowCount"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null" json:"-"`
+}
+
+// TableName: 일별 집계 테이블 이름
+func (Daily) TableName() string { return "command_latency_daily" }
+
+// Models: AutoMigrate 대상 모델 목록 (각 봇 리포지토리의 Models에 포함됩니다)
+func Models() []any {
+	return []any{&Sample{}, &Daily{}}
+}
@@ -0,0 +1,119 @@
+package latency
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	recorderQueueSize     = 1024
+	recorderBatchSize     = 100
+	recorderFlushInterval = 5 * time.Second
+)
+
+// Recorder: 지연 샘플을 모아 주기적으로 일괄 저장하는 레코더
+// 응답 경로를 막지 않도록 큐가 가득 차면 샘플을 버립니다.
+type Recorder struct {
+	bot    string
+	store  *Store
+	logger *slog.Logger
+
+	queue    chan Sample
+	stopped  chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRecorder: 새로운 Recorder 인스턴스를 생성하고 저장 워커를 시작합니다.
+func NewRecorder(bot string, store *Store, logger *slog.Logger) *Recorder {
+	if store == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	r := &Recorder{
+		bot:     bot,
+		store:   store,
+		logger:  logger,
+		queue:   make(chan Sample, recorderQueueSize),
+		stopped: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go r.worker()
+	return r
+}
+
+// Record: 명령어 지연 샘플을 큐에 넣습니다.
+func (r *Recorder) Record(command string, duration time.Duration, at time.Time) {
+	if r == nil || command == "" {
+		return
+	}
+	sample := Sample{
+		Bot:        r.bot,
+		Command:    command,
+		DurationMs: max(duration.Milliseconds(), 0),
+		RecordedAt: at.UTC(),
+	}
+	select {
+	case <-r.stopped:
+	case r.queue <- sample:
+	default:
+		r.logger.Warn("latency_queue_full_dropped", "command", command)
+	}
+}
+
+// Shutdown: 워커를 멈추고 큐에 남은 샘플을 저장한 뒤 종료합니다.
+func (r *Recorder) Shutdown() {
+	if r == nil {
+		return
+	}
+	r.stopOnce.Do(func() {
+		close(r.stopped)
+		<-r.done
+	})
+}
+
+func (r *Recorder) worker() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(recorderFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Sample, 0, recorderBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := r.store.InsertSamples(ctx, batch); err != nil {
+			r.logger.Warn("latency_samples_flush_failed", "count", len(batch), "err", err)
+		}
+		cancel()
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case sample := <-r.queue:
+			batch = append(batch, sample)
+			if len(batch) >= recorderBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-r.stopped:
+			for {
+				select {
+				case sample := <-r.queue:
+					batch = append(batch, sample)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
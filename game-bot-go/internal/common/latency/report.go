@@ -0,0 +1,130 @@
+package latency
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+)
+
+const (
+	defaultReportDays = 7
+	maxReportDays     = 90
+)
+
+// CommandReport: 명령어 하나의 기간 내 일별 지연 집계와 SLA 위반 요약
+type CommandReport struct {
+	Command string `json:"command"`
+	Samples int    `json:"samples"`
+	// Days: 샘플이 있는 날 수, BreachDays: p95가 SLA를 넘긴 날 수
+	Days       int     `json:"days"`
+	BreachDays int     `json:"breachDays"`
+	WorstP95Ms int64   `json:"worstP95Ms"`
+	SlowRatio  float64 `json:"slowRatio"`
+	Daily      []Daily `json:"daily"`
+}
+
+// Report: 봇의 명령어별 지연 리포트 (SLA 위반이 잦은 명령어가 앞에 옵니다)
+type Report struct {
+	Bot      string          `json:"bot"`
+	SLAMs    int64           `json:"slaMs"`
+	From     string          `json:"from"`
+	To       string          `json:"to"`
+	Commands []CommandReport `json:"commands"`
+}
+
+// Reporter: 일별 집계와 오늘의 원본 샘플로 지연 리포트를 만드는 조회기
+type Reporter struct {
+	store *Store
+	bot   string
+	sla   time.Duration
+}
+
+// NewReporter: 새로운 Reporter 인스턴스를 생성합니다.
+func NewReporter(store *Store, bot string, sla time.Duration) *Reporter {
+	return &Reporter{store: store, bot: bot, sla: sla}
+}
+
+// Report: 오늘을 포함한 최근 days일의 리포트를 만듭니다. 오늘은 아직 집계 전이므로 원본 샘플로 계산합니다.
+func (r *Reporter) Report(ctx context.Context, days int, now time.Time) (Report, error) {
+	days = min(max(days, 1), maxReportDays)
+	today := DayOf(now)
+	from := today.AddDate(0, 0, -(days - 1))
+
+	rows, err := r.store.LoadDaily(ctx, r.bot, from.Format(time.DateOnly), today.AddDate(0, 0, -1).Format(time.DateOnly))
+	if err != nil {
+		return Report{}, err
+	}
+	samples, err := r.store.LoadSamples(ctx, r.bot, today, today.AddDate(0, 0, 1))
+	if err != nil {
+		return Report{}, err
+	}
+	rows = append(rows, summarizeByCommand(r.bot, today.Format(time.DateOnly), samples, r.sla, now)...)
+
+	byCommand := make(map[string]*CommandReport)
+	slowTotal := make(map[string]int)
+	for _, row := range rows {
+		report := byCommand[row.Command]
+		if report == nil {
+			report = &CommandReport{Command: row.Command, Daily: []Daily{}}
+			byCommand[row.Command] = report
+		}
+		report.Samples += row.Samples
+		report.Days++
+		if row.P95Ms > r.sla.Milliseconds() {
+			report.BreachDays++
+		}
+		report.WorstP95Ms = max(report.WorstP95Ms, row.P95Ms)
+		report.Daily = append(report.Daily, row)
+		slowTotal[row.Command] += row.SlowCount
+	}
+
+	commands := make([]CommandReport, 0, len(byCommand))
+	for command, report := range byCommand {
+		if report.Samples > 0 {
+			report.SlowRatio = float64(slowTotal[command]) / float64(report.Samples)
+		}
+		commands = append(commands, *report)
+	}
+	slices.SortFunc(commands, func(a, b CommandReport) int {
+		if c := cmp.Compare(b.BreachDays, a.BreachDays); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.WorstP95Ms, a.WorstP95Ms); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Command, b.Command)
+	})
+
+	return Report{
+		Bot:      r.bot,
+		SLAMs:    r.sla.Milliseconds(),
+		From:     from.Format(time.DateOnly),
+		To:       today.Format(time.DateOnly),
+		Commands: commands,
+	}, nil
+}
+
+// HandleReport: GET /admin/latency?days=N 리포트 조회 핸들러
+func (r *Reporter) HandleReport(w http.ResponseWriter, req *http.Request) {
+	days := defaultReportDays
+	if raw := req.URL.Query().Get("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxReportDays {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, "INVALID_REQUEST", "days must be between 1 and 90")
+			return
+		}
+		days = parsed
+	}
+
+	report, err := r.Report(req.Context(), days, time.Now())
+	if err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to build latency report")
+		return
+	}
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, report)
+}
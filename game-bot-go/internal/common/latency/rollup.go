@@ -0,0 +1,208 @@
+package latency
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// schedulerTickInterval: 집계 시각 도달 여부를 확인하는 주기
+	schedulerTickInterval = time.Minute
+	// rollupBackfillDays: 다운타임으로 빠진 집계를 채워 넣는 최대 일수
+	rollupBackfillDays = 3
+)
+
+// Stats: 지연 샘플 묶음의 분위수 요약
+type Stats struct {
+	Samples   int
+	P50Ms     int64
+	P95Ms     int64
+	P99Ms     int64
+	MaxMs     int64
+	SlowCount int
+}
+
+// Summarize: 지연(ms) 목록에서 nearest-rank 분위수와 SLA 초과 건수를 계산합니다.
+func Summarize(durations []int64, sla time.Duration) Stats {
+	if len(durations) == 0 {
+		return Stats{}
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	slaMs := sla.Milliseconds()
+	slow := 0
+	for _, d := range sorted {
+		if d > slaMs {
+			slow++
+		}
+	}
+	return Stats{
+		Samples:   len(sorted),
+		P50Ms:     percentile(sorted, 50),
+		P95Ms:     percentile(sorted, 95),
+		P99Ms:     percentile(sorted, 99),
+		MaxMs:     sorted[len(sorted)-1],
+		SlowCount: slow,
+	}
+}
+
+// percentile: 정렬된 값에서 nearest-rank 방식으로 p분위 값을 구합니다.
+func percentile(sorted []int64, p float64) int64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
+
+// summarizeByCommand: 원본 샘플을 명령어별 일별 집계 행으로 묶습니다.
+func summarizeByCommand(bot string, day string, samples []Sample, sla time.Duration, now time.Time) []Daily {
+	grouped := make(map[string][]int64)
+	for _, sample := range samples {
+		grouped[sample.Command] = append(grouped[sample.Command], sample.DurationMs)
+	}
+
+	rows := make([]Daily, 0, len(grouped))
+	for command, durations := range grouped {
+		stats := Summarize(durations, sla)
+		rows = append(rows, Daily{
+			Day:       day,
+			Bot:       bot,
+			Command:   command,
+			Samples:   stats.Samples,
+			P50Ms:     stats.P50Ms,
+			P95Ms:     stats.P95Ms,
+			P99Ms:     stats.P99Ms,
+			MaxMs:     stats.MaxMs,
+			SlowCount: stats.SlowCount,
+			UpdatedAt: now,
+		})
+	}
+	slices.SortFunc(rows, func(a, b Daily) int { return cmp.Compare(a.Command, b.Command) })
+	return rows
+}
+
+// RollupDay: 하루치 원본 샘플을 명령어별로 집계해 저장합니다.
+func RollupDay(ctx context.Context, store *Store, bot string, day time.Time, sla time.Duration) ([]Daily, error) {
+	from := DayOf(day)
+	samples, err := store.LoadSamples(ctx, bot, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	rows := summarizeByCommand(bot, from.Format(time.DateOnly), samples, sla, time.Now())
+	if err := store.UpsertDaily(ctx, rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Scheduler: 매일 지정 시각 이후 지난 며칠 중 집계되지 않은 날짜를 집계하고 오래된 원본 샘플을 정리합니다.
+type Scheduler struct {
+	store         *Store
+	bot           string
+	sla           time.Duration
+	runAtMinute   int
+	retentionDays int
+	logger        *slog.Logger
+
+	// done: 이미 확인을 마친 날짜 (샘플이 없어 집계 행이 없는 날을 반복 조회하지 않기 위함)
+	done map[string]bool
+	// prunedOn: 마지막으로 원본 샘플을 정리한 날짜 (하루 한 번만 정리)
+	prunedOn string
+}
+
+// NewScheduler: 새로운 Scheduler 인스턴스를 생성합니다.
+func NewScheduler(store *Store, bot string, sla time.Duration, runAtMinute int, retentionDays int, logger *slog.Logger) *Scheduler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Scheduler{
+		store:         store,
+		bot:           bot,
+		sla:           sla,
+		runAtMinute:   runAtMinute,
+		retentionDays: max(retentionDays, rollupBackfillDays+1),
+		logger:        logger,
+		done:          make(map[string]bool),
+	}
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 집계할 날짜를 확인합니다.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.logger.Info("latency_rollup_scheduler_started",
+		"bot", s.bot,
+		"run_at_minute", s.runAtMinute,
+		"retention_days", s.retentionDays,
+	)
+
+	ticker := time.NewTicker(schedulerTickInterval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick: 오늘 실행 시각이 지났으면 어제부터 rollupBackfillDays일 전까지 빠진 날짜를 집계합니다.
+// 원본 샘플 정리는 집계를 모두 확인한 뒤에만 수행합니다.
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	today := DayOf(now)
+	if now.Before(today.Add(time.Duration(s.runAtMinute) * time.Minute)) {
+		return
+	}
+
+	pending := false
+	for offset := rollupBackfillDays; offset >= 1; offset-- {
+		if ctx.Err() != nil {
+			return
+		}
+		day := today.AddDate(0, 0, -offset)
+		key := day.Format(time.DateOnly)
+		if s.done[key] {
+			continue
+		}
+
+		exists, err := s.store.HasDaily(ctx, s.bot, key)
+		if err == nil && !exists {
+			var rows []Daily
+			rows, err = RollupDay(ctx, s.store, s.bot, day, s.sla)
+			if err == nil {
+				s.logger.Info("latency_rollup_completed", "bot", s.bot, "day", key, "commands", len(rows))
+			}
+		}
+		if err != nil {
+			s.logger.Warn("latency_rollup_failed", "bot", s.bot, "day", key, "err", err)
+			pending = true
+			continue
+		}
+		s.done[key] = true
+	}
+	todayKey := today.Format(time.DateOnly)
+	if pending || s.prunedOn == todayKey {
+		return
+	}
+
+	cutoff := today.AddDate(0, 0, -s.retentionDays)
+	deleted, err := s.store.PruneSamples(ctx, s.bot, cutoff)
+	if err != nil {
+		s.logger.Warn("latency_samples_prune_failed", "bot", s.bot, "err", err)
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("latency_samples_pruned", "bot", s.bot, "deleted", deleted)
+	}
+	s.prunedOn = todayKey
+	for key := range s.done {
+		if key < today.AddDate(0, 0, -rollupBackfillDays).Format(time.DateOnly) {
+			delete(s.done, key)
+		}
+	}
+}
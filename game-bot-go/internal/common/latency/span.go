@@ -0,0 +1,61 @@
+package latency
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+type spanKey struct{}
+
+// Span: 한 메시지의 처리 구간. 첫 응답 발행 시각을 기억했다가 Finish에서 지연으로 기록합니다.
+type Span struct {
+	recorder  *Recorder
+	command   string
+	start     time.Time
+	repliedAt atomic.Int64 // 첫 응답 발행 시각 (UnixNano, 0이면 아직 응답 없음)
+}
+
+// Start: 명령어 처리 구간을 시작하고 Span을 담은 컨텍스트를 반환합니다.
+// receivedAt은 메시지가 큐에 들어온 시각이며, 0이면 현재 시각을 사용합니다. recorder가 nil이면 기록하지 않습니다.
+func (r *Recorder) Start(ctx context.Context, command string, receivedAt time.Time) (context.Context, *Span) {
+	if r == nil {
+		return ctx, nil
+	}
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+	span := &Span{recorder: r, command: command, start: receivedAt}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// MarkReply: 컨텍스트의 Span에 첫 응답 발행 시각을 남깁니다. 이후 호출은 무시됩니다.
+func MarkReply(ctx context.Context) {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	if span == nil {
+		return
+	}
+	span.repliedAt.CompareAndSwap(0, time.Now().UnixNano())
+}
+
+// Finish: 처리 구간을 닫고 수신→첫 응답 지연을 기록합니다.
+// 응답 없이 끝난 메시지(무시된 입력, 대기열로 넘어간 명령 등)는 기록하지 않습니다.
+func (s *Span) Finish() {
+	if s == nil {
+		return
+	}
+	repliedAt := s.repliedAt.Load()
+	if repliedAt == 0 {
+		return
+	}
+	s.recorder.Record(s.command, time.Unix(0, repliedAt).Sub(s.start), s.start)
+}
+
+// Detach: Span을 떼어낸 컨텍스트를 반환합니다.
+// 같은 호출 안에서 다른 사용자의 대기열 메시지를 처리할 때 그 응답이 현재 명령의 지연으로 잡히지 않게 합니다.
+func Detach(ctx context.Context) context.Context {
+	if ctx.Value(spanKey{}) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanKey{}, (*Span)(nil))
+}
@@ -0,0 +1,89 @@
+package latency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Store: 지연 샘플과 일별 집계를 저장하는 DB 저장소
+type Store struct {
+	db *gorm.DB
+}
+
+// NewStore: 새로운 Store 인스턴스를 생성합니다.
+func NewStore(db *gorm.DB) *Store {
+	return &Store{db: db}
+}
+
+// InsertSamples: 원본 샘플을 일괄 저장합니다.
+func (s *Store) InsertSamples(ctx context.Context, samples []Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	if err := s.db.WithContext(ctx).CreateInBatches(samples, 200).Error; err != nil {
+		return fmt.Errorf("insert latency samples failed: %w", err)
+	}
+	return nil
+}
+
+// LoadSamples: [from, to) 구간에 기록된 봇의 원본 샘플을 조회합니다.
+func (s *Store) LoadSamples(ctx context.Context, bot string, from time.Time, to time.Time) ([]Sample, error) {
+	var samples []Sample
+	err := s.db.WithContext(ctx).
+		Where("bot = ? AND recorded_at >= ? AND recorded_at < ?", bot, from.UTC(), to.UTC()).
+		Find(&samples).Error
+	if err != nil {
+		return nil, fmt.Errorf("load latency samples failed: %w", err)
+	}
+	return samples, nil
+}
+
+// UpsertDaily: 일별 집계를 저장합니다. 같은 날짜/명령어 행은 덮어씁니다.
+func (s *Store) UpsertDaily(ctx context.Context, rows []Daily) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "day"}, {Name: "bot"}, {Name: "command"}},
+		DoUpdates: clause.AssignmentColumns([]string{"samples", "p50_ms", "p95_ms", "p99_ms", "max_ms", "slow_count", "updated_at"}),
+	}).Create(&rows).Error
+	if err != nil {
+		return fmt.Errorf("upsert latency daily failed: %w", err)
+	}
+	return nil
+}
+
+// LoadDaily: fromDay~toDay(포함) 사이의 일별 집계를 조회합니다.
+func (s *Store) LoadDaily(ctx context.Context, bot string, fromDay string, toDay string) ([]Daily, error) {
+	var rows []Daily
+	err := s.db.WithContext(ctx).
+		Where("bot = ? AND day >= ? AND day <= ?", bot, fromDay, toDay).
+		Order("day").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("load latency daily failed: %w", err)
+	}
+	return rows, nil
+}
+
+// HasDaily: 해당 날짜의 집계가 이미 있는지 확인합니다.
+func (s *Store) HasDaily(ctx context.Context, bot string, day string) (bool, error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&Daily{}).Where("bot = ? AND day = ?", bot, day).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("check latency daily failed: %w", err)
+	}
+	return count > 0, nil
+}
+
+// PruneSamples: before 이전에 기록된 원본 샘플을 삭제합니다.
+func (s *Store) PruneSamples(ctx context.Context, bot string, before time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("bot = ? AND recorded_at < ?", bot, before.UTC()).Delete(&Sample{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("prune latency samples failed: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -22,7 +22,8 @@ type Config struct {
 	APIKey         string
 	Timeout        time.Duration
 	ConnectTimeout time.Duration
-	EnableOTel     bool // OpenTelemetry 계측 활성화
+	EnableOTel     bool   // OpenTelemetry 계측 활성화
+	BotID          string // 봇별 할당량 식별자 (x-bot-id 메타데이터)
 }
 
 // Client: LLM 서버와 gRPC로 통신하기 위한 클라이언트입니다.
@@ -31,6 +32,7 @@ type Client struct {
 	grpcClient  llmv1.LLMServiceClient
 	grpcTimeout time.Duration
 	apiKey      string
+	usageSinks  *usageSinkHolder
 }
 
 // New: 새로운 Client 인스턴스를 생성하고 초기화합니다.
@@ -44,6 +46,8 @@ func New(cfg Config) (*Client, error) {
 	const grpcMaxMsgSizeBytes = 16 * 1024 * 1024
 
 	apiKey := strings.TrimSpace(cfg.APIKey)
+	botID := strings.TrimSpace(cfg.BotID)
+	usageSinks := &usageSinkHolder{}
 	interceptor := func(
 		ctx context.Context,
 		method string,
@@ -55,11 +59,30 @@ func New(cfg Config) (*Client, error) {
 		if apiKey != "" {
 			ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", apiKey)
 		}
+		if botID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-bot-id", botID)
+		}
 		// Context에서 Request ID를 추출하여 메타데이터로 전파
 		if reqID := extractRequestID(ctx); reqID != "" {
 			ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", reqID)
 		}
-		return invoker(ctx, method, req, reply, cc, opts...)
+		if isBatchPriority(ctx) {
+			ctx = metadata.AppendToOutgoingContext(ctx, llmPriorityHeader, llmPriorityBatch)
+		}
+
+		// 귀속 키가 있으면 서버가 trailer로 돌려준 사용량을 sink에 전달
+		key := usageAttribution(ctx)
+		sink := usageSinks.load()
+		if key == "" || sink == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, usageAttributionHeader, key)
+		var trailer metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Trailer(&trailer))...)
+		if usage, ok := parseUsageTrailer(trailer); ok {
+			sink.RecordLLMUsage(ctx, key, usageTask(method), usage)
+		}
+		return err
 	}
 
 	baseOpts := []grpc.DialOption{
@@ -136,6 +159,7 @@ func New(cfg Config) (*Client, error) {
 		grpcClient:  llmv1.NewLLMServiceClient(conn),
 		grpcTimeout: timeout,
 		apiKey:      apiKey,
+		usageSinks:  usageSinks,
 	}, nil
 }
 
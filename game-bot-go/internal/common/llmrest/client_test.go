@@ -299,6 +299,19 @@ func (s *grpcTestService) TurtleSoupGenerateHint(ctx context.Context, req *llmv1
 	return &llmv1.TurtleSoupGenerateHintResponse{Hint: "HINT", Level: req.Level}, nil
 }
 
+func (s *grpcTestService) TurtleSoupGenerateEpilogue(ctx context.Context, req *llmv1.TurtleSoupGenerateEpilogueRequest) (*llmv1.TurtleSoupGenerateEpilogueResponse, error) {
+	s.checkAPIKey(ctx)
+
+	if req == nil {
+		return nil, fmt.Errorf("request required")
+	}
+	if req.Solution != "solution" {
+		return nil, fmt.Errorf("solution mismatch")
+	}
+
+	return &llmv1.TurtleSoupGenerateEpilogueResponse{Epilogue: "EPILOGUE", Question: "QUESTION", Answer: "ANSWER"}, nil
+}
+
 func (s *grpcTestService) GetDailyUsage(ctx context.Context, _ *emptypb.Empty) (*llmv1.DailyUsageResponse, error) {
 	s.checkAPIKey(ctx)
 
@@ -626,6 +639,18 @@ func TestClient_GRPC_Methods(t *testing.T) {
 		}
 	})
 
+	t.Run("TurtleSoupGenerateEpilogue", func(t *testing.T) {
+		svc.t = t
+
+		resp, err := client.TurtleSoupGenerateEpilogue(context.Background(), "chat-1", "turtle-soup", "scenario", "solution")
+		if err != nil {
+			t.Fatalf("TurtleSoupGenerateEpilogue failed: %v", err)
+		}
+		if resp.Epilogue != "EPILOGUE" || resp.Question != "QUESTION" || resp.Answer != "ANSWER" {
+			t.Fatalf("unexpected epilogue: %+v", resp)
+		}
+	})
+
 	t.Run("GetDailyUsage", func(t *testing.T) {
 		svc.t = t
 
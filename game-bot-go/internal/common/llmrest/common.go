@@ -238,3 +238,68 @@ func (c *Client) GetUsageTotalFromDB(ctx context.Context, days int, _ map[string
 		Model:           modelPtr,
 	}, nil
 }
+
+// GetSessionUsage: 세션 ID 기준 누적 토큰 사용량을 작업별로 조회합니다.
+func (c *Client) GetSessionUsage(ctx context.Context, sessionID string) (*SessionUsageResponse, error) {
+	if c.grpcClient == nil {
+		return nil, ErrGRPCClientRequired
+	}
+
+	callCtx, cancel := c.grpcCallContext(ctx)
+	defer cancel()
+
+	resp, err := c.grpcClient.GetSessionUsage(callCtx, &llmv1.GetSessionUsageRequest{SessionId: strings.TrimSpace(sessionID)})
+	if err != nil {
+		return nil, fmt.Errorf("grpc get session usage failed: %w", err)
+	}
+
+	return &SessionUsageResponse{
+		SessionID:       resp.SessionId,
+		InputTokens:     resp.InputTokens,
+		OutputTokens:    resp.OutputTokens,
+		TotalTokens:     resp.TotalTokens,
+		ReasoningTokens: resp.ReasoningTokens,
+		RequestCount:    resp.RequestCount,
+		Tasks:           toTaskUsages(resp.Tasks),
+		FirstUsedAt:     resp.FirstUsedAt,
+		LastUsedAt:      resp.LastUsedAt,
+	}, nil
+}
+
+// GetUsageByTask: 지정된 일수 내 작업별 토큰 사용량을 조회합니다. (사용량 많은 순)
+func (c *Client) GetUsageByTask(ctx context.Context, days int) (*TaskUsageListResponse, error) {
+	if c.grpcClient == nil {
+		return nil, ErrGRPCClientRequired
+	}
+
+	callCtx, cancel := c.grpcCallContext(ctx)
+	defer cancel()
+
+	resp, err := c.grpcClient.GetUsageByTask(callCtx, &llmv1.GetUsageByTaskRequest{Days: int32(days)})
+	if err != nil {
+		return nil, fmt.Errorf("grpc get usage by task failed: %w", err)
+	}
+
+	return &TaskUsageListResponse{
+		Days:  int(resp.Days),
+		Tasks: toTaskUsages(resp.Tasks),
+	}, nil
+}
+
+func toTaskUsages(items []*llmv1.TaskUsage) []TaskUsage {
+	tasks := make([]TaskUsage, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		tasks = append(tasks, TaskUsage{
+			Task:            item.Task,
+			InputTokens:     item.InputTokens,
+			OutputTokens:    item.OutputTokens,
+			TotalTokens:     item.TotalTokens,
+			ReasoningTokens: item.ReasoningTokens,
+			RequestCount:    item.RequestCount,
+		})
+	}
+	return tasks
+}
@@ -0,0 +1,479 @@
+package llmrest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	llmv1 "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest/pb/llm/v1"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+// 계약 파일은 서버 모듈(mcp-llm-server-go/proto/llm/v1/contract)이 소유합니다.
+// 형식은 mcp-llm-server-go/internal/grpcserver/contract 패키지와 동일하며, 모듈이 달라 테스트 내에 최소 구현을 둡니다.
+const contractDir = "../../../../mcp-llm-server-go/proto/llm/v1/contract"
+
+type contractField struct {
+	Number   int32  `json:"number"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Repeated bool   `json:"repeated,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+type contractMethod struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+type contractDescriptor struct {
+	Package  string                     `json:"package"`
+	Service  string                     `json:"service"`
+	Methods  map[string]contractMethod  `json:"methods"`
+	Messages map[string][]contractField `json:"messages"`
+}
+
+type contractFixture struct {
+	Name     string          `json:"-"`
+	Method   string          `json:"method"`
+	Required []string        `json:"required,omitempty"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+type contractSideReport struct {
+	Side       string            `json:"side"`
+	Descriptor string            `json:"descriptor"`
+	Methods    map[string]string `json:"methods"`
+}
+
+var contractResults = struct {
+	sync.Mutex
+	report contractSideReport
+}{report: contractSideReport{Side: "game-bot-go", Descriptor: "drift", Methods: map[string]string{}}}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if dir := os.Getenv("CONTRACT_REPORT_DIR"); dir != "" {
+		if err := writeContractReport(dir, contractResults.report); err != nil {
+			println("contract report:", err.Error())
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+func writeContractReport(dir string, report contractSideReport) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create report dir: %w", err)
+	}
+	raw, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode side report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, report.Side+".json"), raw, 0o644); err != nil {
+		return fmt.Errorf("write side report: %w", err)
+	}
+	return nil
+}
+
+func requireContractDir(t *testing.T) {
+	t.Helper()
+	if _, err := os.Stat(contractDir); err != nil {
+		t.Skipf("contract fixtures not found (%s): %v", contractDir, err)
+	}
+}
+
+func describeContract(fd protoreflect.FileDescriptor) contractDescriptor {
+	out := contractDescriptor{
+		Package:  string(fd.Package()),
+		Methods:  make(map[string]contractMethod),
+		Messages: make(map[string][]contractField),
+	}
+	svc := fd.Services().Get(0)
+	out.Service = string(svc.FullName())
+	for i := range svc.Methods().Len() {
+		m := svc.Methods().Get(i)
+		out.Methods[string(m.Name())] = contractMethod{Input: string(m.Input().FullName()), Output: string(m.Output().FullName())}
+	}
+
+	var walk func(msgs protoreflect.MessageDescriptors)
+	walk = func(msgs protoreflect.MessageDescriptors) {
+		for i := range msgs.Len() {
+			md := msgs.Get(i)
+			fields := make([]contractField, 0, md.Fields().Len())
+			for j := range md.Fields().Len() {
+				f := md.Fields().Get(j)
+				field := contractField{
+					Number:   int32(f.Number()),
+					Name:     string(f.Name()),
+					Kind:     f.Kind().String(),
+					Repeated: f.Cardinality() == protoreflect.Repeated,
+					Optional: f.HasOptionalKeyword(),
+				}
+				switch {
+				case f.Message() != nil:
+					field.Message = string(f.Message().FullName())
+				case f.Enum() != nil:
+					field.Message = string(f.Enum().FullName())
+				}
+				fields = append(fields, field)
+			}
+			out.Messages[string(md.FullName())] = fields
+			walk(md.Messages())
+		}
+	}
+	walk(fd.Messages())
+	return out
+}
+
+func loadContractFixtures(t *testing.T) []contractFixture {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(contractDir, "fixtures", "*.json"))
+	if err != nil {
+		t.Fatalf("glob fixtures: %v", err)
+	}
+	sort.Strings(paths)
+
+	fixtures := make([]contractFixture, 0, len(paths))
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read fixture %s: %v", path, err)
+		}
+		var f contractFixture
+		if err := json.Unmarshal(raw, &f); err != nil {
+			t.Fatalf("decode fixture %s: %v", path, err)
+		}
+		f.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+		fixtures = append(fixtures, f)
+	}
+	return fixtures
+}
+
+func decodeContractMessage(t *testing.T, md protoreflect.MessageDescriptor, raw []byte) proto.Message {
+	t.Helper()
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		t.Fatalf("message type %s: %v", md.FullName(), err)
+	}
+	msg := mt.New().Interface()
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		t.Fatalf("decode %s: %v", md.FullName(), err)
+	}
+	return msg
+}
+
+// TestContract_Descriptor: 클라이언트에 복사된 pb가 서버 골든 디스크립터와 같은지 확인합니다.
+func TestContract_Descriptor(t *testing.T) {
+	requireContractDir(t)
+
+	raw, err := os.ReadFile(filepath.Join(contractDir, "descriptor.golden.json"))
+	if err != nil {
+		t.Fatalf("read golden descriptor: %v", err)
+	}
+	var want contractDescriptor
+	if err := json.Unmarshal(raw, &want); err != nil {
+		t.Fatalf("decode golden descriptor: %v", err)
+	}
+
+	got := describeContract(llmv1.File_llm_v1_llm_service_proto)
+	if want.Package != got.Package || want.Service != got.Service || !reflect.DeepEqual(want.Methods, got.Methods) {
+		t.Fatalf("service drifted from golden: want %s/%s %v, got %s/%s %v", want.Package, want.Service, want.Methods, got.Package, got.Service, got.Methods)
+	}
+	for name, fields := range want.Messages {
+		if !reflect.DeepEqual(fields, got.Messages[name]) {
+			t.Errorf("message %s drifted (pb 재생성 필요): want %+v, got %+v", name, fields, got.Messages[name])
+		}
+	}
+	for name := range got.Messages {
+		if _, ok := want.Messages[name]; !ok {
+			t.Errorf("message %s not in golden descriptor", name)
+		}
+	}
+	if t.Failed() {
+		return
+	}
+
+	contractResults.Lock()
+	contractResults.report.Descriptor = "match"
+	contractResults.Unlock()
+}
+
+// contractCalls: RPC별로 픽스처 요청 값을 실제 클라이언트 메서드 인자로 옮겨 호출합니다.
+// 클라이언트가 노출하지 않는 RPC(GetQuotaStatus)는 매트릭스에 untested로 남습니다.
+var contractCalls = map[string]func(ctx context.Context, c *Client, req proto.Message) error{
+	"GetModelConfig": func(ctx context.Context, c *Client, _ proto.Message) error {
+		_, err := c.GetModelConfig(ctx)
+		return err
+	},
+	"GuardIsMalicious": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.GuardIsMalicious(ctx, req.(*llmv1.GuardIsMaliciousRequest).GetInputText())
+		return err
+	},
+	"EndSession": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.EndSession(ctx, req.(*llmv1.EndSessionRequest).GetSessionId())
+		return err
+	},
+	"Embed": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.EmbedRequest)
+		_, err := c.Embed(ctx, r.GetTexts(), EmbedOptions{TaskType: r.GetTaskType(), Dimensions: int(r.GetDimensions())})
+		return err
+	},
+	"TwentyQSelectTopic": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQSelectTopicRequest)
+		_, err := c.TwentyQSelectTopic(ctx, r.GetCategory(), r.GetBannedTopics(), r.GetExcludedCategories())
+		return err
+	},
+	"TwentyQGetCategories": func(ctx context.Context, c *Client, _ proto.Message) error {
+		_, err := c.TwentyQGetCategories(ctx)
+		return err
+	},
+	"TwentyQGenerateHints": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQGenerateHintsRequest)
+		var feedback *TwentyQHintFeedback
+		if fb := r.GetFeedback(); fb != nil {
+			feedback = &TwentyQHintFeedback{Useful: int(fb.GetUseful()), Useless: int(fb.GetUseless()), UselessExamples: fb.GetUselessExamples()}
+		}
+		_, err := c.TwentyQGenerateHintsWithFeedback(ctx, r.GetTarget(), r.GetCategory(), r.GetDetails().AsMap(), feedback)
+		return err
+	},
+	"TwentyQBatchGenerateHints": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQBatchGenerateHintsRequest)
+		items := make([]TwentyQBatchHintsItem, len(r.GetItems()))
+		for i, item := range r.GetItems() {
+			items[i] = TwentyQBatchHintsItem{Target: item.GetTarget(), Category: item.GetCategory(), Details: item.GetDetails().AsMap()}
+			if fb := item.GetFeedback(); fb != nil {
+				items[i].Feedback = &TwentyQHintFeedback{Useful: int(fb.GetUseful()), Useless: int(fb.GetUseless()), UselessExamples: fb.GetUselessExamples()}
+			}
+		}
+		resp, err := c.TwentyQBatchGenerateHints(ctx, items)
+		if err == nil && (resp.Failed != 1 || resp.Results[1].OK() || resp.Results[1].ErrorCode != "INVALID_INPUT") {
+			return fmt.Errorf("unexpected batch response: %+v", resp)
+		}
+		return err
+	},
+	"TwentyQAnswerQuestion": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQAnswerQuestionRequest)
+		_, err := c.TwentyQAnswerQuestion(ctx, r.GetChatId(), r.GetNamespace(), r.GetTarget(), r.GetCategory(), r.GetQuestion(), r.GetDetails().AsMap())
+		return err
+	},
+	"TwentyQVerifyGuess": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQVerifyGuessRequest)
+		_, err := c.TwentyQVerifyGuess(ctx, r.GetTarget(), r.GetGuess())
+		return err
+	},
+	"TwentyQNormalizeQuestion": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.TwentyQNormalizeQuestion(ctx, req.(*llmv1.TwentyQNormalizeQuestionRequest).GetQuestion())
+		return err
+	},
+	"TwentyQCheckSynonym": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQCheckSynonymRequest)
+		_, err := c.TwentyQCheckSynonym(ctx, r.GetTarget(), r.GetGuess())
+		return err
+	},
+	"TwentyQSummarizeGame": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TwentyQSummarizeGameRequest)
+		in := TwentyQSummaryRequest{
+			SessionID:    r.GetSessionId(),
+			Category:     r.GetCategory(),
+			Hints:        r.GetHints(),
+			WrongGuesses: r.GetWrongGuesses(),
+		}
+		for _, entry := range r.GetHistory() {
+			in.History = append(in.History, TwentyQHistoryEntry{Question: entry.GetQuestion(), Answer: entry.GetAnswer()})
+		}
+		_, err := c.TwentyQSummarizeGame(ctx, in)
+		return err
+	},
+	"TurtleSoupGeneratePuzzle": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupGeneratePuzzleRequest)
+		in := TurtleSoupPuzzleGenerationRequest{Category: r.Category, Theme: r.Theme}
+		if r.Difficulty != nil {
+			difficulty := int(r.GetDifficulty())
+			in.Difficulty = &difficulty
+		}
+		_, err := c.TurtleSoupGeneratePuzzle(ctx, in)
+		return err
+	},
+	"TurtleSoupGetRandomPuzzle": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupGetRandomPuzzleRequest)
+		var difficulty *int
+		if r.Difficulty != nil {
+			value := int(r.GetDifficulty())
+			difficulty = &value
+		}
+		_, err := c.TurtleSoupGetRandomPuzzle(ctx, difficulty)
+		return err
+	},
+	"TurtleSoupRewriteScenario": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupRewriteScenarioRequest)
+		_, err := c.TurtleSoupRewriteScenario(ctx, r.GetTitle(), r.GetScenario(), r.GetSolution(), int(r.GetDifficulty()))
+		return err
+	},
+	"TurtleSoupAnswerQuestion": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupAnswerQuestionRequest)
+		_, err := c.TurtleSoupAnswerQuestion(ctx, r.GetChatId(), r.GetNamespace(), r.GetScenario(), r.GetSolution(), r.GetQuestion())
+		return err
+	},
+	"TurtleSoupValidateSolution": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupValidateSolutionRequest)
+		_, err := c.TurtleSoupValidateSolution(ctx, r.GetChatId(), r.GetNamespace(), r.GetSolution(), r.GetPlayerAnswer())
+		return err
+	},
+	"TurtleSoupGenerateHint": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupGenerateHintRequest)
+		_, err := c.TurtleSoupGenerateHint(ctx, r.GetChatId(), r.GetNamespace(), r.GetScenario(), r.GetSolution(), int(r.GetLevel()))
+		return err
+	},
+	"TurtleSoupGenerateEpilogue": func(ctx context.Context, c *Client, req proto.Message) error {
+		r := req.(*llmv1.TurtleSoupGenerateEpilogueRequest)
+		_, err := c.TurtleSoupGenerateEpilogue(ctx, r.GetChatId(), r.GetNamespace(), r.GetScenario(), r.GetSolution())
+		return err
+	},
+	"GetDailyUsage": func(ctx context.Context, c *Client, _ proto.Message) error {
+		_, err := c.GetDailyUsage(ctx, nil)
+		return err
+	},
+	"GetRecentUsage": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.GetRecentUsage(ctx, int(req.(*llmv1.GetRecentUsageRequest).GetDays()), nil)
+		return err
+	},
+	"GetTotalUsage": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.GetUsageTotalFromDB(ctx, int(req.(*llmv1.GetTotalUsageRequest).GetDays()), nil)
+		return err
+	},
+	"GetSessionUsage": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.GetSessionUsage(ctx, req.(*llmv1.GetSessionUsageRequest).GetSessionId())
+		return err
+	},
+	"GetUsageByTask": func(ctx context.Context, c *Client, req proto.Message) error {
+		_, err := c.GetUsageByTask(ctx, int(req.(*llmv1.GetUsageByTaskRequest).GetDays()))
+		return err
+	},
+}
+
+// fixtureServer: 픽스처 응답을 그대로 돌려주고 수신한 요청을 기록하는 가짜 LLM 서버입니다.
+type fixtureServer struct {
+	mu       sync.Mutex
+	response proto.Message
+	received proto.Message
+}
+
+// register: 생성된 pb의 메서드 목록으로 ServiceDesc를 만들어 모든 RPC를 한 핸들러로 받습니다.
+func (f *fixtureServer) register(s *grpc.Server) {
+	svc := llmv1.File_llm_v1_llm_service_proto.Services().Get(0)
+	desc := grpc.ServiceDesc{
+		ServiceName: string(svc.FullName()),
+		HandlerType: (*any)(nil),
+		Metadata:    llmv1.File_llm_v1_llm_service_proto.Path(),
+	}
+	for i := range svc.Methods().Len() {
+		md := svc.Methods().Get(i)
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: string(md.Name()),
+			Handler: func(_ any, _ context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+				if err != nil {
+					return nil, err
+				}
+				req := mt.New().Interface()
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				f.mu.Lock()
+				defer f.mu.Unlock()
+				f.received = req
+				return f.response, nil
+			},
+		})
+	}
+	s.RegisterService(&desc, f)
+}
+
+func (f *fixtureServer) set(response proto.Message) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.response = response
+	f.received = nil
+}
+
+func (f *fixtureServer) last() proto.Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.received
+}
+
+// TestContract_Fixtures: 클라이언트가 픽스처 요청을 그대로 직렬화하고(필수 필드 포함) 픽스처 응답을 해석하는지 확인합니다.
+func TestContract_Fixtures(t *testing.T) {
+	requireContractDir(t)
+
+	server := &fixtureServer{}
+	baseURL, _ := testhelper.StartTestGRPCServer(t, server.register)
+	client, err := New(Config{BaseURL: baseURL, Timeout: 2 * time.Second})
+	if err != nil {
+		t.Fatalf("failed to create grpc client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	methods := llmv1.File_llm_v1_llm_service_proto.Services().Get(0).Methods()
+	for _, fx := range loadContractFixtures(t) {
+		call, ok := contractCalls[fx.Method]
+		if !ok {
+			continue
+		}
+		t.Run(fx.Name, func(t *testing.T) {
+			defer func() {
+				status := "pass"
+				if t.Failed() {
+					status = "fail"
+				}
+				contractResults.Lock()
+				contractResults.report.Methods[fx.Method] = status
+				contractResults.Unlock()
+			}()
+
+			md := methods.ByName(protoreflect.Name(fx.Method))
+			if md == nil {
+				t.Fatalf("client pb has no rpc %q", fx.Method)
+			}
+			want := decodeContractMessage(t, md.Input(), fx.Request)
+			server.set(decodeContractMessage(t, md.Output(), fx.Response))
+
+			if err := call(context.Background(), client, want); err != nil {
+				t.Fatalf("call failed: %v", err)
+			}
+
+			got := server.last()
+			if got == nil {
+				t.Fatal("server received no request")
+			}
+			if !proto.Equal(want, got) {
+				t.Errorf("request mismatch:\nwant %v\ngot  %v", want, got)
+			}
+			for _, name := range fx.Required {
+				fd := md.Input().Fields().ByName(protoreflect.Name(name))
+				if fd == nil || !got.ProtoReflect().Has(fd) {
+					t.Errorf("required field %q not sent", name)
+				}
+			}
+		})
+	}
+}
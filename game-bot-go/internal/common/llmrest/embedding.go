@@ -0,0 +1,79 @@
+package llmrest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	llmv1 "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest/pb/llm/v1"
+)
+
+// EmbedOptions: 임베딩 요청 옵션 (비워 두면 서버 기본값: SEMANTIC_SIMILARITY, EMBEDDING_DIMENSIONS)
+type EmbedOptions struct {
+	TaskType   string
+	Dimensions int
+}
+
+// Embedding: 텍스트 하나의 임베딩 벡터
+type Embedding struct {
+	Values []float32 `json:"values"`
+	Cached bool      `json:"cached"`
+}
+
+// EmbedResponse: 요청 텍스트 순서대로 정렬된 임베딩 응답
+type EmbedResponse struct {
+	Embeddings []Embedding `json:"embeddings"`
+	Model      string      `json:"model"`
+	Dimensions int         `json:"dimensions"`
+}
+
+// Embed: 텍스트 목록의 임베딩을 요청합니다. 서버가 벡터를 캐시하므로 같은 텍스트를 반복 요청해도 LLM 호출은 한 번입니다.
+func (c *Client) Embed(ctx context.Context, texts []string, opts EmbedOptions) (*EmbedResponse, error) {
+	if c.grpcClient == nil {
+		return nil, ErrGRPCClientRequired
+	}
+
+	req := &llmv1.EmbedRequest{Texts: texts}
+	if taskType := strings.TrimSpace(opts.TaskType); taskType != "" {
+		req.TaskType = &taskType
+	}
+	if opts.Dimensions > 0 {
+		req.Dimensions = Ptr(int32(opts.Dimensions))
+	}
+
+	callCtx, cancel := c.grpcCallContext(ctx)
+	defer cancel()
+
+	resp, err := c.grpcClient.Embed(callCtx, req)
+	if err != nil {
+		return nil, fmt.Errorf("grpc embed failed: %w", err)
+	}
+
+	out := &EmbedResponse{
+		Embeddings: make([]Embedding, 0, len(resp.Embeddings)),
+		Model:      resp.Model,
+		Dimensions: int(resp.Dimensions),
+	}
+	for _, item := range resp.Embeddings {
+		out.Embeddings = append(out.Embeddings, Embedding{Values: item.GetValues(), Cached: item.GetCached()})
+	}
+	return out, nil
+}
+
+// CosineSimilarity: 두 임베딩의 코사인 유사도(-1 ~ 1)를 계산합니다. 길이가 다르거나 영벡터면 0을 반환합니다.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
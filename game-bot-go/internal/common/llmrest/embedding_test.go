@@ -0,0 +1,25 @@
+package llmrest
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCosineSimilarity(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b []float32
+		want float64
+	}{
+		{"same direction", []float32{1, 2, 3}, []float32{2, 4, 6}, 1},
+		{"orthogonal", []float32{1, 0}, []float32{0, 1}, 0},
+		{"opposite", []float32{1, 1}, []float32{-1, -1}, -1},
+		{"length mismatch", []float32{1, 2}, []float32{1, 2, 3}, 0},
+		{"zero vector", []float32{0, 0}, []float32{1, 1}, 0},
+	}
+	for _, tc := range cases {
+		if got := CosineSimilarity(tc.a, tc.b); math.Abs(got-tc.want) > 1e-6 {
+			t.Errorf("%s: CosineSimilarity() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -12,5 +12,6 @@ func NewFromConfig(cfg commonconfig.LlmConfig) (*Client, error) {
 		Timeout:        cfg.Timeout,
 		ConnectTimeout: cfg.ConnectTimeout,
 		EnableOTel:     cfg.EnableOTel,
+		BotID:          cfg.BotID,
 	})
 }
@@ -59,3 +59,32 @@ type UsageListResponse struct {
 	TotalRequestCount int64                `json:"total_request_count"`
 	Model             *string              `json:"model,omitempty"`
 }
+
+// TaskUsage: 작업(RPC)별 토큰 사용량 집계 정보
+type TaskUsage struct {
+	Task            string `json:"task"`
+	InputTokens     int64  `json:"input_tokens"`
+	OutputTokens    int64  `json:"output_tokens"`
+	TotalTokens     int64  `json:"total_tokens"`
+	ReasoningTokens int64  `json:"reasoning_tokens"`
+	RequestCount    int64  `json:"request_count"`
+}
+
+// SessionUsageResponse: 세션 단위 토큰 사용량 응답 (기록이 없으면 0)
+type SessionUsageResponse struct {
+	SessionID       string      `json:"session_id"`
+	InputTokens     int64       `json:"input_tokens"`
+	OutputTokens    int64       `json:"output_tokens"`
+	TotalTokens     int64       `json:"total_tokens"`
+	ReasoningTokens int64       `json:"reasoning_tokens"`
+	RequestCount    int64       `json:"request_count"`
+	Tasks           []TaskUsage `json:"tasks"`
+	FirstUsedAt     *string     `json:"first_used_at,omitempty"`
+	LastUsedAt      *string     `json:"last_used_at,omitempty"`
+}
+
+// TaskUsageListResponse: 기간 내 작업별 토큰 사용량 목록 응답
+type TaskUsageListResponse struct {
+	Days  int         `json:"days"`
+	Tasks []TaskUsage `json:"tasks"`
+}
@@ -0,0 +1,26 @@
+package llmrest
+
+import "context"
+
+// llmPriorityHeader: LLM 서버 호출 대기열 우선순위 메타데이터 키 (mcp-llm-server-go grpcserver와 동일)
+const (
+	llmPriorityHeader = "x-llm-priority"
+	llmPriorityBatch  = "batch"
+)
+
+type batchPriorityKey struct{}
+
+// WithBatchPriority: 이후 이 컨텍스트로 보내는 RPC를 batch 우선순위로 표시합니다.
+// 서버가 바쁘면 게임 진행 호출보다 늦게 처리되고, 대기열이 차면 먼저 거절(ResourceExhausted)된다.
+// 미리 생성처럼 사용자가 기다리지 않는 호출에만 쓴다.
+func WithBatchPriority(ctx context.Context) context.Context {
+	return context.WithValue(ctx, batchPriorityKey{}, true)
+}
+
+func isBatchPriority(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	batch, _ := ctx.Value(batchPriorityKey{}).(bool)
+	return batch
+}
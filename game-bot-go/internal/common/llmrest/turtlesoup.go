@@ -46,6 +46,13 @@ type TurtleSoupHintResponse struct {
 	Level int    `json:"level"`
 }
 
+// TurtleSoupEpilogueResponse: 후일담(보너스 라운드) 응답. Answer는 보너스 질문의 예상 정답입니다.
+type TurtleSoupEpilogueResponse struct {
+	Epilogue string `json:"epilogue"`
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
 // TurtleSoupValidateRequest: 정답 검증 요청 파라미터
 type TurtleSoupValidateRequest struct {
 	SessionID    *string `json:"session_id,omitempty"`
@@ -178,6 +185,35 @@ func (c *Client) TurtleSoupGenerateHint(
 	return &TurtleSoupHintResponse{Hint: resp.Hint, Level: int(resp.Level)}, nil
 }
 
+// TurtleSoupGenerateEpilogue: 풀린 퍼즐의 후일담과 보너스 질문 생성을 요청합니다.
+func (c *Client) TurtleSoupGenerateEpilogue(
+	ctx context.Context,
+	chatID string,
+	namespace string,
+	scenario string,
+	solution string,
+) (*TurtleSoupEpilogueResponse, error) {
+	if c.grpcClient == nil {
+		return nil, ErrGRPCClientRequired
+	}
+
+	callCtx, cancel := c.grpcCallContext(ctx)
+	defer cancel()
+
+	req := &llmv1.TurtleSoupGenerateEpilogueRequest{
+		ChatId:    &chatID,
+		Namespace: &namespace,
+		Scenario:  scenario,
+		Solution:  solution,
+	}
+	resp, err := c.grpcClient.TurtleSoupGenerateEpilogue(callCtx, req)
+	if err != nil {
+		return nil, fmt.Errorf("grpc turtlesoup epilogue failed: %w", err)
+	}
+
+	return &TurtleSoupEpilogueResponse{Epilogue: resp.Epilogue, Question: resp.Question, Answer: resp.Answer}, nil
+}
+
 // TurtleSoupValidateSolution: 사용자의 정답 시도를 검증합니다.
 func (c *Client) TurtleSoupValidateSolution(
 	ctx context.Context,
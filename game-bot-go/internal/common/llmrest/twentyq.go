@@ -75,12 +75,122 @@ type TwentyQSynonymResponse struct {
 	RawText string  `json:"raw_text"`
 }
 
+// TwentyQHintFeedback: 같은 카테고리 지난 힌트의 플레이어 평가 집계 (힌트 프롬프트 참고용)
+type TwentyQHintFeedback struct {
+	Useful          int      `json:"useful"`
+	Useless         int      `json:"useless"`
+	UselessExamples []string `json:"useless_examples,omitempty"`
+}
+
 // TwentyQGenerateHints: 힌트를 생성 요청을 전송합니다.
 func (c *Client) TwentyQGenerateHints(ctx context.Context, target string, category string, details map[string]any) (*TwentyQHintsResponse, error) {
+	return c.TwentyQGenerateHintsWithFeedback(ctx, target, category, details, nil)
+}
+
+// TwentyQGenerateHintsWithFeedback: 지난 힌트 평가 집계를 함께 보내 힌트 생성을 요청합니다. feedback이 nil이면 보내지 않습니다.
+func (c *Client) TwentyQGenerateHintsWithFeedback(
+	ctx context.Context,
+	target string,
+	category string,
+	details map[string]any,
+	feedback *TwentyQHintFeedback,
+) (*TwentyQHintsResponse, error) {
 	if c.grpcClient == nil {
 		return nil, ErrGRPCClientRequired
 	}
 
+	req, err := twentyQHintsRequestPB(target, category, details, feedback)
+	if err != nil {
+		return nil, err
+	}
+
+	callCtx, cancel := c.grpcCallContext(ctx)
+	defer cancel()
+
+	resp, err := c.grpcClient.TwentyQGenerateHints(callCtx, req)
+	if err != nil {
+		return nil, fmt.Errorf("grpc twentyq generate hints failed: %w", err)
+	}
+	return &TwentyQHintsResponse{
+		Hints:            resp.Hints,
+		ThoughtSignature: resp.ThoughtSignature,
+	}, nil
+}
+
+// TwentyQBatchHintsItem: 일괄 힌트 생성 요청의 항목
+type TwentyQBatchHintsItem struct {
+	Target   string
+	Category string
+	Details  map[string]any
+	Feedback *TwentyQHintFeedback
+}
+
+// TwentyQBatchHintResult: 일괄 힌트 생성의 항목별 결과 (실패하면 ErrorCode/ErrorMessage가 채워짐)
+type TwentyQBatchHintResult struct {
+	Target       string   `json:"target"`
+	Category     string   `json:"category"`
+	Hints        []string `json:"hints"`
+	ErrorCode    string   `json:"error_code,omitempty"`
+	ErrorMessage string   `json:"error_message,omitempty"`
+}
+
+// OK: 항목의 힌트 생성이 성공했는지 여부
+func (r TwentyQBatchHintResult) OK() bool {
+	return r.ErrorCode == ""
+}
+
+// TwentyQBatchHintsResponse: 일괄 힌트 생성 응답 (Results는 요청 항목 순서)
+type TwentyQBatchHintsResponse struct {
+	Results   []TwentyQBatchHintResult `json:"results"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+}
+
+// TwentyQBatchGenerateHints: 여러 정답의 힌트를 한 번에 미리 생성하도록 요청합니다.
+// 서버가 batch 우선순위로 처리하므로 게임 시작처럼 사용자가 기다리는 곳에서는 쓰지 않습니다.
+// 일부 항목이 실패해도 오류를 반환하지 않으니 항목별 OK()를 확인해야 합니다.
+// 항목이 많으면 오래 걸릴 수 있으므로 ctx에 충분한 deadline을 지정하는 것이 좋습니다. (없으면 클라이언트 기본 타임아웃)
+func (c *Client) TwentyQBatchGenerateHints(ctx context.Context, items []TwentyQBatchHintsItem) (*TwentyQBatchHintsResponse, error) {
+	if c.grpcClient == nil {
+		return nil, ErrGRPCClientRequired
+	}
+
+	reqItems := make([]*llmv1.TwentyQGenerateHintsRequest, len(items))
+	for i, item := range items {
+		req, err := twentyQHintsRequestPB(item.Target, item.Category, item.Details, item.Feedback)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		reqItems[i] = req
+	}
+
+	callCtx, cancel := c.grpcCallContext(WithBatchPriority(ctx))
+	defer cancel()
+
+	resp, err := c.grpcClient.TwentyQBatchGenerateHints(callCtx, &llmv1.TwentyQBatchGenerateHintsRequest{Items: reqItems})
+	if err != nil {
+		return nil, fmt.Errorf("grpc twentyq batch generate hints failed: %w", err)
+	}
+
+	out := &TwentyQBatchHintsResponse{
+		Results:   make([]TwentyQBatchHintResult, len(resp.GetResults())),
+		Succeeded: int(resp.GetSucceeded()),
+		Failed:    int(resp.GetFailed()),
+	}
+	for i, result := range resp.GetResults() {
+		out.Results[i] = TwentyQBatchHintResult{
+			Target:       result.GetTarget(),
+			Category:     result.GetCategory(),
+			Hints:        result.GetHints(),
+			ErrorCode:    result.GetErrorCode(),
+			ErrorMessage: result.GetErrorMessage(),
+		}
+	}
+	return out, nil
+}
+
+// twentyQHintsRequestPB: 힌트 생성 요청 pb를 만듭니다. details/feedback이 비어 있으면 보내지 않습니다.
+func twentyQHintsRequestPB(target string, category string, details map[string]any, feedback *TwentyQHintFeedback) (*llmv1.TwentyQGenerateHintsRequest, error) {
 	var detailsStruct *structpb.Struct
 	if len(details) > 0 {
 		st, err := structpb.NewStruct(details)
@@ -90,20 +200,20 @@ func (c *Client) TwentyQGenerateHints(ctx context.Context, target string, catego
 		detailsStruct = st
 	}
 
-	callCtx, cancel := c.grpcCallContext(ctx)
-	defer cancel()
+	var feedbackPB *llmv1.TwentyQHintFeedback
+	if feedback != nil {
+		feedbackPB = &llmv1.TwentyQHintFeedback{
+			Useful:          int32(feedback.Useful),
+			Useless:         int32(feedback.Useless),
+			UselessExamples: feedback.UselessExamples,
+		}
+	}
 
-	resp, err := c.grpcClient.TwentyQGenerateHints(callCtx, &llmv1.TwentyQGenerateHintsRequest{
+	return &llmv1.TwentyQGenerateHintsRequest{
 		Target:   target,
 		Category: category,
 		Details:  detailsStruct,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("grpc twentyq generate hints failed: %w", err)
-	}
-	return &TwentyQHintsResponse{
-		Hints:            resp.Hints,
-		ThoughtSignature: resp.ThoughtSignature,
+		Feedback: feedbackPB,
 	}, nil
 }
 
@@ -264,3 +374,53 @@ func (c *Client) TwentyQGetCategories(ctx context.Context) (*TwentyQCategoriesRe
 	}
 	return &TwentyQCategoriesResponse{Categories: resp.Categories}, nil
 }
+
+// TwentyQHistoryEntry: 요약 요청에 싣는 질문/답변 한 쌍
+type TwentyQHistoryEntry struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+// TwentyQSummaryRequest: 게임 요약 요청 파라미터 (정답은 보내지 않음)
+type TwentyQSummaryRequest struct {
+	SessionID    string                `json:"sessionId,omitempty"`
+	Category     string                `json:"category"`
+	History      []TwentyQHistoryEntry `json:"history"`
+	Hints        []string              `json:"hints"`
+	WrongGuesses []string              `json:"wrongGuesses"`
+}
+
+// TwentyQSummaryResponse: 게임 요약 응답
+type TwentyQSummaryResponse struct {
+	Summary    string   `json:"summary"`
+	Eliminated []string `json:"eliminated"`
+}
+
+// TwentyQSummarizeGame: 진행 중인 게임의 중간 요약을 요청합니다.
+func (c *Client) TwentyQSummarizeGame(ctx context.Context, req TwentyQSummaryRequest) (*TwentyQSummaryResponse, error) {
+	if c.grpcClient == nil {
+		return nil, ErrGRPCClientRequired
+	}
+
+	callCtx, cancel := c.grpcCallContext(ctx)
+	defer cancel()
+
+	grpcReq := &llmv1.TwentyQSummarizeGameRequest{
+		Category:     req.Category,
+		History:      make([]*llmv1.TwentyQHistoryEntry, 0, len(req.History)),
+		Hints:        req.Hints,
+		WrongGuesses: req.WrongGuesses,
+	}
+	if req.SessionID != "" {
+		grpcReq.SessionId = &req.SessionID
+	}
+	for _, entry := range req.History {
+		grpcReq.History = append(grpcReq.History, &llmv1.TwentyQHistoryEntry{Question: entry.Question, Answer: entry.Answer})
+	}
+
+	resp, err := c.grpcClient.TwentyQSummarizeGame(callCtx, grpcReq)
+	if err != nil {
+		return nil, fmt.Errorf("grpc twentyq summarize failed: %w", err)
+	}
+	return &TwentyQSummaryResponse{Summary: resp.Summary, Eliminated: resp.Eliminated}, nil
+}
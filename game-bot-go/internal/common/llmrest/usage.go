@@ -0,0 +1,116 @@
+package llmrest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// LLM 서버가 RPC별 사용량을 돌려주는 trailer 키 (mcp-llm-server-go grpcserver와 동일)
+const (
+	usageAttributionHeader = "x-usage-attribution"
+	usageTrailerInput      = "x-usage-input-tokens"
+	usageTrailerOutput     = "x-usage-output-tokens"
+	usageTrailerReasoning  = "x-usage-reasoning-tokens"
+	usageTrailerCalls      = "x-usage-calls"
+	usageTrailerModel      = "x-usage-model"
+)
+
+// CallUsage: RPC 한 건에서 발생한 LLM 사용량입니다.
+type CallUsage struct {
+	InputTokens     int64
+	OutputTokens    int64
+	ReasoningTokens int64
+	Calls           int64
+	Model           string
+}
+
+// UsageSink: 귀속 키(게임 세션 등)가 붙은 RPC의 사용량을 전달받습니다.
+// task는 RPC 메서드 이름입니다. (예: TwentyQAnswerQuestion)
+type UsageSink interface {
+	RecordLLMUsage(ctx context.Context, key string, task string, usage CallUsage)
+}
+
+type usageAttributionKey struct{}
+
+// WithUsageAttribution: 이후 이 컨텍스트로 보내는 RPC의 사용량을 key에 귀속시킵니다.
+func WithUsageAttribution(ctx context.Context, key string) context.Context {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, usageAttributionKey{}, key)
+}
+
+func usageAttribution(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	key, _ := ctx.Value(usageAttributionKey{}).(string)
+	return key
+}
+
+// usageSinkHolder: 클라이언트 생성 후에도 sink를 등록할 수 있도록 인터셉터와 공유하는 보관소
+type usageSinkHolder struct {
+	sink atomic.Pointer[UsageSink]
+}
+
+func (h *usageSinkHolder) load() UsageSink {
+	if p := h.sink.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// SetUsageSink: 귀속 키가 붙은 RPC의 사용량을 받을 sink를 등록합니다. nil이면 해제합니다.
+func (c *Client) SetUsageSink(sink UsageSink) {
+	if c == nil || c.usageSinks == nil {
+		return
+	}
+	if sink == nil {
+		c.usageSinks.sink.Store(nil)
+		return
+	}
+	c.usageSinks.sink.Store(&sink)
+}
+
+// parseUsageTrailer: trailer에서 사용량을 읽습니다. LLM 호출이 없었으면 false를 반환합니다.
+func parseUsageTrailer(md metadata.MD) (CallUsage, bool) {
+	calls := trailerInt(md, usageTrailerCalls)
+	if calls <= 0 {
+		return CallUsage{}, false
+	}
+	usage := CallUsage{
+		InputTokens:     trailerInt(md, usageTrailerInput),
+		OutputTokens:    trailerInt(md, usageTrailerOutput),
+		ReasoningTokens: trailerInt(md, usageTrailerReasoning),
+		Calls:           calls,
+	}
+	if values := md.Get(usageTrailerModel); len(values) > 0 {
+		usage.Model = strings.TrimSpace(values[0])
+	}
+	return usage, true
+}
+
+func trailerInt(md metadata.MD, key string) int64 {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return 0
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(values[0]), 10, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// usageTask: gRPC 전체 메서드 이름에서 RPC 이름만 추출합니다.
+func usageTask(method string) string {
+	if i := strings.LastIndexByte(method, '/'); i >= 0 {
+		return method[i+1:]
+	}
+	return method
+}
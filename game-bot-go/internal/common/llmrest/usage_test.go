@@ -0,0 +1,115 @@
+package llmrest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	llmv1 "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest/pb/llm/v1"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+// usageTestService: 귀속 헤더를 확인하고 사용량 trailer를 돌려주는 테스트 서버
+type usageTestService struct {
+	llmv1.UnimplementedLLMServiceServer
+	mu           sync.Mutex
+	attributions []string
+	priorities   []string
+}
+
+func (s *usageTestService) GuardIsMalicious(ctx context.Context, _ *llmv1.GuardIsMaliciousRequest) (*llmv1.GuardIsMaliciousResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.mu.Lock()
+	s.attributions = append(s.attributions, md.Get(usageAttributionHeader)...)
+	s.priorities = append(s.priorities, md.Get(llmPriorityHeader)...)
+	s.mu.Unlock()
+
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(
+		usageTrailerInput, "1200",
+		usageTrailerOutput, "30",
+		usageTrailerReasoning, "7",
+		usageTrailerCalls, "1",
+		usageTrailerModel, "gemini-2.5-flash",
+	))
+	return &llmv1.GuardIsMaliciousResponse{}, nil
+}
+
+type recordedUsage struct {
+	key   string
+	task  string
+	usage CallUsage
+}
+
+type usageSinkFunc func(ctx context.Context, key string, task string, usage CallUsage)
+
+func (f usageSinkFunc) RecordLLMUsage(ctx context.Context, key string, task string, usage CallUsage) {
+	f(ctx, key, task, usage)
+}
+
+func TestClient_UsageAttribution(t *testing.T) {
+	svc := &usageTestService{}
+	baseURL, _ := testhelper.StartTestGRPCServer(t, func(s *grpc.Server) {
+		llmv1.RegisterLLMServiceServer(s, svc)
+	})
+
+	client, err := New(Config{BaseURL: baseURL, Timeout: 2 * time.Second})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	var records []recordedUsage
+	client.SetUsageSink(usageSinkFunc(func(_ context.Context, key string, task string, usage CallUsage) {
+		records = append(records, recordedUsage{key: key, task: task, usage: usage})
+	}))
+
+	ctx := context.Background()
+	// 귀속 키가 없으면 헤더도 보내지 않고 sink도 호출하지 않음
+	if _, err := client.GuardIsMalicious(ctx, "hello"); err != nil {
+		t.Fatalf("GuardIsMalicious failed: %v", err)
+	}
+	if _, err := client.GuardIsMalicious(WithUsageAttribution(ctx, "room-1"), "hello"); err != nil {
+		t.Fatalf("GuardIsMalicious failed: %v", err)
+	}
+
+	if len(svc.attributions) != 1 || svc.attributions[0] != "room-1" {
+		t.Fatalf("unexpected attribution headers: %v", svc.attributions)
+	}
+	want := recordedUsage{
+		key:   "room-1",
+		task:  "GuardIsMalicious",
+		usage: CallUsage{InputTokens: 1200, OutputTokens: 30, ReasoningTokens: 7, Calls: 1, Model: "gemini-2.5-flash"},
+	}
+	if len(records) != 1 || records[0] != want {
+		t.Fatalf("unexpected usage records: %+v", records)
+	}
+}
+
+func TestClient_BatchPriority(t *testing.T) {
+	svc := &usageTestService{}
+	baseURL, _ := testhelper.StartTestGRPCServer(t, func(s *grpc.Server) {
+		llmv1.RegisterLLMServiceServer(s, svc)
+	})
+
+	client, err := New(Config{BaseURL: baseURL, Timeout: 2 * time.Second})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	if _, err := client.GuardIsMalicious(ctx, "hello"); err != nil {
+		t.Fatalf("GuardIsMalicious failed: %v", err)
+	}
+	if _, err := client.GuardIsMalicious(WithBatchPriority(ctx), "hello"); err != nil {
+		t.Fatalf("GuardIsMalicious failed: %v", err)
+	}
+
+	if len(svc.priorities) != 1 || svc.priorities[0] != llmPriorityBatch {
+		t.Fatalf("expected one batch priority header, got %v", svc.priorities)
+	}
+}
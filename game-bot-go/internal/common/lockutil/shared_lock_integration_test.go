@@ -0,0 +1,69 @@
+//go:build integration
+
+package lockutil
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testhelper.RunIntegrationMain(m))
+}
+
+func TestSharedLock_Contention(t *testing.T) {
+	client := testhelper.NewIntegrationValkeyClient(t)
+	ctx := context.Background()
+	lockKey := testhelper.UniqueTestPrefix(t) + "lock"
+
+	const workers = 32
+	var acquired atomic.Int32
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			ok, err := TryAcquireSharedLock(ctx, client, lockKey, 30)
+			if err != nil {
+				t.Errorf("acquire failed: %v", err)
+				return
+			}
+			if ok {
+				acquired.Add(1)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if got := acquired.Load(); got != 1 {
+		t.Fatalf("expected exactly one winner, got %d", got)
+	}
+
+	ttl, err := client.Do(ctx, client.B().Ttl().Key(lockKey).Build()).AsInt64()
+	if err != nil {
+		t.Fatalf("ttl failed: %v", err)
+	}
+	if ttl <= 0 || ttl > 30 {
+		t.Fatalf("unexpected lock ttl: %d", ttl)
+	}
+
+	if err := ReleaseSharedLock(ctx, client, lockKey); err != nil {
+		t.Fatalf("release failed: %v", err)
+	}
+	ok, err := TryAcquireSharedLock(ctx, client, lockKey, 30)
+	if err != nil {
+		t.Fatalf("re-acquire failed: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected lock to be acquirable after release")
+	}
+}
@@ -5,6 +5,7 @@ const (
 	ScriptPendingEnqueue      = "pending_enqueue"
 	ScriptPendingDequeue      = "pending_dequeue"
 	ScriptPendingDequeueBatch = "pending_dequeue_batch"
+	ScriptRateLimitAcquire    = "ratelimit_acquire"
 )
 
 // twentyq 스크립트 이름 상수.
@@ -0,0 +1,124 @@
+package messageprovider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// 지원 언어 코드 (ISO 639-1)
+const (
+	LocaleKo = "ko"
+	LocaleEn = "en"
+	LocaleJa = "ja"
+)
+
+// DefaultLocale: 기본 번들의 언어. 언어별 번들에 없는 키는 이 언어의 메시지를 사용합니다.
+const DefaultLocale = LocaleKo
+
+type localeKey struct{}
+
+// WithLocale: 이후 For(ctx)로 조회할 메시지 언어를 컨텍스트에 담습니다.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, NormalizeLocale(locale))
+}
+
+// LocaleFromContext: 컨텍스트에 담긴 메시지 언어를 반환합니다. (없으면 빈 문자열)
+func LocaleFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
+
+// NormalizeLocale: 언어 태그를 소문자 코드로 정규화합니다. (예: "en_US" → "en-us")
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
+
+// AddLocale: 언어별 번들을 등록합니다. 번들에 없는 키는 기본 번들(p)에서 찾습니다.
+func (p *Provider) AddLocale(locale string, bundle *Provider) error {
+	locale = NormalizeLocale(locale)
+	if locale == "" || locale == DefaultLocale {
+		return fmt.Errorf("invalid bundle locale: %q", locale)
+	}
+	if bundle == nil {
+		return fmt.Errorf("bundle is nil: %q", locale)
+	}
+	if p.locales == nil {
+		p.locales = make(map[string]*Provider)
+	}
+	p.locales[locale] = &Provider{root: bundle.root, fallback: p}
+	return nil
+}
+
+// AddLocaleYAMLAtPath: YAML의 rootKey 하위 객체를 언어별 번들로 등록합니다.
+func (p *Provider) AddLocaleYAMLAtPath(locale string, yamlContent string, rootKey string) error {
+	bundle, err := NewFromYAMLAtPath(yamlContent, rootKey)
+	if err != nil {
+		return fmt.Errorf("load %s bundle failed: %w", locale, err)
+	}
+	return p.AddLocale(locale, bundle)
+}
+
+// Locales: 사용할 수 있는 언어 코드 목록을 반환합니다. (기본 언어가 먼저, 나머지는 코드순)
+func (p *Provider) Locales() []string {
+	out := []string{DefaultLocale}
+	if p == nil {
+		return out
+	}
+	extra := make([]string, 0, len(p.locales))
+	for locale := range p.locales {
+		extra = append(extra, locale)
+	}
+	slices.Sort(extra)
+	return append(out, extra...)
+}
+
+// HasLocale: 해당 언어의 메시지를 제공할 수 있는지 확인합니다. (지역 태그는 언어 코드로 판단)
+func (p *Provider) HasLocale(locale string) bool {
+	return p.resolveLocale(locale) != "" || baseLanguage(NormalizeLocale(locale)) == DefaultLocale
+}
+
+// ForLocale: 해당 언어의 메시지를 조회하는 Provider를 반환합니다.
+// 대체 순서: 요청 언어(en-us) → 언어 코드(en) → 기본 번들(ko)
+func (p *Provider) ForLocale(locale string) *Provider {
+	if p == nil {
+		return nil
+	}
+	if p.fallback != nil {
+		// 언어별 번들에서 다시 고르는 경우 기본 번들 기준으로 찾는다
+		return p.fallback.ForLocale(locale)
+	}
+	if resolved := p.resolveLocale(locale); resolved != "" {
+		return p.locales[resolved]
+	}
+	return p
+}
+
+// For: 컨텍스트에 담긴 언어(WithLocale)의 Provider를 반환합니다. 언어가 없으면 기본 번들을 사용합니다.
+func (p *Provider) For(ctx context.Context) *Provider {
+	return p.ForLocale(LocaleFromContext(ctx))
+}
+
+// resolveLocale: 등록된 번들 중 요청 언어에 맞는 코드를 찾습니다. (없으면 빈 문자열)
+func (p *Provider) resolveLocale(locale string) string {
+	if p == nil || len(p.locales) == 0 {
+		return ""
+	}
+	locale = NormalizeLocale(locale)
+	for _, candidate := range []string{locale, baseLanguage(locale)} {
+		if _, ok := p.locales[candidate]; ok && candidate != "" {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// baseLanguage: 지역 태그를 뗀 언어 코드를 반환합니다. (예: "en-us" → "en")
+func baseLanguage(locale string) string {
+	lang, _, _ := strings.Cut(locale, "-")
+	return lang
+}
@@ -0,0 +1,89 @@
+package messageprovider
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func newLocalizedProvider(t *testing.T) *Provider {
+	t.Helper()
+	base, err := NewFromYAML(`
+greeting: "안녕하세요 {name}"
+only_ko: "한국어 전용"
+`)
+	if err != nil {
+		t.Fatalf("base bundle failed: %v", err)
+	}
+	if err := base.AddLocaleYAMLAtPath("en", "toon:\n  greeting: \"Hello {name}\"\n", "toon"); err != nil {
+		t.Fatalf("en bundle failed: %v", err)
+	}
+	if err := base.AddLocaleYAMLAtPath("JA", "greeting: \"こんにちは {name}\"\n", ""); err != nil {
+		t.Fatalf("ja bundle failed: %v", err)
+	}
+	return base
+}
+
+func TestProvider_ForLocale(t *testing.T) {
+	provider := newLocalizedProvider(t)
+
+	tests := []struct {
+		locale string
+		key    string
+		want   string
+	}{
+		{"", "greeting", "안녕하세요 Kim"},
+		{"ko", "greeting", "안녕하세요 Kim"},
+		{"en", "greeting", "Hello Kim"},
+		{"en_US", "greeting", "Hello Kim"},
+		{"ja", "greeting", "こんにちは Kim"},
+		{"fr", "greeting", "안녕하세요 Kim"},
+		// 언어별 번들에 없는 키는 기본 번들로 대체
+		{"en", "only_ko", "한국어 전용"},
+		{"en", "missing", "missing"},
+	}
+	for _, tt := range tests {
+		if got := provider.ForLocale(tt.locale).Get(tt.key, P("name", "Kim")); got != tt.want {
+			t.Errorf("ForLocale(%q).Get(%q) = %q, want %q", tt.locale, tt.key, got, tt.want)
+		}
+	}
+
+	// 언어별 번들에서 다시 고르면 기본 번들 기준으로 찾는다
+	if got := provider.ForLocale("en").ForLocale("ja").Get("greeting", P("name", "Kim")); got != "こんにちは Kim" {
+		t.Errorf("nested ForLocale = %q", got)
+	}
+}
+
+func TestProvider_ForContext(t *testing.T) {
+	provider := newLocalizedProvider(t)
+
+	if got := provider.For(context.Background()).Get("greeting", P("name", "Lee")); got != "안녕하세요 Lee" {
+		t.Errorf("no locale = %q", got)
+	}
+	ctx := WithLocale(context.Background(), " EN ")
+	if LocaleFromContext(ctx) != "en" {
+		t.Fatalf("locale must be normalized, got %q", LocaleFromContext(ctx))
+	}
+	if got := provider.For(ctx).Get("greeting", P("name", "Lee")); got != "Hello Lee" {
+		t.Errorf("en locale = %q", got)
+	}
+}
+
+func TestProvider_Locales(t *testing.T) {
+	provider := newLocalizedProvider(t)
+
+	if got := provider.Locales(); !slices.Equal(got, []string{"ko", "en", "ja"}) {
+		t.Fatalf("Locales() = %v", got)
+	}
+	for _, locale := range []string{"ko", "ko-KR", "en", "en-GB", "ja"} {
+		if !provider.HasLocale(locale) {
+			t.Errorf("expected %q to be supported", locale)
+		}
+	}
+	if provider.HasLocale("fr") {
+		t.Error("fr must not be supported")
+	}
+	if err := provider.AddLocale("ko", provider); err == nil {
+		t.Error("default locale must not be registered as a bundle")
+	}
+}
@@ -8,8 +8,13 @@ import (
 )
 
 // Provider: 로드된 YAML 맵 데이터를 기반으로 메시지 템플릿을 제공하고 파라미터를 치환하는 컴포넌트입니다.
+// 언어별 번들을 등록하면 For/ForLocale로 해당 언어의 메시지를 조회할 수 있습니다.
 type Provider struct {
 	root map[string]any
+	// fallback: 이 번들에 없는 키를 찾을 다음 Provider (언어별 번들 → 기본 번들)
+	fallback *Provider
+	// locales: 기본 번들에 등록된 언어별 번들 (시작 시에만 등록하고 이후에는 읽기만 합니다)
+	locales map[string]*Provider
 }
 
 // NewFromYAML: YAML 문자열을 파싱하여 Provider 인스턴스를 생성합니다.
@@ -67,6 +72,9 @@ func (p *Provider) Get(key string, params ...Param) string {
 
 	value, ok := resolveDottedKey(p.root, key)
 	if !ok {
+		if p.fallback != nil {
+			return p.fallback.Get(key, params...)
+		}
 		return key
 	}
 
@@ -57,7 +57,7 @@ func (s *BaseMessageSender) SendWaiting(ctx context.Context, chatID string, thre
 // SendError: 발생한 에러에 매핑된 사용자 메시지를 전송합니다.
 // UseFinalForErrors 설정에 따라 Final 또는 Error 타입으로 전송합니다.
 func (s *BaseMessageSender) SendError(ctx context.Context, chatID string, threadID *string, key string, params ...messageprovider.Param) error {
-	text := s.msgProvider.Get(key, params...)
+	text := s.msgProvider.For(ctx).Get(key, params...)
 	if s.config.UseFinalForErrors {
 		return s.publish(ctx, mqmsg.NewFinal(chatID, text, threadID))
 	}
@@ -69,9 +69,9 @@ func (s *BaseMessageSender) SendError(ctx context.Context, chatID string, thread
 func (s *BaseMessageSender) SendLockError(ctx context.Context, chatID string, threadID *string, holderName *string) error {
 	var text string
 	if holderName != nil && *holderName != "" && s.config.LockErrorWithHolderKey != "" {
-		text = s.msgProvider.Get(s.config.LockErrorWithHolderKey, messageprovider.P("holder", *holderName))
+		text = s.msgProvider.For(ctx).Get(s.config.LockErrorWithHolderKey, messageprovider.P("holder", *holderName))
 	} else {
-		text = s.msgProvider.Get(s.config.LockErrorKey)
+		text = s.msgProvider.For(ctx).Get(s.config.LockErrorKey)
 	}
 	return s.publish(ctx, mqmsg.NewError(chatID, text, threadID))
 }
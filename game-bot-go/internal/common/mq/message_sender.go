@@ -54,5 +54,5 @@ func SendWaitingFromCommand(
 	if key == nil {
 		return nil
 	}
-	return publish(ctx, mqmsg.NewWaiting(chatID, msgProvider.Get(*key), threadID))
+	return publish(ctx, mqmsg.NewWaiting(chatID, msgProvider.For(ctx).Get(*key), threadID))
 }
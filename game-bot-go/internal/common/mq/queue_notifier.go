@@ -62,55 +62,55 @@ func NewMessageQueueNotifier(
 
 // NotifyProcessingStart: 요청 처리가 시작되었음을 알립니다.
 func (n *MessageQueueNotifier) NotifyProcessingStart(
-	_ context.Context,
+	ctx context.Context,
 	chatID string,
 	pending domainmodels.PendingMessage,
 	emit func(mqmsg.OutboundMessage) error,
 ) error {
-	userName := pending.DisplayName(chatID, n.provider.Get(n.cfg.UserAnonymousKey))
-	text := n.provider.Get(n.cfg.QueueProcessingKey, messageprovider.P("user", userName))
+	userName := pending.DisplayName(chatID, n.provider.For(ctx).Get(n.cfg.UserAnonymousKey))
+	text := n.provider.For(ctx).Get(n.cfg.QueueProcessingKey, messageprovider.P("user", userName))
 	return emit(n.cfg.ProcessingStartFactory(chatID, text, pending.ThreadID))
 }
 
 // NotifyRetry: 처리 지연(락 실패 등)으로 재시도 중임을 알립니다.
 func (n *MessageQueueNotifier) NotifyRetry(
-	_ context.Context,
+	ctx context.Context,
 	chatID string,
 	pending domainmodels.PendingMessage,
 	emit func(mqmsg.OutboundMessage) error,
 ) error {
-	userName := pending.DisplayName(chatID, n.provider.Get(n.cfg.UserAnonymousKey))
-	text := n.provider.Get(n.cfg.QueueRetryKey, messageprovider.P("user", userName))
+	userName := pending.DisplayName(chatID, n.provider.For(ctx).Get(n.cfg.UserAnonymousKey))
+	text := n.provider.For(ctx).Get(n.cfg.QueueRetryKey, messageprovider.P("user", userName))
 	return emit(n.cfg.RetryFactory(chatID, text, pending.ThreadID))
 }
 
 // NotifyDuplicate: 중복된 요청이 큐에 있어 처리 순서를 조정하거나 대기 중임을 알립니다.
 func (n *MessageQueueNotifier) NotifyDuplicate(
-	_ context.Context,
+	ctx context.Context,
 	chatID string,
 	pending domainmodels.PendingMessage,
 	emit func(mqmsg.OutboundMessage) error,
 ) error {
-	userName := pending.DisplayName(chatID, n.provider.Get(n.cfg.UserAnonymousKey))
-	text := n.provider.Get(n.cfg.QueueRetryDuplicateKey, messageprovider.P("user", userName))
+	userName := pending.DisplayName(chatID, n.provider.For(ctx).Get(n.cfg.UserAnonymousKey))
+	text := n.provider.For(ctx).Get(n.cfg.QueueRetryDuplicateKey, messageprovider.P("user", userName))
 	return emit(n.cfg.DuplicateFactory(chatID, text, pending.ThreadID))
 }
 
 // NotifyFailed: 요청 처리가 최종적으로 실패했음을 알립니다. (대기열 가득 참 등)
 func (n *MessageQueueNotifier) NotifyFailed(
-	_ context.Context,
+	ctx context.Context,
 	chatID string,
 	pending domainmodels.PendingMessage,
 	emit func(mqmsg.OutboundMessage) error,
 ) error {
-	userName := pending.DisplayName(chatID, n.provider.Get(n.cfg.UserAnonymousKey))
-	text := n.provider.Get(n.cfg.QueueRetryFailedKey, messageprovider.P("user", userName))
+	userName := pending.DisplayName(chatID, n.provider.For(ctx).Get(n.cfg.UserAnonymousKey))
+	text := n.provider.For(ctx).Get(n.cfg.QueueRetryFailedKey, messageprovider.P("user", userName))
 	return emit(n.cfg.FailedFactory(chatID, text, pending.ThreadID))
 }
 
 // NotifyError: 처리 도중 발생한 에러를 사용자 메시지로 변환하여 알립니다.
 func (n *MessageQueueNotifier) NotifyError(
-	_ context.Context,
+	ctx context.Context,
 	chatID string,
 	pending domainmodels.PendingMessage,
 	err error,
@@ -126,12 +126,12 @@ func (n *MessageQueueNotifier) NotifyError(
 	if n.mapError == nil {
 		text := ""
 		if n.provider != nil && n.cfg.DefaultErrorKey != "" {
-			text = n.provider.Get(n.cfg.DefaultErrorKey)
+			text = n.provider.For(ctx).Get(n.cfg.DefaultErrorKey)
 		}
 		return emit(n.cfg.ErrorFactory(chatID, text, pending.ThreadID))
 	}
 
 	key, params := n.mapError(err)
-	text := n.provider.Get(key, params...)
+	text := n.provider.For(ctx).Get(key, params...)
 	return emit(n.cfg.ErrorFactory(chatID, text, pending.ThreadID))
 }
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/outbox"
 )
 
 // ReplyPublisher: 봇의 응답 메시지를 전용 스트림으로 발행하는 발행자
 type ReplyPublisher struct {
 	publisher *StreamPublisher
+	outbox    *outbox.Outbox
 }
 
 // NewReplyPublisher: 새로운 ReplyPublisher 인스턴스를 생성합니다.
@@ -17,8 +20,33 @@ func NewReplyPublisher(publisher *StreamPublisher) *ReplyPublisher {
 	return &ReplyPublisher{publisher: publisher}
 }
 
+// SetOutbox: 발행 실패 시 응답을 보관할 재전송 대기열을 지정합니다. (nil이면 실패를 그대로 반환)
+func (p *ReplyPublisher) SetOutbox(box *outbox.Outbox) {
+	p.outbox = box
+}
+
 // Publish: 응답 메시지를 출력 스트림에 발행합니다.
+// 대기 메시지가 아닌 첫 응답이 발행되면 명령어 지연 측정 구간에 응답 시각을 남깁니다.
+// 재전송 대기열이 있으면 발행에 실패한 응답(대기 메시지 제외)을 대기열에 넣고 성공으로 처리합니다.
 func (p *ReplyPublisher) Publish(ctx context.Context, message mqmsg.OutboundMessage) error {
+	err := p.Deliver(ctx, message)
+	if err == nil {
+		if message.Type != mqmsg.OutboundWaiting {
+			latency.MarkReply(ctx)
+		}
+		return nil
+	}
+	if p.outbox == nil || message.Type == mqmsg.OutboundWaiting {
+		return err
+	}
+	if enqueueErr := p.outbox.Enqueue(context.WithoutCancel(ctx), message, err); enqueueErr != nil {
+		return fmt.Errorf("%w (outbox enqueue failed: %v)", err, enqueueErr)
+	}
+	return nil
+}
+
+// Deliver: 재전송 대기열을 거치지 않고 응답 메시지를 출력 스트림에 발행합니다. (outbox 재시도 경로)
+func (p *ReplyPublisher) Deliver(ctx context.Context, message mqmsg.OutboundMessage) error {
 	if _, err := p.publisher.Publish(ctx, message.ToStreamValues()); err != nil {
 		return fmt.Errorf("publish reply message failed: %w", err)
 	}
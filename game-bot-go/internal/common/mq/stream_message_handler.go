@@ -3,6 +3,9 @@ package mq
 import (
 	"context"
 	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
 )
@@ -44,6 +47,7 @@ func (h *StreamMessageHandler) HandleStreamMessage(ctx context.Context, message
 		h.logger.Warn("message_parsing_failed", "id", message.ID, "err", err)
 		return nil
 	}
+	inbound.ReceivedAt = streamIDTime(message.ID)
 
 	if h.logger.Enabled(ctx, slog.LevelDebug) {
 		h.logger.Debug("message_received", "id", message.ID, "chat_id", inbound.ChatID, "user_id", inbound.UserID)
@@ -53,3 +57,13 @@ func (h *StreamMessageHandler) HandleStreamMessage(ctx context.Context, message
 	}
 	return nil
 }
+
+// streamIDTime: 스트림 ID("<ms>-<seq>")의 밀리초 부분을 시각으로 변환합니다. 형식이 다르면 0을 반환합니다.
+func streamIDTime(id string) time.Time {
+	ms, _, _ := strings.Cut(id, "-")
+	millis, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || millis <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // MQ 메시지 파싱 에러 목록.
@@ -21,6 +22,8 @@ type InboundMessage struct {
 	Content  string
 	ThreadID *string
 	Sender   *string
+	// ReceivedAt: 메시지가 스트림에 들어온 시각 (스트림 ID에서 추출, 알 수 없으면 0)
+	ReceivedAt time.Time
 }
 
 // OutboundType: 아웃바운드 메시지의 유형을 나타냅니다 (waiting, final, error).
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+)
+
+const (
+	defaultDeadLetterLimit = 50
+	maxDeadLetterLimit     = 500
+)
+
+// StatusResponse: 재전송 대기열 현황과 dead-letter 목록 응답
+type StatusResponse struct {
+	Status          string  `json:"status"`
+	Pending         int64   `json:"pending"`
+	DeadLetterTotal int64   `json:"deadLetterTotal"`
+	DeadLetters     []Entry `json:"deadLetters"`
+}
+
+// RegisterRoutes: GET /admin/outbox, DELETE /admin/outbox/dead-letters 라우트를 등록합니다. (nil이면 등록하지 않음)
+func (o *Outbox) RegisterRoutes(mux *http.ServeMux, logger *slog.Logger) {
+	if o == nil {
+		return
+	}
+
+	mux.HandleFunc("GET /admin/outbox", func(w http.ResponseWriter, r *http.Request) {
+		limit := defaultDeadLetterLimit
+		if raw := r.URL.Query().Get("limit"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed < 1 || parsed > maxDeadLetterLimit {
+				_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, "INVALID_REQUEST", "limit must be between 1 and 500")
+				return
+			}
+			limit = parsed
+		}
+
+		pending, err := o.Pending(r.Context())
+		if err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to load outbox")
+			return
+		}
+		entries, total, err := o.DeadLetters(r.Context(), limit)
+		if err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to load outbox")
+			return
+		}
+		_ = commonhttputil.WriteJSON(w, http.StatusOK, StatusResponse{
+			Status:          "ok",
+			Pending:         pending,
+			DeadLetterTotal: total,
+			DeadLetters:     entries,
+		})
+	})
+
+	mux.HandleFunc("DELETE /admin/outbox/dead-letters", func(w http.ResponseWriter, r *http.Request) {
+		purged, err := o.PurgeDeadLetters(r.Context())
+		if err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to purge dead letters")
+			return
+		}
+		logger.Info("reply_outbox_dead_letters_purged", "count", purged)
+		_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{"status": "ok", "purged": purged})
+	})
+}
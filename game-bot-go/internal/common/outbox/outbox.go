@@ -0,0 +1,362 @@
+// Package outbox: 발행에 실패한 카카오톡 응답 메시지를 Valkey에 보관했다가 지수 백오프로 다시 보냅니다.
+// 최대 시도 횟수를 넘긴 메시지는 버리지 않고 dead-letter 목록으로 옮겨 관리자가 확인/정리할 수 있게 합니다.
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+)
+
+const (
+	// pollInterval: 재시도 시각이 된 메시지를 확인하는 주기
+	pollInterval = time.Second
+	// batchSize: 한 번에 꺼내는 재시도 대상 수
+	batchSize = 50
+	// maxErrorLength: 기록하는 마지막 오류 메시지 최대 길이
+	maxErrorLength = 500
+)
+
+// Deliver: 메시지를 실제로 발행하는 함수 (재시도 시에는 outbox를 거치지 않는 원래 발행 경로)
+type Deliver func(ctx context.Context, msg mqmsg.OutboundMessage) error
+
+// Entry: 재전송 대기 중이거나 dead-letter로 옮겨진 메시지
+type Entry struct {
+	ID            string             `json:"id"`
+	ChatID        string             `json:"chatId"`
+	Text          string             `json:"text"`
+	ThreadID      *string            `json:"threadId,omitempty"`
+	Type          mqmsg.OutboundType `json:"type"`
+	Attempts      int                `json:"attempts"`
+	LastError     string             `json:"lastError"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	NextAttemptAt time.Time          `json:"nextAttemptAt"`
+	DeadAt        *time.Time         `json:"deadAt,omitempty"`
+}
+
+// Message: 보관된 내용을 발행용 메시지로 되돌립니다.
+func (e Entry) Message() mqmsg.OutboundMessage {
+	return mqmsg.OutboundMessage{ChatID: e.ChatID, Text: e.Text, ThreadID: e.ThreadID, Type: e.Type}
+}
+
+// Outbox: Valkey 기반 응답 재전송 대기열
+// 키 구성: {prefix}:outbox:pending(ZSET, 다음 시도 시각 ms) / :items(Hash, ID → Entry JSON) / :dead(List, 최신순)
+type Outbox struct {
+	client valkey.Client
+	cfg    commonconfig.OutboxConfig
+	logger *slog.Logger
+	now    func() time.Time
+
+	pendingKey string
+	itemsKey   string
+	deadKey    string
+}
+
+// New: 새로운 Outbox 인스턴스를 생성합니다. prefix는 게임별 Redis 키 접두사입니다. (예: "20q")
+// 비활성화 설정이면 nil을 반환하며, nil Outbox는 아무것도 보관하지 않습니다.
+func New(client valkey.Client, prefix string, cfg commonconfig.OutboxConfig, logger *slog.Logger) *Outbox {
+	if !cfg.Enabled {
+		return nil
+	}
+	base := prefix + ":outbox"
+	return &Outbox{
+		client:     client,
+		cfg:        cfg,
+		logger:     logger,
+		now:        time.Now,
+		pendingKey: base + ":pending",
+		itemsKey:   base + ":items",
+		deadKey:    base + ":dead",
+	}
+}
+
+// Enqueue: 발행에 실패한 메시지를 재전송 대기열에 넣습니다. (cause는 최초 발행 오류, 1회 시도로 계산)
+func (o *Outbox) Enqueue(ctx context.Context, msg mqmsg.OutboundMessage, cause error) error {
+	if o == nil {
+		return errors.New("outbox disabled")
+	}
+
+	now := o.now()
+	entry := Entry{
+		ID:        newEntryID(),
+		ChatID:    msg.ChatID,
+		Text:      msg.Text,
+		ThreadID:  msg.ThreadID,
+		Type:      msg.Type,
+		Attempts:  1,
+		LastError: errorText(cause),
+		CreatedAt: now,
+	}
+	if err := o.schedule(ctx, &entry, now); err != nil {
+		return err
+	}
+
+	o.logger.Warn("reply_outbox_enqueued",
+		"id", entry.ID,
+		"chat_id", entry.ChatID,
+		"type", entry.Type,
+		"next_attempt_at", entry.NextAttemptAt,
+		"err", cause,
+	)
+	return nil
+}
+
+// Run: ctx가 끝날 때까지 재시도 시각이 된 메시지를 deliver로 다시 보냅니다.
+// 시작할 때 처리 도중 중단되어 대기열에서 빠진 메시지를 복구합니다.
+func (o *Outbox) Run(ctx context.Context, deliver Deliver) error {
+	if o == nil {
+		return nil
+	}
+	if recovered, err := o.recoverOrphans(ctx); err != nil {
+		o.logger.Warn("reply_outbox_recover_failed", "err", err)
+	} else if recovered > 0 {
+		o.logger.Info("reply_outbox_recovered", "count", recovered)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := o.ProcessDue(ctx, deliver); err != nil && ctx.Err() == nil {
+				o.logger.Warn("reply_outbox_process_failed", "err", err)
+			}
+		}
+	}
+}
+
+// ProcessDue: 재시도 시각이 된 메시지를 한 묶음 다시 보내고 전송에 성공한 수를 반환합니다.
+// 실패한 메시지는 다음 시도 시각을 늦추고, 최대 시도 횟수에 도달하면 dead-letter로 옮깁니다.
+func (o *Outbox) ProcessDue(ctx context.Context, deliver Deliver) (int, error) {
+	if o == nil {
+		return 0, nil
+	}
+
+	now := o.now()
+	ids, err := o.client.Do(ctx, o.client.B().Zrangebyscore().Key(o.pendingKey).
+		Min("-inf").Max(strconv.FormatInt(now.UnixMilli(), 10)).Limit(0, batchSize).Build()).AsStrSlice()
+	if err != nil {
+		return 0, fmt.Errorf("list due outbox entries: %w", err)
+	}
+
+	delivered := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		entry, ok, err := o.claim(ctx, id)
+		if err != nil {
+			return delivered, err
+		}
+		if !ok {
+			continue
+		}
+
+		if err := deliver(ctx, entry.Message()); err != nil {
+			o.fail(ctx, entry, err)
+			continue
+		}
+		if err := o.client.Do(ctx, o.client.B().Hdel().Key(o.itemsKey).Field(entry.ID).Build()).Error(); err != nil {
+			o.logger.Warn("reply_outbox_cleanup_failed", "id", entry.ID, "err", err)
+		}
+		delivered++
+		o.logger.Info("reply_outbox_delivered", "id", entry.ID, "chat_id", entry.ChatID, "attempts", entry.Attempts+1)
+	}
+	return delivered, nil
+}
+
+// Pending: 재전송 대기 중인 메시지 수를 반환합니다.
+func (o *Outbox) Pending(ctx context.Context) (int64, error) {
+	count, err := o.client.Do(ctx, o.client.B().Zcard().Key(o.pendingKey).Build()).AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("count outbox pending: %w", err)
+	}
+	return count, nil
+}
+
+// DeadLetters: dead-letter 목록을 최신순으로 최대 limit개 반환합니다. (전체 개수 포함)
+func (o *Outbox) DeadLetters(ctx context.Context, limit int) ([]Entry, int64, error) {
+	results := o.client.DoMulti(ctx,
+		o.client.B().Lrange().Key(o.deadKey).Start(0).Stop(int64(limit-1)).Build(),
+		o.client.B().Llen().Key(o.deadKey).Build(),
+	)
+	raw, err := results[0].AsStrSlice()
+	if err != nil {
+		return nil, 0, fmt.Errorf("list outbox dead letters: %w", err)
+	}
+	total, err := results[1].AsInt64()
+	if err != nil {
+		return nil, 0, fmt.Errorf("count outbox dead letters: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(raw))
+	for _, item := range raw {
+		var entry Entry
+		if err := json.Unmarshal([]byte(item), &entry); err != nil {
+			o.logger.Warn("reply_outbox_decode_failed", "err", err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, total, nil
+}
+
+// PurgeDeadLetters: dead-letter 목록을 비우고 삭제한 개수를 반환합니다.
+func (o *Outbox) PurgeDeadLetters(ctx context.Context) (int64, error) {
+	results := o.client.DoMulti(ctx,
+		o.client.B().Llen().Key(o.deadKey).Build(),
+		o.client.B().Del().Key(o.deadKey).Build(),
+	)
+	for _, resp := range results {
+		if err := resp.Error(); err != nil {
+			return 0, fmt.Errorf("purge outbox dead letters: %w", err)
+		}
+	}
+	purged, _ := results[0].AsInt64()
+	return purged, nil
+}
+
+// Backoff: attempts번 시도한 뒤 다음 시도까지의 대기 시간 (BaseDelay × 2^(attempts-1), MaxDelay 상한)
+func (o *Outbox) Backoff(attempts int) time.Duration {
+	delay := o.cfg.BaseDelay
+	for i := 1; i < attempts && delay < o.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, o.cfg.MaxDelay)
+}
+
+// claim: 대기열에서 메시지를 꺼냅니다. 다른 인스턴스가 먼저 꺼냈거나 본문이 없으면 false
+func (o *Outbox) claim(ctx context.Context, id string) (Entry, bool, error) {
+	removed, err := o.client.Do(ctx, o.client.B().Zrem().Key(o.pendingKey).Member(id).Build()).AsInt64()
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("claim outbox entry: %w", err)
+	}
+	if removed == 0 {
+		return Entry{}, false, nil
+	}
+
+	raw, err := o.client.Do(ctx, o.client.B().Hget().Key(o.itemsKey).Field(id).Build()).ToString()
+	if valkey.IsValkeyNil(err) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("load outbox entry: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+		o.logger.Warn("reply_outbox_decode_failed", "id", id, "err", err)
+		_ = o.client.Do(ctx, o.client.B().Hdel().Key(o.itemsKey).Field(id).Build()).Error()
+		return Entry{}, false, nil
+	}
+	return entry, true, nil
+}
+
+// fail: 재시도 실패를 기록하고 다시 예약하거나 dead-letter로 옮깁니다.
+func (o *Outbox) fail(ctx context.Context, entry Entry, cause error) {
+	now := o.now()
+	entry.Attempts++
+	entry.LastError = errorText(cause)
+
+	if entry.Attempts < o.cfg.MaxAttempts {
+		if err := o.schedule(ctx, &entry, now); err != nil {
+			o.logger.Error("reply_outbox_reschedule_failed", "id", entry.ID, "err", err)
+		}
+		return
+	}
+
+	entry.DeadAt = &now
+	entry.NextAttemptAt = time.Time{}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		o.logger.Error("reply_outbox_marshal_failed", "id", entry.ID, "err", err)
+		return
+	}
+	for _, resp := range o.client.DoMulti(ctx,
+		o.client.B().Lpush().Key(o.deadKey).Element(string(data)).Build(),
+		o.client.B().Ltrim().Key(o.deadKey).Start(0).Stop(int64(o.cfg.DeadLetterSize-1)).Build(),
+		o.client.B().Hdel().Key(o.itemsKey).Field(entry.ID).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			o.logger.Error("reply_outbox_dead_letter_failed", "id", entry.ID, "err", err)
+			return
+		}
+	}
+	o.logger.Error("reply_outbox_dead_lettered",
+		"id", entry.ID,
+		"chat_id", entry.ChatID,
+		"attempts", entry.Attempts,
+		"err", cause,
+	)
+}
+
+// schedule: 다음 시도 시각을 정해 본문을 저장하고 대기열에 넣습니다.
+func (o *Outbox) schedule(ctx context.Context, entry *Entry, now time.Time) error {
+	entry.NextAttemptAt = now.Add(o.Backoff(entry.Attempts))
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal outbox entry: %w", err)
+	}
+	for _, resp := range o.client.DoMulti(ctx,
+		o.client.B().Hset().Key(o.itemsKey).FieldValue().FieldValue(entry.ID, string(data)).Build(),
+		o.client.B().Zadd().Key(o.pendingKey).ScoreMember().ScoreMember(float64(entry.NextAttemptAt.UnixMilli()), entry.ID).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("store outbox entry: %w", err)
+		}
+	}
+	return nil
+}
+
+// recoverOrphans: 본문은 있는데 대기열에 없는 메시지(꺼낸 뒤 처리 중 종료)를 저장된 다음 시도 시각으로 다시 넣습니다.
+func (o *Outbox) recoverOrphans(ctx context.Context) (int, error) {
+	items, err := o.client.Do(ctx, o.client.B().Hgetall().Key(o.itemsKey).Build()).AsStrMap()
+	if err != nil {
+		return 0, fmt.Errorf("load outbox items: %w", err)
+	}
+
+	recovered := 0
+	for id, raw := range items {
+		var entry Entry
+		if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+			continue
+		}
+		added, err := o.client.Do(ctx, o.client.B().Zadd().Key(o.pendingKey).Nx().
+			ScoreMember().ScoreMember(float64(entry.NextAttemptAt.UnixMilli()), id).Build()).AsInt64()
+		if err != nil {
+			return recovered, fmt.Errorf("requeue outbox entry: %w", err)
+		}
+		recovered += int(added)
+	}
+	return recovered, nil
+}
+
+func newEntryID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	return strconv.FormatInt(time.Now().UnixMilli(), 36) + "-" + hex.EncodeToString(buf)
+}
+
+func errorText(err error) string {
+	if err == nil {
+		return ""
+	}
+	text := err.Error()
+	if len(text) > maxErrorLength {
+		text = text[:maxErrorLength]
+	}
+	return text
+}
@@ -0,0 +1,236 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func newTestOutbox(t *testing.T, maxAttempts int) (*Outbox, *time.Time) {
+	t.Helper()
+	client := testhelper.NewTestValkeyClient(t)
+	t.Cleanup(client.Close)
+	prefix := testhelper.UniqueTestPrefix(t) + "ob"
+	testhelper.CleanupTestKeys(t, client, prefix+":")
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	box := New(client, prefix, commonconfig.OutboxConfig{
+		Enabled:        true,
+		MaxAttempts:    maxAttempts,
+		BaseDelay:      2 * time.Second,
+		MaxDelay:       10 * time.Second,
+		DeadLetterSize: 2,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	box.now = func() time.Time { return now }
+	return box, &now
+}
+
+func testMessage(text string) mqmsg.OutboundMessage {
+	return mqmsg.OutboundMessage{ChatID: "room", Text: text, Type: mqmsg.OutboundFinal}
+}
+
+func TestNew_DisabledReturnsNil(t *testing.T) {
+	if box := New(nil, "x", commonconfig.OutboxConfig{}, slog.Default()); box != nil {
+		t.Fatal("expected nil outbox when disabled")
+	}
+}
+
+func TestBackoff_DoublesUpToMax(t *testing.T) {
+	box := &Outbox{cfg: commonconfig.OutboxConfig{BaseDelay: 2 * time.Second, MaxDelay: 10 * time.Second}}
+	want := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, expected := range want {
+		if got := box.Backoff(i + 1); got != expected {
+			t.Fatalf("attempt %d: expected %s, got %s", i+1, expected, got)
+		}
+	}
+}
+
+func TestProcessDue_RetriesAfterBackoff(t *testing.T) {
+	box, now := newTestOutbox(t, 5)
+	ctx := context.Background()
+
+	if err := box.Enqueue(ctx, testMessage("hello"), errors.New("stream down")); err != nil {
+		t.Fatal(err)
+	}
+
+	var sent []mqmsg.OutboundMessage
+	deliver := func(_ context.Context, msg mqmsg.OutboundMessage) error {
+		sent = append(sent, msg)
+		return nil
+	}
+
+	if delivered, err := box.ProcessDue(ctx, deliver); err != nil || delivered != 0 {
+		t.Fatalf("expected nothing due yet, got %d (%v)", delivered, err)
+	}
+
+	*now = now.Add(2 * time.Second)
+	delivered, err := box.ProcessDue(ctx, deliver)
+	if err != nil || delivered != 1 {
+		t.Fatalf("expected one delivery, got %d (%v)", delivered, err)
+	}
+	if len(sent) != 1 || sent[0].Text != "hello" || sent[0].ChatID != "room" || sent[0].Type != mqmsg.OutboundFinal {
+		t.Fatalf("unexpected delivered message: %+v", sent)
+	}
+
+	pending, err := box.Pending(ctx)
+	if err != nil || pending != 0 {
+		t.Fatalf("expected empty queue, got %d (%v)", pending, err)
+	}
+	if n, _ := box.client.Do(ctx, box.client.B().Hlen().Key(box.itemsKey).Build()).AsInt64(); n != 0 {
+		t.Fatalf("expected delivered entry to be removed, %d left", n)
+	}
+}
+
+func TestProcessDue_MovesToDeadLetterAfterMaxAttempts(t *testing.T) {
+	box, now := newTestOutbox(t, 3)
+	ctx := context.Background()
+	failing := func(context.Context, mqmsg.OutboundMessage) error { return errors.New("still down") }
+
+	if err := box.Enqueue(ctx, testMessage("hello"), errors.New("stream down")); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2회차 실패: 4초 뒤로 재예약
+	*now = now.Add(2 * time.Second)
+	if _, err := box.ProcessDue(ctx, failing); err != nil {
+		t.Fatal(err)
+	}
+	if pending, _ := box.Pending(ctx); pending != 1 {
+		t.Fatalf("expected entry rescheduled, pending=%d", pending)
+	}
+	*now = now.Add(3 * time.Second)
+	if _, err := box.ProcessDue(ctx, failing); err != nil {
+		t.Fatal(err)
+	}
+	if _, total, _ := box.DeadLetters(ctx, 10); total != 0 {
+		t.Fatal("entry dead-lettered before backoff elapsed")
+	}
+
+	// 3회차 실패: 최대 시도 횟수 도달
+	*now = now.Add(time.Second)
+	if _, err := box.ProcessDue(ctx, failing); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, _ := box.Pending(ctx)
+	entries, total, err := box.DeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending != 0 || total != 1 || len(entries) != 1 {
+		t.Fatalf("expected single dead letter, pending=%d total=%d", pending, total)
+	}
+	if entries[0].Attempts != 3 || entries[0].LastError != "still down" || entries[0].DeadAt == nil || entries[0].Text != "hello" {
+		t.Fatalf("unexpected dead letter: %+v", entries[0])
+	}
+}
+
+func TestDeadLetters_TrimmedAndPurged(t *testing.T) {
+	box, now := newTestOutbox(t, 2)
+	ctx := context.Background()
+	failing := func(context.Context, mqmsg.OutboundMessage) error { return errors.New("down") }
+
+	for _, text := range []string{"a", "b", "c"} {
+		if err := box.Enqueue(ctx, testMessage(text), errors.New("down")); err != nil {
+			t.Fatal(err)
+		}
+		*now = now.Add(2 * time.Second)
+		if _, err := box.ProcessDue(ctx, failing); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, total, err := box.DeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || len(entries) != 2 || entries[0].Text != "c" || entries[1].Text != "b" {
+		t.Fatalf("expected newest two dead letters, got total=%d %+v", total, entries)
+	}
+
+	purged, err := box.PurgeDeadLetters(ctx)
+	if err != nil || purged != 2 {
+		t.Fatalf("expected 2 purged, got %d (%v)", purged, err)
+	}
+	if _, total, _ := box.DeadLetters(ctx, 10); total != 0 {
+		t.Fatalf("expected empty dead letters after purge, got %d", total)
+	}
+}
+
+func TestRecoverOrphans_RequeuesClaimedEntries(t *testing.T) {
+	box, now := newTestOutbox(t, 5)
+	ctx := context.Background()
+
+	if err := box.Enqueue(ctx, testMessage("hello"), errors.New("down")); err != nil {
+		t.Fatal(err)
+	}
+	// 꺼낸 직후 종료된 상황: 대기열에서는 빠지고 본문만 남음
+	if err := box.client.Do(ctx, box.client.B().Del().Key(box.pendingKey).Build()).Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	recovered, err := box.recoverOrphans(ctx)
+	if err != nil || recovered != 1 {
+		t.Fatalf("expected one recovered entry, got %d (%v)", recovered, err)
+	}
+	*now = now.Add(2 * time.Second)
+	delivered, err := box.ProcessDue(ctx, func(context.Context, mqmsg.OutboundMessage) error { return nil })
+	if err != nil || delivered != 1 {
+		t.Fatalf("expected recovered entry delivered, got %d (%v)", delivered, err)
+	}
+}
+
+func TestRegisterRoutes_StatusAndPurge(t *testing.T) {
+	box, now := newTestOutbox(t, 2)
+	ctx := context.Background()
+	if err := box.Enqueue(ctx, testMessage("dead"), errors.New("down")); err != nil {
+		t.Fatal(err)
+	}
+	*now = now.Add(2 * time.Second)
+	if _, err := box.ProcessDue(ctx, func(context.Context, mqmsg.OutboundMessage) error { return errors.New("down") }); err != nil {
+		t.Fatal(err)
+	}
+	if err := box.Enqueue(ctx, testMessage("pending"), errors.New("down")); err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	box.RegisterRoutes(mux, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/outbox?limit=5", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var status StatusResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.Pending != 1 || status.DeadLetterTotal != 1 || len(status.DeadLetters) != 1 || status.DeadLetters[0].Text != "dead" {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/outbox?limit=0", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid limit, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/admin/outbox/dead-letters", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"purged":1`) {
+		t.Fatalf("unexpected purge response: %d %s", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,6 @@
+package ratelimit
+
+import _ "embed" // Lua 스크립트 임베드용
+
+//go:embed lua/ratelimit_acquire.lua
+var acquireLua string
@@ -0,0 +1,145 @@
+// Package ratelimit 는 채팅 명령어의 사용자/채팅방 단위 빈도 제한을 제공합니다.
+// 토큰 버킷 상태를 Valkey에 두어 여러 인스턴스가 같은 한도를 공유합니다.
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
+	luautil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/lua"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+)
+
+// Scope: 제한에 걸린 범위
+type Scope int
+
+// Scope 상수 목록.
+const (
+	// ScopeNone: 제한되지 않음
+	ScopeNone Scope = iota
+	// ScopeUser: 사용자 개인 한도 초과
+	ScopeUser
+	// ScopeChat: 채팅방 전체 한도 초과
+	ScopeChat
+)
+
+func (s Scope) String() string {
+	switch s {
+	case ScopeUser:
+		return "user"
+	case ScopeChat:
+		return "chat"
+	default:
+		return "none"
+	}
+}
+
+// Decision: 명령어 허용 여부 판정 결과
+type Decision struct {
+	Allowed    bool
+	Scope      Scope
+	RetryAfter time.Duration
+	// Notify: 이번 거부에 대해 안내 메시지를 보내야 하는지 여부 (쿨다운 내 반복 거부는 조용히 무시)
+	Notify bool
+}
+
+// RetryAfterSeconds: 다시 시도할 수 있을 때까지 남은 시간(초, 올림)을 반환합니다.
+func (d Decision) RetryAfterSeconds() int64 {
+	seconds := int64(d.RetryAfter / time.Second)
+	if d.RetryAfter%time.Second != 0 {
+		seconds++
+	}
+	return max(seconds, 1)
+}
+
+// Limiter: 사용자별/채팅방별 토큰 버킷으로 명령어 빈도를 제한합니다.
+// nil이거나 비활성화된 Limiter는 모든 요청을 허용합니다.
+type Limiter struct {
+	client   valkey.Client
+	prefix   string
+	cfg      commonconfig.RateLimitConfig
+	registry *luautil.Registry
+	now      func() time.Time
+}
+
+// NewLimiter: 새로운 Limiter 인스턴스를 생성합니다. prefix는 게임별 Redis 키 접두사입니다 (예: "20q").
+func NewLimiter(client valkey.Client, prefix string, cfg commonconfig.RateLimitConfig, logger *slog.Logger) *Limiter {
+	registry := luautil.NewRegistry([]luautil.Script{
+		{Name: luautil.ScriptRateLimitAcquire, Source: acquireLua},
+	})
+
+	preloadCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := registry.Preload(preloadCtx, client); err != nil && logger != nil {
+		logger.Warn("lua_preload_failed", "component", "ratelimit", "err", err)
+	}
+	return &Limiter{
+		client:   client,
+		prefix:   prefix + ":ratelimit",
+		cfg:      cfg,
+		registry: registry,
+		now:      time.Now,
+	}
+}
+
+// Allow: 명령어 1회를 소비할 수 있는지 확인합니다. 허용되면 사용자/채팅방 버킷에서 토큰을 차감합니다.
+// Valkey 오류 시에는 게임 진행을 막지 않도록 허용 결과와 함께 에러를 반환합니다.
+func (l *Limiter) Allow(ctx context.Context, chatID string, userID string) (Decision, error) {
+	if l == nil || !l.cfg.Enabled {
+		return Decision{Allowed: true}, nil
+	}
+
+	keys := []string{
+		valkeyx.BuildKey2(l.prefix+":user", chatID, userID),
+		valkeyx.BuildKey(l.prefix+":chat", chatID),
+		valkeyx.BuildKey2(l.prefix+":notice", chatID, userID),
+	}
+	args := []string{
+		strconv.FormatInt(l.now().UnixMilli(), 10),
+		strconv.Itoa(l.cfg.UserBurst),
+		strconv.FormatInt(refillMillis(l.cfg.UserPerMinute), 10),
+		strconv.Itoa(l.cfg.ChatBurst),
+		strconv.FormatInt(refillMillis(l.cfg.ChatPerMinute), 10),
+		strconv.FormatInt(l.cfg.NoticeCooldown.Milliseconds(), 10),
+	}
+
+	resp, err := l.registry.Exec(ctx, l.client, luautil.ScriptRateLimitAcquire, keys, args)
+	if err != nil {
+		return Decision{Allowed: true}, valkeyx.WrapRedisError("ratelimit_exec", err)
+	}
+	values, err := valkeyx.ParseLuaArray(resp, 4)
+	if err != nil {
+		return Decision{Allowed: true}, valkeyx.WrapRedisError("ratelimit_parse", err)
+	}
+
+	parsed := make([]int64, len(values))
+	for i, value := range values {
+		parsed[i], err = valkeyx.ParseLuaInt64Message(value)
+		if err != nil {
+			return Decision{Allowed: true}, valkeyx.WrapRedisError("ratelimit_parse", err)
+		}
+	}
+
+	if parsed[0] == 1 {
+		return Decision{Allowed: true}, nil
+	}
+	return Decision{
+		Allowed:    false,
+		Scope:      Scope(parsed[1]),
+		RetryAfter: time.Duration(parsed[2]) * time.Millisecond,
+		Notify:     parsed[3] == 1,
+	}, nil
+}
+
+// refillMillis: 분당 충전량을 토큰 1개 충전 간격(ms)으로 변환합니다. 0 이하이면 해당 범위를 제한하지 않습니다.
+func refillMillis(perMinute int) int64 {
+	if perMinute <= 0 {
+		return 0
+	}
+	return int64(time.Minute/time.Millisecond) / int64(perMinute)
+}
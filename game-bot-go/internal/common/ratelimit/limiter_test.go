@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func newTestLimiter(t *testing.T, cfg commonconfig.RateLimitConfig) (*Limiter, *time.Time) {
+	t.Helper()
+	client := testhelper.NewTestValkeyClient(t)
+	t.Cleanup(client.Close)
+	prefix := testhelper.UniqueTestPrefix(t) + "rl"
+	testhelper.CleanupTestKeys(t, client, prefix+":")
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(client, prefix, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestLimiter_UserBurstAndRefill(t *testing.T) {
+	limiter, now := newTestLimiter(t, commonconfig.RateLimitConfig{
+		Enabled:        true,
+		UserBurst:      2,
+		UserPerMinute:  6, // 10초에 1개
+		NoticeCooldown: 30 * time.Second,
+	})
+	ctx := context.Background()
+
+	for i := range 2 {
+		d, err := limiter.Allow(ctx, "room", "user")
+		if err != nil || !d.Allowed {
+			t.Fatalf("call %d: expected allowed, got %+v (%v)", i, d, err)
+		}
+	}
+
+	d, err := limiter.Allow(ctx, "room", "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Allowed || d.Scope != ScopeUser || !d.Notify || d.RetryAfterSeconds() != 10 {
+		t.Fatalf("expected first user rejection with notice, got %+v", d)
+	}
+
+	// 쿨다운 내 반복 거부는 안내하지 않음
+	*now = now.Add(5 * time.Second)
+	d, _ = limiter.Allow(ctx, "room", "user")
+	if d.Allowed || d.Notify || d.RetryAfterSeconds() != 5 {
+		t.Fatalf("expected silent rejection, got %+v", d)
+	}
+
+	// 다른 사용자는 영향 없음
+	if d, _ := limiter.Allow(ctx, "room", "other"); !d.Allowed {
+		t.Fatalf("other user should be allowed, got %+v", d)
+	}
+
+	*now = now.Add(5 * time.Second)
+	if d, _ := limiter.Allow(ctx, "room", "user"); !d.Allowed {
+		t.Fatalf("expected refill after 10s, got %+v", d)
+	}
+}
+
+func TestLimiter_ChatScope(t *testing.T) {
+	limiter, _ := newTestLimiter(t, commonconfig.RateLimitConfig{
+		Enabled:       true,
+		UserBurst:     10,
+		UserPerMinute: 10,
+		ChatBurst:     2,
+		ChatPerMinute: 1,
+	})
+	ctx := context.Background()
+
+	limiter.Allow(ctx, "room", "a")
+	limiter.Allow(ctx, "room", "b")
+	d, err := limiter.Allow(ctx, "room", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Allowed || d.Scope != ScopeChat || !d.Notify {
+		t.Fatalf("expected chat rejection, got %+v", d)
+	}
+	if d, _ := limiter.Allow(ctx, "other-room", "c"); !d.Allowed {
+		t.Fatalf("other room should be allowed, got %+v", d)
+	}
+}
+
+func TestLimiter_DisabledOrNil(t *testing.T) {
+	var nilLimiter *Limiter
+	if d, err := nilLimiter.Allow(context.Background(), "room", "user"); err != nil || !d.Allowed {
+		t.Fatalf("nil limiter must allow, got %+v (%v)", d, err)
+	}
+
+	limiter := &Limiter{cfg: commonconfig.RateLimitConfig{Enabled: false}}
+	if d, err := limiter.Allow(context.Background(), "room", "user"); err != nil || !d.Allowed {
+		t.Fatalf("disabled limiter must allow, got %+v (%v)", d, err)
+	}
+}
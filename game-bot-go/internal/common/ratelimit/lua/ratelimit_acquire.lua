@@ -0,0 +1,82 @@
+-- ============================================================
+-- Script: ratelimit_acquire
+-- Purpose: 사용자/채팅방 토큰 버킷을 함께 검사하고, 둘 다 여유가 있을 때만 토큰 1개씩 차감
+-- KEYS[1]: userBucketKey (HASH: tokens, ts)
+-- KEYS[2]: chatBucketKey (HASH: tokens, ts)
+-- KEYS[3]: noticeKey (STRING, 제한 안내 중복 방지)
+-- ARGV[1]: nowMs
+-- ARGV[2]: userCapacity (0 이하이면 사용자 제한 없음)
+-- ARGV[3]: userRefillMs (토큰 1개 충전 간격)
+-- ARGV[4]: chatCapacity (0 이하이면 채팅방 제한 없음)
+-- ARGV[5]: chatRefillMs
+-- ARGV[6]: noticeCooldownMs
+-- Returns: {allowed(1|0), scope(0=none|1=user|2=chat), retryAfterMs, notify(1|0)}
+-- ============================================================
+
+local now = tonumber(ARGV[1])
+local noticeCooldown = tonumber(ARGV[6])
+
+local function refill(key, capacity, refillMs)
+    if capacity <= 0 or refillMs <= 0 then
+        return nil
+    end
+    local values = redis.call("HMGET", key, "tokens", "ts")
+    local tokens = tonumber(values[1])
+    local ts = tonumber(values[2])
+    if tokens == nil or ts == nil then
+        return capacity
+    end
+    local elapsed = math.max(0, now - ts)
+    return math.min(capacity, tokens + elapsed / refillMs)
+end
+
+local function waitMs(tokens, refillMs)
+    return math.ceil((1 - tokens) * refillMs)
+end
+
+local userCapacity = tonumber(ARGV[2])
+local userRefill = tonumber(ARGV[3])
+local chatCapacity = tonumber(ARGV[4])
+local chatRefill = tonumber(ARGV[5])
+
+local userTokens = refill(KEYS[1], userCapacity, userRefill)
+local chatTokens = refill(KEYS[2], chatCapacity, chatRefill)
+
+-- 1. 거부: 부족한 버킷 중 더 오래 기다려야 하는 쪽을 사유로 반환 (토큰은 차감하지 않음)
+local scope = 0
+local retry = 0
+if userTokens ~= nil and userTokens < 1 then
+    scope = 1
+    retry = waitMs(userTokens, userRefill)
+end
+if chatTokens ~= nil and chatTokens < 1 then
+    local chatWait = waitMs(chatTokens, chatRefill)
+    if chatWait > retry then
+        scope = 2
+        retry = chatWait
+    end
+end
+if scope ~= 0 then
+    local notify = 0
+    if noticeCooldown > 0 then
+        if redis.call("SET", KEYS[3], "1", "NX", "PX", noticeCooldown) then
+            notify = 1
+        end
+    else
+        notify = 1
+    end
+    return {0, scope, retry, notify}
+end
+
+-- 2. 허용: 두 버킷에서 토큰 1개씩 차감, 가득 찰 때까지의 시간만큼만 보관
+local function consume(key, tokens, capacity, refillMs)
+    if tokens == nil then
+        return
+    end
+    redis.call("HSET", key, "tokens", tostring(tokens - 1), "ts", tostring(now))
+    redis.call("PEXPIRE", key, math.ceil(capacity * refillMs) + 1000)
+end
+
+consume(KEYS[1], userTokens, userCapacity, userRefill)
+consume(KEYS[2], chatTokens, chatCapacity, chatRefill)
+return {1, 0, 0, 0}
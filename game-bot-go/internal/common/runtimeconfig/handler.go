@@ -0,0 +1,53 @@
+package runtimeconfig
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+)
+
+// Response: 런타임 설정 조회/적용 응답
+type Response struct {
+	Status    string     `json:"status"`
+	Settings  []Entry    `json:"settings"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// UpdateRequest: 런타임 설정 적용 요청 (키 → 문자열 값, 빠진 키는 기본값)
+type UpdateRequest struct {
+	Values map[string]string `json:"values"`
+}
+
+// RegisterRoutes: GET/PUT /admin/runtime-config 라우트를 등록합니다.
+func (r *Registry) RegisterRoutes(mux *http.ServeMux, logger *slog.Logger) {
+	mux.HandleFunc("GET /admin/runtime-config", func(w http.ResponseWriter, _ *http.Request) {
+		_ = commonhttputil.WriteJSON(w, http.StatusOK, r.response())
+	})
+	mux.HandleFunc("PUT /admin/runtime-config", func(w http.ResponseWriter, req *http.Request) {
+		var body UpdateRequest
+		if err := commonhttputil.ReadJSON(req, &body, 16*1024); err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+			return
+		}
+
+		changed, err := r.Apply(body.Values)
+		if err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+		if len(changed) > 0 {
+			logger.Info("runtime_config_applied", "changed", changed)
+		}
+		_ = commonhttputil.WriteJSON(w, http.StatusOK, r.response())
+	})
+}
+
+func (r *Registry) response() Response {
+	resp := Response{Status: "ok", Settings: r.Entries()}
+	if updatedAt := r.UpdatedAt(); !updatedAt.IsZero() {
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp
+}
@@ -0,0 +1,199 @@
+// Package runtimeconfig: 관리 대시보드가 재시작 없이 바꿀 수 있는 런타임 설정 레지스트리
+package runtimeconfig
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Type: 설정 값 타입
+type Type string
+
+// 설정 값 타입 목록
+const (
+	TypeInt   Type = "int"
+	TypeFloat Type = "float"
+	TypeBool  Type = "bool"
+)
+
+// ErrInvalidValue: 알 수 없는 키이거나 값이 타입/범위에 맞지 않음
+var ErrInvalidValue = errors.New("invalid runtime config value")
+
+// Definition: 설정 하나의 정의 (Default는 환경 변수로 정해진 기동 시 값)
+type Definition struct {
+	Key         string   `json:"key"`
+	Type        Type     `json:"type"`
+	Description string   `json:"description"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Default     string   `json:"default"`
+}
+
+// Entry: 설정 정의와 현재 적용 값
+type Entry struct {
+	Definition
+	Value      string `json:"value"`
+	Overridden bool   `json:"overridden"`
+}
+
+type setting struct {
+	def   Definition
+	value string
+	parse func(raw string) (string, error) // 검증 후 정규화된 문자열 반환
+	apply func(raw string)
+}
+
+// Registry: 봇이 노출하는 런타임 설정 모음
+// 대시보드는 항상 전체 값을 보내므로, 빠진 키는 기본값으로 되돌립니다.
+type Registry struct {
+	mu        sync.Mutex
+	settings  map[string]*setting
+	order     []string
+	updatedAt time.Time
+}
+
+// NewRegistry: 빈 레지스트리를 생성합니다.
+func NewRegistry() *Registry {
+	return &Registry{settings: make(map[string]*setting)}
+}
+
+// Int: 정수 설정을 등록합니다. 값이 바뀔 때마다 apply가 호출됩니다.
+func (r *Registry) Int(key, description string, def, minValue, maxValue int, apply func(int)) {
+	r.register(Definition{
+		Key:         key,
+		Type:        TypeInt,
+		Description: description,
+		Min:         floatPtr(float64(minValue)),
+		Max:         floatPtr(float64(maxValue)),
+		Default:     strconv.Itoa(def),
+	}, func(raw string) (string, error) {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v < minValue || v > maxValue {
+			return "", fmt.Errorf("%w: %s must be an integer between %d and %d", ErrInvalidValue, key, minValue, maxValue)
+		}
+		return strconv.Itoa(v), nil
+	}, func(raw string) {
+		v, _ := strconv.Atoi(raw)
+		apply(v)
+	})
+}
+
+// Float: 실수 설정을 등록합니다.
+func (r *Registry) Float(key, description string, def, minValue, maxValue float64, apply func(float64)) {
+	r.register(Definition{
+		Key:         key,
+		Type:        TypeFloat,
+		Description: description,
+		Min:         floatPtr(minValue),
+		Max:         floatPtr(maxValue),
+		Default:     formatFloat(def),
+	}, func(raw string) (string, error) {
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(v) || v < minValue || v > maxValue {
+			return "", fmt.Errorf("%w: %s must be a number between %s and %s", ErrInvalidValue, key, formatFloat(minValue), formatFloat(maxValue))
+		}
+		return formatFloat(v), nil
+	}, func(raw string) {
+		v, _ := strconv.ParseFloat(raw, 64)
+		apply(v)
+	})
+}
+
+// Bool: 켜기/끄기 설정을 등록합니다.
+func (r *Registry) Bool(key, description string, def bool, apply func(bool)) {
+	r.register(Definition{
+		Key:         key,
+		Type:        TypeBool,
+		Description: description,
+		Default:     strconv.FormatBool(def),
+	}, func(raw string) (string, error) {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s must be true or false", ErrInvalidValue, key)
+		}
+		return strconv.FormatBool(v), nil
+	}, func(raw string) {
+		v, _ := strconv.ParseBool(raw)
+		apply(v)
+	})
+}
+
+func (r *Registry) register(def Definition, parse func(string) (string, error), apply func(string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.settings[def.Key]; exists {
+		panic("runtimeconfig: duplicate key " + def.Key)
+	}
+	r.settings[def.Key] = &setting{def: def, value: def.Default, parse: parse, apply: apply}
+	r.order = append(r.order, def.Key)
+}
+
+// Apply: 설정 전체를 교체합니다. 하나라도 잘못되면 아무것도 바꾸지 않습니다.
+// 값이 실제로 바뀐 설정의 키 목록을 반환합니다.
+func (r *Registry) Apply(values map[string]string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next := make(map[string]string, len(r.settings))
+	for key, raw := range values {
+		s, ok := r.settings[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidValue, key)
+		}
+		normalized, err := s.parse(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, err
+		}
+		next[key] = normalized
+	}
+
+	var changed []string
+	for _, key := range r.order {
+		s := r.settings[key]
+		value, ok := next[key]
+		if !ok {
+			value = s.def.Default
+		}
+		if value == s.value {
+			continue
+		}
+		s.value = value
+		s.apply(value)
+		changed = append(changed, key)
+	}
+	r.updatedAt = time.Now()
+	return changed, nil
+}
+
+// Entries: 등록 순서대로 설정 정의와 현재 값을 반환합니다.
+func (r *Registry) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]Entry, 0, len(r.order))
+	for _, key := range r.order {
+		s := r.settings[key]
+		entries = append(entries, Entry{Definition: s.def, Value: s.value, Overridden: s.value != s.def.Default})
+	}
+	return entries
+}
+
+// UpdatedAt: 마지막으로 설정을 적용한 시각 (적용한 적 없으면 zero)
+func (r *Registry) UpdatedAt() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.updatedAt
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,87 @@
+package runtimeconfig
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	json "github.com/goccy/go-json"
+)
+
+func newTestRegistry(cooldown *int, threshold *float64, enabled *bool) *Registry {
+	r := NewRegistry()
+	r.Int("guess.cooldownSeconds", "정답 시도 간격", 30, 5, 600, func(v int) { *cooldown = v })
+	r.Float("synonym.acceptThreshold", "임베딩 수락 임계값", 0.92, 0.5, 1, func(v float64) { *threshold = v })
+	r.Bool("synonym.embeddingEnabled", "임베딩 판정", true, func(v bool) { *enabled = v })
+	return r
+}
+
+func TestRegistryApply(t *testing.T) {
+	cooldown, threshold, enabled := 30, 0.92, true
+	r := newTestRegistry(&cooldown, &threshold, &enabled)
+
+	changed, err := r.Apply(map[string]string{"guess.cooldownSeconds": " 60 ", "synonym.embeddingEnabled": "false"})
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if len(changed) != 2 || cooldown != 60 || enabled {
+		t.Fatalf("unexpected apply result: changed=%v cooldown=%d enabled=%v", changed, cooldown, enabled)
+	}
+	entries := r.Entries()
+	if entries[0].Value != "60" || !entries[0].Overridden || entries[1].Overridden {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	// 잘못된 값이 하나라도 있으면 아무것도 바꾸지 않음
+	for _, values := range []map[string]string{
+		{"guess.cooldownSeconds": "1"},
+		{"synonym.acceptThreshold": "abc"},
+		{"unknown": "1"},
+	} {
+		if _, err := r.Apply(values); !errors.Is(err, ErrInvalidValue) {
+			t.Fatalf("Apply(%v) expected invalid value, got %v", values, err)
+		}
+	}
+	if cooldown != 60 {
+		t.Fatalf("failed apply must not change values, got %d", cooldown)
+	}
+
+	// 빠진 키는 기본값으로 복귀
+	changed, err = r.Apply(nil)
+	if err != nil || len(changed) != 2 || cooldown != 30 || !enabled {
+		t.Fatalf("expected reset to defaults: changed=%v cooldown=%d enabled=%v err=%v", changed, cooldown, enabled, err)
+	}
+}
+
+func TestRegisterRoutes(t *testing.T) {
+	cooldown, threshold, enabled := 30, 0.92, true
+	r := newTestRegistry(&cooldown, &threshold, &enabled)
+	mux := http.NewServeMux()
+	r.RegisterRoutes(mux, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/runtime-config", strings.NewReader(`{"values":{"synonym.acceptThreshold":"0.95"}}`)))
+	if rec.Code != http.StatusOK || threshold != 0.95 {
+		t.Fatalf("expected 200 and applied threshold, got %d %v", rec.Code, threshold)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/admin/runtime-config", strings.NewReader(`{"values":{"synonym.acceptThreshold":"2"}}`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/runtime-config", nil))
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Settings) != 3 || resp.Settings[1].Value != "0.95" || resp.UpdatedAt == nil {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartSpan: 전역 TracerProvider로 내부(INTERNAL) span을 시작합니다.
+// 트레이싱이 비활성화되어 있으면 no-op span이 반환되므로 호출 측에서 분기할 필요가 없습니다.
+func StartSpan(ctx context.Context, tracerName, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// EndSpan: err가 있으면 span에 에러로 기록하고 종료합니다.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// HasParent: ctx에 유효한 상위 span이 있는지 확인합니다.
+// 백그라운드 루프처럼 요청과 무관한 호출이 root span을 대량으로 만들지 않도록 자식 span 생성 전에 확인합니다.
+func HasParent(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
@@ -0,0 +1,342 @@
+//go:build integration
+
+package testhelper
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/valkey-io/valkey-go"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// 통합 테스트 컨테이너 설정 (docker-compose.prod.yml 이미지와 동일 버전 유지)
+const (
+	integrationValkeyRepo     = "valkey/valkey"
+	integrationValkeyTag      = "9.0.1-alpine3.23"
+	integrationPostgresRepo   = "postgres"
+	integrationPostgresTag    = "18-alpine"
+	integrationPostgresUser   = "integration"
+	integrationPostgresPass   = "integration"
+	integrationPostgresDB     = "integration"
+	integrationStartupTimeout = 2 * time.Minute
+	// integrationContainerTTLSeconds: 테스트 프로세스가 비정상 종료되어도 컨테이너가 남지 않도록 하는 만료 시간
+	integrationContainerTTLSeconds = 600
+)
+
+// 외부 인스턴스 지정 환경 변수 (nightly CI의 service container 등)
+// 설정되면 해당 인스턴스를 사용하고 컨테이너를 띄우지 않습니다.
+// 외부 인스턴스는 테스트 전용이어야 하며, 패키지 병렬 실행 시 -p 1 사용을 권장합니다.
+const (
+	EnvIntegrationValkeyAddr  = "INTEGRATION_VALKEY_ADDR"
+	EnvIntegrationPostgresDSN = "INTEGRATION_POSTGRES_DSN"
+)
+
+var nonIdentChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// IntegrationEnv: 통합 테스트용 Valkey/Postgres 환경
+type IntegrationEnv struct {
+	ValkeyAddr  string
+	PostgresDSN string
+
+	pool      *dockertest.Pool
+	resources []*dockertest.Resource
+}
+
+var sharedIntegrationEnv *IntegrationEnv
+
+// RunIntegrationMain: TestMain에서 호출하여 패키지 단위로 컨테이너를 공유합니다.
+//
+//	func TestMain(m *testing.M) { os.Exit(testhelper.RunIntegrationMain(m)) }
+func RunIntegrationMain(m *testing.M) int {
+	env, err := StartIntegrationEnv()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "integration env start failed: %v\n", err)
+		return 1
+	}
+	sharedIntegrationEnv = env
+	defer env.Close()
+
+	return m.Run()
+}
+
+// StartIntegrationEnv: Valkey/Postgres 컨테이너를 기동하고 준비될 때까지 대기합니다.
+func StartIntegrationEnv() (*IntegrationEnv, error) {
+	env := &IntegrationEnv{
+		ValkeyAddr:  strings.TrimSpace(os.Getenv(EnvIntegrationValkeyAddr)),
+		PostgresDSN: strings.TrimSpace(os.Getenv(EnvIntegrationPostgresDSN)),
+	}
+
+	if env.ValkeyAddr == "" || env.PostgresDSN == "" {
+		pool, err := dockertest.NewPool("")
+		if err != nil {
+			return nil, fmt.Errorf("docker pool init failed: %w", err)
+		}
+		pool.MaxWait = integrationStartupTimeout
+		if err := pool.Client.Ping(); err != nil {
+			return nil, fmt.Errorf("docker ping failed: %w", err)
+		}
+		env.pool = pool
+	}
+
+	if env.ValkeyAddr == "" {
+		if err := env.startValkey(); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+	if env.PostgresDSN == "" {
+		if err := env.startPostgres(); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+
+	return env, nil
+}
+
+func (e *IntegrationEnv) runContainer(opts *dockertest.RunOptions) (*dockertest.Resource, error) {
+	resource, err := e.pool.RunWithOptions(opts, func(hc *docker.HostConfig) {
+		hc.AutoRemove = true
+		hc.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run %s:%s failed: %w", opts.Repository, opts.Tag, err)
+	}
+	e.resources = append(e.resources, resource)
+
+	if err := resource.Expire(integrationContainerTTLSeconds); err != nil {
+		return nil, fmt.Errorf("set container expiry failed: %w", err)
+	}
+	return resource, nil
+}
+
+func (e *IntegrationEnv) startValkey() error {
+	resource, err := e.runContainer(&dockertest.RunOptions{
+		Repository: integrationValkeyRepo,
+		Tag:        integrationValkeyTag,
+	})
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort("127.0.0.1", resource.GetPort("6379/tcp"))
+	if err := e.pool.Retry(func() error {
+		client, err := newIntegrationValkeyClient(addr)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		return client.Do(ctx, client.B().Ping().Build()).Error()
+	}); err != nil {
+		return fmt.Errorf("valkey not ready: %w", err)
+	}
+
+	e.ValkeyAddr = addr
+	return nil
+}
+
+func (e *IntegrationEnv) startPostgres() error {
+	resource, err := e.runContainer(&dockertest.RunOptions{
+		Repository: integrationPostgresRepo,
+		Tag:        integrationPostgresTag,
+		Env: []string{
+			"POSTGRES_USER=" + integrationPostgresUser,
+			"POSTGRES_PASSWORD=" + integrationPostgresPass,
+			"POSTGRES_DB=" + integrationPostgresDB,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	dsn := (&url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(integrationPostgresUser, integrationPostgresPass),
+		Host:     net.JoinHostPort("127.0.0.1", resource.GetPort("5432/tcp")),
+		Path:     "/" + integrationPostgresDB,
+		RawQuery: "sslmode=disable",
+	}).String()
+
+	if err := e.pool.Retry(func() error {
+		db, err := openIntegrationGorm(dsn)
+		if err != nil {
+			return err
+		}
+		return closeIntegrationGorm(db)
+	}); err != nil {
+		return fmt.Errorf("postgres not ready: %w", err)
+	}
+
+	e.PostgresDSN = dsn
+	return nil
+}
+
+// Close: 기동한 컨테이너를 모두 정리합니다. (외부 인스턴스는 건드리지 않음)
+func (e *IntegrationEnv) Close() {
+	if e == nil || e.pool == nil {
+		return
+	}
+	for _, resource := range e.resources {
+		_ = e.pool.Purge(resource)
+	}
+	e.resources = nil
+}
+
+// IntegrationEnvFor: RunIntegrationMain으로 기동된 공유 환경을 반환합니다.
+func IntegrationEnvFor(t *testing.T) *IntegrationEnv {
+	t.Helper()
+
+	if sharedIntegrationEnv == nil {
+		t.Fatal("integration env not initialized: call testhelper.RunIntegrationMain from TestMain")
+	}
+	return sharedIntegrationEnv
+}
+
+// NewIntegrationValkeyClient: 통합 테스트용 Valkey 클라이언트를 생성합니다.
+// 테스트 종료 시 DB를 비우고 클라이언트를 닫습니다.
+func NewIntegrationValkeyClient(t *testing.T) valkey.Client {
+	t.Helper()
+
+	env := IntegrationEnvFor(t)
+	client, err := newIntegrationValkeyClient(env.ValkeyAddr)
+	if err != nil {
+		t.Fatalf("failed to create valkey client (addr=%s): %v", env.ValkeyAddr, err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := client.Do(ctx, client.B().Flushdb().Build()).Error(); err != nil {
+			t.Logf("warning: valkey flushdb failed: %v", err)
+		}
+		client.Close()
+	})
+
+	return client
+}
+
+// NewIntegrationPostgresDSN: 테스트 전용 데이터베이스를 생성하고 DSN을 반환합니다.
+// 테스트 종료 시 데이터베이스를 삭제하므로 테스트 간 스키마/데이터가 격리됩니다.
+func NewIntegrationPostgresDSN(t *testing.T) string {
+	t.Helper()
+
+	env := IntegrationEnvFor(t)
+	admin, err := openIntegrationGorm(env.PostgresDSN)
+	if err != nil {
+		t.Fatalf("failed to connect postgres: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = closeIntegrationGorm(admin)
+	})
+
+	dbName, err := integrationDatabaseName(t)
+	if err != nil {
+		t.Fatalf("failed to build database name: %v", err)
+	}
+	if err := admin.Exec("CREATE DATABASE " + dbName).Error; err != nil {
+		t.Fatalf("failed to create database %s: %v", dbName, err)
+	}
+	t.Cleanup(func() {
+		if err := admin.Exec("DROP DATABASE IF EXISTS " + dbName + " WITH (FORCE)").Error; err != nil {
+			t.Logf("warning: failed to drop database %s: %v", dbName, err)
+		}
+	})
+
+	parsed, err := url.Parse(env.PostgresDSN)
+	if err != nil {
+		t.Fatalf("invalid postgres dsn: %v", err)
+	}
+	parsed.Path = "/" + dbName
+	return parsed.String()
+}
+
+// NewIntegrationGormDB: 테스트 전용 데이터베이스에 연결된 GORM 인스턴스를 반환합니다.
+func NewIntegrationGormDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := openIntegrationGorm(NewIntegrationPostgresDSN(t))
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	// DROP DATABASE 이전에 실행되도록 Cleanup 등록 순서(LIFO)에 의존
+	t.Cleanup(func() {
+		_ = closeIntegrationGorm(db)
+	})
+	return db
+}
+
+func newIntegrationValkeyClient(addr string) (valkey.Client, error) {
+	client, err := valkey.NewClient(valkey.ClientOption{
+		InitAddress:       []string{addr},
+		DisableCache:      true,
+		ForceSingleClient: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("valkey client create failed: %w", err)
+	}
+	return client, nil
+}
+
+func openIntegrationGorm(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gorm open failed: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("get sql db failed: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("postgres ping failed: %w", err)
+	}
+	return db, nil
+}
+
+func closeIntegrationGorm(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("get sql db failed: %w", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		return fmt.Errorf("close sql db failed: %w", err)
+	}
+	return nil
+}
+
+// integrationDatabaseName: 테스트 이름 기반의 유효한 Postgres 식별자를 생성합니다. (최대 63자)
+func integrationDatabaseName(t *testing.T) (string, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("rand read failed: %w", err)
+	}
+
+	base := nonIdentChars.ReplaceAllString(strings.ToLower(t.Name()), "_")
+	const maxBaseLen = 40
+	if len(base) > maxBaseLen {
+		base = base[:maxBaseLen]
+	}
+	return "it_" + strings.Trim(base, "_") + "_" + hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,342 @@
+// Package tmplsandbox 는 CMS 등 런타임에 들어오는 텍스트 템플릿을 안전하게 검증/렌더링합니다.
+// 허용된 함수와 구문만 쓸 수 있고, 렌더링은 시간과 출력 크기 상한 안에서만 수행합니다.
+package tmplsandbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultTimeout: 렌더링 기본 제한 시간
+	DefaultTimeout = 100 * time.Millisecond
+	// DefaultMaxOutputBytes: 렌더링 결과 기본 최대 크기
+	DefaultMaxOutputBytes = 16 << 10
+	// DefaultMaxSourceBytes: 템플릿 원문 기본 최대 크기
+	DefaultMaxSourceBytes = 32 << 10
+)
+
+var (
+	// ErrInvalidTemplate: 문법 오류 또는 허용되지 않은 구문
+	ErrInvalidTemplate = errors.New("invalid template")
+	// ErrRenderTimeout: 제한 시간 안에 렌더링이 끝나지 않음
+	ErrRenderTimeout = errors.New("template render timed out")
+	// ErrOutputTooLarge: 렌더링 결과가 최대 크기를 넘음
+	ErrOutputTooLarge = errors.New("template output too large")
+)
+
+// allowedBuiltins: text/template 기본 함수 중 허용 목록
+// call(데이터 안의 함수 호출), html/js/urlquery(용도 밖)는 제외합니다.
+var allowedBuiltins = map[string]bool{
+	"and": true, "or": true, "not": true,
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"len": true, "index": true, "slice": true,
+	"print": true, "printf": true, "println": true,
+}
+
+// funcs: 추가로 제공하는 함수 (모두 부작용 없는 문자열/숫자 처리)
+var funcs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"join":  joinValues,
+	"add":   func(a, b int) int { return a + b },
+	"default": func(fallback, value any) any {
+		if value == nil || value == "" {
+			return fallback
+		}
+		return value
+	},
+	"truncate": func(n int, s string) string {
+		if n < 0 || utf8.RuneCountInString(s) <= n {
+			return s
+		}
+		return string([]rune(s)[:n]) + "…"
+	},
+}
+
+// Config: 샌드박스 상한 설정 (0이면 기본값)
+type Config struct {
+	Timeout        time.Duration
+	MaxOutputBytes int
+	MaxSourceBytes int
+}
+
+// Sandbox: 허용 목록 기반 템플릿 검증/렌더러
+type Sandbox struct {
+	timeout        time.Duration
+	maxOutputBytes int
+	maxSourceBytes int
+}
+
+// New: Sandbox 인스턴스를 생성합니다.
+func New(cfg Config) *Sandbox {
+	s := &Sandbox{
+		timeout:        cfg.Timeout,
+		maxOutputBytes: cfg.MaxOutputBytes,
+		maxSourceBytes: cfg.MaxSourceBytes,
+	}
+	if s.timeout <= 0 {
+		s.timeout = DefaultTimeout
+	}
+	if s.maxOutputBytes <= 0 {
+		s.maxOutputBytes = DefaultMaxOutputBytes
+	}
+	if s.maxSourceBytes <= 0 {
+		s.maxSourceBytes = DefaultMaxSourceBytes
+	}
+	return s
+}
+
+// Default: 기본 상한을 쓰는 Sandbox
+var Default = New(Config{})
+
+// Validate: 저장 전에 템플릿을 정적으로 검사합니다.
+// 알 수 없는 함수, 허용되지 않은 함수, 템플릿 정의/호출, 필드가 아닌 값에 대한 range는 거부합니다.
+func (s *Sandbox) Validate(src string) error {
+	_, err := s.parse(src)
+	return err
+}
+
+// Render: 템플릿을 검증한 뒤 data로 렌더링합니다. 없는 키를 참조하면 오류를 반환합니다.
+// data는 JSON으로 한 번 직렬화한 값만 템플릿에 노출하므로 메서드나 함수는 호출할 수 없습니다.
+func (s *Sandbox) Render(ctx context.Context, src string, data map[string]any) (string, error) {
+	tmpl, err := s.parse(src)
+	if err != nil {
+		return "", err
+	}
+
+	plain, err := plainData(data)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	w := &cappedWriter{ctx: ctx, limit: s.maxOutputBytes}
+	done := make(chan error, 1)
+	go func() {
+		done <- tmpl.Execute(w, plain)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			if werr := w.failure(); werr != nil {
+				return "", werr
+			}
+			return "", fmt.Errorf("execute template: %w", err)
+		}
+		return w.String(), nil
+	case <-ctx.Done():
+		// 실행 중인 고루틴은 다음 출력에서 중단됨 (range 대상이 데이터로 한정되어 출력 없이 오래 돌 수 없음)
+		w.abort(ErrRenderTimeout)
+		return "", ErrRenderTimeout
+	}
+}
+
+func (s *Sandbox) parse(src string) (*template.Template, error) {
+	if len(src) > s.maxSourceBytes {
+		return nil, fmt.Errorf("%w: source exceeds %d bytes", ErrInvalidTemplate, s.maxSourceBytes)
+	}
+	tmpl, err := template.New("sandbox").Option("missingkey=error").Funcs(funcs).Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Name() != "sandbox" {
+			return nil, fmt.Errorf("%w: template definitions are not allowed", ErrInvalidTemplate)
+		}
+	}
+	if tmpl.Tree == nil {
+		return tmpl, nil
+	}
+	if err := checkNode(tmpl.Tree.Root); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	return tmpl, nil
+}
+
+// checkNode: 파싱 트리를 순회하며 허용되지 않은 구문을 찾습니다.
+func checkNode(node parse.Node) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkNode(child); err != nil {
+				return err
+			}
+		}
+	case *parse.TextNode, *parse.CommentNode, *parse.BreakNode, *parse.ContinueNode:
+		return nil
+	case *parse.ActionNode:
+		return checkPipe(n.Pipe)
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.RangeNode:
+		if err := checkRangeTarget(n.Pipe); err != nil {
+			return err
+		}
+		return checkBranch(&n.BranchNode)
+	case *parse.TemplateNode:
+		return fmt.Errorf("template call %q is not allowed", n.Name)
+	default:
+		return fmt.Errorf("unsupported node %s", node.String())
+	}
+	return nil
+}
+
+func checkBranch(b *parse.BranchNode) error {
+	if err := checkPipe(b.Pipe); err != nil {
+		return err
+	}
+	if err := checkNode(b.List); err != nil {
+		return err
+	}
+	return checkNode(b.ElseList)
+}
+
+func checkPipe(pipe *parse.PipeNode) error {
+	if pipe == nil {
+		return nil
+	}
+	for _, decl := range pipe.Decl {
+		// $는 루트 데이터를 가리키므로 덮어쓰지 못하게 함 (range 제한 우회 방지)
+		if decl.Ident[0] == "$" {
+			return errors.New("assignment to $ is not allowed")
+		}
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			if err := checkArg(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkArg(arg parse.Node) error {
+	switch a := arg.(type) {
+	case *parse.IdentifierNode:
+		if _, ok := funcs[a.Ident]; ok || allowedBuiltins[a.Ident] {
+			return nil
+		}
+		return fmt.Errorf("function %q is not allowed", a.Ident)
+	case *parse.PipeNode:
+		return checkPipe(a)
+	case *parse.ChainNode:
+		return checkArg(a.Node)
+	case *parse.FieldNode, *parse.VariableNode, *parse.DotNode,
+		*parse.StringNode, *parse.NumberNode, *parse.BoolNode, *parse.NilNode:
+		return nil
+	default:
+		return fmt.Errorf("unsupported argument %s", arg.String())
+	}
+}
+
+// checkRangeTarget: range 대상은 데이터 필드(.Items, $.Items)로만 제한합니다.
+// 숫자 리터럴/함수 결과/변수에 담은 정수로 출력 없는 큰 반복을 만들지 못하게 하려는 것으로,
+// 데이터는 JSON으로 정규화되어 숫자가 float64이므로 필드 값으로는 정수 range가 불가능합니다.
+func checkRangeTarget(pipe *parse.PipeNode) error {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return errors.New("range target must be a single field")
+	}
+	switch target := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		return nil
+	case *parse.VariableNode:
+		if len(target.Ident) > 1 && target.Ident[0] == "$" {
+			return nil
+		}
+	}
+	return errors.New("range target must be a data field")
+}
+
+// plainData: 템플릿에 노출할 데이터를 JSON 기본 타입(map/slice/string/number/bool)으로 바꿉니다.
+func plainData(data map[string]any) (map[string]any, error) {
+	if data == nil {
+		return map[string]any{}, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal template data: %w", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("unmarshal template data: %w", err)
+	}
+	return out, nil
+}
+
+func joinValues(sep string, values []any) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, fmt.Sprint(v))
+	}
+	return strings.Join(parts, sep)
+}
+
+// cappedWriter: 출력 크기 상한과 중단 요청을 확인하는 writer
+type cappedWriter struct {
+	ctx   context.Context
+	limit int
+
+	mu  sync.Mutex
+	buf strings.Builder
+	err error
+}
+
+func (w *cappedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return 0, w.err
+	}
+	if w.ctx.Err() != nil {
+		w.err = ErrRenderTimeout
+		return 0, w.err
+	}
+	if w.buf.Len()+len(p) > w.limit {
+		w.err = ErrOutputTooLarge
+		return 0, w.err
+	}
+	w.buf.Write(p)
+	return len(p), nil
+}
+
+func (w *cappedWriter) abort(err error) {
+	w.mu.Lock()
+	if w.err == nil {
+		w.err = err
+	}
+	w.mu.Unlock()
+}
+
+func (w *cappedWriter) failure() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+func (w *cappedWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.String()
+}
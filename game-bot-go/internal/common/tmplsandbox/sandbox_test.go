@@ -0,0 +1,80 @@
+package tmplsandbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"그냥 텍스트",
+		"{{.Name}}님의 차례입니다. 힌트 {{add .Used 1}}/{{.Max}}",
+		"{{range $i, $h := .Hints}}{{add $i 1}}. {{$h | truncate 20}}\n{{end}}",
+		"{{if eq .Result \"solved\"}}정답!{{else}}{{default \"없음\" .Answer | upper}}{{end}}",
+		"{{with .Player}}{{.Nickname}}{{end}} {{range $.Tags}}#{{.}}{{end}} {{join \", \" .Tags}}",
+	}
+	for _, src := range valid {
+		if err := Default.Validate(src); err != nil {
+			t.Errorf("Validate(%q) = %v", src, err)
+		}
+	}
+
+	invalid := []string{
+		"{{.Name",
+		"{{exec \"rm\"}}",
+		"{{call .Fn}}",
+		"{{html .Name}}",
+		`{{define "x"}}a{{end}}{{template "x"}}`,
+		`{{block "x" .}}a{{end}}`,
+		"{{range 1000000000}}{{end}}",
+		"{{$n := 1000000000}}{{range $n}}{{end}}",
+		"{{with .Items}}{{range .}}{{end}}{{end}}",
+		"{{$ = 5}}",
+		strings.Repeat("a", DefaultMaxSourceBytes+1),
+	}
+	for _, src := range invalid {
+		if err := Default.Validate(src); !errors.Is(err, ErrInvalidTemplate) {
+			t.Errorf("Validate(%.40q) = %v, want ErrInvalidTemplate", src, err)
+		}
+	}
+}
+
+type secretHolder struct {
+	Name string
+}
+
+func (secretHolder) Secret() string { return "leaked" }
+
+func TestRender(t *testing.T) {
+	ctx := context.Background()
+
+	out, err := Default.Render(ctx, "{{.Name}}: {{range .Hints}}[{{.}}]{{end}}", map[string]any{
+		"Name":  "거북이",
+		"Hints": []string{"바다", "수프"},
+	})
+	if err != nil || out != "거북이: [바다][수프]" {
+		t.Fatalf("Render() = %q, %v", out, err)
+	}
+	if _, err := Default.Render(ctx, "{{.Missing}}", nil); err == nil {
+		t.Fatal("missing keys must fail instead of rendering <no value>")
+	}
+
+	// 데이터는 JSON 값으로만 노출되어 메서드 호출이 불가능
+	if _, err := Default.Render(ctx, "{{.Holder.Secret}}", map[string]any{"Holder": secretHolder{Name: "a"}}); err == nil {
+		t.Fatal("methods on data must not be callable")
+	}
+
+	small := New(Config{MaxOutputBytes: 10})
+	if _, err := small.Render(ctx, "{{range .Items}}{{.}}{{end}}", map[string]any{"Items": []string{"12345", "67890", "x"}}); !errors.Is(err, ErrOutputTooLarge) {
+		t.Fatalf("expected ErrOutputTooLarge, got %v", err)
+	}
+
+	items := make([]int, 200000)
+	slow := New(Config{Timeout: time.Millisecond, MaxOutputBytes: 1 << 30})
+	if _, err := slow.Render(ctx, "{{range .Items}}{{printf \"%05d\" 1}}{{end}}", map[string]any{"Items": items}); !errors.Is(err, ErrRenderTimeout) {
+		t.Fatalf("expected ErrRenderTimeout, got %v", err)
+	}
+}
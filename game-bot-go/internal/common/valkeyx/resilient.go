@@ -0,0 +1,312 @@
+package valkeyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// FailureReason: ResilientClient가 명령을 최종 실패로 처리한 사유
+type FailureReason string
+
+const (
+	// FailureNonIdempotent: 연결 장애였지만 멱등 명령이 아니어서 재시도하지 않음
+	FailureNonIdempotent FailureReason = "non_idempotent"
+	// FailureReplayBufferFull: 재시도 대기 슬롯이 가득 차 재시도를 포기함
+	FailureReplayBufferFull FailureReason = "replay_buffer_full"
+	// FailureRetriesExhausted: 재시도 횟수를 모두 소진함
+	FailureRetriesExhausted FailureReason = "retries_exhausted"
+	// FailureContextDone: 재시도 대기 중 컨텍스트가 종료됨
+	FailureContextDone FailureReason = "context_done"
+)
+
+// CommandError: 연결 장애로 실패한 뒤 재시도되지 않았거나 재시도에도 실패한 명령 정보
+type CommandError struct {
+	Command    string
+	Idempotent bool
+	Attempts   int
+	Reason     FailureReason
+	Err        error
+}
+
+func (e CommandError) Error() string {
+	return fmt.Sprintf("valkey command failed command=%s reason=%s attempts=%d: %v", e.Command, e.Reason, e.Attempts, e.Err)
+}
+
+func (e CommandError) Unwrap() error { return e.Err }
+
+// AsCommandError: 에러 체인에서 CommandError를 추출합니다.
+func AsCommandError(err error) (CommandError, bool) {
+	var cmdErr CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr, true
+	}
+	return CommandError{}, false
+}
+
+// ResilienceConfig: 재시도 정책 설정. 0 값 필드는 기본값을 사용한다.
+type ResilienceConfig struct {
+	// MaxRetries: 최초 실행 이후 재실행 최대 횟수
+	MaxRetries int
+	// Backoff: 첫 재시도 전 대기 시간 (이후 2배씩 증가)
+	Backoff time.Duration
+	// MaxBackoff: 재시도 대기 시간 상한
+	MaxBackoff time.Duration
+	// ReplayBufferSize: 동시에 재시도 대기할 수 있는 명령 수
+	ReplayBufferSize int
+}
+
+const (
+	defaultResilienceMaxRetries  = 3
+	defaultResilienceBackoff     = 100 * time.Millisecond
+	defaultResilienceMaxBackoff  = time.Second
+	defaultResilienceReplayBurst = 32
+)
+
+func (c ResilienceConfig) withDefaults() ResilienceConfig {
+	if c.MaxRetries <= 0 {
+		c.MaxRetries = defaultResilienceMaxRetries
+	}
+	if c.Backoff <= 0 {
+		c.Backoff = defaultResilienceBackoff
+	}
+	if c.MaxBackoff < c.Backoff {
+		c.MaxBackoff = max(defaultResilienceMaxBackoff, c.Backoff)
+	}
+	if c.ReplayBufferSize <= 0 {
+		c.ReplayBufferSize = defaultResilienceReplayBurst
+	}
+	return c
+}
+
+// ResilientClient: 페일오버 등 일시적 연결 장애 시 멱등 명령만 재실행하는 valkey.Client 래퍼
+// valkey-go는 다음 명령 실행 시 자동으로 재연결하므로, 백오프 대기 후 같은 명령을 다시 보낸다.
+// 비멱등 명령(INCR, LPUSH, SET NX 등)은 중복 적용 위험이 있어 재시도하지 않고 CommandError로 반환한다.
+type ResilientClient struct {
+	client      valkey.Client
+	cfg         ResilienceConfig
+	replaySlots chan struct{}
+	logger      *slog.Logger
+}
+
+// NewResilientClient: 새로운 ResilientClient 인스턴스를 생성합니다.
+func NewResilientClient(client valkey.Client, cfg ResilienceConfig, logger *slog.Logger) *ResilientClient {
+	cfg = cfg.withDefaults()
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ResilientClient{
+		client:      client,
+		cfg:         cfg,
+		replaySlots: make(chan struct{}, cfg.ReplayBufferSize),
+		logger:      logger,
+	}
+}
+
+// B: 명령 빌더를 반환합니다.
+func (c *ResilientClient) B() valkey.Builder {
+	return c.client.B()
+}
+
+// Do: 단일 명령을 실행합니다.
+// 반환 error는 연결 장애가 해소되지 않은 경우에만 CommandError로 채워지며,
+// WRONGTYPE/nil 응답 등 서버 응답 에러는 기존처럼 result에 남는다.
+func (c *ResilientClient) Do(ctx context.Context, cmd valkey.Completed) (valkey.ValkeyResult, error) {
+	args := cmd.Commands()
+	idempotent := IsIdempotentCommand(args)
+	if idempotent {
+		// 재실행을 위해 명령 재활용(recycle) 방지
+		cmd = cmd.Pin()
+	}
+
+	var result valkey.ValkeyResult
+	err := c.run(ctx, commandName(args), idempotent, func() error {
+		result = c.client.Do(ctx, cmd)
+		return result.Error()
+	})
+	return result, err
+}
+
+// DoMulti: 파이프라인 명령을 실행합니다. 모든 명령이 멱등일 때만 파이프라인 전체를 재실행합니다.
+func (c *ResilientClient) DoMulti(ctx context.Context, cmds ...valkey.Completed) ([]valkey.ValkeyResult, error) {
+	names := make([]string, 0, len(cmds))
+	idempotent := true
+	for i := range cmds {
+		args := cmds[i].Commands()
+		names = append(names, commandName(args))
+		if !IsIdempotentCommand(args) {
+			idempotent = false
+		}
+	}
+	if idempotent {
+		for i := range cmds {
+			cmds[i] = cmds[i].Pin()
+		}
+	}
+
+	var results []valkey.ValkeyResult
+	err := c.run(ctx, strings.Join(names, ","), idempotent, func() error {
+		results = c.client.DoMulti(ctx, cmds...)
+		for _, r := range results {
+			if err := r.Error(); IsRetryableError(err) {
+				return err
+			}
+		}
+		return nil
+	})
+	return results, err
+}
+
+func (c *ResilientClient) run(ctx context.Context, name string, idempotent bool, attempt func() error) error {
+	err := attempt()
+	if !IsRetryableError(err) {
+		return nil
+	}
+
+	fail := func(reason FailureReason, attempts int, cause error) error {
+		c.logger.Warn("valkey_command_failed",
+			"command", name,
+			"reason", reason,
+			"attempts", attempts,
+			"err", cause,
+		)
+		return CommandError{Command: name, Idempotent: idempotent, Attempts: attempts, Reason: reason, Err: cause}
+	}
+
+	if !idempotent {
+		return fail(FailureNonIdempotent, 1, err)
+	}
+
+	select {
+	case c.replaySlots <- struct{}{}:
+		defer func() { <-c.replaySlots }()
+	default:
+		return fail(FailureReplayBufferFull, 1, err)
+	}
+
+	backoff := c.cfg.Backoff
+	attempts := 1
+	for range c.cfg.MaxRetries {
+		if waitErr := sleepContext(ctx, backoff); waitErr != nil {
+			return fail(FailureContextDone, attempts, errors.Join(err, waitErr))
+		}
+		backoff = min(backoff*2, c.cfg.MaxBackoff)
+
+		attempts++
+		err = attempt()
+		if !IsRetryableError(err) {
+			c.logger.Info("valkey_command_replayed", "command", name, "attempts", attempts)
+			return nil
+		}
+	}
+	return fail(FailureRetriesExhausted, attempts, err)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait for retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsRetryableError: 연결 끊김, 페일오버 중 응답(READONLY, LOADING, TRYAGAIN 등)처럼
+// 잠시 후 같은 명령을 다시 보내면 성공할 수 있는 에러인지 확인합니다.
+func IsRetryableError(err error) bool {
+	if err == nil || IsNil(err) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var valkeyErr *valkey.ValkeyError
+	if errors.As(err, &valkeyErr) {
+		if valkeyErr.IsTryAgain() || valkeyErr.IsLoading() || valkeyErr.IsClusterDown() {
+			return true
+		}
+		msg := valkeyErr.Error()
+		return strings.HasPrefix(msg, "READONLY") || strings.HasPrefix(msg, "MASTERDOWN")
+	}
+
+	if errors.Is(err, valkey.ErrClosing) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// idempotentCommands: 같은 인자로 여러 번 실행해도 최종 상태가 같은 명령 (조회 명령 포함)
+var idempotentCommands = map[string]struct{}{
+	// 조회
+	"PING": {}, "GET": {}, "MGET": {}, "EXISTS": {}, "TTL": {}, "PTTL": {}, "TYPE": {}, "STRLEN": {},
+	"HGET": {}, "HMGET": {}, "HGETALL": {}, "HEXISTS": {}, "HLEN": {}, "HKEYS": {}, "HVALS": {},
+	"SMEMBERS": {}, "SISMEMBER": {}, "SMISMEMBER": {}, "SCARD": {},
+	"ZSCORE": {}, "ZRANGE": {}, "ZRANGEBYSCORE": {}, "ZREVRANGE": {}, "ZCARD": {}, "ZRANK": {},
+	"LRANGE": {}, "LLEN": {}, "LINDEX": {},
+	// 변경
+	"HSET": {}, "HMSET": {}, "HDEL": {}, "SADD": {}, "SREM": {}, "ZREM": {},
+	"DEL": {}, "UNLINK": {}, "MSET": {}, "SETEX": {}, "PSETEX": {},
+	"EXPIRE": {}, "PEXPIRE": {}, "EXPIREAT": {}, "PEXPIREAT": {}, "PERSIST": {},
+}
+
+// IsIdempotentCommand: 명령(Commands() 결과)이 재실행해도 안전한지 판별합니다.
+// SET은 NX/GET 옵션이, ZADD는 INCR 옵션이 있으면 재실행 결과가 달라지므로 제외한다.
+func IsIdempotentCommand(args []string) bool {
+	name := commandName(args)
+	switch name {
+	case "SET":
+		return !hasOption(args, 3, len(args), "NX", "GET")
+	case "ZADD":
+		return !hasOption(args, 2, zaddOptionsEnd(args), "INCR")
+	}
+	_, ok := idempotentCommands[name]
+	return ok
+}
+
+func commandName(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return strings.ToUpper(args[0])
+}
+
+func hasOption(args []string, from int, to int, options ...string) bool {
+	for i := from; i < to && i < len(args); i++ {
+		for _, opt := range options {
+			if strings.EqualFold(args[i], opt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// zaddOptionsEnd: ZADD key [옵션...] score member ... 에서 첫 score 위치 (옵션 범위 끝)
+func zaddOptionsEnd(args []string) int {
+	for i := 2; i < len(args); i++ {
+		if _, err := strconv.ParseFloat(args[i], 64); err == nil {
+			return i
+		}
+	}
+	return len(args)
+}
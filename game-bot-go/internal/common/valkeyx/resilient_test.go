@@ -0,0 +1,169 @@
+package valkeyx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func newTestResilientClient(cfg ResilienceConfig) *ResilientClient {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewResilientClient(nil, cfg, logger)
+}
+
+func TestIsIdempotentCommand(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"SADD", "k", "m"}, true},
+		{[]string{"HSET", "k", "f", "v"}, true},
+		{[]string{"EXPIRE", "k", "60"}, true},
+		{[]string{"SET", "k", "v", "EX", "60"}, true},
+		{[]string{"SET", "k", "NX"}, true},
+		{[]string{"SET", "k", "v", "NX", "EX", "60"}, false},
+		{[]string{"SET", "k", "v", "GET"}, false},
+		{[]string{"ZADD", "k", "1", "INCR"}, true},
+		{[]string{"ZADD", "k", "INCR", "1", "m"}, false},
+		{[]string{"INCR", "k"}, false},
+		{[]string{"LPUSH", "k", "v"}, false},
+		{[]string{"EVALSHA", "sha", "0"}, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsIdempotentCommand(tt.args); got != tt.want {
+			t.Errorf("IsIdempotentCommand(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"eof", io.EOF, true},
+		{"wrapped reset", fmt.Errorf("write: %w", syscall.ECONNRESET), true},
+		{"canceled", context.Canceled, false},
+		{"other", errors.New("WRONGTYPE"), false},
+	}
+	for _, tt := range tests {
+		if got := IsRetryableError(tt.err); got != tt.want {
+			t.Errorf("%s: IsRetryableError = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResilientClient_ReplaysIdempotentCommand(t *testing.T) {
+	c := newTestResilientClient(ResilienceConfig{MaxRetries: 3, Backoff: time.Millisecond})
+
+	calls := 0
+	err := c.run(context.Background(), "SADD", true, func() error {
+		calls++
+		if calls < 3 {
+			return io.EOF
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestResilientClient_DoesNotReplayNonIdempotent(t *testing.T) {
+	c := newTestResilientClient(ResilienceConfig{Backoff: time.Millisecond})
+
+	calls := 0
+	err := c.run(context.Background(), "INCR", false, func() error {
+		calls++
+		return io.EOF
+	})
+	cmdErr, ok := AsCommandError(fmt.Errorf("wrapped: %w", err))
+	if !ok {
+		t.Fatalf("expected CommandError, got %v", err)
+	}
+	if cmdErr.Reason != FailureNonIdempotent || cmdErr.Attempts != 1 || calls != 1 {
+		t.Errorf("unexpected result: reason=%s attempts=%d calls=%d", cmdErr.Reason, cmdErr.Attempts, calls)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("expected wrapped cause io.EOF, got %v", err)
+	}
+}
+
+func TestResilientClient_RetriesExhausted(t *testing.T) {
+	c := newTestResilientClient(ResilienceConfig{MaxRetries: 2, Backoff: time.Millisecond})
+
+	calls := 0
+	err := c.run(context.Background(), "HSET", true, func() error {
+		calls++
+		return io.EOF
+	})
+	cmdErr, ok := AsCommandError(err)
+	if !ok || cmdErr.Reason != FailureRetriesExhausted {
+		t.Fatalf("expected retries_exhausted, got %v", err)
+	}
+	if cmdErr.Attempts != 3 || calls != 3 {
+		t.Errorf("attempts = %d, calls = %d, want 3", cmdErr.Attempts, calls)
+	}
+}
+
+func TestResilientClient_ServerErrorIsNotReplayed(t *testing.T) {
+	c := newTestResilientClient(ResilienceConfig{Backoff: time.Millisecond})
+
+	calls := 0
+	err := c.run(context.Background(), "SADD", true, func() error {
+		calls++
+		return errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("err = %v, calls = %d; server errors should be left to the result", err, calls)
+	}
+}
+
+func TestResilientClient_ContextDoneDuringBackoff(t *testing.T) {
+	c := newTestResilientClient(ResilienceConfig{Backoff: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.run(ctx, "EXPIRE", true, func() error { return io.EOF })
+	cmdErr, ok := AsCommandError(err)
+	if !ok || cmdErr.Reason != FailureContextDone {
+		t.Fatalf("expected context_done, got %v", err)
+	}
+}
+
+func TestResilientClient_ReplayBufferFull(t *testing.T) {
+	c := newTestResilientClient(ResilienceConfig{MaxRetries: 1, Backoff: 50 * time.Millisecond, ReplayBufferSize: 1})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = c.run(context.Background(), "SADD", true, func() error { return io.EOF })
+	}()
+
+	// 첫 번째 명령이 슬롯을 점유할 때까지 대기
+	deadline := time.Now().Add(time.Second)
+	for len(c.replaySlots) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	err := c.run(context.Background(), "SADD", true, func() error { return io.EOF })
+	cmdErr, ok := AsCommandError(err)
+	if !ok || cmdErr.Reason != FailureReplayBufferFull {
+		t.Fatalf("expected replay_buffer_full, got %v", err)
+	}
+	wg.Wait()
+}
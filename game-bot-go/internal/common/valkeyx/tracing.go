@@ -0,0 +1,144 @@
+package valkeyx
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/telemetry"
+)
+
+const (
+	tracerName = "game-bot-go/valkey"
+	// maxPipelineOpNames: 파이프라인 span에 기록할 명령 이름 최대 개수
+	maxPipelineOpNames = 8
+)
+
+// tracingClient: 명령마다 CLIENT span을 남기는 valkey.Client 래퍼
+// 상위 span이 있는 호출(메시지 처리, HTTP 요청)만 기록하고, 키/값 인자는 개인정보가 섞일 수 있어 남기지 않습니다.
+type tracingClient struct {
+	valkey.Client
+	name string
+}
+
+// NewTracingClient: OpenTelemetry span을 기록하는 클라이언트로 감쌉니다. name은 db.namespace로 기록됩니다. (예: "data", "mq")
+// Dedicated/DoStream 등 나머지 메서드는 그대로 위임합니다.
+func NewTracingClient(client valkey.Client, name string) valkey.Client {
+	if client == nil {
+		return nil
+	}
+	return &tracingClient{Client: client, name: name}
+}
+
+// Do: 단일 명령 실행
+func (c *tracingClient) Do(ctx context.Context, cmd valkey.Completed) valkey.ValkeyResult {
+	if !telemetry.HasParent(ctx) {
+		return c.Client.Do(ctx, cmd)
+	}
+	ctx, span := c.start(ctx, operationName(cmd.Commands()))
+	resp := c.Client.Do(ctx, cmd)
+	endSpan(span, resp.Error())
+	return resp
+}
+
+// DoMulti: 파이프라인 실행 (명령 이름과 개수만 기록)
+func (c *tracingClient) DoMulti(ctx context.Context, multi ...valkey.Completed) []valkey.ValkeyResult {
+	if !telemetry.HasParent(ctx) || len(multi) == 0 {
+		return c.Client.DoMulti(ctx, multi...)
+	}
+	names := make([]string, 0, len(multi))
+	for _, cmd := range multi {
+		names = append(names, operationName(cmd.Commands()))
+	}
+	ctx, span := c.start(ctx, "PIPELINE",
+		attribute.Int("db.operation.batch.size", len(multi)),
+		attribute.String("db.valkey.commands", pipelineSummary(names)),
+	)
+	resps := c.Client.DoMulti(ctx, multi...)
+	endSpan(span, firstError(resps))
+	return resps
+}
+
+// DoCache: 클라이언트 캐시 명령 실행 (캐시 적중 여부 기록)
+func (c *tracingClient) DoCache(ctx context.Context, cmd valkey.Cacheable, ttl time.Duration) valkey.ValkeyResult {
+	if !telemetry.HasParent(ctx) {
+		return c.Client.DoCache(ctx, cmd, ttl)
+	}
+	ctx, span := c.start(ctx, operationName(cmd.Commands()))
+	resp := c.Client.DoCache(ctx, cmd, ttl)
+	span.SetAttributes(attribute.Bool("db.valkey.cache_hit", resp.IsCacheHit()))
+	endSpan(span, resp.Error())
+	return resp
+}
+
+// DoMultiCache: 클라이언트 캐시 파이프라인 실행
+func (c *tracingClient) DoMultiCache(ctx context.Context, multi ...valkey.CacheableTTL) []valkey.ValkeyResult {
+	if !telemetry.HasParent(ctx) || len(multi) == 0 {
+		return c.Client.DoMultiCache(ctx, multi...)
+	}
+	names := make([]string, 0, len(multi))
+	for _, item := range multi {
+		names = append(names, operationName(item.Cmd.Commands()))
+	}
+	ctx, span := c.start(ctx, "PIPELINE",
+		attribute.Int("db.operation.batch.size", len(multi)),
+		attribute.String("db.valkey.commands", pipelineSummary(names)),
+	)
+	resps := c.Client.DoMultiCache(ctx, multi...)
+	endSpan(span, firstError(resps))
+	return resps
+}
+
+func (c *tracingClient) start(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs,
+		attribute.String("db.system", "valkey"),
+		attribute.String("db.namespace", c.name),
+		attribute.String("db.operation.name", operation),
+	)
+	ctx, span := telemetry.StartSpan(ctx, tracerName, "valkey "+operation, attrs...)
+	return ctx, span
+}
+
+// endSpan: 키 없음(nil 응답)은 정상 결과이므로 에러로 기록하지 않음
+func endSpan(span trace.Span, err error) {
+	if IsNil(err) {
+		err = nil
+	}
+	telemetry.EndSpan(span, err)
+}
+
+func firstError(resps []valkey.ValkeyResult) error {
+	for _, resp := range resps {
+		if err := resp.Error(); err != nil && !IsNil(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// operationName: span에 기록할 명령 이름 (빈 명령은 UNKNOWN)
+func operationName(args []string) string {
+	if name := commandName(args); name != "" {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// pipelineSummary: 중복을 뺀 명령 이름 목록 (예: "LPUSH,LTRIM")
+func pipelineSummary(names []string) string {
+	unique := make([]string, 0, len(names))
+	for _, name := range names {
+		if !slices.Contains(unique, name) {
+			unique = append(unique, name)
+		}
+		if len(unique) == maxPipelineOpNames {
+			break
+		}
+	}
+	return strings.Join(unique, ",")
+}
@@ -0,0 +1,75 @@
+package valkeyx
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestTracingClient_RecordsChildSpansOnly(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	raw := testhelper.NewTestValkeyClient(t)
+	defer raw.Close()
+	client := NewTracingClient(raw, "data")
+
+	key := testhelper.UniqueTestPrefix(t) + "tracing"
+	t.Cleanup(func() { _ = DeleteKeys(context.Background(), raw, key) })
+
+	// 상위 span이 없으면 기록하지 않음
+	if err := client.Do(context.Background(), client.B().Set().Key(key).Value("v").Build()).Error(); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("calls without a parent span must not be traced, got %d spans", n)
+	}
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "turn")
+	if _, err := client.Do(ctx, client.B().Get().Key(key+":missing").Build()).ToString(); !IsNil(err) {
+		t.Fatalf("expected nil reply, got %v", err)
+	}
+	client.DoMulti(ctx,
+		client.B().Lpush().Key(key+":list").Element("a").Build(),
+		client.B().Ltrim().Key(key+":list").Start(0).Stop(9).Build(),
+		client.B().Del().Key(key+":list").Build(),
+	)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected GET, PIPELINE and parent spans, got %d", len(spans))
+	}
+	get, pipeline := spans[0], spans[1]
+	if get.Name() != "valkey GET" || get.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Fatalf("unexpected GET span: %s parent=%v", get.Name(), get.Parent().SpanID())
+	}
+	if get.Status().Code == codes.Error {
+		t.Fatalf("nil reply must not be recorded as an error")
+	}
+	if spanAttr(get, "db.namespace") != "data" || spanAttr(get, "db.system") != "valkey" {
+		t.Fatalf("unexpected attributes: %v", get.Attributes())
+	}
+	if pipeline.Name() != "valkey PIPELINE" || spanAttr(pipeline, "db.valkey.commands") != "LPUSH,LTRIM,DEL" || spanAttr(pipeline, "db.operation.batch.size") != "3" {
+		t.Fatalf("unexpected pipeline span: %s %v", pipeline.Name(), pipeline.Attributes())
+	}
+}
@@ -29,11 +29,16 @@ type SurrenderVote struct {
 	EligiblePlayers []string `json:"eligiblePlayers"`
 	Approvals       []string `json:"approvals,omitempty"`
 	CreatedAt       int64    `json:"createdAt"`
+	// RequiredVotes: 방 설정으로 지정한 필요 득표 수 (0이면 인원 수 기준 기본 규칙, 투표 가능 인원을 넘지 않음)
+	RequiredVotes int `json:"requiredVotes,omitempty"`
 }
 
 // RequiredApprovals: 항복 승인에 필요한 최소 득표 수를 반환합니다.
 func (v SurrenderVote) RequiredApprovals() int {
 	playerCount := len(v.EligiblePlayers)
+	if v.RequiredVotes > 0 {
+		return max(min(v.RequiredVotes, playerCount), 1)
+	}
 	switch {
 	case playerCount <= 1:
 		return 1
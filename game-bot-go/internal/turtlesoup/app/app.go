@@ -16,11 +16,18 @@ import (
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/bootstrap"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/di"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/health"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httpserver"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ingress"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	commonmq "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mq"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/outbox"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ratelimit"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
 	tsassets "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/assets"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/httpapi"
@@ -40,9 +47,14 @@ type turtleSoupStores struct {
 	pendingStore          *tsredis.PendingMessageStore
 	dedupStore            *tsredis.PuzzleDedupStore
 	voteStore             *tsredis.SurrenderVoteStore
+	gamemasterStore       *tsredis.GamemasterStore
+	timedStore            *tsredis.TimedStore
+	bonusStore            *tsredis.BonusStore
+	ratingStore           *tsredis.RatingStore
+	commandRateLimiter    *ratelimit.Limiter
 }
 
-func newTurtleSoupStores(client di.DataValkeyClient, logger *slog.Logger) *turtleSoupStores {
+func newTurtleSoupStores(cfg *tsconfig.Config, client di.DataValkeyClient, logger *slog.Logger) *turtleSoupStores {
 	lockManager := tsredis.NewLockManager(client.Client, logger)
 	sessionStore := tsredis.NewSessionStore(client.Client, logger)
 	return &turtleSoupStores{
@@ -53,11 +65,17 @@ func newTurtleSoupStores(client di.DataValkeyClient, logger *slog.Logger) *turtl
 		pendingStore:          tsredis.NewPendingMessageStore(client.Client, logger),
 		dedupStore:            tsredis.NewPuzzleDedupStore(client.Client, logger),
 		voteStore:             tsredis.NewSurrenderVoteStore(client.Client, logger),
+		gamemasterStore:       tsredis.NewGamemasterStore(client.Client, logger),
+		timedStore:            tsredis.NewTimedStore(client.Client, logger),
+		bonusStore:            tsredis.NewBonusStore(client.Client, logger),
+		ratingStore:           tsredis.NewRatingStore(client.Client, logger),
+		commandRateLimiter:    ratelimit.NewLimiter(client.Client, tsconfig.RedisKeyPrefix, cfg.RateLimit, logger),
 	}
 }
 
 type turtleSoupServices struct {
 	gameService    *tssvc.GameService
+	gamemaster     *tssvc.Gamemaster
 	voteService    *tssvc.SurrenderVoteService
 	accessControl  *tssecurity.AccessControl
 	commandHandler *tsmq.GameCommandHandler
@@ -73,22 +91,29 @@ func newTurtleSoupServices(
 	replyPublisher *tsmq.ReplyPublisher,
 	injectionGuard tssecurity.InjectionGuard,
 	stores *turtleSoupStores,
+	repo *tsrepo.Repository,
+	events *eventbus.Publisher,
 	logger *slog.Logger,
 ) *turtleSoupServices {
-	puzzleService := tssvc.NewPuzzleService(restClient, cfg.Puzzle, stores.dedupStore, logger)
+	puzzleService := tssvc.NewPuzzleService(restClient, cfg.Puzzle, stores.dedupStore, repo, logger)
+	puzzleService.SetReviewQueue(repo)
 	setupService := tssvc.NewGameSetupService(restClient, puzzleService, stores.sessionManager, logger)
-	gameService := tssvc.NewGameService(restClient, stores.sessionManager, setupService, injectionGuard, logger)
+	gamemaster := tssvc.NewGamemaster(stores.gamemasterStore, events, 0, logger)
+	bonusService := tssvc.NewBonusRoundService(restClient, stores.bonusStore, injectionGuard, repo, events, logger)
+	ratingService := tssvc.NewPuzzleRatingService(stores.ratingStore, repo, logger)
+	gameService := tssvc.NewGameService(restClient, stores.sessionManager, setupService, injectionGuard, events, gamemaster, stores.timedStore, bonusService, ratingService, cfg.QuestionDedup, logger)
 	voteService := tssvc.NewSurrenderVoteService(stores.sessionManager, stores.voteStore)
 	accessControl := tssecurity.NewAccessControl(cfg.Access)
 
 	messageBuilder := tsmq.NewMessageBuilder(msgProvider)
 	surrenderHandler := tsmq.NewSurrenderHandler(gameService, voteService, msgProvider)
-	commandHandler := tsmq.NewGameCommandHandler(gameService, surrenderHandler, msgProvider, messageBuilder, logger)
+	commandHandler := tsmq.NewGameCommandHandler(gameService, bonusService, ratingService, surrenderHandler, msgProvider, messageBuilder, cfg.Timed, logger)
 	commandParser := tsmq.NewCommandParser(cfg.Commands.Prefix)
 	messageSender := tsmq.NewMessageSender(msgProvider, replyPublisher.Publish)
 
 	return &turtleSoupServices{
 		gameService:    gameService,
+		gamemaster:     gamemaster,
 		voteService:    voteService,
 		accessControl:  accessControl,
 		commandHandler: commandHandler,
@@ -105,6 +130,26 @@ func newTurtleSoupGameService(services *turtleSoupServices) *tssvc.GameService {
 	return services.gameService
 }
 
+func newTurtleSoupTimedScheduler(
+	cfg *tsconfig.Config,
+	msgProvider *messageprovider.Provider,
+	stores *turtleSoupStores,
+	services *turtleSoupServices,
+	repo *tsrepo.Repository,
+	logger *slog.Logger,
+) *tssvc.TimedGameScheduler {
+	return tssvc.NewTimedGameScheduler(
+		cfg.Timed,
+		services.gameService,
+		stores.sessionManager,
+		stores.timedStore,
+		repo,
+		services.replyPublisher.Publish,
+		msgProvider,
+		logger,
+	)
+}
+
 func newTurtleSoupReplyPublisher(cfg *tsconfig.Config, mqValkey di.MQValkeyClient, logger *slog.Logger) *tsmq.ReplyPublisher {
 	return commonmq.NewBotReplyPublisher(
 		mqValkey.Client,
@@ -114,6 +159,15 @@ func newTurtleSoupReplyPublisher(cfg *tsconfig.Config, mqValkey di.MQValkeyClien
 	)
 }
 
+// newTurtleSoupOutbox: 응답 발행 실패 시 재전송 대기열을 생성해 응답 발행자에 연결합니다. 비활성화면 nil입니다.
+func newTurtleSoupOutbox(cfg *tsconfig.Config, dataValkey valkey.Client, replyPublisher *tsmq.ReplyPublisher, logger *slog.Logger) *outbox.Outbox {
+	replyOutbox := outbox.New(dataValkey, tsconfig.RedisKeyPrefix, cfg.Outbox, logger)
+	if replyOutbox != nil {
+		replyPublisher.SetOutbox(replyOutbox)
+	}
+	return replyOutbox
+}
+
 func newTurtleSoupStreamConsumer(cfg *tsconfig.Config, mqValkey di.MQValkeyClient, logger *slog.Logger) *commonmq.StreamConsumer {
 	return commonmq.NewBotStreamConsumer(
 		mqValkey.Client,
@@ -173,6 +227,7 @@ func newTurtleSoupMQPipeline(
 	stores *turtleSoupStores,
 	services *turtleSoupServices,
 	streamConsumer *commonmq.StreamConsumer,
+	latencyRecorder *latency.Recorder,
 	logger *slog.Logger,
 ) *turtleSoupMQPipeline {
 	queueCoordinator := tsmq.NewMessageQueueCoordinator(stores.pendingStore, logger)
@@ -195,10 +250,12 @@ func newTurtleSoupMQPipeline(
 		msgProvider,
 		services.replyPublisher,
 		services.accessControl,
+		stores.commandRateLimiter,
 		services.commandParser,
 		stores.processingLockService,
 		queueProcessor,
 		restClient,
+		latencyRecorder,
 		logger,
 	)
 	executor.service = gameMessageService
@@ -219,6 +276,9 @@ func newTurtleSoupDataRedis(
 	if err != nil {
 		return di.DataValkeyClient{}, nil, fmt.Errorf("init valkey failed: %w", err)
 	}
+	if cfg.Telemetry.Enabled {
+		client.Client = valkeyx.NewTracingClient(client.Client, "data")
+	}
 	return client, closeFn, nil
 }
 
@@ -231,6 +291,9 @@ func newTurtleSoupMQValkey(
 	if err != nil {
 		return di.MQValkeyClient{}, nil, fmt.Errorf("init valkey mq failed: %w", err)
 	}
+	if cfg.Telemetry.Enabled {
+		client.Client = valkeyx.NewTracingClient(client.Client, "mq")
+	}
 	return client, closeFn, nil
 }
 
@@ -261,29 +324,59 @@ func newTurtleSoupMessageProvider(cfg *tsconfig.Config) (*messageprovider.Provid
 	return provider, nil
 }
 
+// newTurtleSoupSchemaChecker: 리포지토리 모델 정의로부터 스키마 점검기를 생성합니다.
+func newTurtleSoupSchemaChecker(db *gorm.DB) (*health.SchemaChecker, error) {
+	expectations, err := health.ModelExpectations(db, tsrepo.Models()...)
+	if err != nil {
+		return nil, fmt.Errorf("build schema expectations failed: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("get sql db failed: %w", err)
+	}
+	return health.NewSchemaChecker(sqlDB, health.DefaultSchemaCheckTTL, expectations), nil
+}
+
 func newTurtleSoupHTTPMux(
 	cfg *tsconfig.Config,
 	restClient *llmrest.Client,
 	db *gorm.DB,
+	schemaChecker *health.SchemaChecker,
 	valkeyClient valkey.Client,
 	gameService *tssvc.GameService,
+	gamemaster *tssvc.Gamemaster,
 	sessionStore *tsredis.SessionStore,
+	latencyReporter *latency.Reporter,
+	replyOutbox *outbox.Outbox,
 	logger *slog.Logger,
 ) *http.ServeMux {
 	mux := http.NewServeMux()
-	httpapi.Register(mux, cfg.Llm, restClient, gameService, logger)
+	httpapi.Register(mux, cfg.Llm, restClient, gameService, schemaChecker, logger)
 
 	httpapi.RegisterTurtleAdminRoutes(mux, httpapi.TurtleAdminDeps{
-		DB:           db,
+		DB:              db,
+		ValkeyClient:    valkeyClient,
+		SessionStore:    sessionStore,
+		LatencyReporter: latencyReporter,
+		Logger:          logger,
+	})
+	httpapi.RegisterGamemasterRoutes(mux, httpapi.GamemasterDeps{
 		ValkeyClient: valkeyClient,
+		Gamemaster:   gamemaster,
 		SessionStore: sessionStore,
 		Logger:       logger,
 	})
+	replyOutbox.RegisterRoutes(mux, logger)
 
 	return mux
 }
 
-func newTurtleSoupHTTPServer(cfg *tsconfig.Config, mux *http.ServeMux) *http.Server {
+// newTurtleSoupIngress: 브릿지 웹훅(/api/) 서명 검증기를 생성합니다. 비밀키가 없으면 nil(검증 안 함)입니다.
+func newTurtleSoupIngress(cfg *tsconfig.Config, valkeyClient valkey.Client, logger *slog.Logger) *ingress.Verifier {
+	return ingress.NewVerifier(cfg.Ingress, valkeyClient, tsconfig.RedisKeyPrefix, "turtlesoup", logger)
+}
+
+func newTurtleSoupHTTPServer(cfg *tsconfig.Config, handler http.Handler) *http.Server {
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
 
 	// OTel 설정
@@ -293,7 +386,7 @@ func newTurtleSoupHTTPServer(cfg *tsconfig.Config, mux *http.ServeMux) *http.Ser
 		otelServiceName = "turtle-soup-bot"
 	}
 
-	return httpserver.NewServer(addr, mux, httpserver.ServerOptions{
+	return httpserver.NewServer(addr, handler, httpserver.ServerOptions{
 		UseH2C:            true,
 		ReadHeaderTimeout: cfg.ServerTuning.ReadHeaderTimeout,
 		IdleTimeout:       cfg.ServerTuning.IdleTimeout,
@@ -303,23 +396,75 @@ func newTurtleSoupHTTPServer(cfg *tsconfig.Config, mux *http.ServeMux) *http.Ser
 	})
 }
 
+// latencyBotName: 지연 샘플/집계 행에 기록하는 봇 이름
+const latencyBotName = "turtlesoup"
+
+// turtleSoupLatency: 명령어 응답 지연 기록/집계/조회 구성요소 (비활성화 시 모두 nil)
+type turtleSoupLatency struct {
+	recorder  *latency.Recorder
+	scheduler *latency.Scheduler
+	reporter  *latency.Reporter
+}
+
+func newTurtleSoupLatency(cfg *tsconfig.Config, db *gorm.DB, logger *slog.Logger) (*turtleSoupLatency, func()) {
+	if !cfg.Latency.Enabled {
+		return &turtleSoupLatency{}, func() {}
+	}
+	store := latency.NewStore(db)
+	recorder := latency.NewRecorder(latencyBotName, store, logger)
+	return &turtleSoupLatency{
+		recorder:  recorder,
+		scheduler: latency.NewScheduler(store, latencyBotName, cfg.Latency.SLA, cfg.Latency.RollupAtMinute, cfg.Latency.RetentionDays, logger),
+		reporter:  latency.NewReporter(store, latencyBotName, cfg.Latency.SLA),
+	}, recorder.Shutdown
+}
+
 func newTurtleSoupServerApp(
 	logger *slog.Logger,
 	server *http.Server,
 	mqPipeline *turtleSoupMQPipeline,
+	timedScheduler *tssvc.TimedGameScheduler,
+	latencyScheduler *latency.Scheduler,
+	replyOutbox *outbox.Outbox,
+	replyPublisher *tsmq.ReplyPublisher,
 ) *bootstrap.ServerApp {
-	return bootstrap.NewServerApp(
-		"turtlesoup",
-		logger,
-		server,
-		10*time.Second,
-		bootstrap.BackgroundTask{
+	tasks := []bootstrap.BackgroundTask{
+		{
 			Name:        "mq_consumer",
 			ErrorLogKey: "mq_consumer_failed",
 			Run: func(ctx context.Context) error {
 				return mqPipeline.streamConsumer.Run(ctx, mqPipeline.streamHandler.HandleStreamMessage)
 			},
 		},
+		{
+			Name:        "timed_game",
+			ErrorLogKey: "timed_game_failed",
+			Run:         timedScheduler.Run,
+		},
+	}
+	if latencyScheduler != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "latency_rollup",
+			ErrorLogKey: "latency_rollup_failed",
+			Run:         latencyScheduler.Run,
+		})
+	}
+	if replyOutbox != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "reply_outbox",
+			ErrorLogKey: "reply_outbox_failed",
+			Run: func(ctx context.Context) error {
+				return replyOutbox.Run(ctx, replyPublisher.Deliver)
+			},
+		})
+	}
+
+	return bootstrap.NewServerApp(
+		"turtlesoup",
+		logger,
+		server,
+		10*time.Second,
+		tasks...,
 	)
 }
 
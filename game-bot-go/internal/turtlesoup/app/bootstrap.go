@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/bootstrap"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 )
 
@@ -41,26 +42,43 @@ func Initialize(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*
 		return nil, nil, err
 	}
 
-	if _, err := newTurtleSoupRepository(ctx, db); err != nil {
+	repo, err := newTurtleSoupRepository(ctx, db)
+	if err != nil {
+		cleanupDB()
+		cleanupDataValkey()
+		cleanupMQValkey()
+		return nil, nil, err
+	}
+
+	schemaChecker, err := newTurtleSoupSchemaChecker(db)
+	if err != nil {
 		cleanupDB()
 		cleanupDataValkey()
 		cleanupMQValkey()
 		return nil, nil, err
 	}
 
-	stores := newTurtleSoupStores(dataValkeyClient, logger)
-	services := newTurtleSoupServices(cfg, restClient, msgProvider, replyPublisher, injectionGuard, stores, logger)
+	replyOutbox := newTurtleSoupOutbox(cfg, dataValkeyClient.Client, replyPublisher, logger)
+	stores := newTurtleSoupStores(cfg, dataValkeyClient, logger)
+	events := eventbus.NewPublisher(dataValkeyClient.Client, eventbus.GameTurtleSoup, logger)
+	services := newTurtleSoupServices(cfg, restClient, msgProvider, replyPublisher, injectionGuard, stores, repo, events, logger)
 	gameService := newTurtleSoupGameService(services)
 
-	httpMux := newTurtleSoupHTTPMux(cfg, restClient, db, dataValkeyClient.Client, gameService, stores.sessionStore, logger)
-	httpServer := newTurtleSoupHTTPServer(cfg, httpMux)
+	latencyParts, cleanupLatency := newTurtleSoupLatency(cfg, db, logger)
+
+	httpMux := newTurtleSoupHTTPMux(cfg, restClient, db, schemaChecker, dataValkeyClient.Client, gameService, services.gamemaster, stores.sessionStore, latencyParts.reporter, replyOutbox, logger)
+	ingressVerifier := newTurtleSoupIngress(cfg, dataValkeyClient.Client, logger)
+	httpServer := newTurtleSoupHTTPServer(cfg, ingressVerifier.Wrap(httpMux))
 
 	streamConsumer := newTurtleSoupStreamConsumer(cfg, mqValkeyClient, logger)
-	mqPipeline := newTurtleSoupMQPipeline(restClient, msgProvider, stores, services, streamConsumer, logger)
+	mqPipeline := newTurtleSoupMQPipeline(restClient, msgProvider, stores, services, streamConsumer, latencyParts.recorder, logger)
+
+	timedScheduler := newTurtleSoupTimedScheduler(cfg, msgProvider, stores, services, repo, logger)
 
-	serverApp := newTurtleSoupServerApp(logger, httpServer, mqPipeline)
+	serverApp := newTurtleSoupServerApp(logger, httpServer, mqPipeline, timedScheduler, latencyParts.scheduler, replyOutbox, replyPublisher)
 
 	cleanup := func() {
+		cleanupLatency()
 		cleanupDB()
 		cleanupDataValkey()
 		cleanupMQValkey()
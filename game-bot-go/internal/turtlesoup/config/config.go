@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
@@ -22,6 +23,22 @@ type LlmConfig = commonconfig.LlmConfig
 // PuzzleConfig: 퍼즐 생성 관련 설정입니다.
 type PuzzleConfig struct {
 	RewriteEnabled bool // Preset 퍼즐 사용 시 시나리오를 재작성할지 여부
+	CatalogEnabled bool // 공개된 CMS 퍼즐을 LLM 생성보다 먼저 출제할지 여부 (평점이 높을수록 자주 출제)
+	// ReviewQueueEnabled: LLM으로 생성해 출제한 퍼즐을 검수 대기 상태로 CMS에 저장할지 여부 (승인하면 공개 퍼즐이 됨)
+	ReviewQueueEnabled bool
+}
+
+// QuestionDedupConfig: 세션 내 중복 질문 감지 설정입니다.
+type QuestionDedupConfig struct {
+	Strict bool // true면 최근 질문뿐 아니라 전체 기록에서 더 느슨한 유사도로 중복을 판정
+}
+
+// TimedConfig: 타임어택 모드(제한 시간 내 풀이) 설정입니다.
+type TimedConfig struct {
+	DefaultDuration time.Duration   // 시간을 지정하지 않았을 때의 제한 시간
+	MaxDuration     time.Duration   // 지정 가능한 최대 제한 시간
+	Warnings        []time.Duration // 남은 시간 경고 시점 (내림차순)
+	TickInterval    time.Duration   // 마감/경고 확인 주기
 }
 
 // RedisConfig: Redis/Valkey 캐시 연결 설정입니다.
@@ -33,6 +50,12 @@ type ValkeyMQConfig = commonconfig.ValkeyMQConfig
 // AccessConfig: 채팅방/사용자 접근 제어 설정입니다.
 type AccessConfig = commonconfig.AccessConfig
 
+// RateLimitConfig: 명령어 빈도 제한 설정입니다.
+type RateLimitConfig = commonconfig.RateLimitConfig
+
+// IngressConfig: 브릿지 웹훅 서명 검증 설정 alias
+type IngressConfig = commonconfig.IngressConfig
+
 // LogConfig: 로그 출력 설정입니다.
 type LogConfig = commonconfig.LogConfig
 
@@ -60,12 +83,18 @@ type Config struct {
 	Commands       CommandsConfig
 	Llm            LlmConfig
 	Puzzle         PuzzleConfig
+	Timed          TimedConfig
+	QuestionDedup  QuestionDedupConfig
 	Redis          RedisConfig
 	Valkey         ValkeyMQConfig
 	Postgres       PostgresConfig
 	Access         AccessConfig
+	RateLimit      RateLimitConfig
+	Ingress        IngressConfig
 	InjectionGuard InjectionGuardConfig
 	Log            LogConfig
+	Latency        commonconfig.LatencyConfig
+	Outbox         commonconfig.OutboxConfig
 	Telemetry      commonconfig.TelemetryConfig
 }
 
@@ -88,6 +117,14 @@ func LoadFromEnv() (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	timed, err := readTimedConfig()
+	if err != nil {
+		return nil, err
+	}
+	questionDedup, err := readQuestionDedupConfig()
+	if err != nil {
+		return nil, err
+	}
 	redis, err := readRedisConfig()
 	if err != nil {
 		return nil, err
@@ -104,6 +141,14 @@ func LoadFromEnv() (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	rateLimit, err := commonconfig.ReadRateLimitConfigFromEnv("TURTLESOUP_")
+	if err != nil {
+		return nil, fmt.Errorf("read rate limit config failed: %w", err)
+	}
+	ingress, err := commonconfig.ReadIngressConfigFromEnv("TURTLESOUP_")
+	if err != nil {
+		return nil, fmt.Errorf("read ingress config failed: %w", err)
+	}
 	injectionGuard, err := readInjectionGuardConfig()
 	if err != nil {
 		return nil, err
@@ -112,6 +157,14 @@ func LoadFromEnv() (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	latency, err := commonconfig.ReadLatencyConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("read latency config failed: %w", err)
+	}
+	outbox, err := commonconfig.ReadOutboxConfigFromEnv("TURTLESOUP_")
+	if err != nil {
+		return nil, fmt.Errorf("read outbox config failed: %w", err)
+	}
 	telemetry, err := commonconfig.ReadTelemetryConfigFromEnv("turtle-soup-bot")
 	if err != nil {
 		return nil, fmt.Errorf("read telemetry config: %w", err)
@@ -125,12 +178,18 @@ func LoadFromEnv() (*Config, error) {
 		Commands:       commands,
 		Llm:            llmCfg,
 		Puzzle:         puzzle,
+		Timed:          timed,
+		QuestionDedup:  questionDedup,
 		Redis:          redis,
 		Valkey:         valkey,
 		Postgres:       postgres,
 		Access:         access,
+		RateLimit:      rateLimit,
+		Ingress:        ingress,
 		InjectionGuard: injectionGuard,
 		Log:            log,
+		Latency:        latency,
+		Outbox:         outbox,
 		Telemetry:      telemetry,
 	}, nil
 }
@@ -162,6 +221,7 @@ func readLlmConfig() (LlmConfig, error) {
 	if err != nil {
 		return LlmConfig{}, fmt.Errorf("read llm config failed: %w", err)
 	}
+	cfg.BotID = "turtlesoup"
 	return cfg, nil
 }
 
@@ -170,7 +230,74 @@ func readPuzzleConfig() (PuzzleConfig, error) {
 	if err != nil {
 		return PuzzleConfig{}, fmt.Errorf("read PUZZLE_REWRITE_ENABLED failed: %w", err)
 	}
-	return PuzzleConfig{RewriteEnabled: puzzleRewriteEnabled}, nil
+	puzzleCatalogEnabled, err := commonconfig.BoolFromEnv("PUZZLE_CATALOG_ENABLED", true)
+	if err != nil {
+		return PuzzleConfig{}, fmt.Errorf("read PUZZLE_CATALOG_ENABLED failed: %w", err)
+	}
+	puzzleReviewQueueEnabled, err := commonconfig.BoolFromEnv("PUZZLE_REVIEW_QUEUE_ENABLED", true)
+	if err != nil {
+		return PuzzleConfig{}, fmt.Errorf("read PUZZLE_REVIEW_QUEUE_ENABLED failed: %w", err)
+	}
+	return PuzzleConfig{
+		RewriteEnabled:     puzzleRewriteEnabled,
+		CatalogEnabled:     puzzleCatalogEnabled,
+		ReviewQueueEnabled: puzzleReviewQueueEnabled,
+	}, nil
+}
+
+func readQuestionDedupConfig() (QuestionDedupConfig, error) {
+	strict, err := commonconfig.BoolFromEnv("TURTLESOUP_QUESTION_DEDUP_STRICT", false)
+	if err != nil {
+		return QuestionDedupConfig{}, fmt.Errorf("read TURTLESOUP_QUESTION_DEDUP_STRICT failed: %w", err)
+	}
+	return QuestionDedupConfig{Strict: strict}, nil
+}
+
+func readTimedConfig() (TimedConfig, error) {
+	defaultMinutes, err := commonconfig.IntFromEnv("TURTLESOUP_TIMED_DEFAULT_MINUTES", 10)
+	if err != nil {
+		return TimedConfig{}, fmt.Errorf("read TURTLESOUP_TIMED_DEFAULT_MINUTES failed: %w", err)
+	}
+	maxMinutes, err := commonconfig.IntFromEnv("TURTLESOUP_TIMED_MAX_MINUTES", 30)
+	if err != nil {
+		return TimedConfig{}, fmt.Errorf("read TURTLESOUP_TIMED_MAX_MINUTES failed: %w", err)
+	}
+	// 경고 발송 기록 TTL보다 긴 게임은 경고가 중복될 수 있으므로 상한을 둠
+	maxAllowed := TimedWarningMarkTTLSeconds/60 - 60
+	if maxMinutes < TimedMinMinutes || maxMinutes > maxAllowed {
+		return TimedConfig{}, fmt.Errorf("invalid TURTLESOUP_TIMED_MAX_MINUTES: %d (%d..%d)", maxMinutes, TimedMinMinutes, maxAllowed)
+	}
+	if defaultMinutes < TimedMinMinutes || defaultMinutes > maxMinutes {
+		return TimedConfig{}, fmt.Errorf("invalid TURTLESOUP_TIMED_DEFAULT_MINUTES: %d (%d..%d)", defaultMinutes, TimedMinMinutes, maxMinutes)
+	}
+	tickSeconds, err := commonconfig.IntFromEnv("TURTLESOUP_TIMED_TICK_SECONDS", 5)
+	if err != nil {
+		return TimedConfig{}, fmt.Errorf("read TURTLESOUP_TIMED_TICK_SECONDS failed: %w", err)
+	}
+	if tickSeconds <= 0 {
+		tickSeconds = 5
+	}
+
+	// 형식: "5m,1m,30s" (Go duration)
+	rawWarnings := commonconfig.StringListFromEnv("TURTLESOUP_TIMED_WARNINGS", []string{"5m", "1m", "30s"})
+	warnings := make([]time.Duration, 0, len(rawWarnings))
+	for _, raw := range rawWarnings {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return TimedConfig{}, fmt.Errorf("read TURTLESOUP_TIMED_WARNINGS failed: invalid duration %q", raw)
+		}
+		if !slices.Contains(warnings, d) {
+			warnings = append(warnings, d)
+		}
+	}
+	slices.SortFunc(warnings, func(a, b time.Duration) int { return int(b - a) })
+
+	return TimedConfig{
+		DefaultDuration: time.Duration(defaultMinutes) * time.Minute,
+		MaxDuration:     time.Duration(maxMinutes) * time.Minute,
+		Warnings:        warnings,
+		TickInterval:    time.Duration(tickSeconds) * time.Second,
+	}, nil
 }
 
 func readRedisConfig() (RedisConfig, error) {
@@ -43,3 +43,46 @@ func TestReadInjectionGuardConfig(t *testing.T) {
 	})
 }
 
+func TestReadTimedConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg, err := readTimedConfig()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.DefaultDuration != 10*time.Minute || cfg.MaxDuration != 30*time.Minute {
+			t.Errorf("unexpected durations: %+v", cfg)
+		}
+		want := []time.Duration{5 * time.Minute, time.Minute, 30 * time.Second}
+		if len(cfg.Warnings) != len(want) {
+			t.Fatalf("expected warnings %v, got %v", want, cfg.Warnings)
+		}
+		for i := range want {
+			if cfg.Warnings[i] != want[i] {
+				t.Errorf("expected warnings %v, got %v", want, cfg.Warnings)
+			}
+		}
+	})
+
+	t.Run("warnings sorted descending", func(t *testing.T) {
+		t.Setenv("TURTLESOUP_TIMED_WARNINGS", "10s, 2m,10s")
+		cfg, err := readTimedConfig()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Warnings) != 2 || cfg.Warnings[0] != 2*time.Minute || cfg.Warnings[1] != 10*time.Second {
+			t.Errorf("unexpected warnings: %v", cfg.Warnings)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("TURTLESOUP_TIMED_DEFAULT_MINUTES", "45")
+		if _, err := readTimedConfig(); err == nil {
+			t.Error("expected error for default above max")
+		}
+		t.Setenv("TURTLESOUP_TIMED_DEFAULT_MINUTES", "10")
+		t.Setenv("TURTLESOUP_TIMED_WARNINGS", "soon")
+		if _, err := readTimedConfig(); err == nil {
+			t.Error("expected error for invalid warning")
+		}
+	})
+}
@@ -51,6 +51,10 @@ const (
 	RedisKeyProcessing    = RedisKeyPrefix + ":processing"
 	RedisKeyPuzzleGlobal  = RedisKeyPrefix + ":puzzle:global"
 	RedisKeyPuzzleChat    = RedisKeyPrefix + ":puzzle:chat"
+	RedisKeyGamemaster    = RedisKeyPrefix + ":gm"
+	RedisKeyTimed         = RedisKeyPrefix + ":timed"
+	RedisKeyBonus         = RedisKeyPrefix + ":bonus"
+	RedisKeyRating        = RedisKeyPrefix + ":rating"
 )
 
 // Redis TTL 상수 (도메인 전용).
@@ -62,6 +66,49 @@ const (
 	RedisProcessingTTLSeconds = 120
 )
 
+// 게임마스터(감독 모드) 상수.
+const (
+	// GamemasterReviewTimeoutSeconds: 운영자 결정을 기다리는 최대 시간 (초과 시 원래 답변 전송)
+	// 세션 락 TTL(RedisLockTTLSeconds)보다 충분히 짧아야 합니다.
+	GamemasterReviewTimeoutSeconds = 45
+	// GamemasterOverrideLogMaxLen: 보관하는 답변 검토 기록 최대 개수
+	GamemasterOverrideLogMaxLen = 1000
+)
+
+// 타임어택 모드 상수.
+const (
+	// TimedMinMinutes: 타임어택 제한 시간 최소값(분)
+	TimedMinMinutes = 1
+	// TimedWarningMarkTTLSeconds: 경고 발송 기록 보관 시간 (최대 제한 시간보다 길어야 함)
+	TimedWarningMarkTTLSeconds = 6 * 3600
+)
+
+// 후일담 보너스 라운드 상수.
+const (
+	// BonusRoundTTLSeconds: 보너스 질문에 답할 수 있는 시간 (지나면 아카이브 없이 사라짐)
+	BonusRoundTTLSeconds = 300
+)
+
+// 퍼즐 평가 상수.
+const (
+	// PuzzleRatingMin: 퍼즐 평점 최소값
+	PuzzleRatingMin = 1
+	PuzzleRatingMax = 5
+	// RatingWindowTTLSeconds: 게임 종료 후 퍼즐을 평가할 수 있는 시간
+	RatingWindowTTLSeconds = 600
+)
+
+// 중복 질문 감지 상수.
+const (
+	// QuestionDedupRecentWindow: 기본 모드에서 유사도를 비교할 최근 질문 수 (정규화 후 완전 일치는 전체 기록과 비교)
+	QuestionDedupRecentWindow = 10
+	// QuestionDedupSimilarity: 기본 모드의 유사 질문 판정 기준 (글자 bigram Dice 계수)
+	QuestionDedupSimilarity       = 0.9
+	QuestionDedupStrictSimilarity = 0.75
+	// QuestionDedupMinFuzzyRunes: 유사도 비교를 시작하는 정규화 질문 최소 길이 (짧은 질문은 오탐이 많음)
+	QuestionDedupMinFuzzyRunes = 4
+)
+
 // 퍼즐 난이도 상수.
 const (
 	// PuzzleMinDifficulty: 퍼즐 최소 난이도
@@ -52,6 +52,27 @@ func (e MaxHintsReachedError) Error() string {
 	return fmt.Sprintf("maximum hints reached: %d", e.MaxHints)
 }
 
+// DuplicateQuestionError: 같은 세션에서 이미 했던 질문(또는 거의 같은 질문)을 다시 했을 때 발생하는 에러
+type DuplicateQuestionError struct {
+	QuestionNumber int
+	Question       string
+	Answer         string
+}
+
+func (e DuplicateQuestionError) Error() string {
+	return fmt.Sprintf("duplicate question: Q%d", e.QuestionNumber)
+}
+
+// PendingAnswerNotFoundError: 검토 대기 중인 답변이 없거나 이미 처리(만료)되었을 때 발생하는 에러
+type PendingAnswerNotFoundError struct {
+	SessionID string
+	PendingID string
+}
+
+func (e PendingAnswerNotFoundError) Error() string {
+	return fmt.Sprintf("pending answer not found: session=%s pending=%s", e.SessionID, e.PendingID)
+}
+
 // PuzzleGenerationError: 퍼즐 자동 생성 중 발생한 에러
 type PuzzleGenerationError struct {
 	Err error
@@ -85,6 +106,7 @@ func IsExpectedUserBehavior(err error) bool {
 		new(GameAlreadyStartedError),
 		new(GameAlreadySolvedError),
 		new(MaxHintsReachedError),
+		new(DuplicateQuestionError),
 	}
 
 	for _, target := range expectedTypes {
@@ -0,0 +1,62 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/dbutil"
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+)
+
+// archiveExportHeader: /admin/archives?format=csv 의 열 순서
+var archiveExportHeader = []string{
+	"id", "sessionId", "chatId", "puzzleId", "questionCount", "hintsUsed",
+	"result", "bonusResult", "bonusPoints", "startedAt", "completedAt", "historyJson", "contributionsJson",
+}
+
+// exportTurtleArchivesCSV: 필터를 적용한 아카이브 전체를 CSV로 스트리밍합니다. (limit/offset 무시)
+// 질문 기록(historyJson)과 기여 점수(contributionsJson)는 JSON 문자열 그대로 한 셀에 담깁니다.
+func exportTurtleArchivesCSV(w http.ResponseWriter, deps TurtleAdminDeps, query *gorm.DB) {
+	start := time.Now()
+	filename := "turtlesoup-archives-" + start.UTC().Format("20060102-150405") + "." + commonhttputil.FormatCSV
+
+	stream, err := commonhttputil.NewCSVStream(w, filename, archiveExportHeader)
+	if err != nil {
+		deps.Logger.Warn("TURTLE_ADMIN_ARCHIVES_EXPORT_WRITE_FAILED", "err", err)
+		return
+	}
+	err = dbutil.EachRow(query, func(a *tsrepo.GameArchive) error {
+		puzzleID := ""
+		if a.PuzzleID != nil {
+			puzzleID = strconv.FormatUint(*a.PuzzleID, 10)
+		}
+		return stream.Write([]string{
+			strconv.FormatUint(a.ID, 10),
+			a.SessionID,
+			a.ChatID,
+			puzzleID,
+			commonhttputil.CSVInt(a.QuestionCount),
+			commonhttputil.CSVInt(a.HintsUsed),
+			a.Result,
+			a.BonusResult,
+			commonhttputil.CSVInt(a.BonusPoints),
+			commonhttputil.CSVTime(a.StartedAt),
+			commonhttputil.CSVTime(a.CompletedAt),
+			a.HistoryJSON,
+			a.ContributionsJSON,
+		})
+	})
+	if closeErr := stream.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// 헤더를 이미 보냈으므로 상태 코드로는 알릴 수 없음
+		deps.Logger.Error("TURTLE_ADMIN_ARCHIVES_EXPORT_FAILED", "rows", stream.Rows(), "err", err)
+		return
+	}
+	deps.Logger.Info("TURTLE_ADMIN_ARCHIVES_EXPORT_SUCCESS", "rows", stream.Rows(), "duration", time.Since(start).Milliseconds())
+}
@@ -0,0 +1,190 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"gorm.io/gorm"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+)
+
+const (
+	turtleAdminErrorPuzzleNotFound = "PUZZLE_NOT_FOUND"
+	// turtleAdminErrorPuzzleNotPending: 이미 검수했거나 검수 대상이 아닌 퍼즐
+	turtleAdminErrorPuzzleNotPending = "PUZZLE_NOT_PENDING"
+)
+
+// PuzzleReviewRequest: 생성 퍼즐 승인/반려 요청
+// 승인 시 비어 있지 않은 필드는 생성된 내용 대신 반영하며, 반려 시에는 note만 사용합니다.
+type PuzzleReviewRequest struct {
+	Title      string   `json:"title"`
+	Scenario   string   `json:"scenario"`
+	Solution   string   `json:"solution"`
+	Category   string   `json:"category"`
+	Difficulty int      `json:"difficulty"`
+	Hints      []string `json:"hints"`
+	Note       string   `json:"note"`
+	ReviewedBy string   `json:"reviewedBy"`
+}
+
+func registerPuzzleReviewRoutes(mux *http.ServeMux, deps TurtleAdminDeps) {
+	mux.HandleFunc("GET /admin/puzzles/review", func(w http.ResponseWriter, r *http.Request) {
+		handleTurtleAdminPuzzleReviewList(w, r, deps)
+	})
+	mux.HandleFunc("POST /admin/puzzles/{id}/approve", func(w http.ResponseWriter, r *http.Request) {
+		handleTurtleAdminPuzzleReview(w, r, deps, tsrepo.PuzzleStatusPublished)
+	})
+	mux.HandleFunc("POST /admin/puzzles/{id}/reject", func(w http.ResponseWriter, r *http.Request) {
+		handleTurtleAdminPuzzleReview(w, r, deps, tsrepo.PuzzleStatusRejected)
+	})
+}
+
+// handleTurtleAdminPuzzleReviewList: 검수 대기 중인 생성 퍼즐 목록 (오래된 순)
+func handleTurtleAdminPuzzleReviewList(w http.ResponseWriter, r *http.Request, deps TurtleAdminDeps) {
+	ctx := r.Context()
+	limit := min(max(parseIntOrDefault(r.URL.Query().Get("limit"), 50), 1), 100)
+	offset := max(parseIntOrDefault(r.URL.Query().Get("offset"), 0), 0)
+
+	if deps.DB == nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "db not available")
+		return
+	}
+
+	query := deps.DB.WithContext(ctx).Model(&tsrepo.Puzzle{}).Where("status = ?", tsrepo.PuzzleStatusPendingReview)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		deps.Logger.Error("TURTLE_ADMIN_PUZZLE_REVIEW_LIST_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to count pending puzzles")
+		return
+	}
+	var puzzles []tsrepo.Puzzle
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Offset(offset).Find(&puzzles).Error; err != nil {
+		deps.Logger.Error("TURTLE_ADMIN_PUZZLE_REVIEW_LIST_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to list pending puzzles")
+		return
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":  "ok",
+		"puzzles": puzzles,
+		"total":   total,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+// handleTurtleAdminPuzzleReview: 검수 대기 퍼즐 승인(공개) 또는 반려
+func handleTurtleAdminPuzzleReview(w http.ResponseWriter, r *http.Request, deps TurtleAdminDeps, status string) {
+	ctx := r.Context()
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidRequest, "invalid puzzle id")
+		return
+	}
+
+	var req PuzzleReviewRequest
+	if r.ContentLength != 0 {
+		if err := commonhttputil.ReadJSON(r, &req, 65536); err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidRequest, "invalid request body")
+			return
+		}
+	}
+
+	deps.Logger.Info("TURTLE_ADMIN_PUZZLE_REVIEW_REQUEST", "id", id, "status", status)
+
+	if deps.DB == nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "db not available")
+		return
+	}
+
+	repo := tsrepo.New(deps.DB)
+	puzzle, err := repo.GetPuzzle(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, turtleAdminErrorPuzzleNotFound, "puzzle not found")
+			return
+		}
+		deps.Logger.Error("TURTLE_ADMIN_PUZZLE_REVIEW_GET_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to get puzzle")
+		return
+	}
+	if puzzle.Status != tsrepo.PuzzleStatusPendingReview {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusConflict, turtleAdminErrorPuzzleNotPending, "puzzle is not pending review")
+		return
+	}
+
+	review := tsrepo.PuzzleReview{
+		Status:     status,
+		ReviewedBy: strings.TrimSpace(req.ReviewedBy),
+		Note:       strings.TrimSpace(req.Note),
+	}
+	if status == tsrepo.PuzzleStatusPublished {
+		review.Edits = applyPuzzleReviewEdits(puzzle, req)
+		if err := validatePuzzleTemplates(puzzle); err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidTemplate, err.Error())
+			return
+		}
+	}
+
+	reviewed, err := repo.ReviewPuzzle(ctx, id, review, time.Now())
+	if err != nil {
+		deps.Logger.Error("TURTLE_ADMIN_PUZZLE_REVIEW_FAILED", "id", id, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to review puzzle")
+		return
+	}
+	if !reviewed {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusConflict, turtleAdminErrorPuzzleNotPending, "puzzle is not pending review")
+		return
+	}
+
+	updated, err := repo.GetPuzzle(ctx, id)
+	if err != nil {
+		deps.Logger.Error("TURTLE_ADMIN_PUZZLE_REVIEW_GET_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to get puzzle")
+		return
+	}
+
+	deps.Logger.Info("TURTLE_ADMIN_PUZZLE_REVIEW_SUCCESS", "id", id, "status", status, "edited", len(review.Edits) > 0)
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status": "ok",
+		"puzzle": updated,
+	})
+}
+
+// applyPuzzleReviewEdits: 승인 요청의 수정 내용을 퍼즐에 반영하고, 바뀐 컬럼만 모아 반환합니다.
+func applyPuzzleReviewEdits(puzzle *tsrepo.Puzzle, req PuzzleReviewRequest) map[string]any {
+	edits := make(map[string]any)
+	if v := strings.TrimSpace(req.Title); v != "" {
+		puzzle.Title = v
+		edits["title"] = v
+	}
+	if v := strings.TrimSpace(req.Scenario); v != "" {
+		puzzle.Scenario = v
+		edits["scenario"] = v
+	}
+	if v := strings.TrimSpace(req.Solution); v != "" {
+		puzzle.Solution = v
+		edits["solution"] = v
+	}
+	if v := strings.TrimSpace(req.Category); v != "" {
+		puzzle.Category = v
+		edits["category"] = v
+	}
+	if req.Difficulty >= 1 && req.Difficulty <= 5 {
+		puzzle.Difficulty = req.Difficulty
+		edits["difficulty"] = req.Difficulty
+	}
+	if req.Hints != nil {
+		if b, err := json.Marshal(req.Hints); err == nil {
+			puzzle.HintsJSON = string(b)
+			edits["hints_json"] = puzzle.HintsJSON
+		}
+	}
+	return edits
+}
@@ -2,6 +2,7 @@ package httpapi
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -13,6 +14,8 @@ import (
 	"gorm.io/gorm"
 
 	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/tmplsandbox"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
@@ -24,6 +27,8 @@ const (
 	turtleAdminErrorInvalidRequest  = "INVALID_REQUEST"
 	turtleAdminErrorSessionNotFound = "SESSION_NOT_FOUND"
 	turtleAdminErrorInternalError   = "INTERNAL_ERROR"
+	// turtleAdminErrorInvalidTemplate: 퍼즐 본문에 허용되지 않은 템플릿 구문이 있음
+	turtleAdminErrorInvalidTemplate = "INVALID_TEMPLATE"
 )
 
 // TurtleAdminStatsResponse: 통합 통계 응답 DTO
@@ -79,7 +84,9 @@ type TurtleAdminDeps struct {
 	DB           *gorm.DB
 	ValkeyClient valkey.Client
 	SessionStore *tsredis.SessionStore
-	Logger       *slog.Logger
+	// LatencyReporter: 명령어 응답 지연 리포트 (nil이면 지연 리포트 API 미등록)
+	LatencyReporter *latency.Reporter
+	Logger          *slog.Logger
 }
 
 // RegisterTurtleAdminRoutes: TurtleSoup Admin API 라우트 등록
@@ -119,13 +126,20 @@ func RegisterTurtleAdminRoutes(mux *http.ServeMux, deps TurtleAdminDeps) {
 	mux.HandleFunc("GET /admin/puzzles/stats", func(w http.ResponseWriter, r *http.Request) {
 		handleTurtleAdminPuzzleStats(w, r, deps)
 	})
+	registerPuzzleReviewRoutes(mux, deps)
 
 	// Archives
 	mux.HandleFunc("GET /admin/archives", func(w http.ResponseWriter, r *http.Request) {
 		handleTurtleAdminArchives(w, r, deps)
 	})
 
-	deps.Logger.Info("turtlesoup_admin_api_registered", "routes", 12)
+	routes := 15
+	if deps.LatencyReporter != nil {
+		mux.HandleFunc("GET /admin/latency", deps.LatencyReporter.HandleReport)
+		routes++
+	}
+
+	deps.Logger.Info("turtlesoup_admin_api_registered", "routes", routes)
 }
 
 func handleTurtleAdminStats(w http.ResponseWriter, r *http.Request, deps TurtleAdminDeps) {
@@ -498,6 +512,10 @@ func handleTurtleAdminPuzzleCreate(w http.ResponseWriter, r *http.Request, deps
 		Status:     "draft",
 		AuthorID:   req.AuthorID,
 	}
+	if err := validatePuzzleTemplates(puzzle); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidTemplate, err.Error())
+		return
+	}
 
 	repo := tsrepo.New(deps.DB)
 	if err := repo.CreatePuzzle(ctx, puzzle); err != nil {
@@ -514,6 +532,35 @@ func handleTurtleAdminPuzzleCreate(w http.ResponseWriter, r *http.Request, deps
 	})
 }
 
+// validatePuzzleTemplates: 퍼즐 제목/시나리오/정답/힌트를 템플릿 샌드박스 규칙으로 검사합니다.
+// CMS 퍼즐은 런타임에 템플릿으로 렌더링될 수 있으므로 저장 시점에 허용되지 않은 구문을 거부합니다.
+func validatePuzzleTemplates(puzzle *tsrepo.Puzzle) error {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"title", puzzle.Title},
+		{"scenario", puzzle.Scenario},
+		{"solution", puzzle.Solution},
+	}
+	for _, f := range fields {
+		if err := tmplsandbox.Default.Validate(f.value); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+
+	var hints []string
+	if err := json.Unmarshal([]byte(puzzle.HintsJSON), &hints); err != nil {
+		return fmt.Errorf("hints: %w", err)
+	}
+	for i, hint := range hints {
+		if err := tmplsandbox.Default.Validate(hint); err != nil {
+			return fmt.Errorf("hints[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // handleTurtleAdminPuzzleGet: 단일 퍼즐 조회
 func handleTurtleAdminPuzzleGet(w http.ResponseWriter, r *http.Request, deps TurtleAdminDeps) {
 	ctx := r.Context()
@@ -615,6 +662,10 @@ func handleTurtleAdminPuzzleUpdate(w http.ResponseWriter, r *http.Request, deps
 			puzzle.HintsJSON = string(b)
 		}
 	}
+	if err := validatePuzzleTemplates(puzzle); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidTemplate, err.Error())
+		return
+	}
 
 	if err := repo.UpdatePuzzle(ctx, puzzle); err != nil {
 		deps.Logger.Error("TURTLE_ADMIN_PUZZLE_UPDATE_FAILED", "err", err)
@@ -697,12 +748,17 @@ func handleTurtleAdminPuzzleStats(w http.ResponseWriter, r *http.Request, deps T
 	}
 
 	categoryStats, _ := repo.GetCategoryStats(ctx)
+	ratingStats, err := repo.GetPuzzleRatingStats(ctx, 50)
+	if err != nil {
+		deps.Logger.Warn("TURTLE_ADMIN_PUZZLE_RATING_STATS_FAILED", "err", err)
+	}
 
 	deps.Logger.Info("TURTLE_ADMIN_PUZZLE_STATS_SUCCESS")
 	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
 		"status":        "ok",
 		"stats":         stats,
 		"categoryStats": categoryStats,
+		"ratingStats":   ratingStats,
 	})
 }
 
@@ -728,6 +784,11 @@ func handleTurtleAdminArchives(w http.ResponseWriter, r *http.Request, deps Turt
 		query = query.Where("result = ?", result)
 	}
 
+	if commonhttputil.WantsCSV(r) {
+		exportTurtleArchivesCSV(w, deps, query)
+		return
+	}
+
 	var total int64
 	query.Count(&total)
 
@@ -739,13 +800,15 @@ func handleTurtleAdminArchives(w http.ResponseWriter, r *http.Request, deps Turt
 	}
 
 	deps.Logger.Info("TURTLE_ADMIN_ARCHIVES_SUCCESS", "count", len(archives))
-	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
-		"status":   "ok",
-		"archives": archives,
-		"total":    total,
-		"limit":    limit,
-		"offset":   offset,
-	})
+	// 아카이브는 질문 기록 전체를 포함하므로 크기 상한을 넘으면 잘라서 nextOffset을 안내
+	if err := commonhttputil.StreamJSONArray(w, http.StatusOK, map[string]any{
+		"status": "ok",
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	}, "archives", archives, offset, commonhttputil.DefaultMaxResponseBytes); err != nil {
+		deps.Logger.Warn("TURTLE_ADMIN_ARCHIVES_WRITE_FAILED", "err", err)
+	}
 }
 
 func parseIntOrDefault(s string, defaultVal int) int {
@@ -16,12 +16,16 @@ type StartGameRequest struct {
 type AskQuestionRequest struct {
 	SessionID string `json:"sessionId"`
 	Question  string `json:"question"`
+	UserID    string `json:"userId,omitempty"` // 기여 점수 집계용 질문자 (비우면 집계하지 않음)
+	Sender    string `json:"sender,omitempty"`
 }
 
 // SubmitSolutionRequest: 정답 제출 요청 DTO
 type SubmitSolutionRequest struct {
 	SessionID string `json:"sessionId"`
 	Answer    string `json:"answer"`
+	UserID    string `json:"userId,omitempty"` // 정답자 기록용 제출자
+	Sender    string `json:"sender,omitempty"`
 }
 
 // HintRequest: 힌트 요청 DTO
@@ -0,0 +1,366 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+	tssvc "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/service"
+)
+
+const turtleAdminErrorPendingNotFound = "PENDING_NOT_FOUND"
+
+// operatorHeader: 관리자 대시보드 프록시가 로그인 사용자명을 전달하는 헤더
+const operatorHeader = "X-Admin-User"
+
+const (
+	liveMessageSnapshot = "snapshot"
+	liveMessageEvent    = "event"
+	liveMessageAck      = "ack"
+	liveMessageError    = "error"
+
+	liveWriteTimeout = 10 * time.Second
+	livePingInterval = 30 * time.Second
+)
+
+// gamemasterUpgrader: GM 콘솔 WebSocket 업그레이드 설정
+// 관리자 대시보드 프록시를 통해서만 노출되므로 Origin 검증은 프록시 인증에 맡깁니다.
+var gamemasterUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// GamemasterDeps: GM 콘솔 API 핸들러 의존성
+type GamemasterDeps struct {
+	ValkeyClient valkey.Client
+	Gamemaster   *tssvc.Gamemaster
+	SessionStore *tsredis.SessionStore
+	Logger       *slog.Logger
+}
+
+// SupervisionRequest: 감독 모드 변경 요청 DTO
+type SupervisionRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SupervisionResponse: 감독 모드 상태 응답 DTO
+type SupervisionResponse struct {
+	SessionID  string                 `json:"sessionId"`
+	Supervised bool                   `json:"supervised"`
+	Pending    *tsmodel.PendingAnswer `json:"pending,omitempty"`
+}
+
+// GamemasterDecisionRequest: 대기 답변 승인/수정 요청 DTO (REST 본문 및 WebSocket 수신 메시지)
+type GamemasterDecisionRequest struct {
+	PendingID string                   `json:"pendingId"`
+	Action    tsmodel.GamemasterAction `json:"action"`
+	Answer    string                   `json:"answer,omitempty"`
+	Operator  string                   `json:"operator,omitempty"`
+}
+
+// GamemasterLiveSnapshot: WebSocket 연결 직후 전송하는 세션 현재 상태
+type GamemasterLiveSnapshot struct {
+	SessionID     string                 `json:"sessionId"`
+	Supervised    bool                   `json:"supervised"`
+	QuestionCount int                    `json:"questionCount"`
+	History       []tsmodel.HistoryEntry `json:"history"`
+	Pending       *tsmodel.PendingAnswer `json:"pending,omitempty"`
+}
+
+// GamemasterLiveMessage: GM 콘솔 WebSocket 송신 메시지
+type GamemasterLiveMessage struct {
+	Type     string                  `json:"type"`
+	Snapshot *GamemasterLiveSnapshot `json:"snapshot,omitempty"`
+	Event    *eventbus.Event         `json:"event,omitempty"`
+	Error    string                  `json:"error,omitempty"`
+}
+
+// RegisterGamemasterRoutes: GM 콘솔(실시간 세션 스트림, 감독 모드, 답변 승인/수정) 라우트 등록
+func RegisterGamemasterRoutes(mux *http.ServeMux, deps GamemasterDeps) {
+	mux.HandleFunc("GET /admin/gamemaster/sessions", func(w http.ResponseWriter, r *http.Request) {
+		handleGamemasterSupervisedSessions(w, r, deps)
+	})
+	mux.HandleFunc("GET /admin/gamemaster/overrides", func(w http.ResponseWriter, r *http.Request) {
+		handleGamemasterOverrides(w, r, deps)
+	})
+	mux.HandleFunc("GET /admin/sessions/{id}/live", func(w http.ResponseWriter, r *http.Request) {
+		handleGamemasterLive(w, r, deps)
+	})
+	mux.HandleFunc("GET /admin/sessions/{id}/supervision", func(w http.ResponseWriter, r *http.Request) {
+		handleGamemasterSupervisionGet(w, r, deps)
+	})
+	mux.HandleFunc("PUT /admin/sessions/{id}/supervision", func(w http.ResponseWriter, r *http.Request) {
+		handleGamemasterSupervisionSet(w, r, deps)
+	})
+	mux.HandleFunc("POST /admin/sessions/{id}/pending/{pendingId}", func(w http.ResponseWriter, r *http.Request) {
+		handleGamemasterDecision(w, r, deps)
+	})
+
+	deps.Logger.Info("turtlesoup_gamemaster_api_registered", "routes", 6)
+}
+
+func handleGamemasterSupervisedSessions(w http.ResponseWriter, r *http.Request, deps GamemasterDeps) {
+	sessions, err := deps.Gamemaster.SupervisedSessions(r.Context())
+	if err != nil {
+		deps.Logger.Error("TURTLE_GM_SESSIONS_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to list supervised sessions")
+		return
+	}
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":   "ok",
+		"sessions": sessions,
+	})
+}
+
+func handleGamemasterOverrides(w http.ResponseWriter, r *http.Request, deps GamemasterDeps) {
+	sessionID := strings.TrimSpace(r.URL.Query().Get("sessionId"))
+	limit := parseIntOrDefault(r.URL.Query().Get("limit"), 50)
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	overrides, err := deps.Gamemaster.Overrides(r.Context(), sessionID, limit)
+	if err != nil {
+		deps.Logger.Error("TURTLE_GM_OVERRIDES_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to list overrides")
+		return
+	}
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":    "ok",
+		"overrides": overrides,
+	})
+}
+
+func handleGamemasterSupervisionGet(w http.ResponseWriter, r *http.Request, deps GamemasterDeps) {
+	ctx := r.Context()
+	sessionID := r.PathValue("id")
+
+	supervised, err := deps.Gamemaster.IsSupervised(ctx, sessionID)
+	if err != nil {
+		deps.Logger.Error("TURTLE_GM_SUPERVISION_GET_FAILED", "sessionId", sessionID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to load supervision")
+		return
+	}
+	pending, err := deps.Gamemaster.Pending(ctx, sessionID)
+	if err != nil {
+		deps.Logger.Error("TURTLE_GM_PENDING_GET_FAILED", "sessionId", sessionID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to load pending answer")
+		return
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, SupervisionResponse{
+		SessionID:  sessionID,
+		Supervised: supervised,
+		Pending:    pending,
+	})
+}
+
+func handleGamemasterSupervisionSet(w http.ResponseWriter, r *http.Request, deps GamemasterDeps) {
+	sessionID := r.PathValue("id")
+
+	var req SupervisionRequest
+	if err := commonhttputil.ReadJSON(r, &req, 1024); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidRequest, "invalid request body")
+		return
+	}
+
+	if err := deps.Gamemaster.SetSupervised(r.Context(), sessionID, req.Enabled); err != nil {
+		deps.Logger.Error("TURTLE_GM_SUPERVISION_SET_FAILED", "sessionId", sessionID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to update supervision")
+		return
+	}
+
+	deps.Logger.Info("TURTLE_GM_SUPERVISION_SET", "sessionId", sessionID, "enabled", req.Enabled, "operator", r.Header.Get(operatorHeader))
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, SupervisionResponse{
+		SessionID:  sessionID,
+		Supervised: req.Enabled,
+	})
+}
+
+func handleGamemasterDecision(w http.ResponseWriter, r *http.Request, deps GamemasterDeps) {
+	sessionID := r.PathValue("id")
+
+	var req GamemasterDecisionRequest
+	if err := commonhttputil.ReadJSON(r, &req, 4096); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, turtleAdminErrorInvalidRequest, "invalid request body")
+		return
+	}
+	req.PendingID = r.PathValue("pendingId")
+
+	if err := decideFromRequest(r.Context(), deps, sessionID, req, r.Header.Get(operatorHeader)); err != nil {
+		status, code := gamemasterErrorStatus(err)
+		message := err.Error()
+		if status == http.StatusInternalServerError {
+			deps.Logger.Error("TURTLE_GM_DECISION_FAILED", "sessionId", sessionID, "err", err)
+			message = "failed to submit decision"
+		}
+		_ = commonhttputil.WriteErrorJSON(w, status, code, message)
+		return
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]string{
+		"status": "ok",
+		"action": string(req.Action),
+	})
+}
+
+// handleGamemasterLive: 세션의 질문/답변 및 검토 이벤트를 WebSocket으로 실시간 전송합니다.
+// 클라이언트가 보낸 GamemasterDecisionRequest 메시지는 승인/수정 결정으로 처리합니다.
+func handleGamemasterLive(w http.ResponseWriter, r *http.Request, deps GamemasterDeps) {
+	sessionID := r.PathValue("id")
+	operator := r.Header.Get(operatorHeader)
+
+	snapshot, err := buildLiveSnapshot(r.Context(), deps, sessionID)
+	if err != nil {
+		deps.Logger.Error("TURTLE_GM_LIVE_SNAPSHOT_FAILED", "sessionId", sessionID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, turtleAdminErrorInternalError, "failed to load session")
+		return
+	}
+	if snapshot == nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, turtleAdminErrorSessionNotFound, "session not found")
+		return
+	}
+
+	conn, err := gamemasterUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var writeMu sync.Mutex
+	send := func(msg GamemasterLiveMessage) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+		return conn.WriteJSON(msg)
+	}
+
+	if err := send(GamemasterLiveMessage{Type: liveMessageSnapshot, Snapshot: snapshot}); err != nil {
+		return
+	}
+	deps.Logger.Info("TURTLE_GM_LIVE_CONNECTED", "sessionId", sessionID, "operator", operator)
+
+	// 수신 루프: 결정 메시지 처리, 연결 종료 감지
+	go func() {
+		defer cancel()
+		for {
+			var req GamemasterDecisionRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			reply := GamemasterLiveMessage{Type: liveMessageAck}
+			if err := decideFromRequest(ctx, deps, sessionID, req, operator); err != nil {
+				reply = GamemasterLiveMessage{Type: liveMessageError, Error: err.Error()}
+			}
+			if err := send(reply); err != nil {
+				return
+			}
+		}
+	}()
+
+	// 프록시 유휴 타임아웃 방지용 ping
+	go func() {
+		ticker := time.NewTicker(livePingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				writeMu.Lock()
+				err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteTimeout))
+				writeMu.Unlock()
+				if err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = eventbus.Subscribe(ctx, deps.ValkeyClient, eventbus.GamePattern(eventbus.GameTurtleSoup), func(event eventbus.Event) {
+		if event.SessionID != sessionID && event.ChatID != sessionID {
+			return
+		}
+		if err := send(GamemasterLiveMessage{Type: liveMessageEvent, Event: &event}); err != nil {
+			cancel()
+		}
+	})
+	if err != nil {
+		deps.Logger.Warn("TURTLE_GM_LIVE_SUBSCRIBE_FAILED", "sessionId", sessionID, "err", err)
+	}
+	deps.Logger.Info("TURTLE_GM_LIVE_DISCONNECTED", "sessionId", sessionID, "operator", operator)
+}
+
+func buildLiveSnapshot(ctx context.Context, deps GamemasterDeps, sessionID string) (*GamemasterLiveSnapshot, error) {
+	state, err := deps.SessionStore.LoadGameState(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, nil
+	}
+
+	supervised, err := deps.Gamemaster.IsSupervised(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := deps.Gamemaster.Pending(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GamemasterLiveSnapshot{
+		SessionID:     sessionID,
+		Supervised:    supervised,
+		QuestionCount: state.QuestionCount,
+		History:       state.History,
+		Pending:       pending,
+	}, nil
+}
+
+// decideFromRequest: 프록시가 전달한 운영자 헤더를 우선 사용해 결정을 전달합니다.
+func decideFromRequest(ctx context.Context, deps GamemasterDeps, sessionID string, req GamemasterDecisionRequest, headerOperator string) error {
+	operator := strings.TrimSpace(headerOperator)
+	if operator == "" {
+		operator = req.Operator
+	}
+	if err := deps.Gamemaster.Decide(ctx, sessionID, req.PendingID, req.Action, req.Answer, operator); err != nil {
+		return err
+	}
+	deps.Logger.Info("TURTLE_GM_DECISION", "sessionId", sessionID, "pendingId", req.PendingID, "action", req.Action, "operator", operator)
+	return nil
+}
+
+func gamemasterErrorStatus(err error) (int, string) {
+	var notFound tserrors.PendingAnswerNotFoundError
+	var invalidAnswer cerrors.InvalidAnswerError
+	var malformed cerrors.MalformedInputError
+	switch {
+	case errors.As(err, &notFound):
+		return http.StatusNotFound, turtleAdminErrorPendingNotFound
+	case errors.As(err, &invalidAnswer), errors.As(err, &malformed):
+		return http.StatusBadRequest, turtleAdminErrorInvalidRequest
+	default:
+		return http.StatusInternalServerError, turtleAdminErrorInternalError
+	}
+}
@@ -31,11 +31,25 @@ const (
 const maxBodyBytes = 1 << 20
 
 // Register: TurtleSoup 게임 API 라우트를 HTTP 멀티플렉서에 등록합니다.
-func Register(mux *http.ServeMux, llmCfg tsconfig.LlmConfig, restClient *llmrest.Client, gameService *tssvc.GameService, logger *slog.Logger) {
+func Register(
+	mux *http.ServeMux,
+	llmCfg tsconfig.LlmConfig,
+	restClient *llmrest.Client,
+	gameService *tssvc.GameService,
+	schemaChecker *health.SchemaChecker,
+	logger *slog.Logger,
+) {
 	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
 		_ = commonhttputil.WriteJSON(w, http.StatusOK, health.Get())
 	})
 
+	// GET /health/detail - 상세 헬스체크 (필수 테이블/컬럼/인덱스 존재 여부)
+	mux.HandleFunc("GET /health/detail", func(w http.ResponseWriter, r *http.Request) {
+		_ = commonhttputil.WriteJSON(w, http.StatusOK, health.Detailed(map[string]health.Component{
+			"schema": schemaChecker.Check(r.Context()),
+		}))
+	})
+
 	// GET /metrics - Prometheus 메트릭 (장기 히스토리 분석용)
 	mux.Handle("GET /metrics", promhttp.Handler())
 
@@ -137,7 +151,7 @@ func handleAskQuestion(w http.ResponseWriter, r *http.Request, gameService *tssv
 		return
 	}
 
-	state, result, err := gameService.AskQuestion(r.Context(), req.SessionID, req.Question)
+	state, result, err := gameService.AskQuestion(r.Context(), req.SessionID, req.UserID, req.Sender, req.Question)
 	if err != nil {
 		respondGameError(w, err, "ask_question_failed", logger)
 		return
@@ -162,7 +176,7 @@ func handleSubmitSolution(w http.ResponseWriter, r *http.Request, gameService *t
 		return
 	}
 
-	state, result, err := gameService.SubmitSolution(r.Context(), req.SessionID, req.Answer)
+	state, result, err := gameService.SubmitSolution(r.Context(), req.SessionID, req.UserID, req.Sender, req.Answer)
 	if err != nil {
 		respondGameError(w, err, "submit_solution_failed", logger)
 		return
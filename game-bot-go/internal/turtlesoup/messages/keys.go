@@ -37,6 +37,32 @@ const (
 	SurrenderHintBlockHeader = "surrender.hint_block_header"
 	SurrenderHintItem        = "surrender.hint_item"
 
+	// TimedStarted: 타임어택 모드 시작, 남은 시간 경고, 시간 초과 관련 메시지 키
+	TimedStarted      = "timed.started"
+	TimedWarning      = "timed.warning"
+	TimedRemaining    = "timed.remaining"
+	TimedInvalidLimit = "timed.invalid_limit"
+	TimedTimeout      = "timed.timeout"
+
+	// BonusOffer: 후일담 보너스 라운드 관련 메시지 키
+	BonusOffer     = "bonus.offer"
+	BonusCorrect   = "bonus.correct"
+	BonusClose     = "bonus.close"
+	BonusIncorrect = "bonus.incorrect"
+	BonusSkipped   = "bonus.skipped"
+	BonusNotFound  = "bonus.not_found"
+
+	// ContributionHeader: 게임 종료 시 플레이어별 기여도 관련 메시지 키
+	ContributionHeader = "contribution.header"
+	ContributionItem   = "contribution.item"
+	ContributionSolver = "contribution.solver"
+
+	// RatingPrompt: 퍼즐 평가 관련 메시지 키
+	RatingPrompt   = "rating.prompt"
+	RatingThanks   = "rating.thanks"
+	RatingInvalid  = "rating.invalid"
+	RatingNotFound = "rating.not_found"
+
 	// VoteStart: 항복 투표 진행 관련 메시지 키
 	VoteStart         = "vote.start"
 	VoteInProgress    = "vote.in_progress"
@@ -80,6 +106,7 @@ const (
 	ErrorNoSession          = "error.no_session"
 	ErrorInvalidQuestion    = "error.invalid_question"
 	ErrorMaxHints           = "error.max_hints"
+	ErrorDuplicateQuestion  = "error.duplicate_question"
 	ErrorInvalidAnswer      = "error.invalid_answer"
 	ErrorGameAlreadyStarted = "error.game_already_started"
 	ErrorGameAlreadySolved  = "error.game_already_solved"
@@ -90,6 +117,8 @@ const (
 	ErrorAccessDenied       = "error.access_denied"
 	ErrorUserBlocked        = "error.user_blocked"
 	ErrorChatBlocked        = "error.chat_blocked"
+	ErrorRateLimitedUser    = "error.rate_limited_user"
+	ErrorRateLimitedChat    = "error.rate_limited_chat"
 
 	// ErrorAICallTimeout: AI 서비스 호출 관련 에러 메시지 키
 	ErrorAICallTimeout = "error.ai_timeout"
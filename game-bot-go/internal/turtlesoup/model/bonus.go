@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// BonusResult: 보너스 라운드 결과
+type BonusResult string
+
+// BonusResult 상수 목록.
+const (
+	BonusCorrect   BonusResult = "correct"
+	BonusClose     BonusResult = "close"
+	BonusIncorrect BonusResult = "incorrect"
+	BonusSkipped   BonusResult = "skipped"
+)
+
+// 보너스 라운드 점수.
+const (
+	// BonusPointsCorrect: 보너스 질문을 맞혔을 때 점수
+	BonusPointsCorrect = 3
+	// BonusPointsClose: 보너스 질문에 근접했을 때 점수
+	BonusPointsClose = 1
+)
+
+// BonusRound: 정답 직후 제안되는 후일담 보너스 라운드 상태
+// 풀린 게임 상태를 함께 보관해 라운드가 끝날 때 게임 결과와 보너스 결과를 한 번에 아카이브합니다.
+type BonusRound struct {
+	State     GameState `json:"state"`
+	Epilogue  string    `json:"epilogue"`
+	Question  string    `json:"question"`
+	Answer    string    `json:"answer"`
+	OfferedAt time.Time `json:"offeredAt"`
+}
+
+// BonusOutcome: 보너스 라운드 종료 결과
+type BonusOutcome struct {
+	Round  BonusRound
+	Result BonusResult
+	Points int
+}
+
+// BonusResultOf: 판정 결과를 보너스 결과와 점수로 변환합니다.
+func BonusResultOf(validation ValidationResult) (BonusResult, int) {
+	switch validation {
+	case ValidationYes:
+		return BonusCorrect, BonusPointsCorrect
+	case ValidationClose:
+		return BonusClose, BonusPointsClose
+	default:
+		return BonusIncorrect, 0
+	}
+}
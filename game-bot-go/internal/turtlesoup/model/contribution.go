@@ -0,0 +1,107 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// 기여 점수.
+const (
+	// ContributionPointsQuestion: 질문 하나당 점수
+	ContributionPointsQuestion = 1
+	// ContributionPointsImportant: 중요한 질문으로 판정된 질문에 더하는 점수
+	ContributionPointsImportant = 3
+	// ContributionPointsSolver: 정답을 맞힌 플레이어에게 더하는 점수
+	ContributionPointsSolver = 10
+)
+
+// PlayerContribution: 한 게임에서 플레이어 한 명의 질문 기록과 기여 점수
+type PlayerContribution struct {
+	UserID             string `json:"userId"`
+	Sender             string `json:"sender,omitempty"`
+	Questions          int    `json:"questions"`
+	ImportantQuestions int    `json:"importantQuestions"`
+	Solved             bool   `json:"solved,omitempty"`
+	Score              int    `json:"score"`
+}
+
+// DisplayName: 표시용 이름 (닉네임이 없으면 사용자 ID)
+func (c PlayerContribution) DisplayName() string {
+	if c.Sender != "" {
+		return c.Sender
+	}
+	return c.UserID
+}
+
+// IsImportantAnswer: 답변에 중요한 질문 표시가 붙었는지 확인합니다. (예: "예, 중요한 질문입니다!")
+func IsImportantAnswer(answer string) bool {
+	normalized := strings.ReplaceAll(answer, " ", "")
+	return strings.Contains(normalized, "중요한질문") || strings.Contains(normalized, "중요합니다")
+}
+
+// RecordQuestion: 플레이어가 한 질문을 기여 기록에 반영합니다. userID가 비어 있으면 그대로 반환합니다. (Immutable)
+func (s GameState) RecordQuestion(userID string, sender string, important bool) GameState {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return s
+	}
+	next := slices.Clone(s.Contributions)
+	idx := slices.IndexFunc(next, func(c PlayerContribution) bool { return c.UserID == userID })
+	if idx < 0 {
+		next = append(next, PlayerContribution{UserID: userID})
+		idx = len(next) - 1
+	}
+	if sender = strings.TrimSpace(sender); sender != "" {
+		next[idx].Sender = sender
+	}
+	next[idx].Questions++
+	if important {
+		next[idx].ImportantQuestions++
+	}
+	return s.copyWith(func(state *GameState) {
+		state.Contributions = next
+	})
+}
+
+// MarkSolvedBy: 정답을 맞힌 플레이어를 기록하고 해결됨 상태로 변경합니다. (Immutable)
+func (s GameState) MarkSolvedBy(userID string, sender string) GameState {
+	next := s.MarkSolved()
+	next.SolvedBy = strings.TrimSpace(userID)
+	next.SolverSender = strings.TrimSpace(sender)
+	return next
+}
+
+// ContributionScores: 플레이어별 기여 점수를 계산해 점수 → 질문 수 → 기록 순으로 반환합니다.
+// 질문 없이 정답만 맞힌 플레이어도 포함하며, 기록이 없으면 빈 목록입니다.
+func (s GameState) ContributionScores() []PlayerContribution {
+	scores := slices.Clone(s.Contributions)
+	if scores == nil {
+		scores = []PlayerContribution{}
+	}
+	if s.SolvedBy != "" {
+		idx := slices.IndexFunc(scores, func(c PlayerContribution) bool { return c.UserID == s.SolvedBy })
+		if idx < 0 {
+			scores = append(scores, PlayerContribution{UserID: s.SolvedBy})
+			idx = len(scores) - 1
+		}
+		scores[idx].Solved = true
+		if scores[idx].Sender == "" {
+			scores[idx].Sender = s.SolverSender
+		}
+	}
+	for i := range scores {
+		score := scores[i].Questions*ContributionPointsQuestion + scores[i].ImportantQuestions*ContributionPointsImportant
+		if scores[i].Solved {
+			score += ContributionPointsSolver
+		}
+		scores[i].Score = score
+	}
+	slices.SortStableFunc(scores, func(a, b PlayerContribution) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Questions, a.Questions)
+	})
+	return scores
+}
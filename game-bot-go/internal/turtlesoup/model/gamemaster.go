@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// GamemasterAction: 운영자가 대기 중인 AI 답변에 내린 결정 종류
+type GamemasterAction string
+
+// GamemasterAction 상수 목록.
+const (
+	GamemasterActionApprove GamemasterAction = "approve"
+	GamemasterActionAmend   GamemasterAction = "amend"
+	// GamemasterActionTimeout: 제한 시간 내 결정이 없어 원래 답변이 그대로 전송됨
+	GamemasterActionTimeout GamemasterAction = "timeout"
+)
+
+// PendingAnswer: 감독 모드에서 운영자 검토를 기다리는 AI 답변
+type PendingAnswer struct {
+	ID             string    `json:"id"`
+	SessionID      string    `json:"sessionId"`
+	ChatID         string    `json:"chatId"`
+	QuestionNumber int       `json:"questionNumber"`
+	Question       string    `json:"question"`
+	Answer         string    `json:"answer"`
+	CreatedAt      time.Time `json:"createdAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// GamemasterDecision: 대기 중인 답변에 대한 운영자의 결정
+type GamemasterDecision struct {
+	PendingID string           `json:"pendingId"`
+	Action    GamemasterAction `json:"action"`
+	Answer    string           `json:"answer,omitempty"`
+	Operator  string           `json:"operator,omitempty"`
+	DecidedAt time.Time        `json:"decidedAt"`
+}
+
+// AnswerOverride: 감독 모드에서 검토된 답변 기록 (승인/수정/시간 초과 모두 기록)
+type AnswerOverride struct {
+	PendingID      string           `json:"pendingId"`
+	SessionID      string           `json:"sessionId"`
+	ChatID         string           `json:"chatId"`
+	QuestionNumber int              `json:"questionNumber"`
+	Question       string           `json:"question"`
+	OriginalAnswer string           `json:"originalAnswer"`
+	FinalAnswer    string           `json:"finalAnswer"`
+	Action         GamemasterAction `json:"action"`
+	Operator       string           `json:"operator,omitempty"`
+	DecidedAt      time.Time        `json:"decidedAt"`
+}
+
+// Amended: 최종 답변이 AI 원본 답변과 다른지 여부를 반환합니다.
+func (o AnswerOverride) Amended() bool {
+	return o.FinalAnswer != o.OriginalAnswer
+}
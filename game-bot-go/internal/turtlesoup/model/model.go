@@ -34,6 +34,8 @@ func ParsePuzzleCategory(input string) PuzzleCategory {
 
 // Puzzle: 바다거북 스푸 게임의 문제(시나리오)와 정답(해설)을 담고 있는 구조체
 type Puzzle struct {
+	// ID: CMS 퍼즐 ID (LLM 생성/프리셋 퍼즐은 0이며 평가 대상이 아님)
+	ID         uint64         `json:"id,omitempty"`
 	Title      string         `json:"title"`
 	Scenario   string         `json:"scenario"`
 	Solution   string         `json:"solution"`
@@ -65,6 +67,15 @@ type GameState struct {
 	IsSolved       bool      `json:"isSolved"`
 	StartedAt      time.Time `json:"startedAt"`
 	LastActivityAt time.Time `json:"lastActivityAt"`
+
+	// Deadline: 타임어택 모드의 풀이 마감 시각 (일반 게임은 nil)
+	Deadline *time.Time `json:"deadline,omitempty"`
+
+	// Contributions: 플레이어별 질문/중요 질문 수 (게임 종료 시 기여 점수 계산용)
+	Contributions []PlayerContribution `json:"contributions,omitempty"`
+	// SolvedBy, SolverSender: 정답을 맞힌 플레이어 ID와 닉네임 (미해결이면 빈 값)
+	SolvedBy     string `json:"solvedBy,omitempty"`
+	SolverSender string `json:"solverSender,omitempty"`
 }
 
 // NewInitialState: 새로운 게임 상태를 초기화합니다.
@@ -122,6 +133,24 @@ func (s GameState) UpdateActivity() GameState {
 	})
 }
 
+// WithDeadline: 타임어택 마감 시각을 지정합니다. (Immutable)
+func (s GameState) WithDeadline(deadline time.Time) GameState {
+	return s.copyWith(func(next *GameState) {
+		next.Deadline = &deadline
+	})
+}
+
+// IsTimed: 타임어택 모드 게임인지 여부를 반환합니다.
+func (s GameState) IsTimed() bool { return s.Deadline != nil }
+
+// Remaining: 마감까지 남은 시간을 반환합니다. 일반 게임이면 0, 마감이 지났으면 음수입니다.
+func (s GameState) Remaining(now time.Time) time.Duration {
+	if s.Deadline == nil {
+		return 0
+	}
+	return s.Deadline.Sub(now)
+}
+
 func (s GameState) copyWith(mut func(*GameState)) GameState {
 	next := s
 	mut(&next)
@@ -164,6 +193,12 @@ type AnswerResult struct {
 	MaxHints      int
 	HintsUsed     []string
 	Explanation   string
+	// Contributions: 정답 시 플레이어별 기여 점수 (점수 순)
+	Contributions []PlayerContribution
+	// Bonus: 정답 후 제안된 후일담 보너스 라운드 (생성 실패나 비활성 시 nil)
+	Bonus *BonusRound
+	// RatingOpen: 게임이 끝난 퍼즐을 평가할 수 있는지 여부 (CMS 퍼즐만)
+	RatingOpen bool
 }
 
 // IsCorrect: 정답 여부를 반환합니다.
@@ -174,8 +209,10 @@ func (r AnswerResult) IsClose() bool { return r.Result.IsClose() }
 
 // SurrenderResult: 게임 포기(항복) 시 공개되는 정답과 해석 정보
 type SurrenderResult struct {
-	Solution  string
-	HintsUsed []string
+	Solution      string
+	HintsUsed     []string
+	RatingOpen    bool
+	Contributions []PlayerContribution // 플레이어별 기여 점수 (점수 순)
 }
 
 // SurrenderVote: domainmodels.SurrenderVote alias
@@ -175,3 +175,49 @@ func TestSurrenderVote_Logic(t *testing.T) {
 		t.Error("should be approved (3/3)")
 	}
 }
+
+func TestGameState_ContributionScores(t *testing.T) {
+	state := NewInitialState("sess1", "user1", "chat1", Puzzle{Title: "Test Puzzle"})
+
+	state = state.RecordQuestion("user1", "Alice", false)
+	state = state.RecordQuestion("user2", "Bob", true)
+	state = state.RecordQuestion("user1", "", IsImportantAnswer("예, 중요한 질문입니다!"))
+	state = state.RecordQuestion("", "Nobody", true)
+
+	before := state
+	solved := state.MarkSolvedBy("user3", "Carol")
+	if len(before.Contributions) != 2 || before.SolvedBy != "" {
+		t.Fatalf("original state should stay unchanged: %+v", before)
+	}
+
+	scores := solved.ContributionScores()
+	want := []PlayerContribution{
+		{UserID: "user3", Sender: "Carol", Solved: true, Score: ContributionPointsSolver},
+		{UserID: "user1", Sender: "Alice", Questions: 2, ImportantQuestions: 1, Score: 2*ContributionPointsQuestion + ContributionPointsImportant},
+		{UserID: "user2", Sender: "Bob", Questions: 1, ImportantQuestions: 1, Score: ContributionPointsQuestion + ContributionPointsImportant},
+	}
+	if !slices.Equal(scores, want) {
+		t.Fatalf("unexpected scores:\n got %+v\nwant %+v", scores, want)
+	}
+	if !solved.IsSolved {
+		t.Error("MarkSolvedBy should mark the game solved")
+	}
+
+	if empty := NewInitialState("s", "u", "c", Puzzle{}).ContributionScores(); empty == nil || len(empty) != 0 {
+		t.Errorf("expected empty non-nil scores, got %#v", empty)
+	}
+}
+
+func TestIsImportantAnswer(t *testing.T) {
+	tests := map[string]bool{
+		"예, 중요한 질문입니다!": true,
+		"아니오. 중요합니다":    true,
+		"예":             false,
+		"관계없습니다":        false,
+	}
+	for answer, want := range tests {
+		if got := IsImportantAnswer(answer); got != want {
+			t.Errorf("IsImportantAnswer(%q) = %v, want %v", answer, got, want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// RatingTarget: 게임 종료 후 평가를 받는 퍼즐 정보 (채팅방마다 마지막으로 끝난 게임 1건)
+type RatingTarget struct {
+	PuzzleID  uint64    `json:"puzzleId"`
+	Title     string    `json:"title"`
+	SessionID string    `json:"sessionId"`
+	EndedAt   time.Time `json:"endedAt"`
+}
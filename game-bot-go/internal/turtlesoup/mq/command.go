@@ -28,10 +28,41 @@ const (
 	CommandSummary
 	// CommandHelp: 도움말 보기
 	CommandHelp
+	// CommandBonus: 정답 후 보너스 질문에 답하기
+	CommandBonus
+	// CommandBonusSkip: 보너스 라운드 건너뛰기
+	CommandBonusSkip
+	// CommandRate: 게임이 끝난 퍼즐 평가하기
+	CommandRate
 	// CommandUnknown: 알 수 없는 명령어
 	CommandUnknown
 )
 
+// commandNames: 지연 집계 등 외부에 노출하는 명령어 이름
+var commandNames = map[CommandKind]string{
+	CommandStart:     "start",
+	CommandAsk:       "ask",
+	CommandAnswer:    "answer",
+	CommandHint:      "hint",
+	CommandProblem:   "problem",
+	CommandSurrender: "surrender",
+	CommandAgree:     "agree",
+	CommandSummary:   "summary",
+	CommandHelp:      "help",
+	CommandBonus:     "bonus",
+	CommandBonusSkip: "bonus_skip",
+	CommandRate:      "rate",
+	CommandUnknown:   "unknown",
+}
+
+// Name: 명령어 종류의 이름을 반환합니다.
+func (k CommandKind) Name() string {
+	if name, ok := commandNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 // Command: 사용자 입력을 파싱하여 정제된 명령어 정보를 담는 구조체
 type Command struct {
 	Kind            CommandKind
@@ -39,6 +70,13 @@ type Command struct {
 	HasInvalidInput bool
 	Question        string
 	Answer          string
+
+	// Rating: 퍼즐 평점 (CommandRate 전용, 범위 검사는 처리 시점에 함)
+	Rating *int
+
+	// Timed: 타임어택 모드로 시작하는지 여부 (CommandStart 전용)
+	Timed            bool
+	TimeLimitMinutes *int
 }
 
 // RequiresLock: 이 명령어를 실행할 때 게임 상태 보호를 위한 분산 락(Write Lock)이 필요한지 여부를 반환합니다.
@@ -62,7 +100,7 @@ func (c Command) WaitingMessageKey() *string {
 		return ptr.String(tsmessages.ProcessingThinking)
 	case CommandHint:
 		return ptr.String(tsmessages.ProcessingGeneratingHint)
-	case CommandAnswer:
+	case CommandAnswer, CommandBonus:
 		return ptr.String(tsmessages.ProcessingValidating)
 	default:
 		return nil
@@ -14,12 +14,16 @@ type CommandParser struct {
 
 	helpRe      *regexp.Regexp
 	startRe     *regexp.Regexp
+	timedRe     *regexp.Regexp
 	hintRe      *regexp.Regexp
 	problemRe   *regexp.Regexp
 	surrenderRe *regexp.Regexp
 	agreeRe     *regexp.Regexp
 	summaryRe   *regexp.Regexp
 	answerRe    *regexp.Regexp
+	bonusSkipRe *regexp.Regexp
+	bonusRe     *regexp.Regexp
+	rateRe      *regexp.Regexp
 	askRe       *regexp.Regexp
 }
 
@@ -30,12 +34,16 @@ func NewCommandParser(prefix string) *CommandParser {
 
 	p.helpRe = p.BuildPattern(`\s*(?:도움|help)?$`)
 	p.startRe = p.BuildPattern(`\s*(?:시작|start)(?:\s+(\S+))?$`)
+	p.timedRe = p.BuildPattern(`\s*(?:타임어택|timed)(?:\s+(\S+))?$`)
 	p.hintRe = p.BuildPattern(`\s*(?:힌트|hint)$`)
 	p.problemRe = p.BuildPattern(`\s*(?:문제|제시문|problem)$`)
 	p.surrenderRe = p.BuildPattern(`\s*(?:포기|surrender)$`)
 	p.agreeRe = p.BuildPattern(`\s*(?:동의|agree)$`)
 	p.summaryRe = p.BuildPattern(`\s*(?:정리|summary)$`)
 	p.answerRe = p.BuildPattern(`\s*(?:정답|answer)\s+(.+)$`)
+	p.bonusSkipRe = p.BuildPattern(`\s*(?:보너스\s*(?:패스|건너뛰기)|bonus\s+skip)$`)
+	p.bonusRe = p.BuildPattern(`\s*(?:보너스|bonus)\s+(.+)$`)
+	p.rateRe = p.BuildPattern(`\s*(?:평가|별점|rate)\s+(\d+)\s*점?$`)
 	p.askRe = p.BuildPattern(`\s+(.+)$`)
 
 	return p
@@ -54,6 +62,9 @@ func (p *CommandParser) Parse(message string) *Command {
 	if cmd := p.parseStart(text); cmd != nil {
 		return cmd
 	}
+	if cmd := p.parseTimed(text); cmd != nil {
+		return cmd
+	}
 	if cmd := p.parseHint(text); cmd != nil {
 		return cmd
 	}
@@ -72,6 +83,12 @@ func (p *CommandParser) Parse(message string) *Command {
 	if cmd := p.parseAnswer(text); cmd != nil {
 		return cmd
 	}
+	if cmd := p.parseBonus(text); cmd != nil {
+		return cmd
+	}
+	if cmd := p.parseRate(text); cmd != nil {
+		return cmd
+	}
 	if cmd := p.parseAsk(text); cmd != nil {
 		return cmd
 	}
@@ -110,6 +127,31 @@ func (p *CommandParser) parseStart(text string) *Command {
 	return &Command{Kind: CommandStart, Difficulty: difficultyPtr, HasInvalidInput: hasInvalidInput}
 }
 
+// parseTimed: 타임어택 시작 명령을 파싱합니다. 제한 시간은 분 단위이며 "5분"처럼 단위를 붙여도 됩니다.
+func (p *CommandParser) parseTimed(text string) *Command {
+	m := p.timedRe.FindStringSubmatch(text)
+	if len(m) == 0 {
+		return nil
+	}
+
+	rawInput := ""
+	if len(m) >= 2 {
+		rawInput = strings.TrimSuffix(strings.TrimSpace(m[1]), "분")
+	}
+	var minutesPtr *int
+	hasInvalidInput := false
+
+	if rawInput != "" {
+		if v, err := strconv.Atoi(rawInput); err == nil {
+			minutesPtr = &v
+		} else {
+			hasInvalidInput = true
+		}
+	}
+
+	return &Command{Kind: CommandStart, Timed: true, TimeLimitMinutes: minutesPtr, HasInvalidInput: hasInvalidInput}
+}
+
 func (p *CommandParser) parseHint(text string) *Command {
 	if parser.MatchSimple(p.hintRe, text) {
 		return &Command{Kind: CommandHint}
@@ -153,6 +195,32 @@ func (p *CommandParser) parseAnswer(text string) *Command {
 	return &Command{Kind: CommandAnswer, Answer: answer}
 }
 
+// parseBonus: 보너스 라운드 명령을 파싱합니다. 건너뛰기를 먼저 확인해 "보너스 패스"가 답변으로 처리되지 않게 합니다.
+func (p *CommandParser) parseBonus(text string) *Command {
+	if parser.MatchSimple(p.bonusSkipRe, text) {
+		return &Command{Kind: CommandBonusSkip}
+	}
+	answer := parser.ExtractFirstGroup(p.bonusRe, text)
+	if answer == "" {
+		return nil
+	}
+	return &Command{Kind: CommandBonus, Answer: answer}
+}
+
+// parseRate: 퍼즐 평가 명령을 파싱합니다. "4점"처럼 단위를 붙여도 되며,
+// 숫자가 아니면 "평가 받았나요?" 같은 질문일 수 있으므로 질문으로 넘깁니다.
+func (p *CommandParser) parseRate(text string) *Command {
+	raw := parser.ExtractFirstGroup(p.rateRe, text)
+	if raw == "" {
+		return nil
+	}
+	rating, err := strconv.Atoi(raw)
+	if err != nil {
+		return &Command{Kind: CommandRate, HasInvalidInput: true}
+	}
+	return &Command{Kind: CommandRate, Rating: &rating}
+}
+
 func (p *CommandParser) parseAsk(text string) *Command {
 	question := parser.ExtractFirstGroup(p.askRe, text)
 	if question == "" {
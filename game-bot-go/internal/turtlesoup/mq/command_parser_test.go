@@ -71,6 +71,51 @@ func TestCommandParser_ParseStart(t *testing.T) {
 	}
 }
 
+func TestCommandParser_ParseTimed(t *testing.T) {
+	parser := NewCommandParser("/스프")
+
+	tests := []struct {
+		name        string
+		input       string
+		wantMinutes *int
+		wantInvalid bool
+	}{
+		{"타임어택 only", "/스프 타임어택", nil, false},
+		{"timed EN", "/스프 timed", nil, false},
+		{"타임어택 with minutes", "/스프 타임어택 7", intPtr(7), false},
+		{"타임어택 with unit", "/스프 타임어택 7분", intPtr(7), false},
+		{"타임어택 with invalid input", "/스프 타임어택 abc", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil {
+				t.Fatal("expected command, got nil")
+			}
+			if cmd.Kind != CommandStart || !cmd.Timed {
+				t.Fatalf("expected timed CommandStart, got %v (timed=%v)", cmd.Kind, cmd.Timed)
+			}
+			if cmd.Difficulty != nil {
+				t.Errorf("expected nil difficulty, got %d", *cmd.Difficulty)
+			}
+			if tt.wantMinutes == nil && cmd.TimeLimitMinutes != nil {
+				t.Errorf("expected nil minutes, got %d", *cmd.TimeLimitMinutes)
+			}
+			if tt.wantMinutes != nil && (cmd.TimeLimitMinutes == nil || *cmd.TimeLimitMinutes != *tt.wantMinutes) {
+				t.Errorf("expected minutes %d, got %v", *tt.wantMinutes, cmd.TimeLimitMinutes)
+			}
+			if cmd.HasInvalidInput != tt.wantInvalid {
+				t.Errorf("expected HasInvalidInput=%v, got %v", tt.wantInvalid, cmd.HasInvalidInput)
+			}
+		})
+	}
+
+	if cmd := parser.Parse("/스프 시작 3"); cmd == nil || cmd.Timed {
+		t.Errorf("expected plain start to stay untimed, got %+v", cmd)
+	}
+}
+
 func TestCommandParser_ParseHint(t *testing.T) {
 	parser := NewCommandParser("/스프")
 
@@ -95,6 +140,69 @@ func TestCommandParser_ParseHint(t *testing.T) {
 	}
 }
 
+func TestCommandParser_ParseBonus(t *testing.T) {
+	parser := NewCommandParser("/스프")
+
+	tests := []struct {
+		input      string
+		wantKind   CommandKind
+		wantAnswer string
+	}{
+		{"/스프 보너스 바다로 갔다", CommandBonus, "바다로 갔다"},
+		{"/스프 bonus sea", CommandBonus, "sea"},
+		{"/스프 보너스 패스", CommandBonusSkip, ""},
+		{"/스프 보너스건너뛰기", CommandBonusSkip, ""},
+		{"/스프 bonus skip", CommandBonusSkip, ""},
+		{"/스프 보너스", CommandAsk, "보너스"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil {
+				t.Fatal("expected command, got nil")
+			}
+			if cmd.Kind != tt.wantKind {
+				t.Errorf("expected %v, got %v", tt.wantKind, cmd.Kind)
+			}
+			if tt.wantKind == CommandBonus && cmd.Answer != tt.wantAnswer {
+				t.Errorf("expected answer %q, got %q", tt.wantAnswer, cmd.Answer)
+			}
+		})
+	}
+}
+
+func TestCommandParser_ParseRate(t *testing.T) {
+	parser := NewCommandParser("/스프")
+
+	tests := []struct {
+		input      string
+		wantKind   CommandKind
+		wantRating int
+	}{
+		{"/스프 평가 4", CommandRate, 4},
+		{"/스프 별점 5점", CommandRate, 5},
+		{"/스프 rate 1", CommandRate, 1},
+		{"/스프 평가 9", CommandRate, 9},
+		{"/스프 평가 받았나요?", CommandAsk, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil {
+				t.Fatal("expected command, got nil")
+			}
+			if cmd.Kind != tt.wantKind {
+				t.Fatalf("expected %v, got %v", tt.wantKind, cmd.Kind)
+			}
+			if tt.wantKind == CommandRate && (cmd.Rating == nil || *cmd.Rating != tt.wantRating) {
+				t.Errorf("expected rating %d, got %v", tt.wantRating, cmd.Rating)
+			}
+		})
+	}
+}
+
 func TestCommandParser_ParseProblem(t *testing.T) {
 	parser := NewCommandParser("/스프")
 
@@ -23,6 +23,7 @@ func GetErrorMapping(err error) ErrorMapping {
 		invalidQuestion  *cerrors.InvalidQuestionError
 		invalidAnswer    *cerrors.InvalidAnswerError
 		maxHints         *tserrors.MaxHintsReachedError
+		duplicate        tserrors.DuplicateQuestionError
 		gameAlreadyStart *tserrors.GameAlreadyStartedError
 		gameSolved       *tserrors.GameAlreadySolvedError
 		puzzleGen        *tserrors.PuzzleGenerationError
@@ -57,6 +58,15 @@ func GetErrorMapping(err error) ErrorMapping {
 				messageprovider.P("maxHints", tsconfig.GameMaxHints),
 			},
 		}
+	case errors.As(err, &duplicate):
+		return ErrorMapping{
+			Key: tsmessages.ErrorDuplicateQuestion,
+			Params: []messageprovider.Param{
+				messageprovider.P("number", duplicate.QuestionNumber),
+				messageprovider.P("question", duplicate.Question),
+				messageprovider.P("answer", duplicate.Answer),
+			},
+		}
 	case errors.As(err, &gameAlreadyStart):
 		return ErrorMapping{Key: tsmessages.ErrorGameAlreadyStarted}
 	case errors.As(err, &gameSolved):
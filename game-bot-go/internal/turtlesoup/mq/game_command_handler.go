@@ -6,9 +6,14 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/telemetry"
+	domainmodels "github.com/park285/llm-kakao-bots/game-bot-go/internal/domain/models"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
 	tsmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/messages"
@@ -16,34 +21,57 @@ import (
 	tssvc "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/service"
 )
 
+// tracerName: 바다거북스프 명령어 처리 span의 tracer 이름
+const tracerName = "game-bot-go/turtlesoup"
+
 // GameCommandHandler: 사용자의 파싱된 명령어를 받아 실제 바다거북스프 게임 로직(Service)을 호출하고 결과를 반환합니다.
 type GameCommandHandler struct {
 	gameService      *tssvc.GameService
+	bonusService     *tssvc.BonusRoundService
+	ratingService    *tssvc.PuzzleRatingService
 	surrenderHandler *SurrenderHandler
 	msgProvider      *messageprovider.Provider
 	messageBuilder   *MessageBuilder
+	timed            tsconfig.TimedConfig
 	logger           *slog.Logger
 }
 
 // NewGameCommandHandler: 새로운 GameCommandHandler 인스턴스를 생성합니다.
 func NewGameCommandHandler(
 	gameService *tssvc.GameService,
+	bonusService *tssvc.BonusRoundService,
+	ratingService *tssvc.PuzzleRatingService,
 	surrenderHandler *SurrenderHandler,
 	msgProvider *messageprovider.Provider,
 	messageBuilder *MessageBuilder,
+	timed tsconfig.TimedConfig,
 	logger *slog.Logger,
 ) *GameCommandHandler {
 	return &GameCommandHandler{
 		gameService:      gameService,
+		bonusService:     bonusService,
+		ratingService:    ratingService,
 		surrenderHandler: surrenderHandler,
 		msgProvider:      msgProvider,
 		messageBuilder:   messageBuilder,
+		timed:            timed,
 		logger:           logger,
 	}
 }
 
 // ProcessCommand: 명령어의 종류(Start, Ask, Answer 등)에 따라 적절한 핸들러 로직을 분기하여 실행합니다.
-func (h *GameCommandHandler) ProcessCommand(ctx context.Context, message mqmsg.InboundMessage, command Command) (string, error) {
+// 명령어 span 아래에 Valkey/LLM 호출 span이 이어지므로 Jaeger에서 한 턴을 끝까지 볼 수 있습니다.
+func (h *GameCommandHandler) ProcessCommand(ctx context.Context, message mqmsg.InboundMessage, command Command) (reply string, err error) {
+	ctx, span := telemetry.StartSpan(ctx, tracerName, "turtlesoup."+command.Kind.Name(),
+		attribute.String("game.command", command.Kind.Name()),
+		attribute.String("game.chat_id", message.ChatID),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	return h.dispatch(ctx, message, command)
+}
+
+func (h *GameCommandHandler) dispatch(ctx context.Context, message mqmsg.InboundMessage, command Command) (string, error) {
 	if h.shouldRegisterPlayer(command) {
 		_ = h.gameService.RegisterPlayer(ctx, message.ChatID, message.UserID)
 	}
@@ -65,6 +93,12 @@ func (h *GameCommandHandler) ProcessCommand(ctx context.Context, message mqmsg.I
 		return h.surrenderHandler.HandleAgree(ctx, message.ChatID, message.UserID)
 	case CommandSummary:
 		return h.handleSummary(ctx, message)
+	case CommandBonus:
+		return h.handleBonus(ctx, message, command.Answer)
+	case CommandBonusSkip:
+		return h.handleBonusSkip(ctx, message)
+	case CommandRate:
+		return h.handleRate(ctx, message, command)
 	case CommandHelp:
 		return h.msgProvider.Get(tsmessages.HelpMessage), nil
 	case CommandUnknown:
@@ -76,8 +110,12 @@ func (h *GameCommandHandler) ProcessCommand(ctx context.Context, message mqmsg.I
 
 // handleStart: 새로운 게임을 시작하거나 기존 게임을 재개한다. 시나리오와 게임 규칙을 안내한다.
 func (h *GameCommandHandler) handleStart(ctx context.Context, message mqmsg.InboundMessage, command Command) (string, error) {
+	if command.Timed {
+		return h.handleTimedStart(ctx, message, command)
+	}
+
 	selection := h.resolveDifficulty(command)
-	startState, err := h.startOrResumeGame(ctx, message, selection.Value)
+	startState, err := h.startOrResumeGame(ctx, message, selection.Value, 0)
 	if err != nil {
 		return "", fmt.Errorf("start or resume game failed: %w", err)
 	}
@@ -94,10 +132,39 @@ func (h *GameCommandHandler) handleStart(ctx context.Context, message mqmsg.Inbo
 	return scenario + "\n\n" + instruction, nil
 }
 
+// handleTimedStart: 타임어택 게임을 시작한다. 이미 진행 중인 게임이 있으면 일반 재개와 같이 안내한다.
+func (h *GameCommandHandler) handleTimedStart(ctx context.Context, message mqmsg.InboundMessage, command Command) (string, error) {
+	selection := h.resolveTimeLimit(command)
+	startState, err := h.startOrResumeGame(ctx, message, nil, selection.Value)
+	if err != nil {
+		return "", fmt.Errorf("start or resume timed game failed: %w", err)
+	}
+
+	if startState.IsResuming {
+		reply := h.composeStartReply(difficultySelection{}, startState.State, true)
+		if remaining := h.buildRemainingLine(startState.State); remaining != "" {
+			reply += "\n" + remaining
+		}
+		return reply, nil
+	}
+
+	parts := make([]string, 0, 4)
+	if selection.Warning != "" {
+		parts = append(parts, selection.Warning)
+	}
+	parts = append(parts,
+		h.buildScenarioMessage(startState.State, false),
+		h.buildInstructionMessage(startState.State, false),
+		h.msgProvider.Get(tsmessages.TimedStarted, messageprovider.P("limit", tssvc.FormatDuration(selection.Value))),
+	)
+	return strings.Join(parts, "\n\n"), nil
+}
+
 // handleAsk: 사용자의 질문을 AI에게 전달하여 "예/아니오" 답변을 받아 반환한다.
 func (h *GameCommandHandler) handleAsk(ctx context.Context, message mqmsg.InboundMessage, question string) (string, error) {
 	h.logger.Debug("handleAsk_start", "session_id", message.ChatID)
-	_, result, err := h.gameService.AskQuestion(ctx, message.ChatID, question)
+	sender := domainmodels.DisplayNameFromUser(message.UserID, message.Sender)
+	_, result, err := h.gameService.AskQuestion(ctx, message.ChatID, message.UserID, sender, question)
 	if err != nil {
 		return "", fmt.Errorf("ask question failed: %w", err)
 	}
@@ -108,21 +175,30 @@ func (h *GameCommandHandler) handleAsk(ctx context.Context, message mqmsg.Inboun
 
 // handleAnswer: 사용자가 제출한 정답을 검증하고, 결과(정답/오답/근접)에 따른 메시지를 생성한다.
 func (h *GameCommandHandler) handleAnswer(ctx context.Context, message mqmsg.InboundMessage, answer string) (string, error) {
-	result, err := h.gameService.SubmitAnswer(ctx, message.ChatID, answer)
+	sender := domainmodels.DisplayNameFromUser(message.UserID, message.Sender)
+	result, err := h.gameService.SubmitAnswer(ctx, message.ChatID, message.UserID, sender, answer)
 	if err != nil {
 		return "", fmt.Errorf("submit answer failed: %w", err)
 	}
 
 	switch {
 	case result.IsCorrect():
-		return h.msgProvider.Get(
+		reply := h.msgProvider.Get(
 			tsmessages.AnswerCorrect,
 			messageprovider.P("explanation", result.Explanation),
 			messageprovider.P("questionCount", result.QuestionCount),
 			messageprovider.P("hintCount", result.HintCount),
 			messageprovider.P("maxHints", result.MaxHints),
 			messageprovider.P("hintBlock", h.messageBuilder.BuildHintBlock(result.HintsUsed)),
-		), nil
+		)
+		reply = tssvc.AppendContributionBlock(h.msgProvider, reply, result.Contributions)
+		if result.Bonus != nil {
+			reply = strings.TrimRight(reply, "\n") + "\n\n" + h.buildBonusOffer(*result.Bonus)
+		}
+		if result.RatingOpen {
+			reply = appendRatingPrompt(h.msgProvider, reply)
+		}
+		return reply, nil
 	case result.IsClose():
 		return h.msgProvider.Get(tsmessages.AnswerCloseCall), nil
 	default:
@@ -130,6 +206,78 @@ func (h *GameCommandHandler) handleAnswer(ctx context.Context, message mqmsg.Inb
 	}
 }
 
+// handleBonus: 보너스 질문 답변을 판정하고 획득 점수와 예상 정답을 안내한다.
+func (h *GameCommandHandler) handleBonus(ctx context.Context, message mqmsg.InboundMessage, answer string) (string, error) {
+	outcome, err := h.bonusService.Answer(ctx, message.ChatID, message.UserID, answer)
+	if err != nil {
+		return "", fmt.Errorf("answer bonus failed: %w", err)
+	}
+	if outcome == nil {
+		return h.msgProvider.Get(tsmessages.BonusNotFound), nil
+	}
+
+	key := tsmessages.BonusIncorrect
+	switch outcome.Result {
+	case tsmodel.BonusCorrect:
+		key = tsmessages.BonusCorrect
+	case tsmodel.BonusClose:
+		key = tsmessages.BonusClose
+	}
+	return h.msgProvider.Get(
+		key,
+		messageprovider.P("points", outcome.Points),
+		messageprovider.P("answer", outcome.Round.Answer),
+	), nil
+}
+
+// handleBonusSkip: 보너스 라운드를 건너뛰고 예상 정답을 공개한다.
+func (h *GameCommandHandler) handleBonusSkip(ctx context.Context, message mqmsg.InboundMessage) (string, error) {
+	outcome, err := h.bonusService.Skip(ctx, message.ChatID, message.UserID)
+	if err != nil {
+		return "", fmt.Errorf("skip bonus failed: %w", err)
+	}
+	if outcome == nil {
+		return h.msgProvider.Get(tsmessages.BonusNotFound), nil
+	}
+	return h.msgProvider.Get(tsmessages.BonusSkipped, messageprovider.P("answer", outcome.Round.Answer)), nil
+}
+
+// handleRate: 방금 끝난 게임의 퍼즐 평점을 기록한다. 평점은 이후 퍼즐 출제 가중치에 반영된다.
+func (h *GameCommandHandler) handleRate(ctx context.Context, message mqmsg.InboundMessage, command Command) (string, error) {
+	if command.Rating == nil || *command.Rating < tsconfig.PuzzleRatingMin || *command.Rating > tsconfig.PuzzleRatingMax {
+		return h.msgProvider.Get(
+			tsmessages.RatingInvalid,
+			messageprovider.P("min", tsconfig.PuzzleRatingMin),
+			messageprovider.P("max", tsconfig.PuzzleRatingMax),
+		), nil
+	}
+	rating := *command.Rating
+
+	target, err := h.ratingService.Rate(ctx, message.ChatID, message.UserID, rating)
+	if err != nil {
+		return "", fmt.Errorf("rate puzzle failed: %w", err)
+	}
+	if target == nil {
+		return h.msgProvider.Get(tsmessages.RatingNotFound, messageprovider.P("minutes", tsconfig.RatingWindowTTLSeconds/60)), nil
+	}
+	return h.msgProvider.Get(
+		tsmessages.RatingThanks,
+		messageprovider.P("title", target.Title),
+		messageprovider.P("stars", strings.Repeat("★", rating)+strings.Repeat("☆", tsconfig.PuzzleRatingMax-rating)),
+		messageprovider.P("rating", rating),
+	), nil
+}
+
+func (h *GameCommandHandler) buildBonusOffer(round tsmodel.BonusRound) string {
+	return strings.TrimRight(h.msgProvider.Get(
+		tsmessages.BonusOffer,
+		messageprovider.P("epilogue", round.Epilogue),
+		messageprovider.P("question", round.Question),
+		messageprovider.P("points", tsmodel.BonusPointsCorrect),
+		messageprovider.P("minutes", tsconfig.BonusRoundTTLSeconds/60),
+	), "\n")
+}
+
 // handleHint: 게임 진행 중 힌트를 생성하고 제공한다. 힌트 카운트를 차감한다.
 func (h *GameCommandHandler) handleHint(ctx context.Context, message mqmsg.InboundMessage) (string, error) {
 	state, hint, err := h.gameService.RequestHint(ctx, message.ChatID)
@@ -155,13 +303,17 @@ func (h *GameCommandHandler) handleProblem(ctx context.Context, message mqmsg.In
 		scenario = state.Puzzle.Scenario
 	}
 
-	return h.msgProvider.Get(
+	display := h.msgProvider.Get(
 		tsmessages.ProblemDisplay,
 		messageprovider.P("scenario", scenario),
 		messageprovider.P("questionCount", state.QuestionCount),
 		messageprovider.P("hintCount", state.HintsUsed),
 		messageprovider.P("maxHints", tsconfig.GameMaxHints),
-	), nil
+	)
+	if remaining := h.buildRemainingLine(state); remaining != "" {
+		display = strings.TrimRight(display, "\n") + "\n" + remaining
+	}
+	return display, nil
 }
 
 // handleSummary: 지금까지 주고받은 질문과 답변의 이력을 요약하여 보여준다.
@@ -209,13 +361,60 @@ func (h *GameCommandHandler) resolveDifficulty(command Command) difficultySelect
 	}
 }
 
+type timeLimitSelection struct {
+	Value   time.Duration
+	Warning string
+}
+
+// resolveTimeLimit: 입력한 제한 시간(분)을 검증한다. 생략하거나 범위를 벗어나면 기본 제한 시간을 사용한다.
+func (h *GameCommandHandler) resolveTimeLimit(command Command) timeLimitSelection {
+	fallback := timeLimitSelection{
+		Value: h.timed.DefaultDuration,
+		Warning: h.msgProvider.Get(
+			tsmessages.TimedInvalidLimit,
+			messageprovider.P("min", tsconfig.TimedMinMinutes),
+			messageprovider.P("max", int(h.timed.MaxDuration/time.Minute)),
+			messageprovider.P("default", int(h.timed.DefaultDuration/time.Minute)),
+		),
+	}
+	if command.HasInvalidInput {
+		return fallback
+	}
+	if command.TimeLimitMinutes == nil {
+		return timeLimitSelection{Value: h.timed.DefaultDuration}
+	}
+
+	desired := time.Duration(*command.TimeLimitMinutes) * time.Minute
+	if *command.TimeLimitMinutes >= tsconfig.TimedMinMinutes && desired <= h.timed.MaxDuration {
+		return timeLimitSelection{Value: desired}
+	}
+	return fallback
+}
+
+// buildRemainingLine: 타임어택 게임이면 남은 시간 안내 문구를, 일반 게임이면 빈 문자열을 반환한다.
+func (h *GameCommandHandler) buildRemainingLine(state tsmodel.GameState) string {
+	if !state.IsTimed() {
+		return ""
+	}
+	remaining := max(state.Remaining(time.Now()), 0)
+	return h.msgProvider.Get(tsmessages.TimedRemaining, messageprovider.P("remaining", tssvc.FormatDuration(remaining)))
+}
+
 type startState struct {
 	State      tsmodel.GameState
 	IsResuming bool
 }
 
-func (h *GameCommandHandler) startOrResumeGame(ctx context.Context, message mqmsg.InboundMessage, difficulty *int) (startState, error) {
-	state, err := h.gameService.StartGame(ctx, message.ChatID, message.UserID, message.ChatID, difficulty, nil, nil)
+func (h *GameCommandHandler) startOrResumeGame(ctx context.Context, message mqmsg.InboundMessage, difficulty *int, timeLimit time.Duration) (startState, error) {
+	var (
+		state tsmodel.GameState
+		err   error
+	)
+	if timeLimit > 0 {
+		state, err = h.gameService.StartTimedGame(ctx, message.ChatID, message.UserID, message.ChatID, difficulty, timeLimit)
+	} else {
+		state, err = h.gameService.StartGame(ctx, message.ChatID, message.UserID, message.ChatID, difficulty, nil, nil)
+	}
 	if err == nil {
 		return startState{State: state, IsResuming: false}, nil
 	}
@@ -291,7 +490,7 @@ func buildDifficultyStars(difficulty int) string {
 
 func (h *GameCommandHandler) shouldRegisterPlayer(command Command) bool {
 	switch command.Kind {
-	case CommandHelp, CommandUnknown:
+	case CommandHelp, CommandUnknown, CommandBonus, CommandBonusSkip, CommandRate:
 		return false
 	default:
 		return true
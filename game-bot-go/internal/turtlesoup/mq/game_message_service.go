@@ -7,9 +7,12 @@ import (
 	"time"
 
 	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ratelimit"
+	domainmodels "github.com/park285/llm-kakao-bots/game-bot-go/internal/domain/models"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	tsmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/messages"
 	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
@@ -24,10 +27,12 @@ type GameMessageService struct {
 	msgProvider           *messageprovider.Provider
 	publisher             *ReplyPublisher
 	accessControl         *tssecurity.AccessControl
+	rateLimiter           *ratelimit.Limiter
 	commandParser         *CommandParser
 	processingLockService *tsredis.ProcessingLockService
 	queueProcessor        *MessageQueueProcessor
 	restClient            *llmrest.Client
+	latencyRecorder       *latency.Recorder
 	logger                *slog.Logger
 }
 
@@ -38,10 +43,12 @@ func NewGameMessageService(
 	msgProvider *messageprovider.Provider,
 	publisher *ReplyPublisher,
 	accessControl *tssecurity.AccessControl,
+	rateLimiter *ratelimit.Limiter,
 	commandParser *CommandParser,
 	processingLockService *tsredis.ProcessingLockService,
 	queueProcessor *MessageQueueProcessor,
 	restClient *llmrest.Client,
+	latencyRecorder *latency.Recorder,
 	logger *slog.Logger,
 ) *GameMessageService {
 	return &GameMessageService{
@@ -50,10 +57,12 @@ func NewGameMessageService(
 		msgProvider:           msgProvider,
 		publisher:             publisher,
 		accessControl:         accessControl,
+		rateLimiter:           rateLimiter,
 		commandParser:         commandParser,
 		processingLockService: processingLockService,
 		queueProcessor:        queueProcessor,
 		restClient:            restClient,
+		latencyRecorder:       latencyRecorder,
 		logger:                logger,
 	}
 }
@@ -71,6 +80,13 @@ func (s *GameMessageService) HandleMessage(ctx context.Context, message mqmsg.In
 		return
 	}
 
+	ctx, span := s.latencyRecorder.Start(ctx, cmd.Kind.Name(), message.ReceivedAt)
+	defer span.Finish()
+
+	if !s.isWithinRateLimit(ctx, message) {
+		return
+	}
+
 	s.dispatchCommand(ctx, message, *cmd)
 }
 
@@ -109,8 +125,9 @@ func (s *GameMessageService) executeWithQueue(ctx context.Context, message mqmsg
 	s.executeCommand(ctx, message, command)
 	_ = s.processingLockService.FinishProcessing(ctx, chatID)
 
-	s.queueProcessor.ProcessQueuedMessages(ctx, chatID, func(out mqmsg.OutboundMessage) error {
-		return s.publisher.Publish(ctx, out)
+	queueCtx := latency.Detach(ctx)
+	s.queueProcessor.ProcessQueuedMessages(queueCtx, chatID, func(out mqmsg.OutboundMessage) error {
+		return s.publisher.Publish(queueCtx, out)
 	})
 }
 
@@ -207,6 +224,41 @@ func (s *GameMessageService) isAccessAllowed(message mqmsg.InboundMessage) bool
 	return false
 }
 
+// isWithinRateLimit: 명령어 빈도 제한을 확인합니다. 제한된 메시지는 큐에도 넣지 않고 버리며,
+// 같은 사용자에게는 쿨다운 동안 안내를 한 번만 보냅니다.
+func (s *GameMessageService) isWithinRateLimit(ctx context.Context, message mqmsg.InboundMessage) bool {
+	decision, err := s.rateLimiter.Allow(ctx, message.ChatID, message.UserID)
+	if err != nil {
+		s.logger.Warn("rate_limit_check_failed", "chat_id", message.ChatID, "user_id", message.UserID, "err", err)
+	}
+	if decision.Allowed {
+		return true
+	}
+
+	s.logger.Info("message_rate_limited",
+		"chat_id", message.ChatID,
+		"user_id", message.UserID,
+		"scope", decision.Scope.String(),
+		"retry_after_ms", decision.RetryAfter.Milliseconds(),
+	)
+	if decision.Notify {
+		_ = s.messageSender.SendError(ctx, message, s.rateLimitMapping(message, decision))
+	}
+	return false
+}
+
+func (s *GameMessageService) rateLimitMapping(message mqmsg.InboundMessage, decision ratelimit.Decision) ErrorMapping {
+	seconds := messageprovider.P("seconds", decision.RetryAfterSeconds())
+	if decision.Scope != ratelimit.ScopeUser {
+		return ErrorMapping{Key: tsmessages.ErrorRateLimitedChat, Params: []messageprovider.Param{seconds}}
+	}
+	nickname := domainmodels.DisplayName(message.ChatID, message.UserID, message.Sender, s.msgProvider.Get(tsmessages.UserAnonymous))
+	return ErrorMapping{
+		Key:    tsmessages.ErrorRateLimitedUser,
+		Params: []messageprovider.Param{messageprovider.P("nickname", nickname), seconds},
+	}
+}
+
 func (s *GameMessageService) isProcessing(ctx context.Context, chatID string) bool {
 	ok, err := s.processingLockService.IsProcessing(ctx, chatID)
 	if err != nil {
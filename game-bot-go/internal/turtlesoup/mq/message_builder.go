@@ -69,3 +69,14 @@ func (b *MessageBuilder) BuildHintBlock(hints []string) string {
 		messageprovider.P("hintList", strings.Join(lines, "\n")),
 	)
 }
+
+// appendRatingPrompt: 게임 종료 메시지 뒤에 퍼즐 평가 안내를 덧붙입니다.
+func appendRatingPrompt(provider *messageprovider.Provider, reply string) string {
+	prompt := provider.Get(
+		tsmessages.RatingPrompt,
+		messageprovider.P("minutes", tsconfig.RatingWindowTTLSeconds/60),
+		messageprovider.P("min", tsconfig.PuzzleRatingMin),
+		messageprovider.P("max", tsconfig.PuzzleRatingMax),
+	)
+	return strings.TrimRight(reply, "\n") + "\n\n" + prompt
+}
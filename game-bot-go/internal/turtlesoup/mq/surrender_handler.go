@@ -139,9 +139,14 @@ func (h *SurrenderHandler) executeSurrender(ctx context.Context, chatID string)
 		hintBlock = header + strings.Join(items, "\n")
 	}
 
-	return h.msgProvider.Get(
+	reply := h.msgProvider.Get(
 		tsmessages.SurrenderResult,
 		messageprovider.P("solution", result.Solution),
 		messageprovider.P("hintBlock", hintBlock),
-	), nil
+	)
+	reply = tssvc.AppendContributionBlock(h.msgProvider, reply, result.Contributions)
+	if result.RatingOpen {
+		reply = appendRatingPrompt(h.msgProvider, reply)
+	}
+	return reply, nil
 }
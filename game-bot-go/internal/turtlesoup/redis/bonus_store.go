@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+// BonusStore: 정답 직후 제안된 후일담 보너스 라운드를 채팅방 단위로 Redis에 저장하는 저장소
+type BonusStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewBonusStore: 새로운 BonusStore 인스턴스를 생성합니다.
+func NewBonusStore(client valkey.Client, logger *slog.Logger) *BonusStore {
+	return &BonusStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Save: 보너스 라운드를 저장합니다. 같은 방의 이전 라운드는 덮어쓰며 응답 시간이 지나면 만료됩니다.
+func (s *BonusStore) Save(ctx context.Context, chatID string, round tsmodel.BonusRound) error {
+	payload, err := json.Marshal(round)
+	if err != nil {
+		return cerrors.RedisError{Operation: "bonus_marshal", Err: err}
+	}
+	ttl := time.Duration(tsconfig.BonusRoundTTLSeconds) * time.Second
+	cmd := s.client.B().Set().Key(bonusKey(chatID)).Value(string(payload)).Ex(ttl).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "bonus_save", Err: err}
+	}
+	s.logger.Debug("bonus_saved", "chat_id", chatID)
+	return nil
+}
+
+// Get: 진행 중인 보너스 라운드를 조회합니다. 없으면 nil을 반환합니다.
+func (s *BonusStore) Get(ctx context.Context, chatID string) (*tsmodel.BonusRound, error) {
+	cmd := s.client.B().Get().Key(bonusKey(chatID)).Build()
+	return s.decode(s.client.Do(ctx, cmd), "bonus_get")
+}
+
+// Take: 보너스 라운드를 꺼내면서 삭제합니다. (GETDEL) 없으면 nil을 반환합니다.
+// 여러 명이 동시에 답해도 한 명만 라운드를 가져가므로 점수가 중복 지급되지 않습니다.
+func (s *BonusStore) Take(ctx context.Context, chatID string) (*tsmodel.BonusRound, error) {
+	cmd := s.client.B().Getdel().Key(bonusKey(chatID)).Build()
+	return s.decode(s.client.Do(ctx, cmd), "bonus_take")
+}
+
+func (s *BonusStore) decode(resp valkey.ValkeyResult, operation string) (*tsmodel.BonusRound, error) {
+	raw, err := resp.ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: operation, Err: err}
+	}
+
+	var round tsmodel.BonusRound
+	if err := json.Unmarshal([]byte(raw), &round); err != nil {
+		return nil, cerrors.RedisError{Operation: "bonus_unmarshal", Err: err}
+	}
+	return &round, nil
+}
@@ -0,0 +1,190 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+// GamemasterStore: 감독 모드 설정, 검토 대기 답변, 운영자 결정, 답변 검토 기록을 Redis에 저장하는 저장소
+// 운영자 결정은 리스트(BLPOP)로 전달되므로 관리 API와 게임 처리가 다른 인스턴스에서 실행되어도 동작합니다.
+type GamemasterStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewGamemasterStore: 새로운 GamemasterStore 인스턴스를 생성합니다.
+func NewGamemasterStore(client valkey.Client, logger *slog.Logger) *GamemasterStore {
+	return &GamemasterStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// SetSupervised: 세션의 감독 모드를 켜거나 끕니다.
+func (s *GamemasterStore) SetSupervised(ctx context.Context, sessionID string, enabled bool) error {
+	var cmd valkey.Completed
+	if enabled {
+		cmd = s.client.B().Sadd().Key(gamemasterSupervisedKey()).Member(sessionID).Build()
+	} else {
+		cmd = s.client.B().Srem().Key(gamemasterSupervisedKey()).Member(sessionID).Build()
+	}
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "gm_set_supervised", Err: err}
+	}
+	s.logger.Info("gm_supervised_changed", "session_id", sessionID, "enabled", enabled)
+	return nil
+}
+
+// IsSupervised: 세션이 감독 모드인지 확인합니다.
+func (s *GamemasterStore) IsSupervised(ctx context.Context, sessionID string) (bool, error) {
+	cmd := s.client.B().Sismember().Key(gamemasterSupervisedKey()).Member(sessionID).Build()
+	ok, err := s.client.Do(ctx, cmd).AsBool()
+	if err != nil && !valkeyx.IsNil(err) {
+		return false, cerrors.RedisError{Operation: "gm_is_supervised", Err: err}
+	}
+	return ok, nil
+}
+
+// ListSupervised: 감독 모드가 켜진 세션 ID 목록을 반환합니다.
+func (s *GamemasterStore) ListSupervised(ctx context.Context) ([]string, error) {
+	cmd := s.client.B().Smembers().Key(gamemasterSupervisedKey()).Build()
+	members, err := s.client.Do(ctx, cmd).AsStrSlice()
+	if err != nil && !valkeyx.IsNil(err) {
+		return nil, cerrors.RedisError{Operation: "gm_list_supervised", Err: err}
+	}
+	return members, nil
+}
+
+// SavePending: 검토 대기 답변을 저장합니다. 결정 대기 시간이 지나면 자동으로 만료됩니다.
+func (s *GamemasterStore) SavePending(ctx context.Context, pending tsmodel.PendingAnswer, ttl time.Duration) error {
+	payload, err := json.Marshal(pending)
+	if err != nil {
+		return cerrors.RedisError{Operation: "gm_pending_marshal", Err: err}
+	}
+	cmd := s.client.B().Set().Key(gamemasterPendingKey(pending.SessionID)).Value(string(payload)).Ex(ttl).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "gm_pending_save", Err: err}
+	}
+	return nil
+}
+
+// GetPending: 세션의 검토 대기 답변을 조회합니다. 없으면 nil을 반환합니다.
+func (s *GamemasterStore) GetPending(ctx context.Context, sessionID string) (*tsmodel.PendingAnswer, error) {
+	cmd := s.client.B().Get().Key(gamemasterPendingKey(sessionID)).Build()
+	raw, err := s.client.Do(ctx, cmd).ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "gm_pending_get", Err: err}
+	}
+
+	var pending tsmodel.PendingAnswer
+	if err := json.Unmarshal([]byte(raw), &pending); err != nil {
+		return nil, cerrors.RedisError{Operation: "gm_pending_unmarshal", Err: err}
+	}
+	return &pending, nil
+}
+
+// ClearPending: 세션의 검토 대기 답변을 삭제합니다.
+func (s *GamemasterStore) ClearPending(ctx context.Context, sessionID string) error {
+	cmd := s.client.B().Del().Key(gamemasterPendingKey(sessionID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "gm_pending_clear", Err: err}
+	}
+	return nil
+}
+
+// PushDecision: 대기 중인 답변을 처리하는 쪽으로 운영자 결정을 전달합니다.
+func (s *GamemasterStore) PushDecision(ctx context.Context, decision tsmodel.GamemasterDecision, ttl time.Duration) error {
+	payload, err := json.Marshal(decision)
+	if err != nil {
+		return cerrors.RedisError{Operation: "gm_decision_marshal", Err: err}
+	}
+
+	key := gamemasterDecisionKey(decision.PendingID)
+	pushCmd := s.client.B().Rpush().Key(key).Element(string(payload)).Build()
+	expireCmd := s.client.B().Expire().Key(key).Seconds(int64(ttl / time.Second)).Build()
+	for _, resp := range s.client.DoMulti(ctx, pushCmd, expireCmd) {
+		if err := resp.Error(); err != nil {
+			return cerrors.RedisError{Operation: "gm_decision_push", Err: err}
+		}
+	}
+	return nil
+}
+
+// WaitDecision: 운영자 결정을 최대 timeout 동안 기다립니다. 시간 내 결정이 없으면 nil을 반환합니다.
+func (s *GamemasterStore) WaitDecision(ctx context.Context, pendingID string, timeout time.Duration) (*tsmodel.GamemasterDecision, error) {
+	key := gamemasterDecisionKey(pendingID)
+	cmd := s.client.B().Blpop().Key(key).Timeout(timeout.Seconds()).Build()
+	values, err := s.client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "gm_decision_wait", Err: err}
+	}
+	// BLPOP 응답: [key, value]
+	if len(values) < 2 {
+		return nil, nil
+	}
+
+	var decision tsmodel.GamemasterDecision
+	if err := json.Unmarshal([]byte(values[1]), &decision); err != nil {
+		return nil, cerrors.RedisError{Operation: "gm_decision_unmarshal", Err: err}
+	}
+	return &decision, nil
+}
+
+// AppendOverride: 답변 검토 기록을 추가합니다. 최근 GamemasterOverrideLogMaxLen개만 보관합니다.
+func (s *GamemasterStore) AppendOverride(ctx context.Context, override tsmodel.AnswerOverride) error {
+	payload, err := json.Marshal(override)
+	if err != nil {
+		return cerrors.RedisError{Operation: "gm_override_marshal", Err: err}
+	}
+
+	key := gamemasterOverridesKey()
+	pushCmd := s.client.B().Lpush().Key(key).Element(string(payload)).Build()
+	trimCmd := s.client.B().Ltrim().Key(key).Start(0).Stop(tsconfig.GamemasterOverrideLogMaxLen - 1).Build()
+	for _, resp := range s.client.DoMulti(ctx, pushCmd, trimCmd) {
+		if err := resp.Error(); err != nil {
+			return cerrors.RedisError{Operation: "gm_override_append", Err: err}
+		}
+	}
+	return nil
+}
+
+// ListOverrides: 최신순 답변 검토 기록을 조회합니다. sessionID가 비어 있으면 전체를 조회합니다.
+func (s *GamemasterStore) ListOverrides(ctx context.Context, sessionID string, limit int) ([]tsmodel.AnswerOverride, error) {
+	cmd := s.client.B().Lrange().Key(gamemasterOverridesKey()).Start(0).Stop(-1).Build()
+	raws, err := s.client.Do(ctx, cmd).AsStrSlice()
+	if err != nil && !valkeyx.IsNil(err) {
+		return nil, cerrors.RedisError{Operation: "gm_override_list", Err: err}
+	}
+
+	overrides := make([]tsmodel.AnswerOverride, 0, min(len(raws), limit))
+	for _, raw := range raws {
+		if len(overrides) >= limit {
+			break
+		}
+		var override tsmodel.AnswerOverride
+		if err := json.Unmarshal([]byte(raw), &override); err != nil {
+			s.logger.Warn("gm_override_unmarshal_failed", "err", err)
+			continue
+		}
+		if sessionID != "" && override.SessionID != sessionID {
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
@@ -2,6 +2,8 @@
 package redis
 
 import (
+	"strconv"
+
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 )
@@ -45,3 +47,56 @@ func puzzleChatKey(chatID string) string {
 func pendingKeyPrefix() string {
 	return tsconfig.RedisKeyPendingPrefix
 }
+
+// gamemasterSupervisedKey: 감독 모드가 켜진 세션 집합 키를 반환합니다.
+// 형식: turtle:gm:supervised
+func gamemasterSupervisedKey() string {
+	return valkeyx.BuildKey(tsconfig.RedisKeyGamemaster, "supervised")
+}
+
+// gamemasterPendingKey: 검토 대기 중인 답변 저장용 키를 생성합니다.
+// 형식: turtle:gm:pending:{sessionID}
+func gamemasterPendingKey(sessionID string) string {
+	return valkeyx.BuildKeySuffix(tsconfig.RedisKeyGamemaster, "pending", sessionID)
+}
+
+// gamemasterDecisionKey: 운영자 결정 전달용 리스트 키를 생성합니다.
+// 형식: turtle:gm:decision:{pendingID}
+func gamemasterDecisionKey(pendingID string) string {
+	return valkeyx.BuildKeySuffix(tsconfig.RedisKeyGamemaster, "decision", pendingID)
+}
+
+// gamemasterOverridesKey: 답변 검토 기록 리스트 키를 반환합니다.
+// 형식: turtle:gm:overrides
+func gamemasterOverridesKey() string {
+	return valkeyx.BuildKey(tsconfig.RedisKeyGamemaster, "overrides")
+}
+
+// timedDeadlinesKey: 타임어택 세션별 마감 시각 sorted set 키를 반환합니다.
+// 형식: turtle:timed:deadlines
+func timedDeadlinesKey() string {
+	return valkeyx.BuildKey(tsconfig.RedisKeyTimed, "deadlines")
+}
+
+// timedWarnedKey: 남은 시간 경고 발송 기록 키를 생성합니다. 같은 방의 다음 게임과 섞이지 않도록 마감 시각을 포함합니다.
+// 형식: turtle:timed:warned:{sessionID}:{deadlineUnix}:{thresholdSeconds}
+func timedWarnedKey(sessionID string, deadlineUnix int64, thresholdSeconds int64) string {
+	return valkeyx.BuildKey3(
+		valkeyx.BuildKey(tsconfig.RedisKeyTimed, "warned"),
+		sessionID,
+		strconv.FormatInt(deadlineUnix, 10),
+		strconv.FormatInt(thresholdSeconds, 10),
+	)
+}
+
+// bonusKey: 채팅방의 후일담 보너스 라운드 저장용 키를 생성합니다.
+// 형식: turtle:bonus:{chatID}
+func bonusKey(chatID string) string {
+	return valkeyx.BuildKey(tsconfig.RedisKeyBonus, chatID)
+}
+
+// ratingKey: 채팅방에서 마지막으로 끝난 게임의 퍼즐 평가 대상 저장용 키를 생성합니다.
+// 형식: turtle:rating:{chatID}
+func ratingKey(chatID string) string {
+	return valkeyx.BuildKey(tsconfig.RedisKeyRating, chatID)
+}
@@ -14,15 +14,17 @@ import (
 // PuzzleDedupStore: 생성된 퍼즐의 내용(Signature)을 기반으로 중복 생성을 감지하고 방지하는 저장소
 // 전역 범위(Global)와 채팅방 범위(Chat) 두 가지 레벨에서 중복을 체크합니다.
 type PuzzleDedupStore struct {
-	client valkey.Client
-	logger *slog.Logger
+	client    valkey.Client
+	resilient *valkeyx.ResilientClient
+	logger    *slog.Logger
 }
 
 // NewPuzzleDedupStore: 새로운 PuzzleDedupStore 인스턴스를 생성합니다.
 func NewPuzzleDedupStore(client valkey.Client, logger *slog.Logger) *PuzzleDedupStore {
 	return &PuzzleDedupStore{
-		client: client,
-		logger: logger,
+		client:    client,
+		resilient: valkeyx.NewResilientClient(client, valkeyx.ResilienceConfig{}, logger),
+		logger:    logger,
 	}
 }
 
@@ -50,16 +52,20 @@ func (s *PuzzleDedupStore) IsDuplicate(ctx context.Context, signature string, ch
 
 // MarkUsed: 생성된 퍼즐을 '사용됨' 상태로 Redis Set에 등록하여 이후 중복 생성을 방지합니다.
 // 전역 관리 셋과 채팅방 관리 셋에 각각 TTL을 적용하여 저장합니다.
+// 모두 멱등 명령이므로 Valkey 페일오버 중 끊긴 요청은 재연결 후 재실행됩니다.
 func (s *PuzzleDedupStore) MarkUsed(ctx context.Context, signature string, chatID string) error {
 	globalKey := tsconfig.RedisKeyPuzzleGlobal
 	chatKey := puzzleChatKey(chatID)
 
-	saddGlobalCmd := s.client.B().Sadd().Key(globalKey).Member(signature).Build()
-	saddChatCmd := s.client.B().Sadd().Key(chatKey).Member(signature).Build()
-	expireGlobalCmd := s.client.B().Expire().Key(globalKey).Seconds(int64(tsconfig.PuzzleDedupGlobalTTLSeconds)).Build()
-	expireChatCmd := s.client.B().Expire().Key(chatKey).Seconds(int64(tsconfig.PuzzleDedupChatTTLSeconds)).Build()
+	saddGlobalCmd := s.resilient.B().Sadd().Key(globalKey).Member(signature).Build()
+	saddChatCmd := s.resilient.B().Sadd().Key(chatKey).Member(signature).Build()
+	expireGlobalCmd := s.resilient.B().Expire().Key(globalKey).Seconds(int64(tsconfig.PuzzleDedupGlobalTTLSeconds)).Build()
+	expireChatCmd := s.resilient.B().Expire().Key(chatKey).Seconds(int64(tsconfig.PuzzleDedupChatTTLSeconds)).Build()
 
-	results := s.client.DoMulti(ctx, saddGlobalCmd, saddChatCmd, expireGlobalCmd, expireChatCmd)
+	results, err := s.resilient.DoMulti(ctx, saddGlobalCmd, saddChatCmd, expireGlobalCmd, expireChatCmd)
+	if err != nil {
+		return cerrors.RedisError{Operation: "puzzle_dedup_mark", Err: err}
+	}
 	for _, r := range results {
 		if err := r.Error(); err != nil && !valkeyx.IsNil(err) {
 			return cerrors.RedisError{Operation: "puzzle_dedup_mark", Err: err}
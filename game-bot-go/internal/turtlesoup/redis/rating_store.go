@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+// RatingStore: 게임 종료 후 평가를 받을 퍼즐을 채팅방 단위로 Redis에 저장하는 저장소
+type RatingStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewRatingStore: 새로운 RatingStore 인스턴스를 생성합니다.
+func NewRatingStore(client valkey.Client, logger *slog.Logger) *RatingStore {
+	return &RatingStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Open: 평가 대상을 저장합니다. 같은 방의 이전 대상은 덮어쓰며 평가 시간이 지나면 만료됩니다.
+func (s *RatingStore) Open(ctx context.Context, chatID string, target tsmodel.RatingTarget) error {
+	payload, err := json.Marshal(target)
+	if err != nil {
+		return cerrors.RedisError{Operation: "rating_marshal", Err: err}
+	}
+	ttl := time.Duration(tsconfig.RatingWindowTTLSeconds) * time.Second
+	cmd := s.client.B().Set().Key(ratingKey(chatID)).Value(string(payload)).Ex(ttl).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "rating_open", Err: err}
+	}
+	s.logger.Debug("rating_opened", "chat_id", chatID, "puzzle_id", target.PuzzleID)
+	return nil
+}
+
+// Get: 평가 대상을 조회합니다. 평가 시간이 지났거나 없으면 nil을 반환합니다.
+// 여러 플레이어가 각자 평가할 수 있도록 조회해도 삭제하지 않습니다.
+func (s *RatingStore) Get(ctx context.Context, chatID string) (*tsmodel.RatingTarget, error) {
+	cmd := s.client.B().Get().Key(ratingKey(chatID)).Build()
+	raw, err := s.client.Do(ctx, cmd).ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "rating_get", Err: err}
+	}
+
+	var target tsmodel.RatingTarget
+	if err := json.Unmarshal([]byte(raw), &target); err != nil {
+		return nil, cerrors.RedisError{Operation: "rating_unmarshal", Err: err}
+	}
+	return &target, nil
+}
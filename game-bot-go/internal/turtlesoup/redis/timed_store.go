@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+)
+
+// TimedEntry: 마감 시각을 추적 중인 타임어택 세션 항목
+type TimedEntry struct {
+	SessionID string
+	Deadline  time.Time
+}
+
+// TimedStore: 타임어택 세션의 마감 시각과 남은 시간 경고 발송 여부를 Redis에 저장하는 저장소
+// 마감 시각은 sorted set(score=unix ms)에 보관하므로 스케줄러가 세션 키를 스캔하지 않고 진행 중인 타임어택 게임만 조회합니다.
+type TimedStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewTimedStore: 새로운 TimedStore 인스턴스를 생성합니다.
+func NewTimedStore(client valkey.Client, logger *slog.Logger) *TimedStore {
+	return &TimedStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Track: 세션의 마감 시각을 등록합니다. 이미 등록된 세션이면 마감 시각을 갱신합니다.
+func (s *TimedStore) Track(ctx context.Context, sessionID string, deadline time.Time) error {
+	cmd := s.client.B().Zadd().Key(timedDeadlinesKey()).ScoreMember().
+		ScoreMember(float64(deadline.UnixMilli()), sessionID).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "timed_track", Err: err}
+	}
+	s.logger.Debug("timed_session_tracked", "session_id", sessionID, "deadline", deadline)
+	return nil
+}
+
+// Untrack: 세션을 마감 추적 대상에서 제외합니다.
+func (s *TimedStore) Untrack(ctx context.Context, sessionID string) error {
+	cmd := s.client.B().Zrem().Key(timedDeadlinesKey()).Member(sessionID).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "timed_untrack", Err: err}
+	}
+	return nil
+}
+
+// List: 추적 중인 타임어택 세션을 마감 시각이 빠른 순서로 반환합니다.
+func (s *TimedStore) List(ctx context.Context) ([]TimedEntry, error) {
+	cmd := s.client.B().Zrange().Key(timedDeadlinesKey()).Min("0").Max("-1").Withscores().Build()
+	scores, err := s.client.Do(ctx, cmd).AsZScores()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "timed_list", Err: err}
+	}
+
+	entries := make([]TimedEntry, 0, len(scores))
+	for _, z := range scores {
+		entries = append(entries, TimedEntry{
+			SessionID: z.Member,
+			Deadline:  time.UnixMilli(int64(z.Score)),
+		})
+	}
+	return entries, nil
+}
+
+// MarkWarned: 해당 마감 시각/경고 구간에 대한 발송 기록을 남깁니다.
+// 처음 기록한 경우에만 true를 반환하므로 여러 인스턴스가 동시에 돌아도 경고는 한 번만 전송됩니다.
+func (s *TimedStore) MarkWarned(ctx context.Context, sessionID string, deadline time.Time, threshold time.Duration) (bool, error) {
+	key := timedWarnedKey(sessionID, deadline.Unix(), int64(threshold/time.Second))
+	cmd := s.client.B().Set().Key(key).Value("1").Nx().ExSeconds(tsconfig.TimedWarningMarkTTLSeconds).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		if valkeyx.IsNil(err) {
+			return false, nil
+		}
+		return false, cerrors.RedisError{Operation: "timed_mark_warned", Err: err}
+	}
+	return true, nil
+}
@@ -0,0 +1,92 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+)
+
+func TestTimedStore_TrackListUntrack(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, tsconfig.RedisKeyPrefix+":")
+
+	store := NewTimedStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Millisecond)
+	late := prefix + "room_late"
+	early := prefix + "room_early"
+	if err := store.Track(ctx, late, now.Add(5*time.Minute)); err != nil {
+		t.Fatalf("track failed: %v", err)
+	}
+	if err := store.Track(ctx, early, now.Add(time.Minute)); err != nil {
+		t.Fatalf("track failed: %v", err)
+	}
+
+	entries, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	var mine []TimedEntry
+	for _, e := range entries {
+		if e.SessionID == late || e.SessionID == early {
+			mine = append(mine, e)
+		}
+	}
+	if len(mine) != 2 || mine[0].SessionID != early || mine[1].SessionID != late {
+		t.Fatalf("expected entries ordered by deadline, got %+v", mine)
+	}
+	if !mine[0].Deadline.Equal(now.Add(time.Minute)) {
+		t.Errorf("expected deadline %v, got %v", now.Add(time.Minute), mine[0].Deadline)
+	}
+
+	if err := store.Untrack(ctx, early); err != nil {
+		t.Fatalf("untrack failed: %v", err)
+	}
+	entries, err = store.List(ctx)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	for _, e := range entries {
+		if e.SessionID == early {
+			t.Fatalf("expected %s to be untracked", early)
+		}
+	}
+}
+
+func TestTimedStore_MarkWarnedOnce(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, tsconfig.RedisKeyPrefix+":")
+
+	store := NewTimedStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	sessionID := prefix + "room_warn"
+	deadline := time.Now().Add(3 * time.Minute)
+
+	first, err := store.MarkWarned(ctx, sessionID, deadline, time.Minute)
+	if err != nil || !first {
+		t.Fatalf("expected first mark, got %v (err=%v)", first, err)
+	}
+	again, err := store.MarkWarned(ctx, sessionID, deadline, time.Minute)
+	if err != nil || again {
+		t.Fatalf("expected duplicate mark to be rejected, got %v (err=%v)", again, err)
+	}
+	other, err := store.MarkWarned(ctx, sessionID, deadline, 30*time.Second)
+	if err != nil || !other {
+		t.Fatalf("expected other threshold to be marked, got %v (err=%v)", other, err)
+	}
+	// 같은 방의 다음 게임(다른 마감 시각)은 새로 경고해야 함
+	next, err := store.MarkWarned(ctx, sessionID, deadline.Add(time.Hour), time.Minute)
+	if err != nil || !next {
+		t.Fatalf("expected new deadline to be marked, got %v (err=%v)", next, err)
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// QueueGeneratedPuzzle: LLM이 생성한 퍼즐을 검수 대기(pending_review) 상태로 저장하고 퍼즐 ID를 반환합니다.
+// 같은 내용(signature)의 퍼즐이 이미 있으면 새로 만들지 않고 기존 퍼즐 ID를 반환합니다. (반려된 퍼즐도 다시 올리지 않음)
+func (r *Repository) QueueGeneratedPuzzle(ctx context.Context, puzzle *Puzzle, signature string) (uint64, error) {
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("db is nil")
+	}
+	if signature == "" {
+		return 0, fmt.Errorf("signature is empty")
+	}
+
+	puzzle.Status = PuzzleStatusPendingReview
+	puzzle.Source = PuzzleSourceGenerated
+	puzzle.Signature = &signature
+	if puzzle.HintsJSON == "" {
+		puzzle.HintsJSON = "[]"
+	}
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "signature"}}, DoNothing: true}).
+		Create(puzzle)
+	if result.Error != nil {
+		return 0, fmt.Errorf("queue generated puzzle failed: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return puzzle.ID, nil
+	}
+
+	var existing Puzzle
+	if err := r.db.WithContext(ctx).Select("id").Where("signature = ?", signature).Take(&existing).Error; err != nil {
+		return 0, fmt.Errorf("find queued puzzle failed: %w", err)
+	}
+	return existing.ID, nil
+}
+
+// PuzzleReview: 검수 결과 (승인 시 함께 반영할 수정 내용 포함)
+type PuzzleReview struct {
+	Status     string // published 또는 rejected
+	ReviewedBy string
+	Note       string
+	// Edits: 승인하면서 고친 내용 (nil이면 생성된 내용 그대로)
+	Edits map[string]any
+}
+
+// ReviewPuzzle: 검수 대기 중인 퍼즐을 승인하거나 반려합니다.
+// 검수 대기 상태에서만 바꾸므로 두 관리자가 동시에 처리해도 한 번만 반영되며, 이미 처리된 퍼즐이면 false를 반환합니다.
+func (r *Repository) ReviewPuzzle(ctx context.Context, id uint64, review PuzzleReview, at time.Time) (bool, error) {
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("db is nil")
+	}
+
+	updates := map[string]any{
+		"status":      review.Status,
+		"reviewed_at": at.UTC(),
+		"reviewed_by": review.ReviewedBy,
+		"review_note": review.Note,
+	}
+	for column, value := range review.Edits {
+		updates[column] = value
+	}
+
+	result := r.db.WithContext(ctx).Model(&Puzzle{}).
+		Where("id = ? AND status = ?", id, PuzzleStatusPendingReview).
+		Updates(updates)
+	if result.Error != nil {
+		return false, fmt.Errorf("review puzzle failed: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
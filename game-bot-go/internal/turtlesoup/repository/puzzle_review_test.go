@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestQueueGeneratedPuzzle_DedupesBySignature(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	first, err := repo.QueueGeneratedPuzzle(ctx, &Puzzle{Title: "t", Scenario: "s", Solution: "a", Category: "MYSTERY", Difficulty: 3}, "sig-1")
+	if err != nil || first == 0 {
+		t.Fatalf("queue failed: id=%d err=%v", first, err)
+	}
+	again, err := repo.QueueGeneratedPuzzle(ctx, &Puzzle{Title: "t", Scenario: "s", Solution: "a", Category: "MYSTERY", Difficulty: 3}, "sig-1")
+	if err != nil || again != first {
+		t.Fatalf("expected existing puzzle %d, got %d (%v)", first, again, err)
+	}
+
+	puzzles, total, err := repo.ListPuzzles(ctx, PuzzleStatusPendingReview, 10, 0)
+	if err != nil || total != 1 {
+		t.Fatalf("expected one pending puzzle, got %d (%v)", total, err)
+	}
+	if puzzles[0].Source != PuzzleSourceGenerated || puzzles[0].HintsJSON != "[]" {
+		t.Fatalf("unexpected queued puzzle: %+v", puzzles[0])
+	}
+
+	// 직접 등록한 퍼즐은 signature가 NULL이라 여러 개여도 충돌하지 않음
+	for range 2 {
+		if err := repo.CreatePuzzle(ctx, &Puzzle{Title: "m", Scenario: "s", Solution: "a", Status: PuzzleStatusDraft}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := repo.GetPuzzleStats(ctx)
+	if err != nil || stats.PendingCount != 1 || stats.DraftCount != 2 {
+		t.Fatalf("unexpected puzzle stats: %+v (%v)", stats, err)
+	}
+}
+
+func TestReviewPuzzle_OnlyPendingOnce(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	id, err := repo.QueueGeneratedPuzzle(ctx, &Puzzle{Title: "t", Scenario: "s", Solution: "a", Difficulty: 2}, "sig-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := repo.ReviewPuzzle(ctx, id, PuzzleReview{
+		Status:     PuzzleStatusPublished,
+		ReviewedBy: "admin",
+		Edits:      map[string]any{"title": "고친 제목", "difficulty": 4},
+	}, time.Now())
+	if err != nil || !ok {
+		t.Fatalf("expected approval, got ok=%v err=%v", ok, err)
+	}
+
+	puzzle, err := repo.GetPuzzle(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if puzzle.Status != PuzzleStatusPublished || puzzle.Title != "고친 제목" || puzzle.Difficulty != 4 || puzzle.ReviewedAt == nil || puzzle.ReviewedBy != "admin" {
+		t.Fatalf("unexpected reviewed puzzle: %+v", puzzle)
+	}
+
+	// 승인된 퍼즐은 공개 풀에서 출제 대상이 됨
+	picked, err := repo.GetRandomPublishedPuzzle(ctx, "", 4)
+	if err != nil || picked.ID != id {
+		t.Fatalf("expected approved puzzle in catalog, got %+v (%v)", picked, err)
+	}
+
+	ok, err = repo.ReviewPuzzle(ctx, id, PuzzleReview{Status: PuzzleStatusRejected}, time.Now())
+	if err != nil || ok {
+		t.Fatalf("expected second review to be ignored, got ok=%v err=%v", ok, err)
+	}
+}
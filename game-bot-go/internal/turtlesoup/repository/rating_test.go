@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *Repository {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	repo := New(db)
+	if err := repo.AutoMigrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+func TestRatePuzzle_UpsertsAndAggregates(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	good := &Puzzle{Title: "good", Scenario: "s", Solution: "a", Status: "published", Difficulty: 3}
+	bad := &Puzzle{Title: "bad", Scenario: "s", Solution: "a", Status: "published", Difficulty: 3}
+	for _, p := range []*Puzzle{good, bad} {
+		if err := repo.CreatePuzzle(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ratings := []RatePuzzleParams{
+		{PuzzleID: good.ID, SessionID: "s1", UserID: "u1", ChatID: "c", Rating: 2},
+		{PuzzleID: good.ID, SessionID: "s1", UserID: "u1", ChatID: "c", Rating: 5}, // 같은 게임 재평가는 덮어씀
+		{PuzzleID: good.ID, SessionID: "s1", UserID: "u2", ChatID: "c", Rating: 4},
+		{PuzzleID: bad.ID, SessionID: "s2", UserID: "u1", ChatID: "c", Rating: 1},
+	}
+	for _, p := range ratings {
+		if err := repo.RatePuzzle(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := repo.GetPuzzleRatingStats(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 2 || stats[0].PuzzleID != good.ID || stats[0].RatingCount != 2 || stats[0].AvgRating != 4.5 {
+		t.Fatalf("unexpected rating stats: %+v", stats)
+	}
+
+	overall, err := repo.GetPuzzleStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overall.TotalRatings != 3 {
+		t.Fatalf("expected 3 ratings, got %d", overall.TotalRatings)
+	}
+}
+
+func TestGetRandomPublishedPuzzle_PrefersHigherRated(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	if _, err := repo.GetRandomPublishedPuzzle(ctx, "", 0); err != gorm.ErrRecordNotFound {
+		t.Fatalf("expected ErrRecordNotFound without published puzzles, got %v", err)
+	}
+
+	good := &Puzzle{Title: "good", Scenario: "s", Solution: "a", Status: "published", Difficulty: 3}
+	bad := &Puzzle{Title: "bad", Scenario: "s", Solution: "a", Status: "published", Difficulty: 3}
+	draft := &Puzzle{Title: "draft", Scenario: "s", Solution: "a", Status: "draft", Difficulty: 3}
+	for _, p := range []*Puzzle{good, bad, draft} {
+		if err := repo.CreatePuzzle(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, user := range []string{"u1", "u2", "u3", "u4", "u5", "u6"} {
+		if err := repo.RatePuzzle(ctx, RatePuzzleParams{PuzzleID: good.ID, SessionID: "s", UserID: user, Rating: 5}); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.RatePuzzle(ctx, RatePuzzleParams{PuzzleID: bad.ID, SessionID: "s", UserID: user, Rating: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	picks := map[uint64]int{}
+	for range 400 {
+		p, err := repo.GetRandomPublishedPuzzle(ctx, "", 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		picks[p.ID]++
+	}
+	if picks[draft.ID] != 0 {
+		t.Fatalf("draft puzzle must not be selected: %v", picks)
+	}
+	// 가중치 4.5 : 1.5 이므로 기대 비율은 3:1
+	if picks[good.ID] <= 2*picks[bad.ID] {
+		t.Fatalf("expected higher-rated puzzle to dominate, got %v", picks)
+	}
+}
+
+func TestRatingWeight_ShrinksTowardPrior(t *testing.T) {
+	if w := ratingWeight(0, 0); w != ratingPriorMean {
+		t.Fatalf("unrated weight = %v, want %v", w, ratingPriorMean)
+	}
+	if w := ratingWeight(5, 1); w <= ratingPriorMean || w >= 5 {
+		t.Fatalf("single 5-star rating should be pulled toward prior, got %v", w)
+	}
+}
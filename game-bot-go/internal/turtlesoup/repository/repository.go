@@ -3,9 +3,13 @@ package repository
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 )
 
 // Repository: TurtleSoup DB 리포지토리
@@ -18,15 +22,21 @@ func New(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
+// Models: AutoMigrate 대상 모델 목록 (헬스체크 스키마 점검과 공유)
+func Models() []any {
+	return append([]any{
+		&GameArchive{},
+		&Puzzle{},
+		&PuzzleRating{},
+	}, latency.Models()...)
+}
+
 // AutoMigrate: DB 테이블 스키마 자동 마이그레이션
 func (r *Repository) AutoMigrate(ctx context.Context) error {
 	if r == nil || r.db == nil {
 		return fmt.Errorf("db is nil")
 	}
-	if err := r.db.WithContext(ctx).AutoMigrate(
-		&GameArchive{},
-		&Puzzle{},
-	); err != nil {
+	if err := r.db.WithContext(ctx).AutoMigrate(Models()...); err != nil {
 		return fmt.Errorf("auto migrate failed: %w", err)
 	}
 	return nil
@@ -34,21 +44,42 @@ func (r *Repository) AutoMigrate(ctx context.Context) error {
 
 // GameArchive: 게임 아카이브 (세션 종료 시 저장)
 type GameArchive struct {
-	ID            uint64    `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
-	SessionID     string    `gorm:"column:session_id;not null;uniqueIndex" json:"sessionId"`
-	ChatID        string    `gorm:"column:chat_id;not null;index" json:"chatId"`
-	PuzzleID      *uint64   `gorm:"column:puzzle_id;index" json:"puzzleId,omitempty"`
-	QuestionCount int       `gorm:"column:question_count;not null;default:0" json:"questionCount"`
-	HintsUsed     int       `gorm:"column:hints_used;not null;default:0" json:"hintsUsed"`
-	Result        string    `gorm:"column:result;not null;index" json:"result"` // solved, surrendered, timeout
-	HistoryJSON   string    `gorm:"column:history_json;type:jsonb" json:"historyJson"`
-	StartedAt     time.Time `gorm:"column:started_at;not null" json:"startedAt"`
-	CompletedAt   time.Time `gorm:"column:completed_at;not null;index" json:"completedAt"`
-	CreatedAt     time.Time `gorm:"column:created_at;not null;autoCreateTime" json:"createdAt"`
+	ID            uint64  `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	SessionID     string  `gorm:"column:session_id;not null;uniqueIndex" json:"sessionId"`
+	ChatID        string  `gorm:"column:chat_id;not null;index" json:"chatId"`
+	PuzzleID      *uint64 `gorm:"column:puzzle_id;index" json:"puzzleId,omitempty"`
+	QuestionCount int     `gorm:"column:question_count;not null;default:0" json:"questionCount"`
+	HintsUsed     int     `gorm:"column:hints_used;not null;default:0" json:"hintsUsed"`
+	Result        string  `gorm:"column:result;not null;index" json:"result"`       // solved, surrendered, timeout
+	BonusResult   string  `gorm:"column:bonus_result" json:"bonusResult,omitempty"` // correct, close, incorrect, skipped
+	BonusPoints   int     `gorm:"column:bonus_points;not null;default:0" json:"bonusPoints"`
+	HistoryJSON   string  `gorm:"column:history_json;type:jsonb" json:"historyJson"`
+	// ContributionsJSON: 플레이어별 기여 점수 목록 (model.PlayerContribution, 점수 내림차순)
+	ContributionsJSON string    `gorm:"column:contributions_json;type:jsonb;default:'[]'" json:"contributionsJson"`
+	StartedAt         time.Time `gorm:"column:started_at;not null" json:"startedAt"`
+	CompletedAt       time.Time `gorm:"column:completed_at;not null;index" json:"completedAt"`
+	CreatedAt         time.Time `gorm:"column:created_at;not null;autoCreateTime" json:"createdAt"`
 }
 
 func (GameArchive) TableName() string { return "turtle_game_archives" }
 
+// 퍼즐 상태: 공개(published) 퍼즐만 게임에 출제됩니다.
+const (
+	PuzzleStatusDraft     = "draft"
+	PuzzleStatusTest      = "test"
+	PuzzleStatusPublished = "published"
+	// PuzzleStatusPendingReview: LLM이 생성해 검수를 기다리는 퍼즐
+	PuzzleStatusPendingReview = "pending_review"
+	// PuzzleStatusRejected: 검수에서 반려된 생성 퍼즐 (같은 퍼즐이 다시 대기열에 오르지 않도록 남겨둠)
+	PuzzleStatusRejected = "rejected"
+)
+
+// 퍼즐 출처
+const (
+	PuzzleSourceManual    = "manual"
+	PuzzleSourceGenerated = "generated"
+)
+
 // Puzzle: 퍼즐 시나리오 CMS
 type Puzzle struct {
 	ID          uint64    `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
@@ -58,17 +89,37 @@ type Puzzle struct {
 	Category    string    `gorm:"column:category;not null;index;default:'MYSTERY'" json:"category"`
 	Difficulty  int       `gorm:"column:difficulty;not null;default:3" json:"difficulty"`
 	HintsJSON   string    `gorm:"column:hints_json;type:jsonb;default:'[]'" json:"hintsJson"`
-	Status      string    `gorm:"column:status;not null;index;default:'draft'" json:"status"` // draft, test, published
+	Status      string    `gorm:"column:status;not null;index;default:'draft'" json:"status"` // draft, test, published, pending_review, rejected
 	AuthorID    string    `gorm:"column:author_id" json:"authorId"`
 	PlayCount   int       `gorm:"column:play_count;not null;default:0" json:"playCount"`
 	SolveCount  int       `gorm:"column:solve_count;not null;default:0" json:"solveCount"`
 	AvgQuestion float64   `gorm:"column:avg_question;not null;default:0" json:"avgQuestion"`
 	CreatedAt   time.Time `gorm:"column:created_at;not null;autoCreateTime" json:"createdAt"`
 	UpdatedAt   time.Time `gorm:"column:updated_at;not null;autoUpdateTime" json:"updatedAt"`
+	// 생성 퍼즐 검수: Signature는 생성 결과의 내용 해시로, 같은 퍼즐이 대기열에 두 번 쌓이지 않게 함 (직접 등록한 퍼즐은 NULL)
+	Source     string     `gorm:"column:source;not null;default:'manual'" json:"source"` // manual, generated
+	Signature  *string    `gorm:"column:signature;uniqueIndex" json:"-"`
+	ReviewedAt *time.Time `gorm:"column:reviewed_at" json:"reviewedAt,omitempty"`
+	ReviewedBy string     `gorm:"column:reviewed_by" json:"reviewedBy,omitempty"`
+	ReviewNote string     `gorm:"column:review_note" json:"reviewNote,omitempty"`
 }
 
 func (Puzzle) TableName() string { return "turtle_puzzles" }
 
+// PuzzleRating: 게임 종료 후 플레이어가 남긴 퍼즐 평점 (게임당 플레이어 1건, 다시 평가하면 덮어씀)
+type PuzzleRating struct {
+	ID        uint64    `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PuzzleID  uint64    `gorm:"column:puzzle_id;not null;index;uniqueIndex:idx_turtle_puzzle_ratings_game_user" json:"puzzleId"`
+	SessionID string    `gorm:"column:session_id;not null;uniqueIndex:idx_turtle_puzzle_ratings_game_user" json:"sessionId"`
+	UserID    string    `gorm:"column:user_id;not null;uniqueIndex:idx_turtle_puzzle_ratings_game_user" json:"userId"`
+	ChatID    string    `gorm:"column:chat_id;not null" json:"chatId"`
+	Rating    int       `gorm:"column:rating;not null" json:"rating"` // 1~5
+	CreatedAt time.Time `gorm:"column:created_at;not null;autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null;autoUpdateTime" json:"updatedAt"`
+}
+
+func (PuzzleRating) TableName() string { return "turtle_puzzle_ratings" }
+
 // ArchiveGameParams: 게임 아카이브 파라미터
 type ArchiveGameParams struct {
 	SessionID     string
@@ -77,9 +128,13 @@ type ArchiveGameParams struct {
 	QuestionCount int
 	HintsUsed     int
 	Result        string
+	BonusResult   string
+	BonusPoints   int
 	HistoryJSON   string
-	StartedAt     time.Time
-	CompletedAt   time.Time
+	// ContributionsJSON: 비어 있으면 빈 목록으로 저장
+	ContributionsJSON string
+	StartedAt         time.Time
+	CompletedAt       time.Time
 }
 
 // ArchiveGame: 게임 결과를 PostgreSQL에 아카이브
@@ -88,16 +143,23 @@ func (r *Repository) ArchiveGame(ctx context.Context, p ArchiveGameParams) error
 		return fmt.Errorf("db is nil")
 	}
 
+	if p.ContributionsJSON == "" {
+		p.ContributionsJSON = "[]"
+	}
+
 	archive := GameArchive{
-		SessionID:     p.SessionID,
-		ChatID:        p.ChatID,
-		PuzzleID:      p.PuzzleID,
-		QuestionCount: p.QuestionCount,
-		HintsUsed:     p.HintsUsed,
-		Result:        p.Result,
-		HistoryJSON:   p.HistoryJSON,
-		StartedAt:     p.StartedAt,
-		CompletedAt:   p.CompletedAt,
+		SessionID:         p.SessionID,
+		ChatID:            p.ChatID,
+		PuzzleID:          p.PuzzleID,
+		QuestionCount:     p.QuestionCount,
+		HintsUsed:         p.HintsUsed,
+		Result:            p.Result,
+		BonusResult:       p.BonusResult,
+		BonusPoints:       p.BonusPoints,
+		HistoryJSON:       p.HistoryJSON,
+		ContributionsJSON: p.ContributionsJSON,
+		StartedAt:         p.StartedAt,
+		CompletedAt:       p.CompletedAt,
 	}
 
 	if err := r.db.WithContext(ctx).Create(&archive).Error; err != nil {
@@ -175,22 +237,126 @@ func (r *Repository) DeletePuzzle(ctx context.Context, id uint64) error {
 	return nil
 }
 
-// GetRandomPublishedPuzzle: 랜덤 공개 퍼즐 조회
-func (r *Repository) GetRandomPublishedPuzzle(ctx context.Context, category string) (*Puzzle, error) {
+// 평점 가중치 보정: 평가가 적은 퍼즐은 중간 점수(3점) 쪽으로 당겨서 한두 건의 평가로 선택 확률이 크게 흔들리지 않게 함
+const (
+	ratingPriorMean  = 3.0
+	ratingPriorCount = 2.0
+)
+
+// ratingWeight: 평점 합계와 건수로 퍼즐 선택 가중치(보정된 평균 평점, 1~5)를 계산합니다.
+func ratingWeight(sum int64, count int64) float64 {
+	return (float64(sum) + ratingPriorMean*ratingPriorCount) / (float64(count) + ratingPriorCount)
+}
+
+// GetRandomPublishedPuzzle: 공개 퍼즐 중 하나를 평점 가중 무작위로 조회 (평점이 높을수록 자주 선택)
+// category가 빈 문자열이거나 difficulty가 0이면 해당 조건으로 거르지 않습니다.
+func (r *Repository) GetRandomPublishedPuzzle(ctx context.Context, category string, difficulty int) (*Puzzle, error) {
 	if r == nil || r.db == nil {
 		return nil, fmt.Errorf("db is nil")
 	}
 
-	query := r.db.WithContext(ctx).Model(&Puzzle{}).Where("status = ?", "published")
+	type candidate struct {
+		ID          uint64 `gorm:"column:id"`
+		RatingSum   int64  `gorm:"column:rating_sum"`
+		RatingCount int64  `gorm:"column:rating_count"`
+	}
+
+	query := r.db.WithContext(ctx).Model(&Puzzle{}).
+		Select("turtle_puzzles.id, coalesce(sum(r.rating), 0) as rating_sum, count(r.id) as rating_count").
+		Joins("LEFT JOIN turtle_puzzle_ratings r ON r.puzzle_id = turtle_puzzles.id").
+		Where("turtle_puzzles.status = ?", PuzzleStatusPublished)
 	if category != "" {
-		query = query.Where("category = ?", category)
+		query = query.Where("turtle_puzzles.category = ?", category)
+	}
+	if difficulty > 0 {
+		query = query.Where("turtle_puzzles.difficulty = ?", difficulty)
 	}
 
-	var puzzle Puzzle
-	if err := query.Order("random()").First(&puzzle).Error; err != nil {
-		return nil, err
+	var candidates []candidate
+	if err := query.Group("turtle_puzzles.id").Scan(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("list puzzle candidates failed: %w", err)
 	}
-	return &puzzle, nil
+	if len(candidates) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	weights := make([]float64, len(candidates))
+	total := 0.0
+	for i, c := range candidates {
+		weights[i] = ratingWeight(c.RatingSum, c.RatingCount)
+		total += weights[i]
+	}
+
+	picked := candidates[len(candidates)-1].ID
+	target := rand.Float64() * total
+	for i, w := range weights {
+		if target < w {
+			picked = candidates[i].ID
+			break
+		}
+		target -= w
+	}
+	return r.GetPuzzle(ctx, picked)
+}
+
+// RatePuzzleParams: 퍼즐 평가 파라미터
+type RatePuzzleParams struct {
+	PuzzleID  uint64
+	SessionID string
+	UserID    string
+	ChatID    string
+	Rating    int
+}
+
+// RatePuzzle: 퍼즐 평점을 저장합니다. 같은 게임에서 같은 플레이어가 다시 평가하면 점수를 갱신합니다.
+func (r *Repository) RatePuzzle(ctx context.Context, p RatePuzzleParams) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("db is nil")
+	}
+
+	rating := PuzzleRating{
+		PuzzleID:  p.PuzzleID,
+		SessionID: p.SessionID,
+		UserID:    p.UserID,
+		ChatID:    p.ChatID,
+		Rating:    p.Rating,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "puzzle_id"}, {Name: "session_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "updated_at"}),
+	}).Create(&rating).Error
+	if err != nil {
+		return fmt.Errorf("rate puzzle failed: %w", err)
+	}
+	return nil
+}
+
+// PuzzleRatingStats: 퍼즐별 평점 집계
+type PuzzleRatingStats struct {
+	PuzzleID    uint64  `gorm:"column:puzzle_id" json:"puzzleId"`
+	Title       string  `gorm:"column:title" json:"title"`
+	Status      string  `gorm:"column:status" json:"status"`
+	RatingCount int64   `gorm:"column:rating_count" json:"ratingCount"`
+	AvgRating   float64 `gorm:"column:avg_rating" json:"avgRating"`
+}
+
+// GetPuzzleRatingStats: 평가가 있는 퍼즐의 평균 평점을 높은 순으로 조회
+func (r *Repository) GetPuzzleRatingStats(ctx context.Context, limit int) ([]PuzzleRatingStats, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+
+	var stats []PuzzleRatingStats
+	if err := r.db.WithContext(ctx).Model(&PuzzleRating{}).
+		Select("turtle_puzzle_ratings.puzzle_id, p.title, p.status, count(*) as rating_count, avg(turtle_puzzle_ratings.rating) as avg_rating").
+		Joins("JOIN turtle_puzzles p ON p.id = turtle_puzzle_ratings.puzzle_id").
+		Group("turtle_puzzle_ratings.puzzle_id, p.title, p.status").
+		Order("avg_rating DESC, rating_count DESC").
+		Limit(limit).
+		Scan(&stats).Error; err != nil {
+		return nil, fmt.Errorf("get puzzle rating stats failed: %w", err)
+	}
+	return stats, nil
 }
 
 // PuzzleStatsResult: 퍼즐 통계 결과
@@ -198,9 +364,12 @@ type PuzzleStatsResult struct {
 	TotalPuzzles     int64   `json:"totalPuzzles"`
 	PublishedCount   int64   `json:"publishedCount"`
 	DraftCount       int64   `json:"draftCount"`
+	PendingCount     int64   `json:"pendingReviewCount"`
 	TotalPlays       int64   `json:"totalPlays"`
 	TotalSolves      int64   `json:"totalSolves"`
 	OverallSolveRate float64 `json:"overallSolveRate"`
+	TotalRatings     int64   `json:"totalRatings"`
+	AvgRating        float64 `json:"avgRating"`
 }
 
 // GetPuzzleStats: 퍼즐 전체 통계
@@ -213,8 +382,9 @@ func (r *Repository) GetPuzzleStats(ctx context.Context) (*PuzzleStatsResult, er
 
 	// 퍼즐 수 통계
 	r.db.WithContext(ctx).Model(&Puzzle{}).Count(&result.TotalPuzzles)
-	r.db.WithContext(ctx).Model(&Puzzle{}).Where("status = ?", "published").Count(&result.PublishedCount)
-	r.db.WithContext(ctx).Model(&Puzzle{}).Where("status = ?", "draft").Count(&result.DraftCount)
+	r.db.WithContext(ctx).Model(&Puzzle{}).Where("status = ?", PuzzleStatusPublished).Count(&result.PublishedCount)
+	r.db.WithContext(ctx).Model(&Puzzle{}).Where("status = ?", PuzzleStatusDraft).Count(&result.DraftCount)
+	r.db.WithContext(ctx).Model(&Puzzle{}).Where("status = ?", PuzzleStatusPendingReview).Count(&result.PendingCount)
 
 	// 아카이브 통계
 	var archiveStats struct {
@@ -232,6 +402,20 @@ func (r *Repository) GetPuzzleStats(ctx context.Context) (*PuzzleStatsResult, er
 		result.OverallSolveRate = float64(result.TotalSolves) / float64(result.TotalPlays) * 100
 	}
 
+	// 평점 통계
+	var ratingStats struct {
+		TotalRatings int64    `gorm:"column:total_ratings"`
+		AvgRating    *float64 `gorm:"column:avg_rating"`
+	}
+	r.db.WithContext(ctx).Model(&PuzzleRating{}).
+		Select("count(*) as total_ratings, avg(rating) as avg_rating").
+		Scan(&ratingStats)
+
+	result.TotalRatings = ratingStats.TotalRatings
+	if ratingStats.AvgRating != nil {
+		result.AvgRating = *ratingStats.AvgRating
+	}
+
 	return result, nil
 }
 
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+	tssecurity "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/security"
+)
+
+// archiveResultSolved: 정답으로 끝난 게임의 GameArchive.Result 값
+const archiveResultSolved = "solved"
+
+// BonusRoundService: 정답 직후 후일담과 보너스 미니 질문을 제안하고, 답변/건너뛰기 결과를 점수와 아카이브에 반영합니다.
+// 보너스 라운드는 선택 사항이므로 후일담 생성에 실패해도 정답 처리는 그대로 진행됩니다.
+type BonusRoundService struct {
+	restClient     *llmrest.Client
+	store          *tsredis.BonusStore
+	injectionGuard tssecurity.InjectionGuard
+	archiver       GameArchiver
+	events         *eventbus.Publisher
+	logger         *slog.Logger
+}
+
+// NewBonusRoundService: BonusRoundService 인스턴스를 생성합니다. archiver가 nil이면 아카이브를 건너뜁니다.
+func NewBonusRoundService(
+	restClient *llmrest.Client,
+	store *tsredis.BonusStore,
+	injectionGuard tssecurity.InjectionGuard,
+	archiver GameArchiver,
+	events *eventbus.Publisher,
+	logger *slog.Logger,
+) *BonusRoundService {
+	return &BonusRoundService{
+		restClient:     restClient,
+		store:          store,
+		injectionGuard: injectionGuard,
+		archiver:       archiver,
+		events:         events,
+		logger:         logger,
+	}
+}
+
+// Offer: 풀린 게임의 후일담과 보너스 질문을 생성해 저장합니다. 실패하면 로그만 남기고 nil을 반환합니다.
+func (s *BonusRoundService) Offer(ctx context.Context, state tsmodel.GameState) *tsmodel.BonusRound {
+	if s == nil || state.Puzzle == nil {
+		return nil
+	}
+
+	chatID := chatIDOf(state)
+	res, err := s.restClient.TurtleSoupGenerateEpilogue(ctx, chatID, tsconfig.LlmNamespace, state.Puzzle.Scenario, state.Puzzle.Solution)
+	if err != nil {
+		s.logger.Warn("bonus_offer_failed", "chat_id", chatID, "err", err)
+		return nil
+	}
+	if res.Epilogue == "" || res.Question == "" || res.Answer == "" {
+		s.logger.Warn("bonus_offer_empty", "chat_id", chatID)
+		return nil
+	}
+
+	round := tsmodel.BonusRound{
+		State:     state,
+		Epilogue:  res.Epilogue,
+		Question:  res.Question,
+		Answer:    res.Answer,
+		OfferedAt: time.Now(),
+	}
+	if err := s.store.Save(ctx, chatID, round); err != nil {
+		s.logger.Warn("bonus_save_failed", "chat_id", chatID, "err", err)
+		return nil
+	}
+	s.logger.Info("bonus_offered", "chat_id", chatID, "session_id", state.SessionID)
+	return &round
+}
+
+// Answer: 보너스 질문 답변을 판정하고 라운드를 종료합니다. 진행 중인 보너스 라운드가 없으면 nil을 반환합니다.
+// 판정 호출이 실패하면 라운드를 그대로 두어 다시 답할 수 있게 합니다.
+func (s *BonusRoundService) Answer(ctx context.Context, chatID string, userID string, answer string) (*tsmodel.BonusOutcome, error) {
+	if !isValidAnswer(answer) {
+		return nil, cerrors.InvalidAnswerError{Message: "invalid answer format"}
+	}
+
+	round, err := s.store.Get(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("bonus get failed: %w", err)
+	}
+	if round == nil {
+		return nil, nil
+	}
+
+	sanitized, err := s.injectionGuard.ValidateOrThrow(ctx, answer)
+	if err != nil {
+		return nil, fmt.Errorf("validate bonus answer failed: %w", err)
+	}
+	res, err := s.restClient.TurtleSoupValidateSolution(ctx, chatID, tsconfig.LlmNamespace, round.Answer, sanitized)
+	if err != nil {
+		return nil, fmt.Errorf("llm validate bonus answer failed: %w", err)
+	}
+	validation, err := tsmodel.ParseValidationResult(res.Result)
+	if err != nil {
+		return nil, fmt.Errorf("parse bonus validation failed: %w", err)
+	}
+
+	// 판정 중에 다른 요청이 라운드를 끝냈다면 점수를 주지 않음
+	taken, err := s.store.Take(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("bonus take failed: %w", err)
+	}
+	if taken == nil {
+		return nil, nil
+	}
+
+	result, points := tsmodel.BonusResultOf(validation)
+	outcome := tsmodel.BonusOutcome{Round: *taken, Result: result, Points: points}
+	s.finish(ctx, userID, outcome)
+	return &outcome, nil
+}
+
+// Skip: 보너스 라운드를 건너뛰고 종료합니다. 진행 중인 보너스 라운드가 없으면 nil을 반환합니다.
+func (s *BonusRoundService) Skip(ctx context.Context, chatID string, userID string) (*tsmodel.BonusOutcome, error) {
+	round, err := s.store.Take(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("bonus take failed: %w", err)
+	}
+	if round == nil {
+		return nil, nil
+	}
+
+	outcome := tsmodel.BonusOutcome{Round: *round, Result: tsmodel.BonusSkipped}
+	s.finish(ctx, userID, outcome)
+	return &outcome, nil
+}
+
+// finish: 보너스 결과를 이벤트로 발행하고 풀린 게임과 함께 아카이브합니다.
+func (s *BonusRoundService) finish(ctx context.Context, userID string, outcome tsmodel.BonusOutcome) {
+	state := outcome.Round.State
+	s.logger.Info("bonus_completed", "chat_id", chatIDOf(state), "session_id", state.SessionID, "result", outcome.Result, "points", outcome.Points)
+
+	s.events.PublishEvent(ctx, eventbus.Event{
+		Type:      eventbus.EventBonusCompleted,
+		ChatID:    chatIDOf(state),
+		SessionID: state.SessionID,
+		UserID:    userID,
+		Data: map[string]any{
+			"result": string(outcome.Result),
+			"points": outcome.Points,
+		},
+	})
+
+	if s.archiver == nil {
+		return
+	}
+	history, err := json.Marshal(state.History)
+	if err != nil {
+		s.logger.Warn("bonus_archive_marshal_failed", "session_id", state.SessionID, "err", err)
+		return
+	}
+	contributions, err := json.Marshal(state.ContributionScores())
+	if err != nil {
+		s.logger.Warn("bonus_archive_marshal_failed", "session_id", state.SessionID, "err", err)
+		return
+	}
+	err = s.archiver.ArchiveGame(ctx, tsrepo.ArchiveGameParams{
+		SessionID:         archiveSessionID(state),
+		ChatID:            chatIDOf(state),
+		PuzzleID:          archivePuzzleID(state),
+		QuestionCount:     state.QuestionCount,
+		HintsUsed:         state.HintsUsed,
+		Result:            archiveResultSolved,
+		BonusResult:       string(outcome.Result),
+		BonusPoints:       outcome.Points,
+		HistoryJSON:       string(history),
+		ContributionsJSON: string(contributions),
+		StartedAt:         state.StartedAt,
+		CompletedAt:       outcome.Round.OfferedAt,
+	})
+	if err != nil {
+		s.logger.Warn("bonus_archive_failed", "session_id", state.SessionID, "err", err)
+	}
+}
+
+// archiveSessionID: 세션 ID는 채팅방 단위로 재사용되므로 시작 시각을 붙여 게임별로 구분합니다.
+func archiveSessionID(state tsmodel.GameState) string {
+	return fmt.Sprintf("%s:%d", state.SessionID, state.StartedAt.UnixMilli())
+}
+
+// archivePuzzleID: CMS 퍼즐로 진행한 게임이면 퍼즐 ID를 반환합니다. (LLM 생성 퍼즐은 nil)
+func archivePuzzleID(state tsmodel.GameState) *uint64 {
+	if state.Puzzle == nil || state.Puzzle.ID == 0 {
+		return nil
+	}
+	id := state.Puzzle.ID
+	return &id
+}
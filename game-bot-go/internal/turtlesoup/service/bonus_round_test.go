@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+func TestBonusRound_OfferAnswerArchive(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "sess_bonus"
+	chatID := env.chatID("chat_bonus")
+
+	if _, err := env.svc.StartGame(ctx, sessionID, "user1", chatID, nil, nil, nil); err != nil {
+		t.Fatalf("StartGame failed: %v", err)
+	}
+
+	env.mocks.validation = &llmrest.TurtleSoupValidateResponse{Result: "YES"}
+	res, err := env.svc.SubmitAnswer(ctx, sessionID, "user1", "Tester", "The correct answer")
+	if err != nil {
+		t.Fatalf("SubmitAnswer failed: %v", err)
+	}
+	if len(res.Contributions) != 1 || !res.Contributions[0].Solved || res.Contributions[0].Score != tsmodel.ContributionPointsSolver {
+		t.Fatalf("expected solver contribution, got %+v", res.Contributions)
+	}
+	if res.Bonus == nil || res.Bonus.Question != "Where did he go?" {
+		t.Fatalf("expected bonus round offer, got %+v", res.Bonus)
+	}
+
+	env.mocks.validation = &llmrest.TurtleSoupValidateResponse{Result: "CLOSE"}
+	outcome, err := env.bonus.Answer(ctx, chatID, "user2", "the ocean")
+	if err != nil {
+		t.Fatalf("bonus answer failed: %v", err)
+	}
+	if outcome == nil || outcome.Result != tsmodel.BonusClose || outcome.Points != tsmodel.BonusPointsClose {
+		t.Fatalf("unexpected bonus outcome: %+v", outcome)
+	}
+
+	if len(env.archiver.params) != 1 {
+		t.Fatalf("expected one archive, got %d", len(env.archiver.params))
+	}
+	archived := env.archiver.params[0]
+	if archived.Result != archiveResultSolved || archived.BonusResult != "close" || archived.BonusPoints != tsmodel.BonusPointsClose || archived.ChatID != chatID {
+		t.Fatalf("unexpected archive: %+v", archived)
+	}
+	var contributions []tsmodel.PlayerContribution
+	if err := json.Unmarshal([]byte(archived.ContributionsJSON), &contributions); err != nil {
+		t.Fatalf("invalid contributions json %q: %v", archived.ContributionsJSON, err)
+	}
+	if len(contributions) != 1 || contributions[0].UserID != "user1" || contributions[0].Sender != "Tester" || !contributions[0].Solved {
+		t.Fatalf("unexpected archived contributions: %+v", contributions)
+	}
+
+	// 라운드는 한 번만 끝낼 수 있음
+	again, err := env.bonus.Answer(ctx, chatID, "user1", "the sea")
+	if err != nil || again != nil {
+		t.Fatalf("expected no bonus round after completion, got %+v, %v", again, err)
+	}
+}
+
+func TestBonusRound_Skip(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "sess_bonus_skip"
+	chatID := env.chatID("chat_bonus_skip")
+
+	if skipped, err := env.bonus.Skip(ctx, chatID, "user1"); err != nil || skipped != nil {
+		t.Fatalf("expected no bonus round before solving, got %+v, %v", skipped, err)
+	}
+
+	if _, err := env.svc.StartGame(ctx, sessionID, "user1", chatID, nil, nil, nil); err != nil {
+		t.Fatalf("StartGame failed: %v", err)
+	}
+	env.mocks.validation = &llmrest.TurtleSoupValidateResponse{Result: "YES"}
+	if _, err := env.svc.SubmitAnswer(ctx, sessionID, "", "", "The correct answer"); err != nil {
+		t.Fatalf("SubmitAnswer failed: %v", err)
+	}
+
+	outcome, err := env.bonus.Skip(ctx, chatID, "user1")
+	if err != nil {
+		t.Fatalf("bonus skip failed: %v", err)
+	}
+	if outcome == nil || outcome.Result != tsmodel.BonusSkipped || outcome.Points != 0 || outcome.Round.Answer != "The sea" {
+		t.Fatalf("unexpected skip outcome: %+v", outcome)
+	}
+	if len(env.archiver.params) != 1 || env.archiver.params[0].BonusResult != "skipped" {
+		t.Fatalf("expected skipped archive, got %+v", env.archiver.params)
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	tsmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/messages"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+// BuildContributionBlock: 플레이어별 기여 점수 목록을 게임 종료 메시지용 블록으로 만듭니다. 기록이 없으면 빈 문자열입니다.
+func BuildContributionBlock(provider *messageprovider.Provider, contributions []tsmodel.PlayerContribution) string {
+	if len(contributions) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(contributions)+1)
+	lines = append(lines, provider.Get(
+		tsmessages.ContributionHeader,
+		messageprovider.P("pointsQuestion", tsmodel.ContributionPointsQuestion),
+		messageprovider.P("pointsImportant", tsmodel.ContributionPointsImportant),
+		messageprovider.P("pointsSolver", tsmodel.ContributionPointsSolver),
+	))
+	for i, c := range contributions {
+		solver := ""
+		if c.Solved {
+			solver = provider.Get(tsmessages.ContributionSolver)
+		}
+		lines = append(lines, provider.Get(
+			tsmessages.ContributionItem,
+			messageprovider.P("rank", i+1),
+			messageprovider.P("name", c.DisplayName()),
+			messageprovider.P("score", c.Score),
+			messageprovider.P("questions", c.Questions),
+			messageprovider.P("important", c.ImportantQuestions),
+			messageprovider.P("solver", solver),
+		))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AppendContributionBlock: 기여도 블록이 있으면 메시지 뒤에 빈 줄을 두고 덧붙입니다.
+func AppendContributionBlock(provider *messageprovider.Provider, reply string, contributions []tsmodel.PlayerContribution) string {
+	block := BuildContributionBlock(provider, contributions)
+	if block == "" {
+		return reply
+	}
+	return strings.TrimRight(reply, "\n") + "\n\n" + block
+}
@@ -8,10 +8,12 @@ import (
 	"time"
 
 	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
 	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
 	tssecurity "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/security"
 )
 
@@ -22,6 +24,12 @@ type GameService struct {
 	sessionManager *GameSessionManager
 	setupService   *GameSetupService
 	injectionGuard tssecurity.InjectionGuard
+	events         *eventbus.Publisher
+	gamemaster     *Gamemaster
+	timedStore     *tsredis.TimedStore
+	bonus          *BonusRoundService
+	ratings        *PuzzleRatingService
+	deduper        QuestionDeduper
 	logger         *slog.Logger
 }
 
@@ -31,6 +39,12 @@ func NewGameService(
 	sessionManager *GameSessionManager,
 	setupService *GameSetupService,
 	injectionGuard tssecurity.InjectionGuard,
+	events *eventbus.Publisher,
+	gamemaster *Gamemaster,
+	timedStore *tsredis.TimedStore,
+	bonus *BonusRoundService,
+	ratings *PuzzleRatingService,
+	questionDedup tsconfig.QuestionDedupConfig,
 	logger *slog.Logger,
 ) *GameService {
 	return &GameService{
@@ -38,6 +52,12 @@ func NewGameService(
 		sessionManager: sessionManager,
 		setupService:   setupService,
 		injectionGuard: injectionGuard,
+		events:         events,
+		gamemaster:     gamemaster,
+		timedStore:     timedStore,
+		bonus:          bonus,
+		ratings:        ratings,
+		deduper:        NewQuestionDeduper(questionDedup),
 		logger:         logger,
 	}
 }
@@ -52,6 +72,20 @@ func (s *GameService) StartGame(
 	difficulty *int,
 	category *tsmodel.PuzzleCategory,
 	theme *string,
+) (tsmodel.GameState, error) {
+	return s.startGame(ctx, sessionID, userID, chatID, difficulty, category, theme, 0)
+}
+
+// startGame: 게임을 준비합니다. timeLimit이 양수면 퍼즐 생성이 끝난 시점부터 마감 시각을 계산해 타임어택으로 시작합니다.
+func (s *GameService) startGame(
+	ctx context.Context,
+	sessionID string,
+	userID string,
+	chatID string,
+	difficulty *int,
+	category *tsmodel.PuzzleCategory,
+	theme *string,
+	timeLimit time.Duration,
 ) (tsmodel.GameState, error) {
 	var state tsmodel.GameState
 	err := s.sessionManager.WithLock(ctx, sessionID, &userID, func(ctx context.Context) error {
@@ -59,8 +93,20 @@ func (s *GameService) StartGame(
 		if err != nil {
 			return err
 		}
-		s.logGameStarted(setup.State.SessionID, userID, setup.Puzzle)
 		state = setup.State
+		if timeLimit > 0 {
+			if state, err = s.applyDeadline(ctx, state, timeLimit); err != nil {
+				return err
+			}
+		}
+
+		s.logGameStarted(state.SessionID, userID, setup.Puzzle)
+		s.publish(ctx, eventbus.EventGameStarted, state, userID, map[string]any{
+			"puzzleTitle": setup.Puzzle.Title,
+			"difficulty":  setup.Puzzle.Difficulty,
+			"category":    setup.Puzzle.Category,
+			"timed":       state.IsTimed(),
+		})
 		return nil
 	})
 	if err != nil {
@@ -106,8 +152,14 @@ type AnswerQuestionResult struct {
 }
 
 // AskQuestion: LLM에 질문을 전달하고 예/아니오 답변을 받습니다.
-// 질문 유효성 검증 및 Injection Guard를 거친 후 처리합니다.
-func (s *GameService) AskQuestion(ctx context.Context, sessionID string, question string) (tsmodel.GameState, AnswerQuestionResult, error) {
+// 질문 유효성 검증 및 Injection Guard를 거친 후 처리하며, userID가 있으면 질문자 기여 기록에 반영합니다.
+func (s *GameService) AskQuestion(
+	ctx context.Context,
+	sessionID string,
+	userID string,
+	sender string,
+	question string,
+) (tsmodel.GameState, AnswerQuestionResult, error) {
 	if !isValidQuestion(question) {
 		return tsmodel.GameState{}, AnswerQuestionResult{}, cerrors.InvalidQuestionError{Message: "invalid question format"}
 	}
@@ -131,6 +183,12 @@ func (s *GameService) AskQuestion(ctx context.Context, sessionID string, questio
 			return tserrors.GameNotStartedError{SessionID: sessionID}
 		}
 
+		// 이미 답한 질문이면 LLM을 호출하지 않고 기존 기록을 안내
+		if number, entry := s.deduper.Find(loaded.History, sanitizedQuestion); number > 0 {
+			s.logger.Info("question_duplicate", "session_id", sessionID, "question_number", number)
+			return tserrors.DuplicateQuestionError{QuestionNumber: number, Question: entry.Question, Answer: entry.Answer}
+		}
+
 		chatID := loaded.ChatID
 		if chatID == "" {
 			chatID = sessionID
@@ -158,7 +216,12 @@ func (s *GameService) AskQuestion(ctx context.Context, sessionID string, questio
 
 		mergedHistory, mergedQuestionCount := mergeHistory(loaded, resolvedHistory, result.QuestionCount)
 
+		// 감독 모드 세션이면 운영자 승인/수정을 거친 답변으로 확정
+		answer := s.gamemaster.Review(ctx, loaded, mergedQuestionCount, sanitizedQuestion, result.Answer)
+		mergedHistory = applyReviewedAnswer(mergedHistory, result.Answer, answer)
+
 		now := time.Now()
+		loaded = loaded.RecordQuestion(userID, sender, tsmodel.IsImportantAnswer(answer))
 		loaded.QuestionCount = mergedQuestionCount
 		loaded.History = mergedHistory
 		loaded.LastActivityAt = now
@@ -169,9 +232,14 @@ func (s *GameService) AskQuestion(ctx context.Context, sessionID string, questio
 		_ = s.sessionManager.Refresh(ctx, sessionID)
 
 		s.logger.Info("question_answered", "session_id", sessionID, "question_count", loaded.QuestionCount)
+		s.publish(ctx, eventbus.EventQuestionAnswered, loaded, "", map[string]any{
+			"questionCount": loaded.QuestionCount,
+			"question":      sanitizedQuestion,
+			"answer":        answer,
+		})
 
 		answerResult = AnswerQuestionResult{
-			Answer:        result.Answer,
+			Answer:        answer,
 			QuestionCount: loaded.QuestionCount,
 			History:       slices.Clone(loaded.History),
 		}
@@ -186,8 +254,14 @@ func (s *GameService) AskQuestion(ctx context.Context, sessionID string, questio
 }
 
 // SubmitSolution: 플레이어의 정답 제출을 검증합니다.
-// 정답이면 게임을 종료하고, 오답이면 계속 진행합니다.
-func (s *GameService) SubmitSolution(ctx context.Context, sessionID string, playerAnswer string) (tsmodel.GameState, tsmodel.ValidationResult, error) {
+// 정답이면 제출자를 정답자로 기록하고 게임을 종료하며, 오답이면 계속 진행합니다.
+func (s *GameService) SubmitSolution(
+	ctx context.Context,
+	sessionID string,
+	userID string,
+	sender string,
+	playerAnswer string,
+) (tsmodel.GameState, tsmodel.ValidationResult, error) {
 	if !isValidAnswer(playerAnswer) {
 		return tsmodel.GameState{}, "", cerrors.InvalidAnswerError{Message: "invalid answer format"}
 	}
@@ -229,7 +303,7 @@ func (s *GameService) SubmitSolution(ctx context.Context, sessionID string, play
 		validation = parsed
 
 		if validation == tsmodel.ValidationYes {
-			loaded = loaded.MarkSolved()
+			loaded = loaded.MarkSolvedBy(userID, sender)
 		} else {
 			loaded = loaded.UpdateActivity()
 		}
@@ -248,6 +322,7 @@ func (s *GameService) SubmitSolution(ctx context.Context, sessionID string, play
 			_, _ = s.restClient.EndSessionByChat(ctx, tsconfig.LlmNamespace, chatID)
 
 			s.logger.Info("game_ended", "session_id", sessionID, "reason", "solved", "question_count", loaded.QuestionCount, "hints_used", loaded.HintsUsed)
+			s.publishGameCompleted(ctx, loaded, gameResultSolved)
 		}
 
 		state = loaded
@@ -260,15 +335,28 @@ func (s *GameService) SubmitSolution(ctx context.Context, sessionID string, play
 }
 
 // SubmitAnswer: SubmitSolution의 래퍼로, AnswerResult 형태로 결과를 반환합니다.
-func (s *GameService) SubmitAnswer(ctx context.Context, sessionID string, answer string) (tsmodel.AnswerResult, error) {
-	state, result, err := s.SubmitSolution(ctx, sessionID, answer)
+// 정답이면 후일담 보너스 라운드를 함께 제안합니다.
+func (s *GameService) SubmitAnswer(
+	ctx context.Context,
+	sessionID string,
+	userID string,
+	sender string,
+	answer string,
+) (tsmodel.AnswerResult, error) {
+	state, result, err := s.SubmitSolution(ctx, sessionID, userID, sender, answer)
 	if err != nil {
 		return tsmodel.AnswerResult{}, err
 	}
 
 	explanation := ""
+	var bonus *tsmodel.BonusRound
+	var contributions []tsmodel.PlayerContribution
+	ratingOpen := false
 	if result == tsmodel.ValidationYes && state.Puzzle != nil {
 		explanation = state.Puzzle.Solution
+		contributions = state.ContributionScores()
+		bonus = s.bonus.Offer(ctx, state)
+		ratingOpen = s.OpenRating(ctx, state)
 	}
 
 	return tsmodel.AnswerResult{
@@ -278,6 +366,9 @@ func (s *GameService) SubmitAnswer(ctx context.Context, sessionID string, answer
 		MaxHints:      tsconfig.GameMaxHints,
 		HintsUsed:     slices.Clone(state.HintContents),
 		Explanation:   explanation,
+		Contributions: contributions,
+		Bonus:         bonus,
+		RatingOpen:    ratingOpen,
 	}, nil
 }
 
@@ -316,6 +407,10 @@ func (s *GameService) RequestHint(ctx context.Context, sessionID string) (tsmode
 		}
 
 		s.logger.Info("hint_requested", "session_id", sessionID, "hints_used", loaded.HintsUsed)
+		s.publish(ctx, eventbus.EventHintUsed, loaded, "", map[string]any{
+			"hintsUsed": loaded.HintsUsed,
+			"maxHints":  tsconfig.GameMaxHints,
+		})
 		state = loaded
 		return nil
 	})
@@ -354,10 +449,13 @@ func (s *GameService) Surrender(ctx context.Context, sessionID string) (tsmodel.
 		_, _ = s.restClient.EndSessionByChat(ctx, tsconfig.LlmNamespace, chatID)
 
 		s.logger.Info("game_surrendered", "session_id", sessionID, "question_count", state.QuestionCount, "hints_used", state.HintsUsed)
+		s.publishGameCompleted(ctx, state, gameResultSurrender)
 
 		out = tsmodel.SurrenderResult{
-			Solution:  state.Puzzle.Solution,
-			HintsUsed: slices.Clone(state.HintContents),
+			Solution:      state.Puzzle.Solution,
+			HintsUsed:     slices.Clone(state.HintContents),
+			RatingOpen:    s.OpenRating(ctx, state),
+			Contributions: state.ContributionScores(),
 		}
 		return nil
 	})
@@ -388,11 +486,19 @@ func (s *GameService) EndGame(ctx context.Context, sessionID string) error {
 		_ = s.sessionManager.Delete(ctx, sessionID)
 		_, _ = s.restClient.EndSessionByChat(ctx, tsconfig.LlmNamespace, chatID)
 		s.logger.Info("game_ended", "session_id", sessionID)
+		if loaded != nil {
+			s.publishGameCompleted(ctx, *loaded, gameResultEnded)
+		}
 		return nil
 	})
 	return err
 }
 
+// OpenRating: 끝난 게임의 퍼즐을 평가 대상으로 등록합니다. 평가를 받을 수 있으면 true를 반환합니다.
+func (s *GameService) OpenRating(ctx context.Context, state tsmodel.GameState) bool {
+	return s.ratings.Open(ctx, state)
+}
+
 func (s *GameService) logGameStarted(sessionID string, userID string, puzzle tsmodel.Puzzle) {
 	s.logger.Info("game_started",
 		"session_id", sessionID,
@@ -403,6 +509,33 @@ func (s *GameService) logGameStarted(sessionID string, userID string, puzzle tsm
 	)
 }
 
+// 게임 종료 이벤트의 result 값
+const (
+	gameResultSolved    = "SOLVED"
+	gameResultSurrender = "SURRENDER"
+	gameResultEnded     = "ENDED"
+	gameResultTimeout   = "TIMEOUT"
+)
+
+// publish: 게임 상태 기준으로 세션/채팅방 ID를 채워 이벤트를 발행합니다.
+func (s *GameService) publish(ctx context.Context, eventType eventbus.EventType, state tsmodel.GameState, userID string, data map[string]any) {
+	s.events.PublishEvent(ctx, eventbus.Event{
+		Type:      eventType,
+		ChatID:    chatIDOf(state),
+		SessionID: state.SessionID,
+		UserID:    userID,
+		Data:      data,
+	})
+}
+
+func (s *GameService) publishGameCompleted(ctx context.Context, state tsmodel.GameState, result string) {
+	s.publish(ctx, eventbus.EventGameCompleted, state, "", map[string]any{
+		"result":        result,
+		"questionCount": state.QuestionCount,
+		"hintsUsed":     state.HintsUsed,
+	})
+}
+
 func mergeHistory(state tsmodel.GameState, resolvedHistory []tsmodel.HistoryEntry, resolvedQuestionCount int) ([]tsmodel.HistoryEntry, int) {
 	var lastEntry *tsmodel.HistoryEntry
 	if len(resolvedHistory) > 0 {
@@ -35,6 +35,12 @@ type testEnv struct {
 	stopLLM      func()
 	client       valkey.Client
 	sessionStore *tsredis.SessionStore
+	timedStore   *tsredis.TimedStore
+	bonus        *BonusRoundService
+	archiver     *recordingArchiver
+	catalog      *fakeCatalog
+	ratings      *PuzzleRatingService
+	rater        *recordingRater
 	mocks        mockResponses
 	t            *testing.T
 	prefix       string
@@ -53,7 +59,8 @@ func setupTestEnv(t *testing.T) *testEnv {
 	sessionManager := NewGameSessionManager(sessionStore, lockManager)
 
 	prefix := testhelper.UniqueTestPrefix(t)
-	env := &testEnv{client: client, sessionStore: sessionStore, t: t, prefix: prefix}
+	timedStore := tsredis.NewTimedStore(client, logger)
+	env := &testEnv{client: client, sessionStore: sessionStore, timedStore: timedStore, t: t, prefix: prefix}
 	stub := &turtlesoupLLMGRPCStub{
 		guardMalicious: func() bool {
 			return env.mocks.guardMalicious
@@ -107,12 +114,17 @@ func setupTestEnv(t *testing.T) *testEnv {
 	}
 	env.llmClient = llmClient
 
-	puzzleConfig := tsconfig.PuzzleConfig{RewriteEnabled: false}
-	puzzleService := NewPuzzleService(llmClient, puzzleConfig, dedupStore, logger)
+	puzzleConfig := tsconfig.PuzzleConfig{RewriteEnabled: false, CatalogEnabled: true}
+	env.catalog = &fakeCatalog{}
+	puzzleService := NewPuzzleService(llmClient, puzzleConfig, dedupStore, env.catalog, logger)
 	setupService := NewGameSetupService(llmClient, puzzleService, sessionManager, logger)
 	injectionGuard := tssecurity.NewMcpInjectionGuard(llmClient, logger)
 
-	env.svc = NewGameService(llmClient, sessionManager, setupService, injectionGuard, logger)
+	env.archiver = &recordingArchiver{}
+	env.bonus = NewBonusRoundService(llmClient, tsredis.NewBonusStore(client, logger), injectionGuard, env.archiver, nil, logger)
+	env.rater = &recordingRater{}
+	env.ratings = NewPuzzleRatingService(tsredis.NewRatingStore(client, logger), env.rater, logger)
+	env.svc = NewGameService(llmClient, sessionManager, setupService, injectionGuard, nil, nil, timedStore, env.bonus, env.ratings, tsconfig.QuestionDedupConfig{}, logger)
 
 	return env
 }
@@ -181,10 +193,13 @@ func TestGameService_AskQuestion(t *testing.T) {
 		QuestionCount: 1,
 	}
 
-	state, result, err := env.svc.AskQuestion(ctx, sessionID, question)
+	state, result, err := env.svc.AskQuestion(ctx, sessionID, "user1", "Tester", question)
 	if err != nil {
 		t.Fatalf("AskQuestion failed: %v", err)
 	}
+	if len(state.Contributions) != 1 || state.Contributions[0].UserID != "user1" || state.Contributions[0].Questions != 1 {
+		t.Errorf("expected question recorded for user1, got %+v", state.Contributions)
+	}
 
 	if result.Answer != "No" {
 		t.Errorf("expected answer 'No', got %s", result.Answer)
@@ -210,7 +225,7 @@ func TestGameService_SubmitSolution_Correct(t *testing.T) {
 		Result: "YES",
 	}
 
-	state, validation, err := env.svc.SubmitSolution(ctx, sessionID, "The correct answer")
+	state, validation, err := env.svc.SubmitSolution(ctx, sessionID, "", "", "The correct answer")
 	if err != nil {
 		t.Fatalf("SubmitSolution failed: %v", err)
 	}
@@ -245,7 +260,7 @@ func TestGameService_SubmitSolution_Incorrect(t *testing.T) {
 		Result: "NO",
 	}
 
-	_, validation, err := env.svc.SubmitSolution(ctx, sessionID, "Wrong answer")
+	_, validation, err := env.svc.SubmitSolution(ctx, sessionID, "", "", "Wrong answer")
 	if err != nil {
 		t.Fatalf("SubmitSolution failed: %v", err)
 	}
@@ -370,7 +385,7 @@ func TestGameService_IsMalicious(t *testing.T) {
 
 	env.mocks.guardMalicious = true
 
-	_, _, err = env.svc.AskQuestion(ctx, sessionID, "Bad question")
+	_, _, err = env.svc.AskQuestion(ctx, sessionID, "", "", "Bad question")
 	if err == nil {
 		t.Error("expected error for malicious question")
 	}
@@ -379,7 +394,7 @@ func TestGameService_IsMalicious(t *testing.T) {
 		t.Fatalf("expected InputInjectionError, got: %v", err)
 	}
 
-	_, _, err = env.svc.SubmitSolution(ctx, sessionID, "Bad answer")
+	_, _, err = env.svc.SubmitSolution(ctx, sessionID, "", "", "Bad answer")
 	if err == nil {
 		t.Error("expected error for malicious answer")
 	}
@@ -399,7 +414,7 @@ func TestGameService_SubmitAnswer(t *testing.T) {
 
 	env.mocks.validation = &llmrest.TurtleSoupValidateResponse{Result: "NO"}
 
-	res, err := env.svc.SubmitAnswer(ctx, sessionID, "Some answer")
+	res, err := env.svc.SubmitAnswer(ctx, sessionID, "", "", "Some answer")
 	if err != nil {
 		t.Fatalf("SubmitAnswer failed: %v", err)
 	}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+)
+
+// Gamemaster: 감독 모드(supervised mode) 세션에서 AI 답변을 전송 전에 운영자가 승인/수정할 수 있게 하는 서비스입니다.
+// 감독 모드가 꺼진 세션에는 아무 영향을 주지 않으며, 운영자가 시간 내 결정하지 않으면 원래 답변을 그대로 보냅니다.
+type Gamemaster struct {
+	store         *tsredis.GamemasterStore
+	events        *eventbus.Publisher
+	reviewTimeout time.Duration
+	logger        *slog.Logger
+}
+
+// NewGamemaster: Gamemaster 인스턴스를 생성합니다. reviewTimeout이 0 이하이면 기본값을 사용합니다.
+func NewGamemaster(store *tsredis.GamemasterStore, events *eventbus.Publisher, reviewTimeout time.Duration, logger *slog.Logger) *Gamemaster {
+	if reviewTimeout <= 0 {
+		reviewTimeout = time.Duration(tsconfig.GamemasterReviewTimeoutSeconds) * time.Second
+	}
+	return &Gamemaster{
+		store:         store,
+		events:        events,
+		reviewTimeout: reviewTimeout,
+		logger:        logger,
+	}
+}
+
+// Review: 감독 모드 세션이면 답변을 검토 대기열에 올리고 운영자 결정을 기다려 최종 답변을 반환합니다.
+// Redis 장애 등으로 검토할 수 없으면 원래 답변을 그대로 반환합니다 (게임 진행 우선).
+func (g *Gamemaster) Review(ctx context.Context, state tsmodel.GameState, questionNumber int, question string, answer string) string {
+	if g == nil {
+		return answer
+	}
+
+	supervised, err := g.store.IsSupervised(ctx, state.SessionID)
+	if err != nil {
+		g.logger.Warn("gm_supervised_check_failed", "session_id", state.SessionID, "err", err)
+		return answer
+	}
+	if !supervised {
+		return answer
+	}
+
+	now := time.Now()
+	pending := tsmodel.PendingAnswer{
+		ID:             newPendingID(),
+		SessionID:      state.SessionID,
+		ChatID:         chatIDOf(state),
+		QuestionNumber: questionNumber,
+		Question:       question,
+		Answer:         answer,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(g.reviewTimeout),
+	}
+	if err := g.store.SavePending(ctx, pending, g.reviewTimeout); err != nil {
+		g.logger.Warn("gm_pending_save_failed", "session_id", state.SessionID, "err", err)
+		return answer
+	}
+	g.publish(ctx, eventbus.EventAnswerPending, state, map[string]any{
+		"pendingId":      pending.ID,
+		"questionNumber": questionNumber,
+		"question":       question,
+		"answer":         answer,
+		"expiresAt":      pending.ExpiresAt,
+	})
+
+	decision, err := g.store.WaitDecision(ctx, pending.ID, g.reviewTimeout)
+	if err != nil {
+		g.logger.Warn("gm_decision_wait_failed", "session_id", state.SessionID, "pending_id", pending.ID, "err", err)
+	}
+	if err := g.store.ClearPending(context.WithoutCancel(ctx), state.SessionID); err != nil {
+		g.logger.Warn("gm_pending_clear_failed", "session_id", state.SessionID, "err", err)
+	}
+
+	override := tsmodel.AnswerOverride{
+		PendingID:      pending.ID,
+		SessionID:      pending.SessionID,
+		ChatID:         pending.ChatID,
+		QuestionNumber: questionNumber,
+		Question:       question,
+		OriginalAnswer: answer,
+		FinalAnswer:    answer,
+		Action:         tsmodel.GamemasterActionTimeout,
+		DecidedAt:      time.Now(),
+	}
+	if decision != nil {
+		override.Action = decision.Action
+		override.Operator = decision.Operator
+		override.DecidedAt = decision.DecidedAt
+		if decision.Action == tsmodel.GamemasterActionAmend {
+			override.FinalAnswer = decision.Answer
+		}
+	}
+
+	if err := g.store.AppendOverride(context.WithoutCancel(ctx), override); err != nil {
+		g.logger.Warn("gm_override_log_failed", "session_id", state.SessionID, "pending_id", pending.ID, "err", err)
+	}
+	g.logger.Info("gm_answer_reviewed",
+		"session_id", state.SessionID,
+		"pending_id", pending.ID,
+		"action", override.Action,
+		"operator", override.Operator,
+		"amended", override.Amended(),
+	)
+	g.publish(ctx, eventbus.EventAnswerReviewed, state, map[string]any{
+		"pendingId":      pending.ID,
+		"questionNumber": questionNumber,
+		"action":         override.Action,
+		"operator":       override.Operator,
+		"originalAnswer": override.OriginalAnswer,
+		"finalAnswer":    override.FinalAnswer,
+	})
+
+	return override.FinalAnswer
+}
+
+// Decide: 검토 대기 중인 답변에 대해 운영자의 승인 또는 수정 결정을 전달합니다.
+// pendingID가 현재 대기 중인 답변과 다르면(이미 처리/만료됨) PendingAnswerNotFoundError를 반환합니다.
+func (g *Gamemaster) Decide(
+	ctx context.Context,
+	sessionID string,
+	pendingID string,
+	action tsmodel.GamemasterAction,
+	answer string,
+	operator string,
+) error {
+	answer = strings.TrimSpace(answer)
+	switch action {
+	case tsmodel.GamemasterActionApprove:
+		answer = ""
+	case tsmodel.GamemasterActionAmend:
+		if !isValidAnswer(answer) {
+			return cerrors.InvalidAnswerError{Message: "amended answer is empty or too long"}
+		}
+	default:
+		return cerrors.MalformedInputError{Message: fmt.Sprintf("unknown gamemaster action: %s", action)}
+	}
+
+	pending, err := g.store.GetPending(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get pending answer failed: %w", err)
+	}
+	if pending == nil || pending.ID != pendingID {
+		return tserrors.PendingAnswerNotFoundError{SessionID: sessionID, PendingID: pendingID}
+	}
+
+	decision := tsmodel.GamemasterDecision{
+		PendingID: pendingID,
+		Action:    action,
+		Answer:    answer,
+		Operator:  strings.TrimSpace(operator),
+		DecidedAt: time.Now(),
+	}
+	// 결정이 소비되지 않더라도(대기 측 종료) 잔여 키가 남지 않도록 대기 시간만큼만 유지
+	if err := g.store.PushDecision(ctx, decision, g.reviewTimeout); err != nil {
+		return fmt.Errorf("push decision failed: %w", err)
+	}
+	return nil
+}
+
+// SetSupervised: 세션의 감독 모드를 켜거나 끕니다.
+func (g *Gamemaster) SetSupervised(ctx context.Context, sessionID string, enabled bool) error {
+	if err := g.store.SetSupervised(ctx, sessionID, enabled); err != nil {
+		return fmt.Errorf("set supervised failed: %w", err)
+	}
+	return nil
+}
+
+// IsSupervised: 세션이 감독 모드인지 확인합니다.
+func (g *Gamemaster) IsSupervised(ctx context.Context, sessionID string) (bool, error) {
+	supervised, err := g.store.IsSupervised(ctx, sessionID)
+	if err != nil {
+		return false, fmt.Errorf("check supervised failed: %w", err)
+	}
+	return supervised, nil
+}
+
+// SupervisedSessions: 감독 모드가 켜진 세션 ID 목록을 반환합니다.
+func (g *Gamemaster) SupervisedSessions(ctx context.Context) ([]string, error) {
+	sessions, err := g.store.ListSupervised(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list supervised failed: %w", err)
+	}
+	return sessions, nil
+}
+
+// Pending: 세션의 검토 대기 답변을 조회합니다. 없으면 nil을 반환합니다.
+func (g *Gamemaster) Pending(ctx context.Context, sessionID string) (*tsmodel.PendingAnswer, error) {
+	pending, err := g.store.GetPending(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get pending answer failed: %w", err)
+	}
+	return pending, nil
+}
+
+// Overrides: 최신순 답변 검토 기록을 조회합니다.
+func (g *Gamemaster) Overrides(ctx context.Context, sessionID string, limit int) ([]tsmodel.AnswerOverride, error) {
+	overrides, err := g.store.ListOverrides(ctx, sessionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list overrides failed: %w", err)
+	}
+	return overrides, nil
+}
+
+func (g *Gamemaster) publish(ctx context.Context, eventType eventbus.EventType, state tsmodel.GameState, data map[string]any) {
+	g.events.PublishEvent(ctx, eventbus.Event{
+		Type:      eventType,
+		ChatID:    chatIDOf(state),
+		SessionID: state.SessionID,
+		Data:      data,
+	})
+}
+
+// applyReviewedAnswer: 확정된 답변이 AI 원본과 다르면 이력의 마지막 항목 답변을 교체한 복사본을 반환합니다.
+func applyReviewedAnswer(history []tsmodel.HistoryEntry, original string, final string) []tsmodel.HistoryEntry {
+	if final == original || len(history) == 0 {
+		return history
+	}
+	last := len(history) - 1
+	if history[last].Answer != original {
+		return history
+	}
+	updated := slices.Clone(history)
+	updated[last].Answer = final
+	return updated
+}
+
+func chatIDOf(state tsmodel.GameState) string {
+	if state.ChatID == "" {
+		return state.SessionID
+	}
+	return state.ChatID
+}
+
+func newPendingID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+)
+
+func setupGamemasterTest(t *testing.T, timeout time.Duration) (*Gamemaster, valkey.Client) {
+	client := testhelper.NewTestValkeyClient(t)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	store := tsredis.NewGamemasterStore(client, logger)
+	t.Cleanup(func() {
+		testhelper.CleanupTestKeys(t, client, tsconfig.RedisKeyGamemaster+":")
+		client.Close()
+	})
+	return NewGamemaster(store, nil, timeout, logger), client
+}
+
+func waitPending(t *testing.T, gm *Gamemaster, sessionID string) *tsmodel.PendingAnswer {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		pending, err := gm.Pending(context.Background(), sessionID)
+		if err != nil {
+			t.Fatalf("pending get failed: %v", err)
+		}
+		if pending != nil {
+			return pending
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("pending answer not created")
+	return nil
+}
+
+func TestGamemaster_Review_NotSupervisedPassesThrough(t *testing.T) {
+	gm, _ := setupGamemasterTest(t, time.Second)
+	sessionID := testhelper.UniqueTestPrefix(t) + "room"
+
+	got := gm.Review(context.Background(), tsmodel.GameState{SessionID: sessionID}, 1, "q", "예")
+	if got != "예" {
+		t.Fatalf("expected original answer, got %q", got)
+	}
+}
+
+func TestGamemaster_Review_Amend(t *testing.T) {
+	gm, _ := setupGamemasterTest(t, 5*time.Second)
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "room"
+
+	if err := gm.SetSupervised(ctx, sessionID, true); err != nil {
+		t.Fatalf("set supervised failed: %v", err)
+	}
+
+	done := make(chan string, 1)
+	go func() {
+		done <- gm.Review(ctx, tsmodel.GameState{SessionID: sessionID}, 3, "사람이 죽었나요?", "예")
+	}()
+
+	pending := waitPending(t, gm, sessionID)
+	if err := gm.Decide(ctx, sessionID, "wrong-id", tsmodel.GamemasterActionApprove, "", "op"); !errors.As(err, new(tserrors.PendingAnswerNotFoundError)) {
+		t.Fatalf("expected PendingAnswerNotFoundError, got %v", err)
+	}
+	if err := gm.Decide(ctx, sessionID, pending.ID, tsmodel.GamemasterActionAmend, "아니오", "op"); err != nil {
+		t.Fatalf("decide failed: %v", err)
+	}
+
+	if got := <-done; got != "아니오" {
+		t.Fatalf("expected amended answer, got %q", got)
+	}
+
+	overrides, err := gm.Overrides(ctx, sessionID, 10)
+	if err != nil {
+		t.Fatalf("overrides failed: %v", err)
+	}
+	if len(overrides) != 1 || overrides[0].Action != tsmodel.GamemasterActionAmend || overrides[0].Operator != "op" || !overrides[0].Amended() {
+		t.Fatalf("unexpected overrides: %+v", overrides)
+	}
+}
+
+func TestGamemaster_Review_TimeoutSendsOriginal(t *testing.T) {
+	gm, _ := setupGamemasterTest(t, time.Second)
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "room"
+
+	if err := gm.SetSupervised(ctx, sessionID, true); err != nil {
+		t.Fatalf("set supervised failed: %v", err)
+	}
+
+	got := gm.Review(ctx, tsmodel.GameState{SessionID: sessionID}, 1, "q", "예")
+	if got != "예" {
+		t.Fatalf("expected original answer on timeout, got %q", got)
+	}
+
+	overrides, err := gm.Overrides(ctx, sessionID, 10)
+	if err != nil {
+		t.Fatalf("overrides failed: %v", err)
+	}
+	if len(overrides) != 1 || overrides[0].Action != tsmodel.GamemasterActionTimeout {
+		t.Fatalf("unexpected overrides: %+v", overrides)
+	}
+}
+
+func TestApplyReviewedAnswer(t *testing.T) {
+	history := []tsmodel.HistoryEntry{
+		{Question: "q1", Answer: "예"},
+		{Question: "q2", Answer: "아니오"},
+	}
+
+	updated := applyReviewedAnswer(history, "아니오", "중요하지 않습니다")
+	if updated[1].Answer != "중요하지 않습니다" {
+		t.Fatalf("expected last answer replaced, got %+v", updated)
+	}
+	if history[1].Answer != "아니오" {
+		t.Fatalf("original history must not be mutated")
+	}
+
+	unchanged := applyReviewedAnswer(history, "예", "아니오")
+	if unchanged[1].Answer != "아니오" {
+		t.Fatalf("expected history unchanged when last answer differs, got %+v", unchanged)
+	}
+}
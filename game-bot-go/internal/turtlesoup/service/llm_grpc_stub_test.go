@@ -25,6 +25,7 @@ type turtlesoupLLMGRPCStub struct {
 	answerQuestion   func() *llmrest.TurtleSoupAnswerResponse
 	validateSolution func() *llmrest.TurtleSoupValidateResponse
 	generateHint     func() *llmrest.TurtleSoupHintResponse
+	generateEpilogue func() *llmrest.TurtleSoupEpilogueResponse
 }
 
 func (s *turtlesoupLLMGRPCStub) incCall() {
@@ -225,6 +226,23 @@ func (s *turtlesoupLLMGRPCStub) TurtleSoupGenerateHint(ctx context.Context, _ *l
 	return &llmv1.TurtleSoupGenerateHintResponse{Hint: resp.Hint, Level: int32(resp.Level)}, nil
 }
 
+func (s *turtlesoupLLMGRPCStub) TurtleSoupGenerateEpilogue(ctx context.Context, _ *llmv1.TurtleSoupGenerateEpilogueRequest) (*llmv1.TurtleSoupGenerateEpilogueResponse, error) {
+	s.incCall()
+	if s.isError() {
+		return nil, status.Error(codes.Internal, "mock error")
+	}
+
+	resp := (*llmrest.TurtleSoupEpilogueResponse)(nil)
+	if s != nil && s.generateEpilogue != nil {
+		resp = s.generateEpilogue()
+	}
+	if resp == nil {
+		resp = &llmrest.TurtleSoupEpilogueResponse{Epilogue: "He never came back.", Question: "Where did he go?", Answer: "The sea"}
+	}
+
+	return &llmv1.TurtleSoupGenerateEpilogueResponse{Epilogue: resp.Epilogue, Question: resp.Question, Answer: resp.Answer}, nil
+}
+
 func (s *turtlesoupLLMGRPCStub) EndSession(ctx context.Context, req *llmv1.EndSessionRequest) (*llmv1.EndSessionResponse, error) {
 	s.incCall()
 	if s.isError() {
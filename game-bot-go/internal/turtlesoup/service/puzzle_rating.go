@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+)
+
+// PuzzleRatingRecorder: 퍼즐 평점을 영구 저장소에 기록하는 인터페이스 (tsrepo.Repository가 구현)
+type PuzzleRatingRecorder interface {
+	RatePuzzle(ctx context.Context, p tsrepo.RatePuzzleParams) error
+}
+
+// PuzzleRatingService: 게임이 끝난 뒤 플레이어에게 CMS 퍼즐 평점(1~5)을 받아 기록하는 서비스입니다.
+// 평점은 퍼즐 선택 가중치로 쓰이므로 LLM이 생성한 퍼즐처럼 ID가 없는 퍼즐은 평가를 받지 않습니다.
+type PuzzleRatingService struct {
+	store    *tsredis.RatingStore
+	recorder PuzzleRatingRecorder
+	logger   *slog.Logger
+}
+
+// NewPuzzleRatingService: PuzzleRatingService 인스턴스를 생성합니다. recorder가 nil이면 평가를 받지 않습니다.
+func NewPuzzleRatingService(store *tsredis.RatingStore, recorder PuzzleRatingRecorder, logger *slog.Logger) *PuzzleRatingService {
+	return &PuzzleRatingService{
+		store:    store,
+		recorder: recorder,
+		logger:   logger,
+	}
+}
+
+// Open: 끝난 게임의 퍼즐을 평가 대상으로 등록합니다. 평가를 받을 수 있게 되면 true를 반환합니다.
+// 평가는 부가 기능이므로 저장에 실패해도 로그만 남깁니다.
+func (s *PuzzleRatingService) Open(ctx context.Context, state tsmodel.GameState) bool {
+	if s == nil || s.recorder == nil || state.Puzzle == nil || state.Puzzle.ID == 0 {
+		return false
+	}
+
+	chatID := chatIDOf(state)
+	target := tsmodel.RatingTarget{
+		PuzzleID:  state.Puzzle.ID,
+		Title:     state.Puzzle.Title,
+		SessionID: archiveSessionID(state),
+		EndedAt:   time.Now(),
+	}
+	if err := s.store.Open(ctx, chatID, target); err != nil {
+		s.logger.Warn("rating_open_failed", "chat_id", chatID, "puzzle_id", target.PuzzleID, "err", err)
+		return false
+	}
+	return true
+}
+
+// Rate: 채팅방에서 마지막으로 끝난 게임의 퍼즐에 평점을 기록합니다. 평가할 퍼즐이 없으면 nil을 반환합니다.
+func (s *PuzzleRatingService) Rate(ctx context.Context, chatID string, userID string, rating int) (*tsmodel.RatingTarget, error) {
+	if rating < tsconfig.PuzzleRatingMin || rating > tsconfig.PuzzleRatingMax {
+		return nil, fmt.Errorf("rating out of range (%d..%d): %d", tsconfig.PuzzleRatingMin, tsconfig.PuzzleRatingMax, rating)
+	}
+	if s.recorder == nil {
+		return nil, nil
+	}
+
+	target, err := s.store.Get(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("rating target get failed: %w", err)
+	}
+	if target == nil {
+		return nil, nil
+	}
+
+	err = s.recorder.RatePuzzle(ctx, tsrepo.RatePuzzleParams{
+		PuzzleID:  target.PuzzleID,
+		SessionID: target.SessionID,
+		UserID:    userID,
+		ChatID:    chatID,
+		Rating:    rating,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record rating failed: %w", err)
+	}
+
+	s.logger.Info("puzzle_rated", "chat_id", chatID, "user_id", userID, "puzzle_id", target.PuzzleID, "rating", rating)
+	return target, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+)
+
+// fakeCatalog: puzzle이 nil이면 공개 퍼즐이 없는 것처럼 동작합니다.
+type fakeCatalog struct {
+	puzzle *tsrepo.Puzzle
+}
+
+func (c *fakeCatalog) GetRandomPublishedPuzzle(_ context.Context, _ string, _ int) (*tsrepo.Puzzle, error) {
+	if c.puzzle == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *c.puzzle
+	return &copied, nil
+}
+
+type recordingRater struct {
+	mu     sync.Mutex
+	params []tsrepo.RatePuzzleParams
+}
+
+func (r *recordingRater) RatePuzzle(_ context.Context, p tsrepo.RatePuzzleParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.params = append(r.params, p)
+	return nil
+}
+
+func TestPuzzleRating_CatalogPuzzleCanBeRatedAfterSurrender(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "sess_rate"
+	chatID := env.chatID("chat_rate")
+
+	if target, err := env.ratings.Rate(ctx, chatID, "user1", 5); err != nil || target != nil {
+		t.Fatalf("expected no rating target before any game, got %+v, %v", target, err)
+	}
+
+	env.catalog.puzzle = &tsrepo.Puzzle{
+		ID:         7,
+		Title:      "Lighthouse",
+		Scenario:   "The keeper turned off the light.",
+		Solution:   "Ships crashed.",
+		Category:   "MYSTERY",
+		Difficulty: 3,
+		HintsJSON:  `["It was night"]`,
+	}
+	state, err := env.svc.StartGame(ctx, sessionID, "user1", chatID, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("StartGame failed: %v", err)
+	}
+	if state.Puzzle == nil || state.Puzzle.ID != 7 || len(state.Puzzle.Hints) != 1 {
+		t.Fatalf("expected catalog puzzle, got %+v", state.Puzzle)
+	}
+
+	surrendered, err := env.svc.Surrender(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("Surrender failed: %v", err)
+	}
+	if !surrendered.RatingOpen {
+		t.Fatal("expected rating to open for a catalog puzzle")
+	}
+
+	for _, userID := range []string{"user1", "user2"} {
+		target, err := env.ratings.Rate(ctx, chatID, userID, 4)
+		if err != nil || target == nil || target.PuzzleID != 7 {
+			t.Fatalf("Rate(%s) = %+v, %v", userID, target, err)
+		}
+	}
+	if len(env.rater.params) != 2 || env.rater.params[1].UserID != "user2" || env.rater.params[1].Rating != 4 {
+		t.Fatalf("unexpected recorded ratings: %+v", env.rater.params)
+	}
+
+	if _, err := env.ratings.Rate(ctx, chatID, "user1", 6); err == nil {
+		t.Fatal("expected out-of-range rating to fail")
+	}
+}
+
+func TestPuzzleRating_GeneratedPuzzleIsNotRateable(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "sess_norate"
+	chatID := env.chatID("chat_norate")
+
+	if _, err := env.svc.StartGame(ctx, sessionID, "user1", chatID, nil, nil, nil); err != nil {
+		t.Fatalf("StartGame failed: %v", err)
+	}
+	surrendered, err := env.svc.Surrender(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("Surrender failed: %v", err)
+	}
+	if surrendered.RatingOpen {
+		t.Fatal("generated puzzle must not open rating")
+	}
+	if target, err := env.ratings.Rate(ctx, chatID, "user1", 3); err != nil || target != nil {
+		t.Fatalf("expected no rating target, got %+v, %v", target, err)
+	}
+}
@@ -4,37 +4,67 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
 	"time"
 
+	json "github.com/goccy/go-json"
+	"gorm.io/gorm"
+
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ptr"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
 	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
 	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
 )
 
+// PuzzleCatalog: 운영자가 공개한 CMS 퍼즐 저장소 인터페이스 (tsrepo.Repository가 구현)
+type PuzzleCatalog interface {
+	GetRandomPublishedPuzzle(ctx context.Context, category string, difficulty int) (*tsrepo.Puzzle, error)
+}
+
+// PuzzleReviewQueue: LLM 생성 퍼즐을 검수 대기열에 올리는 저장소 인터페이스 (tsrepo.Repository가 구현)
+type PuzzleReviewQueue interface {
+	QueueGeneratedPuzzle(ctx context.Context, puzzle *tsrepo.Puzzle, signature string) (uint64, error)
+}
+
 // PuzzleService: TurtleSoup 퍼즐 생성 및 중복 방지를 담당하는 서비스입니다.
 type PuzzleService struct {
-	restClient *llmrest.Client
-	cfg        tsconfig.PuzzleConfig
-	dedupStore *tsredis.PuzzleDedupStore
-	logger     *slog.Logger
+	restClient  *llmrest.Client
+	cfg         tsconfig.PuzzleConfig
+	dedupStore  *tsredis.PuzzleDedupStore
+	catalog     PuzzleCatalog
+	reviewQueue PuzzleReviewQueue
+	logger      *slog.Logger
 }
 
-// NewPuzzleService: PuzzleService 인스턴스를 생성합니다.
-func NewPuzzleService(restClient *llmrest.Client, cfg tsconfig.PuzzleConfig, dedupStore *tsredis.PuzzleDedupStore, logger *slog.Logger) *PuzzleService {
+// NewPuzzleService: PuzzleService 인스턴스를 생성합니다. catalog가 nil이면 항상 LLM으로 퍼즐을 생성합니다.
+func NewPuzzleService(
+	restClient *llmrest.Client,
+	cfg tsconfig.PuzzleConfig,
+	dedupStore *tsredis.PuzzleDedupStore,
+	catalog PuzzleCatalog,
+	logger *slog.Logger,
+) *PuzzleService {
 	return &PuzzleService{
 		restClient: restClient,
 		cfg:        cfg,
 		dedupStore: dedupStore,
+		catalog:    catalog,
 		logger:     logger,
 	}
 }
 
+// SetReviewQueue: 생성 퍼즐 검수 대기열을 연결합니다. nil이거나 설정이 꺼져 있으면 생성 퍼즐은 저장하지 않습니다.
+func (s *PuzzleService) SetReviewQueue(queue PuzzleReviewQueue) {
+	s.reviewQueue = queue
+}
+
 // PuzzleGenerationRequest: 퍼즐 생성 요청 파라미터입니다.
 type PuzzleGenerationRequest struct {
 	Category   *tsmodel.PuzzleCategory
@@ -42,7 +72,7 @@ type PuzzleGenerationRequest struct {
 	Theme      *string
 }
 
-// GeneratePuzzle: LLM을 통해 새 퍼즐을 생성합니다.
+// GeneratePuzzle: 새 퍼즐을 준비합니다. 조건에 맞는 공개 CMS 퍼즐이 있으면 먼저 출제하고, 없으면 LLM으로 생성합니다.
 // 중복 방지 및 재시도 로직을 포함하며, 실패 시 Preset 퍼즐을 반환합니다.
 func (s *PuzzleService) GeneratePuzzle(ctx context.Context, req PuzzleGenerationRequest, chatID string) (tsmodel.Puzzle, error) {
 	category := tsmodel.PuzzleCategoryMystery
@@ -61,6 +91,10 @@ func (s *PuzzleService) GeneratePuzzle(ctx context.Context, req PuzzleGeneration
 		theme = strings.TrimSpace(*req.Theme)
 	}
 
+	if puzzle, ok := s.pickCatalogPuzzle(ctx, req.Category, difficulty, theme, chatID); ok {
+		return puzzle, nil
+	}
+
 	var lastErr error
 
 	for attempt := 0; attempt < tsconfig.PuzzleDedupMaxGenerationRetries; attempt++ {
@@ -92,6 +126,44 @@ func (s *PuzzleService) GeneratePuzzle(ctx context.Context, req PuzzleGeneration
 	return fallback, nil
 }
 
+// pickCatalogPuzzle: 공개 CMS 퍼즐을 평점 가중 무작위로 하나 고릅니다.
+// 테마를 지정했거나 이 방에서 이미 출제한 퍼즐이면 LLM 생성으로 넘어가며, 조회 실패도 게임 시작을 막지 않습니다.
+func (s *PuzzleService) pickCatalogPuzzle(
+	ctx context.Context,
+	category *tsmodel.PuzzleCategory,
+	difficulty int,
+	theme string,
+	chatID string,
+) (tsmodel.Puzzle, bool) {
+	if s.catalog == nil || !s.cfg.CatalogEnabled || theme != "" {
+		return tsmodel.Puzzle{}, false
+	}
+
+	categoryFilter := ""
+	if category != nil {
+		categoryFilter = string(*category)
+	}
+	entry, err := s.catalog.GetRandomPublishedPuzzle(ctx, categoryFilter, difficulty)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Warn("puzzle_catalog_failed", "chat_id", chatID, "err", err)
+		}
+		return tsmodel.Puzzle{}, false
+	}
+
+	puzzle := puzzleFromCatalog(entry)
+	signature := computeSignature(puzzle)
+	dup, err := s.dedupStore.IsDuplicate(ctx, signature, chatID)
+	if err != nil || dup {
+		s.logger.Info("puzzle_catalog_skipped", "chat_id", chatID, "puzzle_id", puzzle.ID, "duplicate", dup, "err", err)
+		return tsmodel.Puzzle{}, false
+	}
+	_ = s.dedupStore.MarkUsed(ctx, signature, chatID)
+
+	s.logger.Info("puzzle_catalog_selected", "chat_id", chatID, "puzzle_id", puzzle.ID, "difficulty", puzzle.Difficulty)
+	return puzzle, true
+}
+
 func (s *PuzzleService) tryGeneratePuzzle(
 	ctx context.Context,
 	chatID string,
@@ -132,14 +204,54 @@ func (s *PuzzleService) tryGeneratePuzzle(
 	}
 
 	if err := s.dedupStore.MarkUsed(ctx, signature, chatID); err != nil {
-		s.logger.Warn("puzzle_dedup_mark_failed", "attempt", attempt+1, "chat_id", chatID, "err", err)
-		return tsmodel.Puzzle{}, fmt.Errorf("puzzle dedup mark failed: %w", err)
+		// 재시도로도 해소되지 않은 연결 장애는 중복 방지 기록만 포기하고 생성된 퍼즐은 그대로 사용
+		if cmdErr, ok := valkeyx.AsCommandError(err); ok {
+			s.logger.Warn("puzzle_dedup_mark_skipped",
+				"attempt", attempt+1,
+				"chat_id", chatID,
+				"reason", cmdErr.Reason,
+				"err", err,
+			)
+		} else {
+			s.logger.Warn("puzzle_dedup_mark_failed", "attempt", attempt+1, "chat_id", chatID, "err", err)
+			return tsmodel.Puzzle{}, fmt.Errorf("puzzle dedup mark failed: %w", err)
+		}
 	}
 
-	s.logger.Info("puzzle_generated", "chat_id", chatID, "difficulty", puzzle.Difficulty)
+	puzzle.ID = s.queueForReview(ctx, puzzle, signature, chatID)
+	s.logger.Info("puzzle_generated", "chat_id", chatID, "difficulty", puzzle.Difficulty, "puzzle_id", puzzle.ID)
 	return puzzle, nil
 }
 
+// queueForReview: 출제하는 생성 퍼즐을 검수 대기열에 저장하고 퍼즐 ID를 반환합니다.
+// ID가 붙은 게임은 CMS 퍼즐처럼 아카이브/평가가 연결되어 검수 때 참고할 수 있으며, 저장에 실패해도 게임은 그대로 진행합니다. (ID 0)
+func (s *PuzzleService) queueForReview(ctx context.Context, puzzle tsmodel.Puzzle, signature string, chatID string) uint64 {
+	if s.reviewQueue == nil || !s.cfg.ReviewQueueEnabled {
+		return 0
+	}
+
+	hintsJSON := "[]"
+	if len(puzzle.Hints) > 0 {
+		if b, err := json.Marshal(puzzle.Hints); err == nil {
+			hintsJSON = string(b)
+		}
+	}
+	entry := &tsrepo.Puzzle{
+		Title:      puzzle.Title,
+		Scenario:   puzzle.Scenario,
+		Solution:   puzzle.Solution,
+		Category:   string(puzzle.Category),
+		Difficulty: puzzle.Difficulty,
+		HintsJSON:  hintsJSON,
+	}
+	id, err := s.reviewQueue.QueueGeneratedPuzzle(ctx, entry, signature)
+	if err != nil {
+		s.logger.Warn("puzzle_review_queue_failed", "chat_id", chatID, "err", err)
+		return 0
+	}
+	return id
+}
+
 func (s *PuzzleService) getPresetPuzzleByDifficulty(ctx context.Context, difficulty int) (tsmodel.Puzzle, error) {
 	difficulty = clampInt(difficulty, tsconfig.PuzzleMinDifficulty, tsconfig.PuzzleMaxDifficulty)
 
@@ -187,6 +299,24 @@ func puzzleFromGeneration(res *llmrest.TurtleSoupPuzzleGenerationResponse) tsmod
 	}
 }
 
+func puzzleFromCatalog(entry *tsrepo.Puzzle) tsmodel.Puzzle {
+	var hints []string
+	if entry.HintsJSON != "" {
+		_ = json.Unmarshal([]byte(entry.HintsJSON), &hints)
+	}
+
+	return tsmodel.Puzzle{
+		ID:         entry.ID,
+		Title:      strings.TrimSpace(entry.Title),
+		Scenario:   strings.TrimSpace(entry.Scenario),
+		Solution:   strings.TrimSpace(entry.Solution),
+		Category:   tsmodel.ParsePuzzleCategory(entry.Category),
+		Difficulty: clampInt(entry.Difficulty, tsconfig.PuzzleMinDifficulty, tsconfig.PuzzleMaxDifficulty),
+		Hints:      hints,
+		CreatedAt:  timeNow(),
+	}
+}
+
 func puzzleFromPreset(res *llmrest.TurtleSoupPuzzlePresetResponse) tsmodel.Puzzle {
 	title := ""
 	if res.Title != nil {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
 	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
 	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
 )
 
 type puzzleMockResponses struct {
@@ -95,7 +97,7 @@ func setupPuzzleTestEnv(t *testing.T, rewriteEnabled bool) *puzzleTestEnv {
 	env.llmClient = llmClient
 
 	cfg := tsconfig.PuzzleConfig{RewriteEnabled: rewriteEnabled}
-	env.svc = NewPuzzleService(llmClient, cfg, dedupStore, logger)
+	env.svc = NewPuzzleService(llmClient, cfg, dedupStore, nil, logger)
 
 	return env
 }
@@ -128,6 +130,49 @@ func TestPuzzleService_GeneratePuzzle_Success(t *testing.T) {
 	}
 }
 
+type fakeReviewQueue struct {
+	queued     []*tsrepo.Puzzle
+	signatures []string
+	err        error
+}
+
+func (q *fakeReviewQueue) QueueGeneratedPuzzle(_ context.Context, puzzle *tsrepo.Puzzle, signature string) (uint64, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	q.queued = append(q.queued, puzzle)
+	q.signatures = append(q.signatures, signature)
+	return uint64(len(q.queued)), nil
+}
+
+func TestPuzzleService_GeneratePuzzle_QueuesForReview(t *testing.T) {
+	env := setupPuzzleTestEnv(t, false)
+	defer env.teardown(t)
+
+	ctx := context.Background()
+	queue := &fakeReviewQueue{}
+	env.svc.cfg.ReviewQueueEnabled = true
+	env.svc.SetReviewQueue(queue)
+
+	puzzle, err := env.svc.GeneratePuzzle(ctx, PuzzleGenerationRequest{}, testhelper.UniqueTestPrefix(t)+"chat_review")
+	if err != nil {
+		t.Fatalf("GeneratePuzzle failed: %v", err)
+	}
+	if len(queue.queued) != 1 || puzzle.ID != 1 {
+		t.Fatalf("expected generated puzzle to be queued with id, got id=%d queued=%d", puzzle.ID, len(queue.queued))
+	}
+	if queue.queued[0].Title != "Gen Title" || queue.queued[0].HintsJSON != `["h1","h2"]` || queue.signatures[0] != computeSignature(puzzle) {
+		t.Fatalf("unexpected queued puzzle: %+v", queue.queued[0])
+	}
+
+	// 대기열 저장 실패는 게임 시작을 막지 않음
+	queue.err = errors.New("db down")
+	puzzle, err = env.svc.GeneratePuzzle(ctx, PuzzleGenerationRequest{}, testhelper.UniqueTestPrefix(t)+"chat_review_fail")
+	if err != nil || puzzle.ID != 0 {
+		t.Fatalf("expected puzzle without id on queue failure, got id=%d err=%v", puzzle.ID, err)
+	}
+}
+
 func TestPuzzleService_GeneratePuzzle_DedupRetry(t *testing.T) {
 	env := setupPuzzleTestEnv(t, false)
 	defer env.teardown(t)
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+// questionEndings: 정규화 시 떼어내는 질문형 어미 (긴 것부터 검사)
+var questionEndings = []string{
+	"인가요", "습니까", "입니까", "나요", "까요", "가요", "니까", "냐", "니", "요",
+}
+
+// QuestionDeduper: 세션 기록에서 이미 했던 질문을 찾아 LLM 호출 없이 돌려줄 수 있게 합니다.
+// 정규화한 질문이 완전히 같으면 전체 기록에서, 글자 bigram 유사도는 최근 질문(엄격 모드면 전체)에서 비교합니다.
+type QuestionDeduper struct {
+	strict bool
+}
+
+// NewQuestionDeduper: QuestionDeduper 인스턴스를 생성합니다.
+func NewQuestionDeduper(cfg tsconfig.QuestionDedupConfig) QuestionDeduper {
+	return QuestionDeduper{strict: cfg.Strict}
+}
+
+// Find: 중복으로 판단된 기록의 질문 번호(1부터)와 항목을 반환합니다. 없으면 0을 반환합니다.
+func (d QuestionDeduper) Find(history []tsmodel.HistoryEntry, question string) (int, tsmodel.HistoryEntry) {
+	target := normalizeQuestion(question)
+	if target == "" {
+		return 0, tsmodel.HistoryEntry{}
+	}
+
+	threshold := tsconfig.QuestionDedupSimilarity
+	windowStart := max(len(history)-tsconfig.QuestionDedupRecentWindow, 0)
+	if d.strict {
+		threshold = tsconfig.QuestionDedupStrictSimilarity
+		windowStart = 0
+	}
+	fuzzy := len([]rune(target)) >= tsconfig.QuestionDedupMinFuzzyRunes
+
+	// 최근 질문부터 확인해 가장 가까운 기록 번호를 안내
+	for i := len(history) - 1; i >= 0; i-- {
+		candidate := normalizeQuestion(history[i].Question)
+		if candidate == "" {
+			continue
+		}
+		if candidate == target {
+			return i + 1, history[i]
+		}
+		if fuzzy && i >= windowStart && questionSimilarity(candidate, target) >= threshold {
+			return i + 1, history[i]
+		}
+	}
+	return 0, tsmodel.HistoryEntry{}
+}
+
+// normalizeQuestion: 대소문자, 공백, 문장부호와 끝의 질문형 어미를 제거합니다.
+func normalizeQuestion(question string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(question) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	normalized := b.String()
+	for _, ending := range questionEndings {
+		trimmed := strings.TrimSuffix(normalized, ending)
+		if trimmed != normalized && trimmed != "" {
+			return trimmed
+		}
+	}
+	return normalized
+}
+
+// questionSimilarity: 두 정규화 문자열의 글자 bigram Dice 계수 (0~1)
+func questionSimilarity(a string, b string) float64 {
+	if a == b {
+		return 1
+	}
+	left := runeBigrams(a)
+	right := runeBigrams(b)
+	if len(left) == 0 || len(right) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(left))
+	for _, g := range left {
+		counts[g]++
+	}
+	shared := 0
+	for _, g := range right {
+		if counts[g] > 0 {
+			counts[g]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(left)+len(right))
+}
+
+func runeBigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return nil
+	}
+	grams := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		grams = append(grams, string(runes[i:i+2]))
+	}
+	return grams
+}
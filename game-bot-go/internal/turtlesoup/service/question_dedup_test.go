@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tserrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/errors"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+func TestQuestionDeduper_Find(t *testing.T) {
+	history := []tsmodel.HistoryEntry{
+		{Question: "남자는 바다에 갔나요?", Answer: "예"},
+		{Question: "수프에 독이 들어 있었나요?", Answer: "아니오"},
+		{Question: "Is it alive?", Answer: "No"},
+	}
+
+	tests := []struct {
+		name     string
+		strict   bool
+		question string
+		want     int
+	}{
+		{name: "normalized exact", question: "남자는 바다에 갔나요", want: 1},
+		{name: "ending and punctuation", question: "남자는 바다에 갔니??", want: 1},
+		{name: "case and spacing", question: "is it ALIVE", want: 3},
+		{name: "near duplicate in strict mode", strict: true, question: "수프에 독이 들어있었습니까?", want: 2},
+		{name: "different question", question: "남자는 산에 갔나요?", want: 0},
+		{name: "short question is not fuzzy matched", strict: true, question: "바다?", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deduper := NewQuestionDeduper(tsconfig.QuestionDedupConfig{Strict: tt.strict})
+			got, _ := deduper.Find(history, tt.question)
+			if got != tt.want {
+				t.Fatalf("Find(%q) = %d, want %d", tt.question, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionSimilarity(t *testing.T) {
+	if got := questionSimilarity("수프에독이들어있었", "수프에독이들어있었"); got != 1 {
+		t.Fatalf("identical similarity = %v", got)
+	}
+	if got := questionSimilarity("남자는바다에갔", "여자는산에왔"); got >= tsconfig.QuestionDedupStrictSimilarity {
+		t.Fatalf("unrelated similarity too high: %v", got)
+	}
+}
+
+func TestGameService_AskQuestion_Duplicate(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := testhelper.UniqueTestPrefix(t) + "sess_dup"
+
+	if _, err := env.svc.StartGame(ctx, sessionID, "user1", env.chatID("chat_dup"), nil, nil, nil); err != nil {
+		t.Fatalf("StartGame failed: %v", err)
+	}
+
+	env.mocks.answer = &llmrest.TurtleSoupAnswerResponse{
+		Answer:        "No",
+		History:       []llmrest.TurtleSoupHistoryItem{{Question: "Is it alive?", Answer: "No"}},
+		QuestionCount: 1,
+	}
+	if _, _, err := env.svc.AskQuestion(ctx, sessionID, "", "", "Is it alive?"); err != nil {
+		t.Fatalf("AskQuestion failed: %v", err)
+	}
+
+	state, _, err := env.svc.AskQuestion(ctx, sessionID, "", "", "is it alive")
+	var duplicate tserrors.DuplicateQuestionError
+	if !errors.As(err, &duplicate) {
+		t.Fatalf("expected DuplicateQuestionError, got %v", err)
+	}
+	if duplicate.QuestionNumber != 1 || duplicate.Answer != "No" {
+		t.Fatalf("unexpected duplicate error: %+v", duplicate)
+	}
+	if state.QuestionCount != 0 {
+		t.Fatalf("duplicate question must not return state, got %+v", state)
+	}
+
+	loaded, err := env.svc.sessionManager.LoadOrThrow(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("load session failed: %v", err)
+	}
+	if loaded.QuestionCount != 1 {
+		t.Fatalf("duplicate question must not be counted, got %d", loaded.QuestionCount)
+	}
+}
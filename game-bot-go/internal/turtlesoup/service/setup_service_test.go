@@ -66,7 +66,7 @@ func TestPrepareNewGame(t *testing.T) {
 	puzzleCfg := tsconfig.PuzzleConfig{
 		RewriteEnabled: false,
 	}
-	puzzleService := NewPuzzleService(llmClient, puzzleCfg, dedupStore, logger)
+	puzzleService := NewPuzzleService(llmClient, puzzleCfg, dedupStore, nil, logger)
 
 	setupService := NewGameSetupService(llmClient, puzzleService, sessionManager, logger)
 
@@ -140,7 +140,7 @@ func TestPrepareNewGame(t *testing.T) {
 		t.Cleanup(func() {
 			_ = clientErr.Close()
 		})
-		svcErr := NewGameSetupService(clientErr, NewPuzzleService(clientErr, puzzleCfg, dedupStore, logger), sessionManager, logger)
+		svcErr := NewGameSetupService(clientErr, NewPuzzleService(clientErr, puzzleCfg, dedupStore, nil, logger), sessionManager, logger)
 
 		chatID := prefix + "chat_err"
 		_, err = svcErr.PrepareNewGame(ctx, prefix+"session_err", "user_1", chatID, nil, nil, nil)
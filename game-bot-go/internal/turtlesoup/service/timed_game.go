@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+)
+
+// StartTimedGame: 제한 시간 안에 풀어야 하는 타임어택 게임을 시작합니다.
+// 마감 시각은 세션 상태에 저장되고, 스케줄러가 경고/시간 초과를 처리하도록 추적 목록에 등록됩니다.
+func (s *GameService) StartTimedGame(
+	ctx context.Context,
+	sessionID string,
+	userID string,
+	chatID string,
+	difficulty *int,
+	timeLimit time.Duration,
+) (tsmodel.GameState, error) {
+	if timeLimit <= 0 {
+		return tsmodel.GameState{}, errors.New("time limit must be positive")
+	}
+	return s.startGame(ctx, sessionID, userID, chatID, difficulty, nil, nil, timeLimit)
+}
+
+// applyDeadline: 준비된 게임 상태에 마감 시각을 기록하고 추적 목록에 등록합니다.
+func (s *GameService) applyDeadline(ctx context.Context, state tsmodel.GameState, timeLimit time.Duration) (tsmodel.GameState, error) {
+	if s.timedStore == nil {
+		return tsmodel.GameState{}, errors.New("timed mode is not configured")
+	}
+	timed := state.WithDeadline(time.Now().Add(timeLimit))
+	if err := s.sessionManager.Save(ctx, timed); err != nil {
+		return tsmodel.GameState{}, err
+	}
+	if err := s.timedStore.Track(ctx, timed.SessionID, *timed.Deadline); err != nil {
+		return tsmodel.GameState{}, err
+	}
+	return timed, nil
+}
+
+// ExpireTimedGame: 마감 시각이 지난 타임어택 게임을 종료합니다.
+// 실제로 종료한 경우 종료 직전 상태와 true를 반환하며, 이미 풀었거나 아직 시간이 남았으면 false를 반환합니다.
+func (s *GameService) ExpireTimedGame(ctx context.Context, sessionID string) (tsmodel.GameState, bool, error) {
+	var (
+		expired tsmodel.GameState
+		ok      bool
+	)
+	err := s.sessionManager.WithOwnerLock(ctx, sessionID, func(ctx context.Context) error {
+		state, err := s.sessionManager.Load(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if state == nil || !state.IsTimed() || state.IsSolved || state.Remaining(time.Now()) > 0 {
+			return nil
+		}
+
+		if err := s.sessionManager.Delete(ctx, sessionID); err != nil {
+			return err
+		}
+		_, _ = s.restClient.EndSessionByChat(ctx, tsconfig.LlmNamespace, chatIDOf(*state))
+
+		s.logger.Info("game_timed_out", "session_id", sessionID, "question_count", state.QuestionCount, "hints_used", state.HintsUsed)
+		s.publishGameCompleted(ctx, *state, gameResultTimeout)
+
+		expired = *state
+		ok = true
+		return nil
+	})
+	if err != nil {
+		return tsmodel.GameState{}, false, err
+	}
+	if ok && s.timedStore != nil {
+		if err := s.timedStore.Untrack(ctx, sessionID); err != nil {
+			s.logger.Warn("timed_untrack_failed", "session_id", sessionID, "err", err)
+		}
+	}
+	return expired, ok, nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/messages"
+	tsmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/model"
+	tsredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/redis"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+)
+
+// archiveResultTimeout: 시간 초과로 끝난 게임의 GameArchive.Result 값
+const archiveResultTimeout = "timeout"
+
+// TimedPublishFunc: 타임어택 경고/시간 초과 메시지를 채팅방으로 발행하는 함수
+type TimedPublishFunc func(ctx context.Context, message mqmsg.OutboundMessage) error
+
+// GameArchiver: 종료된 게임을 영구 저장소에 기록하는 인터페이스 (tsrepo.Repository가 구현)
+type GameArchiver interface {
+	ArchiveGame(ctx context.Context, p tsrepo.ArchiveGameParams) error
+}
+
+// TimedGameScheduler: 진행 중인 타임어택 게임의 남은 시간을 주기적으로 확인하는 스케줄러입니다.
+// 경고 구간에 들어서면 남은 시간을 알리고, 마감이 지나면 정답을 공개한 뒤 결과를 아카이브합니다.
+type TimedGameScheduler struct {
+	cfg            tsconfig.TimedConfig
+	gameService    *GameService
+	sessionManager *GameSessionManager
+	store          *tsredis.TimedStore
+	archiver       GameArchiver
+	publish        TimedPublishFunc
+	msgProvider    *messageprovider.Provider
+	logger         *slog.Logger
+}
+
+// NewTimedGameScheduler: TimedGameScheduler 인스턴스를 생성합니다. archiver가 nil이면 아카이브를 건너뜁니다.
+func NewTimedGameScheduler(
+	cfg tsconfig.TimedConfig,
+	gameService *GameService,
+	sessionManager *GameSessionManager,
+	store *tsredis.TimedStore,
+	archiver GameArchiver,
+	publish TimedPublishFunc,
+	msgProvider *messageprovider.Provider,
+	logger *slog.Logger,
+) *TimedGameScheduler {
+	return &TimedGameScheduler{
+		cfg:            cfg,
+		gameService:    gameService,
+		sessionManager: sessionManager,
+		store:          store,
+		archiver:       archiver,
+		publish:        publish,
+		msgProvider:    msgProvider,
+		logger:         logger,
+	}
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 타임어택 게임을 확인합니다.
+func (s *TimedGameScheduler) Run(ctx context.Context) error {
+	s.logger.Info("timed_game_scheduler_started",
+		"tick_interval", s.cfg.TickInterval,
+		"warnings", s.cfg.Warnings,
+	)
+
+	ticker := time.NewTicker(s.cfg.TickInterval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *TimedGameScheduler) tick(ctx context.Context, now time.Time) {
+	entries, err := s.store.List(ctx)
+	if err != nil {
+		s.logger.Warn("timed_list_failed", "err", err)
+		return
+	}
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+		s.check(ctx, entry, now)
+	}
+}
+
+func (s *TimedGameScheduler) check(ctx context.Context, entry tsredis.TimedEntry, now time.Time) {
+	state, err := s.sessionManager.Load(ctx, entry.SessionID)
+	if err != nil {
+		s.logger.Warn("timed_load_failed", "session_id", entry.SessionID, "err", err)
+		return
+	}
+	// 정답/포기/종료로 이미 끝난 게임은 추적에서 제외
+	if state == nil || !state.IsTimed() || state.IsSolved {
+		if err := s.store.Untrack(ctx, entry.SessionID); err != nil {
+			s.logger.Warn("timed_untrack_failed", "session_id", entry.SessionID, "err", err)
+		}
+		return
+	}
+
+	remaining := state.Remaining(now)
+	if remaining <= 0 {
+		s.expire(ctx, entry.SessionID)
+		return
+	}
+
+	threshold, ok := warningThreshold(s.cfg.Warnings, remaining)
+	if !ok {
+		return
+	}
+	first, err := s.store.MarkWarned(ctx, entry.SessionID, *state.Deadline, threshold)
+	if err != nil {
+		s.logger.Warn("timed_mark_warned_failed", "session_id", entry.SessionID, "err", err)
+		return
+	}
+	if !first {
+		return
+	}
+
+	text := s.msgProvider.Get(tsmessages.TimedWarning, messageprovider.P("remaining", FormatDuration(threshold)))
+	if err := s.publish(ctx, mqmsg.NewFinal(chatIDOf(*state), text, nil)); err != nil {
+		s.logger.Warn("timed_warning_publish_failed", "session_id", entry.SessionID, "err", err)
+	}
+}
+
+func (s *TimedGameScheduler) expire(ctx context.Context, sessionID string) {
+	state, expired, err := s.gameService.ExpireTimedGame(ctx, sessionID)
+	if err != nil {
+		// 질문 처리 중이라 락을 얻지 못한 경우 등은 다음 주기에 다시 시도
+		s.logger.Warn("timed_expire_failed", "session_id", sessionID, "err", err)
+		return
+	}
+	if !expired {
+		return
+	}
+
+	text := s.buildTimeoutMessage(state)
+	text = AppendContributionBlock(s.msgProvider, text, state.ContributionScores())
+	if s.gameService.OpenRating(ctx, state) {
+		text = strings.TrimRight(text, "\n") + "\n\n" + s.msgProvider.Get(
+			tsmessages.RatingPrompt,
+			messageprovider.P("minutes", tsconfig.RatingWindowTTLSeconds/60),
+			messageprovider.P("min", tsconfig.PuzzleRatingMin),
+			messageprovider.P("max", tsconfig.PuzzleRatingMax),
+		)
+	}
+	if err := s.publish(ctx, mqmsg.NewFinal(chatIDOf(state), text, nil)); err != nil {
+		s.logger.Warn("timed_timeout_publish_failed", "session_id", sessionID, "err", err)
+	}
+	s.archive(ctx, state)
+}
+
+func (s *TimedGameScheduler) buildTimeoutMessage(state tsmodel.GameState) string {
+	solution := s.msgProvider.Get(tsmessages.FallbackPuzzleNotFound)
+	if state.Puzzle != nil {
+		solution = state.Puzzle.Solution
+	}
+	return s.msgProvider.Get(
+		tsmessages.TimedTimeout,
+		messageprovider.P("limit", FormatDuration(state.Deadline.Sub(state.StartedAt).Round(time.Minute))),
+		messageprovider.P("solution", solution),
+		messageprovider.P("questionCount", state.QuestionCount),
+		messageprovider.P("hintCount", state.HintsUsed),
+		messageprovider.P("maxHints", tsconfig.GameMaxHints),
+	)
+}
+
+func (s *TimedGameScheduler) archive(ctx context.Context, state tsmodel.GameState) {
+	if s.archiver == nil {
+		return
+	}
+
+	history, err := json.Marshal(state.History)
+	if err != nil {
+		s.logger.Warn("timed_archive_marshal_failed", "session_id", state.SessionID, "err", err)
+		return
+	}
+	contributions, err := json.Marshal(state.ContributionScores())
+	if err != nil {
+		s.logger.Warn("timed_archive_marshal_failed", "session_id", state.SessionID, "err", err)
+		return
+	}
+	err = s.archiver.ArchiveGame(ctx, tsrepo.ArchiveGameParams{
+		SessionID:         archiveSessionID(state),
+		ChatID:            chatIDOf(state),
+		PuzzleID:          archivePuzzleID(state),
+		QuestionCount:     state.QuestionCount,
+		HintsUsed:         state.HintsUsed,
+		Result:            archiveResultTimeout,
+		HistoryJSON:       string(history),
+		ContributionsJSON: string(contributions),
+		StartedAt:         state.StartedAt,
+		CompletedAt:       *state.Deadline,
+	})
+	if err != nil {
+		s.logger.Warn("timed_archive_failed", "session_id", state.SessionID, "err", err)
+	}
+}
+
+// warningThreshold: 남은 시간이 속한 가장 작은 경고 구간을 반환합니다. warnings는 내림차순이어야 합니다.
+// 스케줄러가 잠시 멈췄다가 돌아와도 지나간 구간의 경고를 몰아서 보내지 않도록 현재 구간 하나만 고릅니다.
+func warningThreshold(warnings []time.Duration, remaining time.Duration) (time.Duration, bool) {
+	var (
+		picked time.Duration
+		ok     bool
+	)
+	for _, w := range warnings {
+		if remaining <= w {
+			picked, ok = w, true
+		}
+	}
+	return picked, ok
+}
+
+// FormatDuration: 시간을 "N분 M초" 형태의 한국어 문자열로 변환합니다.
+func FormatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	minutes := int(d / time.Minute)
+	seconds := int((d % time.Minute) / time.Second)
+	switch {
+	case minutes > 0 && seconds > 0:
+		return fmt.Sprintf("%d분 %d초", minutes, seconds)
+	case minutes > 0:
+		return fmt.Sprintf("%d분", minutes)
+	default:
+		return fmt.Sprintf("%d초", seconds)
+	}
+}
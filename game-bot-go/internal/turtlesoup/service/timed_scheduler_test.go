@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	tsassets "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/assets"
+	tsconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/config"
+	tsrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/turtlesoup/repository"
+)
+
+func TestWarningThreshold(t *testing.T) {
+	warnings := []time.Duration{5 * time.Minute, time.Minute, 30 * time.Second}
+
+	tests := []struct {
+		remaining time.Duration
+		want      time.Duration
+		wantOK    bool
+	}{
+		{6 * time.Minute, 0, false},
+		{5 * time.Minute, 5 * time.Minute, true},
+		{4 * time.Minute, 5 * time.Minute, true},
+		{50 * time.Second, time.Minute, true},
+		{10 * time.Second, 30 * time.Second, true},
+	}
+	for _, tt := range tests {
+		got, ok := warningThreshold(warnings, tt.remaining)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("warningThreshold(%v) = %v, %v; want %v, %v", tt.remaining, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		10 * time.Minute:                     "10분",
+		90 * time.Second:                     "1분 30초",
+		30 * time.Second:                     "30초",
+		1500 * time.Millisecond:              "2초",
+		2*time.Minute + 400*time.Millisecond: "2분",
+	}
+	for in, want := range tests {
+		if got := FormatDuration(in); got != want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+type recordingArchiver struct {
+	mu     sync.Mutex
+	params []tsrepo.ArchiveGameParams
+}
+
+func (a *recordingArchiver) ArchiveGame(_ context.Context, p tsrepo.ArchiveGameParams) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.params = append(a.params, p)
+	return nil
+}
+
+func TestTimedGameScheduler_WarnsOnceAndExpires(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := env.chatID("timed_sched")
+	limit := 3 * time.Minute
+
+	state, err := env.svc.StartTimedGame(ctx, sessionID, "user1", sessionID, nil, limit)
+	if err != nil {
+		t.Fatalf("StartTimedGame failed: %v", err)
+	}
+	if !state.IsTimed() {
+		t.Fatal("expected timed state")
+	}
+
+	msgProvider, err := messageprovider.NewFromYAML(tsassets.GameMessagesYAML)
+	if err != nil {
+		t.Fatalf("message provider: %v", err)
+	}
+	var published []mqmsg.OutboundMessage
+	publish := func(_ context.Context, msg mqmsg.OutboundMessage) error {
+		published = append(published, msg)
+		return nil
+	}
+	archiver := &recordingArchiver{}
+	cfg := tsconfig.TimedConfig{Warnings: []time.Duration{5 * time.Minute, time.Minute}, TickInterval: time.Second}
+	scheduler := NewTimedGameScheduler(cfg, env.svc, env.svc.sessionManager, env.timedStore, archiver, publish, msgProvider,
+		slog.New(slog.NewTextHandler(os.Stdout, nil)))
+
+	// 5분 구간 경고는 한 번만 전송
+	scheduler.tick(ctx, time.Now())
+	scheduler.tick(ctx, time.Now())
+	if len(published) != 1 || !strings.Contains(published[0].Text, "5분") {
+		t.Fatalf("expected single 5분 warning, got %+v", published)
+	}
+
+	// 마감 직전으로 건너뛰면 1분 구간 경고
+	scheduler.tick(ctx, state.Deadline.Add(-30*time.Second))
+	if len(published) != 2 || !strings.Contains(published[1].Text, "1분") {
+		t.Fatalf("expected 1분 warning, got %+v", published)
+	}
+
+	// 마감 시각을 과거로 당겨 시간 초과 처리
+	expired := state.WithDeadline(time.Now().Add(-time.Second))
+	if err := env.sessionStore.SaveGameState(ctx, expired); err != nil {
+		t.Fatalf("save state: %v", err)
+	}
+	scheduler.tick(ctx, time.Now())
+
+	if len(published) != 3 || !strings.Contains(published[2].Text, "He was thirsty.") {
+		t.Fatalf("expected timeout message revealing solution, got %+v", published)
+	}
+	if len(archiver.params) != 1 || archiver.params[0].Result != "timeout" || archiver.params[0].ChatID != sessionID {
+		t.Fatalf("expected timeout archive, got %+v", archiver.params)
+	}
+	if loaded, _ := env.sessionStore.LoadGameState(ctx, sessionID); loaded != nil {
+		t.Fatal("expected session to be deleted after timeout")
+	}
+	entries, err := env.timedStore.List(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	for _, e := range entries {
+		if e.SessionID == sessionID {
+			t.Fatal("expected session to be untracked after timeout")
+		}
+	}
+
+	// 이미 끝난 게임은 다시 처리하지 않음
+	scheduler.tick(ctx, time.Now())
+	if len(published) != 3 || len(archiver.params) != 1 {
+		t.Fatalf("expected no further messages, got %d messages / %d archives", len(published), len(archiver.params))
+	}
+}
+
+func TestGameService_ExpireTimedGame_NotYetDue(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	sessionID := env.chatID("timed_not_due")
+	if _, err := env.svc.StartTimedGame(ctx, sessionID, "user1", sessionID, nil, time.Minute); err != nil {
+		t.Fatalf("StartTimedGame failed: %v", err)
+	}
+
+	_, expired, err := env.svc.ExpireTimedGame(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("ExpireTimedGame failed: %v", err)
+	}
+	if expired {
+		t.Fatal("expected game with time left not to expire")
+	}
+	if loaded, _ := env.sessionStore.LoadGameState(ctx, sessionID); loaded == nil || !loaded.IsTimed() {
+		t.Fatalf("expected timed session to remain, got %+v", loaded)
+	}
+}
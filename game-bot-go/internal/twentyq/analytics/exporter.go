@@ -0,0 +1,206 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/parquet-go/parquet-go"
+	"gorm.io/gorm"
+
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+// Timezone: 파티션 날짜 계산 기준 시간대 (KST)
+var Timezone = time.FixedZone("KST", 9*60*60)
+
+// Exporter: 하루치 게임 기록을 익명화하여 Parquet 파티션으로 내보내고 매니페스트를 갱신합니다.
+type Exporter struct {
+	db     *gorm.DB
+	sink   Sink
+	anon   Anonymizer
+	logger *slog.Logger
+
+	// mu: 매니페스트 읽기-수정-쓰기를 직렬화 (수동 실행과 스케줄러가 겹칠 수 있음)
+	mu sync.Mutex
+}
+
+// NewExporter: 새로운 Exporter 인스턴스를 생성합니다.
+func NewExporter(db *gorm.DB, sink Sink, anon Anonymizer, logger *slog.Logger) *Exporter {
+	return &Exporter{
+		db:     db,
+		sink:   sink,
+		anon:   anon,
+		logger: logger,
+	}
+}
+
+// ExportDate: date가 속한 KST 하루의 게임/참여자 기록을 내보냅니다.
+// 기록이 없는 날도 빈 파티션을 남겨 "내보냈지만 0건"과 "아직 안 내보냄"을 구분할 수 있게 합니다.
+func (e *Exporter) ExportDate(ctx context.Context, date time.Time) ([]Partition, error) {
+	day := DayOf(date)
+	start, end := day, day.AddDate(0, 0, 1)
+	dateKey := day.Format(time.DateOnly)
+
+	games, err := e.loadGames(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	players, err := e.loadPlayers(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	gamesPart, err := writePartition(ctx, e.sink, TableGames, dateKey, games)
+	if err != nil {
+		return nil, err
+	}
+	playersPart, err := writePartition(ctx, e.sink, TablePlayers, dateKey, players)
+	if err != nil {
+		return nil, err
+	}
+	partitions := []Partition{gamesPart, playersPart}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	manifest, err := e.loadManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifest.upsert(partitions...)
+	manifest.UpdatedAt = time.Now()
+	if err := e.saveManifest(ctx, manifest); err != nil {
+		return nil, err
+	}
+
+	e.logger.Info("analytics_export_completed",
+		"date", dateKey,
+		"games", gamesPart.Rows,
+		"players", playersPart.Rows,
+	)
+	return partitions, nil
+}
+
+// Manifest: 현재 매니페스트를 반환합니다. 아직 내보낸 적이 없으면 빈 매니페스트를 반환합니다.
+func (e *Exporter) Manifest(ctx context.Context) (Manifest, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.loadManifest(ctx)
+}
+
+func (e *Exporter) loadGames(ctx context.Context, start time.Time, end time.Time) ([]GameFact, error) {
+	var rows []qrepo.GameSession
+	err := e.db.WithContext(ctx).
+		Where("completed_at >= ? AND completed_at < ?", start, end).
+		Order("completed_at ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("load game sessions failed: %w", err)
+	}
+
+	facts := make([]GameFact, 0, len(rows))
+	for _, row := range rows {
+		facts = append(facts, GameFact{
+			ChatKey:          e.anon.ChatKey(row.ChatID),
+			Category:         row.Category,
+			Target:           row.Target,
+			Result:           row.Result,
+			ParticipantCount: int32(row.ParticipantCount),
+			QuestionCount:    int32(row.QuestionCount),
+			HintCount:        int32(row.HintCount),
+			CompletedAt:      row.CompletedAt.UTC(),
+		})
+	}
+	return facts, nil
+}
+
+func (e *Exporter) loadPlayers(ctx context.Context, start time.Time, end time.Time) ([]PlayerFact, error) {
+	var rows []qrepo.GameLog
+	err := e.db.WithContext(ctx).
+		Where("completed_at >= ? AND completed_at < ?", start, end).
+		Order("completed_at ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("load game logs failed: %w", err)
+	}
+
+	// Sender(닉네임)와 Target은 참여자 기록에서 제외
+	facts := make([]PlayerFact, 0, len(rows))
+	for _, row := range rows {
+		facts = append(facts, PlayerFact{
+			ChatKey:         e.anon.ChatKey(row.ChatID),
+			UserKey:         e.anon.UserKey(row.UserID),
+			Category:        row.Category,
+			Result:          row.Result,
+			QuestionCount:   int32(row.QuestionCount),
+			HintCount:       int32(row.HintCount),
+			WrongGuessCount: int32(row.WrongGuessCount),
+			CompletedAt:     row.CompletedAt.UTC(),
+		})
+	}
+	return facts, nil
+}
+
+func (e *Exporter) loadManifest(ctx context.Context) (Manifest, error) {
+	raw, err := e.sink.Get(ctx, manifestKey)
+	if err != nil {
+		if errors.Is(err, ErrObjectNotFound) {
+			return Manifest{}, nil
+		}
+		return Manifest{}, fmt.Errorf("load analytics manifest failed: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("decode analytics manifest failed: %w", err)
+	}
+	return manifest, nil
+}
+
+func (e *Exporter) saveManifest(ctx context.Context, manifest Manifest) error {
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode analytics manifest failed: %w", err)
+	}
+	if err := e.sink.Put(ctx, manifestKey, raw, "application/json"); err != nil {
+		return fmt.Errorf("save analytics manifest failed: %w", err)
+	}
+	return nil
+}
+
+// writePartition: 행을 zstd 압축 Parquet으로 직렬화하여 저장합니다.
+func writePartition[T any](ctx context.Context, sink Sink, table string, date string, rows []T) (Partition, error) {
+	var buf bytes.Buffer
+	writer := parquet.NewGenericWriter[T](&buf, parquet.Compression(&parquet.Zstd))
+	if _, err := writer.Write(rows); err != nil {
+		return Partition{}, fmt.Errorf("encode %s parquet failed: %w", table, err)
+	}
+	if err := writer.Close(); err != nil {
+		return Partition{}, fmt.Errorf("close %s parquet failed: %w", table, err)
+	}
+
+	key := partitionKey(table, date)
+	if err := sink.Put(ctx, key, buf.Bytes(), "application/vnd.apache.parquet"); err != nil {
+		return Partition{}, fmt.Errorf("store %s partition failed: %w", table, err)
+	}
+	return Partition{
+		Table:      table,
+		Date:       date,
+		Key:        key,
+		Location:   sink.Location(key),
+		Rows:       len(rows),
+		Bytes:      buf.Len(),
+		ExportedAt: time.Now(),
+	}, nil
+}
+
+// DayOf: 시각이 속한 KST 날짜의 자정을 반환합니다.
+func DayOf(t time.Time) time.Time {
+	local := t.In(Timezone)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, Timezone)
+}
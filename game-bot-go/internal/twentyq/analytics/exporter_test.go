@@ -0,0 +1,212 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/parquet-go/parquet-go"
+	"gorm.io/gorm"
+
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(&qrepo.GameSession{}, &qrepo.GameLog{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func readParquet[T any](t *testing.T, path string) []T {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	rows, err := parquet.Read[T](bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		t.Fatalf("decode %s: %v", path, err)
+	}
+	return rows
+}
+
+func TestExporter_ExportDate(t *testing.T) {
+	db := newTestDB(t)
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, Timezone)
+	inDay := day.Add(13 * time.Hour)
+
+	db.Create(&qrepo.GameSession{SessionID: "s1", ChatID: "room-1", Category: "animal", Target: "호랑이", Result: "CORRECT", ParticipantCount: 2, QuestionCount: 7, CompletedAt: inDay})
+	db.Create(&qrepo.GameSession{SessionID: "s0", ChatID: "room-1", Category: "food", Result: "SURRENDER", CompletedAt: day.Add(-time.Minute)})
+	db.Create(&qrepo.GameLog{ChatID: "room-1", UserID: "user-1", Sender: "닉네임", Category: "animal", Result: "CORRECT", QuestionCount: 4, WrongGuessCount: 1, CompletedAt: inDay})
+
+	dir := t.TempDir()
+	anon := NewAnonymizer("0123456789abcdef")
+	exporter := NewExporter(db, NewLocalSink(dir), anon, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	partitions, err := exporter.ExportDate(context.Background(), inDay)
+	if err != nil {
+		t.Fatalf("ExportDate failed: %v", err)
+	}
+	if len(partitions) != 2 || partitions[0].Key != "games/date=2026-10-17/part-0.parquet" || partitions[0].Rows != 1 {
+		t.Fatalf("unexpected partitions: %+v", partitions)
+	}
+
+	games := readParquet[GameFact](t, filepath.Join(dir, "games", "date=2026-10-17", "part-0.parquet"))
+	if len(games) != 1 {
+		t.Fatalf("expected 1 game (previous day excluded), got %d", len(games))
+	}
+	if games[0].ChatKey != anon.ChatKey("room-1") || strings.Contains(games[0].ChatKey, "room") {
+		t.Errorf("expected anonymized chat key, got %q", games[0].ChatKey)
+	}
+	if games[0].QuestionCount != 7 || !games[0].CompletedAt.Equal(inDay) {
+		t.Errorf("unexpected game fact: %+v", games[0])
+	}
+
+	players := readParquet[PlayerFact](t, filepath.Join(dir, "players", "date=2026-10-17", "part-0.parquet"))
+	if len(players) != 1 || players[0].UserKey != anon.UserKey("user-1") || players[0].WrongGuessCount != 1 {
+		t.Fatalf("unexpected player facts: %+v", players)
+	}
+	raw, _ := os.ReadFile(filepath.Join(dir, "players", "date=2026-10-17", "part-0.parquet"))
+	for _, secret := range []string{"user-1", "room-1", "닉네임"} {
+		if bytes.Contains(raw, []byte(secret)) {
+			t.Errorf("player partition leaks %q", secret)
+		}
+	}
+
+	manifest, err := exporter.Manifest(context.Background())
+	if err != nil {
+		t.Fatalf("Manifest failed: %v", err)
+	}
+	if !manifest.Has("2026-10-17") || manifest.Has("2026-10-16") {
+		t.Fatalf("unexpected manifest: %+v", manifest)
+	}
+
+	// 같은 날짜를 다시 내보내면 항목을 교체
+	if _, err := exporter.ExportDate(context.Background(), inDay); err != nil {
+		t.Fatalf("re-export failed: %v", err)
+	}
+	manifest, _ = exporter.Manifest(context.Background())
+	if len(manifest.Partitions) != 2 {
+		t.Fatalf("expected re-export to replace partitions, got %+v", manifest.Partitions)
+	}
+}
+
+func TestAnonymizer_Keys(t *testing.T) {
+	a := NewAnonymizer("0123456789abcdef")
+	b := NewAnonymizer("another-salt-value")
+
+	if a.UserKey("same") == a.ChatKey("same") {
+		t.Error("user and chat keys must not collide for the same raw id")
+	}
+	if a.UserKey("u1") != a.UserKey("u1") {
+		t.Error("keys must be stable for the same salt")
+	}
+	if a.UserKey("u1") == b.UserKey("u1") {
+		t.Error("keys must depend on the salt")
+	}
+}
+
+func TestScheduler_BackfillsMissingDays(t *testing.T) {
+	db := newTestDB(t)
+	dir := t.TempDir()
+	exporter := NewExporter(db, NewLocalSink(dir), NewAnonymizer("0123456789abcdef"), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scheduler := NewScheduler(exporter, 4*60, 3, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	// 실행 시각 이전에는 아무것도 하지 않음
+	scheduler.tick(ctx, time.Date(2026, 10, 18, 3, 59, 0, 0, Timezone))
+	if m, _ := exporter.Manifest(ctx); len(m.Partitions) != 0 {
+		t.Fatalf("expected no export before run time, got %+v", m.Partitions)
+	}
+
+	scheduler.tick(ctx, time.Date(2026, 10, 18, 4, 0, 0, 0, Timezone))
+	m, _ := exporter.Manifest(ctx)
+	for _, date := range []string{"2026-10-15", "2026-10-16", "2026-10-17"} {
+		if !m.Has(date) {
+			t.Errorf("expected %s to be exported", date)
+		}
+	}
+	if m.Has("2026-10-18") || m.Has("2026-10-14") {
+		t.Errorf("unexpected dates exported: %+v", m.Partitions)
+	}
+}
+
+func TestS3Sink_PutGet(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewS3Sink(S3Config{
+		Bucket:          "bucket",
+		Prefix:          "/twentyq/",
+		Region:          "ap-northeast-2",
+		Endpoint:        server.URL,
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	}, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err := sink.Get(ctx, manifestKey); err != ErrObjectNotFound {
+		t.Fatalf("expected ErrObjectNotFound, got %v", err)
+	}
+	if err := sink.Put(ctx, "games/date=2026-10-17/part-0.parquet", []byte("data"), "application/vnd.apache.parquet"); err != nil {
+		t.Fatalf("put failed: %v", err)
+	}
+	if _, ok := objects["/bucket/twentyq/games/date=2026-10-17/part-0.parquet"]; !ok {
+		t.Fatalf("unexpected object paths: %v", objects)
+	}
+	got, err := sink.Get(ctx, "games/date=2026-10-17/part-0.parquet")
+	if err != nil || string(got) != "data" {
+		t.Fatalf("get = %q, %v", got, err)
+	}
+	if loc := sink.Location("manifest.json"); loc != "s3://bucket/twentyq/manifest.json" {
+		t.Errorf("unexpected location %q", loc)
+	}
+}
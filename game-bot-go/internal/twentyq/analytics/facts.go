@@ -0,0 +1,66 @@
+// Package analytics 는 스무고개 게임 기록을 익명화된 Parquet 파티션으로 내보내는 기능을 제공합니다.
+// 원본 사용자/채팅방 ID와 닉네임, 대화 내용은 내보내지 않으며, ID는 솔트를 넣은 HMAC 해시로 대체합니다.
+package analytics
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// 내보내는 테이블 이름 (파티션 경로의 첫 번째 구간)
+const (
+	TableGames   = "games"
+	TablePlayers = "players"
+)
+
+// GameFact: 게임 한 판의 익명화된 결과 (game_sessions 기준)
+type GameFact struct {
+	ChatKey          string    `parquet:"chat_key"`
+	Category         string    `parquet:"category"`
+	Target           string    `parquet:"target"`
+	Result           string    `parquet:"result"`
+	ParticipantCount int32     `parquet:"participant_count"`
+	QuestionCount    int32     `parquet:"question_count"`
+	HintCount        int32     `parquet:"hint_count"`
+	CompletedAt      time.Time `parquet:"completed_at,timestamp(millisecond)"`
+}
+
+// PlayerFact: 참여자별 익명화된 플레이 기록 (game_logs 기준)
+type PlayerFact struct {
+	ChatKey         string    `parquet:"chat_key"`
+	UserKey         string    `parquet:"user_key"`
+	Category        string    `parquet:"category"`
+	Result          string    `parquet:"result"`
+	QuestionCount   int32     `parquet:"question_count"`
+	HintCount       int32     `parquet:"hint_count"`
+	WrongGuessCount int32     `parquet:"wrong_guess_count"`
+	CompletedAt     time.Time `parquet:"completed_at,timestamp(millisecond)"`
+}
+
+// Anonymizer: 원본 ID를 되돌릴 수 없는 고정 길이 키로 바꿉니다.
+// 같은 솔트를 쓰는 동안은 같은 ID가 항상 같은 키가 되므로 파티션을 넘나드는 조인이 가능합니다.
+type Anonymizer struct {
+	salt []byte
+}
+
+// NewAnonymizer: 주어진 솔트로 Anonymizer를 생성합니다.
+func NewAnonymizer(salt string) Anonymizer {
+	return Anonymizer{salt: []byte(salt)}
+}
+
+// ChatKey: 채팅방 ID의 익명 키를 반환합니다.
+func (a Anonymizer) ChatKey(chatID string) string { return a.key("chat", chatID) }
+
+// UserKey: 사용자 ID의 익명 키를 반환합니다.
+func (a Anonymizer) UserKey(userID string) string { return a.key("user", userID) }
+
+// key: 종류별로 네임스페이스를 나눠 채팅방 ID와 사용자 ID가 우연히 같아도 키가 겹치지 않게 합니다.
+func (a Anonymizer) key(kind string, id string) string {
+	mac := hmac.New(sha256.New, a.salt)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil)[:12])
+}
@@ -0,0 +1,58 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// manifestKey: 내보낸 파티션 목록을 기록하는 객체 키
+const manifestKey = "manifest.json"
+
+// Partition: 내보낸 파티션 하나 (테이블 + 날짜)
+type Partition struct {
+	Table      string    `json:"table"`
+	Date       string    `json:"date"` // YYYY-MM-DD (KST)
+	Key        string    `json:"key"`
+	Location   string    `json:"location"`
+	Rows       int       `json:"rows"`
+	Bytes      int       `json:"bytes"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// Manifest: 사용 가능한 파티션 목록
+type Manifest struct {
+	UpdatedAt  time.Time   `json:"updatedAt"`
+	Partitions []Partition `json:"partitions"`
+}
+
+// Has: 해당 날짜의 모든 테이블 파티션이 기록되어 있는지 확인합니다.
+func (m Manifest) Has(date string) bool {
+	for _, table := range []string{TableGames, TablePlayers} {
+		found := slices.ContainsFunc(m.Partitions, func(p Partition) bool {
+			return p.Table == table && p.Date == date
+		})
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// upsert: 같은 테이블/날짜 항목을 교체하고 테이블, 날짜 순으로 정렬합니다.
+func (m *Manifest) upsert(partitions ...Partition) {
+	for _, p := range partitions {
+		m.Partitions = slices.DeleteFunc(m.Partitions, func(existing Partition) bool {
+			return existing.Table == p.Table && existing.Date == p.Date
+		})
+		m.Partitions = append(m.Partitions, p)
+	}
+	slices.SortFunc(m.Partitions, func(a, b Partition) int {
+		return cmp.Or(cmp.Compare(a.Table, b.Table), cmp.Compare(a.Date, b.Date))
+	})
+}
+
+// partitionKey: Hive 형식(date=YYYY-MM-DD) 경로를 만들어 DuckDB/Spark가 날짜 컬럼으로 인식하게 합니다.
+func partitionKey(table string, date string) string {
+	return table + "/date=" + date + "/part-0.parquet"
+}
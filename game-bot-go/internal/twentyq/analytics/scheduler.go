@@ -0,0 +1,78 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// schedulerTickInterval: 내보내기 시각 도달 여부를 확인하는 주기
+const schedulerTickInterval = time.Minute
+
+// Scheduler: 매일 지정 시각 이후 최근 며칠 중 아직 내보내지 않은 날짜를 내보내는 스케줄러입니다.
+// 완료 여부는 매니페스트로 판단하므로 다운타임 뒤에도 빠진 날짜를 채워 넣습니다.
+type Scheduler struct {
+	exporter     *Exporter
+	runAtMinute  int
+	backfillDays int
+	logger       *slog.Logger
+}
+
+// NewScheduler: 새로운 Scheduler 인스턴스를 생성합니다.
+func NewScheduler(exporter *Exporter, runAtMinute int, backfillDays int, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		exporter:     exporter,
+		runAtMinute:  runAtMinute,
+		backfillDays: max(backfillDays, 1),
+		logger:       logger,
+	}
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 내보낼 날짜를 확인합니다.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.logger.Info("analytics_export_scheduler_started",
+		"run_at_minute", s.runAtMinute,
+		"backfill_days", s.backfillDays,
+	)
+
+	ticker := time.NewTicker(schedulerTickInterval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick: 오늘 실행 시각이 지났으면 어제부터 backfillDays일 전까지 누락된 날짜를 내보냅니다.
+// 오늘 날짜는 아직 진행 중이므로 내보내지 않습니다.
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	today := DayOf(now)
+	if now.Before(today.Add(time.Duration(s.runAtMinute) * time.Minute)) {
+		return
+	}
+
+	manifest, err := s.exporter.Manifest(ctx)
+	if err != nil {
+		s.logger.Warn("analytics_manifest_load_failed", "err", err)
+		return
+	}
+
+	for offset := s.backfillDays; offset >= 1; offset-- {
+		if ctx.Err() != nil {
+			return
+		}
+		date := today.AddDate(0, 0, -offset)
+		if manifest.Has(date.Format(time.DateOnly)) {
+			continue
+		}
+		if _, err := s.exporter.ExportDate(ctx, date); err != nil {
+			s.logger.Warn("analytics_export_failed", "date", date.Format(time.DateOnly), "err", err)
+		}
+	}
+}
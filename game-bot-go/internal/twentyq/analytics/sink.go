@@ -0,0 +1,199 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrObjectNotFound: 저장소에 요청한 객체가 없을 때 반환됩니다.
+var ErrObjectNotFound = errors.New("analytics object not found")
+
+// Sink: 내보낸 파일을 저장하는 대상 (로컬 디스크 또는 S3 호환 스토리지)
+// key는 "/"로 구분된 상대 경로입니다.
+type Sink interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Location: 로그/매니페스트에 표시할 객체 위치를 반환합니다.
+	Location(key string) string
+}
+
+// LocalSink: 지정한 디렉터리 아래에 파일로 기록하는 Sink
+type LocalSink struct {
+	root string
+}
+
+// NewLocalSink: 새로운 LocalSink 인스턴스를 생성합니다.
+func NewLocalSink(root string) *LocalSink {
+	return &LocalSink{root: root}
+}
+
+// Put: 임시 파일에 쓴 뒤 rename하여 읽는 쪽이 쓰다 만 파일을 보지 않도록 합니다.
+func (s *LocalSink) Put(_ context.Context, key string, body []byte, _ string) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create analytics dir failed: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create analytics temp file failed: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(body); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write analytics file failed: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close analytics file failed: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename analytics file failed: %w", err)
+	}
+	return nil
+}
+
+// Get: 파일 내용을 읽습니다.
+func (s *LocalSink) Get(_ context.Context, key string) ([]byte, error) {
+	body, err := os.ReadFile(s.path(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("read analytics file failed: %w", err)
+	}
+	return body, nil
+}
+
+// Location: 파일 경로를 반환합니다.
+func (s *LocalSink) Location(key string) string { return s.path(key) }
+
+func (s *LocalSink) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+// S3Config: S3 호환 스토리지 접속 정보
+type S3Config struct {
+	Bucket          string
+	Prefix          string
+	Region          string
+	Endpoint        string // 비어 있으면 https://s3.{region}.amazonaws.com
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Sink: S3 호환 스토리지에 path-style 요청으로 기록하는 Sink
+// 필요한 API가 PUT/GET뿐이므로 SDK 대신 SigV4 서명을 직접 붙입니다.
+type S3Sink struct {
+	cfg      S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Sink: 새로운 S3Sink 인스턴스를 생성합니다.
+func NewS3Sink(cfg S3Config, client *http.Client) (*S3Sink, error) {
+	raw := cfg.Endpoint
+	if raw == "" {
+		raw = "https://s3." + cfg.Region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(raw)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", raw)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	cfg.Prefix = strings.Trim(cfg.Prefix, "/")
+	return &S3Sink{cfg: cfg, endpoint: endpoint, client: client, now: time.Now}, nil
+}
+
+// Put: 객체를 업로드합니다.
+func (s *S3Sink) Put(ctx context.Context, key string, body []byte, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := s.do(req, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return s.statusError(resp, "put", key)
+	}
+	return nil
+}
+
+// Get: 객체를 다운로드합니다.
+func (s *S3Sink) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, s.statusError(resp, "get", key)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("s3 get %s: read body failed: %w", key, err)
+	}
+	return body, nil
+}
+
+// Location: s3:// 형식의 객체 위치를 반환합니다.
+func (s *S3Sink) Location(key string) string {
+	return "s3://" + s.cfg.Bucket + "/" + s.objectKey(key)
+}
+
+func (s *S3Sink) objectKey(key string) string {
+	if s.cfg.Prefix == "" {
+		return key
+	}
+	return s.cfg.Prefix + "/" + key
+}
+
+func (s *S3Sink) newRequest(ctx context.Context, method string, key string, body []byte) (*http.Request, error) {
+	u := *s.endpoint
+	u.Path = "/" + s.cfg.Bucket + "/" + s.objectKey(key)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s: build request failed: %w", strings.ToLower(method), key, err)
+	}
+	req.ContentLength = int64(len(body))
+	return req, nil
+}
+
+func (s *S3Sink) do(req *http.Request, body []byte) (*http.Response, error) {
+	signV4(req, body, s.cfg.AccessKeyID, s.cfg.SecretAccessKey, s.cfg.Region, s.now())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s failed: %w", strings.ToLower(req.Method), req.URL.Path, err)
+	}
+	return resp, nil
+}
+
+func (s *S3Sink) statusError(resp *http.Response, op string, key string) error {
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("s3 %s %s: unexpected status %d: %s", op, key, resp.StatusCode, strings.TrimSpace(string(detail)))
+}
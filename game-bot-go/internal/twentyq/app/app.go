@@ -14,11 +14,20 @@ import (
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/bootstrap"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/di"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/health"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httpserver"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ingress"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	commonmq "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mq"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/outbox"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ratelimit"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/runtimeconfig"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/analytics"
 	qassets "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/assets"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qhttpapi "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/httpapi"
@@ -35,6 +44,7 @@ type twentyQStores struct {
 	lockManager           *qredis.LockManager
 	processingLockService *qredis.ProcessingLockService
 	pendingStore          *qredis.PendingMessageStore
+	commandRateLimiter    *ratelimit.Limiter
 
 	sessionStore      *qredis.SessionStore
 	categoryStore     *qredis.CategoryStore
@@ -45,13 +55,29 @@ type twentyQStores struct {
 	topicHistoryStore *qredis.TopicHistoryStore
 	voteStore         *qredis.SurrenderVoteStore
 	guessRateLimiter  *qredis.GuessRateLimiter
+	themeEventStore   *qredis.ThemeEventStore
+	budgetStore       *qredis.BudgetStore
+	tournamentStore   *qredis.TournamentStore
+	hotseatStore      *qredis.HotseatStore
+	openingStore      *qredis.OpeningStore // 추천 첫 질문 비활성화 시 nil
+	llmUsageStore     *qredis.LLMUsageStore
+	chatSettingsStore *qredis.ChatSettingsStore
+	comboStore        *qredis.ComboStore
+	localeStore       *qredis.LocaleStore
+	synonymStore      *qredis.SynonymStore
 }
 
-func newTwentyQStores(client di.DataValkeyClient, logger *slog.Logger) *twentyQStores {
+func newTwentyQStores(cfg *qconfig.Config, client di.DataValkeyClient, logger *slog.Logger) *twentyQStores {
+	var openingStore *qredis.OpeningStore
+	if cfg.Opening.Enabled {
+		openingStore = qredis.NewOpeningStore(client.Client, logger)
+	}
+
 	return &twentyQStores{
 		lockManager:           qredis.NewLockManager(client.Client, logger),
 		processingLockService: qredis.NewProcessingLockService(client.Client, logger),
 		pendingStore:          qredis.NewPendingMessageStore(client.Client, logger),
+		commandRateLimiter:    ratelimit.NewLimiter(client.Client, qconfig.RedisKeyPrefix, cfg.RateLimit, logger),
 		sessionStore:          qredis.NewSessionStore(client.Client, logger),
 		categoryStore:         qredis.NewCategoryStore(client.Client, logger),
 		historyStore:          qredis.NewHistoryStore(client.Client, logger),
@@ -61,6 +87,16 @@ func newTwentyQStores(client di.DataValkeyClient, logger *slog.Logger) *twentyQS
 		topicHistoryStore:     qredis.NewTopicHistoryStore(client.Client, logger),
 		voteStore:             qredis.NewSurrenderVoteStore(client.Client, logger),
 		guessRateLimiter:      qredis.NewGuessRateLimiter(client.Client, "twentyq"),
+		themeEventStore:       qredis.NewThemeEventStore(client.Client, logger),
+		budgetStore:           qredis.NewBudgetStore(client.Client, logger),
+		tournamentStore:       qredis.NewTournamentStore(client.Client, logger),
+		hotseatStore:          qredis.NewHotseatStore(client.Client, logger),
+		openingStore:          openingStore,
+		llmUsageStore:         qredis.NewLLMUsageStore(client.Client, logger),
+		chatSettingsStore:     qredis.NewChatSettingsStore(client.Client, logger),
+		comboStore:            qredis.NewComboStore(client.Client, logger),
+		localeStore:           qredis.NewLocaleStore(client.Client, logger),
+		synonymStore:          qredis.NewSynonymStore(client.Client, logger),
 	}
 }
 
@@ -69,10 +105,17 @@ func newTwentyQRiddleService(
 	restClient *llmrest.Client,
 	msgProvider *messageprovider.Provider,
 	stores *twentyQStores,
+	repo *qrepo.Repository,
 	statsRecorder *qsvc.StatsRecorder,
+	synonymMatcher *qsvc.SynonymMatcher,
+	events *eventbus.Publisher,
 	logger *slog.Logger,
 ) *qsvc.RiddleService {
-	return qsvc.NewRiddleService(
+	// 게임별 LLM 사용량: llmrest가 방(chatID)별로 누적하고, 기록기가 게임 종료 시 꺼내 세션에 붙임
+	restClient.SetUsageSink(stores.llmUsageStore)
+	statsRecorder.SetLLMUsageStore(stores.llmUsageStore)
+
+	svc := qsvc.NewRiddleService(
 		restClient,
 		cfg.Commands.Prefix,
 		msgProvider,
@@ -86,9 +129,42 @@ func newTwentyQRiddleService(
 		stores.topicHistoryStore,
 		stores.voteStore,
 		stores.guessRateLimiter,
+		stores.themeEventStore,
+		stores.budgetStore,
+		stores.tournamentStore,
+		stores.hotseatStore,
+		stores.openingStore,
+		stores.chatSettingsStore,
+		stores.comboStore,
 		statsRecorder,
+		events,
 		logger,
 	)
+	svc.SetHintFeedback(qsvc.NewHintFeedbackService(repo, cfg.HintFeedback, logger))
+	svc.SetLocales(stores.localeStore, cfg.Locale.Default)
+	svc.SetSynonymMatcher(synonymMatcher)
+	return svc
+}
+
+func newTwentyQSynonymMatcher(cfg *qconfig.Config, restClient *llmrest.Client, stores *twentyQStores, logger *slog.Logger) *qsvc.SynonymMatcher {
+	return qsvc.NewSynonymMatcher(restClient, stores.synonymStore, cfg.Synonym, logger)
+}
+
+// newTwentyQRuntimeConfig: 관리 대시보드가 재시작 없이 바꿀 수 있는 설정을 등록합니다. (기본값은 환경 변수 값)
+func newTwentyQRuntimeConfig(cfg *qconfig.Config, stores *twentyQStores, synonymMatcher *qsvc.SynonymMatcher) *runtimeconfig.Registry {
+	registry := runtimeconfig.NewRegistry()
+	registry.Int("guess.cooldownSeconds", "같은 사용자의 정답 시도 간격(초)", int(stores.guessRateLimiter.GetLimitSeconds()), 5, 600,
+		stores.guessRateLimiter.SetLimitSeconds)
+	registry.Bool("synonym.embeddingEnabled", "임베딩 유사도로 동의어 정답 판정", cfg.Synonym.EmbeddingEnabled, func(v bool) {
+		synonymMatcher.UpdateConfig(func(c *qconfig.SynonymConfig) { c.EmbeddingEnabled = v })
+	})
+	registry.Float("synonym.acceptThreshold", "바로 정답 처리하는 최소 임베딩 유사도", cfg.Synonym.AcceptThreshold, 0.5, 1, func(v float64) {
+		synonymMatcher.UpdateConfig(func(c *qconfig.SynonymConfig) { c.AcceptThreshold = v })
+	})
+	registry.Float("synonym.borderlineThreshold", "LLM 동의어 판정으로 확인하는 최소 임베딩 유사도", cfg.Synonym.BorderlineThreshold, 0.5, 1, func(v float64) {
+		synonymMatcher.UpdateConfig(func(c *qconfig.SynonymConfig) { c.BorderlineThreshold = v })
+	})
+	return registry
 }
 
 type twentyQAdminServices struct {
@@ -183,6 +259,7 @@ func (e *twentyQQueuedExecutor) Execute(
 type twentyQMQPipeline struct {
 	streamConsumer *commonmq.StreamConsumer
 	streamHandler  *qmq.StreamMessageHandler
+	replyPublisher *qmq.ReplyPublisher
 }
 
 func newTwentyQMQPipeline(
@@ -193,6 +270,7 @@ func newTwentyQMQPipeline(
 	stores *twentyQStores,
 	riddleService *qsvc.RiddleService,
 	adminServices *twentyQAdminServices,
+	latencyRecorder *latency.Recorder,
 	logger *slog.Logger,
 ) *twentyQMQPipeline {
 	accessControl := qsecurity.NewAccessControl(cfg.Access)
@@ -240,12 +318,14 @@ func newTwentyQMQPipeline(
 		msgProvider,
 		replyPublisher,
 		accessControl,
+		stores.commandRateLimiter,
 		commandParser,
 		stores.lockManager,
 		stores.processingLockService,
 		queueProcessor,
 		restClient,
 		cfg.Commands.Prefix,
+		latencyRecorder,
 		logger,
 	)
 	executor.service = gameMessageService
@@ -255,6 +335,7 @@ func newTwentyQMQPipeline(
 	return &twentyQMQPipeline{
 		streamConsumer: streamConsumer,
 		streamHandler:  streamHandler,
+		replyPublisher: replyPublisher,
 	}
 }
 
@@ -267,6 +348,9 @@ func newTwentyQDataRedis(
 	if err != nil {
 		return di.DataValkeyClient{}, nil, fmt.Errorf("init valkey failed: %w", err)
 	}
+	if cfg.Telemetry.Enabled {
+		client.Client = valkeyx.NewTracingClient(client.Client, "data")
+	}
 	return client, closeFn, nil
 }
 
@@ -279,6 +363,9 @@ func newTwentyQMQValkey(
 	if err != nil {
 		return di.MQValkeyClient{}, nil, fmt.Errorf("init valkey mq failed: %w", err)
 	}
+	if cfg.Telemetry.Enabled {
+		client.Client = valkeyx.NewTracingClient(client.Client, "mq")
+	}
 	return client, closeFn, nil
 }
 
@@ -295,6 +382,11 @@ func newTwentyQMessageProvider() (*messageprovider.Provider, error) {
 	if err != nil {
 		return nil, fmt.Errorf("load messages failed: %w", err)
 	}
+	for locale, content := range qassets.LocaleMessagesYAML {
+		if err := provider.AddLocaleYAMLAtPath(locale, content, "toon"); err != nil {
+			return nil, fmt.Errorf("load %s messages failed: %w", locale, err)
+		}
+	}
 	return provider, nil
 }
 
@@ -334,28 +426,61 @@ func newTwentyQStatsRecorder(cfg *qconfig.Config, repo *qrepo.Repository, logger
 	return recorder, cleanup
 }
 
+// newTwentyQSchemaChecker: AutoMigrate 모델 목록을 기준으로 스키마 점검기를 생성합니다.
+func newTwentyQSchemaChecker(db *gorm.DB) (*health.SchemaChecker, error) {
+	expectations, err := health.ModelExpectations(db, qrepo.Models()...)
+	if err != nil {
+		return nil, fmt.Errorf("build schema expectations failed: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("get sql db failed: %w", err)
+	}
+	return health.NewSchemaChecker(sqlDB, health.DefaultSchemaCheckTTL, expectations), nil
+}
+
 func newTwentyQHTTPMux(
 	riddleService *qsvc.RiddleService,
 	db *gorm.DB,
+	schemaChecker *health.SchemaChecker,
 	valkeyClient valkey.Client,
 	sessionStore *qredis.SessionStore,
+	themeEventStore *qredis.ThemeEventStore,
+	chatSettingsStore *qredis.ChatSettingsStore,
+	analyticsExporter *analytics.Exporter,
+	latencyReporter *latency.Reporter,
+	runtimeConfig *runtimeconfig.Registry,
+	seasons *qsvc.SeasonService,
 	msgProvider *messageprovider.Provider,
+	replyOutbox *outbox.Outbox,
 	logger *slog.Logger,
 ) *http.ServeMux {
 	mux := http.NewServeMux()
-	qhttpapi.Register(mux, riddleService, db, msgProvider, logger)
+	qhttpapi.Register(mux, riddleService, db, schemaChecker, msgProvider, logger)
 
 	qhttpapi.RegisterAdminRoutes(mux, qhttpapi.AdminDeps{
-		DB:           db,
-		ValkeyClient: valkeyClient,
-		SessionStore: sessionStore,
-		Logger:       logger,
+		DB:                db,
+		ValkeyClient:      valkeyClient,
+		SessionStore:      sessionStore,
+		ThemeEventStore:   themeEventStore,
+		ChatSettingsStore: chatSettingsStore,
+		AnalyticsExporter: analyticsExporter,
+		LatencyReporter:   latencyReporter,
+		RuntimeConfig:     runtimeConfig,
+		Seasons:           seasons,
+		Logger:            logger,
 	})
+	replyOutbox.RegisterRoutes(mux, logger)
 
 	return mux
 }
 
-func newTwentyQHTTPServer(cfg *qconfig.Config, mux *http.ServeMux) *http.Server {
+// newTwentyQIngress: 브릿지 웹훅(/api/) 서명 검증기를 생성합니다. 비밀키가 없으면 nil(검증 안 함)입니다.
+func newTwentyQIngress(cfg *qconfig.Config, valkeyClient valkey.Client, logger *slog.Logger) *ingress.Verifier {
+	return ingress.NewVerifier(cfg.Ingress, valkeyClient, qconfig.RedisKeyPrefix, "twentyq", logger)
+}
+
+func newTwentyQHTTPServer(cfg *qconfig.Config, handler http.Handler) *http.Server {
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
 
 	// OTel 설정: 환경변수에서 읽음 (bootstrap에서 이미 초기화됨)
@@ -365,7 +490,7 @@ func newTwentyQHTTPServer(cfg *qconfig.Config, mux *http.ServeMux) *http.Server
 		otelServiceName = "twentyq-bot"
 	}
 
-	return httpserver.NewServer(addr, mux, httpserver.ServerOptions{
+	return httpserver.NewServer(addr, handler, httpserver.ServerOptions{
 		UseH2C:            true,
 		ReadHeaderTimeout: cfg.ServerTuning.ReadHeaderTimeout,
 		IdleTimeout:       cfg.ServerTuning.IdleTimeout,
@@ -375,23 +500,213 @@ func newTwentyQHTTPServer(cfg *qconfig.Config, mux *http.ServeMux) *http.Server
 	})
 }
 
+// newTwentyQLeaderboardDigest: 다이제스트가 비활성화되어 있거나 대상 방이 없으면 nil을 반환합니다.
+func newTwentyQLeaderboardDigest(
+	cfg *qconfig.Config,
+	db *gorm.DB,
+	dataValkey di.DataValkeyClient,
+	mqPipeline *twentyQMQPipeline,
+	msgProvider *messageprovider.Provider,
+	riddleService *qsvc.RiddleService,
+	logger *slog.Logger,
+) *qsvc.LeaderboardDigestScheduler {
+	if !cfg.Digest.Enabled || len(cfg.Digest.ChatIDs) == 0 {
+		return nil
+	}
+	digest := qsvc.NewLeaderboardDigestScheduler(
+		cfg.Digest,
+		db,
+		qredis.NewDigestStore(dataValkey.Client, logger),
+		mqPipeline.replyPublisher.Publish,
+		msgProvider,
+		logger,
+	)
+	digest.SetChatLocale(riddleService.WithChatLocale)
+	return digest
+}
+
+// newTwentyQSeasons: 시즌이 비활성화되어 있으면 nil을 반환합니다.
+// 게임 기록에 시즌 번호가 붙도록 통계 기록기에 바로 연결합니다.
+func newTwentyQSeasons(
+	cfg *qconfig.Config,
+	repo *qrepo.Repository,
+	statsRecorder *qsvc.StatsRecorder,
+	msgProvider *messageprovider.Provider,
+	logger *slog.Logger,
+) *qsvc.SeasonService {
+	seasons := qsvc.NewSeasonService(cfg.Season, repo, msgProvider, logger)
+	if seasons == nil {
+		return nil
+	}
+	statsRecorder.SetSeasons(seasons)
+	return seasons
+}
+
+// connectTwentyQSeasons: 시즌 종료 안내를 MQ 응답 스트림으로, 방 언어에 맞춰 보내도록 연결합니다.
+func connectTwentyQSeasons(seasons *qsvc.SeasonService, mqPipeline *twentyQMQPipeline, riddleService *qsvc.RiddleService) {
+	if seasons == nil {
+		return
+	}
+	seasons.SetPublisher(mqPipeline.replyPublisher.Publish)
+	seasons.SetChatLocale(riddleService.WithChatLocale)
+}
+
+// newTwentyQOutbox: 응답 발행 실패 시 재전송 대기열을 생성합니다. 비활성화면 nil입니다.
+func newTwentyQOutbox(cfg *qconfig.Config, dataValkey valkey.Client, logger *slog.Logger) *outbox.Outbox {
+	return outbox.New(dataValkey, qconfig.RedisKeyPrefix, cfg.Outbox, logger)
+}
+
+// connectTwentyQOutbox: MQ 응답 발행자가 발행에 실패한 응답을 재전송 대기열에 넣도록 연결합니다.
+func connectTwentyQOutbox(replyOutbox *outbox.Outbox, mqPipeline *twentyQMQPipeline) {
+	if replyOutbox == nil {
+		return
+	}
+	mqPipeline.replyPublisher.SetOutbox(replyOutbox)
+}
+
+// newTwentyQOpeningMiner: 추천 첫 질문이 비활성화되어 있으면 nil을 반환합니다.
+func newTwentyQOpeningMiner(cfg *qconfig.Config, repo *qrepo.Repository, stores *twentyQStores, logger *slog.Logger) *qsvc.OpeningSuggestionMiner {
+	if stores.openingStore == nil {
+		return nil
+	}
+	return qsvc.NewOpeningSuggestionMiner(cfg.Opening, repo, stores.openingStore, logger)
+}
+
+// newTwentyQHotseatWatcher: 턴제 차례 만료 안내를 MQ 응답 스트림으로 발행하는 감시기를 생성합니다.
+func newTwentyQHotseatWatcher(riddleService *qsvc.RiddleService, mqPipeline *twentyQMQPipeline, logger *slog.Logger) *qsvc.HotseatWatcher {
+	return qsvc.NewHotseatWatcher(riddleService, mqPipeline.replyPublisher.Publish, logger)
+}
+
+// newTwentyQAnalyticsExport: 분석 내보내기가 비활성화되어 있으면 nil을 반환합니다.
+// 버킷이 설정되어 있으면 S3에, 아니면 로컬 디렉터리에 파티션을 기록합니다.
+func newTwentyQAnalyticsExport(cfg *qconfig.Config, db *gorm.DB, logger *slog.Logger) (*analytics.Exporter, *analytics.Scheduler, error) {
+	if !cfg.Analytics.Enabled {
+		return nil, nil, nil
+	}
+
+	var sink analytics.Sink = analytics.NewLocalSink(cfg.Analytics.Dir)
+	if cfg.Analytics.S3Bucket != "" {
+		s3Sink, err := analytics.NewS3Sink(analytics.S3Config{
+			Bucket:          cfg.Analytics.S3Bucket,
+			Prefix:          cfg.Analytics.S3Prefix,
+			Region:          cfg.Analytics.S3Region,
+			Endpoint:        cfg.Analytics.S3Endpoint,
+			AccessKeyID:     cfg.Analytics.S3AccessKeyID,
+			SecretAccessKey: cfg.Analytics.S3SecretAccessKey,
+		}, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("create analytics s3 sink failed: %w", err)
+		}
+		sink = s3Sink
+	}
+
+	exporter := analytics.NewExporter(db, sink, analytics.NewAnonymizer(cfg.Analytics.Salt), logger)
+	scheduler := analytics.NewScheduler(exporter, cfg.Analytics.RunAtMinute, cfg.Analytics.BackfillDays, logger)
+	return exporter, scheduler, nil
+}
+
+// latencyBotName: 지연 샘플/집계 행에 기록하는 봇 이름
+const latencyBotName = "twentyq"
+
+// twentyQLatency: 명령어 응답 지연 기록/집계/조회 구성요소 (비활성화 시 모두 nil)
+type twentyQLatency struct {
+	recorder  *latency.Recorder
+	scheduler *latency.Scheduler
+	reporter  *latency.Reporter
+}
+
+func newTwentyQLatency(cfg *qconfig.Config, db *gorm.DB, logger *slog.Logger) (*twentyQLatency, func()) {
+	if !cfg.Latency.Enabled {
+		return &twentyQLatency{}, func() {}
+	}
+	store := latency.NewStore(db)
+	recorder := latency.NewRecorder(latencyBotName, store, logger)
+	return &twentyQLatency{
+		recorder:  recorder,
+		scheduler: latency.NewScheduler(store, latencyBotName, cfg.Latency.SLA, cfg.Latency.RollupAtMinute, cfg.Latency.RetentionDays, logger),
+		reporter:  latency.NewReporter(store, latencyBotName, cfg.Latency.SLA),
+	}, recorder.Shutdown
+}
+
 func newTwentyQServerApp(
 	logger *slog.Logger,
 	server *http.Server,
 	mqPipeline *twentyQMQPipeline,
+	digest *qsvc.LeaderboardDigestScheduler,
+	seasons *qsvc.SeasonService,
+	analyticsScheduler *analytics.Scheduler,
+	latencyScheduler *latency.Scheduler,
+	openingMiner *qsvc.OpeningSuggestionMiner,
+	hotseatWatcher *qsvc.HotseatWatcher,
+	replyOutbox *outbox.Outbox,
 ) *bootstrap.ServerApp {
-	return bootstrap.NewServerApp(
-		"twentyq",
-		logger,
-		server,
-		10*time.Second,
-		bootstrap.BackgroundTask{
+	tasks := []bootstrap.BackgroundTask{
+		{
 			Name:        "mq_consumer",
 			ErrorLogKey: "mq_consumer_failed",
 			Run: func(ctx context.Context) error {
 				return mqPipeline.streamConsumer.Run(ctx, mqPipeline.streamHandler.HandleStreamMessage)
 			},
 		},
+	}
+	if hotseatWatcher != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "hotseat_watcher",
+			ErrorLogKey: "hotseat_watcher_failed",
+			Run:         hotseatWatcher.Run,
+		})
+	}
+	if digest != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "leaderboard_digest",
+			ErrorLogKey: "leaderboard_digest_failed",
+			Run:         digest.Run,
+		})
+	}
+	if seasons != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "season_scheduler",
+			ErrorLogKey: "season_scheduler_failed",
+			Run:         seasons.Run,
+		})
+	}
+	if analyticsScheduler != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "analytics_export",
+			ErrorLogKey: "analytics_export_failed",
+			Run:         analyticsScheduler.Run,
+		})
+	}
+	if latencyScheduler != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "latency_rollup",
+			ErrorLogKey: "latency_rollup_failed",
+			Run:         latencyScheduler.Run,
+		})
+	}
+	if openingMiner != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "opening_suggestions",
+			ErrorLogKey: "opening_suggestions_failed",
+			Run:         openingMiner.Run,
+		})
+	}
+	if replyOutbox != nil {
+		tasks = append(tasks, bootstrap.BackgroundTask{
+			Name:        "reply_outbox",
+			ErrorLogKey: "reply_outbox_failed",
+			Run: func(ctx context.Context) error {
+				return replyOutbox.Run(ctx, mqPipeline.replyPublisher.Deliver)
+			},
+		})
+	}
+
+	return bootstrap.NewServerApp(
+		"twentyq",
+		logger,
+		server,
+		10*time.Second,
+		tasks...,
 	)
 }
 
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/bootstrap"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 )
 
@@ -25,7 +26,7 @@ func Initialize(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*
 		return nil, nil, err
 	}
 
-	stores := newTwentyQStores(dataValkeyClient, logger)
+	stores := newTwentyQStores(cfg, dataValkeyClient, logger)
 
 	db, cleanupDB, err := newTwentyQDB(ctx, cfg, logger)
 	if err != nil {
@@ -40,15 +41,40 @@ func Initialize(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*
 		return nil, nil, err
 	}
 
+	schemaChecker, err := newTwentyQSchemaChecker(db)
+	if err != nil {
+		cleanupDB()
+		cleanupDataValkey()
+		return nil, nil, err
+	}
+
 	statsRecorder, cleanupStats := newTwentyQStatsRecorder(cfg, repository, logger)
+	seasons := newTwentyQSeasons(cfg, repository, statsRecorder, msgProvider, logger)
+
+	events := eventbus.NewPublisher(dataValkeyClient.Client, eventbus.GameTwentyQ, logger)
+	synonymMatcher := newTwentyQSynonymMatcher(cfg, restClient, stores, logger)
+	riddleService := newTwentyQRiddleService(cfg, restClient, msgProvider, stores, repository, statsRecorder, synonymMatcher, events, logger)
+	riddleService.SetSeasons(seasons)
+	runtimeConfig := newTwentyQRuntimeConfig(cfg, stores, synonymMatcher)
+
+	analyticsExporter, analyticsScheduler, err := newTwentyQAnalyticsExport(cfg, db, logger)
+	if err != nil {
+		cleanupStats()
+		cleanupDB()
+		cleanupDataValkey()
+		return nil, nil, err
+	}
 
-	riddleService := newTwentyQRiddleService(cfg, restClient, msgProvider, stores, statsRecorder, logger)
+	latencyParts, cleanupLatency := newTwentyQLatency(cfg, db, logger)
+	replyOutbox := newTwentyQOutbox(cfg, dataValkeyClient.Client, logger)
 
-	httpMux := newTwentyQHTTPMux(riddleService, db, dataValkeyClient.Client, stores.sessionStore, msgProvider, logger)
-	httpServer := newTwentyQHTTPServer(cfg, httpMux)
+	httpMux := newTwentyQHTTPMux(riddleService, db, schemaChecker, dataValkeyClient.Client, stores.sessionStore, stores.themeEventStore, stores.chatSettingsStore, analyticsExporter, latencyParts.reporter, runtimeConfig, seasons, msgProvider, replyOutbox, logger)
+	ingressVerifier := newTwentyQIngress(cfg, dataValkeyClient.Client, logger)
+	httpServer := newTwentyQHTTPServer(cfg, ingressVerifier.Wrap(httpMux))
 
 	mqValkeyClient, cleanupMQValkey, err := newTwentyQMQValkey(ctx, cfg, logger)
 	if err != nil {
+		cleanupLatency()
 		cleanupStats()
 		cleanupDB()
 		cleanupDataValkey()
@@ -56,13 +82,23 @@ func Initialize(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*
 	}
 
 	adminServices := newTwentyQAdminServices(cfg, db, restClient, msgProvider, stores, riddleService, logger)
-	mqPipeline := newTwentyQMQPipeline(cfg, mqValkeyClient, restClient, msgProvider, stores, riddleService, adminServices, logger)
+	mqPipeline := newTwentyQMQPipeline(cfg, mqValkeyClient, restClient, msgProvider, stores, riddleService, adminServices, latencyParts.recorder, logger)
+
+	connectTwentyQSeasons(seasons, mqPipeline, riddleService)
+	connectTwentyQOutbox(replyOutbox, mqPipeline)
+
+	digest := newTwentyQLeaderboardDigest(cfg, db, dataValkeyClient, mqPipeline, msgProvider, riddleService, logger)
+
+	openingMiner := newTwentyQOpeningMiner(cfg, repository, stores, logger)
+
+	hotseatWatcher := newTwentyQHotseatWatcher(riddleService, mqPipeline, logger)
 
-	serverApp := newTwentyQServerApp(logger, httpServer, mqPipeline)
+	serverApp := newTwentyQServerApp(logger, httpServer, mqPipeline, digest, seasons, analyticsScheduler, latencyParts.scheduler, openingMiner, hotseatWatcher, replyOutbox)
 
 	cleanup := func() {
 		riddleService.ShutdownPlayerRegistration()
 		cleanupMQValkey()
+		cleanupLatency()
 		cleanupStats()
 		cleanupDB()
 		cleanupDataValkey()
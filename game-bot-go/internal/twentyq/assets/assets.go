@@ -7,6 +7,22 @@ import _ "embed" // 에셋 임베드용
 //go:embed messages/game-messages.yml
 var GameMessagesYAML string
 
+// GameMessagesEnYAML: 20문답 게임 영어 메시지 YAML입니다.
+//
+//go:embed messages/game-messages.en.yml
+var GameMessagesEnYAML string
+
+// GameMessagesJaYAML: 20문답 게임 일본어 메시지 YAML입니다.
+//
+//go:embed messages/game-messages.ja.yml
+var GameMessagesJaYAML string
+
+// LocaleMessagesYAML: 언어 코드별 추가 메시지 YAML입니다. (기본 번들은 GameMessagesYAML)
+var LocaleMessagesYAML = map[string]string{
+	"en": GameMessagesEnYAML,
+	"ja": GameMessagesJaYAML,
+}
+
 // LockAcquireLua: 락 획득 Lua 스크립트입니다.
 //
 //go:embed lua/lock_acquire.lua
@@ -24,10 +24,36 @@ func TestHelpMessage_NotChunked(t *testing.T) {
 	if err != nil {
 		t.Fatalf("parse failed: %v", err)
 	}
+	for locale, content := range LocaleMessagesYAML {
+		if err := provider.AddLocaleYAMLAtPath(locale, content, "toon"); err != nil {
+			t.Fatalf("add %s failed: %v", locale, err)
+		}
+	}
+
+	for _, locale := range provider.Locales() {
+		help := provider.ForLocale(locale).Get("help.message")
+		chunks := textutil.ChunkByLines(help, qconfig.KakaoMessageMaxLength)
+		if len(chunks) != 1 {
+			t.Fatalf("expected %s help.message to be 1 chunk, got %d", locale, len(chunks))
+		}
+	}
+}
+
+func TestLocaleMessagesYAML_CoverBaseKeys(t *testing.T) {
+	base, err := messageprovider.NewFromYAMLAtPath(GameMessagesYAML, "toon")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
 
-	help := provider.Get("help.message")
-	chunks := textutil.ChunkByLines(help, qconfig.KakaoMessageMaxLength)
-	if len(chunks) != 1 {
-		t.Fatalf("expected help.message to be 1 chunk, got %d", len(chunks))
+	for locale, content := range LocaleMessagesYAML {
+		bundle, err := messageprovider.NewFromYAMLAtPath(content, "toon")
+		if err != nil {
+			t.Fatalf("parse %s failed: %v", locale, err)
+		}
+		for _, key := range []string{"help.message", "error.no_session", "answer.success", "settings.show", "locale.updated"} {
+			if got := bundle.Get(key); got == key || got == base.Get(key) {
+				t.Errorf("%s bundle should translate %s, got %q", locale, key, got)
+			}
+		}
 	}
 }
@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	commonconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/config"
 )
@@ -41,6 +44,12 @@ type AccessConfig = commonconfig.AccessConfig
 // LogConfig: 로깅 설정 (레벨, 포맷 등) alias
 type LogConfig = commonconfig.LogConfig
 
+// RateLimitConfig: 명령어 빈도 제한 설정 alias
+type RateLimitConfig = commonconfig.RateLimitConfig
+
+// IngressConfig: 브릿지 웹훅 서명 검증 설정 alias
+type IngressConfig = commonconfig.IngressConfig
+
 // AdminConfig: 관리자 권한 설정
 type AdminConfig struct {
 	UserIDs []string
@@ -58,6 +67,75 @@ type UsageConfig struct {
 	ExchangeRateAPIURL string
 }
 
+// DigestConfig: 방별 리더보드 다이제스트 정기 게시 설정
+// 시각은 모두 KST 기준 자정부터의 분(minute)으로 저장합니다.
+type DigestConfig struct {
+	Enabled       bool
+	ChatIDs       []string // 다이제스트를 게시할 방 목록 (비어 있으면 게시하지 않음)
+	SendAtMinute  int
+	WeeklyEnabled bool
+	WeeklyDay     time.Weekday // 이 요일에는 일간 대신 주간 다이제스트를 게시
+	QuietHours    bool
+	QuietStart    int // 방해 금지 시작 (포함)
+	QuietEnd      int // 방해 금지 종료 (미포함), QuietStart보다 작으면 자정을 넘기는 구간
+	TopN          int
+}
+
+// SeasonConfig: 방별 시즌(기간제 리더보드) 설정
+// 시즌은 방에서 첫 게임이 끝날 때 열리고 LengthDays가 지나면 스케줄러가 닫아 최종 순위를 보관합니다.
+type SeasonConfig struct {
+	Enabled    bool
+	LengthDays int
+	TopN       int // 시즌 종료 안내와 순위 조회에 표시할 인원
+}
+
+// OpeningConfig: 지난 게임 기록에서 카테고리별 추천 첫 질문을 집계하는 설정
+type OpeningConfig struct {
+	Enabled      bool
+	RunAtMinute  int // 매일 집계를 시작하는 시각 (KST 자정부터의 분)
+	LookbackDays int // 집계에 포함할 최근 일수 (이보다 오래된 기록은 정리)
+	MinGames     int // 추천 후보가 되기 위한 최소 게임 수
+	TopN         int // 카테고리별로 저장할 추천 질문 수
+}
+
+// HintFeedbackConfig: 힌트 유용/무용 평가 수집과 힌트 프롬프트 반영 설정
+type HintFeedbackConfig struct {
+	Enabled      bool
+	LookbackDays int // 프롬프트와 관리자 집계에 포함할 최근 일수
+	MinVotes     int // 카테고리 평가가 이 수 이상일 때만 프롬프트에 반영
+}
+
+// LocaleConfig: 방별 메시지 언어 설정
+type LocaleConfig struct {
+	Default string // 언어를 고르지 않은 방에 쓸 언어 (ko/en/ja, 지원하지 않는 값이면 ko)
+}
+
+// SynonymConfig: 정답 추측의 동의어 판정 설정
+// 임베딩 유사도가 AcceptThreshold 이상이면 바로 정답, BorderlineThreshold 이상이면 LLM 동의어 판정으로 확인합니다.
+type SynonymConfig struct {
+	EmbeddingEnabled    bool
+	AcceptThreshold     float64
+	BorderlineThreshold float64
+	Dimensions          int // 임베딩 차원 (0이면 LLM 서버 기본값)
+}
+
+// AnalyticsExportConfig: 익명화된 분석용 Parquet 내보내기 설정
+// S3Bucket이 비어 있으면 Dir 아래 로컬 디스크에 기록합니다.
+type AnalyticsExportConfig struct {
+	Enabled      bool
+	RunAtMinute  int    // 매일 내보내기를 시작하는 시각 (KST 자정부터의 분)
+	BackfillDays int    // 누락된 날짜를 찾아 다시 내보낼 최근 일수
+	Salt         string // 채팅방/사용자 ID 해시용 비밀 값 (활성화 시 필수)
+	Dir          string
+
+	S3Bucket          string
+	S3Prefix          string
+	S3Region          string
+	S3Endpoint        string // 비어 있으면 AWS 기본 엔드포인트 (MinIO 등 호환 스토리지는 직접 지정)
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
 // Config: 전체 애플리케이션 설정 구조체
 type Config struct {
 	Server       ServerConfig
@@ -68,10 +146,21 @@ type Config struct {
 	Valkey       ValkeyMQConfig
 	Postgres     PostgresConfig
 	Access       AccessConfig
+	RateLimit    RateLimitConfig
+	Ingress      IngressConfig
 	Admin        AdminConfig
 	Log          LogConfig
 	Stats        StatsConfig
 	Usage        UsageConfig
+	Digest       DigestConfig
+	Season       SeasonConfig
+	Opening      OpeningConfig
+	HintFeedback HintFeedbackConfig
+	Locale       LocaleConfig
+	Synonym      SynonymConfig
+	Analytics    AnalyticsExportConfig
+	Latency      commonconfig.LatencyConfig   // 명령어 응답 지연 SLA 집계
+	Outbox       commonconfig.OutboxConfig    // 응답 발행 실패 재전송 대기열
 	Telemetry    commonconfig.TelemetryConfig // OpenTelemetry 분산 추적
 }
 
@@ -109,6 +198,14 @@ func LoadFromEnv() (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	rateLimit, err := commonconfig.ReadRateLimitConfigFromEnv("TWENTYQ_")
+	if err != nil {
+		return nil, fmt.Errorf("read rate limit config failed: %w", err)
+	}
+	ingress, err := commonconfig.ReadIngressConfigFromEnv("TWENTYQ_")
+	if err != nil {
+		return nil, fmt.Errorf("read ingress config failed: %w", err)
+	}
 	admin := readAdminConfig()
 	log, err := readLogConfig()
 	if err != nil {
@@ -119,6 +216,39 @@ func LoadFromEnv() (*Config, error) {
 		return nil, err
 	}
 	usage := readUsageConfig()
+	digest, err := readDigestConfig()
+	if err != nil {
+		return nil, err
+	}
+	season, err := readSeasonConfig()
+	if err != nil {
+		return nil, err
+	}
+	opening, err := readOpeningConfig()
+	if err != nil {
+		return nil, err
+	}
+	hintFeedback, err := readHintFeedbackConfig()
+	if err != nil {
+		return nil, err
+	}
+	locale := readLocaleConfig()
+	synonym, err := readSynonymConfig()
+	if err != nil {
+		return nil, err
+	}
+	analytics, err := readAnalyticsExportConfig()
+	if err != nil {
+		return nil, err
+	}
+	latency, err := commonconfig.ReadLatencyConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("read latency config failed: %w", err)
+	}
+	outbox, err := commonconfig.ReadOutboxConfigFromEnv("TWENTYQ_")
+	if err != nil {
+		return nil, fmt.Errorf("read outbox config failed: %w", err)
+	}
 	telemetry, err := commonconfig.ReadTelemetryConfigFromEnv("twentyq-bot")
 	if err != nil {
 		return nil, fmt.Errorf("read telemetry config: %w", err)
@@ -136,14 +266,58 @@ func LoadFromEnv() (*Config, error) {
 		Valkey:       valkey,
 		Postgres:     postgres,
 		Access:       access,
+		RateLimit:    rateLimit,
+		Ingress:      ingress,
 		Admin:        admin,
 		Log:          log,
 		Stats:        stats,
 		Usage:        usage,
+		Digest:       digest,
+		Season:       season,
+		Opening:      opening,
+		HintFeedback: hintFeedback,
+		Locale:       locale,
+		Synonym:      synonym,
+		Analytics:    analytics,
+		Latency:      latency,
+		Outbox:       outbox,
 		Telemetry:    telemetry,
 	}, nil
 }
 
+func readLocaleConfig() LocaleConfig {
+	return LocaleConfig{Default: commonconfig.StringFromEnv("TWENTYQ_DEFAULT_LOCALE", "ko")}
+}
+
+func readSynonymConfig() (SynonymConfig, error) {
+	enabled, err := commonconfig.BoolFromEnv("TWENTYQ_SYNONYM_EMBEDDING_ENABLED", true)
+	if err != nil {
+		return SynonymConfig{}, fmt.Errorf("read TWENTYQ_SYNONYM_EMBEDDING_ENABLED failed: %w", err)
+	}
+	accept, err := commonconfig.Float64FromEnv("TWENTYQ_SYNONYM_ACCEPT_THRESHOLD", 0.92)
+	if err != nil {
+		return SynonymConfig{}, fmt.Errorf("read TWENTYQ_SYNONYM_ACCEPT_THRESHOLD failed: %w", err)
+	}
+	borderline, err := commonconfig.Float64FromEnv("TWENTYQ_SYNONYM_BORDERLINE_THRESHOLD", 0.82)
+	if err != nil {
+		return SynonymConfig{}, fmt.Errorf("read TWENTYQ_SYNONYM_BORDERLINE_THRESHOLD failed: %w", err)
+	}
+	dimensions, err := commonconfig.IntFromEnv("TWENTYQ_SYNONYM_EMBEDDING_DIMENSIONS", 768)
+	if err != nil {
+		return SynonymConfig{}, fmt.Errorf("read TWENTYQ_SYNONYM_EMBEDDING_DIMENSIONS failed: %w", err)
+	}
+	if accept <= 0 || accept > 1 || borderline <= 0 || borderline > accept {
+		return SynonymConfig{}, fmt.Errorf("invalid synonym thresholds: borderline=%v accept=%v (0 < borderline <= accept <= 1)", borderline, accept)
+	}
+
+	return SynonymConfig{
+		EmbeddingEnabled:    enabled,
+		AcceptThreshold:     accept,
+		BorderlineThreshold: borderline,
+		Dimensions:          max(dimensions, 0),
+	}, nil
+}
+
 func readUsageConfig() UsageConfig {
 	apiURL := commonconfig.StringFromEnvFirstNonEmpty(
 		[]string{"TWENTYQ_EXCHANGE_RATE_API_URL", "EXCHANGE_RATE_API_URL"},
@@ -173,6 +347,210 @@ func readStatsConfig() (StatsConfig, error) {
 	}, nil
 }
 
+func readDigestConfig() (DigestConfig, error) {
+	enabled, err := commonconfig.BoolFromEnv("TWENTYQ_DIGEST_ENABLED", false)
+	if err != nil {
+		return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_ENABLED failed: %w", err)
+	}
+	sendAt, err := parseClockMinute(commonconfig.StringFromEnv("TWENTYQ_DIGEST_TIME", "21:00"))
+	if err != nil {
+		return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_TIME failed: %w", err)
+	}
+	weeklyEnabled, err := commonconfig.BoolFromEnv("TWENTYQ_DIGEST_WEEKLY_ENABLED", true)
+	if err != nil {
+		return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_WEEKLY_ENABLED failed: %w", err)
+	}
+	weeklyDay, err := parseWeekday(commonconfig.StringFromEnv("TWENTYQ_DIGEST_WEEKLY_DAY", "sunday"))
+	if err != nil {
+		return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_WEEKLY_DAY failed: %w", err)
+	}
+	topN, err := commonconfig.IntFromEnv("TWENTYQ_DIGEST_TOP_N", 5)
+	if err != nil {
+		return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_TOP_N failed: %w", err)
+	}
+	if topN <= 0 {
+		topN = 5
+	}
+
+	cfg := DigestConfig{
+		Enabled:       enabled,
+		ChatIDs:       commonconfig.StringListFromEnv("TWENTYQ_DIGEST_CHAT_IDS", nil),
+		SendAtMinute:  sendAt,
+		WeeklyEnabled: weeklyEnabled,
+		WeeklyDay:     weeklyDay,
+		TopN:          topN,
+	}
+
+	// 형식: "23:00-08:00" (비어 있으면 방해 금지 시간 없음)
+	if quiet := commonconfig.StringFromEnv("TWENTYQ_DIGEST_QUIET_HOURS", ""); quiet != "" {
+		startRaw, endRaw, ok := strings.Cut(quiet, "-")
+		if !ok {
+			return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_QUIET_HOURS failed: expected HH:MM-HH:MM, got %q", quiet)
+		}
+		start, err := parseClockMinute(startRaw)
+		if err != nil {
+			return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_QUIET_HOURS failed: %w", err)
+		}
+		end, err := parseClockMinute(endRaw)
+		if err != nil {
+			return DigestConfig{}, fmt.Errorf("read TWENTYQ_DIGEST_QUIET_HOURS failed: %w", err)
+		}
+		cfg.QuietHours = start != end
+		cfg.QuietStart = start
+		cfg.QuietEnd = end
+	}
+	return cfg, nil
+}
+
+func readSeasonConfig() (SeasonConfig, error) {
+	enabled, err := commonconfig.BoolFromEnv("TWENTYQ_SEASON_ENABLED", false)
+	if err != nil {
+		return SeasonConfig{}, fmt.Errorf("read TWENTYQ_SEASON_ENABLED failed: %w", err)
+	}
+	lengthDays, err := commonconfig.IntFromEnv("TWENTYQ_SEASON_LENGTH_DAYS", 30)
+	if err != nil {
+		return SeasonConfig{}, fmt.Errorf("read TWENTYQ_SEASON_LENGTH_DAYS failed: %w", err)
+	}
+	if lengthDays <= 0 {
+		return SeasonConfig{}, fmt.Errorf("read TWENTYQ_SEASON_LENGTH_DAYS failed: must be positive, got %d", lengthDays)
+	}
+	topN, err := commonconfig.IntFromEnv("TWENTYQ_SEASON_TOP_N", 3)
+	if err != nil {
+		return SeasonConfig{}, fmt.Errorf("read TWENTYQ_SEASON_TOP_N failed: %w", err)
+	}
+	if topN <= 0 {
+		topN = 3
+	}
+	return SeasonConfig{Enabled: enabled, LengthDays: lengthDays, TopN: topN}, nil
+}
+
+func readOpeningConfig() (OpeningConfig, error) {
+	enabled, err := commonconfig.BoolFromEnv("TWENTYQ_OPENING_ENABLED", true)
+	if err != nil {
+		return OpeningConfig{}, fmt.Errorf("read TWENTYQ_OPENING_ENABLED failed: %w", err)
+	}
+	runAt, err := parseClockMinute(commonconfig.StringFromEnv("TWENTYQ_OPENING_TIME", "05:00"))
+	if err != nil {
+		return OpeningConfig{}, fmt.Errorf("read TWENTYQ_OPENING_TIME failed: %w", err)
+	}
+	lookbackDays, err := commonconfig.IntFromEnv("TWENTYQ_OPENING_LOOKBACK_DAYS", 90)
+	if err != nil {
+		return OpeningConfig{}, fmt.Errorf("read TWENTYQ_OPENING_LOOKBACK_DAYS failed: %w", err)
+	}
+	minGames, err := commonconfig.IntFromEnv("TWENTYQ_OPENING_MIN_GAMES", 5)
+	if err != nil {
+		return OpeningConfig{}, fmt.Errorf("read TWENTYQ_OPENING_MIN_GAMES failed: %w", err)
+	}
+	topN, err := commonconfig.IntFromEnv("TWENTYQ_OPENING_TOP_N", 3)
+	if err != nil {
+		return OpeningConfig{}, fmt.Errorf("read TWENTYQ_OPENING_TOP_N failed: %w", err)
+	}
+
+	return OpeningConfig{
+		Enabled:      enabled,
+		RunAtMinute:  runAt,
+		LookbackDays: max(lookbackDays, 1),
+		MinGames:     max(minGames, 1),
+		TopN:         max(topN, 1),
+	}, nil
+}
+
+func readHintFeedbackConfig() (HintFeedbackConfig, error) {
+	enabled, err := commonconfig.BoolFromEnv("TWENTYQ_HINT_FEEDBACK_ENABLED", true)
+	if err != nil {
+		return HintFeedbackConfig{}, fmt.Errorf("read TWENTYQ_HINT_FEEDBACK_ENABLED failed: %w", err)
+	}
+	lookbackDays, err := commonconfig.IntFromEnv("TWENTYQ_HINT_FEEDBACK_LOOKBACK_DAYS", 30)
+	if err != nil {
+		return HintFeedbackConfig{}, fmt.Errorf("read TWENTYQ_HINT_FEEDBACK_LOOKBACK_DAYS failed: %w", err)
+	}
+	minVotes, err := commonconfig.IntFromEnv("TWENTYQ_HINT_FEEDBACK_MIN_VOTES", 10)
+	if err != nil {
+		return HintFeedbackConfig{}, fmt.Errorf("read TWENTYQ_HINT_FEEDBACK_MIN_VOTES failed: %w", err)
+	}
+
+	return HintFeedbackConfig{
+		Enabled:      enabled,
+		LookbackDays: max(lookbackDays, 1),
+		MinVotes:     max(minVotes, 1),
+	}, nil
+}
+
+func readAnalyticsExportConfig() (AnalyticsExportConfig, error) {
+	enabled, err := commonconfig.BoolFromEnv("TWENTYQ_ANALYTICS_EXPORT_ENABLED", false)
+	if err != nil {
+		return AnalyticsExportConfig{}, fmt.Errorf("read TWENTYQ_ANALYTICS_EXPORT_ENABLED failed: %w", err)
+	}
+	runAt, err := parseClockMinute(commonconfig.StringFromEnv("TWENTYQ_ANALYTICS_EXPORT_TIME", "04:00"))
+	if err != nil {
+		return AnalyticsExportConfig{}, fmt.Errorf("read TWENTYQ_ANALYTICS_EXPORT_TIME failed: %w", err)
+	}
+	backfill, err := commonconfig.IntFromEnv("TWENTYQ_ANALYTICS_EXPORT_BACKFILL_DAYS", 7)
+	if err != nil {
+		return AnalyticsExportConfig{}, fmt.Errorf("read TWENTYQ_ANALYTICS_EXPORT_BACKFILL_DAYS failed: %w", err)
+	}
+	if backfill <= 0 {
+		backfill = 1
+	}
+
+	cfg := AnalyticsExportConfig{
+		Enabled:      enabled,
+		RunAtMinute:  runAt,
+		BackfillDays: backfill,
+		Salt:         commonconfig.StringFromEnv("TWENTYQ_ANALYTICS_SALT", ""),
+		Dir:          commonconfig.StringFromEnv("TWENTYQ_ANALYTICS_EXPORT_DIR", "data/analytics"),
+		S3Bucket:     commonconfig.StringFromEnv("TWENTYQ_ANALYTICS_S3_BUCKET", ""),
+		S3Prefix:     strings.Trim(commonconfig.StringFromEnv("TWENTYQ_ANALYTICS_S3_PREFIX", "twentyq"), "/"),
+		S3Region:     commonconfig.StringFromEnvFirstNonEmpty([]string{"TWENTYQ_ANALYTICS_S3_REGION", "AWS_REGION"}, "us-east-1"),
+		S3Endpoint:   commonconfig.StringFromEnv("TWENTYQ_ANALYTICS_S3_ENDPOINT", ""),
+		S3AccessKeyID: commonconfig.StringFromEnvFirstNonEmpty(
+			[]string{"TWENTYQ_ANALYTICS_S3_ACCESS_KEY_ID", "AWS_ACCESS_KEY_ID"}, "",
+		),
+		S3SecretAccessKey: commonconfig.StringFromEnvFirstNonEmpty(
+			[]string{"TWENTYQ_ANALYTICS_S3_SECRET_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY"}, "",
+		),
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+	// 솔트 없이 해시하면 ID 목록만으로 원래 값을 역추적할 수 있음
+	if len(cfg.Salt) < 16 {
+		return AnalyticsExportConfig{}, fmt.Errorf("read TWENTYQ_ANALYTICS_SALT failed: at least 16 characters required when export is enabled")
+	}
+	if cfg.S3Bucket != "" && (cfg.S3AccessKeyID == "" || cfg.S3SecretAccessKey == "") {
+		return AnalyticsExportConfig{}, fmt.Errorf("read TWENTYQ_ANALYTICS_S3 credentials failed: access key id and secret are required")
+	}
+	return cfg, nil
+}
+
+// parseClockMinute: "HH:MM" 형식의 시각을 자정부터의 분으로 변환합니다.
+func parseClockMinute(raw string) (int, error) {
+	hourRaw, minuteRaw, ok := strings.Cut(strings.TrimSpace(raw), ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid clock %q: expected HH:MM", raw)
+	}
+	hour, err := strconv.Atoi(hourRaw)
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, fmt.Errorf("invalid clock %q: hour out of range", raw)
+	}
+	minute, err := strconv.Atoi(minuteRaw)
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, fmt.Errorf("invalid clock %q: minute out of range", raw)
+	}
+	return hour*60 + minute, nil
+}
+
+func parseWeekday(raw string) (time.Weekday, error) {
+	normalized := strings.ToLower(strings.TrimSpace(raw))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if normalized == name || normalized == name[:3] {
+			return day, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid weekday %q", raw)
+}
+
 func readServerConfig() (ServerConfig, error) {
 	cfg, err := commonconfig.ReadServerConfigFromEnv(40258)
 	if err != nil {
@@ -199,6 +577,7 @@ func readLlmConfig() (LlmConfig, error) {
 	if err != nil {
 		return LlmConfig{}, fmt.Errorf("read llm config failed: %w", err)
 	}
+	cfg.BotID = "twentyq"
 	return cfg, nil
 }
 
@@ -30,6 +30,31 @@ const (
 	MaxHintsTotal = 1
 )
 
+// QuestionBudget: 예산 줄에 표시하는 기준 질문 수 (표시용이며 초과해도 게임은 계속됨)
+const (
+	QuestionBudget = 20
+)
+
+// OpeningQuestionDepth: 추천 첫 질문 집계에 기록하는 게임당 앞쪽 질문 수
+const (
+	OpeningQuestionDepth = 3
+)
+
+// TournamentMinRounds: 토너먼트 라운드 수 범위와 정답 기본 점수
+// 정답자는 기본 점수에 질문 예산 대비 남은 질문 수만큼 보너스를 받습니다.
+const (
+	TournamentMinRounds  = 2
+	TournamentMaxRounds  = 10
+	TournamentBasePoints = 10
+)
+
+// HotseatTurnTimeoutSeconds: 턴제 모드에서 한 차례에 주어지는 시간과 만료 확인 주기
+// 제한 시간을 넘기면 차례가 자동으로 다음 참가자에게 넘어갑니다.
+const (
+	HotseatTurnTimeoutSeconds   = 90
+	HotseatWatchIntervalSeconds = 10
+)
+
 // HintDisplayInterval: 힌트 라인을 표시할 질문 간격 (N번 질문마다 표시)
 // 0이면 항상 표시, 양수면 해당 횟수마다 표시
 const (
@@ -51,6 +76,32 @@ const (
 	RedisKeyVotePrefix    = RedisKeyPrefix + ":surrender:vote"
 	RedisKeyPendingPrefix = RedisKeyPrefix + ":pending-messages"
 	RedisKeyLockPrefix    = RedisKeyPrefix + ":lock"
+
+	RedisKeyThemeEvents         = RedisKeyPrefix + ":theme-events"
+	RedisKeyThemeEventAnnounced = RedisKeyPrefix + ":theme-events:announced"
+
+	RedisKeyDigestSent = RedisKeyPrefix + ":digest:sent"
+
+	RedisKeyBudgetHidden = RedisKeyPrefix + ":settings:budget-hidden"
+
+	RedisKeyTournament = RedisKeyPrefix + ":tournament"
+
+	RedisKeyOpeningSuggestions = RedisKeyPrefix + ":opening:suggestions"
+	RedisKeyOpeningMined       = RedisKeyPrefix + ":opening:mined"
+	RedisKeyOpeningOff         = RedisKeyPrefix + ":settings:opening-off"
+
+	RedisKeyLLMUsage = RedisKeyPrefix + ":llm-usage"
+
+	RedisKeyHotseat      = RedisKeyPrefix + ":hotseat"
+	RedisKeyHotseatMode  = RedisKeyPrefix + ":settings:hotseat"
+	RedisKeyHotseatRooms = RedisKeyPrefix + ":hotseat-rooms"
+
+	RedisKeyChatSettings = RedisKeyPrefix + ":settings:chat"
+	RedisKeyChatLocale   = RedisKeyPrefix + ":settings:locale"
+
+	RedisKeyCombo = RedisKeyPrefix + ":combo"
+
+	RedisKeySynonyms = RedisKeyPrefix + ":synonyms" // 관리자가 등록한 별칭 → 정답 해시
 )
 
 // DefaultExchangeRateAPIURL: USD/KRW 환율 조회를 위한 기본 API URL입니다.
@@ -63,6 +114,7 @@ const (
 	RedisSessionTTLSeconds    = 12 * 60 * 60
 	RedisLockTTLSeconds       = 5
 	RedisProcessingTTLSeconds = 200
+	RedisTournamentTTLSeconds = 24 * 60 * 60
 )
 
 // MQMaxQueueIterations: twentyq 전용 상수입니다.
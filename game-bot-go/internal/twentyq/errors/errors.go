@@ -46,3 +46,26 @@ type GuessRateLimitError struct {
 func (e GuessRateLimitError) Error() string {
 	return fmt.Sprintf("guess rate limit exceeded remainingSeconds=%d", e.RemainingSeconds)
 }
+
+// NotYourTurnError: 턴제 모드에서 현재 차례가 아닌 참가자가 질문했을 때 발생하는 에러
+type NotYourTurnError struct {
+	CurrentSender string
+}
+
+func (e NotYourTurnError) Error() string {
+	return fmt.Sprintf("not your turn current=%s", e.CurrentSender)
+}
+
+// HotseatNotJoinedError: 턴제 모드에서 참가 등록하지 않은 사용자가 질문했을 때 발생하는 에러
+type HotseatNotJoinedError struct{}
+
+func (e HotseatNotJoinedError) Error() string { return "hotseat not joined" }
+
+// QuestionLimitExceededError: 방 설정의 최대 질문 수에 도달했을 때 발생하는 에러
+type QuestionLimitExceededError struct {
+	MaxQuestions int
+}
+
+func (e QuestionLimitExceededError) Error() string {
+	return fmt.Sprintf("question limit exceeded maxQuestions=%d", e.MaxQuestions)
+}
@@ -0,0 +1,87 @@
+package httpapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/analytics"
+)
+
+func registerAnalyticsRoutes(mux *http.ServeMux, deps AdminDeps) {
+	mux.HandleFunc("GET /admin/analytics/partitions", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminAnalyticsPartitions(w, r, deps)
+	})
+	mux.HandleFunc("POST /admin/analytics/exports", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminAnalyticsExport(w, r, deps)
+	})
+}
+
+// handleAdminAnalyticsPartitions: 내보낸 파티션 목록 조회 (?table=games|players, ?from=YYYY-MM-DD, ?to=YYYY-MM-DD)
+func handleAdminAnalyticsPartitions(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	query := r.URL.Query()
+	table := strings.TrimSpace(query.Get("table"))
+	from := strings.TrimSpace(query.Get("from"))
+	to := strings.TrimSpace(query.Get("to"))
+	for _, raw := range []string{from, to} {
+		if raw == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, raw); err != nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "from/to must be YYYY-MM-DD")
+			return
+		}
+	}
+
+	manifest, err := deps.AnalyticsExporter.Manifest(r.Context())
+	if err != nil {
+		deps.Logger.Error("ADMIN_ANALYTICS_MANIFEST_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to load analytics manifest")
+		return
+	}
+
+	// 날짜 문자열이 YYYY-MM-DD 고정 형식이므로 사전순 비교로 범위를 거릅니다.
+	partitions := make([]analytics.Partition, 0, len(manifest.Partitions))
+	for _, p := range manifest.Partitions {
+		if table != "" && p.Table != table {
+			continue
+		}
+		if (from != "" && p.Date < from) || (to != "" && p.Date > to) {
+			continue
+		}
+		partitions = append(partitions, p)
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":     "ok",
+		"updatedAt":  manifest.UpdatedAt,
+		"partitions": partitions,
+		"count":      len(partitions),
+	})
+}
+
+// handleAdminAnalyticsExport: 지정한 날짜(?date=YYYY-MM-DD)를 즉시 다시 내보냅니다.
+func handleAdminAnalyticsExport(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	date, err := time.ParseInLocation(time.DateOnly, strings.TrimSpace(r.URL.Query().Get("date")), analytics.Timezone)
+	if err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "date must be YYYY-MM-DD")
+		return
+	}
+	if !date.Before(analytics.DayOf(time.Now())) {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "only past dates can be exported")
+		return
+	}
+
+	partitions, err := deps.AnalyticsExporter.ExportDate(r.Context(), date)
+	if err != nil {
+		deps.Logger.Error("ADMIN_ANALYTICS_EXPORT_FAILED", "date", date.Format(time.DateOnly), "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to export analytics")
+		return
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":     "ok",
+		"partitions": partitions,
+	})
+}
@@ -0,0 +1,115 @@
+package httpapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qsvc "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/service"
+)
+
+// ChatSettingsRequest: 방별 게임 설정 변경 요청 DTO (0이나 빈 목록은 기본값)
+type ChatSettingsRequest struct {
+	MaxQuestions      int      `json:"maxQuestions"`
+	MaxHints          int      `json:"maxHints"`
+	AllowedCategories []string `json:"allowedCategories"`
+	SurrenderVotes    int      `json:"surrenderVotes"`
+	AdminUserID       string   `json:"adminUserId"`
+}
+
+func registerChatSettingsRoutes(mux *http.ServeMux, deps AdminDeps) {
+	mux.HandleFunc("GET /admin/settings/{chatId}", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminChatSettingsGet(w, r, deps)
+	})
+	mux.HandleFunc("PUT /admin/settings/{chatId}", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminChatSettingsPut(w, r, deps)
+	})
+	mux.HandleFunc("DELETE /admin/settings/{chatId}", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminChatSettingsDelete(w, r, deps)
+	})
+}
+
+// handleAdminChatSettingsGet: 방별 게임 설정과 실제 적용 규칙 조회
+func handleAdminChatSettingsGet(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	chatID := strings.TrimSpace(r.PathValue("chatId"))
+	if chatID == "" {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "chatId is required")
+		return
+	}
+
+	settings, err := deps.ChatSettingsStore.Get(r.Context(), chatID)
+	if err != nil {
+		deps.Logger.Error("ADMIN_CHAT_SETTINGS_GET_FAILED", "chatId", chatID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to load chat settings")
+		return
+	}
+	writeChatSettings(w, chatID, settings)
+}
+
+// handleAdminChatSettingsPut: 방별 게임 설정 덮어쓰기 (진행 중인 게임에도 즉시 적용)
+func handleAdminChatSettingsPut(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	chatID := strings.TrimSpace(r.PathValue("chatId"))
+	if chatID == "" {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "chatId is required")
+		return
+	}
+
+	var req ChatSettingsRequest
+	if err := commonhttputil.ReadJSON(r, &req, 4096); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "invalid request body")
+		return
+	}
+
+	settings, err := qmodel.ChatSettings{
+		MaxQuestions:      req.MaxQuestions,
+		MaxHints:          req.MaxHints,
+		AllowedCategories: req.AllowedCategories,
+		SurrenderVotes:    req.SurrenderVotes,
+		UpdatedBy:         strings.TrimSpace(req.AdminUserID),
+		UpdatedAt:         time.Now(),
+	}.Validate(qconfig.AllCategories)
+	if err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, err.Error())
+		return
+	}
+
+	if err := deps.ChatSettingsStore.Save(r.Context(), chatID, settings); err != nil {
+		deps.Logger.Error("ADMIN_CHAT_SETTINGS_SAVE_FAILED", "chatId", chatID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to save chat settings")
+		return
+	}
+
+	deps.Logger.Info("ADMIN_CHAT_SETTINGS_SAVED", "chatId", chatID, "adminUserId", settings.UpdatedBy)
+	writeChatSettings(w, chatID, settings)
+}
+
+// handleAdminChatSettingsDelete: 방별 게임 설정을 삭제해 기본 규칙으로 되돌림
+func handleAdminChatSettingsDelete(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	chatID := strings.TrimSpace(r.PathValue("chatId"))
+	if chatID == "" {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "chatId is required")
+		return
+	}
+
+	if err := deps.ChatSettingsStore.Delete(r.Context(), chatID); err != nil {
+		deps.Logger.Error("ADMIN_CHAT_SETTINGS_DELETE_FAILED", "chatId", chatID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to delete chat settings")
+		return
+	}
+
+	deps.Logger.Info("ADMIN_CHAT_SETTINGS_DELETED", "chatId", chatID)
+	writeChatSettings(w, chatID, qmodel.ChatSettings{})
+}
+
+func writeChatSettings(w http.ResponseWriter, chatID string, settings qmodel.ChatSettings) {
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":    "ok",
+		"chatId":    chatID,
+		"settings":  settings,
+		"isDefault": settings.IsDefault(),
+		"effective": qsvc.ResolveGameRules(settings),
+	})
+}
@@ -0,0 +1,62 @@
+package httpapi
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+)
+
+func TestAdminChatSettingsRoutes(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	mux := http.NewServeMux()
+	registerChatSettingsRoutes(mux, AdminDeps{
+		ChatSettingsStore: qredis.NewChatSettingsStore(client, logger),
+		Logger:            logger,
+	})
+	chatID := testhelper.UniqueTestPrefix(t) + "room"
+
+	do := func(method string, body string) (int, map[string]any) {
+		req := httptest.NewRequest(method, "/admin/settings/"+chatID, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		var out map[string]any
+		_ = json.Unmarshal(rec.Body.Bytes(), &out)
+		return rec.Code, out
+	}
+
+	code, out := do(http.MethodPut, `{"maxQuestions":30,"allowedCategories":["Food","place"],"adminUserId":"ops"}`)
+	if code != http.StatusOK {
+		t.Fatalf("PUT status = %d, body = %v", code, out)
+	}
+	effective, _ := out["effective"].(map[string]any)
+	if effective["maxQuestions"] != float64(30) || effective["questionLimit"] != true || effective["maxHints"] != float64(1) {
+		t.Fatalf("unexpected effective rules: %v", effective)
+	}
+
+	if code, _ := do(http.MethodPut, `{"allowedCategories":["spaceship"]}`); code != http.StatusBadRequest {
+		t.Fatalf("unknown category must be rejected, got %d", code)
+	}
+
+	code, out = do(http.MethodGet, "")
+	settings, _ := out["settings"].(map[string]any)
+	if code != http.StatusOK || settings["updatedBy"] != "ops" || out["isDefault"] != false {
+		t.Fatalf("GET status = %d, body = %v", code, out)
+	}
+
+	code, out = do(http.MethodDelete, "")
+	if code != http.StatusOK || out["isDefault"] != true {
+		t.Fatalf("DELETE status = %d, body = %v", code, out)
+	}
+}
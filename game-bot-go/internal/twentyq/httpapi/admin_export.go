@@ -0,0 +1,123 @@
+package httpapi
+
+import (
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/dbutil"
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+// gameExportHeader: /admin/games?format=csv 의 열 순서
+var gameExportHeader = []string{
+	"sessionId", "chatId", "category", "target", "result",
+	"participantCount", "questionCount", "hintCount", "maxCombo", "completedAt",
+}
+
+// userStatsExportHeader: /admin/users/stats?format=csv 의 열 순서
+var userStatsExportHeader = []string{
+	"chatId", "userId", "totalGamesStarted", "totalGamesCompleted", "totalSurrenders",
+	"totalQuestionsAsked", "totalHintsUsed", "totalWrongGuesses",
+	"bestScoreQuestionCount", "bestScoreWrongGuessCount", "bestScoreTarget", "bestScoreCategory", "bestScoreAchievedAt",
+	"updatedAt",
+}
+
+// filterGameSessions: 게임 히스토리 목록/개수/내보내기가 공유하는 필터
+func filterGameSessions(db *gorm.DB, category string, result string) *gorm.DB {
+	db = db.Model(&qrepo.GameSession{})
+	if category != "" {
+		db = db.Where("category = ?", category)
+	}
+	if result != "" {
+		db = db.Where("result = ?", result)
+	}
+	return db
+}
+
+// filterUserStats: 유저 통계 목록/내보내기가 공유하는 필터
+func filterUserStats(db *gorm.DB, chatID string) *gorm.DB {
+	db = db.Model(&qrepo.UserStats{})
+	if chatID != "" {
+		db = db.Where("chat_id = ?", chatID)
+	}
+	return db
+}
+
+// exportAdminGamesCSV: 필터에 맞는 게임 히스토리 전체를 CSV로 스트리밍합니다. (limit/offset 무시)
+func exportAdminGamesCSV(w http.ResponseWriter, r *http.Request, deps AdminDeps, category string, result string) {
+	start := time.Now()
+	query := filterGameSessions(deps.DB.WithContext(r.Context()), category, result).Order("completed_at DESC")
+
+	stream, err := commonhttputil.NewCSVStream(w, exportFilename("twentyq-games", start), gameExportHeader)
+	if err != nil {
+		deps.Logger.Warn("ADMIN_GAMES_EXPORT_WRITE_FAILED", "err", err)
+		return
+	}
+	err = dbutil.EachRow(query, func(s *qrepo.GameSession) error {
+		return stream.Write([]string{
+			s.SessionID,
+			s.ChatID,
+			s.Category,
+			s.Target,
+			s.Result,
+			commonhttputil.CSVInt(s.ParticipantCount),
+			commonhttputil.CSVInt(s.QuestionCount),
+			commonhttputil.CSVInt(s.HintCount),
+			commonhttputil.CSVInt(s.MaxCombo),
+			commonhttputil.CSVTime(s.CompletedAt),
+		})
+	})
+	finishExport(deps, stream, "ADMIN_GAMES_EXPORT", start, err)
+}
+
+// exportAdminUserStatsCSV: 유저 통계 전체를 CSV로 스트리밍합니다. (limit/offset 무시)
+func exportAdminUserStatsCSV(w http.ResponseWriter, r *http.Request, deps AdminDeps, chatID string) {
+	start := time.Now()
+	query := filterUserStats(deps.DB.WithContext(r.Context()), chatID).Order("total_games_completed DESC, id ASC")
+
+	stream, err := commonhttputil.NewCSVStream(w, exportFilename("twentyq-user-stats", start), userStatsExportHeader)
+	if err != nil {
+		deps.Logger.Warn("ADMIN_USER_STATS_EXPORT_WRITE_FAILED", "err", err)
+		return
+	}
+	err = dbutil.EachRow(query, func(s *qrepo.UserStats) error {
+		return stream.Write([]string{
+			s.ChatID,
+			s.UserID,
+			commonhttputil.CSVInt(s.TotalGamesStarted),
+			commonhttputil.CSVInt(s.TotalGamesCompleted),
+			commonhttputil.CSVInt(s.TotalSurrenders),
+			commonhttputil.CSVInt(s.TotalQuestionsAsked),
+			commonhttputil.CSVInt(s.TotalHintsUsed),
+			commonhttputil.CSVInt(s.TotalWrongGuesses),
+			commonhttputil.CSVIntPtr(s.BestScoreQuestionCnt),
+			commonhttputil.CSVIntPtr(s.BestScoreWrongGuess),
+			commonhttputil.CSVStringPtr(s.BestScoreTarget),
+			commonhttputil.CSVStringPtr(s.BestScoreCategory),
+			commonhttputil.CSVTimePtr(s.BestScoreAchievedAt),
+			commonhttputil.CSVTime(s.UpdatedAt),
+		})
+	})
+	finishExport(deps, stream, "ADMIN_USER_STATS_EXPORT", start, err)
+}
+
+// finishExport: 남은 버퍼를 내보내고 결과를 로깅합니다.
+// 헤더를 이미 보냈으므로 도중 실패는 응답 코드로 알릴 수 없어 로그로만 남깁니다.
+func finishExport(deps AdminDeps, stream *commonhttputil.CSVStream, event string, start time.Time, err error) {
+	if closeErr := stream.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		deps.Logger.Error(event+"_FAILED", "rows", stream.Rows(), "err", err)
+		return
+	}
+	deps.Logger.Info(event+"_SUCCESS", "rows", stream.Rows(), "duration", time.Since(start).Milliseconds())
+}
+
+// exportFilename: 내려받기 파일명 (예: twentyq-games-20260101-120000.csv)
+func exportFilename(prefix string, at time.Time) string {
+	return prefix + "-" + at.UTC().Format("20060102-150405") + "." + commonhttputil.FormatCSV
+}
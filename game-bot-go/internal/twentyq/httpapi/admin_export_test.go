@@ -0,0 +1,74 @@
+package httpapi
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+func TestHandleAdminGames_CSVExportsAllRows(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite failed: %v", err)
+	}
+	if err := db.AutoMigrate(&qrepo.GameSession{}); err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+
+	// 페이지 상한(100)보다 많은 행을 넣어 limit이 무시되는지 확인
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 130 {
+		result := "CORRECT"
+		if i%2 == 1 {
+			result = "SURRENDER"
+		}
+		session := qrepo.GameSession{
+			SessionID:        fmt.Sprintf("s-%03d", i),
+			ChatID:           "room",
+			Category:         "FOOD",
+			Target:           "=cmd|' /C calc'!A0",
+			Result:           result,
+			ParticipantCount: 2,
+			QuestionCount:    i,
+			CompletedAt:      base.Add(time.Duration(i) * time.Minute),
+		}
+		if err := db.Create(&session).Error; err != nil {
+			t.Fatalf("seed failed: %v", err)
+		}
+	}
+
+	deps := AdminDeps{DB: db, Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	rec := httptest.NewRecorder()
+	handleAdminGames(rec, httptest.NewRequest(http.MethodGet, "/admin/games?format=csv&limit=10&result=CORRECT", nil), deps)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	if !strings.Contains(rec.Header().Get("Content-Disposition"), "twentyq-games-") {
+		t.Fatalf("unexpected disposition: %q", rec.Header().Get("Content-Disposition"))
+	}
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(rec.Body.String(), "\ufeff"))).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid csv: %v", err)
+	}
+	if len(records) != 66 {
+		t.Fatalf("expected header + 65 rows, got %d", len(records))
+	}
+	if records[0][0] != "sessionId" || records[1][0] != "s-128" {
+		t.Fatalf("expected newest first, got %v / %v", records[0], records[1])
+	}
+	if records[1][3] != "'=cmd|' /C calc'!A0" {
+		t.Fatalf("formula cell not neutralized: %q", records[1][3])
+	}
+}
@@ -0,0 +1,90 @@
+package httpapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+// HintFeedbackStatsResponse: 카테고리별 힌트 평가 집계 DTO
+type HintFeedbackStatsResponse struct {
+	Category   string  `json:"category"`
+	Useful     int     `json:"useful"`
+	Useless    int     `json:"useless"`
+	Total      int     `json:"total"`
+	UsefulRate float64 `json:"usefulRate"` // 0~100
+}
+
+// UselessHintResponse: 무용 평가가 더 많은 힌트 문구 DTO
+type UselessHintResponse struct {
+	Category string `json:"category"`
+	HintText string `json:"hintText"`
+	Useful   int    `json:"useful"`
+	Useless  int    `json:"useless"`
+}
+
+// handleAdminHintFeedback: 힌트 평가 집계 조회 (?days=30, ?category=, ?limit=20)
+func handleAdminHintFeedback(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	days := parseIntOrDefault(query.Get("days"), 30)
+	if days < 1 || days > 365 {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "days must be between 1 and 365")
+		return
+	}
+	limit := min(max(parseIntOrDefault(query.Get("limit"), 20), 1), 100)
+	category := strings.TrimSpace(query.Get("category"))
+	since := time.Now().AddDate(0, 0, -days)
+
+	repo := qrepo.New(deps.DB)
+	stats, err := repo.HintFeedbackStats(ctx, since)
+	if err != nil {
+		deps.Logger.Error("ADMIN_HINT_FEEDBACK_STATS_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to query hint feedback stats")
+		return
+	}
+	useless, err := repo.UselessHints(ctx, since, category, limit)
+	if err != nil {
+		deps.Logger.Error("ADMIN_HINT_FEEDBACK_USELESS_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to query useless hints")
+		return
+	}
+
+	categories := make([]HintFeedbackStatsResponse, 0, len(stats))
+	var overall HintFeedbackStatsResponse
+	for _, stat := range stats {
+		if category != "" && stat.Category != category {
+			continue
+		}
+		item := hintFeedbackStats(stat.Category, stat.Useful, stat.Useless)
+		categories = append(categories, item)
+		overall.Useful += stat.Useful
+		overall.Useless += stat.Useless
+	}
+	overall = hintFeedbackStats("", overall.Useful, overall.Useless)
+
+	hints := make([]UselessHintResponse, 0, len(useless))
+	for _, h := range useless {
+		hints = append(hints, UselessHintResponse{Category: h.Category, HintText: h.HintText, Useful: h.Useful, Useless: h.Useless})
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":       "ok",
+		"days":         days,
+		"overall":      overall,
+		"categories":   categories,
+		"uselessHints": hints,
+	})
+}
+
+// hintFeedbackStats: 평가 수로 응답 DTO를 만들고 유용 비율(%)을 계산합니다.
+func hintFeedbackStats(category string, useful int, useless int) HintFeedbackStatsResponse {
+	out := HintFeedbackStatsResponse{Category: category, Useful: useful, Useless: useless, Total: useful + useless}
+	if out.Total > 0 {
+		out.UsefulRate = float64(useful) / float64(out.Total) * 100
+	}
+	return out
+}
@@ -13,10 +13,15 @@ import (
 	"gorm.io/gorm"
 
 	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/runtimeconfig"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/analytics"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
 	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
 	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+	qsvc "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/service"
 )
 
 // Admin API 에러 코드
@@ -56,6 +61,7 @@ type GameHistoryResponse struct {
 	ParticipantCount int       `json:"participantCount"`
 	QuestionCount    int       `json:"questionCount"`
 	HintCount        int       `json:"hintCount"`
+	MaxCombo         int       `json:"maxCombo"`
 	CompletedAt      time.Time `json:"completedAt"`
 }
 
@@ -97,7 +103,19 @@ type AdminDeps struct {
 	DB           *gorm.DB
 	ValkeyClient valkey.Client
 	SessionStore *qredis.SessionStore
-	Logger       *slog.Logger
+	// ThemeEventStore: 테마 이벤트 저장소 (nil이면 테마 이벤트 API 미등록)
+	ThemeEventStore *qredis.ThemeEventStore
+	// ChatSettingsStore: 방별 게임 설정 저장소 (nil이면 설정 API 미등록)
+	ChatSettingsStore *qredis.ChatSettingsStore
+	// AnalyticsExporter: 분석용 Parquet 내보내기 (nil이면 내보내기 API 미등록)
+	AnalyticsExporter *analytics.Exporter
+	// LatencyReporter: 명령어 응답 지연 리포트 (nil이면 지연 리포트 API 미등록)
+	LatencyReporter *latency.Reporter
+	// RuntimeConfig: 관리 대시보드가 푸시하는 런타임 설정 (nil이면 런타임 설정 API 미등록)
+	RuntimeConfig *runtimeconfig.Registry
+	// Seasons: 방별 시즌 서비스 (nil이면 시즌 API 미등록)
+	Seasons *qsvc.SeasonService
+	Logger  *slog.Logger
 }
 
 // RegisterAdminRoutes: Admin API 라우트 등록
@@ -152,6 +170,9 @@ func RegisterAdminRoutes(mux *http.ServeMux, deps AdminDeps) {
 	mux.HandleFunc("GET /admin/stats/categories", func(w http.ResponseWriter, r *http.Request) {
 		handleAdminCategoryStats(w, r, deps)
 	})
+	mux.HandleFunc("GET /admin/stats/hints", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminHintFeedback(w, r, deps)
+	})
 	mux.HandleFunc("GET /admin/nicknames", func(w http.ResponseWriter, r *http.Request) {
 		handleAdminNicknames(w, r, deps)
 	})
@@ -173,7 +194,34 @@ func RegisterAdminRoutes(mux *http.ServeMux, deps AdminDeps) {
 		handleAdminRefundLogs(w, r, deps)
 	})
 
-	deps.Logger.Info("twentyq_admin_api_registered", "routes", 22)
+	// Phase 6: 테마 이벤트 (기간 한정 카테고리 가중치)
+	routes := 23
+	if deps.ThemeEventStore != nil {
+		registerThemeEventRoutes(mux, deps)
+		routes += 5
+	}
+	if deps.ChatSettingsStore != nil {
+		registerChatSettingsRoutes(mux, deps)
+		routes += 3
+	}
+	if deps.AnalyticsExporter != nil {
+		registerAnalyticsRoutes(mux, deps)
+		routes += 2
+	}
+	if deps.Seasons != nil {
+		registerSeasonRoutes(mux, deps)
+		routes += 3
+	}
+	if deps.LatencyReporter != nil {
+		mux.HandleFunc("GET /admin/latency", deps.LatencyReporter.HandleReport)
+		routes++
+	}
+	if deps.RuntimeConfig != nil {
+		deps.RuntimeConfig.RegisterRoutes(mux, deps.Logger)
+		routes += 2
+	}
+
+	deps.Logger.Info("twentyq_admin_api_registered", "routes", routes)
 }
 
 // handleAdminStats: 통합 통계 조회
@@ -292,18 +340,16 @@ func handleAdminGames(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
 		limit = 100
 	}
 
-	db := deps.DB.WithContext(ctx).Model(&qrepo.GameSession{}).
+	if commonhttputil.WantsCSV(r) {
+		exportAdminGamesCSV(w, r, deps, category, result)
+		return
+	}
+
+	db := filterGameSessions(deps.DB.WithContext(ctx), category, result).
 		Order("completed_at DESC").
 		Limit(limit).
 		Offset(offset)
 
-	if category != "" {
-		db = db.Where("category = ?", category)
-	}
-	if result != "" {
-		db = db.Where("result = ?", result)
-	}
-
 	var sessions []qrepo.GameSession
 	if err := db.Find(&sessions).Error; err != nil {
 		deps.Logger.Error("ADMIN_GAMES_QUERY_FAILED", "err", err)
@@ -322,28 +368,23 @@ func handleAdminGames(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
 			ParticipantCount: s.ParticipantCount,
 			QuestionCount:    s.QuestionCount,
 			HintCount:        s.HintCount,
+			MaxCombo:         s.MaxCombo,
 			CompletedAt:      s.CompletedAt,
 		})
 	}
 
 	var total int64
-	countDB := deps.DB.WithContext(ctx).Model(&qrepo.GameSession{})
-	if category != "" {
-		countDB = countDB.Where("category = ?", category)
-	}
-	if result != "" {
-		countDB = countDB.Where("result = ?", result)
-	}
-	countDB.Count(&total)
+	filterGameSessions(deps.DB.WithContext(ctx), category, result).Count(&total)
 
 	deps.Logger.Info("ADMIN_GAMES_SUCCESS", "count", len(games), "duration", time.Since(start).Milliseconds())
-	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+	if err := commonhttputil.StreamJSONArray(w, http.StatusOK, map[string]any{
 		"status": "ok",
-		"games":  games,
 		"total":  total,
 		"limit":  limit,
 		"offset": offset,
-	})
+	}, "games", games, offset, commonhttputil.DefaultMaxResponseBytes); err != nil {
+		deps.Logger.Warn("ADMIN_GAMES_WRITE_FAILED", "err", err)
+	}
 }
 
 // handleAdminLeaderboard: 리더보드 조회
@@ -393,8 +434,8 @@ func handleAdminLeaderboard(w http.ResponseWriter, r *http.Request, deps AdminDe
 	})
 }
 
-// synonymKeyPrefix: 동의어 저장용 Valkey 키 접두사
-const synonymKeyPrefix = "20q:synonyms"
+// synonymKeyPrefix: 동의어 저장용 Valkey 키 (정답 판정은 qredis.SynonymStore로 조회)
+const synonymKeyPrefix = qconfig.RedisKeySynonyms
 
 // handleAdminSynonymCreate: 동의어 매핑 생성 (Valkey Hash)
 func handleAdminSynonymCreate(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
@@ -825,14 +866,31 @@ func handleAdminGameDetail(w http.ResponseWriter, r *http.Request, deps AdminDep
 			"participantCount": session.ParticipantCount,
 			"questionCount":    session.QuestionCount,
 			"hintCount":        session.HintCount,
+			"maxCombo":         session.MaxCombo,
 			"completedAt":      session.CompletedAt,
+			"llmTotalTokens":   session.LLMTotalTokens,
+			"llmCostUsd":       session.LLMCostUSD,
 		},
-		"logs":    logs,
-		"audits":  audits,
-		"refunds": refunds,
+		"llmUsage": adminLLMUsage(session, deps),
+		"logs":     logs,
+		"audits":   audits,
+		"refunds":  refunds,
 	})
 }
 
+// adminLLMUsage: 세션에 저장된 LLM 사용량 요약을 해석합니다. (기록이 없거나 깨졌으면 nil)
+func adminLLMUsage(session qrepo.GameSession, deps AdminDeps) *qmodel.LLMUsageSummary {
+	if session.LLMUsageJSON == nil {
+		return nil
+	}
+	var usage qmodel.LLMUsageSummary
+	if err := json.Unmarshal([]byte(*session.LLMUsageJSON), &usage); err != nil {
+		deps.Logger.Warn("ADMIN_GAME_DETAIL_LLM_USAGE_INVALID", "sessionId", session.SessionID, "err", err)
+		return nil
+	}
+	return &usage
+}
+
 // handleAdminSynonymDelete: 동의어 삭제
 func handleAdminSynonymDelete(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
 	ctx := r.Context()
@@ -1217,11 +1275,13 @@ func handleAdminUserStatsList(w http.ResponseWriter, r *http.Request, deps Admin
 
 	deps.Logger.Info("ADMIN_USER_STATS_LIST_REQUEST", "chatId", chatID, "limit", limit)
 
-	query := deps.DB.WithContext(ctx).Model(&qrepo.UserStats{}).Order("total_games_completed DESC").Limit(limit).Offset(offset)
-	if chatID != "" {
-		query = query.Where("chat_id = ?", chatID)
+	if commonhttputil.WantsCSV(r) {
+		exportAdminUserStatsCSV(w, r, deps, chatID)
+		return
 	}
 
+	query := filterUserStats(deps.DB.WithContext(ctx), chatID).Order("total_games_completed DESC").Limit(limit).Offset(offset)
+
 	var stats []qrepo.UserStats
 	if err := query.Find(&stats).Error; err != nil {
 		deps.Logger.Error("ADMIN_USER_STATS_LIST_FAILED", "err", err)
@@ -1333,13 +1393,14 @@ func handleAdminAuditLogs(w http.ResponseWriter, r *http.Request, deps AdminDeps
 	deps.DB.WithContext(ctx).Model(&qrepo.AuditLog{}).Count(&total)
 
 	deps.Logger.Info("ADMIN_AUDIT_LOGS_SUCCESS", "count", len(logs))
-	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+	if err := commonhttputil.StreamJSONArray(w, http.StatusOK, map[string]any{
 		"status": "ok",
-		"logs":   logs,
 		"total":  total,
 		"limit":  limit,
 		"offset": offset,
-	})
+	}, "logs", logs, offset, commonhttputil.DefaultMaxResponseBytes); err != nil {
+		deps.Logger.Warn("ADMIN_AUDIT_LOGS_WRITE_FAILED", "err", err)
+	}
 }
 
 // handleAdminRefundLogs: 리펀드 로그 조회
@@ -1374,11 +1435,12 @@ func handleAdminRefundLogs(w http.ResponseWriter, r *http.Request, deps AdminDep
 	deps.DB.WithContext(ctx).Model(&qrepo.RefundLog{}).Count(&total)
 
 	deps.Logger.Info("ADMIN_REFUND_LOGS_SUCCESS", "count", len(logs))
-	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+	if err := commonhttputil.StreamJSONArray(w, http.StatusOK, map[string]any{
 		"status": "ok",
-		"logs":   logs,
 		"total":  total,
 		"limit":  limit,
 		"offset": offset,
-	})
+	}, "logs", logs, offset, commonhttputil.DefaultMaxResponseBytes); err != nil {
+		deps.Logger.Warn("ADMIN_REFUND_LOGS_WRITE_FAILED", "err", err)
+	}
 }
@@ -0,0 +1,66 @@
+//go:build integration
+
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testhelper.RunIntegrationMain(m))
+}
+
+func TestActiveSessionsScan_MultiplePages(t *testing.T) {
+	client := testhelper.NewIntegrationValkeyClient(t)
+	ctx := context.Background()
+	deps := AdminDeps{
+		ValkeyClient: client,
+		Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	// SCAN COUNT(100)보다 많은 키를 넣어 커서 순회를 검증
+	const sessions = 250
+	for i := range sessions {
+		key := fmt.Sprintf("%s:room-%03d", qconfig.RedisKeySessionPrefix, i)
+		value := fmt.Sprintf(`{"target":"target-%d","category":"ANIMAL"}`, i)
+		cmd := client.B().Set().Key(key).Value(value).Ex(time.Hour).Build()
+		if err := client.Do(ctx, cmd).Error(); err != nil {
+			t.Fatalf("seed failed: %v", err)
+		}
+	}
+	// 패턴에 걸리지 않아야 하는 키
+	if err := client.Do(ctx, client.B().Set().Key(qconfig.RedisKeyPrefix+":other").Value("x").Build()).Error(); err != nil {
+		t.Fatalf("seed failed: %v", err)
+	}
+
+	if got := countActiveSessions(ctx, deps); got != sessions {
+		t.Fatalf("countActiveSessions = %d, want %d", got, sessions)
+	}
+
+	listed := listActiveSessions(ctx, deps)
+	if len(listed) != sessions {
+		t.Fatalf("listActiveSessions len = %d, want %d", len(listed), sessions)
+	}
+	seen := make(map[string]struct{}, len(listed))
+	for _, s := range listed {
+		if s.Category != "ANIMAL" {
+			t.Fatalf("unexpected category: %q", s.Category)
+		}
+		if s.TTLSeconds <= 0 {
+			t.Fatalf("expected positive ttl for %s, got %d", s.ChatID, s.TTLSeconds)
+		}
+		seen[s.ChatID] = struct{}{}
+	}
+	if len(seen) != sessions {
+		t.Fatalf("expected %d unique chat ids, got %d", sessions, len(seen))
+	}
+}
@@ -0,0 +1,168 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+const (
+	adminErrorSeasonNotFound = "SEASON_NOT_FOUND"
+
+	// seasonStatusActive/seasonStatusClosed: 시즌 목록/상세 응답의 status 값
+	seasonStatusActive = "active"
+	seasonStatusClosed = "closed"
+)
+
+// SeasonResponse: 시즌 목록/상세 DTO
+type SeasonResponse struct {
+	ID          uint64     `json:"id"`
+	ChatID      string     `json:"chatId"`
+	Number      int        `json:"number"`
+	Status      string     `json:"status"`
+	StartedAt   time.Time  `json:"startedAt"`
+	EndsAt      time.Time  `json:"endsAt"`
+	ClosedAt    *time.Time `json:"closedAt,omitempty"`
+	AnnouncedAt *time.Time `json:"announcedAt,omitempty"`
+}
+
+// SeasonCloseRequest: 시즌 조기 종료(초기화) 요청 본문
+type SeasonCloseRequest struct {
+	ChatID string `json:"chatId"`
+}
+
+func registerSeasonRoutes(mux *http.ServeMux, deps AdminDeps) {
+	mux.HandleFunc("GET /admin/seasons", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminSeasonList(w, r, deps)
+	})
+	mux.HandleFunc("GET /admin/seasons/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminSeasonDetail(w, r, deps)
+	})
+	mux.HandleFunc("POST /admin/seasons/close", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminSeasonClose(w, r, deps)
+	})
+}
+
+func seasonResponse(season qrepo.Season) SeasonResponse {
+	status := seasonStatusActive
+	if season.ClosedAt != nil {
+		status = seasonStatusClosed
+	}
+	return SeasonResponse{
+		ID:          season.ID,
+		ChatID:      season.ChatID,
+		Number:      season.Number,
+		Status:      status,
+		StartedAt:   season.StartedAt,
+		EndsAt:      season.EndsAt,
+		ClosedAt:    season.ClosedAt,
+		AnnouncedAt: season.AnnouncedAt,
+	}
+}
+
+// handleAdminSeasonList: 시즌 목록 조회 (?chatId=, ?limit=20, ?offset=0)
+func handleAdminSeasonList(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	query := r.URL.Query()
+	chatID := strings.TrimSpace(query.Get("chatId"))
+	limit := min(max(parseIntOrDefault(query.Get("limit"), 20), 1), 100)
+	offset := max(parseIntOrDefault(query.Get("offset"), 0), 0)
+
+	seasons, total, err := qrepo.New(deps.DB).ListSeasons(r.Context(), chatID, limit, offset)
+	if err != nil {
+		deps.Logger.Error("ADMIN_SEASON_LIST_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to list seasons")
+		return
+	}
+
+	items := make([]SeasonResponse, 0, len(seasons))
+	for _, season := range seasons {
+		items = append(items, seasonResponse(season))
+	}
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":  "ok",
+		"seasons": items,
+		"total":   total,
+	})
+}
+
+// handleAdminSeasonDetail: 시즌 상세와 순위 조회 (?limit=50)
+// 닫힌 시즌은 보관된 최종 순위를, 진행 중인 시즌은 현재까지의 집계를 반환합니다.
+func handleAdminSeasonDetail(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	ctx := r.Context()
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "invalid season id")
+		return
+	}
+	limit := min(max(parseIntOrDefault(r.URL.Query().Get("limit"), 50), 1), 500)
+
+	repo := qrepo.New(deps.DB)
+	season, err := repo.GetSeason(ctx, id)
+	if err != nil {
+		deps.Logger.Error("ADMIN_SEASON_GET_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to load season")
+		return
+	}
+	if season == nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, adminErrorSeasonNotFound, "season not found")
+		return
+	}
+
+	var standings []qrepo.SeasonStanding
+	if season.ClosedAt != nil {
+		standings, err = repo.SeasonStandings(ctx, season.ID, limit)
+	} else {
+		standings, err = repo.SeasonLeaderboard(ctx, season.ChatID, season.Number, limit)
+	}
+	if err != nil {
+		deps.Logger.Error("ADMIN_SEASON_STANDINGS_FAILED", "season_id", season.ID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to load season standings")
+		return
+	}
+	if standings == nil {
+		standings = []qrepo.SeasonStanding{}
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":    "ok",
+		"season":    seasonResponse(*season),
+		"standings": standings,
+	})
+}
+
+// handleAdminSeasonClose: 방의 진행 중인 시즌을 지금 닫고 최종 순위를 보관합니다. (시즌 초기화)
+// 다음 게임이 끝나면 새 시즌이 열립니다.
+func handleAdminSeasonClose(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	var req SeasonCloseRequest
+	if err := commonhttputil.ReadJSON(r, &req, 1024); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "invalid request body")
+		return
+	}
+	chatID := strings.TrimSpace(req.ChatID)
+	if chatID == "" {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "chatId is required")
+		return
+	}
+
+	deps.Logger.Info("ADMIN_SEASON_CLOSE_REQUEST", "chatId", chatID)
+	season, closed, err := deps.Seasons.CloseCurrent(r.Context(), chatID)
+	if err != nil {
+		deps.Logger.Error("ADMIN_SEASON_CLOSE_FAILED", "chatId", chatID, "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to close season")
+		return
+	}
+	if !closed || season == nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, adminErrorSeasonNotFound, "no active season")
+		return
+	}
+
+	deps.Logger.Info("ADMIN_SEASON_CLOSE_SUCCESS", "chatId", chatID, "season", season.Number)
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status": "ok",
+		"season": seasonResponse(*season),
+	})
+}
@@ -0,0 +1,390 @@
+package httpapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	commonhttputil "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/httputil"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+const (
+	adminErrorThemeEventNotFound = "THEME_EVENT_NOT_FOUND"
+
+	themeEventDefaultBaselineWeeks = 2
+	themeEventMaxBaselineWeeks     = 8
+	week                           = 7 * 24 * time.Hour
+)
+
+// ThemeEventRequest: 테마 이벤트 생성/수정 요청 DTO
+type ThemeEventRequest struct {
+	Name            string             `json:"name"`
+	Announcement    string             `json:"announcement"`
+	StartsAt        time.Time          `json:"startsAt"`
+	EndsAt          time.Time          `json:"endsAt"`
+	CategoryWeights map[string]float64 `json:"categoryWeights"`
+}
+
+// EngagementWindow: 기간별 참여 지표
+type EngagementWindow struct {
+	From            time.Time      `json:"from"`
+	To              time.Time      `json:"to"`
+	Games           int            `json:"games"`
+	GamesPerDay     float64        `json:"gamesPerDay"`
+	SuccessRate     float64        `json:"successRate"`
+	AvgParticipants float64        `json:"avgParticipants"`
+	AvgQuestions    float64        `json:"avgQuestions"`
+	ActiveRooms     int            `json:"activeRooms"`
+	ThemedShare     float64        `json:"themedShare"`
+	Categories      map[string]int `json:"categories"`
+}
+
+// ThemeEventStatsResponse: 이벤트 기간과 직전 기준 주간 비교 결과 DTO
+type ThemeEventStatsResponse struct {
+	Event            qmodel.ThemeEvent  `json:"event"`
+	InProgress       bool               `json:"inProgress"`
+	ThemedCategories []string           `json:"themedCategories"`
+	EventWindow      EngagementWindow   `json:"eventWindow"`
+	Baseline         []EngagementWindow `json:"baseline"`
+	// Change: 기준 주간 평균 대비 변화율(%) - 기준값이 0이면 생략
+	Change map[string]float64 `json:"change"`
+}
+
+func registerThemeEventRoutes(mux *http.ServeMux, deps AdminDeps) {
+	mux.HandleFunc("GET /admin/theme-events", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminThemeEventList(w, r, deps)
+	})
+	mux.HandleFunc("POST /admin/theme-events", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminThemeEventSave(w, r, deps, "")
+	})
+	mux.HandleFunc("PUT /admin/theme-events/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminThemeEventSave(w, r, deps, r.PathValue("id"))
+	})
+	mux.HandleFunc("DELETE /admin/theme-events/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminThemeEventDelete(w, r, deps)
+	})
+	mux.HandleFunc("GET /admin/theme-events/{id}/stats", func(w http.ResponseWriter, r *http.Request) {
+		handleAdminThemeEventStats(w, r, deps)
+	})
+}
+
+// handleAdminThemeEventList: 테마 이벤트 목록 조회
+func handleAdminThemeEventList(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	events, err := deps.ThemeEventStore.List(r.Context())
+	if err != nil {
+		deps.Logger.Error("ADMIN_THEME_EVENT_LIST_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to list theme events")
+		return
+	}
+
+	now := time.Now()
+	var activeID string
+	for _, event := range events {
+		if event.IsActive(now) {
+			activeID = event.ID
+		}
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status":        "ok",
+		"events":        events,
+		"activeEventId": activeID,
+		"count":         len(events),
+	})
+}
+
+// handleAdminThemeEventSave: 테마 이벤트 생성(POST) 또는 수정(PUT)
+func handleAdminThemeEventSave(w http.ResponseWriter, r *http.Request, deps AdminDeps, eventID string) {
+	ctx := r.Context()
+
+	var req ThemeEventRequest
+	if err := commonhttputil.ReadJSON(r, &req, 8192); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "invalid request body")
+		return
+	}
+
+	event := qmodel.ThemeEvent{
+		ID:              strings.TrimSpace(eventID),
+		Name:            strings.TrimSpace(req.Name),
+		Announcement:    strings.TrimSpace(req.Announcement),
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		CategoryWeights: normalizeCategoryWeights(req.CategoryWeights),
+		CreatedAt:       time.Now(),
+	}
+	if err := event.Validate(qconfig.AllCategories); err != nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, err.Error())
+		return
+	}
+
+	status := http.StatusCreated
+	if event.ID == "" {
+		event.ID = newThemeEventID()
+	} else {
+		existing, err := deps.ThemeEventStore.Get(ctx, event.ID)
+		if err != nil {
+			deps.Logger.Error("ADMIN_THEME_EVENT_GET_FAILED", "err", err)
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to load theme event")
+			return
+		}
+		if existing == nil {
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, adminErrorThemeEventNotFound, "theme event not found")
+			return
+		}
+		event.CreatedAt = existing.CreatedAt
+		status = http.StatusOK
+	}
+
+	if err := deps.ThemeEventStore.Save(ctx, event); err != nil {
+		deps.Logger.Error("ADMIN_THEME_EVENT_SAVE_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to save theme event")
+		return
+	}
+
+	deps.Logger.Info("ADMIN_THEME_EVENT_SAVED", "eventId", event.ID, "name", event.Name)
+	_ = commonhttputil.WriteJSON(w, status, map[string]any{
+		"status": "ok",
+		"event":  event,
+	})
+}
+
+// handleAdminThemeEventDelete: 테마 이벤트 삭제
+func handleAdminThemeEventDelete(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	eventID := r.PathValue("id")
+	removed, err := deps.ThemeEventStore.Delete(r.Context(), eventID)
+	if err != nil {
+		deps.Logger.Error("ADMIN_THEME_EVENT_DELETE_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to delete theme event")
+		return
+	}
+	if !removed {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, adminErrorThemeEventNotFound, "theme event not found")
+		return
+	}
+
+	deps.Logger.Info("ADMIN_THEME_EVENT_DELETED", "eventId", eventID)
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]string{
+		"status":  "ok",
+		"message": "theme event deleted",
+	})
+}
+
+// handleAdminThemeEventStats: 이벤트 기간 참여 지표를 직전 기준 주간들과 비교
+// 기준 주간은 요일 분포가 같도록 주 단위로 이동한 동일 길이 구간입니다. (baselineWeeks, 기본 2)
+func handleAdminThemeEventStats(w http.ResponseWriter, r *http.Request, deps AdminDeps) {
+	ctx := r.Context()
+	event, err := deps.ThemeEventStore.Get(ctx, r.PathValue("id"))
+	if err != nil {
+		deps.Logger.Error("ADMIN_THEME_EVENT_GET_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to load theme event")
+		return
+	}
+	if event == nil {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusNotFound, adminErrorThemeEventNotFound, "theme event not found")
+		return
+	}
+
+	now := time.Now()
+	if now.Before(event.StartsAt) {
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusBadRequest, adminErrorInvalidRequest, "theme event has not started")
+		return
+	}
+
+	baselineWeeks := min(max(parseIntOrDefault(r.URL.Query().Get("baselineWeeks"), themeEventDefaultBaselineWeeks), 1), themeEventMaxBaselineWeeks)
+	themed := themedCategories(event.CategoryWeights)
+
+	eventTo := event.EndsAt
+	if now.Before(eventTo) {
+		eventTo = now
+	}
+
+	eventWindow, err := queryEngagementWindow(ctx, deps, event.StartsAt, eventTo, themed)
+	if err != nil {
+		deps.Logger.Error("ADMIN_THEME_EVENT_STATS_FAILED", "err", err)
+		_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to query theme event stats")
+		return
+	}
+
+	shift := baselineShift(event.EndsAt.Sub(event.StartsAt))
+	baseline := make([]EngagementWindow, 0, baselineWeeks)
+	for i := 1; i <= baselineWeeks; i++ {
+		offset := shift * time.Duration(i)
+		window, err := queryEngagementWindow(ctx, deps, event.StartsAt.Add(-offset), eventTo.Add(-offset), themed)
+		if err != nil {
+			deps.Logger.Error("ADMIN_THEME_EVENT_STATS_FAILED", "err", err)
+			_ = commonhttputil.WriteErrorJSON(w, http.StatusInternalServerError, adminErrorInternalError, "failed to query baseline stats")
+			return
+		}
+		baseline = append(baseline, window)
+	}
+
+	_ = commonhttputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"status": "ok",
+		"stats": ThemeEventStatsResponse{
+			Event:            *event,
+			InProgress:       event.IsActive(now),
+			ThemedCategories: themed,
+			EventWindow:      eventWindow,
+			Baseline:         baseline,
+			Change:           compareEngagement(eventWindow, baseline),
+		},
+	})
+}
+
+func queryEngagementWindow(ctx context.Context, deps AdminDeps, from, to time.Time, themed []string) (EngagementWindow, error) {
+	type categoryAggregate struct {
+		Category          string `gorm:"column:category"`
+		Games             int    `gorm:"column:games"`
+		Successes         int    `gorm:"column:successes"`
+		TotalParticipants int    `gorm:"column:total_participants"`
+		TotalQuestions    int    `gorm:"column:total_questions"`
+	}
+
+	var rows []categoryAggregate
+	if err := deps.DB.WithContext(ctx).
+		Model(&qrepo.GameSession{}).
+		Select("category, count(*) as games, "+
+			"sum(case when result = ? then 1 else 0 end) as successes, "+
+			"coalesce(sum(participant_count), 0) as total_participants, "+
+			"coalesce(sum(question_count), 0) as total_questions", string(qrepo.GameResultCorrect)).
+		Where("completed_at >= ? AND completed_at < ?", from, to).
+		Group("category").
+		Scan(&rows).Error; err != nil {
+		return EngagementWindow{}, fmt.Errorf("query engagement by category failed: %w", err)
+	}
+
+	var activeRooms int64
+	if err := deps.DB.WithContext(ctx).
+		Model(&qrepo.GameSession{}).
+		Where("completed_at >= ? AND completed_at < ?", from, to).
+		Distinct("chat_id").
+		Count(&activeRooms).Error; err != nil {
+		return EngagementWindow{}, fmt.Errorf("query active rooms failed: %w", err)
+	}
+
+	window := EngagementWindow{
+		From:        from,
+		To:          to,
+		ActiveRooms: int(activeRooms),
+		Categories:  make(map[string]int, len(rows)),
+	}
+	var successes, participants, questions, themedGames int
+	for _, row := range rows {
+		window.Games += row.Games
+		window.Categories[row.Category] = row.Games
+		successes += row.Successes
+		participants += row.TotalParticipants
+		questions += row.TotalQuestions
+		for _, category := range themed {
+			if category == row.Category {
+				themedGames += row.Games
+			}
+		}
+	}
+
+	if days := to.Sub(from).Hours() / 24; days > 0 {
+		window.GamesPerDay = roundTo2(float64(window.Games) / days)
+	}
+	if window.Games > 0 {
+		games := float64(window.Games)
+		window.SuccessRate = roundTo2(float64(successes) / games * 100)
+		window.AvgParticipants = roundTo2(float64(participants) / games)
+		window.AvgQuestions = roundTo2(float64(questions) / games)
+		window.ThemedShare = roundTo2(float64(themedGames) / games * 100)
+	}
+	return window, nil
+}
+
+// compareEngagement: 기준 주간 평균 대비 이벤트 기간 지표 변화율(%)을 계산
+func compareEngagement(event EngagementWindow, baseline []EngagementWindow) map[string]float64 {
+	change := make(map[string]float64)
+	if len(baseline) == 0 {
+		return change
+	}
+
+	metrics := map[string]func(EngagementWindow) float64{
+		"gamesPerDay":     func(e EngagementWindow) float64 { return e.GamesPerDay },
+		"successRate":     func(e EngagementWindow) float64 { return e.SuccessRate },
+		"avgParticipants": func(e EngagementWindow) float64 { return e.AvgParticipants },
+		"avgQuestions":    func(e EngagementWindow) float64 { return e.AvgQuestions },
+		"activeRooms":     func(e EngagementWindow) float64 { return float64(e.ActiveRooms) },
+		"themedShare":     func(e EngagementWindow) float64 { return e.ThemedShare },
+	}
+	for name, metric := range metrics {
+		sum := 0.0
+		for _, b := range baseline {
+			sum += metric(b)
+		}
+		avg := sum / float64(len(baseline))
+		if avg == 0 {
+			continue
+		}
+		change[name] = roundTo2((metric(event) - avg) / avg * 100)
+	}
+	return change
+}
+
+// themedCategories: 평균보다 가중치가 높은 카테고리를 테마 카테고리로 간주 (모두 같으면 양수 가중치 전체)
+func themedCategories(weights map[string]float64) []string {
+	positive := make([]string, 0, len(weights))
+	sum := 0.0
+	for _, category := range qconfig.AllCategories {
+		if weights[category] > 0 {
+			positive = append(positive, category)
+			sum += weights[category]
+		}
+	}
+	if len(positive) == 0 {
+		return positive
+	}
+
+	mean := sum / float64(len(positive))
+	themed := make([]string, 0, len(positive))
+	for _, category := range positive {
+		if weights[category] > mean {
+			themed = append(themed, category)
+		}
+	}
+	if len(themed) == 0 {
+		return positive
+	}
+	return themed
+}
+
+// baselineShift: 이벤트 기간과 겹치지 않는 최소 주 단위 이동 간격
+func baselineShift(duration time.Duration) time.Duration {
+	weeks := max(int(math.Ceil(float64(duration)/float64(week))), 1)
+	return time.Duration(weeks) * week
+}
+
+func normalizeCategoryWeights(weights map[string]float64) map[string]float64 {
+	normalized := make(map[string]float64, len(weights))
+	for category, weight := range weights {
+		key := strings.ToLower(strings.TrimSpace(category))
+		if key == "" {
+			continue
+		}
+		normalized[key] = weight
+	}
+	return normalized
+}
+
+func newThemeEventID() string {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("evt-%d", time.Now().UnixNano())
+	}
+	return "evt-" + hex.EncodeToString(b[:])
+}
+
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,50 @@
+package httpapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestThemedCategories(t *testing.T) {
+	got := themedCategories(map[string]float64{"movie": 4, "place": 1, "food": 1})
+	if !reflect.DeepEqual(got, []string{"movie"}) {
+		t.Fatalf("expected boosted category only, got %v", got)
+	}
+
+	uniform := themedCategories(map[string]float64{"place": 1, "food": 1})
+	if !reflect.DeepEqual(uniform, []string{"food", "place"}) {
+		t.Fatalf("expected all positive categories for uniform weights, got %v", uniform)
+	}
+}
+
+func TestBaselineShift(t *testing.T) {
+	if got := baselineShift(3 * 24 * time.Hour); got != week {
+		t.Fatalf("short event should shift by one week, got %v", got)
+	}
+	if got := baselineShift(10 * 24 * time.Hour); got != 2*week {
+		t.Fatalf("10-day event should shift by two weeks, got %v", got)
+	}
+}
+
+func TestCompareEngagement(t *testing.T) {
+	event := EngagementWindow{GamesPerDay: 15, SuccessRate: 50, ThemedShare: 60}
+	baseline := []EngagementWindow{
+		{GamesPerDay: 10, SuccessRate: 40, ThemedShare: 20},
+		{GamesPerDay: 10, SuccessRate: 60, ThemedShare: 20},
+	}
+
+	change := compareEngagement(event, baseline)
+	if change["gamesPerDay"] != 50 {
+		t.Errorf("gamesPerDay change = %v, want 50", change["gamesPerDay"])
+	}
+	if change["successRate"] != 0 {
+		t.Errorf("successRate change = %v, want 0", change["successRate"])
+	}
+	if change["themedShare"] != 200 {
+		t.Errorf("themedShare change = %v, want 200", change["themedShare"])
+	}
+	if _, ok := change["activeRooms"]; ok {
+		t.Errorf("zero baseline metric should be omitted")
+	}
+}
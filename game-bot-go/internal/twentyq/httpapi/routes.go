@@ -33,6 +33,7 @@ func Register(
 	mux *http.ServeMux,
 	riddleService *qsvc.RiddleService,
 	db *gorm.DB,
+	schemaChecker *health.SchemaChecker,
 	msgProvider *messageprovider.Provider,
 	logger *slog.Logger,
 ) {
@@ -41,6 +42,13 @@ func Register(
 		respondJSON(w, http.StatusOK, health.Get())
 	})
 
+	// GET /health/detail - 구성 요소별 상세 헬스체크 (DB 스키마 점검 포함)
+	mux.HandleFunc("GET /health/detail", func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, health.Detailed(map[string]health.Component{
+			"schema": schemaChecker.Check(r.Context()),
+		}))
+	})
+
 	// GET /metrics - Prometheus 메트릭 (장기 히스토리 분석용)
 	mux.Handle("GET /metrics", promhttp.Handler())
 
@@ -12,12 +12,28 @@ const (
 	StartResumeCategoryLine = "start.resume_category_line"
 	StartResumeQnAHeader    = "start.resume_qna_header"
 	StartResumeHintHeader   = "start.resume_hint_header"
+
+	// 테마 이벤트 안내
+	StartThemeEventAnnouncement   = "start.theme_event_announcement"
+	StartThemeEventDefaultMessage = "start.theme_event_default_message"
 )
 
 // HintWaiting: 힌트 생성 및 제공 관련 메시지 키
 const (
 	HintWaiting   = "hint.waiting"
 	HintGenerated = "hint.generated"
+
+	// 힌트 평가
+	HintFeedbackUseful      = "hint.feedback_useful"
+	HintFeedbackUseless     = "hint.feedback_useless"
+	HintFeedbackNoHint      = "hint.feedback_no_hint"
+	HintFeedbackUnavailable = "hint.feedback_unavailable"
+)
+
+// ComboProgress: 연속 '예' 콤보 진행/무료 힌트 안내 메시지 키
+const (
+	ComboProgress = "combo.progress"
+	ComboUnlocked = "combo.unlocked"
 )
 
 // StatusHeaderWithCategory: 게임 상태(Status) 출력 시 사용되는 헤더 및 포맷 관련 메시지 키
@@ -30,6 +46,111 @@ const (
 	StatusChainSuffix        = "status.chain_suffix"
 )
 
+// SummaryWaiting: 중간 참가자용 게임 요약 관련 메시지 키
+const (
+	SummaryWaiting    = "summary.waiting"
+	SummaryHeader     = "summary.header"
+	SummaryEliminated = "summary.eliminated"
+	SummaryEmpty      = "summary.empty"
+)
+
+// BudgetLine: 답변/힌트 응답 하단의 질문·힌트 사용량 줄과 방별 표시 설정 관련 메시지 키
+const (
+	BudgetLine   = "budget.line"
+	BudgetHidden = "budget.hidden"
+	BudgetShown  = "budget.shown"
+)
+
+// OpeningHeader: 게임 시작 시 보여주는 추천 첫 질문과 방별 표시 설정 관련 메시지 키
+const (
+	OpeningHeader      = "opening.header"
+	OpeningItem        = "opening.item"
+	OpeningEnabled     = "opening.enabled"
+	OpeningDisabled    = "opening.disabled"
+	OpeningUnavailable = "opening.unavailable"
+)
+
+// TournamentStarted: 다중 라운드 토너먼트 진행/순위 안내 메시지 키
+const (
+	TournamentStarted        = "tournament.started"
+	TournamentRoundHeader    = "tournament.round_header"
+	TournamentRoundResult    = "tournament.round_result"
+	TournamentRoundNoWinner  = "tournament.round_no_winner"
+	TournamentStandings      = "tournament.standings"
+	TournamentStandingsEmpty = "tournament.standings_empty"
+	TournamentStandingItem   = "tournament.standing_item"
+	TournamentNextRound      = "tournament.next_round"
+	TournamentFinal          = "tournament.final"
+	TournamentFinalNoWinner  = "tournament.final_no_winner"
+	TournamentCancelled      = "tournament.cancelled"
+	TournamentCancelDenied   = "tournament.cancel_denied"
+	TournamentNotFound       = "tournament.not_found"
+	TournamentAlreadyRunning = "tournament.already_running"
+	TournamentGameInProgress = "tournament.game_in_progress"
+	TournamentInvalidRounds  = "tournament.invalid_rounds"
+)
+
+// SeasonStandings: 방별 시즌 순위/종료 안내 메시지 키
+const (
+	SeasonStandings       = "season.standings"
+	SeasonFinalStandings  = "season.final_standings"
+	SeasonItem            = "season.item"
+	SeasonNone            = "season.none"
+	SeasonEmpty           = "season.empty"
+	SeasonClosed          = "season.closed"
+	SeasonClosedNoPlayers = "season.closed_no_players"
+	SeasonDisabled        = "season.disabled"
+)
+
+// HotseatEnabled: 턴제 모드(등록 순서대로 질문) 설정/순서/차례 안내 메시지 키
+const (
+	HotseatEnabled        = "hotseat.enabled"
+	HotseatDisabled       = "hotseat.disabled"
+	HotseatDenied         = "hotseat.denied"
+	HotseatNotActive      = "hotseat.not_active"
+	HotseatStartNotice    = "hotseat.start_notice"
+	HotseatJoined         = "hotseat.joined"
+	HotseatAlreadyJoined  = "hotseat.already_joined"
+	HotseatLeft           = "hotseat.left"
+	HotseatNotJoined      = "hotseat.not_joined"
+	HotseatKicked         = "hotseat.kicked"
+	HotseatPlayerNotFound = "hotseat.player_not_found"
+	HotseatOrder          = "hotseat.order"
+	HotseatOrderEmpty     = "hotseat.order_empty"
+	HotseatOrderItem      = "hotseat.order_item"
+	HotseatOrderAverage   = "hotseat.order_average"
+	HotseatTurn           = "hotseat.turn"
+	HotseatPassed         = "hotseat.passed"
+	HotseatTimedOut       = "hotseat.timed_out"
+	HotseatChainDisabled  = "hotseat.chain_disabled"
+)
+
+// SettingsShow: 방별 게임 규칙(최대 질문/힌트, 허용 카테고리, 포기 투표 인원, 콤보) 설정 안내 메시지 키
+const (
+	SettingsShow             = "settings.show"
+	SettingsQuestionLimit    = "settings.question_limit"
+	SettingsQuestionBudget   = "settings.question_budget"
+	SettingsCategoriesAll    = "settings.categories_all"
+	SettingsSurrenderDefault = "settings.surrender_default"
+	SettingsSurrenderVotes   = "settings.surrender_votes"
+	SettingsComboOff         = "settings.combo_off"
+	SettingsComboStreak      = "settings.combo_streak"
+	SettingsUpdated          = "settings.updated"
+	SettingsReset            = "settings.reset"
+	SettingsInGame           = "settings.in_game"
+	SettingsInvalid          = "settings.invalid"
+	SettingsUnavailable      = "settings.unavailable"
+)
+
+// LocaleShow: 방별 메시지 언어 조회/변경 안내 메시지 키
+const (
+	LocaleShow        = "locale.show"
+	LocaleUpdated     = "locale.updated"
+	LocaleReset       = "locale.reset"
+	LocaleInvalid     = "locale.invalid"
+	LocaleUnavailable = "locale.unavailable"
+)
+
 // VoteStart: 항복 투표(Surrender Vote) 관련 메시지 키
 const (
 	VoteStart              = "vote.start"
@@ -127,6 +248,11 @@ const (
 	ErrorChatBlocked       = "error.chat_blocked"
 	ErrorNoPermission      = "error.no_permission"
 	ErrorGuessRateLimit    = "error.guess_rate_limit"
+	ErrorRateLimitedUser   = "error.rate_limited_user"
+	ErrorRateLimitedChat   = "error.rate_limited_chat"
+	ErrorNotYourTurn       = "error.not_your_turn"
+	ErrorHotseatNotJoined  = "error.hotseat_not_joined"
+	ErrorQuestionLimit     = "error.question_limit_exceeded"
 )
 
 // StatsNotFound: 전적 조회 관련 메시지 키
@@ -152,6 +278,16 @@ const (
 	StatsCategoryAverages = "stats.category.averages"
 	StatsCategoryBest     = "stats.category.best"
 	StatsCategoryNoBest   = "stats.category.no_best"
+
+	StatsProfileRecord   = "stats.profile.record"
+	StatsProfileBest     = "stats.profile.best"
+	StatsProfileFavorite = "stats.profile.favorite"
+	StatsProfileRank     = "stats.profile.rank"
+
+	StatsDigestDailyHeader  = "stats.digest.daily_header"
+	StatsDigestWeeklyHeader = "stats.digest.weekly_header"
+	StatsDigestSummary      = "stats.digest.summary"
+	StatsDigestItem         = "stats.digest.item"
 )
 
 // AdminForceEndPrefix: 관리자 전용 명령어 관련 메시지 키
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ChatSettingsAction: 방별 게임 설정 명령의 세부 동작
+type ChatSettingsAction int
+
+// ChatSettingsShow: 방별 게임 설정 하위 명령 목록
+const (
+	ChatSettingsShow ChatSettingsAction = iota
+	ChatSettingsMaxQuestions
+	ChatSettingsMaxHints
+	ChatSettingsCategories
+	ChatSettingsSurrenderVotes
+	ChatSettingsCombo
+	ChatSettingsReset
+)
+
+// 방별 설정 허용 범위
+const (
+	ChatSettingsMaxQuestionsLimit   = 100
+	ChatSettingsMinQuestions        = 5
+	ChatSettingsMaxHintsLimit       = 5
+	ChatSettingsSurrenderVotesLimit = 10
+	ChatSettingsMinComboStreak      = 3
+	ChatSettingsMaxComboStreak      = 10
+)
+
+// ErrInvalidChatSettings: 방별 설정 값이 허용 범위를 벗어났을 때 반환되는 에러
+var ErrInvalidChatSettings = errors.New("invalid chat settings")
+
+// ChatSettings: 방별 게임 규칙 재정의 값 (0이나 빈 목록은 기본 규칙을 따름)
+type ChatSettings struct {
+	MaxQuestions      int      `json:"maxQuestions,omitempty"`
+	MaxHints          int      `json:"maxHints,omitempty"`
+	AllowedCategories []string `json:"allowedCategories,omitempty"`
+	SurrenderVotes    int      `json:"surrenderVotes,omitempty"`
+	// ComboStreak: 무료 힌트를 주는 연속 '예' 답변 수 (0이면 콤보 꺼짐)
+	ComboStreak int       `json:"comboStreak,omitempty"`
+	UpdatedBy   string    `json:"updatedBy,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// IsDefault: 재정의한 규칙이 하나도 없는지 확인합니다.
+func (c ChatSettings) IsDefault() bool {
+	return c.MaxQuestions == 0 && c.MaxHints == 0 && len(c.AllowedCategories) == 0 && c.SurrenderVotes == 0 && c.ComboStreak == 0
+}
+
+// Validate: 허용 범위와 카테고리 목록(knownCategories)을 검사하고 카테고리를 정규화한 설정을 반환합니다.
+func (c ChatSettings) Validate(knownCategories []string) (ChatSettings, error) {
+	if c.MaxQuestions != 0 && (c.MaxQuestions < ChatSettingsMinQuestions || c.MaxQuestions > ChatSettingsMaxQuestionsLimit) {
+		return c, fmt.Errorf("%w: maxQuestions must be between %d and %d", ErrInvalidChatSettings, ChatSettingsMinQuestions, ChatSettingsMaxQuestionsLimit)
+	}
+	if c.MaxHints < 0 || c.MaxHints > ChatSettingsMaxHintsLimit {
+		return c, fmt.Errorf("%w: maxHints must be between 0 and %d", ErrInvalidChatSettings, ChatSettingsMaxHintsLimit)
+	}
+	if c.SurrenderVotes < 0 || c.SurrenderVotes > ChatSettingsSurrenderVotesLimit {
+		return c, fmt.Errorf("%w: surrenderVotes must be between 0 and %d", ErrInvalidChatSettings, ChatSettingsSurrenderVotesLimit)
+	}
+	if c.ComboStreak != 0 && (c.ComboStreak < ChatSettingsMinComboStreak || c.ComboStreak > ChatSettingsMaxComboStreak) {
+		return c, fmt.Errorf("%w: comboStreak must be between %d and %d", ErrInvalidChatSettings, ChatSettingsMinComboStreak, ChatSettingsMaxComboStreak)
+	}
+
+	categories := make([]string, 0, len(c.AllowedCategories))
+	for _, raw := range c.AllowedCategories {
+		category := strings.ToLower(strings.TrimSpace(raw))
+		if category == "" || slices.Contains(categories, category) {
+			continue
+		}
+		if !slices.Contains(knownCategories, category) {
+			return c, fmt.Errorf("%w: unknown category %q", ErrInvalidChatSettings, raw)
+		}
+		categories = append(categories, category)
+	}
+	slices.Sort(categories)
+	c.AllowedCategories = nil
+	if len(categories) > 0 {
+		c.AllowedCategories = categories
+	}
+	return c, nil
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestChatSettings_Validate(t *testing.T) {
+	known := []string{"food", "movie", "place"}
+
+	got, err := ChatSettings{MaxQuestions: 30, AllowedCategories: []string{" Place ", "food", "place", ""}}.Validate(known)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got.AllowedCategories, []string{"food", "place"}) {
+		t.Fatalf("categories must be normalized, deduplicated and sorted, got %v", got.AllowedCategories)
+	}
+
+	got, err = ChatSettings{AllowedCategories: []string{" "}}.Validate(known)
+	if err != nil || got.AllowedCategories != nil || !got.IsDefault() {
+		t.Fatalf("blank categories must collapse to default, got %+v, %v", got, err)
+	}
+
+	invalid := []ChatSettings{
+		{MaxQuestions: ChatSettingsMinQuestions - 1},
+		{MaxQuestions: ChatSettingsMaxQuestionsLimit + 1},
+		{MaxHints: -1},
+		{MaxHints: ChatSettingsMaxHintsLimit + 1},
+		{SurrenderVotes: ChatSettingsSurrenderVotesLimit + 1},
+		{ComboStreak: ChatSettingsMinComboStreak - 1},
+		{ComboStreak: ChatSettingsMaxComboStreak + 1},
+		{AllowedCategories: []string{"spaceship"}},
+	}
+	for _, settings := range invalid {
+		if _, err := settings.Validate(known); !errors.Is(err, ErrInvalidChatSettings) {
+			t.Errorf("expected ErrInvalidChatSettings for %+v, got %v", settings, err)
+		}
+	}
+}
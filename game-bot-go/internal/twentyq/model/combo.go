@@ -0,0 +1,38 @@
+package model
+
+// ComboState: 게임 중 연속 '예' 답변 콤보 진행 상태
+type ComboState struct {
+	// Current: 현재 이어지고 있는 연속 '예' 수 (무료 힌트를 받으면 0부터 다시 셉니다)
+	Current int `json:"current"`
+	// Max: 이번 게임에서 가장 길게 이어진 연속 '예' 수
+	Max int `json:"max"`
+	// Bonus: 콤보로 얻은 무료 힌트 수
+	Bonus int `json:"bonus"`
+	// Run: 무료 힌트 지급과 관계없이 이어지고 있는 연속 수 (Max 계산용)
+	Run int `json:"run"`
+}
+
+// Advance: 답변 하나를 반영합니다. 연속 수가 streak에 닿으면 무료 힌트를 하나 더하고 true를 반환합니다.
+// '예'가 아닌 답변은 콤보를 끊습니다.
+func (c *ComboState) Advance(yes bool, streak int) bool {
+	if !yes {
+		c.Current = 0
+		c.Run = 0
+		return false
+	}
+
+	c.Current++
+	c.Run++
+	c.Max = max(c.Max, c.Run)
+	if streak > 0 && c.Current >= streak {
+		c.Current = 0
+		c.Bonus++
+		return true
+	}
+	return false
+}
+
+// IsComboAnswer: 콤보를 이어가는 답변인지 확인합니다. 확답('예')만 인정하고 '아마도 예'는 콤보를 끊습니다.
+func IsComboAnswer(scale FiveScaleKo) bool {
+	return scale == FiveScaleAlwaysYes
+}
@@ -0,0 +1,41 @@
+package model
+
+import "testing"
+
+func TestComboState_Advance(t *testing.T) {
+	var combo ComboState
+
+	unlocked := 0
+	for range 2 {
+		if combo.Advance(true, 3) {
+			unlocked++
+		}
+	}
+	if combo.Advance(false, 3) || combo.Current != 0 || combo.Max != 2 {
+		t.Fatalf("non-yes answer must break the combo, got %+v", combo)
+	}
+
+	for range 4 {
+		if combo.Advance(true, 3) {
+			unlocked++
+		}
+	}
+	if unlocked != 1 || combo.Bonus != 1 {
+		t.Fatalf("expected one free hint, got unlocked=%d %+v", unlocked, combo)
+	}
+	// 무료 힌트를 받아도 최대 연속 수는 끊기지 않고 이어서 센다
+	if combo.Current != 1 || combo.Max != 4 {
+		t.Fatalf("unexpected combo after unlock: %+v", combo)
+	}
+}
+
+func TestIsComboAnswer(t *testing.T) {
+	if !IsComboAnswer(FiveScaleAlwaysYes) {
+		t.Fatal("'예' must continue the combo")
+	}
+	for _, scale := range []FiveScaleKo{FiveScaleMostlyYes, FiveScaleMostlyNo, FiveScaleAlwaysNo} {
+		if IsComboAnswer(scale) {
+			t.Errorf("%v must break the combo", scale)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package model
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+)
+
+// HotseatAction: 턴제 모드 명령의 세부 동작
+type HotseatAction int
+
+// HotseatShow: 턴제 모드 하위 명령 목록
+const (
+	HotseatShow HotseatAction = iota
+	HotseatOn
+	HotseatOff
+	HotseatJoin
+	HotseatLeave
+	HotseatShuffle
+	HotseatKick
+)
+
+// HotseatTurnOutcome: 차례가 넘어간 이유
+type HotseatTurnOutcome int
+
+// HotseatTurnPlayed: 질문/정답 시도로 차례를 마친 경우
+const (
+	HotseatTurnPlayed HotseatTurnOutcome = iota
+	HotseatTurnPassed
+	HotseatTurnTimedOut
+)
+
+// Hotseat: 소규모 방을 위한 턴제 진행 상태 (등록 순서대로 한 명씩 질문)
+type Hotseat struct {
+	Order         []PlayerInfo            `json:"order"`
+	Current       int                     `json:"current"`
+	TurnStartedAt time.Time               `json:"turnStartedAt"`
+	StartedBy     string                  `json:"startedBy"`
+	Stats         map[string]HotseatStats `json:"stats,omitempty"`
+}
+
+// HotseatStats: 참가자별 차례 소요 시간 통계
+type HotseatStats struct {
+	Turns      int   `json:"turns"`
+	TurnMillis int64 `json:"turnMillis"`
+	Timeouts   int   `json:"timeouts"`
+	Passes     int   `json:"passes"`
+}
+
+// AverageTurn: 차례당 평균 소요 시간 (기록이 없으면 0)
+func (s HotseatStats) AverageTurn() time.Duration {
+	if s.Turns == 0 {
+		return 0
+	}
+	return time.Duration(s.TurnMillis/int64(s.Turns)) * time.Millisecond
+}
+
+// CurrentPlayer: 현재 차례인 참가자를 반환합니다. (등록자가 없으면 false)
+func (h Hotseat) CurrentPlayer() (PlayerInfo, bool) {
+	if len(h.Order) == 0 {
+		return PlayerInfo{}, false
+	}
+	return h.Order[h.Current%len(h.Order)], true
+}
+
+// IsJoined: 참가 순서에 등록된 사용자인지 확인합니다.
+func (h Hotseat) IsJoined(userID string) bool {
+	return h.indexOf(userID) >= 0
+}
+
+// Join: 순서 맨 뒤에 참가자를 추가합니다. 이미 등록돼 있으면 false를 반환합니다.
+// 첫 참가자라면 그 시점부터 차례 시간을 잽니다.
+func (h *Hotseat) Join(player PlayerInfo, now time.Time) bool {
+	if h.IsJoined(player.UserID) {
+		return false
+	}
+	h.Order = append(h.Order, player)
+	if len(h.Order) == 1 {
+		h.Current = 0
+		h.TurnStartedAt = now
+	}
+	return true
+}
+
+// Leave: 참가자를 순서에서 제거합니다. 현재 차례였다면 다음 사람에게 차례가 넘어갑니다.
+func (h *Hotseat) Leave(userID string, now time.Time) bool {
+	idx := h.indexOf(userID)
+	if idx < 0 {
+		return false
+	}
+
+	wasCurrent := idx == h.Current
+	h.Order = slices.Delete(h.Order, idx, idx+1)
+	switch {
+	case len(h.Order) == 0:
+		h.Current = 0
+	case idx < h.Current:
+		h.Current--
+	case wasCurrent:
+		h.Current %= len(h.Order)
+		h.TurnStartedAt = now
+	}
+	return true
+}
+
+// FindByUserID: 사용자 ID로 참가자를 찾습니다.
+func (h Hotseat) FindByUserID(userID string) (PlayerInfo, bool) {
+	idx := h.indexOf(userID)
+	if idx < 0 {
+		return PlayerInfo{}, false
+	}
+	return h.Order[idx], true
+}
+
+// FindByNickname: 닉네임으로 참가자를 찾습니다. (대소문자/앞뒤 공백 무시)
+func (h Hotseat) FindByNickname(nickname string) (PlayerInfo, bool) {
+	nickname = strings.TrimSpace(nickname)
+	for _, p := range h.Order {
+		if strings.EqualFold(strings.TrimSpace(p.Sender), nickname) {
+			return p, true
+		}
+	}
+	return PlayerInfo{}, false
+}
+
+// Shuffle: 참가 순서를 무작위로 섞고 첫 번째 참가자부터 다시 시작합니다.
+func (h *Hotseat) Shuffle(now time.Time) {
+	rand.Shuffle(len(h.Order), func(i, j int) {
+		h.Order[i], h.Order[j] = h.Order[j], h.Order[i]
+	})
+	h.Current = 0
+	h.TurnStartedAt = now
+}
+
+// Advance: 현재 차례를 마감하고 다음 참가자에게 넘깁니다. 소요 시간은 현재 참가자 통계에 누적됩니다.
+func (h *Hotseat) Advance(now time.Time, outcome HotseatTurnOutcome) {
+	player, ok := h.CurrentPlayer()
+	if !ok {
+		return
+	}
+
+	if h.Stats == nil {
+		h.Stats = make(map[string]HotseatStats)
+	}
+	stats := h.Stats[player.UserID]
+	stats.Turns++
+	if !h.TurnStartedAt.IsZero() && now.After(h.TurnStartedAt) {
+		stats.TurnMillis += now.Sub(h.TurnStartedAt).Milliseconds()
+	}
+	switch outcome {
+	case HotseatTurnPassed:
+		stats.Passes++
+	case HotseatTurnTimedOut:
+		stats.Timeouts++
+	default:
+	}
+	h.Stats[player.UserID] = stats
+
+	h.Current = (h.Current + 1) % len(h.Order)
+	h.TurnStartedAt = now
+}
+
+// TurnExpired: 현재 차례가 제한 시간을 넘겼는지 여부
+// 혼자 참가 중이면 넘길 사람이 없으므로 만료로 보지 않습니다.
+func (h Hotseat) TurnExpired(now time.Time, timeout time.Duration) bool {
+	if len(h.Order) < 2 || h.TurnStartedAt.IsZero() {
+		return false
+	}
+	return now.Sub(h.TurnStartedAt) >= timeout
+}
+
+func (h Hotseat) indexOf(userID string) int {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return -1
+	}
+	return slices.IndexFunc(h.Order, func(p PlayerInfo) bool { return p.UserID == userID })
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHotseat_AdvanceRotatesAndRecordsTiming(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var h Hotseat
+	h.Join(PlayerInfo{UserID: "u1", Sender: "Alice"}, start)
+	if h.Join(PlayerInfo{UserID: "u1", Sender: "Alice"}, start) {
+		t.Fatal("duplicate join must be rejected")
+	}
+	h.Join(PlayerInfo{UserID: "u2", Sender: "Bob"}, start.Add(time.Second))
+
+	h.Advance(start.Add(30*time.Second), HotseatTurnPlayed)
+	if p, _ := h.CurrentPlayer(); p.UserID != "u2" {
+		t.Fatalf("expected u2's turn, got %+v", p)
+	}
+	h.Advance(start.Add(40*time.Second), HotseatTurnPassed)
+	h.Advance(start.Add(100*time.Second), HotseatTurnTimedOut)
+	if p, _ := h.CurrentPlayer(); p.UserID != "u2" {
+		t.Fatalf("expected rotation back to u2, got %+v", p)
+	}
+
+	alice := h.Stats["u1"]
+	if alice.Turns != 2 || alice.TurnMillis != 90_000 || alice.Timeouts != 1 {
+		t.Fatalf("unexpected alice stats: %+v", alice)
+	}
+	if alice.AverageTurn() != 45*time.Second {
+		t.Fatalf("unexpected average: %s", alice.AverageTurn())
+	}
+	if bob := h.Stats["u2"]; bob.Turns != 1 || bob.Passes != 1 || bob.TurnMillis != 10_000 {
+		t.Fatalf("unexpected bob stats: %+v", bob)
+	}
+}
+
+func TestHotseat_LeaveKeepsCurrentTurn(t *testing.T) {
+	now := time.Now()
+	var h Hotseat
+	for _, id := range []string{"u1", "u2", "u3"} {
+		h.Join(PlayerInfo{UserID: id, Sender: id}, now)
+	}
+	h.Advance(now, HotseatTurnPlayed) // u2 차례
+
+	h.Leave("u1", now)
+	if p, _ := h.CurrentPlayer(); p.UserID != "u2" {
+		t.Fatalf("leaving earlier player must keep u2's turn, got %+v", p)
+	}
+
+	h.Leave("u2", now)
+	if p, _ := h.CurrentPlayer(); p.UserID != "u3" {
+		t.Fatalf("leaving current player must pass to u3, got %+v", p)
+	}
+	if h.Leave("missing", now) {
+		t.Fatal("leaving unknown player must return false")
+	}
+}
+
+func TestHotseat_TurnExpiredNeedsTwoPlayers(t *testing.T) {
+	start := time.Now()
+	var h Hotseat
+	h.Join(PlayerInfo{UserID: "u1"}, start)
+	if h.TurnExpired(start.Add(time.Hour), time.Minute) {
+		t.Fatal("solo player turn must never expire")
+	}
+	h.Join(PlayerInfo{UserID: "u2"}, start)
+	if !h.TurnExpired(start.Add(time.Minute), time.Minute) {
+		t.Fatal("expected expired turn")
+	}
+}
@@ -0,0 +1,23 @@
+package model
+
+// LLMTaskUsage: 게임 한 판에서 LLM 작업(RPC)별로 사용한 토큰과 예상 비용
+type LLMTaskUsage struct {
+	Task            string  `json:"task"`
+	Model           string  `json:"model,omitempty"`
+	InputTokens     int64   `json:"inputTokens"`
+	OutputTokens    int64   `json:"outputTokens"`
+	ReasoningTokens int64   `json:"reasoningTokens"`
+	Calls           int64   `json:"calls"`
+	CostUSD         float64 `json:"costUsd"`
+}
+
+// LLMUsageSummary: 게임 한 판의 LLM 사용량 요약 (game_sessions.llm_usage_json에 저장)
+type LLMUsageSummary struct {
+	InputTokens     int64          `json:"inputTokens"`
+	OutputTokens    int64          `json:"outputTokens"`
+	ReasoningTokens int64          `json:"reasoningTokens"`
+	TotalTokens     int64          `json:"totalTokens"`
+	Calls           int64          `json:"calls"`
+	CostUSD         float64        `json:"costUsd"`
+	Tasks           []LLMTaskUsage `json:"tasks"`
+}
@@ -12,6 +12,10 @@ type RiddleSecret struct {
 	Category    string `json:"category"`
 	Intro       string `json:"intro"`
 	Description string `json:"description,omitempty"`
+	// StartedBy: 게임을 시작한 사용자 ID (턴제 모드 전환 권한 확인용)
+	StartedBy string `json:"startedBy,omitempty"`
+	// SessionID: 게임 기록과 힌트 평가를 잇는 게임별 식별자 (이전 버전에서 만든 세션은 비어 있음)
+	SessionID string `json:"sessionId,omitempty"`
 }
 
 // QuestionHistory: 사용자의 질문과 그에 대한 AI의 답변 기록
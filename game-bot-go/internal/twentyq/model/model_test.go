@@ -81,6 +81,23 @@ func TestSurrenderVote_RequiredApprovals(t *testing.T) {
 			t.Errorf("players=%d RequiredApprovals() = %d, want %d", tt.players, got, tt.want)
 		}
 	}
+
+	// 방 설정 득표 수는 투표 가능 인원을 넘지 않음
+	overrides := []struct {
+		players  int
+		required int
+		want     int
+	}{
+		{5, 2, 2},
+		{2, 5, 2},
+		{0, 3, 1},
+	}
+	for _, tt := range overrides {
+		v := SurrenderVote{EligiblePlayers: make([]string, tt.players), RequiredVotes: tt.required}
+		if got := v.RequiredApprovals(); got != tt.want {
+			t.Errorf("players=%d requiredVotes=%d RequiredApprovals() = %d, want %d", tt.players, tt.required, got, tt.want)
+		}
+	}
 }
 
 func TestSurrenderVote_Approve(t *testing.T) {
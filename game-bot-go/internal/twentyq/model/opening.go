@@ -0,0 +1,9 @@
+package model
+
+// OpeningSuggestion: 지난 게임 기록에서 정답으로 이어진 비율이 높았던 첫 질문
+type OpeningSuggestion struct {
+	Question string  `json:"question"`
+	Games    int     `json:"games"`
+	Wins     int     `json:"wins"`
+	WinRate  float64 `json:"winRate"`
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ThemeEvent: 기간 한정으로 카테고리 출제 가중치를 덮어쓰는 테마 이벤트 (예: 호러 주간)
+type ThemeEvent struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Announcement: 이벤트 기간 중 방마다 첫 게임 시작 시 안내할 문구 (비어 있으면 기본 문구)
+	Announcement string    `json:"announcement,omitempty"`
+	StartsAt     time.Time `json:"startsAt"`
+	EndsAt       time.Time `json:"endsAt"`
+	// CategoryWeights: 카테고리 키별 상대 가중치 (0 이하 항목은 출제 대상에서 제외)
+	CategoryWeights map[string]float64 `json:"categoryWeights"`
+	CreatedAt       time.Time          `json:"createdAt"`
+}
+
+// IsActive: 주어진 시각이 이벤트 기간 [StartsAt, EndsAt) 안에 있는지 확인합니다.
+func (e ThemeEvent) IsActive(now time.Time) bool {
+	return !now.Before(e.StartsAt) && now.Before(e.EndsAt)
+}
+
+// Validate: 이벤트 정의가 유효한지 검사합니다.
+func (e ThemeEvent) Validate(allCategories []string) error {
+	if strings.TrimSpace(e.Name) == "" {
+		return errors.New("name is required")
+	}
+	if e.StartsAt.IsZero() || e.EndsAt.IsZero() {
+		return errors.New("startsAt and endsAt are required")
+	}
+	if !e.EndsAt.After(e.StartsAt) {
+		return errors.New("endsAt must be after startsAt")
+	}
+
+	positive := 0
+	for category, weight := range e.CategoryWeights {
+		if !slices.Contains(allCategories, category) {
+			return errors.New("unknown category: " + category)
+		}
+		if weight < 0 {
+			return errors.New("negative weight: " + category)
+		}
+		if weight > 0 {
+			positive++
+		}
+	}
+	if positive == 0 {
+		return errors.New("at least one positive category weight is required")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThemeEvent_Validate(t *testing.T) {
+	categories := []string{"organism", "place", "movie"}
+	start := time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC)
+	valid := ThemeEvent{
+		Name:            "호러 주간",
+		StartsAt:        start,
+		EndsAt:          start.Add(7 * 24 * time.Hour),
+		CategoryWeights: map[string]float64{"movie": 3, "place": 1, "organism": 0},
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(e *ThemeEvent)
+		wantErr bool
+	}{
+		{"valid", func(*ThemeEvent) {}, false},
+		{"missing name", func(e *ThemeEvent) { e.Name = " " }, true},
+		{"reversed range", func(e *ThemeEvent) { e.EndsAt = e.StartsAt }, true},
+		{"unknown category", func(e *ThemeEvent) { e.CategoryWeights = map[string]float64{"horror": 1} }, true},
+		{"negative weight", func(e *ThemeEvent) { e.CategoryWeights = map[string]float64{"movie": -1, "place": 1} }, true},
+		{"all zero", func(e *ThemeEvent) { e.CategoryWeights = map[string]float64{"movie": 0} }, true},
+	}
+
+	for _, tt := range tests {
+		event := valid
+		tt.mutate(&event)
+		if err := event.Validate(categories); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestThemeEvent_IsActive(t *testing.T) {
+	start := time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC)
+	event := ThemeEvent{StartsAt: start, EndsAt: start.Add(time.Hour)}
+
+	if event.IsActive(start.Add(-time.Second)) {
+		t.Error("should not be active before start")
+	}
+	if !event.IsActive(start) {
+		t.Error("should be active at start")
+	}
+	if event.IsActive(start.Add(time.Hour)) {
+		t.Error("should not be active at end (exclusive)")
+	}
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Tournament: 한 채팅방에서 N개 라운드를 연속 진행하며 점수를 누적하는 토너먼트 상태
+type Tournament struct {
+	TotalRounds     int `json:"totalRounds"`
+	CompletedRounds int `json:"completedRounds"`
+	// Categories: 라운드 시작 시 카테고리를 생략하면 사용할 기본 카테고리
+	Categories []string          `json:"categories,omitempty"`
+	Scores     []TournamentScore `json:"scores"`
+	StartedBy  string            `json:"startedBy"`
+	StartedAt  time.Time         `json:"startedAt"`
+}
+
+// TournamentScore: 토너먼트 참가자별 누적 점수
+type TournamentScore struct {
+	UserID string `json:"userId"`
+	Sender string `json:"sender"`
+	Points int    `json:"points"`
+	Wins   int    `json:"wins"`
+}
+
+// CurrentRound: 진행 중(또는 다음에 시작할) 라운드 번호
+func (t Tournament) CurrentRound() int {
+	return min(t.CompletedRounds+1, t.TotalRounds)
+}
+
+// Finished: 모든 라운드가 끝났는지 여부
+func (t Tournament) Finished() bool {
+	return t.CompletedRounds >= t.TotalRounds
+}
+
+// RecordRound: 라운드 종료를 반영합니다. winnerID가 비어 있으면 (포기 등) 점수 없이 라운드만 넘어갑니다.
+func (t *Tournament) RecordRound(winnerID string, sender string, points int) {
+	t.CompletedRounds++
+	if winnerID == "" {
+		return
+	}
+
+	for i := range t.Scores {
+		if t.Scores[i].UserID == winnerID {
+			t.Scores[i].Points += points
+			t.Scores[i].Wins++
+			if sender != "" {
+				t.Scores[i].Sender = sender
+			}
+			return
+		}
+	}
+	t.Scores = append(t.Scores, TournamentScore{UserID: winnerID, Sender: sender, Points: points, Wins: 1})
+}
+
+// Standings: 점수 내림차순, 동점이면 정답 횟수 내림차순으로 정렬한 순위표
+// 그래도 같으면 먼저 점수를 얻은 참가자가 앞에 옵니다.
+func (t Tournament) Standings() []TournamentScore {
+	out := slices.Clone(t.Scores)
+	slices.SortStableFunc(out, func(a, b TournamentScore) int {
+		if c := cmp.Compare(b.Points, a.Points); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Wins, a.Wins)
+	})
+	return out
+}
@@ -0,0 +1,33 @@
+package model
+
+import "testing"
+
+func TestTournament_RecordRoundAndStandings(t *testing.T) {
+	tour := Tournament{TotalRounds: 3}
+
+	tour.RecordRound("u1", "Alice", 12)
+	tour.RecordRound("", "", 0) // 포기 라운드
+	if tour.Finished() || tour.CurrentRound() != 3 {
+		t.Fatalf("unexpected progress: completed=%d current=%d", tour.CompletedRounds, tour.CurrentRound())
+	}
+
+	tour.RecordRound("u2", "Bob", 12)
+	if !tour.Finished() || tour.CurrentRound() != 3 {
+		t.Fatalf("expected finished tournament, got completed=%d", tour.CompletedRounds)
+	}
+
+	// 동점이면 먼저 점수를 얻은 참가자가 앞
+	standings := tour.Standings()
+	if len(standings) != 2 || standings[0].UserID != "u1" || standings[1].UserID != "u2" {
+		t.Fatalf("unexpected standings: %+v", standings)
+	}
+
+	tour.RecordRound("u2", "Bobby", 5)
+	standings = tour.Standings()
+	if standings[0].UserID != "u2" || standings[0].Points != 17 || standings[0].Wins != 2 || standings[0].Sender != "Bobby" {
+		t.Fatalf("unexpected leader: %+v", standings[0])
+	}
+	if tour.Scores[0].UserID != "u1" {
+		t.Fatal("Standings must not reorder the stored scores")
+	}
+}
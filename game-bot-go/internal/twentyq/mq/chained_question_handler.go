@@ -21,6 +21,7 @@ type ChainedQuestionRiddleService interface {
 	AnswerWithOutcome(ctx context.Context, chatID string, userID string, sender *string, question string, isChain bool) (qsvc.AnswerOutcome, error)
 	StatusSeparated(ctx context.Context, chatID string) (string, string, error)
 	StatusSeparatedWithCount(ctx context.Context, chatID string) (string, string, int, error)
+	BudgetLine(ctx context.Context, chatID string) (string, error)
 }
 
 // ChainedQuestionQueueCoordinator: 체인 질문 처리에 필요한 큐 코디네이터 인터페이스
@@ -67,7 +68,7 @@ func (h *ChainedQuestionHandler) PrepareChainQueue(
 		return "", nil
 	}
 
-	displaySender := domainmodels.DisplayName(chatID, userID, sender, h.msgProvider.Get(qmessages.UserAnonymous))
+	displaySender := domainmodels.DisplayName(chatID, userID, sender, h.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
 
 	remainingQuestions := questions[1:]
 
@@ -93,7 +94,7 @@ func (h *ChainedQuestionHandler) PrepareChainQueue(
 	// 큐 안내 메시지 생성
 	var queueDetails strings.Builder
 	for i, question := range remainingQuestions {
-		item := h.msgProvider.Get(qmessages.ChainQueueItem,
+		item := h.msgProvider.For(ctx).Get(qmessages.ChainQueueItem,
 			messageprovider.P("index", i+1),
 			messageprovider.P("question", question))
 		queueDetails.WriteString(item)
@@ -102,7 +103,7 @@ func (h *ChainedQuestionHandler) PrepareChainQueue(
 		}
 	}
 
-	return h.msgProvider.Get(qmessages.LockMessageQueued,
+	return h.msgProvider.For(ctx).Get(qmessages.LockMessageQueued,
 		messageprovider.P("user", displaySender),
 		messageprovider.P("queueDetails", queueDetails.String())), nil
 }
@@ -118,7 +119,7 @@ func (h *ChainedQuestionHandler) Handle(
 	condition qmodel.ChainCondition,
 ) (string, error) {
 	if len(questions) == 0 {
-		return h.msgProvider.Get(qmessages.ErrorInvalidQuestion), nil
+		return h.msgProvider.For(ctx).Get(qmessages.ErrorInvalidQuestion), nil
 	}
 
 	firstQuestion := questions[0]
@@ -145,7 +146,7 @@ func (h *ChainedQuestionHandler) Handle(
 	// 스킵 알림 메시지 포함
 	if !shouldContinue && hasRemainingQuestions {
 		skippedQuestions := questions[1:]
-		skipNotification := h.msgProvider.Get(qmessages.ChainConditionNotMet,
+		skipNotification := h.msgProvider.For(ctx).Get(qmessages.ChainConditionNotMet,
 			messageprovider.P("questions", strings.Join(skippedQuestions, ", ")))
 		return outcome.Message + "\n\n" + skipNotification, nil
 	}
@@ -172,7 +173,7 @@ func (h *ChainedQuestionHandler) ProcessChainBatch(
 	}
 	if skipped {
 		h.logger.Debug("chain_batch_skipped", "chatID", chatID, "userID", pending.UserID)
-		skipNotification := h.msgProvider.Get(
+		skipNotification := h.msgProvider.For(ctx).Get(
 			qmessages.ChainConditionNotMet,
 			messageprovider.P("questions", strings.Join(pending.BatchQuestions, ", ")),
 		)
@@ -190,9 +191,11 @@ func (h *ChainedQuestionHandler) ProcessChainBatch(
 	main, hint, questionCount, err := h.riddleService.StatusSeparatedWithCount(ctx, chatID)
 	if err != nil {
 		h.logger.Warn("chain_status_failed", "chatID", chatID, "err", err)
-		main = h.msgProvider.Get(qmessages.ErrorNoSessionShort)
+		main = h.msgProvider.For(ctx).Get(qmessages.ErrorNoSessionShort)
 		hint = ""
 		questionCount = 0
+	} else {
+		main = appendBudgetLine(ctx, h.riddleService, h.logger, chatID, main)
 	}
 
 	messages := []string{main}
@@ -22,6 +22,7 @@ type fakeChainedQuestionRiddleService struct {
 	statusMain    string
 	statusHint    string
 	questionCount int
+	budgetLine    string
 }
 
 func (f *fakeChainedQuestionRiddleService) AnswerWithOutcome(
@@ -49,6 +50,10 @@ func (f *fakeChainedQuestionRiddleService) StatusSeparatedWithCount(ctx context.
 	return f.statusMain, f.statusHint, f.questionCount, nil
 }
 
+func (f *fakeChainedQuestionRiddleService) BudgetLine(ctx context.Context, chatID string) (string, error) {
+	return f.budgetLine, nil
+}
+
 type fakeChainedQuestionQueueCoordinator struct {
 	enqueued  []qmodel.PendingMessage
 	skipFlags map[string]bool
@@ -201,6 +206,40 @@ func TestChainedQuestionHandler_ProcessChainBatch_UsesIsChainFlag(t *testing.T)
 	}
 }
 
+func TestChainedQuestionHandler_ProcessChainBatch_AppendsBudgetLine(t *testing.T) {
+	msgProvider, err := messageprovider.NewFromYAML(
+		"error:\n  session_not_found: \"NOSESSION\"\n",
+	)
+	if err != nil {
+		t.Fatalf("message provider init failed: %v", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	riddleService := &fakeChainedQuestionRiddleService{
+		answerScale: qmodel.FiveScaleAlwaysYes,
+		statusMain:  "STATUS",
+		budgetLine:  "질문 3/20 · 힌트 0/1",
+	}
+	handler := NewChainedQuestionHandler(riddleService, newFakeChainedQuestionQueueCoordinator(), msgProvider, logger)
+
+	var emitted []mqmsg.OutboundMessage
+	err = handler.ProcessChainBatch(
+		context.Background(),
+		"chat1",
+		qmodel.PendingMessage{UserID: "user1", IsChainBatch: true, BatchQuestions: []string{"Q1"}},
+		func(out mqmsg.OutboundMessage) error {
+			emitted = append(emitted, out)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("process chain batch failed: %v", err)
+	}
+	if len(emitted) != 1 || emitted[0].Text != "STATUS\n질문 3/20 · 힌트 0/1" {
+		t.Fatalf("unexpected emitted messages: %+v", emitted)
+	}
+}
+
 func TestChainedQuestionHandler_ProcessChainBatch_StatusWithHintLine_EmitsSeparatedMessages(t *testing.T) {
 	msgProvider, err := messageprovider.NewFromYAML(
 		"error:\n  session_not_found: \"NOSESSION\"\n",
@@ -29,6 +29,37 @@ const (
 	CommandUserStats
 	CommandRoomStats
 
+	// CommandBudget: 방별 예산 줄(질문/힌트 사용량) 표시 설정 명령
+	CommandBudget
+	// CommandOpening: 방별 추천 첫 질문 표시 설정 명령
+	CommandOpening
+	// CommandSettings: 방별 게임 규칙(최대 질문/힌트, 카테고리, 포기 투표) 조회/변경 명령
+	CommandSettings
+	// CommandLocale: 방별 메시지 언어 조회/변경 명령
+	CommandLocale
+	// CommandSummary: 중간 참가자용 진행 상황 요약 명령
+	CommandSummary
+	// CommandHintFeedback: 마지막 힌트 유용/무용 평가 명령
+	CommandHintFeedback
+
+	// 토너먼트
+
+	// CommandTournamentStart: N 라운드 토너먼트 시작 명령
+	CommandTournamentStart
+	CommandTournamentRank
+	CommandTournamentEnd
+
+	// 시즌
+
+	// CommandSeason: 방 시즌 순위 조회 명령
+	CommandSeason
+
+	// 턴제
+
+	// CommandHotseat: 턴제 모드 전환 및 순서 관리 명령
+	CommandHotseat
+	CommandPass
+
 	// 관리자 명령어
 
 	// CommandAdminForceEnd: 관리자 강제 종료 명령
@@ -37,6 +68,46 @@ const (
 	CommandAdminUsage
 )
 
+// commandNames: 지연 집계 등 외부에 노출하는 명령어 이름
+var commandNames = map[CommandKind]string{
+	CommandStart:           "start",
+	CommandHints:           "hints",
+	CommandAsk:             "ask",
+	CommandChainedQuestion: "chained_question",
+	CommandSurrender:       "surrender",
+	CommandAgree:           "agree",
+	CommandReject:          "reject",
+	CommandStatus:          "status",
+	CommandModelInfo:       "model_info",
+	CommandHelp:            "help",
+	CommandUnknown:         "unknown",
+	CommandUserStats:       "user_stats",
+	CommandRoomStats:       "room_stats",
+	CommandBudget:          "budget",
+	CommandOpening:         "opening",
+	CommandSettings:        "settings",
+	CommandLocale:          "locale",
+	CommandSummary:         "summary",
+	CommandHintFeedback:    "hint_feedback",
+	CommandTournamentStart: "tournament_start",
+	CommandTournamentRank:  "tournament_rank",
+	CommandTournamentEnd:   "tournament_end",
+	CommandSeason:          "season",
+	CommandHotseat:         "hotseat",
+	CommandPass:            "pass",
+	CommandAdminForceEnd:   "admin_force_end",
+	CommandAdminClearAll:   "admin_clear_all",
+	CommandAdminUsage:      "admin_usage",
+}
+
+// Name: 명령어 종류의 이름을 반환합니다.
+func (k CommandKind) Name() string {
+	if name, ok := commandNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 // Command: 사용자 입력에서 파싱된 게임 명령어 정보를 담는 구조체
 type Command struct {
 	Kind       CommandKind
@@ -52,18 +123,35 @@ type Command struct {
 	// 사용량 조회용
 	UsagePeriod   qmodel.UsagePeriod
 	ModelOverride *string
+	// 예산 줄 설정용
+	BudgetHidden bool
+	// 추천 첫 질문 설정용
+	OpeningEnabled bool
+	// 방별 게임 설정용
+	SettingsAction qmodel.ChatSettingsAction
+	SettingsValue  string
+	// 방별 언어 설정용 (빈 값이면 현재 언어 조회)
+	LocaleValue string
+	// 토너먼트용
+	TournamentRounds int
+	// 힌트 평가용
+	HintUseful bool
+	// 턴제용 (제외 대상 닉네임은 TargetNickname 사용)
+	HotseatAction qmodel.HotseatAction
 }
 
 // WaitingMessageKey: 명령어를 처리하는 동안 사용자에게 즉시 보여줄 '대기 중' 메시지의 키를 반환합니다.
 // 반환값이 nil이면 별도의 대기 메시지를 보내지 않습니다.
 func (c Command) WaitingMessageKey() *string {
 	switch c.Kind {
-	case CommandStart:
+	case CommandStart, CommandTournamentStart:
 		return ptr.String(qmessages.StartWaiting)
 	case CommandHints:
 		return ptr.String(qmessages.HintWaiting)
 	case CommandAsk:
 		return ptr.String(qmessages.ProcessingWaiting)
+	case CommandSummary:
+		return ptr.String(qmessages.SummaryWaiting)
 	default:
 		return nil
 	}
@@ -73,8 +161,10 @@ func (c Command) WaitingMessageKey() *string {
 // 단순 조회나 도움말 등은 락이 필요 없습니다.
 func (c Command) RequiresLock() bool {
 	switch c.Kind {
-	case CommandHelp, CommandUnknown, CommandStatus, CommandModelInfo, CommandUserStats, CommandRoomStats, CommandAdminUsage:
+	case CommandHelp, CommandUnknown, CommandStatus, CommandModelInfo, CommandUserStats, CommandRoomStats, CommandBudget, CommandOpening, CommandSettings, CommandLocale, CommandSummary, CommandHintFeedback, CommandTournamentRank, CommandSeason, CommandAdminUsage:
 		return false
+	case CommandHotseat:
+		return c.HotseatAction != qmodel.HotseatShow
 	default:
 		return true
 	}
@@ -16,10 +16,12 @@ type CommandParser struct {
 	helpRe             *regexp.Regexp
 	startRe            *regexp.Regexp
 	hintRe             *regexp.Regexp
+	hintFeedbackRe     *regexp.Regexp
 	surrenderRe        *regexp.Regexp
 	agreeRe            *regexp.Regexp
 	rejectRe           *regexp.Regexp
 	statusRe           *regexp.Regexp
+	summaryRe          *regexp.Regexp
 	modelInfoRe        *regexp.Regexp
 	chainConditionalRe *regexp.Regexp
 	chainRegularRe     *regexp.Regexp
@@ -29,6 +31,16 @@ type CommandParser struct {
 	roomStatsRe        *regexp.Regexp
 	userStatsRe        *regexp.Regexp
 	usageRe            *regexp.Regexp
+	budgetRe           *regexp.Regexp
+	openingRe          *regexp.Regexp
+	settingsRe         *regexp.Regexp
+	localeRe           *regexp.Regexp
+	tournamentStartRe  *regexp.Regexp
+	tournamentCancelRe *regexp.Regexp
+	tournamentRe       *regexp.Regexp
+	seasonRe           *regexp.Regexp
+	hotseatRe          *regexp.Regexp
+	passRe             *regexp.Regexp
 }
 
 // NewCommandParser: 주어진 접두사(prefix)를 기반으로 정규식 패턴들을 초기화하여 새로운 CommandParser를 생성합니다.
@@ -39,10 +51,12 @@ func NewCommandParser(prefix string) *CommandParser {
 	p.helpRe = p.BuildPattern(`\s*$`)
 	p.startRe = p.BuildPattern(`\s*(?:start|시작)(?:\s+(.+))?$`)
 	p.hintRe = p.BuildPattern(`\s*(?:hint|힌트|ㅎㅌ)(?:\s+(\d+))?$`)
+	p.hintFeedbackRe = p.BuildPatternCaseInsensitive(`\s*(?:hint|힌트|ㅎㅌ)\s+(좋아요?|유용|useful|good|👍|별로|무용|useless|bad|👎)$`)
 	p.surrenderRe = p.BuildPattern(`\s*(?:surrender|하남자|포기)$`)
 	p.agreeRe = p.BuildPattern(`\s*(?:agree|동의)$`)
 	p.rejectRe = p.BuildPattern(`\s*(?:reject|거부)$`)
 	p.statusRe = p.BuildPattern(`\s*(?:status|상태|현황|상황|현재)$`)
+	p.summaryRe = p.BuildPatternCaseInsensitive(`\s*(?:요약|summary)$`)
 	p.modelInfoRe = p.BuildPattern(`\s*(?:모델|model)$`)
 	p.chainConditionalRe = p.BuildPatternCaseInsensitive(`\s+if\s+(.+,.+)$`)
 	p.chainRegularRe = p.BuildPattern(`\s+(.+,.+)$`)
@@ -55,6 +69,16 @@ func NewCommandParser(prefix string) *CommandParser {
 	p.adminClearAllRe = p.BuildPatternCaseInsensitive(`\s*(?:admin\s+clear-all|관리자\s+전체삭제)$`)
 	p.roomStatsRe = p.BuildPatternCaseInsensitive(`\s*전적\s+룸(?:\s+(일간|주간|월간))?$`)
 	p.userStatsRe = p.BuildPatternCaseInsensitive(`\s*전적(?:\s+(.+))?$`)
+	p.budgetRe = p.BuildPatternCaseInsensitive(`\s*(?:예산|budget)\s+(숨김|끄기|off|표시|켜기|on)$`)
+	p.openingRe = p.BuildPatternCaseInsensitive(`\s*(?:추천\s*질문|opening)\s+(켜기|on|끄기|off)$`)
+	p.settingsRe = p.BuildPatternCaseInsensitive(`\s*(?:설정|settings)(?:\s+(\S+)(?:\s+(.+))?)?$`)
+	p.localeRe = p.BuildPatternCaseInsensitive(`\s*(?:언어|language|lang)(?:\s+(\S+))?$`)
+	p.tournamentStartRe = p.BuildPatternCaseInsensitive(`\s*(?:토너먼트|tournament)\s+(\d+)(?:\s*(?:라운드|판|rounds?))?(?:\s+(.+))?$`)
+	p.tournamentCancelRe = p.BuildPatternCaseInsensitive(`\s*(?:토너먼트|tournament)\s+(?:종료|중단|cancel)$`)
+	p.tournamentRe = p.BuildPatternCaseInsensitive(`\s*(?:토너먼트|tournament)(?:\s+(?:순위|현황|standings))?$`)
+	p.seasonRe = p.BuildPatternCaseInsensitive(`\s*(?:시즌|season)(?:\s+(?:순위|현황|standings))?$`)
+	p.hotseatRe = p.BuildPatternCaseInsensitive(`\s*(?:턴제|hotseat)(?:\s+(\S+)(?:\s+(.+))?)?$`)
+	p.passRe = p.BuildPatternCaseInsensitive(`\s*(?:패스|pass)$`)
 
 	const usagePeriodKeywords = `오늘|주간|월간|today|weekly|monthly`
 	p.usageRe = p.BuildPatternCaseInsensitive(`\s*(?:사용량|usage)(?:\s+(` + usagePeriodKeywords + `))?(?:\s+(.+))?$`)
@@ -78,6 +102,9 @@ func (p *CommandParser) Parse(message string) *Command {
 	if cmd := p.parseStart(text); cmd != nil {
 		return cmd
 	}
+	if cmd := p.parseHintFeedback(text); cmd != nil {
+		return cmd
+	}
 	if cmd := p.parseHint(text); cmd != nil {
 		return cmd
 	}
@@ -87,6 +114,27 @@ func (p *CommandParser) Parse(message string) *Command {
 	if cmd := p.parseUserStats(text); cmd != nil {
 		return cmd
 	}
+	if cmd := p.parseBudget(text); cmd != nil {
+		return cmd
+	}
+	if cmd := p.parseOpening(text); cmd != nil {
+		return cmd
+	}
+	if cmd := p.parseSettings(text); cmd != nil {
+		return cmd
+	}
+	if cmd := p.parseLocale(text); cmd != nil {
+		return cmd
+	}
+	if cmd := p.parseTournament(text); cmd != nil {
+		return cmd
+	}
+	if parser.MatchSimple(p.seasonRe, text) {
+		return &Command{Kind: CommandSeason}
+	}
+	if cmd := p.parseHotseat(text); cmd != nil {
+		return cmd
+	}
 	if cmd := p.parseSurrender(text); cmd != nil {
 		return cmd
 	}
@@ -99,6 +147,9 @@ func (p *CommandParser) Parse(message string) *Command {
 	if cmd := p.parseStatus(text); cmd != nil {
 		return cmd
 	}
+	if cmd := p.parseSummary(text); cmd != nil {
+		return cmd
+	}
 	if cmd := p.parseModelInfo(text); cmd != nil {
 		return cmd
 	}
@@ -161,6 +212,20 @@ func (p *CommandParser) parseHint(text string) *Command {
 	return &Command{Kind: CommandHints, HintCount: count}
 }
 
+// parseHintFeedback: 마지막 힌트에 대한 유용/무용 평가 명령을 파싱합니다.
+func (p *CommandParser) parseHintFeedback(text string) *Command {
+	m := p.hintFeedbackRe.FindStringSubmatch(text)
+	if len(m) < 2 {
+		return nil
+	}
+	switch strings.ToLower(strings.TrimSpace(m[1])) {
+	case "별로", "무용", "useless", "bad", "👎":
+		return &Command{Kind: CommandHintFeedback, HintUseful: false}
+	default:
+		return &Command{Kind: CommandHintFeedback, HintUseful: true}
+	}
+}
+
 func (p *CommandParser) parseSurrender(text string) *Command {
 	if parser.MatchSimple(p.surrenderRe, text) {
 		return &Command{Kind: CommandSurrender}
@@ -189,6 +254,13 @@ func (p *CommandParser) parseStatus(text string) *Command {
 	return nil
 }
 
+func (p *CommandParser) parseSummary(text string) *Command {
+	if parser.MatchSimple(p.summaryRe, text) {
+		return &Command{Kind: CommandSummary}
+	}
+	return nil
+}
+
 func (p *CommandParser) parseModelInfo(text string) *Command {
 	if parser.MatchSimple(p.modelInfoRe, text) {
 		return &Command{Kind: CommandModelInfo}
@@ -277,6 +349,152 @@ func (p *CommandParser) parseUserStats(text string) *Command {
 	return nil
 }
 
+// parseBudget: 방별 예산 줄 숨김/표시 명령을 파싱합니다.
+func (p *CommandParser) parseBudget(text string) *Command {
+	m := p.budgetRe.FindStringSubmatch(text)
+	if len(m) < 2 {
+		return nil
+	}
+	switch strings.ToLower(strings.TrimSpace(m[1])) {
+	case "숨김", "끄기", "off":
+		return &Command{Kind: CommandBudget, BudgetHidden: true}
+	default:
+		return &Command{Kind: CommandBudget, BudgetHidden: false}
+	}
+}
+
+// parseOpening: 방별 추천 첫 질문 켜기/끄기 명령을 파싱합니다.
+func (p *CommandParser) parseOpening(text string) *Command {
+	m := p.openingRe.FindStringSubmatch(text)
+	if len(m) < 2 {
+		return nil
+	}
+	switch strings.ToLower(strings.TrimSpace(m[1])) {
+	case "끄기", "off":
+		return &Command{Kind: CommandOpening, OpeningEnabled: false}
+	default:
+		return &Command{Kind: CommandOpening, OpeningEnabled: true}
+	}
+}
+
+// parseSettings: 방별 게임 설정 조회/변경 명령을 파싱합니다. 값 검증은 서비스에서 안내 메시지와 함께 처리합니다.
+// 알 수 없는 항목은 nil을 반환해 일반 질문으로 처리되게 합니다.
+func (p *CommandParser) parseSettings(text string) *Command {
+	m := p.settingsRe.FindStringSubmatch(text)
+	if m == nil {
+		return nil
+	}
+
+	sub := strings.ToLower(strings.TrimSpace(m[1]))
+	value := ""
+	if len(m) >= 3 {
+		value = strings.TrimSpace(m[2])
+	}
+
+	var action qmodel.ChatSettingsAction
+	switch sub {
+	case "":
+		action = qmodel.ChatSettingsShow
+	case "질문", "questions":
+		action = qmodel.ChatSettingsMaxQuestions
+	case "힌트", "hints":
+		action = qmodel.ChatSettingsMaxHints
+	case "카테고리", "categories":
+		action = qmodel.ChatSettingsCategories
+	case "포기", "항복", "surrender":
+		action = qmodel.ChatSettingsSurrenderVotes
+	case "콤보", "combo":
+		action = qmodel.ChatSettingsCombo
+	case "초기화", "reset":
+		if value != "" {
+			return nil
+		}
+		return &Command{Kind: CommandSettings, SettingsAction: qmodel.ChatSettingsReset}
+	default:
+		return nil
+	}
+	if action != qmodel.ChatSettingsShow && value == "" {
+		return nil
+	}
+	return &Command{Kind: CommandSettings, SettingsAction: action, SettingsValue: value}
+}
+
+// parseLocale: 방별 메시지 언어 조회/변경 명령을 파싱합니다. 지원 언어 판단은 서비스에서 처리합니다.
+func (p *CommandParser) parseLocale(text string) *Command {
+	m := p.localeRe.FindStringSubmatch(text)
+	if m == nil {
+		return nil
+	}
+	return &Command{Kind: CommandLocale, LocaleValue: strings.TrimSpace(m[1])}
+}
+
+// parseTournament: 토너먼트 시작(라운드 수, 카테고리)/순위/중단 명령을 파싱합니다.
+// 라운드 수 범위 검증은 서비스에서 안내 메시지와 함께 처리합니다.
+func (p *CommandParser) parseTournament(text string) *Command {
+	if m := p.tournamentStartRe.FindStringSubmatch(text); m != nil {
+		rounds, err := strconv.Atoi(m[1])
+		if err != nil {
+			rounds = 0
+		}
+		var categories []string
+		if len(m) >= 3 {
+			categories = strings.Fields(m[2])
+		}
+		return &Command{Kind: CommandTournamentStart, TournamentRounds: rounds, Categories: categories}
+	}
+	if parser.MatchSimple(p.tournamentCancelRe, text) {
+		return &Command{Kind: CommandTournamentEnd}
+	}
+	if parser.MatchSimple(p.tournamentRe, text) {
+		return &Command{Kind: CommandTournamentRank}
+	}
+	return nil
+}
+
+// parseHotseat: 턴제 모드 하위 명령과 차례 넘기기(패스)를 파싱합니다.
+// 알 수 없는 하위 명령은 nil을 반환해 일반 질문으로 처리되게 합니다.
+func (p *CommandParser) parseHotseat(text string) *Command {
+	if parser.MatchSimple(p.passRe, text) {
+		return &Command{Kind: CommandPass}
+	}
+
+	m := p.hotseatRe.FindStringSubmatch(text)
+	if m == nil {
+		return nil
+	}
+
+	sub := strings.ToLower(strings.TrimSpace(m[1]))
+	arg := ""
+	if len(m) >= 3 {
+		arg = strings.TrimSpace(m[2])
+	}
+	if arg != "" && sub != "제외" && sub != "kick" {
+		return nil
+	}
+
+	switch sub {
+	case "", "순서", "order":
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatShow}
+	case "켜기", "on":
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatOn}
+	case "끄기", "off":
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatOff}
+	case "참가", "join":
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatJoin}
+	case "빠지기", "leave":
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatLeave}
+	case "섞기", "shuffle":
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatShuffle}
+	case "제외", "kick":
+		if arg == "" {
+			return nil
+		}
+		return &Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatKick, TargetNickname: &arg}
+	default:
+		return nil
+	}
+}
+
 // parseUsage: 토큰 사용량 조회 명령을 파싱합니다.
 func (p *CommandParser) parseUsage(text string) *Command {
 	m := p.usageRe.FindStringSubmatch(text)
@@ -1,6 +1,7 @@
 package mq
 
 import (
+	"strings"
 	"testing"
 
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
@@ -154,6 +155,38 @@ func TestCommandParser_ParseHints(t *testing.T) {
 	}
 }
 
+func TestCommandParser_ParseHintFeedback(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input      string
+		wantUseful bool
+	}{
+		{"/스자 힌트 좋아요", true},
+		{"/스자 ㅎㅌ 👍", true},
+		{"/스자 hint Useful", true},
+		{"/스자 힌트 별로", false},
+		{"/스자 힌트 👎", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != CommandHintFeedback {
+				t.Fatalf("expected hint feedback command, got %+v", cmd)
+			}
+			if cmd.HintUseful != tt.wantUseful {
+				t.Errorf("expected useful=%v, got %v", tt.wantUseful, cmd.HintUseful)
+			}
+		})
+	}
+
+	// 숫자 인자는 기존처럼 힌트 요청
+	if cmd := parser.Parse("/스자 힌트 2"); cmd == nil || cmd.Kind != CommandHints {
+		t.Fatalf("expected hints command, got %+v", cmd)
+	}
+}
+
 func TestCommandParser_ParseSurrender(t *testing.T) {
 	parser := NewCommandParser("/스자")
 
@@ -234,6 +267,8 @@ func TestCommandParser_ParseStatus(t *testing.T) {
 		{"/스자 현황", CommandStatus},
 		{"/스자 상황", CommandStatus},
 		{"/스자 현재", CommandStatus},
+		{"/스자 요약", CommandSummary},
+		{"/스자 summary", CommandSummary},
 	}
 
 	for _, tt := range tests {
@@ -249,6 +284,177 @@ func TestCommandParser_ParseStatus(t *testing.T) {
 	}
 }
 
+func TestCommandParser_ParseBudget(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input      string
+		wantHidden bool
+	}{
+		{"/스자 예산 숨김", true},
+		{"/스자 예산 끄기", true},
+		{"/스자 budget OFF", true},
+		{"/스자 예산 표시", false},
+		{"/스자 budget on", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != CommandBudget {
+				t.Fatalf("expected budget command, got %+v", cmd)
+			}
+			if cmd.BudgetHidden != tt.wantHidden {
+				t.Errorf("expected hidden=%v, got %v", tt.wantHidden, cmd.BudgetHidden)
+			}
+		})
+	}
+
+	// 설정값이 없으면 일반 질문으로 처리
+	if cmd := parser.Parse("/스자 예산"); cmd == nil || cmd.Kind != CommandAsk {
+		t.Fatalf("expected ask command, got %+v", cmd)
+	}
+}
+
+func TestCommandParser_ParseOpening(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input       string
+		wantEnabled bool
+	}{
+		{"/스자 추천질문 켜기", true},
+		{"/스자 추천 질문 on", true},
+		{"/스자 추천질문 끄기", false},
+		{"/스자 opening OFF", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != CommandOpening {
+				t.Fatalf("expected opening command, got %+v", cmd)
+			}
+			if cmd.OpeningEnabled != tt.wantEnabled {
+				t.Errorf("expected enabled=%v, got %v", tt.wantEnabled, cmd.OpeningEnabled)
+			}
+		})
+	}
+
+	if cmd := parser.Parse("/스자 추천질문"); cmd == nil || cmd.Kind != CommandAsk {
+		t.Fatalf("expected ask command, got %+v", cmd)
+	}
+}
+
+func TestCommandParser_ParseTournament(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input          string
+		wantKind       CommandKind
+		wantRounds     int
+		wantCategories []string
+	}{
+		{"/스자 토너먼트 5", CommandTournamentStart, 5, nil},
+		{"/스자 토너먼트 3라운드 음식", CommandTournamentStart, 3, []string{"음식"}},
+		{"/스자 tournament 4 rounds 영화 장소", CommandTournamentStart, 4, []string{"영화", "장소"}},
+		{"/스자 토너먼트", CommandTournamentRank, 0, nil},
+		{"/스자 토너먼트 순위", CommandTournamentRank, 0, nil},
+		{"/스자 토너먼트 종료", CommandTournamentEnd, 0, nil},
+		{"/스자 tournament cancel", CommandTournamentEnd, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != tt.wantKind {
+				t.Fatalf("expected kind %v, got %+v", tt.wantKind, cmd)
+			}
+			if cmd.TournamentRounds != tt.wantRounds {
+				t.Errorf("expected rounds=%d, got %d", tt.wantRounds, cmd.TournamentRounds)
+			}
+			if strings.Join(cmd.Categories, ",") != strings.Join(tt.wantCategories, ",") {
+				t.Errorf("expected categories %v, got %v", tt.wantCategories, cmd.Categories)
+			}
+		})
+	}
+
+	// 순위 조회는 락 없이 처리
+	if (Command{Kind: CommandTournamentRank}).RequiresLock() {
+		t.Error("tournament standings should not require lock")
+	}
+}
+
+func TestCommandParser_ParseSeason(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	for _, input := range []string{"/스자 시즌", "/스자 시즌 순위", "/스자 season standings"} {
+		if cmd := parser.Parse(input); cmd == nil || cmd.Kind != CommandSeason {
+			t.Errorf("%q: expected season command, got %+v", input, cmd)
+		}
+	}
+	if cmd := parser.Parse("/스자 시즌 초기화"); cmd != nil && cmd.Kind == CommandSeason {
+		t.Errorf("unexpected season command for unknown subcommand")
+	}
+
+	// 시즌 순위는 세션/락 없이 조회
+	if (Command{Kind: CommandSeason}).RequiresLock() {
+		t.Error("season standings should not require lock")
+	}
+	if requiresExistingSession(Command{Kind: CommandSeason}) {
+		t.Error("season standings should not require session")
+	}
+}
+
+func TestCommandParser_ParseHotseat(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input        string
+		wantKind     CommandKind
+		wantAction   qmodel.HotseatAction
+		wantNickname string
+	}{
+		{"/스자 턴제", CommandHotseat, qmodel.HotseatShow, ""},
+		{"/스자 턴제 켜기", CommandHotseat, qmodel.HotseatOn, ""},
+		{"/스자 hotseat off", CommandHotseat, qmodel.HotseatOff, ""},
+		{"/스자 턴제 참가", CommandHotseat, qmodel.HotseatJoin, ""},
+		{"/스자 턴제 빠지기", CommandHotseat, qmodel.HotseatLeave, ""},
+		{"/스자 턴제 섞기", CommandHotseat, qmodel.HotseatShuffle, ""},
+		{"/스자 턴제 제외 홍 길동", CommandHotseat, qmodel.HotseatKick, "홍 길동"},
+		{"/스자 패스", CommandPass, qmodel.HotseatShow, ""},
+		{"/스자 턴제 제외", CommandAsk, qmodel.HotseatShow, ""},
+		{"/스자 턴제 게임이야?", CommandAsk, qmodel.HotseatShow, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != tt.wantKind {
+				t.Fatalf("expected kind %v, got %+v", tt.wantKind, cmd)
+			}
+			if cmd.HotseatAction != tt.wantAction {
+				t.Errorf("expected action=%d, got %d", tt.wantAction, cmd.HotseatAction)
+			}
+			nickname := ""
+			if cmd.TargetNickname != nil {
+				nickname = *cmd.TargetNickname
+			}
+			if nickname != tt.wantNickname {
+				t.Errorf("expected nickname %q, got %q", tt.wantNickname, nickname)
+			}
+		})
+	}
+
+	// 순서 조회만 락 없이 처리
+	if (Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatShow}).RequiresLock() {
+		t.Error("hotseat order view should not require lock")
+	}
+	if !(Command{Kind: CommandHotseat, HotseatAction: qmodel.HotseatJoin}).RequiresLock() {
+		t.Error("hotseat join should require lock")
+	}
+}
+
 func TestCommandParser_InvalidInput(t *testing.T) {
 	parser := NewCommandParser("/스자")
 
@@ -453,3 +659,64 @@ func TestCommandParser_ParseRoomStats(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandParser_ParseSettings(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input      string
+		wantKind   CommandKind
+		wantAction qmodel.ChatSettingsAction
+		wantValue  string
+	}{
+		{"/스자 설정", CommandSettings, qmodel.ChatSettingsShow, ""},
+		{"/스자 설정 질문 30", CommandSettings, qmodel.ChatSettingsMaxQuestions, "30"},
+		{"/스자 settings hints 2", CommandSettings, qmodel.ChatSettingsMaxHints, "2"},
+		{"/스자 설정 카테고리 음식 장소", CommandSettings, qmodel.ChatSettingsCategories, "음식 장소"},
+		{"/스자 설정 포기 기본", CommandSettings, qmodel.ChatSettingsSurrenderVotes, "기본"},
+		{"/스자 설정 콤보 5", CommandSettings, qmodel.ChatSettingsCombo, "5"},
+		{"/스자 설정 초기화", CommandSettings, qmodel.ChatSettingsReset, ""},
+		{"/스자 설정 질문", CommandAsk, qmodel.ChatSettingsShow, ""},
+		{"/스자 설정 바꿀 수 있어?", CommandAsk, qmodel.ChatSettingsShow, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != tt.wantKind {
+				t.Fatalf("expected kind %v, got %+v", tt.wantKind, cmd)
+			}
+			if cmd.SettingsAction != tt.wantAction || cmd.SettingsValue != tt.wantValue {
+				t.Errorf("expected %v %q, got %v %q", tt.wantAction, tt.wantValue, cmd.SettingsAction, cmd.SettingsValue)
+			}
+		})
+	}
+}
+
+func TestCommandParser_ParseLocale(t *testing.T) {
+	parser := NewCommandParser("/스자")
+
+	tests := []struct {
+		input     string
+		wantKind  CommandKind
+		wantValue string
+	}{
+		{"/스자 언어", CommandLocale, ""},
+		{"/스자 언어 en", CommandLocale, "en"},
+		{"/스자 language 日本語", CommandLocale, "日本語"},
+		{"/스자 lang 기본", CommandLocale, "기본"},
+		{"/스자 언어 두 개", CommandAsk, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd := parser.Parse(tt.input)
+			if cmd == nil || cmd.Kind != tt.wantKind {
+				t.Fatalf("expected kind %v, got %+v", tt.wantKind, cmd)
+			}
+			if cmd.LocaleValue != tt.wantValue {
+				t.Errorf("expected value %q, got %q", tt.wantValue, cmd.LocaleValue)
+			}
+		})
+	}
+}
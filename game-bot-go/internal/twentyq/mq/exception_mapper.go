@@ -25,6 +25,9 @@ func GetErrorMapping(err error, commandPrefix string) ErrorMapping {
 		hintLimit       qerrors.HintLimitExceededError
 		hintNA          qerrors.HintNotAvailableError
 		guessRateLimit  qerrors.GuessRateLimitError
+		notYourTurn     qerrors.NotYourTurnError
+		notJoined       qerrors.HotseatNotJoinedError
+		questionLimit   qerrors.QuestionLimitExceededError
 	)
 
 	switch {
@@ -58,6 +61,28 @@ func GetErrorMapping(err error, commandPrefix string) ErrorMapping {
 				messageprovider.P("totalSeconds", guessRateLimit.TotalSeconds),
 			},
 		}
+	case errors.As(err, &notYourTurn):
+		return ErrorMapping{
+			Key: qmessages.ErrorNotYourTurn,
+			Params: []messageprovider.Param{
+				messageprovider.P("nickname", notYourTurn.CurrentSender),
+			},
+		}
+	case errors.As(err, &notJoined):
+		return ErrorMapping{
+			Key: qmessages.ErrorHotseatNotJoined,
+			Params: []messageprovider.Param{
+				messageprovider.P("prefix", commandPrefix),
+			},
+		}
+	case errors.As(err, &questionLimit):
+		return ErrorMapping{
+			Key: qmessages.ErrorQuestionLimit,
+			Params: []messageprovider.Param{
+				messageprovider.P("maxQuestions", questionLimit.MaxQuestions),
+				messageprovider.P("prefix", commandPrefix),
+			},
+		}
 	case errors.Is(err, context.DeadlineExceeded):
 		return ErrorMapping{Key: qmessages.ErrorAITimeout}
 	default:
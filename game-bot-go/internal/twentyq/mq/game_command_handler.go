@@ -6,13 +6,19 @@ import (
 	"log/slog"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/telemetry"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
 	qsvc "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/service"
 )
 
+// tracerName: 스무고개 명령어 처리 span의 tracer 이름
+const tracerName = "game-bot-go/twentyq"
+
 // GameCommandHandler: 게임 관련 명령어를 적절한 서비스 메서드로 라우팅하고 응답을 생성하는 핸들러
 type GameCommandHandler struct {
 	gameService            *qsvc.RiddleService
@@ -52,12 +58,24 @@ func NewGameCommandHandler(
 		CommandAsk:             h.handleAsk,
 		CommandChainedQuestion: h.handleChainedQuestion,
 		CommandHints:           h.handleHints,
+		CommandHintFeedback:    h.handleHintFeedback,
 		CommandStatus:          h.handleStatus,
+		CommandSummary:         h.handleSummary,
 		CommandSurrender:       h.handleSurrender,
 		CommandAgree:           h.handleAgree,
 		CommandReject:          h.handleReject,
 		CommandUserStats:       h.handleUserStats,
 		CommandRoomStats:       h.handleRoomStats,
+		CommandBudget:          h.handleBudget,
+		CommandOpening:         h.handleOpening,
+		CommandSettings:        h.handleSettings,
+		CommandLocale:          h.handleLocale,
+		CommandTournamentStart: h.handleTournamentStart,
+		CommandTournamentRank:  h.handleTournamentStandings,
+		CommandTournamentEnd:   h.handleTournamentCancel,
+		CommandSeason:          h.handleSeasonStandings,
+		CommandHotseat:         h.handleHotseat,
+		CommandPass:            h.handlePass,
 		CommandAdminForceEnd:   h.handleAdminForceEnd,
 		CommandAdminClearAll:   h.handleAdminClearAll,
 		CommandAdminUsage:      h.handleAdminUsage,
@@ -69,11 +87,18 @@ func NewGameCommandHandler(
 }
 
 // ProcessCommand: 인입된 메시지와 파싱된 명령어를 바탕으로 적절한 핸들러를 실행하여 응답을 반환합니다.
-func (h *GameCommandHandler) ProcessCommand(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+// 명령어마다 span을 남겨 Valkey/LLM 호출이 한 게임 턴 아래에 묶이도록 합니다.
+func (h *GameCommandHandler) ProcessCommand(ctx context.Context, message mqmsg.InboundMessage, command Command) (responses []string, err error) {
+	ctx, span := telemetry.StartSpan(ctx, tracerName, "twentyq."+command.Kind.Name(),
+		attribute.String("game.command", command.Kind.Name()),
+		attribute.String("game.chat_id", message.ChatID),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	handler, ok := h.handlers[command.Kind]
 	if !ok {
 		h.logger.Debug("command_kind_unhandled", "kind", command.Kind)
-		return []string{h.msgProvider.Get(qmessages.ErrorUnknownCommand)}, nil
+		return []string{h.msgProvider.For(ctx).Get(qmessages.ErrorUnknownCommand)}, nil
 	}
 
 	return handler(ctx, message, command)
@@ -89,19 +114,24 @@ func (h *GameCommandHandler) handleStart(ctx context.Context, message mqmsg.Inbo
 }
 
 func (h *GameCommandHandler) handleAsk(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
-	text, err := h.gameService.Answer(ctx, message.ChatID, message.UserID, message.Sender, command.Question)
+	outcome, err := h.gameService.AnswerWithOutcome(ctx, message.ChatID, message.UserID, message.Sender, command.Question, false)
 	if err != nil {
 		return nil, fmt.Errorf("answer failed: %w", err)
 	}
+	text := outcome.Message
 	if isAnswerCommand(command.Question) {
-		return []string{text}, nil
+		return []string{appendHotseatTurnLine(ctx, h.gameService, h.logger, message.ChatID, text)}, nil
 	}
 
 	main, hint, questionCount, statusErr := h.gameService.StatusSeparatedWithCount(ctx, message.ChatID)
 	if statusErr != nil {
 		return []string{text}, nil
 	}
-	messages := []string{main}
+	if line := h.gameService.ComboLine(ctx, outcome.Combo); line != "" {
+		main += "\n" + line
+	}
+	main = appendBudgetLine(ctx, h.gameService, h.logger, message.ChatID, main)
+	messages := []string{appendHotseatTurnLine(ctx, h.gameService, h.logger, message.ChatID, main)}
 	if shouldShowHint(hint, questionCount) {
 		messages = append(messages, hint)
 	}
@@ -109,6 +139,13 @@ func (h *GameCommandHandler) handleAsk(ctx context.Context, message mqmsg.Inboun
 }
 
 func (h *GameCommandHandler) handleChainedQuestion(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	// 턴제에서는 한 차례에 질문 하나만 허용
+	if active, err := h.gameService.HotseatActive(ctx, message.ChatID); err != nil {
+		h.logger.Warn("hotseat_check_failed", "chat_id", message.ChatID, "err", err)
+	} else if active {
+		return []string{h.msgProvider.For(ctx).Get(qmessages.HotseatChainDisabled)}, nil
+	}
+
 	// 첫 번째 질문 처리
 	response, err := h.chainedQuestionHandler.Handle(
 		ctx, message.ChatID, message.UserID, message.Sender,
@@ -128,7 +165,7 @@ func (h *GameCommandHandler) handleChainedQuestion(ctx context.Context, message
 		main = main + "\n\n" + parts[1]
 	}
 
-	messages := []string{main}
+	messages := []string{appendBudgetLine(ctx, h.gameService, h.logger, message.ChatID, main)}
 	if shouldShowHint(hint, questionCount) {
 		messages = append(messages, hint)
 	}
@@ -140,6 +177,14 @@ func (h *GameCommandHandler) handleHints(ctx context.Context, message mqmsg.Inbo
 	if err != nil {
 		return nil, fmt.Errorf("generate hint failed: %w", err)
 	}
+	return []string{appendBudgetLine(ctx, h.gameService, h.logger, message.ChatID, text)}, nil
+}
+
+func (h *GameCommandHandler) handleHintFeedback(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.RecordHintFeedback(ctx, message.ChatID, message.UserID, command.HintUseful)
+	if err != nil {
+		return nil, fmt.Errorf("record hint feedback failed: %w", err)
+	}
 	return []string{text}, nil
 }
 
@@ -156,6 +201,14 @@ func (h *GameCommandHandler) handleStatus(ctx context.Context, message mqmsg.Inb
 	return messages, nil
 }
 
+func (h *GameCommandHandler) handleSummary(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.Summary(ctx, message.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("summary failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
 func (h *GameCommandHandler) handleSurrender(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
 	text, err := h.gameService.HandleSurrenderConsensus(ctx, message.ChatID, message.UserID)
 	if err != nil {
@@ -180,6 +233,91 @@ func (h *GameCommandHandler) handleReject(ctx context.Context, message mqmsg.Inb
 	return []string{text}, nil
 }
 
+func (h *GameCommandHandler) handleBudget(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.SetBudgetHidden(ctx, message.ChatID, command.BudgetHidden)
+	if err != nil {
+		return nil, fmt.Errorf("set budget hidden failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleOpening(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.SetOpeningSuggestions(ctx, message.ChatID, command.OpeningEnabled)
+	if err != nil {
+		return nil, fmt.Errorf("set opening suggestions failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleSettings(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.UpdateChatSettings(ctx, message.ChatID, message.UserID, command.SettingsAction, command.SettingsValue)
+	if err != nil {
+		return nil, fmt.Errorf("chat settings failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleLocale(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.UpdateChatLocale(ctx, message.ChatID, message.UserID, command.LocaleValue)
+	if err != nil {
+		return nil, fmt.Errorf("chat locale failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleTournamentStart(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	h.logger.Info("handle_tournament_start", "chat_id", message.ChatID, "rounds", command.TournamentRounds, "categories", command.Categories)
+	text, err := h.gameService.StartTournament(ctx, message.ChatID, message.UserID, command.TournamentRounds, command.Categories)
+	if err != nil {
+		return nil, fmt.Errorf("start tournament failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleTournamentStandings(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.TournamentStandings(ctx, message.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("tournament standings failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleTournamentCancel(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.CancelTournament(ctx, message.ChatID, message.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("cancel tournament failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleSeasonStandings(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.SeasonStandings(ctx, message.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("season standings failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handleHotseat(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	nickname := ""
+	if command.TargetNickname != nil {
+		nickname = *command.TargetNickname
+	}
+	text, err := h.gameService.HotseatCommand(ctx, message.ChatID, message.UserID, message.Sender, command.HotseatAction, nickname)
+	if err != nil {
+		return nil, fmt.Errorf("hotseat command failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
+func (h *GameCommandHandler) handlePass(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
+	text, err := h.gameService.PassTurn(ctx, message.ChatID, message.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("pass turn failed: %w", err)
+	}
+	return []string{text}, nil
+}
+
 func (h *GameCommandHandler) handleUserStats(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
 	text, err := h.statsService.GetUserStats(ctx, message.ChatID, message.UserID, message.Sender, command.TargetNickname)
 	if err != nil {
@@ -221,11 +359,11 @@ func (h *GameCommandHandler) handleAdminUsage(ctx context.Context, message mqmsg
 }
 
 func (h *GameCommandHandler) handleHelp(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
-	return []string{h.msgProvider.Get(qmessages.HelpMessage)}, nil
+	return []string{h.msgProvider.For(ctx).Get(qmessages.HelpMessage)}, nil
 }
 
 func (h *GameCommandHandler) handleUnknown(ctx context.Context, message mqmsg.InboundMessage, command Command) ([]string, error) {
-	return []string{h.msgProvider.Get(qmessages.ErrorUnknownCommand)}, nil
+	return []string{h.msgProvider.For(ctx).Get(qmessages.ErrorUnknownCommand)}, nil
 }
 
 func isAnswerCommand(question string) bool {
@@ -244,3 +382,40 @@ func shouldShowHint(hint string, questionCount int) bool {
 	}
 	return questionCount > 0 && questionCount%qconfig.HintDisplayInterval == 0
 }
+
+// budgetLiner: 예산 줄 조회자 (RiddleService)
+type budgetLiner interface {
+	BudgetLine(ctx context.Context, chatID string) (string, error)
+}
+
+// appendBudgetLine: 응답 끝에 질문/힌트 사용량 줄을 덧붙입니다.
+// 조회 실패는 응답을 막지 않도록 로그만 남기고 원문을 반환합니다.
+func appendBudgetLine(ctx context.Context, svc budgetLiner, logger *slog.Logger, chatID string, text string) string {
+	line, err := svc.BudgetLine(ctx, chatID)
+	if err != nil {
+		logger.Warn("budget_line_failed", "chat_id", chatID, "err", err)
+		return text
+	}
+	if line == "" {
+		return text
+	}
+	return text + "\n" + line
+}
+
+// hotseatTurnLiner: 턴제 차례 안내 조회자 (RiddleService)
+type hotseatTurnLiner interface {
+	HotseatTurnLine(ctx context.Context, chatID string) (string, error)
+}
+
+// appendHotseatTurnLine: 턴제 게임이면 응답 끝에 다음 차례 안내를 덧붙입니다.
+func appendHotseatTurnLine(ctx context.Context, svc hotseatTurnLiner, logger *slog.Logger, chatID string, text string) string {
+	line, err := svc.HotseatTurnLine(ctx, chatID)
+	if err != nil {
+		logger.Warn("hotseat_turn_line_failed", "chat_id", chatID, "err", err)
+		return text
+	}
+	if line == "" {
+		return text
+	}
+	return text + "\n" + line
+}
@@ -9,9 +9,11 @@ import (
 	"time"
 
 	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ratelimit"
 	domainmodels "github.com/park285/llm-kakao-bots/game-bot-go/internal/domain/models"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
@@ -29,6 +31,7 @@ type GameMessageService struct {
 	msgProvider            *messageprovider.Provider
 	publisher              *ReplyPublisher
 	accessControl          *qsecurity.AccessControl
+	rateLimiter            *ratelimit.Limiter
 	commandParser          *CommandParser
 	lockManager            *qredis.LockManager
 	processingLockService  *qredis.ProcessingLockService
@@ -36,6 +39,7 @@ type GameMessageService struct {
 	restClient             *llmrest.Client
 	commandPrefix          string
 	processingWaitingDelay time.Duration
+	latencyRecorder        *latency.Recorder
 	logger                 *slog.Logger
 }
 
@@ -49,6 +53,11 @@ type hintAvailabilityChecker interface {
 	CanGenerateHint(ctx context.Context, chatID string) (bool, error)
 }
 
+// chatLocaleResolver: 방별 메시지 언어를 컨텍스트에 싣는 인터페이스 (RiddleService가 구현)
+type chatLocaleResolver interface {
+	WithChatLocale(ctx context.Context, chatID string) context.Context
+}
+
 // NewGameMessageService: 모든 종속성을 주입받아 GameMessageService 인스턴스를 생성합니다.
 func NewGameMessageService(
 	commandHandler *GameCommandHandler,
@@ -57,12 +66,14 @@ func NewGameMessageService(
 	msgProvider *messageprovider.Provider,
 	publisher *ReplyPublisher,
 	accessControl *qsecurity.AccessControl,
+	rateLimiter *ratelimit.Limiter,
 	commandParser *CommandParser,
 	lockManager *qredis.LockManager,
 	processingLockService *qredis.ProcessingLockService,
 	queueProcessor *MessageQueueProcessor,
 	restClient *llmrest.Client,
 	commandPrefix string,
+	latencyRecorder *latency.Recorder,
 	logger *slog.Logger,
 ) *GameMessageService {
 	return &GameMessageService{
@@ -72,6 +83,7 @@ func NewGameMessageService(
 		msgProvider:            msgProvider,
 		publisher:              publisher,
 		accessControl:          accessControl,
+		rateLimiter:            rateLimiter,
 		commandParser:          commandParser,
 		lockManager:            lockManager,
 		processingLockService:  processingLockService,
@@ -79,6 +91,7 @@ func NewGameMessageService(
 		restClient:             restClient,
 		commandPrefix:          strings.TrimSpace(commandPrefix),
 		processingWaitingDelay: 5 * time.Second,
+		latencyRecorder:        latencyRecorder,
 		logger:                 logger,
 	}
 }
@@ -92,10 +105,20 @@ func (s *GameMessageService) HandleMessage(ctx context.Context, message mqmsg.In
 		return
 	}
 
+	ctx, span := s.latencyRecorder.Start(ctx, cmd.Kind.Name(), message.ReceivedAt)
+	defer span.Finish()
+
+	// 대기 메시지·대기열 안내·오류까지 모두 방 언어로 보낸다
+	ctx = s.withChatLocale(ctx, message.ChatID)
+
 	if !s.isAccessAllowed(ctx, message, *cmd) {
 		return
 	}
 
+	if !s.isWithinRateLimit(ctx, message, *cmd) {
+		return
+	}
+
 	// 세션이 필요한 명령어인데 세션이 없으면 바로 에러 반환 (대기 메시지 없이)
 	if requiresExistingSession(*cmd) {
 		hasSession, err := s.playerRegistrar.HasSession(ctx, message.ChatID)
@@ -104,7 +127,7 @@ func (s *GameMessageService) HandleMessage(ctx context.Context, message mqmsg.In
 		}
 		if !hasSession {
 			s.logger.Warn("message_rejected_no_session", "chat_id", message.ChatID, "user_id", message.UserID)
-			noSessionText := s.msgProvider.Get(qmessages.ErrorNoSession, messageprovider.P("prefix", s.commandPrefix))
+			noSessionText := s.msgProvider.For(ctx).Get(qmessages.ErrorNoSession, messageprovider.P("prefix", s.commandPrefix))
 			_ = s.messageSender.SendFinal(ctx, message, noSessionText)
 			return
 		}
@@ -113,6 +136,14 @@ func (s *GameMessageService) HandleMessage(ctx context.Context, message mqmsg.In
 	s.handleCommand(ctx, message, *cmd)
 }
 
+func (s *GameMessageService) withChatLocale(ctx context.Context, chatID string) context.Context {
+	resolver, ok := s.playerRegistrar.(chatLocaleResolver)
+	if !ok || resolver == nil {
+		return ctx
+	}
+	return resolver.WithChatLocale(ctx, chatID)
+}
+
 func (s *GameMessageService) handleCommand(ctx context.Context, message mqmsg.InboundMessage, command Command) {
 	chatID := message.ChatID
 
@@ -170,6 +201,7 @@ func (s *GameMessageService) enqueueMessage(ctx context.Context, message mqmsg.I
 }
 
 func (s *GameMessageService) processQueuedMessages(ctx context.Context, chatID string) {
+	ctx = latency.Detach(ctx)
 	s.queueProcessor.ProcessQueuedMessages(ctx, chatID, func(out mqmsg.OutboundMessage) error {
 		return s.publisher.Publish(ctx, out)
 	})
@@ -357,7 +389,7 @@ func (s *GameMessageService) HandleQueuedCommand(
 
 	responses, err := s.runCommand(timeoutCtx, message, command)
 	if err != nil {
-		return s.handleQueuedFailure(message, err, emit)
+		return s.handleQueuedFailure(ctx, message, err, emit)
 	}
 
 	return emitChunkedResponses(message.ChatID, message.ThreadID, responses, emit)
@@ -402,18 +434,19 @@ func (s *GameMessageService) handleDirectFailure(ctx context.Context, message mq
 }
 
 func (s *GameMessageService) handleQueuedFailure(
+	ctx context.Context,
 	message mqmsg.InboundMessage,
 	err error,
 	emit func(mqmsg.OutboundMessage) error,
 ) error {
 	var lockErr cerrors.LockError
 	if errors.As(err, &lockErr) {
-		text := s.msgProvider.Get(qmessages.LockRequestInProgress)
+		text := s.msgProvider.For(ctx).Get(qmessages.LockRequestInProgress)
 		return emit(mqmsg.NewFinal(message.ChatID, text, message.ThreadID))
 	}
 
 	mapping := GetErrorMapping(err, s.commandPrefix)
-	text := s.msgProvider.Get(mapping.Key, mapping.Params...)
+	text := s.msgProvider.For(ctx).Get(mapping.Key, mapping.Params...)
 	return emit(mqmsg.NewFinal(message.ChatID, text, message.ThreadID))
 }
 
@@ -421,24 +454,24 @@ func (s *GameMessageService) handleModelInfo(ctx context.Context, message mqmsg.
 	cfg, err := s.restClient.GetModelConfig(ctx)
 	if err != nil {
 		s.logger.Warn("model_info_fetch_failed", "err", err)
-		_ = s.messageSender.SendFinal(ctx, message, s.msgProvider.Get(qmessages.ModelInfoFetchFailed))
+		_ = s.messageSender.SendFinal(ctx, message, s.msgProvider.For(ctx).Get(qmessages.ModelInfoFetchFailed))
 		return
 	}
 
-	_ = s.messageSender.SendFinal(ctx, message, s.formatModelInfo(cfg))
+	_ = s.messageSender.SendFinal(ctx, message, s.formatModelInfo(ctx, cfg))
 }
 
 func (s *GameMessageService) handleModelInfoQueued(ctx context.Context, message mqmsg.InboundMessage, emit func(mqmsg.OutboundMessage) error) error {
 	cfg, err := s.restClient.GetModelConfig(ctx)
 	if err != nil {
 		s.logger.Warn("model_info_fetch_failed", "err", err)
-		return emitChunkedText(message.ChatID, message.ThreadID, s.msgProvider.Get(qmessages.ModelInfoFetchFailed), emit)
+		return emitChunkedText(message.ChatID, message.ThreadID, s.msgProvider.For(ctx).Get(qmessages.ModelInfoFetchFailed), emit)
 	}
 
-	return emitChunkedText(message.ChatID, message.ThreadID, s.formatModelInfo(cfg), emit)
+	return emitChunkedText(message.ChatID, message.ThreadID, s.formatModelInfo(ctx, cfg), emit)
 }
 
-func (s *GameMessageService) formatModelInfo(cfg *llmrest.ModelConfigResponse) string {
+func (s *GameMessageService) formatModelInfo(ctx context.Context, cfg *llmrest.ModelConfigResponse) string {
 	hintsModel := cfg.ModelDefault
 	if cfg.ModelHints != nil && strings.TrimSpace(*cfg.ModelHints) != "" {
 		hintsModel = strings.TrimSpace(*cfg.ModelHints)
@@ -462,15 +495,15 @@ func (s *GameMessageService) formatModelInfo(cfg *llmrest.ModelConfigResponse) s
 	temperature := fmt.Sprintf("%.2f", cfg.Temperature)
 
 	lines := []string{
-		s.msgProvider.Get(qmessages.ModelInfoHeader),
-		s.msgProvider.Get(qmessages.ModelInfoDefault, messageprovider.P("model", cfg.ModelDefault)),
-		s.msgProvider.Get(qmessages.ModelInfoHints, messageprovider.P("model", hintsModel)),
-		s.msgProvider.Get(qmessages.ModelInfoAnswer, messageprovider.P("model", answerModel)),
-		s.msgProvider.Get(qmessages.ModelInfoVerify, messageprovider.P("model", verifyModel)),
-		s.msgProvider.Get(qmessages.ModelInfoTemperature, messageprovider.P("value", temperature)),
-		s.msgProvider.Get(qmessages.ModelInfoMaxRetries, messageprovider.P("value", cfg.MaxRetries)),
-		s.msgProvider.Get(qmessages.ModelInfoTimeout, messageprovider.P("value", cfg.TimeoutSeconds)),
-		s.msgProvider.Get(qmessages.ModelInfoTransport, messageprovider.P("mode", transportMode)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoHeader),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoDefault, messageprovider.P("model", cfg.ModelDefault)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoHints, messageprovider.P("model", hintsModel)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoAnswer, messageprovider.P("model", answerModel)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoVerify, messageprovider.P("model", verifyModel)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoTemperature, messageprovider.P("value", temperature)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoMaxRetries, messageprovider.P("value", cfg.MaxRetries)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoTimeout, messageprovider.P("value", cfg.TimeoutSeconds)),
+		s.msgProvider.For(ctx).Get(qmessages.ModelInfoTransport, messageprovider.P("mode", transportMode)),
 	}
 
 	return strings.Join(lines, "\n")
@@ -502,7 +535,7 @@ func (s *GameMessageService) isAccessAllowed(ctx context.Context, message mqmsg.
 
 	mapping := ErrorMapping{Key: *reason}
 	if *reason == qmessages.ErrorUserBlocked {
-		nickname := domainmodels.DisplayName(message.ChatID, message.UserID, message.Sender, s.msgProvider.Get(qmessages.UserAnonymous))
+		nickname := domainmodels.DisplayName(message.ChatID, message.UserID, message.Sender, s.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
 		mapping.Params = []messageprovider.Param{messageprovider.P("nickname", nickname)}
 	}
 
@@ -510,6 +543,45 @@ func (s *GameMessageService) isAccessAllowed(ctx context.Context, message mqmsg.
 	return false
 }
 
+// isWithinRateLimit: 사용자/채팅방 명령어 빈도 제한을 확인합니다.
+// 제한된 메시지는 LLM 호출 없이 버리고, 안내는 쿨다운마다 한 번만 보냅니다.
+func (s *GameMessageService) isWithinRateLimit(ctx context.Context, message mqmsg.InboundMessage, command Command) bool {
+	if isAccessBypassAdminCommand(command) {
+		return true
+	}
+
+	decision, err := s.rateLimiter.Allow(ctx, message.ChatID, message.UserID)
+	if err != nil {
+		s.logger.Warn("rate_limit_check_failed", "chat_id", message.ChatID, "user_id", message.UserID, "err", err)
+	}
+	if decision.Allowed {
+		return true
+	}
+
+	s.logger.Info("message_rate_limited",
+		"chat_id", message.ChatID,
+		"user_id", message.UserID,
+		"scope", decision.Scope.String(),
+		"retry_after_ms", decision.RetryAfter.Milliseconds(),
+	)
+	if decision.Notify {
+		_ = s.messageSender.SendError(ctx, message, s.rateLimitMapping(ctx, message, decision))
+	}
+	return false
+}
+
+func (s *GameMessageService) rateLimitMapping(ctx context.Context, message mqmsg.InboundMessage, decision ratelimit.Decision) ErrorMapping {
+	seconds := messageprovider.P("seconds", decision.RetryAfterSeconds())
+	if decision.Scope != ratelimit.ScopeUser {
+		return ErrorMapping{Key: qmessages.ErrorRateLimitedChat, Params: []messageprovider.Param{seconds}}
+	}
+	nickname := domainmodels.DisplayName(message.ChatID, message.UserID, message.Sender, s.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
+	return ErrorMapping{
+		Key:    qmessages.ErrorRateLimitedUser,
+		Params: []messageprovider.Param{messageprovider.P("nickname", nickname), seconds},
+	}
+}
+
 func (s *GameMessageService) isProcessing(ctx context.Context, chatID string) bool {
 	ok, err := s.processingLockService.IsProcessing(ctx, chatID)
 	if err != nil {
@@ -523,8 +595,8 @@ func (s *GameMessageService) isProcessing(ctx context.Context, chatID string) bo
 // Start, Help, UserStats, Admin 명령어는 세션 없이도 실행 가능.
 func requiresExistingSession(command Command) bool {
 	switch command.Kind {
-	case CommandStart, CommandHelp, CommandUserStats, CommandRoomStats,
-		CommandAdminForceEnd, CommandAdminClearAll, CommandAdminUsage, CommandModelInfo:
+	case CommandStart, CommandHelp, CommandUserStats, CommandRoomStats, CommandBudget,
+		CommandAdminForceEnd, CommandAdminClearAll, CommandAdminUsage, CommandModelInfo, CommandSeason:
 		return false
 	default:
 		return true
@@ -0,0 +1,67 @@
+package mq
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ratelimit"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+)
+
+func TestGameMessageService_isWithinRateLimit_NotifiesOncePerCooldown(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t) + "20q"
+	testhelper.CleanupTestKeys(t, client, prefix+":")
+
+	msgProvider, err := messageprovider.NewFromYAML("error:\n  rate_limited_user: \"SLOW:{nickname}:{seconds}\"\nuser:\n  anonymous: \"anon\"\n")
+	if err != nil {
+		t.Fatalf("message provider init failed: %v", err)
+	}
+
+	var published []mqmsg.OutboundMessage
+	sender := NewMessageSender(msgProvider, func(ctx context.Context, msg mqmsg.OutboundMessage) error {
+		published = append(published, msg)
+		return nil
+	})
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := &GameMessageService{
+		messageSender: sender,
+		msgProvider:   msgProvider,
+		rateLimiter: ratelimit.NewLimiter(client, prefix, qconfig.RateLimitConfig{
+			Enabled:        true,
+			UserBurst:      1,
+			UserPerMinute:  1,
+			NoticeCooldown: time.Minute,
+		}, logger),
+		logger: logger,
+	}
+
+	nick := "Nick"
+	in := mqmsg.InboundMessage{ChatID: "chat1", UserID: "user1", Sender: &nick, Content: "/스자 질문"}
+	ctx := context.Background()
+
+	if !svc.isWithinRateLimit(ctx, in, Command{Kind: CommandAsk}) {
+		t.Fatal("first command should pass")
+	}
+	for range 3 {
+		if svc.isWithinRateLimit(ctx, in, Command{Kind: CommandAsk}) {
+			t.Fatal("expected rate limited")
+		}
+	}
+	if len(published) != 1 || published[0].Text != "SLOW:Nick:60" {
+		t.Fatalf("expected a single notice, got %+v", published)
+	}
+
+	// 관리자 명령어는 제한하지 않음
+	if !svc.isWithinRateLimit(ctx, in, Command{Kind: CommandAdminForceEnd}) {
+		t.Fatal("admin command should bypass rate limit")
+	}
+}
@@ -89,7 +89,7 @@ func (p *MessageQueueProcessor) EnqueueAndNotify(
 		return err
 	}
 
-	userName := pending.DisplayName(chatID, p.msgProvider.Get(qmessages.UserAnonymous))
+	userName := pending.DisplayName(chatID, p.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
 	message, err := p.buildQueueMessage(ctx, result, chatID, userName, content)
 	if err != nil {
 		return err
@@ -113,23 +113,23 @@ func (p *MessageQueueProcessor) buildQueueMessage(
 		}
 		queueDetails := rawDetails
 		if queueDetails == "" {
-			queueDetails = p.msgProvider.Get(qmessages.QueueEmpty)
+			queueDetails = p.msgProvider.For(ctx).Get(qmessages.QueueEmpty)
 		}
-		return p.msgProvider.Get(
+		return p.msgProvider.For(ctx).Get(
 			qmessages.LockMessageQueued,
 			messageprovider.P("user", userName),
 			messageprovider.P("queueDetails", queueDetails),
 		), nil
 	case qredis.EnqueueQueueFull:
-		return p.msgProvider.Get(qmessages.LockQueueFull), nil
+		return p.msgProvider.For(ctx).Get(qmessages.LockQueueFull), nil
 	case qredis.EnqueueDuplicate:
-		return p.msgProvider.Get(
+		return p.msgProvider.For(ctx).Get(
 			qmessages.LockAlreadyQueued,
 			messageprovider.P("user", userName),
 			messageprovider.P("content", content),
 		), nil
 	default:
-		return p.msgProvider.Get(qmessages.ErrorGeneric), nil
+		return p.msgProvider.For(ctx).Get(qmessages.ErrorGeneric), nil
 	}
 }
 
@@ -226,7 +226,7 @@ func (p *MessageQueueProcessor) shouldSkipChainBatch(
 	}
 
 	p.logger.Info("chain_batch_skipped", "chat_id", chatID, "user_id", pending.UserID, "reason", "condition_not_met")
-	skipMessage := p.msgProvider.Get(qmessages.ChainConditionNotMet,
+	skipMessage := p.msgProvider.For(ctx).Get(qmessages.ChainConditionNotMet,
 		messageprovider.P("questions", strings.Join(pending.BatchQuestions, ", ")))
 	_ = emit(mqmsg.NewFinal(chatID, skipMessage, pending.ThreadID))
 	return true
@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+)
+
+// Budget: 현재 게임의 질문/힌트 사용량과 방별 예산 줄 표시 설정
+type Budget struct {
+	Questions int
+	Hints     int
+	Hidden    bool
+}
+
+// BudgetStore: 답변/힌트 응답에 붙는 예산 줄 데이터를 조회하고 방별 숨김 설정을 관리하는 저장소
+type BudgetStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewBudgetStore: 새로운 BudgetStore 인스턴스를 생성합니다.
+func NewBudgetStore(client valkey.Client, logger *slog.Logger) *BudgetStore {
+	return &BudgetStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 이력 길이, 힌트 횟수, 숨김 설정을 파이프라인(DoMulti) 한 번으로 조회합니다.
+// 힌트도 이력에 기록되므로 질문 수는 이력 길이에서 힌트 횟수를 뺀 값입니다.
+func (s *BudgetStore) Get(ctx context.Context, chatID string) (Budget, error) {
+	results := s.client.DoMulti(ctx,
+		s.client.B().Llen().Key(historyKey(chatID)).Build(),
+		s.client.B().Get().Key(hintCountKey(chatID)).Build(),
+		s.client.B().Exists().Key(budgetHiddenKey(chatID)).Build(),
+	)
+
+	historyLen, err := results[0].AsInt64()
+	if err != nil {
+		return Budget{}, cerrors.RedisError{Operation: "budget_history_len", Err: err}
+	}
+	hints, err := results[1].AsInt64()
+	if err != nil && !valkeyx.IsNil(err) {
+		return Budget{}, cerrors.RedisError{Operation: "budget_hint_count", Err: err}
+	}
+	hidden, err := results[2].AsInt64()
+	if err != nil {
+		return Budget{}, cerrors.RedisError{Operation: "budget_hidden_get", Err: err}
+	}
+
+	hints = max(hints, 0)
+	return Budget{
+		Questions: int(max(historyLen-hints, 0)),
+		Hints:     int(hints),
+		Hidden:    hidden > 0,
+	}, nil
+}
+
+// SetHidden: 방의 예산 줄 숨김 여부를 저장합니다. (게임 종료 후에도 유지)
+func (s *BudgetStore) SetHidden(ctx context.Context, chatID string, hidden bool) error {
+	key := budgetHiddenKey(chatID)
+
+	cmd := s.client.B().Del().Key(key).Build()
+	if hidden {
+		cmd = s.client.B().Set().Key(key).Value("1").Build()
+	}
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "budget_hidden_set", Err: err}
+	}
+	return nil
+}
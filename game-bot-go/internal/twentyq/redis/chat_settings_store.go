@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// ChatSettingsStore: 방별 게임 규칙 재정의(최대 질문/힌트, 허용 카테고리, 항복 투표 인원)를 저장하는 저장소
+// 설정은 게임 종료 후에도 유지되며, 기본값으로 되돌리면 키를 삭제합니다.
+type ChatSettingsStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewChatSettingsStore: 새로운 ChatSettingsStore 인스턴스를 생성합니다.
+func NewChatSettingsStore(client valkey.Client, logger *slog.Logger) *ChatSettingsStore {
+	return &ChatSettingsStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 방의 설정을 조회합니다. 저장된 설정이 없으면 빈 설정(기본 규칙)을 반환합니다.
+func (s *ChatSettingsStore) Get(ctx context.Context, chatID string) (qmodel.ChatSettings, error) {
+	cmd := s.client.B().Get().Key(chatSettingsKey(chatID)).Build()
+	raw, err := s.client.Do(ctx, cmd).AsBytes()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return qmodel.ChatSettings{}, nil
+		}
+		return qmodel.ChatSettings{}, cerrors.RedisError{Operation: "chat_settings_get", Err: err}
+	}
+
+	var out qmodel.ChatSettings
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return qmodel.ChatSettings{}, cerrors.RedisError{Operation: "chat_settings_unmarshal", Err: err}
+	}
+	return out, nil
+}
+
+// Save: 방의 설정을 덮어씁니다. 재정의한 규칙이 없으면 키를 삭제합니다.
+func (s *ChatSettingsStore) Save(ctx context.Context, chatID string, settings qmodel.ChatSettings) error {
+	if settings.IsDefault() {
+		return s.Delete(ctx, chatID)
+	}
+
+	payload, err := json.Marshal(settings)
+	if err != nil {
+		return cerrors.RedisError{Operation: "chat_settings_marshal", Err: err}
+	}
+	cmd := s.client.B().Set().Key(chatSettingsKey(chatID)).Value(string(payload)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "chat_settings_save", Err: err}
+	}
+	s.logger.Info("chat_settings_saved", "chat_id", chatID, "updated_by", settings.UpdatedBy)
+	return nil
+}
+
+// Delete: 방의 설정을 삭제해 기본 규칙으로 되돌립니다.
+func (s *ChatSettingsStore) Delete(ctx context.Context, chatID string) error {
+	cmd := s.client.B().Del().Key(chatSettingsKey(chatID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "chat_settings_delete", Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func TestChatSettingsStore_SaveGetDelete(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewChatSettingsStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	chatID := prefix + "room_settings"
+
+	got, err := store.Get(ctx, chatID)
+	if err != nil || !got.IsDefault() {
+		t.Fatalf("expected default settings, got %+v, %v", got, err)
+	}
+
+	settings := qmodel.ChatSettings{
+		MaxQuestions:      30,
+		MaxHints:          2,
+		AllowedCategories: []string{"food", "place"},
+		SurrenderVotes:    2,
+		UpdatedBy:         "admin",
+		UpdatedAt:         time.Now(),
+	}
+	if err := store.Save(ctx, chatID, settings); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	got, err = store.Get(ctx, chatID)
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if got.MaxQuestions != 30 || got.MaxHints != 2 || got.SurrenderVotes != 2 ||
+		!slices.Equal(got.AllowedCategories, []string{"food", "place"}) {
+		t.Fatalf("unexpected settings: %+v", got)
+	}
+
+	// 모든 값을 기본값으로 되돌리면 키가 삭제됨
+	if err := store.Save(ctx, chatID, qmodel.ChatSettings{UpdatedBy: "admin"}); err != nil {
+		t.Fatalf("save default failed: %v", err)
+	}
+	if n, _ := client.Do(ctx, client.B().Exists().Key(chatSettingsKey(chatID)).Build()).AsInt64(); n != 0 {
+		t.Fatal("default settings must not be stored")
+	}
+
+	if err := store.Save(ctx, chatID, settings); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if err := store.Delete(ctx, chatID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	got, _ = store.Get(ctx, chatID)
+	if !got.IsDefault() {
+		t.Fatalf("expected default after delete, got %+v", got)
+	}
+}
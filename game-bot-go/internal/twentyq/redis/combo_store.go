@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// ComboStore: 게임별 연속 '예' 콤보 상태(현재/최대 연속 수, 무료 힌트 수)를 저장하는 저장소
+// 갱신은 답변 처리와 같은 채팅방 락 안에서만 일어나므로 조회 후 덮어쓰기로 충분합니다.
+type ComboStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewComboStore: 새로운 ComboStore 인스턴스를 생성합니다.
+func NewComboStore(client valkey.Client, logger *slog.Logger) *ComboStore {
+	return &ComboStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 콤보 상태를 조회합니다. (기록이 없으면 빈 상태)
+func (s *ComboStore) Get(ctx context.Context, chatID string) (qmodel.ComboState, error) {
+	cmd := s.client.B().Get().Key(comboKey(chatID)).Build()
+	raw, err := s.client.Do(ctx, cmd).AsBytes()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return qmodel.ComboState{}, nil
+		}
+		return qmodel.ComboState{}, cerrors.RedisError{Operation: "combo_get", Err: err}
+	}
+
+	var out qmodel.ComboState
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return qmodel.ComboState{}, cerrors.RedisError{Operation: "combo_unmarshal", Err: err}
+	}
+	return out, nil
+}
+
+// Save: 콤보 상태를 덮어씁니다. TTL은 게임 세션과 같습니다.
+func (s *ComboStore) Save(ctx context.Context, chatID string, state qmodel.ComboState) error {
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal combo failed: %w", err)
+	}
+
+	cmd := s.client.B().Set().Key(comboKey(chatID)).Value(string(payload)).
+		Ex(time.Duration(qconfig.RedisSessionTTLSeconds) * time.Second).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "combo_save", Err: err}
+	}
+	return nil
+}
+
+// Delete: 콤보 상태를 삭제합니다. (게임 종료 시)
+func (s *ComboStore) Delete(ctx context.Context, chatID string) error {
+	cmd := s.client.B().Del().Key(comboKey(chatID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "combo_delete", Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func TestComboStore_SaveGetDelete(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewComboStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	chatID := prefix + "room_combo"
+
+	got, err := store.Get(ctx, chatID)
+	if err != nil || got != (qmodel.ComboState{}) {
+		t.Fatalf("expected empty combo, got %+v, %v", got, err)
+	}
+
+	state := qmodel.ComboState{Current: 1, Max: 4, Bonus: 1, Run: 4}
+	if err := store.Save(ctx, chatID, state); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if got, err = store.Get(ctx, chatID); err != nil || got != state {
+		t.Fatalf("expected %+v, got %+v, %v", state, got, err)
+	}
+
+	if err := store.Delete(ctx, chatID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if got, _ = store.Get(ctx, chatID); got != (qmodel.ComboState{}) {
+		t.Fatalf("expected combo deleted, got %+v", got)
+	}
+}
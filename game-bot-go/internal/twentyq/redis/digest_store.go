@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+)
+
+// digestSentTTL: 게시 완료 플래그 유지 기간 (주간 다이제스트 주기보다 길게)
+const digestSentTTL = 8 * 24 * time.Hour
+
+// DigestStore: 방별 리더보드 다이제스트 게시 여부를 기록하여 중복 게시(재시작/다중 인스턴스)를 막는 저장소
+type DigestStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewDigestStore: 새로운 DigestStore 인스턴스를 생성합니다.
+func NewDigestStore(client valkey.Client, logger *slog.Logger) *DigestStore {
+	return &DigestStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// MarkSent: 해당 기간/날짜의 다이제스트를 처음 게시하는 경우에만 true를 반환합니다. (SET NX)
+func (s *DigestStore) MarkSent(ctx context.Context, chatID string, period string, date string) (bool, error) {
+	cmd := s.client.B().Set().Key(digestSentKey(period, date, chatID)).Value("1").Nx().Ex(digestSentTTL).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		if valkeyx.IsNil(err) {
+			return false, nil
+		}
+		return false, cerrors.RedisError{Operation: "digest_mark_sent", Err: err}
+	}
+	return true, nil
+}
+
+// UnmarkSent: 게시에 실패한 경우 플래그를 지워 다음 주기에 다시 시도할 수 있게 합니다.
+func (s *DigestStore) UnmarkSent(ctx context.Context, chatID string, period string, date string) error {
+	cmd := s.client.B().Del().Key(digestSentKey(period, date, chatID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "digest_unmark_sent", Err: err}
+	}
+	s.logger.Debug("digest_unmarked", "chat_id", chatID, "period", period, "date", date)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/valkey-io/valkey-go"
@@ -14,7 +15,7 @@ import (
 )
 
 const (
-	guessRateLimitTTL = 30 * time.Second // 30초 제한
+	guessRateLimitTTL = 30 * time.Second // 기본 30초 제한 (런타임 설정으로 변경 가능)
 )
 
 // GuessRateLimiter: 정답 시도에 대한 개인별 Rate Limit를 관리합니다.
@@ -22,6 +23,8 @@ type GuessRateLimiter struct {
 	client   valkey.Client
 	prefix   string
 	registry *luautil.Registry
+
+	limitSeconds atomic.Int64
 }
 
 // NewGuessRateLimiter: 새로운 GuessRateLimiter를 생성합니다.
@@ -32,11 +35,20 @@ func NewGuessRateLimiter(client valkey.Client, prefix string) *GuessRateLimiter
 	preloadCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	_ = registry.Preload(preloadCtx, client)
-	return &GuessRateLimiter{
+	limiter := &GuessRateLimiter{
 		client:   client,
 		prefix:   prefix,
 		registry: registry,
 	}
+	limiter.limitSeconds.Store(int64(guessRateLimitTTL.Seconds()))
+	return limiter
+}
+
+// SetLimitSeconds: 정답 시도 제한 시간(초)을 바꿉니다. 이미 걸린 제한에는 영향을 주지 않습니다.
+func (r *GuessRateLimiter) SetLimitSeconds(seconds int) {
+	if seconds > 0 {
+		r.limitSeconds.Store(int64(seconds))
+	}
 }
 
 // guessRateLimitKey: 사용자별 Rate Limit 키를 생성합니다.
@@ -48,8 +60,7 @@ func (r *GuessRateLimiter) guessRateLimitKey(chatID, userID string) string {
 // 반환: (허용 여부, 남은 시간(초), 에러)
 func (r *GuessRateLimiter) CheckAndSet(ctx context.Context, chatID, userID string) (bool, int64, error) {
 	key := r.guessRateLimitKey(chatID, userID)
-	ttlSec := int64(guessRateLimitTTL.Seconds())
-	ttlArg := strconv.FormatInt(ttlSec, 10)
+	ttlArg := strconv.FormatInt(r.GetLimitSeconds(), 10)
 
 	// Lua 스크립트 실행 (1 RTT)
 	// 반환값: {allowed(1|0), remaining_ms}
@@ -75,7 +86,7 @@ func (r *GuessRateLimiter) CheckAndSet(ctx context.Context, chatID, userID strin
 
 // GetLimitSeconds: Rate Limit 제한 시간(초)을 반환합니다.
 func (r *GuessRateLimiter) GetLimitSeconds() int64 {
-	return int64(guessRateLimitTTL.Seconds())
+	return r.limitSeconds.Load()
 }
 
 // GetRemainingTime: 남은 Rate Limit 시간을 확인합니다.
@@ -0,0 +1,118 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// HotseatStore: 턴제 모드 상태(참가 순서, 현재 차례)와 방별 모드 설정을 저장하는 저장소
+// 차례 만료 감시기가 순회할 수 있도록 상태가 있는 방 목록을 별도 SET으로 관리합니다.
+type HotseatStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewHotseatStore: 새로운 HotseatStore 인스턴스를 생성합니다.
+func NewHotseatStore(client valkey.Client, logger *slog.Logger) *HotseatStore {
+	return &HotseatStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 진행 중인 게임의 턴제 상태를 조회합니다. (없으면 nil 반환)
+func (s *HotseatStore) Get(ctx context.Context, chatID string) (*qmodel.Hotseat, error) {
+	cmd := s.client.B().Get().Key(hotseatKey(chatID)).Build()
+	raw, err := s.client.Do(ctx, cmd).AsBytes()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "hotseat_get", Err: err}
+	}
+
+	var out qmodel.Hotseat
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, cerrors.RedisError{Operation: "hotseat_unmarshal", Err: err}
+	}
+	return &out, nil
+}
+
+// Save: 턴제 상태를 덮어쓰고 감시 대상 방 목록에 등록합니다. TTL은 게임 세션과 같습니다.
+func (s *HotseatStore) Save(ctx context.Context, chatID string, hotseat qmodel.Hotseat) error {
+	payload, err := json.Marshal(hotseat)
+	if err != nil {
+		return fmt.Errorf("marshal hotseat failed: %w", err)
+	}
+
+	results := s.client.DoMulti(ctx,
+		s.client.B().Set().Key(hotseatKey(chatID)).Value(string(payload)).
+			Ex(time.Duration(qconfig.RedisSessionTTLSeconds)*time.Second).Build(),
+		s.client.B().Sadd().Key(qconfig.RedisKeyHotseatRooms).Member(chatID).Build(),
+	)
+	for _, res := range results {
+		if err := res.Error(); err != nil {
+			return cerrors.RedisError{Operation: "hotseat_save", Err: err}
+		}
+	}
+	return nil
+}
+
+// Delete: 게임이 끝나거나 모드를 끄면 상태를 삭제하고 감시 대상에서 제외합니다.
+func (s *HotseatStore) Delete(ctx context.Context, chatID string) error {
+	results := s.client.DoMulti(ctx,
+		s.client.B().Del().Key(hotseatKey(chatID)).Build(),
+		s.client.B().Srem().Key(qconfig.RedisKeyHotseatRooms).Member(chatID).Build(),
+	)
+	for _, res := range results {
+		if err := res.Error(); err != nil {
+			return cerrors.RedisError{Operation: "hotseat_delete", Err: err}
+		}
+	}
+	return nil
+}
+
+// ActiveRooms: 턴제 상태가 저장된 방 목록을 반환합니다.
+// 세션 TTL로 상태만 만료된 방이 남아 있을 수 있으므로 호출 측에서 Get 결과를 확인해야 합니다.
+func (s *HotseatStore) ActiveRooms(ctx context.Context) ([]string, error) {
+	cmd := s.client.B().Smembers().Key(qconfig.RedisKeyHotseatRooms).Build()
+	rooms, err := s.client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, cerrors.RedisError{Operation: "hotseat_rooms", Err: err}
+	}
+	return rooms, nil
+}
+
+// ModeEnabled: 방에 턴제 모드가 켜져 있는지 확인합니다.
+func (s *HotseatStore) ModeEnabled(ctx context.Context, chatID string) (bool, error) {
+	cmd := s.client.B().Exists().Key(hotseatModeKey(chatID)).Build()
+	n, err := s.client.Do(ctx, cmd).AsInt64()
+	if err != nil {
+		return false, cerrors.RedisError{Operation: "hotseat_mode_get", Err: err}
+	}
+	return n > 0, nil
+}
+
+// SetMode: 방의 턴제 모드 설정을 저장합니다. (게임 종료 후에도 유지)
+func (s *HotseatStore) SetMode(ctx context.Context, chatID string, enabled bool) error {
+	key := hotseatModeKey(chatID)
+
+	cmd := s.client.B().Del().Key(key).Build()
+	if enabled {
+		cmd = s.client.B().Set().Key(key).Value("1").Build()
+	}
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "hotseat_mode_set", Err: err}
+	}
+	return nil
+}
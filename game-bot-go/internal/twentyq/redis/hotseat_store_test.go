@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func TestHotseatStore_StateAndMode(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewHotseatStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	chatID := prefix + "room_hotseat"
+
+	got, err := store.Get(ctx, chatID)
+	if err != nil || got != nil {
+		t.Fatalf("expected no hotseat, got %+v, %v", got, err)
+	}
+
+	var hotseat qmodel.Hotseat
+	hotseat.Join(qmodel.PlayerInfo{UserID: "u1", Sender: "Alice"}, time.Now())
+	hotseat.Join(qmodel.PlayerInfo{UserID: "u2", Sender: "Bob"}, time.Now())
+	hotseat.Advance(time.Now(), qmodel.HotseatTurnPassed)
+	if err := store.Save(ctx, chatID, hotseat); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	got, err = store.Get(ctx, chatID)
+	if err != nil || got == nil {
+		t.Fatalf("get failed: %+v, %v", got, err)
+	}
+	if p, _ := got.CurrentPlayer(); p.UserID != "u2" || got.Stats["u1"].Passes != 1 {
+		t.Fatalf("unexpected hotseat: %+v", got)
+	}
+
+	rooms, err := store.ActiveRooms(ctx)
+	if err != nil || !slices.Contains(rooms, chatID) {
+		t.Fatalf("expected room in active set, got %v, %v", rooms, err)
+	}
+
+	if err := store.Delete(ctx, chatID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	rooms, _ = store.ActiveRooms(ctx)
+	if slices.Contains(rooms, chatID) {
+		t.Fatal("deleted room must leave active set")
+	}
+
+	if enabled, _ := store.ModeEnabled(ctx, chatID); enabled {
+		t.Fatal("mode must be off by default")
+	}
+	if err := store.SetMode(ctx, chatID, true); err != nil {
+		t.Fatalf("set mode failed: %v", err)
+	}
+	if enabled, _ := store.ModeEnabled(ctx, chatID); !enabled {
+		t.Fatal("expected mode on")
+	}
+	_ = store.SetMode(ctx, chatID, false)
+	if enabled, _ := store.ModeEnabled(ctx, chatID); enabled {
+		t.Fatal("expected mode off")
+	}
+}
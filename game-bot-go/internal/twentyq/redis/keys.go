@@ -83,3 +83,75 @@ func lockKey(chatID string) string {
 func chainSkipFlagKey(chatID string, userID string) string {
 	return valkeyx.BuildKey3(qconfig.RedisKeyPendingPrefix, "chain_skip", chatID, userID)
 }
+
+// themeEventAnnouncedKey: 테마 이벤트 안내 완료 플래그 키를 생성합니다.
+// 형식: 20q:theme-events:announced:{eventID}:{chatID}
+func themeEventAnnouncedKey(eventID string, chatID string) string {
+	return valkeyx.BuildKey2(qconfig.RedisKeyThemeEventAnnounced, eventID, chatID)
+}
+
+// budgetHiddenKey: 방별 예산 줄 숨김 설정 키를 생성합니다. (TTL 없음)
+// 형식: 20q:settings:budget-hidden:{chatID}
+func budgetHiddenKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyBudgetHidden, chatID)
+}
+
+// openingOffKey: 방별 추천 첫 질문 숨김 설정 키를 생성합니다. (TTL 없음)
+// 형식: 20q:settings:opening-off:{chatID}
+func openingOffKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyOpeningOff, chatID)
+}
+
+// openingMinedKey: 추천 첫 질문 일일 집계 완료 플래그 키를 생성합니다.
+// 형식: 20q:opening:mined:{date}
+func openingMinedKey(date string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyOpeningMined, date)
+}
+
+// llmUsageKey: 진행 중인 게임의 LLM 사용량 누적 키를 생성합니다.
+// 형식: 20q:llm-usage:{chatID}
+func llmUsageKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyLLMUsage, chatID)
+}
+
+// digestSentKey: 리더보드 다이제스트 게시 완료 플래그 키를 생성합니다.
+// 형식: 20q:digest:sent:{period}:{date}:{chatID}
+func digestSentKey(period string, date string, chatID string) string {
+	return valkeyx.BuildKey3(qconfig.RedisKeyDigestSent, period, date, chatID)
+}
+
+// tournamentKey: 다중 라운드 토너먼트 상태 키를 생성합니다.
+// 형식: 20q:tournament:{chatID}
+func tournamentKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyTournament, chatID)
+}
+
+// hotseatKey: 진행 중인 게임의 턴제 상태 키를 생성합니다.
+// 형식: 20q:hotseat:{chatID}
+func hotseatKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyHotseat, chatID)
+}
+
+// hotseatModeKey: 방별 턴제 모드 설정 키를 생성합니다. (TTL 없음)
+// 형식: 20q:settings:hotseat:{chatID}
+func hotseatModeKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyHotseatMode, chatID)
+}
+
+// chatSettingsKey: 방별 게임 규칙 재정의 설정 키를 생성합니다. (TTL 없음)
+// 형식: 20q:settings:chat:{chatID}
+func chatSettingsKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyChatSettings, chatID)
+}
+
+// chatLocaleKey: 방별 메시지 언어 설정 키를 생성합니다. (TTL 없음)
+// 형식: 20q:settings:locale:{chatID}
+func chatLocaleKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyChatLocale, chatID)
+}
+
+// comboKey: 진행 중인 게임의 연속 '예' 콤보 상태 키를 생성합니다.
+// 형식: 20q:combo:{chatID}
+func comboKey(chatID string) string {
+	return valkeyx.BuildKey(qconfig.RedisKeyCombo, chatID)
+}
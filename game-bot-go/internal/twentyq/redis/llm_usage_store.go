@@ -0,0 +1,147 @@
+package redis
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// llmUsage 해시 필드 접미사: {task}|in, {task}|out, {task}|reason, {task}|calls, {task}|model
+const (
+	llmUsageFieldInput     = "in"
+	llmUsageFieldOutput    = "out"
+	llmUsageFieldReasoning = "reason"
+	llmUsageFieldCalls     = "calls"
+	llmUsageFieldModel     = "model"
+)
+
+// LLMUsageStore: 방에서 진행 중인 게임의 LLM 사용량을 작업별로 누적하는 저장소
+// llmrest.UsageSink를 구현하며, 귀속 키는 chatID입니다. 게임이 끝나면 Take로 꺼내 세션 기록에 붙입니다.
+type LLMUsageStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewLLMUsageStore: 새로운 LLMUsageStore 인스턴스를 생성합니다.
+func NewLLMUsageStore(client valkey.Client, logger *slog.Logger) *LLMUsageStore {
+	return &LLMUsageStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// RecordLLMUsage: RPC 한 건의 사용량을 더합니다. 실패해도 게임 진행에는 영향이 없으므로 로그만 남깁니다.
+func (s *LLMUsageStore) RecordLLMUsage(ctx context.Context, chatID string, task string, usage llmrest.CallUsage) {
+	key := llmUsageKey(chatID)
+	field := func(suffix string) string { return task + "|" + suffix }
+
+	cmds := valkey.Commands{
+		s.client.B().Hincrby().Key(key).Field(field(llmUsageFieldInput)).Increment(usage.InputTokens).Build(),
+		s.client.B().Hincrby().Key(key).Field(field(llmUsageFieldOutput)).Increment(usage.OutputTokens).Build(),
+		s.client.B().Hincrby().Key(key).Field(field(llmUsageFieldReasoning)).Increment(usage.ReasoningTokens).Build(),
+		s.client.B().Hincrby().Key(key).Field(field(llmUsageFieldCalls)).Increment(usage.Calls).Build(),
+	}
+	if usage.Model != "" {
+		cmds = append(cmds, s.client.B().Hset().Key(key).FieldValue().FieldValue(field(llmUsageFieldModel), usage.Model).Build())
+	}
+	cmds = append(cmds, s.client.B().Expire().Key(key).Seconds(int64(qconfig.RedisSessionTTLSeconds)).Build())
+
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			s.logger.Warn("llm_usage_record_failed", "chat_id", chatID, "task", task, "err", err)
+			return
+		}
+	}
+}
+
+// Reset: 방의 누적 사용량을 비웁니다. (새 게임 시작 시)
+func (s *LLMUsageStore) Reset(ctx context.Context, chatID string) error {
+	cmd := s.client.B().Del().Key(llmUsageKey(chatID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "llm_usage_reset", Err: err}
+	}
+	return nil
+}
+
+// Take: 방의 누적 사용량을 작업별로 꺼내고 지웁니다. (MULTI/EXEC로 조회와 삭제를 함께 수행)
+// 누적된 사용량이 없으면 nil을 반환합니다.
+func (s *LLMUsageStore) Take(ctx context.Context, chatID string) ([]qmodel.LLMTaskUsage, error) {
+	key := llmUsageKey(chatID)
+	resps := s.client.DoMulti(ctx,
+		s.client.B().Multi().Build(),
+		s.client.B().Hgetall().Key(key).Build(),
+		s.client.B().Del().Key(key).Build(),
+		s.client.B().Exec().Build(),
+	)
+	results, err := resps[3].ToArray()
+	if err != nil {
+		return nil, cerrors.RedisError{Operation: "llm_usage_take", Err: err}
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	fields, err := results[0].AsStrMap()
+	if err != nil {
+		return nil, cerrors.RedisError{Operation: "llm_usage_take", Err: err}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return parseLLMUsageFields(fields), nil
+}
+
+// parseLLMUsageFields: 해시 필드를 작업별 사용량으로 묶습니다. (작업 이름순)
+func parseLLMUsageFields(fields map[string]string) []qmodel.LLMTaskUsage {
+	byTask := make(map[string]*qmodel.LLMTaskUsage)
+	for field, value := range fields {
+		task, suffix, ok := strings.Cut(field, "|")
+		if !ok || task == "" {
+			continue
+		}
+		usage, exists := byTask[task]
+		if !exists {
+			usage = &qmodel.LLMTaskUsage{Task: task}
+			byTask[task] = usage
+		}
+		if suffix == llmUsageFieldModel {
+			usage.Model = value
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch suffix {
+		case llmUsageFieldInput:
+			usage.InputTokens = n
+		case llmUsageFieldOutput:
+			usage.OutputTokens = n
+		case llmUsageFieldReasoning:
+			usage.ReasoningTokens = n
+		case llmUsageFieldCalls:
+			usage.Calls = n
+		}
+	}
+
+	out := make([]qmodel.LLMTaskUsage, 0, len(byTask))
+	for _, usage := range byTask {
+		if usage.Calls > 0 {
+			out = append(out, *usage)
+		}
+	}
+	slices.SortFunc(out, func(a, b qmodel.LLMTaskUsage) int { return cmp.Compare(a.Task, b.Task) })
+	return out
+}
+
+// 컴파일 시점에 llmrest.UsageSink 구현 여부 확인
+var _ llmrest.UsageSink = (*LLMUsageStore)(nil)
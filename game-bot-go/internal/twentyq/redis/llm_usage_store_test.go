@@ -0,0 +1,84 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func newTestLLMUsageStore(t *testing.T) (*LLMUsageStore, valkey.Client) {
+	t.Helper()
+	client := testhelper.NewTestValkeyClient(t)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	return NewLLMUsageStore(client, logger), client
+}
+
+func TestLLMUsageStore_RecordAndTake(t *testing.T) {
+	store, client := newTestLLMUsageStore(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	ctx := context.Background()
+	chatID := prefix + "room_usage"
+
+	store.RecordLLMUsage(ctx, chatID, "AnswerQuestion", llmrest.CallUsage{InputTokens: 100, OutputTokens: 10, Calls: 1, Model: "gemini-3-flash"})
+	store.RecordLLMUsage(ctx, chatID, "AnswerQuestion", llmrest.CallUsage{InputTokens: 50, OutputTokens: 5, ReasoningTokens: 7, Calls: 1})
+	store.RecordLLMUsage(ctx, chatID, "GenerateHints", llmrest.CallUsage{InputTokens: 30, OutputTokens: 20, Calls: 2, Model: "gemini-3-pro"})
+
+	tasks, err := store.Take(ctx, chatID)
+	if err != nil {
+		t.Fatalf("Take failed: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d: %+v", len(tasks), tasks)
+	}
+
+	answer := tasks[0]
+	if answer.Task != "AnswerQuestion" || answer.InputTokens != 150 || answer.OutputTokens != 15 ||
+		answer.ReasoningTokens != 7 || answer.Calls != 2 || answer.Model != "gemini-3-flash" {
+		t.Errorf("unexpected AnswerQuestion usage: %+v", answer)
+	}
+	hints := tasks[1]
+	if hints.Task != "GenerateHints" || hints.Calls != 2 || hints.Model != "gemini-3-pro" {
+		t.Errorf("unexpected GenerateHints usage: %+v", hints)
+	}
+
+	// Take는 누적분을 지우므로 두 번째 호출은 비어 있어야 함
+	tasks, err = store.Take(ctx, chatID)
+	if err != nil {
+		t.Fatalf("second Take failed: %v", err)
+	}
+	if len(tasks) != 0 {
+		t.Errorf("expected no usage after take, got %+v", tasks)
+	}
+}
+
+func TestLLMUsageStore_Reset(t *testing.T) {
+	store, client := newTestLLMUsageStore(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	ctx := context.Background()
+	chatID := prefix + "room_usage_reset"
+
+	store.RecordLLMUsage(ctx, chatID, "SelectTopic", llmrest.CallUsage{InputTokens: 10, Calls: 1})
+	if err := store.Reset(ctx, chatID); err != nil {
+		t.Fatalf("Reset failed: %v", err)
+	}
+
+	tasks, err := store.Take(ctx, chatID)
+	if err != nil {
+		t.Fatalf("Take failed: %v", err)
+	}
+	if tasks != nil {
+		t.Errorf("expected nil after reset, got %+v", tasks)
+	}
+}
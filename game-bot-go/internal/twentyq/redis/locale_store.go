@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+)
+
+// LocaleStore: 방별 메시지 언어(ko/en/ja 등) 설정을 저장하는 저장소
+// 설정은 게임과 무관하게 유지되며, 기본 언어로 되돌리면 키를 삭제합니다.
+type LocaleStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewLocaleStore: 새로운 LocaleStore 인스턴스를 생성합니다.
+func NewLocaleStore(client valkey.Client, logger *slog.Logger) *LocaleStore {
+	return &LocaleStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 방에 설정된 언어 코드를 조회합니다. (설정이 없으면 빈 문자열)
+func (s *LocaleStore) Get(ctx context.Context, chatID string) (string, error) {
+	cmd := s.client.B().Get().Key(chatLocaleKey(chatID)).Build()
+	locale, err := s.client.Do(ctx, cmd).ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return "", nil
+		}
+		return "", cerrors.RedisError{Operation: "chat_locale_get", Err: err}
+	}
+	return locale, nil
+}
+
+// Set: 방의 언어 코드를 저장합니다.
+func (s *LocaleStore) Set(ctx context.Context, chatID string, locale string) error {
+	cmd := s.client.B().Set().Key(chatLocaleKey(chatID)).Value(locale).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "chat_locale_set", Err: err}
+	}
+	s.logger.Info("chat_locale_saved", "chat_id", chatID, "locale", locale)
+	return nil
+}
+
+// Delete: 방의 언어 설정을 삭제해 기본 언어로 되돌립니다.
+func (s *LocaleStore) Delete(ctx context.Context, chatID string) error {
+	cmd := s.client.B().Del().Key(chatLocaleKey(chatID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "chat_locale_delete", Err: err}
+	}
+	return nil
+}
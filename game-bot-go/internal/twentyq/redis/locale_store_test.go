@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func TestLocaleStore_SetGetDelete(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewLocaleStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	chatID := prefix + "room_locale"
+
+	if locale, err := store.Get(ctx, chatID); err != nil || locale != "" {
+		t.Fatalf("expected no locale, got %q, %v", locale, err)
+	}
+	if err := store.Set(ctx, chatID, "en"); err != nil {
+		t.Fatalf("set failed: %v", err)
+	}
+	if locale, err := store.Get(ctx, chatID); err != nil || locale != "en" {
+		t.Fatalf("expected en, got %q, %v", locale, err)
+	}
+	if err := store.Delete(ctx, chatID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if locale, _ := store.Get(ctx, chatID); locale != "" {
+		t.Fatalf("expected locale deleted, got %q", locale)
+	}
+}
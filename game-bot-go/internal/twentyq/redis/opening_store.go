@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// openingMinedTTL: 일일 집계 완료 플래그 유지 기간
+const openingMinedTTL = 48 * time.Hour
+
+// OpeningStore: 카테고리별 추천 첫 질문과 방별 표시 설정을 관리하는 저장소
+// 추천 목록은 단일 해시(20q:opening:suggestions)에 카테고리 → JSON 형태로 저장됩니다.
+type OpeningStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewOpeningStore: 새로운 OpeningStore 인스턴스를 생성합니다.
+func NewOpeningStore(client valkey.Client, logger *slog.Logger) *OpeningStore {
+	return &OpeningStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 카테고리의 추천 첫 질문 목록을 조회합니다. 집계 결과가 없으면 nil을 반환합니다.
+func (s *OpeningStore) Get(ctx context.Context, category string) ([]qmodel.OpeningSuggestion, error) {
+	cmd := s.client.B().Hget().Key(qconfig.RedisKeyOpeningSuggestions).Field(strings.TrimSpace(category)).Build()
+	raw, err := s.client.Do(ctx, cmd).ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "opening_get", Err: err}
+	}
+
+	var suggestions []qmodel.OpeningSuggestion
+	if err := json.Unmarshal([]byte(raw), &suggestions); err != nil {
+		return nil, cerrors.RedisError{Operation: "opening_unmarshal", Err: err}
+	}
+	return suggestions, nil
+}
+
+// ReplaceAll: 전체 추천 목록을 새 집계 결과로 교체합니다. (MULTI/EXEC로 원자적으로 교체)
+// 새 결과에 없는 카테고리는 목록에서 사라집니다.
+func (s *OpeningStore) ReplaceAll(ctx context.Context, byCategory map[string][]qmodel.OpeningSuggestion) error {
+	cmds := valkey.Commands{
+		s.client.B().Multi().Build(),
+		s.client.B().Del().Key(qconfig.RedisKeyOpeningSuggestions).Build(),
+	}
+	if len(byCategory) > 0 {
+		hset := s.client.B().Hset().Key(qconfig.RedisKeyOpeningSuggestions).FieldValue()
+		for category, suggestions := range byCategory {
+			data, err := json.Marshal(suggestions)
+			if err != nil {
+				return cerrors.RedisError{Operation: "opening_marshal", Err: err}
+			}
+			hset = hset.FieldValue(category, string(data))
+		}
+		cmds = append(cmds, hset.Build())
+	}
+	cmds = append(cmds, s.client.B().Exec().Build())
+
+	for _, resp := range s.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return cerrors.RedisError{Operation: "opening_replace", Err: err}
+		}
+	}
+	s.logger.Info("opening_suggestions_replaced", "categories", len(byCategory))
+	return nil
+}
+
+// MarkMined: 해당 날짜의 집계를 처음 실행하는 경우에만 true를 반환합니다. (SET NX)
+func (s *OpeningStore) MarkMined(ctx context.Context, date string) (bool, error) {
+	cmd := s.client.B().Set().Key(openingMinedKey(date)).Value("1").Nx().Ex(openingMinedTTL).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		if valkeyx.IsNil(err) {
+			return false, nil
+		}
+		return false, cerrors.RedisError{Operation: "opening_mark_mined", Err: err}
+	}
+	return true, nil
+}
+
+// UnmarkMined: 집계에 실패한 경우 플래그를 지워 다음 주기에 다시 시도할 수 있게 합니다.
+func (s *OpeningStore) UnmarkMined(ctx context.Context, date string) error {
+	cmd := s.client.B().Del().Key(openingMinedKey(date)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "opening_unmark_mined", Err: err}
+	}
+	return nil
+}
+
+// Enabled: 방에서 게임 시작 시 추천 첫 질문을 보여줄지 여부를 반환합니다. (기본값: 표시)
+func (s *OpeningStore) Enabled(ctx context.Context, chatID string) (bool, error) {
+	cmd := s.client.B().Exists().Key(openingOffKey(chatID)).Build()
+	n, err := s.client.Do(ctx, cmd).AsInt64()
+	if err != nil {
+		return false, cerrors.RedisError{Operation: "opening_enabled_get", Err: err}
+	}
+	return n == 0, nil
+}
+
+// SetEnabled: 방의 추천 첫 질문 표시 여부를 저장합니다. (게임 종료 후에도 유지)
+func (s *OpeningStore) SetEnabled(ctx context.Context, chatID string, enabled bool) error {
+	key := openingOffKey(chatID)
+
+	cmd := s.client.B().Set().Key(key).Value("1").Build()
+	if enabled {
+		cmd = s.client.B().Del().Key(key).Build()
+	}
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "opening_enabled_set", Err: err}
+	}
+	return nil
+}
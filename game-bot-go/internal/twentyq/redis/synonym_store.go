@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+)
+
+// SynonymStore: 관리자가 등록한 별칭 → 정답 매핑을 조회하는 저장소
+// 등록/삭제는 관리자 API가 같은 해시(20q:synonyms)에 직접 수행합니다.
+type SynonymStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewSynonymStore: 새로운 SynonymStore 인스턴스를 생성합니다.
+func NewSynonymStore(client valkey.Client, logger *slog.Logger) *SynonymStore {
+	return &SynonymStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Lookup: 별칭에 매핑된 정답을 조회합니다. (등록되지 않았으면 found=false)
+func (s *SynonymStore) Lookup(ctx context.Context, alias string) (string, bool, error) {
+	alias = strings.TrimSpace(alias)
+	if alias == "" {
+		return "", false, nil
+	}
+
+	cmd := s.client.B().Hget().Key(qconfig.RedisKeySynonyms).Field(alias).Build()
+	canonical, err := s.client.Do(ctx, cmd).ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return "", false, nil
+		}
+		return "", false, cerrors.RedisError{Operation: "synonym_lookup", Err: err}
+	}
+	return canonical, true, nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+)
+
+func TestSynonymStore_Lookup(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewSynonymStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	alias := prefix + "폰"
+	// 별칭 해시는 테스트 접두사가 없는 공용 키이므로 필드만 지움
+	defer func() {
+		_ = client.Do(context.Background(), client.B().Hdel().Key(qconfig.RedisKeySynonyms).Field(alias).Build()).Error()
+	}()
+
+	if _, found, err := store.Lookup(ctx, alias); err != nil || found {
+		t.Fatalf("expected no mapping, got found=%v, %v", found, err)
+	}
+
+	cmd := client.B().Hset().Key(qconfig.RedisKeySynonyms).FieldValue().FieldValue(alias, "스마트폰").Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
+		t.Fatalf("hset failed: %v", err)
+	}
+
+	canonical, found, err := store.Lookup(ctx, " "+alias+" ")
+	if err != nil || !found || canonical != "스마트폰" {
+		t.Fatalf("expected 스마트폰, got %q found=%v, %v", canonical, found, err)
+	}
+	if _, found, _ := store.Lookup(ctx, "  "); found {
+		t.Fatalf("blank alias must not match")
+	}
+}
@@ -0,0 +1,136 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// themeEventAnnounceGrace: 이벤트 종료 후에도 안내 플래그를 유지하는 여유 시간
+const themeEventAnnounceGrace = 24 * time.Hour
+
+// ThemeEventStore: 테마 이벤트 정의와 방별 안내 여부를 관리하는 저장소
+// 이벤트는 단일 해시(20q:theme-events)에 ID → JSON 형태로 저장됩니다.
+type ThemeEventStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewThemeEventStore: 새로운 ThemeEventStore 인스턴스를 생성합니다.
+func NewThemeEventStore(client valkey.Client, logger *slog.Logger) *ThemeEventStore {
+	return &ThemeEventStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Save: 이벤트를 생성하거나 갱신합니다.
+func (s *ThemeEventStore) Save(ctx context.Context, event qmodel.ThemeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return cerrors.RedisError{Operation: "theme_event_marshal", Err: err}
+	}
+
+	cmd := s.client.B().Hset().Key(qconfig.RedisKeyThemeEvents).FieldValue().FieldValue(event.ID, string(data)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "theme_event_save", Err: err}
+	}
+	s.logger.Info("theme_event_saved", "event_id", event.ID, "name", event.Name)
+	return nil
+}
+
+// Get: ID로 이벤트를 조회합니다. 없으면 nil을 반환합니다.
+func (s *ThemeEventStore) Get(ctx context.Context, eventID string) (*qmodel.ThemeEvent, error) {
+	cmd := s.client.B().Hget().Key(qconfig.RedisKeyThemeEvents).Field(strings.TrimSpace(eventID)).Build()
+	raw, err := s.client.Do(ctx, cmd).ToString()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "theme_event_get", Err: err}
+	}
+
+	var event qmodel.ThemeEvent
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		return nil, cerrors.RedisError{Operation: "theme_event_unmarshal", Err: err}
+	}
+	return &event, nil
+}
+
+// Delete: 이벤트를 삭제합니다. 삭제 여부를 반환합니다.
+func (s *ThemeEventStore) Delete(ctx context.Context, eventID string) (bool, error) {
+	cmd := s.client.B().Hdel().Key(qconfig.RedisKeyThemeEvents).Field(strings.TrimSpace(eventID)).Build()
+	removed, err := s.client.Do(ctx, cmd).AsInt64()
+	if err != nil {
+		return false, cerrors.RedisError{Operation: "theme_event_delete", Err: err}
+	}
+	return removed > 0, nil
+}
+
+// List: 전체 이벤트를 시작 시각 순으로 반환합니다.
+func (s *ThemeEventStore) List(ctx context.Context) ([]qmodel.ThemeEvent, error) {
+	cmd := s.client.B().Hvals().Key(qconfig.RedisKeyThemeEvents).Build()
+	values, err := s.client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, cerrors.RedisError{Operation: "theme_event_list", Err: err}
+	}
+
+	events := make([]qmodel.ThemeEvent, 0, len(values))
+	for _, raw := range values {
+		var event qmodel.ThemeEvent
+		if err := json.Unmarshal([]byte(raw), &event); err != nil {
+			s.logger.Warn("theme_event_decode_failed", "err", err)
+			continue
+		}
+		events = append(events, event)
+	}
+
+	slices.SortFunc(events, func(a, b qmodel.ThemeEvent) int {
+		return a.StartsAt.Compare(b.StartsAt)
+	})
+	return events, nil
+}
+
+// Active: 현재 진행 중인 이벤트를 반환합니다. 기간이 겹치면 가장 늦게 시작한 이벤트가 우선합니다.
+func (s *ThemeEventStore) Active(ctx context.Context, now time.Time) (*qmodel.ThemeEvent, error) {
+	events, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var active *qmodel.ThemeEvent
+	for i := range events {
+		if events[i].IsActive(now) {
+			active = &events[i]
+		}
+	}
+	return active, nil
+}
+
+// MarkAnnounced: 방에 이벤트 안내를 처음 표시하는 경우에만 true를 반환합니다. (SET NX)
+func (s *ThemeEventStore) MarkAnnounced(ctx context.Context, event qmodel.ThemeEvent, chatID string, now time.Time) (bool, error) {
+	ttl := event.EndsAt.Sub(now) + themeEventAnnounceGrace
+	if ttl <= 0 {
+		return false, nil
+	}
+
+	key := themeEventAnnouncedKey(event.ID, chatID)
+	cmd := s.client.B().Set().Key(key).Value("1").Nx().Ex(ttl).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		if valkeyx.IsNil(err) {
+			return false, nil
+		}
+		return false, cerrors.RedisError{Operation: "theme_event_mark_announced", Err: err}
+	}
+	return true, nil
+}
@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func TestThemeEventStore_SaveListActive(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewThemeEventStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	now := time.Now()
+
+	past := qmodel.ThemeEvent{ID: "past", Name: "지난 주간", StartsAt: now.Add(-72 * time.Hour), EndsAt: now.Add(-48 * time.Hour)}
+	horror := qmodel.ThemeEvent{ID: "horror", Name: "호러 주간", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+	for _, event := range []qmodel.ThemeEvent{horror, past} {
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	events, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(events) != 2 || events[0].ID != "past" {
+		t.Fatalf("expected events sorted by start, got %+v", events)
+	}
+
+	active, err := store.Active(ctx, now)
+	if err != nil {
+		t.Fatalf("Active failed: %v", err)
+	}
+	if active == nil || active.ID != "horror" {
+		t.Fatalf("expected horror active, got %+v", active)
+	}
+
+	removed, err := store.Delete(ctx, "horror")
+	if err != nil || !removed {
+		t.Fatalf("Delete failed: removed=%v err=%v", removed, err)
+	}
+	if active, _ := store.Active(ctx, now); active != nil {
+		t.Fatalf("expected no active event after delete, got %+v", active)
+	}
+	if got, _ := store.Get(ctx, "horror"); got != nil {
+		t.Fatalf("expected nil after delete, got %+v", got)
+	}
+}
+
+func TestThemeEventStore_MarkAnnouncedOncePerRoom(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	store := NewThemeEventStore(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	ctx := context.Background()
+	prefix := testhelper.UniqueTestPrefix(t)
+	now := time.Now()
+	event := qmodel.ThemeEvent{ID: "horror", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+
+	first, err := store.MarkAnnounced(ctx, event, prefix+"room1", now)
+	if err != nil || !first {
+		t.Fatalf("expected first announcement, got %v err=%v", first, err)
+	}
+	again, err := store.MarkAnnounced(ctx, event, prefix+"room1", now)
+	if err != nil || again {
+		t.Fatalf("expected duplicate announcement suppressed, got %v err=%v", again, err)
+	}
+	other, err := store.MarkAnnounced(ctx, event, prefix+"room2", now)
+	if err != nil || !other {
+		t.Fatalf("expected other room announced, got %v err=%v", other, err)
+	}
+}
@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	json "github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// TournamentStore: 채팅방별 다중 라운드 토너먼트 상태(라운드 진행, 누적 점수)를 저장하는 저장소
+// 라운드 사이에 세션이 비어 있어도 유지되도록 게임 세션과 별도 키/TTL을 사용합니다.
+type TournamentStore struct {
+	client valkey.Client
+	logger *slog.Logger
+}
+
+// NewTournamentStore: 새로운 TournamentStore 인스턴스를 생성합니다.
+func NewTournamentStore(client valkey.Client, logger *slog.Logger) *TournamentStore {
+	return &TournamentStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Get: 진행 중인 토너먼트를 조회합니다. (없으면 nil 반환)
+func (s *TournamentStore) Get(ctx context.Context, chatID string) (*qmodel.Tournament, error) {
+	cmd := s.client.B().Get().Key(tournamentKey(chatID)).Build()
+	raw, err := s.client.Do(ctx, cmd).AsBytes()
+	if err != nil {
+		if valkeyx.IsNil(err) {
+			return nil, nil
+		}
+		return nil, cerrors.RedisError{Operation: "tournament_get", Err: err}
+	}
+
+	var out qmodel.Tournament
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, cerrors.RedisError{Operation: "tournament_unmarshal", Err: err}
+	}
+	return &out, nil
+}
+
+// Save: 토너먼트 상태를 덮어쓰고 TTL을 갱신합니다. (라운드가 끝날 때마다 연장)
+func (s *TournamentStore) Save(ctx context.Context, chatID string, tournament qmodel.Tournament) error {
+	payload, err := json.Marshal(tournament)
+	if err != nil {
+		return fmt.Errorf("marshal tournament failed: %w", err)
+	}
+
+	cmd := s.client.B().Set().Key(tournamentKey(chatID)).Value(string(payload)).
+		Ex(time.Duration(qconfig.RedisTournamentTTLSeconds) * time.Second).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "tournament_save", Err: err}
+	}
+
+	s.logger.Debug("tournament_saved", "chat_id", chatID, "completed", tournament.CompletedRounds, "total", tournament.TotalRounds)
+	return nil
+}
+
+// Delete: 토너먼트가 끝나거나 취소되면 상태를 삭제합니다.
+func (s *TournamentStore) Delete(ctx context.Context, chatID string) error {
+	cmd := s.client.B().Del().Key(tournamentKey(chatID)).Build()
+	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+		return cerrors.RedisError{Operation: "tournament_delete", Err: err}
+	}
+	return nil
+}
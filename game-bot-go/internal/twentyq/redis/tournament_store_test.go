@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func newTestTournamentStore(t *testing.T) (*TournamentStore, valkey.Client) {
+	t.Helper()
+	client := testhelper.NewTestValkeyClient(t)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	return NewTournamentStore(client, logger), client
+}
+
+func TestTournamentStore_SaveGetDelete(t *testing.T) {
+	store, client := newTestTournamentStore(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	ctx := context.Background()
+	chatID := prefix + "room_tournament"
+
+	got, err := store.Get(ctx, chatID)
+	if err != nil || got != nil {
+		t.Fatalf("expected no tournament, got %+v, %v", got, err)
+	}
+
+	tour := qmodel.Tournament{TotalRounds: 3, Categories: []string{"음식"}, StartedBy: "user1"}
+	tour.RecordRound("user1", "Alice", 15)
+	if err := store.Save(ctx, chatID, tour); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	got, err = store.Get(ctx, chatID)
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if got == nil || got.CompletedRounds != 1 || len(got.Scores) != 1 || got.Scores[0].Points != 15 || got.Categories[0] != "음식" {
+		t.Fatalf("unexpected tournament: %+v", got)
+	}
+
+	ttl, err := client.Do(ctx, client.B().Ttl().Key(tournamentKey(chatID)).Build()).AsInt64()
+	if err != nil || ttl <= 0 {
+		t.Errorf("expected positive ttl, got %d (%v)", ttl, err)
+	}
+
+	if err := store.Delete(ctx, chatID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if got, _ := store.Get(ctx, chatID); got != nil {
+		t.Errorf("expected deleted tournament, got %+v", got)
+	}
+}
@@ -14,19 +14,22 @@ import (
 
 // WrongGuessStore: 사용자 및 세션별 오답 기록을 Redis에 저장하고 조회하는 저장소
 type WrongGuessStore struct {
-	client valkey.Client
-	logger *slog.Logger
+	client    valkey.Client
+	resilient *valkeyx.ResilientClient
+	logger    *slog.Logger
 }
 
 // NewWrongGuessStore: 새로운 WrongGuessStore 인스턴스를 생성합니다.
 func NewWrongGuessStore(client valkey.Client, logger *slog.Logger) *WrongGuessStore {
 	return &WrongGuessStore{
-		client: client,
-		logger: logger,
+		client:    client,
+		resilient: valkeyx.NewResilientClient(client, valkeyx.ResilienceConfig{}, logger),
+		logger:    logger,
 	}
 }
 
 // Add: 사용자 및 세션의 오답 기록을 추가합니다.
+// SADD/EXPIRE만 사용하므로 일시적 연결 장애 시 재연결 후 재실행됩니다.
 func (s *WrongGuessStore) Add(ctx context.Context, chatID string, userID string, guess string) error {
 	guess = strings.TrimSpace(guess)
 	if guess == "" {
@@ -38,12 +41,15 @@ func (s *WrongGuessStore) Add(ctx context.Context, chatID string, userID string,
 	ttl := int64(qconfig.RedisSessionTTLSeconds)
 
 	// DoMulti로 4개 명령을 단일 RTT로 처리
-	saddSessionCmd := s.client.B().Sadd().Key(sessionKey).Member(guess).Build()
-	saddUserCmd := s.client.B().Sadd().Key(userKey).Member(guess).Build()
-	expireSessionCmd := s.client.B().Expire().Key(sessionKey).Seconds(ttl).Build()
-	expireUserCmd := s.client.B().Expire().Key(userKey).Seconds(ttl).Build()
+	saddSessionCmd := s.resilient.B().Sadd().Key(sessionKey).Member(guess).Build()
+	saddUserCmd := s.resilient.B().Sadd().Key(userKey).Member(guess).Build()
+	expireSessionCmd := s.resilient.B().Expire().Key(sessionKey).Seconds(ttl).Build()
+	expireUserCmd := s.resilient.B().Expire().Key(userKey).Seconds(ttl).Build()
 
-	results := s.client.DoMulti(ctx, saddSessionCmd, saddUserCmd, expireSessionCmd, expireUserCmd)
+	results, err := s.resilient.DoMulti(ctx, saddSessionCmd, saddUserCmd, expireSessionCmd, expireUserCmd)
+	if err != nil {
+		return cerrors.RedisError{Operation: "wrong_guess_add", Err: err}
+	}
 	for _, r := range results {
 		if err := r.Error(); err != nil && !valkeyx.IsNil(err) {
 			return cerrors.RedisError{Operation: "wrong_guess_add", Err: err}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// HintFeedbackParams: 힌트 평가 기록 파라미터 구조체
+type HintFeedbackParams struct {
+	SessionID  string
+	ChatID     string
+	UserID     string
+	Category   string
+	HintNumber int
+	HintText   string
+	Useful     bool
+	Now        time.Time
+}
+
+// HintFeedbackStat: 카테고리별 유용/무용 평가 수 집계
+type HintFeedbackStat struct {
+	Category string
+	Useful   int
+	Useless  int
+}
+
+// UselessHint: 유용보다 무용 평가를 많이 받은 힌트 문구 집계
+type UselessHint struct {
+	Category string
+	HintText string
+	Useful   int
+	Useless  int
+}
+
+// RecordHintFeedback: 힌트 평가를 기록합니다. 같은 플레이어가 같은 힌트를 다시 평가하면 마지막 평가로 바꿉니다.
+func (r *Repository) RecordHintFeedback(ctx context.Context, p HintFeedbackParams) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("db is nil")
+	}
+
+	p.SessionID = strings.TrimSpace(p.SessionID)
+	p.UserID = strings.TrimSpace(p.UserID)
+	p.HintText = strings.TrimSpace(p.HintText)
+	if p.SessionID == "" || p.UserID == "" || p.HintText == "" || p.HintNumber <= 0 {
+		return fmt.Errorf("invalid hint feedback params")
+	}
+
+	entity := HintFeedback{
+		SessionID:  p.SessionID,
+		HintNumber: p.HintNumber,
+		UserID:     p.UserID,
+		ChatID:     strings.TrimSpace(p.ChatID),
+		Category:   strings.TrimSpace(p.Category),
+		HintText:   p.HintText,
+		Useful:     p.Useful,
+		CreatedAt:  p.Now,
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "session_id"},
+			{Name: "hint_number"},
+			{Name: "user_id"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"useful", "created_at"}),
+	}).Create(&entity).Error; err != nil {
+		return fmt.Errorf("upsert hint feedback failed: %w", err)
+	}
+	return nil
+}
+
+// HintFeedbackStats: since 이후 평가를 카테고리별로 집계합니다.
+func (r *Repository) HintFeedbackStats(ctx context.Context, since time.Time) ([]HintFeedbackStat, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+
+	var rows []HintFeedbackStat
+	err := r.db.WithContext(ctx).
+		Model(&HintFeedback{}).
+		Select("category, SUM(CASE WHEN useful THEN 1 ELSE 0 END) AS useful, SUM(CASE WHEN useful THEN 0 ELSE 1 END) AS useless").
+		Where("created_at >= ?", since).
+		Group("category").
+		Order("category").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("query hint feedback stats failed: %w", err)
+	}
+	return rows, nil
+}
+
+// UselessHints: since 이후 무용 평가가 유용 평가보다 많은 힌트 문구를 무용 평가 수 순으로 limit개 반환합니다.
+// category가 비어 있으면 전체 카테고리에서 고릅니다.
+func (r *Repository) UselessHints(ctx context.Context, since time.Time, category string, limit int) ([]UselessHint, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&HintFeedback{}).
+		Select("category, hint_text, SUM(CASE WHEN useful THEN 1 ELSE 0 END) AS useful, SUM(CASE WHEN useful THEN 0 ELSE 1 END) AS useless").
+		Where("created_at >= ?", since)
+	if category = strings.TrimSpace(category); category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	var rows []UselessHint
+	err := query.
+		Group("category, hint_text").
+		Having("SUM(CASE WHEN useful THEN 0 ELSE 1 END) > SUM(CASE WHEN useful THEN 1 ELSE 0 END)").
+		Order("useless DESC, hint_text").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("query useless hints failed: %w", err)
+	}
+	return rows, nil
+}
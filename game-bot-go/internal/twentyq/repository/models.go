@@ -14,27 +14,40 @@ type GameSession struct {
 	ParticipantCount int       `gorm:"column:participant_count;not null"`
 	QuestionCount    int       `gorm:"column:question_count;not null;default:0"`
 	HintCount        int       `gorm:"column:hint_count;not null;default:0"`
+	MaxCombo         int       `gorm:"column:max_combo;not null;default:0"` // 가장 길었던 연속 '예' 수 (콤보를 끈 방은 0)
 	CompletedAt      time.Time `gorm:"column:completed_at;not null;index:idx_game_sessions_room_stats,priority:2"`
 	CreatedAt        time.Time `gorm:"column:created_at;not null;autoCreateTime"`
+	// LLM 사용량 요약 (기록 이전 세션 또는 사용량을 받지 못한 세션은 0/NULL)
+	LLMTotalTokens int64   `gorm:"column:llm_total_tokens;not null;default:0"`
+	LLMCostUSD     float64 `gorm:"column:llm_cost_usd;not null;default:0"`
+	LLMUsageJSON   *string `gorm:"column:llm_usage_json;type:jsonb"`
+	// Season: 게임이 끝난 시점의 방 시즌 번호 (시즌 기능을 켜기 전 기록은 0)
+	Season int `gorm:"column:season;not null;default:0"`
 }
 
 func (GameSession) TableName() string { return "game_sessions" }
 
 // GameLog: 게임 로그 (참여자별 기록)
-// 복합 인덱스: idx_game_logs_activity (chat_id, completed_at, sender)
+// 복합 인덱스: idx_game_logs_activity (chat_id, completed_at, sender), idx_game_logs_season (chat_id, season)
 type GameLog struct {
-	ID              uint64    `gorm:"column:id;primaryKey;autoIncrement"`
-	ChatID          string    `gorm:"column:chat_id;not null;index:idx_game_logs_activity,priority:1"`
-	UserID          string    `gorm:"column:user_id;not null;index"`
-	Sender          string    `gorm:"column:sender;not null;default:'';index:idx_game_logs_activity,priority:3"`
-	Category        string    `gorm:"column:category;not null;index"`
-	QuestionCount   int       `gorm:"column:question_count;not null;default:0"`
-	HintCount       int       `gorm:"column:hint_count;not null;default:0"`
-	WrongGuessCount int       `gorm:"column:wrong_guess_count;not null;default:0"`
-	Result          string    `gorm:"column:result;not null;index"`
-	Target          *string   `gorm:"column:target"`
-	CompletedAt     time.Time `gorm:"column:completed_at;not null;index:idx_game_logs_activity,priority:2"`
-	CreatedAt       time.Time `gorm:"column:created_at;not null;autoCreateTime"`
+	ID              uint64  `gorm:"column:id;primaryKey;autoIncrement"`
+	ChatID          string  `gorm:"column:chat_id;not null;index:idx_game_logs_activity,priority:1;index:idx_game_logs_season,priority:1"`
+	UserID          string  `gorm:"column:user_id;not null;index"`
+	Sender          string  `gorm:"column:sender;not null;default:'';index:idx_game_logs_activity,priority:3"`
+	Category        string  `gorm:"column:category;not null;index"`
+	QuestionCount   int     `gorm:"column:question_count;not null;default:0"`
+	HintCount       int     `gorm:"column:hint_count;not null;default:0"`
+	WrongGuessCount int     `gorm:"column:wrong_guess_count;not null;default:0"`
+	Result          string  `gorm:"column:result;not null;index"`
+	Target          *string `gorm:"column:target"`
+	// 턴제 모드 차례 기록 (일반 게임은 0)
+	TurnCount    int   `gorm:"column:turn_count;not null;default:0"`
+	TurnMillis   int64 `gorm:"column:turn_millis;not null;default:0"`
+	TurnTimeouts int   `gorm:"column:turn_timeouts;not null;default:0"`
+	// Season: 시즌별 리더보드 집계용 방 시즌 번호 (시즌 기능을 켜기 전 기록은 0)
+	Season      int       `gorm:"column:season;not null;default:0;index:idx_game_logs_season,priority:2"`
+	CompletedAt time.Time `gorm:"column:completed_at;not null;index:idx_game_logs_activity,priority:2"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null;autoCreateTime"`
 }
 
 func (GameLog) TableName() string { return "game_logs" }
@@ -99,3 +112,64 @@ type RefundLog struct {
 }
 
 func (RefundLog) TableName() string { return "refund_logs" }
+
+// GameOpeningQuestion: 게임 초반 질문 기록 (카테고리별 추천 첫 질문 집계용)
+// 복합 인덱스: idx_game_opening_questions_mining (completed_at, category)
+type GameOpeningQuestion struct {
+	ID          uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	Category    string    `gorm:"column:category;not null;index:idx_game_opening_questions_mining,priority:2"`
+	Position    int       `gorm:"column:position;not null"`
+	Question    string    `gorm:"column:question;not null"`
+	Result      string    `gorm:"column:result;not null"`
+	CompletedAt time.Time `gorm:"column:completed_at;not null;index:idx_game_opening_questions_mining,priority:1"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null;autoCreateTime"`
+}
+
+func (GameOpeningQuestion) TableName() string { return "game_opening_questions" }
+
+// HintFeedback: 힌트에 대한 플레이어 평가 (유용/무용). 같은 플레이어가 같은 힌트를 다시 평가하면 덮어씁니다.
+// 복합 인덱스: idx_hint_feedback_aggregate (created_at, category)
+type HintFeedback struct {
+	ID         uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	SessionID  string    `gorm:"column:session_id;not null;uniqueIndex:idx_hint_feedback_vote,priority:1"`
+	HintNumber int       `gorm:"column:hint_number;not null;uniqueIndex:idx_hint_feedback_vote,priority:2"`
+	UserID     string    `gorm:"column:user_id;not null;uniqueIndex:idx_hint_feedback_vote,priority:3"`
+	ChatID     string    `gorm:"column:chat_id;not null;index"`
+	Category   string    `gorm:"column:category;not null;index:idx_hint_feedback_aggregate,priority:2"`
+	HintText   string    `gorm:"column:hint_text;not null"`
+	Useful     bool      `gorm:"column:useful;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null;index:idx_hint_feedback_aggregate,priority:1"`
+}
+
+func (HintFeedback) TableName() string { return "hint_feedback" }
+
+// Season: 방별 시즌. 번호는 방마다 1부터 증가하며 ClosedAt이 비어 있으면 진행 중입니다.
+// 관리자가 조기 종료하면 ClosedAt이 EndsAt보다 앞섭니다.
+type Season struct {
+	ID          uint64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ChatID      string     `gorm:"column:chat_id;not null;uniqueIndex:idx_seasons_chat_number,priority:1" json:"chatId"`
+	Number      int        `gorm:"column:number;not null;uniqueIndex:idx_seasons_chat_number,priority:2" json:"number"`
+	StartedAt   time.Time  `gorm:"column:started_at;not null" json:"startedAt"`
+	EndsAt      time.Time  `gorm:"column:ends_at;not null;index" json:"endsAt"`
+	ClosedAt    *time.Time `gorm:"column:closed_at;index" json:"closedAt,omitempty"`
+	AnnouncedAt *time.Time `gorm:"column:announced_at" json:"announcedAt,omitempty"`
+	CreatedAt   time.Time  `gorm:"column:created_at;not null;autoCreateTime" json:"createdAt"`
+}
+
+func (Season) TableName() string { return "seasons" }
+
+// SeasonStanding: 종료된 시즌의 최종 순위 (시즌을 닫을 때 game_logs에서 집계해 보관)
+type SeasonStanding struct {
+	ID           uint64    `gorm:"column:id;primaryKey;autoIncrement" json:"-"`
+	SeasonID     uint64    `gorm:"column:season_id;not null;uniqueIndex:idx_season_standings_season_user,priority:1" json:"seasonId"`
+	ChatID       string    `gorm:"column:chat_id;not null;index" json:"chatId"`
+	UserID       string    `gorm:"column:user_id;not null;uniqueIndex:idx_season_standings_season_user,priority:2" json:"userId"`
+	Sender       string    `gorm:"column:sender;not null;default:''" json:"sender"`
+	Rank         int       `gorm:"column:rank;not null" json:"rank"`
+	Games        int       `gorm:"column:games;not null" json:"games"`
+	Wins         int       `gorm:"column:wins;not null" json:"wins"`
+	AvgQuestions float64   `gorm:"column:avg_questions;not null;default:0" json:"avgQuestions"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null;autoCreateTime" json:"-"`
+}
+
+func (SeasonStanding) TableName() string { return "season_standings" }
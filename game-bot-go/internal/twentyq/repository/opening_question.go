@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// OpeningQuestionParams: 게임 초반 질문 기록 파라미터 구조체
+type OpeningQuestionParams struct {
+	Category    string
+	Result      GameResult
+	Questions   []string // 질문 순서대로 (정규화된 문장)
+	CompletedAt time.Time
+	Now         time.Time
+}
+
+// OpeningQuestionStat: 카테고리·질문별 게임 수와 정답 수 집계
+type OpeningQuestionStat struct {
+	Category string
+	Question string
+	Games    int
+	Wins     int
+}
+
+// RecordOpeningQuestions: 한 게임의 초반 질문들을 순서와 함께 기록합니다.
+func (r *Repository) RecordOpeningQuestions(ctx context.Context, p OpeningQuestionParams) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("db is nil")
+	}
+
+	p.Category = strings.TrimSpace(p.Category)
+	p.Result = GameResult(strings.TrimSpace(string(p.Result)))
+	if p.Category == "" || p.Result == "" {
+		return nil
+	}
+
+	entities := make([]GameOpeningQuestion, 0, len(p.Questions))
+	for i, q := range p.Questions {
+		q = strings.TrimSpace(q)
+		if q == "" {
+			continue
+		}
+		entities = append(entities, GameOpeningQuestion{
+			Category:    p.Category,
+			Position:    i + 1,
+			Question:    q,
+			Result:      string(p.Result),
+			CompletedAt: p.CompletedAt,
+			CreatedAt:   p.Now,
+		})
+	}
+	if len(entities) == 0 {
+		return nil
+	}
+
+	if err := r.db.WithContext(ctx).Create(&entities).Error; err != nil {
+		return fmt.Errorf("record opening questions failed: %w", err)
+	}
+	return nil
+}
+
+// OpeningQuestionStats: since 이후 완료된 게임의 초반 질문을 카테고리·질문별로 집계합니다.
+func (r *Repository) OpeningQuestionStats(ctx context.Context, since time.Time) ([]OpeningQuestionStat, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+
+	var rows []OpeningQuestionStat
+	err := r.db.WithContext(ctx).
+		Model(&GameOpeningQuestion{}).
+		Select("category, question, COUNT(*) AS games, SUM(CASE WHEN result = ? THEN 1 ELSE 0 END) AS wins", string(GameResultCorrect)).
+		Where("completed_at >= ?", since).
+		Group("category, question").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("query opening question stats failed: %w", err)
+	}
+	return rows, nil
+}
+
+// PruneOpeningQuestions: before 이전에 완료된 게임의 초반 질문 기록을 삭제합니다.
+func (r *Repository) PruneOpeningQuestions(ctx context.Context, before time.Time) (int64, error) {
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("db is nil")
+	}
+
+	result := r.db.WithContext(ctx).Where("completed_at < ?", before).Delete(&GameOpeningQuestion{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("prune opening questions failed: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
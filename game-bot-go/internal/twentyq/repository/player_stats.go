@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PlayerCategoryStat: 한 방에서 플레이어 한 명의 카테고리별 참여/정답 횟수 (game_logs 집계)
+type PlayerCategoryStat struct {
+	Category string `gorm:"column:category"`
+	Games    int    `gorm:"column:games"`
+	Wins     int    `gorm:"column:wins"`
+}
+
+// PlayerRank: 방 안에서의 플레이어 순위. 기록이 없는 플레이어는 Rank가 0입니다.
+type PlayerRank struct {
+	Rank    int
+	Players int
+}
+
+// PlayerCategoryStats: 방에서 플레이어의 카테고리별 기록을 참여 판수가 많은 순으로 반환합니다.
+// 정답은 시즌 순위와 같이 정답자로 기록된 판(target이 있는 로그)만 셉니다.
+func (r *Repository) PlayerCategoryStats(ctx context.Context, chatID string, userID string) ([]PlayerCategoryStat, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("db is nil")
+	}
+
+	var rows []PlayerCategoryStat
+	err := r.db.WithContext(ctx).
+		Model(&GameLog{}).
+		Select("upper(category) as category, count(*) as games, "+
+			"sum(case when target is not null then 1 else 0 end) as wins").
+		Where("chat_id = ? AND user_id = ?", strings.TrimSpace(chatID), strings.TrimSpace(userID)).
+		Group("upper(category)").
+		Order("games DESC, wins DESC, category ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("aggregate player category stats failed: %w", err)
+	}
+	return rows, nil
+}
+
+// PlayerRankInChat: 방 전체 기간 기록으로 플레이어 순위를 계산합니다.
+// 리더보드와 같이 정답 횟수 → 참여 판수 순이며, 두 값이 모두 같으면 같은 순위입니다.
+func (r *Repository) PlayerRankInChat(ctx context.Context, chatID string, userID string) (PlayerRank, error) {
+	if r == nil || r.db == nil {
+		return PlayerRank{}, fmt.Errorf("db is nil")
+	}
+	chatID = strings.TrimSpace(chatID)
+	userID = strings.TrimSpace(userID)
+
+	db := r.db.WithContext(ctx)
+	perPlayer := db.Model(&GameLog{}).
+		Select("user_id, count(*) as games, sum(case when target is not null then 1 else 0 end) as wins").
+		Where("chat_id = ?", chatID).
+		Group("user_id")
+
+	var self struct {
+		Games int `gorm:"column:games"`
+		Wins  int `gorm:"column:wins"`
+	}
+	if err := db.Table("(?) as players", perPlayer).
+		Select("games, wins").
+		Where("user_id = ?", userID).
+		Scan(&self).Error; err != nil {
+		return PlayerRank{}, fmt.Errorf("aggregate player record failed: %w", err)
+	}
+
+	var result struct {
+		Players int `gorm:"column:players"`
+		Ahead   int `gorm:"column:ahead"`
+	}
+	if err := db.Table("(?) as players", perPlayer).
+		Select("count(*) as players, "+
+			"coalesce(sum(case when wins > ? or (wins = ? and games > ?) then 1 else 0 end), 0) as ahead",
+			self.Wins, self.Wins, self.Games).
+		Scan(&result).Error; err != nil {
+		return PlayerRank{}, fmt.Errorf("aggregate player rank failed: %w", err)
+	}
+
+	rank := PlayerRank{Players: result.Players}
+	if self.Games > 0 {
+		rank.Rank = result.Ahead + 1
+	}
+	return rank, nil
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/latency"
 )
 
 // Repository: DB 접근을 위한 GORM 기반 리포지토리
@@ -17,6 +19,10 @@ import (
 //   - game_stats.go: 게임 시작/완료 통계
 //   - category_stats.go: 카테고리별 통계 JSON
 //   - session_log.go: 세션/로그 기록
+//   - opening_question.go: 초반 질문 기록/집계
+//   - hint_feedback.go: 힌트 평가 기록/집계
+//   - season.go: 방별 시즌 진행/종료/순위 보관
+//   - player_stats.go: 개인 전적용 카테고리별 기록/방 순위 집계
 type Repository struct {
 	db *gorm.DB
 }
@@ -26,17 +32,26 @@ func New(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
+// Models: AutoMigrate 대상 모델 목록 (헬스체크의 스키마 점검도 같은 목록을 기준으로 합니다)
+func Models() []any {
+	return append([]any{
+		&GameSession{},
+		&GameLog{},
+		&UserStats{},
+		&UserNicknameMap{},
+		&GameOpeningQuestion{},
+		&HintFeedback{},
+		&Season{},
+		&SeasonStanding{},
+	}, latency.Models()...)
+}
+
 // AutoMigrate: 자동으로 DB 테이블 스키마를 마이그레이션합니다.
 func (r *Repository) AutoMigrate(ctx context.Context) error {
 	if r == nil || r.db == nil {
 		return fmt.Errorf("db is nil")
 	}
-	if err := r.db.WithContext(ctx).AutoMigrate(
-		&GameSession{},
-		&GameLog{},
-		&UserStats{},
-		&UserNicknameMap{},
-	); err != nil {
+	if err := r.db.WithContext(ctx).AutoMigrate(Models()...); err != nil {
 		return fmt.Errorf("auto migrate failed: %w", err)
 	}
 	return nil
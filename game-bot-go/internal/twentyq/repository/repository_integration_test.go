@@ -0,0 +1,159 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testhelper.RunIntegrationMain(m))
+}
+
+func newIntegrationRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	repo := New(testhelper.NewIntegrationGormDB(t))
+	if err := repo.AutoMigrate(context.Background()); err != nil {
+		t.Fatalf("auto migrate failed: %v", err)
+	}
+	return repo
+}
+
+func TestRecordGameCompletion_PostgresCategoryStats(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	if err := repo.RecordGameStart(ctx, "room", "user", now); err != nil {
+		t.Fatalf("record start failed: %v", err)
+	}
+
+	target := "호랑이"
+	games := []GameCompletionParams{
+		{Result: GameResultCorrect, QuestionCount: 12, HintCount: 1, TotalGameQuestionCount: 12, Target: &target},
+		{Result: GameResultCorrect, QuestionCount: 8, HintCount: 0, TotalGameQuestionCount: 8, Target: &target},
+		{Result: GameResultSurrender, QuestionCount: 20, HintCount: 2, TotalGameQuestionCount: 20},
+	}
+	for _, g := range games {
+		g.ChatID = "room"
+		g.UserID = "user"
+		g.Category = "animal"
+		g.CompletedAt = now
+		g.Now = now
+		if err := repo.RecordGameCompletion(ctx, g); err != nil {
+			t.Fatalf("record completion failed: %v", err)
+		}
+	}
+
+	var stats UserStats
+	if err := repo.db.WithContext(ctx).First(&stats, "id = ?", CompositeUserStatsID("room", "user")).Error; err != nil {
+		t.Fatalf("load stats failed: %v", err)
+	}
+	if stats.TotalGamesCompleted != 3 || stats.TotalSurrenders != 1 {
+		t.Fatalf("unexpected totals: completed=%d surrenders=%d", stats.TotalGamesCompleted, stats.TotalSurrenders)
+	}
+	if stats.CategoryStatsJSON == nil {
+		t.Fatal("expected category stats json")
+	}
+
+	var byCategory map[string]categoryStat
+	if err := json.Unmarshal([]byte(*stats.CategoryStatsJSON), &byCategory); err != nil {
+		t.Fatalf("unmarshal category stats failed: %v", err)
+	}
+	animal, ok := byCategory["ANIMAL"]
+	if !ok {
+		t.Fatalf("missing ANIMAL entry: %v", byCategory)
+	}
+	if animal.GamesCompleted != 3 || animal.Surrenders != 1 || animal.QuestionsAsked != 40 || animal.HintsUsed != 3 {
+		t.Fatalf("unexpected category stats: %+v", animal)
+	}
+	if animal.BestQuestionCount == nil || *animal.BestQuestionCount != 8 {
+		t.Fatalf("unexpected best question count: %v", animal.BestQuestionCount)
+	}
+}
+
+func TestRecordGameCompletion_ConcurrentUpserts(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	const workers = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repo.RecordGameCompletion(ctx, GameCompletionParams{
+				ChatID:                 "room",
+				UserID:                 "user",
+				Category:               "food",
+				Result:                 GameResultSurrender,
+				QuestionCount:          1,
+				TotalGameQuestionCount: 1,
+				CompletedAt:            now,
+				Now:                    now,
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent completion failed: %v", err)
+		}
+	}
+
+	var stats UserStats
+	if err := repo.db.WithContext(ctx).First(&stats, "id = ?", CompositeUserStatsID("room", "user")).Error; err != nil {
+		t.Fatalf("load stats failed: %v", err)
+	}
+	if stats.TotalGamesCompleted != workers || stats.TotalQuestionsAsked != workers {
+		t.Fatalf("lost updates: completed=%d questions=%d", stats.TotalGamesCompleted, stats.TotalQuestionsAsked)
+	}
+}
+
+func TestHintFeedback_UpsertAndAggregate(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	votes := []HintFeedbackParams{
+		{SessionID: "s1", UserID: "u1", HintNumber: 1, HintText: "색깔이 있습니다", Useful: true},
+		{SessionID: "s1", UserID: "u1", HintNumber: 1, HintText: "색깔이 있습니다", Useful: false}, // 같은 플레이어 재평가
+		{SessionID: "s1", UserID: "u2", HintNumber: 1, HintText: "색깔이 있습니다", Useful: false},
+		{SessionID: "s1", UserID: "u2", HintNumber: 2, HintText: "네 발로 걷습니다", Useful: true},
+	}
+	for _, v := range votes {
+		v.ChatID = "room"
+		v.Category = "animal"
+		v.Now = now
+		if err := repo.RecordHintFeedback(ctx, v); err != nil {
+			t.Fatalf("record hint feedback failed: %v", err)
+		}
+	}
+
+	stats, err := repo.HintFeedbackStats(ctx, now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("stats failed: %v", err)
+	}
+	if len(stats) != 1 || stats[0].Useful != 1 || stats[0].Useless != 2 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	useless, err := repo.UselessHints(ctx, now.Add(-time.Hour), "animal", 5)
+	if err != nil {
+		t.Fatalf("useless hints failed: %v", err)
+	}
+	if len(useless) != 1 || useless[0].HintText != "색깔이 있습니다" || useless[0].Useless != 2 {
+		t.Fatalf("unexpected useless hints: %+v", useless)
+	}
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CurrentSeason: at 시점에 진행 중인 방의 시즌을 반환하고, 없으면 새 시즌을 엽니다.
+// 직전 시즌이 끝난(또는 조기 종료된) 시각부터 이어서 열며, 한 시즌 길이 이상 게임이 없던 방은 at부터 새로 시작합니다.
+// 동시에 여러 게임이 끝나도 (chat_id, number) 유니크 인덱스로 같은 번호의 시즌은 하나만 만들어집니다.
+func (r *Repository) CurrentSeason(ctx context.Context, chatID string, at time.Time, length time.Duration) (Season, error) {
+	if r == nil || r.db == nil {
+		return Season{}, fmt.Errorf("db is nil")
+	}
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" || length <= 0 {
+		return Season{}, fmt.Errorf("invalid season params")
+	}
+	at = at.UTC()
+
+	latest, err := r.LatestSeason(ctx, chatID)
+	if err != nil {
+		return Season{}, err
+	}
+	if latest != nil && latest.ClosedAt == nil && at.Before(latest.EndsAt) {
+		return *latest, nil
+	}
+
+	next := Season{ChatID: chatID, Number: 1, StartedAt: at}
+	if latest != nil {
+		next.Number = latest.Number + 1
+		boundary := latest.EndsAt
+		if latest.ClosedAt != nil && latest.ClosedAt.Before(boundary) {
+			boundary = *latest.ClosedAt
+		}
+		if at.Sub(boundary) < length {
+			next.StartedAt = boundary.UTC()
+		}
+	}
+	next.EndsAt = next.StartedAt.Add(length)
+
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&next).Error; err != nil {
+		return Season{}, fmt.Errorf("create season failed: %w", err)
+	}
+
+	// 다른 인스턴스가 먼저 만들었을 수 있으므로 다시 조회
+	created, err := r.LatestSeason(ctx, chatID)
+	if err != nil {
+		return Season{}, err
+	}
+	if created == nil {
+		return Season{}, fmt.Errorf("season not found after create")
+	}
+	return *created, nil
+}
+
+// LatestSeason: 방의 가장 최근 시즌을 반환합니다. 시즌이 없으면 nil입니다.
+func (r *Repository) LatestSeason(ctx context.Context, chatID string) (*Season, error) {
+	var season Season
+	err := r.db.WithContext(ctx).
+		Where("chat_id = ?", strings.TrimSpace(chatID)).
+		Order("number DESC").
+		Take(&season).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get latest season failed: %w", err)
+	}
+	return &season, nil
+}
+
+// GetSeason: ID로 시즌을 조회합니다. 없으면 nil입니다.
+func (r *Repository) GetSeason(ctx context.Context, id uint64) (*Season, error) {
+	var season Season
+	err := r.db.WithContext(ctx).Where("id = ?", id).Take(&season).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get season failed: %w", err)
+	}
+	return &season, nil
+}
+
+// ListSeasons: 시즌 목록을 최근 순으로 조회합니다. chatID가 비어 있으면 모든 방을 대상으로 합니다.
+func (r *Repository) ListSeasons(ctx context.Context, chatID string, limit int, offset int) ([]Season, int64, error) {
+	query := r.db.WithContext(ctx).Model(&Season{})
+	if chatID = strings.TrimSpace(chatID); chatID != "" {
+		query = query.Where("chat_id = ?", chatID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("count seasons failed: %w", err)
+	}
+
+	var seasons []Season
+	if err := query.Order("started_at DESC, id DESC").Limit(limit).Offset(offset).Find(&seasons).Error; err != nil {
+		return nil, 0, fmt.Errorf("list seasons failed: %w", err)
+	}
+	return seasons, total, nil
+}
+
+// DueSeasons: 종료 시각이 지났지만 아직 닫지 않은 시즌을 조회합니다.
+func (r *Repository) DueSeasons(ctx context.Context, now time.Time) ([]Season, error) {
+	var seasons []Season
+	if err := r.db.WithContext(ctx).
+		Where("closed_at IS NULL AND ends_at <= ?", now.UTC()).
+		Order("ends_at ASC").
+		Find(&seasons).Error; err != nil {
+		return nil, fmt.Errorf("query due seasons failed: %w", err)
+	}
+	return seasons, nil
+}
+
+// UnannouncedSeasons: since 이후에 닫혔지만 종료 안내를 보내지 못한 시즌을 조회합니다.
+func (r *Repository) UnannouncedSeasons(ctx context.Context, since time.Time) ([]Season, error) {
+	var seasons []Season
+	if err := r.db.WithContext(ctx).
+		Where("closed_at IS NOT NULL AND closed_at >= ? AND announced_at IS NULL", since.UTC()).
+		Order("closed_at ASC").
+		Find(&seasons).Error; err != nil {
+		return nil, fmt.Errorf("query unannounced seasons failed: %w", err)
+	}
+	return seasons, nil
+}
+
+// SeasonLeaderboard: 시즌 번호로 태그된 game_logs를 집계해 순위를 계산합니다. (진행 중 시즌 조회와 종료 시 보관에 공통 사용)
+// 정답 횟수 → 참여 판수 → 평균 질문 수(적을수록 위) 순이며, limit이 0 이하면 전체를 반환합니다.
+func (r *Repository) SeasonLeaderboard(ctx context.Context, chatID string, number int, limit int) ([]SeasonStanding, error) {
+	return seasonLeaderboard(r.db.WithContext(ctx), chatID, number, limit)
+}
+
+func seasonLeaderboard(db *gorm.DB, chatID string, number int, limit int) ([]SeasonStanding, error) {
+	query := db.Model(&GameLog{}).
+		Select("user_id, max(sender) as sender, count(*) as games, "+
+			"sum(case when target is not null then 1 else 0 end) as wins, "+
+			"avg(question_count) as avg_questions").
+		Where("chat_id = ? AND season = ?", strings.TrimSpace(chatID), number).
+		Group("user_id").
+		Order("wins DESC, games DESC, avg_questions ASC, user_id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var standings []SeasonStanding
+	if err := query.Scan(&standings).Error; err != nil {
+		return nil, fmt.Errorf("aggregate season leaderboard failed: %w", err)
+	}
+	for i := range standings {
+		standings[i].ChatID = strings.TrimSpace(chatID)
+		standings[i].Rank = i + 1
+	}
+	return standings, nil
+}
+
+// CloseSeason: 시즌을 닫고 최종 순위를 보관합니다. 이미 닫힌 시즌이면 false를 반환합니다.
+// 닫기 표시와 순위 보관을 한 트랜잭션으로 처리하므로 여러 인스턴스가 동시에 닫아도 한 번만 보관됩니다.
+func (r *Repository) CloseSeason(ctx context.Context, season Season, closedAt time.Time) (bool, error) {
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("db is nil")
+	}
+
+	closed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Season{}).
+			Where("id = ? AND closed_at IS NULL", season.ID).
+			Update("closed_at", closedAt.UTC())
+		if result.Error != nil {
+			return fmt.Errorf("mark season closed failed: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		closed = true
+
+		standings, err := seasonLeaderboard(tx, season.ChatID, season.Number, 0)
+		if err != nil {
+			return err
+		}
+		if len(standings) == 0 {
+			return nil
+		}
+		for i := range standings {
+			standings[i].SeasonID = season.ID
+		}
+		if err := tx.CreateInBatches(standings, 200).Error; err != nil {
+			return fmt.Errorf("archive season standings failed: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return closed, nil
+}
+
+// SeasonStandings: 보관된 시즌 최종 순위를 조회합니다. limit이 0 이하면 전체를 반환합니다.
+func (r *Repository) SeasonStandings(ctx context.Context, seasonID uint64, limit int) ([]SeasonStanding, error) {
+	query := r.db.WithContext(ctx).Where("season_id = ?", seasonID).Order("rank ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var standings []SeasonStanding
+	if err := query.Find(&standings).Error; err != nil {
+		return nil, fmt.Errorf("get season standings failed: %w", err)
+	}
+	return standings, nil
+}
+
+// MarkSeasonAnnounced: 종료 안내를 처음 보내는 경우에만 true를 반환합니다. (중복 게시 방지)
+func (r *Repository) MarkSeasonAnnounced(ctx context.Context, seasonID uint64, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&Season{}).
+		Where("id = ? AND announced_at IS NULL", seasonID).
+		Update("announced_at", at.UTC())
+	if result.Error != nil {
+		return false, fmt.Errorf("mark season announced failed: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UnmarkSeasonAnnounced: 안내 게시에 실패한 경우 표시를 지워 다음 주기에 다시 시도할 수 있게 합니다.
+func (r *Repository) UnmarkSeasonAnnounced(ctx context.Context, seasonID uint64) error {
+	if err := r.db.WithContext(ctx).Model(&Season{}).
+		Where("id = ?", seasonID).
+		Update("announced_at", nil).Error; err != nil {
+		return fmt.Errorf("unmark season announced failed: %w", err)
+	}
+	return nil
+}
@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"gorm.io/gorm/clause"
+
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
 )
 
 // GameSessionParams: 게임 세션 기록 파라미터 구조체
@@ -18,6 +21,9 @@ type GameSessionParams struct {
 	ParticipantCount int
 	QuestionCount    int
 	HintCount        int
+	MaxCombo         int
+	LLMUsage         *qmodel.LLMUsageSummary
+	Season           int
 	CompletedAt      time.Time
 	Now              time.Time
 }
@@ -48,9 +54,21 @@ func (r *Repository) RecordGameSession(ctx context.Context, p GameSessionParams)
 		ParticipantCount: p.ParticipantCount,
 		QuestionCount:    p.QuestionCount,
 		HintCount:        p.HintCount,
+		MaxCombo:         p.MaxCombo,
+		Season:           p.Season,
 		CompletedAt:      p.CompletedAt,
 		CreatedAt:        p.Now,
 	}
+	if p.LLMUsage != nil {
+		raw, err := json.Marshal(p.LLMUsage)
+		if err != nil {
+			return fmt.Errorf("marshal llm usage failed: %w", err)
+		}
+		usageJSON := string(raw)
+		entity.LLMTotalTokens = p.LLMUsage.TotalTokens
+		entity.LLMCostUSD = p.LLMUsage.CostUSD
+		entity.LLMUsageJSON = &usageJSON
+	}
 
 	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "session_id"}},
@@ -73,6 +91,10 @@ type GameLogParams struct {
 	WrongGuessCount int
 	Result          GameResult
 	Target          *string
+	TurnCount       int
+	TurnMillis      int64
+	TurnTimeouts    int
+	Season          int
 	CompletedAt     time.Time
 	Now             time.Time
 }
@@ -103,6 +125,10 @@ func (r *Repository) RecordGameLog(ctx context.Context, p GameLogParams) error {
 		WrongGuessCount: p.WrongGuessCount,
 		Result:          string(p.Result),
 		Target:          p.Target,
+		TurnCount:       p.TurnCount,
+		TurnMillis:      p.TurnMillis,
+		TurnTimeouts:    p.TurnTimeouts,
+		Season:          p.Season,
 		CompletedAt:     p.CompletedAt,
 		CreatedAt:       p.Now,
 	}
@@ -56,7 +56,7 @@ func (h *AdminHandler) ForceEnd(ctx context.Context, chatID string, userID strin
 	h.logger.Info("HANDLE_ADMIN_FORCE_END", "chatID", chatID, "userID", userID)
 	if !h.IsAdmin(userID) {
 		h.logger.Warn("ADMIN_PERMISSION_DENIED", "userID", userID, "chatID", chatID)
-		return h.msgProvider.Get(qmessages.ErrorNoPermission), nil
+		return h.msgProvider.For(ctx).Get(qmessages.ErrorNoPermission), nil
 	}
 
 	// 세션 확인
@@ -65,7 +65,7 @@ func (h *AdminHandler) ForceEnd(ctx context.Context, chatID string, userID strin
 		return "", fmt.Errorf("session store get: %w", err)
 	}
 	if session == nil {
-		return h.msgProvider.Get(qmessages.ErrorNoSessionShort), nil
+		return h.msgProvider.For(ctx).Get(qmessages.ErrorNoSessionShort), nil
 	}
 
 	result, err := h.riddleService.Surrender(ctx, chatID)
@@ -74,7 +74,7 @@ func (h *AdminHandler) ForceEnd(ctx context.Context, chatID string, userID strin
 	}
 
 	h.logger.Info("ADMIN_FORCE_END_SUCCESS", "chatID", chatID, "adminID", userID)
-	return h.msgProvider.Get(qmessages.AdminForceEndPrefix) + result, nil
+	return h.msgProvider.For(ctx).Get(qmessages.AdminForceEndPrefix) + result, nil
 }
 
 // ClearAll 관리자 전체 삭제.
@@ -82,7 +82,7 @@ func (h *AdminHandler) ClearAll(ctx context.Context, chatID string, userID strin
 	h.logger.Info("HANDLE_ADMIN_CLEAR_ALL", "chatID", chatID, "userID", userID)
 	if !h.IsAdmin(userID) {
 		h.logger.Warn("ADMIN_PERMISSION_DENIED", "userID", userID, "chatID", chatID)
-		return h.msgProvider.Get(qmessages.ErrorNoPermission), nil
+		return h.msgProvider.For(ctx).Get(qmessages.ErrorNoPermission), nil
 	}
 
 	if err := h.sessionStore.ClearAllData(ctx, chatID); err != nil {
@@ -91,5 +91,5 @@ func (h *AdminHandler) ClearAll(ctx context.Context, chatID string, userID strin
 	}
 
 	h.logger.Info("ADMIN_CLEAR_ALL_SUCCESS", "chatID", chatID, "adminID", userID)
-	return h.msgProvider.Get(qmessages.AdminClearAllSuccess), nil
+	return h.msgProvider.For(ctx).Get(qmessages.AdminClearAllSuccess), nil
 }
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+const (
+	// hintFeedbackCacheTTL: 카테고리별 평가 집계를 다시 조회하기까지의 시간 (힌트마다 DB를 읽지 않도록)
+	hintFeedbackCacheTTL = 10 * time.Minute
+	// hintFeedbackExamples: 힌트 프롬프트에 함께 보내는 '도움이 안 된 힌트' 예시 수
+	hintFeedbackExamples = 3
+)
+
+type hintFeedbackCacheEntry struct {
+	feedback  *llmrest.TwentyQHintFeedback
+	expiresAt time.Time
+}
+
+// HintFeedbackService: 힌트 평가를 기록하고, 힌트 생성 시 참고할 카테고리별 집계를 캐시해 제공합니다.
+type HintFeedbackService struct {
+	repo   *qrepo.Repository
+	cfg    qconfig.HintFeedbackConfig
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]hintFeedbackCacheEntry
+}
+
+// NewHintFeedbackService: 새로운 HintFeedbackService 인스턴스를 생성합니다. 비활성화되어 있거나 DB가 없으면 nil을 반환합니다.
+func NewHintFeedbackService(repo *qrepo.Repository, cfg qconfig.HintFeedbackConfig, logger *slog.Logger) *HintFeedbackService {
+	if repo == nil || !cfg.Enabled {
+		return nil
+	}
+	return &HintFeedbackService{
+		repo:   repo,
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+		cache:  make(map[string]hintFeedbackCacheEntry),
+	}
+}
+
+// Record: 힌트 평가 한 건을 저장합니다.
+func (f *HintFeedbackService) Record(ctx context.Context, p qrepo.HintFeedbackParams) error {
+	if p.Now.IsZero() {
+		p.Now = f.now()
+	}
+	if err := f.repo.RecordHintFeedback(ctx, p); err != nil {
+		return fmt.Errorf("record hint feedback failed: %w", err)
+	}
+	return nil
+}
+
+// PromptFeedback: 카테고리의 최근 평가 집계를 힌트 요청에 실을 형태로 반환합니다.
+// 평가 수가 MinVotes 미만이거나 조회에 실패하면 nil을 반환합니다. (평가 없이 기존처럼 힌트 생성)
+func (f *HintFeedbackService) PromptFeedback(ctx context.Context, category string) *llmrest.TwentyQHintFeedback {
+	category = strings.TrimSpace(category)
+	if f == nil || category == "" {
+		return nil
+	}
+
+	now := f.now()
+	f.mu.Lock()
+	entry, ok := f.cache[category]
+	f.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.feedback
+	}
+
+	feedback, err := f.loadPromptFeedback(ctx, category, now)
+	if err != nil {
+		f.logger.Warn("hint_feedback_load_failed", "category", category, "err", err)
+		return nil
+	}
+
+	f.mu.Lock()
+	f.cache[category] = hintFeedbackCacheEntry{feedback: feedback, expiresAt: now.Add(hintFeedbackCacheTTL)}
+	f.mu.Unlock()
+	return feedback
+}
+
+func (f *HintFeedbackService) loadPromptFeedback(ctx context.Context, category string, now time.Time) (*llmrest.TwentyQHintFeedback, error) {
+	since := now.AddDate(0, 0, -f.cfg.LookbackDays)
+	stats, err := f.repo.HintFeedbackStats(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("hint feedback stats failed: %w", err)
+	}
+
+	var feedback *llmrest.TwentyQHintFeedback
+	for _, stat := range stats {
+		if stat.Category == category && stat.Useful+stat.Useless >= f.cfg.MinVotes {
+			feedback = &llmrest.TwentyQHintFeedback{Useful: stat.Useful, Useless: stat.Useless}
+			break
+		}
+	}
+	if feedback == nil {
+		return nil, nil
+	}
+
+	useless, err := f.repo.UselessHints(ctx, since, category, hintFeedbackExamples)
+	if err != nil {
+		return nil, fmt.Errorf("useless hints failed: %w", err)
+	}
+	for _, hint := range useless {
+		feedback.UselessExamples = append(feedback.UselessExamples, hint.HintText)
+	}
+	return feedback, nil
+}
+
+// SetHintFeedback: 힌트 평가 서비스를 연결합니다. nil이면 평가 명령은 안내만 하고 힌트 프롬프트에도 반영하지 않습니다.
+func (s *RiddleService) SetHintFeedback(feedback *HintFeedbackService) {
+	s.hintFeedback = feedback
+}
+
+// RecordHintFeedback: 마지막으로 받은 힌트에 대한 플레이어의 유용/무용 평가를 기록하고 안내 메시지를 반환합니다.
+func (s *RiddleService) RecordHintFeedback(ctx context.Context, chatID string, userID string, useful bool) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	userID = strings.TrimSpace(userID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.hintFeedback == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.HintFeedbackUnavailable), nil
+	}
+
+	secret, err := s.sessionStore.GetSecret(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("secret get failed: %w", err)
+	}
+	if secret == nil {
+		return "", qerrors.SessionNotFoundError{ChatID: chatID}
+	}
+
+	history, err := s.historyStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("history get failed: %w", err)
+	}
+	hint, ok := latestHint(history)
+	if !ok {
+		return s.msgProvider.For(ctx).Get(qmessages.HintFeedbackNoHint), nil
+	}
+	hintNumber := -hint.QuestionNumber
+
+	// 세션 식별자가 없던 이전 버전 게임은 방 단위로 묶는다
+	sessionID := secret.SessionID
+	if sessionID == "" {
+		sessionID = chatID
+	}
+	if err := s.hintFeedback.Record(ctx, qrepo.HintFeedbackParams{
+		SessionID:  sessionID,
+		ChatID:     chatID,
+		UserID:     userID,
+		Category:   secret.Category,
+		HintNumber: hintNumber,
+		HintText:   hint.Answer,
+		Useful:     useful,
+	}); err != nil {
+		return "", err
+	}
+
+	key := qmessages.HintFeedbackUseless
+	if useful {
+		key = qmessages.HintFeedbackUseful
+	}
+	return s.msgProvider.For(ctx).Get(key, messageprovider.P("hintNumber", hintNumber)), nil
+}
+
+// latestHint: 이력에서 가장 최근 힌트(힌트 번호가 가장 큰 항목)를 찾습니다.
+func latestHint(history []qmodel.QuestionHistory) (qmodel.QuestionHistory, bool) {
+	var latest qmodel.QuestionHistory
+	found := false
+	for _, h := range history {
+		if h.QuestionNumber < 0 && (!found || h.QuestionNumber < latest.QuestionNumber) {
+			latest = h
+			found = true
+		}
+	}
+	return latest, found
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func TestLatestHint(t *testing.T) {
+	if _, ok := latestHint([]qmodel.QuestionHistory{{QuestionNumber: 1, Question: "살아있나요?"}}); ok {
+		t.Fatal("expected no hint")
+	}
+
+	hint, ok := latestHint([]qmodel.QuestionHistory{
+		{QuestionNumber: -1, Answer: "첫 힌트"},
+		{QuestionNumber: 3, Answer: "예"},
+		{QuestionNumber: -2, Answer: "두 번째 힌트"},
+		{QuestionNumber: 4, Answer: "아니오"},
+	})
+	if !ok || hint.QuestionNumber != -2 || hint.Answer != "두 번째 힌트" {
+		t.Fatalf("unexpected latest hint: %+v ok=%v", hint, ok)
+	}
+}
+
+func TestHintFeedbackService_DisabledIsNil(t *testing.T) {
+	svc := NewHintFeedbackService(nil, qconfig.HintFeedbackConfig{Enabled: true}, nil)
+	if svc != nil {
+		t.Fatal("expected nil service without repository")
+	}
+	if got := svc.PromptFeedback(context.Background(), "animal"); got != nil {
+		t.Fatalf("nil service should not return feedback, got %+v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+)
+
+// HotseatWatcher: 턴제 게임의 차례 제한 시간을 감시하고, 시간이 지나면 다음 참가자에게 넘기며 방에 안내합니다.
+type HotseatWatcher struct {
+	riddle  *RiddleService
+	publish DigestPublishFunc
+	logger  *slog.Logger
+}
+
+// NewHotseatWatcher: HotseatWatcher 인스턴스를 생성합니다.
+func NewHotseatWatcher(riddle *RiddleService, publish DigestPublishFunc, logger *slog.Logger) *HotseatWatcher {
+	return &HotseatWatcher{
+		riddle:  riddle,
+		publish: publish,
+		logger:  logger,
+	}
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 만료된 차례를 넘깁니다.
+func (w *HotseatWatcher) Run(ctx context.Context) error {
+	w.logger.Info("hotseat_watcher_started",
+		"turn_timeout_seconds", qconfig.HotseatTurnTimeoutSeconds,
+		"interval_seconds", qconfig.HotseatWatchIntervalSeconds,
+	)
+
+	ticker := time.NewTicker(time.Duration(qconfig.HotseatWatchIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.tick(ctx, time.Now())
+		}
+	}
+}
+
+func (w *HotseatWatcher) tick(ctx context.Context, now time.Time) {
+	notices, err := w.riddle.SkipExpiredTurns(ctx, now)
+	if err != nil {
+		w.logger.Warn("hotseat_watch_failed", "err", err)
+		return
+	}
+	for _, notice := range notices {
+		if err := w.publish(ctx, mqmsg.NewFinal(notice.ChatID, notice.Text, nil)); err != nil {
+			w.logger.Warn("hotseat_notice_publish_failed", "chat_id", notice.ChatID, "err", err)
+		}
+	}
+}
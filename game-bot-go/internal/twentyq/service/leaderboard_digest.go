@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+const (
+	digestPeriodDaily  = "daily"
+	digestPeriodWeekly = "weekly"
+
+	// digestTickInterval: 게시 시각 도달 여부를 확인하는 주기
+	digestTickInterval = time.Minute
+	// digestMaxDelay: 예정 시각보다 이만큼 늦어지면(장기 다운타임 등) 해당 회차는 건너뜀
+	digestMaxDelay = 3 * time.Hour
+)
+
+// digestTimezone: 게시 시각/집계 구간 계산 기준 시간대 (KST)
+var digestTimezone = time.FixedZone("KST", 9*60*60)
+
+// DigestPublishFunc: 다이제스트 메시지를 채팅방으로 발행하는 함수
+type DigestPublishFunc func(ctx context.Context, message mqmsg.OutboundMessage) error
+
+// LeaderboardEntry: 다이제스트에 표시되는 플레이어별 집계
+type LeaderboardEntry struct {
+	UserID       string
+	Sender       string
+	Games        int
+	Wins         int
+	AvgQuestions float64
+	// LifetimeGames: UserStats 기준 누적 완료 판수 (통계 행이 없으면 0)
+	LifetimeGames int
+}
+
+type digestRoomSummary struct {
+	TotalGames   int
+	CorrectCount int
+}
+
+// LeaderboardDigestScheduler: 설정된 시각에 방별 일간/주간 리더보드 요약을 게시하는 스케줄러입니다.
+// 게시 여부는 DigestStore에 기록하므로 재시작이나 다중 인스턴스에서도 회차당 한 번만 게시됩니다.
+type LeaderboardDigestScheduler struct {
+	cfg         qconfig.DigestConfig
+	db          *gorm.DB
+	store       *redis.DigestStore
+	publish     DigestPublishFunc
+	msgProvider *messageprovider.Provider
+	withLocale  func(ctx context.Context, chatID string) context.Context
+	logger      *slog.Logger
+}
+
+// NewLeaderboardDigestScheduler: LeaderboardDigestScheduler 인스턴스를 생성합니다.
+func NewLeaderboardDigestScheduler(
+	cfg qconfig.DigestConfig,
+	db *gorm.DB,
+	store *redis.DigestStore,
+	publish DigestPublishFunc,
+	msgProvider *messageprovider.Provider,
+	logger *slog.Logger,
+) *LeaderboardDigestScheduler {
+	return &LeaderboardDigestScheduler{
+		cfg:         cfg,
+		db:          db,
+		store:       store,
+		publish:     publish,
+		msgProvider: msgProvider,
+		logger:      logger,
+	}
+}
+
+// SetChatLocale: 방별 언어를 컨텍스트에 싣는 함수를 연결합니다. 없으면 기본 언어로 게시합니다.
+func (s *LeaderboardDigestScheduler) SetChatLocale(withLocale func(ctx context.Context, chatID string) context.Context) {
+	s.withLocale = withLocale
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 게시 시각을 확인합니다.
+func (s *LeaderboardDigestScheduler) Run(ctx context.Context) error {
+	s.logger.Info("leaderboard_digest_started",
+		"chat_count", len(s.cfg.ChatIDs),
+		"send_at_minute", s.cfg.SendAtMinute,
+		"quiet_hours", s.cfg.QuietHours,
+	)
+
+	ticker := time.NewTicker(digestTickInterval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick: 오늘/어제 회차 중 게시 시각이 지난 회차를 게시합니다.
+// 방해 금지 시간 때문에 다음 날 아침으로 미뤄진 어제 회차도 여기서 처리됩니다.
+func (s *LeaderboardDigestScheduler) tick(ctx context.Context, now time.Time) {
+	today := digestDate(now)
+	for _, date := range []time.Time{today.AddDate(0, 0, -1), today} {
+		dueAt := digestDueAt(date, s.cfg)
+		if now.Before(dueAt) || now.Sub(dueAt) > digestMaxDelay {
+			continue
+		}
+		s.sendRound(ctx, date)
+	}
+}
+
+func (s *LeaderboardDigestScheduler) sendRound(ctx context.Context, date time.Time) {
+	period, start, end := digestWindow(date, s.cfg)
+	dateKey := date.Format(time.DateOnly)
+
+	for _, chatID := range s.cfg.ChatIDs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		first, err := s.store.MarkSent(ctx, chatID, period, dateKey)
+		if err != nil {
+			s.logger.Warn("digest_mark_failed", "chat_id", chatID, "period", period, "date", dateKey, "err", err)
+			continue
+		}
+		if !first {
+			continue
+		}
+
+		if err := s.sendDigest(ctx, chatID, period, start, end); err != nil {
+			s.logger.Warn("digest_send_failed", "chat_id", chatID, "period", period, "date", dateKey, "err", err)
+			if unmarkErr := s.store.UnmarkSent(context.WithoutCancel(ctx), chatID, period, dateKey); unmarkErr != nil {
+				s.logger.Warn("digest_unmark_failed", "chat_id", chatID, "err", unmarkErr)
+			}
+		}
+	}
+}
+
+func (s *LeaderboardDigestScheduler) sendDigest(ctx context.Context, chatID string, period string, start time.Time, end time.Time) error {
+	if s.withLocale != nil {
+		ctx = s.withLocale(ctx, chatID)
+	}
+	summary, participants, err := s.loadRoomSummary(ctx, chatID, start, end)
+	if err != nil {
+		return err
+	}
+	if summary.TotalGames == 0 {
+		s.logger.Debug("digest_skipped_no_games", "chat_id", chatID, "period", period)
+		return nil
+	}
+
+	entries, err := s.loadLeaderboard(ctx, chatID, start, end)
+	if err != nil {
+		return err
+	}
+
+	text := s.formatDigest(ctx, period, summary, participants, entries)
+	if err := s.publish(ctx, mqmsg.NewFinal(chatID, text, nil)); err != nil {
+		return fmt.Errorf("publish digest: %w", err)
+	}
+	s.logger.Info("digest_sent", "chat_id", chatID, "period", period, "games", summary.TotalGames, "players", len(entries))
+	return nil
+}
+
+func (s *LeaderboardDigestScheduler) loadRoomSummary(ctx context.Context, chatID string, start time.Time, end time.Time) (digestRoomSummary, int, error) {
+	var summary digestRoomSummary
+	if err := s.db.WithContext(ctx).
+		Model(&repository.GameSession{}).
+		Select("count(*) as total_games, coalesce(sum(case when result = ? then 1 else 0 end), 0) as correct_count",
+			string(repository.GameResultCorrect)).
+		Where("chat_id = ? AND completed_at >= ? AND completed_at < ?", chatID, start, end).
+		Scan(&summary).Error; err != nil {
+		return digestRoomSummary{}, 0, fmt.Errorf("aggregate game_sessions: %w", err)
+	}
+
+	var participants int64
+	if err := s.db.WithContext(ctx).
+		Model(&repository.GameLog{}).
+		Where("chat_id = ? AND completed_at >= ? AND completed_at < ?", chatID, start, end).
+		Distinct("user_id").
+		Count(&participants).Error; err != nil {
+		return digestRoomSummary{}, 0, fmt.Errorf("count participants: %w", err)
+	}
+	return summary, int(participants), nil
+}
+
+// loadLeaderboard: 구간 내 정답 횟수 → 참여 판수 순으로 상위 플레이어를 집계하고 누적 전적을 덧붙입니다.
+func (s *LeaderboardDigestScheduler) loadLeaderboard(ctx context.Context, chatID string, start time.Time, end time.Time) ([]LeaderboardEntry, error) {
+	var entries []LeaderboardEntry
+	if err := s.db.WithContext(ctx).
+		Model(&repository.GameLog{}).
+		Select("user_id, max(sender) as sender, count(*) as games, "+
+			"sum(case when target is not null then 1 else 0 end) as wins, "+
+			"avg(question_count) as avg_questions").
+		Where("chat_id = ? AND completed_at >= ? AND completed_at < ?", chatID, start, end).
+		Group("user_id").
+		Order("wins DESC, games DESC").
+		Limit(s.cfg.TopN).
+		Scan(&entries).Error; err != nil {
+		return nil, fmt.Errorf("aggregate game_logs: %w", err)
+	}
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, repository.CompositeUserStatsID(chatID, entry.UserID))
+	}
+	var stats []repository.UserStats
+	if err := s.db.WithContext(ctx).Where("id IN ?", ids).Find(&stats).Error; err != nil {
+		// 누적 전적은 부가 정보이므로 실패해도 다이제스트는 게시
+		s.logger.Warn("digest_user_stats_failed", "chat_id", chatID, "err", err)
+		return entries, nil
+	}
+
+	lifetime := make(map[string]int, len(stats))
+	for _, stat := range stats {
+		lifetime[stat.UserID] = stat.TotalGamesCompleted
+	}
+	for i := range entries {
+		entries[i].LifetimeGames = lifetime[entries[i].UserID]
+	}
+	return entries, nil
+}
+
+func (s *LeaderboardDigestScheduler) formatDigest(ctx context.Context, period string, summary digestRoomSummary, participants int, entries []LeaderboardEntry) string {
+	headerKey := qmessages.StatsDigestDailyHeader
+	if period == digestPeriodWeekly {
+		headerKey = qmessages.StatsDigestWeeklyHeader
+	}
+
+	parts := []string{
+		s.msgProvider.For(ctx).Get(headerKey),
+		"",
+		s.msgProvider.For(ctx).Get(
+			qmessages.StatsDigestSummary,
+			messageprovider.P("totalGames", summary.TotalGames),
+			messageprovider.P("correctGames", summary.CorrectCount),
+			messageprovider.P("totalParticipants", participants),
+		),
+	}
+	if len(entries) > 0 {
+		parts = append(parts, "")
+		for i, entry := range entries {
+			parts = append(parts, s.msgProvider.For(ctx).Get(
+				qmessages.StatsDigestItem,
+				messageprovider.P("rank", i+1),
+				messageprovider.P("sender", entry.Sender),
+				messageprovider.P("wins", entry.Wins),
+				messageprovider.P("games", entry.Games),
+				messageprovider.P("lifetimeGames", entry.LifetimeGames),
+			))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// digestDate: 시각이 속한 KST 날짜의 자정을 반환합니다.
+func digestDate(t time.Time) time.Time {
+	local := t.In(digestTimezone)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, digestTimezone)
+}
+
+// digestWindow: 회차의 종류와 집계 구간 [start, end)를 계산합니다.
+// 구간은 예정 게시 시각에서 끝나므로 방해 금지 시간으로 게시가 늦어져도 회차 사이에 빈틈이 없습니다.
+func digestWindow(date time.Time, cfg qconfig.DigestConfig) (string, time.Time, time.Time) {
+	end := date.Add(time.Duration(cfg.SendAtMinute) * time.Minute)
+	if cfg.WeeklyEnabled && date.Weekday() == cfg.WeeklyDay {
+		return digestPeriodWeekly, end.AddDate(0, 0, -7), end
+	}
+	return digestPeriodDaily, end.AddDate(0, 0, -1), end
+}
+
+// digestDueAt: 회차의 실제 게시 시각을 계산합니다. 예정 시각이 방해 금지 시간에 걸리면 종료 시각으로 미룹니다.
+func digestDueAt(date time.Time, cfg qconfig.DigestConfig) time.Time {
+	sendAt := cfg.SendAtMinute
+	if !cfg.QuietHours || !inQuietHours(sendAt, cfg.QuietStart, cfg.QuietEnd) {
+		return date.Add(time.Duration(sendAt) * time.Minute)
+	}
+
+	quietEnd := date.Add(time.Duration(cfg.QuietEnd) * time.Minute)
+	// 자정을 넘기는 구간에서 시작 이후에 걸렸다면 종료는 다음 날
+	if cfg.QuietStart > cfg.QuietEnd && sendAt >= cfg.QuietStart {
+		quietEnd = quietEnd.AddDate(0, 0, 1)
+	}
+	return quietEnd
+}
+
+func inQuietHours(minute int, start int, end int) bool {
+	if start <= end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
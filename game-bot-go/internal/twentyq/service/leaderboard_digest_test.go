@@ -0,0 +1,87 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+)
+
+func kst(year int, month time.Month, day int, hour int, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, digestTimezone)
+}
+
+func TestDigestDueAt(t *testing.T) {
+	date := kst(2026, time.October, 14, 0, 0)
+
+	tests := []struct {
+		name string
+		cfg  qconfig.DigestConfig
+		want time.Time
+	}{
+		{
+			name: "no quiet hours",
+			cfg:  qconfig.DigestConfig{SendAtMinute: 21 * 60},
+			want: kst(2026, time.October, 14, 21, 0),
+		},
+		{
+			name: "outside quiet hours",
+			cfg:  qconfig.DigestConfig{SendAtMinute: 21 * 60, QuietHours: true, QuietStart: 23 * 60, QuietEnd: 8 * 60},
+			want: kst(2026, time.October, 14, 21, 0),
+		},
+		{
+			name: "wrapping quiet hours after start",
+			cfg:  qconfig.DigestConfig{SendAtMinute: 23*60 + 30, QuietHours: true, QuietStart: 23 * 60, QuietEnd: 8 * 60},
+			want: kst(2026, time.October, 15, 8, 0),
+		},
+		{
+			name: "wrapping quiet hours before end",
+			cfg:  qconfig.DigestConfig{SendAtMinute: 6 * 60, QuietHours: true, QuietStart: 23 * 60, QuietEnd: 8 * 60},
+			want: kst(2026, time.October, 14, 8, 0),
+		},
+		{
+			name: "same-day quiet hours",
+			cfg:  qconfig.DigestConfig{SendAtMinute: 13 * 60, QuietHours: true, QuietStart: 12 * 60, QuietEnd: 14 * 60},
+			want: kst(2026, time.October, 14, 14, 0),
+		},
+	}
+	for _, tt := range tests {
+		if got := digestDueAt(date, tt.cfg); !got.Equal(tt.want) {
+			t.Errorf("%s: digestDueAt = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDigestWindow(t *testing.T) {
+	cfg := qconfig.DigestConfig{SendAtMinute: 21 * 60, WeeklyEnabled: true, WeeklyDay: time.Sunday}
+
+	// 2026-10-14는 수요일
+	period, start, end := digestWindow(kst(2026, time.October, 14, 0, 0), cfg)
+	if period != digestPeriodDaily {
+		t.Fatalf("expected daily period, got %s", period)
+	}
+	if !start.Equal(kst(2026, time.October, 13, 21, 0)) || !end.Equal(kst(2026, time.October, 14, 21, 0)) {
+		t.Fatalf("unexpected daily window: %v ~ %v", start, end)
+	}
+
+	period, start, _ = digestWindow(kst(2026, time.October, 18, 0, 0), cfg)
+	if period != digestPeriodWeekly {
+		t.Fatalf("expected weekly period on Sunday, got %s", period)
+	}
+	if !start.Equal(kst(2026, time.October, 11, 21, 0)) {
+		t.Fatalf("unexpected weekly start: %v", start)
+	}
+
+	cfg.WeeklyEnabled = false
+	if period, _, _ := digestWindow(kst(2026, time.October, 18, 0, 0), cfg); period != digestPeriodDaily {
+		t.Fatalf("expected daily period when weekly disabled, got %s", period)
+	}
+}
+
+func TestDigestDate_ConvertsToKST(t *testing.T) {
+	// UTC 16:00은 KST 다음 날 01:00
+	got := digestDate(time.Date(2026, time.October, 14, 16, 0, 0, 0, time.UTC))
+	if !got.Equal(kst(2026, time.October, 15, 0, 0)) {
+		t.Fatalf("digestDate = %v", got)
+	}
+}
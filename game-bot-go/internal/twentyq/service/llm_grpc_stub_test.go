@@ -23,6 +23,9 @@ type twentyqLLMGRPCStub struct {
 	generateHints  func(req *llmv1.TwentyQGenerateHintsRequest) (*llmv1.TwentyQGenerateHintsResponse, error)
 	answerQuestion func(req *llmv1.TwentyQAnswerQuestionRequest) (*llmv1.TwentyQAnswerQuestionResponse, error)
 	verifyGuess    func(req *llmv1.TwentyQVerifyGuessRequest) (*llmv1.TwentyQVerifyGuessResponse, error)
+	checkSynonym   func(req *llmv1.TwentyQCheckSynonymRequest) (*llmv1.TwentyQCheckSynonymResponse, error)
+	embed          func(req *llmv1.EmbedRequest) (*llmv1.EmbedResponse, error)
+	summarizeGame  func(req *llmv1.TwentyQSummarizeGameRequest) (*llmv1.TwentyQSummarizeGameResponse, error)
 	endSession     func(req *llmv1.EndSessionRequest) (*llmv1.EndSessionResponse, error)
 	getDailyUsage  func() (*llmv1.DailyUsageResponse, error)
 	getRecentUsage func(req *llmv1.GetRecentUsageRequest) (*llmv1.UsageListResponse, error)
@@ -113,6 +116,39 @@ func (s *twentyqLLMGRPCStub) TwentyQVerifyGuess(ctx context.Context, req *llmv1.
 	return &llmv1.TwentyQVerifyGuessResponse{Result: nil, RawText: ""}, nil
 }
 
+func (s *twentyqLLMGRPCStub) TwentyQCheckSynonym(ctx context.Context, req *llmv1.TwentyQCheckSynonymRequest) (*llmv1.TwentyQCheckSynonymResponse, error) {
+	s.incCall()
+	if s.isError() {
+		return nil, status.Error(codes.Internal, "mock error")
+	}
+	if s != nil && s.checkSynonym != nil {
+		return s.checkSynonym(req)
+	}
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (s *twentyqLLMGRPCStub) Embed(ctx context.Context, req *llmv1.EmbedRequest) (*llmv1.EmbedResponse, error) {
+	s.incCall()
+	if s.isError() {
+		return nil, status.Error(codes.Internal, "mock error")
+	}
+	if s != nil && s.embed != nil {
+		return s.embed(req)
+	}
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (s *twentyqLLMGRPCStub) TwentyQSummarizeGame(ctx context.Context, req *llmv1.TwentyQSummarizeGameRequest) (*llmv1.TwentyQSummarizeGameResponse, error) {
+	s.incCall()
+	if s.isError() {
+		return nil, status.Error(codes.Internal, "mock error")
+	}
+	if s != nil && s.summarizeGame != nil {
+		return s.summarizeGame(req)
+	}
+	return &llmv1.TwentyQSummarizeGameResponse{Summary: "summary"}, nil
+}
+
 func (s *twentyqLLMGRPCStub) EndSession(ctx context.Context, req *llmv1.EndSessionRequest) (*llmv1.EndSessionResponse, error) {
 	s.incCall()
 	if s.isError() {
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+// openingTickInterval: 집계 시각 도달 여부를 확인하는 주기
+const openingTickInterval = time.Minute
+
+// OpeningSuggestionMiner: 매일 지정 시각에 지난 게임의 초반 질문을 집계해 카테고리별 추천 첫 질문을 갱신하는 작업입니다.
+// 실행 여부는 OpeningStore에 날짜별로 기록하므로 재시작이나 다중 인스턴스에서도 하루 한 번만 집계합니다.
+type OpeningSuggestionMiner struct {
+	cfg    qconfig.OpeningConfig
+	repo   *repository.Repository
+	store  *redis.OpeningStore
+	logger *slog.Logger
+}
+
+// NewOpeningSuggestionMiner: OpeningSuggestionMiner 인스턴스를 생성합니다.
+func NewOpeningSuggestionMiner(
+	cfg qconfig.OpeningConfig,
+	repo *repository.Repository,
+	store *redis.OpeningStore,
+	logger *slog.Logger,
+) *OpeningSuggestionMiner {
+	return &OpeningSuggestionMiner{
+		cfg:    cfg,
+		repo:   repo,
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 집계 시각을 확인합니다.
+func (m *OpeningSuggestionMiner) Run(ctx context.Context) error {
+	m.logger.Info("opening_miner_started",
+		"run_at_minute", m.cfg.RunAtMinute,
+		"lookback_days", m.cfg.LookbackDays,
+		"min_games", m.cfg.MinGames,
+		"top_n", m.cfg.TopN,
+	)
+
+	ticker := time.NewTicker(openingTickInterval)
+	defer ticker.Stop()
+
+	for {
+		m.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick: 오늘 집계 시각이 지났고 아직 집계하지 않았다면 집계를 실행합니다.
+func (m *OpeningSuggestionMiner) tick(ctx context.Context, now time.Time) {
+	today := digestDate(now)
+	if now.Before(today.Add(time.Duration(m.cfg.RunAtMinute) * time.Minute)) {
+		return
+	}
+
+	date := today.Format(time.DateOnly)
+	first, err := m.store.MarkMined(ctx, date)
+	if err != nil {
+		m.logger.Warn("opening_mark_mined_failed", "date", date, "err", err)
+		return
+	}
+	if !first {
+		return
+	}
+
+	if _, err := m.Mine(ctx, now); err != nil {
+		m.logger.Warn("opening_mine_failed", "date", date, "err", err)
+		if unmarkErr := m.store.UnmarkMined(ctx, date); unmarkErr != nil {
+			m.logger.Warn("opening_unmark_mined_failed", "date", date, "err", unmarkErr)
+		}
+	}
+}
+
+// Mine: 최근 LookbackDays일의 초반 질문을 집계해 추천 목록을 교체하고, 기간이 지난 기록을 정리합니다.
+func (m *OpeningSuggestionMiner) Mine(ctx context.Context, now time.Time) (map[string][]qmodel.OpeningSuggestion, error) {
+	since := now.AddDate(0, 0, -m.cfg.LookbackDays)
+
+	pruned, err := m.repo.PruneOpeningQuestions(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("prune opening questions failed: %w", err)
+	}
+
+	stats, err := m.repo.OpeningQuestionStats(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("load opening question stats failed: %w", err)
+	}
+
+	suggestions := rankOpeningQuestions(stats, m.cfg.MinGames, m.cfg.TopN)
+	if err := m.store.ReplaceAll(ctx, suggestions); err != nil {
+		return nil, fmt.Errorf("store opening suggestions failed: %w", err)
+	}
+
+	m.logger.Info("opening_mined", "questions", len(stats), "categories", len(suggestions), "pruned", pruned)
+	return suggestions, nil
+}
+
+// rankOpeningQuestions: 카테고리별로 게임 수가 minGames 이상인 질문을 보정 정답률 순으로 topN개 고릅니다.
+// 표본이 적은 질문이 우연히 높은 정답률로 앞서지 않도록 (정답+1)/(게임+2)로 보정한 값을 기준으로 삼습니다.
+func rankOpeningQuestions(stats []repository.OpeningQuestionStat, minGames int, topN int) map[string][]qmodel.OpeningSuggestion {
+	type candidate struct {
+		stat  repository.OpeningQuestionStat
+		score float64
+	}
+
+	byCategory := make(map[string][]candidate)
+	for _, st := range stats {
+		if st.Category == "" || st.Question == "" || st.Games < minGames {
+			continue
+		}
+		score := float64(st.Wins+1) / float64(st.Games+2)
+		byCategory[st.Category] = append(byCategory[st.Category], candidate{stat: st, score: score})
+	}
+
+	out := make(map[string][]qmodel.OpeningSuggestion, len(byCategory))
+	for category, candidates := range byCategory {
+		slices.SortFunc(candidates, func(a, b candidate) int {
+			if c := cmp.Compare(b.score, a.score); c != 0 {
+				return c
+			}
+			if c := cmp.Compare(b.stat.Games, a.stat.Games); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.stat.Question, b.stat.Question)
+		})
+
+		top := candidates[:min(topN, len(candidates))]
+		suggestions := make([]qmodel.OpeningSuggestion, 0, len(top))
+		for _, c := range top {
+			suggestions = append(suggestions, qmodel.OpeningSuggestion{
+				Question: c.stat.Question,
+				Games:    c.stat.Games,
+				Wins:     c.stat.Wins,
+				WinRate:  math.Round(float64(c.stat.Wins)/float64(c.stat.Games)*1000) / 1000,
+			})
+		}
+		out[category] = suggestions
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+func TestOpeningQuestions(t *testing.T) {
+	history := []qmodel.QuestionHistory{
+		{QuestionNumber: 1, Question: "  살아 있는   건가요？ "},
+		{QuestionNumber: -1, Question: "힌트", Answer: "털이 있다"},
+		{QuestionNumber: 2, Question: "정답 호랑이"},
+		{QuestionNumber: 3, Question: "살아 있는 건가요?"},
+		{QuestionNumber: 4, Question: "손에 들 수 있나요?"},
+		{QuestionNumber: 5, Question: strings.Repeat("아주 ", 30) + "긴 질문인가요?"},
+		{QuestionNumber: 6, Question: "먹을 수 있나요"},
+		{QuestionNumber: 7, Question: "네 번째 질문인가요"},
+	}
+
+	got := openingQuestions(history, 3)
+	want := []string{"살아 있는 건가요", "손에 들 수 있나요", "먹을 수 있나요"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("openingQuestions() = %q, want %q", got, want)
+	}
+}
+
+func TestRankOpeningQuestions(t *testing.T) {
+	stats := []qrepo.OpeningQuestionStat{
+		{Category: "organism", Question: "살아 있나요", Games: 20, Wins: 14},
+		{Category: "organism", Question: "동물인가요", Games: 10, Wins: 7},
+		{Category: "organism", Question: "날 수 있나요", Games: 3, Wins: 3}, // 최소 게임 수 미달
+		{Category: "organism", Question: "식물인가요", Games: 8, Wins: 2},
+		{Category: "food", Question: "달콤한가요", Games: 5, Wins: 1},
+	}
+
+	got := rankOpeningQuestions(stats, 5, 2)
+	organism := got["organism"]
+	if len(organism) != 2 || organism[0].Question != "살아 있나요" || organism[1].Question != "동물인가요" {
+		t.Fatalf("unexpected organism ranking: %+v", organism)
+	}
+	if organism[0].WinRate != 0.7 || organism[0].Games != 20 || organism[0].Wins != 14 {
+		t.Fatalf("unexpected suggestion stats: %+v", organism[0])
+	}
+	if food := got["food"]; len(food) != 1 || food[0].WinRate != 0.2 {
+		t.Fatalf("unexpected food ranking: %+v", food)
+	}
+}
+
+func TestOpeningSuggestionMiner_Tick(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	repo := qrepo.New(db)
+	if err := repo.AutoMigrate(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	client := testhelper.NewTestValkeyClient(t)
+	t.Cleanup(client.Close)
+	store := qredis.NewOpeningStore(client, logger)
+
+	now := kst(2026, time.October, 18, 6, 0)
+	t.Cleanup(func() {
+		_ = store.ReplaceAll(ctx, nil)
+		_ = store.UnmarkMined(ctx, "2026-10-18")
+	})
+
+	record := func(result qrepo.GameResult, completedAt time.Time, questions ...string) {
+		t.Helper()
+		if err := repo.RecordOpeningQuestions(ctx, qrepo.OpeningQuestionParams{
+			Category:    "place",
+			Result:      result,
+			Questions:   questions,
+			CompletedAt: completedAt,
+			Now:         completedAt,
+		}); err != nil {
+			t.Fatalf("record opening questions failed: %v", err)
+		}
+	}
+	recent := now.AddDate(0, 0, -1)
+	record(qrepo.GameResultCorrect, recent, "실내인가요", "한국에 있나요")
+	record(qrepo.GameResultCorrect, recent, "실내인가요")
+	record(qrepo.GameResultSurrender, recent, "한국에 있나요")
+	record(qrepo.GameResultCorrect, now.AddDate(0, 0, -40), "오래된 질문인가요")
+
+	miner := NewOpeningSuggestionMiner(qconfig.OpeningConfig{
+		RunAtMinute:  5 * 60,
+		LookbackDays: 30,
+		MinGames:     2,
+		TopN:         3,
+	}, repo, store, logger)
+
+	miner.tick(ctx, kst(2026, time.October, 18, 4, 0))
+	if got, _ := store.Get(ctx, "place"); got != nil {
+		t.Fatalf("mining must wait for run minute, got %+v", got)
+	}
+
+	miner.tick(ctx, now)
+	got, err := store.Get(ctx, "place")
+	if err != nil {
+		t.Fatalf("get suggestions failed: %v", err)
+	}
+	if len(got) != 2 || got[0].Question != "실내인가요" || got[0].WinRate != 1 || got[1].WinRate != 0.5 {
+		t.Fatalf("unexpected suggestions: %+v", got)
+	}
+
+	stats, err := repo.OpeningQuestionStats(ctx, now.AddDate(0, -3, 0))
+	if err != nil {
+		t.Fatalf("stats failed: %v", err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("rows past lookback must be pruned, got %+v", stats)
+	}
+}
+
+func TestRiddleService_OpeningSuggestions(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	store := qredis.NewOpeningStore(env.client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err := store.ReplaceAll(ctx, map[string][]qmodel.OpeningSuggestion{
+		"place": {{Question: "실내인가요", Games: 10, Wins: 7, WinRate: 0.7}},
+	}); err != nil {
+		t.Fatalf("ReplaceAll failed: %v", err)
+	}
+	defer func() { _ = store.ReplaceAll(ctx, nil) }()
+
+	chatID := env.chatID("room_opening")
+	text, err := env.svc.Start(ctx, chatID, "user1", []string{"장소"})
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if !strings.HasSuffix(text, "\n\nOpening\n1. 실내인가요? 70%") {
+		t.Fatalf("start message must end with suggestions, got %q", text)
+	}
+
+	// 추천은 이력에 남지 않으므로 예산 줄의 질문 수도 0이어야 함
+	if line, _ := env.svc.BudgetLine(ctx, chatID); line != "Budget 0/20 0/1" {
+		t.Errorf("suggestions must not count as questions, got %q", line)
+	}
+
+	if msg, err := env.svc.SetOpeningSuggestions(ctx, chatID, false); err != nil || msg != "Opening Off" {
+		t.Fatalf("SetOpeningSuggestions(false) = %q, %v", msg, err)
+	}
+	if block := env.svc.openingSuggestionBlock(ctx, chatID, "place"); block != "" {
+		t.Errorf("expected no suggestions when disabled, got %q", block)
+	}
+	if msg, err := env.svc.SetOpeningSuggestions(ctx, chatID, true); err != nil || msg != "Opening On" {
+		t.Fatalf("SetOpeningSuggestions(true) = %q, %v", msg, err)
+	}
+	if block := env.svc.openingSuggestionBlock(ctx, chatID, "place"); block == "" {
+		t.Error("expected suggestions after re-enabling")
+	}
+	if block := env.svc.openingSuggestionBlock(ctx, chatID, "food"); block != "" {
+		t.Errorf("expected no suggestions for unmined category, got %q", block)
+	}
+}
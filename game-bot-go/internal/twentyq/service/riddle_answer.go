@@ -23,6 +23,8 @@ type AnswerOutcome struct {
 	Message         string
 	Scale           qmodel.FiveScaleKo
 	IsAnswerAttempt bool
+	// Combo: 방에서 콤보를 켠 경우의 진행 상황 (정답 시도나 콤보가 꺼진 방은 nil)
+	Combo *ComboProgress
 }
 
 // AnswerWithOutcome: 질문 처리 결과와 함께 답변 타입(정답 시도 여부 등)을 반환합니다.
@@ -51,6 +53,11 @@ func (s *RiddleService) AnswerWithOutcome(
 			return qerrors.SessionNotFoundError{ChatID: chatID}
 		}
 
+		hotseat, err := s.checkHotseatTurn(ctx, chatID, userID)
+		if err != nil {
+			return err
+		}
+
 		normalized, err := s.normalizeAndGuard(ctx, chatID, question)
 		if err != nil {
 			return err
@@ -61,6 +68,10 @@ func (s *RiddleService) AnswerWithOutcome(
 			if guessErr != nil {
 				return guessErr
 			}
+			// 정답이면 세션과 함께 턴제 상태도 정리되었으므로 오답일 때만 차례를 넘김
+			if scale != qmodel.FiveScaleAlwaysYes {
+				s.advanceHotseatTurn(ctx, chatID, hotseat)
+			}
 			out = AnswerOutcome{
 				Message:         outcome,
 				Scale:           scale,
@@ -73,10 +84,12 @@ func (s *RiddleService) AnswerWithOutcome(
 		if err != nil {
 			return err
 		}
+		s.advanceHotseatTurn(ctx, chatID, hotseat)
 		out = AnswerOutcome{
 			Message:         outcome,
 			Scale:           scale,
 			IsAnswerAttempt: false,
+			Combo:           s.advanceCombo(ctx, chatID, scale),
 		}
 		return nil
 	})
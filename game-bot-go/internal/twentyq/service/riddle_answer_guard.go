@@ -8,13 +8,13 @@ import (
 	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
 )
 
-func (s *RiddleService) normalizeAndGuard(ctx context.Context, _ string, question string) (string, error) {
+func (s *RiddleService) normalizeAndGuard(ctx context.Context, chatID string, question string) (string, error) {
 	question = strings.TrimSpace(question)
 	if question == "" {
 		return "", cerrors.InvalidQuestionError{Message: "empty question"}
 	}
 
-	malicious, err := s.restClient.GuardIsMalicious(ctx, question)
+	malicious, err := s.restClient.GuardIsMalicious(llmUsageCtx(ctx, chatID), question)
 	if err != nil {
 		return "", fmt.Errorf("guard check failed: %w", err)
 	}
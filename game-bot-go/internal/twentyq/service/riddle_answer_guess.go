@@ -7,6 +7,7 @@ import (
 
 	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/valkeyx"
 	domainmodels "github.com/park285/llm-kakao-bots/game-bot-go/internal/domain/models"
 	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
@@ -41,7 +42,13 @@ func (s *RiddleService) handleGuess(
 		return s.handleSuccess(ctx, chatID, userID, secret), qmodel.FiveScaleAlwaysYes, nil
 	}
 
-	verifyResp, err := s.restClient.TwentyQVerifyGuess(ctx, secret.Target, guess)
+	// 별칭/임베딩 유사도로 먼저 판정하고, 일치하지 않으면 LLM 정답 검증으로 근접(CLOSE) 여부까지 확인
+	if match := s.synonymMatcher.Match(llmUsageCtx(ctx, chatID), secret.Target, guess); match.Matched {
+		s.logger.Info("guess_synonym_matched", "chat_id", chatID, "source", match.Source, "score", match.Score)
+		return s.handleSuccess(ctx, chatID, userID, secret), qmodel.FiveScaleAlwaysYes, nil
+	}
+
+	verifyResp, err := s.restClient.TwentyQVerifyGuess(llmUsageCtx(ctx, chatID), secret.Target, guess)
 	if err != nil {
 		s.logger.Warn("verify_failed", "chat_id", chatID, "err", err)
 		verifyResp = nil
@@ -52,22 +59,41 @@ func (s *RiddleService) handleGuess(
 		case "ACCEPT":
 			return s.handleSuccess(ctx, chatID, userID, secret), qmodel.FiveScaleAlwaysYes, nil
 		case "CLOSE":
-			if err := s.wrongGuessStore.Add(ctx, chatID, userID, guess); err != nil {
-				return "", qmodel.FiveScaleAlwaysNo, fmt.Errorf("wrong guess add failed: %w", err)
+			if err := s.recordWrongGuess(ctx, chatID, userID, guess); err != nil {
+				return "", qmodel.FiveScaleAlwaysNo, err
 			}
-			return s.msgProvider.Get(qmessages.AnswerCloseCall), qmodel.FiveScaleAlwaysNo, nil
+			return s.msgProvider.For(ctx).Get(qmessages.AnswerCloseCall), qmodel.FiveScaleAlwaysNo, nil
 		default:
 		}
 	}
 
-	if err := s.wrongGuessStore.Add(ctx, chatID, userID, guess); err != nil {
-		return "", qmodel.FiveScaleAlwaysNo, fmt.Errorf("wrong guess add failed: %w", err)
+	if err := s.recordWrongGuess(ctx, chatID, userID, guess); err != nil {
+		return "", qmodel.FiveScaleAlwaysNo, err
 	}
 
-	displayName := domainmodels.DisplayName(chatID, userID, sender, s.msgProvider.Get(qmessages.UserAnonymous))
-	return s.msgProvider.Get(
+	displayName := domainmodels.DisplayName(chatID, userID, sender, s.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
+	return s.msgProvider.For(ctx).Get(
 		qmessages.AnswerWrongGuess,
 		messageprovider.P("nickname", displayName),
 		messageprovider.P("guess", guess),
 	), qmodel.FiveScaleAlwaysNo, nil
 }
+
+// recordWrongGuess: 오답을 기록합니다.
+// Valkey 연결 장애가 재시도 후에도 해소되지 않은 경우 오답 기록만 생략하고 정답 판정 응답은 계속 진행합니다.
+func (s *RiddleService) recordWrongGuess(ctx context.Context, chatID string, userID string, guess string) error {
+	err := s.wrongGuessStore.Add(ctx, chatID, userID, guess)
+	if err == nil {
+		return nil
+	}
+	if cmdErr, ok := valkeyx.AsCommandError(err); ok {
+		s.logger.Warn("wrong_guess_add_skipped",
+			"chat_id", chatID,
+			"reason", cmdErr.Reason,
+			"attempts", cmdErr.Attempts,
+			"err", err,
+		)
+		return nil
+	}
+	return fmt.Errorf("wrong guess add failed: %w", err)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	cerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/errors"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
@@ -50,12 +51,16 @@ func (s *RiddleService) handleRegularQuestionWithFlags(
 	}
 	questionNumber++
 
+	if rules := s.gameRules(ctx, chatID); rules.QuestionLimit && questionNumber > rules.MaxQuestions {
+		return "", qmodel.FiveScaleAlwaysNo, qerrors.QuestionLimitExceededError{MaxQuestions: rules.MaxQuestions}
+	}
+
 	details := parseDetailsOrNil(secret.Description)
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(qconfig.AITimeoutSeconds)*time.Second)
 	defer cancel()
 
-	resp, err := s.restClient.TwentyQAnswerQuestion(timeoutCtx, chatID, qconfig.LlmNamespace, secret.Target, secret.Category, question, details)
+	resp, err := s.restClient.TwentyQAnswerQuestion(llmUsageCtx(timeoutCtx, chatID), chatID, qconfig.LlmNamespace, secret.Target, secret.Category, question, details)
 	if err != nil {
 		return "", qmodel.FiveScaleAlwaysNo, fmt.Errorf("answer question failed: %w", err)
 	}
@@ -96,5 +101,12 @@ func (s *RiddleService) handleRegularQuestionWithFlags(
 		return "", qmodel.FiveScaleAlwaysNo, fmt.Errorf("history add failed: %w", err)
 	}
 
+	s.events.Publish(ctx, eventbus.EventQuestionAnswered, chatID, userIDTrimmed, map[string]any{
+		"questionNumber": questionNumber,
+		"question":       question,
+		"answer":         answerToken,
+		"isChain":        isChain,
+	})
+
 	return answerToken, scale, nil
 }
@@ -43,36 +43,39 @@ func (s *RiddleService) handleSuccess(ctx context.Context, chatID string, answer
 
 	wrongGuessBlock := ""
 	if len(wrongGuesses) > 0 {
-		wrongGuessBlock = s.msgProvider.Get(qmessages.AnswerWrongGuessSection, messageprovider.P("wrongGuesses", strings.Join(wrongGuesses, ", ")))
+		wrongGuessBlock = s.msgProvider.For(ctx).Get(qmessages.AnswerWrongGuessSection, messageprovider.P("wrongGuesses", strings.Join(wrongGuesses, ", ")))
 	}
 
 	var hintBlock string
 	if len(hints) > 0 {
 		hintLines := make([]string, 0, len(hints))
 		for _, h := range hints {
-			hintLines = append(hintLines, s.msgProvider.Get(qmessages.AnswerHintItem, messageprovider.P("question", h.Question), messageprovider.P("answer", h.Answer)))
+			hintLines = append(hintLines, s.msgProvider.For(ctx).Get(qmessages.AnswerHintItem, messageprovider.P("question", h.Question), messageprovider.P("answer", h.Answer)))
 		}
-		hintBlock = s.msgProvider.Get(
+		hintBlock = s.msgProvider.For(ctx).Get(
 			qmessages.AnswerHintSectionUsed,
 			messageprovider.P("hintCount", len(hints)),
 			messageprovider.P("hintList", strings.Join(hintLines, "\n")),
 		)
 	} else {
-		hintBlock = s.msgProvider.Get(qmessages.AnswerHintSectionNone)
+		hintBlock = s.msgProvider.For(ctx).Get(qmessages.AnswerHintSectionNone)
 	}
 
-	successMessage := s.msgProvider.Get(
+	successMessage := s.msgProvider.For(ctx).Get(
 		qmessages.AnswerSuccess,
 		messageprovider.P("target", secret.Target),
 		messageprovider.P("questionCount", questionCount),
 		messageprovider.P("hintCount", hintCount),
-		messageprovider.P("maxHints", qconfig.MaxHintsTotal),
+		messageprovider.P("maxHints", s.hintAllowance(ctx, chatID, s.gameRules(ctx, chatID))),
 		messageprovider.P("wrongGuessBlock", wrongGuessBlock),
 		messageprovider.P("hintBlock", hintBlock),
 	)
 
 	completedAt := time.Now()
 	s.recordGameCompletionIfEnabled(ctx, chatID, secret, GameResultCorrect, &answererID, history, hintCount, questionCount, completedAt)
+	s.publishGameCompleted(ctx, chatID, secret, GameResultCorrect, answererID, questionCount, hintCount)
+
+	successMessage += s.recordTournamentRound(ctx, chatID, answererID, questionCount)
 
 	categoryKey := strings.TrimSpace(secret.Category)
 	_ = s.topicHistoryStore.AddCompletedTopic(ctx, chatID, categoryKey, secret.Target, 20)
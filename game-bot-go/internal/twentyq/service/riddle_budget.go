@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+)
+
+// BudgetLine: 답변/힌트 응답 하단에 붙일 사용량 줄을 반환합니다. (예: 질문 12/20 · 힌트 1/1)
+// 방에서 숨김으로 설정했거나 저장소가 없으면 빈 문자열을 반환합니다.
+func (s *RiddleService) BudgetLine(ctx context.Context, chatID string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" || s.budgetStore == nil {
+		return "", nil
+	}
+
+	budget, err := s.budgetStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("budget get failed: %w", err)
+	}
+	if budget.Hidden {
+		return "", nil
+	}
+
+	rules := s.gameRules(ctx, chatID)
+	return s.msgProvider.For(ctx).Get(
+		qmessages.BudgetLine,
+		messageprovider.P("questions", budget.Questions),
+		messageprovider.P("maxQuestions", rules.MaxQuestions),
+		messageprovider.P("hints", budget.Hints),
+		messageprovider.P("maxHints", s.hintAllowance(ctx, chatID, rules)),
+	), nil
+}
+
+// SetBudgetHidden: 방의 예산 줄 표시 여부를 변경하고 안내 메시지를 반환합니다.
+func (s *RiddleService) SetBudgetHidden(ctx context.Context, chatID string, hidden bool) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.budgetStore == nil {
+		return "", fmt.Errorf("budget store not configured")
+	}
+
+	if err := s.budgetStore.SetHidden(ctx, chatID, hidden); err != nil {
+		return "", fmt.Errorf("budget hidden set failed: %w", err)
+	}
+	if hidden {
+		return s.msgProvider.For(ctx).Get(qmessages.BudgetHidden), nil
+	}
+	return s.msgProvider.For(ctx).Get(qmessages.BudgetShown), nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// comboProgressMin: 진행 줄을 보여주기 시작하는 연속 '예' 수 (한 번은 콤보로 보지 않음)
+const comboProgressMin = 2
+
+// ComboProgress: 질문 답변 직후의 콤보 진행 상황
+type ComboProgress struct {
+	Current  int
+	Streak   int
+	Unlocked bool
+}
+
+// advanceCombo: 방에서 콤보를 켠 경우 답변을 콤보에 반영합니다. 채팅방 락 안에서 호출해야 합니다.
+// 콤보가 꺼져 있거나 저장소 오류가 나면 nil을 반환하고 게임은 그대로 진행합니다.
+func (s *RiddleService) advanceCombo(ctx context.Context, chatID string, scale qmodel.FiveScaleKo) *ComboProgress {
+	if s.comboStore == nil {
+		return nil
+	}
+	streak := s.gameRules(ctx, chatID).ComboStreak
+	if streak <= 0 {
+		return nil
+	}
+
+	state, err := s.comboStore.Get(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("combo_get_failed", "chat_id", chatID, "err", err)
+		return nil
+	}
+	unlocked := state.Advance(qmodel.IsComboAnswer(scale), streak)
+	if err := s.comboStore.Save(ctx, chatID, state); err != nil {
+		s.logger.Warn("combo_save_failed", "chat_id", chatID, "err", err)
+		return nil
+	}
+	if unlocked {
+		s.logger.Info("combo_hint_unlocked", "chat_id", chatID, "streak", streak, "bonus", state.Bonus)
+	}
+	return &ComboProgress{Current: state.Current, Streak: streak, Unlocked: unlocked}
+}
+
+// ComboLine: 답변 아래에 붙일 콤보 안내 줄을 반환합니다. (보여줄 내용이 없으면 빈 문자열)
+func (s *RiddleService) ComboLine(ctx context.Context, progress *ComboProgress) string {
+	switch {
+	case progress == nil:
+		return ""
+	case progress.Unlocked:
+		return s.msgProvider.For(ctx).Get(
+			qmessages.ComboUnlocked,
+			messageprovider.P("streak", progress.Streak),
+			messageprovider.P("prefix", s.commandPrefix),
+		)
+	case progress.Current >= comboProgressMin:
+		return s.msgProvider.For(ctx).Get(
+			qmessages.ComboProgress,
+			messageprovider.P("current", progress.Current),
+			messageprovider.P("streak", progress.Streak),
+		)
+	default:
+		return ""
+	}
+}
+
+// hintAllowance: 방 규칙의 최대 힌트 수에 콤보로 얻은 무료 힌트를 더한 이번 게임의 힌트 한도를 반환합니다.
+func (s *RiddleService) hintAllowance(ctx context.Context, chatID string, rules GameRules) int {
+	if s.comboStore == nil || rules.ComboStreak <= 0 {
+		return rules.MaxHints
+	}
+	state, err := s.comboStore.Get(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("combo_get_failed", "chat_id", chatID, "err", err)
+		return rules.MaxHints
+	}
+	return rules.MaxHints + state.Bonus
+}
+
+// maxCombo: 게임 기록용으로 이번 게임의 최대 연속 '예' 수를 조회합니다. (콤보 기록이 없으면 0)
+func (s *RiddleService) maxCombo(ctx context.Context, chatID string) int {
+	if s.comboStore == nil {
+		return 0
+	}
+	state, err := s.comboStore.Get(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("combo_get_failed", "chat_id", chatID, "err", err)
+		return 0
+	}
+	return state.Max
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
-	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
@@ -35,12 +35,14 @@ func (s *RiddleService) GenerateHint(ctx context.Context, chatID string) (string
 		if err != nil {
 			return fmt.Errorf("hint count get failed: %w", err)
 		}
-		if hintCount >= qconfig.MaxHintsTotal {
-			return qerrors.HintLimitExceededError{MaxHints: qconfig.MaxHintsTotal, HintCount: hintCount, Remaining: 0}
+		maxHints := s.hintAllowance(ctx, chatID, s.gameRules(ctx, chatID))
+		if hintCount >= maxHints {
+			return qerrors.HintLimitExceededError{MaxHints: maxHints, HintCount: hintCount, Remaining: 0}
 		}
 
 		details := parseDetailsOrNil(secret.Description)
-		hintsResp, err := s.restClient.TwentyQGenerateHints(ctx, secret.Target, secret.Category, details)
+		feedback := s.hintFeedback.PromptFeedback(ctx, secret.Category)
+		hintsResp, err := s.restClient.TwentyQGenerateHintsWithFeedback(llmUsageCtx(ctx, chatID), secret.Target, secret.Category, details, feedback)
 		if err != nil {
 			return fmt.Errorf("generate hints failed: %w", err)
 		}
@@ -68,7 +70,12 @@ func (s *RiddleService) GenerateHint(ctx context.Context, chatID string) (string
 			return fmt.Errorf("history add failed: %w", err)
 		}
 
-		out = s.msgProvider.Get(
+		s.events.Publish(ctx, eventbus.EventHintUsed, chatID, "", map[string]any{
+			"hintNumber": hintNumber,
+			"maxHints":   maxHints,
+		})
+
+		out = s.msgProvider.For(ctx).Get(
 			qmessages.HintGenerated,
 			messageprovider.P("hintNumber", hintNumber),
 			messageprovider.P("content", hintText),
@@ -102,5 +109,5 @@ func (s *RiddleService) CanGenerateHint(ctx context.Context, chatID string) (boo
 		return false, fmt.Errorf("hint count get failed: %w", err)
 	}
 
-	return hintCount < qconfig.MaxHintsTotal, nil
+	return hintCount < s.hintAllowance(ctx, chatID, s.gameRules(ctx, chatID)), nil
 }
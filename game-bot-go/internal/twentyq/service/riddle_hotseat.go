@@ -0,0 +1,413 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	domainmodels "github.com/park285/llm-kakao-bots/game-bot-go/internal/domain/models"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+const hotseatTurnTimeout = time.Duration(qconfig.HotseatTurnTimeoutSeconds) * time.Second
+
+// HotseatTimeoutNotice: 차례 시간이 지나 자동으로 넘어간 방과 안내 문구
+type HotseatTimeoutNotice struct {
+	ChatID string
+	Text   string
+}
+
+// HotseatCommand: 턴제 모드 하위 명령(켜기/끄기/참가/빠지기/섞기/제외/순서)을 처리합니다.
+// 모드 전환과 순서 관리(섞기/제외)는 게임을 시작한 사람만 할 수 있습니다.
+func (s *RiddleService) HotseatCommand(
+	ctx context.Context,
+	chatID string,
+	userID string,
+	sender *string,
+	action qmodel.HotseatAction,
+	nickname string,
+) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.hotseatStore == nil {
+		return "", fmt.Errorf("hotseat store not configured")
+	}
+
+	if action == qmodel.HotseatShow {
+		return s.hotseatOrder(ctx, chatID)
+	}
+
+	holderName := userID
+	out := ""
+	err := s.lockManager.WithLock(ctx, chatID, &holderName, func(ctx context.Context) error {
+		secret, err := s.sessionStore.GetSecret(ctx, chatID)
+		if err != nil {
+			return fmt.Errorf("secret get failed: %w", err)
+		}
+		if secret == nil {
+			return qerrors.SessionNotFoundError{ChatID: chatID}
+		}
+
+		switch action {
+		case qmodel.HotseatOn, qmodel.HotseatOff:
+			out, err = s.setHotseatMode(ctx, chatID, userID, *secret, action == qmodel.HotseatOn)
+		default:
+			out, err = s.updateHotseatOrder(ctx, chatID, userID, sender, action, nickname)
+		}
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("hotseat command failed: %w", err)
+	}
+	return out, nil
+}
+
+// PassTurn: 현재 차례인 참가자가 질문 없이 차례를 넘깁니다.
+func (s *RiddleService) PassTurn(ctx context.Context, chatID string, userID string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.hotseatStore == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.HotseatNotActive, messageprovider.P("prefix", s.commandPrefix)), nil
+	}
+
+	holderName := userID
+	out := ""
+	err := s.lockManager.WithLock(ctx, chatID, &holderName, func(ctx context.Context) error {
+		hotseat, err := s.checkHotseatTurn(ctx, chatID, userID)
+		if err != nil {
+			return err
+		}
+		if hotseat == nil {
+			out = s.msgProvider.For(ctx).Get(qmessages.HotseatNotActive, messageprovider.P("prefix", s.commandPrefix))
+			return nil
+		}
+
+		current, _ := hotseat.CurrentPlayer()
+		hotseat.Advance(time.Now(), qmodel.HotseatTurnPassed)
+		if err := s.hotseatStore.Save(ctx, chatID, *hotseat); err != nil {
+			return fmt.Errorf("hotseat save failed: %w", err)
+		}
+		out = s.msgProvider.For(ctx).Get(qmessages.HotseatPassed, messageprovider.P("nickname", s.hotseatName(ctx, chatID, current))) +
+			"\n" + s.hotseatTurnLine(ctx, chatID, *hotseat)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("pass turn failed: %w", err)
+	}
+	return out, nil
+}
+
+// HotseatActive: 진행 중인 게임이 턴제로 진행되는지 여부를 반환합니다.
+func (s *RiddleService) HotseatActive(ctx context.Context, chatID string) (bool, error) {
+	hotseat, err := s.getHotseat(ctx, chatID)
+	if err != nil {
+		return false, err
+	}
+	return hotseat != nil, nil
+}
+
+// HotseatTurnLine: 답변 하단에 붙일 '다음 차례' 안내를 반환합니다. (턴제가 아니면 빈 문자열)
+func (s *RiddleService) HotseatTurnLine(ctx context.Context, chatID string) (string, error) {
+	hotseat, err := s.getHotseat(ctx, chatID)
+	if err != nil || hotseat == nil {
+		return "", err
+	}
+	return s.hotseatTurnLine(ctx, strings.TrimSpace(chatID), *hotseat), nil
+}
+
+// SkipExpiredTurns: 제한 시간을 넘긴 차례를 다음 참가자에게 넘기고 방별 안내 문구를 반환합니다.
+// 방마다 게임 락을 잡으므로 질문 처리와 겹치지 않습니다.
+func (s *RiddleService) SkipExpiredTurns(ctx context.Context, now time.Time) ([]HotseatTimeoutNotice, error) {
+	if s.hotseatStore == nil {
+		return nil, nil
+	}
+	rooms, err := s.hotseatStore.ActiveRooms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("hotseat rooms failed: %w", err)
+	}
+
+	var notices []HotseatTimeoutNotice
+	for _, chatID := range rooms {
+		if ctx.Err() != nil {
+			break
+		}
+
+		holderName := "hotseat-watcher"
+		err := s.lockManager.WithLock(ctx, chatID, &holderName, func(ctx context.Context) error {
+			ctx = s.WithChatLocale(ctx, chatID)
+			hotseat, err := s.hotseatStore.Get(ctx, chatID)
+			if err != nil {
+				return fmt.Errorf("hotseat get failed: %w", err)
+			}
+			if hotseat == nil {
+				// 세션 TTL로 상태만 만료된 방은 감시 대상에서 정리
+				return s.hotseatStore.Delete(ctx, chatID)
+			}
+			if !hotseat.TurnExpired(now, hotseatTurnTimeout) {
+				return nil
+			}
+
+			current, _ := hotseat.CurrentPlayer()
+			hotseat.Advance(now, qmodel.HotseatTurnTimedOut)
+			if err := s.hotseatStore.Save(ctx, chatID, *hotseat); err != nil {
+				return fmt.Errorf("hotseat save failed: %w", err)
+			}
+			s.logger.Info("hotseat_turn_timed_out", "chat_id", chatID, "user_id", current.UserID)
+
+			notices = append(notices, HotseatTimeoutNotice{
+				ChatID: chatID,
+				Text: s.msgProvider.For(ctx).Get(
+					qmessages.HotseatTimedOut,
+					messageprovider.P("nickname", s.hotseatName(ctx, chatID, current)),
+					messageprovider.P("timeout", qconfig.HotseatTurnTimeoutSeconds),
+				) + "\n" + s.hotseatTurnLine(ctx, chatID, *hotseat),
+			})
+			return nil
+		})
+		if err != nil {
+			s.logger.Warn("hotseat_skip_failed", "chat_id", chatID, "err", err)
+		}
+	}
+	return notices, nil
+}
+
+func (s *RiddleService) setHotseatMode(ctx context.Context, chatID string, userID string, secret qmodel.RiddleSecret, enabled bool) (string, error) {
+	if secret.StartedBy != "" && secret.StartedBy != strings.TrimSpace(userID) {
+		return s.msgProvider.For(ctx).Get(qmessages.HotseatDenied), nil
+	}
+
+	if err := s.hotseatStore.SetMode(ctx, chatID, enabled); err != nil {
+		return "", fmt.Errorf("hotseat mode set failed: %w", err)
+	}
+
+	if !enabled {
+		if err := s.hotseatStore.Delete(ctx, chatID); err != nil {
+			return "", fmt.Errorf("hotseat delete failed: %w", err)
+		}
+		s.logger.Info("hotseat_disabled", "chat_id", chatID)
+		return s.msgProvider.For(ctx).Get(qmessages.HotseatDisabled), nil
+	}
+
+	current, err := s.hotseatStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("hotseat get failed: %w", err)
+	}
+	if current == nil {
+		startedBy := secret.StartedBy
+		if startedBy == "" {
+			startedBy = strings.TrimSpace(userID)
+		}
+		if err := s.hotseatStore.Save(ctx, chatID, qmodel.Hotseat{StartedBy: startedBy}); err != nil {
+			return "", fmt.Errorf("hotseat save failed: %w", err)
+		}
+	}
+	s.logger.Info("hotseat_enabled", "chat_id", chatID)
+	return s.msgProvider.For(ctx).Get(
+		qmessages.HotseatEnabled,
+		messageprovider.P("prefix", s.commandPrefix),
+		messageprovider.P("timeout", qconfig.HotseatTurnTimeoutSeconds),
+	), nil
+}
+
+func (s *RiddleService) updateHotseatOrder(
+	ctx context.Context,
+	chatID string,
+	userID string,
+	sender *string,
+	action qmodel.HotseatAction,
+	nickname string,
+) (string, error) {
+	hotseat, err := s.hotseatStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("hotseat get failed: %w", err)
+	}
+	if hotseat == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.HotseatNotActive, messageprovider.P("prefix", s.commandPrefix)), nil
+	}
+
+	userID = strings.TrimSpace(userID)
+	now := time.Now()
+	var text string
+
+	switch action {
+	case qmodel.HotseatJoin:
+		player := qmodel.PlayerInfo{UserID: userID}
+		if sender != nil {
+			player.Sender = strings.TrimSpace(*sender)
+		}
+		if !hotseat.Join(player, now) {
+			return s.msgProvider.For(ctx).Get(qmessages.HotseatAlreadyJoined), nil
+		}
+		text = s.msgProvider.For(ctx).Get(
+			qmessages.HotseatJoined,
+			messageprovider.P("nickname", s.hotseatName(ctx, chatID, player)),
+			messageprovider.P("position", len(hotseat.Order)),
+		)
+	case qmodel.HotseatLeave:
+		player, ok := hotseat.FindByUserID(userID)
+		if !ok {
+			return s.msgProvider.For(ctx).Get(qmessages.HotseatNotJoined), nil
+		}
+		hotseat.Leave(userID, now)
+		text = s.msgProvider.For(ctx).Get(qmessages.HotseatLeft, messageprovider.P("nickname", s.hotseatName(ctx, chatID, player)))
+	case qmodel.HotseatShuffle:
+		if !hotseatManager(*hotseat, userID) {
+			return s.msgProvider.For(ctx).Get(qmessages.HotseatDenied), nil
+		}
+		hotseat.Shuffle(now)
+		text = s.buildHotseatOrder(ctx, chatID, *hotseat)
+	case qmodel.HotseatKick:
+		if !hotseatManager(*hotseat, userID) {
+			return s.msgProvider.For(ctx).Get(qmessages.HotseatDenied), nil
+		}
+		player, ok := hotseat.FindByNickname(nickname)
+		if !ok {
+			return s.msgProvider.For(ctx).Get(qmessages.HotseatPlayerNotFound, messageprovider.P("nickname", nickname)), nil
+		}
+		hotseat.Leave(player.UserID, now)
+		text = s.msgProvider.For(ctx).Get(qmessages.HotseatKicked, messageprovider.P("nickname", s.hotseatName(ctx, chatID, player)))
+	default:
+		return s.buildHotseatOrder(ctx, chatID, *hotseat), nil
+	}
+
+	if err := s.hotseatStore.Save(ctx, chatID, *hotseat); err != nil {
+		return "", fmt.Errorf("hotseat save failed: %w", err)
+	}
+	if line := s.hotseatTurnLine(ctx, chatID, *hotseat); line != "" && action != qmodel.HotseatShuffle {
+		text += "\n" + line
+	}
+	return text, nil
+}
+
+func (s *RiddleService) hotseatOrder(ctx context.Context, chatID string) (string, error) {
+	hotseat, err := s.getHotseat(ctx, chatID)
+	if err != nil {
+		return "", err
+	}
+	if hotseat == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.HotseatNotActive, messageprovider.P("prefix", s.commandPrefix)), nil
+	}
+	return s.buildHotseatOrder(ctx, chatID, *hotseat), nil
+}
+
+// checkHotseatTurn: 턴제 게임이면 질문자가 현재 차례인지 확인합니다. (턴제가 아니면 nil, nil)
+func (s *RiddleService) checkHotseatTurn(ctx context.Context, chatID string, userID string) (*qmodel.Hotseat, error) {
+	hotseat, err := s.getHotseat(ctx, chatID)
+	if err != nil || hotseat == nil {
+		return nil, err
+	}
+
+	userID = strings.TrimSpace(userID)
+	if !hotseat.IsJoined(userID) {
+		return nil, qerrors.HotseatNotJoinedError{}
+	}
+	current, _ := hotseat.CurrentPlayer()
+	if current.UserID != userID {
+		return nil, qerrors.NotYourTurnError{CurrentSender: s.hotseatName(ctx, chatID, current)}
+	}
+	return hotseat, nil
+}
+
+// advanceHotseatTurn: 질문/오답 처리가 끝난 뒤 차례를 넘깁니다. 저장 실패는 응답을 막지 않습니다.
+func (s *RiddleService) advanceHotseatTurn(ctx context.Context, chatID string, hotseat *qmodel.Hotseat) {
+	if hotseat == nil {
+		return
+	}
+	hotseat.Advance(time.Now(), qmodel.HotseatTurnPlayed)
+	if err := s.hotseatStore.Save(ctx, chatID, *hotseat); err != nil {
+		s.logger.Warn("hotseat_advance_failed", "chat_id", chatID, "err", err)
+	}
+}
+
+// openHotseatIfEnabled: 방에 턴제 모드가 켜져 있으면 새 게임의 턴제 상태를 만들고 시작 안내를 반환합니다.
+func (s *RiddleService) openHotseatIfEnabled(ctx context.Context, chatID string, userID string) string {
+	if s.hotseatStore == nil {
+		return ""
+	}
+	enabled, err := s.hotseatStore.ModeEnabled(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("hotseat_mode_get_failed", "chat_id", chatID, "err", err)
+		return ""
+	}
+	if !enabled {
+		return ""
+	}
+	if err := s.hotseatStore.Save(ctx, chatID, qmodel.Hotseat{StartedBy: strings.TrimSpace(userID)}); err != nil {
+		s.logger.Warn("hotseat_save_failed", "chat_id", chatID, "err", err)
+		return ""
+	}
+	return "\n\n" + s.msgProvider.For(ctx).Get(
+		qmessages.HotseatStartNotice,
+		messageprovider.P("prefix", s.commandPrefix),
+		messageprovider.P("timeout", qconfig.HotseatTurnTimeoutSeconds),
+	)
+}
+
+func (s *RiddleService) getHotseat(ctx context.Context, chatID string) (*qmodel.Hotseat, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" || s.hotseatStore == nil {
+		return nil, nil
+	}
+	hotseat, err := s.hotseatStore.Get(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("hotseat get failed: %w", err)
+	}
+	return hotseat, nil
+}
+
+func (s *RiddleService) buildHotseatOrder(ctx context.Context, chatID string, hotseat qmodel.Hotseat) string {
+	if len(hotseat.Order) == 0 {
+		return s.msgProvider.For(ctx).Get(qmessages.HotseatOrderEmpty, messageprovider.P("prefix", s.commandPrefix))
+	}
+
+	current, _ := hotseat.CurrentPlayer()
+	lines := make([]string, 0, len(hotseat.Order))
+	for i, p := range hotseat.Order {
+		marker := "  "
+		if p.UserID == current.UserID {
+			marker = "▶ "
+		}
+		average := ""
+		if avg := hotseat.Stats[p.UserID].AverageTurn(); avg > 0 {
+			average = s.msgProvider.For(ctx).Get(qmessages.HotseatOrderAverage, messageprovider.P("seconds", int(avg.Round(time.Second).Seconds())))
+		}
+		lines = append(lines, s.msgProvider.For(ctx).Get(
+			qmessages.HotseatOrderItem,
+			messageprovider.P("marker", marker),
+			messageprovider.P("index", i+1),
+			messageprovider.P("nickname", s.hotseatName(ctx, chatID, p)),
+			messageprovider.P("average", average),
+		))
+	}
+	return s.msgProvider.For(ctx).Get(
+		qmessages.HotseatOrder,
+		messageprovider.P("timeout", qconfig.HotseatTurnTimeoutSeconds),
+		messageprovider.P("order", strings.Join(lines, "\n")),
+	)
+}
+
+func (s *RiddleService) hotseatTurnLine(ctx context.Context, chatID string, hotseat qmodel.Hotseat) string {
+	current, ok := hotseat.CurrentPlayer()
+	if !ok {
+		return ""
+	}
+	return s.msgProvider.For(ctx).Get(qmessages.HotseatTurn, messageprovider.P("nickname", s.hotseatName(ctx, chatID, current)))
+}
+
+func (s *RiddleService) hotseatName(ctx context.Context, chatID string, player qmodel.PlayerInfo) string {
+	return domainmodels.DisplayName(chatID, player.UserID, &player.Sender, s.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
+}
+
+// hotseatManager: 순서 관리 권한 확인 (시작한 사람이 기록되지 않은 게임은 누구나 허용)
+func hotseatManager(hotseat qmodel.Hotseat, userID string) bool {
+	return hotseat.StartedBy == "" || hotseat.StartedBy == strings.TrimSpace(userID)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+)
+
+// localeResetValues: 방 언어 설정을 지우고 기본 언어로 되돌리는 입력값
+var localeResetValues = map[string]bool{"기본": true, "default": true, "reset": true}
+
+// localeAliases: 언어 명령에서 받는 별칭 → 언어 코드
+var localeAliases = map[string]string{
+	"한국어":      messageprovider.LocaleKo,
+	"한글":       messageprovider.LocaleKo,
+	"korean":   messageprovider.LocaleKo,
+	"kr":       messageprovider.LocaleKo,
+	"영어":       messageprovider.LocaleEn,
+	"english":  messageprovider.LocaleEn,
+	"英語":       messageprovider.LocaleEn,
+	"일본어":      messageprovider.LocaleJa,
+	"japanese": messageprovider.LocaleJa,
+	"日本語":      messageprovider.LocaleJa,
+	"jp":       messageprovider.LocaleJa,
+}
+
+// localeNames: 언어 코드별 표시 이름 (어느 언어로 안내하든 각 언어의 고유 이름을 쓴다)
+var localeNames = map[string]string{
+	messageprovider.LocaleKo: "한국어",
+	messageprovider.LocaleEn: "English",
+	messageprovider.LocaleJa: "日本語",
+}
+
+// SetLocales: 방별 언어 저장소와 기본 언어를 연결합니다.
+// 등록되지 않은 기본 언어는 무시하고 한국어를 씁니다. store가 nil이면 모든 방이 기본 언어로 응답합니다.
+func (s *RiddleService) SetLocales(store *qredis.LocaleStore, defaultLocale string) {
+	s.localeStore = store
+	s.defaultLocale = messageprovider.DefaultLocale
+
+	defaultLocale = messageprovider.NormalizeLocale(defaultLocale)
+	if defaultLocale == "" || defaultLocale == messageprovider.DefaultLocale {
+		return
+	}
+	if !s.msgProvider.HasLocale(defaultLocale) {
+		s.logger.Warn("default_locale_unsupported", "locale", defaultLocale)
+		return
+	}
+	s.defaultLocale = defaultLocale
+}
+
+// ChatLocale: 방에 적용되는 메시지 언어 코드를 반환합니다. (방 설정 → 기본 언어)
+func (s *RiddleService) ChatLocale(ctx context.Context, chatID string) string {
+	fallback := s.defaultLocale
+	if fallback == "" {
+		fallback = messageprovider.DefaultLocale
+	}
+	if s.localeStore == nil {
+		return fallback
+	}
+
+	locale, err := s.localeStore.Get(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("chat_locale_get_failed", "chat_id", chatID, "err", err)
+		return fallback
+	}
+	if locale == "" || !s.msgProvider.HasLocale(locale) {
+		return fallback
+	}
+	return locale
+}
+
+// WithChatLocale: 방의 메시지 언어를 컨텍스트에 실어 반환합니다.
+// 이후 msgProvider.For(ctx)로 만든 문구는 모두 이 언어로 렌더링됩니다.
+func (s *RiddleService) WithChatLocale(ctx context.Context, chatID string) context.Context {
+	return messageprovider.WithLocale(ctx, s.ChatLocale(ctx, chatID))
+}
+
+// UpdateChatLocale: 언어 명령을 처리합니다. 값이 없으면 현재 언어를 보여주고,
+// 언어를 바꾸면 안내 문구를 새 언어로 반환합니다.
+func (s *RiddleService) UpdateChatLocale(ctx context.Context, chatID string, userID string, value string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.localeStore == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.LocaleUnavailable), nil
+	}
+
+	available := s.availableLocalesText()
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		current := s.ChatLocale(ctx, chatID)
+		return s.msgProvider.For(ctx).Get(
+			qmessages.LocaleShow,
+			messageprovider.P("language", localeDisplayName(current)),
+			messageprovider.P("available", available),
+			messageprovider.P("prefix", s.commandPrefix),
+		), nil
+	}
+
+	if localeResetValues[value] {
+		if err := s.localeStore.Delete(ctx, chatID); err != nil {
+			return "", fmt.Errorf("chat locale delete failed: %w", err)
+		}
+		s.logger.Info("chat_locale_reset", "chat_id", chatID, "user_id", userID)
+		ctx = s.WithChatLocale(ctx, chatID)
+		return s.msgProvider.For(ctx).Get(
+			qmessages.LocaleReset,
+			messageprovider.P("language", localeDisplayName(messageprovider.LocaleFromContext(ctx))),
+		), nil
+	}
+
+	locale, ok := s.resolveLocale(value)
+	if !ok {
+		return s.msgProvider.For(ctx).Get(qmessages.LocaleInvalid, messageprovider.P("available", available)), nil
+	}
+	if err := s.localeStore.Set(ctx, chatID, locale); err != nil {
+		return "", fmt.Errorf("chat locale set failed: %w", err)
+	}
+	ctx = messageprovider.WithLocale(ctx, locale)
+	return s.msgProvider.For(ctx).Get(qmessages.LocaleUpdated, messageprovider.P("language", localeDisplayName(locale))), nil
+}
+
+// resolveLocale: 사용자가 입력한 언어 코드/별칭을 등록된 언어 코드로 바꿉니다.
+func (s *RiddleService) resolveLocale(value string) (string, bool) {
+	if alias, ok := localeAliases[value]; ok {
+		value = alias
+	}
+	locale := messageprovider.NormalizeLocale(value)
+	if !s.msgProvider.HasLocale(locale) {
+		return "", false
+	}
+	return locale, true
+}
+
+func (s *RiddleService) availableLocalesText() string {
+	locales := s.msgProvider.Locales()
+	parts := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		parts = append(parts, fmt.Sprintf("%s(%s)", localeDisplayName(locale), locale))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func localeDisplayName(locale string) string {
+	if name, ok := localeNames[locale]; ok {
+		return name
+	}
+	return locale
+}
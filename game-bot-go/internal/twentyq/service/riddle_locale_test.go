@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+)
+
+func TestRiddleService_UpdateChatLocale(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	bundle, err := messageprovider.NewFromYAML(`
+budget:
+  hidden: "EN Budget Hidden"
+locale:
+  updated: "EN Locale Updated {language}"
+`)
+	if err != nil {
+		t.Fatalf("bundle init failed: %v", err)
+	}
+	if err := env.svc.msgProvider.AddLocale(messageprovider.LocaleEn, bundle); err != nil {
+		t.Fatalf("AddLocale failed: %v", err)
+	}
+	env.svc.SetLocales(qredis.NewLocaleStore(env.client, slog.Default()), "")
+
+	ctx := context.Background()
+	chatID := env.chatID("room_locale")
+
+	text, err := env.svc.UpdateChatLocale(ctx, chatID, "user1", "")
+	if err != nil {
+		t.Fatalf("UpdateChatLocale show failed: %v", err)
+	}
+	if text != "Locale 한국어 [한국어(ko), English(en)]" {
+		t.Fatalf("unexpected show text: %q", text)
+	}
+
+	if text, _ := env.svc.UpdateChatLocale(ctx, chatID, "user1", "klingon"); text != "Locale Invalid [한국어(ko), English(en)]" {
+		t.Fatalf("unexpected invalid text: %q", text)
+	}
+
+	// 변경 안내는 새 언어로, 이후 메시지는 방 언어로 렌더링된다
+	if text, _ := env.svc.UpdateChatLocale(ctx, chatID, "user1", "English"); text != "EN Locale Updated English" {
+		t.Fatalf("unexpected updated text: %q", text)
+	}
+	if got := env.svc.ChatLocale(ctx, chatID); got != messageprovider.LocaleEn {
+		t.Fatalf("expected en, got %q", got)
+	}
+	localized := env.svc.WithChatLocale(ctx, chatID)
+	if text, _ := env.svc.SetBudgetHidden(localized, chatID, true); text != "EN Budget Hidden" {
+		t.Fatalf("expected english budget text, got %q", text)
+	}
+	// 번들에 없는 키는 기본 번들로 대체
+	if text, _ := env.svc.SetBudgetHidden(localized, chatID, false); text != "Budget Shown" {
+		t.Fatalf("expected fallback budget text, got %q", text)
+	}
+
+	if text, _ := env.svc.UpdateChatLocale(localized, chatID, "user1", "기본"); text != "Locale Reset 한국어" {
+		t.Fatalf("unexpected reset text: %q", text)
+	}
+	if got := env.svc.ChatLocale(ctx, chatID); got != messageprovider.LocaleKo {
+		t.Fatalf("expected ko after reset, got %q", got)
+	}
+}
+
+func TestRiddleService_SetLocalesDefault(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	if err := env.svc.msgProvider.AddLocale(messageprovider.LocaleJa, &messageprovider.Provider{}); err != nil {
+		t.Fatalf("AddLocale failed: %v", err)
+	}
+	ctx := context.Background()
+	chatID := env.chatID("room_locale_default")
+
+	env.svc.SetLocales(nil, "fr")
+	if got := env.svc.ChatLocale(ctx, chatID); got != messageprovider.LocaleKo {
+		t.Fatalf("unsupported default should fall back to ko, got %q", got)
+	}
+	env.svc.SetLocales(nil, "ja_JP")
+	if got := env.svc.ChatLocale(ctx, chatID); got != "ja-jp" {
+		t.Fatalf("expected ja-jp default, got %q", got)
+	}
+	if text, _ := env.svc.UpdateChatLocale(ctx, chatID, "user1", "en"); text != "Locale Unavailable" {
+		t.Fatalf("expected unavailable without store, got %q", text)
+	}
+}
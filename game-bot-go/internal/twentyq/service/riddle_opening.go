@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+)
+
+// openingSuggestionBlock: 시작 메시지에 덧붙일 카테고리별 추천 첫 질문 블록을 반환합니다.
+// 추천은 안내 문구일 뿐이므로 이력에 남지 않고 질문 수에도 포함되지 않습니다.
+// 방에서 꺼 두었거나 집계 결과가 없으면 빈 문자열을 반환합니다.
+func (s *RiddleService) openingSuggestionBlock(ctx context.Context, chatID string, category string) string {
+	category = strings.TrimSpace(category)
+	if s.openingStore == nil || category == "" {
+		return ""
+	}
+
+	enabled, err := s.openingStore.Enabled(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("opening_enabled_get_failed", "chat_id", chatID, "err", err)
+		return ""
+	}
+	if !enabled {
+		return ""
+	}
+
+	suggestions, err := s.openingStore.Get(ctx, category)
+	if err != nil {
+		s.logger.Warn("opening_get_failed", "chat_id", chatID, "category", category, "err", err)
+		return ""
+	}
+	if len(suggestions) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(suggestions)+1)
+	lines = append(lines, s.msgProvider.For(ctx).Get(qmessages.OpeningHeader))
+	for i, sg := range suggestions {
+		lines = append(lines, s.msgProvider.For(ctx).Get(
+			qmessages.OpeningItem,
+			messageprovider.P("index", i+1),
+			messageprovider.P("question", sg.Question),
+			messageprovider.P("winRate", int(math.Round(sg.WinRate*100))),
+		))
+	}
+	return "\n\n" + strings.Join(lines, "\n")
+}
+
+// SetOpeningSuggestions: 방의 추천 첫 질문 표시 여부를 변경하고 안내 메시지를 반환합니다.
+func (s *RiddleService) SetOpeningSuggestions(ctx context.Context, chatID string, enabled bool) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.openingStore == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.OpeningUnavailable), nil
+	}
+
+	if err := s.openingStore.SetEnabled(ctx, chatID, enabled); err != nil {
+		return "", fmt.Errorf("opening enabled set failed: %w", err)
+	}
+	if enabled {
+		return s.msgProvider.For(ctx).Get(qmessages.OpeningEnabled), nil
+	}
+	return s.msgProvider.For(ctx).Get(qmessages.OpeningDisabled), nil
+}
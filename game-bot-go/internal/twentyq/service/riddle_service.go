@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
@@ -29,9 +30,22 @@ type RiddleService struct {
 	topicHistoryStore *qredis.TopicHistoryStore
 	voteStore         *qredis.SurrenderVoteStore
 	guessRateLimiter  *qredis.GuessRateLimiter
+	themeEventStore   *qredis.ThemeEventStore
+	budgetStore       *qredis.BudgetStore
+	tournamentStore   *qredis.TournamentStore
+	hotseatStore      *qredis.HotseatStore
+	openingStore      *qredis.OpeningStore
+	chatSettingsStore *qredis.ChatSettingsStore
+	comboStore        *qredis.ComboStore
+	localeStore       *qredis.LocaleStore // 언어 설정 비활성화 시 nil (SetLocales)
+	defaultLocale     string
 
-	statsRecorder *StatsRecorder
-	logger        *slog.Logger
+	statsRecorder  *StatsRecorder
+	hintFeedback   *HintFeedbackService // 힌트 평가 비활성화 시 nil
+	seasons        *SeasonService       // 시즌 비활성화 시 nil
+	synonymMatcher *SynonymMatcher      // 동의어 판정 비활성화 시 nil
+	events         *eventbus.Publisher
+	logger         *slog.Logger
 
 	playerRegistrationOnce    sync.Once
 	playerRegistrationTasks   chan playerRegistrationTask
@@ -54,7 +68,15 @@ func NewRiddleService(
 	topicHistoryStore *qredis.TopicHistoryStore,
 	voteStore *qredis.SurrenderVoteStore,
 	guessRateLimiter *qredis.GuessRateLimiter,
+	themeEventStore *qredis.ThemeEventStore,
+	budgetStore *qredis.BudgetStore,
+	tournamentStore *qredis.TournamentStore,
+	hotseatStore *qredis.HotseatStore,
+	openingStore *qredis.OpeningStore,
+	chatSettingsStore *qredis.ChatSettingsStore,
+	comboStore *qredis.ComboStore,
 	statsRecorder *StatsRecorder,
+	events *eventbus.Publisher,
 	logger *slog.Logger,
 ) *RiddleService {
 	svc := &RiddleService{
@@ -71,12 +93,26 @@ func NewRiddleService(
 		topicHistoryStore: topicHistoryStore,
 		voteStore:         voteStore,
 		guessRateLimiter:  guessRateLimiter,
+		themeEventStore:   themeEventStore,
+		budgetStore:       budgetStore,
+		tournamentStore:   tournamentStore,
+		hotseatStore:      hotseatStore,
+		openingStore:      openingStore,
+		chatSettingsStore: chatSettingsStore,
+		comboStore:        comboStore,
 		statsRecorder:     statsRecorder,
+		events:            events,
 		logger:            logger,
 	}
 	return svc
 }
 
+// llmUsageCtx: LLM 호출 사용량을 방(chatID) 단위로 귀속시키는 컨텍스트를 만듭니다.
+// 귀속된 사용량은 게임이 끝날 때 세션 기록에 합산됩니다.
+func llmUsageCtx(ctx context.Context, chatID string) context.Context {
+	return llmrest.WithUsageAttribution(ctx, chatID)
+}
+
 // HasSession: 세션 존재 여부를 확인합니다.
 func (s *RiddleService) HasSession(ctx context.Context, chatID string) (bool, error) {
 	chatID = strings.TrimSpace(chatID)
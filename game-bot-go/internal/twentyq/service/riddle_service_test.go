@@ -8,6 +8,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/glebarez/sqlite"
@@ -21,6 +22,7 @@ import (
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
 	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
 	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
@@ -33,6 +35,7 @@ type testEnv struct {
 	client       valkey.Client
 	db           *gorm.DB
 	mockResponse string
+	lastSummary  *llmv1.TwentyQSummarizeGameRequest
 	t            *testing.T
 	prefix       string
 }
@@ -91,6 +94,16 @@ func setupTestEnv(t *testing.T) *testEnv {
 			}
 			return &llmv1.TwentyQGenerateHintsResponse{Hints: payload.Hints}, nil
 		},
+		answerQuestion: func(_ *llmv1.TwentyQAnswerQuestionRequest) (*llmv1.TwentyQAnswerQuestionResponse, error) {
+			var payload struct {
+				Scale string `json:"scale"`
+			}
+			_ = json.Unmarshal([]byte(env.mockResponse), &payload)
+			if payload.Scale == "" {
+				payload.Scale = "아니오"
+			}
+			return &llmv1.TwentyQAnswerQuestionResponse{Scale: &payload.Scale, RawText: payload.Scale}, nil
+		},
 		verifyGuess: func(_ *llmv1.TwentyQVerifyGuessRequest) (*llmv1.TwentyQVerifyGuessResponse, error) {
 			var payload struct {
 				Result string `json:"result"`
@@ -102,6 +115,13 @@ func setupTestEnv(t *testing.T) *testEnv {
 			}
 			return &llmv1.TwentyQVerifyGuessResponse{Result: &result, RawText: result}, nil
 		},
+		summarizeGame: func(req *llmv1.TwentyQSummarizeGameRequest) (*llmv1.TwentyQSummarizeGameResponse, error) {
+			env.lastSummary = req
+			return &llmv1.TwentyQSummarizeGameResponse{
+				Summary:    "Big and not bread.",
+				Eliminated: []string{"small things", "Bread"},
+			}, nil
+		},
 	}
 	baseURL, stop := testhelper.StartTestGRPCServer(t, func(s *grpc.Server) {
 		llmv1.RegisterLLMServiceServer(s, stub)
@@ -125,6 +145,57 @@ status:
   wrong_guesses: "Wrong: {guesses}"
   question_answer: "Q: {question} A: {answer}"
   chain_suffix: "+"
+summary:
+  header: "Summary {questions}/{hints}"
+  eliminated: "Eliminated: {items}"
+  empty: "Summary Empty"
+budget:
+  line: "Budget {questions}/{maxQuestions} {hints}/{maxHints}"
+  hidden: "Budget Hidden"
+  shown: "Budget Shown"
+opening:
+  header: "Opening"
+  item: "{index}. {question}? {winRate}%"
+  enabled: "Opening On"
+  disabled: "Opening Off"
+  unavailable: "Opening Unavailable"
+tournament:
+  started: "Tournament {rounds}"
+  round_header: "Round {round}/{total}"
+  round_result: "RoundResult {round}/{total} {nickname} +{points}"
+  round_no_winner: "RoundNoWinner {round}/{total}"
+  standings: "Standings {completed}/{total}\n{standings}"
+  standings_empty: "Standings Empty"
+  standing_item: "{rank}. {nickname} {points} ({wins})"
+  next_round: "Next {round} {prefix}"
+  final: "Final {total} {nickname} {points}\n{standings}"
+  final_no_winner: "Final No Winner {total}"
+  cancelled: "Tournament Cancelled"
+  cancel_denied: "Cancel Denied"
+  not_found: "No Tournament"
+  already_running: "Already Running {round}/{total}"
+  game_in_progress: "Game In Progress"
+  invalid_rounds: "Invalid Rounds {min}-{max}"
+hotseat:
+  enabled: "Hotseat On"
+  disabled: "Hotseat Off"
+  denied: "Hotseat Denied"
+  not_active: "Hotseat Inactive"
+  start_notice: "Hotseat Notice"
+  joined: "Joined {nickname} #{position}"
+  already_joined: "Already Joined"
+  left: "Left {nickname}"
+  not_joined: "Not Joined"
+  kicked: "Kicked {nickname}"
+  player_not_found: "Player Not Found {nickname}"
+  order: "Order\n{order}"
+  order_empty: "Order Empty"
+  order_item: "{marker}{index}. {nickname}{average}"
+  order_average: " avg {seconds}s"
+  turn: "Turn {nickname}"
+  passed: "Passed {nickname}"
+  timed_out: "Timed Out {nickname}"
+  chain_disabled: "Chain Disabled"
 vote:
   start: "Vote Started"
   in_progress: "Vote In Progress"
@@ -154,6 +225,12 @@ answer:
   hint_section_used: "\nHints used ({hintCount}):\n{hintList}"
   hint_section_none: "\nNo Hints"
   close_call: "Close Call"
+locale:
+  show: "Locale {language} [{available}]"
+  updated: "Locale Updated {language}"
+  reset: "Locale Reset {language}"
+  invalid: "Locale Invalid [{available}]"
+  unavailable: "Locale Unavailable"
 `)
 	if err != nil {
 		t.Fatalf("msg provider init failed: %v", err)
@@ -176,7 +253,15 @@ answer:
 		topicHistoryStore,
 		voteStore,
 		nil, // guessRateLimiter
+		nil, // themeEventStore
+		qredis.NewBudgetStore(client, logger),
+		qredis.NewTournamentStore(client, logger),
+		qredis.NewHotseatStore(client, logger),
+		qredis.NewOpeningStore(client, logger),
+		qredis.NewChatSettingsStore(client, logger),
+		qredis.NewComboStore(client, logger),
 		statsRecorder,
+		nil, // events
 		logger,
 	)
 	env.svc = svc
@@ -523,6 +608,63 @@ func TestRiddleService_Status(t *testing.T) {
 	}
 }
 
+func TestRiddleService_Summary(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+	ctx := context.Background()
+	chatID := env.chatID("room_summary")
+	userID := "user1"
+
+	env.svc.Start(ctx, chatID, userID, nil)
+
+	// 아직 아무 진행이 없으면 LLM 호출 없이 안내
+	text, err := env.svc.Summary(ctx, chatID)
+	if err != nil {
+		t.Fatalf("Summary failed: %v", err)
+	}
+	if text != "Summary Empty" || env.lastSummary != nil {
+		t.Fatalf("expected empty summary without llm call, got %q", text)
+	}
+
+	env.svc.historyStore.Add(ctx, chatID, qmodel.QuestionHistory{
+		QuestionNumber: 1,
+		Question:       "Is it huge?",
+		Answer:         "YES",
+		UserID:         &userID,
+	})
+	env.svc.historyStore.Add(ctx, chatID, qmodel.QuestionHistory{
+		QuestionNumber: -1,
+		Answer:         "It is bigger than bread.",
+	})
+	env.svc.wrongGuessStore.Add(ctx, chatID, userID, "Bread")
+
+	text, err = env.svc.Summary(ctx, chatID)
+	if err != nil {
+		t.Fatalf("Summary failed: %v", err)
+	}
+	if !strings.Contains(text, "Summary 1/1") || !strings.Contains(text, "Big and not bread.") || !strings.Contains(text, "Eliminated: small things, Bread") {
+		t.Errorf("unexpected summary text: %q", text)
+	}
+
+	req := env.lastSummary
+	if req == nil {
+		t.Fatal("expected summarize request")
+	}
+	if len(req.History) != 1 || req.History[0].Question != "Is it huge?" || req.History[0].Answer != "YES" {
+		t.Errorf("unexpected history: %v", req.History)
+	}
+	if len(req.Hints) != 1 || req.Hints[0] != "It is bigger than bread." {
+		t.Errorf("unexpected hints: %v", req.Hints)
+	}
+	if len(req.WrongGuesses) != 1 || req.WrongGuesses[0] != "Bread" {
+		t.Errorf("unexpected wrong guesses: %v", req.WrongGuesses)
+	}
+
+	if _, err := env.svc.Summary(ctx, env.chatID("room_summary_missing")); !errors.As(err, new(qerrors.SessionNotFoundError)) {
+		t.Errorf("expected session not found, got %v", err)
+	}
+}
+
 func TestRiddleService_GenerateHint(t *testing.T) {
 	env := setupTestEnv(t)
 	defer env.teardown()
@@ -567,6 +709,111 @@ func TestRiddleService_GenerateHint(t *testing.T) {
 	}
 }
 
+func TestRiddleService_BudgetLine(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	chatID := env.chatID("room_budget")
+	userID := "user1"
+	sender := "UserOne"
+
+	env.svc.Start(ctx, chatID, userID, nil)
+
+	env.mockResponse = `{"answer": "아니오"}`
+	if _, err := env.svc.Answer(ctx, chatID, userID, &sender, "이것은 음식인가요?"); err != nil {
+		t.Fatalf("Answer failed: %v", err)
+	}
+	env.mockResponse = `{"hints": ["It has fur"]}`
+	if _, err := env.svc.GenerateHint(ctx, chatID); err != nil {
+		t.Fatalf("GenerateHint failed: %v", err)
+	}
+
+	// 힌트도 이력에 남지만 질문 수에는 포함되지 않아야 함
+	line, err := env.svc.BudgetLine(ctx, chatID)
+	if err != nil {
+		t.Fatalf("BudgetLine failed: %v", err)
+	}
+	if line != "Budget 1/20 1/1" {
+		t.Errorf("unexpected budget line: %q", line)
+	}
+
+	// 방별 숨김 설정
+	if msg, err := env.svc.SetBudgetHidden(ctx, chatID, true); err != nil || msg != "Budget Hidden" {
+		t.Fatalf("SetBudgetHidden(true) = %q, %v", msg, err)
+	}
+	if line, _ := env.svc.BudgetLine(ctx, chatID); line != "" {
+		t.Errorf("expected hidden budget line, got %q", line)
+	}
+	if msg, err := env.svc.SetBudgetHidden(ctx, chatID, false); err != nil || msg != "Budget Shown" {
+		t.Fatalf("SetBudgetHidden(false) = %q, %v", msg, err)
+	}
+	if line, _ := env.svc.BudgetLine(ctx, chatID); line == "" {
+		t.Error("expected budget line after re-enabling")
+	}
+}
+
+func TestRiddleService_Tournament(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	chatID := env.chatID("room_tournament")
+	userID := "user1"
+	sender := "UserOne"
+
+	if msg, _ := env.svc.StartTournament(ctx, chatID, userID, 1, nil); msg != "Invalid Rounds 2-10" {
+		t.Fatalf("expected invalid rounds message, got %q", msg)
+	}
+
+	resp, err := env.svc.StartTournament(ctx, chatID, userID, 2, []string{"사물"})
+	if err != nil {
+		t.Fatalf("StartTournament failed: %v", err)
+	}
+	if !strings.Contains(resp, "Tournament 2") || !strings.Contains(resp, "Round 1/2") {
+		t.Fatalf("unexpected start message: %q", resp)
+	}
+	if msg, _ := env.svc.StartTournament(ctx, chatID, userID, 3, nil); msg != "Already Running 1/2" {
+		t.Fatalf("expected already running message, got %q", msg)
+	}
+
+	// 라운드 1: 질문 없이 정답 → 기본 점수 + 질문 예산 전체 보너스
+	secret, _ := env.svc.sessionStore.GetSecret(ctx, chatID)
+	env.svc.playerStore.Add(ctx, chatID, userID, sender)
+	resp, err = env.svc.Answer(ctx, chatID, userID, &sender, "정답 "+secret.Target)
+	if err != nil {
+		t.Fatalf("Answer failed: %v", err)
+	}
+	if !strings.Contains(resp, "RoundResult 1/2 UserOne +30") || !strings.Contains(resp, "1. UserOne 30 (1)") || !strings.Contains(resp, "Next 2 /20q") {
+		t.Fatalf("unexpected round result: %q", resp)
+	}
+
+	// 다른 참가자는 토너먼트를 중단할 수 없음
+	if msg, _ := env.svc.CancelTournament(ctx, chatID, "user2"); msg != "Cancel Denied" {
+		t.Fatalf("expected cancel denied, got %q", msg)
+	}
+
+	// 라운드 2: 카테고리 생략 시 토너먼트 카테고리 사용, 포기로 종료
+	resp, err = env.svc.Start(ctx, chatID, userID, nil)
+	if err != nil {
+		t.Fatalf("Start round 2 failed: %v", err)
+	}
+	if !strings.Contains(resp, "Round 2/2") {
+		t.Fatalf("expected round header, got %q", resp)
+	}
+	resp, err = env.svc.Surrender(ctx, chatID)
+	if err != nil {
+		t.Fatalf("Surrender failed: %v", err)
+	}
+	if !strings.Contains(resp, "Final 2 UserOne 30") {
+		t.Fatalf("expected final announcement, got %q", resp)
+	}
+
+	if msg, _ := env.svc.TournamentStandings(ctx, chatID); msg != "No Tournament" {
+		t.Fatalf("expected finished tournament to be removed, got %q", msg)
+	}
+}
+
 func TestRiddleService_Start_Resume(t *testing.T) {
 	env := setupTestEnv(t)
 	defer env.teardown()
@@ -782,7 +1029,7 @@ func TestRiddleService_MaliciousInput(t *testing.T) {
 	// Need to initialize session
 	sStore.SaveSecret(ctx, chatID, qmodel.RiddleSecret{Target: "T"})
 
-	svc := NewRiddleService(llmClient, "/20q", msgProvider, qredis.NewLockManager(valkeyClient, logger), sStore, nil, qredis.NewHistoryStore(valkeyClient, logger), nil, nil, nil, nil, nil, nil, nil, logger)
+	svc := NewRiddleService(llmClient, "/20q", msgProvider, qredis.NewLockManager(valkeyClient, logger), sStore, nil, qredis.NewHistoryStore(valkeyClient, logger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, logger)
 
 	_, err = svc.Answer(ctx, chatID, user1, nil, "bad input")
 	if err == nil {
@@ -863,7 +1110,7 @@ func TestRiddleService_Normalize_EdgeCases(t *testing.T) {
 		_ = client.Close()
 	})
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	svc := NewRiddleService(client, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, logger)
+	svc := NewRiddleService(client, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, logger)
 
 	ctx := context.Background()
 
@@ -876,3 +1123,90 @@ func TestRiddleService_Normalize_EdgeCases(t *testing.T) {
 		t.Errorf("expected fallback to raw input, got %s", norm)
 	}
 }
+
+func TestRiddleService_Hotseat(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	chatID := env.chatID("room_hotseat")
+	alice, bob := "user1", "user2"
+	aliceName, bobName := "Alice", "Bob"
+
+	if _, err := env.svc.Start(ctx, chatID, alice, nil); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	// 시작한 사람만 모드를 전환할 수 있음
+	if msg, _ := env.svc.HotseatCommand(ctx, chatID, bob, &bobName, qmodel.HotseatOn, ""); msg != "Hotseat Denied" {
+		t.Fatalf("expected denied, got %q", msg)
+	}
+	if msg, _ := env.svc.HotseatCommand(ctx, chatID, alice, &aliceName, qmodel.HotseatOn, ""); msg != "Hotseat On" {
+		t.Fatalf("expected enabled, got %q", msg)
+	}
+
+	// 등록 전에는 질문할 수 없음
+	_, err := env.svc.Answer(ctx, chatID, alice, &aliceName, "정답 무언가")
+	var notJoined qerrors.HotseatNotJoinedError
+	if !errors.As(err, &notJoined) {
+		t.Fatalf("expected not joined error, got %v", err)
+	}
+
+	if msg, _ := env.svc.HotseatCommand(ctx, chatID, alice, &aliceName, qmodel.HotseatJoin, ""); msg != "Joined Alice #1\nTurn Alice" {
+		t.Fatalf("unexpected join message: %q", msg)
+	}
+	if _, err := env.svc.HotseatCommand(ctx, chatID, bob, &bobName, qmodel.HotseatJoin, ""); err != nil {
+		t.Fatalf("join failed: %v", err)
+	}
+
+	// 차례가 아닌 참가자
+	_, err = env.svc.PassTurn(ctx, chatID, bob)
+	var notYourTurn qerrors.NotYourTurnError
+	if !errors.As(err, &notYourTurn) || notYourTurn.CurrentSender != aliceName {
+		t.Fatalf("expected not your turn error, got %v", err)
+	}
+
+	if msg, _ := env.svc.PassTurn(ctx, chatID, alice); msg != "Passed Alice\nTurn Bob" {
+		t.Fatalf("unexpected pass message: %q", msg)
+	}
+
+	// 제한 시간이 지나면 감시기가 다음 사람에게 넘김
+	notices, err := env.svc.SkipExpiredTurns(ctx, time.Now().Add(2*time.Duration(qconfig.HotseatTurnTimeoutSeconds)*time.Second))
+	if err != nil {
+		t.Fatalf("SkipExpiredTurns failed: %v", err)
+	}
+	var notice string
+	for _, n := range notices {
+		if n.ChatID == chatID {
+			notice = n.Text
+		}
+	}
+	if notice != "Timed Out Bob\nTurn Alice" {
+		t.Fatalf("unexpected timeout notice: %q (all: %+v)", notice, notices)
+	}
+
+	order, _ := env.svc.HotseatCommand(ctx, chatID, bob, &bobName, qmodel.HotseatShow, "")
+	if !strings.Contains(order, "▶ 1. Alice") || !strings.Contains(order, "2. Bob avg") {
+		t.Fatalf("unexpected order: %q", order)
+	}
+
+	// 정답으로 끝나면 이번 게임의 순서는 정리되고 방 설정은 유지
+	secret, _ := env.svc.sessionStore.GetSecret(ctx, chatID)
+	if _, err := env.svc.Answer(ctx, chatID, alice, &aliceName, "정답 "+secret.Target); err != nil {
+		t.Fatalf("Answer failed: %v", err)
+	}
+	if active, _ := env.svc.HotseatActive(ctx, chatID); active {
+		t.Fatal("hotseat state must be cleared with the session")
+	}
+	if enabled, _ := env.svc.hotseatStore.ModeEnabled(ctx, chatID); !enabled {
+		t.Fatal("room hotseat mode must persist across games")
+	}
+
+	resp, err := env.svc.Start(ctx, chatID, bob, nil)
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if !strings.Contains(resp, "Hotseat Notice") {
+		t.Fatalf("expected hotseat notice on new game, got %q", resp)
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// GameRules: 방 설정을 기본 규칙과 합친 실제 적용 규칙
+type GameRules struct {
+	MaxQuestions int `json:"maxQuestions"`
+	// QuestionLimit: 방에서 질문 수를 지정한 경우에만 초과 질문을 거절합니다. (기본은 표시용 예산)
+	QuestionLimit     bool     `json:"questionLimit"`
+	MaxHints          int      `json:"maxHints"`
+	AllowedCategories []string `json:"allowedCategories"`
+	// SurrenderVotes: 포기 투표 필요 인원 (0이면 참여 인원 기준 기본 규칙)
+	SurrenderVotes int `json:"surrenderVotes"`
+	// ComboStreak: 연속 '예' 답변이 이 수에 닿으면 무료 힌트 1개 (0이면 콤보 꺼짐)
+	ComboStreak int `json:"comboStreak"`
+}
+
+// ResolveGameRules: 방 설정의 빈 값을 컴파일 시점 기본값으로 채운 규칙을 반환합니다.
+func ResolveGameRules(settings qmodel.ChatSettings) GameRules {
+	rules := GameRules{
+		MaxQuestions:      qconfig.QuestionBudget,
+		MaxHints:          qconfig.MaxHintsTotal,
+		AllowedCategories: settings.AllowedCategories,
+		SurrenderVotes:    settings.SurrenderVotes,
+		ComboStreak:       settings.ComboStreak,
+	}
+	if settings.MaxQuestions > 0 {
+		rules.MaxQuestions = settings.MaxQuestions
+		rules.QuestionLimit = true
+	}
+	if settings.MaxHints > 0 {
+		rules.MaxHints = settings.MaxHints
+	}
+	return rules
+}
+
+// gameRules: 방의 실제 적용 규칙을 조회합니다. 설정 조회에 실패하면 게임이 멈추지 않도록 기본 규칙을 사용합니다.
+func (s *RiddleService) gameRules(ctx context.Context, chatID string) GameRules {
+	if s.chatSettingsStore == nil {
+		return ResolveGameRules(qmodel.ChatSettings{})
+	}
+	settings, err := s.chatSettingsStore.Get(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("chat_settings_get_failed", "chat_id", chatID, "err", err)
+		return ResolveGameRules(qmodel.ChatSettings{})
+	}
+	return ResolveGameRules(settings)
+}
+
+// constrainCategory: 방에서 허용한 카테고리로 출제 범위를 좁힙니다.
+// 선택한 카테고리가 허용 목록에 없거나 선택하지 않았으면 허용 목록에서 무작위로 고릅니다.
+func constrainCategory(selectedKey string, allowed []string) (key string, rejected bool) {
+	if len(allowed) == 0 || slices.Contains(allowed, selectedKey) {
+		return selectedKey, false
+	}
+	return allowed[randInt(len(allowed))], selectedKey != ""
+}
+
+// ChatSettingsText: 방의 현재 게임 설정을 안내 메시지로 반환합니다.
+func (s *RiddleService) ChatSettingsText(ctx context.Context, chatID string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.chatSettingsStore == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.SettingsUnavailable), nil
+	}
+
+	settings, err := s.chatSettingsStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("chat settings get failed: %w", err)
+	}
+	return s.buildChatSettingsText(ctx, ResolveGameRules(settings)), nil
+}
+
+// UpdateChatSettings: 방 설정 명령(항목과 값)을 적용하고 안내 메시지를 반환합니다.
+// 진행 중인 게임의 규칙이 중간에 바뀌지 않도록 게임이 없을 때만 변경할 수 있습니다.
+func (s *RiddleService) UpdateChatSettings(ctx context.Context, chatID string, userID string, action qmodel.ChatSettingsAction, value string) (string, error) {
+	if action == qmodel.ChatSettingsShow {
+		return s.ChatSettingsText(ctx, chatID)
+	}
+
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.chatSettingsStore == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.SettingsUnavailable), nil
+	}
+
+	inGame, err := s.sessionStore.Exists(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("session exists check failed: %w", err)
+	}
+	if inGame {
+		return s.msgProvider.For(ctx).Get(qmessages.SettingsInGame), nil
+	}
+
+	if action == qmodel.ChatSettingsReset {
+		if err := s.chatSettingsStore.Delete(ctx, chatID); err != nil {
+			return "", fmt.Errorf("chat settings delete failed: %w", err)
+		}
+		s.logger.Info("chat_settings_reset", "chat_id", chatID, "user_id", userID)
+		return s.msgProvider.For(ctx).Get(qmessages.SettingsReset), nil
+	}
+
+	settings, err := s.chatSettingsStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("chat settings get failed: %w", err)
+	}
+
+	next, ok := applyChatSettingsValue(settings, action, value)
+	if !ok {
+		return s.buildChatSettingsInvalid(ctx), nil
+	}
+	next, err = next.Validate(qconfig.AllCategories)
+	if err != nil {
+		if errors.Is(err, qmodel.ErrInvalidChatSettings) {
+			return s.buildChatSettingsInvalid(ctx), nil
+		}
+		return "", fmt.Errorf("chat settings validate failed: %w", err)
+	}
+	next.UpdatedBy = strings.TrimSpace(userID)
+	next.UpdatedAt = time.Now()
+
+	if err := s.chatSettingsStore.Save(ctx, chatID, next); err != nil {
+		return "", fmt.Errorf("chat settings save failed: %w", err)
+	}
+	return s.msgProvider.For(ctx).Get(qmessages.SettingsUpdated) + "\n\n" + s.buildChatSettingsText(ctx, ResolveGameRules(next)), nil
+}
+
+// applyChatSettingsValue: 명령 값을 설정 항목에 반영합니다. '기본'(콤보는 '끄기'도)은 해당 항목을 기본값으로 되돌립니다.
+func applyChatSettingsValue(settings qmodel.ChatSettings, action qmodel.ChatSettingsAction, value string) (qmodel.ChatSettings, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return settings, false
+	}
+	reset := value == "기본" || strings.EqualFold(value, "default")
+
+	if action == qmodel.ChatSettingsCategories {
+		if reset || value == "전체" || strings.EqualFold(value, "all") {
+			settings.AllowedCategories = nil
+			return settings, true
+		}
+		fields := strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+		categories := make([]string, 0, len(fields))
+		for _, field := range fields {
+			key := normalizeCategoryInput(field)
+			if key == "" {
+				return settings, false
+			}
+			categories = append(categories, key)
+		}
+		settings.AllowedCategories = categories
+		return settings, true
+	}
+
+	n := 0
+	if action == qmodel.ChatSettingsCombo && (value == "끄기" || strings.EqualFold(value, "off")) {
+		reset = true
+	}
+	if !reset {
+		parsed, err := strconv.Atoi(strings.TrimRight(value, "명개번"))
+		if err != nil || parsed <= 0 {
+			return settings, false
+		}
+		n = parsed
+	}
+
+	switch action {
+	case qmodel.ChatSettingsMaxQuestions:
+		settings.MaxQuestions = n
+	case qmodel.ChatSettingsMaxHints:
+		settings.MaxHints = n
+	case qmodel.ChatSettingsSurrenderVotes:
+		settings.SurrenderVotes = n
+	case qmodel.ChatSettingsCombo:
+		settings.ComboStreak = n
+	default:
+		return settings, false
+	}
+	return settings, true
+}
+
+func (s *RiddleService) buildChatSettingsText(ctx context.Context, rules GameRules) string {
+	questionMode := s.msgProvider.For(ctx).Get(qmessages.SettingsQuestionBudget)
+	if rules.QuestionLimit {
+		questionMode = s.msgProvider.For(ctx).Get(qmessages.SettingsQuestionLimit)
+	}
+
+	categories := s.msgProvider.For(ctx).Get(qmessages.SettingsCategoriesAll)
+	if len(rules.AllowedCategories) > 0 {
+		names := make([]string, 0, len(rules.AllowedCategories))
+		for _, key := range rules.AllowedCategories {
+			if name := categoryToKorean(key); name != nil {
+				names = append(names, *name)
+			}
+		}
+		categories = strings.Join(names, ", ")
+	}
+
+	surrender := s.msgProvider.For(ctx).Get(qmessages.SettingsSurrenderDefault)
+	if rules.SurrenderVotes > 0 {
+		surrender = s.msgProvider.For(ctx).Get(qmessages.SettingsSurrenderVotes, messageprovider.P("votes", rules.SurrenderVotes))
+	}
+
+	combo := s.msgProvider.For(ctx).Get(qmessages.SettingsComboOff)
+	if rules.ComboStreak > 0 {
+		combo = s.msgProvider.For(ctx).Get(qmessages.SettingsComboStreak, messageprovider.P("streak", rules.ComboStreak))
+	}
+
+	return s.msgProvider.For(ctx).Get(
+		qmessages.SettingsShow,
+		messageprovider.P("maxQuestions", rules.MaxQuestions),
+		messageprovider.P("questionMode", questionMode),
+		messageprovider.P("maxHints", rules.MaxHints),
+		messageprovider.P("categories", categories),
+		messageprovider.P("surrender", surrender),
+		messageprovider.P("combo", combo),
+		messageprovider.P("prefix", s.commandPrefix),
+	)
+}
+
+func (s *RiddleService) buildChatSettingsInvalid(ctx context.Context) string {
+	return s.msgProvider.For(ctx).Get(
+		qmessages.SettingsInvalid,
+		messageprovider.P("minQuestions", qmodel.ChatSettingsMinQuestions),
+		messageprovider.P("maxQuestions", qmodel.ChatSettingsMaxQuestionsLimit),
+		messageprovider.P("maxHints", qmodel.ChatSettingsMaxHintsLimit),
+		messageprovider.P("maxVotes", qmodel.ChatSettingsSurrenderVotesLimit),
+		messageprovider.P("minCombo", qmodel.ChatSettingsMinComboStreak),
+		messageprovider.P("maxCombo", qmodel.ChatSettingsMaxComboStreak),
+		messageprovider.P("prefix", s.commandPrefix),
+	)
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+func TestResolveGameRules(t *testing.T) {
+	defaults := ResolveGameRules(qmodel.ChatSettings{})
+	if defaults.MaxQuestions != 20 || defaults.QuestionLimit || defaults.MaxHints != 1 || defaults.SurrenderVotes != 0 {
+		t.Fatalf("unexpected default rules: %+v", defaults)
+	}
+
+	rules := ResolveGameRules(qmodel.ChatSettings{MaxQuestions: 30, MaxHints: 3, SurrenderVotes: 2})
+	if rules.MaxQuestions != 30 || !rules.QuestionLimit || rules.MaxHints != 3 || rules.SurrenderVotes != 2 {
+		t.Fatalf("unexpected overridden rules: %+v", rules)
+	}
+}
+
+func TestApplyChatSettingsValue(t *testing.T) {
+	base := qmodel.ChatSettings{MaxQuestions: 30, AllowedCategories: []string{"food"}}
+
+	got, ok := applyChatSettingsValue(base, qmodel.ChatSettingsCategories, "음식, 장소 movie")
+	if !ok || !slices.Equal(got.AllowedCategories, []string{"food", "place", "movie"}) {
+		t.Fatalf("categories = %v, %v", got.AllowedCategories, ok)
+	}
+	if got, ok := applyChatSettingsValue(base, qmodel.ChatSettingsCategories, "전체"); !ok || got.AllowedCategories != nil {
+		t.Fatalf("expected all categories, got %v, %v", got.AllowedCategories, ok)
+	}
+	if _, ok := applyChatSettingsValue(base, qmodel.ChatSettingsCategories, "우주선"); ok {
+		t.Fatal("unknown category must be rejected")
+	}
+
+	if got, ok := applyChatSettingsValue(base, qmodel.ChatSettingsSurrenderVotes, "2명"); !ok || got.SurrenderVotes != 2 {
+		t.Fatalf("surrender votes = %d, %v", got.SurrenderVotes, ok)
+	}
+	if got, ok := applyChatSettingsValue(base, qmodel.ChatSettingsMaxQuestions, "기본"); !ok || got.MaxQuestions != 0 {
+		t.Fatalf("expected default max questions, got %d, %v", got.MaxQuestions, ok)
+	}
+	if got, ok := applyChatSettingsValue(base, qmodel.ChatSettingsCombo, "4번"); !ok || got.ComboStreak != 4 {
+		t.Fatalf("combo streak = %d, %v", got.ComboStreak, ok)
+	}
+	if got, ok := applyChatSettingsValue(qmodel.ChatSettings{ComboStreak: 4}, qmodel.ChatSettingsCombo, "끄기"); !ok || got.ComboStreak != 0 {
+		t.Fatalf("expected combo off, got %d, %v", got.ComboStreak, ok)
+	}
+	if _, ok := applyChatSettingsValue(base, qmodel.ChatSettingsMaxHints, "끄기"); ok {
+		t.Fatal("'끄기' must only apply to combo")
+	}
+	if _, ok := applyChatSettingsValue(base, qmodel.ChatSettingsMaxHints, "많이"); ok {
+		t.Fatal("non-numeric value must be rejected")
+	}
+}
+
+func TestConstrainCategory(t *testing.T) {
+	if key, rejected := constrainCategory("food", nil); key != "food" || rejected {
+		t.Fatalf("no restriction must keep selection, got %q, %v", key, rejected)
+	}
+	if key, rejected := constrainCategory("food", []string{"food", "place"}); key != "food" || rejected {
+		t.Fatalf("allowed selection must be kept, got %q, %v", key, rejected)
+	}
+	if key, rejected := constrainCategory("movie", []string{"place"}); key != "place" || !rejected {
+		t.Fatalf("disallowed selection must fall back, got %q, %v", key, rejected)
+	}
+	if key, rejected := constrainCategory("", []string{"place"}); key != "place" || rejected {
+		t.Fatalf("random start must pick an allowed category, got %q, %v", key, rejected)
+	}
+}
+
+func TestRiddleService_ChatSettings(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	chatID := env.chatID("room_settings")
+	userID := "user1"
+	sender := "UserOne"
+
+	for _, step := range []struct {
+		action qmodel.ChatSettingsAction
+		value  string
+	}{
+		{qmodel.ChatSettingsMaxQuestions, "5"},
+		{qmodel.ChatSettingsMaxHints, "2"},
+		{qmodel.ChatSettingsCategories, "장소"},
+		{qmodel.ChatSettingsSurrenderVotes, "1"},
+	} {
+		if _, err := env.svc.UpdateChatSettings(ctx, chatID, userID, step.action, step.value); err != nil {
+			t.Fatalf("UpdateChatSettings(%v) failed: %v", step.action, err)
+		}
+	}
+	if msg, _ := env.svc.UpdateChatSettings(ctx, chatID, userID, qmodel.ChatSettingsMaxHints, "9"); msg == qmessages.SettingsUpdated {
+		t.Fatal("out-of-range value must be rejected")
+	}
+
+	// 허용되지 않은 카테고리를 고르면 허용 목록에서 출제
+	if _, err := env.svc.Start(ctx, chatID, userID, []string{"음식"}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	secret, _ := env.svc.sessionStore.GetSecret(ctx, chatID)
+	if secret == nil || secret.Category != "place" {
+		t.Fatalf("expected allowed category, got %+v", secret)
+	}
+
+	if msg, _ := env.svc.UpdateChatSettings(ctx, chatID, userID, qmodel.ChatSettingsMaxHints, "3"); msg != qmessages.SettingsInGame {
+		t.Fatalf("settings must be locked during a game, got %q", msg)
+	}
+
+	// 방 설정의 힌트 수(2)까지 허용
+	for i := range 2 {
+		if _, err := env.svc.GenerateHint(ctx, chatID); err != nil {
+			t.Fatalf("hint %d failed: %v", i+1, err)
+		}
+	}
+	var hintLimit qerrors.HintLimitExceededError
+	if _, err := env.svc.GenerateHint(ctx, chatID); !errors.As(err, &hintLimit) || hintLimit.MaxHints != 2 {
+		t.Fatalf("expected hint limit 2, got %v", err)
+	}
+
+	// 방 설정의 질문 수(5)를 넘으면 거절
+	questions := []string{"살아있나요?", "먹을 수 있나요?", "손에 들 수 있나요?", "실내에 있나요?", "한국에 있나요?"}
+	for _, q := range questions {
+		if _, err := env.svc.Answer(ctx, chatID, userID, &sender, q); err != nil {
+			t.Fatalf("Answer(%q) failed: %v", q, err)
+		}
+	}
+	var questionLimit qerrors.QuestionLimitExceededError
+	if _, err := env.svc.Answer(ctx, chatID, userID, &sender, "바다에 있나요?"); !errors.As(err, &questionLimit) || questionLimit.MaxQuestions != 5 {
+		t.Fatalf("expected question limit 5, got %v", err)
+	}
+	if line, _ := env.svc.BudgetLine(ctx, chatID); line != "Budget 5/5 2/2" {
+		t.Errorf("unexpected budget line: %q", line)
+	}
+
+	// 필요 득표 수 1명이면 여러 명이 참여해도 시작자 동의만으로 포기
+	_ = env.svc.RegisterPlayer(ctx, chatID, "user2", nil)
+	if _, err := env.svc.HandleSurrenderConsensus(ctx, chatID, userID); err != nil {
+		t.Fatalf("surrender failed: %v", err)
+	}
+	if exists, _ := env.svc.sessionStore.Exists(ctx, chatID); exists {
+		t.Fatal("session must end after surrender")
+	}
+
+	if msg, err := env.svc.UpdateChatSettings(ctx, chatID, userID, qmodel.ChatSettingsReset, ""); err != nil || msg != qmessages.SettingsReset {
+		t.Fatalf("reset = %q, %v", msg, err)
+	}
+	if rules := env.svc.gameRules(ctx, chatID); rules.QuestionLimit || rules.MaxHints != 1 {
+		t.Fatalf("expected default rules after reset, got %+v", rules)
+	}
+}
+
+func TestRiddleService_ComboUnlocksFreeHint(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.teardown()
+
+	ctx := context.Background()
+	chatID := env.chatID("room_combo")
+	userID := "user1"
+	sender := "UserOne"
+
+	if _, err := env.svc.UpdateChatSettings(ctx, chatID, userID, qmodel.ChatSettingsCombo, "3"); err != nil {
+		t.Fatalf("UpdateChatSettings failed: %v", err)
+	}
+	if _, err := env.svc.Start(ctx, chatID, userID, []string{"장소"}); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	ask := func(question string) AnswerOutcome {
+		t.Helper()
+		outcome, err := env.svc.AnswerWithOutcome(ctx, chatID, userID, &sender, question, false)
+		if err != nil {
+			t.Fatalf("Answer(%q) failed: %v", question, err)
+		}
+		return outcome
+	}
+
+	env.mockResponse = `{"scale": "예"}`
+	ask("살아있나요?")
+	if outcome := ask("동물인가요?"); outcome.Combo == nil || outcome.Combo.Current != 2 || outcome.Combo.Unlocked {
+		t.Fatalf("unexpected combo progress: %+v", outcome.Combo)
+	}
+	// '아마도 예'는 콤보를 끊는다
+	env.mockResponse = `{"scale": "아마도 예"}`
+	if outcome := ask("네 발로 걷나요?"); outcome.Combo == nil || outcome.Combo.Current != 0 {
+		t.Fatalf("combo must reset, got %+v", outcome.Combo)
+	}
+	env.mockResponse = `{"scale": "예"}`
+	ask("털이 있나요?")
+	ask("집에서 키우나요?")
+	if outcome := ask("사람보다 작나요?"); outcome.Combo == nil || !outcome.Combo.Unlocked {
+		t.Fatalf("expected free hint unlocked, got %+v", outcome.Combo)
+	}
+
+	// 기본 힌트 1개 + 콤보 무료 힌트 1개
+	env.mockResponse = `{"hints": ["It has fur"]}`
+	for i := range 2 {
+		if _, err := env.svc.GenerateHint(ctx, chatID); err != nil {
+			t.Fatalf("hint %d failed: %v", i+1, err)
+		}
+	}
+	var hintLimit qerrors.HintLimitExceededError
+	if _, err := env.svc.GenerateHint(ctx, chatID); !errors.As(err, &hintLimit) || hintLimit.MaxHints != 2 {
+		t.Fatalf("expected hint limit 2, got %v", err)
+	}
+
+	if _, err := env.svc.HandleSurrenderConsensus(ctx, chatID, userID); err != nil {
+		t.Fatalf("surrender failed: %v", err)
+	}
+	var maxCombo int
+	if err := env.db.Table("game_sessions").Where("chat_id = ?", chatID).Select("max_combo").Scan(&maxCombo).Error; err != nil || maxCombo != 3 {
+		t.Fatalf("expected max combo 3 in game session, got %d, %v", maxCombo, err)
+	}
+	if state, _ := env.svc.comboStore.Get(ctx, chatID); state != (qmodel.ComboState{}) {
+		t.Fatalf("combo state must be cleared after the game, got %+v", state)
+	}
+}
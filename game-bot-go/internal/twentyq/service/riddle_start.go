@@ -7,10 +7,12 @@ import (
 
 	json "github.com/goccy/go-json"
 
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
 )
 
 // Start: 새로운 스무고개 게임을 시작합니다. (이전 세션 있으면 재개)
@@ -38,7 +40,15 @@ func (s *RiddleService) Start(ctx context.Context, chatID string, userID string,
 			return nil
 		}
 
+		roundHeader, categories := s.tournamentRound(ctx, chatID, categories)
 		selectedKey, invalidInput := selectCategory(categories)
+		allowed := s.gameRules(ctx, chatID).AllowedCategories
+		selectedKey, rejected := constrainCategory(selectedKey, allowed)
+		invalidInput = invalidInput || rejected
+		themeEvent := s.applyThemeEvent(ctx, chatID, selectedKey != "")
+		if themeEvent.categoryKey != "" {
+			selectedKey = themeEvent.categoryKey
+		}
 		s.logger.Info("start_category_selection", "chat_id", chatID, "input", categories, "selectedKey", selectedKey, "invalidInput", invalidInput)
 
 		banned, err := s.topicHistoryStore.GetBannedTopics(ctx, chatID, optionalString(selectedKey), 20, qconfig.AllCategories)
@@ -47,11 +57,13 @@ func (s *RiddleService) Start(ctx context.Context, chatID string, userID string,
 		}
 
 		var excludedCategories []string
-		if len(categories) == 0 {
+		if len(categories) == 0 && selectedKey == "" {
 			excludedCategories = []string{categoryMovie}
 		}
 
-		topicResp, err := s.restClient.TwentyQSelectTopic(ctx, selectedKey, banned, excludedCategories)
+		// 이전 게임에서 남은 사용량이 새 게임에 섞이지 않도록 주제 선택 전에 비움
+		s.statsRecorder.ResetLLMUsage(ctx, chatID)
+		topicResp, err := s.restClient.TwentyQSelectTopic(llmUsageCtx(ctx, chatID), selectedKey, banned, excludedCategories)
 		if err != nil {
 			return fmt.Errorf("select topic failed: %w", err)
 		}
@@ -65,8 +77,10 @@ func (s *RiddleService) Start(ctx context.Context, chatID string, userID string,
 		secret := qmodel.RiddleSecret{
 			Target:      topicResp.Name,
 			Category:    topicResp.Category,
-			Intro:       s.msgProvider.Get("start.intro"),
+			Intro:       s.msgProvider.For(ctx).Get("start.intro"),
 			Description: string(descriptionJSON),
+			StartedBy:   strings.TrimSpace(userID),
+			SessionID:   qrepo.GenerateFallbackSessionID(chatID),
 		}
 
 		if err := s.sessionStore.SaveSecret(ctx, chatID, secret); err != nil {
@@ -75,8 +89,16 @@ func (s *RiddleService) Start(ctx context.Context, chatID string, userID string,
 		if err := s.categoryStore.Save(ctx, chatID, optionalString(topicResp.Category)); err != nil {
 			return fmt.Errorf("save category failed: %w", err)
 		}
+		s.synonymMatcher.Precompute(llmUsageCtx(ctx, chatID), secret.Target)
+
+		s.events.Publish(ctx, eventbus.EventGameStarted, chatID, userID, map[string]any{
+			"category":   topicResp.Category,
+			"themeEvent": themeEvent.categoryKey != "",
+		})
 
-		returnText = s.buildStartMessage(categoryToKorean(topicResp.Category), invalidInput)
+		hotseatNotice := s.openHotseatIfEnabled(ctx, chatID, userID)
+		openingBlock := s.openingSuggestionBlock(ctx, chatID, topicResp.Category)
+		returnText = roundHeader + themeEvent.announcement + s.buildStartMessage(ctx, categoryToKorean(topicResp.Category), invalidInput) + hotseatNotice + openingBlock
 		return nil
 	})
 	if err != nil {
@@ -107,27 +129,27 @@ func (s *RiddleService) buildResumeMessage(ctx context.Context, chatID string) (
 	categoryLine := ""
 	if secret != nil && secret.Category != "" {
 		if categoryKo := categoryToKorean(secret.Category); categoryKo != nil {
-			categoryLine = s.msgProvider.Get(qmessages.StartResumeCategoryLine, messageprovider.P("category", *categoryKo))
+			categoryLine = s.msgProvider.For(ctx).Get(qmessages.StartResumeCategoryLine, messageprovider.P("category", *categoryKo))
 		}
 	}
 
 	// 헤더
-	header := s.msgProvider.Get(qmessages.StartResumeHeader, messageprovider.P("categoryLine", categoryLine))
+	header := s.msgProvider.For(ctx).Get(qmessages.StartResumeHeader, messageprovider.P("categoryLine", categoryLine))
 
 	// Q&A 기록
-	qnaLines := s.buildStatusQnALines(history)
+	qnaLines := s.buildStatusQnALines(ctx, history)
 	qnaSection := ""
 	if len(qnaLines) > 0 {
-		qnaSection = s.msgProvider.Get(qmessages.StartResumeQnAHeader) + "\n" + strings.Join(qnaLines, "\n")
+		qnaSection = s.msgProvider.For(ctx).Get(qmessages.StartResumeQnAHeader) + "\n" + strings.Join(qnaLines, "\n")
 	}
 
 	// 힌트 기록
-	hintSection := s.buildResumeHintSection(history)
+	hintSection := s.buildResumeHintSection(ctx, history)
 
 	// 틀린 정답
 	wrongSection := ""
 	if len(wrongGuesses) > 0 {
-		wrongSection = s.msgProvider.Get(qmessages.StatusWrongGuesses, messageprovider.P("guesses", strings.Join(wrongGuesses, ", ")))
+		wrongSection = s.msgProvider.For(ctx).Get(qmessages.StatusWrongGuesses, messageprovider.P("guesses", strings.Join(wrongGuesses, ", ")))
 	}
 
 	// 조합: 헤더 > 힌트 > 틀린정답 > Q&A (최하단)
@@ -146,12 +168,12 @@ func (s *RiddleService) buildResumeMessage(ctx context.Context, chatID string) (
 	return strings.Join(parts, "\n\n"), nil
 }
 
-func (s *RiddleService) buildResumeHintSection(history []qmodel.QuestionHistory) string {
+func (s *RiddleService) buildResumeHintSection(ctx context.Context, history []qmodel.QuestionHistory) string {
 	var hints []string
 	for _, h := range history {
 		if h.QuestionNumber < 0 {
 			hintNumber := -h.QuestionNumber
-			hints = append(hints, s.msgProvider.Get(
+			hints = append(hints, s.msgProvider.For(ctx).Get(
 				qmessages.StatusHintLine,
 				messageprovider.P("number", hintNumber),
 				messageprovider.P("content", h.Answer),
@@ -161,17 +183,17 @@ func (s *RiddleService) buildResumeHintSection(history []qmodel.QuestionHistory)
 	if len(hints) == 0 {
 		return ""
 	}
-	return s.msgProvider.Get(qmessages.StartResumeHintHeader) + "\n" + strings.Join(hints, "\n")
+	return s.msgProvider.For(ctx).Get(qmessages.StartResumeHintHeader) + "\n" + strings.Join(hints, "\n")
 }
 
-func (s *RiddleService) buildStartMessage(selectedCategoryKo *string, invalidInput bool) string {
-	ready := s.msgProvider.Get(qmessages.StartReady)
+func (s *RiddleService) buildStartMessage(ctx context.Context, selectedCategoryKo *string, invalidInput bool) string {
+	ready := s.msgProvider.For(ctx).Get(qmessages.StartReady)
 	if selectedCategoryKo != nil {
-		categoryText := s.msgProvider.Get(qmessages.StartCategoryPrefix, messageprovider.P("category", *selectedCategoryKo))
-		ready = s.msgProvider.Get(qmessages.StartReadyWithCategory, messageprovider.P("category", categoryText))
+		categoryText := s.msgProvider.For(ctx).Get(qmessages.StartCategoryPrefix, messageprovider.P("category", *selectedCategoryKo))
+		ready = s.msgProvider.For(ctx).Get(qmessages.StartReadyWithCategory, messageprovider.P("category", categoryText))
 	}
 	if invalidInput {
-		return s.msgProvider.Get(qmessages.StartInvalidCategoryWarning) + ready
+		return s.msgProvider.For(ctx).Get(qmessages.StartInvalidCategoryWarning) + ready
 	}
 	return ready
 }
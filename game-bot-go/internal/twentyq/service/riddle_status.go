@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
-	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
@@ -56,21 +55,21 @@ func (s *RiddleService) StatusSeparatedWithCount(ctx context.Context, chatID str
 		return "", "", 0, fmt.Errorf("hint count get failed: %w", err)
 	}
 
-	remaining := (qconfig.MaxHintsTotal - hintCount)
+	remaining := (s.hintAllowance(ctx, chatID, s.gameRules(ctx, chatID)) - hintCount)
 	if remaining < 0 {
 		remaining = 0
 	}
 
-	header := s.buildStatusHeader(secret.Category, remaining)
+	header := s.buildStatusHeader(ctx, secret.Category, remaining)
 
 	wrongGuesses, err := s.wrongGuessStore.GetSessionWrongGuesses(ctx, chatID)
 	if err != nil {
 		return "", "", 0, fmt.Errorf("wrong guess get failed: %w", err)
 	}
-	wrongLine := s.buildStatusWrongLine(wrongGuesses)
+	wrongLine := s.buildStatusWrongLine(ctx, wrongGuesses)
 
-	hintLine := s.buildStatusHintLine(history)
-	qnaLines := s.buildStatusQnALines(history)
+	hintLine := s.buildStatusHintLine(ctx, history)
+	qnaLines := s.buildStatusQnALines(ctx, history)
 
 	// 마지막 힌트 이후의 질문 횟수 계산
 	// 힌트가 없으면 0 반환 (힌트 라인도 없으므로 표시 안됨)
@@ -80,23 +79,23 @@ func (s *RiddleService) StatusSeparatedWithCount(ctx context.Context, chatID str
 	return main, hintLine, questionsSinceHint, nil
 }
 
-func (s *RiddleService) buildStatusHeader(category string, remaining int) string {
+func (s *RiddleService) buildStatusHeader(ctx context.Context, category string, remaining int) string {
 	selectedCategoryKo := categoryToKorean(category)
 	if selectedCategoryKo != nil {
-		return s.msgProvider.Get(
+		return s.msgProvider.For(ctx).Get(
 			qmessages.StatusHeaderWithCategory,
 			messageprovider.P("category", *selectedCategoryKo),
 			messageprovider.P("remaining", remaining),
 		)
 	}
-	return s.msgProvider.Get(qmessages.StatusHeaderNoCategory, messageprovider.P("remaining", remaining))
+	return s.msgProvider.For(ctx).Get(qmessages.StatusHeaderNoCategory, messageprovider.P("remaining", remaining))
 }
 
-func (s *RiddleService) buildStatusHintLine(history []qmodel.QuestionHistory) string {
+func (s *RiddleService) buildStatusHintLine(ctx context.Context, history []qmodel.QuestionHistory) string {
 	for _, h := range history {
 		if h.QuestionNumber < 0 {
 			hintNumber := -h.QuestionNumber
-			return s.msgProvider.Get(
+			return s.msgProvider.For(ctx).Get(
 				qmessages.StatusHintLine,
 				messageprovider.P("number", hintNumber),
 				messageprovider.P("content", h.Answer),
@@ -106,7 +105,7 @@ func (s *RiddleService) buildStatusHintLine(history []qmodel.QuestionHistory) st
 	return ""
 }
 
-func (s *RiddleService) buildStatusQnALines(history []qmodel.QuestionHistory) []string {
+func (s *RiddleService) buildStatusQnALines(ctx context.Context, history []qmodel.QuestionHistory) []string {
 	qnaLines := make([]string, 0, len(history))
 	qIndex := 0
 	for _, h := range history {
@@ -116,11 +115,11 @@ func (s *RiddleService) buildStatusQnALines(history []qmodel.QuestionHistory) []
 		qIndex++
 		numberText := fmt.Sprintf("%d", qIndex)
 		if h.IsChain {
-			numberText += s.msgProvider.Get(qmessages.StatusChainSuffix)
+			numberText += s.msgProvider.For(ctx).Get(qmessages.StatusChainSuffix)
 		}
 		qnaLines = append(
 			qnaLines,
-			s.msgProvider.Get(
+			s.msgProvider.For(ctx).Get(
 				qmessages.StatusQuestionAnswer,
 				messageprovider.P("number", numberText),
 				messageprovider.P("question", h.Question),
@@ -131,11 +130,11 @@ func (s *RiddleService) buildStatusQnALines(history []qmodel.QuestionHistory) []
 	return qnaLines
 }
 
-func (s *RiddleService) buildStatusWrongLine(wrongGuesses []string) string {
+func (s *RiddleService) buildStatusWrongLine(ctx context.Context, wrongGuesses []string) string {
 	if len(wrongGuesses) == 0 {
 		return ""
 	}
-	return s.msgProvider.Get(qmessages.StatusWrongGuesses, messageprovider.P("guesses", strings.Join(wrongGuesses, ", ")))
+	return s.msgProvider.For(ctx).Get(qmessages.StatusWrongGuesses, messageprovider.P("guesses", strings.Join(wrongGuesses, ", ")))
 }
 
 func (s *RiddleService) buildStatusMain(header string, wrongLine string, qnaLines []string) string {
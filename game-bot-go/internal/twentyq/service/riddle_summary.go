@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qerrors "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/errors"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+)
+
+// Summary: 중간에 들어온 참가자를 위해 지금까지의 질문/힌트/오답을 LLM으로 요약합니다.
+// 정답은 요약 요청에 싣지 않으므로 요약 문구로 정답이 새지 않습니다.
+func (s *RiddleService) Summary(ctx context.Context, chatID string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+
+	secret, err := s.sessionStore.GetSecret(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("secret get failed: %w", err)
+	}
+	if secret == nil {
+		return "", qerrors.SessionNotFoundError{ChatID: chatID}
+	}
+
+	history, err := s.historyStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("history get failed: %w", err)
+	}
+	wrongGuesses, err := s.wrongGuessStore.GetSessionWrongGuesses(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("wrong guess get failed: %w", err)
+	}
+
+	req := llmrest.TwentyQSummaryRequest{
+		Category:     secret.Category,
+		History:      make([]llmrest.TwentyQHistoryEntry, 0, len(history)),
+		WrongGuesses: wrongGuesses,
+	}
+	for _, h := range history {
+		// 음수 번호는 힌트 (내용은 Answer에 저장)
+		if h.QuestionNumber < 0 {
+			req.Hints = append(req.Hints, h.Answer)
+			continue
+		}
+		if h.QuestionNumber > 0 {
+			req.History = append(req.History, llmrest.TwentyQHistoryEntry{Question: h.Question, Answer: h.Answer})
+		}
+	}
+	if len(req.History) == 0 && len(req.Hints) == 0 && len(req.WrongGuesses) == 0 {
+		return s.msgProvider.For(ctx).Get(qmessages.SummaryEmpty), nil
+	}
+
+	resp, err := s.restClient.TwentyQSummarizeGame(llmUsageCtx(ctx, chatID), req)
+	if err != nil {
+		return "", fmt.Errorf("summarize game failed: %w", err)
+	}
+
+	lines := []string{
+		s.msgProvider.For(ctx).Get(
+			qmessages.SummaryHeader,
+			messageprovider.P("questions", len(req.History)),
+			messageprovider.P("hints", len(req.Hints)),
+		),
+		strings.TrimSpace(resp.Summary),
+	}
+	if len(resp.Eliminated) > 0 {
+		lines = append(lines, s.msgProvider.For(ctx).Get(qmessages.SummaryEliminated, messageprovider.P("items", strings.Join(resp.Eliminated, ", "))))
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -24,4 +24,11 @@ func (s *RiddleService) cleanupSession(ctx context.Context, chatID string) {
 	_ = s.playerStore.Clear(ctx, chatID)
 	_ = s.wrongGuessStore.Delete(ctx, chatID, userIDs)
 	_ = s.voteStore.Clear(ctx, chatID)
+	if s.comboStore != nil {
+		_ = s.comboStore.Delete(ctx, chatID)
+	}
+	if s.hotseatStore != nil {
+		// 방별 턴제 모드 설정은 유지하고 이번 게임의 순서만 정리
+		_ = s.hotseatStore.Delete(ctx, chatID)
+	}
 }
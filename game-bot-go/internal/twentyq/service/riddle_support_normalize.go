@@ -3,6 +3,7 @@ package service
 import (
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"golang.org/x/text/unicode/norm"
 )
@@ -33,3 +34,20 @@ func normalizeForEquality(text string) string {
 	normalized = whitespacePunctPattern.ReplaceAllString(normalized, "")
 	return normalized
 }
+
+// openingQuestionMaxRunes: 추천 첫 질문으로 모을 질문의 최대 길이 (너무 긴 질문은 재사용 가치가 낮음)
+const openingQuestionMaxRunes = 60
+
+var openingTrailingPattern = regexp.MustCompile(`[\s?!.~]+$`)
+
+// normalizeOpeningQuestion: 같은 질문이 하나로 집계되도록 공백과 끝 문장부호를 정리합니다.
+// 표시용 문장이므로 어미는 그대로 둡니다.
+func normalizeOpeningQuestion(text string) string {
+	normalized := norm.NFKC.String(text)
+	normalized = strings.Join(strings.Fields(normalized), " ")
+	normalized = openingTrailingPattern.ReplaceAllString(normalized, "")
+	if utf8.RuneCountInString(normalized) > openingQuestionMaxRunes {
+		return ""
+	}
+	return normalized
+}
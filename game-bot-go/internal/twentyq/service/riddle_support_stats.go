@@ -5,9 +5,30 @@ import (
 	"strings"
 	"time"
 
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/eventbus"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
 )
 
+// publishGameCompleted: 게임 종료(정답/항복) 이벤트를 발행합니다.
+func (s *RiddleService) publishGameCompleted(
+	ctx context.Context,
+	chatID string,
+	secret qmodel.RiddleSecret,
+	result GameResult,
+	answererID string,
+	questionCount int,
+	hintCount int,
+) {
+	s.events.Publish(ctx, eventbus.EventGameCompleted, chatID, answererID, map[string]any{
+		"result":        string(result),
+		"target":        secret.Target,
+		"category":      strings.TrimSpace(secret.Category),
+		"questionCount": questionCount,
+		"hintCount":     hintCount,
+	})
+}
+
 func (s *RiddleService) recordGameCompletionIfEnabled(
 	ctx context.Context,
 	chatID string,
@@ -79,6 +100,8 @@ func (s *RiddleService) recordGameCompletionIfEnabled(
 		wgCounts = make(map[string]int)
 	}
 
+	turnStats := s.hotseatCompletionStats(ctx, chatID, result, completedAt)
+
 	for _, uid := range userIDs {
 		var target *string
 		if result == GameResultCorrect && answerer != "" && uid == answerer {
@@ -92,17 +115,72 @@ func (s *RiddleService) recordGameCompletionIfEnabled(
 			QuestionCount:   questionCounts[uid],
 			WrongGuessCount: wgCounts[uid],
 			Target:          target,
+			TurnCount:       turnStats[uid].Turns,
+			TurnMillis:      turnStats[uid].TurnMillis,
+			TurnTimeouts:    turnStats[uid].Timeouts,
 		})
 	}
 
 	s.statsRecorder.RecordGameCompletion(ctx, GameCompletionRecord{
-		SessionID:          "",
+		SessionID:          secret.SessionID,
 		ChatID:             chatID,
 		Category:           strings.TrimSpace(secret.Category),
 		Result:             result,
 		Players:            playerRecords,
 		TotalQuestionCount: totalQuestionCount,
 		HintCount:          hintCount,
+		MaxCombo:           s.maxCombo(ctx, chatID),
 		CompletedAt:        completedAt,
+		OpeningQuestions:   openingQuestions(history, qconfig.OpeningQuestionDepth),
 	})
 }
+
+// openingQuestions: 이력에서 힌트와 정답 시도를 제외한 앞쪽 질문을 최대 depth개까지 정규화하여 반환합니다.
+// 같은 게임에서 반복된 질문은 한 번만 셉니다.
+func openingQuestions(history []qmodel.QuestionHistory, depth int) []string {
+	out := make([]string, 0, depth)
+	seen := make(map[string]struct{}, depth)
+	for _, h := range history {
+		if len(out) >= depth {
+			break
+		}
+		if h.QuestionNumber <= 0 {
+			continue
+		}
+		if _, isGuess := matchExplicitAnswer(h.Question); isGuess {
+			continue
+		}
+		q := normalizeOpeningQuestion(h.Question)
+		if q == "" {
+			continue
+		}
+		if _, dup := seen[q]; dup {
+			continue
+		}
+		seen[q] = struct{}{}
+		out = append(out, q)
+	}
+	return out
+}
+
+// hotseatCompletionStats: 턴제 게임이었다면 참가자별 차례 통계를 반환합니다.
+// 정답으로 끝난 경우 정답자의 마지막 차례도 완료 시각까지 반영합니다.
+func (s *RiddleService) hotseatCompletionStats(
+	ctx context.Context,
+	chatID string,
+	result GameResult,
+	completedAt time.Time,
+) map[string]qmodel.HotseatStats {
+	hotseat, err := s.getHotseat(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("hotseat_get_failed", "chat_id", chatID, "err", err)
+		return nil
+	}
+	if hotseat == nil {
+		return nil
+	}
+	if result == GameResultCorrect {
+		hotseat.Advance(completedAt, qmodel.HotseatTurnPlayed)
+	}
+	return hotseat.Stats
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// themeEventTimezone: 이벤트 종료 시각 안내용 표시 시간대 (KST)
+var themeEventTimezone = time.FixedZone("KST", 9*60*60)
+
+// themeEventSelection: 게임 시작 시 적용된 테마 이벤트 결과
+type themeEventSelection struct {
+	categoryKey  string
+	announcement string
+}
+
+// applyThemeEvent: 진행 중인 테마 이벤트가 있으면 가중치로 카테고리를 고르고, 방에 처음이면 안내 문구를 만듭니다.
+// 사용자가 카테고리를 직접 지정한 경우 가중치는 적용하지 않습니다.
+// 이벤트 조회 실패는 게임 시작을 막지 않도록 로그만 남깁니다.
+func (s *RiddleService) applyThemeEvent(ctx context.Context, chatID string, categoryChosen bool) themeEventSelection {
+	if s.themeEventStore == nil {
+		return themeEventSelection{}
+	}
+
+	now := time.Now()
+	event, err := s.themeEventStore.Active(ctx, now)
+	if err != nil {
+		s.logger.Warn("theme_event_lookup_failed", "chat_id", chatID, "err", err)
+		return themeEventSelection{}
+	}
+	if event == nil {
+		return themeEventSelection{}
+	}
+
+	var selection themeEventSelection
+	if !categoryChosen {
+		selection.categoryKey = selectWeightedCategory(event.CategoryWeights, rand.Float64())
+	}
+
+	first, err := s.themeEventStore.MarkAnnounced(ctx, *event, chatID, now)
+	if err != nil {
+		s.logger.Warn("theme_event_announce_mark_failed", "chat_id", chatID, "event_id", event.ID, "err", err)
+	}
+	if first {
+		selection.announcement = s.buildThemeEventAnnouncement(ctx, *event)
+	}
+
+	s.logger.Info("theme_event_applied",
+		"chat_id", chatID,
+		"event_id", event.ID,
+		"category", selection.categoryKey,
+		"announced", first,
+	)
+	return selection
+}
+
+func (s *RiddleService) buildThemeEventAnnouncement(ctx context.Context, event qmodel.ThemeEvent) string {
+	message := strings.TrimSpace(event.Announcement)
+	if message == "" {
+		message = s.msgProvider.For(ctx).Get(qmessages.StartThemeEventDefaultMessage)
+	}
+	return s.msgProvider.For(ctx).Get(
+		qmessages.StartThemeEventAnnouncement,
+		messageprovider.P("name", event.Name),
+		messageprovider.P("message", message),
+		messageprovider.P("endsAt", event.EndsAt.In(themeEventTimezone).Format("1/2 15:04")),
+	)
+}
+
+// selectWeightedCategory: 가중치 비율에 따라 카테고리를 선택합니다. r은 [0, 1) 범위의 난수입니다.
+// 맵 순회 순서에 영향받지 않도록 키를 정렬한 뒤 누적 가중치로 선택합니다.
+func selectWeightedCategory(weights map[string]float64, r float64) string {
+	keys := make([]string, 0, len(weights))
+	total := 0.0
+	for key, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		keys = append(keys, key)
+		total += weight
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	slices.Sort(keys)
+
+	threshold := r * total
+	cumulative := 0.0
+	for _, key := range keys {
+		cumulative += weights[key]
+		if threshold < cumulative {
+			return key
+		}
+	}
+	return keys[len(keys)-1]
+}
@@ -0,0 +1,27 @@
+package service
+
+import "testing"
+
+func TestSelectWeightedCategory(t *testing.T) {
+	weights := map[string]float64{"movie": 3, "place": 1, "food": 0}
+
+	// 정렬된 키 순서(movie, place) 기준 누적 가중치: movie [0, 0.75), place [0.75, 1)
+	tests := []struct {
+		r    float64
+		want string
+	}{
+		{0, "movie"},
+		{0.74, "movie"},
+		{0.75, "place"},
+		{0.999, "place"},
+	}
+	for _, tt := range tests {
+		if got := selectWeightedCategory(weights, tt.r); got != tt.want {
+			t.Errorf("selectWeightedCategory(r=%v) = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+
+	if got := selectWeightedCategory(map[string]float64{"food": 0}, 0.5); got != "" {
+		t.Errorf("expected empty selection for zero weights, got %q", got)
+	}
+}
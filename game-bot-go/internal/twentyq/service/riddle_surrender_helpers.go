@@ -1,24 +1,26 @@
 package service
 
 import (
+	"context"
+
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
 	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
 	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
 )
 
-func (s *RiddleService) buildSurrenderCategoryLine(category string) string {
+func (s *RiddleService) buildSurrenderCategoryLine(ctx context.Context, category string) string {
 	categoryKo := categoryToKorean(category)
 	if categoryKo == nil {
 		return ""
 	}
-	return s.msgProvider.Get(qmessages.SurrenderCategoryLine, messageprovider.P("category", *categoryKo))
+	return s.msgProvider.For(ctx).Get(qmessages.SurrenderCategoryLine, messageprovider.P("category", *categoryKo))
 }
 
-func (s *RiddleService) buildSurrenderHintBlock(history []qmodel.QuestionHistory) string {
+func (s *RiddleService) buildSurrenderHintBlock(ctx context.Context, history []qmodel.QuestionHistory) string {
 	for _, h := range history {
 		if h.QuestionNumber < 0 {
-			header := s.msgProvider.Get(qmessages.SurrenderHintBlockHeader, messageprovider.P("hintCount", 1))
-			line := s.msgProvider.Get(qmessages.SurrenderHintItem, messageprovider.P("hintNumber", 1), messageprovider.P("content", h.Answer))
+			header := s.msgProvider.For(ctx).Get(qmessages.SurrenderHintBlockHeader, messageprovider.P("hintCount", 1))
+			line := s.msgProvider.For(ctx).Get(qmessages.SurrenderHintItem, messageprovider.P("hintNumber", 1), messageprovider.P("content", h.Answer))
 			return header + line
 		}
 	}
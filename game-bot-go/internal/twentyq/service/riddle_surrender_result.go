@@ -26,15 +26,15 @@ func (s *RiddleService) Surrender(ctx context.Context, chatID string) (string, e
 			return qerrors.SessionNotFoundError{ChatID: chatID}
 		}
 
-		categoryLine := s.buildSurrenderCategoryLine(secret.Category)
+		categoryLine := s.buildSurrenderCategoryLine(ctx, secret.Category)
 
 		history, err := s.historyStore.Get(ctx, chatID)
 		if err != nil {
 			return fmt.Errorf("history get failed: %w", err)
 		}
-		hintBlock := s.buildSurrenderHintBlock(history)
+		hintBlock := s.buildSurrenderHintBlock(ctx, history)
 
-		out = s.msgProvider.Get(
+		out = s.msgProvider.For(ctx).Get(
 			qmessages.SurrenderResult,
 			messageprovider.P("hintBlock", hintBlock),
 			messageprovider.P("target", secret.Target),
@@ -45,6 +45,9 @@ func (s *RiddleService) Surrender(ctx context.Context, chatID string) (string, e
 
 		completedAt := time.Now()
 		s.recordGameCompletionIfEnabled(ctx, chatID, *secret, GameResultSurrender, nil, history, hintCount, questionCount, completedAt)
+		s.publishGameCompleted(ctx, chatID, *secret, GameResultSurrender, "", questionCount, hintCount)
+
+		out += s.recordTournamentRound(ctx, chatID, "", questionCount)
 
 		_ = s.topicHistoryStore.AddCompletedTopic(ctx, chatID, strings.TrimSpace(secret.Category), secret.Target, 20)
 		s.cleanupSession(ctx, chatID)
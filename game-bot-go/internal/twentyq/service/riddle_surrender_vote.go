@@ -52,7 +52,7 @@ func (s *RiddleService) HandleSurrenderConsensus(ctx context.Context, chatID str
 				return fmt.Errorf("vote get failed: %w", err)
 			}
 			if vote == nil {
-				out = s.msgProvider.Get(qmessages.VoteInProgress,
+				out = s.msgProvider.For(ctx).Get(qmessages.VoteInProgress,
 					messageprovider.P("current", 0),
 					messageprovider.P("required", 1),
 					messageprovider.P("remain", 1),
@@ -73,7 +73,7 @@ func (s *RiddleService) HandleSurrenderConsensus(ctx context.Context, chatID str
 
 			_ = s.voteStore.Save(ctx, chatID, *vote)
 			remain := vote.RequiredApprovals() - len(vote.Approvals)
-			out = s.msgProvider.Get(
+			out = s.msgProvider.For(ctx).Get(
 				qmessages.VoteInProgress,
 				messageprovider.P("current", len(vote.Approvals)),
 				messageprovider.P("required", vote.RequiredApprovals()),
@@ -88,6 +88,7 @@ func (s *RiddleService) HandleSurrenderConsensus(ctx context.Context, chatID str
 			EligiblePlayers: eligible,
 			Approvals:       []string{userID},
 			CreatedAt:       time.Now().UnixMilli(),
+			RequiredVotes:   s.gameRules(ctx, chatID).SurrenderVotes,
 		}
 		if vote.IsApproved() {
 			result, err := s.Surrender(ctx, chatID)
@@ -102,7 +103,7 @@ func (s *RiddleService) HandleSurrenderConsensus(ctx context.Context, chatID str
 			return fmt.Errorf("vote save failed: %w", err)
 		}
 
-		out = s.msgProvider.Get(
+		out = s.msgProvider.For(ctx).Get(
 			qmessages.VoteStart,
 			messageprovider.P("required", vote.RequiredApprovals()),
 			messageprovider.P("current", len(vote.Approvals)),
@@ -135,16 +136,16 @@ func (s *RiddleService) HandleSurrenderAgree(ctx context.Context, chatID string,
 			return fmt.Errorf("vote get failed: %w", err)
 		}
 		if vote == nil {
-			out = s.msgProvider.Get(qmessages.VoteNotFound, messageprovider.P("prefix", s.commandPrefix))
+			out = s.msgProvider.For(ctx).Get(qmessages.VoteNotFound, messageprovider.P("prefix", s.commandPrefix))
 			return nil
 		}
 
 		if !vote.CanVote(userID) {
-			out = s.msgProvider.Get(qmessages.VoteCannotVote)
+			out = s.msgProvider.For(ctx).Get(qmessages.VoteCannotVote)
 			return nil
 		}
 		if vote.HasVoted(userID) {
-			out = s.msgProvider.Get(qmessages.VoteAlreadyVoted)
+			out = s.msgProvider.For(ctx).Get(qmessages.VoteAlreadyVoted)
 			return nil
 		}
 
@@ -153,7 +154,7 @@ func (s *RiddleService) HandleSurrenderAgree(ctx context.Context, chatID string,
 			return fmt.Errorf("vote approve failed: %w", err)
 		}
 		if updated == nil {
-			out = s.msgProvider.Get(qmessages.VoteProcessingFailed)
+			out = s.msgProvider.For(ctx).Get(qmessages.VoteProcessingFailed)
 			return nil
 		}
 
@@ -168,7 +169,7 @@ func (s *RiddleService) HandleSurrenderAgree(ctx context.Context, chatID string,
 		}
 
 		remain := updated.RequiredApprovals() - len(updated.Approvals)
-		out = s.msgProvider.Get(
+		out = s.msgProvider.For(ctx).Get(
 			qmessages.VoteAgreeProgress,
 			messageprovider.P("current", len(updated.Approvals)),
 			messageprovider.P("required", updated.RequiredApprovals()),
@@ -188,5 +189,5 @@ func (s *RiddleService) HandleSurrenderReject(ctx context.Context, chatID string
 	if _, err := s.sessionStore.GetSecret(ctx, chatID); err != nil {
 		return "", fmt.Errorf("secret get failed: %w", err)
 	}
-	return s.msgProvider.Get(qmessages.VoteRejectNotSupported), nil
+	return s.msgProvider.For(ctx).Get(qmessages.VoteRejectNotSupported), nil
 }
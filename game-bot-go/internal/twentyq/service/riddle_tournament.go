@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	domainmodels "github.com/park285/llm-kakao-bots/game-bot-go/internal/domain/models"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+)
+
+// StartTournament: N 라운드 토너먼트를 시작하고 첫 라운드 게임을 엽니다.
+// 이후 라운드는 이전 라운드가 끝난 뒤 일반 시작 명령으로 이어갑니다.
+func (s *RiddleService) StartTournament(ctx context.Context, chatID string, userID string, rounds int, categories []string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.tournamentStore == nil {
+		return "", fmt.Errorf("tournament store not configured")
+	}
+
+	if rounds < qconfig.TournamentMinRounds || rounds > qconfig.TournamentMaxRounds {
+		return s.msgProvider.For(ctx).Get(
+			qmessages.TournamentInvalidRounds,
+			messageprovider.P("min", qconfig.TournamentMinRounds),
+			messageprovider.P("max", qconfig.TournamentMaxRounds),
+		), nil
+	}
+
+	current, err := s.tournamentStore.Get(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("tournament get failed: %w", err)
+	}
+	if current != nil {
+		return s.msgProvider.For(ctx).Get(
+			qmessages.TournamentAlreadyRunning,
+			messageprovider.P("round", current.CurrentRound()),
+			messageprovider.P("total", current.TotalRounds),
+		), nil
+	}
+
+	exists, err := s.sessionStore.Exists(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("session exists check failed: %w", err)
+	}
+	if exists {
+		return s.msgProvider.For(ctx).Get(qmessages.TournamentGameInProgress), nil
+	}
+
+	tournament := qmodel.Tournament{
+		TotalRounds: rounds,
+		Categories:  categories,
+		StartedBy:   strings.TrimSpace(userID),
+		StartedAt:   time.Now(),
+	}
+	if err := s.tournamentStore.Save(ctx, chatID, tournament); err != nil {
+		return "", fmt.Errorf("tournament save failed: %w", err)
+	}
+	s.logger.Info("tournament_started", "chat_id", chatID, "rounds", rounds, "categories", categories)
+
+	startText, err := s.Start(ctx, chatID, userID, categories)
+	if err != nil {
+		// 첫 라운드를 열지 못하면 빈 토너먼트가 남지 않도록 정리
+		if delErr := s.tournamentStore.Delete(ctx, chatID); delErr != nil {
+			s.logger.Warn("tournament_rollback_failed", "chat_id", chatID, "err", delErr)
+		}
+		return "", err
+	}
+
+	return s.msgProvider.For(ctx).Get(qmessages.TournamentStarted, messageprovider.P("rounds", rounds)) + "\n\n" + startText, nil
+}
+
+// TournamentStandings: 진행 중인 토너먼트의 현재 순위를 반환합니다.
+func (s *RiddleService) TournamentStandings(ctx context.Context, chatID string) (string, error) {
+	tournament, err := s.getTournament(ctx, chatID)
+	if err != nil {
+		return "", err
+	}
+	if tournament == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.TournamentNotFound), nil
+	}
+	return s.buildTournamentStandings(ctx, chatID, *tournament), nil
+}
+
+// CancelTournament: 토너먼트를 중단합니다. 시작한 사용자만 중단할 수 있으며,
+// 진행 중인 라운드 게임은 일반 게임으로 계속됩니다.
+func (s *RiddleService) CancelTournament(ctx context.Context, chatID string, userID string) (string, error) {
+	tournament, err := s.getTournament(ctx, chatID)
+	if err != nil {
+		return "", err
+	}
+	if tournament == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.TournamentNotFound), nil
+	}
+	if tournament.StartedBy != "" && tournament.StartedBy != strings.TrimSpace(userID) {
+		return s.msgProvider.For(ctx).Get(qmessages.TournamentCancelDenied), nil
+	}
+
+	if err := s.tournamentStore.Delete(ctx, chatID); err != nil {
+		return "", fmt.Errorf("tournament delete failed: %w", err)
+	}
+	s.logger.Info("tournament_cancelled", "chat_id", chatID, "completed", tournament.CompletedRounds)
+	return s.msgProvider.For(ctx).Get(qmessages.TournamentCancelled) + "\n\n" + s.buildTournamentStandings(ctx, chatID, *tournament), nil
+}
+
+func (s *RiddleService) getTournament(ctx context.Context, chatID string) (*qmodel.Tournament, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" || s.tournamentStore == nil {
+		return nil, nil
+	}
+	tournament, err := s.tournamentStore.Get(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("tournament get failed: %w", err)
+	}
+	return tournament, nil
+}
+
+// tournamentRound: 새 게임이 토너먼트 라운드라면 라운드 헤더와 (생략 시) 토너먼트 기본 카테고리를 반환합니다.
+func (s *RiddleService) tournamentRound(ctx context.Context, chatID string, categories []string) (string, []string) {
+	tournament, err := s.getTournament(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("tournament_get_failed", "chat_id", chatID, "err", err)
+		return "", categories
+	}
+	if tournament == nil {
+		return "", categories
+	}
+
+	if len(categories) == 0 {
+		categories = tournament.Categories
+	}
+	header := s.msgProvider.For(ctx).Get(
+		qmessages.TournamentRoundHeader,
+		messageprovider.P("round", tournament.CurrentRound()),
+		messageprovider.P("total", tournament.TotalRounds),
+	)
+	return header + "\n", categories
+}
+
+// recordTournamentRound: 라운드 종료(정답/포기)를 토너먼트에 반영하고 결과 메시지 꼬리말을 반환합니다.
+// 정답자는 기본 점수 + 질문 예산 대비 남은 질문 수만큼 점수를 얻습니다.
+// 세션 정리 전에 호출해야 참여자 목록에서 정답자 닉네임을 찾을 수 있습니다.
+func (s *RiddleService) recordTournamentRound(ctx context.Context, chatID string, winnerID string, questionCount int) string {
+	tournament, err := s.getTournament(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("tournament_get_failed", "chat_id", chatID, "err", err)
+		return ""
+	}
+	if tournament == nil {
+		return ""
+	}
+
+	round := tournament.CurrentRound()
+	points := 0
+	sender := ""
+	if winnerID != "" {
+		points = qconfig.TournamentBasePoints + max(s.gameRules(ctx, chatID).MaxQuestions-questionCount, 0)
+		sender = s.playerSender(ctx, chatID, winnerID)
+	}
+	tournament.RecordRound(winnerID, sender, points)
+
+	if tournament.Finished() {
+		if err := s.tournamentStore.Delete(ctx, chatID); err != nil {
+			s.logger.Warn("tournament_delete_failed", "chat_id", chatID, "err", err)
+		}
+		s.logger.Info("tournament_finished", "chat_id", chatID, "rounds", tournament.TotalRounds)
+		return "\n\n" + s.buildTournamentFinal(ctx, chatID, *tournament)
+	}
+
+	if err := s.tournamentStore.Save(ctx, chatID, *tournament); err != nil {
+		s.logger.Warn("tournament_save_failed", "chat_id", chatID, "err", err)
+		return ""
+	}
+
+	var result string
+	if winnerID != "" {
+		result = s.msgProvider.For(ctx).Get(
+			qmessages.TournamentRoundResult,
+			messageprovider.P("round", round),
+			messageprovider.P("total", tournament.TotalRounds),
+			messageprovider.P("nickname", s.tournamentDisplayName(ctx, chatID, winnerID, sender)),
+			messageprovider.P("points", points),
+		)
+	} else {
+		result = s.msgProvider.For(ctx).Get(
+			qmessages.TournamentRoundNoWinner,
+			messageprovider.P("round", round),
+			messageprovider.P("total", tournament.TotalRounds),
+		)
+	}
+
+	return "\n\n" + result + "\n\n" + s.buildTournamentStandings(ctx, chatID, *tournament) + "\n\n" +
+		s.msgProvider.For(ctx).Get(
+			qmessages.TournamentNextRound,
+			messageprovider.P("round", tournament.CurrentRound()),
+			messageprovider.P("prefix", s.commandPrefix),
+		)
+}
+
+func (s *RiddleService) buildTournamentStandings(ctx context.Context, chatID string, tournament qmodel.Tournament) string {
+	standings := tournament.Standings()
+	if len(standings) == 0 {
+		return s.msgProvider.For(ctx).Get(qmessages.TournamentStandingsEmpty)
+	}
+
+	lines := make([]string, 0, len(standings))
+	for i, score := range standings {
+		lines = append(lines, s.msgProvider.For(ctx).Get(
+			qmessages.TournamentStandingItem,
+			messageprovider.P("rank", i+1),
+			messageprovider.P("nickname", s.tournamentDisplayName(ctx, chatID, score.UserID, score.Sender)),
+			messageprovider.P("points", score.Points),
+			messageprovider.P("wins", score.Wins),
+		))
+	}
+	return s.msgProvider.For(ctx).Get(
+		qmessages.TournamentStandings,
+		messageprovider.P("completed", tournament.CompletedRounds),
+		messageprovider.P("total", tournament.TotalRounds),
+		messageprovider.P("standings", strings.Join(lines, "\n")),
+	)
+}
+
+func (s *RiddleService) buildTournamentFinal(ctx context.Context, chatID string, tournament qmodel.Tournament) string {
+	standings := tournament.Standings()
+	if len(standings) == 0 {
+		return s.msgProvider.For(ctx).Get(qmessages.TournamentFinalNoWinner, messageprovider.P("total", tournament.TotalRounds))
+	}
+
+	winner := standings[0]
+	return s.msgProvider.For(ctx).Get(
+		qmessages.TournamentFinal,
+		messageprovider.P("total", tournament.TotalRounds),
+		messageprovider.P("nickname", s.tournamentDisplayName(ctx, chatID, winner.UserID, winner.Sender)),
+		messageprovider.P("points", winner.Points),
+		messageprovider.P("standings", s.buildTournamentStandings(ctx, chatID, tournament)),
+	)
+}
+
+func (s *RiddleService) tournamentDisplayName(ctx context.Context, chatID string, userID string, sender string) string {
+	return domainmodels.DisplayName(chatID, userID, &sender, s.msgProvider.For(ctx).Get(qmessages.UserAnonymous))
+}
+
+// playerSender: 현재 게임 참여자 목록에서 닉네임을 찾습니다. (없으면 빈 문자열)
+func (s *RiddleService) playerSender(ctx context.Context, chatID string, userID string) string {
+	if s.playerStore == nil {
+		return ""
+	}
+	players, err := s.playerStore.GetAll(ctx, chatID)
+	if err != nil {
+		s.logger.Warn("player_get_failed", "chat_id", chatID, "err", err)
+		return ""
+	}
+	for _, p := range players {
+		if p.UserID == userID {
+			return p.Sender
+		}
+	}
+	return ""
+}
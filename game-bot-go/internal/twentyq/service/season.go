@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmessages "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/messages"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+const (
+	// seasonTickInterval: 종료 시각이 지난 시즌을 확인하는 주기
+	seasonTickInterval = time.Minute
+	// seasonAnnounceMaxDelay: 닫힌 뒤 이 시간이 지나도록 안내하지 못한 시즌은 더 이상 게시하지 않음 (장기 다운타임 뒤 묵은 안내 방지)
+	seasonAnnounceMaxDelay = 6 * time.Hour
+	// seasonTimeLayout: 순위/종료 안내에 표시하는 시각 형식 (KST)
+	seasonTimeLayout = "01/02 15:04"
+)
+
+// SeasonService: 방별 시즌을 관리합니다.
+// 게임 기록에 현재 시즌 번호를 붙이고, 종료 시각이 지난 시즌을 닫아 최종 순위를 보관한 뒤 방에 우승자를 안내합니다.
+type SeasonService struct {
+	cfg         qconfig.SeasonConfig
+	repo        *repository.Repository
+	publish     DigestPublishFunc
+	msgProvider *messageprovider.Provider
+	withLocale  func(ctx context.Context, chatID string) context.Context
+	logger      *slog.Logger
+}
+
+// NewSeasonService: SeasonService 인스턴스를 생성합니다. 시즌이 꺼져 있으면 nil을 반환합니다.
+func NewSeasonService(cfg qconfig.SeasonConfig, repo *repository.Repository, msgProvider *messageprovider.Provider, logger *slog.Logger) *SeasonService {
+	if !cfg.Enabled || repo == nil {
+		return nil
+	}
+	return &SeasonService{
+		cfg:         cfg,
+		repo:        repo,
+		msgProvider: msgProvider,
+		logger:      logger,
+	}
+}
+
+// SetPublisher: 시즌 종료 안내를 채팅방으로 발행하는 함수를 연결합니다. 없으면 순위만 보관합니다.
+func (s *SeasonService) SetPublisher(publish DigestPublishFunc) {
+	s.publish = publish
+}
+
+// SetChatLocale: 방별 언어를 컨텍스트에 싣는 함수를 연결합니다. 없으면 기본 언어로 안내합니다.
+func (s *SeasonService) SetChatLocale(withLocale func(ctx context.Context, chatID string) context.Context) {
+	s.withLocale = withLocale
+}
+
+func (s *SeasonService) length() time.Duration {
+	return time.Duration(s.cfg.LengthDays) * 24 * time.Hour
+}
+
+// SeasonNumber: at 시점의 방 시즌 번호를 반환합니다. 진행 중인 시즌이 없으면 새로 엽니다.
+// 시즌이 꺼져 있거나 조회에 실패하면 0(시즌 미지정)이며, 게임 기록 자체는 막지 않습니다.
+func (s *SeasonService) SeasonNumber(ctx context.Context, chatID string, at time.Time) int {
+	if s == nil {
+		return 0
+	}
+	season, err := s.repo.CurrentSeason(ctx, chatID, at, s.length())
+	if err != nil {
+		s.logger.Warn("season_resolve_failed", "chat_id", chatID, "err", err)
+		return 0
+	}
+	return season.Number
+}
+
+// Standings: 방의 이번 시즌 순위 안내 메시지를 만듭니다. 최근 시즌이 이미 닫혔으면 그 시즌의 최종 순위를 보여줍니다.
+func (s *SeasonService) Standings(ctx context.Context, chatID string) (string, error) {
+	season, err := s.repo.LatestSeason(ctx, chatID)
+	if err != nil {
+		return "", fmt.Errorf("latest season failed: %w", err)
+	}
+	if season == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.SeasonNone), nil
+	}
+
+	if season.ClosedAt != nil {
+		standings, err := s.repo.SeasonStandings(ctx, season.ID, s.cfg.TopN)
+		if err != nil {
+			return "", err
+		}
+		return s.msgProvider.For(ctx).Get(qmessages.SeasonFinalStandings,
+			messageprovider.P("number", season.Number),
+			messageprovider.P("standings", s.formatStandings(ctx, standings)),
+		), nil
+	}
+
+	standings, err := s.repo.SeasonLeaderboard(ctx, chatID, season.Number, s.cfg.TopN)
+	if err != nil {
+		return "", err
+	}
+	return s.msgProvider.For(ctx).Get(qmessages.SeasonStandings,
+		messageprovider.P("number", season.Number),
+		messageprovider.P("endsAt", formatSeasonTime(season.EndsAt)),
+		messageprovider.P("standings", s.formatStandings(ctx, standings)),
+	), nil
+}
+
+// Run: ctx가 종료될 때까지 주기적으로 종료 시각이 지난 시즌을 닫습니다.
+func (s *SeasonService) Run(ctx context.Context) error {
+	s.logger.Info("season_scheduler_started", "length_days", s.cfg.LengthDays, "top_n", s.cfg.TopN)
+
+	ticker := time.NewTicker(seasonTickInterval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick: 종료 시각이 지난 시즌을 닫고, 이전 주기에 안내하지 못한 시즌의 안내를 다시 시도합니다.
+func (s *SeasonService) tick(ctx context.Context, now time.Time) {
+	due, err := s.repo.DueSeasons(ctx, now)
+	if err != nil {
+		s.logger.Warn("season_due_query_failed", "err", err)
+		return
+	}
+	for _, season := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		// 스케줄러가 늦게 돌아도 시즌 경계는 예정된 종료 시각으로 기록
+		if _, err := s.close(ctx, season, season.EndsAt); err != nil {
+			s.logger.Warn("season_close_failed", "chat_id", season.ChatID, "season", season.Number, "err", err)
+		}
+	}
+
+	pending, err := s.repo.UnannouncedSeasons(ctx, now.Add(-seasonAnnounceMaxDelay))
+	if err != nil {
+		s.logger.Warn("season_pending_query_failed", "err", err)
+		return
+	}
+	for _, season := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		s.announce(ctx, season)
+	}
+}
+
+// CloseCurrent: 방의 진행 중인 시즌을 지금 닫습니다. (관리자 시즌 초기화)
+// 진행 중인 시즌이 없으면 nil, false를 반환합니다.
+func (s *SeasonService) CloseCurrent(ctx context.Context, chatID string) (*repository.Season, bool, error) {
+	season, err := s.repo.LatestSeason(ctx, chatID)
+	if err != nil {
+		return nil, false, err
+	}
+	if season == nil || season.ClosedAt != nil {
+		return nil, false, nil
+	}
+
+	closedAt := time.Now()
+	if season.EndsAt.Before(closedAt) {
+		closedAt = season.EndsAt
+	}
+	closed, err := s.close(ctx, *season, closedAt)
+	if err != nil || !closed {
+		return nil, closed, err
+	}
+	updated, err := s.repo.GetSeason(ctx, season.ID)
+	if err != nil {
+		return nil, true, err
+	}
+	return updated, true, nil
+}
+
+// close: 시즌을 닫고 순위를 보관한 뒤 종료 안내를 게시합니다. 다른 인스턴스가 먼저 닫았으면 false입니다.
+func (s *SeasonService) close(ctx context.Context, season repository.Season, closedAt time.Time) (bool, error) {
+	closed, err := s.repo.CloseSeason(ctx, season, closedAt)
+	if err != nil || !closed {
+		return closed, err
+	}
+	closedUTC := closedAt.UTC()
+	season.ClosedAt = &closedUTC
+	s.logger.Info("season_closed", "chat_id", season.ChatID, "season", season.Number, "closed_at", closedUTC)
+	s.announce(ctx, season)
+	return true, nil
+}
+
+// announce: 시즌 종료와 우승자를 방에 안내합니다. 게시에 실패하면 표시를 되돌려 다음 주기에 다시 시도합니다.
+func (s *SeasonService) announce(ctx context.Context, season repository.Season) {
+	if s.publish == nil {
+		return
+	}
+	first, err := s.repo.MarkSeasonAnnounced(ctx, season.ID, time.Now())
+	if err != nil {
+		s.logger.Warn("season_announce_mark_failed", "chat_id", season.ChatID, "season", season.Number, "err", err)
+		return
+	}
+	if !first {
+		return
+	}
+
+	if err := s.sendClosed(ctx, season); err != nil {
+		s.logger.Warn("season_announce_failed", "chat_id", season.ChatID, "season", season.Number, "err", err)
+		if unmarkErr := s.repo.UnmarkSeasonAnnounced(context.WithoutCancel(ctx), season.ID); unmarkErr != nil {
+			s.logger.Warn("season_announce_unmark_failed", "chat_id", season.ChatID, "err", unmarkErr)
+		}
+	}
+}
+
+func (s *SeasonService) sendClosed(ctx context.Context, season repository.Season) error {
+	if s.withLocale != nil {
+		ctx = s.withLocale(ctx, season.ChatID)
+	}
+	standings, err := s.repo.SeasonStandings(ctx, season.ID, 0)
+	if err != nil {
+		return err
+	}
+
+	text := s.formatClosed(ctx, season, standings)
+	if err := s.publish(ctx, mqmsg.NewFinal(season.ChatID, text, nil)); err != nil {
+		return fmt.Errorf("publish season result: %w", err)
+	}
+	s.logger.Info("season_announced", "chat_id", season.ChatID, "season", season.Number, "players", len(standings))
+	return nil
+}
+
+func (s *SeasonService) formatClosed(ctx context.Context, season repository.Season, standings []repository.SeasonStanding) string {
+	if len(standings) == 0 {
+		return s.msgProvider.For(ctx).Get(qmessages.SeasonClosedNoPlayers,
+			messageprovider.P("number", season.Number),
+			messageprovider.P("next", season.Number+1),
+		)
+	}
+
+	closedAt := season.EndsAt
+	if season.ClosedAt != nil {
+		closedAt = *season.ClosedAt
+	}
+	top := standings
+	if len(top) > s.cfg.TopN {
+		top = top[:s.cfg.TopN]
+	}
+	return s.msgProvider.For(ctx).Get(qmessages.SeasonClosed,
+		messageprovider.P("number", season.Number),
+		messageprovider.P("startedAt", formatSeasonTime(season.StartedAt)),
+		messageprovider.P("closedAt", formatSeasonTime(closedAt)),
+		messageprovider.P("players", len(standings)),
+		messageprovider.P("standings", s.formatStandings(ctx, top)),
+		messageprovider.P("winner", standings[0].Sender),
+		messageprovider.P("next", season.Number+1),
+	)
+}
+
+func (s *SeasonService) formatStandings(ctx context.Context, standings []repository.SeasonStanding) string {
+	if len(standings) == 0 {
+		return s.msgProvider.For(ctx).Get(qmessages.SeasonEmpty)
+	}
+	lines := make([]string, 0, len(standings))
+	for _, standing := range standings {
+		lines = append(lines, s.msgProvider.For(ctx).Get(qmessages.SeasonItem,
+			messageprovider.P("rank", standing.Rank),
+			messageprovider.P("sender", standing.Sender),
+			messageprovider.P("wins", standing.Wins),
+			messageprovider.P("games", standing.Games),
+		))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatSeasonTime(t time.Time) string {
+	return t.In(digestTimezone).Format(seasonTimeLayout)
+}
+
+// SetSeasons: 시즌 서비스를 연결합니다. nil이면 시즌 명령은 비활성 안내만 합니다.
+func (s *RiddleService) SetSeasons(seasons *SeasonService) {
+	s.seasons = seasons
+}
+
+// SeasonStandings: 방의 시즌 순위 안내 메시지를 반환합니다.
+func (s *RiddleService) SeasonStandings(ctx context.Context, chatID string) (string, error) {
+	chatID = strings.TrimSpace(chatID)
+	if chatID == "" {
+		return "", fmt.Errorf("chat id is empty")
+	}
+	if s.seasons == nil {
+		return s.msgProvider.For(ctx).Get(qmessages.SeasonDisabled), nil
+	}
+	return s.seasons.Standings(ctx, chatID)
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/messageprovider"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/mqmsg"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
+)
+
+const seasonTestMessages = `
+season:
+  standings: "S{number} until {endsAt}\n{standings}"
+  final_standings: "S{number} final\n{standings}"
+  item: "{rank}. {sender} {wins}/{games}"
+  none: "no season"
+  empty: "no players"
+  closed: "S{number} closed ({players})\n{standings}\nwinner {winner}, next S{next}"
+  closed_no_players: "S{number} closed, next S{next}"
+  disabled: "seasons off"
+`
+
+func newSeasonTestService(t *testing.T) (*SeasonService, *qrepo.Repository, *gorm.DB) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+	repo := qrepo.New(db)
+	if err := repo.AutoMigrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	msgProvider, err := messageprovider.NewFromYAML(seasonTestMessages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := qconfig.SeasonConfig{Enabled: true, LengthDays: 7, TopN: 2}
+	return NewSeasonService(cfg, repo, msgProvider, logger), repo, db
+}
+
+func createSeasonGameLog(t *testing.T, db *gorm.DB, chatID string, userID string, season int, won bool) {
+	t.Helper()
+	var target *string
+	if won {
+		answer := "사과"
+		target = &answer
+	}
+	if err := db.Create(&qrepo.GameLog{
+		ChatID:        chatID,
+		UserID:        userID,
+		Sender:        userID + "님",
+		Category:      "음식",
+		QuestionCount: 10,
+		Result:        string(GameResultCorrect),
+		Target:        target,
+		Season:        season,
+		CompletedAt:   time.Now(),
+	}).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSeasonService_Disabled(t *testing.T) {
+	if NewSeasonService(qconfig.SeasonConfig{Enabled: false, LengthDays: 7}, &qrepo.Repository{}, nil, nil) != nil {
+		t.Fatal("expected nil service when seasons are disabled")
+	}
+	var seasons *SeasonService
+	if got := seasons.SeasonNumber(context.Background(), "room", time.Now()); got != 0 {
+		t.Fatalf("expected season 0 from nil service, got %d", got)
+	}
+}
+
+func TestSeasonService_SeasonNumberContinuesAfterBoundary(t *testing.T) {
+	seasons, repo, _ := newSeasonTestService(t)
+	ctx := context.Background()
+	start := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	if got := seasons.SeasonNumber(ctx, "room", start); got != 1 {
+		t.Fatalf("expected first season, got %d", got)
+	}
+	if got := seasons.SeasonNumber(ctx, "room", start.Add(3*24*time.Hour)); got != 1 {
+		t.Fatalf("expected same season within length, got %d", got)
+	}
+	if got := seasons.SeasonNumber(ctx, "other", start); got != 1 {
+		t.Fatalf("expected independent numbering per room, got %d", got)
+	}
+
+	// 종료 시각 직후의 게임은 다음 시즌이며, 새 시즌은 직전 시즌 종료 시각부터 이어진다
+	if got := seasons.SeasonNumber(ctx, "room", start.Add(8*24*time.Hour)); got != 2 {
+		t.Fatalf("expected second season after boundary, got %d", got)
+	}
+	latest, err := repo.LatestSeason(ctx, "room")
+	if err != nil || latest == nil {
+		t.Fatalf("latest season: %v, %v", latest, err)
+	}
+	if !latest.StartedAt.Equal(start.Add(7 * 24 * time.Hour)) {
+		t.Fatalf("expected season 2 to start at previous end, got %v", latest.StartedAt)
+	}
+
+	// 한 시즌 넘게 쉬었던 방은 게임이 끝난 시각부터 새로 시작
+	resume := start.Add(40 * 24 * time.Hour)
+	if got := seasons.SeasonNumber(ctx, "room", resume); got != 3 {
+		t.Fatalf("expected third season, got %d", got)
+	}
+	latest, _ = repo.LatestSeason(ctx, "room")
+	if !latest.StartedAt.Equal(resume) {
+		t.Fatalf("expected idle room to restart at %v, got %v", resume, latest.StartedAt)
+	}
+}
+
+func TestSeasonService_TickClosesAndAnnounces(t *testing.T) {
+	seasons, repo, db := newSeasonTestService(t)
+	ctx := context.Background()
+	start := time.Now().Add(-8 * 24 * time.Hour)
+
+	number := seasons.SeasonNumber(ctx, "room", start)
+	createSeasonGameLog(t, db, "room", "alice", number, true)
+	createSeasonGameLog(t, db, "room", "alice", number, true)
+	createSeasonGameLog(t, db, "room", "bob", number, true)
+	createSeasonGameLog(t, db, "room", "bob", number, false)
+	createSeasonGameLog(t, db, "room", "carol", number, false)
+	// 다른 시즌/방 기록은 집계하지 않음
+	createSeasonGameLog(t, db, "room", "dave", 0, true)
+	createSeasonGameLog(t, db, "other", "erin", number, true)
+
+	var published []mqmsg.OutboundMessage
+	seasons.SetPublisher(func(_ context.Context, msg mqmsg.OutboundMessage) error {
+		published = append(published, msg)
+		return nil
+	})
+
+	seasons.tick(ctx, time.Now())
+	seasons.tick(ctx, time.Now())
+
+	if len(published) != 1 {
+		t.Fatalf("expected one announcement, got %d", len(published))
+	}
+	text := published[0].Text
+	if published[0].ChatID != "room" || !strings.Contains(text, "S1 closed (3)") || !strings.Contains(text, "winner alice님, next S2") {
+		t.Fatalf("unexpected announcement: %+v", published[0])
+	}
+	if strings.Contains(text, "carol") {
+		t.Fatalf("announcement should list only top 2: %q", text)
+	}
+
+	season, err := repo.LatestSeason(ctx, "room")
+	if err != nil || season == nil || season.ClosedAt == nil || season.AnnouncedAt == nil {
+		t.Fatalf("expected closed and announced season, got %+v (%v)", season, err)
+	}
+	if !season.ClosedAt.Equal(season.EndsAt) {
+		t.Fatalf("expected close at scheduled end %v, got %v", season.EndsAt, season.ClosedAt)
+	}
+
+	standings, err := repo.SeasonStandings(ctx, season.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]string, 0, len(standings))
+	for _, s := range standings {
+		got = append(got, s.UserID)
+	}
+	if strings.Join(got, ",") != "alice,bob,carol" {
+		t.Fatalf("unexpected archived standings: %v", got)
+	}
+
+	// 닫힌 뒤에는 최종 순위를 보여주고, 다음 게임부터 새 시즌
+	text, err = seasons.Standings(ctx, "room")
+	if err != nil || !strings.HasPrefix(text, "S1 final") {
+		t.Fatalf("unexpected standings after close: %q (%v)", text, err)
+	}
+	if got := seasons.SeasonNumber(ctx, "room", time.Now()); got != 2 {
+		t.Fatalf("expected season 2 after close, got %d", got)
+	}
+}
+
+func TestSeasonService_AnnounceRetriesAfterPublishFailure(t *testing.T) {
+	seasons, repo, _ := newSeasonTestService(t)
+	ctx := context.Background()
+	// 방금 끝난 시즌 (안내 재시도 허용 시간 안)
+	seasons.SeasonNumber(ctx, "room", time.Now().Add(-7*24*time.Hour-time.Minute))
+
+	attempts := 0
+	seasons.SetPublisher(func(_ context.Context, _ mqmsg.OutboundMessage) error {
+		attempts++
+		if attempts == 1 {
+			return context.DeadlineExceeded
+		}
+		return nil
+	})
+
+	seasons.tick(ctx, time.Now())
+	if attempts != 2 {
+		t.Fatalf("expected failed announcement to be retried in the same tick, got %d attempts", attempts)
+	}
+	season, _ := repo.LatestSeason(ctx, "room")
+	if season.AnnouncedAt == nil {
+		t.Fatal("expected season to be marked announced after retry")
+	}
+	if season.ClosedAt == nil {
+		t.Fatal("expected season closed")
+	}
+}
+
+func TestSeasonService_CloseCurrent(t *testing.T) {
+	seasons, _, db := newSeasonTestService(t)
+	ctx := context.Background()
+
+	if _, closed, err := seasons.CloseCurrent(ctx, "room"); err != nil || closed {
+		t.Fatalf("expected nothing to close, got closed=%v err=%v", closed, err)
+	}
+
+	number := seasons.SeasonNumber(ctx, "room", time.Now())
+	createSeasonGameLog(t, db, "room", "alice", number, true)
+
+	text, err := seasons.Standings(ctx, "room")
+	if err != nil || !strings.Contains(text, "1. alice님 1/1") {
+		t.Fatalf("unexpected live standings: %q (%v)", text, err)
+	}
+
+	season, closed, err := seasons.CloseCurrent(ctx, "room")
+	if err != nil || !closed || season == nil || season.ClosedAt == nil {
+		t.Fatalf("expected season closed, got %+v closed=%v err=%v", season, closed, err)
+	}
+	if _, closed, _ := seasons.CloseCurrent(ctx, "room"); closed {
+		t.Fatal("expected second close to be a no-op")
+	}
+}
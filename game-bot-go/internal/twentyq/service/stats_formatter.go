@@ -2,6 +2,7 @@ package service
 
 import (
 	"cmp"
+	"context"
 	"fmt"
 	"slices"
 	"strings"
@@ -14,7 +15,12 @@ import (
 )
 
 // formatStats 사용자 통계를 포맷팅하여 반환.
-func (s *StatsService) formatStats(stats *repository.UserStats, senderName string) string {
+func (s *StatsService) formatStats(
+	ctx context.Context,
+	stats *repository.UserStats,
+	senderName string,
+	profile *playerProfile,
+) string {
 	var parts []string
 
 	// 카테고리별 통계 파싱
@@ -36,21 +42,63 @@ func (s *StatsService) formatStats(stats *repository.UserStats, senderName strin
 	categoryStats = ensureAllCategoryStats(categoryStats)
 
 	// 헤더
-	parts = append(parts, s.msgProvider.Get(
+	parts = append(parts, s.msgProvider.For(ctx).Get(
 		qmessages.StatsHeader,
 		messageprovider.P("nickname", senderName),
 		messageprovider.P("totalGames", totalGames),
 	))
 
+	s.addProfile(ctx, &parts, stats, profile)
+
 	if len(categoryStats) > 0 {
-		s.addCategoryStats(&parts, categoryStats)
+		s.addCategoryStats(ctx, &parts, categoryStats)
 	}
 
 	return strings.Join(parts, "\n")
 }
 
+// addProfile 정답 횟수, 최소 질문 정답, 주력 카테고리, 방 순위를 추가 (집계할 기록이 없는 항목은 생략).
+func (s *StatsService) addProfile(ctx context.Context, parts *[]string, stats *repository.UserStats, profile *playerProfile) {
+	msgs := s.msgProvider.For(ctx)
+	if profile != nil && profile.Games > 0 {
+		*parts = append(*parts, msgs.Get(
+			qmessages.StatsProfileRecord,
+			messageprovider.P("wins", profile.Wins),
+			messageprovider.P("games", profile.Games),
+			messageprovider.P("winRate", (profile.Wins*percentageMultiplier)/profile.Games),
+		))
+	}
+	if stats.BestScoreQuestionCnt != nil {
+		*parts = append(*parts, msgs.Get(
+			qmessages.StatsProfileBest,
+			messageprovider.P("count", *stats.BestScoreQuestionCnt),
+		))
+	}
+	if profile == nil || profile.Games == 0 {
+		return
+	}
+	if favorite := profile.Favorite; favorite != nil {
+		displayCategory := favorite.Category
+		if korean := categoryToKorean(favorite.Category); korean != nil {
+			displayCategory = *korean
+		}
+		*parts = append(*parts, msgs.Get(
+			qmessages.StatsProfileFavorite,
+			messageprovider.P("category", displayCategory),
+			messageprovider.P("games", favorite.Games),
+		))
+	}
+	if profile.Rank.Rank > 0 {
+		*parts = append(*parts, msgs.Get(
+			qmessages.StatsProfileRank,
+			messageprovider.P("rank", profile.Rank.Rank),
+			messageprovider.P("players", profile.Rank.Players),
+		))
+	}
+}
+
 // addCategoryStats 카테고리별 통계를 추가.
-func (s *StatsService) addCategoryStats(parts *[]string, categoryStats map[string]CategoryStat) {
+func (s *StatsService) addCategoryStats(ctx context.Context, parts *[]string, categoryStats map[string]CategoryStat) {
 	// 카테고리 정렬
 	type catEntry struct {
 		name string
@@ -85,19 +133,19 @@ func (s *StatsService) addCategoryStats(parts *[]string, categoryStats map[strin
 	})
 
 	for _, entry := range entries {
-		s.addSingleCategoryStats(parts, entry.name, entry.stat)
+		s.addSingleCategoryStats(ctx, parts, entry.name, entry.stat)
 	}
 }
 
 // addSingleCategoryStats 단일 카테고리 통계를 추가.
-func (s *StatsService) addSingleCategoryStats(parts *[]string, category string, stat CategoryStat) {
+func (s *StatsService) addSingleCategoryStats(ctx context.Context, parts *[]string, category string, stat CategoryStat) {
 	displayCategory := category
 	if korean := categoryToKorean(category); korean != nil {
 		displayCategory = *korean
 	}
 
 	// 카테고리 헤더
-	*parts = append(*parts, s.msgProvider.Get(
+	*parts = append(*parts, s.msgProvider.For(ctx).Get(
 		qmessages.StatsCategoryHdr,
 		messageprovider.P("category", displayCategory),
 		messageprovider.P("games", stat.GamesCompleted),
@@ -112,7 +160,7 @@ func (s *StatsService) addSingleCategoryStats(parts *[]string, category string,
 	if stat.GamesCompleted > 0 {
 		completionRate = (completed * percentageMultiplier) / stat.GamesCompleted
 	}
-	*parts = append(*parts, s.msgProvider.Get(
+	*parts = append(*parts, s.msgProvider.For(ctx).Get(
 		qmessages.StatsCategoryResults,
 		messageprovider.P("completed", completed),
 		messageprovider.P("surrender", stat.Surrenders),
@@ -126,7 +174,7 @@ func (s *StatsService) addSingleCategoryStats(parts *[]string, category string,
 		avgQuestions = float64(stat.QuestionsAsked) / float64(stat.GamesCompleted)
 		avgHints = float64(stat.HintsUsed) / float64(stat.GamesCompleted)
 	}
-	*parts = append(*parts, s.msgProvider.Get(
+	*parts = append(*parts, s.msgProvider.For(ctx).Get(
 		qmessages.StatsCategoryAverages,
 		messageprovider.P("avgQuestions", fmt.Sprintf("%.1f", avgQuestions)),
 		messageprovider.P("avgHints", fmt.Sprintf("%.1f", avgHints)),
@@ -137,6 +185,7 @@ func (s *StatsService) addSingleCategoryStats(parts *[]string, category string,
 
 // formatRoomStats 방 전적을 포맷팅하여 반환.
 func (s *StatsService) formatRoomStats(
+	ctx context.Context,
 	period qmodel.StatsPeriod,
 	totalGames int,
 	totalParticipants int,
@@ -147,12 +196,12 @@ func (s *StatsService) formatRoomStats(
 
 	// 헤더
 	parts = append(parts,
-		s.msgProvider.Get(
+		s.msgProvider.For(ctx).Get(
 			qmessages.StatsRoomHeader,
-			messageprovider.P("period", s.getPeriodName(period)),
+			messageprovider.P("period", s.getPeriodName(ctx, period)),
 		),
 		"",
-		s.msgProvider.Get(
+		s.msgProvider.For(ctx).Get(
 			qmessages.StatsRoomSummary,
 			messageprovider.P("totalGames", totalGames),
 			messageprovider.P("totalParticipants", totalParticipants),
@@ -162,9 +211,9 @@ func (s *StatsService) formatRoomStats(
 
 	// 참여 활동
 	if len(activities) > 0 {
-		parts = append(parts, "", s.msgProvider.Get(qmessages.StatsRoomActivityHdr))
+		parts = append(parts, "", s.msgProvider.For(ctx).Get(qmessages.StatsRoomActivityHdr))
 		for _, activity := range activities {
-			parts = append(parts, s.msgProvider.Get(
+			parts = append(parts, s.msgProvider.For(ctx).Get(
 				qmessages.StatsRoomActivityItem,
 				messageprovider.P("sender", activity.Sender),
 				messageprovider.P("games", activity.GamesPlayed),
@@ -176,17 +225,17 @@ func (s *StatsService) formatRoomStats(
 }
 
 // getPeriodName 기간 이름을 반환.
-func (s *StatsService) getPeriodName(period qmodel.StatsPeriod) string {
+func (s *StatsService) getPeriodName(ctx context.Context, period qmodel.StatsPeriod) string {
 	switch period {
 	case qmodel.StatsPeriodDaily:
-		return s.msgProvider.Get(qmessages.StatsPeriodDaily)
+		return s.msgProvider.For(ctx).Get(qmessages.StatsPeriodDaily)
 	case qmodel.StatsPeriodWeekly:
-		return s.msgProvider.Get(qmessages.StatsPeriodWeekly)
+		return s.msgProvider.For(ctx).Get(qmessages.StatsPeriodWeekly)
 	case qmodel.StatsPeriodMonthly:
-		return s.msgProvider.Get(qmessages.StatsPeriodMonthly)
+		return s.msgProvider.For(ctx).Get(qmessages.StatsPeriodMonthly)
 	case qmodel.StatsPeriodAll:
-		return s.msgProvider.Get(qmessages.StatsPeriodAll)
+		return s.msgProvider.For(ctx).Get(qmessages.StatsPeriodAll)
 	default:
-		return s.msgProvider.Get(qmessages.StatsPeriodAll)
+		return s.msgProvider.For(ctx).Get(qmessages.StatsPeriodAll)
 	}
 }
@@ -11,6 +11,8 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
 	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
 )
 
@@ -30,6 +32,10 @@ type PlayerCompletionRecord struct {
 	QuestionCount   int
 	WrongGuessCount int
 	Target          *string
+	// 턴제 모드에서 진행한 차례 수, 차례 소요 시간 합계(ms), 시간 초과 횟수
+	TurnCount    int
+	TurnMillis   int64
+	TurnTimeouts int
 }
 
 // GameCompletionRecord: 게임 전체 완료 기록 구조체
@@ -41,7 +47,14 @@ type GameCompletionRecord struct {
 	Players            []PlayerCompletionRecord
 	TotalQuestionCount int
 	HintCount          int
+	MaxCombo           int
 	CompletedAt        time.Time
+	// OpeningQuestions: 추천 첫 질문 집계용 초반 질문 (정규화된 문장, 순서대로)
+	OpeningQuestions []string
+	// LLMUsage: 이 게임에서 사용한 LLM 토큰/비용 요약 (기록 시 누적 저장소에서 채움)
+	LLMUsage *qmodel.LLMUsageSummary
+	// Season: 게임이 끝난 시점의 방 시즌 번호 (기록 시 SeasonService에서 채움, 시즌이 꺼져 있으면 0)
+	Season int
 }
 
 // StatsRecorder: 게임 통계를 비동기 또는 동기로 기록하는 레코더
@@ -55,6 +68,11 @@ type StatsRecorder struct {
 	stopOnce           sync.Once
 	stopped            chan struct{}
 	dropLogOnQueueFull bool
+
+	// 게임별 LLM 사용량 누적 저장소 (없으면 사용량 기록 생략)
+	llmUsage *qredis.LLMUsageStore
+	// 방별 시즌 (없으면 시즌 번호 0으로 기록)
+	seasons *SeasonService
 }
 
 // NewStatsRecorder: 새로운 StatsRecorder 인스턴스를 생성합니다.
@@ -116,6 +134,81 @@ func (r *StatsRecorder) worker(id int) {
 	r.logger.Debug("stats_worker_stopped", "worker_id", id)
 }
 
+// SetLLMUsageStore: 게임별 LLM 사용량 누적 저장소를 연결합니다.
+func (r *StatsRecorder) SetLLMUsageStore(store *qredis.LLMUsageStore) {
+	if r == nil {
+		return
+	}
+	r.llmUsage = store
+}
+
+// SetSeasons: 게임 기록에 시즌 번호를 붙일 시즌 서비스를 연결합니다.
+func (r *StatsRecorder) SetSeasons(seasons *SeasonService) {
+	if r == nil {
+		return
+	}
+	r.seasons = seasons
+}
+
+// attachSeason: 게임이 끝난 시점의 시즌 번호를 기록에 붙입니다.
+// 비동기 기록 도중 시즌이 바뀌어도 끝난 시점의 시즌으로 집계되도록 큐에 넣기 전에 정합니다.
+func (r *StatsRecorder) attachSeason(ctx context.Context, record *GameCompletionRecord, now time.Time) {
+	if r.seasons == nil || record.Season != 0 {
+		return
+	}
+	at := record.CompletedAt
+	if at.IsZero() {
+		at = now
+	}
+	record.Season = r.seasons.SeasonNumber(ctx, record.ChatID, at)
+}
+
+// ResetLLMUsage: 방의 누적 LLM 사용량을 비웁니다.
+func (r *StatsRecorder) ResetLLMUsage(ctx context.Context, chatID string) {
+	if r == nil || r.llmUsage == nil {
+		return
+	}
+	if err := r.llmUsage.Reset(ctx, chatID); err != nil {
+		r.logger.Warn("stats_llm_usage_reset_failed", "chat_id", chatID, "err", err)
+	}
+}
+
+// attachLLMUsage: 누적된 LLM 사용량을 꺼내 기록에 붙입니다.
+// 다음 게임이 같은 방에서 바로 시작될 수 있으므로 큐에 넣기 전에 동기로 꺼냅니다.
+func (r *StatsRecorder) attachLLMUsage(ctx context.Context, record *GameCompletionRecord) {
+	if r.llmUsage == nil || record.LLMUsage != nil {
+		return
+	}
+	tasks, err := r.llmUsage.Take(ctx, record.ChatID)
+	if err != nil {
+		r.logger.Warn("stats_llm_usage_take_failed", "chat_id", record.ChatID, "err", err)
+		return
+	}
+	record.LLMUsage = SummarizeLLMUsage(tasks)
+}
+
+// SummarizeLLMUsage: 작업별 사용량을 합산하고 모델 단가로 예상 비용을 계산합니다.
+// 사용량이 없으면 nil을 반환합니다.
+func SummarizeLLMUsage(tasks []qmodel.LLMTaskUsage) *qmodel.LLMUsageSummary {
+	if len(tasks) == 0 {
+		return nil
+	}
+	summary := &qmodel.LLMUsageSummary{Tasks: make([]qmodel.LLMTaskUsage, 0, len(tasks))}
+	for _, task := range tasks {
+		model := task.Model
+		task.CostUSD = ParseGeminiModel(&model).CalculateCostUsd(task.InputTokens, task.OutputTokens, task.ReasoningTokens)
+
+		summary.InputTokens += task.InputTokens
+		summary.OutputTokens += task.OutputTokens
+		summary.ReasoningTokens += task.ReasoningTokens
+		summary.Calls += task.Calls
+		summary.CostUSD += task.CostUSD
+		summary.Tasks = append(summary.Tasks, task)
+	}
+	summary.TotalTokens = summary.InputTokens + summary.OutputTokens + summary.ReasoningTokens
+	return summary
+}
+
 // RecordGameStart: 게임 시작 정보를 기록합니다.
 func (r *StatsRecorder) RecordGameStart(ctx context.Context, chatID string, userID string) {
 	if r == nil || r.repo == nil {
@@ -145,6 +238,8 @@ func (r *StatsRecorder) RecordGameCompletion(ctx context.Context, record GameCom
 	}
 
 	now := time.Now()
+	r.attachLLMUsage(ctx, &record)
+	r.attachSeason(ctx, &record, now)
 
 	// [동기] 사용자에게 표시되는 핵심 통계 먼저 처리
 	r.processCriticalSync(ctx, record, now)
@@ -189,6 +284,8 @@ func (r *StatsRecorder) RecordGameCompletionSync(ctx context.Context, record Gam
 	}
 
 	now := time.Now()
+	r.attachLLMUsage(ctx, &record)
+	r.attachSeason(ctx, &record, now)
 	r.processCriticalSync(ctx, record, now)
 	r.processNonCriticalAsync(ctx, record, now)
 }
@@ -261,6 +358,7 @@ func (r *StatsRecorder) processCriticalSync(ctx context.Context, record GameComp
 // processNonCriticalAsync 분석용 로그 처리 (비동기 또는 fallback 동기)
 // - game_session: 게임 세션 메타데이터
 // - game_log: 플레이어별 상세 기록
+// - game_opening_questions: 추천 첫 질문 집계용 초반 질문
 func (r *StatsRecorder) processNonCriticalAsync(ctx context.Context, record GameCompletionRecord, now time.Time) {
 	participantCount := len(record.Players)
 	if participantCount < 1 {
@@ -276,6 +374,9 @@ func (r *StatsRecorder) processNonCriticalAsync(ctx context.Context, record Game
 		ParticipantCount: participantCount,
 		QuestionCount:    record.TotalQuestionCount,
 		HintCount:        record.HintCount,
+		MaxCombo:         record.MaxCombo,
+		LLMUsage:         record.LLMUsage,
+		Season:           record.Season,
 		CompletedAt:      record.CompletedAt,
 		Now:              now,
 	}); err != nil {
@@ -299,10 +400,26 @@ func (r *StatsRecorder) processNonCriticalAsync(ctx context.Context, record Game
 			WrongGuessCount: p.WrongGuessCount,
 			Result:          qrepo.GameResult(record.Result),
 			Target:          p.Target,
+			TurnCount:       p.TurnCount,
+			TurnMillis:      p.TurnMillis,
+			TurnTimeouts:    p.TurnTimeouts,
+			Season:          record.Season,
 			CompletedAt:     record.CompletedAt,
 			Now:             now,
 		}); err != nil {
 			r.logger.Warn("stats_game_log_record_failed", "chat_id", record.ChatID, "user_id", userID, "err", err)
 		}
 	}
+
+	if len(record.OpeningQuestions) > 0 {
+		if err := r.repo.RecordOpeningQuestions(ctx, qrepo.OpeningQuestionParams{
+			Category:    record.Category,
+			Result:      qrepo.GameResult(record.Result),
+			Questions:   record.OpeningQuestions,
+			CompletedAt: record.CompletedAt,
+			Now:         now,
+		}); err != nil {
+			r.logger.Warn("stats_opening_questions_record_failed", "chat_id", record.ChatID, "err", err)
+		}
+	}
 }
@@ -11,8 +11,12 @@ import (
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
 
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
 	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/ptr"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
 	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qmodel "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/model"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
 	qrepo "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/repository"
 )
 
@@ -301,3 +305,82 @@ func TestBestScoreUpdate(t *testing.T) {
 		t.Errorf("expected best target to be updated to 복수, got %v", catStat2.BestTarget)
 	}
 }
+
+func TestStatsRecorder_LLMUsage(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+	repo := qrepo.New(db)
+	if err := repo.AutoMigrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	usageStore := qredis.NewLLMUsageStore(client, logger)
+	recorder := NewStatsRecorder(repo, logger, qconfig.StatsConfig{})
+	recorder.SetLLMUsageStore(usageStore)
+
+	ctx := context.Background()
+	chatID := testhelper.UniqueTestPrefix(t) + "chat_usage"
+
+	// 이전 게임 잔여분은 ResetLLMUsage로 버려짐
+	usageStore.RecordLLMUsage(ctx, chatID, "VerifyGuess", llmrest.CallUsage{InputTokens: 999, Calls: 1})
+	recorder.ResetLLMUsage(ctx, chatID)
+
+	usageStore.RecordLLMUsage(ctx, chatID, "SelectTopic", llmrest.CallUsage{InputTokens: 1000, OutputTokens: 100, Calls: 1, Model: "gemini-3-flash-preview"})
+	usageStore.RecordLLMUsage(ctx, chatID, "AnswerQuestion", llmrest.CallUsage{InputTokens: 2000, OutputTokens: 200, ReasoningTokens: 50, Calls: 4, Model: "gemini-3-flash-preview"})
+
+	recorder.RecordGameCompletionSync(ctx, GameCompletionRecord{
+		SessionID:   "sess_usage_1",
+		ChatID:      chatID,
+		Category:    "Animals",
+		Result:      GameResultCorrect,
+		CompletedAt: time.Now(),
+		Players:     []PlayerCompletionRecord{{UserID: "user_usage_1", Sender: "P1", QuestionCount: 4}},
+	})
+
+	var session qrepo.GameSession
+	if err := db.Where("session_id = ?", "sess_usage_1").First(&session).Error; err != nil {
+		t.Fatalf("expected session to be created: %v", err)
+	}
+	if session.LLMTotalTokens != 3350 {
+		t.Errorf("expected 3350 total tokens, got %d", session.LLMTotalTokens)
+	}
+	wantCost := GeminiModelFlash30.CalculateCostUsd(3000, 300, 50)
+	if diff := session.LLMCostUSD - wantCost; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("expected cost %f, got %f", wantCost, session.LLMCostUSD)
+	}
+	if session.LLMUsageJSON == nil {
+		t.Fatal("expected llm usage json")
+	}
+
+	var summary qmodel.LLMUsageSummary
+	if err := json.Unmarshal([]byte(*session.LLMUsageJSON), &summary); err != nil {
+		t.Fatalf("invalid llm usage json: %v", err)
+	}
+	if summary.Calls != 5 || len(summary.Tasks) != 2 {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+	if summary.Tasks[0].Task != "AnswerQuestion" || summary.Tasks[1].Task != "SelectTopic" {
+		t.Errorf("expected tasks sorted by name, got %+v", summary.Tasks)
+	}
+}
+
+func TestSummarizeLLMUsage_Empty(t *testing.T) {
+	if got := SummarizeLLMUsage(nil); got != nil {
+		t.Errorf("expected nil summary, got %+v", got)
+	}
+}
@@ -25,6 +25,7 @@ const (
 // StatsService 전적 조회 서비스.
 type StatsService struct {
 	db           *gorm.DB
+	repo         *repository.Repository
 	sessionStore *redis.SessionStore
 	msgProvider  *messageprovider.Provider
 	logger       *slog.Logger
@@ -39,6 +40,7 @@ func NewStatsService(
 ) *StatsService {
 	return &StatsService{
 		db:           db,
+		repo:         repository.New(db),
 		sessionStore: sessionStore,
 		msgProvider:  msgProvider,
 		logger:       logger,
@@ -65,13 +67,13 @@ func (s *StatsService) GetUserStats(
 		targetUserID, resolvedSender, ok, err := s.resolveTargetUserByNickname(ctx, chatID, nickname)
 		if err != nil {
 			s.logger.Warn("resolve_target_nickname_failed", "error", err, "chatID", chatID, "nickname", nickname)
-			return s.msgProvider.Get(
+			return s.msgProvider.For(ctx).Get(
 				qmessages.StatsUserNotFound,
 				messageprovider.P("nickname", nickname),
 			), nil
 		}
 		if !ok {
-			return s.msgProvider.Get(
+			return s.msgProvider.For(ctx).Get(
 				qmessages.StatsUserNotFound,
 				messageprovider.P("nickname", nickname),
 			), nil
@@ -80,34 +82,34 @@ func (s *StatsService) GetUserStats(
 		stats, err := s.loadUserStats(ctx, chatID, targetUserID)
 		if err != nil {
 			s.logger.Warn("loadUserStats_failed", "error", err, "chatID", chatID, "userID", targetUserID)
-			return s.msgProvider.Get(
+			return s.msgProvider.For(ctx).Get(
 				qmessages.StatsNoStats,
 				messageprovider.P("nickname", resolvedSender),
 			), nil
 		}
 		if stats == nil {
-			return s.msgProvider.Get(
+			return s.msgProvider.For(ctx).Get(
 				qmessages.StatsNoStats,
 				messageprovider.P("nickname", resolvedSender),
 			), nil
 		}
-		return s.formatStats(stats, resolvedSender), nil
+		return s.formatStats(ctx, stats, resolvedSender, s.loadPlayerProfile(ctx, chatID, targetUserID)), nil
 	}
 
 	// 본인 전적 조회
 	stats, err := s.loadUserStats(ctx, chatID, userID)
 	if err != nil {
 		s.logger.Warn("loadUserStats_failed", "error", err, "chatID", chatID, "userID", userID)
-		return s.msgProvider.Get(qmessages.StatsNotFound), nil
+		return s.msgProvider.For(ctx).Get(qmessages.StatsNotFound), nil
 	}
 	if stats == nil {
-		return s.msgProvider.Get(qmessages.StatsNotFound), nil
+		return s.msgProvider.For(ctx).Get(qmessages.StatsNotFound), nil
 	}
 	displayName := "누군가"
 	if sender != nil && *sender != "" {
 		displayName = *sender
 	}
-	return s.formatStats(stats, displayName), nil
+	return s.formatStats(ctx, stats, displayName, s.loadPlayerProfile(ctx, chatID, userID)), nil
 }
 
 // roomStatsAggregate DB 집계 결과를 담는 구조체.
@@ -140,9 +142,9 @@ func (s *StatsService) GetRoomStats(
 	}
 
 	if stats.TotalGames == 0 {
-		return s.msgProvider.Get(
+		return s.msgProvider.For(ctx).Get(
 			qmessages.StatsRoomNoGames,
-			messageprovider.P("period", s.getPeriodName(period)),
+			messageprovider.P("period", s.getPeriodName(ctx, period)),
 		), nil
 	}
 
@@ -177,7 +179,7 @@ func (s *StatsService) GetRoomStats(
 		return "", fmt.Errorf("count participants: %w", err)
 	}
 
-	return s.formatRoomStats(period, stats.TotalGames, int(totalParticipants), completionRate, activities), nil
+	return s.formatRoomStats(ctx, period, stats.TotalGames, int(totalParticipants), completionRate, activities), nil
 }
 
 // loadUserStats 사용자 통계 로드.
@@ -193,6 +195,41 @@ func (s *StatsService) loadUserStats(ctx context.Context, chatID string, userID
 	return &stats, nil
 }
 
+// playerProfile 개인 전적 상단 요약 (game_logs 집계, 로그가 없으면 Games가 0).
+type playerProfile struct {
+	Games    int
+	Wins     int
+	Favorite *repository.PlayerCategoryStat
+	Rank     repository.PlayerRank
+}
+
+// loadPlayerProfile 카테고리별 기록과 방 순위를 집계. 부가 정보이므로 실패하면 nil.
+func (s *StatsService) loadPlayerProfile(ctx context.Context, chatID string, userID string) *playerProfile {
+	categories, err := s.repo.PlayerCategoryStats(ctx, chatID, userID)
+	if err != nil {
+		s.logger.Warn("player_category_stats_failed", "error", err, "chatID", chatID, "userID", userID)
+		return nil
+	}
+	profile := &playerProfile{}
+	for i, stat := range categories {
+		profile.Games += stat.Games
+		profile.Wins += stat.Wins
+		if i == 0 {
+			profile.Favorite = &categories[i]
+		}
+	}
+	if profile.Games == 0 {
+		return profile
+	}
+
+	rank, err := s.repo.PlayerRankInChat(ctx, chatID, userID)
+	if err != nil {
+		s.logger.Warn("player_rank_failed", "error", err, "chatID", chatID, "userID", userID)
+	}
+	profile.Rank = rank
+	return profile
+}
+
 // resolveTargetUserByNickname 닉네임으로 대상 사용자 조회.
 func (s *StatsService) resolveTargetUserByNickname(
 	ctx context.Context,
@@ -64,6 +64,11 @@ stats:
     averages: "Avg: q={avgQuestions} h={avgHints}"
     best: "Best: {count} ({target})"
     no_best: "No Best"
+  profile:
+    record: "Record: {wins}/{games} ({winRate}%)"
+    best: "Fewest: {count}"
+    favorite: "Favorite: {category} ({games})"
+    rank: "Rank: {rank}/{players}"
 `)
 
 	sessionStore := qredis.NewSessionStore(client, logger)
@@ -279,6 +284,59 @@ stats:
 		}
 	})
 
+	t.Run("GetUserStats_Profile", func(t *testing.T) {
+		chatID := prefix + "chat_profile"
+		target := "호랑이"
+		logs := []struct {
+			userID   string
+			category string
+			won      bool
+		}{
+			{"me", "organism", true},
+			{"me", "organism", false},
+			{"me", "ORGANISM", true},
+			{"me", "food", true},
+			{"rival", "food", true},
+			{"rival", "food", true},
+			{"rival", "food", true},
+			{"rival", "food", true},
+			{"casual", "place", false},
+		}
+		for _, l := range logs {
+			entry := repository.GameLog{
+				ChatID:      chatID,
+				UserID:      l.userID,
+				Category:    l.category,
+				Result:      "SURRENDER",
+				CompletedAt: time.Now(),
+			}
+			if l.won {
+				entry.Result = "CORRECT"
+				entry.Target = &target
+			}
+			db.Create(&entry)
+		}
+		best := 7
+		db.Create(&repository.UserStats{
+			ID:                   chatID + ":me",
+			ChatID:               chatID,
+			UserID:               "me",
+			TotalGamesCompleted:  4,
+			BestScoreQuestionCnt: &best,
+		})
+
+		nick := "Me"
+		resp, err := svc.GetUserStats(ctx, chatID, "me", &nick, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{"Record: 3/4 (75%)", "Fewest: 7", "Favorite: 생물 (3)", "Rank: 2/3"} {
+			if !strings.Contains(resp, want) {
+				t.Errorf("expected %q in %s", want, resp)
+			}
+		}
+	})
+
 	t.Run("GetPeriodHelpers_EdgeCases", func(t *testing.T) {
 		unknownPeriod := qmodel.StatsPeriod("unknown")
 
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/telemetry"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+)
+
+const (
+	// tracerName: 스무고개 게임 흐름 span의 tracer 이름
+	tracerName = "game-bot-go/twentyq"
+	// synonymEmbedTaskType: 동의어 판정용 임베딩 작업 유형
+	synonymEmbedTaskType = "SEMANTIC_SIMILARITY"
+	// synonymPrecomputeTimeout: 게임 시작 시 정답 임베딩을 미리 계산할 때의 제한 시간 (게임 진행과 무관하게 백그라운드 수행)
+	synonymPrecomputeTimeout = 10 * time.Second
+)
+
+// 동의어 판정 근거
+const (
+	SynonymSourceAlias     = "alias"     // 관리자가 등록한 별칭
+	SynonymSourceEmbedding = "embedding" // 임베딩 유사도가 수락 임계값 이상
+	SynonymSourceLLM       = "llm"       // 경계 구간에서 LLM 동의어 판정
+)
+
+// SynonymMatch: 정답 추측의 동의어 판정 결과
+type SynonymMatch struct {
+	Matched bool
+	Source  string
+	Score   float64 // 임베딩 코사인 유사도 (임베딩을 계산하지 않았으면 0)
+}
+
+// SynonymMatcher: 정답과 추측이 같은 대상을 가리키는지 판정합니다.
+// 별칭 해시 → 임베딩 유사도 → (경계 구간만) LLM 동의어 판정 순으로 확인해 LLM 호출을 최소화합니다.
+type SynonymMatcher struct {
+	restClient *llmrest.Client
+	store      *qredis.SynonymStore // 별칭 조회 비활성화 시 nil
+	logger     *slog.Logger
+
+	mu  sync.RWMutex
+	cfg qconfig.SynonymConfig
+}
+
+// NewSynonymMatcher: 새로운 SynonymMatcher 인스턴스를 생성합니다. 임베딩 판정이 꺼져 있어도 별칭 조회는 동작합니다.
+func NewSynonymMatcher(restClient *llmrest.Client, store *qredis.SynonymStore, cfg qconfig.SynonymConfig, logger *slog.Logger) *SynonymMatcher {
+	return &SynonymMatcher{
+		restClient: restClient,
+		store:      store,
+		cfg:        cfg,
+		logger:     logger,
+	}
+}
+
+// UpdateConfig: 임계값 등 판정 설정을 바꿉니다. (관리 대시보드 런타임 설정)
+func (m *SynonymMatcher) UpdateConfig(update func(cfg *qconfig.SynonymConfig)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	update(&m.cfg)
+}
+
+func (m *SynonymMatcher) config() qconfig.SynonymConfig {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.cfg
+}
+
+// SetSynonymMatcher: 정답 추측의 동의어 판정기를 설정합니다. (nil이면 기존 LLM 정답 검증만 사용)
+func (s *RiddleService) SetSynonymMatcher(matcher *SynonymMatcher) {
+	s.synonymMatcher = matcher
+}
+
+// Precompute: 정답 임베딩을 미리 계산해 LLM 서버 캐시에 올려 둡니다. 첫 추측의 지연을 줄이기 위한 것으로 실패해도 무시합니다.
+func (m *SynonymMatcher) Precompute(ctx context.Context, target string) {
+	target = strings.TrimSpace(target)
+	if m == nil || m.restClient == nil || target == "" {
+		return
+	}
+	cfg := m.config()
+	if !cfg.EmbeddingEnabled {
+		return
+	}
+
+	go func() {
+		embedCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), synonymPrecomputeTimeout)
+		defer cancel()
+		if _, err := m.embed(embedCtx, cfg.Dimensions, target); err != nil {
+			m.logger.Warn("synonym_precompute_failed", "err", err)
+		}
+	}()
+}
+
+// Match: 추측이 정답의 동의어인지 판정합니다. 조회/LLM 호출 실패는 불일치로 취급합니다.
+func (m *SynonymMatcher) Match(ctx context.Context, target string, guess string) SynonymMatch {
+	target = strings.TrimSpace(target)
+	guess = strings.TrimSpace(guess)
+	if m == nil || target == "" || guess == "" {
+		return SynonymMatch{}
+	}
+
+	ctx, span := telemetry.StartSpan(ctx, tracerName, "twentyq.synonym_match")
+	defer span.End()
+	result := m.match(ctx, target, guess)
+	span.SetAttributes(
+		attribute.Bool("game.synonym.matched", result.Matched),
+		attribute.String("game.synonym.source", result.Source),
+		attribute.Float64("game.synonym.score", result.Score),
+	)
+	return result
+}
+
+func (m *SynonymMatcher) match(ctx context.Context, target string, guess string) SynonymMatch {
+	if m.matchAlias(ctx, target, guess) {
+		return SynonymMatch{Matched: true, Source: SynonymSourceAlias}
+	}
+	cfg := m.config()
+	if !cfg.EmbeddingEnabled || m.restClient == nil {
+		return SynonymMatch{}
+	}
+
+	vectors, err := m.embed(ctx, cfg.Dimensions, target, guess)
+	if err != nil {
+		m.logger.Warn("synonym_embed_failed", "err", err)
+		return SynonymMatch{}
+	}
+	score := llmrest.CosineSimilarity(vectors[0], vectors[1])
+	switch {
+	case score >= cfg.AcceptThreshold:
+		return SynonymMatch{Matched: true, Source: SynonymSourceEmbedding, Score: score}
+	case score >= cfg.BorderlineThreshold:
+		return SynonymMatch{Matched: m.checkWithLLM(ctx, target, guess), Source: SynonymSourceLLM, Score: score}
+	default:
+		return SynonymMatch{Score: score}
+	}
+}
+
+// matchAlias: 추측(또는 정답)이 별칭으로 등록되어 있고 그 정답이 상대와 같으면 일치로 봅니다.
+func (m *SynonymMatcher) matchAlias(ctx context.Context, target string, guess string) bool {
+	if m.store == nil {
+		return false
+	}
+	for _, pair := range [][2]string{{guess, target}, {target, guess}} {
+		canonical, found, err := m.store.Lookup(ctx, pair[0])
+		if err != nil {
+			m.logger.Warn("synonym_lookup_failed", "err", err)
+			return false
+		}
+		if found && normalizeForEquality(canonical) == normalizeForEquality(pair[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *SynonymMatcher) checkWithLLM(ctx context.Context, target string, guess string) bool {
+	resp, err := m.restClient.TwentyQCheckSynonym(ctx, target, guess)
+	if err != nil {
+		m.logger.Warn("synonym_llm_check_failed", "err", err)
+		return false
+	}
+	return resp.Result != nil && strings.EqualFold(strings.TrimSpace(*resp.Result), "EQUIVALENT")
+}
+
+func (m *SynonymMatcher) embed(ctx context.Context, dimensions int, texts ...string) ([][]float32, error) {
+	resp, err := m.restClient.Embed(ctx, texts, llmrest.EmbedOptions{
+		TaskType:   synonymEmbedTaskType,
+		Dimensions: dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("synonym embed failed: %w", err)
+	}
+	if len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("synonym embed failed: expected %d vectors, got %d", len(texts), len(resp.Embeddings))
+	}
+	vectors := make([][]float32, len(resp.Embeddings))
+	for i, item := range resp.Embeddings {
+		vectors[i] = item.Values
+	}
+	return vectors, nil
+}
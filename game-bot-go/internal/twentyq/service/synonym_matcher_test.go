@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest"
+	llmv1 "github.com/park285/llm-kakao-bots/game-bot-go/internal/common/llmrest/pb/llm/v1"
+	"github.com/park285/llm-kakao-bots/game-bot-go/internal/common/testhelper"
+	qconfig "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/config"
+	qredis "github.com/park285/llm-kakao-bots/game-bot-go/internal/twentyq/redis"
+)
+
+func TestSynonymMatcher_Match(t *testing.T) {
+	client := testhelper.NewTestValkeyClient(t)
+	defer client.Close()
+	prefix := testhelper.UniqueTestPrefix(t)
+	defer testhelper.CleanupTestKeys(t, client, "20q:")
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	alias := prefix + "폰"
+	cmd := client.B().Hset().Key(qconfig.RedisKeySynonyms).FieldValue().FieldValue(alias, "스마트폰").Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
+		t.Fatalf("hset failed: %v", err)
+	}
+
+	vectors := map[string][]float32{
+		"스마트폰": {1, 0},
+		"휴대폰":  {0.95, 0.31}, // ≈0.95
+		"핸드폰":  {0.85, 0.53}, // ≈0.85
+		"사과":   {0, 1},
+	}
+	var embedCalls, synonymCalls int
+	var lastDimensions int32
+	stub := &twentyqLLMGRPCStub{
+		embed: func(req *llmv1.EmbedRequest) (*llmv1.EmbedResponse, error) {
+			embedCalls++
+			lastDimensions = req.GetDimensions()
+			resp := &llmv1.EmbedResponse{Model: "test", Dimensions: 2}
+			for _, text := range req.Texts {
+				resp.Embeddings = append(resp.Embeddings, &llmv1.Embedding{Values: vectors[text]})
+			}
+			return resp, nil
+		},
+		checkSynonym: func(req *llmv1.TwentyQCheckSynonymRequest) (*llmv1.TwentyQCheckSynonymResponse, error) {
+			synonymCalls++
+			result := "EQUIVALENT"
+			return &llmv1.TwentyQCheckSynonymResponse{Result: &result, RawText: result}, nil
+		},
+	}
+	baseURL, stop := testhelper.StartTestGRPCServer(t, func(s *grpc.Server) {
+		llmv1.RegisterLLMServiceServer(s, stub)
+	})
+	defer stop()
+	llmClient, err := llmrest.New(llmrest.Config{BaseURL: baseURL})
+	if err != nil {
+		t.Fatalf("llm client init failed: %v", err)
+	}
+
+	cfg := qconfig.SynonymConfig{EmbeddingEnabled: true, AcceptThreshold: 0.92, BorderlineThreshold: 0.8, Dimensions: 256}
+	matcher := NewSynonymMatcher(llmClient, qredis.NewSynonymStore(client, logger), cfg, logger)
+
+	if got := matcher.Match(ctx, "스마트폰", alias); !got.Matched || got.Source != SynonymSourceAlias || embedCalls != 0 {
+		t.Fatalf("alias must match without embedding: %+v (embed calls %d)", got, embedCalls)
+	}
+	if got := matcher.Match(ctx, "스마트폰", "휴대폰"); !got.Matched || got.Source != SynonymSourceEmbedding || synonymCalls != 0 {
+		t.Fatalf("high score must match without llm: %+v (llm calls %d)", got, synonymCalls)
+	}
+	if lastDimensions != 256 {
+		t.Fatalf("expected configured dimensions, got %d", lastDimensions)
+	}
+	if got := matcher.Match(ctx, "스마트폰", "핸드폰"); !got.Matched || got.Source != SynonymSourceLLM || synonymCalls != 1 {
+		t.Fatalf("borderline score must be confirmed by llm: %+v (llm calls %d)", got, synonymCalls)
+	}
+	if got := matcher.Match(ctx, "스마트폰", "사과"); got.Matched || synonymCalls != 1 {
+		t.Fatalf("low score must not match or call llm: %+v (llm calls %d)", got, synonymCalls)
+	}
+
+	cfg.EmbeddingEnabled = false
+	disabled := NewSynonymMatcher(llmClient, nil, cfg, logger)
+	before := embedCalls
+	if got := disabled.Match(ctx, "스마트폰", "휴대폰"); got.Matched || embedCalls != before {
+		t.Fatalf("disabled matcher must not embed: %+v", got)
+	}
+
+	var nilMatcher *SynonymMatcher
+	if got := nilMatcher.Match(ctx, "스마트폰", "휴대폰"); got.Matched {
+		t.Fatalf("nil matcher must not match")
+	}
+}
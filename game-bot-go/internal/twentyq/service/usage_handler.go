@@ -81,7 +81,7 @@ func (h *UsageHandler) Handle(
 
 	if !h.IsAdmin(userID) {
 		h.logger.Warn("USAGE_PERMISSION_DENIED", "chatID", chatID, "userID", userID)
-		return h.msgProvider.Get(qmessages.ErrorNoPermission), nil
+		return h.msgProvider.For(ctx).Get(qmessages.ErrorNoPermission), nil
 	}
 
 	switch period {
@@ -100,10 +100,10 @@ func (h *UsageHandler) buildTodayReport(ctx context.Context, modelOverride *stri
 	usage, err := h.llmClient.GetDailyUsage(ctx, nil)
 	if err != nil {
 		h.logger.Warn("get_daily_usage_failed", "error", err)
-		return h.msgProvider.Get(qmessages.UsageFetchFailed), nil
+		return h.msgProvider.For(ctx).Get(qmessages.UsageFetchFailed), nil
 	}
 	if usage == nil {
-		return h.msgProvider.Get(qmessages.UsageFetchFailed), nil
+		return h.msgProvider.For(ctx).Get(qmessages.UsageFetchFailed), nil
 	}
 	model := ResolveGeminiModel(modelOverride, usage.Model)
 	return h.formatDailyUsage(ctx, usage, model), nil
@@ -113,10 +113,10 @@ func (h *UsageHandler) buildWeeklyReport(ctx context.Context, modelOverride *str
 	usage, err := h.llmClient.GetRecentUsage(ctx, weeklyDays, nil)
 	if err != nil {
 		h.logger.Warn("get_weekly_usage_failed", "error", err)
-		return h.msgProvider.Get(qmessages.UsageFetchFailedWeekly), nil
+		return h.msgProvider.For(ctx).Get(qmessages.UsageFetchFailedWeekly), nil
 	}
 	if usage == nil {
-		return h.msgProvider.Get(qmessages.UsageFetchFailedWeekly), nil
+		return h.msgProvider.For(ctx).Get(qmessages.UsageFetchFailedWeekly), nil
 	}
 	model := ResolveGeminiModel(modelOverride, usage.Model)
 	return h.formatWeeklyUsage(ctx, usage, model), nil
@@ -126,10 +126,10 @@ func (h *UsageHandler) buildMonthlyReport(ctx context.Context, modelOverride *st
 	usage, err := h.llmClient.GetUsageTotalFromDB(ctx, monthlyDays, nil)
 	if err != nil {
 		h.logger.Warn("get_monthly_usage_failed", "error", err)
-		return h.msgProvider.Get(qmessages.UsageFetchFailedMonthly), nil
+		return h.msgProvider.For(ctx).Get(qmessages.UsageFetchFailedMonthly), nil
 	}
 	if usage == nil {
-		return h.msgProvider.Get(qmessages.UsageFetchFailedMonthly), nil
+		return h.msgProvider.For(ctx).Get(qmessages.UsageFetchFailedMonthly), nil
 	}
 	model := ResolveGeminiModel(modelOverride, usage.Model)
 	return h.formatMonthlyUsage(ctx, usage, model), nil
@@ -138,19 +138,19 @@ func (h *UsageHandler) buildMonthlyReport(ctx context.Context, modelOverride *st
 func (h *UsageHandler) formatDailyUsage(ctx context.Context, usage *llmrest.DailyUsageResponse, model GeminiModel) string {
 	var sb strings.Builder
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageHeaderToday,
-		messageprovider.P("label", h.msgProvider.Get(qmessages.StatsPeriodDaily)),
+		messageprovider.P("label", h.msgProvider.For(ctx).Get(qmessages.StatsPeriodDaily)),
 	))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelDate,
 		messageprovider.P("date", usage.UsageDate),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelInputOutput,
 		messageprovider.P("input", h.formatNum(usage.InputTokens)),
 		messageprovider.P("output", h.formatNum(usage.OutputTokens)),
@@ -158,20 +158,20 @@ func (h *UsageHandler) formatDailyUsage(ctx context.Context, usage *llmrest.Dail
 	sb.WriteString("\n")
 
 	if usage.ReasoningTokens > 0 {
-		sb.WriteString(h.msgProvider.Get(
+		sb.WriteString(h.msgProvider.For(ctx).Get(
 			qmessages.UsageLabelReasoning,
 			messageprovider.P("reasoning", h.formatNum(usage.ReasoningTokens)),
 		))
 		sb.WriteString("\n")
 	}
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelTotal,
 		messageprovider.P("total", h.formatNum(usage.TotalTokens)),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelReqCount,
 		messageprovider.P("count", h.formatNum(usage.RequestCount)),
 	))
@@ -185,7 +185,7 @@ func (h *UsageHandler) formatDailyUsage(ctx context.Context, usage *llmrest.Dail
 func (h *UsageHandler) formatWeeklyUsage(ctx context.Context, usage *llmrest.UsageListResponse, model GeminiModel) string {
 	var sb strings.Builder
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageHeaderWeekly,
 		messageprovider.P("days", weeklyDays),
 	))
@@ -193,7 +193,7 @@ func (h *UsageHandler) formatWeeklyUsage(ctx context.Context, usage *llmrest.Usa
 
 	for _, day := range usage.Usages {
 		if day.RequestCount > 0 {
-			sb.WriteString(h.msgProvider.Get(
+			sb.WriteString(h.msgProvider.For(ctx).Get(
 				qmessages.UsageLabelDailySummary,
 				messageprovider.P("date", day.UsageDate),
 				messageprovider.P("total", h.formatNum(day.TotalTokens)),
@@ -204,28 +204,28 @@ func (h *UsageHandler) formatWeeklyUsage(ctx context.Context, usage *llmrest.Usa
 	}
 
 	sb.WriteString("\n")
-	sb.WriteString(h.msgProvider.Get(qmessages.UsageLabelSum))
+	sb.WriteString(h.msgProvider.For(ctx).Get(qmessages.UsageLabelSum))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelInput,
 		messageprovider.P("input", h.formatNum(usage.TotalInputTokens)),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelOutput,
 		messageprovider.P("output", h.formatNum(usage.TotalOutputTokens)),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelTotal,
 		messageprovider.P("total", h.formatNum(usage.TotalTokens)),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelReqCount,
 		messageprovider.P("count", h.formatNum(usage.TotalRequestCount)),
 	))
@@ -244,33 +244,33 @@ func (h *UsageHandler) formatWeeklyUsage(ctx context.Context, usage *llmrest.Usa
 func (h *UsageHandler) formatMonthlyUsage(ctx context.Context, usage *llmrest.UsageResponse, model GeminiModel) string {
 	var sb strings.Builder
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageHeaderMonthly,
 		messageprovider.P("days", monthlyDays),
 	))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelInput,
 		messageprovider.P("input", h.formatNumInt(usage.InputTokens)),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelOutput,
 		messageprovider.P("output", h.formatNumInt(usage.OutputTokens)),
 	))
 	sb.WriteString("\n")
 
 	if usage.ReasoningTokens != nil && *usage.ReasoningTokens > 0 {
-		sb.WriteString(h.msgProvider.Get(
+		sb.WriteString(h.msgProvider.For(ctx).Get(
 			qmessages.UsageLabelReasoning,
 			messageprovider.P("reasoning", h.formatNumInt(*usage.ReasoningTokens)),
 		))
 		sb.WriteString("\n")
 	}
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelTotal,
 		messageprovider.P("total", h.formatNumInt(usage.TotalTokens)),
 	))
@@ -306,19 +306,19 @@ func (h *UsageHandler) appendCostSection(
 	costKrw := h.exchangeRate.UsdToKrw(ctx, costUsd)
 	rateInfo := h.exchangeRate.RateInfo(ctx)
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelCostHeader,
 		messageprovider.P("model", model.DisplayName()),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelCostValue,
 		messageprovider.P("cost", h.formatKrw(costKrw)),
 	))
 	sb.WriteString("\n")
 
-	sb.WriteString(h.msgProvider.Get(
+	sb.WriteString(h.msgProvider.For(ctx).Get(
 		qmessages.UsageLabelExchangeRate,
 		messageprovider.P("rate", rateInfo),
 	))
@@ -0,0 +1,149 @@
+// alarm_backup: alarms 테이블을 JSON 파일로 내보내거나 JSON 파일에서 가져옵니다.
+// 가져오기는 (room, user, channel) 기준 upsert이며, 끝나면 DB 기준으로 Valkey 알람 세트를 다시 맞춥니다.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/app"
+	"github.com/kapu/hololive-kakao-bot-go/internal/config"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/alarm"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// backupFile: 내보내기 파일 형식
+type backupFile struct {
+	ExportedAt time.Time       `json:"exported_at"`
+	Alarms     []*domain.Alarm `json:"alarms"`
+}
+
+func main() {
+	exportPath := flag.String("export", "", "write all alarms to this JSON file (- for stdout)")
+	importPath := flag.String("import", "", "upsert alarms from this JSON file (- for stdin)")
+	skipCache := flag.Bool("skip-cache", false, "do not reconcile Valkey after import")
+	timeout := flag.Duration("timeout", 5*time.Minute, "overall timeout")
+	flag.Parse()
+
+	if (*exportPath == "") == (*importPath == "") {
+		fmt.Fprintln(os.Stderr, "exactly one of -export or -import is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	logger, err := util.EnableFileLoggingWithLevel(util.LogConfig{
+		Dir:        cfg.Logging.Dir,
+		MaxSizeMB:  cfg.Logging.MaxSizeMB,
+		MaxBackups: cfg.Logging.MaxBackups,
+		MaxAgeDays: cfg.Logging.MaxAgeDays,
+		Compress:   cfg.Logging.Compress,
+	}, "alarm_backup.log", cfg.Logging.Level)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	repo, alarmSvc, cleanup, err := app.InitializeAlarmBackup(cfg, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect: %v\n", err)
+		os.Exit(1)
+	}
+	defer cleanup()
+
+	if *exportPath != "" {
+		err = exportAlarms(ctx, repo, *exportPath)
+	} else {
+		err = importAlarms(ctx, repo, alarmSvc, *importPath, !*skipCache)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func exportAlarms(ctx context.Context, repo *alarm.Repository, path string) error {
+	alarms, err := repo.LoadAll(ctx)
+	if err != nil {
+		return fmt.Errorf("load alarms: %w", err)
+	}
+	for _, a := range alarms {
+		a.ID = 0 // DB 기본 키는 복원 대상 DB에서 새로 부여
+	}
+	if alarms == nil {
+		alarms = []*domain.Alarm{}
+	}
+
+	out := io.Writer(os.Stdout)
+	if path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("create export file: %w", err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(backupFile{ExportedAt: time.Now().UTC(), Alarms: alarms}); err != nil {
+		return fmt.Errorf("write export: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "exported %d alarms\n", len(alarms))
+	return nil
+}
+
+func importAlarms(ctx context.Context, repo *alarm.Repository, alarmSvc *notification.AlarmService, path string, syncCache bool) error {
+	in := io.Reader(os.Stdin)
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("open import file: %w", err)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	var backup backupFile
+	if err := json.NewDecoder(in).Decode(&backup); err != nil {
+		return fmt.Errorf("decode import file: %w", err)
+	}
+	for i, a := range backup.Alarms {
+		if a == nil || a.RoomID == "" || a.UserID == "" || a.ChannelID == "" {
+			return fmt.Errorf("alarm #%d: room_id, user_id and channel_id are required", i)
+		}
+	}
+
+	imported, err := repo.Import(ctx, backup.Alarms)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("imported %d alarms\n", imported)
+
+	if !syncCache {
+		return nil
+	}
+	report, err := alarmSvc.ReconcileWithDB(ctx)
+	if err != nil {
+		return fmt.Errorf("reconcile valkey: %w", err)
+	}
+	fmt.Printf("valkey reconciled: restored %d, backfilled %d (db %d, cached %d)\n",
+		report.Restored, report.Backfilled, report.DBAlarms, report.CachedAlarms)
+	return nil
+}
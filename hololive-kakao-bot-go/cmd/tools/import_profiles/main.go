@@ -0,0 +1,84 @@
+// import_profiles: 임베드된 프로필 JSON(official_profiles_raw, official_profiles_ko)을 talent_profiles 테이블로 가져옵니다.
+// 원본 프로필은 항상 덮어쓰고, 관리자 API로 수정한 번역은 -overwrite-edited가 없으면 유지한다.
+// fetch_profiles로 JSON을 갱신한 뒤 실행하며, 실행 중인 봇은 재시작해야 새 데이터를 읽는다.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/app"
+	"github.com/kapu/hololive-kakao-bot-go/internal/config"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+func main() {
+	overwriteEdited := flag.Bool("overwrite-edited", false, "also overwrite translations edited through the admin API")
+	skipTranslations := flag.Bool("skip-translations", false, "import raw profiles only")
+	asJSON := flag.Bool("json", false, "print the result as JSON")
+	timeout := flag.Duration("timeout", 5*time.Minute, "overall timeout")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	logger, err := util.EnableFileLoggingWithLevel(util.LogConfig{
+		Dir:        cfg.Logging.Dir,
+		MaxSizeMB:  cfg.Logging.MaxSizeMB,
+		MaxBackups: cfg.Logging.MaxBackups,
+		MaxAgeDays: cfg.Logging.MaxAgeDays,
+		Compress:   cfg.Logging.Compress,
+	}, "import_profiles.log", cfg.Logging.Level)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	profiles, err := domain.LoadProfiles()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load profiles: %v\n", err)
+		os.Exit(1)
+	}
+	var translations map[string]*domain.Translated
+	if !*skipTranslations {
+		if translations, err = domain.LoadTranslated(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to load translations: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	store, cleanup, err := app.InitializeProfileImport(ctx, cfg, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer cleanup()
+
+	result, err := store.Import(ctx, member.TranslationLocale, profiles, translations, *overwriteEdited)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(result)
+		return
+	}
+	fmt.Printf("imported %d profiles, %d translations (%d admin-edited translations kept)\n",
+		result.Profiles, result.Translations, result.TranslationsKept)
+}
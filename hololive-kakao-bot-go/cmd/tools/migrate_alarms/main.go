@@ -0,0 +1,105 @@
+// migrate_alarms: 알람 Valkey 키를 현재 구조(registry/channel_subscribers/channel_registry)로 정리합니다.
+// 기본은 변경 내용만 출력하는 dry-run이며, -apply를 주어야 실제로 반영합니다.
+// 자동으로 고치지 못한 불일치가 있으면 종료 코드 3으로 끝난다.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/app"
+	"github.com/kapu/hololive-kakao-bot-go/internal/config"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+func main() {
+	apply := flag.Bool("apply", false, "apply changes (default: dry-run report only)")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	timeout := flag.Duration("timeout", 5*time.Minute, "overall timeout")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	logger, err := util.EnableFileLoggingWithLevel(util.LogConfig{
+		Dir:        cfg.Logging.Dir,
+		MaxSizeMB:  cfg.Logging.MaxSizeMB,
+		MaxBackups: cfg.Logging.MaxBackups,
+		MaxAgeDays: cfg.Logging.MaxAgeDays,
+		Compress:   cfg.Logging.Compress,
+	}, "migrate_alarms.log", cfg.Logging.Level)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	alarmSvc, cleanup, err := app.InitializeAlarmKeyspace(cfg, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to valkey: %v\n", err)
+		os.Exit(1)
+	}
+	defer cleanup()
+
+	report, err := alarmSvc.MigrateKeyspace(ctx, !*apply)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migration failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(report)
+	} else {
+		printReport(report)
+	}
+
+	if len(report.Inconsistencies) > 0 {
+		os.Exit(3)
+	}
+}
+
+func printReport(report *notification.KeyspaceReport) {
+	mode := "APPLIED"
+	if report.DryRun {
+		mode = "DRY-RUN (use -apply to write)"
+	}
+	fmt.Printf("Alarm keyspace migration: %s\n", mode)
+	fmt.Printf("  scanned keys: %d, user alarm keys: %d, changes: %d\n", report.ScannedKeys, report.UserKeys, report.Changes())
+
+	sections := []struct {
+		title   string
+		entries []string
+	}{
+		{"legacy keys converted to SET", report.LegacyConverted},
+		{"empty alarm keys removed", report.EmptyKeysRemoved},
+		{"registry entries added", report.RegistryAdded},
+		{"registry entries removed", report.RegistryRemoved},
+		{"channel subscribers added", report.SubscribersAdded},
+		{"channel subscribers removed", report.SubscribersRemoved},
+		{"channels added to registry", report.ChannelsAdded},
+		{"orphaned channels removed", report.ChannelsRemoved},
+		{"inconsistencies (manual check required)", report.Inconsistencies},
+	}
+	for _, section := range sections {
+		if len(section.entries) == 0 {
+			continue
+		}
+		fmt.Printf("\n%s (%d):\n", section.title, len(section.entries))
+		for _, entry := range section.entries {
+			fmt.Printf("  - %s\n", entry)
+		}
+	}
+}
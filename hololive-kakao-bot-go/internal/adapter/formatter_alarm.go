@@ -15,6 +15,7 @@ import (
 type AlarmListEntry struct {
 	MemberName string
 	NextStream *domain.NextStreamInfo
+	Filter     domain.AlarmKeywordFilter
 }
 
 type alarmAddedTemplateData struct {
@@ -41,6 +42,17 @@ type alarmListTemplateData struct {
 type alarmListEntryView struct {
 	MemberName string
 	NextStream *nextStreamInfoView
+	Filter     string
+}
+
+type alarmFilterTemplateData struct {
+	Emoji      UIEmoji
+	MemberName string
+	Updated    bool
+	Empty      bool
+	Include    string
+	Exclude    string
+	Prefix     string
 }
 
 type nextStreamInfoView struct {
@@ -60,8 +72,10 @@ type alarmClearedTemplateData struct {
 type alarmNotificationTemplateData struct {
 	Emoji           UIEmoji
 	ChannelName     string
+	Platform        string
 	MinutesUntil    int
 	Title           string
+	TitleKo         string
 	URL             string
 	ScheduleMessage string
 }
@@ -140,7 +154,9 @@ func buildNextStreamInfoView(info *domain.NextStreamInfo) *nextStreamInfoView {
 		view.Title = util.TruncateString(title, constants.StringLimits.NextStreamTitle)
 	}
 
-	if videoID := util.TrimSpace(info.VideoID); videoID != "" {
+	if watchURL := util.TrimSpace(info.URL); watchURL != "" {
+		view.URL = watchURL
+	} else if videoID := util.TrimSpace(info.VideoID); videoID != "" {
 		view.URL = youtubeWatchURLPrefix + videoID
 	}
 
@@ -188,6 +204,7 @@ func (f *ResponseFormatter) FormatAlarmList(alarms []AlarmListEntry) string {
 		processed[idx] = alarmListEntryView{
 			MemberName: alarm.MemberName,
 			NextStream: buildNextStreamInfoView(summarizeNextStreamInfo(alarm.NextStream)),
+			Filter:     summarizeKeywordFilter(alarm.Filter),
 		}
 	}
 
@@ -224,6 +241,38 @@ func (f *ResponseFormatter) FormatAlarmCleared(count int) string {
 	return rendered
 }
 
+// FormatAlarmFilter: 알림 키워드 필터 조회/변경 결과 메시지를 생성합니다. (updated: 이번 요청으로 바뀌었는지)
+func (f *ResponseFormatter) FormatAlarmFilter(memberName string, filter domain.AlarmKeywordFilter, updated bool) string {
+	data := alarmFilterTemplateData{
+		Emoji:      DefaultEmoji,
+		MemberName: memberName,
+		Updated:    updated,
+		Empty:      filter.IsEmpty(),
+		Include:    strings.Join(filter.Include, ", "),
+		Exclude:    strings.Join(filter.Exclude, ", "),
+		Prefix:     f.prefix,
+	}
+
+	rendered, err := executeFormatterTemplate("alarm_filter.tmpl", data)
+	if err != nil {
+		return ErrorMessage(ErrDisplayAlarmFilterFailed)
+	}
+
+	return rendered
+}
+
+// summarizeKeywordFilter: 알림 목록에 붙일 필터 요약 ("+歌枠 -ASMR", 없으면 빈 문자열)
+func summarizeKeywordFilter(filter domain.AlarmKeywordFilter) string {
+	parts := make([]string, 0, len(filter.Include)+len(filter.Exclude))
+	for _, keyword := range filter.Include {
+		parts = append(parts, "+"+keyword)
+	}
+	for _, keyword := range filter.Exclude {
+		parts = append(parts, "-"+keyword)
+	}
+	return strings.Join(parts, " ")
+}
+
 // InvalidAlarmUsage: 알림 명령어의 잘못된 사용법에 대한 안내 메시지를 반환합니다.
 func (f *ResponseFormatter) InvalidAlarmUsage() string {
 	return ErrInvalidAlarmUsage
@@ -240,9 +289,11 @@ func (f *ResponseFormatter) AlarmNotification(notification *domain.AlarmNotifica
 	data := alarmNotificationTemplateData{
 		Emoji:           DefaultEmoji,
 		ChannelName:     channelName,
+		Platform:        streamPlatformLabel(notification.Stream),
 		MinutesUntil:    notification.MinutesUntil,
 		Title:           util.TruncateString(notification.Stream.Title, constants.StringLimits.StreamTitle),
-		URL:             notification.Stream.GetYouTubeURL(),
+		TitleKo:         f.titleGloss(notification.Stream),
+		URL:             notification.Stream.GetWatchURL(),
 		ScheduleMessage: notification.ScheduleChangeMessage,
 	}
 
@@ -266,7 +317,9 @@ func (f *ResponseFormatter) AlarmNotificationGroup(minutesUntil int, notificatio
 
 	type entry struct {
 		ChannelName string
+		Platform    string
 		Title       string
+		TitleKo     string
 		URL         string
 	}
 
@@ -278,8 +331,10 @@ func (f *ResponseFormatter) AlarmNotificationGroup(minutesUntil int, notificatio
 
 		entries = append(entries, entry{
 			ChannelName: alarmChannelName(notification),
+			Platform:    streamPlatformLabel(notification.Stream),
 			Title:       util.TruncateString(util.TrimSpace(notification.Stream.Title), constants.StringLimits.StreamTitle),
-			URL:         util.TrimSpace(notification.Stream.GetYouTubeURL()),
+			TitleKo:     f.titleGloss(notification.Stream),
+			URL:         util.TrimSpace(notification.Stream.GetWatchURL()),
 		})
 	}
 
@@ -316,12 +371,20 @@ func (f *ResponseFormatter) AlarmNotificationGroup(minutesUntil int, notificatio
 			name = "알 수 없는 채널"
 		}
 
+		if entry.Platform != "" {
+			name = fmt.Sprintf("%s [%s]", name, entry.Platform)
+		}
+
 		sb.WriteString(fmt.Sprintf("%d. %s\n", idx+1, name))
 
 		if entry.Title != "" {
 			sb.WriteString(fmt.Sprintf("   %s\n", entry.Title))
 		}
 
+		if entry.TitleKo != "" {
+			sb.WriteString(fmt.Sprintf("   %s %s\n", DefaultEmoji.Translate, entry.TitleKo))
+		}
+
 		if entry.URL != "" {
 			sb.WriteString(fmt.Sprintf("   %s\n", entry.URL))
 		}
@@ -339,6 +402,80 @@ func (f *ResponseFormatter) AlarmNotificationGroup(minutesUntil int, notificatio
 	return util.ApplyKakaoSeeMorePadding(content, instruction)
 }
 
+// AlarmDigest: 요약 모드 방의 알림을 시작 시각 순 한 통으로 만든다. (hourly면 매시 요약 문구)
+// AlarmNotificationGroup과 달리 시작 시각이 다른 방송도 함께 담으므로 방송마다 시작 시각을 붙인다.
+func (f *ResponseFormatter) AlarmDigest(notifications []*domain.AlarmNotification, hourly bool) string {
+	valid := make([]*domain.AlarmNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		if notification != nil && notification.Stream != nil {
+			valid = append(valid, notification)
+		}
+	}
+	if len(valid) == 0 {
+		return ""
+	}
+
+	slices.SortStableFunc(valid, func(a, b *domain.AlarmNotification) int {
+		if a.MinutesUntil != b.MinutesUntil {
+			return a.MinutesUntil - b.MinutesUntil
+		}
+		return strings.Compare(alarmChannelName(a), alarmChannelName(b))
+	})
+
+	title := "방송 알림 요약"
+	lead := "곧 시작하는 구독 방송입니다."
+	if hourly {
+		title = "이번 시간 방송 요약"
+		lead = "다음 정각까지 시작하는 구독 방송입니다."
+	}
+	instruction := DefaultEmoji.Alarm + " " + title
+
+	var sb strings.Builder
+	sb.WriteString(CountedHeader(DefaultEmoji.Alarm, title, len(valid)))
+	sb.WriteString("\n\n")
+	sb.WriteString(DefaultEmoji.Time + " " + lead + "\n\n")
+
+	for idx, notification := range valid {
+		name := util.TrimSpace(alarmChannelName(notification))
+		if name == "" {
+			name = "알 수 없는 채널"
+		}
+		if platform := streamPlatformLabel(notification.Stream); platform != "" {
+			name = fmt.Sprintf("%s [%s]", name, platform)
+		}
+
+		when := fmt.Sprintf("%d분 후", notification.MinutesUntil)
+		if notification.Stream.StartScheduled != nil {
+			when = fmt.Sprintf("%s (%s)", util.FormatKST(*notification.Stream.StartScheduled, "15:04"), when)
+		}
+		sb.WriteString(fmt.Sprintf("%d. %s - %s\n", idx+1, when, name))
+
+		if title := util.TruncateString(util.TrimSpace(notification.Stream.Title), constants.StringLimits.StreamTitle); title != "" {
+			sb.WriteString(fmt.Sprintf("   %s\n", title))
+		}
+		if titleKo := f.titleGloss(notification.Stream); titleKo != "" {
+			sb.WriteString(fmt.Sprintf("   %s %s\n", DefaultEmoji.Translate, titleKo))
+		}
+		if message := util.TrimSpace(notification.ScheduleChangeMessage); message != "" {
+			sb.WriteString(fmt.Sprintf("   %s\n", message))
+		}
+		if url := util.TrimSpace(notification.Stream.GetWatchURL()); url != "" {
+			sb.WriteString(fmt.Sprintf("   %s\n", url))
+		}
+
+		if idx < len(valid)-1 {
+			sb.WriteString("\n")
+		}
+	}
+
+	content := util.TrimSpace(sb.String())
+	if content == "" {
+		return ""
+	}
+
+	return util.ApplyKakaoSeeMorePadding(content, instruction)
+}
+
 // milestoneAchievedTemplateData: 마일스톤 달성 알림 템플릿 데이터
 type milestoneAchievedTemplateData struct {
 	MemberName string
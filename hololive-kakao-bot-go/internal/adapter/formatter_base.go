@@ -9,7 +9,8 @@ import (
 
 // ResponseFormatter: 봇의 응답 메시지를 생성하는 포맷터 (카카오톡 UI 템플릿 적용)
 type ResponseFormatter struct {
-	prefix string
+	prefix       string
+	titleGlosses map[string]string // 방송 ID → 한국어 제목 (WithTitleGlosses로만 설정)
 }
 
 func splitTemplateInstruction(rendered string) (instruction string, body string) {
@@ -47,6 +48,17 @@ func (f *ResponseFormatter) Prefix() string {
 	return "!"
 }
 
+// WithTitleGlosses: 방송 ID → 한국어 제목 맵을 적용한 포맷터 사본을 반환합니다.
+// 포맷터는 여러 방이 공유하므로 원본은 변경하지 않는다.
+func (f *ResponseFormatter) WithTitleGlosses(glosses map[string]string) *ResponseFormatter {
+	if f == nil || len(glosses) == 0 {
+		return f
+	}
+	clone := *f
+	clone.titleGlosses = glosses
+	return &clone
+}
+
 // FormatError: 에러 메시지를 사용자 친화적인 포맷으로 변환합니다.
 func (f *ResponseFormatter) FormatError(message string) string {
 	return ErrorMessage(message)
@@ -0,0 +1,50 @@
+package adapter
+
+import (
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+type clipView struct {
+	ChannelName string
+	Title       string
+	PublishedAt string
+	URL         string
+}
+
+type clipListTemplateData struct {
+	Emoji   UIEmoji
+	Subject string
+	Count   int
+	Clips   []clipView
+}
+
+// ClipList: 인기 클립 채널의 최근 클립 목록을 포맷팅합니다. subject는 헤더에 표시할 대상(멤버 이름 또는 "구독 멤버")
+func (f *ResponseFormatter) ClipList(subject string, clips []*domain.Clip) string {
+	data := clipListTemplateData{Emoji: DefaultEmoji, Subject: subject, Count: len(clips)}
+	if len(clips) > 0 {
+		data.Clips = make([]clipView, len(clips))
+		for i, clip := range clips {
+			data.Clips[i] = clipView{
+				ChannelName: clip.ClipChannelName,
+				Title:       f.truncateTitle(clip.Title),
+				PublishedAt: util.FormatKST(clip.PublishedAt, "01/02 15:04"),
+				URL:         clip.Link,
+			}
+		}
+	}
+
+	rendered, err := executeFormatterTemplate("clip_list.tmpl", data)
+	if err != nil {
+		return ErrorMessage(ErrDisplayClipListFailed)
+	}
+
+	if data.Count == 0 {
+		return rendered
+	}
+	instruction, body := splitTemplateInstruction(rendered)
+	if instruction == "" || body == "" {
+		return rendered
+	}
+	return util.ApplyKakaoSeeMorePadding(body, instruction)
+}
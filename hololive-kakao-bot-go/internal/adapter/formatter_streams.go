@@ -10,7 +10,9 @@ import (
 
 type liveStreamView struct {
 	ChannelName string
+	Platform    string
 	Title       string
+	TitleKo     string
 	URL         string
 }
 
@@ -22,7 +24,9 @@ type liveStreamsTemplateData struct {
 
 type upcomingStreamView struct {
 	ChannelName string
+	Platform    string
 	Title       string
+	TitleKo     string
 	TimeInfo    string
 	URL         string
 }
@@ -36,7 +40,9 @@ type upcomingStreamsTemplateData struct {
 
 type scheduleEntryView struct {
 	IsLive   bool
+	Platform string
 	Title    string
+	TitleKo  string
 	TimeInfo string
 	URL      string
 }
@@ -57,8 +63,10 @@ func (f *ResponseFormatter) FormatLiveStreams(streams []*domain.Stream) string {
 		for i, stream := range streams {
 			data.Streams[i] = liveStreamView{
 				ChannelName: stream.ChannelName,
+				Platform:    streamPlatformLabel(stream),
 				Title:       f.truncateTitle(stream.Title),
-				URL:         stream.GetYouTubeURL(),
+				TitleKo:     f.titleGloss(stream),
+				URL:         stream.GetWatchURL(),
 			}
 		}
 	}
@@ -86,9 +94,11 @@ func (f *ResponseFormatter) UpcomingStreams(streams []*domain.Stream, hours int)
 		for i, stream := range streams {
 			data.Streams[i] = upcomingStreamView{
 				ChannelName: stream.ChannelName,
+				Platform:    streamPlatformLabel(stream),
 				Title:       f.truncateTitle(stream.Title),
+				TitleKo:     f.titleGloss(stream),
 				TimeInfo:    f.streamTimeInfo(stream),
-				URL:         stream.GetYouTubeURL(),
+				URL:         stream.GetWatchURL(),
 			}
 		}
 	}
@@ -118,8 +128,10 @@ func (f *ResponseFormatter) ChannelSchedule(channel *domain.Channel, streams []*
 		data.Streams = make([]scheduleEntryView, len(streams))
 		for i, stream := range streams {
 			entry := scheduleEntryView{
-				Title: f.truncateTitle(stream.Title),
-				URL:   stream.GetYouTubeURL(),
+				Platform: streamPlatformLabel(stream),
+				Title:    f.truncateTitle(stream.Title),
+				TitleKo:  f.titleGloss(stream),
+				URL:      stream.GetWatchURL(),
 			}
 
 			if stream.IsLive() {
@@ -151,6 +163,18 @@ func (f *ResponseFormatter) truncateTitle(title string) string {
 	return util.TruncateString(title, constants.StringLimits.StreamTitle)
 }
 
+// titleGloss: 방송 제목의 한국어 번역을 반환합니다. 번역이 없거나 원문과 같으면 빈 문자열
+func (f *ResponseFormatter) titleGloss(stream *domain.Stream) string {
+	if f == nil || stream == nil || len(f.titleGlosses) == 0 {
+		return ""
+	}
+	gloss := util.TrimSpace(f.titleGlosses[stream.ID])
+	if gloss == "" || gloss == util.TrimSpace(stream.Title) {
+		return ""
+	}
+	return f.truncateTitle(gloss)
+}
+
 func (f *ResponseFormatter) streamTimeInfo(stream *domain.Stream) string {
 	if stream == nil || stream.StartScheduled == nil {
 		return MsgTimeUnknown
@@ -175,3 +199,11 @@ func (f *ResponseFormatter) streamTimeInfo(stream *domain.Stream) string {
 		return fmt.Sprintf("%s (%d분 후)", kstTime, minutesRem)
 	}
 }
+
+// streamPlatformLabel: YouTube 외 플랫폼 방송에 붙일 표시 라벨을 반환합니다. (YouTube는 빈 문자열)
+func streamPlatformLabel(stream *domain.Stream) string {
+	if stream.IsTwitch() {
+		return "Twitch"
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package adapter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+func TestFormatterTitleGlosses(t *testing.T) {
+	base := NewResponseFormatter("!")
+	streams := []*domain.Stream{
+		{ID: "v1", Title: "【雑談】おはよう", ChannelName: "Pekora", Status: domain.StreamStatusLive},
+		{ID: "v2", Title: "Minecraft", ChannelName: "Miko", Status: domain.StreamStatusLive},
+	}
+	gloss := DefaultEmoji.Translate + " 【잡담】 좋은 아침"
+
+	if plain := base.FormatLiveStreams(streams); strings.Contains(plain, DefaultEmoji.Translate) {
+		t.Fatalf("formatter without glosses must not render translations:\n%s", plain)
+	}
+
+	glossed := base.WithTitleGlosses(map[string]string{"v1": "【잡담】 좋은 아침", "v2": "Minecraft"})
+	if base.titleGlosses != nil {
+		t.Fatalf("WithTitleGlosses must not modify the shared formatter")
+	}
+
+	live := glossed.FormatLiveStreams(streams)
+	if !strings.Contains(live, "【雑談】おはよう\n   "+gloss+"\n") {
+		t.Fatalf("expected gloss under original title:\n%s", live)
+	}
+	if strings.Count(live, DefaultEmoji.Translate) != 1 {
+		t.Fatalf("gloss identical to the original title must be skipped:\n%s", live)
+	}
+
+	alarm := glossed.AlarmNotification(domain.NewAlarmNotification("room", nil, streams[0], 5, nil, ""))
+	if !strings.Contains(alarm, gloss) {
+		t.Fatalf("expected gloss in alarm notification:\n%s", alarm)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
 	"github.com/kapu/hololive-kakao-bot-go/internal/iris"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/schedulequery"
 	"github.com/kapu/hololive-kakao-bot-go/internal/util"
 )
 
@@ -72,9 +73,18 @@ func (ma *MessageAdapter) ParseMessage(message *iris.Message) *ParsedCommand {
 	if parsed, ok := ma.tryStatsCommand(command, args, text); ok {
 		return parsed
 	}
+	if parsed, ok := ma.tryLeaveCommand(command, args, text); ok {
+		return parsed
+	}
+	if parsed, ok := ma.tryClipCommand(command, args, text); ok {
+		return parsed
+	}
 	if parsed, ok := ma.tryMemberInfoCommand(command, args, text); ok {
 		return parsed
 	}
+	if parsed, ok := ma.tryScheduleQueryCommand(commandText, text); ok {
+		return parsed
+	}
 
 	return ma.createUnknownCommand(text)
 }
@@ -160,6 +170,41 @@ func (ma *MessageAdapter) tryStatsCommand(command string, args []string, raw str
 	}, true
 }
 
+func (ma *MessageAdapter) tryLeaveCommand(command string, args []string, raw string) (*ParsedCommand, bool) {
+	if !ma.isLeaveCommand(command) {
+		return nil, false
+	}
+	// 실수로 정리되지 않도록 "확인"을 붙여야 실제로 떠난다.
+	confirmed := len(args) > 0 && util.Contains([]string{"확인", "confirm"}, util.Normalize(args[0]))
+	return &ParsedCommand{
+		Type:       domain.CommandLeave,
+		Params:     map[string]any{"confirm": confirmed},
+		RawMessage: raw,
+	}, true
+}
+
+func (ma *MessageAdapter) tryClipCommand(command string, args []string, raw string) (*ParsedCommand, bool) {
+	if !ma.isClipCommand(command) {
+		return nil, false
+	}
+
+	params := make(map[string]any)
+	if len(args) > 0 {
+		switch util.Normalize(args[0]) {
+		case "켜기", "on":
+			params["action"] = "on"
+		case "끄기", "off":
+			params["action"] = "off"
+		default:
+			if member := util.TrimSpace(strings.Join(args, " ")); member != "" {
+				params["member"] = member
+			}
+		}
+	}
+
+	return &ParsedCommand{Type: domain.CommandClip, Params: params, RawMessage: raw}, true
+}
+
 func (ma *MessageAdapter) tryMemberInfoCommand(command string, args []string, raw string) (*ParsedCommand, bool) {
 	if !ma.isMemberInfoCommand(command) {
 		return nil, false
@@ -174,6 +219,18 @@ func (ma *MessageAdapter) tryMemberInfoCommand(command string, args []string, ra
 	return &ParsedCommand{Type: domain.CommandMemberInfo, Params: params, RawMessage: raw}, true
 }
 
+// tryScheduleQueryCommand: 정해진 명령어가 아니지만 일정을 묻는 문장이면 자연어 일정 질문으로 넘깁니다.
+func (ma *MessageAdapter) tryScheduleQueryCommand(commandText string, raw string) (*ParsedCommand, bool) {
+	if len(strings.Fields(commandText)) < 2 || !schedulequery.LooksLikeScheduleQuestion(commandText) {
+		return nil, false
+	}
+	return &ParsedCommand{
+		Type:       domain.CommandScheduleQuery,
+		Params:     map[string]any{"query": commandText},
+		RawMessage: raw,
+	}, true
+}
+
 func (ma *MessageAdapter) isLiveCommand(cmd string) bool {
 	return util.Contains([]string{"라이브", "live", "방송중", "생방송"}, cmd)
 }
@@ -186,6 +243,14 @@ func (ma *MessageAdapter) isScheduleCommand(cmd string) bool {
 	return util.Contains([]string{"일정", "스케줄", "schedule", "멤버", "member"}, cmd)
 }
 
+func (ma *MessageAdapter) isLeaveCommand(cmd string) bool {
+	return util.Contains([]string{"떠나기", "나가기", "leave"}, cmd)
+}
+
+func (ma *MessageAdapter) isClipCommand(cmd string) bool {
+	return util.Contains([]string{"클립", "키리누키", "clip"}, cmd)
+}
+
 func (ma *MessageAdapter) isAlarmCommand(cmd string, args []string) bool {
 	if util.Contains([]string{"알람", "알림", "알림설정", "알람설정", "alarm"}, cmd) {
 		return true
@@ -335,6 +400,22 @@ func (ma *MessageAdapter) parseAlarmCommand(_ string, args []string, rawMessage
 		}
 	}
 
+	if util.Contains([]string{"필터", "filter"}, subCmd) {
+		return &ParsedCommand{
+			Type:       domain.CommandAlarmFilter,
+			Params:     parseAlarmFilterArgs(restArgs),
+			RawMessage: rawMessage,
+		}
+	}
+
+	if util.Contains([]string{"요약", "digest"}, subCmd) {
+		return &ParsedCommand{
+			Type:       domain.CommandAlarmDigest,
+			Params:     parseAlarmDigestArgs(restArgs),
+			RawMessage: rawMessage,
+		}
+	}
+
 	if util.Contains([]string{"초기화", "clear", "reset"}, subCmd) {
 		return &ParsedCommand{
 			Type:       domain.CommandAlarmClear,
@@ -374,6 +455,60 @@ func normalizePeriodToken(raw string) string {
 	return domain.NormalizeStatsPeriodToken(raw)
 }
 
+// parseAlarmFilterArgs: "[멤버명] +포함 -제외 ..." 또는 "[멤버명] 해제"를 파싱합니다.
+// +/- 접두사가 없는 토큰은 멤버 이름으로 본다.
+func parseAlarmFilterArgs(args []string) map[string]any {
+	params := map[string]any{"action": "filter"}
+	var member, include, exclude []string
+	reset := false
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "+"):
+			if keyword := strings.TrimPrefix(arg, "+"); keyword != "" {
+				include = append(include, keyword)
+			}
+		case strings.HasPrefix(arg, "-"):
+			if keyword := strings.TrimPrefix(arg, "-"); keyword != "" {
+				exclude = append(exclude, keyword)
+			}
+		case util.Contains([]string{"해제", "초기화", "clear", "reset"}, util.Normalize(arg)):
+			reset = true
+		default:
+			member = append(member, arg)
+		}
+	}
+	params["member"] = strings.Join(member, " ")
+	if len(include) > 0 {
+		params["include"] = include
+	}
+	if len(exclude) > 0 {
+		params["exclude"] = exclude
+	}
+	if reset {
+		params["reset"] = true
+	}
+	return params
+}
+
+// parseAlarmDigestArgs: "켜기|매시간|끄기"를 요약 모드로 변환합니다. 인자가 없으면 현재 설정 조회
+func parseAlarmDigestArgs(args []string) map[string]any {
+	params := map[string]any{"action": "digest"}
+	if len(args) == 0 {
+		return params
+	}
+	switch util.Normalize(args[0]) {
+	case "켜기", "on", "체크", "cycle":
+		params["mode"] = "cycle"
+	case "매시간", "매시", "시간", "hourly":
+		params["mode"] = "hourly"
+	case "끄기", "off", "해제":
+		params["mode"] = "off"
+	default:
+		params["mode"] = util.Normalize(args[0])
+	}
+	return params
+}
+
 // 알람 명령 정규화
 func normalizeCompactAlarmTokens(command string, args []string) (string, []string, bool) {
 	mapping := map[string]string{
@@ -395,6 +530,10 @@ func normalizeCompactAlarmTokens(command string, args []string) (string, []strin
 		"알림리셋":  "초기화",
 		"알람해제":  "제거",
 		"알림해제":  "제거",
+		"알람필터":  "필터",
+		"알림필터":  "필터",
+		"알람요약":  "요약",
+		"알림요약":  "요약",
 	}
 
 	subCmd, ok := mapping[command]
@@ -1,7 +1,9 @@
 package adapter
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
 	"github.com/kapu/hololive-kakao-bot-go/internal/iris"
@@ -57,3 +59,151 @@ func TestParseMessage_InvalidAlarmCommand(t *testing.T) {
 		t.Fatalf("expected action invalid, got %v", result.Params["action"])
 	}
 }
+
+func TestParseMessage_LeaveRequiresConfirm(t *testing.T) {
+	adapter := NewMessageAdapter("!")
+
+	result := adapter.ParseMessage(&iris.Message{Msg: "!떠나기"})
+	if result.Type != domain.CommandLeave {
+		t.Fatalf("expected CommandLeave, got %s", result.Type)
+	}
+	if confirmed, _ := result.Params["confirm"].(bool); confirmed {
+		t.Fatalf("expected unconfirmed leave without argument")
+	}
+
+	result = adapter.ParseMessage(&iris.Message{Msg: "!떠나기 확인"})
+	if confirmed, _ := result.Params["confirm"].(bool); result.Type != domain.CommandLeave || !confirmed {
+		t.Fatalf("expected confirmed leave, got %s %v", result.Type, result.Params)
+	}
+}
+
+func TestParseMessage_ClipToggleAndMember(t *testing.T) {
+	adapter := NewMessageAdapter("!")
+
+	result := adapter.ParseMessage(&iris.Message{Msg: "!클립 켜기"})
+	if result.Type != domain.CommandClip || result.Params["action"] != "on" {
+		t.Fatalf("expected clip on, got %s %v", result.Type, result.Params)
+	}
+
+	result = adapter.ParseMessage(&iris.Message{Msg: "!clip off"})
+	if result.Type != domain.CommandClip || result.Params["action"] != "off" {
+		t.Fatalf("expected clip off, got %s %v", result.Type, result.Params)
+	}
+
+	result = adapter.ParseMessage(&iris.Message{Msg: "!클립 페코라"})
+	if result.Type != domain.CommandClip || result.Params["member"] != "페코라" || result.Params["action"] != nil {
+		t.Fatalf("expected clip member query, got %s %v", result.Type, result.Params)
+	}
+
+	result = adapter.ParseMessage(&iris.Message{Msg: "!클립"})
+	if result.Type != domain.CommandClip || len(result.Params) != 0 {
+		t.Fatalf("expected bare clip query, got %s %v", result.Type, result.Params)
+	}
+}
+
+func TestParseMessage_ScheduleQuestion(t *testing.T) {
+	adapter := NewMessageAdapter("!")
+
+	result := adapter.ParseMessage(&iris.Message{Msg: "!페코라 이번주 언제 방송해?"})
+	if result.Type != domain.CommandScheduleQuery {
+		t.Fatalf("expected CommandScheduleQuery, got %s", result.Type)
+	}
+	if query, _ := result.Params["query"].(string); query != "페코라 이번주 언제 방송해?" {
+		t.Fatalf("unexpected query param: %q", query)
+	}
+
+	// 정해진 명령어는 그대로, 일정과 무관한 문장은 알 수 없는 명령어로 남음
+	if result := adapter.ParseMessage(&iris.Message{Msg: "!일정 페코라"}); result.Type != domain.CommandSchedule {
+		t.Fatalf("expected CommandSchedule, got %s", result.Type)
+	}
+	if result := adapter.ParseMessage(&iris.Message{Msg: "!페코라 귀여워"}); result.Type != domain.CommandUnknown {
+		t.Fatalf("expected CommandUnknown, got %s", result.Type)
+	}
+}
+
+func TestParseMessage_AlarmFilter(t *testing.T) {
+	adapter := NewMessageAdapter("!")
+
+	result := adapter.ParseMessage(&iris.Message{Msg: "!알림필터 호쇼 마린 +歌枠 -ASMR +karaoke"})
+	if result.Type != domain.CommandAlarmFilter {
+		t.Fatalf("expected CommandAlarmFilter, got %s", result.Type)
+	}
+	if member, _ := result.Params["member"].(string); member != "호쇼 마린" {
+		t.Fatalf("unexpected member %q", member)
+	}
+	include, _ := result.Params["include"].([]string)
+	exclude, _ := result.Params["exclude"].([]string)
+	if len(include) != 2 || include[0] != "歌枠" || len(exclude) != 1 || exclude[0] != "ASMR" {
+		t.Fatalf("unexpected keywords: include=%v exclude=%v", include, exclude)
+	}
+
+	result = adapter.ParseMessage(&iris.Message{Msg: "!알람 필터 페코라 해제"})
+	if result.Type != domain.CommandAlarmFilter || result.Params["reset"] != true || result.Params["member"] != "페코라" {
+		t.Fatalf("expected reset filter command, got %+v", result)
+	}
+}
+
+func TestFormatAlarmFilter(t *testing.T) {
+	formatter := NewResponseFormatter("!")
+
+	got := formatter.FormatAlarmFilter("마린", domain.AlarmKeywordFilter{Include: []string{"歌枠", "karaoke"}, Exclude: []string{"ASMR"}}, true)
+	for _, want := range []string{"마린 알람 키워드 필터를 설정했습니다", "포함: 歌枠, karaoke", "제외: ASMR"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in:\n%s", want, got)
+		}
+	}
+
+	if got := formatter.FormatAlarmFilter("마린", domain.AlarmKeywordFilter{}, false); !strings.Contains(got, "키워드 필터가 없습니다") {
+		t.Fatalf("unexpected empty filter message:\n%s", got)
+	}
+
+	list := formatter.FormatAlarmList([]AlarmListEntry{{MemberName: "마린", Filter: domain.AlarmKeywordFilter{Include: []string{"歌枠"}, Exclude: []string{"ASMR"}}}})
+	if !strings.Contains(list, "필터: +歌枠 -ASMR") {
+		t.Fatalf("expected filter summary in alarm list:\n%s", list)
+	}
+}
+
+func TestParseMessage_AlarmDigest(t *testing.T) {
+	adapter := NewMessageAdapter("!")
+
+	cases := map[string]any{
+		"!알림요약":       nil,
+		"!알림요약 켜기":    "cycle",
+		"!알람 요약 매시간":  "hourly",
+		"!알람요약 끄기":    "off",
+		"!알림요약 daily": "daily",
+	}
+	for msg, want := range cases {
+		result := adapter.ParseMessage(&iris.Message{Msg: msg})
+		if result.Type != domain.CommandAlarmDigest {
+			t.Fatalf("%s: expected CommandAlarmDigest, got %s", msg, result.Type)
+		}
+		if got := result.Params["mode"]; got != want {
+			t.Fatalf("%s: expected mode %v, got %v", msg, want, got)
+		}
+	}
+}
+
+func TestAlarmDigest(t *testing.T) {
+	formatter := NewResponseFormatter("!")
+	soon := time.Date(2026, 10, 18, 1, 5, 0, 0, time.UTC)
+	later := soon.Add(25 * time.Minute)
+
+	notifications := []*domain.AlarmNotification{
+		{MinutesUntil: 30, Channel: &domain.Channel{Name: "Marine"}, Stream: &domain.Stream{ID: "v2", Title: "歌枠", StartScheduled: &later}},
+		{MinutesUntil: 5, Channel: &domain.Channel{Name: "Pekora"}, Stream: &domain.Stream{ID: "v1", Title: "雑談", StartScheduled: &soon}},
+		nil,
+	}
+
+	got := formatter.AlarmDigest(notifications, false)
+	first, second := strings.Index(got, "1. 10:05 (5분 후) - Pekora"), strings.Index(got, "2. 10:30 (30분 후) - Marine")
+	if first < 0 || second < first || !strings.Contains(got, "방송 알림 요약") {
+		t.Fatalf("unexpected digest:\n%s", got)
+	}
+	if hourly := formatter.AlarmDigest(notifications, true); !strings.Contains(hourly, "이번 시간 방송 요약") {
+		t.Fatalf("unexpected hourly digest:\n%s", hourly)
+	}
+	if formatter.AlarmDigest(nil, false) != "" {
+		t.Fatal("expected empty digest for no notifications")
+	}
+}
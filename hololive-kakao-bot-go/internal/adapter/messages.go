@@ -22,6 +22,7 @@ type UIEmoji struct {
 	Data      string
 	Stats     string
 	Video     string
+	Translate string
 }
 
 // DefaultEmoji: 모든 사용자 메시지에 사용되는 이모지 단일 정의다.
@@ -44,6 +45,7 @@ var DefaultEmoji = UIEmoji{
 	Data:      "📋",
 	Stats:     "📊",
 	Video:     "🎬",
+	Translate: "🇰🇷",
 }
 
 // MessageBuilder: 공통 메시지 패턴을 생성합니다.
@@ -182,14 +184,41 @@ const (
 	ErrAlarmClearFailed           = "알람 초기화 중 오류가 발생했습니다."
 	ErrAlarmNeedMemberNameAdd     = "멤버 이름을 입력해주세요.\n예) !알람 추가 페코라"
 	ErrAlarmNeedMemberNameRemove  = "멤버 이름을 입력해주세요.\n예) !알람 제거 페코라"
+	ErrAlarmNeedMemberNameFilter  = "멤버 이름을 입력해주세요.\n예) !알림필터 페코라 +歌枠 -ASMR"
+	ErrAlarmFilterNotSubscribed   = "%s 알람이 설정되어 있지 않습니다.\n먼저 !알람 추가 %s 로 알람을 추가해주세요."
+	ErrAlarmFilterInvalid         = "키워드 필터를 저장할 수 없습니다.\n키워드는 최대 10개, 각 30자까지이며 같은 키워드를 포함(+)과 제외(-)에 함께 쓸 수 없습니다."
+	ErrAlarmFilterFailed          = "알람 키워드 필터 설정 중 오류가 발생했습니다."
+	ErrAlarmDigestInvalid         = "알 수 없는 요약 방식입니다.\n!알림요약 켜기 / 매시간 / 끄기 중에서 골라주세요."
+	ErrAlarmDigestFailed          = "알림 요약 설정 중 오류가 발생했습니다."
+	MsgAlarmDigestOff             = "🔔 이 방은 방송마다 알림을 받습니다.\n!알림요약 켜기 - 알람 체크마다 한 통으로 묶기\n!알림요약 매시간 - 매시 정각 무렵 한 통으로 받기"
+	MsgAlarmDigestCycle           = "✅ 이 방의 알림을 알람 체크마다 한 통으로 묶어 보냅니다.\n!알림요약 끄기 로 되돌릴 수 있습니다."
+	MsgAlarmDigestHourly          = "✅ 이 방은 매시 정각 무렵 다음 정각까지 시작하는 구독 방송을 한 통으로 받습니다. (방송별 알림은 보내지 않습니다)\n!알림요약 끄기 로 되돌릴 수 있습니다."
+
+	// 떠나기 관련
+	ErrRoomLeaveUnavailable = "방 정리 기능이 비활성화되어 있습니다."
+	ErrRoomLeaveFailed      = "방 정리 중 오류가 발생했습니다. 관리자에게 문의해주세요."
+	MsgRoomLeaveConfirm     = "⚠️ 이 방의 모든 알람과 이용 기록이 정리되고, 봇이 더 이상 이 방의 명령에 응답하지 않습니다.\n계속하려면 !떠나기 확인 을 입력해주세요."
+	MsgRoomLeaveDone        = "👋 알람 %d개를 정리했습니다. 그동안 이용해주셔서 감사합니다."
+
+	// 클립 관련
+	ErrClipUnavailable       = "클립 기능이 비활성화되어 있습니다."
+	ErrClipQueryFailed       = "클립 조회 중 오류가 발생했습니다."
+	ErrClipRoomNotEnabled    = "이 방은 클립 목록이 꺼져 있습니다.\n!클립 켜기 로 사용할 수 있습니다."
+	ErrClipNoSubscriptions   = "알람을 등록한 멤버가 없습니다.\n!클립 [멤버명] 으로 조회하거나 알람을 먼저 추가해주세요."
+	MsgClipEnabled           = "✅ 이 방에서 클립 목록을 켰습니다.\n!클립 으로 구독 멤버의 최근 클립을 볼 수 있습니다."
+	MsgClipAlreadyEnabled    = "이미 클립 목록이 켜져 있습니다."
+	MsgClipDisabled          = "✅ 이 방에서 클립 목록을 껐습니다."
+	MsgClipAlreadyDisabled   = "클립 목록이 이미 꺼져 있습니다."
+	MsgClipSubjectSubscribed = "구독 멤버"
 
 	// Live/Upcoming/Schedule 관련
-	ErrLiveStreamQueryFailed     = "라이브 스트림 조회 실패"
-	ErrUpcomingStreamQueryFailed = "예정 방송 조회 실패"
-	ErrScheduleQueryFailed       = "일정 조회 실패"
-	MsgMemberNotLive             = "%s은(는) 현재 방송 중이 아닙니다."
-	MsgMemberNoUpcoming          = "%s은(는) %d시간 이내 예정된 방송이 없습니다."
-	ErrScheduleNeedMemberName    = "❌ 멤버 이름을 지정해주세요.\n예) !일정 페코라"
+	ErrLiveStreamQueryFailed      = "라이브 스트림 조회 실패"
+	ErrUpcomingStreamQueryFailed  = "예정 방송 조회 실패"
+	ErrScheduleQueryFailed        = "일정 조회 실패"
+	MsgMemberNotLive              = "%s은(는) 현재 방송 중이 아닙니다."
+	MsgMemberNoUpcoming           = "%s은(는) %d시간 이내 예정된 방송이 없습니다."
+	ErrScheduleNeedMemberName     = "❌ 멤버 이름을 지정해주세요.\n예) !일정 페코라"
+	ErrScheduleQueryNotUnderstood = "❓ 일정 질문을 이해하지 못했습니다.\n예) !페코라 이번주 언제 방송해?"
 
 	// Stats 관련
 	ErrUnknownStatsPeriod = "알 수 없는 통계 유형입니다. !도움말을 참고해주세요."
@@ -213,11 +242,13 @@ const (
 	ErrDisplayLiveStreamsFailed = "방송 목록을 표시할 수 없습니다."
 	ErrDisplayUpcomingFailed    = "예정 방송 목록을 표시할 수 없습니다."
 	ErrDisplayScheduleFailed    = "일정을 표시할 수 없습니다."
+	ErrDisplayClipListFailed    = "클립 목록을 표시할 수 없습니다."
 	ErrDisplayAlarmAddFailed    = "알람 설정 결과를 표시할 수 없습니다."
 	ErrDisplayAlarmRemoveFailed = "알람 제거 결과를 표시할 수 없습니다."
 	ErrDisplayAlarmListFailed   = "알람 목록을 표시할 수 없습니다."
 	ErrDisplayAlarmClearFailed  = "알람 초기화 결과를 표시할 수 없습니다."
 	ErrDisplayAlarmNotifyFailed = "알람 알림을 표시할 수 없습니다."
+	ErrDisplayAlarmFilterFailed = "알람 키워드 필터를 표시할 수 없습니다."
 	ErrDisplayMemberListFailed  = "멤버 목록을 표시할 수 없습니다."
 	ErrDisplayHelpFailed        = "도움말을 표시할 수 없습니다."
 	ErrDisplayProfileDataFailed = "프로필 데이터를 찾을 수 없습니다."
@@ -44,8 +44,9 @@ func ProvideAPIRouter(
 	logger *slog.Logger,
 	apiHandler *server.APIHandler,
 	authHandler *server.AuthHandler,
+	schemaChecker *health.SchemaChecker,
 ) (*gin.Engine, error) {
-	router, err := newAPIRouter(ctx, cfg, logger)
+	router, err := newAPIRouter(ctx, cfg, logger, schemaChecker)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +66,7 @@ func ProvideAPIRouter(
 	return router, nil
 }
 
-func newAPIRouter(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*gin.Engine, error) {
+func newAPIRouter(ctx context.Context, cfg *config.Config, logger *slog.Logger, schemaChecker *health.SchemaChecker) (*gin.Engine, error) {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 	if err := router.SetTrustedProxies(constants.ServerConfig.TrustedProxies); err != nil {
@@ -86,13 +87,14 @@ func newAPIRouter(ctx context.Context, cfg *config.Config, logger *slog.Logger)
 	router.Use(gin.Recovery())
 	router.Use(server.LoggerMiddleware(ctx, logger,
 		"/health",
-		"/metrics", // Prometheus 메트릭 폴링 (15초 간격)
+		"/health/detail", // Admin Dashboard 상태 수집
+		"/metrics",       // Prometheus 메트릭 폴링 (15초 간격)
 	))
 	router.Use(cors.New(newAPICORSConfig()))
 	router.Use(server.SecurityHeadersMiddleware())
 	router.Use(server.ClientHintsMiddleware()) // Client Hints 요청 (실제 기기 정보 수집)
 
-	registerAPIHealthRoutes(router)
+	registerAPIHealthRoutes(router, schemaChecker)
 
 	// NoRoute 핸들러: 미등록 경로 접근 시 API Key 검증 후 401/404 반환
 	// 크롤러/스캐너가 루트 경로 등에 접근할 때 404 대신 401 Unauthorized 반환
@@ -110,12 +112,19 @@ func newAPICORSConfig() cors.Config {
 	return corsConfig
 }
 
-func registerAPIHealthRoutes(router *gin.Engine) {
+func registerAPIHealthRoutes(router *gin.Engine, schemaChecker *health.SchemaChecker) {
 	// Health check 엔드포인트 (버전/uptime 포함)
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, health.Get())
 	})
 
+	// 상세 Health check: 필수 테이블/컬럼/인덱스 누락 여부 포함
+	router.GET("/health/detail", func(c *gin.Context) {
+		c.JSON(200, health.Detailed(map[string]health.Component{
+			"schema": schemaChecker.Check(c.Request.Context()),
+		}))
+	})
+
 	// Prometheus 메트릭 (장기 히스토리 분석용)
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 }
@@ -156,16 +165,36 @@ func registerAPIRoutes(
 
 	holoAPI.GET("/alarms", apiHandler.GetAlarms)
 	holoAPI.DELETE("/alarms", apiHandler.DeleteAlarm)
+	holoAPI.GET("/alarms/dormant", apiHandler.GetDormantAlarmRooms)
+	holoAPI.DELETE("/alarms/dormant", apiHandler.ReactivateAlarmRoom)
 
 	holoAPI.GET("/rooms", apiHandler.GetRooms)
 	holoAPI.POST("/rooms", apiHandler.AddRoom)
 	holoAPI.DELETE("/rooms", apiHandler.RemoveRoom)
 	holoAPI.POST("/rooms/acl", apiHandler.SetACL)
+	holoAPI.POST("/rooms/leave", apiHandler.LeaveRoom)
+	holoAPI.GET("/rooms/departures", apiHandler.GetRoomDepartures)
+
+	// 알람 등록 방 대상 관리자 공지
+	holoAPI.POST("/broadcasts", apiHandler.SendBroadcast)
+	holoAPI.GET("/broadcasts", apiHandler.GetBroadcasts)
+
+	// 명령어 응답 지연 SLA 리포트
+	holoAPI.GET("/latency", apiHandler.GetCommandLatency)
 
-	holoAPI.GET("/stats", apiHandler.GetStats)
-	holoAPI.GET("/stats/channels", apiHandler.GetChannelStats)
-	holoAPI.GET("/streams/live", apiHandler.GetLiveStreams)
-	holoAPI.GET("/streams/upcoming", apiHandler.GetUpcomingStreams)
+	// 방송 제목 번역 방 설정
+	holoAPI.GET("/translation/rooms", apiHandler.GetTranslationRooms)
+	holoAPI.POST("/translation/rooms", apiHandler.EnableTranslationRoom)
+	holoAPI.DELETE("/translation/rooms", apiHandler.DisableTranslationRoom)
+
+	// 조회 빈도가 높은 일정/통계 API: ETag/Last-Modified 조건부 요청 지원 (API Key 응답이므로 공유 캐시는 금지)
+	validators := server.NewValidatorStore()
+	revalidate := server.ConditionalGETMiddleware(server.CachePolicy{}, validators)
+
+	holoAPI.GET("/stats", revalidate, apiHandler.GetStats)
+	holoAPI.GET("/stats/channels", revalidate, apiHandler.GetChannelStats)
+	holoAPI.GET("/streams/live", revalidate, apiHandler.GetLiveStreams)
+	holoAPI.GET("/streams/upcoming", revalidate, apiHandler.GetUpcomingStreams)
 
 	// 채널 정보 API (Holodex 기반 - 프로필 이미지 포함)
 	holoAPI.GET("/channels", apiHandler.GetChannel)
@@ -174,15 +203,50 @@ func registerAPIRoutes(
 	holoAPI.GET("/logs", apiHandler.GetLogs)
 	holoAPI.GET("/settings", apiHandler.GetSettings)
 	holoAPI.POST("/settings", apiHandler.UpdateSettings)
+	holoAPI.GET("/runtime-config", apiHandler.GetRuntimeConfig)
+	holoAPI.PUT("/runtime-config", apiHandler.UpdateRuntimeConfig)
 	holoAPI.POST("/names/room", apiHandler.SetRoomName)
 	holoAPI.POST("/names/user", apiHandler.SetUserName)
 
 	// 마일스톤 API
 	holoAPI.GET("/milestones", apiHandler.GetMilestones)
 	holoAPI.GET("/milestones/near", apiHandler.GetNearMilestoneMembers)
-	holoAPI.GET("/milestones/stats", apiHandler.GetMilestoneStats)
+	holoAPI.GET("/milestones/stats", revalidate, apiHandler.GetMilestoneStats)
 
 	// 프로필 API (Tauri 앱 전용)
 	holoAPI.GET("/profiles", apiHandler.GetProfile)
 	holoAPI.GET("/profiles/name", apiHandler.GetProfileByName)
+
+	// 탤런트 프로필 관리 (DB 저장본, 번역 필드 수정)
+	holoAPI.GET("/profiles/talents", apiHandler.ListTalentProfiles)
+	holoAPI.GET("/profiles/talents/:slug", apiHandler.GetTalentProfile)
+	holoAPI.PATCH("/profiles/talents/:slug/translation", apiHandler.UpdateTalentTranslation)
+	holoAPI.DELETE("/profiles/talents/:slug/translation", apiHandler.DeleteTalentTranslation)
+
+	registerPublicRoutes(router, apiHandler, validators)
+}
+
+// registerPublicRoutes: 인증 없이 공개하는 읽기 전용 일정/통계 API를 등록합니다.
+// Cloudflare가 s-maxage 동안 응답을 보관하고 만료 후에는 ETag로 재검증하므로, 대부분의 조회가 봇까지 오지 않는다.
+func registerPublicRoutes(router *gin.Engine, apiHandler *server.APIHandler, validators *server.ValidatorStore) {
+	publicAPI := router.Group("/api/public")
+
+	publicAPI.GET("/streams/live",
+		server.ConditionalGETMiddleware(edgeCachePolicy(constants.EdgeCache.LiveStreams), validators),
+		apiHandler.GetLiveStreams)
+	publicAPI.GET("/streams/upcoming",
+		server.ConditionalGETMiddleware(edgeCachePolicy(constants.EdgeCache.UpcomingStreams), validators),
+		apiHandler.GetUpcomingStreams)
+	publicAPI.GET("/stats/channels",
+		server.ConditionalGETMiddleware(edgeCachePolicy(constants.EdgeCache.ChannelStats), validators),
+		apiHandler.GetChannelStats)
+}
+
+func edgeCachePolicy(ttl constants.EdgeCacheTTL) server.CachePolicy {
+	return server.CachePolicy{
+		Public:               true,
+		MaxAge:               ttl.MaxAge,
+		SMaxAge:              ttl.SMaxAge,
+		StaleWhileRevalidate: ttl.StaleWhileRevalidate,
+	}
 }
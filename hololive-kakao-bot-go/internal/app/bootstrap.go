@@ -6,10 +6,12 @@ import (
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/bot"
 	"github.com/kapu/hololive-kakao-bot-go/internal/config"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/alarm"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/database"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
 )
 
 // coreInfrastructure 는 공통 인프라 의존성을 담는다.
@@ -88,8 +90,12 @@ func initCoreInfrastructure(ctx context.Context, cfg *config.Config, logger *slo
 		infra.cleanupCache()
 		return nil, err
 	}
+	holodexService.SetQuotaTracker(ProvideHolodexQuotaTracker(cfg, cacheService, logger))
+	if twitchService := ProvideTwitchService(cfg, cacheService, logger); twitchService != nil {
+		holodexService.SetExternalSource(twitchService)
+	}
 
-	profileService, err := ProvideProfileService(ctx, cacheService, memberServiceAdapter, logger)
+	profileService, err := ProvideProfileService(ctx, cacheService, postgresService, memberServiceAdapter, logger)
 	if err != nil {
 		infra.cleanupDB()
 		infra.cleanupCache()
@@ -99,15 +105,17 @@ func initCoreInfrastructure(ctx context.Context, cfg *config.Config, logger *slo
 	alarmRepository := ProvideAlarmRepository(postgresService, logger)
 	alarmService := ProvideAlarmService(cfg, cacheService, holodexService, alarmRepository, logger)
 
-	// 앱 시작 시 알람 캐시 워밍 (DB에서 Valkey로 일괄 로드)
-	if warnErr := alarmService.WarmCacheFromDB(ctx); warnErr != nil {
-		logger.Warn("Failed to warm alarm cache from DB", "error", warnErr)
+	// 앱 시작 시 알람 대조 (DB → Valkey 복원, Valkey에만 남은 알람은 DB에 저장)
+	if _, warnErr := alarmService.ReconcileWithDB(ctx); warnErr != nil {
+		logger.Warn("Failed to reconcile alarms with DB", "error", warnErr)
 	}
 
 	memberDataProvider := ProvideMembersData(memberServiceAdapter)
 	memberMatcher := ProvideMemberMatcher(ctx, memberDataProvider, cacheService, holodexService, logger)
 	youTubeStatsRepository := ProvideYouTubeStatsRepository(postgresService, logger)
 	youTubeStack := ProvideYouTubeStack(ctx, cfg, cacheService, holodexService, memberServiceAdapter, youTubeStatsRepository, alarmService, irisClient, logger)
+	titleTranslator := ProvideTitleTranslator(cfg, cacheService, logger)
+	scheduleQuery := ProvideScheduleQuery(cfg, logger)
 	activityLogger := ProvideActivityLogger(cfg, logger)
 	settingsService := ProvideSettingsService(logger)
 
@@ -118,7 +126,23 @@ func initCoreInfrastructure(ctx context.Context, cfg *config.Config, logger *slo
 		return nil, err
 	}
 
-	deps := ProvideBotDependencies(cfg, logger, irisClient, messageStack, cacheService, postgresService, infra.memberRepo, infra.memberCache, holodexService, profileService, alarmService, memberMatcher, memberDataProvider, youTubeStack, activityLogger, settingsService, aclService)
+	roomService, err := ProvideRoomService(ctx, postgresService, cacheService, alarmService, titleTranslator, aclService, logger)
+	if err != nil {
+		infra.cleanupDB()
+		infra.cleanupCache()
+		return nil, err
+	}
+
+	clipService := ProvideClipService(cfg, holodexService, cacheService, logger)
+
+	latencyService, err := ProvideLatencyService(ctx, cfg, postgresService, logger)
+	if err != nil {
+		infra.cleanupDB()
+		infra.cleanupCache()
+		return nil, err
+	}
+
+	deps := ProvideBotDependencies(cfg, logger, irisClient, messageStack, cacheService, postgresService, infra.memberRepo, infra.memberCache, holodexService, profileService, alarmService, memberMatcher, memberDataProvider, youTubeStack, titleTranslator, activityLogger, settingsService, aclService, roomService, clipService, scheduleQuery, latencyService)
 
 	// 프로필 이미지 동기화 서비스 생성 (7일 주기)
 	photoSyncService := holodex.NewPhotoSyncService(holodexService, infra.memberRepo, logger)
@@ -128,7 +152,11 @@ func initCoreInfrastructure(ctx context.Context, cfg *config.Config, logger *slo
 		ytStack:      youTubeStack,
 		photoSync:    photoSyncService,
 		cleanupCache: infra.cleanupCache,
-		cleanupDB:    infra.cleanupDB,
+		// 남은 지연 샘플을 DB 연결을 닫기 전에 저장
+		cleanupDB: func() {
+			latencyService.Close()
+			infra.cleanupDB()
+		},
 	}, nil
 }
 
@@ -188,7 +216,18 @@ func buildBotRuntime(ctx context.Context, cfg *config.Config, logger *slog.Logge
 	youTubeService := ProvideYouTubeService(infra.ytStack)
 	systemCollector := ProvideSystemCollector(cfg)
 
-	apiHandler := ProvideAPIHandler(deps.MemberRepo, deps.MemberCache, deps.Cache, deps.Profiles, deps.Alarm, deps.Holodex, youTubeService, infra.ytStack.StatsRepo, deps.Activity, deps.Settings, deps.ACL, systemCollector, logger)
+	roomSweeper := ProvideRoomSweeper(cfg, deps.Rooms, logger)
+	alarmHygiene := ProvideAlarmHygiene(cfg, deps.Rooms, deps.Client, deps.Activity, logger)
+	schedulePrefetcher := ProvideSchedulePrefetcher(cfg, deps.Alarm, deps.Holodex, logger)
+	clipTracker := ProvideClipTracker(cfg, deps.Clips, deps.Alarm, logger)
+	latencyRoller := ProvideLatencyRoller(cfg, deps.Latency, logger)
+
+	broadcastService, err := ProvideBroadcastService(ctx, deps.Postgres, deps.Alarm, deps.Client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	apiHandler := ProvideAPIHandler(deps.MemberRepo, deps.MemberCache, deps.Cache, deps.Profiles, deps.Alarm, deps.Holodex, youTubeService, infra.ytStack.StatsRepo, deps.Activity, deps.Settings, deps.ACL, deps.TitleTranslator, deps.Rooms, deps.Latency, broadcastService, systemCollector, ProvideRuntimeConfig(cfg, botBot), logger)
 
 	authService, err := ProvideAuthService(ctx, deps.Postgres, deps.Cache, logger)
 	if err != nil {
@@ -196,7 +235,9 @@ func buildBotRuntime(ctx context.Context, cfg *config.Config, logger *slog.Logge
 	}
 	authHandler := ProvideAuthHandler(authService, logger)
 
-	adminRouter, err := ProvideAPIRouter(ctx, cfg, logger, apiHandler, authHandler)
+	schemaChecker := ProvideSchemaChecker(deps.Postgres)
+
+	adminRouter, err := ProvideAPIRouter(ctx, cfg, logger, apiHandler, authHandler, schemaChecker)
 	if err != nil {
 		return nil, err
 	}
@@ -205,16 +246,21 @@ func buildBotRuntime(ctx context.Context, cfg *config.Config, logger *slog.Logge
 	adminServer := ProvideAPIServer(adminAddr, adminRouter)
 
 	return &BotRuntime{
-		Config:      cfg,
-		Logger:      logger,
-		Bot:         botBot,
-		MQConsumer:  valkeyMQConsumer,
-		Scheduler:   youTubeScheduler,
-		PhotoSync:   infra.photoSync, // 프로필 이미지 동기화 서비스
-		APIHandler:  apiHandler,
-		AdminRouter: adminRouter,
-		AdminAddr:   adminAddr,
-		AdminServer: adminServer,
+		Config:        cfg,
+		Logger:        logger,
+		Bot:           botBot,
+		MQConsumer:    valkeyMQConsumer,
+		Scheduler:     youTubeScheduler,
+		PhotoSync:     infra.photoSync, // 프로필 이미지 동기화 서비스
+		RoomSweeper:   roomSweeper,
+		AlarmHygiene:  alarmHygiene,
+		Prefetcher:    schedulePrefetcher,
+		ClipTracker:   clipTracker,
+		LatencyRoller: latencyRoller,
+		APIHandler:    apiHandler,
+		AdminRouter:   adminRouter,
+		AdminAddr:     adminAddr,
+		AdminServer:   adminServer,
 	}, nil
 }
 
@@ -294,3 +340,58 @@ func InitializeFetchProfilesRuntime(_ context.Context) (*FetchProfilesRuntime, f
 
 	return runtime, cleanupLogger, nil
 }
+
+// InitializeAlarmKeyspace - cmd/tools/migrate_alarms 전용 (Valkey만 연결, 알람 체크/DB 저장 없음)
+func InitializeAlarmKeyspace(cfg *config.Config, logger *slog.Logger) (*notification.AlarmService, func(), error) {
+	valkeyConfig := ProvideValkeyConfig(cfg)
+	cacheResources, cleanupCache, err := ProvideCacheResources(valkeyConfig, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	cacheService := ProvideCacheService(cacheResources)
+
+	return notification.NewAlarmService(cacheService, nil, nil, logger, nil), cleanupCache, nil
+}
+
+// InitializeAlarmBackup - cmd/tools/alarm_backup 전용 (DB 알람 저장소와 Valkey 대조용 알람 서비스, 알람 체크 없음)
+func InitializeAlarmBackup(cfg *config.Config, logger *slog.Logger) (*alarm.Repository, *notification.AlarmService, func(), error) {
+	postgresConfig := ProvidePostgresConfig(cfg)
+	databaseResources, cleanupDB, err := ProvideDatabaseResources(postgresConfig, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	postgresService := ProvidePostgresService(databaseResources)
+	alarmRepository := ProvideAlarmRepository(postgresService, logger)
+
+	valkeyConfig := ProvideValkeyConfig(cfg)
+	cacheResources, cleanupCache, err := ProvideCacheResources(valkeyConfig, logger)
+	if err != nil {
+		cleanupDB()
+		return nil, nil, nil, err
+	}
+	cacheService := ProvideCacheService(cacheResources)
+
+	cleanup := func() {
+		cleanupCache()
+		cleanupDB()
+	}
+
+	return alarmRepository, notification.NewAlarmService(cacheService, nil, alarmRepository, logger, nil), cleanup, nil
+}
+
+// InitializeProfileImport - cmd/tools/import_profiles 전용 (DB 프로필 저장소만 연결)
+func InitializeProfileImport(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*member.ProfileStore, func(), error) {
+	postgresConfig := ProvidePostgresConfig(cfg)
+	databaseResources, cleanupDB, err := ProvideDatabaseResources(postgresConfig, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	postgresService := ProvidePostgresService(databaseResources)
+
+	store, err := member.NewProfileStore(ctx, postgresService.GetGormDB())
+	if err != nil {
+		cleanupDB()
+		return nil, nil, err
+	}
+	return store, cleanupDB, nil
+}
@@ -11,19 +11,27 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/config"
 	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/health"
 	"github.com/kapu/hololive-kakao-bot-go/internal/iris"
 	"github.com/kapu/hololive-kakao-bot-go/internal/mq"
 	"github.com/kapu/hololive-kakao-bot-go/internal/platform/bootstrap"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/activity"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/alarm"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/broadcast"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/clip"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/database"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/matcher"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/schedulequery"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/settings"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/twitch"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 )
 
@@ -65,6 +73,11 @@ func ProvidePostgresService(resources *bootstrap.DatabaseResources) *database.Po
 	return resources.Service
 }
 
+// ProvideSchemaChecker - 상세 헬스체크용 스키마 점검기 생성
+func ProvideSchemaChecker(postgres *database.PostgresService) *health.SchemaChecker {
+	return health.NewSchemaChecker(postgres.GetDB(), health.DefaultSchemaCheckTTL, database.SchemaExpectations())
+}
+
 // ProvideValkeyMQConfig - 설정에서 MQ 설정 생성
 func ProvideValkeyMQConfig(cfg *config.Config) mq.ValkeyMQConfig {
 	return mq.ValkeyMQConfig{
@@ -192,10 +205,63 @@ func ProvideHolodexService(
 	return svc, nil
 }
 
-// ProvideProfileService - 프로필 서비스 생성 (번역 사전 로드 포함)
+// ProvideHolodexQuotaTracker - Holodex 일일 호출 예산 추적기 생성
+func ProvideHolodexQuotaTracker(cfg *config.Config, cacheSvc *cache.Service, logger *slog.Logger) *holodex.QuotaTracker {
+	return holodex.NewQuotaTracker(cacheSvc, cfg.Holodex.DailyBudget, cfg.Holodex.BudgetReserveRatio, logger)
+}
+
+// ProvideTwitchService - Twitch 서비스 생성 (자격 증명/채널 매핑이 없으면 nil)
+func ProvideTwitchService(
+	cfg *config.Config,
+	cacheSvc *cache.Service,
+	logger *slog.Logger,
+) *twitch.Service {
+	if !cfg.Twitch.Enabled() {
+		return nil
+	}
+
+	client := twitch.NewTwitchClient(nil, cfg.Twitch.ClientID, cfg.Twitch.ClientSecret, logger)
+	svc, err := twitch.NewTwitchService(client, cacheSvc, cfg.Twitch.Channels, logger)
+	if err != nil {
+		logger.Warn("Twitch service disabled", slog.Any("error", err))
+		return nil
+	}
+	return svc
+}
+
+// ProvideTitleTranslator - 방송 제목 번역 서비스 생성 (LLM 서버 주소가 없으면 nil)
+func ProvideTitleTranslator(
+	cfg *config.Config,
+	cacheSvc *cache.Service,
+	logger *slog.Logger,
+) *translation.Service {
+	if !cfg.Translation.Enabled() {
+		return nil
+	}
+
+	client := translation.NewLLMClient(nil, cfg.Translation.LLMBaseURL, cfg.Translation.APIKey)
+	return translation.NewService(client, cacheSvc, cfg.Translation.DailyLimit, logger)
+}
+
+// ProvideScheduleQuery - 자연어 일정 질문 해석 서비스 생성 (비활성화면 nil, LLM 서버 주소가 없으면 규칙 기반 해석만 사용)
+func ProvideScheduleQuery(cfg *config.Config, logger *slog.Logger) *schedulequery.Service {
+	if !cfg.Schedule.Enabled {
+		return nil
+	}
+
+	var parser schedulequery.Parser
+	if cfg.Schedule.LLMBaseURL != "" {
+		parser = schedulequery.NewLLMClient(nil, cfg.Schedule.LLMBaseURL, cfg.Schedule.APIKey)
+	}
+	logger.Info("Schedule query enabled", slog.Bool("llm", parser != nil))
+	return schedulequery.NewService(parser, logger)
+}
+
+// ProvideProfileService - 프로필 서비스 생성 (PostgreSQL talent_profiles 기준, 비어 있으면 임베드 JSON으로 채움, 번역 사전 로드 포함)
 func ProvideProfileService(
 	ctx context.Context,
 	cacheSvc *cache.Service,
+	postgres *database.PostgresService,
 	members *member.ServiceAdapter,
 	logger *slog.Logger,
 ) (*member.ProfileService, error) {
@@ -203,6 +269,13 @@ func ProvideProfileService(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create profile service: %w", err)
 	}
+	store, err := member.NewProfileStore(ctx, postgres.GetGormDB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create profile store: %w", err)
+	}
+	if err := svc.UseStore(ctx, store); err != nil {
+		return nil, fmt.Errorf("failed to load profiles from database: %w", err)
+	}
 	svc.PreloadTranslations(ctx)
 	return svc, nil
 }
@@ -334,6 +407,103 @@ func ProvideACLService(
 	return svc, nil
 }
 
+// ProvideRoomService - 방 활동 추적 및 떠나기 서비스 생성 (떠나기 기록은 PostgreSQL)
+func ProvideRoomService(
+	ctx context.Context,
+	postgres *database.PostgresService,
+	cacheSvc *cache.Service,
+	alarmSvc *notification.AlarmService,
+	titleTranslator *translation.Service,
+	aclSvc *acl.Service,
+	logger *slog.Logger,
+) (*room.Service, error) {
+	svc, err := room.NewService(ctx, postgres.GetGormDB(), cacheSvc, alarmSvc, titleTranslator, aclSvc, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create room service: %w", err)
+	}
+	return svc, nil
+}
+
+// ProvideBroadcastService - 알람 등록 방 대상 관리자 공지 서비스 생성 (전송 기록은 PostgreSQL)
+func ProvideBroadcastService(
+	ctx context.Context,
+	postgres *database.PostgresService,
+	alarmSvc *notification.AlarmService,
+	irisClient iris.Client,
+	logger *slog.Logger,
+) (*broadcast.Service, error) {
+	svc, err := broadcast.NewService(ctx, postgres.GetGormDB(), alarmSvc, irisClient, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create broadcast service: %w", err)
+	}
+	return svc, nil
+}
+
+// ProvideLatencyService - 명령어 응답 지연 기록 서비스 생성 (LATENCY_SLA_ENABLED=false면 nil)
+func ProvideLatencyService(ctx context.Context, cfg *config.Config, postgres *database.PostgresService, logger *slog.Logger) (*latency.Service, error) {
+	if !cfg.Latency.Enabled {
+		return nil, nil
+	}
+	svc, err := latency.NewService(ctx, postgres.GetGormDB(), cfg.Latency.SLA, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create latency service: %w", err)
+	}
+	return svc, nil
+}
+
+// ProvideLatencyRoller - 전날 응답 지연 일별 집계 작업 생성 (지연 기록이 비활성이면 nil)
+func ProvideLatencyRoller(cfg *config.Config, latencySvc *latency.Service, logger *slog.Logger) *latency.Roller {
+	return latency.NewRoller(latencySvc, cfg.Latency.RollupAtMinute, cfg.Latency.RetentionDays, logger)
+}
+
+// ProvideRoomSweeper - 장기 미사용 방 자동 정리 작업 생성 (ROOM_INACTIVE_DAYS가 0 이하면 nil)
+func ProvideRoomSweeper(cfg *config.Config, rooms *room.Service, logger *slog.Logger) *room.Sweeper {
+	return room.NewSweeper(rooms, cfg.Room.InactiveDays, cfg.Room.SweepInterval, logger)
+}
+
+// ProvideAlarmHygiene - 전송 실패가 이어지는 방의 알람 구독 휴면 처리 작업 생성 (ALARM_DORMANT_DAYS가 0 이하면 nil)
+// 휴면 전환/복귀는 활동 로그에 남기고, 관리자 방이 설정돼 있으면 그 방에도 보낸다.
+func ProvideAlarmHygiene(cfg *config.Config, rooms *room.Service, irisClient iris.Client, activityLogger *activity.Logger, logger *slog.Logger) *room.AlarmHygiene {
+	adminRoom := cfg.Room.AdminRoom
+	rooms.SetAdminNotifier(func(ctx context.Context, summary string, details map[string]any) {
+		if activityLogger != nil {
+			activityLogger.Log("alarm_hygiene", summary, details)
+		}
+		if adminRoom == "" || irisClient == nil {
+			return
+		}
+		if err := irisClient.SendMessage(ctx, adminRoom, "[알람 관리] "+summary); err != nil {
+			logger.Warn("Failed to notify admin room", slog.String("room", adminRoom), slog.Any("error", err))
+		}
+	})
+	return room.NewAlarmHygiene(rooms, cfg.Room.AlarmDormantDays, cfg.Room.SweepInterval, logger)
+}
+
+// ProvideSchedulePrefetcher - 구독 채널 일정 프리페처 생성 (SCHEDULE_PREFETCH_ENABLED가 false면 nil)
+func ProvideSchedulePrefetcher(cfg *config.Config, alarmSvc *notification.AlarmService, holodexSvc *holodex.Service, logger *slog.Logger) *notification.SchedulePrefetcher {
+	if !cfg.Notification.SchedulePrefetch || holodexSvc == nil {
+		return nil
+	}
+	return notification.NewSchedulePrefetcher(alarmSvc, holodexSvc, logger)
+}
+
+// ProvideClipService - 클립 채널 추적 서비스 생성 (CLIP_TRACKING_ENABLED가 false면 nil)
+func ProvideClipService(cfg *config.Config, holodexSvc *holodex.Service, cacheSvc *cache.Service, logger *slog.Logger) *clip.Service {
+	if !cfg.Clip.Enabled {
+		return nil
+	}
+	return clip.NewService(holodexSvc, cacheSvc, clip.Config{
+		Langs:        cfg.Clip.Langs,
+		TopChannels:  cfg.Clip.TopChannels,
+		LookbackDays: cfg.Clip.LookbackDays,
+	}, logger)
+}
+
+// ProvideClipTracker - 클립을 켠 방의 구독 멤버 클립 집계 갱신 작업 생성 (클립 비활성 시 nil)
+func ProvideClipTracker(cfg *config.Config, clips *clip.Service, alarmSvc *notification.AlarmService, logger *slog.Logger) *clip.Tracker {
+	return clip.NewTracker(clips, alarmSvc, cfg.Clip.RefreshInterval, logger)
+}
+
 // MessageStack - 메시지 어댑터와 포매터 묶음
 type MessageStack struct {
 	Adapter   *adapter.MessageAdapter
@@ -365,9 +535,14 @@ func ProvideBotDependencies(
 	memberMatcher *matcher.MemberMatcher,
 	membersData domain.MemberDataProvider,
 	ytStack *YouTubeStack,
+	titleTranslator *translation.Service,
 	activityLogger *activity.Logger,
 	settingsSvc *settings.Service,
 	aclSvc *acl.Service,
+	rooms *room.Service,
+	clips *clip.Service,
+	scheduleQuery *schedulequery.Service,
+	latencySvc *latency.Service,
 ) *bot.Dependencies {
 	return &bot.Dependencies{
 		Config:           cfg,
@@ -387,8 +562,13 @@ func ProvideBotDependencies(
 		Service:          ytStack.Service,
 		Scheduler:        ytStack.Scheduler,
 		YouTubeStatsRepo: ytStack.StatsRepo,
+		TitleTranslator:  titleTranslator,
 		Activity:         activityLogger,
 		Settings:         settingsSvc,
 		ACL:              aclSvc,
+		Rooms:            rooms,
+		Clips:            clips,
+		ScheduleQuery:    scheduleQuery,
+		Latency:          latencySvc,
 	}
 }
@@ -17,7 +17,11 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
 	"github.com/kapu/hololive-kakao-bot-go/internal/mq"
 	"github.com/kapu/hololive-kakao-bot-go/internal/server"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/clip"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 )
 
@@ -30,6 +34,16 @@ type BotRuntime struct {
 	MQConsumer *mq.ValkeyMQConsumer
 	Scheduler  *youtube.Scheduler
 	PhotoSync  *holodex.PhotoSyncService // 프로필 이미지 동기화 서비스
+	// RoomSweeper: 장기 미사용 방 자동 정리 (비활성 시 nil)
+	RoomSweeper *room.Sweeper
+	// AlarmHygiene: 전송 실패가 이어지는 방의 알람 구독 휴면 처리 (비활성 시 nil)
+	AlarmHygiene *room.AlarmHygiene
+	// Prefetcher: 구독 채널 일정 캐시 미리 갱신 (비활성 시 nil)
+	Prefetcher *notification.SchedulePrefetcher
+	// ClipTracker: 클립 채널 집계 주기 갱신 (클립 비활성 시 nil)
+	ClipTracker *clip.Tracker
+	// LatencyRoller: 명령어 응답 지연 일별 집계 (지연 기록 비활성 시 nil)
+	LatencyRoller *latency.Roller
 
 	APIHandler  *server.APIHandler
 	AdminRouter *gin.Engine
@@ -122,6 +136,46 @@ func (r *BotRuntime) Start(ctx context.Context, errCh chan<- error) {
 		}
 	}
 
+	// 장기 미사용 방 자동 정리 시작
+	if r.RoomSweeper != nil {
+		go r.RoomSweeper.Start(ctx)
+		if r.Logger != nil {
+			r.Logger.Info("Room sweeper started")
+		}
+	}
+
+	// 알람 구독 휴면 처리 시작
+	if r.AlarmHygiene != nil {
+		go r.AlarmHygiene.Start(ctx)
+		if r.Logger != nil {
+			r.Logger.Info("Alarm hygiene job started")
+		}
+	}
+
+	// 구독 채널 일정 프리페치 시작
+	if r.Prefetcher != nil {
+		go r.Prefetcher.Start(ctx)
+		if r.Logger != nil {
+			r.Logger.Info("Schedule prefetcher started")
+		}
+	}
+
+	// 클립을 켠 방의 구독 멤버 클립 집계 갱신 시작
+	if r.ClipTracker != nil {
+		go r.ClipTracker.Start(ctx)
+		if r.Logger != nil {
+			r.Logger.Info("Clip tracker started")
+		}
+	}
+
+	// 전날 명령어 응답 지연 집계 시작
+	if r.LatencyRoller != nil {
+		go r.LatencyRoller.Start(ctx)
+		if r.Logger != nil {
+			r.Logger.Info("Latency rollup started")
+		}
+	}
+
 	// 백그라운드에서 알림 체커 시작
 	if r.Bot != nil {
 		go func() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/bot"
 	"github.com/kapu/hololive-kakao-bot-go/internal/config"
@@ -12,13 +13,18 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/activity"
 	authsvc "github.com/kapu/hololive-kakao-bot-go/internal/service/auth"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/broadcast"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/database"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/runtimeconfig"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/settings"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/system"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 )
 
@@ -69,7 +75,12 @@ func ProvideAPIHandler(
 	activityLogger *activity.Logger,
 	settingsSvc *settings.Service,
 	aclSvc *acl.Service,
+	titleTranslator *translation.Service,
+	rooms *room.Service,
+	latencySvc *latency.Service,
+	broadcasts *broadcast.Service,
 	systemSvc *system.Collector,
+	runtimeCfg *runtimeconfig.Registry,
 	logger *slog.Logger,
 ) *server.APIHandler {
 	return server.NewAPIHandler(
@@ -84,11 +95,25 @@ func ProvideAPIHandler(
 		activityLogger,
 		settingsSvc,
 		aclSvc,
+		titleTranslator,
+		rooms,
+		latencySvc,
+		broadcasts,
 		systemSvc,
+		runtimeCfg,
 		logger,
 	)
 }
 
+// ProvideRuntimeConfig: 관리 대시보드가 푸시하는 런타임 설정을 등록합니다. (기본값은 환경 변수 값)
+func ProvideRuntimeConfig(cfg *config.Config, kakaoBot *bot.Bot) *runtimeconfig.Registry {
+	registry := runtimeconfig.NewRegistry()
+	registry.Int("alarm.checkIntervalSeconds", "방송 알람 확인 주기(초)", int(cfg.Notification.CheckInterval/time.Second), 10, 3600, func(v int) {
+		kakaoBot.SetAlarmCheckInterval(time.Duration(v) * time.Second)
+	})
+	return registry
+}
+
 // ProvideAuthService: 세션 기반 인증 서비스를 생성하여 제공합니다.
 func ProvideAuthService(
 	ctx context.Context,
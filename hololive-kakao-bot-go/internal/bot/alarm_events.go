@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// alarmFiredChannel: 알림 발송 이벤트 채널. 게임 봇 이벤트 버스(game-events:{source}:{type})와 같은 형식이라
+// 관리자 대시보드 활동 피드가 한 번의 구독으로 함께 받는다.
+const alarmFiredChannel = "game-events:hololive:alarm_fired"
+
+// alarmEventPublishTimeout: 이벤트 발행이 알림 발송 흐름을 늦추지 않도록 짧게 제한
+const alarmEventPublishTimeout = 500 * time.Millisecond
+
+// alarmFiredEvent: 게임 봇 이벤트(eventbus.Event)와 같은 JSON 형태의 알림 발송 이벤트
+type alarmFiredEvent struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Game       string         `json:"game"`
+	ChatID     string         `json:"chatId"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// publishAlarmFired: 방에 알림을 보낸 뒤 활동 피드용 이벤트를 발행합니다.
+// 부가 정보이므로 실패해도 경고 로그만 남깁니다.
+func (b *Bot) publishAlarmFired(ctx context.Context, roomID string, minutesUntil int, notifications []*domain.AlarmNotification) {
+	if b.cache == nil {
+		return
+	}
+
+	streams := make([]map[string]any, 0, len(notifications))
+	users := 0
+	for _, notif := range notifications {
+		if notif == nil || notif.Stream == nil {
+			continue
+		}
+		users += len(notif.Users)
+		channelName := notif.Stream.ChannelName
+		if notif.Channel != nil && notif.Channel.Name != "" {
+			channelName = notif.Channel.Name
+		}
+		streams = append(streams, map[string]any{
+			"streamId": notif.Stream.ID,
+			"title":    notif.Stream.Title,
+			"channel":  channelName,
+		})
+	}
+
+	payload, err := json.Marshal(alarmFiredEvent{
+		ID:         newAlarmEventID(),
+		Type:       "alarm_fired",
+		Game:       "hololive",
+		ChatID:     roomID,
+		OccurredAt: time.Now(),
+		Data: map[string]any{
+			"minutesUntil": minutesUntil,
+			"streams":      streams,
+			"users":        users,
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), alarmEventPublishTimeout)
+	defer cancel()
+	if err := b.cache.Publish(publishCtx, alarmFiredChannel, string(payload)); err != nil {
+		b.logger.Warn("Failed to publish alarm event", slog.String("room", roomID), slog.Any("error", err))
+	}
+}
+
+func newAlarmEventID() string {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(buf[:])
+}
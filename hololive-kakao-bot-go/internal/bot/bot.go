@@ -18,11 +18,16 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/iris"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/clip"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/database"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/matcher"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/schedulequery"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 	"github.com/kapu/hololive-kakao-bot-go/internal/util"
 	appErrors "github.com/kapu/hololive-kakao-bot-go/pkg/errors"
@@ -47,10 +52,17 @@ type Bot struct {
 	matcher          *matcher.MemberMatcher
 	commandRegistry  *command.Registry
 	statsRepo        *youtube.StatsRepository
+	titleTranslator  *translation.Service
 	acl              *acl.Service
+	rooms            *room.Service
+	clips            *clip.Service
+	scheduleQuery    *schedulequery.Service
+	latency          *latency.Service
 	alarmTicker      *time.Ticker
 	alarmStopCh      chan struct{}
 	alarmMutex       sync.Mutex
+	alarmIntervalMu  sync.Mutex
+	alarmInterval    time.Duration // 런타임 설정으로 바뀐 확인 주기 (0이면 설정 파일 값)
 	membersData      domain.MemberDataProvider
 	stopCh           chan struct{}
 	doneCh           chan struct{}
@@ -114,7 +126,12 @@ func NewBot(deps *Dependencies) (*Bot, error) {
 		alarm:            deps.Alarm,
 		matcher:          deps.Matcher,
 		statsRepo:        deps.YouTubeStatsRepo,
+		titleTranslator:  deps.TitleTranslator,
 		acl:              deps.ACL,
+		rooms:            deps.Rooms,
+		clips:            deps.Clips,
+		scheduleQuery:    deps.ScheduleQuery,
+		latency:          deps.Latency,
 		membersData:      deps.MembersData,
 		stopCh:           make(chan struct{}),
 		doneCh:           make(chan struct{}),
@@ -144,6 +161,10 @@ func (b *Bot) initializeCommands() {
 		StatsRepo:        b.statsRepo,
 		MembersData:      b.membersData,
 		Formatter:        b.formatter,
+		TitleTranslator:  b.titleTranslator,
+		Rooms:            b.rooms,
+		Clips:            b.clips,
+		ScheduleQuery:    b.scheduleQuery,
 		SendMessage:      b.sendMessage,
 		SendImage:        b.sendImage,
 		SendError:        b.sendError,
@@ -162,6 +183,8 @@ func (b *Bot) initializeCommands() {
 		command.NewAlarmCommand(deps),
 		command.NewMemberInfoCommand(deps),
 		command.NewSubscriberCommand(deps),
+		command.NewLeaveCommand(deps),
+		command.NewClipCommand(deps),
 	}
 
 	if deps.StatsRepo != nil {
@@ -169,6 +192,11 @@ func (b *Bot) initializeCommands() {
 		commandsList = append(commandsList, command.NewStatsCommand(deps))
 	}
 
+	if deps.ScheduleQuery != nil {
+		b.logger.Info("Schedule query command enabled")
+		commandsList = append(commandsList, command.NewScheduleQueryCommand(deps))
+	}
+
 	for _, cmd := range commandsList {
 		registry.Register(cmd)
 	}
@@ -260,8 +288,17 @@ func (b *Bot) HandleMessage(ctx context.Context, message *iris.Message) {
 	}
 
 	parsed := b.messageAdapter.ParseMessage(message)
+	// 자연어 일정 질문이 꺼져 있으면 기존처럼 알 수 없는 명령어로 보고 무시
+	if parsed.Type == domain.CommandScheduleQuery && b.scheduleQuery == nil {
+		parsed.Type = domain.CommandUnknown
+	}
 	commandType = parsed.Type.String()
 
+	// 장기 미사용 방 판별을 위해 명령어가 아닌 메시지도 활동으로 기록
+	if err := b.rooms.Touch(ctx, chatID, roomName, parsed.Type != domain.CommandUnknown); err != nil {
+		b.logger.Debug("Failed to record room activity", slog.String("room", chatID), slog.Any("error", err))
+	}
+
 	if parsed.Type == domain.CommandUnknown {
 		b.logger.Debug("Unknown command ignored",
 			slog.String("msg", message.Msg),
@@ -271,6 +308,9 @@ func (b *Bot) HandleMessage(ctx context.Context, message *iris.Message) {
 		return // 알 수 없는 명령어는 무시함
 	}
 
+	ctx, span := b.latency.Start(ctx, commandType)
+	defer span.Finish()
+
 	b.logger.Info("Command received",
 		slog.String("raw", parsed.RawMessage),
 		slog.String("type", commandType),
@@ -351,10 +391,13 @@ func (b *Bot) sendMessage(ctx context.Context, room, message string) error {
 	ctx, cancel := context.WithTimeout(ctx, constants.RequestTimeout.BotCommand)
 	defer cancel()
 
-	if err := b.irisClient.SendMessage(ctx, room, message); err != nil {
+	err := b.irisClient.SendMessage(ctx, room, message)
+	b.recordDelivery(ctx, room, err)
+	if err != nil {
 		serviceErr := appErrors.NewServiceError("failed to send message", "iris", "send_message", err)
 		return fmt.Errorf("failed to send message to room %s: %w", room, serviceErr)
 	}
+	latency.MarkReply(ctx)
 	return nil
 }
 
@@ -362,13 +405,23 @@ func (b *Bot) sendImage(ctx context.Context, room, imageBase64 string) error {
 	ctx, cancel := context.WithTimeout(ctx, constants.RequestTimeout.BotCommand)
 	defer cancel()
 
-	if err := b.irisClient.SendImage(ctx, room, imageBase64); err != nil {
+	err := b.irisClient.SendImage(ctx, room, imageBase64)
+	b.recordDelivery(ctx, room, err)
+	if err != nil {
 		serviceErr := appErrors.NewServiceError("failed to send image", "iris", "send_image", err)
 		return fmt.Errorf("failed to send image to room %s: %w", room, serviceErr)
 	}
+	latency.MarkReply(ctx)
 	return nil
 }
 
+// recordDelivery: 알람 휴면 판별을 위해 방별 전송 성공/실패를 기록합니다.
+func (b *Bot) recordDelivery(ctx context.Context, room string, sendErr error) {
+	if err := b.rooms.RecordDelivery(context.WithoutCancel(ctx), room, sendErr); err != nil {
+		b.logger.Debug("Failed to record delivery", slog.String("room", room), slog.Any("error", err))
+	}
+}
+
 func (b *Bot) sendError(ctx context.Context, room, errorMsg string) error {
 	message := b.formatter.FormatError(errorMsg)
 	if err := b.sendMessage(ctx, room, message); err != nil {
@@ -426,8 +479,13 @@ func (b *Bot) getErrorMessage(err error, commandType string) string {
 }
 
 func (b *Bot) startAlarmChecker(ctx context.Context) {
+	b.alarmIntervalMu.Lock()
 	interval := b.config.Notification.CheckInterval
+	if b.alarmInterval > 0 {
+		interval = b.alarmInterval
+	}
 	b.alarmTicker = time.NewTicker(interval)
+	b.alarmIntervalMu.Unlock()
 	b.alarmStopCh = make(chan struct{})
 
 	b.logger.Info("Alarm checker started", slog.Duration("interval", interval))
@@ -448,6 +506,21 @@ func (b *Bot) startAlarmChecker(ctx context.Context) {
 	}()
 }
 
+// SetAlarmCheckInterval: 알람 확인 주기를 바꿉니다. 체커가 이미 돌고 있으면 다음 틱부터 적용됩니다.
+func (b *Bot) SetAlarmCheckInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	b.alarmIntervalMu.Lock()
+	defer b.alarmIntervalMu.Unlock()
+
+	b.alarmInterval = interval
+	if b.alarmTicker != nil {
+		b.alarmTicker.Reset(interval)
+	}
+	b.logger.Info("Alarm check interval changed", slog.Duration("interval", interval))
+}
+
 func (b *Bot) performAlarmCheck(ctx context.Context) {
 	if !b.alarmMutex.TryLock() {
 		b.logger.Debug("Alarm check already in progress, skipping")
@@ -464,7 +537,16 @@ func (b *Bot) performAlarmCheck(ctx context.Context) {
 		return
 	}
 
-	grouped := groupAlarmNotifications(notifications)
+	digestModes := b.alarm.RoomDigestModes(childCtx)
+	grouped := groupAlarmNotifications(notifications, digestModes)
+	for roomID, roomNotifs := range b.alarm.HourlyDigests(childCtx, digestModes, time.Now()) {
+		grouped = append(grouped, &alarmNotificationGroup{
+			roomID:        roomID,
+			minutesUntil:  minAlarmMinutes(roomNotifs),
+			notifications: roomNotifs,
+			digest:        notification.DigestHourly,
+		})
+	}
 
 	// 병렬 알림 전송 (CONVENTIONS.md Phase 2 최적화)
 	var wg sync.WaitGroup
@@ -477,11 +559,22 @@ func (b *Bot) performAlarmCheck(ctx context.Context) {
 		go func(g alarmNotificationGroup) {
 			defer wg.Done()
 
+			streams := make([]*domain.Stream, 0, len(g.notifications))
+			for _, notif := range g.notifications {
+				if notif != nil && notif.Stream != nil {
+					streams = append(streams, notif.Stream)
+				}
+			}
+			formatter := b.formatter.WithTitleGlosses(b.titleTranslator.Glosses(childCtx, g.roomID, streams))
+
 			var message string
-			if len(g.notifications) == 1 {
-				message = b.formatter.AlarmNotification(g.notifications[0])
-			} else {
-				message = b.formatter.AlarmNotificationGroup(g.minutesUntil, g.notifications)
+			switch {
+			case g.digest != notification.DigestOff:
+				message = formatter.AlarmDigest(g.notifications, g.digest == notification.DigestHourly)
+			case len(g.notifications) == 1:
+				message = formatter.AlarmNotification(g.notifications[0])
+			default:
+				message = formatter.AlarmNotificationGroup(g.minutesUntil, g.notifications)
 			}
 
 			if util.TrimSpace(message) == "" {
@@ -496,12 +589,17 @@ func (b *Bot) performAlarmCheck(ctx context.Context) {
 				)
 				return
 			}
+			b.publishAlarmFired(childCtx, g.roomID, g.minutesUntil, g.notifications)
 
+			// 매시 요약은 알림 시점과 무관하게 보낸 목록이므로 발송 기록을 남기지 않는다
+			if g.digest == notification.DigestHourly {
+				return
+			}
 			for _, notif := range g.notifications {
 				if notif == nil || notif.Stream == nil || notif.Stream.StartScheduled == nil {
 					continue
 				}
-				if err := b.alarm.MarkAsNotified(childCtx, notif.Stream.ID, *notif.Stream.StartScheduled, notif.MinutesUntil); err != nil {
+				if err := b.alarm.MarkNotificationSent(childCtx, notif); err != nil {
 					b.logger.Warn("Failed to mark as notified",
 						slog.String("stream_id", notif.Stream.ID),
 						slog.Any("error", err),
@@ -517,9 +615,12 @@ type alarmNotificationGroup struct {
 	roomID        string
 	minutesUntil  int
 	notifications []*domain.AlarmNotification
+	digest        notification.DigestMode
 }
 
-func groupAlarmNotifications(notifications []*domain.AlarmNotification) []*alarmNotificationGroup {
+// groupAlarmNotifications: 알림을 방·시작 시각별로 묶는다.
+// 요약 모드 방은 방 단위 한 묶음(cycle)으로 합치고, 매시 요약 방(hourly)의 방송별 알림은 보내지 않는다.
+func groupAlarmNotifications(notifications []*domain.AlarmNotification, digestModes map[string]notification.DigestMode) []*alarmNotificationGroup {
 	if len(notifications) == 0 {
 		return []*alarmNotificationGroup{}
 	}
@@ -532,7 +633,15 @@ func groupAlarmNotifications(notifications []*domain.AlarmNotification) []*alarm
 			continue
 		}
 
+		mode := digestModes[notif.RoomID]
+		if mode == notification.DigestHourly {
+			continue
+		}
+
 		key := buildAlarmGroupKey(notif)
+		if mode == notification.DigestCycle {
+			key = notif.RoomID + "|digest"
+		}
 		if idx, ok := index[key]; ok {
 			group := groups[idx]
 			group.notifications = append(group.notifications, notif)
@@ -546,6 +655,7 @@ func groupAlarmNotifications(notifications []*domain.AlarmNotification) []*alarm
 			roomID:        notif.RoomID,
 			minutesUntil:  notif.MinutesUntil,
 			notifications: []*domain.AlarmNotification{notif},
+			digest:        mode,
 		}
 
 		groups = append(groups, group)
@@ -555,6 +665,17 @@ func groupAlarmNotifications(notifications []*domain.AlarmNotification) []*alarm
 	return groups
 }
 
+// minAlarmMinutes: 알림 중 가장 먼저 시작하는 방송까지 남은 분
+func minAlarmMinutes(notifications []*domain.AlarmNotification) int {
+	minutes := -1
+	for _, notif := range notifications {
+		if notif != nil && notif.MinutesUntil >= 0 && (minutes < 0 || notif.MinutesUntil < minutes) {
+			minutes = notif.MinutesUntil
+		}
+	}
+	return minutes
+}
+
 func buildAlarmGroupKey(notif *domain.AlarmNotification) string {
 	if notif == nil {
 		return ""
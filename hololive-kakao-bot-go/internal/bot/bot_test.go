@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
 )
 
 func TestGroupAlarmNotifications_GroupByScheduledTime(t *testing.T) {
@@ -34,7 +35,7 @@ func TestGroupAlarmNotifications_GroupByScheduledTime(t *testing.T) {
 		},
 	}
 
-	groups := groupAlarmNotifications([]*domain.AlarmNotification{notif1, notif2})
+	groups := groupAlarmNotifications([]*domain.AlarmNotification{notif1, notif2}, nil)
 	if len(groups) != 1 {
 		t.Fatalf("expected 1 group, got %d", len(groups))
 	}
@@ -60,7 +61,7 @@ func TestGroupAlarmNotifications_FallbackByMinutes(t *testing.T) {
 		MinutesUntil: 3,
 	}
 
-	groups := groupAlarmNotifications([]*domain.AlarmNotification{notif1, notif2})
+	groups := groupAlarmNotifications([]*domain.AlarmNotification{notif1, notif2}, nil)
 	if len(groups) != 2 {
 		t.Fatalf("expected 2 groups, got %d", len(groups))
 	}
@@ -69,3 +70,41 @@ func TestGroupAlarmNotifications_FallbackByMinutes(t *testing.T) {
 		t.Fatalf("expected different minutesUntil values per group when schedules are missing")
 	}
 }
+
+func TestGroupAlarmNotifications_DigestModes(t *testing.T) {
+	now := time.Now()
+	soon := now.Add(5 * time.Minute).Truncate(time.Minute)
+	later := now.Add(30 * time.Minute).Truncate(time.Minute)
+
+	notif := func(room, id string, minutes int, scheduled time.Time) *domain.AlarmNotification {
+		return &domain.AlarmNotification{
+			RoomID:       room,
+			MinutesUntil: minutes,
+			Stream:       &domain.Stream{ID: id, Status: domain.StreamStatusUpcoming, StartScheduled: &scheduled},
+		}
+	}
+
+	groups := groupAlarmNotifications([]*domain.AlarmNotification{
+		notif("cycle-room", "s1", 30, later),
+		notif("cycle-room", "s2", 5, soon),
+		notif("hourly-room", "s3", 5, soon),
+		notif("plain-room", "s4", 30, later),
+		notif("plain-room", "s5", 5, soon),
+	}, map[string]notification.DigestMode{
+		"cycle-room":  notification.DigestCycle,
+		"hourly-room": notification.DigestHourly,
+	})
+
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups (1 digest + 2 plain), got %d", len(groups))
+	}
+	digest := groups[0]
+	if digest.roomID != "cycle-room" || digest.digest != notification.DigestCycle || len(digest.notifications) != 2 || digest.minutesUntil != 5 {
+		t.Fatalf("unexpected digest group: %+v", digest)
+	}
+	for _, group := range groups[1:] {
+		if group.roomID != "plain-room" || group.digest != notification.DigestOff || len(group.notifications) != 1 {
+			t.Fatalf("unexpected plain group: %+v", group)
+		}
+	}
+}
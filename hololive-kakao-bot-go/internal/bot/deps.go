@@ -10,12 +10,17 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/activity"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/clip"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/database"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/matcher"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/schedulequery"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/settings"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 )
 
@@ -38,7 +43,12 @@ type Dependencies struct {
 	Service          *youtube.Service
 	Scheduler        *youtube.Scheduler
 	YouTubeStatsRepo *youtube.StatsRepository
+	TitleTranslator  *translation.Service // nil이면 제목 번역 비활성
 	Activity         *activity.Logger
 	Settings         *settings.Service
 	ACL              *acl.Service
+	Rooms            *room.Service          // 방 활동 추적 및 떠나기 처리
+	Clips            *clip.Service          // nil이면 클립 명령 비활성
+	ScheduleQuery    *schedulequery.Service // nil이면 자연어 일정 질문 비활성
+	Latency          *latency.Service       // nil이면 명령어 응답 지연 기록 비활성
 }
@@ -2,11 +2,13 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/adapter"
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
 )
 
 // AlarmCommand: 알람 설정 및 관리를 담당하는 커맨드 핸들러
@@ -54,6 +56,10 @@ func (c *AlarmCommand) Execute(ctx context.Context, cmdCtx *domain.CommandContex
 		return c.handleList(ctx, cmdCtx)
 	case "clear":
 		return c.handleClear(ctx, cmdCtx)
+	case "filter":
+		return c.handleFilter(ctx, cmdCtx, params)
+	case "digest":
+		return c.handleDigest(ctx, cmdCtx, params)
 	case "invalid":
 		subCmd, _ := params["sub_command"].(string)
 		memberName, _ := params["member"].(string)
@@ -153,9 +159,11 @@ func (c *AlarmCommand) handleList(ctx context.Context, cmdCtx *domain.CommandCon
 	for _, channelID := range channelIDs {
 		memberName := c.Deps().Alarm.GetMemberNameWithFallback(ctx, channelID)
 		nextStreamInfo, _ := c.Deps().Alarm.GetNextStreamInfo(ctx, channelID)
+		filter, _ := c.Deps().Alarm.GetAlarmKeywordFilter(ctx, cmdCtx.Room, cmdCtx.UserID, channelID)
 		alarmInfos = append(alarmInfos, adapter.AlarmListEntry{
 			MemberName: memberName,
 			NextStream: nextStreamInfo,
+			Filter:     filter,
 		})
 	}
 
@@ -173,3 +181,94 @@ func (c *AlarmCommand) handleClear(ctx context.Context, cmdCtx *domain.CommandCo
 	message := c.Deps().Formatter.FormatAlarmCleared(count)
 	return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
 }
+
+// handleFilter: 키워드가 없으면 현재 필터를 보여주고, 있으면(또는 해제 요청이면) 필터를 교체한다.
+func (c *AlarmCommand) handleFilter(ctx context.Context, cmdCtx *domain.CommandContext, params map[string]any) error {
+	memberName, _ := params["member"].(string)
+	if memberName == "" {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmNeedMemberNameFilter)
+	}
+
+	channel, err := FindActiveMemberOrError(ctx, c.Deps(), cmdCtx.Room, memberName)
+	if err != nil {
+		return err
+	}
+
+	include, _ := params["include"].([]string)
+	exclude, _ := params["exclude"].([]string)
+	reset, _ := params["reset"].(bool)
+	if reset {
+		include, exclude = nil, nil
+	}
+
+	if len(include) == 0 && len(exclude) == 0 && !reset {
+		filter, err := c.Deps().Alarm.GetAlarmKeywordFilter(ctx, cmdCtx.Room, cmdCtx.UserID, channel.ID)
+		if err != nil {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmFilterFailed)
+		}
+		return c.Deps().SendMessage(ctx, cmdCtx.Room, c.Deps().Formatter.FormatAlarmFilter(channel.Name, filter, false))
+	}
+
+	c.Deps().Logger.Info("Alarm filter requested",
+		slog.String("member", channel.Name),
+		slog.Any("include", include),
+		slog.Any("exclude", exclude),
+		slog.Bool("reset", reset),
+	)
+
+	filter, err := c.Deps().Alarm.SetAlarmKeywordFilter(ctx, cmdCtx.Room, cmdCtx.UserID, channel.ID,
+		domain.AlarmKeywordFilter{Include: include, Exclude: exclude})
+	switch {
+	case errors.Is(err, notification.ErrAlarmNotSubscribed):
+		return c.Deps().SendError(ctx, cmdCtx.Room, fmt.Sprintf(adapter.ErrAlarmFilterNotSubscribed, channel.Name, memberName))
+	case errors.Is(err, notification.ErrInvalidKeywordFilter):
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmFilterInvalid)
+	case err != nil:
+		c.Deps().Logger.Error("Failed to set alarm filter",
+			slog.String("channel", channel.Name),
+			slog.Any("error", err),
+		)
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmFilterFailed)
+	}
+
+	return c.Deps().SendMessage(ctx, cmdCtx.Room, c.Deps().Formatter.FormatAlarmFilter(channel.Name, filter, true))
+}
+
+// handleDigest: 방의 알림 요약 방식을 조회하거나 바꿉니다. (mode 없으면 조회)
+func (c *AlarmCommand) handleDigest(ctx context.Context, cmdCtx *domain.CommandContext, params map[string]any) error {
+	raw, hasMode := params["mode"].(string)
+	if !hasMode {
+		mode, err := c.Deps().Alarm.GetRoomDigestMode(ctx, cmdCtx.Room)
+		if err != nil {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmDigestFailed)
+		}
+		return c.Deps().SendMessage(ctx, cmdCtx.Room, digestModeMessage(mode))
+	}
+
+	mode, err := notification.ParseDigestMode(raw)
+	if err != nil {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmDigestInvalid)
+	}
+
+	c.Deps().Logger.Info("Alarm digest mode requested",
+		slog.String("room", cmdCtx.Room),
+		slog.String("mode", string(mode)),
+	)
+
+	if err := c.Deps().Alarm.SetRoomDigestMode(ctx, cmdCtx.Room, mode); err != nil {
+		c.Deps().Logger.Error("Failed to set alarm digest mode", slog.Any("error", err))
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmDigestFailed)
+	}
+	return c.Deps().SendMessage(ctx, cmdCtx.Room, digestModeMessage(mode))
+}
+
+func digestModeMessage(mode notification.DigestMode) string {
+	switch mode {
+	case notification.DigestCycle:
+		return adapter.MsgAlarmDigestCycle
+	case notification.DigestHourly:
+		return adapter.MsgAlarmDigestHourly
+	default:
+		return adapter.MsgAlarmDigestOff
+	}
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/adapter"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// clipListLimit: 한 번에 보여주는 최대 클립 수
+const clipListLimit = 10
+
+// ClipCommand: 구독 멤버를 다루는 인기 클립 채널의 최근 클립을 보여주고, 방별로 켜고 끄는 명령어
+type ClipCommand struct {
+	BaseCommand
+}
+
+// NewClipCommand: 새로운 ClipCommand 인스턴스를 생성합니다.
+func NewClipCommand(deps *Dependencies) *ClipCommand {
+	return &ClipCommand{BaseCommand: NewBaseCommand(deps)}
+}
+
+// Name: 명령어 이름을 반환합니다.
+func (c *ClipCommand) Name() string {
+	return string(domain.CommandClip)
+}
+
+// Description: 명령어 설명을 반환합니다.
+func (c *ClipCommand) Description() string {
+	return "구독 멤버 최근 클립 조회"
+}
+
+// Execute: 켜기/끄기 요청이면 방 설정을 바꾸고, 아니면 지정 멤버 또는 방의 구독 멤버 클립 목록을 전송합니다.
+func (c *ClipCommand) Execute(ctx context.Context, cmdCtx *domain.CommandContext, params map[string]any) error {
+	if err := c.EnsureBaseDeps(); err != nil {
+		return err
+	}
+
+	clips := c.Deps().Clips
+	if clips == nil {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipUnavailable)
+	}
+
+	switch action, _ := params["action"].(string); action {
+	case "on":
+		added, err := clips.EnableRoom(ctx, cmdCtx.Room)
+		if err != nil {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipQueryFailed)
+		}
+		if !added {
+			return c.Deps().SendMessage(ctx, cmdCtx.Room, adapter.MsgClipAlreadyEnabled)
+		}
+		return c.Deps().SendMessage(ctx, cmdCtx.Room, adapter.MsgClipEnabled)
+	case "off":
+		removed, err := clips.DisableRoom(ctx, cmdCtx.Room)
+		if err != nil {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipQueryFailed)
+		}
+		if !removed {
+			return c.Deps().SendMessage(ctx, cmdCtx.Room, adapter.MsgClipAlreadyDisabled)
+		}
+		return c.Deps().SendMessage(ctx, cmdCtx.Room, adapter.MsgClipDisabled)
+	}
+
+	if !clips.RoomEnabled(ctx, cmdCtx.Room) {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipRoomNotEnabled)
+	}
+
+	subject := adapter.MsgClipSubjectSubscribed
+	var talentIDs []string
+	if memberName, _ := params["member"].(string); memberName != "" {
+		channel, err := FindActiveMemberOrError(ctx, c.Deps(), cmdCtx.Room, memberName)
+		if err != nil {
+			return err
+		}
+		subject = channel.GetDisplayName()
+		talentIDs = []string{channel.ID}
+	} else {
+		if c.Deps().Alarm == nil {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrAlarmServiceNotInitialized)
+		}
+		channelIDs, err := c.Deps().Alarm.GetRoomChannels(ctx, cmdCtx.Room)
+		if err != nil {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipQueryFailed)
+		}
+		if len(channelIDs) == 0 {
+			return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipNoSubscriptions)
+		}
+		talentIDs = channelIDs
+	}
+
+	recent, err := clips.RecentClips(ctx, talentIDs, clipListLimit)
+	if err != nil {
+		c.Deps().Logger.Warn("Failed to load recent clips",
+			slog.String("room", cmdCtx.Room),
+			slog.Any("error", err),
+		)
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrClipQueryFailed)
+	}
+
+	return c.Deps().SendMessage(ctx, cmdCtx.Room, c.Deps().Formatter.ClipList(subject, recent))
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/adapter"
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/clip"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/matcher"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/schedulequery"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 )
 
@@ -34,7 +38,7 @@ type Dispatcher interface {
 
 // Dependencies: 명령어 실행에 필요한 외부 서비스(Holodex, 캐시 등) 및 유틸리티 의존성 모음
 type Dependencies struct {
-	Holodex          *holodex.Service
+	Holodex          holodex.StreamProvider
 	Cache            *cache.Service
 	Alarm            *notification.AlarmService
 	Matcher          *matcher.MemberMatcher
@@ -42,6 +46,10 @@ type Dependencies struct {
 	StatsRepo        *youtube.StatsRepository
 	MembersData      domain.MemberDataProvider
 	Formatter        *adapter.ResponseFormatter
+	TitleTranslator  *translation.Service   // nil이면 제목 번역 비활성
+	Rooms            *room.Service          // nil이면 떠나기 명령 비활성
+	Clips            *clip.Service          // nil이면 클립 명령 비활성
+	ScheduleQuery    *schedulequery.Service // nil이면 자연어 일정 질문 비활성
 	SendMessage      func(ctx context.Context, room, message string) error
 	SendImage        func(ctx context.Context, room, imageBase64 string) error
 	SendError        func(ctx context.Context, room, message string) error
@@ -44,3 +44,8 @@ func FindActiveMemberOrError(ctx context.Context, deps *Dependencies, room, memb
 
 	return channel, nil
 }
+
+// streamFormatter: 방에 제목 번역이 켜져 있으면 방송 제목 번역을 적용한 포맷터를 반환합니다.
+func streamFormatter(ctx context.Context, deps *Dependencies, room string, streams []*domain.Stream) *adapter.ResponseFormatter {
+	return deps.Formatter.WithTitleGlosses(deps.TitleTranslator.Glosses(ctx, room, streams))
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/adapter"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+)
+
+// LeaveCommand: 방의 알람과 이용 기록을 정리하고 봇 사용을 종료하는 명령어
+type LeaveCommand struct {
+	BaseCommand
+}
+
+// NewLeaveCommand: 새로운 LeaveCommand 인스턴스를 생성합니다.
+func NewLeaveCommand(deps *Dependencies) *LeaveCommand {
+	return &LeaveCommand{BaseCommand: NewBaseCommand(deps)}
+}
+
+// Name: 명령어 이름을 반환합니다.
+func (c *LeaveCommand) Name() string {
+	return string(domain.CommandLeave)
+}
+
+// Description: 명령어 설명을 반환합니다.
+func (c *LeaveCommand) Description() string {
+	return "방 정리 후 봇 사용 종료"
+}
+
+// Execute: "확인"이 붙지 않았으면 안내만 보내고, 확인된 요청이면 떠나기 흐름을 실행합니다.
+func (c *LeaveCommand) Execute(ctx context.Context, cmdCtx *domain.CommandContext, params map[string]any) error {
+	if err := c.EnsureBaseDeps(); err != nil {
+		return err
+	}
+
+	if c.Deps().Rooms == nil {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrRoomLeaveUnavailable)
+	}
+
+	if confirmed, _ := params["confirm"].(bool); !confirmed {
+		return c.Deps().SendMessage(ctx, cmdCtx.Room, adapter.MsgRoomLeaveConfirm)
+	}
+
+	departure, err := c.Deps().Rooms.Leave(ctx, room.LeaveRequest{
+		RoomID:      cmdCtx.Room,
+		RoomName:    cmdCtx.RoomName,
+		Reason:      room.ReasonCommand,
+		RequestedBy: cmdCtx.UserName,
+	})
+	if err != nil && departure == nil {
+		c.Deps().Logger.Error("Failed to leave room",
+			slog.String("room", cmdCtx.Room),
+			slog.Any("error", err),
+		)
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrRoomLeaveFailed)
+	}
+
+	// 기록 저장만 실패한 경우에도 정리는 끝났으므로 작별 메시지를 보낸다.
+	return c.Deps().SendMessage(ctx, cmdCtx.Room, fmt.Sprintf(adapter.MsgRoomLeaveDone, departure.Stats.Alarms))
+}
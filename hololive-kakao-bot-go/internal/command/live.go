@@ -59,7 +59,7 @@ func (c *LiveCommand) Execute(ctx context.Context, cmdCtx *domain.CommandContext
 			return c.Deps().SendMessage(ctx, cmdCtx.Room, fmt.Sprintf(adapter.MsgMemberNotLive, channel.Name))
 		}
 
-		message := c.Deps().Formatter.FormatLiveStreams(memberStreams)
+		message := streamFormatter(ctx, c.Deps(), cmdCtx.Room, memberStreams).FormatLiveStreams(memberStreams)
 		return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
 	}
 
@@ -68,7 +68,7 @@ func (c *LiveCommand) Execute(ctx context.Context, cmdCtx *domain.CommandContext
 		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrLiveStreamQueryFailed)
 	}
 
-	message := c.Deps().Formatter.FormatLiveStreams(streams)
+	message := streamFormatter(ctx, c.Deps(), cmdCtx.Room, streams).FormatLiveStreams(streams)
 	return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
 }
 
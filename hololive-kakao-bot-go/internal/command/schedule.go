@@ -74,7 +74,7 @@ func (c *ScheduleCommand) Execute(ctx context.Context, cmdCtx *domain.CommandCon
 		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrScheduleQueryFailed)
 	}
 
-	message := c.Deps().Formatter.ChannelSchedule(channel, streams, days)
+	message := streamFormatter(ctx, c.Deps(), cmdCtx.Room, streams).ChannelSchedule(channel, streams, days)
 	return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
 }
 
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/adapter"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/schedulequery"
+)
+
+// ScheduleQueryCommand: "페코라 이번주 언제 방송해?" 같은 자연어 질문을 해석해 기존 일정 조회로 답하는 명령어
+type ScheduleQueryCommand struct {
+	BaseCommand
+}
+
+// NewScheduleQueryCommand: NewScheduleQueryCommand 인스턴스를 생성합니다.
+func NewScheduleQueryCommand(deps *Dependencies) *ScheduleQueryCommand {
+	return &ScheduleQueryCommand{BaseCommand: NewBaseCommand(deps)}
+}
+
+// Name: 명령어의 고유 식별자('schedule_query')를 반환합니다.
+func (c *ScheduleQueryCommand) Name() string {
+	return string(domain.CommandScheduleQuery)
+}
+
+// Description: 명령어에 대한 사용자용 설명('자연어 일정 질문')을 반환합니다.
+func (c *ScheduleQueryCommand) Description() string {
+	return "자연어 일정 질문"
+}
+
+// Execute: 질문에서 멤버와 날짜 범위를 뽑아 해당 기간의 방송 일정만 전송합니다.
+func (c *ScheduleQueryCommand) Execute(ctx context.Context, cmdCtx *domain.CommandContext, params map[string]any) error {
+	if err := c.ensureDeps(); err != nil {
+		return err
+	}
+
+	text, _ := params["query"].(string)
+	query, ok := c.Deps().ScheduleQuery.Parse(ctx, text)
+	if !ok {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrScheduleQueryNotUnderstood)
+	}
+
+	channel, err := FindActiveMemberOrError(ctx, c.Deps(), cmdCtx.Room, query.Member)
+	if err != nil || channel == nil {
+		return err
+	}
+
+	// Holodex는 "지금부터 N시간" 단위로만 조회하므로 범위 끝까지 받아 온 뒤 시작일 이전 방송을 걸러냄
+	days := query.Days(c.Deps().ScheduleQuery.Today())
+	streams, err := c.Deps().Holodex.GetChannelSchedule(ctx, channel.ID, days*24, true)
+	if err != nil {
+		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrScheduleQueryFailed)
+	}
+	streams = filterStreamsInRange(streams, query, time.Now())
+
+	if c.Deps().Logger != nil {
+		c.Deps().Logger.Debug("Schedule query resolved",
+			slog.String("member", query.Member),
+			slog.String("channel_id", channel.ID),
+			slog.Time("start", query.Start),
+			slog.Time("end", query.End),
+			slog.Int("streams", len(streams)),
+		)
+	}
+
+	message := streamFormatter(ctx, c.Deps(), cmdCtx.Room, streams).ChannelSchedule(channel, streams, days)
+	return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
+}
+
+func (c *ScheduleQueryCommand) ensureDeps() error {
+	if err := c.EnsureBaseDeps(); err != nil {
+		return err
+	}
+
+	if c.Deps().ScheduleQuery == nil || c.Deps().Matcher == nil || c.Deps().Holodex == nil || c.Deps().Formatter == nil {
+		return fmt.Errorf("schedule query command services not configured")
+	}
+
+	return nil
+}
+
+// filterStreamsInRange: 예정 시각이 질문한 날짜 범위 안인 방송만 남깁니다.
+// 진행 중인 방송은 범위가 오늘을 포함할 때만 남깁니다.
+func filterStreamsInRange(streams []*domain.Stream, query schedulequery.Query, now time.Time) []*domain.Stream {
+	filtered := make([]*domain.Stream, 0, len(streams))
+	for _, stream := range streams {
+		if stream == nil {
+			continue
+		}
+		if stream.IsLive() {
+			if query.Contains(now) {
+				filtered = append(filtered, stream)
+			}
+			continue
+		}
+		if stream.StartScheduled != nil && query.Contains(*stream.StartScheduled) {
+			filtered = append(filtered, stream)
+		}
+	}
+	return filtered
+}
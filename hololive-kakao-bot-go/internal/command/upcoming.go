@@ -76,7 +76,7 @@ func (c *UpcomingCommand) Execute(ctx context.Context, cmdCtx *domain.CommandCon
 			return c.Deps().SendMessage(ctx, cmdCtx.Room, fmt.Sprintf(adapter.MsgMemberNoUpcoming, channel.Name, hours))
 		}
 
-		message := c.Deps().Formatter.UpcomingStreams(memberStreams, hours)
+		message := streamFormatter(ctx, c.Deps(), cmdCtx.Room, memberStreams).UpcomingStreams(memberStreams, hours)
 		return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
 	}
 
@@ -86,7 +86,7 @@ func (c *UpcomingCommand) Execute(ctx context.Context, cmdCtx *domain.CommandCon
 		return c.Deps().SendError(ctx, cmdCtx.Room, adapter.ErrUpcomingStreamQueryFailed)
 	}
 
-	message := c.Deps().Formatter.UpcomingStreams(streams, hours)
+	message := streamFormatter(ctx, c.Deps(), cmdCtx.Room, streams).UpcomingStreams(streams, hours)
 	return c.Deps().SendMessage(ctx, cmdCtx.Room, message)
 }
 
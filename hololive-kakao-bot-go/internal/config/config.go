@@ -24,9 +24,15 @@ type Config struct {
 	Kakao        KakaoConfig
 	Holodex      HolodexConfig
 	YouTube      YouTubeConfig
+	Twitch       TwitchConfig
+	Translation  TranslationConfig
+	Schedule     ScheduleQueryConfig
+	Clip         ClipConfig
 	Valkey       ValkeyConfig
 	Postgres     PostgresConfig
 	Notification NotificationConfig
+	Room         RoomConfig
+	Latency      LatencyConfig
 	Logging      LoggingConfig
 	Bot          BotConfig
 	Services     ServicesConfig
@@ -178,6 +184,10 @@ func (c *KakaoConfig) IsRoomAllowed(roomName, chatID string) bool {
 // HolodexConfig: Holodex API 키 및 호출 관련 설정
 type HolodexConfig struct {
 	APIKeys []string
+	// DailyBudget: 하루(KST) 최대 Holodex 호출 수 (0 이하면 제한 없이 사용량만 집계)
+	DailyBudget int
+	// BudgetReserveRatio: 남은 예산이 이 비율 이하가 되면 알람·라이브 감지 외 호출을 생략
+	BudgetReserveRatio float64
 }
 
 // YouTubeConfig: YouTube Data API 키 및 Quota 관리 설정
@@ -186,6 +196,47 @@ type YouTubeConfig struct {
 	EnableQuotaBuilding bool
 }
 
+// TwitchConfig: Twitch Helix API 자격 증명 및 Twitch 방송 멤버 매핑 설정
+type TwitchConfig struct {
+	ClientID     string
+	ClientSecret string
+	// Channels: YouTube 채널 ID → Twitch 로그인 이름
+	Channels map[string]string
+}
+
+// Enabled: Twitch 연동에 필요한 자격 증명과 대상 채널이 모두 설정되었는지 확인합니다.
+func (c TwitchConfig) Enabled() bool {
+	return c.ClientID != "" && c.ClientSecret != "" && len(c.Channels) > 0
+}
+
+// TranslationConfig: 방송 제목 한국어 번역(mcp-llm-server-go 경유) 설정
+type TranslationConfig struct {
+	LLMBaseURL string // mcp-llm-server-go HTTP 주소 (비어 있으면 번역 비활성)
+	APIKey     string
+	DailyLimit int // 하루 최대 LLM 번역 호출 수 (0 이하면 제한 없음)
+}
+
+// Enabled: 번역 서버 주소가 설정되었는지 확인합니다.
+func (c TranslationConfig) Enabled() bool {
+	return c.LLMBaseURL != ""
+}
+
+// ScheduleQueryConfig: 자연어 일정 질문("페코라 이번주 언제 방송해?") 설정
+type ScheduleQueryConfig struct {
+	Enabled    bool   // false면 자연어 일정 질문을 무시 (알 수 없는 명령어로 처리)
+	LLMBaseURL string // mcp-llm-server-go HTTP 주소 (비어 있으면 규칙 기반 해석만 사용)
+	APIKey     string
+}
+
+// ClipConfig: 구독 멤버를 다루는 클립(키리누키) 채널 추적 설정
+type ClipConfig struct {
+	Enabled         bool          // false면 !클립 명령과 백그라운드 갱신 모두 비활성
+	Langs           []string      // Holodex lang 필터 (예: ko,ja)
+	TopChannels     int           // 멤버별로 목록에 포함할 인기 클립 채널 수
+	LookbackDays    int           // 인기 채널 집계에 사용하는 최근 클립 기간
+	RefreshInterval time.Duration // 클립을 켠 방의 구독 멤버 클립 갱신 주기
+}
+
 // ValkeyConfig: 데이터 캐싱 용도의 Redis(Valkey) 연결 설정
 type ValkeyConfig struct {
 	Host       string
@@ -209,6 +260,25 @@ type PostgresConfig struct {
 type NotificationConfig struct {
 	AdvanceMinutes []int
 	CheckInterval  time.Duration
+	// SchedulePrefetch: 구독 채널 일정을 다음 방송 시각에 맞춰 미리 갱신 (알람 체크 시 Holodex 호출 몰림 완화)
+	SchedulePrefetch bool
+}
+
+// RoomConfig: 방 활동 추적 및 장기 미사용 방 정리(떠나기) 설정
+type RoomConfig struct {
+	InactiveDays  int           // 마지막 메시지 이후 이 일수가 지나면 자동으로 떠나기 처리 (0 이하면 자동 정리 비활성)
+	SweepInterval time.Duration // 미사용 방 검사 주기 (알람 휴면 검사도 같은 주기로 실행)
+
+	AlarmDormantDays int    // 전송 실패가 이 일수 이상 이어진 방의 알람 구독을 휴면 처리 (0 이하면 비활성)
+	AdminRoom        string // 휴면 전환/복귀 알림을 보낼 관리자 방 ID (비어 있으면 활동 로그에만 기록)
+}
+
+// LatencyConfig: 명령어 응답 지연(수신→첫 응답) 기록과 일별 분위수 집계 설정
+type LatencyConfig struct {
+	Enabled        bool
+	SLA            time.Duration // 이 시간을 넘긴 응답을 느린 응답으로 집계 (카카오톡 체감 한계)
+	RollupAtMinute int           // 매일 전날 집계를 실행하는 시각 (자정 이후 분)
+	RetentionDays  int           // 원본 샘플 보관 일수
 }
 
 // LoggingConfig: 애플리케이션 로그 설정 (레벨, 디렉토리, 로테이션 정책)
@@ -280,12 +350,37 @@ func Load() (*Config, error) {
 			ACLEnabled: getEnvBool("KAKAO_ACL_ENABLED", true),
 		},
 		Holodex: HolodexConfig{
-			APIKeys: collectAPIKeys("HOLODEX_API_KEY_"),
+			APIKeys:            collectAPIKeys("HOLODEX_API_KEY_"),
+			DailyBudget:        getEnvInt("HOLODEX_DAILY_BUDGET", 0),
+			BudgetReserveRatio: getEnvFloat("HOLODEX_BUDGET_RESERVE_RATIO", 0.2),
 		},
 		YouTube: YouTubeConfig{
 			APIKey:              getEnv("YOUTUBE_API_KEY", ""),
 			EnableQuotaBuilding: getEnvBool("YOUTUBE_ENABLE_QUOTA_BUILDING", false),
 		},
+		Twitch: TwitchConfig{
+			ClientID:     util.TrimSpace(getEnv("TWITCH_CLIENT_ID", "")),
+			ClientSecret: util.TrimSpace(getEnv("TWITCH_CLIENT_SECRET", "")),
+			Channels:     parseKeyValuePairs(getEnv("TWITCH_CHANNELS", "")),
+		},
+		Translation: TranslationConfig{
+			LLMBaseURL: strings.TrimRight(util.TrimSpace(getEnv("TITLE_TRANSLATION_LLM_URL", "")), "/"),
+			APIKey:     util.TrimSpace(getEnv("TITLE_TRANSLATION_API_KEY", "")),
+			DailyLimit: getEnvInt("TITLE_TRANSLATION_DAILY_LIMIT", 300),
+		},
+		// 번역과 같은 LLM 서버를 쓰는 경우가 대부분이므로 주소/키를 따로 주지 않으면 번역 설정을 재사용
+		Schedule: ScheduleQueryConfig{
+			Enabled:    getEnvBool("SCHEDULE_QUERY_ENABLED", false),
+			LLMBaseURL: strings.TrimRight(util.TrimSpace(getEnv("SCHEDULE_QUERY_LLM_URL", getEnv("TITLE_TRANSLATION_LLM_URL", ""))), "/"),
+			APIKey:     util.TrimSpace(getEnv("SCHEDULE_QUERY_API_KEY", getEnv("TITLE_TRANSLATION_API_KEY", ""))),
+		},
+		Clip: ClipConfig{
+			Enabled:         getEnvBool("CLIP_TRACKING_ENABLED", false),
+			Langs:           parseCommaSeparated(getEnv("CLIP_LANGS", "ko,ja")),
+			TopChannels:     getEnvInt("CLIP_TOP_CHANNELS", 5),
+			LookbackDays:    getEnvInt("CLIP_LOOKBACK_DAYS", 14),
+			RefreshInterval: time.Duration(getEnvInt("CLIP_REFRESH_INTERVAL_MINUTES", 60)) * time.Minute,
+		},
 		Valkey: ValkeyConfig{
 			Host:       getEnv("CACHE_HOST", "localhost"),
 			Port:       getEnvInt("CACHE_PORT", 6379),
@@ -304,6 +399,21 @@ func Load() (*Config, error) {
 		Notification: NotificationConfig{
 			AdvanceMinutes: parseIntList(getEnv("NOTIFICATION_ADVANCE_MINUTES", "5,15,30")),
 			CheckInterval:  time.Duration(getEnvInt("CHECK_INTERVAL_SECONDS", 60)) * time.Second,
+
+			SchedulePrefetch: getEnvBool("SCHEDULE_PREFETCH_ENABLED", true),
+		},
+		Room: RoomConfig{
+			InactiveDays:  getEnvInt("ROOM_INACTIVE_DAYS", 30),
+			SweepInterval: time.Duration(getEnvInt("ROOM_SWEEP_INTERVAL_MINUTES", 60)) * time.Minute,
+
+			AlarmDormantDays: getEnvInt("ALARM_DORMANT_DAYS", 14),
+			AdminRoom:        getEnv("ALARM_HYGIENE_ADMIN_ROOM", ""),
+		},
+		Latency: LatencyConfig{
+			Enabled:        getEnvBool("LATENCY_SLA_ENABLED", true),
+			SLA:            time.Duration(getEnvInt("LATENCY_SLA_MS", 5000)) * time.Millisecond,
+			RollupAtMinute: getEnvInt("LATENCY_ROLLUP_AT_MINUTE", 10),
+			RetentionDays:  getEnvInt("LATENCY_SAMPLE_RETENTION_DAYS", 14),
 		},
 		Logging: LoggingConfig{
 			Level:      getEnv("LOG_LEVEL", "info"),
@@ -403,6 +513,20 @@ func parseCommaSeparated(value string) []string {
 	return result
 }
 
+// parseKeyValuePairs: "key=value,key2=value2" 형식을 맵으로 변환합니다. 형식이 잘못된 항목은 무시한다.
+func parseKeyValuePairs(value string) map[string]string {
+	result := make(map[string]string)
+	for _, part := range parseCommaSeparated(value) {
+		key, val, ok := strings.Cut(part, "=")
+		key, val = util.TrimSpace(key), util.TrimSpace(val)
+		if !ok || key == "" || val == "" {
+			continue
+		}
+		result[key] = val
+	}
+	return result
+}
+
 func parseIntList(value string) []int {
 	if value == "" {
 		return []int{}
@@ -117,3 +117,11 @@ func TestKakaoConfig_SnapshotACL_ReturnsCopy(t *testing.T) {
 		t.Fatalf("expected SnapshotACL to return a copy, got: %v", rooms2)
 	}
 }
+
+func TestParseKeyValuePairs(t *testing.T) {
+	got := parseKeyValuePairs(" UCaaa = pekora ,invalid,UCbbb=,=miko,UCccc=koyori")
+	expected := map[string]string{"UCaaa": "pekora", "UCccc": "koyori"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("parseKeyValuePairs() = %v, expected %v", got, expected)
+	}
+}
@@ -11,6 +11,7 @@ var CacheTTL = struct {
 	ChannelSearch    time.Duration
 	NextStreamInfo   time.Duration
 	NotificationSent time.Duration
+	RecentClips      time.Duration
 }{
 	LiveStreams:      5 * time.Minute,  // 5분 - 라이브 스트림 목록
 	UpcomingStreams:  5 * time.Minute,  // 5분 - 예정 스트림 목록
@@ -19,6 +20,50 @@ var CacheTTL = struct {
 	ChannelSearch:    10 * time.Minute, // 10분 - 채널 검색 결과
 	NextStreamInfo:   60 * time.Minute, // 1시간 - 다음 방송 정보
 	NotificationSent: 24 * time.Hour,   // 24시간 - 알림 발송 기록
+	RecentClips:      30 * time.Minute, // 30분 - 멤버별 최근 클립 목록
+}
+
+// SchedulePrefetch: 구독 채널 일정 프리페치 주기 설정입니다.
+// 다음 방송이 가까운 채널일수록 자주 갱신하고, 캐시 TTL은 다음 갱신까지 버티도록 TTLMargin만큼 길게 잡는다.
+var SchedulePrefetch = struct {
+	Tick            time.Duration // 갱신할 채널을 고르는 주기
+	MaxPerTick      int           // 한 번에 갱신하는 최대 채널 수 (넘치면 다음 주기로 미룸)
+	NearWindow      time.Duration // 다음 방송이 이 안이면 NearInterval
+	NearInterval    time.Duration
+	SoonWindow      time.Duration // 다음 방송이 이 안이면 SoonInterval
+	SoonInterval    time.Duration
+	DefaultInterval time.Duration // 그보다 먼 예정 방송만 있을 때 (조회 실패 후 재시도 간격 포함)
+	IdleInterval    time.Duration // 예정 방송이 없을 때
+	TTLMargin       time.Duration
+}{
+	Tick:            15 * time.Second,
+	MaxPerTick:      10,
+	NearWindow:      30 * time.Minute,
+	NearInterval:    1 * time.Minute,
+	SoonWindow:      3 * time.Hour,
+	SoonInterval:    3 * time.Minute,
+	DefaultInterval: 5 * time.Minute,
+	IdleInterval:    10 * time.Minute,
+	TTLMargin:       1 * time.Minute,
+}
+
+// EdgeCacheTTL: 공개 조회 API 한 개의 Cache-Control 시간 설정입니다.
+type EdgeCacheTTL struct {
+	MaxAge               time.Duration // 브라우저 캐시
+	SMaxAge              time.Duration // Cloudflare 등 공유 캐시
+	StaleWhileRevalidate time.Duration // 만료 후 재검증 중 이전 응답 제공 허용 시간
+}
+
+// EdgeCache: 공개 조회 API(/api/public)의 엔드포인트별 캐시 시간입니다.
+// 공유 캐시 시간은 내부 캐시 TTL(CacheTTL 등)을 넘지 않게 맞춰, 엣지가 오래된 내부 캐시보다 더 늦게 갱신되지 않도록 한다.
+var EdgeCache = struct {
+	LiveStreams     EdgeCacheTTL
+	UpcomingStreams EdgeCacheTTL
+	ChannelStats    EdgeCacheTTL
+}{
+	LiveStreams:     EdgeCacheTTL{MaxAge: 30 * time.Second, SMaxAge: time.Minute, StaleWhileRevalidate: time.Minute},
+	UpcomingStreams: EdgeCacheTTL{MaxAge: time.Minute, SMaxAge: 5 * time.Minute, StaleWhileRevalidate: 2 * time.Minute},
+	ChannelStats:    EdgeCacheTTL{MaxAge: 5 * time.Minute, SMaxAge: 10 * time.Minute, StaleWhileRevalidate: 5 * time.Minute},
 }
 
 // MemberCacheDefaults: 패키지 변수다.
@@ -107,6 +152,62 @@ var APIConfig = struct {
 	MaxRetryAttempts: 3,
 }
 
+// TwitchAPIConfig: Twitch Helix API 호출 설정입니다.
+var TwitchAPIConfig = struct {
+	HelixBaseURL   string
+	TokenURL       string
+	ChannelBaseURL string
+	Timeout        time.Duration
+	TokenRefresh   time.Duration // 만료 전 토큰 재발급 여유 시간
+}{
+	HelixBaseURL:   "https://api.twitch.tv/helix",
+	TokenURL:       "https://id.twitch.tv/oauth2/token",
+	ChannelBaseURL: "https://www.twitch.tv/",
+	Timeout:        10 * time.Second,
+	TokenRefresh:   5 * time.Minute,
+}
+
+// TitleTranslationConfig: 방송 제목 번역 호출/캐시 설정입니다.
+var TitleTranslationConfig = struct {
+	RequestTimeout  time.Duration // LLM 서버 단일 호출 타임아웃
+	MessageBudget   time.Duration // 메시지 한 건을 만들 때 번역에 기다리는 최대 시간 (초과 시 원문)
+	MaxPerMessage   int           // 메시지 한 건에서 새로 번역하는 최대 제목 수
+	CacheTTL        time.Duration // 번역 결과 캐시 (영상 ID 기준)
+	FailureCacheTTL time.Duration // 실패/빈 응답 재시도 억제 시간
+	Task            string        // mcp-llm-server-go 라우팅 작업명
+}{
+	RequestTimeout:  8 * time.Second,
+	MessageBudget:   4 * time.Second,
+	MaxPerMessage:   10,
+	CacheTTL:        30 * 24 * time.Hour,
+	FailureCacheTTL: 30 * time.Minute,
+	Task:            "title_translation",
+}
+
+// ScheduleQueryConfig: 자연어 일정 질문 해석 설정입니다.
+var ScheduleQueryConfig = struct {
+	RequestTimeout time.Duration // LLM 서버 해석 호출 타임아웃 (초과 시 규칙 기반 해석)
+	MaxSpanDays    int           // 한 번에 조회하는 최대 기간 (!일정 상한과 동일)
+	DefaultDays    int           // 기간 표현이 없을 때의 조회 기간
+}{
+	RequestTimeout: 6 * time.Second,
+	MaxSpanDays:    30,
+	DefaultDays:    7,
+}
+
+// BroadcastConfig: 관리자 공지 일괄 전송 설정입니다.
+var BroadcastConfig = struct {
+	SendInterval     time.Duration // 방 사이 전송 간격 (카카오톡 도배 제한 회피)
+	SendTimeout      time.Duration // 방 하나에 대한 Iris 전송 타임아웃
+	MaxMessageLength int           // 공지 본문 최대 글자 수 (rune)
+	HistoryLimit     int           // 전송 기록 조회 기본 개수
+}{
+	SendInterval:     1500 * time.Millisecond,
+	SendTimeout:      10 * time.Second,
+	MaxMessageLength: 2000,
+	HistoryLimit:     50,
+}
+
 // HolodexTransportConfig: Holodex HTTP Transport 설정입니다.
 // 동시 요청 시 커넥션 풀 고갈 방지를 위해 디폴트(MaxIdleConnsPerHost=2)보다 높게 설정한다.
 var HolodexTransportConfig = struct {
@@ -119,6 +220,15 @@ var HolodexTransportConfig = struct {
 	IdleConnTimeout:     30 * time.Second,
 }
 
+// HolodexQuota: Holodex 일일 호출 예산 집계와 예산 부족 시 대체 응답 설정입니다.
+var HolodexQuota = struct {
+	KeyTTL   time.Duration // KST 날짜별 사용량 키 보관 기간
+	StaleTTL time.Duration // 예산 부족 시 대신 내보낼 마지막 일정 사본 보관 기간
+}{
+	KeyTTL:   48 * time.Hour,
+	StaleTTL: 24 * time.Hour,
+}
+
 // OfficialScheduleConfig: 패키지 변수다.
 var OfficialScheduleConfig = struct {
 	BaseURL     string
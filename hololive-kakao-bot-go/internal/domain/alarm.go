@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Alarm: 특정 채팅방(user)이 특정 멤버(channel)의 방송 알림을 구독한 정보
 type Alarm struct {
@@ -30,6 +33,37 @@ func NewAlarm(roomID, userID, channelID, memberName string) *Alarm {
 	}
 }
 
+// AlarmKeywordFilter: 알림 구독(방·사용자·채널)별 방송 제목 키워드 필터
+// Include가 있으면 그중 하나라도 제목에 있어야 하고, Exclude는 하나라도 있으면 알림을 보내지 않는다. (대소문자 무시)
+type AlarmKeywordFilter struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// IsEmpty: 설정된 키워드가 없는지 여부 (빈 필터는 모든 방송을 통과시킨다)
+func (f AlarmKeywordFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Matches: 방송 제목이 필터를 통과하는지 확인합니다.
+func (f AlarmKeywordFilter) Matches(title string) bool {
+	title = strings.ToLower(title)
+	for _, keyword := range f.Exclude {
+		if strings.Contains(title, strings.ToLower(keyword)) {
+			return false
+		}
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, keyword := range f.Include {
+		if strings.Contains(title, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
 // AlarmNotification: 방송 시작 임박 등의 이벤트로 인해 발송될 알림 메시지 정보
 // 여러 사용자(Users)에게 동일한 내용이 전송될 수 있다.
 type AlarmNotification struct {
@@ -39,6 +73,10 @@ type AlarmNotification struct {
 	MinutesUntil          int      `json:"minutes_until"`
 	Users                 []string `json:"users"`
 	ScheduleChangeMessage string   `json:"schedule_change_message,omitempty"`
+	// CustomOnly: 사용자별 알림 시점 설정으로만 발송되는 알림 여부 (기본 알림 발송 기록을 남기지 않음)
+	CustomOnly bool `json:"custom_only,omitempty"`
+	// TargetMinute: 알림을 발생시킨 알림 시점(분). 발송 기록 키에 사용된다.
+	TargetMinute int `json:"target_minute,omitempty"`
 }
 
 // NewAlarmNotification: 알림 발송을 위한 새로운 Notification 객체를 생성합니다.
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Clip: 클립(키리누키) 채널이 올린, 특정 멤버를 다루는 영상 정보
+type Clip struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	ClipChannelID   string    `json:"clipChannelId"`
+	ClipChannelName string    `json:"clipChannelName"`
+	TalentChannelID string    `json:"talentChannelId"` // 클립이 다루는 멤버 채널 ID (조회 기준)
+	PublishedAt     time.Time `json:"publishedAt"`
+	Duration        int       `json:"duration,omitempty"` // 초 단위
+	Link            string    `json:"link"`
+}
@@ -22,6 +22,10 @@ const (
 	CommandAlarmList CommandType = "alarm_list"
 	// CommandAlarmClear: 모든 알림 초기화 명령어
 	CommandAlarmClear CommandType = "alarm_clear"
+	// CommandAlarmFilter: 알림 구독별 방송 제목 키워드 필터 조회/설정 명령어
+	CommandAlarmFilter CommandType = "alarm_filter"
+	// CommandAlarmDigest: 방별 알림 요약 모드(체크마다 한 통/매시 한 통) 조회/설정 명령어
+	CommandAlarmDigest CommandType = "alarm_digest"
 	// CommandAlarmInvalid: 알림 관련 불완전하거나 유효하지 않은 명령어
 	CommandAlarmInvalid CommandType = "alarm_invalid"
 	// CommandMemberInfo: 멤버 프로필 정보 조회 명령어
@@ -30,6 +34,12 @@ const (
 	CommandStats CommandType = "stats"
 	// CommandSubscriber: 특정 멤버의 구독자 수 조회 명령어
 	CommandSubscriber CommandType = "subscriber"
+	// CommandLeave: 방의 알람/기록을 정리하고 봇 사용을 종료하는 명령어
+	CommandLeave CommandType = "leave"
+	// CommandClip: 구독 멤버의 인기 클립 채널 최근 클립 조회 및 방별 켜기/끄기 명령어
+	CommandClip CommandType = "clip"
+	// CommandScheduleQuery: 자연어 일정 질문 (예: "!페코라 이번주 언제 방송해?")
+	CommandScheduleQuery CommandType = "schedule_query"
 	// CommandUnknown: 인식할 수 없는 명령어
 	CommandUnknown CommandType = "unknown"
 )
@@ -42,8 +52,8 @@ func (c CommandType) String() string {
 func (c CommandType) IsValid() bool {
 	switch c {
 	case CommandLive, CommandUpcoming, CommandSchedule, CommandHelp,
-		CommandAlarmAdd, CommandAlarmRemove, CommandAlarmList, CommandAlarmClear, CommandAlarmInvalid,
-		CommandMemberInfo, CommandStats, CommandSubscriber, CommandUnknown:
+		CommandAlarmAdd, CommandAlarmRemove, CommandAlarmList, CommandAlarmClear, CommandAlarmFilter, CommandAlarmDigest, CommandAlarmInvalid,
+		CommandMemberInfo, CommandStats, CommandSubscriber, CommandLeave, CommandClip, CommandScheduleQuery, CommandUnknown:
 		return true
 	default:
 		return false
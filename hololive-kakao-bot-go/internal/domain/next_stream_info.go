@@ -46,6 +46,7 @@ func (s NextStreamStatus) IsValid() bool {
 type NextStreamInfo struct {
 	Status         NextStreamStatus
 	VideoID        string
+	URL            string // 시청 URL (비어 있으면 VideoID로 YouTube URL 생성)
 	Title          string
 	StartScheduled *time.Time
 }
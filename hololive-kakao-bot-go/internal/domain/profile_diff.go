@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// ProfileFieldChange: 프로필 필드 하나의 변경 내용 (data_entries/social_links는 라벨 단위, 예: "data_entries.誕生日")
+type ProfileFieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// ProfileChange: 변경된 탤런트 프로필과 필드별 변경 내용
+type ProfileChange struct {
+	Slug   string               `json:"slug"`
+	Fields []ProfileFieldChange `json:"fields"`
+}
+
+// ProfileChangelog: 공식 프로필 동기화 한 번의 결과
+type ProfileChangelog struct {
+	GeneratedAt time.Time       `json:"generated_at"`
+	DryRun      bool            `json:"dry_run"`
+	Checked     int             `json:"checked"`           // 실제로 가져온 프로필 수
+	Skipped     int             `json:"skipped"`           // --since 기준으로 건너뛴 수
+	Failed      []string        `json:"failed,omitempty"`  // 가져오기 실패 (기존 데이터 유지)
+	Added       []string        `json:"added,omitempty"`   // 새로 생긴 프로필
+	Removed     []string        `json:"removed,omitempty"` // 공식 목록에서 빠진 프로필
+	Modified    []ProfileChange `json:"modified,omitempty"`
+}
+
+// HasChanges: 기록할 변경(추가/삭제/수정)이 있는지 여부
+func (c *ProfileChangelog) HasChanges() bool {
+	return len(c.Added) > 0 || len(c.Removed) > 0 || len(c.Modified) > 0
+}
+
+// DiffTalentProfile: 두 프로필의 필드별 차이를 반환합니다. (같으면 nil)
+// 데이터 항목과 소셜 링크는 라벨 기준으로 비교하므로 순서만 바뀐 경우는 변경으로 보지 않는다.
+func DiffTalentProfile(before, after *TalentProfile) []ProfileFieldChange {
+	if before == nil {
+		before = &TalentProfile{}
+	}
+	if after == nil {
+		after = &TalentProfile{}
+	}
+
+	var changes []ProfileFieldChange
+	field := func(name, old, updated string) {
+		if old != updated {
+			changes = append(changes, ProfileFieldChange{Field: name, Old: old, New: updated})
+		}
+	}
+	field("english_name", before.EnglishName, after.EnglishName)
+	field("japanese_name", before.JapaneseName, after.JapaneseName)
+	field("catchphrase", before.Catchphrase, after.Catchphrase)
+	field("description", before.Description, after.Description)
+	field("official_url", before.OfficialURL, after.OfficialURL)
+
+	entryMap := func(entries []TalentProfileEntry) map[string]string {
+		m := make(map[string]string, len(entries))
+		for _, e := range entries {
+			m[e.Label] = e.Value
+		}
+		return m
+	}
+	linkMap := func(links []TalentSocialLink) map[string]string {
+		m := make(map[string]string, len(links))
+		for _, l := range links {
+			m[l.Label] = l.URL
+		}
+		return m
+	}
+	changes = appendLabeledChanges(changes, "data_entries.", entryMap(before.DataEntries), entryMap(after.DataEntries))
+	changes = appendLabeledChanges(changes, "social_links.", linkMap(before.SocialLinks), linkMap(after.SocialLinks))
+	return changes
+}
+
+func appendLabeledChanges(changes []ProfileFieldChange, prefix string, before, after map[string]string) []ProfileFieldChange {
+	labels := make([]string, 0, len(before)+len(after))
+	for label := range before {
+		labels = append(labels, label)
+	}
+	for label := range after {
+		if _, ok := before[label]; !ok {
+			labels = append(labels, label)
+		}
+	}
+	slices.Sort(labels)
+
+	for _, label := range labels {
+		if old, updated := before[label], after[label]; old != updated {
+			changes = append(changes, ProfileFieldChange{Field: prefix + label, Old: old, New: updated})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffTalentProfile(t *testing.T) {
+	before := &TalentProfile{
+		Slug:        "airani-iofifteen",
+		EnglishName: "Airani Iofifteen",
+		Catchphrase: "IOFORIA~!",
+		DataEntries: []TalentProfileEntry{{Label: "誕生日", Value: "7月15日"}, {Label: "身長", Value: "150㎝"}},
+		SocialLinks: []TalentSocialLink{{Label: "YouTube", URL: "https://youtube.com/a"}, {Label: "X", URL: "https://x.com/a"}},
+	}
+
+	reordered := *before
+	reordered.DataEntries = []TalentProfileEntry{{Label: "身長", Value: "150㎝"}, {Label: "誕生日", Value: "7月15日"}}
+	if changes := DiffTalentProfile(before, &reordered); changes != nil {
+		t.Fatalf("reordering entries must not be a change: %+v", changes)
+	}
+
+	after := *before
+	after.Catchphrase = "OBISA!"
+	after.DataEntries = []TalentProfileEntry{{Label: "誕生日", Value: "7月15日"}, {Label: "ユニット", Value: "AREA 15"}}
+	after.SocialLinks = []TalentSocialLink{{Label: "YouTube", URL: "https://youtube.com/b"}, {Label: "X", URL: "https://x.com/a"}}
+
+	got := DiffTalentProfile(before, &after)
+	want := []ProfileFieldChange{
+		{Field: "catchphrase", Old: "IOFORIA~!", New: "OBISA!"},
+		{Field: "data_entries.ユニット", New: "AREA 15"},
+		{Field: "data_entries.身長", Old: "150㎝"},
+		{Field: "social_links.YouTube", Old: "https://youtube.com/a", New: "https://youtube.com/b"},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("DiffTalentProfile() = %+v, want %+v", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"time"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/util"
@@ -33,6 +34,17 @@ func (s StreamStatus) IsValid() bool {
 	}
 }
 
+// StreamPlatform: 방송이 송출되는 플랫폼
+type StreamPlatform string
+
+// StreamPlatform 상수 목록.
+const (
+	// StreamPlatformYouTube: YouTube 방송 (Holodex 수집, 기본값)
+	StreamPlatformYouTube StreamPlatform = "youtube"
+	// StreamPlatformTwitch: Twitch 방송
+	StreamPlatformTwitch StreamPlatform = "twitch"
+)
+
 // Stream: Holodex 등에서 수집한 방송(스트림) 상세 정보
 type Stream struct {
 	ID             string       `json:"id"`
@@ -47,6 +59,8 @@ type Stream struct {
 	Link           *string      `json:"link,omitempty"`
 	TopicID        *string      `json:"topic_id,omitempty"`
 	Channel        *Channel     `json:"channel,omitempty"`
+	// Platform: 비어 있으면 YouTube로 간주한다. (기존 캐시 데이터 호환)
+	Platform StreamPlatform `json:"platform,omitempty"`
 }
 
 // IsLive: 방송이 현재 진행 중('live')인지 확인합니다.
@@ -84,6 +98,29 @@ func (s *Stream) GetYouTubeURL() string {
 	return "https://youtube.com/watch?v=" + s.ID
 }
 
+// IsTwitch: Twitch 방송인지 확인합니다.
+func (s *Stream) IsTwitch() bool {
+	if s == nil {
+		return false
+	}
+	return s.Platform == StreamPlatformTwitch
+}
+
+// GetWatchURL: 플랫폼에 맞는 시청 URL을 반환합니다.
+// Twitch 방송은 Link(채널 URL)를 그대로 사용하고, 그 외에는 YouTube URL을 반환한다.
+func (s *Stream) GetWatchURL() string {
+	if s == nil {
+		return ""
+	}
+	if s.IsTwitch() {
+		if s.Link != nil {
+			return *s.Link
+		}
+		return ""
+	}
+	return s.GetYouTubeURL()
+}
+
 // TimeUntilStart: 예정된 방송 시작 시각까지 남은 시간을 Duration으로 반환합니다.
 // 이미 시작 시간이 지났거나 예정 시간이 없으면 nil을 반환한다.
 func (s *Stream) TimeUntilStart() *time.Duration {
@@ -104,3 +141,28 @@ func (s *Stream) TimeUntilStart() *time.Duration {
 func (s *Stream) MinutesUntilStart() int {
 	return util.MinutesUntilCeil(s.StartScheduled, time.Now())
 }
+
+// SortStreamsByStart: 방송을 시작(예정) 시각 오름차순으로 정렬합니다. 시각이 없는 방송은 앞쪽에 둔다.
+func SortStreamsByStart(streams []*Stream) {
+	startOf := func(s *Stream) int64 {
+		switch {
+		case s.StartScheduled != nil:
+			return s.StartScheduled.Unix()
+		case s.StartActual != nil:
+			return s.StartActual.Unix()
+		default:
+			return 0
+		}
+	}
+	slices.SortStableFunc(streams, func(a, b *Stream) int {
+		aTime, bTime := startOf(a), startOf(b)
+		switch {
+		case aTime < bTime:
+			return -1
+		case aTime > bTime:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
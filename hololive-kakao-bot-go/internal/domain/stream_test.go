@@ -98,3 +98,42 @@ func TestStream_GetYouTubeURL(t *testing.T) {
 		})
 	}
 }
+
+func TestStream_GetWatchURL(t *testing.T) {
+	twitchLink := "https://www.twitch.tv/usadapekora_hololive"
+
+	tests := []struct {
+		name   string
+		stream *Stream
+		want   string
+	}{
+		{
+			name:   "youtube without link",
+			stream: &Stream{ID: "abc123"},
+			want:   "https://youtube.com/watch?v=abc123",
+		},
+		{
+			name:   "twitch uses channel link",
+			stream: &Stream{ID: "twitch:42", Platform: StreamPlatformTwitch, Link: &twitchLink},
+			want:   twitchLink,
+		},
+		{
+			name:   "twitch without link",
+			stream: &Stream{ID: "twitch:42", Platform: StreamPlatformTwitch},
+			want:   "",
+		},
+		{
+			name:   "nil stream",
+			stream: nil,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stream.GetWatchURL(); got != tt.want {
+				t.Errorf("Stream.GetWatchURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -31,16 +31,47 @@ type Response struct {
 	Goroutines int    `json:"goroutines"`
 }
 
+// 상태 값
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Component: 상세 헬스 응답의 구성 요소별 상태
+type Component struct {
+	Status string         `json:"status"`
+	Detail map[string]any `json:"detail"`
+}
+
+// DetailedResponse: /health/detail 엔드포인트 응답
+type DetailedResponse struct {
+	Response
+	Components map[string]Component `json:"components"`
+}
+
 // Get: 현재 상태 반환
 func Get() Response {
 	return Response{
-		Status:     "ok",
+		Status:     StatusOK,
 		Version:    version,
 		Uptime:     formatDuration(time.Since(startTime)),
 		Goroutines: runtime.NumGoroutine(),
 	}
 }
 
+// Detailed: 구성 요소 점검 결과를 포함한 상태 반환 (하나라도 ok가 아니면 degraded)
+func Detailed(components map[string]Component) DetailedResponse {
+	resp := DetailedResponse{Response: Get(), Components: components}
+	for _, c := range components {
+		if c.Status != StatusOK {
+			resp.Status = StatusDegraded
+			break
+		}
+	}
+	return resp
+}
+
 // GetVersion: 현재 버전 반환
 func GetVersion() string {
 	return version
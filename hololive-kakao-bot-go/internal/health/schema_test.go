@@ -0,0 +1,65 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSchemaChecker_ReportsMissingObjectsAndCaches(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	loads := 0
+	catalog := make(schemaCatalog)
+	games := catalog.table("games")
+	games.columns["id"] = struct{}{}
+	games.indexes["games_pkey"] = struct{}{}
+
+	checker := NewSchemaChecker(nil, time.Minute, []SchemaExpectation{
+		{Table: "games", Columns: []string{"id", "turn_count"}, Indexes: []string{"games_pkey", "idx_games_chat"}},
+		{Table: "players", Columns: []string{"id"}},
+	})
+	checker.now = func() time.Time { return now }
+	checker.load = func(context.Context) (schemaCatalog, error) {
+		loads++
+		return catalog, nil
+	}
+
+	got := checker.Check(context.Background())
+	if got.Status != StatusDegraded {
+		t.Fatalf("expected degraded, got %+v", got)
+	}
+	want := []string{"column:games.turn_count", "index:games.idx_games_chat", "table:players"}
+	if missing, _ := got.Detail["missing"].([]string); !slices.Equal(missing, want) {
+		t.Fatalf("unexpected missing: %v", got.Detail["missing"])
+	}
+
+	checker.Check(context.Background())
+	if loads != 1 {
+		t.Fatalf("expected cached result, loads=%d", loads)
+	}
+
+	now = now.Add(2 * time.Minute)
+	checker.load = func(context.Context) (schemaCatalog, error) {
+		loads++
+		return nil, errors.New("connection refused")
+	}
+	if got := checker.Check(context.Background()); got.Status != StatusDown || got.Detail["error"] == nil {
+		t.Fatalf("expected down on catalog error, got %+v", got)
+	}
+	if loads != 2 {
+		t.Fatalf("expected reload after ttl, loads=%d", loads)
+	}
+}
+
+func TestDetailed_DegradesOnUnhealthyComponent(t *testing.T) {
+	resp := Detailed(map[string]Component{"schema": {Status: StatusOK}})
+	if resp.Status != StatusOK {
+		t.Fatalf("expected ok, got %s", resp.Status)
+	}
+	resp = Detailed(map[string]Component{"schema": {Status: StatusDegraded}})
+	if resp.Status != StatusDegraded {
+		t.Fatalf("expected degraded, got %s", resp.Status)
+	}
+}
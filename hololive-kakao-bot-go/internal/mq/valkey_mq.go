@@ -15,6 +15,7 @@ import (
 	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
 	"github.com/kapu/hololive-kakao-bot-go/internal/iris"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
 )
 
 // ValkeyMQConfig: Redis(Valkey) 스트림 기반 메시지 큐 연결 설정
@@ -449,8 +450,8 @@ func (c *ValkeyMQConsumer) processMessage(ctx context.Context, streamKey, group,
 		Sender: senderPtr,
 	}
 
-	// 메시지 처리
-	c.bot.HandleMessage(ctx, irisMsg)
+	// 메시지 처리 (응답 지연은 스트림에 들어온 시각부터 측정)
+	c.bot.HandleMessage(latency.WithReceivedAt(ctx, latency.StreamIDTime(msgID)), irisMsg)
 
 	// 처리 완료 + ACK
 	c.markComplete(ctx, streamKey, group, msgID)
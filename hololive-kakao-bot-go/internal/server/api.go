@@ -6,12 +6,17 @@ import (
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/activity"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/broadcast"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/runtimeconfig"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/settings"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/system"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/youtube"
 )
 
@@ -19,12 +24,14 @@ import (
 // Admin Dashboard와 Tauri 앱 모두에서 사용됩니다.
 // 핸들러 메서드는 도메인별 파일로 분리됨:
 //   - api_member.go: 멤버 관리 + 프로필 조회
+//   - api_profile_admin.go: 탤런트 프로필 번역 수정
 //   - api_alarm.go: 알람 관리
-//   - api_room.go: 룸/ACL 관리
+//   - api_room.go: 룸/ACL 관리 + 떠나기
 //   - api_stream.go: 스트림/채널 통계
 //   - api_stats.go: 봇 통계
-//   - api_settings.go: 설정/활동 로그/이름매핑
+//   - api_settings.go: 설정/런타임 설정/활동 로그/이름매핑
 //   - api_milestone.go: 마일스톤 조회
+//   - api_broadcast.go: 알람 등록 방 대상 공지 전송
 type APIHandler struct {
 	repo        *member.Repository
 	memberCache *member.Cache
@@ -37,8 +44,13 @@ type APIHandler struct {
 	activity    *activity.Logger
 	settings    *settings.Service
 	acl         *acl.Service
+	translation *translation.Service
+	rooms       *room.Service
+	latency     *latency.Service
+	broadcasts  *broadcast.Service
 	logger      *slog.Logger
 	systemStats *system.Collector
+	runtimeCfg  *runtimeconfig.Registry
 	startTime   time.Time
 }
 
@@ -55,7 +67,12 @@ func NewAPIHandler(
 	activityLogger *activity.Logger,
 	settingsSvc *settings.Service,
 	aclSvc *acl.Service,
+	titleTranslator *translation.Service,
+	roomSvc *room.Service,
+	latencySvc *latency.Service,
+	broadcastSvc *broadcast.Service,
 	systemSvc *system.Collector,
+	runtimeCfg *runtimeconfig.Registry,
 	logger *slog.Logger,
 ) *APIHandler {
 	return &APIHandler{
@@ -70,7 +87,12 @@ func NewAPIHandler(
 		activity:    activityLogger,
 		settings:    settingsSvc,
 		acl:         aclSvc,
+		translation: titleTranslator,
+		rooms:       roomSvc,
+		latency:     latencySvc,
+		broadcasts:  broadcastSvc,
 		systemStats: systemSvc,
+		runtimeCfg:  runtimeCfg,
 		logger:      logger,
 		startTime:   time.Now(),
 	}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/broadcast"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// operatorHeader: 관리자 대시보드 프록시가 로그인 사용자명을 전달하는 헤더
+const operatorHeader = "X-Admin-User"
+
+// SendBroadcast: 알람이 등록된 방(또는 필터에 맞는 일부)에 공지를 보냅니다.
+// dryRun이면 대상 방 목록만 반환하고, 아니면 202와 함께 백그라운드 전송을 시작합니다.
+func (h *APIHandler) SendBroadcast(c *gin.Context) {
+	if h.broadcasts == nil {
+		c.JSON(503, gin.H{"error": "Broadcast service not available"})
+		return
+	}
+
+	var req struct {
+		Message string           `json:"message" binding:"required"`
+		Filter  broadcast.Filter `json:"filter"`
+		DryRun  bool             `json:"dryRun"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	requestedBy := util.TrimSpace(c.GetHeader(operatorHeader))
+	if requestedBy == "" {
+		requestedBy = "admin"
+	}
+
+	record, err := h.broadcasts.Send(c.Request.Context(), broadcast.Request{
+		Message:     req.Message,
+		Filter:      req.Filter,
+		DryRun:      req.DryRun,
+		RequestedBy: requestedBy,
+	})
+	switch {
+	case errors.Is(err, broadcast.ErrEmptyMessage), errors.Is(err, broadcast.ErrMessageTooLong), errors.Is(err, broadcast.ErrNoTargets):
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, broadcast.ErrInProgress):
+		c.JSON(409, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		h.logger.Error("Failed to start broadcast", slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to start broadcast"})
+		return
+	}
+
+	if req.DryRun {
+		c.JSON(200, gin.H{
+			"status":    "ok",
+			"broadcast": record,
+		})
+		return
+	}
+
+	c.JSON(202, gin.H{
+		"status":    "ok",
+		"broadcast": record,
+	})
+
+	h.activity.Log("broadcast", "Broadcast sent by "+requestedBy, map[string]any{
+		"id":      record.ID,
+		"targets": len(record.Targets),
+	})
+}
+
+// GetBroadcasts: 최근 공지 전송 기록을 반환합니다. (?limit=N, 기본 50)
+func (h *APIHandler) GetBroadcasts(c *gin.Context) {
+	if h.broadcasts == nil {
+		c.JSON(503, gin.H{"error": "Broadcast service not available"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	records, err := h.broadcasts.History(c.Request.Context(), limit)
+	if err != nil {
+		h.logger.Error("Failed to list broadcasts", slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to list broadcasts"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":     "ok",
+		"broadcasts": records,
+	})
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/latency"
+)
+
+// GetCommandLatency: 명령어별 응답 지연 SLA 리포트를 반환합니다. (?days=1~90, 기본 7일)
+func (h *APIHandler) GetCommandLatency(c *gin.Context) {
+	if h.latency == nil {
+		c.JSON(503, gin.H{"error": "Latency reporting not available"})
+		return
+	}
+
+	days := 7
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > latency.MaxReportDays {
+			c.JSON(400, gin.H{"error": "days must be between 1 and 90"})
+			return
+		}
+		days = parsed
+	}
+
+	report, err := h.latency.Report(c.Request.Context(), days)
+	if err != nil {
+		h.logger.Error("Failed to build latency report", slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to build latency report"})
+		return
+	}
+	c.JSON(200, report)
+}
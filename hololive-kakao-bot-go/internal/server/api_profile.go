@@ -97,15 +97,7 @@ func (h *APIHandler) GetProfile(c *gin.Context) {
 		Profile: convertToProfileData(profile),
 	}
 
-	if translated != nil {
-		resp.Translated = &TranslatedData{
-			DisplayName: translated.DisplayName,
-			Catchphrase: translated.Catchphrase,
-			Summary:     translated.Summary,
-			Highlights:  translated.Highlights,
-			Data:        convertTranslatedRows(translated.Data),
-		}
-	}
+	resp.Translated = convertTranslated(translated)
 
 	c.JSON(200, resp)
 }
@@ -144,15 +136,7 @@ func (h *APIHandler) GetProfileByName(c *gin.Context) {
 		Profile: convertToProfileData(profile),
 	}
 
-	if translated != nil {
-		resp.Translated = &TranslatedData{
-			DisplayName: translated.DisplayName,
-			Catchphrase: translated.Catchphrase,
-			Summary:     translated.Summary,
-			Highlights:  translated.Highlights,
-			Data:        convertTranslatedRows(translated.Data),
-		}
-	}
+	resp.Translated = convertTranslated(translated)
 
 	c.JSON(200, resp)
 }
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/member"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// ProfileAdminEntry: 관리자 프로필 목록/상세 항목 (translated가 없으면 원본 기반 기본 번역으로 응답 중)
+type ProfileAdminEntry struct {
+	Profile    *ProfileData    `json:"profile"`
+	Translated *TranslatedData `json:"translated,omitempty"`
+}
+
+// ListTalentProfiles: DB에 저장된 전체 탤런트 프로필과 번역을 slug 순으로 반환합니다.
+func (h *APIHandler) ListTalentProfiles(c *gin.Context) {
+	if h.profiles == nil {
+		c.JSON(503, gin.H{"error": "Profile service unavailable"})
+		return
+	}
+
+	entries := h.profiles.List()
+	result := make([]ProfileAdminEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, convertProfileEntry(entry))
+	}
+	c.JSON(200, gin.H{"status": "ok", "profiles": result})
+}
+
+// GetTalentProfile: slug로 탤런트 프로필과 번역을 조회합니다.
+func (h *APIHandler) GetTalentProfile(c *gin.Context) {
+	if h.profiles == nil {
+		c.JSON(503, gin.H{"error": "Profile service unavailable"})
+		return
+	}
+
+	entry, err := h.profiles.Get(c.Param("slug"))
+	if err != nil {
+		c.JSON(404, gin.H{"error": "Profile not found"})
+		return
+	}
+	c.JSON(200, gin.H{"status": "ok", "profile": convertProfileEntry(entry)})
+}
+
+// UpdateTalentTranslation: 번역 필드(display_name, catchphrase, summary, highlights)를 수정합니다.
+// 보낸 필드만 바꾸며, 수정본은 이후 JSON 가져오기에서 덮어쓰지 않는다.
+func (h *APIHandler) UpdateTalentTranslation(c *gin.Context) {
+	if h.profiles == nil {
+		c.JSON(503, gin.H{"error": "Profile service unavailable"})
+		return
+	}
+
+	var req struct {
+		DisplayName *string   `json:"display_name"`
+		Catchphrase *string   `json:"catchphrase"`
+		Summary     *string   `json:"summary"`
+		Highlights  *[]string `json:"highlights"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if req.DisplayName == nil && req.Catchphrase == nil && req.Summary == nil && req.Highlights == nil {
+		c.JSON(400, gin.H{"error": "at least one of display_name, catchphrase, summary, highlights is required"})
+		return
+	}
+	if req.DisplayName != nil && util.TrimSpace(*req.DisplayName) == "" {
+		c.JSON(400, gin.H{"error": "display_name must not be empty"})
+		return
+	}
+
+	slug := c.Param("slug")
+	translated, err := h.profiles.UpdateTranslation(c.Request.Context(), slug, member.TranslationPatch{
+		DisplayName: req.DisplayName,
+		Catchphrase: req.Catchphrase,
+		Summary:     req.Summary,
+		Highlights:  req.Highlights,
+	}, util.TrimSpace(c.GetHeader(operatorHeader)))
+	if h.writeProfileAdminError(c, slug, err, "Failed to update profile translation") {
+		return
+	}
+
+	h.activity.Log("profile_translation_update", "Profile translation updated", map[string]any{"slug": slug})
+	c.JSON(200, gin.H{"status": "ok", "translated": convertTranslated(translated)})
+}
+
+// DeleteTalentTranslation: 저장된 번역을 삭제합니다. 이후에는 원본 기반 기본 번역으로 응답합니다.
+func (h *APIHandler) DeleteTalentTranslation(c *gin.Context) {
+	if h.profiles == nil {
+		c.JSON(503, gin.H{"error": "Profile service unavailable"})
+		return
+	}
+
+	slug := c.Param("slug")
+	err := h.profiles.DeleteTranslation(c.Request.Context(), slug)
+	if h.writeProfileAdminError(c, slug, err, "Failed to delete profile translation") {
+		return
+	}
+
+	h.activity.Log("profile_translation_delete", "Profile translation deleted", map[string]any{"slug": slug})
+	c.JSON(200, gin.H{"status": "ok"})
+}
+
+// writeProfileAdminError: 프로필 수정 오류를 HTTP 응답으로 바꾼다. 오류가 있었으면 true.
+func (h *APIHandler) writeProfileAdminError(c *gin.Context, slug string, err error, message string) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, member.ErrProfileNotFound):
+		c.JSON(404, gin.H{"error": "Profile not found"})
+	case errors.Is(err, member.ErrProfileStoreUnavailable):
+		c.JSON(503, gin.H{"error": "Profile store unavailable"})
+	default:
+		h.logger.Error(message, slog.String("slug", slug), slog.Any("error", err))
+		c.JSON(500, gin.H{"error": message})
+	}
+	return true
+}
+
+func convertProfileEntry(entry member.ProfileEntry) ProfileAdminEntry {
+	return ProfileAdminEntry{
+		Profile:    convertToProfileData(entry.Profile),
+		Translated: convertTranslated(entry.Translated),
+	}
+}
+
+func convertTranslated(translated *domain.Translated) *TranslatedData {
+	if translated == nil {
+		return nil
+	}
+	return &TranslatedData{
+		DisplayName: translated.DisplayName,
+		Catchphrase: translated.Catchphrase,
+		Summary:     translated.Summary,
+		Highlights:  translated.Highlights,
+		Data:        convertTranslatedRows(translated.Data),
+	}
+}
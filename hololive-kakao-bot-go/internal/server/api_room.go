@@ -1,10 +1,14 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/room"
 )
 
 // GetRooms: 설정된 방 목록을 반환합니다.
@@ -122,3 +126,123 @@ func (h *APIHandler) SetACL(c *gin.Context) {
 		"enabled": req.Enabled,
 	})
 }
+
+// LeaveRoom: 방의 통계를 보관하고 알람/이름 매핑/ACL 등을 정리하는 떠나기 흐름을 수동으로 실행합니다.
+func (h *APIHandler) LeaveRoom(c *gin.Context) {
+	if h.rooms == nil {
+		c.JSON(503, gin.H{"error": "Room service not available"})
+		return
+	}
+
+	var req struct {
+		Room     string `json:"room" binding:"required"`
+		RoomName string `json:"roomName"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	departure, err := h.rooms.Leave(ctx, room.LeaveRequest{
+		RoomID:      req.Room,
+		RoomName:    req.RoomName,
+		Reason:      room.ReasonAdmin,
+		RequestedBy: "admin",
+	})
+	if errors.Is(err, room.ErrRoomNotFound) {
+		c.JSON(404, gin.H{"error": "Room not found"})
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to leave room", slog.String("room", req.Room), slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to leave room"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":    "ok",
+		"departure": departure,
+	})
+
+	h.activity.Log("room_leave", "Room departed by admin: "+req.Room, map[string]any{
+		"room":   req.Room,
+		"alarms": departure.Stats.Alarms,
+	})
+}
+
+// GetRoomDepartures: 최근 떠나기 기록을 반환합니다. (?limit=N, 기본 50)
+func (h *APIHandler) GetRoomDepartures(c *gin.Context) {
+	if h.rooms == nil {
+		c.JSON(503, gin.H{"error": "Room service not available"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	departures, err := h.rooms.ListDepartures(c.Request.Context(), limit)
+	if err != nil {
+		h.logger.Error("Failed to list room departures", slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to list room departures"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":     "ok",
+		"departures": departures,
+	})
+}
+
+// GetDormantAlarmRooms: 알람 구독이 휴면 처리된 방 목록을 반환합니다.
+func (h *APIHandler) GetDormantAlarmRooms(c *gin.Context) {
+	if h.rooms == nil {
+		c.JSON(503, gin.H{"error": "Room service not available"})
+		return
+	}
+
+	rooms, err := h.rooms.DormantRooms(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to list dormant alarm rooms", slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to list dormant alarm rooms"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status": "ok",
+		"rooms":  rooms,
+	})
+}
+
+// ReactivateAlarmRoom: 휴면 처리된 방의 알람 구독을 수동으로 복귀시킵니다.
+func (h *APIHandler) ReactivateAlarmRoom(c *gin.Context) {
+	if h.rooms == nil {
+		c.JSON(503, gin.H{"error": "Room service not available"})
+		return
+	}
+
+	var req struct {
+		Room string `json:"room" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	reactivated, err := h.rooms.Reactivate(c.Request.Context(), req.Room)
+	if err != nil {
+		h.logger.Error("Failed to reactivate alarm room", slog.String("room", req.Room), slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to reactivate alarm room"})
+		return
+	}
+	if !reactivated {
+		c.JSON(404, gin.H{"error": "Room is not dormant"})
+		return
+	}
+
+	c.JSON(200, gin.H{"status": "ok"})
+
+	h.activity.Log("alarm_hygiene", "Dormant alarm room reactivated by admin: "+req.Room, map[string]any{
+		"room": req.Room,
+	})
+}
@@ -119,3 +119,39 @@ func (h *APIHandler) UpdateSettings(c *gin.Context) {
 
 	c.JSON(200, gin.H{"status": "ok", "message": "Settings updated"})
 }
+
+// GetRuntimeConfig: 관리 대시보드가 관리하는 런타임 설정의 정의와 현재 값을 조회합니다.
+func (h *APIHandler) GetRuntimeConfig(c *gin.Context) {
+	c.JSON(200, h.runtimeConfigResponse())
+}
+
+// UpdateRuntimeConfig: 런타임 설정 전체를 교체합니다. (빠진 키는 기본값으로 복귀)
+func (h *APIHandler) UpdateRuntimeConfig(c *gin.Context) {
+	var req struct {
+		Values map[string]string `json:"values"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	changed, err := h.runtimeCfg.Apply(req.Values)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if len(changed) > 0 {
+		h.logger.Info("Runtime config applied", slog.Any("changed", changed))
+		h.activity.Log("runtime_config_update", "Runtime config applied", map[string]any{"changed": changed})
+	}
+
+	c.JSON(200, h.runtimeConfigResponse())
+}
+
+func (h *APIHandler) runtimeConfigResponse() gin.H {
+	resp := gin.H{"status": "ok", "settings": h.runtimeCfg.Entries()}
+	if updatedAt := h.runtimeCfg.UpdatedAt(); !updatedAt.IsZero() {
+		resp["updatedAt"] = updatedAt
+	}
+	return resp
+}
@@ -43,14 +43,23 @@ func (h *APIHandler) GetStats(c *gin.Context) {
 		roomCount = len(rooms)
 	}
 
-	c.JSON(200, gin.H{
+	resp := gin.H{
 		"status":  "ok",
 		"members": len(members),
 		"alarms":  len(alarmKeys),
 		"rooms":   roomCount,
 		"version": health.GetVersion(),
 		"uptime":  health.GetUptime(),
-	})
+	}
+
+	// Holodex 호출 예산 (조회 실패 시 생략)
+	if h.holodex != nil {
+		if quota, err := h.holodex.QuotaStatus(ctx); err == nil {
+			resp["holodexQuota"] = quota
+		}
+	}
+
+	c.JSON(200, resp)
 }
 
 // StreamSystemStats: WebSocket을 통해 시스템 리소스 사용량을 실시간 스트리밍합니다.
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetTranslationRooms: 방송 제목 번역이 켜진 방 목록을 반환합니다.
+func (h *APIHandler) GetTranslationRooms(c *gin.Context) {
+	if h.translation == nil {
+		c.JSON(503, gin.H{"error": "Title translation not configured"})
+		return
+	}
+
+	rooms, err := h.translation.Rooms(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to get translation rooms", slog.Any("error", err))
+		c.JSON(500, gin.H{"error": "Failed to get translation rooms"})
+		return
+	}
+	c.JSON(200, gin.H{"status": "ok", "rooms": rooms})
+}
+
+// EnableTranslationRoom: 방에 방송 제목 번역을 켭니다.
+func (h *APIHandler) EnableTranslationRoom(c *gin.Context) {
+	h.setTranslationRoom(c, true)
+}
+
+// DisableTranslationRoom: 방의 방송 제목 번역을 끕니다.
+func (h *APIHandler) DisableTranslationRoom(c *gin.Context) {
+	h.setTranslationRoom(c, false)
+}
+
+func (h *APIHandler) setTranslationRoom(c *gin.Context, enabled bool) {
+	if h.translation == nil {
+		c.JSON(503, gin.H{"error": "Title translation not configured"})
+		return
+	}
+
+	var req struct {
+		Room string `json:"room" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	var err error
+	if enabled {
+		_, err = h.translation.EnableRoom(ctx, req.Room)
+	} else {
+		_, err = h.translation.DisableRoom(ctx, req.Room)
+	}
+	if err != nil {
+		h.logger.Error("Failed to update translation room",
+			slog.String("room", req.Room),
+			slog.Bool("enabled", enabled),
+			slog.Any("error", err),
+		)
+		c.JSON(500, gin.H{"error": "Failed to update translation room"})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"status":  "ok",
+		"room":    req.Room,
+		"enabled": enabled,
+	})
+
+	h.activity.Log("translation_room_update", "Title translation room updated: "+req.Room, map[string]any{
+		"room":    req.Room,
+		"enabled": enabled,
+	})
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CachePolicy: 엔드포인트별 Cache-Control 정책입니다.
+// Public이 false이면 브라우저 캐시만 허용하고 매 요청마다 재검증(no-cache)하도록 한다.
+type CachePolicy struct {
+	// Public: 공유 캐시(Cloudflare 등 CDN) 저장 허용 여부
+	Public bool
+	// MaxAge: 브라우저 캐시 유효 시간
+	MaxAge time.Duration
+	// SMaxAge: 공유 캐시 유효 시간 (0이면 MaxAge를 따름)
+	SMaxAge time.Duration
+	// StaleWhileRevalidate: 만료 후 백그라운드 재검증 동안 이전 응답을 제공할 수 있는 시간
+	StaleWhileRevalidate time.Duration
+}
+
+// Header: Cache-Control 헤더 값을 반환합니다.
+func (p CachePolicy) Header() string {
+	if !p.Public {
+		return "private, no-cache"
+	}
+
+	directives := []string{"public", "max-age=" + seconds(p.MaxAge)}
+	if p.SMaxAge > 0 {
+		directives = append(directives, "s-maxage="+seconds(p.SMaxAge))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+seconds(p.StaleWhileRevalidate))
+	}
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
+
+// maxValidatorEntries: 기억할 응답 표현(경로+쿼리) 수 상한. 초과하면 전체를 비우고 다시 기록한다.
+const maxValidatorEntries = 1024
+
+type validator struct {
+	etag         string
+	lastModified time.Time
+}
+
+// ValidatorStore: 응답 표현별 ETag와 마지막 변경 시각을 기억합니다.
+// 본문은 캐시 갱신 주기마다 다시 만들어지므로, 내용(ETag)이 바뀐 시각을 Last-Modified로 사용한다.
+type ValidatorStore struct {
+	mu      sync.Mutex
+	entries map[string]validator
+	now     func() time.Time
+}
+
+// NewValidatorStore: 빈 ValidatorStore를 생성합니다.
+func NewValidatorStore() *ValidatorStore {
+	return &ValidatorStore{entries: make(map[string]validator), now: time.Now}
+}
+
+// observe: 현재 ETag를 기록하고, 내용이 마지막으로 바뀐 시각(초 단위 절삭)을 반환합니다.
+func (s *ValidatorStore) observe(key, etag string) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.entries[key]; ok && entry.etag == etag {
+		return entry.lastModified
+	}
+	if len(s.entries) >= maxValidatorEntries {
+		s.entries = make(map[string]validator)
+	}
+	// HTTP 날짜는 초 단위이므로 If-Modified-Since 비교가 어긋나지 않도록 절삭
+	modified := s.now().UTC().Truncate(time.Second)
+	s.entries[key] = validator{etag: etag, lastModified: modified}
+	return modified
+}
+
+// bufferedWriter: 조건부 응답 판단을 위해 본문과 상태 코드를 끝까지 보류하는 ResponseWriter입니다.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) { w.status = code }
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+
+func (w *bufferedWriter) WriteString(s string) (int, error) { return w.body.WriteString(s) }
+
+func (w *bufferedWriter) Status() int { return w.status }
+
+func (w *bufferedWriter) Size() int { return w.body.Len() }
+
+func (w *bufferedWriter) Written() bool { return w.body.Len() > 0 }
+
+// ConditionalGETMiddleware: GET 응답에 강한 ETag, Last-Modified, Cache-Control을 붙이고
+// If-None-Match / If-Modified-Since가 현재 표현과 일치하면 본문 없이 304를 반환합니다.
+// 200 이외의 응답은 그대로 전달하며 캐시 헤더를 붙이지 않는다.
+func ConditionalGETMiddleware(policy CachePolicy, validators *ValidatorStore) gin.HandlerFunc {
+	cacheControl := policy.Header()
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = original
+
+		if writer.status != http.StatusOK {
+			original.WriteHeader(writer.status)
+			_, _ = original.Write(writer.body.Bytes())
+			return
+		}
+
+		hash := sha256.Sum256(writer.body.Bytes())
+		etag := `"` + hex.EncodeToString(hash[:16]) + `"`
+		lastModified := validators.observe(c.Request.URL.RequestURI(), etag)
+
+		header := original.Header()
+		header.Set("ETag", etag)
+		header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		header.Set("Cache-Control", cacheControl)
+
+		if notModified(c.Request, etag, lastModified) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		original.WriteHeader(http.StatusOK)
+		_, _ = original.Write(writer.body.Bytes())
+	}
+}
+
+// notModified: RFC 9110 13.2.2 순서에 따라 If-None-Match를 우선 평가하고,
+// 없을 때만 If-Modified-Since를 비교합니다.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etagMatches(inm, etag)
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		since, err := http.ParseTime(ims)
+		return err == nil && !lastModified.After(since)
+	}
+	return false
+}
+
+// etagMatches: If-None-Match 목록에 현재 ETag가 있는지 확인합니다. (GET은 약한 비교, "*"는 항상 일치)
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
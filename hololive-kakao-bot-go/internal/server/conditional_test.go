@@ -0,0 +1,108 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/server"
+)
+
+func newConditionalRouter(policy server.CachePolicy, body *string, status *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/streams", server.ConditionalGETMiddleware(policy, server.NewValidatorStore()), func(c *gin.Context) {
+		c.JSON(*status, gin.H{"streams": *body})
+	})
+	return router
+}
+
+func doGet(router http.Handler, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/streams", nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestConditionalGET_ETagRoundTrip(t *testing.T) {
+	body, status := "a", http.StatusOK
+	policy := server.CachePolicy{Public: true, MaxAge: 30 * time.Second, SMaxAge: time.Minute, StaleWhileRevalidate: time.Minute}
+	router := newConditionalRouter(policy, &body, &status)
+
+	first := doGet(router, nil)
+	if first.Code != http.StatusOK || first.Body.Len() == 0 {
+		t.Fatalf("first request: code=%d body=%q", first.Code, first.Body.String())
+	}
+	etag := first.Header().Get("ETag")
+	if etag == "" || etag[0] != '"' {
+		t.Fatalf("expected strong ETag, got %q", etag)
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, max-age=30, s-maxage=60, stale-while-revalidate=60" {
+		t.Fatalf("unexpected Cache-Control: %q", got)
+	}
+	lastModified := first.Header().Get("Last-Modified")
+	if lastModified == "" {
+		t.Fatal("missing Last-Modified")
+	}
+
+	// 같은 표현: 304, 본문 없음, 검증자 헤더 유지
+	second := doGet(router, map[string]string{"If-None-Match": `"other", ` + etag})
+	if second.Code != http.StatusNotModified || second.Body.Len() != 0 {
+		t.Fatalf("expected empty 304, got code=%d body=%q", second.Code, second.Body.String())
+	}
+	if second.Header().Get("ETag") != etag || second.Header().Get("Last-Modified") != lastModified {
+		t.Fatal("304 must carry the same validators")
+	}
+
+	// If-None-Match가 있으면 If-Modified-Since는 무시
+	mismatch := doGet(router, map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": lastModified})
+	if mismatch.Code != http.StatusOK {
+		t.Fatalf("mismatched ETag should return 200, got %d", mismatch.Code)
+	}
+
+	// 내용 변경: 새 ETag로 200
+	body = "b"
+	changed := doGet(router, map[string]string{"If-None-Match": etag})
+	if changed.Code != http.StatusOK || changed.Header().Get("ETag") == etag {
+		t.Fatalf("changed body should return 200 with new ETag, got code=%d etag=%q", changed.Code, changed.Header().Get("ETag"))
+	}
+}
+
+func TestConditionalGET_IfModifiedSince(t *testing.T) {
+	body, status := "a", http.StatusOK
+	router := newConditionalRouter(server.CachePolicy{}, &body, &status)
+
+	first := doGet(router, nil)
+	if got := first.Header().Get("Cache-Control"); got != "private, no-cache" {
+		t.Fatalf("unexpected Cache-Control: %q", got)
+	}
+
+	rec := doGet(router, map[string]string{"If-Modified-Since": first.Header().Get("Last-Modified")})
+	if rec.Code != http.StatusNotModified {
+		t.Fatalf("expected 304, got %d", rec.Code)
+	}
+
+	past := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+	if rec := doGet(router, map[string]string{"If-Modified-Since": past}); rec.Code != http.StatusOK {
+		t.Fatalf("older If-Modified-Since should return 200, got %d", rec.Code)
+	}
+}
+
+func TestConditionalGET_ErrorsPassThrough(t *testing.T) {
+	body, status := "boom", http.StatusInternalServerError
+	router := newConditionalRouter(server.CachePolicy{Public: true, MaxAge: time.Minute}, &body, &status)
+
+	rec := doGet(router, map[string]string{"If-None-Match": "*"})
+	if rec.Code != http.StatusInternalServerError || rec.Body.Len() == 0 {
+		t.Fatalf("error response should pass through, got code=%d body=%q", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("ETag") != "" || rec.Header().Get("Cache-Control") != "" {
+		t.Fatal("error response must not carry cache headers")
+	}
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package acl_test
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
+	"github.com/kapu/hololive-kakao-bot-go/internal/testutil"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunMain(m))
+}
+
+func TestACLService_PersistsAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	postgres := testutil.NewPostgresService(t)
+	cacheSvc := testutil.NewCacheService(t)
+	logger := testutil.DiscardLogger()
+
+	svc, err := acl.NewACLService(ctx, postgres, cacheSvc, logger, true, []string{"default-room"})
+	if err != nil {
+		t.Fatalf("new acl service failed: %v", err)
+	}
+	if added, err := svc.AddRoom(ctx, "room-a"); err != nil || !added {
+		t.Fatalf("add room: added=%v err=%v", added, err)
+	}
+	if removed, err := svc.RemoveRoom(ctx, "default-room"); err != nil || !removed {
+		t.Fatalf("remove room: removed=%v err=%v", removed, err)
+	}
+	if err := svc.SetEnabled(ctx, false); err != nil {
+		t.Fatalf("set enabled failed: %v", err)
+	}
+
+	// 기본값이 달라도 재시작 시 DB 상태가 우선해야 한다
+	restarted, err := acl.NewACLService(ctx, postgres, cacheSvc, logger, true, []string{"other-room"})
+	if err != nil {
+		t.Fatalf("restart acl service failed: %v", err)
+	}
+	enabled, rooms := restarted.GetACLStatus()
+	if enabled {
+		t.Fatal("expected ACL to stay disabled after restart")
+	}
+	if !slices.Equal(rooms, []string{"room-a"}) {
+		t.Fatalf("unexpected rooms after restart: %v", rooms)
+	}
+
+	var cachedEnabled string
+	if err := cacheSvc.Get(ctx, "acl:settings", &cachedEnabled); err != nil {
+		t.Fatalf("cache get failed: %v", err)
+	}
+	if cachedEnabled != "false" {
+		t.Fatalf("expected valkey mirror to be synced, got %q", cachedEnabled)
+	}
+}
+
+func TestACLService_DuplicateRoomRejectedByUniqueIndex(t *testing.T) {
+	ctx := context.Background()
+	postgres := testutil.NewPostgresService(t)
+	logger := testutil.DiscardLogger()
+
+	first, err := acl.NewACLService(ctx, postgres, testutil.NewCacheService(t), logger, true, nil)
+	if err != nil {
+		t.Fatalf("new acl service failed: %v", err)
+	}
+	second, err := acl.NewACLService(ctx, postgres, testutil.NewCacheService(t), logger, true, nil)
+	if err != nil {
+		t.Fatalf("new acl service failed: %v", err)
+	}
+
+	if added, err := first.AddRoom(ctx, "room-a"); err != nil || !added {
+		t.Fatalf("first add: added=%v err=%v", added, err)
+	}
+	// 다른 인스턴스의 메모리 캐시에는 없지만 DB UNIQUE 제약으로 거부되어야 한다
+	if _, err := second.AddRoom(ctx, "room-a"); err == nil {
+		t.Fatal("expected unique violation from second instance")
+	}
+	if second.IsRoomAllowed("", "room-a") {
+		t.Fatal("expected in-memory rollback after failed insert")
+	}
+}
@@ -45,6 +45,45 @@ func (r *Repository) Add(ctx context.Context, alarm *domain.Alarm) error {
 	return nil
 }
 
+// Import: 알람 목록을 한 트랜잭션으로 upsert하고 저장한 수를 반환합니다. (백업 복원용)
+// created_at이 있으면 그대로 보존한다.
+func (r *Repository) Import(ctx context.Context, alarms []*domain.Alarm) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin import: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO alarms (room_id, user_id, channel_id, member_name, room_name, user_name, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, NOW()))
+		ON CONFLICT (room_id, user_id, channel_id) DO UPDATE
+		SET member_name = COALESCE(NULLIF(EXCLUDED.member_name, ''), alarms.member_name),
+		    room_name = COALESCE(NULLIF(EXCLUDED.room_name, ''), alarms.room_name),
+		    user_name = COALESCE(NULLIF(EXCLUDED.user_name, ''), alarms.user_name)
+	`
+
+	imported := 0
+	for _, alarm := range alarms {
+		var createdAt sql.NullTime
+		if !alarm.CreatedAt.IsZero() {
+			createdAt = sql.NullTime{Time: alarm.CreatedAt, Valid: true}
+		}
+		if _, err := tx.ExecContext(ctx, query,
+			alarm.RoomID, alarm.UserID, alarm.ChannelID,
+			alarm.MemberName, alarm.RoomName, alarm.UserName, createdAt,
+		); err != nil {
+			return 0, fmt.Errorf("import alarm %s/%s: %w", alarm.RegistryKey(), alarm.ChannelID, err)
+		}
+		imported++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit import: %w", err)
+	}
+	return imported, nil
+}
+
 // Remove: 특정 알람을 DB에서 삭제합니다.
 func (r *Repository) Remove(ctx context.Context, roomID, userID, channelID string) error {
 	query := `DELETE FROM alarms WHERE room_id = $1 AND user_id = $2 AND channel_id = $3`
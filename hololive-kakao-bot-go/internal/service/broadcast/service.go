@@ -0,0 +1,407 @@
+package broadcast
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+var (
+	// ErrEmptyMessage: 공지 본문이 비어 있을 때 반환되는 오류
+	ErrEmptyMessage = stdErrors.New("broadcast message is empty")
+	// ErrMessageTooLong: 공지 본문이 최대 길이를 넘을 때 반환되는 오류
+	ErrMessageTooLong = stdErrors.New("broadcast message is too long")
+	// ErrNoTargets: 필터에 맞는 방이 하나도 없을 때 반환되는 오류
+	ErrNoTargets = stdErrors.New("no rooms match the broadcast filter")
+	// ErrInProgress: 다른 공지가 아직 전송 중일 때 반환되는 오류
+	ErrInProgress = stdErrors.New("another broadcast is in progress")
+)
+
+// Status: 공지 전송 상태
+type Status string
+
+// Status 상수 목록.
+const (
+	StatusSending   Status = "sending"
+	StatusCompleted Status = "completed"
+	StatusDryRun    Status = "dry_run" // 대상 목록만 계산하고 보내지 않음 (기록하지 않음)
+)
+
+// Sender: 방에 메시지를 보내는 인터페이스 (iris.Client의 부분집합)
+type Sender interface {
+	SendMessage(ctx context.Context, room, message string) error
+}
+
+// Filter: 공지 대상 방 필터. 비어 있으면 알람이 있는 모든 방이 대상이다.
+type Filter struct {
+	Rooms          []string `json:"rooms,omitempty"`          // 이 방들로만 제한
+	Exclude        []string `json:"exclude,omitempty"`        // 이 방들은 제외
+	Channels       []string `json:"channels,omitempty"`       // 이 채널 중 하나라도 구독 중인 방으로 제한
+	IncludeDormant bool     `json:"includeDormant,omitempty"` // 휴면 처리된 방도 포함
+}
+
+// Request: 공지 전송 요청
+type Request struct {
+	Message     string
+	Filter      Filter
+	DryRun      bool
+	RequestedBy string
+}
+
+// Target: 공지를 받을 방
+type Target struct {
+	RoomID   string `json:"roomId"`
+	RoomName string `json:"roomName,omitempty"`
+}
+
+// Record: 공지 전송 감사 기록
+type Record struct {
+	ID          string            `json:"id"`
+	Message     string            `json:"message"`
+	RequestedBy string            `json:"requestedBy"`
+	Filter      Filter            `json:"filter"`
+	Targets     []Target          `json:"targets"`
+	Sent        int               `json:"sent"`
+	Failed      int               `json:"failed"`
+	Failures    map[string]string `json:"failures,omitempty"` // roomID → 오류 메시지
+	Status      Status            `json:"status"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	CompletedAt *time.Time        `json:"completedAt,omitempty"`
+}
+
+// broadcastModel: broadcasts 테이블 GORM 모델 (필터/대상/실패 목록은 JSON 텍스트로 저장)
+type broadcastModel struct {
+	ID          string `gorm:"primaryKey"`
+	Message     string
+	RequestedBy string
+	Filter      string
+	Targets     string
+	Sent        int
+	Failed      int
+	Failures    string
+	Status      string
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// TableName: 공지 기록 테이블의 이름을 반환한다. ("broadcasts")
+func (broadcastModel) TableName() string {
+	return "broadcasts"
+}
+
+// Service: 알람이 등록된 방들에 관리자 공지를 일괄 전송하는 서비스
+// 카카오톡 도배 제한을 피하려고 방 사이에 간격을 두고 순차 전송하며, 한 번에 하나의 공지만 보낸다.
+type Service struct {
+	db       *gorm.DB
+	alarm    *notification.AlarmService
+	sender   Sender
+	interval time.Duration
+	logger   *slog.Logger
+
+	sending sync.Mutex
+	wg      sync.WaitGroup
+	now     func() time.Time
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewService: 공지 서비스를 생성하고 기록 테이블을 준비합니다.
+func NewService(
+	ctx context.Context,
+	db *gorm.DB,
+	alarmSvc *notification.AlarmService,
+	sender Sender,
+	logger *slog.Logger,
+) (*Service, error) {
+	if db == nil || alarmSvc == nil || sender == nil {
+		return nil, fmt.Errorf("db, alarm service and sender are required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	svc := &Service{
+		db:       db,
+		alarm:    alarmSvc,
+		sender:   sender,
+		interval: constants.BroadcastConfig.SendInterval,
+		logger:   logger.With(slog.String("service", "broadcast")),
+		now:      time.Now,
+		sleep:    sleepContext,
+	}
+	if err := svc.createTablesIfNotExist(ctx); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+func (s *Service) createTablesIfNotExist(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS broadcasts (
+			id TEXT PRIMARY KEY,
+			message TEXT NOT NULL,
+			requested_by TEXT NOT NULL DEFAULT '',
+			filter TEXT NOT NULL,
+			targets TEXT NOT NULL,
+			sent INTEGER NOT NULL DEFAULT 0,
+			failed INTEGER NOT NULL DEFAULT 0,
+			failures TEXT NOT NULL DEFAULT '{}',
+			status TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			completed_at TIMESTAMP
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create broadcasts table: %w", err)
+	}
+
+	if err := db.Exec(`CREATE INDEX IF NOT EXISTS idx_broadcasts_created_at ON broadcasts (created_at)`).Error; err != nil {
+		return fmt.Errorf("failed to create broadcasts index: %w", err)
+	}
+
+	return nil
+}
+
+// Targets: 필터에 맞는 알람 등록 방 목록을 방 ID 순으로 반환합니다.
+func (s *Service) Targets(ctx context.Context, filter Filter) ([]Target, error) {
+	roomIDs, err := s.alarm.GetDistinctRooms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list alarm rooms: %w", err)
+	}
+
+	var dormant map[string]time.Time
+	if !filter.IncludeDormant {
+		if dormant, err = s.alarm.DormantRooms(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	targets := make([]Target, 0, len(roomIDs))
+	for _, roomID := range roomIDs {
+		if len(filter.Rooms) > 0 && !slices.Contains(filter.Rooms, roomID) {
+			continue
+		}
+		if slices.Contains(filter.Exclude, roomID) {
+			continue
+		}
+		if _, ok := dormant[roomID]; ok {
+			continue
+		}
+		if len(filter.Channels) > 0 {
+			channels, err := s.alarm.GetRoomChannels(ctx, roomID)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.ContainsFunc(channels, func(ch string) bool { return slices.Contains(filter.Channels, ch) }) {
+				continue
+			}
+		}
+		roomName, _ := s.alarm.GetRoomName(ctx, roomID)
+		targets = append(targets, Target{RoomID: roomID, RoomName: roomName})
+	}
+
+	slices.SortFunc(targets, func(a, b Target) int { return strings.Compare(a.RoomID, b.RoomID) })
+	return targets, nil
+}
+
+// Send: 공지를 검증하고 대상 방을 계산합니다. DryRun이면 대상 목록만 반환하고,
+// 아니면 감사 기록을 남긴 뒤 백그라운드에서 순차 전송을 시작합니다. 진행 상황은 History로 확인한다.
+func (s *Service) Send(ctx context.Context, req Request) (*Record, error) {
+	message := util.TrimSpace(req.Message)
+	if message == "" {
+		return nil, ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(message) > constants.BroadcastConfig.MaxMessageLength {
+		return nil, ErrMessageTooLong
+	}
+
+	targets, err := s.Targets(ctx, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, ErrNoTargets
+	}
+
+	record := &Record{
+		ID:          uuid.NewString(),
+		Message:     message,
+		RequestedBy: req.RequestedBy,
+		Filter:      req.Filter,
+		Targets:     targets,
+		Status:      StatusDryRun,
+		CreatedAt:   s.now().UTC(),
+	}
+	if req.DryRun {
+		return record, nil
+	}
+
+	if !s.sending.TryLock() {
+		return nil, ErrInProgress
+	}
+	record.Status = StatusSending
+	if err := s.save(ctx, record); err != nil {
+		s.sending.Unlock()
+		return nil, err
+	}
+
+	s.logger.Info("Broadcast started",
+		slog.String("id", record.ID),
+		slog.String("requested_by", record.RequestedBy),
+		slog.Int("targets", len(targets)),
+	)
+
+	// 요청이 끝나도 전송은 계속되어야 하므로 요청 컨텍스트의 취소는 끊는다.
+	snapshot := *record
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer s.sending.Unlock()
+		s.deliver(context.WithoutCancel(ctx), &snapshot)
+	}()
+	return record, nil
+}
+
+// Wait: 진행 중인 전송이 끝날 때까지 기다립니다. (테스트에서 전송 완료를 확인할 때 사용)
+func (s *Service) Wait() {
+	s.wg.Wait()
+}
+
+// deliver: 대상 방에 간격을 두고 순차 전송하고, 결과를 기록에 반영합니다.
+func (s *Service) deliver(ctx context.Context, record *Record) {
+	record.Failures = map[string]string{}
+	for i, target := range record.Targets {
+		if i > 0 {
+			if err := s.sleep(ctx, s.interval); err != nil {
+				break
+			}
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, constants.BroadcastConfig.SendTimeout)
+		err := s.sender.SendMessage(sendCtx, target.RoomID, record.Message)
+		cancel()
+		if err != nil {
+			record.Failed++
+			record.Failures[target.RoomID] = err.Error()
+			s.logger.Warn("Broadcast send failed", slog.String("id", record.ID), slog.String("room", target.RoomID), slog.Any("error", err))
+			continue
+		}
+		record.Sent++
+	}
+
+	completedAt := s.now().UTC()
+	record.CompletedAt = &completedAt
+	record.Status = StatusCompleted
+	if err := s.save(ctx, record); err != nil {
+		s.logger.Error("Failed to save broadcast result", slog.String("id", record.ID), slog.Any("error", err))
+	}
+
+	s.logger.Info("Broadcast completed",
+		slog.String("id", record.ID),
+		slog.Int("sent", record.Sent),
+		slog.Int("failed", record.Failed),
+	)
+}
+
+// History: 최근 공지 기록을 최신순으로 반환합니다.
+func (s *Service) History(ctx context.Context, limit int) ([]*Record, error) {
+	if limit <= 0 {
+		limit = constants.BroadcastConfig.HistoryLimit
+	}
+
+	var rows []broadcastModel
+	if err := s.db.WithContext(ctx).Order("created_at DESC").Limit(limit).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("list broadcasts: %w", err)
+	}
+
+	records := make([]*Record, 0, len(rows))
+	for _, row := range rows {
+		record := &Record{
+			ID:          row.ID,
+			Message:     row.Message,
+			RequestedBy: row.RequestedBy,
+			Sent:        row.Sent,
+			Failed:      row.Failed,
+			Status:      Status(row.Status),
+			CreatedAt:   row.CreatedAt,
+			CompletedAt: row.CompletedAt,
+		}
+		if err := decodeColumns(row, record); err != nil {
+			s.logger.Warn("Failed to decode broadcast record", slog.String("id", row.ID), slog.Any("error", err))
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// save: 기록을 저장합니다. 같은 ID가 있으면 전송 결과로 덮어쓴다.
+func (s *Service) save(ctx context.Context, record *Record) error {
+	filter, err := json.Marshal(record.Filter)
+	if err != nil {
+		return fmt.Errorf("marshal broadcast filter: %w", err)
+	}
+	targets, err := json.Marshal(record.Targets)
+	if err != nil {
+		return fmt.Errorf("marshal broadcast targets: %w", err)
+	}
+	failures, err := json.Marshal(record.Failures)
+	if err != nil {
+		return fmt.Errorf("marshal broadcast failures: %w", err)
+	}
+
+	row := &broadcastModel{
+		ID:          record.ID,
+		Message:     record.Message,
+		RequestedBy: record.RequestedBy,
+		Filter:      string(filter),
+		Targets:     string(targets),
+		Sent:        record.Sent,
+		Failed:      record.Failed,
+		Failures:    string(failures),
+		Status:      string(record.Status),
+		CreatedAt:   record.CreatedAt,
+		CompletedAt: record.CompletedAt,
+	}
+	if err := s.db.WithContext(ctx).Save(row).Error; err != nil {
+		return fmt.Errorf("save broadcast: %w", err)
+	}
+	return nil
+}
+
+func decodeColumns(row broadcastModel, record *Record) error {
+	if err := json.Unmarshal([]byte(row.Filter), &record.Filter); err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(row.Targets), &record.Targets); err != nil {
+		return err
+	}
+	if row.Failures != "" && row.Failures != "null" {
+		return json.Unmarshal([]byte(row.Failures), &record.Failures)
+	}
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
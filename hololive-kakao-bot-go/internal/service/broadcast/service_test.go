@@ -0,0 +1,180 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+)
+
+type fakeSender struct {
+	mu    sync.Mutex
+	sent  []string
+	fails map[string]bool
+}
+
+func (f *fakeSender) SendMessage(_ context.Context, room, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fails[room] {
+		return errors.New("send failed")
+	}
+	f.sent = append(f.sent, room)
+	return nil
+}
+
+func newTestService(t *testing.T, sender Sender) (*Service, *notification.AlarmService) {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+
+	dbName := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	db, err := gorm.Open(sqlite.Open("file:"+dbName+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	alarmSvc := notification.NewAlarmService(cacheSvc, nil, nil, logger, nil)
+	svc, err := NewService(context.Background(), db, alarmSvc, sender, logger)
+	if err != nil {
+		t.Fatalf("failed to create broadcast service: %v", err)
+	}
+	return svc, alarmSvc
+}
+
+func TestSend_DryRunAppliesFilters(t *testing.T) {
+	svc, alarmSvc := newTestService(t, &fakeSender{})
+	ctx := context.Background()
+
+	mustAddAlarm := func(roomID, channelID string) {
+		t.Helper()
+		if _, err := alarmSvc.AddAlarm(ctx, roomID, "u1", channelID, "멤버", "방"+roomID, "유저"); err != nil {
+			t.Fatalf("add alarm: %v", err)
+		}
+	}
+	mustAddAlarm("r1", "ch-a")
+	mustAddAlarm("r2", "ch-b")
+	mustAddAlarm("r3", "ch-a")
+	mustAddAlarm("r4", "ch-a")
+	if _, err := alarmSvc.MarkRoomDormant(ctx, "r4", time.Now()); err != nil {
+		t.Fatalf("mark dormant: %v", err)
+	}
+
+	record, err := svc.Send(ctx, Request{
+		Message: "  점검 안내  ",
+		Filter:  Filter{Channels: []string{"ch-a"}, Exclude: []string{"r3"}},
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if record.Status != StatusDryRun || record.Message != "점검 안내" {
+		t.Fatalf("unexpected dry run record: %+v", record)
+	}
+	if len(record.Targets) != 1 || record.Targets[0].RoomID != "r1" || record.Targets[0].RoomName != "방r1" {
+		t.Fatalf("unexpected targets: %+v", record.Targets)
+	}
+
+	all, err := svc.Targets(ctx, Filter{IncludeDormant: true})
+	if err != nil {
+		t.Fatalf("targets: %v", err)
+	}
+	if len(all) != 4 {
+		t.Fatalf("expected dormant room included, got %+v", all)
+	}
+
+	history, _ := svc.History(ctx, 10)
+	if len(history) != 0 {
+		t.Fatalf("dry run must not be recorded, got %+v", history)
+	}
+
+	if _, err := svc.Send(ctx, Request{Message: " "}); !errors.Is(err, ErrEmptyMessage) {
+		t.Fatalf("expected ErrEmptyMessage, got %v", err)
+	}
+	if _, err := svc.Send(ctx, Request{Message: "공지", Filter: Filter{Rooms: []string{"none"}}}); !errors.Is(err, ErrNoTargets) {
+		t.Fatalf("expected ErrNoTargets, got %v", err)
+	}
+}
+
+func TestSend_ThrottlesAndRecordsAudit(t *testing.T) {
+	sender := &fakeSender{fails: map[string]bool{"r2": true}}
+	svc, alarmSvc := newTestService(t, sender)
+	ctx := context.Background()
+
+	for _, roomID := range []string{"r1", "r2", "r3"} {
+		if _, err := alarmSvc.AddAlarm(ctx, roomID, "u1", "ch-a", "멤버", "방"+roomID, "유저"); err != nil {
+			t.Fatalf("add alarm: %v", err)
+		}
+	}
+
+	var waits []time.Duration
+	svc.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	record, err := svc.Send(ctx, Request{Message: "공지", RequestedBy: "alice"})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if record.Status != StatusSending {
+		t.Fatalf("expected sending status, got %s", record.Status)
+	}
+	svc.Wait()
+
+	if len(waits) != 2 || waits[0] != svc.interval {
+		t.Fatalf("expected throttle between 3 sends, got %v", waits)
+	}
+	if strings.Join(sender.sent, ",") != "r1,r3" {
+		t.Fatalf("unexpected sent rooms: %v", sender.sent)
+	}
+
+	history, err := svc.History(ctx, 10)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(history))
+	}
+	got := history[0]
+	if got.ID != record.ID || got.RequestedBy != "alice" || got.Status != StatusCompleted || got.CompletedAt == nil {
+		t.Fatalf("unexpected audit record: %+v", got)
+	}
+	if got.Sent != 2 || got.Failed != 1 || got.Failures["r2"] == "" || len(got.Targets) != 3 {
+		t.Fatalf("unexpected delivery result: %+v", got)
+	}
+}
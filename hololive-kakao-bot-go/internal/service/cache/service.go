@@ -393,6 +393,15 @@ func (c *Service) HGet(ctx context.Context, key, field string) (string, error) {
 	return value, nil
 }
 
+// HDel: Hash 자료구조에서 특정 필드를 삭제합니다.
+func (c *Service) HDel(ctx context.Context, key, field string) error {
+	if err := c.client.Do(ctx, c.client.B().Hdel().Key(key).Field(field).Build()).Error(); err != nil {
+		c.logger.Error("Cache hdel failed", slog.String("key", key), slog.String("field", field), slog.Any("error", err))
+		return errors.NewCacheError("hdel failed", "hdel", key, err)
+	}
+	return nil
+}
+
 // HGetAll: Hash의 모든 필드와 값을 조회합니다.
 func (c *Service) HGetAll(ctx context.Context, key string) (map[string]string, error) {
 	resp := c.client.Do(ctx, c.client.B().Hgetall().Key(key).Build())
@@ -434,6 +443,14 @@ func (c *Service) Exists(ctx context.Context, key string) (bool, error) {
 	return count > 0, nil
 }
 
+// Publish: Pub/Sub 채널로 메시지를 발행합니다.
+func (c *Service) Publish(ctx context.Context, channel, message string) error {
+	if err := c.client.Do(ctx, c.client.B().Publish().Channel(channel).Message(message).Build()).Error(); err != nil {
+		return errors.NewCacheError("publish failed", "publish", channel, err)
+	}
+	return nil
+}
+
 // Close: 캐시 스토어 연결을 안전하게 종료합니다.
 func (c *Service) Close() error {
 	var closeErr error
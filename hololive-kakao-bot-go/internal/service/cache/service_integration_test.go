@@ -0,0 +1,65 @@
+//go:build integration
+
+package cache_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/testutil"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunMain(m))
+}
+
+func TestScanKeys_IteratesAllCursorPages(t *testing.T) {
+	svc := testutil.NewCacheService(t)
+	ctx := context.Background()
+
+	const total = 350
+	pairs := make(map[string]any, total)
+	for i := range total {
+		pairs[fmt.Sprintf("scan:item:%04d", i)] = i
+	}
+	if err := svc.MSet(ctx, pairs, time.Hour); err != nil {
+		t.Fatalf("mset failed: %v", err)
+	}
+	if err := svc.Set(ctx, "scan:other", "x", time.Hour); err != nil {
+		t.Fatalf("set failed: %v", err)
+	}
+
+	keys, err := svc.ScanKeys(ctx, "scan:item:*", 50)
+	if err != nil {
+		t.Fatalf("scan failed: %v", err)
+	}
+
+	// SCAN은 중복을 반환할 수 있으므로 유니크 기준으로 검증
+	unique := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		unique[k] = struct{}{}
+	}
+	if len(unique) != total {
+		sorted := make([]string, 0, len(unique))
+		for k := range unique {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		t.Fatalf("expected %d keys, got %d (sample: %v)", total, len(unique), sorted[:min(5, len(sorted))])
+	}
+	if _, ok := unique["scan:other"]; ok {
+		t.Fatal("pattern should not match scan:other")
+	}
+
+	deleted, err := svc.DelMany(ctx, keys)
+	if err != nil {
+		t.Fatalf("del many failed: %v", err)
+	}
+	if deleted != total {
+		t.Fatalf("expected %d deleted, got %d", total, deleted)
+	}
+}
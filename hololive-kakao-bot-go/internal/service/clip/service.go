@@ -0,0 +1,220 @@
+package clip
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+const (
+	cacheKeyRooms   = "hololive:clip:rooms"      // 클립 목록을 켠 방 목록
+	cacheKeyPopular = "hololive:clip:popular:%s" // ZSET: 멤버 채널별 클립 채널 ID → 최근 클립 수
+	popularTTL      = 7 * 24 * time.Hour         // 갱신이 멈춘 멤버의 집계는 자연스럽게 사라지도록
+	fetchLimit      = 50                         // 멤버 한 명당 집계에 사용하는 최근 클립 수
+)
+
+// Source: 멤버 채널을 다룬 최근 클립을 제공하는 인터페이스 (holodex.Service가 구현)
+type Source interface {
+	GetRecentClips(ctx context.Context, talentChannelID string, langs []string, limit int) ([]*domain.Clip, error)
+}
+
+// Config: 클립 추적 서비스 설정
+type Config struct {
+	Langs        []string
+	TopChannels  int // 멤버별로 목록에 포함할 인기 클립 채널 수 (기본 5)
+	LookbackDays int // 인기 채널 집계 기간 (기본 14일)
+}
+
+// Service: 구독 멤버를 다루는 인기 클립 채널을 추적하고, 방별로 켠 경우에만 최근 클립 목록을 제공하는 서비스
+// 인기 채널은 최근 기간 동안 해당 멤버의 클립을 올린 수로 정하며, 멤버별 ZSET으로 Valkey에 보관한다.
+type Service struct {
+	source Source
+	cache  *cache.Service
+	cfg    Config
+	logger *slog.Logger
+
+	now func() time.Time
+}
+
+// NewService: 클립 추적 서비스를 생성합니다.
+func NewService(source Source, cacheSvc *cache.Service, cfg Config, logger *slog.Logger) *Service {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.TopChannels <= 0 {
+		cfg.TopChannels = 5
+	}
+	if cfg.LookbackDays <= 0 {
+		cfg.LookbackDays = 14
+	}
+	return &Service{
+		source: source,
+		cache:  cacheSvc,
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// RoomEnabled: 방에 클립 목록이 켜져 있는지 확인합니다. 조회 실패 시 꺼진 것으로 본다.
+func (s *Service) RoomEnabled(ctx context.Context, room string) bool {
+	if s == nil || util.TrimSpace(room) == "" {
+		return false
+	}
+	enabled, err := s.cache.SIsMember(ctx, cacheKeyRooms, room)
+	return err == nil && enabled
+}
+
+// EnableRoom: 방에 클립 목록을 켭니다.
+func (s *Service) EnableRoom(ctx context.Context, room string) (bool, error) {
+	added, err := s.cache.SAdd(ctx, cacheKeyRooms, []string{room})
+	if err != nil {
+		return false, fmt.Errorf("enable clip tracking: %w", err)
+	}
+	return added > 0, nil
+}
+
+// DisableRoom: 방의 클립 목록을 끕니다.
+func (s *Service) DisableRoom(ctx context.Context, room string) (bool, error) {
+	removed, err := s.cache.SRem(ctx, cacheKeyRooms, []string{room})
+	if err != nil {
+		return false, fmt.Errorf("disable clip tracking: %w", err)
+	}
+	return removed > 0, nil
+}
+
+// Rooms: 클립 목록이 켜진 방 목록을 반환합니다.
+func (s *Service) Rooms(ctx context.Context) ([]string, error) {
+	rooms, err := s.cache.SMembers(ctx, cacheKeyRooms)
+	if err != nil {
+		return nil, fmt.Errorf("list clip rooms: %w", err)
+	}
+	return rooms, nil
+}
+
+// Refresh: 멤버의 최근 클립을 가져와 집계 기간 안의 클립 채널별 업로드 수로 인기 채널 순위를 다시 만듭니다.
+func (s *Service) Refresh(ctx context.Context, talentChannelID string) ([]*domain.Clip, error) {
+	clips, err := s.source.GetRecentClips(ctx, talentChannelID, s.cfg.Langs, fetchLimit)
+	if err != nil {
+		return nil, fmt.Errorf("fetch clips for %s: %w", talentChannelID, err)
+	}
+
+	counts := make(map[string]int)
+	for _, clip := range s.withinLookback(clips) {
+		counts[clip.ClipChannelID]++
+	}
+
+	client := s.cache.GetClient()
+	key := popularKey(talentChannelID)
+	cmds := client.B()
+	batch := []valkey.Completed{cmds.Del().Key(key).Build()}
+	if len(counts) > 0 {
+		zadd := cmds.Zadd().Key(key).ScoreMember()
+		for channelID, count := range counts {
+			zadd = zadd.ScoreMember(float64(count), channelID)
+		}
+		batch = append(batch,
+			zadd.Build(),
+			cmds.Expire().Key(key).Seconds(int64(popularTTL/time.Second)).Build(),
+		)
+	}
+	for _, resp := range client.DoMulti(ctx, batch...) {
+		if err := resp.Error(); err != nil {
+			return nil, fmt.Errorf("store popular clip channels: %w", err)
+		}
+	}
+
+	return clips, nil
+}
+
+// PopularChannels: 멤버를 다루는 인기 클립 채널 ID를 클립 수가 많은 순으로 반환합니다.
+func (s *Service) PopularChannels(ctx context.Context, talentChannelID string) ([]string, error) {
+	client := s.cache.GetClient()
+	cmd := client.B().Zrange().Key(popularKey(talentChannelID)).Min("0").Max(strconv.Itoa(s.cfg.TopChannels - 1)).Rev().Build()
+	channelIDs, err := client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list popular clip channels: %w", err)
+	}
+	return channelIDs, nil
+}
+
+// RecentClips: 여러 멤버에 대해 인기 클립 채널이 올린 최근 클립을 최신순으로 최대 limit개 반환합니다.
+// 아직 집계가 없는 멤버는 이 자리에서 집계를 만든다.
+func (s *Service) RecentClips(ctx context.Context, talentChannelIDs []string, limit int) ([]*domain.Clip, error) {
+	var (
+		result  []*domain.Clip
+		seen    = make(map[string]struct{})
+		lastErr error
+	)
+
+	for _, talentID := range talentChannelIDs {
+		popular, err := s.PopularChannels(ctx, talentID)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		var clips []*domain.Clip
+		if len(popular) == 0 {
+			clips, err = s.Refresh(ctx, talentID)
+			if err == nil {
+				popular, err = s.PopularChannels(ctx, talentID)
+			}
+		} else {
+			clips, err = s.source.GetRecentClips(ctx, talentID, s.cfg.Langs, fetchLimit)
+		}
+		if err != nil {
+			s.logger.Warn("Failed to load clips", slog.String("channel_id", talentID), slog.Any("error", err))
+			lastErr = err
+			continue
+		}
+
+		for _, clip := range s.withinLookback(clips) {
+			if !slices.Contains(popular, clip.ClipChannelID) {
+				continue
+			}
+			// 여러 멤버가 함께 나온 클립은 한 번만 표시
+			if _, ok := seen[clip.ID]; ok {
+				continue
+			}
+			seen[clip.ID] = struct{}{}
+			result = append(result, clip)
+		}
+	}
+
+	if len(result) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	slices.SortFunc(result, func(a, b *domain.Clip) int {
+		return b.PublishedAt.Compare(a.PublishedAt)
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *Service) withinLookback(clips []*domain.Clip) []*domain.Clip {
+	since := s.now().Add(-time.Duration(s.cfg.LookbackDays) * 24 * time.Hour)
+	recent := make([]*domain.Clip, 0, len(clips))
+	for _, clip := range clips {
+		if clip.PublishedAt.After(since) {
+			recent = append(recent, clip)
+		}
+	}
+	return recent
+}
+
+func popularKey(talentChannelID string) string {
+	return fmt.Sprintf(cacheKeyPopular, talentChannelID)
+}
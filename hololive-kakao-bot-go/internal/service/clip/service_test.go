@@ -0,0 +1,172 @@
+package clip
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+)
+
+var testNow = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+type fakeSource struct {
+	mu    sync.Mutex
+	clips map[string][]*domain.Clip
+	calls map[string]int
+}
+
+func (f *fakeSource) GetRecentClips(_ context.Context, talentChannelID string, _ []string, _ int) ([]*domain.Clip, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.calls == nil {
+		f.calls = make(map[string]int)
+	}
+	f.calls[talentChannelID]++
+	return f.clips[talentChannelID], nil
+}
+
+func newClip(id, clipChannelID, talentID string, age time.Duration) *domain.Clip {
+	return &domain.Clip{
+		ID:              id,
+		Title:           "clip " + id,
+		ClipChannelID:   clipChannelID,
+		ClipChannelName: "채널 " + clipChannelID,
+		TalentChannelID: talentID,
+		PublishedAt:     testNow.Add(-age),
+	}
+}
+
+func newTestCache(t *testing.T) (*cache.Service, *slog.Logger) {
+	t.Helper()
+
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+	return cacheSvc, logger
+}
+
+func TestRecentClips_OnlyPopularChannelsWithinLookback(t *testing.T) {
+	cacheSvc, logger := newTestCache(t)
+	ctx := context.Background()
+
+	day := 24 * time.Hour
+	source := &fakeSource{clips: map[string][]*domain.Clip{
+		"talent-a": {
+			newClip("a1", "busy", "talent-a", 1*day),
+			newClip("a2", "busy", "talent-a", 2*day),
+			newClip("a3", "second", "talent-a", 3*day),
+			newClip("a4", "rare", "talent-a", 4*day),
+			newClip("a5", "busy", "talent-a", 40*day), // 집계 기간 밖
+			newClip("shared", "second", "talent-a", 5*day),
+			newClip("a6", "busy", "talent-a", 6*day),
+		},
+		"talent-b": {
+			newClip("b1", "busy", "talent-b", 12*time.Hour),
+			newClip("shared", "second", "talent-b", 5*day),
+		},
+	}}
+
+	svc := NewService(source, cacheSvc, Config{TopChannels: 2, LookbackDays: 14}, logger)
+	svc.now = func() time.Time { return testNow }
+
+	popular, err := svc.PopularChannels(ctx, "talent-a")
+	if err != nil || len(popular) != 0 {
+		t.Fatalf("expected no ranking before refresh, got %v (%v)", popular, err)
+	}
+
+	clips, err := svc.RecentClips(ctx, []string{"talent-a", "talent-b"}, 10)
+	if err != nil {
+		t.Fatalf("recent clips: %v", err)
+	}
+
+	popular, _ = svc.PopularChannels(ctx, "talent-a")
+	if len(popular) != 2 || popular[0] != "busy" || popular[1] != "second" {
+		t.Fatalf("unexpected popular channels: %v", popular)
+	}
+
+	ids := make([]string, 0, len(clips))
+	for _, clip := range clips {
+		ids = append(ids, clip.ID)
+	}
+	want := []string{"b1", "a1", "a2", "a3", "shared", "a6"}
+	if len(ids) != len(want) {
+		t.Fatalf("expected %v, got %v", want, ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, ids)
+		}
+	}
+
+	limited, _ := svc.RecentClips(ctx, []string{"talent-a"}, 2)
+	if len(limited) != 2 || limited[0].ID != "a1" {
+		t.Fatalf("unexpected limited clips: %+v", limited)
+	}
+}
+
+func TestTracker_RefreshesOnlyOptedInRooms(t *testing.T) {
+	cacheSvc, logger := newTestCache(t)
+	ctx := context.Background()
+
+	alarmSvc := notification.NewAlarmService(cacheSvc, nil, nil, logger, nil)
+	for _, alarm := range []struct{ room, user, channel string }{
+		{"on", "u1", "talent-a"},
+		{"on", "u2", "talent-a"},
+		{"on", "u2", "talent-b"},
+		{"off", "u3", "talent-c"},
+	} {
+		if _, err := alarmSvc.AddAlarm(ctx, alarm.room, alarm.user, alarm.channel, "멤버", "방", "사용자"); err != nil {
+			t.Fatalf("add alarm: %v", err)
+		}
+	}
+
+	source := &fakeSource{clips: map[string][]*domain.Clip{
+		"talent-a": {newClip("a1", "busy", "talent-a", time.Hour)},
+	}}
+	svc := NewService(source, cacheSvc, Config{}, logger)
+	svc.now = func() time.Time { return testNow }
+
+	if _, err := svc.EnableRoom(ctx, "on"); err != nil {
+		t.Fatalf("enable room: %v", err)
+	}
+	if !svc.RoomEnabled(ctx, "on") || svc.RoomEnabled(ctx, "off") {
+		t.Fatal("unexpected room opt-in state")
+	}
+
+	tracker := NewTracker(svc, alarmSvc, time.Hour, logger)
+	if got := tracker.refresh(ctx); got != 2 {
+		t.Fatalf("expected 2 talents refreshed, got %d", got)
+	}
+	if source.calls["talent-a"] != 1 || source.calls["talent-b"] != 1 || source.calls["talent-c"] != 0 {
+		t.Fatalf("unexpected source calls: %v", source.calls)
+	}
+	if popular, _ := svc.PopularChannels(ctx, "talent-a"); len(popular) != 1 || popular[0] != "busy" {
+		t.Fatalf("unexpected popular channels: %v", popular)
+	}
+
+	if _, err := svc.DisableRoom(ctx, "on"); err != nil {
+		t.Fatalf("disable room: %v", err)
+	}
+	if got := tracker.refresh(ctx); got != 0 {
+		t.Fatalf("expected no refresh after opt-out, got %d", got)
+	}
+}
@@ -0,0 +1,101 @@
+package clip
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+)
+
+// Tracker: 클립을 켠 방들의 구독 멤버에 대해 인기 클립 채널 집계를 주기적으로 갱신하는 백그라운드 작업
+type Tracker struct {
+	clips    *Service
+	alarm    *notification.AlarmService
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewTracker: 클립 집계 갱신 작업을 생성합니다. 클립 서비스가 없으면 nil을 반환한다.
+func NewTracker(clips *Service, alarm *notification.AlarmService, interval time.Duration, logger *slog.Logger) *Tracker {
+	if clips == nil || alarm == nil {
+		return nil
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Tracker{
+		clips:    clips,
+		alarm:    alarm,
+		interval: interval,
+		logger:   logger.With(slog.String("service", "clip_tracker")),
+	}
+}
+
+// Start: ctx가 종료될 때까지 주기적으로 구독 멤버의 클립 집계를 갱신합니다.
+func (t *Tracker) Start(ctx context.Context) {
+	t.logger.Info("Starting clip tracker", slog.Duration("interval", t.interval))
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.logger.Info("Clip tracker stopped")
+			return
+		case <-ticker.C:
+			t.refresh(ctx)
+		}
+	}
+}
+
+// refresh: 클립을 켠 방의 구독 멤버를 모아 멤버마다 한 번씩 집계를 갱신하고, 갱신한 멤버 수를 반환합니다.
+func (t *Tracker) refresh(ctx context.Context) int {
+	talentIDs, err := t.trackedTalents(ctx)
+	if err != nil {
+		t.logger.Warn("Failed to collect tracked talents", slog.Any("error", err))
+		return 0
+	}
+
+	refreshed := 0
+	for _, talentID := range talentIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		if _, err := t.clips.Refresh(ctx, talentID); err != nil {
+			t.logger.Warn("Failed to refresh clip channels", slog.String("channel_id", talentID), slog.Any("error", err))
+			continue
+		}
+		refreshed++
+	}
+
+	if refreshed > 0 {
+		t.logger.Debug("Clip channels refreshed", slog.Int("talents", refreshed))
+	}
+	return refreshed
+}
+
+func (t *Tracker) trackedTalents(ctx context.Context) ([]string, error) {
+	rooms, err := t.clips.Rooms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	talentIDs := make([]string, 0)
+	for _, room := range rooms {
+		channelIDs, err := t.alarm.GetRoomChannels(ctx, room)
+		if err != nil {
+			return nil, err
+		}
+		for _, channelID := range channelIDs {
+			if _, ok := seen[channelID]; ok {
+				continue
+			}
+			seen[channelID] = struct{}{}
+			talentIDs = append(talentIDs, channelID)
+		}
+	}
+	return talentIDs, nil
+}
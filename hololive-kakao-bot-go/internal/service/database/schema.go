@@ -0,0 +1,89 @@
+package database
+
+import "github.com/kapu/hololive-kakao-bot-go/internal/health"
+
+// SchemaExpectations: 상세 헬스체크가 검증하는 필수 스키마 목록
+// scripts/init-db, scripts/migrations(008 인덱스 정리 이후 기준)와 서비스 기동 시 생성하는 테이블을 반영합니다.
+// 컬럼은 쿼리에서 실제로 참조하는 것만, 인덱스는 주요 조회 경로에 쓰이는 것만 나열합니다.
+func SchemaExpectations() []health.SchemaExpectation {
+	return []health.SchemaExpectation{
+		{
+			Table: "members",
+			Columns: []string{
+				"id", "slug", "channel_id", "english_name", "japanese_name", "korean_name",
+				"status", "is_graduated", "aliases", "photo", "photo_updated_at",
+			},
+			Indexes: []string{"idx_members_channel_id", "idx_members_photo_updated_at"},
+		},
+		{
+			Table:   "alarms",
+			Columns: []string{"id", "room_id", "user_id", "channel_id", "member_name", "room_name", "user_name", "created_at"},
+			Indexes: []string{"idx_alarms_room_user", "idx_alarms_channel"},
+		},
+		{
+			Table:   "youtube_stats_history",
+			Columns: []string{"time", "channel_id", "member_name", "subscribers", "videos", "views"},
+			Indexes: []string{"idx_youtube_stats_history_channel_time"},
+		},
+		{
+			Table: "youtube_stats_changes",
+			Columns: []string{
+				"channel_id", "member_name", "subscriber_change", "video_change", "view_change",
+				"previous_subs", "current_subs", "previous_videos", "current_videos", "detected_at", "notified",
+			},
+			Indexes: []string{"idx_changes_channel_detected"},
+		},
+		{
+			Table:   "youtube_milestones",
+			Columns: []string{"id", "channel_id", "member_name", "type", "value", "achieved_at", "notified"},
+			Indexes: []string{"idx_milestones_channel_type", "idx_milestones_lookup", "idx_milestones_unnotified"},
+		},
+		{
+			Table:   "youtube_milestone_approaching",
+			Columns: []string{"id", "channel_id", "milestone_value", "notified_at", "current_subs", "chat_notified"},
+		},
+		{
+			Table:   "room_departures",
+			Columns: []string{"id", "room_id", "room_name", "reason", "requested_by", "stats", "departed_at"},
+			Indexes: []string{"idx_room_departures_room_id"},
+		},
+		{
+			Table:   "broadcasts",
+			Columns: []string{"id", "message", "requested_by", "filter", "targets", "sent", "failed", "failures", "status", "created_at", "completed_at"},
+			Indexes: []string{"idx_broadcasts_created_at"},
+		},
+		{
+			Table:   "talent_profiles",
+			Columns: []string{"slug", "english_name", "japanese_name", "catchphrase", "description", "data_entries", "social_links", "official_url", "updated_at"},
+		},
+		{
+			Table:   "talent_profile_translations",
+			Columns: []string{"slug", "locale", "display_name", "catchphrase", "summary", "highlights", "data", "edited_by", "updated_at"},
+		},
+		{
+			Table:   "auth_users",
+			Columns: []string{"id", "email", "password_hash", "display_name", "avatar_url", "created_at", "updated_at"},
+		},
+		{
+			Table:   "auth_password_reset_tokens",
+			Columns: []string{"token_hash", "user_id", "expires_at", "used_at", "created_at"},
+		},
+		{
+			Table:   "acl_settings",
+			Columns: []string{"id", "key", "value"},
+		},
+		{
+			Table:   "acl_rooms",
+			Columns: []string{"id", "room_id"},
+		},
+		{
+			Table:   "command_latency_samples",
+			Columns: []string{"id", "command", "duration_ms", "recorded_at"},
+			Indexes: []string{"idx_command_latency_samples_recorded_at"},
+		},
+		{
+			Table:   "command_latency_daily",
+			Columns: []string{"day", "command", "samples", "p50_ms", "p95_ms", "p99_ms", "max_ms", "slow_count", "updated_at"},
+		},
+	}
+}
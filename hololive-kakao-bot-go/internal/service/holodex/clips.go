@@ -0,0 +1,112 @@
+package holodex
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// maxClipsPerRequest: Holodex /channels/{id}/clips 한 번에 받을 수 있는 최대 개수
+const maxClipsPerRequest = 50
+
+// ClipRaw: Holodex API로부터 수신한 클립 영상 정보의 Raw 데이터 구조체
+type ClipRaw struct {
+	ID          string      `json:"id"`
+	Title       string      `json:"title"`
+	PublishedAt *string     `json:"published_at,omitempty"`
+	AvailableAt *string     `json:"available_at,omitempty"`
+	Duration    *int        `json:"duration,omitempty"`
+	Channel     *ChannelRaw `json:"channel,omitempty"`
+}
+
+// GetRecentClips: 특정 멤버 채널을 다룬 최근 클립 목록을 최신순으로 조회합니다. (캐시 적용)
+// langs가 비어 있으면 언어 필터 없이 조회한다.
+func (h *Service) GetRecentClips(ctx context.Context, talentChannelID string, langs []string, limit int) ([]*domain.Clip, error) {
+	if limit <= 0 || limit > maxClipsPerRequest {
+		limit = maxClipsPerRequest
+	}
+	lang := strings.Join(langs, ",")
+	cacheKey := fmt.Sprintf("recent_clips_%s_%s_%d", talentChannelID, lang, limit)
+
+	var cached []*domain.Clip
+	if err := h.cache.Get(ctx, cacheKey, &cached); err == nil && cached != nil {
+		return cached, nil
+	}
+
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", limit))
+	if lang != "" {
+		params.Set("lang", lang)
+	}
+
+	body, err := h.doRequest(ctx, priorityOptional, "GET", "/channels/"+url.PathEscape(talentChannelID)+"/clips", params)
+	if err != nil {
+		h.logger.Error("Failed to get recent clips",
+			slog.String("channel_id", talentChannelID),
+			slog.Any("error", err),
+		)
+		return nil, fmt.Errorf("get recent clips: %w", err)
+	}
+
+	var rawClips []ClipRaw
+	if err := json.Unmarshal(body, &rawClips); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recent clips: %w", err)
+	}
+
+	clips := mapClipsResponse(talentChannelID, rawClips)
+	_ = h.cache.Set(ctx, cacheKey, clips, constants.CacheTTL.RecentClips)
+
+	return clips, nil
+}
+
+// mapClipsResponse: 클립 Raw 데이터를 도메인 모델로 변환합니다. 채널 정보나 게시 시각이 없는 항목은 건너뛴다.
+func mapClipsResponse(talentChannelID string, rawClips []ClipRaw) []*domain.Clip {
+	clips := make([]*domain.Clip, 0, len(rawClips))
+	for _, raw := range rawClips {
+		if raw.ID == "" || raw.Channel == nil || raw.Channel.ID == "" {
+			continue
+		}
+
+		published := parseClipTime(raw.PublishedAt)
+		if published.IsZero() {
+			published = parseClipTime(raw.AvailableAt)
+		}
+		if published.IsZero() {
+			continue
+		}
+
+		clip := &domain.Clip{
+			ID:              raw.ID,
+			Title:           raw.Title,
+			ClipChannelID:   raw.Channel.ID,
+			ClipChannelName: raw.Channel.Name,
+			TalentChannelID: talentChannelID,
+			PublishedAt:     published,
+			Link:            fmt.Sprintf("https://www.youtube.com/watch?v=%s", raw.ID),
+		}
+		if raw.Duration != nil {
+			clip.Duration = *raw.Duration
+		}
+		clips = append(clips, clip)
+	}
+	return clips
+}
+
+func parseClipTime(raw *string) time.Time {
+	if raw == nil || *raw == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, *raw)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
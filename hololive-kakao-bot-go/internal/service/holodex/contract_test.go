@@ -0,0 +1,114 @@
+package holodex_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex/holodextest"
+	"github.com/kapu/hololive-kakao-bot-go/pkg/errors"
+)
+
+// fixtureRequester: 픽스처 데이터를 Holodex API 응답 형식으로 돌려주는 Requester
+// 서버 쪽 필터(status, channel_id, max_upcoming_hours)와 정렬(sort=start_scheduled)만 흉내 내고 org 필터는 무시해, 클라이언트 쪽 Hololive 필터도 함께 검증한다.
+type fixtureRequester struct {
+	fixture *holodextest.Fixture
+}
+
+func (r *fixtureRequester) DoRequest(_ context.Context, _ string, path string, params url.Values) ([]byte, error) {
+	if channelID, ok := strings.CutPrefix(path, "/channels/"); ok {
+		channel := r.fixture.Channel(channelID)
+		if channel == nil {
+			return nil, errors.NewAPIError("channel not found", 404, nil)
+		}
+		return json.Marshal(toChannelRaw(channel))
+	}
+	if path != "/live" {
+		return nil, errors.NewAPIError("unexpected path "+path, 404, nil)
+	}
+
+	statuses := strings.Split(params.Get("status"), ",")
+	channelID := params.Get("channel_id")
+	maxHours, _ := strconv.Atoi(params.Get("max_upcoming_hours"))
+	limit := time.Now().Add(time.Duration(maxHours) * time.Hour)
+
+	streams := slices.Clone(r.fixture.Streams)
+	if params.Get("sort") == "start_scheduled" {
+		domain.SortStreamsByStart(streams)
+	}
+
+	raws := make([]holodex.StreamRaw, 0)
+	for _, stream := range streams {
+		if !slices.Contains(statuses, string(stream.Status)) {
+			continue
+		}
+		if channelID != "" && stream.ChannelID != channelID {
+			continue
+		}
+		if maxHours > 0 && stream.IsUpcoming() && stream.StartScheduled.After(limit) {
+			continue
+		}
+		raws = append(raws, toStreamRaw(stream))
+	}
+	return json.Marshal(raws)
+}
+
+func (r *fixtureRequester) IsCircuitOpen() bool { return false }
+
+func toChannelRaw(channel *domain.Channel) holodex.ChannelRaw {
+	return holodex.ChannelRaw{ID: channel.ID, Name: channel.Name, Org: channel.Org}
+}
+
+func toStreamRaw(stream *domain.Stream) holodex.StreamRaw {
+	raw := holodex.StreamRaw{
+		ID:        stream.ID,
+		Title:     stream.Title,
+		ChannelID: &stream.ChannelID,
+		Status:    stream.Status,
+	}
+	if stream.StartScheduled != nil {
+		scheduled := stream.StartScheduled.Format(time.RFC3339)
+		raw.StartScheduled = &scheduled
+	}
+	if stream.StartActual != nil {
+		actual := stream.StartActual.Format(time.RFC3339)
+		raw.StartActual = &actual
+	}
+	if stream.Channel != nil {
+		channel := toChannelRaw(stream.Channel)
+		raw.Channel = &channel
+	}
+	return raw
+}
+
+func TestService_StreamProviderContract(t *testing.T) {
+	holodextest.RunStreamProviderContract(t, func(t *testing.T, fixture *holodextest.Fixture) holodex.StreamProvider {
+		mini := miniredis.RunT(t)
+		host, portStr, err := net.SplitHostPort(mini.Addr())
+		if err != nil {
+			t.Fatalf("failed to split address: %v", err)
+		}
+		port, _ := strconv.Atoi(portStr)
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+		if err != nil {
+			t.Fatalf("failed to create cache service: %v", err)
+		}
+		t.Cleanup(func() { _ = cacheSvc.Close() })
+
+		return holodex.NewServiceWithRequester(&fixtureRequester{fixture: fixture}, cacheSvc, nil, logger)
+	})
+}
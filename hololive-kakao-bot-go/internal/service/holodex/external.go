@@ -0,0 +1,87 @@
+package holodex
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// ExternalStreamSource: Holodex가 수집하지 않는 플랫폼(Twitch 등)의 방송을 제공하는 소스
+// 반환하는 방송의 ChannelID는 멤버의 YouTube 채널 ID여야 한다.
+type ExternalStreamSource interface {
+	GetLiveStreams(ctx context.Context) ([]*domain.Stream, error)
+	GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error)
+	GetChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error)
+}
+
+// SetExternalSource: Holodex 결과에 합쳐질 외부 방송 소스를 설정합니다. nil이면 Holodex 결과만 사용한다.
+func (h *Service) SetExternalSource(source ExternalStreamSource) {
+	h.external = source
+}
+
+// GetLiveStreams: 현재 진행 중인('live') 모든 Hololive 스트림 목록을 조회한다. (외부 소스 방송 포함)
+func (h *Service) GetLiveStreams(ctx context.Context) ([]*domain.Stream, error) {
+	streams, err := h.fetchLiveStreams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.external == nil {
+		return streams, nil
+	}
+
+	extra, err := h.external.GetLiveStreams(ctx)
+	return h.mergeExternal("live", streams, extra, err, false), nil
+}
+
+// GetUpcomingStreams: 향후 예정된('upcoming') Hololive 스트림 목록을 조회한다. (외부 소스 방송 포함, 시작 시각 순)
+func (h *Service) GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error) {
+	streams, err := h.fetchUpcomingStreams(ctx, hours)
+	if err != nil {
+		return nil, err
+	}
+	if h.external == nil {
+		return streams, nil
+	}
+
+	extra, err := h.external.GetUpcomingStreams(ctx, hours)
+	return h.mergeExternal("upcoming", streams, extra, err, true), nil
+}
+
+// GetChannelSchedule: 특정 채널의 방송 일정(예정된 방송)을 조회합니다.
+// includeLive가 true이면 현재 진행 중인 방송도 포함한다. 외부 소스 방송도 시작 시각 순으로 합쳐진다.
+func (h *Service) GetChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error) {
+	streams, err := h.fetchChannelSchedule(ctx, channelID, hours, includeLive)
+	if err != nil {
+		return nil, err
+	}
+	if h.external == nil {
+		return streams, nil
+	}
+
+	extra, err := h.external.GetChannelSchedule(ctx, channelID, hours, includeLive)
+	return h.mergeExternal("channel_schedule", streams, extra, err, true), nil
+}
+
+// mergeExternal: 외부 소스 결과를 Holodex 결과 뒤에 합친다. 외부 소스 실패는 경고만 남기고 Holodex 결과를 그대로 사용한다.
+// 캐시된 슬라이스를 건드리지 않도록 항상 새 슬라이스를 만든다.
+func (h *Service) mergeExternal(kind string, streams, extra []*domain.Stream, extraErr error, sortByStart bool) []*domain.Stream {
+	if extraErr != nil {
+		h.logger.Warn("External stream source failed, using Holodex only",
+			slog.String("kind", kind),
+			slog.Any("error", extraErr),
+		)
+		return streams
+	}
+	if len(extra) == 0 {
+		return streams
+	}
+
+	merged := make([]*domain.Stream, 0, len(streams)+len(extra))
+	merged = append(merged, streams...)
+	merged = append(merged, extra...)
+	if sortByStart {
+		domain.SortStreamsByStart(merged)
+	}
+	return merged
+}
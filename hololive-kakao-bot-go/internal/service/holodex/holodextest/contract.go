@@ -0,0 +1,127 @@
+package holodextest
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+)
+
+// ProviderFactory: 픽스처 데이터를 제공하는 StreamProvider 구현체를 생성한다.
+// 실제 클라이언트는 픽스처를 Holodex 응답으로 흉내 내는 Requester로, 대체 제공자는 각자의 원천 데이터로 픽스처를 재현해야 한다.
+type ProviderFactory func(t *testing.T, fixture *Fixture) holodex.StreamProvider
+
+// RunStreamProviderContract: 모든 StreamProvider 구현체가 지켜야 할 동작을 검증합니다.
+// 명령어와 알람은 이 규칙(Hololive 외 채널 제외, 예정 방송 시작 시각 순, 없는 채널은 nil)에 기대고 있다.
+func RunStreamProviderContract(t *testing.T, newProvider ProviderFactory) {
+	t.Helper()
+
+	setup := func(t *testing.T) holodex.StreamProvider {
+		t.Helper()
+		return newProvider(t, NewFixture(time.Now()))
+	}
+
+	t.Run("GetChannel returns known channel", func(t *testing.T) {
+		channel, err := setup(t).GetChannel(context.Background(), PekoraChannelID)
+		if err != nil {
+			t.Fatalf("GetChannel: %v", err)
+		}
+		if channel == nil || channel.ID != PekoraChannelID || channel.Name == "" {
+			t.Fatalf("unexpected channel: %+v", channel)
+		}
+	})
+
+	t.Run("GetChannel returns nil for unknown channel", func(t *testing.T) {
+		channel, err := setup(t).GetChannel(context.Background(), UnknownChannelID)
+		if err != nil || channel != nil {
+			t.Fatalf("expected (nil, nil), got (%+v, %v)", channel, err)
+		}
+	})
+
+	t.Run("GetLiveStreams returns only live Hololive streams", func(t *testing.T) {
+		streams, err := setup(t).GetLiveStreams(context.Background())
+		if err != nil {
+			t.Fatalf("GetLiveStreams: %v", err)
+		}
+		assertIDs(t, streams, []string{"peko-live"}, false)
+		for _, stream := range streams {
+			if !stream.IsLive() || stream.ChannelID == "" || stream.GetWatchURL() == "" {
+				t.Fatalf("incomplete live stream: %+v", stream)
+			}
+		}
+	})
+
+	t.Run("GetUpcomingStreams filters by window and sorts by start", func(t *testing.T) {
+		streams, err := setup(t).GetUpcomingStreams(context.Background(), 24)
+		if err != nil {
+			t.Fatalf("GetUpcomingStreams: %v", err)
+		}
+		assertIDs(t, streams, []string{"miko-soon", "peko-soon"}, true)
+		for _, stream := range streams {
+			if !stream.IsUpcoming() || stream.StartScheduled == nil {
+				t.Fatalf("unexpected upcoming stream: %+v", stream)
+			}
+		}
+	})
+
+	t.Run("GetChannelSchedule excludes live unless requested", func(t *testing.T) {
+		provider := setup(t)
+
+		upcoming, err := provider.GetChannelSchedule(context.Background(), PekoraChannelID, 48, false)
+		if err != nil {
+			t.Fatalf("GetChannelSchedule: %v", err)
+		}
+		assertIDs(t, upcoming, []string{"peko-soon", "peko-later"}, true)
+
+		withLive, err := provider.GetChannelSchedule(context.Background(), PekoraChannelID, 48, true)
+		if err != nil {
+			t.Fatalf("GetChannelSchedule(includeLive): %v", err)
+		}
+		assertIDs(t, withLive, []string{"peko-live", "peko-soon", "peko-later"}, false)
+		for _, stream := range withLive {
+			if stream.ChannelID != PekoraChannelID {
+				t.Fatalf("stream from another channel: %+v", stream)
+			}
+		}
+	})
+
+	t.Run("results are safe to modify", func(t *testing.T) {
+		provider := setup(t)
+
+		first, err := provider.GetUpcomingStreams(context.Background(), 24)
+		if err != nil || len(first) == 0 {
+			t.Fatalf("GetUpcomingStreams: %v (%d)", err, len(first))
+		}
+		first[0].Title = "changed"
+		first[0] = nil
+
+		second, err := provider.GetUpcomingStreams(context.Background(), 24)
+		if err != nil {
+			t.Fatalf("GetUpcomingStreams: %v", err)
+		}
+		assertIDs(t, second, []string{"miko-soon", "peko-soon"}, true)
+		if second[0].Title == "changed" {
+			t.Fatal("modifying a result changed later results")
+		}
+	})
+}
+
+// assertIDs: 방송 ID 목록이 기대값과 같은지 확인합니다. ordered가 false면 순서는 비교하지 않는다.
+func assertIDs(t *testing.T, streams []*domain.Stream, want []string, ordered bool) {
+	t.Helper()
+
+	got := make([]string, 0, len(streams))
+	for _, stream := range streams {
+		got = append(got, stream.ID)
+	}
+	if !ordered {
+		got = slices.Sorted(slices.Values(got))
+		want = slices.Sorted(slices.Values(want))
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected streams %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,73 @@
+// Package holodextest: holodex.StreamProvider 테스트 대역(MockStreamProvider)과 구현체 공통 계약 테스트를 제공한다.
+package holodextest
+
+import (
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// 계약 테스트 픽스처에 사용하는 채널 ID
+const (
+	PekoraChannelID  = "UC1DCedRgGHBdm81E1llLhOQ"  // Hololive, 라이브 1개 + 예정 2개
+	MikoChannelID    = "UC-hM6YJuNYVAmUWxeIr9FeA"  // Hololive, 예정 1개
+	OutsideChannelID = "UCoutside0000000000000000" // Hololive가 아닌 채널, 목록 조회에서 제외되어야 함
+	UnknownChannelID = "UCunknown0000000000000000" // 존재하지 않는 채널
+)
+
+// Fixture: 계약 테스트와 MockStreamProvider가 공유하는 채널/방송 데이터
+type Fixture struct {
+	Channels []*domain.Channel
+	Streams  []*domain.Stream
+}
+
+// NewFixture: now를 기준으로 진행 중/예정 방송이 섞인 기본 픽스처를 생성합니다.
+func NewFixture(now time.Time) *Fixture {
+	pekora := newChannel(PekoraChannelID, "Pekora Ch. 兎田ぺこら", "Hololive")
+	miko := newChannel(MikoChannelID, "Miko Ch. さくらみこ", "Hololive")
+	outside := newChannel(OutsideChannelID, "Outside Ch.", "Independents")
+
+	return &Fixture{
+		Channels: []*domain.Channel{pekora, miko, outside},
+		Streams: []*domain.Stream{
+			newStream("peko-live", pekora, domain.StreamStatusLive, now.Add(-40*time.Minute), true),
+			newStream("peko-soon", pekora, domain.StreamStatusUpcoming, now.Add(2*time.Hour), false),
+			newStream("peko-later", pekora, domain.StreamStatusUpcoming, now.Add(30*time.Hour), false),
+			newStream("miko-soon", miko, domain.StreamStatusUpcoming, now.Add(time.Hour), false),
+			newStream("outside-live", outside, domain.StreamStatusLive, now.Add(-10*time.Minute), true),
+			newStream("outside-soon", outside, domain.StreamStatusUpcoming, now.Add(3*time.Hour), false),
+		},
+	}
+}
+
+// Channel: 채널 ID로 픽스처 채널을 찾습니다. 없으면 nil
+func (f *Fixture) Channel(channelID string) *domain.Channel {
+	for _, channel := range f.Channels {
+		if channel.ID == channelID {
+			return channel
+		}
+	}
+	return nil
+}
+
+func newChannel(id, name, org string) *domain.Channel {
+	return &domain.Channel{ID: id, Name: name, Org: &org}
+}
+
+func newStream(id string, channel *domain.Channel, status domain.StreamStatus, start time.Time, started bool) *domain.Stream {
+	stream := &domain.Stream{
+		ID:          id,
+		Title:       "【配信】" + id,
+		ChannelID:   channel.ID,
+		ChannelName: channel.Name,
+		Status:      status,
+		Channel:     channel,
+	}
+	scheduled := start
+	stream.StartScheduled = &scheduled
+	if started {
+		actual := start
+		stream.StartActual = &actual
+	}
+	return stream
+}
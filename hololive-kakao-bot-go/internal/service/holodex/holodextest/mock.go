@@ -0,0 +1,160 @@
+package holodextest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+)
+
+var _ holodex.StreamProvider = (*MockStreamProvider)(nil)
+
+// 호출 횟수 조회용 메서드 이름
+const (
+	MethodGetChannel         = "GetChannel"
+	MethodGetChannelSchedule = "GetChannelSchedule"
+	MethodGetLiveStreams     = "GetLiveStreams"
+	MethodGetUpcomingStreams = "GetUpcomingStreams"
+)
+
+// MockStreamProvider: holodex.StreamProvider 테스트 대역
+// 기본적으로 Fixture 데이터로 실제 클라이언트와 같은 규칙(Hololive만, 시작 시각 순 등)을 따라 응답하며,
+// Err를 설정하면 모든 메서드가 그 오류를 반환하고, *Func 필드를 설정하면 해당 메서드만 대신 실행한다.
+type MockStreamProvider struct {
+	Fixture *Fixture
+	Err     error
+
+	GetChannelFunc         func(ctx context.Context, channelID string) (*domain.Channel, error)
+	GetChannelScheduleFunc func(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error)
+	GetLiveStreamsFunc     func(ctx context.Context) ([]*domain.Stream, error)
+	GetUpcomingStreamsFunc func(ctx context.Context, hours int) ([]*domain.Stream, error)
+
+	mu    sync.Mutex
+	calls map[string]int
+	now   func() time.Time
+}
+
+// NewMockStreamProvider: 픽스처로 응답하는 테스트 대역을 생성합니다. fixture가 nil이면 빈 데이터로 응답한다.
+func NewMockStreamProvider(fixture *Fixture) *MockStreamProvider {
+	if fixture == nil {
+		fixture = &Fixture{}
+	}
+	return &MockStreamProvider{Fixture: fixture, now: time.Now}
+}
+
+// Calls: 메서드별 호출 횟수를 반환합니다.
+func (m *MockStreamProvider) Calls(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[method]
+}
+
+// GetChannel: 픽스처에서 채널을 찾아 복사본을 반환합니다. 없으면 (nil, nil)
+func (m *MockStreamProvider) GetChannel(ctx context.Context, channelID string) (*domain.Channel, error) {
+	if err := m.record(ctx, MethodGetChannel); err != nil {
+		return nil, err
+	}
+	if m.GetChannelFunc != nil {
+		return m.GetChannelFunc(ctx, channelID)
+	}
+
+	channel := m.Fixture.Channel(channelID)
+	if channel == nil {
+		return nil, nil
+	}
+	copied := *channel
+	return &copied, nil
+}
+
+// GetChannelSchedule: 채널의 예정 방송(includeLive면 진행 중 방송 포함)을 시작 시각 순으로 반환합니다.
+func (m *MockStreamProvider) GetChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error) {
+	if err := m.record(ctx, MethodGetChannelSchedule); err != nil {
+		return nil, err
+	}
+	if m.GetChannelScheduleFunc != nil {
+		return m.GetChannelScheduleFunc(ctx, channelID, hours, includeLive)
+	}
+
+	streams := m.filter(func(stream *domain.Stream) bool {
+		if stream.ChannelID != channelID {
+			return false
+		}
+		if stream.IsLive() {
+			return includeLive
+		}
+		return m.upcomingWithin(stream, hours)
+	})
+	domain.SortStreamsByStart(streams)
+	return streams, nil
+}
+
+// GetLiveStreams: 진행 중인 Hololive 방송을 반환합니다.
+func (m *MockStreamProvider) GetLiveStreams(ctx context.Context) ([]*domain.Stream, error) {
+	if err := m.record(ctx, MethodGetLiveStreams); err != nil {
+		return nil, err
+	}
+	if m.GetLiveStreamsFunc != nil {
+		return m.GetLiveStreamsFunc(ctx)
+	}
+
+	return m.filter(func(stream *domain.Stream) bool {
+		return stream.IsLive() && isHololive(stream)
+	}), nil
+}
+
+// GetUpcomingStreams: hours 이내 시작하는 Hololive 예정 방송을 시작 시각 순으로 반환합니다.
+func (m *MockStreamProvider) GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error) {
+	if err := m.record(ctx, MethodGetUpcomingStreams); err != nil {
+		return nil, err
+	}
+	if m.GetUpcomingStreamsFunc != nil {
+		return m.GetUpcomingStreamsFunc(ctx, hours)
+	}
+
+	streams := m.filter(func(stream *domain.Stream) bool {
+		return isHololive(stream) && m.upcomingWithin(stream, hours)
+	})
+	domain.SortStreamsByStart(streams)
+	return streams, nil
+}
+
+func (m *MockStreamProvider) record(ctx context.Context, method string) error {
+	m.mu.Lock()
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+	m.calls[method]++
+	m.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return m.Err
+}
+
+// filter: 조건에 맞는 방송의 복사본을 반환합니다. (호출자가 결과를 수정해도 픽스처는 그대로 유지)
+func (m *MockStreamProvider) filter(keep func(stream *domain.Stream) bool) []*domain.Stream {
+	streams := make([]*domain.Stream, 0)
+	for _, stream := range m.Fixture.Streams {
+		if !keep(stream) {
+			continue
+		}
+		copied := *stream
+		streams = append(streams, &copied)
+	}
+	return streams
+}
+
+func (m *MockStreamProvider) upcomingWithin(stream *domain.Stream, hours int) bool {
+	if !stream.IsUpcoming() || stream.StartActual != nil || stream.StartScheduled == nil {
+		return false
+	}
+	now := m.now()
+	return stream.StartScheduled.After(now) && !stream.StartScheduled.After(now.Add(time.Duration(hours)*time.Hour))
+}
+
+func isHololive(stream *domain.Stream) bool {
+	return stream.Channel != nil && stream.Channel.Org != nil && *stream.Channel.Org == "Hololive"
+}
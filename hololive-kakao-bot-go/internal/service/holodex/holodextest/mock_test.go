@@ -0,0 +1,37 @@
+package holodextest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
+)
+
+func TestMockStreamProvider_Contract(t *testing.T) {
+	RunStreamProviderContract(t, func(_ *testing.T, fixture *Fixture) holodex.StreamProvider {
+		return NewMockStreamProvider(fixture)
+	})
+}
+
+func TestMockStreamProvider_OverridesAndErrors(t *testing.T) {
+	mock := NewMockStreamProvider(nil)
+	mock.GetLiveStreamsFunc = func(context.Context) ([]*domain.Stream, error) {
+		return []*domain.Stream{{ID: "custom"}}, nil
+	}
+
+	streams, err := mock.GetLiveStreams(context.Background())
+	if err != nil || len(streams) != 1 || streams[0].ID != "custom" {
+		t.Fatalf("expected override result, got %v (%v)", streams, err)
+	}
+
+	want := errors.New("boom")
+	mock.Err = want
+	if _, err := mock.GetChannel(context.Background(), PekoraChannelID); !errors.Is(err, want) {
+		t.Fatalf("expected configured error, got %v", err)
+	}
+	if mock.Calls(MethodGetChannel) != 1 || mock.Calls(MethodGetLiveStreams) != 1 {
+		t.Fatalf("unexpected call counts")
+	}
+}
@@ -0,0 +1,23 @@
+package holodex
+
+import (
+	"context"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// StreamProvider: 채널 정보와 방송 목록을 제공하는 인터페이스
+// 명령어, 알람, 매처 등은 holodex.Service 대신 이 인터페이스에 의존하며, 테스트 대역과 대체 제공자도 이를 구현한다.
+// 구현체가 지켜야 할 동작은 holodextest.RunStreamProviderContract로 검증한다.
+type StreamProvider interface {
+	// GetChannel: 채널 정보를 조회합니다. 존재하지 않는 채널이면 (nil, nil)을 반환한다.
+	GetChannel(ctx context.Context, channelID string) (*domain.Channel, error)
+	// GetChannelSchedule: 채널의 예정 방송을 시작 시각 순으로 반환합니다. includeLive면 진행 중인 방송도 포함한다.
+	GetChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error)
+	// GetLiveStreams: 진행 중인 Hololive 방송 목록을 반환합니다.
+	GetLiveStreams(ctx context.Context) ([]*domain.Stream, error)
+	// GetUpcomingStreams: hours 이내에 시작하는 Hololive 예정 방송을 시작 시각 순으로 반환합니다.
+	GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error)
+}
+
+var _ StreamProvider = (*Service)(nil)
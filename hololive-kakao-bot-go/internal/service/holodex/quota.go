@@ -0,0 +1,243 @@
+package holodex
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+const quotaKeyPrefix = "holodex:quota:" // + KST 날짜(YYYYMMDD)
+
+var (
+	// ErrQuotaExhausted: 오늘(KST) Holodex 호출 예산을 모두 사용함
+	ErrQuotaExhausted = stdErrors.New("holodex daily quota exhausted")
+	// ErrQuotaDegraded: 예산이 바닥에 가까워 우선순위가 낮은 호출을 생략함
+	ErrQuotaDegraded = stdErrors.New("holodex quota low: non-critical request suppressed")
+)
+
+// requestPriority: 예산이 부족할 때 호출을 계속 허용할지 가르는 우선순위
+type requestPriority int
+
+const (
+	// priorityCritical: 알람·라이브 감지처럼 빠지면 알림이 누락되는 호출 (예산을 다 쓸 때까지 허용)
+	priorityCritical requestPriority = iota
+	// priorityOptional: 검색, 클립, 채널 목록처럼 캐시나 나중 조회로 대신할 수 있는 호출 (저하 모드에서 생략)
+	priorityOptional
+)
+
+// QuotaStatus: 오늘(KST) Holodex 호출 예산 현황
+type QuotaStatus struct {
+	Date      string `json:"date"`
+	Used      int    `json:"used"`
+	Limit     int    `json:"limit"`               // 0이면 제한 없음 (사용량만 집계)
+	Remaining *int   `json:"remaining,omitempty"` // 제한이 없으면 생략
+	Degraded  bool   `json:"degraded"`            // 우선순위가 낮은 호출을 생략 중
+	Exhausted bool   `json:"exhausted"`
+	// CircuitOpen: API 클라이언트 서킷 브레이커가 열려 호출을 막고 있음
+	CircuitOpen bool `json:"circuitOpen"`
+}
+
+// QuotaTracker: Holodex 일일 호출 수를 Valkey에 KST 날짜별로 집계하고 예산을 적용한다.
+// 예산의 reserveRatio 이하만 남으면 저하 모드로 전환해 priorityCritical 호출만 허용한다.
+type QuotaTracker struct {
+	cache        *cache.Service
+	dailyLimit   int
+	reserveRatio float64
+	logger       *slog.Logger
+	now          func() time.Time
+}
+
+// NewQuotaTracker: 호출 예산 추적기를 생성합니다. dailyLimit이 0 이하면 사용량만 집계한다.
+func NewQuotaTracker(cacheSvc *cache.Service, dailyLimit int, reserveRatio float64, logger *slog.Logger) *QuotaTracker {
+	if cacheSvc == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if reserveRatio < 0 || reserveRatio >= 1 {
+		reserveRatio = 0
+	}
+	return &QuotaTracker{
+		cache:        cacheSvc,
+		dailyLimit:   max(dailyLimit, 0),
+		reserveRatio: reserveRatio,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// degradeAt: 저하 모드로 전환되는 사용량 (제한이 없으면 0)
+func (q *QuotaTracker) degradeAt() int {
+	if q.dailyLimit == 0 {
+		return 0
+	}
+	return q.dailyLimit - int(float64(q.dailyLimit)*q.reserveRatio)
+}
+
+func (q *QuotaTracker) key() string {
+	return quotaKeyPrefix + util.FormatKST(q.now(), "20060102")
+}
+
+// reserve: 우선순위에 따라 호출을 허용하면 오늘 사용량을 하나 늘립니다.
+// 조회와 증가가 원자적이지 않아 동시 호출 시 예산을 몇 건 넘길 수 있으며, Valkey 오류 시에는 호출을 막지 않는다.
+func (q *QuotaTracker) reserve(ctx context.Context, priority requestPriority) error {
+	if q == nil {
+		return nil
+	}
+
+	key := q.key()
+	if q.dailyLimit > 0 {
+		used, err := q.used(ctx, key)
+		if err != nil {
+			q.logger.Warn("Holodex quota check failed", slog.Any("error", err))
+			return nil
+		}
+		if used >= q.dailyLimit {
+			return ErrQuotaExhausted
+		}
+		if priority != priorityCritical && used >= q.degradeAt() {
+			return ErrQuotaDegraded
+		}
+	}
+
+	count, err := q.incr(ctx, key)
+	if err != nil {
+		q.logger.Warn("Holodex quota increment failed", slog.Any("error", err))
+		return nil
+	}
+	switch {
+	case q.dailyLimit == 0:
+	case count == int64(q.dailyLimit):
+		q.logger.Warn("Holodex daily quota exhausted", slog.Int("limit", q.dailyLimit))
+	case count == int64(q.degradeAt()):
+		q.logger.Warn("Holodex quota low, suppressing non-critical requests",
+			slog.Int("used", int(count)),
+			slog.Int("limit", q.dailyLimit),
+		)
+	}
+	return nil
+}
+
+// Status: 오늘 사용량과 저하 모드 여부를 반환합니다.
+func (q *QuotaTracker) Status(ctx context.Context) (QuotaStatus, error) {
+	if q == nil {
+		return QuotaStatus{}, nil
+	}
+
+	status := QuotaStatus{
+		Date:  util.FormatKST(q.now(), "2006-01-02"),
+		Limit: q.dailyLimit,
+	}
+	used, err := q.used(ctx, q.key())
+	if err != nil {
+		return status, err
+	}
+	status.Used = used
+	if q.dailyLimit > 0 {
+		remaining := max(q.dailyLimit-used, 0)
+		status.Remaining = &remaining
+		status.Exhausted = used >= q.dailyLimit
+		status.Degraded = used >= q.degradeAt()
+	}
+	return status, nil
+}
+
+func (q *QuotaTracker) used(ctx context.Context, key string) (int, error) {
+	client := q.cache.GetClient()
+	resp := client.Do(ctx, client.B().Get().Key(key).Build())
+	if util.IsValkeyNil(resp.Error()) {
+		return 0, nil
+	}
+	if resp.Error() != nil {
+		return 0, fmt.Errorf("get quota usage: %w", resp.Error())
+	}
+	raw, err := resp.ToString()
+	if err != nil {
+		return 0, fmt.Errorf("get quota usage: %w", err)
+	}
+	used, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("parse quota usage %q: %w", raw, err)
+	}
+	return used, nil
+}
+
+func (q *QuotaTracker) incr(ctx context.Context, key string) (int64, error) {
+	client := q.cache.GetClient()
+	resp := client.Do(ctx, client.B().Incr().Key(key).Build())
+	if resp.Error() != nil {
+		return 0, fmt.Errorf("increment quota usage: %w", resp.Error())
+	}
+	count, err := resp.AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("increment quota usage: %w", err)
+	}
+	// 최초 생성 시에만 TTL 부여 (날짜가 바뀌면 새 키로 집계)
+	if count == 1 {
+		_ = q.cache.Expire(ctx, key, constants.HolodexQuota.KeyTTL)
+	}
+	return count, nil
+}
+
+// isQuotaError: 예산 때문에 호출하지 않은 경우인지 확인합니다.
+func isQuotaError(err error) bool {
+	return stdErrors.Is(err, ErrQuotaExhausted) || stdErrors.Is(err, ErrQuotaDegraded)
+}
+
+// SetQuotaTracker: 일일 호출 예산 추적기를 설정합니다. nil이면 예산 없이 호출한다.
+func (h *Service) SetQuotaTracker(quota *QuotaTracker) {
+	h.quota = quota
+}
+
+// QuotaStatus: 오늘 호출 예산 현황과 서킷 브레이커 상태를 반환합니다.
+func (h *Service) QuotaStatus(ctx context.Context) (QuotaStatus, error) {
+	status, err := h.quota.Status(ctx)
+	if h.requester != nil {
+		status.CircuitOpen = h.requester.IsCircuitOpen()
+	}
+	return status, err
+}
+
+// doRequest: 호출 예산을 확인·차감한 뒤 Holodex API를 호출합니다.
+func (h *Service) doRequest(ctx context.Context, priority requestPriority, method, path string, params url.Values) ([]byte, error) {
+	if err := h.quota.reserve(ctx, priority); err != nil {
+		h.logger.Debug("Holodex request skipped by quota",
+			slog.String("path", path),
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+	return h.requester.DoRequest(ctx, method, path, params) //nolint:wrapcheck // 호출부에서 작업 단위로 감싼다
+}
+
+// saveStaleStreams: 예산이 제한된 경우 예산 부족 시 대신 내보낼 일정 사본을 길게 보관합니다.
+func (h *Service) saveStaleStreams(ctx context.Context, cacheKey string, streams []*domain.Stream) {
+	if h.quota == nil || h.quota.dailyLimit == 0 {
+		return
+	}
+	_ = h.cache.Set(ctx, staleCacheKey(cacheKey), streams, constants.HolodexQuota.StaleTTL)
+}
+
+// loadStaleStreams: 보관해 둔 일정 사본을 조회합니다.
+func (h *Service) loadStaleStreams(ctx context.Context, cacheKey string) ([]*domain.Stream, bool) {
+	var stale []*domain.Stream
+	if err := h.cache.Get(ctx, staleCacheKey(cacheKey), &stale); err != nil || stale == nil {
+		return nil, false
+	}
+	h.logger.Info("Serving stale Holodex schedule (quota low)", slog.String("cache_key", cacheKey))
+	return stale, true
+}
+
+func staleCacheKey(cacheKey string) string {
+	return "stale:" + cacheKey
+}
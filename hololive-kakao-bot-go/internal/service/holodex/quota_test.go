@@ -0,0 +1,132 @@
+package holodex
+
+import (
+	"context"
+	stdErrors "errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+)
+
+type countingRequester struct {
+	body  string
+	calls int
+}
+
+func (r *countingRequester) DoRequest(context.Context, string, string, url.Values) ([]byte, error) {
+	r.calls++
+	return []byte(r.body), nil
+}
+
+func (r *countingRequester) IsCircuitOpen() bool { return false }
+
+func newQuotaTestCache(t *testing.T) *cache.Service {
+	t.Helper()
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+	return cacheSvc
+}
+
+func TestQuotaTracker_DegradesThenExhausts(t *testing.T) {
+	ctx := context.Background()
+	q := NewQuotaTracker(newQuotaTestCache(t), 10, 0.2, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC) // KST 3월 2일
+	q.now = func() time.Time { return now }
+
+	for i := range 8 {
+		if err := q.reserve(ctx, priorityOptional); err != nil {
+			t.Fatalf("request %d should be allowed: %v", i, err)
+		}
+	}
+	if err := q.reserve(ctx, priorityOptional); !stdErrors.Is(err, ErrQuotaDegraded) {
+		t.Fatalf("optional request should be suppressed, got %v", err)
+	}
+	for range 2 {
+		if err := q.reserve(ctx, priorityCritical); err != nil {
+			t.Fatalf("critical request should be allowed until exhausted: %v", err)
+		}
+	}
+	if err := q.reserve(ctx, priorityCritical); !stdErrors.Is(err, ErrQuotaExhausted) {
+		t.Fatalf("expected exhausted, got %v", err)
+	}
+
+	status, err := q.Status(ctx)
+	if err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	if status.Date != "2026-03-02" || status.Used != 10 || status.Remaining == nil || *status.Remaining != 0 || !status.Exhausted || !status.Degraded {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	// 날짜가 바뀌면 새로 집계
+	now = now.Add(24 * time.Hour)
+	if err := q.reserve(ctx, priorityOptional); err != nil {
+		t.Fatalf("new day should reset quota: %v", err)
+	}
+}
+
+func TestQuotaTracker_UnlimitedOnlyCounts(t *testing.T) {
+	ctx := context.Background()
+	q := NewQuotaTracker(newQuotaTestCache(t), 0, 0.2, nil)
+	for range 3 {
+		if err := q.reserve(ctx, priorityOptional); err != nil {
+			t.Fatalf("unlimited tracker should not block: %v", err)
+		}
+	}
+	status, err := q.Status(ctx)
+	if err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	if status.Used != 3 || status.Remaining != nil || status.Degraded || status.Exhausted {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+}
+
+func TestService_ServesStaleScheduleWhenQuotaExhausted(t *testing.T) {
+	ctx := context.Background()
+	cacheSvc := newQuotaTestCache(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	requester := &countingRequester{body: `[{"id":"v1","title":"歌枠","channel_id":"UC1","status":"upcoming","start_scheduled":"2026-03-02T12:00:00Z","channel":{"id":"UC1","name":"A","org":"Hololive"}}]`}
+
+	svc := NewServiceWithRequester(requester, cacheSvc, nil, logger)
+	svc.SetQuotaTracker(NewQuotaTracker(cacheSvc, 1, 0.2, logger))
+
+	streams, err := svc.RefreshChannelSchedule(ctx, "UC1", 24, true, time.Minute)
+	if err != nil || len(streams) != 1 {
+		t.Fatalf("first refresh failed: %v %v", streams, err)
+	}
+
+	// 예산 소진 후에는 호출하지 않고 마지막 일정을 내보낸다
+	streams, err = svc.RefreshChannelSchedule(ctx, "UC1", 24, true, time.Minute)
+	if err != nil || len(streams) != 1 || streams[0].ID != "v1" {
+		t.Fatalf("expected stale schedule, got %v %v", streams, err)
+	}
+	if _, err := svc.SearchChannels(ctx, "A"); !stdErrors.Is(err, ErrQuotaExhausted) {
+		t.Fatalf("expected quota error for search, got %v", err)
+	}
+	if requester.calls != 1 {
+		t.Fatalf("expected single API call, got %d", requester.calls)
+	}
+
+	status, err := svc.QuotaStatus(ctx)
+	if err != nil || status.Used != 1 || !status.Exhausted {
+		t.Fatalf("unexpected quota status: %+v %v", status, err)
+	}
+}
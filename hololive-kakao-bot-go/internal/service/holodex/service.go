@@ -59,6 +59,8 @@ type Service struct {
 	requester Requester
 	cache     *cache.Service
 	scraper   *ScraperService
+	external  ExternalStreamSource // Twitch 등 Holodex 외 방송 소스 (선택)
+	quota     *QuotaTracker        // 일일 호출 예산 (선택)
 	logger    *slog.Logger
 }
 
@@ -84,16 +86,24 @@ func NewHolodexService(apiKeys []string, cacheSvc *cache.Service, scraper *Scrap
 
 	requester := NewHolodexAPIClient(httpClient, apiKeys, logger)
 
+	return NewServiceWithRequester(requester, cacheSvc, scraper, logger), nil
+}
+
+// NewServiceWithRequester: 이미 구성된 Requester로 Holodex 서비스를 생성합니다. (테스트용 가짜 응답, 별도 전송 계층 등)
+func NewServiceWithRequester(requester Requester, cacheSvc *cache.Service, scraper *ScraperService, logger *slog.Logger) *Service {
+	if logger == nil {
+		logger = slog.Default()
+	}
 	return &Service{
 		requester: requester,
 		cache:     cacheSvc,
 		scraper:   scraper,
 		logger:    logger,
-	}, nil
+	}
 }
 
-// GetLiveStreams: 현재 진행 중인('live') 모든 Hololive 스트림 목록을 조회한다. (캐시 적용)
-func (h *Service) GetLiveStreams(ctx context.Context) ([]*domain.Stream, error) {
+// fetchLiveStreams: 현재 진행 중인('live') 모든 Hololive 스트림 목록을 조회한다. (캐시 적용)
+func (h *Service) fetchLiveStreams(ctx context.Context) ([]*domain.Stream, error) {
 	cacheKey := "live_streams"
 
 	var cached []*domain.Stream
@@ -106,7 +116,7 @@ func (h *Service) GetLiveStreams(ctx context.Context) ([]*domain.Stream, error)
 	params.Set("status", "live")
 	params.Set("type", "stream")
 
-	body, err := h.requester.DoRequest(ctx, "GET", "/live", params)
+	body, err := h.doRequest(ctx, priorityCritical, "GET", "/live", params)
 	if err != nil {
 		h.logger.Error("Failed to get live streams", slog.Any("error", err))
 		return nil, fmt.Errorf("get live streams: %w", err)
@@ -125,8 +135,8 @@ func (h *Service) GetLiveStreams(ctx context.Context) ([]*domain.Stream, error)
 	return filtered, nil
 }
 
-// GetUpcomingStreams: 향후 예정된('upcoming') Hololive 스트림 목록을 조회한다. (최대 hours 시간까지, 캐시 적용)
-func (h *Service) GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error) {
+// fetchUpcomingStreams: 향후 예정된('upcoming') Hololive 스트림 목록을 조회한다. (최대 hours 시간까지, 캐시 적용)
+func (h *Service) fetchUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error) {
 	cacheKey := fmt.Sprintf("upcoming_streams_%d", hours)
 
 	var cached []*domain.Stream
@@ -142,8 +152,13 @@ func (h *Service) GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.
 	params.Set("order", "asc")
 	params.Set("sort", "start_scheduled")
 
-	body, err := h.requester.DoRequest(ctx, "GET", "/live", params)
+	body, err := h.doRequest(ctx, priorityOptional, "GET", "/live", params)
 	if err != nil {
+		if isQuotaError(err) {
+			if stale, ok := h.loadStaleStreams(ctx, cacheKey); ok {
+				return h.filterUpcomingStreams(stale), nil
+			}
+		}
 		h.logger.Error("Failed to get upcoming streams", slog.Any("error", err))
 		return nil, fmt.Errorf("get upcoming streams: %w", err)
 	}
@@ -158,14 +173,15 @@ func (h *Service) GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.
 	upcoming := h.filterUpcomingStreams(filtered)
 
 	_ = h.cache.Set(ctx, cacheKey, upcoming, constants.CacheTTL.UpcomingStreams)
+	h.saveStaleStreams(ctx, cacheKey, upcoming)
 
 	return upcoming, nil
 }
 
-// GetChannelSchedule: 특정 채널의 방송 일정(예정된 방송)을 조회합니다.
+// fetchChannelSchedule: 특정 채널의 방송 일정(예정된 방송)을 조회합니다.
 // includeLive가 true이면 현재 진행 중인 방송도 포함한다.
-func (h *Service) GetChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error) {
-	cacheKey := fmt.Sprintf("channel_schedule_%s_%d_%t", channelID, hours, includeLive)
+func (h *Service) fetchChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error) {
+	cacheKey := channelScheduleCacheKey(channelID, hours, includeLive)
 
 	var cached []*domain.Stream
 	if err := h.cache.Get(ctx, cacheKey, &cached); err == nil && cached != nil {
@@ -189,6 +205,21 @@ func (h *Service) GetChannelSchedule(ctx context.Context, channelID string, hour
 		return h.filterUpcomingStreams(copied), nil
 	}
 
+	return h.loadChannelSchedule(ctx, channelID, hours, includeLive, constants.CacheTTL.ChannelSchedule)
+}
+
+// RefreshChannelSchedule: 캐시를 거치지 않고 채널 일정을 다시 조회해 ttl 동안 캐싱합니다.
+// 알람 체크 전에 일정을 미리 채워 두는 프리페처용이며, 스크래퍼 폴백 결과는 캐싱하지 않는다.
+func (h *Service) RefreshChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool, ttl time.Duration) ([]*domain.Stream, error) {
+	return h.loadChannelSchedule(ctx, channelID, hours, includeLive, ttl)
+}
+
+func channelScheduleCacheKey(channelID string, hours int, includeLive bool) string {
+	return fmt.Sprintf("channel_schedule_%s_%d_%t", channelID, hours, includeLive)
+}
+
+// loadChannelSchedule: Holodex에서 채널 일정을 조회해 ttl 동안 캐싱합니다.
+func (h *Service) loadChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool, ttl time.Duration) ([]*domain.Stream, error) {
 	// Holodex API는 콤마 구분 복수 status를 지원
 	// 기존 2회 호출을 단일 호출로 통합하여 latency 및 rate limit 부담 감소
 	var statusStr string
@@ -204,8 +235,16 @@ func (h *Service) GetChannelSchedule(ctx context.Context, channelID string, hour
 	params.Set("type", "stream")
 	params.Set("max_upcoming_hours", fmt.Sprintf("%d", hours))
 
-	body, err := h.requester.DoRequest(ctx, "GET", "/live", params)
+	cacheKey := channelScheduleCacheKey(channelID, hours, includeLive)
+	body, err := h.doRequest(ctx, priorityCritical, "GET", "/live", params)
 	if err != nil {
+		// 예산이 바닥나면 마지막으로 받은 일정을 그대로 내보낸다 (알람 체크가 멈추지 않도록)
+		if isQuotaError(err) {
+			if stale, ok := h.loadStaleStreams(ctx, cacheKey); ok {
+				return stale, nil
+			}
+		}
+
 		h.logger.Error("Failed to get channel schedule",
 			slog.String("channel_id", channelID),
 			slog.String("status", statusStr),
@@ -255,7 +294,8 @@ func (h *Service) GetChannelSchedule(ctx context.Context, channelID string, hour
 		result = h.filterUpcomingStreams(hololiveOnly)
 	}
 
-	_ = h.cache.Set(ctx, cacheKey, result, constants.CacheTTL.ChannelSchedule)
+	_ = h.cache.Set(ctx, cacheKey, result, ttl)
+	h.saveStaleStreams(ctx, cacheKey, result)
 
 	return result, nil
 }
@@ -275,7 +315,7 @@ func (h *Service) SearchChannels(ctx context.Context, query string) ([]*domain.C
 	params.Set("type", "vtuber")
 	params.Set("limit", "50")
 
-	body, err := h.requester.DoRequest(ctx, "GET", "/channels", params)
+	body, err := h.doRequest(ctx, priorityOptional, "GET", "/channels", params)
 	if err != nil {
 		h.logger.Error("Failed to search channels", slog.String("query", query), slog.Any("error", err))
 		return nil, fmt.Errorf("search channels: %w", err)
@@ -337,7 +377,7 @@ func (h *Service) GetChannel(ctx context.Context, channelID string) (*domain.Cha
 		return &cached, nil
 	}
 
-	body, err := h.requester.DoRequest(ctx, "GET", "/channels/"+channelID, nil)
+	body, err := h.doRequest(ctx, priorityOptional, "GET", "/channels/"+channelID, nil)
 	if err != nil {
 		apiErr := &errors.APIError{}
 		if stdErrors.As(err, &apiErr) {
@@ -446,7 +486,7 @@ func (h *Service) GetChannelsLiveStatus(ctx context.Context, channelIDs []string
 	params := url.Values{}
 	params.Set("channels", strings.Join(channelIDs, ","))
 
-	body, err := h.requester.DoRequest(ctx, "GET", "/users/live", params)
+	body, err := h.doRequest(ctx, priorityCritical, "GET", "/users/live", params)
 	if err != nil {
 		h.logger.Error("Failed to get channels live status",
 			slog.Int("channel_count", len(channelIDs)),
@@ -496,7 +536,7 @@ func (h *Service) fetchHololiveChannelList(ctx context.Context) ([]*domain.Chann
 		params.Set("limit", fmt.Sprintf("%d", pageSize))
 		params.Set("offset", fmt.Sprintf("%d", offset))
 
-		body, err := h.requester.DoRequest(ctx, "GET", "/channels", params)
+		body, err := h.doRequest(ctx, priorityOptional, "GET", "/channels", params)
 		if err != nil {
 			return nil, fmt.Errorf("fetch hololive channel list (offset=%d): %w", offset, err)
 		}
@@ -771,6 +811,10 @@ func (h *Service) shouldUseFallback(err error) bool {
 		return true
 	}
 
+	if stdErrors.Is(err, ErrQuotaExhausted) {
+		return true
+	}
+
 	apiErr := &errors.APIError{}
 	if stdErrors.As(err, &apiErr) {
 		if apiErr.StatusCode >= 500 {
@@ -0,0 +1,93 @@
+package latency
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+)
+
+// MaxReportDays: 리포트로 조회할 수 있는 최대 일수
+const MaxReportDays = 90
+
+// CommandReport: 명령어 하나의 기간 내 일별 지연 집계와 SLA 위반 요약
+type CommandReport struct {
+	Command string `json:"command"`
+	Samples int    `json:"samples"`
+	// Days: 샘플이 있는 날 수, BreachDays: p95가 SLA를 넘긴 날 수
+	Days       int     `json:"days"`
+	BreachDays int     `json:"breachDays"`
+	WorstP95Ms int64   `json:"worstP95Ms"`
+	SlowRatio  float64 `json:"slowRatio"`
+	Daily      []Daily `json:"daily"`
+}
+
+// Report: 명령어별 지연 리포트 (SLA 위반이 잦은 명령어가 앞에 온다)
+type Report struct {
+	Bot      string          `json:"bot"`
+	SLAMs    int64           `json:"slaMs"`
+	From     string          `json:"from"`
+	To       string          `json:"to"`
+	Commands []CommandReport `json:"commands"`
+}
+
+// Report: 오늘을 포함한 최근 days일의 리포트를 만든다. 오늘은 아직 집계 전이므로 원본 샘플로 계산한다.
+func (s *Service) Report(ctx context.Context, days int) (Report, error) {
+	days = min(max(days, 1), MaxReportDays)
+	now := s.now()
+	today := dayOf(now)
+	from := today.AddDate(0, 0, -(days - 1))
+
+	rows, err := s.loadDaily(ctx, from.Format(time.DateOnly), today.AddDate(0, 0, -1).Format(time.DateOnly))
+	if err != nil {
+		return Report{}, err
+	}
+	samples, err := s.loadSamples(ctx, today, today.AddDate(0, 0, 1))
+	if err != nil {
+		return Report{}, err
+	}
+	rows = append(rows, summarizeByCommand(today.Format(time.DateOnly), samples, s.sla, now)...)
+
+	byCommand := make(map[string]*CommandReport)
+	slowTotal := make(map[string]int)
+	for _, row := range rows {
+		report := byCommand[row.Command]
+		if report == nil {
+			report = &CommandReport{Command: row.Command, Daily: []Daily{}}
+			byCommand[row.Command] = report
+		}
+		report.Samples += row.Samples
+		report.Days++
+		if row.P95Ms > s.sla.Milliseconds() {
+			report.BreachDays++
+		}
+		report.WorstP95Ms = max(report.WorstP95Ms, row.P95Ms)
+		report.Daily = append(report.Daily, row)
+		slowTotal[row.Command] += row.SlowCount
+	}
+
+	commands := make([]CommandReport, 0, len(byCommand))
+	for command, report := range byCommand {
+		if report.Samples > 0 {
+			report.SlowRatio = float64(slowTotal[command]) / float64(report.Samples)
+		}
+		commands = append(commands, *report)
+	}
+	slices.SortFunc(commands, func(a, b CommandReport) int {
+		if c := cmp.Compare(b.BreachDays, a.BreachDays); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.WorstP95Ms, a.WorstP95Ms); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Command, b.Command)
+	})
+
+	return Report{
+		Bot:      BotName,
+		SLAMs:    s.sla.Milliseconds(),
+		From:     from.Format(time.DateOnly),
+		To:       today.Format(time.DateOnly),
+		Commands: commands,
+	}, nil
+}
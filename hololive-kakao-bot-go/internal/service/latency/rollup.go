@@ -0,0 +1,218 @@
+package latency
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// rollupTickInterval: 집계 시각 도달 여부를 확인하는 주기
+	rollupTickInterval = time.Minute
+	// rollupBackfillDays: 다운타임으로 빠진 집계를 채워 넣는 최대 일수
+	rollupBackfillDays = 3
+)
+
+// Stats: 지연 샘플 묶음의 분위수 요약
+type Stats struct {
+	Samples   int
+	P50Ms     int64
+	P95Ms     int64
+	P99Ms     int64
+	MaxMs     int64
+	SlowCount int
+}
+
+// Summarize: 지연(ms) 목록에서 nearest-rank 분위수와 SLA 초과 건수를 계산한다.
+func Summarize(durations []int64, sla time.Duration) Stats {
+	if len(durations) == 0 {
+		return Stats{}
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	slow := 0
+	for _, d := range sorted {
+		if d > sla.Milliseconds() {
+			slow++
+		}
+	}
+	return Stats{
+		Samples:   len(sorted),
+		P50Ms:     percentile(sorted, 50),
+		P95Ms:     percentile(sorted, 95),
+		P99Ms:     percentile(sorted, 99),
+		MaxMs:     sorted[len(sorted)-1],
+		SlowCount: slow,
+	}
+}
+
+func percentile(sorted []int64, p float64) int64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
+
+// summarizeByCommand: 원본 샘플을 명령어별 일별 집계 행으로 묶는다.
+func summarizeByCommand(day string, samples []sampleModel, sla time.Duration, now time.Time) []Daily {
+	grouped := make(map[string][]int64)
+	for _, sample := range samples {
+		grouped[sample.Command] = append(grouped[sample.Command], sample.DurationMs)
+	}
+
+	rows := make([]Daily, 0, len(grouped))
+	for command, durations := range grouped {
+		stats := Summarize(durations, sla)
+		rows = append(rows, Daily{
+			Day:       day,
+			Command:   command,
+			Samples:   stats.Samples,
+			P50Ms:     stats.P50Ms,
+			P95Ms:     stats.P95Ms,
+			P99Ms:     stats.P99Ms,
+			MaxMs:     stats.MaxMs,
+			SlowCount: stats.SlowCount,
+			UpdatedAt: now,
+		})
+	}
+	slices.SortFunc(rows, func(a, b Daily) int { return cmp.Compare(a.Command, b.Command) })
+	return rows
+}
+
+// RollupDay: 하루치 원본 샘플을 명령어별로 집계해 저장한다.
+func (s *Service) RollupDay(ctx context.Context, day time.Time) ([]Daily, error) {
+	from := dayOf(day)
+	samples, err := s.loadSamples(ctx, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	rows := summarizeByCommand(from.Format(time.DateOnly), samples, s.sla, s.now())
+	if err := s.upsertDaily(ctx, rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Roller: 매일 지정 시각 이후 지난 며칠 중 집계되지 않은 날짜를 집계하고 오래된 원본 샘플을 정리하는 백그라운드 작업
+type Roller struct {
+	latency       *Service
+	runAtMinute   int
+	retentionDays int
+	logger        *slog.Logger
+
+	done     map[string]bool // 확인을 마친 날짜 (샘플이 없는 날을 반복 조회하지 않기 위함)
+	prunedOn string          // 마지막으로 원본 샘플을 정리한 날짜
+}
+
+// NewRoller: 일별 집계 작업을 생성한다. 서비스가 nil이면 nil을 반환한다.
+func NewRoller(latency *Service, runAtMinute, retentionDays int, logger *slog.Logger) *Roller {
+	if latency == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Roller{
+		latency:       latency,
+		runAtMinute:   runAtMinute,
+		retentionDays: max(retentionDays, rollupBackfillDays+1),
+		logger:        logger.With(slog.String("service", "latency_rollup")),
+		done:          make(map[string]bool),
+	}
+}
+
+// Start: ctx가 종료될 때까지 주기적으로 집계할 날짜를 확인한다.
+func (r *Roller) Start(ctx context.Context) {
+	r.logger.Info("Starting latency rollup",
+		slog.Int("run_at_minute", r.runAtMinute),
+		slog.Int("retention_days", r.retentionDays),
+	)
+
+	ticker := time.NewTicker(rollupTickInterval)
+	defer ticker.Stop()
+
+	for {
+		r.tick(ctx, r.latency.now())
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Latency rollup stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick: 오늘 실행 시각이 지났으면 어제부터 rollupBackfillDays일 전까지 빠진 날짜를 집계한다.
+// 원본 샘플 정리는 집계를 모두 마친 뒤 하루 한 번만 수행한다.
+func (r *Roller) tick(ctx context.Context, now time.Time) {
+	today := dayOf(now)
+	if now.Before(today.Add(time.Duration(r.runAtMinute) * time.Minute)) {
+		return
+	}
+
+	pending := false
+	for offset := rollupBackfillDays; offset >= 1; offset-- {
+		if ctx.Err() != nil {
+			return
+		}
+		day := today.AddDate(0, 0, -offset)
+		key := day.Format(time.DateOnly)
+		if r.done[key] {
+			continue
+		}
+		if err := r.rollupIfMissing(ctx, day); err != nil {
+			r.logger.Warn("Failed to roll up latency", slog.String("day", key), slog.Any("error", err))
+			pending = true
+			continue
+		}
+		r.done[key] = true
+	}
+
+	todayKey := today.Format(time.DateOnly)
+	if pending || r.prunedOn == todayKey {
+		return
+	}
+	if err := r.prune(ctx, today.AddDate(0, 0, -r.retentionDays)); err != nil {
+		r.logger.Warn("Failed to prune latency samples", slog.Any("error", err))
+		return
+	}
+	r.prunedOn = todayKey
+	for key := range r.done {
+		if key < today.AddDate(0, 0, -rollupBackfillDays).Format(time.DateOnly) {
+			delete(r.done, key)
+		}
+	}
+}
+
+func (r *Roller) rollupIfMissing(ctx context.Context, day time.Time) error {
+	key := day.Format(time.DateOnly)
+	var count int64
+	if err := r.latency.db.WithContext(ctx).Model(&Daily{}).Where("day = ?", key).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check latency daily: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	rows, err := r.latency.RollupDay(ctx, day)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("Latency rollup completed", slog.String("day", key), slog.Int("commands", len(rows)))
+	return nil
+}
+
+func (r *Roller) prune(ctx context.Context, before time.Time) error {
+	result := r.latency.db.WithContext(ctx).Where("recorded_at < ?", before.UTC()).Delete(&sampleModel{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to prune latency samples: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		r.logger.Info("Pruned latency samples", slog.Int64("deleted", result.RowsAffected))
+	}
+	return nil
+}
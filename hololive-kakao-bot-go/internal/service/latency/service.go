@@ -0,0 +1,248 @@
+// Package latency: 명령어별 응답 지연(메시지 수신 → 첫 응답 전송)을 기록하고 일별 분위수로 집계한다.
+package latency
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BotName: 대시보드 통합 리포트에서 이 봇을 가리키는 이름
+const BotName = "holo"
+
+const (
+	queueSize     = 1024
+	batchSize     = 100
+	flushInterval = 5 * time.Second
+)
+
+// timezone: 일별 집계 날짜 계산 기준 시간대 (KST)
+var timezone = time.FixedZone("KST", 9*60*60)
+
+// dayOf: 시각이 속한 KST 날짜의 자정을 반환한다.
+func dayOf(t time.Time) time.Time {
+	local := t.In(timezone)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, timezone)
+}
+
+// sampleModel: command_latency_samples 테이블 GORM 모델
+type sampleModel struct {
+	ID         int64 `gorm:"primaryKey;autoIncrement"`
+	Command    string
+	DurationMs int64
+	RecordedAt time.Time
+}
+
+// TableName: 원본 샘플 테이블의 이름을 반환한다. ("command_latency_samples")
+func (sampleModel) TableName() string {
+	return "command_latency_samples"
+}
+
+// Daily: 명령어별 하루 지연 집계 (날짜는 KST 기준 YYYY-MM-DD)
+type Daily struct {
+	Day       string    `gorm:"primaryKey" json:"day"`
+	Command   string    `gorm:"primaryKey" json:"-"`
+	Samples   int       `json:"samples"`
+	P50Ms     int64     `gorm:"column:p50_ms" json:"p50Ms"`
+	P95Ms     int64     `gorm:"column:p95_ms" json:"p95Ms"`
+	P99Ms     int64     `gorm:"column:p99_ms" json:"p99Ms"`
+	MaxMs     int64     `json:"maxMs"`
+	SlowCount int       `json:"slowCount"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// TableName: 일별 집계 테이블의 이름을 반환한다. ("command_latency_daily")
+func (Daily) TableName() string {
+	return "command_latency_daily"
+}
+
+// Service: 지연 샘플을 모아 PostgreSQL에 일괄 저장하고, 일별 집계와 리포트를 제공하는 서비스
+// 응답 경로를 막지 않도록 큐가 가득 차면 샘플을 버린다.
+type Service struct {
+	db     *gorm.DB
+	sla    time.Duration
+	logger *slog.Logger
+
+	queue    chan sampleModel
+	stopped  chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+	now      func() time.Time
+}
+
+// NewService: 지연 테이블을 준비하고 저장 워커를 시작한다.
+func NewService(ctx context.Context, db *gorm.DB, sla time.Duration, logger *slog.Logger) (*Service, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db must not be nil")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	svc := &Service{
+		db:      db,
+		sla:     sla,
+		logger:  logger.With(slog.String("service", "latency")),
+		queue:   make(chan sampleModel, queueSize),
+		stopped: make(chan struct{}),
+		done:    make(chan struct{}),
+		now:     time.Now,
+	}
+	if err := svc.createTablesIfNotExist(ctx); err != nil {
+		return nil, err
+	}
+
+	go svc.worker()
+	return svc, nil
+}
+
+func (s *Service) createTablesIfNotExist(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS command_latency_samples (
+			id BIGSERIAL PRIMARY KEY,
+			command TEXT NOT NULL,
+			duration_ms BIGINT NOT NULL,
+			recorded_at TIMESTAMP NOT NULL
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create command_latency_samples table: %w", err)
+	}
+
+	if err := db.Exec(`CREATE INDEX IF NOT EXISTS idx_command_latency_samples_recorded_at ON command_latency_samples (recorded_at)`).Error; err != nil {
+		return fmt.Errorf("failed to create command_latency_samples index: %w", err)
+	}
+
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS command_latency_daily (
+			day TEXT NOT NULL,
+			command TEXT NOT NULL,
+			samples INTEGER NOT NULL,
+			p50_ms BIGINT NOT NULL,
+			p95_ms BIGINT NOT NULL,
+			p99_ms BIGINT NOT NULL,
+			max_ms BIGINT NOT NULL,
+			slow_count INTEGER NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (day, command)
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create command_latency_daily table: %w", err)
+	}
+
+	return nil
+}
+
+// Record: 명령어 지연 샘플을 저장 큐에 넣는다.
+func (s *Service) Record(command string, duration time.Duration, at time.Time) {
+	if s == nil || command == "" {
+		return
+	}
+	sample := sampleModel{
+		Command:    command,
+		DurationMs: max(duration.Milliseconds(), 0),
+		RecordedAt: at.UTC(),
+	}
+	select {
+	case <-s.stopped:
+	case s.queue <- sample:
+	default:
+		s.logger.Warn("Latency sample queue full, dropping sample", slog.String("command", command))
+	}
+}
+
+// Close: 워커를 멈추고 큐에 남은 샘플을 저장한다.
+func (s *Service) Close() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopped)
+		<-s.done
+	})
+}
+
+func (s *Service) worker() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]sampleModel, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := s.db.WithContext(ctx).CreateInBatches(batch, 200).Error; err != nil {
+			s.logger.Warn("Failed to flush latency samples", slog.Int("count", len(batch)), slog.Any("error", err))
+		}
+		cancel()
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case sample := <-s.queue:
+			batch = append(batch, sample)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stopped:
+			for {
+				select {
+				case sample := <-s.queue:
+					batch = append(batch, sample)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// loadSamples: [from, to) 구간의 원본 샘플을 조회한다.
+func (s *Service) loadSamples(ctx context.Context, from, to time.Time) ([]sampleModel, error) {
+	var samples []sampleModel
+	if err := s.db.WithContext(ctx).
+		Where("recorded_at >= ? AND recorded_at < ?", from.UTC(), to.UTC()).
+		Find(&samples).Error; err != nil {
+		return nil, fmt.Errorf("failed to load latency samples: %w", err)
+	}
+	return samples, nil
+}
+
+// upsertDaily: 일별 집계를 저장한다. 같은 날짜/명령어 행은 덮어쓴다.
+func (s *Service) upsertDaily(ctx context.Context, rows []Daily) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "day"}, {Name: "command"}},
+		DoUpdates: clause.AssignmentColumns([]string{"samples", "p50_ms", "p95_ms", "p99_ms", "max_ms", "slow_count", "updated_at"}),
+	}).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to upsert latency daily: %w", err)
+	}
+	return nil
+}
+
+// loadDaily: fromDay~toDay(포함) 사이의 일별 집계를 조회한다.
+func (s *Service) loadDaily(ctx context.Context, fromDay, toDay string) ([]Daily, error) {
+	var rows []Daily
+	if err := s.db.WithContext(ctx).
+		Where("day >= ? AND day <= ?", fromDay, toDay).
+		Order("day").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load latency daily: %w", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,138 @@
+package latency
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+
+	dbName := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	db, err := gorm.Open(sqlite.Open("file:"+dbName+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	svc, err := NewService(context.Background(), db, 5*time.Second, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("failed to create latency service: %v", err)
+	}
+	t.Cleanup(svc.Close)
+	return svc
+}
+
+func insertSamples(t *testing.T, svc *Service, command string, at time.Time, durations ...int64) {
+	t.Helper()
+	for i, d := range durations {
+		sample := sampleModel{Command: command, DurationMs: d, RecordedAt: at.Add(time.Duration(i) * time.Second).UTC()}
+		if err := svc.db.Create(&sample).Error; err != nil {
+			t.Fatalf("failed to insert sample: %v", err)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	stats := Summarize([]int64{900, 100, 300, 700, 500, 6000}, 5*time.Second)
+	if stats.P50Ms != 500 || stats.P95Ms != 6000 || stats.MaxMs != 6000 || stats.SlowCount != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestSpan_RecordsReceiveToFirstReply(t *testing.T) {
+	svc := newTestService(t)
+	received := time.Now().Add(-3 * time.Second)
+
+	ctx, span := svc.Start(WithReceivedAt(context.Background(), received), "live")
+	MarkReply(ctx)
+	MarkReply(ctx)
+	span.Finish()
+
+	// 응답이 없는 명령과 nil 서비스는 기록하지 않음
+	_, silent := svc.Start(context.Background(), "alarm_add")
+	silent.Finish()
+	var disabled *Service
+	_, none := disabled.Start(context.Background(), "help")
+	none.Finish()
+
+	svc.Close()
+
+	samples, err := svc.loadSamples(context.Background(), received.Add(-time.Minute), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("failed to load samples: %v", err)
+	}
+	if len(samples) != 1 || samples[0].Command != "live" {
+		t.Fatalf("expected one live sample, got %+v", samples)
+	}
+	if d := samples[0].DurationMs; d < 3000 || d > 3500 {
+		t.Fatalf("duration must be measured from receive time, got %dms", d)
+	}
+}
+
+func TestStreamIDTime(t *testing.T) {
+	if got := StreamIDTime("1760000000123-4"); !got.Equal(time.UnixMilli(1760000000123)) {
+		t.Fatalf("unexpected stream time: %v", got)
+	}
+	if !StreamIDTime("not-an-id").IsZero() {
+		t.Fatalf("invalid id must return zero time")
+	}
+}
+
+func TestRollerAndReport(t *testing.T) {
+	svc := newTestService(t)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, timezone)
+	svc.now = func() time.Time { return now }
+
+	insertSamples(t, svc, "schedule", now.AddDate(0, 0, -2), 7000, 8000)
+	insertSamples(t, svc, "schedule", now.AddDate(0, 0, -1), 1000, 6000)
+	insertSamples(t, svc, "help", now.AddDate(0, 0, -1), 50)
+	insertSamples(t, svc, "live", now.Add(-time.Hour), 200)
+	insertSamples(t, svc, "live", now.AddDate(0, 0, -30), 200)
+
+	roller := NewRoller(svc, 10, 7, svc.logger)
+	roller.tick(context.Background(), now.Add(-12*time.Hour))
+	if rows, _ := svc.loadDaily(context.Background(), "2026-10-16", "2026-10-17"); len(rows) != 0 {
+		t.Fatalf("rollup must wait for run minute, got %+v", rows)
+	}
+
+	roller.tick(context.Background(), now)
+	rows, err := svc.loadDaily(context.Background(), "2026-10-16", "2026-10-17")
+	if err != nil || len(rows) != 3 {
+		t.Fatalf("expected 3 daily rows, got %+v, %v", rows, err)
+	}
+	old, _ := svc.loadSamples(context.Background(), now.AddDate(0, 0, -40), now.AddDate(0, 0, -20))
+	if len(old) != 0 {
+		t.Fatalf("samples past retention must be pruned")
+	}
+
+	report, err := svc.Report(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("failed to build report: %v", err)
+	}
+	if report.Bot != BotName || report.From != "2026-10-12" || report.To != "2026-10-18" || len(report.Commands) != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	schedule := report.Commands[0]
+	if schedule.Command != "schedule" || schedule.BreachDays != 2 || schedule.SlowRatio != 0.75 {
+		t.Fatalf("schedule must lead the report: %+v", schedule)
+	}
+	if live := report.Commands[1]; live.Command != "live" || live.Daily[0].Day != "2026-10-18" {
+		t.Fatalf("today's samples must be reported live: %+v", live)
+	}
+}
@@ -0,0 +1,74 @@
+package latency
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type spanKey struct{}
+
+type receivedAtKey struct{}
+
+// Span: 한 명령어의 처리 구간. 첫 응답 전송 시각을 기억했다가 Finish에서 지연으로 기록한다.
+type Span struct {
+	service   *Service
+	command   string
+	start     time.Time
+	repliedAt atomic.Int64 // 첫 응답 전송 시각 (UnixNano, 0이면 아직 응답 없음)
+}
+
+// WithReceivedAt: 메시지가 큐에 들어온 시각을 컨텍스트에 담는다. Start가 측정 시작점으로 사용한다.
+func WithReceivedAt(ctx context.Context, receivedAt time.Time) context.Context {
+	if receivedAt.IsZero() {
+		return ctx
+	}
+	return context.WithValue(ctx, receivedAtKey{}, receivedAt)
+}
+
+// StreamIDTime: Valkey 스트림 ID("<ms>-<seq>")의 밀리초 부분을 시각으로 변환한다. 형식이 다르면 0을 반환한다.
+func StreamIDTime(id string) time.Time {
+	ms, _, _ := strings.Cut(id, "-")
+	millis, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || millis <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+// Start: 명령어 처리 구간을 시작하고 Span을 담은 컨텍스트를 반환한다.
+// 수신 시각이 컨텍스트에 없으면 현재 시각부터 잰다. 서비스가 nil이면 기록하지 않는다.
+func (s *Service) Start(ctx context.Context, command string) (context.Context, *Span) {
+	if s == nil {
+		return ctx, nil
+	}
+	start, ok := ctx.Value(receivedAtKey{}).(time.Time)
+	if !ok {
+		start = time.Now()
+	}
+	span := &Span{service: s, command: command, start: start}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// MarkReply: 컨텍스트의 Span에 첫 응답 전송 시각을 남긴다. 이후 호출은 무시된다.
+func MarkReply(ctx context.Context) {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	if span == nil {
+		return
+	}
+	span.repliedAt.CompareAndSwap(0, time.Now().UnixNano())
+}
+
+// Finish: 처리 구간을 닫고 수신→첫 응답 지연을 기록한다. 응답 없이 끝난 명령은 기록하지 않는다.
+func (sp *Span) Finish() {
+	if sp == nil {
+		return
+	}
+	repliedAt := sp.repliedAt.Load()
+	if repliedAt == 0 {
+		return
+	}
+	sp.service.Record(sp.command, time.Unix(0, repliedAt).Sub(sp.start), sp.start)
+}
@@ -38,7 +38,7 @@ type MemberMatcher struct {
 	membersData           domain.MemberDataProvider
 	fallbackData          domain.MemberDataProvider
 	cache                 *cache.Service
-	holodex               *holodex.Service
+	holodex               holodex.StreamProvider
 	selector              ChannelSelector
 	logger                *slog.Logger
 	matchCache            map[string]*MatchCacheEntry
@@ -52,7 +52,7 @@ func NewMemberMatcher(
 	ctx context.Context,
 	membersData domain.MemberDataProvider,
 	cacheSvc *cache.Service,
-	holodexSvc *holodex.Service,
+	holodexSvc holodex.StreamProvider,
 	selector ChannelSelector,
 	logger *slog.Logger,
 ) *MemberMatcher {
@@ -1,9 +1,14 @@
 package member
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"sync"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
@@ -11,14 +16,33 @@ import (
 )
 
 const (
-	translationLocale         = "ko"
+	// TranslationLocale: 번역 프로필 locale (talent_profile_translations.locale)
+	TranslationLocale         = "ko"
 	cacheKeyProfileTranslated = "hololive:profile:translated:%s:%s"
 )
 
+// 프로필 관리 오류 목록.
+var (
+	// ErrProfileNotFound: slug에 해당하는 프로필 없음
+	ErrProfileNotFound = errors.New("profile not found")
+	// ErrProfileStoreUnavailable: DB 저장소 없이(임베드 JSON만으로) 동작 중이라 수정할 수 없음
+	ErrProfileStoreUnavailable = errors.New("profile store unavailable")
+)
+
+// TranslationPatch: 관리자 번역 수정 요청 (nil 필드는 유지)
+type TranslationPatch struct {
+	DisplayName *string
+	Catchphrase *string
+	Summary     *string
+	Highlights  *[]string
+}
+
 // ProfileService: 탤런트 상세 프로필 정보를 관리하는 서비스
-// 로컬 파일에서 데이터를 로드하고, 번역 정보를 관리하며 캐싱을 지원한다.
+// 임베드 JSON 또는 DB(UseStore)에서 데이터를 로드하고, 번역 정보를 관리하며 캐싱을 지원한다.
 type ProfileService struct {
+	mu            sync.RWMutex
 	cache         *cache.Service
+	store         *ProfileStore
 	logger        *slog.Logger
 	membersData   domain.MemberDataProvider
 	profiles      map[string]*domain.TalentProfile // slug -> profile
@@ -48,48 +72,88 @@ func NewProfileService(cacheSvc *cache.Service, membersData domain.MemberDataPro
 	}
 
 	service := &ProfileService{
-		cache:         cacheSvc,
-		logger:        logger,
-		membersData:   membersData,
-		profiles:      profiles,
-		translations:  preTranslated,
-		englishToSlug: make(map[string]string, len(profiles)),
-		channelToSlug: make(map[string]string, len(membersData.GetAllMembers())),
+		cache:        cacheSvc,
+		logger:       logger,
+		membersData:  membersData,
+		profiles:     maps.Clone(profiles), // 임베드 캐시는 공유되므로 수정용 사본을 둔다
+		translations: maps.Clone(preTranslated),
 	}
+	service.rebuildIndex()
+
+	logger.Info("ProfileService initialized",
+		slog.Int("profiles", len(service.profiles)),
+		slog.Int("translated_profiles", len(service.translations)),
+		slog.Int("index_english", len(service.englishToSlug)),
+		slog.Int("index_channel", len(service.channelToSlug)),
+	)
 
-	for slug, profile := range profiles {
+	return service, nil
+}
+
+// rebuildIndex: 영문 이름/채널 ID → slug 색인을 다시 만든다. (mu 쓰기 잠금 상태 또는 생성 중에 호출)
+func (s *ProfileService) rebuildIndex() {
+	s.englishToSlug = make(map[string]string, len(s.profiles))
+	s.channelToSlug = make(map[string]string, len(s.membersData.GetAllMembers()))
+
+	for slug, profile := range s.profiles {
 		if profile == nil {
 			continue
 		}
 		key := util.NormalizeKey(profile.EnglishName)
 		if key != "" {
-			service.englishToSlug[key] = slug
+			s.englishToSlug[key] = slug
 		}
 	}
 
-	for _, member := range membersData.GetAllMembers() {
+	for _, member := range s.membersData.GetAllMembers() {
 		if member == nil {
 			continue
 		}
-		if slug, ok := service.slugFor(member.Name); ok {
-			service.channelToSlug[util.Normalize(member.ChannelID)] = slug
+		if slug, ok := s.slugFor(member.Name); ok {
+			s.channelToSlug[util.Normalize(member.ChannelID)] = slug
 			continue
 		}
 
 		key := util.NormalizeKey(member.Name)
 		if key != "" {
-			service.englishToSlug[key] = util.Slugify(member.Name)
+			s.englishToSlug[key] = util.Slugify(member.Name)
 		}
 	}
+}
 
-	logger.Info("ProfileService initialized",
-		slog.Int("profiles", len(service.profiles)),
-		slog.Int("translated_profiles", len(service.translations)),
-		slog.Int("index_english", len(service.englishToSlug)),
-		slog.Int("index_channel", len(service.channelToSlug)),
-	)
+// UseStore: 프로필 원본/번역을 DB에서 읽도록 전환합니다.
+// DB가 비어 있으면 현재(임베드 JSON) 데이터를 가져와 채운 뒤 그대로 사용한다.
+func (s *ProfileService) UseStore(ctx context.Context, store *ProfileStore) error {
+	profiles, translations, err := store.Load(ctx, TranslationLocale)
+	if err != nil {
+		return err
+	}
 
-	return service, nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(profiles) == 0 {
+		result, err := store.Import(ctx, TranslationLocale, s.profiles, s.translations, false)
+		if err != nil {
+			return fmt.Errorf("failed to seed profile store: %w", err)
+		}
+		s.logger.Info("Seeded profile store from embedded JSON",
+			slog.Int("profiles", result.Profiles),
+			slog.Int("translations", result.Translations),
+		)
+		s.store = store
+		return nil
+	}
+
+	s.store = store
+	s.profiles = profiles
+	s.translations = translations
+	s.rebuildIndex()
+	s.logger.Info("ProfileService loaded from database",
+		slog.Int("profiles", len(profiles)),
+		slog.Int("translated_profiles", len(translations)),
+	)
+	return nil
 }
 
 // GetWithTranslation: 영문 이름으로 프로필을 조회하고, 번역된 정보가 있다면 함께 반환합니다.
@@ -124,6 +188,8 @@ func (s *ProfileService) GetByChannel(channelID string) (*domain.TalentProfile,
 	if channelID == "" {
 		return nil, fmt.Errorf("channel id is empty")
 	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	slug, ok := s.channelToSlug[util.Normalize(channelID)]
 	if !ok {
 		return nil, fmt.Errorf("no official profile for channel ID '%s'", channelID)
@@ -136,6 +202,8 @@ func (s *ProfileService) GetByChannel(channelID string) (*domain.TalentProfile,
 }
 
 func (s *ProfileService) byEnglish(englishName string) (*domain.TalentProfile, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	slug, ok := s.slugFor(englishName)
 	if !ok {
 		return nil, false
@@ -161,7 +229,7 @@ func (s *ProfileService) getTranslated(ctx context.Context, raw *domain.TalentPr
 		return nil, fmt.Errorf("raw profile is nil")
 	}
 
-	cacheKey := fmt.Sprintf(cacheKeyProfileTranslated, translationLocale, raw.Slug)
+	cacheKey := fmt.Sprintf(cacheKeyProfileTranslated, TranslationLocale, raw.Slug)
 
 	if s.cache != nil {
 		var cached domain.Translated
@@ -170,7 +238,10 @@ func (s *ProfileService) getTranslated(ctx context.Context, raw *domain.TalentPr
 		}
 	}
 
-	if translated := s.translations[raw.Slug]; translated != nil {
+	s.mu.RLock()
+	translated := s.translations[raw.Slug]
+	s.mu.RUnlock()
+	if translated != nil {
 		cloned := cloneTranslatedProfile(translated)
 		if s.cache != nil && cloned != nil {
 			if err := s.cache.Set(ctx, cacheKey, cloned, 0); err != nil {
@@ -183,14 +254,7 @@ func (s *ProfileService) getTranslated(ctx context.Context, raw *domain.TalentPr
 		return cloned, nil
 	}
 
-	// Fallback: raw profile에서 단순 번역 생성 (AI 미사용)
-	fallback := &domain.Translated{
-		DisplayName: raw.EnglishName,
-		Catchphrase: raw.Catchphrase,
-		Summary:     raw.Description,
-		Highlights:  []string{},
-		Data:        convertToTranslatedRows(raw.DataEntries),
-	}
+	fallback := fallbackTranslation(raw)
 	if s.cache != nil {
 		if err := s.cache.Set(ctx, cacheKey, fallback, 0); err != nil {
 			s.logger.Warn("Failed to cache fallback translated profile",
@@ -202,6 +266,17 @@ func (s *ProfileService) getTranslated(ctx context.Context, raw *domain.TalentPr
 	return fallback, nil
 }
 
+// fallbackTranslation: 번역이 없을 때 raw profile에서 단순 번역 생성 (AI 미사용)
+func fallbackTranslation(raw *domain.TalentProfile) *domain.Translated {
+	return &domain.Translated{
+		DisplayName: raw.EnglishName,
+		Catchphrase: raw.Catchphrase,
+		Summary:     raw.Description,
+		Highlights:  []string{},
+		Data:        convertToTranslatedRows(raw.DataEntries),
+	}
+}
+
 func convertToTranslatedRows(entries []domain.TalentProfileEntry) []domain.TranslatedProfileDataRow {
 	if len(entries) == 0 {
 		return []domain.TranslatedProfileDataRow{}
@@ -220,16 +295,19 @@ func convertToTranslatedRows(entries []domain.TalentProfileEntry) []domain.Trans
 
 // PreloadTranslations: 모든 번역 데이터를 캐시에 미리 적재하여 조회 성능을 높인다.
 func (s *ProfileService) PreloadTranslations(ctx context.Context) {
-	if s == nil || s.cache == nil || len(s.translations) == 0 {
+	if s == nil || s.cache == nil {
 		return
 	}
+	s.mu.RLock()
+	translations := maps.Clone(s.translations)
+	s.mu.RUnlock()
 
 	written := 0
-	for slug, profile := range s.translations {
+	for slug, profile := range translations {
 		if profile == nil {
 			continue
 		}
-		if err := s.cache.Set(ctx, fmt.Sprintf(cacheKeyProfileTranslated, translationLocale, slug), profile, 0); err != nil {
+		if err := s.cache.Set(ctx, fmt.Sprintf(cacheKeyProfileTranslated, TranslationLocale, slug), profile, 0); err != nil {
 			s.logger.Warn("Failed to preload translated profile",
 				slog.String("slug", slug),
 				slog.Any("error", err),
@@ -260,3 +338,122 @@ func cloneTranslatedProfile(src *domain.Translated) *domain.Translated {
 	}
 	return &clone
 }
+
+// ProfileEntry: 관리자 화면용 프로필 (번역이 저장돼 있지 않으면 Translated는 nil)
+type ProfileEntry struct {
+	Profile    *domain.TalentProfile
+	Translated *domain.Translated
+}
+
+// List: 전체 프로필과 저장된 번역을 slug 순으로 반환합니다.
+func (s *ProfileService) List() []ProfileEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]ProfileEntry, 0, len(s.profiles))
+	for slug, profile := range s.profiles {
+		if profile == nil {
+			continue
+		}
+		entries = append(entries, ProfileEntry{Profile: profile, Translated: cloneTranslatedProfile(s.translations[slug])})
+	}
+	slices.SortFunc(entries, func(a, b ProfileEntry) int { return cmp.Compare(a.Profile.Slug, b.Profile.Slug) })
+	return entries
+}
+
+// Get: slug로 프로필과 저장된 번역을 조회합니다.
+func (s *ProfileService) Get(slug string) (ProfileEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	profile := s.profiles[slug]
+	if profile == nil {
+		return ProfileEntry{}, ErrProfileNotFound
+	}
+	return ProfileEntry{Profile: profile, Translated: cloneTranslatedProfile(s.translations[slug])}, nil
+}
+
+// UpdateTranslation: 번역 필드를 수정해 DB에 저장하고, 캐시를 비워 다음 조회부터 반영되게 합니다.
+// 저장된 번역이 없으면 원본 기반 기본 번역에 patch를 적용한다.
+func (s *ProfileService) UpdateTranslation(ctx context.Context, slug string, patch TranslationPatch, editedBy string) (*domain.Translated, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.store == nil {
+		return nil, ErrProfileStoreUnavailable
+	}
+	profile := s.profiles[slug]
+	if profile == nil {
+		return nil, ErrProfileNotFound
+	}
+
+	updated := cloneTranslatedProfile(s.translations[slug])
+	if updated == nil {
+		updated = fallbackTranslation(profile)
+	}
+	if patch.DisplayName != nil {
+		updated.DisplayName = util.TrimSpace(*patch.DisplayName)
+	}
+	if patch.Catchphrase != nil {
+		updated.Catchphrase = util.TrimSpace(*patch.Catchphrase)
+	}
+	if patch.Summary != nil {
+		updated.Summary = util.TrimSpace(*patch.Summary)
+	}
+	if patch.Highlights != nil {
+		updated.Highlights = make([]string, 0, len(*patch.Highlights))
+		for _, highlight := range *patch.Highlights {
+			if highlight = util.TrimSpace(highlight); highlight != "" {
+				updated.Highlights = append(updated.Highlights, highlight)
+			}
+		}
+	}
+
+	if editedBy == "" {
+		editedBy = "admin"
+	}
+	if err := s.store.SaveTranslation(ctx, slug, TranslationLocale, updated, editedBy); err != nil {
+		return nil, err
+	}
+	s.translations[slug] = updated
+	s.invalidateTranslation(ctx, slug)
+
+	s.logger.Info("Profile translation updated",
+		slog.String("slug", slug),
+		slog.String("edited_by", editedBy),
+	)
+	return cloneTranslatedProfile(updated), nil
+}
+
+// DeleteTranslation: 저장된 번역을 삭제합니다. 이후 조회는 원본 기반 기본 번역으로 응답한다.
+func (s *ProfileService) DeleteTranslation(ctx context.Context, slug string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.store == nil {
+		return ErrProfileStoreUnavailable
+	}
+	if s.profiles[slug] == nil {
+		return ErrProfileNotFound
+	}
+	if _, err := s.store.DeleteTranslation(ctx, slug, TranslationLocale); err != nil {
+		return err
+	}
+	delete(s.translations, slug)
+	s.invalidateTranslation(ctx, slug)
+
+	s.logger.Info("Profile translation deleted", slog.String("slug", slug))
+	return nil
+}
+
+func (s *ProfileService) invalidateTranslation(ctx context.Context, slug string) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Del(ctx, fmt.Sprintf(cacheKeyProfileTranslated, TranslationLocale, slug)); err != nil {
+		s.logger.Warn("Failed to invalidate translated profile cache",
+			slog.String("slug", slug),
+			slog.Any("error", err),
+		)
+	}
+}
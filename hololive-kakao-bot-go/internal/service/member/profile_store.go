@@ -0,0 +1,319 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-json"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// profileModel: talent_profiles 테이블 GORM 모델 (공식 사이트 원본, 목록 필드는 JSON 문자열)
+type profileModel struct {
+	Slug         string    `gorm:"primaryKey;column:slug"`
+	EnglishName  string    `gorm:"column:english_name"`
+	JapaneseName string    `gorm:"column:japanese_name"`
+	Catchphrase  string    `gorm:"column:catchphrase"`
+	Description  string    `gorm:"column:description"`
+	DataEntries  string    `gorm:"column:data_entries"`
+	SocialLinks  string    `gorm:"column:social_links"`
+	OfficialURL  string    `gorm:"column:official_url"`
+	UpdatedAt    time.Time `gorm:"column:updated_at"`
+}
+
+// TableName: 원본 프로필 테이블의 이름을 반환한다. ("talent_profiles")
+func (profileModel) TableName() string {
+	return "talent_profiles"
+}
+
+// translationModel: talent_profile_translations 테이블 GORM 모델 (slug+locale 단위 번역)
+type translationModel struct {
+	Slug        string    `gorm:"primaryKey;column:slug"`
+	Locale      string    `gorm:"primaryKey;column:locale"`
+	DisplayName string    `gorm:"column:display_name"`
+	Catchphrase string    `gorm:"column:catchphrase"`
+	Summary     string    `gorm:"column:summary"`
+	Highlights  string    `gorm:"column:highlights"`
+	Data        string    `gorm:"column:data"`
+	EditedBy    string    `gorm:"column:edited_by"` // 관리자가 수정한 경우 요청자, 가져오기로 채운 행은 빈 값
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
+
+// TableName: 번역 프로필 테이블의 이름을 반환한다. ("talent_profile_translations")
+func (translationModel) TableName() string {
+	return "talent_profile_translations"
+}
+
+// ProfileImportResult: JSON → DB 가져오기 결과
+type ProfileImportResult struct {
+	Profiles         int `json:"profiles"`         // upsert한 원본 프로필 수
+	Translations     int `json:"translations"`     // 새로 넣거나 덮어쓴 번역 수
+	TranslationsKept int `json:"translationsKept"` // 관리자 수정본이라 건드리지 않은 번역 수
+}
+
+// ProfileStore: 탤런트 프로필/번역의 영속 저장소 (PostgreSQL)
+type ProfileStore struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewProfileStore: 프로필 저장소를 생성하고 테이블을 준비합니다.
+func NewProfileStore(ctx context.Context, db *gorm.DB) (*ProfileStore, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db is required")
+	}
+	store := &ProfileStore{db: db, now: time.Now}
+	if err := store.createTablesIfNotExist(ctx); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *ProfileStore) createTablesIfNotExist(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS talent_profiles (
+			slug TEXT PRIMARY KEY,
+			english_name TEXT NOT NULL,
+			japanese_name TEXT NOT NULL DEFAULT '',
+			catchphrase TEXT NOT NULL DEFAULT '',
+			description TEXT NOT NULL DEFAULT '',
+			data_entries TEXT NOT NULL DEFAULT '[]',
+			social_links TEXT NOT NULL DEFAULT '[]',
+			official_url TEXT NOT NULL DEFAULT '',
+			updated_at TIMESTAMP NOT NULL
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create talent_profiles table: %w", err)
+	}
+
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS talent_profile_translations (
+			slug TEXT NOT NULL,
+			locale TEXT NOT NULL,
+			display_name TEXT NOT NULL DEFAULT '',
+			catchphrase TEXT NOT NULL DEFAULT '',
+			summary TEXT NOT NULL DEFAULT '',
+			highlights TEXT NOT NULL DEFAULT '[]',
+			data TEXT NOT NULL DEFAULT '[]',
+			edited_by TEXT NOT NULL DEFAULT '',
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (slug, locale)
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create talent_profile_translations table: %w", err)
+	}
+
+	return nil
+}
+
+// Load: 저장된 원본 프로필과 locale 번역을 slug 기준으로 조회합니다.
+func (s *ProfileStore) Load(ctx context.Context, locale string) (map[string]*domain.TalentProfile, map[string]*domain.Translated, error) {
+	var profileRows []profileModel
+	if err := s.db.WithContext(ctx).Find(&profileRows).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to load talent profiles: %w", err)
+	}
+	var translationRows []translationModel
+	if err := s.db.WithContext(ctx).Where("locale = ?", locale).Find(&translationRows).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to load profile translations: %w", err)
+	}
+
+	profiles := make(map[string]*domain.TalentProfile, len(profileRows))
+	for _, row := range profileRows {
+		profile, err := row.toDomain()
+		if err != nil {
+			return nil, nil, err
+		}
+		profiles[row.Slug] = profile
+	}
+	translations := make(map[string]*domain.Translated, len(translationRows))
+	for _, row := range translationRows {
+		translated, err := row.toDomain()
+		if err != nil {
+			return nil, nil, err
+		}
+		translations[row.Slug] = translated
+	}
+	return profiles, translations, nil
+}
+
+// Import: JSON 프로필/번역을 한 트랜잭션으로 가져옵니다.
+// 원본 프로필은 항상 upsert하고, 관리자가 수정한 번역(edited_by 있음)은 overwriteEdited가 아니면 유지한다.
+func (s *ProfileStore) Import(
+	ctx context.Context,
+	locale string,
+	profiles map[string]*domain.TalentProfile,
+	translations map[string]*domain.Translated,
+	overwriteEdited bool,
+) (ProfileImportResult, error) {
+	var result ProfileImportResult
+	now := s.now().UTC()
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for slug, profile := range profiles {
+			if profile == nil {
+				continue
+			}
+			row, err := newProfileModel(slug, profile, now)
+			if err != nil {
+				return err
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "slug"}},
+				DoUpdates: clause.AssignmentColumns([]string{"english_name", "japanese_name", "catchphrase", "description", "data_entries", "social_links", "official_url", "updated_at"}),
+			}).Create(&row).Error; err != nil {
+				return fmt.Errorf("failed to import profile %s: %w", slug, err)
+			}
+			result.Profiles++
+		}
+
+		for slug, translated := range translations {
+			if translated == nil {
+				continue
+			}
+			var existing translationModel
+			err := tx.Where("slug = ? AND locale = ?", slug, locale).Take(&existing).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+			case err != nil:
+				return fmt.Errorf("failed to check translation %s: %w", slug, err)
+			case existing.EditedBy != "" && !overwriteEdited:
+				result.TranslationsKept++
+				continue
+			}
+
+			row, err := newTranslationModel(slug, locale, translated, "", now)
+			if err != nil {
+				return err
+			}
+			if err := upsertTranslation(tx, &row); err != nil {
+				return fmt.Errorf("failed to import translation %s: %w", slug, err)
+			}
+			result.Translations++
+		}
+		return nil
+	})
+	if err != nil {
+		return ProfileImportResult{}, err
+	}
+	return result, nil
+}
+
+// SaveTranslation: 관리자가 수정한 번역을 저장합니다.
+func (s *ProfileStore) SaveTranslation(ctx context.Context, slug, locale string, translated *domain.Translated, editedBy string) error {
+	row, err := newTranslationModel(slug, locale, translated, editedBy, s.now().UTC())
+	if err != nil {
+		return err
+	}
+	if err := upsertTranslation(s.db.WithContext(ctx), &row); err != nil {
+		return fmt.Errorf("failed to save translation %s: %w", slug, err)
+	}
+	return nil
+}
+
+// DeleteTranslation: 번역을 삭제하고, 삭제된 행이 있었는지 반환합니다.
+func (s *ProfileStore) DeleteTranslation(ctx context.Context, slug, locale string) (bool, error) {
+	result := s.db.WithContext(ctx).Where("slug = ? AND locale = ?", slug, locale).Delete(&translationModel{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete translation %s: %w", slug, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func upsertTranslation(db *gorm.DB, row *translationModel) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slug"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"display_name", "catchphrase", "summary", "highlights", "data", "edited_by", "updated_at"}),
+	}).Create(row).Error
+}
+
+func newProfileModel(slug string, profile *domain.TalentProfile, now time.Time) (profileModel, error) {
+	entries, err := json.Marshal(nonNil(profile.DataEntries))
+	if err != nil {
+		return profileModel{}, fmt.Errorf("failed to marshal data entries %s: %w", slug, err)
+	}
+	links, err := json.Marshal(nonNil(profile.SocialLinks))
+	if err != nil {
+		return profileModel{}, fmt.Errorf("failed to marshal social links %s: %w", slug, err)
+	}
+	return profileModel{
+		Slug:         slug,
+		EnglishName:  profile.EnglishName,
+		JapaneseName: profile.JapaneseName,
+		Catchphrase:  profile.Catchphrase,
+		Description:  profile.Description,
+		DataEntries:  string(entries),
+		SocialLinks:  string(links),
+		OfficialURL:  profile.OfficialURL,
+		UpdatedAt:    now,
+	}, nil
+}
+
+func (m profileModel) toDomain() (*domain.TalentProfile, error) {
+	profile := &domain.TalentProfile{
+		Slug:         m.Slug,
+		EnglishName:  m.EnglishName,
+		JapaneseName: m.JapaneseName,
+		Catchphrase:  m.Catchphrase,
+		Description:  m.Description,
+		OfficialURL:  m.OfficialURL,
+	}
+	if err := json.Unmarshal([]byte(m.DataEntries), &profile.DataEntries); err != nil {
+		return nil, fmt.Errorf("failed to parse data entries %s: %w", m.Slug, err)
+	}
+	if err := json.Unmarshal([]byte(m.SocialLinks), &profile.SocialLinks); err != nil {
+		return nil, fmt.Errorf("failed to parse social links %s: %w", m.Slug, err)
+	}
+	return profile, nil
+}
+
+func newTranslationModel(slug, locale string, translated *domain.Translated, editedBy string, now time.Time) (translationModel, error) {
+	highlights, err := json.Marshal(nonNil(translated.Highlights))
+	if err != nil {
+		return translationModel{}, fmt.Errorf("failed to marshal highlights %s: %w", slug, err)
+	}
+	data, err := json.Marshal(nonNil(translated.Data))
+	if err != nil {
+		return translationModel{}, fmt.Errorf("failed to marshal translated data %s: %w", slug, err)
+	}
+	return translationModel{
+		Slug:        slug,
+		Locale:      locale,
+		DisplayName: translated.DisplayName,
+		Catchphrase: translated.Catchphrase,
+		Summary:     translated.Summary,
+		Highlights:  string(highlights),
+		Data:        string(data),
+		EditedBy:    editedBy,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (m translationModel) toDomain() (*domain.Translated, error) {
+	translated := &domain.Translated{
+		DisplayName: m.DisplayName,
+		Catchphrase: m.Catchphrase,
+		Summary:     m.Summary,
+	}
+	if err := json.Unmarshal([]byte(m.Highlights), &translated.Highlights); err != nil {
+		return nil, fmt.Errorf("failed to parse highlights %s: %w", m.Slug, err)
+	}
+	if err := json.Unmarshal([]byte(m.Data), &translated.Data); err != nil {
+		return nil, fmt.Errorf("failed to parse translated data %s: %w", m.Slug, err)
+	}
+	return translated, nil
+}
+
+// nonNil: nil 슬라이스를 JSON null 대신 []로 저장하기 위함
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
@@ -0,0 +1,146 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+func newTestProfileStore(t *testing.T) *ProfileStore {
+	t.Helper()
+
+	dbName := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	db, err := gorm.Open(sqlite.Open("file:"+dbName+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	store, err := NewProfileStore(context.Background(), db)
+	if err != nil {
+		t.Fatalf("failed to create profile store: %v", err)
+	}
+	return store
+}
+
+func TestProfileStore_ImportKeepsEditedTranslations(t *testing.T) {
+	ctx := context.Background()
+	store := newTestProfileStore(t)
+
+	profiles := map[string]*domain.TalentProfile{
+		"tokino-sora": {
+			Slug:        "tokino-sora",
+			EnglishName: "Tokino Sora",
+			DataEntries: []domain.TalentProfileEntry{{Label: "誕生日", Value: "5月15日"}},
+		},
+	}
+	translations := map[string]*domain.Translated{
+		"tokino-sora": {DisplayName: "토키노 소라", Summary: "원본 요약"},
+	}
+	if _, err := store.Import(ctx, TranslationLocale, profiles, translations, false); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if err := store.SaveTranslation(ctx, "tokino-sora", TranslationLocale, &domain.Translated{DisplayName: "소라", Summary: "수정한 요약"}, "operator"); err != nil {
+		t.Fatalf("SaveTranslation failed: %v", err)
+	}
+
+	result, err := store.Import(ctx, TranslationLocale, profiles, translations, false)
+	if err != nil {
+		t.Fatalf("re-import failed: %v", err)
+	}
+	if result.Profiles != 1 || result.Translations != 0 || result.TranslationsKept != 1 {
+		t.Fatalf("unexpected import result: %+v", result)
+	}
+
+	loadedProfiles, loadedTranslations, err := store.Load(ctx, TranslationLocale)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := loadedProfiles["tokino-sora"]; got == nil || got.DataEntries[0].Value != "5月15日" || got.SocialLinks == nil {
+		t.Fatalf("unexpected profile: %+v", got)
+	}
+	if got := loadedTranslations["tokino-sora"]; got.Summary != "수정한 요약" || got.Highlights == nil {
+		t.Fatalf("edited translation must survive import: %+v", got)
+	}
+
+	if _, err := store.Import(ctx, TranslationLocale, nil, translations, true); err != nil {
+		t.Fatalf("overwrite import failed: %v", err)
+	}
+	if _, loadedTranslations, _ = store.Load(ctx, TranslationLocale); loadedTranslations["tokino-sora"].Summary != "원본 요약" {
+		t.Fatalf("overwriteEdited should replace the edit: %+v", loadedTranslations["tokino-sora"])
+	}
+}
+
+func TestProfileService_UseStoreAndEditTranslation(t *testing.T) {
+	ctx := context.Background()
+	store := newTestProfileStore(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	svc, err := NewProfileService(nil, newStubMemberProvider(nil), logger)
+	if err != nil {
+		t.Fatalf("failed to create service: %v", err)
+	}
+	entries := svc.List()
+	if len(entries) == 0 {
+		t.Fatalf("expected embedded profiles")
+	}
+	target := entries[0].Profile
+
+	if _, err := svc.UpdateTranslation(ctx, target.Slug, TranslationPatch{}, ""); !errors.Is(err, ErrProfileStoreUnavailable) {
+		t.Fatalf("editing without store should fail, got %v", err)
+	}
+	if err := svc.UseStore(ctx, store); err != nil {
+		t.Fatalf("UseStore (seed) failed: %v", err)
+	}
+
+	summary := "  새 요약  "
+	highlights := []string{"노래", " ", "게임"}
+	updated, err := svc.UpdateTranslation(ctx, target.Slug, TranslationPatch{Summary: &summary, Highlights: &highlights}, "")
+	if err != nil {
+		t.Fatalf("UpdateTranslation failed: %v", err)
+	}
+	if updated.Summary != "새 요약" || !slices.Equal(updated.Highlights, []string{"노래", "게임"}) || updated.DisplayName == "" {
+		t.Fatalf("unexpected updated translation: %+v", updated)
+	}
+	if _, err := svc.UpdateTranslation(ctx, "no-such-talent", TranslationPatch{Summary: &summary}, ""); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("unknown slug should be not found, got %v", err)
+	}
+
+	// 새 서비스가 DB에서 수정본을 읽어야 함
+	reloaded, err := NewProfileService(nil, newStubMemberProvider(nil), logger)
+	if err != nil {
+		t.Fatalf("failed to create service: %v", err)
+	}
+	if err := reloaded.UseStore(ctx, store); err != nil {
+		t.Fatalf("UseStore (load) failed: %v", err)
+	}
+	entry, err := reloaded.Get(target.Slug)
+	if err != nil || entry.Translated == nil || entry.Translated.Summary != "새 요약" {
+		t.Fatalf("edit should be persisted: %+v %v", entry.Translated, err)
+	}
+
+	if err := reloaded.DeleteTranslation(ctx, target.Slug); err != nil {
+		t.Fatalf("DeleteTranslation failed: %v", err)
+	}
+	_, translated, err := reloaded.GetWithTranslation(ctx, target.EnglishName)
+	if err != nil || translated.Summary != target.Description {
+		t.Fatalf("deleted translation should fall back to raw profile: %+v %v", translated, err)
+	}
+}
@@ -0,0 +1,158 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+)
+
+const (
+	// maxUserAdvanceMinute: 사용자가 지정할 수 있는 최대 알림 시점(분). 알림 체크는 24시간 이내 일정만 조회한다.
+	maxUserAdvanceMinute = 24 * 60
+	// maxUserAdvanceTargets: 사용자별 알림 시점 최대 개수
+	maxUserAdvanceTargets = 5
+)
+
+// SetUserAdvanceMinutes: 사용자의 알림 시점(방송 시작 N분 전 목록)을 저장하고, 정규화된 값을 반환합니다.
+// minutes가 비어 있으면 사용자 설정을 삭제하고 전역 기본값을 반환한다.
+func (as *AlarmService) SetUserAdvanceMinutes(ctx context.Context, roomID, userID string, minutes []int) ([]int, error) {
+	field := as.getRegistryKey(roomID, userID)
+
+	if len(minutes) == 0 {
+		if err := as.cache.HDel(ctx, AdvanceMinutesKey, field); err != nil {
+			return nil, fmt.Errorf("reset advance minutes: %w", err)
+		}
+		as.logger.Info("Alarm advance minutes reset",
+			slog.String("room_id", roomID),
+			slog.String("user_id", userID),
+		)
+		return slices.Clone(as.targetMinutes), nil
+	}
+
+	normalized, err := normalizeUserAdvanceMinutes(minutes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := as.cache.HSet(ctx, AdvanceMinutesKey, field, formatAdvanceMinutes(normalized)); err != nil {
+		return nil, fmt.Errorf("set advance minutes: %w", err)
+	}
+
+	as.logger.Info("Alarm advance minutes set",
+		slog.String("room_id", roomID),
+		slog.String("user_id", userID),
+		slog.Any("minutes", normalized),
+	)
+	return normalized, nil
+}
+
+// GetUserAdvanceMinutes: 사용자의 알림 시점 목록을 반환합니다. 설정이 없으면 전역 기본값을 반환한다.
+func (as *AlarmService) GetUserAdvanceMinutes(ctx context.Context, roomID, userID string) ([]int, error) {
+	raw, err := as.cache.HGet(ctx, AdvanceMinutesKey, as.getRegistryKey(roomID, userID))
+	if err != nil {
+		return nil, fmt.Errorf("get advance minutes: %w", err)
+	}
+
+	if minutes := parseAdvanceMinutes(raw); len(minutes) > 0 {
+		return minutes, nil
+	}
+	return slices.Clone(as.targetMinutes), nil
+}
+
+// loadUserAdvanceMinutes: 사용자별 알림 시점 설정 전체를 registryKey(roomID:userID) 기준으로 조회합니다.
+func (as *AlarmService) loadUserAdvanceMinutes(ctx context.Context) map[string][]int {
+	values, err := as.cache.HGetAll(ctx, AdvanceMinutesKey)
+	if err != nil {
+		as.logger.Warn("Failed to load user advance minutes, using defaults", slog.Any("error", err))
+		return map[string][]int{}
+	}
+
+	result := make(map[string][]int, len(values))
+	for registryKey, raw := range values {
+		if minutes := parseAdvanceMinutes(raw); len(minutes) > 0 {
+			result[registryKey] = minutes
+		}
+	}
+	return result
+}
+
+// markCustomTargetNotified: 사용자별 알림 시점(minute) 알림 발송을 기록합니다.
+func (as *AlarmService) markCustomTargetNotified(ctx context.Context, streamID string, startScheduled time.Time, minute int) error {
+	data := NotifiedData{
+		StartScheduled: startScheduled.Format(time.RFC3339),
+		NotifiedAt:     time.Now().Format(time.RFC3339),
+		MinutesUntil:   minute,
+	}
+	if err := as.cache.Set(ctx, customNotifiedKey(streamID, minute), data, constants.CacheTTL.NotificationSent); err != nil {
+		return fmt.Errorf("mark custom target notified: %w", err)
+	}
+	return nil
+}
+
+// isCustomTargetNotified: 해당 방송의 minute 시점 알림이 현재 일정 기준으로 이미 발송되었는지 확인합니다.
+// 일정이 바뀌면 기록된 시작 시각과 달라지므로 다시 발송 대상이 된다.
+func (as *AlarmService) isCustomTargetNotified(ctx context.Context, streamID string, startScheduled time.Time, minute int) bool {
+	var data NotifiedData
+	if err := as.cache.Get(ctx, customNotifiedKey(streamID, minute), &data); err != nil || data.StartScheduled == "" {
+		return false
+	}
+	saved, err := time.Parse(time.RFC3339, data.StartScheduled)
+	if err != nil {
+		return false
+	}
+	return saved.Unix() == startScheduled.Unix()
+}
+
+func customNotifiedKey(streamID string, minute int) string {
+	return NotifiedKeyPrefix + streamID + ":" + strconv.Itoa(minute)
+}
+
+// normalizeUserAdvanceMinutes: 중복 제거 후 내림차순 정렬합니다. 범위를 벗어난 값이 있으면 오류를 반환한다.
+// 전역 설정과 달리 1분 전 폴백을 자동으로 추가하지 않는다. (사용자가 고른 시점에만 알림)
+func normalizeUserAdvanceMinutes(minutes []int) ([]int, error) {
+	normalized := make([]int, 0, len(minutes))
+	for _, minute := range minutes {
+		if minute <= 0 || minute > maxUserAdvanceMinute {
+			return nil, fmt.Errorf("advance minute out of range (1-%d): %d", maxUserAdvanceMinute, minute)
+		}
+		if !slices.Contains(normalized, minute) {
+			normalized = append(normalized, minute)
+		}
+	}
+	if len(normalized) > maxUserAdvanceTargets {
+		return nil, fmt.Errorf("too many advance minutes (max %d): %d", maxUserAdvanceTargets, len(normalized))
+	}
+
+	slices.SortFunc(normalized, func(a, b int) int { return b - a })
+	return normalized, nil
+}
+
+func parseAdvanceMinutes(raw string) []int {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+
+	minutes := make([]int, 0, maxUserAdvanceTargets)
+	for _, part := range strings.Split(raw, ",") {
+		minute, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || minute <= 0 {
+			continue
+		}
+		minutes = append(minutes, minute)
+	}
+	return minutes
+}
+
+func formatAdvanceMinutes(minutes []int) string {
+	parts := make([]string, len(minutes))
+	for i, minute := range minutes {
+		parts[i] = strconv.Itoa(minute)
+	}
+	return strings.Join(parts, ",")
+}
@@ -72,6 +72,15 @@ func (as *AlarmService) SetRoomName(ctx context.Context, roomID, roomName string
 	return nil
 }
 
+// GetRoomName: 방 ID에 저장된 표시 이름을 조회합니다. 없으면 빈 문자열을 반환합니다.
+func (as *AlarmService) GetRoomName(ctx context.Context, roomID string) (string, error) {
+	roomName, err := as.cache.HGet(ctx, RoomNamesCacheKey, roomID)
+	if err != nil {
+		return "", fmt.Errorf("get room name: %w", err)
+	}
+	return roomName, nil
+}
+
 // SetUserName: 사용자 ID에 대한 표시 이름을 설정합니다.
 func (as *AlarmService) SetUserName(ctx context.Context, userID, userName string) error {
 	if err := as.cache.HSet(ctx, UserNamesCacheKey, userID, userName); err != nil {
@@ -100,6 +109,30 @@ func (as *AlarmService) MarkAsNotified(ctx context.Context, streamID string, sta
 	return nil
 }
 
+// MarkNotificationSent: 발송한 알림의 대상에 맞게 발송 기록을 남깁니다.
+// 기본 설정 사용자가 포함된 알림은 방송 단위 기록을, 모든 알림은 시점(분) 단위 기록을 남긴다.
+func (as *AlarmService) MarkNotificationSent(ctx context.Context, notification *domain.AlarmNotification) error {
+	if notification == nil || notification.Stream == nil || notification.Stream.StartScheduled == nil {
+		return nil
+	}
+
+	stream := notification.Stream
+	if !notification.CustomOnly {
+		if err := as.MarkAsNotified(ctx, stream.ID, *stream.StartScheduled, notification.MinutesUntil); err != nil {
+			return err
+		}
+	}
+
+	minute := notification.TargetMinute
+	if minute <= 0 {
+		minute = util.MinutesUntilCeil(stream.StartScheduled, time.Now())
+	}
+	if minute <= 0 {
+		return nil
+	}
+	return as.markCustomTargetNotified(ctx, stream.ID, *stream.StartScheduled, minute)
+}
+
 // isAlreadyNotified: 해당 방송에 대해 이미 알림이 발송되었는지 확인함
 func (as *AlarmService) isAlreadyNotified(ctx context.Context, streamID string) bool {
 	notifiedKey := NotifiedKeyPrefix + streamID
@@ -130,6 +163,7 @@ func (as *AlarmService) GetNextStreamInfo(ctx context.Context, channelID string)
 	info := &domain.NextStreamInfo{
 		Status:  domain.NextStreamStatus(util.TrimSpace(data["status"])),
 		VideoID: util.TrimSpace(data["video_id"]),
+		URL:     util.TrimSpace(data["url"]),
 		Title:   util.TrimSpace(data["title"]),
 	}
 
@@ -198,6 +232,7 @@ func (as *AlarmService) cacheLiveStream(ctx context.Context, key string, stream
 	fields := map[string]any{
 		"title":    stream.Title,
 		"video_id": stream.ID,
+		"url":      stream.GetWatchURL(),
 		"status":   "live",
 	}
 	if stream.StartScheduled != nil {
@@ -218,6 +253,7 @@ func (as *AlarmService) cacheUpcomingStream(ctx context.Context, key string, str
 		"title":           stream.Title,
 		"start_scheduled": stream.StartScheduled.Format(time.RFC3339),
 		"video_id":        stream.ID,
+		"url":             stream.GetWatchURL(),
 		"status":          "upcoming",
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -39,13 +40,24 @@ func (as *AlarmService) CheckUpcomingStreams(ctx context.Context) ([]*domain.Ala
 	p := pool.New().WithMaxGoroutines(concurrency)
 	now := time.Now()
 
+	// 사용자별 알림 시점 설정을 반영해 이번 체크에서 확인할 시점(분) 집합 구성
+	userTargets := as.loadUserAdvanceMinutes(ctx)
+	notifyMinutes := as.collectNotifyMinutes(userTargets)
+	keywordFilters := as.loadKeywordFilters(ctx)
+
+	// 휴면 방 구독은 제외 (조회 실패 시 모든 방을 대상으로 진행)
+	dormant, err := as.DormantRooms(ctx)
+	if err != nil {
+		as.logger.Warn("Failed to load dormant rooms", slog.Any("error", err))
+	}
+
 	results := make([]*channelCheckResult, len(channelIDs))
 	resultsMu := sync.Mutex{}
 
 	for idx, channelID := range channelIDs {
 		idx, channelID := idx, channelID
 		p.Go(func() {
-			result := as.checkChannel(ctx, channelID)
+			result := as.checkChannel(ctx, channelID, dormant)
 			resultsMu.Lock()
 			results[idx] = result
 			resultsMu.Unlock()
@@ -67,10 +79,11 @@ func (as *AlarmService) CheckUpcomingStreams(ctx context.Context) ([]*domain.Ala
 			continue
 		}
 
-		upcomingStreams := as.filterUpcomingStreams(result.streams, now)
+		filters := subscriberFilters(result.channelID, result.subscribers, keywordFilters)
+		upcomingStreams := as.filterUpcomingStreams(result.streams, now, notifyMinutes, len(result.subscribers), filters)
 
 		for _, stream := range upcomingStreams {
-			roomNotifs, err := as.createNotification(ctx, stream, result.channelID, result.subscribers)
+			roomNotifs, err := as.createNotification(ctx, stream, result.channelID, result.subscribers, userTargets, filters, now)
 			if err != nil {
 				as.logger.Warn("Failed to create notification", slog.Any("error", err))
 				continue
@@ -79,6 +92,7 @@ func (as *AlarmService) CheckUpcomingStreams(ctx context.Context) ([]*domain.Ala
 			if len(roomNotifs) > 0 {
 				as.logger.Info("Alarm notifications created",
 					slog.String("channel", stream.ChannelName),
+					slog.Bool("twitch", stream.IsTwitch()),
 					slog.Int("minutes_until", roomNotifs[0].MinutesUntil),
 					slog.Int("rooms", len(roomNotifs)),
 				)
@@ -90,13 +104,16 @@ func (as *AlarmService) CheckUpcomingStreams(ctx context.Context) ([]*domain.Ala
 	return notifications, nil
 }
 
+// channelScheduleHours: 알람 체크의 채널 일정 조회 범위 (SchedulePrefetcher도 같은 캐시 키를 채우도록 공유)
+const channelScheduleHours = 24
+
 type channelCheckResult struct {
 	channelID   string
 	subscribers []string
 	streams     []*domain.Stream
 }
 
-func (as *AlarmService) checkChannel(ctx context.Context, channelID string) *channelCheckResult {
+func (as *AlarmService) checkChannel(ctx context.Context, channelID string, dormant map[string]time.Time) *channelCheckResult {
 	channelSubsKey := as.channelSubscribersKey(channelID)
 	subscribers, err := as.cache.SMembers(ctx, channelSubsKey)
 	if err != nil {
@@ -113,7 +130,13 @@ func (as *AlarmService) checkChannel(ctx context.Context, channelID string) *cha
 		return &channelCheckResult{channelID: channelID, subscribers: []string{}, streams: []*domain.Stream{}}
 	}
 
-	streams, err := as.holodex.GetChannelSchedule(ctx, channelID, 24, true)
+	// 구독자가 모두 휴면 방이면 Holodex 조회 자체를 건너뜀 (레지스트리는 복귀에 대비해 유지)
+	subscribers = activeSubscribers(subscribers, dormant)
+	if len(subscribers) == 0 {
+		return &channelCheckResult{channelID: channelID, subscribers: []string{}, streams: []*domain.Stream{}}
+	}
+
+	streams, err := as.holodex.GetChannelSchedule(ctx, channelID, channelScheduleHours, true)
 	if err != nil {
 		as.logger.Warn("Failed to get channel schedule",
 			slog.String("channel_id", channelID),
@@ -129,7 +152,22 @@ func (as *AlarmService) checkChannel(ctx context.Context, channelID string) *cha
 	}
 }
 
-func (as *AlarmService) filterUpcomingStreams(streams []*domain.Stream, now time.Time) []*domain.Stream {
+// collectNotifyMinutes: 전역 알림 시점과 사용자별 알림 시점의 합집합을 반환합니다.
+func (as *AlarmService) collectNotifyMinutes(userTargets map[string][]int) []int {
+	minutes := slices.Clone(as.targetMinutes)
+	for _, targets := range userTargets {
+		for _, minute := range targets {
+			if !slices.Contains(minutes, minute) {
+				minutes = append(minutes, minute)
+			}
+		}
+	}
+	return minutes
+}
+
+// filterUpcomingStreams: 알림 시점에 도달한 예정 방송 중 구독자 누군가의 키워드 필터를 통과하는 방송만 남긴다.
+// filters는 필터를 설정한 구독자만 담으며, 필터 없는 구독자가 있으면 제목과 무관하게 통과한다.
+func (as *AlarmService) filterUpcomingStreams(streams []*domain.Stream, now time.Time, notifyMinutes []int, subscriberCount int, filters map[string]domain.AlarmKeywordFilter) []*domain.Stream {
 	filtered := make([]*domain.Stream, 0, len(streams))
 
 	for _, stream := range streams {
@@ -140,15 +178,7 @@ func (as *AlarmService) filterUpcomingStreams(streams []*domain.Stream, now time
 		secondsUntil := int(stream.StartScheduled.Sub(now).Seconds())
 		minutesUntil := util.MinutesUntilCeil(stream.StartScheduled, now)
 
-		shouldNotify := false
-		for _, target := range as.targetMinutes {
-			if minutesUntil == target {
-				shouldNotify = true
-				break
-			}
-		}
-
-		if secondsUntil > 0 && shouldNotify {
+		if secondsUntil > 0 && slices.Contains(notifyMinutes, minutesUntil) && anySubscriberWants(stream, subscriberCount, filters) {
 			filtered = append(filtered, stream)
 		}
 	}
@@ -186,7 +216,18 @@ func (as *AlarmService) triggerCacheRefresh(parent context.Context, channelID st
 	}(parent, channelID, streamsCopy)
 }
 
-func (as *AlarmService) createNotification(ctx context.Context, stream *domain.Stream, channelID string, subscriberKeys []string) ([]*domain.AlarmNotification, error) {
+// createNotification: 방송 하나에 대해 지금 알림을 받아야 하는 구독자를 방별로 묶어 알림을 생성합니다.
+// 기본 설정 사용자는 전역 알림 시점 중 처음 도달한 시점에 한 번만 받고(이후 시점은 폴백),
+// 사용자별 알림 시점을 설정한 사용자는 지정한 시점마다 한 번씩 받는다.
+func (as *AlarmService) createNotification(
+	ctx context.Context,
+	stream *domain.Stream,
+	channelID string,
+	subscriberKeys []string,
+	userTargets map[string][]int,
+	filters map[string]domain.AlarmKeywordFilter,
+	now time.Time,
+) ([]*domain.AlarmNotification, error) {
 	if stream.StartScheduled == nil {
 		return []*domain.AlarmNotification{}, nil
 	}
@@ -195,11 +236,17 @@ func (as *AlarmService) createNotification(ctx context.Context, stream *domain.S
 	if minutesUntil < 0 {
 		return []*domain.AlarmNotification{}, nil
 	}
+	targetMinute := util.MinutesUntilCeil(stream.StartScheduled, now)
 
 	scheduleChangeMsg := as.detectScheduleChange(ctx, stream)
 
-	// 이미 알림을 보냈고 일정 변경이 없으면 중복 발송 방지
-	if scheduleChangeMsg == "" && as.isAlreadyNotified(ctx, stream.ID) {
+	// 이미 알림을 보냈고 일정 변경이 없으면 기본 설정 사용자에게는 중복 발송 방지
+	defaultDue := slices.Contains(as.targetMinutes, targetMinute) &&
+		(scheduleChangeMsg != "" || !as.isAlreadyNotified(ctx, stream.ID))
+	customDue := hasCustomTarget(subscriberKeys, userTargets, targetMinute) &&
+		!as.isCustomTargetNotified(ctx, stream.ID, *stream.StartScheduled, targetMinute)
+
+	if !defaultDue && !customDue {
 		as.logger.Debug("Skipping duplicate notification",
 			slog.String("stream_id", stream.ID),
 			slog.String("channel", stream.ChannelName),
@@ -229,19 +276,54 @@ func (as *AlarmService) createNotification(ctx context.Context, stream *domain.S
 
 	notifications := make([]*domain.AlarmNotification, 0, len(usersByRoom))
 	for roomID, users := range usersByRoom {
-		notifications = append(notifications, domain.NewAlarmNotification(
+		dueUsers := make([]string, 0, len(users))
+		customOnly := true
+		for _, user := range users {
+			registryKey := as.getRegistryKey(roomID, user)
+			if filter, ok := filters[registryKey]; ok && !filter.Matches(stream.Title) {
+				continue
+			}
+			targets, custom := userTargets[registryKey]
+			switch {
+			case custom:
+				if customDue && slices.Contains(targets, targetMinute) {
+					dueUsers = append(dueUsers, user)
+				}
+			case defaultDue:
+				dueUsers = append(dueUsers, user)
+				customOnly = false
+			}
+		}
+		if len(dueUsers) == 0 {
+			continue
+		}
+
+		notification := domain.NewAlarmNotification(
 			roomID,
 			channel,
 			stream,
 			minutesUntil,
-			users,
+			dueUsers,
 			scheduleChangeMsg,
-		))
+		)
+		notification.CustomOnly = customOnly
+		notification.TargetMinute = targetMinute
+		notifications = append(notifications, notification)
 	}
 
 	return notifications, nil
 }
 
+// hasCustomTarget: 구독자 중 minute 시점을 직접 지정한 사용자가 있는지 확인합니다.
+func hasCustomTarget(subscriberKeys []string, userTargets map[string][]int, minute int) bool {
+	for _, registryKey := range subscriberKeys {
+		if slices.Contains(userTargets[registryKey], minute) {
+			return true
+		}
+	}
+	return false
+}
+
 // 스트림 일정 변경 감지 및 변경 메시지 반환
 func (as *AlarmService) detectScheduleChange(ctx context.Context, stream *domain.Stream) string {
 	notifiedKey := NotifiedKeyPrefix + stream.ID
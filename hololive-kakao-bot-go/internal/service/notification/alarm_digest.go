@@ -0,0 +1,229 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// DigestMode: 방별 알림 요약 방식
+type DigestMode string
+
+// DigestMode 상수 목록.
+const (
+	// DigestOff: 방송마다(같은 시각 방송은 묶어서) 알림을 보낸다. (기본값)
+	DigestOff DigestMode = ""
+	// DigestCycle: 알람 체크 한 번에 방의 알림을 모두 모아 한 통으로 보낸다.
+	DigestCycle DigestMode = "cycle"
+	// DigestHourly: 방송별 알림 대신 매시 첫 체크에서 다음 정각까지 시작하는 구독 방송을 한 통으로 보낸다.
+	DigestHourly DigestMode = "hourly"
+)
+
+const (
+	// DigestModeKey: 방별 알림 요약 방식 Hash 키 (field: roomID, value: cycle|hourly)
+	DigestModeKey = "alarm:digest_mode"
+	// hourlyDigestSentKeyPrefix: 매시 요약 발송 기록 키 접두사 ({prefix}{roomID}:{YYYYMMDDHH}, 같은 시간대 중복 발송 방지)
+	hourlyDigestSentKeyPrefix = "alarm:digest_sent:"
+	hourlyDigestSentTTL       = 2 * time.Hour
+)
+
+// ErrInvalidDigestMode: 지원하지 않는 요약 방식
+var ErrInvalidDigestMode = errors.New("invalid digest mode")
+
+// ParseDigestMode: 저장값/API 입력을 DigestMode로 변환합니다. ("off"와 빈 문자열은 DigestOff)
+func ParseDigestMode(raw string) (DigestMode, error) {
+	switch DigestMode(raw) {
+	case DigestOff, "off":
+		return DigestOff, nil
+	case DigestCycle, DigestHourly:
+		return DigestMode(raw), nil
+	default:
+		return DigestOff, fmt.Errorf("%w: %q", ErrInvalidDigestMode, raw)
+	}
+}
+
+// SetRoomDigestMode: 방의 알림 요약 방식을 바꿉니다. DigestOff면 설정을 지운다.
+func (as *AlarmService) SetRoomDigestMode(ctx context.Context, roomID string, mode DigestMode) error {
+	if mode == DigestOff {
+		if err := as.cache.HDel(ctx, DigestModeKey, roomID); err != nil {
+			return fmt.Errorf("reset digest mode: %w", err)
+		}
+	} else if err := as.cache.HSet(ctx, DigestModeKey, roomID, string(mode)); err != nil {
+		return fmt.Errorf("set digest mode: %w", err)
+	}
+
+	as.logger.Info("Alarm digest mode changed",
+		slog.String("room_id", roomID),
+		slog.String("mode", string(mode)),
+	)
+	return nil
+}
+
+// GetRoomDigestMode: 방의 알림 요약 방식을 반환합니다.
+func (as *AlarmService) GetRoomDigestMode(ctx context.Context, roomID string) (DigestMode, error) {
+	raw, err := as.cache.HGet(ctx, DigestModeKey, roomID)
+	if err != nil {
+		return DigestOff, fmt.Errorf("get digest mode: %w", err)
+	}
+	mode, _ := ParseDigestMode(raw)
+	return mode, nil
+}
+
+// RoomDigestModes: 요약 방식을 설정한 방 전체를 반환합니다. 조회에 실패하면 모든 방을 기본 방식으로 보낸다.
+func (as *AlarmService) RoomDigestModes(ctx context.Context) map[string]DigestMode {
+	values, err := as.cache.HGetAll(ctx, DigestModeKey)
+	if err != nil {
+		as.logger.Warn("Failed to load alarm digest modes, using per-stream alarms", slog.Any("error", err))
+		return map[string]DigestMode{}
+	}
+
+	modes := make(map[string]DigestMode, len(values))
+	for roomID, raw := range values {
+		if mode, err := ParseDigestMode(raw); err == nil && mode != DigestOff {
+			modes[roomID] = mode
+		}
+	}
+	return modes
+}
+
+// HourlyDigests: 매시 요약 방이 이번 시간대 요약을 아직 받지 않았으면, 지금부터 다음 정각까지 시작하는
+// 구독 방송을 방별 알림 목록으로 반환합니다. 보낼 방송이 없어도 이번 시간대는 발송한 것으로 기록한다.
+func (as *AlarmService) HourlyDigests(ctx context.Context, modes map[string]DigestMode, now time.Time) map[string][]*domain.AlarmNotification {
+	rooms := make([]string, 0)
+	for roomID, mode := range modes {
+		if mode == DigestHourly {
+			rooms = append(rooms, roomID)
+		}
+	}
+	if len(rooms) == 0 {
+		return nil
+	}
+
+	dormant, err := as.DormantRooms(ctx)
+	if err != nil {
+		as.logger.Warn("Failed to load dormant rooms", slog.Any("error", err))
+	}
+	keywordFilters := as.loadKeywordFilters(ctx)
+	until := now.Truncate(time.Hour).Add(time.Hour)
+
+	result := make(map[string][]*domain.AlarmNotification)
+	for _, roomID := range rooms {
+		if _, ok := dormant[roomID]; ok {
+			continue
+		}
+		if !as.claimHourlyDigest(ctx, roomID, now) {
+			continue
+		}
+
+		notifications, err := as.roomUpcomingNotifications(ctx, roomID, keywordFilters, now, until)
+		if err != nil {
+			as.logger.Warn("Failed to build hourly alarm digest", slog.String("room_id", roomID), slog.Any("error", err))
+			continue
+		}
+		if len(notifications) > 0 {
+			result[roomID] = notifications
+		}
+	}
+	return result
+}
+
+// claimHourlyDigest: 이번 시간대 요약 발송 권한을 얻습니다. 이미 기록이 있으면 false (조회 실패 시에도 false)
+func (as *AlarmService) claimHourlyDigest(ctx context.Context, roomID string, now time.Time) bool {
+	client := as.cache.GetClient()
+	key := hourlyDigestSentKeyPrefix + roomID + ":" + now.Format("2006010215")
+	err := client.Do(ctx, client.B().Set().Key(key).Value("1").Nx().Ex(hourlyDigestSentTTL).Build()).Error()
+	if err == nil {
+		return true
+	}
+	if !valkey.IsValkeyNil(err) {
+		as.logger.Warn("Failed to claim hourly alarm digest", slog.String("room_id", roomID), slog.Any("error", err))
+	}
+	return false
+}
+
+// roomUpcomingNotifications: 방 사용자들이 구독한 채널의 (now, until] 시작 예정 방송을 채널별 수신자와 함께 모은다.
+func (as *AlarmService) roomUpcomingNotifications(
+	ctx context.Context,
+	roomID string,
+	keywordFilters map[string]domain.AlarmKeywordFilter,
+	now, until time.Time,
+) ([]*domain.AlarmNotification, error) {
+	userIDs, err := as.roomUserIDs(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByChannel := make(map[string][]string)
+	channelIDs := make([]string, 0)
+	for _, userID := range userIDs {
+		userChannels, err := as.GetUserAlarms(ctx, roomID, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, channelID := range userChannels {
+			if _, ok := usersByChannel[channelID]; !ok {
+				channelIDs = append(channelIDs, channelID)
+			}
+			usersByChannel[channelID] = append(usersByChannel[channelID], userID)
+		}
+	}
+
+	notifications := make([]*domain.AlarmNotification, 0)
+	for _, channelID := range channelIDs {
+		streams, err := as.holodex.GetChannelSchedule(ctx, channelID, channelScheduleHours, true)
+		if err != nil {
+			as.logger.Warn("Failed to get channel schedule", slog.String("channel_id", channelID), slog.Any("error", err))
+			continue
+		}
+		streams = streamsStartingWithin(streams, now, until)
+		if len(streams) == 0 {
+			continue
+		}
+
+		channel, err := as.holodex.GetChannel(ctx, channelID)
+		if err != nil || channel == nil {
+			as.logger.Warn("Failed to get channel", slog.String("channel_id", channelID), slog.Any("error", err))
+			continue
+		}
+
+		for _, stream := range streams {
+			users := make([]string, 0, len(usersByChannel[channelID]))
+			for _, userID := range usersByChannel[channelID] {
+				if filter, ok := keywordFilters[keywordFilterField(roomID, userID, channelID)]; ok && !filter.Matches(stream.Title) {
+					continue
+				}
+				users = append(users, userID)
+			}
+			if len(users) == 0 {
+				continue
+			}
+			notifications = append(notifications,
+				domain.NewAlarmNotification(roomID, channel, stream, stream.MinutesUntilStart(), users, ""))
+		}
+	}
+	return notifications, nil
+}
+
+// streamsStartingWithin: 시작 예정 시각이 (now, until]인 예정 방송만 시작 시각 순으로 남긴다.
+func streamsStartingWithin(streams []*domain.Stream, now, until time.Time) []*domain.Stream {
+	filtered := make([]*domain.Stream, 0, len(streams))
+	for _, stream := range streams {
+		if !stream.IsUpcoming() || stream.StartScheduled == nil {
+			continue
+		}
+		if stream.StartScheduled.After(now) && !stream.StartScheduled.After(until) {
+			filtered = append(filtered, stream)
+		}
+	}
+	slices.SortStableFunc(filtered, func(a, b *domain.Stream) int {
+		return a.StartScheduled.Compare(*b.StartScheduled)
+	})
+	return filtered
+}
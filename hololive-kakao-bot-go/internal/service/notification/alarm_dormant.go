@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DormantRoomsKey: 휴면 처리된 방 Hash 키 (field: roomID, value: 휴면 전환 시각 unix 초)
+// 휴면 방의 구독은 지워지지 않고 알람 체크에서만 제외된다.
+const DormantRoomsKey = "alarm:dormant_rooms"
+
+// MarkRoomDormant: 방의 알람 구독을 휴면 상태로 바꿉니다. 이미 휴면이면 false를 반환합니다.
+func (as *AlarmService) MarkRoomDormant(ctx context.Context, roomID string, at time.Time) (bool, error) {
+	client := as.cache.GetClient()
+	cmd := client.B().Hsetnx().Key(DormantRoomsKey).Field(roomID).Value(strconv.FormatInt(at.Unix(), 10)).Build()
+	created, err := client.Do(ctx, cmd).AsBool()
+	if err != nil {
+		return false, fmt.Errorf("mark room dormant: %w", err)
+	}
+	return created, nil
+}
+
+// ReactivateRoom: 휴면 상태를 해제합니다. 휴면이 아니었으면 false를 반환합니다.
+func (as *AlarmService) ReactivateRoom(ctx context.Context, roomID string) (bool, error) {
+	client := as.cache.GetClient()
+	removed, err := client.Do(ctx, client.B().Hdel().Key(DormantRoomsKey).Field(roomID).Build()).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("reactivate room: %w", err)
+	}
+	return removed > 0, nil
+}
+
+// DormantRooms: 휴면 방과 휴면 전환 시각을 반환합니다.
+func (as *AlarmService) DormantRooms(ctx context.Context) (map[string]time.Time, error) {
+	fields, err := as.cache.HGetAll(ctx, DormantRoomsKey)
+	if err != nil {
+		return nil, fmt.Errorf("list dormant rooms: %w", err)
+	}
+	rooms := make(map[string]time.Time, len(fields))
+	for roomID, raw := range fields {
+		unix, _ := strconv.ParseInt(raw, 10, 64)
+		rooms[roomID] = time.Unix(unix, 0)
+	}
+	return rooms, nil
+}
+
+// activeSubscribers: 휴면 방의 구독자(roomID:userID)를 뺀 목록을 반환합니다.
+func activeSubscribers(subscribers []string, dormant map[string]time.Time) []string {
+	if len(dormant) == 0 {
+		return subscribers
+	}
+	active := make([]string, 0, len(subscribers))
+	for _, registryKey := range subscribers {
+		if parts := splitRegistryKey(registryKey); len(parts) == 2 {
+			if _, ok := dormant[parts[0]]; ok {
+				continue
+			}
+		}
+		active = append(active, registryKey)
+	}
+	return active
+}
@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+const (
+	// maxFilterKeywords: 구독 하나에 지정할 수 있는 키워드 최대 개수 (포함+제외)
+	maxFilterKeywords = 10
+	// maxFilterKeywordRunes: 키워드 하나의 최대 글자 수
+	maxFilterKeywordRunes = 30
+)
+
+// 키워드 필터 오류 목록.
+var (
+	// ErrAlarmNotSubscribed: 알람을 등록하지 않은 멤버에 필터를 설정하려 함
+	ErrAlarmNotSubscribed = errors.New("alarm not subscribed")
+	// ErrInvalidKeywordFilter: 키워드 개수/길이 제한 위반 또는 포함·제외 충돌
+	ErrInvalidKeywordFilter = errors.New("invalid keyword filter")
+)
+
+// SetAlarmKeywordFilter: 알림 구독의 제목 키워드 필터를 저장하고, 정규화된 값을 반환합니다.
+// 필터가 비어 있으면 삭제한다. 해당 멤버 알람이 없으면 ErrAlarmNotSubscribed를 반환한다.
+func (as *AlarmService) SetAlarmKeywordFilter(ctx context.Context, roomID, userID, channelID string, filter domain.AlarmKeywordFilter) (domain.AlarmKeywordFilter, error) {
+	subscribed, err := as.cache.SIsMember(ctx, as.getAlarmKey(roomID, userID), channelID)
+	if err != nil {
+		return domain.AlarmKeywordFilter{}, fmt.Errorf("check alarm subscription: %w", err)
+	}
+	if !subscribed {
+		return domain.AlarmKeywordFilter{}, ErrAlarmNotSubscribed
+	}
+
+	field := keywordFilterField(roomID, userID, channelID)
+	normalized, err := normalizeKeywordFilter(filter)
+	if err != nil {
+		return domain.AlarmKeywordFilter{}, err
+	}
+
+	if normalized.IsEmpty() {
+		if err := as.cache.HDel(ctx, KeywordFiltersKey, field); err != nil {
+			return domain.AlarmKeywordFilter{}, fmt.Errorf("reset keyword filter: %w", err)
+		}
+		as.logger.Info("Alarm keyword filter reset",
+			slog.String("room_id", roomID),
+			slog.String("user_id", userID),
+			slog.String("channel_id", channelID),
+		)
+		return normalized, nil
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return domain.AlarmKeywordFilter{}, fmt.Errorf("marshal keyword filter: %w", err)
+	}
+	if err := as.cache.HSet(ctx, KeywordFiltersKey, field, string(data)); err != nil {
+		return domain.AlarmKeywordFilter{}, fmt.Errorf("set keyword filter: %w", err)
+	}
+
+	as.logger.Info("Alarm keyword filter set",
+		slog.String("room_id", roomID),
+		slog.String("user_id", userID),
+		slog.String("channel_id", channelID),
+		slog.Any("include", normalized.Include),
+		slog.Any("exclude", normalized.Exclude),
+	)
+	return normalized, nil
+}
+
+// GetAlarmKeywordFilter: 알림 구독의 제목 키워드 필터를 반환합니다. 설정이 없으면 빈 필터를 반환한다.
+func (as *AlarmService) GetAlarmKeywordFilter(ctx context.Context, roomID, userID, channelID string) (domain.AlarmKeywordFilter, error) {
+	raw, err := as.cache.HGet(ctx, KeywordFiltersKey, keywordFilterField(roomID, userID, channelID))
+	if err != nil {
+		return domain.AlarmKeywordFilter{}, fmt.Errorf("get keyword filter: %w", err)
+	}
+	filter, _ := parseKeywordFilter(raw)
+	return filter, nil
+}
+
+// loadKeywordFilters: 키워드 필터 전체를 roomID:userID:channelID 기준으로 조회합니다.
+// 조회에 실패하면 필터 없이(모든 방송 알림) 진행한다.
+func (as *AlarmService) loadKeywordFilters(ctx context.Context) map[string]domain.AlarmKeywordFilter {
+	values, err := as.cache.HGetAll(ctx, KeywordFiltersKey)
+	if err != nil {
+		as.logger.Warn("Failed to load alarm keyword filters, notifying without filters", slog.Any("error", err))
+		return map[string]domain.AlarmKeywordFilter{}
+	}
+
+	result := make(map[string]domain.AlarmKeywordFilter, len(values))
+	for field, raw := range values {
+		if filter, ok := parseKeywordFilter(raw); ok && !filter.IsEmpty() {
+			result[field] = filter
+		}
+	}
+	return result
+}
+
+// deleteKeywordFilters: 알람 해제 시 해당 구독들의 키워드 필터를 함께 지운다.
+func (as *AlarmService) deleteKeywordFilters(ctx context.Context, roomID, userID string, channelIDs []string) {
+	for _, channelID := range channelIDs {
+		if err := as.cache.HDel(ctx, KeywordFiltersKey, keywordFilterField(roomID, userID, channelID)); err != nil {
+			as.logger.Warn("Failed to delete alarm keyword filter",
+				slog.String("channel_id", channelID),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// subscriberFilters: 채널 구독자(registryKey 목록)의 키워드 필터를 registryKey 기준으로 모은다.
+func subscriberFilters(channelID string, subscriberKeys []string, filters map[string]domain.AlarmKeywordFilter) map[string]domain.AlarmKeywordFilter {
+	result := make(map[string]domain.AlarmKeywordFilter)
+	for _, registryKey := range subscriberKeys {
+		if filter, ok := filters[registryKey+":"+channelID]; ok {
+			result[registryKey] = filter
+		}
+	}
+	return result
+}
+
+// anySubscriberWants: 필터가 없는 구독자가 있거나, 누군가의 필터를 통과하면 true
+func anySubscriberWants(stream *domain.Stream, subscriberCount int, filters map[string]domain.AlarmKeywordFilter) bool {
+	if len(filters) < subscriberCount {
+		return true
+	}
+	for _, filter := range filters {
+		if filter.Matches(stream.Title) {
+			return true
+		}
+	}
+	return false
+}
+
+func keywordFilterField(roomID, userID, channelID string) string {
+	return roomID + ":" + userID + ":" + channelID
+}
+
+// normalizeKeywordFilter: 키워드 공백 정리, 대소문자 무시 중복 제거, 개수/길이 제한을 적용합니다.
+// 포함과 제외에 같은 키워드가 있으면 오류를 반환한다.
+func normalizeKeywordFilter(filter domain.AlarmKeywordFilter) (domain.AlarmKeywordFilter, error) {
+	seen := make(map[string]bool)
+	clean := func(keywords []string) ([]string, error) {
+		var out []string
+		for _, keyword := range keywords {
+			keyword = strings.TrimSpace(keyword)
+			if keyword == "" {
+				continue
+			}
+			if utf8.RuneCountInString(keyword) > maxFilterKeywordRunes {
+				return nil, fmt.Errorf("%w: keyword too long (max %d): %s", ErrInvalidKeywordFilter, maxFilterKeywordRunes, keyword)
+			}
+			lower := strings.ToLower(keyword)
+			if seen[lower] {
+				if slices.ContainsFunc(out, func(k string) bool { return strings.EqualFold(k, keyword) }) {
+					continue
+				}
+				return nil, fmt.Errorf("%w: keyword both included and excluded: %s", ErrInvalidKeywordFilter, keyword)
+			}
+			seen[lower] = true
+			out = append(out, keyword)
+		}
+		return out, nil
+	}
+
+	include, err := clean(filter.Include)
+	if err != nil {
+		return domain.AlarmKeywordFilter{}, err
+	}
+	exclude, err := clean(filter.Exclude)
+	if err != nil {
+		return domain.AlarmKeywordFilter{}, err
+	}
+	if total := len(include) + len(exclude); total > maxFilterKeywords {
+		return domain.AlarmKeywordFilter{}, fmt.Errorf("%w: too many keywords (max %d): %d", ErrInvalidKeywordFilter, maxFilterKeywords, total)
+	}
+	return domain.AlarmKeywordFilter{Include: include, Exclude: exclude}, nil
+}
+
+func parseKeywordFilter(raw string) (domain.AlarmKeywordFilter, bool) {
+	var filter domain.AlarmKeywordFilter
+	if strings.TrimSpace(raw) == "" {
+		return filter, false
+	}
+	if err := json.Unmarshal([]byte(raw), &filter); err != nil {
+		return domain.AlarmKeywordFilter{}, false
+	}
+	return filter, true
+}
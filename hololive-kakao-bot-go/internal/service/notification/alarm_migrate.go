@@ -0,0 +1,299 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// reservedAlarmKeys: alarm: 접두사를 쓰지만 사용자 알람 키(alarm:{roomID}:{userID})가 아닌 키
+var reservedAlarmKeys = map[string]struct{}{
+	AlarmRegistryKey:        {},
+	AlarmChannelRegistryKey: {},
+	RoomNamesCacheKey:       {},
+	UserNamesCacheKey:       {},
+	AdvanceMinutesKey:       {},
+	DormantRoomsKey:         {},
+}
+
+// reservedAlarmPrefixes: 사용자 알람 키가 아닌 alarm: 하위 접두사
+var reservedAlarmPrefixes = []string{ChannelSubscribersKeyPrefix, NextStreamKeyPrefix}
+
+// KeyspaceReport: 알람 키 정리 결과. DryRun이면 실제로 바꾸지 않고 바꿀 내용만 담는다.
+// 항목 형식: 레지스트리는 "roomID:userID", 채널 구독자는 "channelID <- roomID:userID".
+type KeyspaceReport struct {
+	DryRun             bool     `json:"dryRun"`
+	ScannedKeys        int      `json:"scannedKeys"`
+	UserKeys           int      `json:"userKeys"`
+	LegacyConverted    []string `json:"legacyConverted,omitempty"`    // 문자열/리스트로 저장된 이전 형식 알람 키 → SET
+	EmptyKeysRemoved   []string `json:"emptyKeysRemoved,omitempty"`   // 채널이 하나도 없는 알람 키
+	RegistryAdded      []string `json:"registryAdded,omitempty"`      // 알람 키는 있는데 레지스트리에 없던 사용자
+	RegistryRemoved    []string `json:"registryRemoved,omitempty"`    // 알람 키가 없는 레지스트리 항목
+	SubscribersAdded   []string `json:"subscribersAdded,omitempty"`   // 채널 구독자 목록에 빠져 있던 사용자
+	SubscribersRemoved []string `json:"subscribersRemoved,omitempty"` // 실제로는 구독하지 않는 채널 구독자 항목
+	ChannelsAdded      []string `json:"channelsAdded,omitempty"`      // 구독자가 있는데 채널 레지스트리에 없던 채널
+	ChannelsRemoved    []string `json:"channelsRemoved,omitempty"`    // 구독자가 없는 채널 레지스트리 항목
+	Inconsistencies    []string `json:"inconsistencies,omitempty"`    // 자동으로 고치지 못한 항목 (수동 확인 필요)
+}
+
+// Changes: 적용했거나(DryRun이 아니면) 적용할 변경 건수를 반환합니다.
+func (r *KeyspaceReport) Changes() int {
+	return len(r.LegacyConverted) + len(r.EmptyKeysRemoved) +
+		len(r.RegistryAdded) + len(r.RegistryRemoved) +
+		len(r.SubscribersAdded) + len(r.SubscribersRemoved) +
+		len(r.ChannelsAdded) + len(r.ChannelsRemoved)
+}
+
+// MigrateKeyspace: 사용자 알람 키(alarm:{roomID}:{userID})를 기준으로 레지스트리, 채널 구독자, 채널 레지스트리를 다시 맞춥니다.
+// 이전 형식(문자열 JSON 배열/쉼표 구분, 리스트)으로 저장된 알람 키는 SET으로 바꾼다.
+// 알람 체크와 동시에 실행되면 그 사이 추가/삭제된 알람이 반영되지 않을 수 있으므로 봇을 멈춘 상태에서 실행하는 것을 권장한다.
+func (as *AlarmService) MigrateKeyspace(ctx context.Context, dryRun bool) (*KeyspaceReport, error) {
+	report := &KeyspaceReport{DryRun: dryRun}
+
+	keys, err := as.cache.ScanKeys(ctx, AlarmKeyPrefix+"*", 500)
+	if err != nil {
+		return nil, fmt.Errorf("scan alarm keys: %w", err)
+	}
+	report.ScannedKeys = len(keys)
+	slices.Sort(keys)
+
+	// 1. 사용자 알람 키에서 기준 데이터 구성 (registryKey → 채널, 채널 → registryKey)
+	userChannels := make(map[string][]string)
+	channelSubs := make(map[string]map[string]struct{})
+	subscriberKeys := make([]string, 0)
+	for _, key := range keys {
+		if strings.HasPrefix(key, ChannelSubscribersKeyPrefix) {
+			subscriberKeys = append(subscriberKeys, key)
+			continue
+		}
+		if isReservedAlarmKey(key) {
+			continue
+		}
+		registryKey, ok := userRegistryKey(key)
+		if !ok {
+			report.Inconsistencies = append(report.Inconsistencies, key+": unrecognized alarm key")
+			continue
+		}
+		report.UserKeys++
+
+		channels, err := as.loadUserAlarmKey(ctx, key, report)
+		if err != nil {
+			return nil, err
+		}
+		if channels == nil {
+			continue
+		}
+		userChannels[registryKey] = channels
+		for _, channelID := range channels {
+			if channelSubs[channelID] == nil {
+				channelSubs[channelID] = make(map[string]struct{})
+			}
+			channelSubs[channelID][registryKey] = struct{}{}
+		}
+	}
+
+	// 2. 사용자 레지스트리
+	if err := as.syncSet(ctx, AlarmRegistryKey, slices.Collect(maps.Keys(userChannels)), dryRun, func(member string, added bool) {
+		if added {
+			report.RegistryAdded = append(report.RegistryAdded, member)
+		} else {
+			report.RegistryRemoved = append(report.RegistryRemoved, member)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	// 3. 채널별 구독자 (기존 키 + 구독자가 있는데 키가 없는 채널)
+	for channelID := range channelSubs {
+		key := as.channelSubscribersKey(channelID)
+		if !slices.Contains(subscriberKeys, key) {
+			subscriberKeys = append(subscriberKeys, key)
+		}
+	}
+	slices.Sort(subscriberKeys)
+	for _, key := range subscriberKeys {
+		channelID := strings.TrimPrefix(key, ChannelSubscribersKeyPrefix)
+		desired := slices.Collect(maps.Keys(channelSubs[channelID]))
+		if err := as.syncSet(ctx, key, desired, dryRun, func(member string, added bool) {
+			entry := channelID + " <- " + member
+			if added {
+				report.SubscribersAdded = append(report.SubscribersAdded, entry)
+			} else {
+				report.SubscribersRemoved = append(report.SubscribersRemoved, entry)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. 채널 레지스트리 (구독자 없는 채널 제거)
+	if err := as.syncSet(ctx, AlarmChannelRegistryKey, slices.Collect(maps.Keys(channelSubs)), dryRun, func(member string, added bool) {
+		if added {
+			report.ChannelsAdded = append(report.ChannelsAdded, member)
+		} else {
+			report.ChannelsRemoved = append(report.ChannelsRemoved, member)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func isReservedAlarmKey(key string) bool {
+	if _, reserved := reservedAlarmKeys[key]; reserved {
+		return true
+	}
+	for _, prefix := range reservedAlarmPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// userRegistryKey: 사용자 알람 키에서 registryKey(roomID:userID)를 뽑습니다. 형식이 맞지 않으면 false.
+func userRegistryKey(key string) (string, bool) {
+	registryKey := strings.TrimPrefix(key, AlarmKeyPrefix)
+	parts := splitRegistryKey(registryKey)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return registryKey, true
+}
+
+// loadUserAlarmKey: 알람 키의 채널 목록을 읽습니다. 이전 형식이면 SET으로 바꾸고, 비어 있으면 삭제합니다.
+// 알 수 없는 형식이면 불일치로 기록하고 nil을 반환합니다.
+func (as *AlarmService) loadUserAlarmKey(ctx context.Context, key string, report *KeyspaceReport) ([]string, error) {
+	client := as.cache.GetClient()
+	keyType, err := client.Do(ctx, client.B().Type().Key(key).Build()).ToString()
+	if err != nil {
+		return nil, fmt.Errorf("type %s: %w", key, err)
+	}
+
+	var channels []string
+	legacy := false
+	switch keyType {
+	case "set":
+		if channels, err = as.cache.SMembers(ctx, key); err != nil {
+			return nil, fmt.Errorf("read %s: %w", key, err)
+		}
+	case "string":
+		raw, err := client.Do(ctx, client.B().Get().Key(key).Build()).ToString()
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", key, err)
+		}
+		channels = parseLegacyChannels(raw)
+		legacy = true
+	case "list":
+		if channels, err = client.Do(ctx, client.B().Lrange().Key(key).Start(0).Stop(-1).Build()).AsStrSlice(); err != nil {
+			return nil, fmt.Errorf("read %s: %w", key, err)
+		}
+		legacy = true
+	default:
+		report.Inconsistencies = append(report.Inconsistencies, fmt.Sprintf("%s: unexpected type %q", key, keyType))
+		return nil, nil
+	}
+	channels = normalizeChannels(channels)
+
+	if len(channels) == 0 {
+		report.EmptyKeysRemoved = append(report.EmptyKeysRemoved, key)
+		if !report.DryRun {
+			if err := as.cache.Del(ctx, key); err != nil {
+				return nil, fmt.Errorf("delete empty alarm key %s: %w", key, err)
+			}
+		}
+		return nil, nil
+	}
+
+	if legacy {
+		report.LegacyConverted = append(report.LegacyConverted, key)
+		if !report.DryRun {
+			if err := as.cache.Del(ctx, key); err != nil {
+				return nil, fmt.Errorf("delete legacy alarm key %s: %w", key, err)
+			}
+			if _, err := as.cache.SAdd(ctx, key, channels); err != nil {
+				return nil, fmt.Errorf("convert legacy alarm key %s: %w", key, err)
+			}
+		}
+	}
+
+	return channels, nil
+}
+
+// syncSet: SET을 desired와 같게 맞추고, 추가(added=true)/제거된 멤버마다 onChange를 호출합니다.
+// desired가 비면 키를 삭제합니다.
+func (as *AlarmService) syncSet(ctx context.Context, key string, desired []string, dryRun bool, onChange func(member string, added bool)) error {
+	actual, err := as.cache.SMembers(ctx, key)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", key, err)
+	}
+
+	slices.Sort(desired)
+	var toAdd, toRemove []string
+	for _, member := range desired {
+		if !slices.Contains(actual, member) {
+			toAdd = append(toAdd, member)
+		}
+	}
+	slices.Sort(actual)
+	for _, member := range actual {
+		if !slices.Contains(desired, member) {
+			toRemove = append(toRemove, member)
+		}
+	}
+	for _, member := range toAdd {
+		onChange(member, true)
+	}
+	for _, member := range toRemove {
+		onChange(member, false)
+	}
+	if dryRun {
+		return nil
+	}
+
+	if len(desired) == 0 {
+		if len(actual) > 0 {
+			if err := as.cache.Del(ctx, key); err != nil {
+				return fmt.Errorf("delete %s: %w", key, err)
+			}
+		}
+		return nil
+	}
+	if _, err := as.cache.SAdd(ctx, key, toAdd); err != nil {
+		return fmt.Errorf("add to %s: %w", key, err)
+	}
+	if len(toRemove) > 0 {
+		if _, err := as.cache.SRem(ctx, key, toRemove); err != nil {
+			return fmt.Errorf("remove from %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// parseLegacyChannels: 문자열로 저장된 채널 목록(JSON 배열 또는 쉼표 구분)을 파싱합니다.
+func parseLegacyChannels(raw string) []string {
+	raw = util.TrimSpace(raw)
+	var channels []string
+	if strings.HasPrefix(raw, "[") && json.Unmarshal([]byte(raw), &channels) == nil {
+		return channels
+	}
+	return strings.Split(raw, ",")
+}
+
+func normalizeChannels(channels []string) []string {
+	normalized := make([]string, 0, len(channels))
+	for _, channelID := range channels {
+		channelID = util.TrimSpace(channelID)
+		if channelID != "" && !slices.Contains(normalized, channelID) {
+			normalized = append(normalized, channelID)
+		}
+	}
+	return normalized
+}
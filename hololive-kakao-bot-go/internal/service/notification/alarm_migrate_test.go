@@ -0,0 +1,113 @@
+package notification
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+)
+
+func newMigrateTestService(t *testing.T) (*AlarmService, *miniredis.Miniredis) {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+
+	return NewAlarmService(cacheSvc, nil, nil, logger, nil), mini
+}
+
+func TestMigrateKeyspace_RebuildsRegistriesFromUserKeys(t *testing.T) {
+	as, mini := newMigrateTestService(t)
+	ctx := context.Background()
+
+	// 정상 알람 (레지스트리 누락 상태)
+	_, _ = mini.SAdd("alarm:r1:u1", "ch-a", "ch-b")
+	// 이전 형식: JSON 배열 문자열, 쉼표 구분 리스트
+	_ = mini.Set("alarm:r2:u2", `["ch-b"," ch-c ","ch-b"]`)
+	_, _ = mini.Push("alarm:r3:u3", "ch-a")
+	// 빈 알람 키, 알 수 없는 형식
+	_ = mini.Set("alarm:r4:u4", "")
+	mini.HSet("alarm:r5:u5", "x", "y")
+	// 고아 레지스트리/구독자/채널
+	_, _ = mini.SAdd(AlarmRegistryKey, "r1:u1", "gone:user")
+	_, _ = mini.SAdd(ChannelSubscribersKeyPrefix+"ch-a", "gone:user")
+	_, _ = mini.SAdd(ChannelSubscribersKeyPrefix+"ch-z", "gone:user")
+	_, _ = mini.SAdd(AlarmChannelRegistryKey, "ch-a", "ch-z")
+	// 예약 키는 건드리지 않음
+	mini.HSet(RoomNamesCacheKey, "r1", "방")
+
+	dry, err := as.MigrateKeyspace(ctx, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if dry.Changes() == 0 {
+		t.Fatal("expected changes in dry run")
+	}
+	if members, _ := mini.SMembers(AlarmRegistryKey); len(members) != 2 {
+		t.Fatalf("dry run must not write, registry=%v", members)
+	}
+
+	report, err := as.MigrateKeyspace(ctx, false)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	if !slices.Equal(report.LegacyConverted, []string{"alarm:r2:u2", "alarm:r3:u3"}) {
+		t.Fatalf("unexpected legacy conversions: %v", report.LegacyConverted)
+	}
+	if !slices.Equal(report.EmptyKeysRemoved, []string{"alarm:r4:u4"}) || mini.Exists("alarm:r4:u4") {
+		t.Fatalf("expected empty key removed: %v", report.EmptyKeysRemoved)
+	}
+	if len(report.Inconsistencies) != 1 || !mini.Exists("alarm:r5:u5") {
+		t.Fatalf("expected hash alarm key reported and kept: %v", report.Inconsistencies)
+	}
+
+	assertSet := func(key string, want ...string) {
+		t.Helper()
+		got, _ := mini.SMembers(key)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Fatalf("%s = %v, want %v", key, got, want)
+		}
+	}
+	assertSet("alarm:r2:u2", "ch-b", "ch-c")
+	assertSet(AlarmRegistryKey, "r1:u1", "r2:u2", "r3:u3")
+	assertSet(ChannelSubscribersKeyPrefix+"ch-a", "r1:u1", "r3:u3")
+	assertSet(ChannelSubscribersKeyPrefix+"ch-b", "r1:u1", "r2:u2")
+	assertSet(ChannelSubscribersKeyPrefix+"ch-c", "r2:u2")
+	assertSet(AlarmChannelRegistryKey, "ch-a", "ch-b", "ch-c")
+	if mini.Exists(ChannelSubscribersKeyPrefix + "ch-z") {
+		t.Fatal("expected orphaned channel subscribers removed")
+	}
+	if !slices.Equal(report.ChannelsRemoved, []string{"ch-z"}) {
+		t.Fatalf("unexpected removed channels: %v", report.ChannelsRemoved)
+	}
+	if name := mini.HGet(RoomNamesCacheKey, "r1"); name != "방" {
+		t.Fatalf("reserved key modified: %q", name)
+	}
+
+	again, err := as.MigrateKeyspace(ctx, false)
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if again.Changes() != 0 {
+		t.Fatalf("expected idempotent migration, got %+v", again)
+	}
+}
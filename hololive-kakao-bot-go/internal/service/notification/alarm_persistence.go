@@ -78,64 +78,142 @@ func (as *AlarmService) clearUserAlarmsAsync(roomID, userID string) {
 	}()
 }
 
-// WarmCacheFromDB: 앱 시작 시 DB에서 모든 알람을 로드하여 Valkey 캐시를 워밍합니다.
-// 이 메서드는 앱 시작 시 한 번만 호출되며, 이후 런타임 중에는 Valkey만 사용한다.
-func (as *AlarmService) WarmCacheFromDB(ctx context.Context) error {
+// AlarmReconcileReport: 시작 시 DB와 Valkey 알람 대조 결과
+type AlarmReconcileReport struct {
+	DBAlarms     int `json:"dbAlarms"`
+	CachedAlarms int `json:"cachedAlarms"`
+	Restored     int `json:"restored"`   // DB에만 있어 Valkey에 다시 채운 알람
+	Backfilled   int `json:"backfilled"` // Valkey에만 있어 DB에 다시 저장한 알람 (비동기 저장 실패분)
+}
+
+// ReconcileWithDB: 앱 시작 시 DB의 알람으로 Valkey 세트를 다시 채우고, Valkey에만 남은 알람은 DB에 되살립니다.
+// DB가 원본이지만 write-through가 비동기라 저장에 실패한 알람이 Valkey에만 있을 수 있으므로 지우지 않고 DB로 옮긴다.
+// 이후 런타임 중에는 Valkey만 읽는다.
+func (as *AlarmService) ReconcileWithDB(ctx context.Context) (*AlarmReconcileReport, error) {
+	report := &AlarmReconcileReport{}
 	if as.alarmRepo == nil {
-		as.logger.Info("Alarm repository not configured, skipping cache warming")
-		return nil
+		as.logger.Info("Alarm repository not configured, skipping alarm reconciliation")
+		return report, nil
 	}
 
 	alarms, err := as.alarmRepo.LoadAll(ctx)
 	if err != nil {
-		return fmt.Errorf("load alarms from DB: %w", err)
+		return nil, fmt.Errorf("load alarms from DB: %w", err)
 	}
-
-	if len(alarms) == 0 {
-		as.logger.Info("No alarms found in DB, cache warming skipped")
-		return nil
+	cached, err := as.cachedAlarms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.DBAlarms = len(alarms)
+	for _, channels := range cached {
+		report.CachedAlarms += len(channels)
 	}
 
-	// 1. 알람 데이터를 Valkey에 캐싱
+	inDB := make(map[string]struct{}, len(alarms))
 	for _, alarm := range alarms {
-		alarmKey := as.getAlarmKey(alarm.RoomID, alarm.UserID)
 		registryKey := alarm.RegistryKey()
-		channelSubsKey := as.channelSubscribersKey(alarm.ChannelID)
+		inDB[registryKey+"|"+alarm.ChannelID] = struct{}{}
 
-		// 사용자별 알람 채널 목록
-		if _, err := as.cache.SAdd(ctx, alarmKey, []string{alarm.ChannelID}); err != nil {
-			as.logger.Warn("Failed to warm alarm cache",
-				slog.String("alarm_key", alarmKey),
-				slog.Any("error", err),
-			)
+		if _, ok := cached[registryKey][alarm.ChannelID]; !ok {
+			report.Restored++
 		}
+		as.cacheAlarm(ctx, alarm)
+	}
 
-		// 전체 사용자 레지스트리
-		_, _ = as.cache.SAdd(ctx, AlarmRegistryKey, []string{registryKey})
+	roomNames, _ := as.cache.HGetAll(ctx, RoomNamesCacheKey)
+	userNames, _ := as.cache.HGetAll(ctx, UserNamesCacheKey)
+	for registryKey, channels := range cached {
+		parts := splitRegistryKey(registryKey)
+		if len(parts) != 2 {
+			continue
+		}
+		for channelID := range channels {
+			if _, ok := inDB[registryKey+"|"+channelID]; ok {
+				continue
+			}
+			memberName, _ := as.GetMemberName(ctx, channelID)
+			alarm := &domain.Alarm{
+				RoomID:     parts[0],
+				UserID:     parts[1],
+				ChannelID:  channelID,
+				MemberName: memberName,
+				RoomName:   roomNames[parts[0]],
+				UserName:   userNames[parts[1]],
+			}
+			if err := as.alarmRepo.Add(ctx, alarm); err != nil {
+				return report, fmt.Errorf("backfill alarm %s/%s: %w", registryKey, channelID, err)
+			}
+			report.Backfilled++
+		}
+	}
 
-		// 채널별 구독자 목록
-		_, _ = as.cache.SAdd(ctx, channelSubsKey, []string{registryKey})
+	as.logger.Info("Alarms reconciled with DB",
+		slog.Int("db_alarms", report.DBAlarms),
+		slog.Int("cached_alarms", report.CachedAlarms),
+		slog.Int("restored", report.Restored),
+		slog.Int("backfilled", report.Backfilled),
+	)
+	return report, nil
+}
 
-		// 채널 레지스트리
-		_, _ = as.cache.SAdd(ctx, AlarmChannelRegistryKey, []string{alarm.ChannelID})
+// cachedAlarms: 레지스트리 기준으로 Valkey에 있는 알람을 registryKey → 채널 집합으로 모읍니다.
+func (as *AlarmService) cachedAlarms(ctx context.Context) (map[string]map[string]struct{}, error) {
+	registryKeys, err := as.cache.SMembers(ctx, AlarmRegistryKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alarm registry: %w", err)
+	}
 
-		// 멤버 이름 캐싱
-		if alarm.MemberName != "" {
-			_ = as.CacheMemberName(ctx, alarm.ChannelID, alarm.MemberName)
+	cached := make(map[string]map[string]struct{}, len(registryKeys))
+	for _, registryKey := range registryKeys {
+		parts := splitRegistryKey(registryKey)
+		if len(parts) != 2 {
+			continue
 		}
-
-		// 방/유저 이름 캐싱
-		if alarm.RoomName != "" {
-			_ = as.cache.HSet(ctx, RoomNamesCacheKey, alarm.RoomID, alarm.RoomName)
+		channelIDs, err := as.cache.SMembers(ctx, as.getAlarmKey(parts[0], parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user alarms: %w", err)
 		}
-		if alarm.UserName != "" {
-			_ = as.cache.HSet(ctx, UserNamesCacheKey, alarm.UserID, alarm.UserName)
+		channels := make(map[string]struct{}, len(channelIDs))
+		for _, channelID := range channelIDs {
+			channels[channelID] = struct{}{}
 		}
+		cached[registryKey] = channels
 	}
+	return cached, nil
+}
 
-	as.logger.Info("Cache warmed from DB",
-		slog.Int("alarms_loaded", len(alarms)),
-	)
+// cacheAlarm: DB 알람 하나를 Valkey의 사용자 알람/레지스트리/채널 구독자 세트와 이름 캐시에 반영합니다.
+func (as *AlarmService) cacheAlarm(ctx context.Context, alarm *domain.Alarm) {
+	alarmKey := as.getAlarmKey(alarm.RoomID, alarm.UserID)
+	registryKey := alarm.RegistryKey()
+
+	// 사용자별 알람 채널 목록
+	if _, err := as.cache.SAdd(ctx, alarmKey, []string{alarm.ChannelID}); err != nil {
+		as.logger.Warn("Failed to warm alarm cache",
+			slog.String("alarm_key", alarmKey),
+			slog.Any("error", err),
+		)
+	}
+
+	// 전체 사용자 레지스트리
+	_, _ = as.cache.SAdd(ctx, AlarmRegistryKey, []string{registryKey})
 
-	return nil
+	// 채널별 구독자 목록
+	_, _ = as.cache.SAdd(ctx, as.channelSubscribersKey(alarm.ChannelID), []string{registryKey})
+
+	// 채널 레지스트리
+	_, _ = as.cache.SAdd(ctx, AlarmChannelRegistryKey, []string{alarm.ChannelID})
+
+	// 멤버 이름 캐싱
+	if alarm.MemberName != "" {
+		_ = as.CacheMemberName(ctx, alarm.ChannelID, alarm.MemberName)
+	}
+
+	// 방/유저 이름 캐싱
+	if alarm.RoomName != "" {
+		_ = as.cache.HSet(ctx, RoomNamesCacheKey, alarm.RoomID, alarm.RoomName)
+	}
+	if alarm.UserName != "" {
+		_ = as.cache.HSet(ctx, UserNamesCacheKey, alarm.UserID, alarm.UserName)
+	}
 }
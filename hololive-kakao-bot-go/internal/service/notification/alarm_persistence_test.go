@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+)
+
+type memoryAlarmStore struct {
+	mu     sync.Mutex
+	alarms []*domain.Alarm
+}
+
+func (m *memoryAlarmStore) Add(_ context.Context, alarm *domain.Alarm) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.alarms = append(m.alarms, alarm)
+	return nil
+}
+
+func (m *memoryAlarmStore) Remove(context.Context, string, string, string) error { return nil }
+
+func (m *memoryAlarmStore) ClearByUser(context.Context, string, string) (int64, error) {
+	return 0, nil
+}
+
+func (m *memoryAlarmStore) GetMemberName(context.Context, string) (string, error) { return "", nil }
+
+func (m *memoryAlarmStore) LoadAll(context.Context) ([]*domain.Alarm, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.alarms), nil
+}
+
+func TestReconcileWithDB_RestoresAndBackfills(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+
+	store := &memoryAlarmStore{alarms: []*domain.Alarm{
+		{RoomID: "r1", UserID: "u1", ChannelID: "ch-a", MemberName: "페코라", RoomName: "홀로방"},
+		{RoomID: "r1", UserID: "u1", ChannelID: "ch-b"},
+	}}
+	as := NewAlarmService(cacheSvc, nil, store, logger, nil)
+	ctx := context.Background()
+
+	// ch-b는 flush로 사라진 상태, r2:u2/ch-c는 DB 저장에 실패해 Valkey에만 남은 상태
+	_, _ = mini.SAdd("alarm:r1:u1", "ch-a")
+	_, _ = mini.SAdd("alarm:r2:u2", "ch-c")
+	_, _ = mini.SAdd(AlarmRegistryKey, "r1:u1", "r2:u2")
+	mini.HSet(UserNamesCacheKey, "u2", "유저2")
+
+	report, err := as.ReconcileWithDB(ctx)
+	if err != nil {
+		t.Fatalf("ReconcileWithDB: %v", err)
+	}
+	if report.DBAlarms != 2 || report.CachedAlarms != 2 || report.Restored != 1 || report.Backfilled != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if got, _ := mini.SMembers("alarm:r1:u1"); !slices.Equal(got, []string{"ch-a", "ch-b"}) {
+		t.Fatalf("user alarms not restored: %v", got)
+	}
+	if got, _ := mini.SMembers(ChannelSubscribersKeyPrefix + "ch-b"); !slices.Equal(got, []string{"r1:u1"}) {
+		t.Fatalf("channel subscribers not restored: %v", got)
+	}
+	if ok, _ := mini.SIsMember(AlarmChannelRegistryKey, "ch-b"); !ok {
+		t.Fatal("channel registry not restored")
+	}
+	if name := mini.HGet(MemberNameKey, "ch-a"); name != "페코라" {
+		t.Fatalf("member name not cached: %q", name)
+	}
+
+	backfilled := store.alarms[len(store.alarms)-1]
+	if backfilled.RegistryKey() != "r2:u2" || backfilled.ChannelID != "ch-c" || backfilled.UserName != "유저2" {
+		t.Fatalf("unexpected backfilled alarm: %+v", backfilled)
+	}
+
+	// 두 번째 실행은 바꿀 것이 없어야 함
+	report, err = as.ReconcileWithDB(ctx)
+	if err != nil {
+		t.Fatalf("ReconcileWithDB: %v", err)
+	}
+	if report.Restored != 0 || report.Backfilled != 0 || report.DBAlarms != 3 || report.CachedAlarms != 3 {
+		t.Fatalf("second run should be a no-op: %+v", report)
+	}
+}
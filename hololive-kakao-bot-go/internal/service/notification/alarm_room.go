@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// RoomAlarmCleanup: 방 단위 알람 정리 결과 (정리 전 스냅샷 포함)
+type RoomAlarmCleanup struct {
+	Users           int            `json:"users"`
+	Alarms          int            `json:"alarms"`
+	ChannelCounts   map[string]int `json:"channelCounts"`
+	ReleasedNames   int            `json:"releasedNames"`
+	RoomNameRemoved bool           `json:"roomNameRemoved"`
+}
+
+// ClearRoomAlarms: 방에 등록된 모든 사용자의 알람과 알림 시점 설정을 삭제하고,
+// 더 이상 어느 방에도 알람이 없는 사용자의 이름 매핑과 방 이름 매핑을 함께 정리합니다.
+func (as *AlarmService) ClearRoomAlarms(ctx context.Context, roomID string) (*RoomAlarmCleanup, error) {
+	userIDs, err := as.roomUserIDs(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RoomAlarmCleanup{ChannelCounts: make(map[string]int)}
+	for _, userID := range userIDs {
+		channelIDs, err := as.GetUserAlarms(ctx, roomID, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, channelID := range channelIDs {
+			result.ChannelCounts[channelID]++
+		}
+
+		removed, err := as.ClearUserAlarms(ctx, roomID, userID)
+		if err != nil {
+			return nil, err
+		}
+		result.Alarms += removed
+		result.Users++
+
+		if err := as.cache.HDel(ctx, AdvanceMinutesKey, as.getRegistryKey(roomID, userID)); err != nil {
+			as.logger.Warn("Failed to reset advance minutes on room cleanup",
+				slog.String("room_id", roomID),
+				slog.String("user_id", userID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	released, err := as.releaseUserNames(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result.ReleasedNames = released
+
+	if err := as.cache.HDel(ctx, DigestModeKey, roomID); err != nil {
+		as.logger.Warn("Failed to reset digest mode on room cleanup", slog.String("room_id", roomID), slog.Any("error", err))
+	}
+
+	if _, err := as.ReactivateRoom(ctx, roomID); err != nil {
+		as.logger.Warn("Failed to clear dormant flag on room cleanup", slog.String("room_id", roomID), slog.Any("error", err))
+	}
+
+	roomName, _ := as.cache.HGet(ctx, RoomNamesCacheKey, roomID)
+	if roomName != "" {
+		if err := as.cache.HDel(ctx, RoomNamesCacheKey, roomID); err != nil {
+			return nil, fmt.Errorf("delete room name: %w", err)
+		}
+		result.RoomNameRemoved = true
+	}
+
+	as.logger.Info("Room alarms cleared",
+		slog.String("room_id", roomID),
+		slog.Int("users", result.Users),
+		slog.Int("alarms", result.Alarms),
+		slog.Int("released_names", result.ReleasedNames),
+	)
+
+	return result, nil
+}
+
+// GetRoomChannels: 방의 사용자들이 알람을 등록한 채널 ID 목록을 중복 없이 반환합니다.
+func (as *AlarmService) GetRoomChannels(ctx context.Context, roomID string) ([]string, error) {
+	userIDs, err := as.roomUserIDs(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	channelIDs := make([]string, 0)
+	for _, userID := range userIDs {
+		userChannels, err := as.GetUserAlarms(ctx, roomID, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, channelID := range userChannels {
+			if _, ok := seen[channelID]; ok {
+				continue
+			}
+			seen[channelID] = struct{}{}
+			channelIDs = append(channelIDs, channelID)
+		}
+	}
+	return channelIDs, nil
+}
+
+// roomUserIDs: 알람 레지스트리에서 해당 방에 알람을 등록한 사용자 ID 목록을 추출합니다.
+func (as *AlarmService) roomUserIDs(ctx context.Context, roomID string) ([]string, error) {
+	registryKeys, err := as.cache.SMembers(ctx, AlarmRegistryKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alarm registry: %w", err)
+	}
+
+	userIDs := make([]string, 0)
+	for _, registryKey := range registryKeys {
+		parts := splitRegistryKey(registryKey)
+		if len(parts) != 2 || parts[0] != roomID {
+			continue
+		}
+		userIDs = append(userIDs, parts[1])
+	}
+	return userIDs, nil
+}
+
+// releaseUserNames: 다른 방에 남은 알람이 없는 사용자의 이름 매핑을 삭제하고 삭제한 수를 반환합니다.
+func (as *AlarmService) releaseUserNames(ctx context.Context, userIDs []string) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	remaining, err := as.cache.SMembers(ctx, AlarmRegistryKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get alarm registry: %w", err)
+	}
+	stillUsed := make(map[string]struct{}, len(remaining))
+	for _, registryKey := range remaining {
+		if parts := splitRegistryKey(registryKey); len(parts) == 2 {
+			stillUsed[parts[1]] = struct{}{}
+		}
+	}
+
+	released := 0
+	for _, userID := range userIDs {
+		if _, ok := stillUsed[userID]; ok {
+			continue
+		}
+		if err := as.cache.HDel(ctx, UserNamesCacheKey, userID); err != nil {
+			return released, fmt.Errorf("delete user name: %w", err)
+		}
+		released++
+	}
+	return released, nil
+}
@@ -7,7 +7,6 @@ import (
 	"slices"
 
 	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
-	"github.com/kapu/hololive-kakao-bot-go/internal/service/alarm"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
 )
@@ -15,8 +14,8 @@ import (
 // NewAlarmService: 새로운 AlarmService 인스턴스를 생성하고 설정(목표 알림 시간 등)을 초기화합니다.
 func NewAlarmService(
 	cacheSvc *cache.Service,
-	holodexSvc *holodex.Service,
-	alarmRepo *alarm.Repository,
+	holodexSvc holodex.StreamProvider,
+	alarmRepo AlarmStore,
 	logger *slog.Logger,
 	advanceMinutes []int,
 ) *AlarmService {
@@ -153,6 +152,8 @@ func (as *AlarmService) RemoveAlarm(ctx context.Context, roomID, userID, channel
 		)
 	}
 
+	as.deleteKeywordFilters(ctx, roomID, userID, []string{channelID})
+
 	// 비동기 DB 삭제 (Write-Through, non-blocking)
 	as.removeAlarmAsync(roomID, userID, channelID)
 
@@ -208,6 +209,7 @@ func (as *AlarmService) ClearUserAlarms(ctx context.Context, roomID, userID stri
 	}
 
 	_, _ = as.cache.SRem(ctx, AlarmRegistryKey, []string{registryKey})
+	as.deleteKeywordFilters(ctx, roomID, userID, alarms)
 
 	// 비동기 DB 삭제 (Write-Through, non-blocking)
 	as.clearUserAlarmsAsync(roomID, userID)
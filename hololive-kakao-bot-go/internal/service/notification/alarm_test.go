@@ -1,6 +1,13 @@
 package notification
 
-import "testing"
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
 
 func TestBuildTargetMinutes(t *testing.T) {
 	t.Parallel()
@@ -44,3 +51,176 @@ func TestBuildTargetMinutes(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeUserAdvanceMinutes(t *testing.T) {
+	t.Parallel()
+
+	got, err := normalizeUserAdvanceMinutes([]int{10, 30, 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got, []int{30, 10}) {
+		t.Fatalf("unexpected minutes: %v", got)
+	}
+
+	if _, err := normalizeUserAdvanceMinutes([]int{0}); err == nil {
+		t.Fatal("expected error for zero minute")
+	}
+	if _, err := normalizeUserAdvanceMinutes([]int{maxUserAdvanceMinute + 1}); err == nil {
+		t.Fatal("expected error for out of range minute")
+	}
+	if _, err := normalizeUserAdvanceMinutes([]int{60, 50, 40, 30, 20, 10}); err == nil {
+		t.Fatal("expected error for too many minutes")
+	}
+}
+
+func TestParseAdvanceMinutes(t *testing.T) {
+	t.Parallel()
+
+	if got := parseAdvanceMinutes(formatAdvanceMinutes([]int{30, 10})); !slices.Equal(got, []int{30, 10}) {
+		t.Fatalf("round trip failed: %v", got)
+	}
+	if got := parseAdvanceMinutes(" 15, x, -1 ,5"); !slices.Equal(got, []int{15, 5}) {
+		t.Fatalf("unexpected parse result: %v", got)
+	}
+	if got := parseAdvanceMinutes(""); got != nil {
+		t.Fatalf("expected nil, got %v", got)
+	}
+}
+
+func TestFilterUpcomingStreams_HonorsUserTargets(t *testing.T) {
+	t.Parallel()
+
+	as := &AlarmService{targetMinutes: []int{5, 3, 1}}
+	userTargets := map[string][]int{"room:alice": {30, 10}}
+	notifyMinutes := as.collectNotifyMinutes(userTargets)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *domain.Stream {
+		start := now.Add(time.Duration(minutes)*time.Minute - time.Second)
+		return &domain.Stream{ID: "s", Status: domain.StreamStatusUpcoming, StartScheduled: &start}
+	}
+
+	streams := []*domain.Stream{at(30), at(20), at(10), at(5)}
+	got := as.filterUpcomingStreams(streams, now, notifyMinutes, 1, nil)
+	if len(got) != 3 {
+		t.Fatalf("expected streams at 30/10/5 minutes, got %d", len(got))
+	}
+
+	if !hasCustomTarget([]string{"room:bob", "room:alice"}, userTargets, 30) {
+		t.Fatal("expected alice's 30 minute target")
+	}
+	if hasCustomTarget([]string{"room:bob"}, userTargets, 30) {
+		t.Fatal("bob has no custom target")
+	}
+}
+
+func TestFilterUpcomingStreams_AppliesKeywordFilters(t *testing.T) {
+	t.Parallel()
+
+	as := &AlarmService{targetMinutes: []int{5}}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	start := now.Add(5*time.Minute - time.Second)
+	stream := func(title string) *domain.Stream {
+		return &domain.Stream{ID: title, Title: title, Status: domain.StreamStatusUpcoming, StartScheduled: &start}
+	}
+	streams := []*domain.Stream{stream("【歌枠】Karaoke"), stream("【ASMR】歌枠 whisper"), stream("Minecraft")}
+
+	filters := subscriberFilters("ch1", []string{"r1:alice", "r2:bob"}, map[string]domain.AlarmKeywordFilter{
+		"r1:alice:ch1": {Include: []string{"歌枠"}, Exclude: []string{"asmr"}},
+		"r1:alice:ch2": {Include: []string{"minecraft"}},
+	})
+	if len(filters) != 1 {
+		t.Fatalf("expected only alice's ch1 filter, got %v", filters)
+	}
+
+	// bob은 필터가 없으므로 모든 방송 통과
+	if got := as.filterUpcomingStreams(streams, now, as.targetMinutes, 2, filters); len(got) != 3 {
+		t.Fatalf("unfiltered subscriber should keep all streams, got %d", len(got))
+	}
+	got := as.filterUpcomingStreams(streams, now, as.targetMinutes, 1, filters)
+	if len(got) != 1 || got[0].ID != "【歌枠】Karaoke" {
+		t.Fatalf("expected only karaoke stream, got %v", got)
+	}
+}
+
+func TestNormalizeKeywordFilter(t *testing.T) {
+	t.Parallel()
+
+	got, err := normalizeKeywordFilter(domain.AlarmKeywordFilter{Include: []string{" 歌枠 ", "Karaoke", "karaoke", ""}, Exclude: []string{"ASMR"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got.Include, []string{"歌枠", "Karaoke"}) || !slices.Equal(got.Exclude, []string{"ASMR"}) {
+		t.Fatalf("unexpected filter: %+v", got)
+	}
+
+	if _, err := normalizeKeywordFilter(domain.AlarmKeywordFilter{Include: []string{"asmr"}, Exclude: []string{"ASMR"}}); err == nil {
+		t.Fatal("expected error for conflicting keyword")
+	}
+	if _, err := normalizeKeywordFilter(domain.AlarmKeywordFilter{Include: []string{strings.Repeat("가", maxFilterKeywordRunes+1)}}); err == nil {
+		t.Fatal("expected error for long keyword")
+	}
+
+	round, ok := parseKeywordFilter(`{"include":["歌枠"],"exclude":["ASMR"]}`)
+	if !ok || !round.Matches("歌枠 REBOOT") || round.Matches("asmr 歌枠") {
+		t.Fatalf("unexpected parsed filter: %+v", round)
+	}
+}
+
+func TestActiveSubscribers_SkipsDormantRooms(t *testing.T) {
+	t.Parallel()
+
+	subscribers := []string{"r1:alice", "r2:bob", "r1:carol", "broken"}
+	if got := activeSubscribers(subscribers, nil); len(got) != 4 {
+		t.Fatalf("expected all subscribers without dormant rooms, got %v", got)
+	}
+
+	got := activeSubscribers(subscribers, map[string]time.Time{"r1": time.Now()})
+	if !slices.Equal(got, []string{"r2:bob", "broken"}) {
+		t.Fatalf("unexpected active subscribers: %v", got)
+	}
+}
+
+func TestStreamsStartingWithin(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 10, 2, 0, 0, time.UTC)
+	until := now.Truncate(time.Hour).Add(time.Hour)
+	at := func(minute int) *time.Time {
+		ts := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC).Add(time.Duration(minute) * time.Minute)
+		return &ts
+	}
+
+	streams := []*domain.Stream{
+		{ID: "top-of-hour", Status: domain.StreamStatusUpcoming, StartScheduled: at(60)},
+		{ID: "soon", Status: domain.StreamStatusUpcoming, StartScheduled: at(5)},
+		{ID: "started", Status: domain.StreamStatusUpcoming, StartScheduled: at(1)},
+		{ID: "next-hour", Status: domain.StreamStatusUpcoming, StartScheduled: at(61)},
+		{ID: "live", Status: domain.StreamStatusLive, StartScheduled: at(30)},
+		{ID: "unscheduled", Status: domain.StreamStatusUpcoming},
+	}
+
+	got := streamsStartingWithin(streams, now, until)
+	ids := make([]string, 0, len(got))
+	for _, stream := range got {
+		ids = append(ids, stream.ID)
+	}
+	if !slices.Equal(ids, []string{"soon", "top-of-hour"}) {
+		t.Fatalf("unexpected streams: %v", ids)
+	}
+}
+
+func TestParseDigestMode(t *testing.T) {
+	t.Parallel()
+
+	for raw, want := range map[string]DigestMode{"": DigestOff, "off": DigestOff, "cycle": DigestCycle, "hourly": DigestHourly} {
+		got, err := ParseDigestMode(raw)
+		if err != nil || got != want {
+			t.Fatalf("ParseDigestMode(%q) = %q, %v", raw, got, err)
+		}
+	}
+	if _, err := ParseDigestMode("daily"); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+}
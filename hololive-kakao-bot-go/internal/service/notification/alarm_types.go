@@ -1,10 +1,11 @@
 package notification
 
 import (
+	"context"
 	"log/slog"
 	"sync"
 
-	"github.com/kapu/hololive-kakao-bot-go/internal/service/alarm"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
 	"github.com/kapu/hololive-kakao-bot-go/internal/service/holodex"
 )
@@ -22,6 +23,10 @@ const (
 	UserNamesCacheKey           = "alarm:user_names"
 	NotifiedKeyPrefix           = "notified:"
 	NextStreamKeyPrefix         = "alarm:next_stream:"
+	// AdvanceMinutesKey: 사용자별 알림 시점(분) 설정 Hash 키 (field: roomID:userID, value: "30,10")
+	AdvanceMinutesKey = "alarm:advance_minutes"
+	// KeywordFiltersKey: 알림 구독별 제목 키워드 필터 Hash 키 (field: roomID:userID:channelID, value: JSON)
+	KeywordFiltersKey = "alarm:keyword_filters"
 )
 
 // NotifiedData: 알림 중복 발송 방지를 위해 기록하는 알림 이력 정보
@@ -31,11 +36,20 @@ type NotifiedData struct {
 	MinutesUntil   int    `json:"minutes_until"`
 }
 
+// AlarmStore: 알람 영속 저장소 (alarm.Repository)
+type AlarmStore interface {
+	Add(ctx context.Context, alarm *domain.Alarm) error
+	Remove(ctx context.Context, roomID, userID, channelID string) error
+	ClearByUser(ctx context.Context, roomID, userID string) (int64, error)
+	GetMemberName(ctx context.Context, channelID string) (string, error)
+	LoadAll(ctx context.Context) ([]*domain.Alarm, error)
+}
+
 // AlarmService: 방송 알림(Alarm)을 관리하고, 예정된 방송을 주기적으로 체크하여 알림을 발송하는 서비스
 type AlarmService struct {
 	cache           *cache.Service
-	holodex         *holodex.Service
-	alarmRepo       *alarm.Repository // DB 영속 저장소 (write-through)
+	holodex         holodex.StreamProvider
+	alarmRepo       AlarmStore // DB 영속 저장소 (write-through)
 	logger          *slog.Logger
 	targetMinutes   []int
 	baseConcurrency int  // 기본 동시성
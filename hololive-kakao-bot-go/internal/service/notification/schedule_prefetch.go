@@ -0,0 +1,199 @@
+package notification
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+// ScheduleRefresher: 채널 일정을 캐시를 거치지 않고 다시 조회해 ttl 동안 캐싱하는 제공자 (holodex.Service)
+type ScheduleRefresher interface {
+	RefreshChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool, ttl time.Duration) ([]*domain.Stream, error)
+}
+
+// SchedulePrefetcher: 구독 채널의 일정 캐시를 알람 체크보다 먼저 채워 두는 백그라운드 작업
+// 채널마다 다음 갱신 시각을 따로 두고(다음 방송이 가까울수록 짧게) 처음 본 채널은 해시로 흩어 놓아,
+// 일정 캐시가 한꺼번에 만료되어 알람 체크 한 번에 Holodex 호출이 몰리지 않게 한다.
+type SchedulePrefetcher struct {
+	alarm     *AlarmService
+	refresher ScheduleRefresher
+	logger    *slog.Logger
+	now       func() time.Time
+
+	mu  sync.Mutex
+	due map[string]time.Time // 채널 ID → 다음 갱신 시각
+}
+
+// NewSchedulePrefetcher: 일정 프리페처를 생성합니다. alarm이나 refresher가 없으면 nil을 반환한다.
+func NewSchedulePrefetcher(alarm *AlarmService, refresher ScheduleRefresher, logger *slog.Logger) *SchedulePrefetcher {
+	if alarm == nil || refresher == nil {
+		return nil
+	}
+	return &SchedulePrefetcher{
+		alarm:     alarm,
+		refresher: refresher,
+		logger:    logger.With(slog.String("service", "schedule_prefetch")),
+		now:       time.Now,
+		due:       make(map[string]time.Time),
+	}
+}
+
+// Start: ctx가 종료될 때까지 갱신 시각이 된 채널의 일정을 미리 조회합니다.
+func (p *SchedulePrefetcher) Start(ctx context.Context) {
+	p.logger.Info("Starting schedule prefetcher",
+		slog.Duration("tick", constants.SchedulePrefetch.Tick),
+		slog.Int("max_per_tick", constants.SchedulePrefetch.MaxPerTick),
+	)
+
+	ticker := time.NewTicker(constants.SchedulePrefetch.Tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logger.Info("Schedule prefetcher stopped")
+			return
+		case <-ticker.C:
+			p.prefetch(ctx)
+		}
+	}
+}
+
+// prefetch: 갱신 시각이 지난 채널을 오래된 순으로 최대 MaxPerTick개 갱신하고, 갱신한 채널 수를 반환합니다.
+func (p *SchedulePrefetcher) prefetch(ctx context.Context) int {
+	channelIDs, err := p.alarm.cache.SMembers(ctx, AlarmChannelRegistryKey)
+	if err != nil {
+		p.logger.Warn("Failed to get channel registry", slog.Any("error", err))
+		return 0
+	}
+
+	now := p.now()
+	dueChannels := p.dueChannels(channelIDs, now)
+	if len(dueChannels) == 0 {
+		return 0
+	}
+
+	dormant, err := p.alarm.DormantRooms(ctx)
+	if err != nil {
+		p.logger.Warn("Failed to load dormant rooms", slog.Any("error", err))
+	}
+
+	refreshed := 0
+	for _, channelID := range dueChannels {
+		if ctx.Err() != nil {
+			break
+		}
+		p.schedule(channelID, now.Add(p.refreshChannel(ctx, channelID, dormant, now)))
+		refreshed++
+	}
+	return refreshed
+}
+
+// dueChannels: 레지스트리에서 빠진 채널을 잊고, 처음 본 채널은 흩어 놓은 갱신 시각을 배정한 뒤
+// 갱신 시각이 지난 채널을 오래된 순으로 최대 MaxPerTick개 반환합니다.
+func (p *SchedulePrefetcher) dueChannels(channelIDs []string, now time.Time) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	registered := make(map[string]struct{}, len(channelIDs))
+	for _, channelID := range channelIDs {
+		registered[channelID] = struct{}{}
+		if _, ok := p.due[channelID]; !ok {
+			p.due[channelID] = now.Add(staggerOffset(channelID, constants.SchedulePrefetch.DefaultInterval))
+		}
+	}
+	for channelID := range p.due {
+		if _, ok := registered[channelID]; !ok {
+			delete(p.due, channelID)
+		}
+	}
+
+	due := make([]string, 0)
+	for channelID, at := range p.due {
+		if !at.After(now) {
+			due = append(due, channelID)
+		}
+	}
+	slices.SortFunc(due, func(a, b string) int {
+		return p.due[a].Compare(p.due[b])
+	})
+	if len(due) > constants.SchedulePrefetch.MaxPerTick {
+		due = due[:constants.SchedulePrefetch.MaxPerTick]
+	}
+	return due
+}
+
+func (p *SchedulePrefetcher) schedule(channelID string, at time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.due[channelID]; ok {
+		p.due[channelID] = at
+	}
+}
+
+// refreshChannel: 채널 일정을 갱신하고 다음 갱신까지의 간격을 반환합니다.
+// 구독자가 모두 휴면 방이면 조회하지 않는다 (알람 체크도 건너뛰는 채널).
+func (p *SchedulePrefetcher) refreshChannel(ctx context.Context, channelID string, dormant map[string]time.Time, now time.Time) time.Duration {
+	subscribers, err := p.alarm.cache.SMembers(ctx, p.alarm.channelSubscribersKey(channelID))
+	if err != nil || len(activeSubscribers(subscribers, dormant)) == 0 {
+		return constants.SchedulePrefetch.IdleInterval
+	}
+
+	// 조회가 늦어져도 다음 갱신 전에 캐시가 비지 않도록 가장 긴 간격 기준 TTL로 먼저 저장하고,
+	// 결과에 맞는 간격으로 다음 갱신을 예약한다.
+	ttl := constants.SchedulePrefetch.IdleInterval + constants.SchedulePrefetch.TTLMargin
+	refreshCtx, cancel := context.WithTimeout(ctx, constants.RequestTimeout.AlarmService)
+	streams, err := p.refresher.RefreshChannelSchedule(refreshCtx, channelID, channelScheduleHours, true, ttl)
+	cancel()
+	if err != nil {
+		p.logger.Warn("Failed to prefetch channel schedule",
+			slog.String("channel_id", channelID),
+			slog.Any("error", err),
+		)
+		return constants.SchedulePrefetch.DefaultInterval
+	}
+	return refreshInterval(streams, now)
+}
+
+// refreshInterval: 가장 가까운 예정 방송까지 남은 시간에 따라 다음 갱신 간격을 정합니다.
+func refreshInterval(streams []*domain.Stream, now time.Time) time.Duration {
+	var next time.Duration
+	found := false
+	for _, stream := range streams {
+		if !stream.IsUpcoming() || stream.StartScheduled == nil {
+			continue
+		}
+		// 예정 시각이 지났는데 아직 시작하지 않은 방송도 곧 시작할 방송으로 본다
+		if until := stream.StartScheduled.Sub(now); !found || until < next {
+			next, found = until, true
+		}
+	}
+
+	cfg := constants.SchedulePrefetch
+	switch {
+	case !found:
+		return cfg.IdleInterval
+	case next <= cfg.NearWindow:
+		return cfg.NearInterval
+	case next <= cfg.SoonWindow:
+		return cfg.SoonInterval
+	default:
+		return cfg.DefaultInterval
+	}
+}
+
+// staggerOffset: 채널 ID로 정해지는 [0, spread) 구간의 첫 갱신 지연 (재시작해도 같은 채널은 같은 자리에 놓인다)
+func staggerOffset(channelID string, spread time.Duration) time.Duration {
+	if spread <= 0 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(channelID))
+	return time.Duration(uint64(h.Sum32()) % uint64(spread))
+}
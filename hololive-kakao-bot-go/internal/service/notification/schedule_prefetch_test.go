@@ -0,0 +1,127 @@
+package notification
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+)
+
+type fakeScheduleRefresher struct {
+	streams map[string][]*domain.Stream
+	calls   []string
+	ttls    []time.Duration
+}
+
+func (f *fakeScheduleRefresher) RefreshChannelSchedule(_ context.Context, channelID string, hours int, includeLive bool, ttl time.Duration) ([]*domain.Stream, error) {
+	if hours != channelScheduleHours || !includeLive {
+		return nil, context.Canceled
+	}
+	f.calls = append(f.calls, channelID)
+	f.ttls = append(f.ttls, ttl)
+	return f.streams[channelID], nil
+}
+
+func upcomingAt(at time.Time) *domain.Stream {
+	return &domain.Stream{Status: domain.StreamStatusUpcoming, StartScheduled: &at}
+}
+
+func TestRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cfg := constants.SchedulePrefetch
+	live := &domain.Stream{Status: domain.StreamStatusLive}
+
+	tests := map[string]struct {
+		streams []*domain.Stream
+		want    time.Duration
+	}{
+		"noStreams":       {nil, cfg.IdleInterval},
+		"liveOnly":        {[]*domain.Stream{live}, cfg.IdleInterval},
+		"near":            {[]*domain.Stream{upcomingAt(now.Add(5 * time.Hour)), upcomingAt(now.Add(20 * time.Minute))}, cfg.NearInterval},
+		"lateStart":       {[]*domain.Stream{upcomingAt(now.Add(-3 * time.Minute))}, cfg.NearInterval},
+		"soon":            {[]*domain.Stream{upcomingAt(now.Add(2 * time.Hour))}, cfg.SoonInterval},
+		"farUpcomingOnly": {[]*domain.Stream{live, upcomingAt(now.Add(10 * time.Hour))}, cfg.DefaultInterval},
+	}
+	for name, tc := range tests {
+		if got := refreshInterval(tc.streams, now); got != tc.want {
+			t.Errorf("%s: got %v, want %v", name, got, tc.want)
+		}
+	}
+}
+
+func TestStaggerOffset(t *testing.T) {
+	t.Parallel()
+
+	spread := 5 * time.Minute
+	seen := make(map[time.Duration]struct{})
+	for _, channelID := range []string{"UC1", "UC2", "UC3", "UC4", "UC5"} {
+		offset := staggerOffset(channelID, spread)
+		if offset < 0 || offset >= spread {
+			t.Fatalf("offset out of range for %s: %v", channelID, offset)
+		}
+		if offset != staggerOffset(channelID, spread) {
+			t.Fatalf("offset not stable for %s", channelID)
+		}
+		seen[offset] = struct{}{}
+	}
+	if len(seen) < 2 {
+		t.Fatalf("offsets should be spread, got %v", seen)
+	}
+}
+
+func TestSchedulePrefetcher_AdaptiveRefresh(t *testing.T) {
+	as, mini := newMigrateTestService(t)
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	refresher := &fakeScheduleRefresher{streams: map[string][]*domain.Stream{
+		"ch-near": {upcomingAt(now.Add(10 * time.Minute))},
+		"ch-far":  {upcomingAt(now.Add(8 * time.Hour))},
+	}}
+	p := NewSchedulePrefetcher(as, refresher, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	p.now = func() time.Time { return now }
+
+	_, _ = mini.SAdd(AlarmChannelRegistryKey, "ch-near", "ch-far", "ch-dormant")
+	_, _ = mini.SAdd(ChannelSubscribersKeyPrefix+"ch-near", "r1:u1")
+	_, _ = mini.SAdd(ChannelSubscribersKeyPrefix+"ch-far", "r1:u1")
+	_, _ = mini.SAdd(ChannelSubscribersKeyPrefix+"ch-dormant", "r2:u2")
+	mini.HSet(DormantRoomsKey, "r2", "1700000000")
+
+	// 처음 본 채널은 DefaultInterval 안에 흩어져 배정되므로 그 전에는 아무것도 갱신하지 않을 수 있음
+	p.prefetch(ctx)
+	now = now.Add(constants.SchedulePrefetch.DefaultInterval)
+	p.prefetch(ctx)
+
+	slices.Sort(refresher.calls)
+	if !slices.Equal(refresher.calls, []string{"ch-far", "ch-near"}) {
+		t.Fatalf("expected near/far channels refreshed once (dormant skipped), got %v", refresher.calls)
+	}
+	wantTTL := constants.SchedulePrefetch.IdleInterval + constants.SchedulePrefetch.TTLMargin
+	if refresher.ttls[0] != wantTTL {
+		t.Fatalf("unexpected cache ttl: %v", refresher.ttls[0])
+	}
+
+	// NearInterval 뒤에는 다음 방송이 가까운 채널만 다시 갱신
+	refresher.calls = nil
+	now = now.Add(constants.SchedulePrefetch.NearInterval)
+	if n := p.prefetch(ctx); n != 1 || !slices.Equal(refresher.calls, []string{"ch-near"}) {
+		t.Fatalf("expected only near channel, got %d %v", n, refresher.calls)
+	}
+
+	// 레지스트리에서 빠진 채널은 잊음
+	_, _ = mini.SRem(AlarmChannelRegistryKey, "ch-far")
+	p.prefetch(ctx)
+	p.mu.Lock()
+	_, tracked := p.due["ch-far"]
+	p.mu.Unlock()
+	if tracked {
+		t.Fatal("removed channel should be forgotten")
+	}
+}
@@ -0,0 +1,134 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+const (
+	// Valkey 키
+	lastSeenKey         = "room:last_seen" // ZSET: roomID → 마지막 메시지 시각 (unix 초)
+	activityKeyPrefix   = "room:activity:" // HASH: name, first_seen, messages, commands
+	activityFieldName   = "name"
+	activityFieldFirst  = "first_seen"
+	activityFieldMsgs   = "messages"
+	activityFieldCmds   = "commands"
+	activityFieldUpdate = "last_seen"
+)
+
+// Activity: 방의 활동 기록 (메시지 수, 최초/마지막 메시지 시각)
+type Activity struct {
+	RoomID    string     `json:"roomId"`
+	RoomName  string     `json:"roomName,omitempty"`
+	FirstSeen *time.Time `json:"firstSeenAt,omitempty"`
+	LastSeen  *time.Time `json:"lastSeenAt,omitempty"`
+	Messages  int64      `json:"messages"`
+	Commands  int64      `json:"commands"`
+}
+
+func activityKey(roomID string) string {
+	return activityKeyPrefix + roomID
+}
+
+// Touch: 방에서 메시지가 들어왔음을 기록합니다. isCommand가 true면 명령어 수도 함께 증가시킨다.
+func (s *Service) Touch(ctx context.Context, roomID, roomName string, isCommand bool) error {
+	roomID = util.TrimSpace(roomID)
+	if s == nil || roomID == "" {
+		return nil
+	}
+
+	client := s.cache.GetClient()
+	now := s.now()
+	unix := now.Unix()
+	key := activityKey(roomID)
+
+	cmds := client.B()
+	batch := []valkey.Completed{
+		// 메시지가 다시 들어오면 휴면 처리된 알람 구독을 복귀 (응답 위치를 고정하려고 첫 번째에 둔다)
+		cmds.Hdel().Key(notification.DormantRoomsKey).Field(roomID).Build(),
+		cmds.Zadd().Key(lastSeenKey).ScoreMember().ScoreMember(float64(unix), roomID).Build(),
+		cmds.Hsetnx().Key(key).Field(activityFieldFirst).Value(strconv.FormatInt(unix, 10)).Build(),
+		cmds.Hset().Key(key).FieldValue().FieldValue(activityFieldUpdate, strconv.FormatInt(unix, 10)).Build(),
+		cmds.Hincrby().Key(key).Field(activityFieldMsgs).Increment(1).Build(),
+	}
+	if name := util.TrimSpace(roomName); name != "" && name != roomID {
+		batch = append(batch, cmds.Hset().Key(key).FieldValue().FieldValue(activityFieldName, name).Build())
+	}
+	if isCommand {
+		batch = append(batch, cmds.Hincrby().Key(key).Field(activityFieldCmds).Increment(1).Build())
+	}
+
+	resps := client.DoMulti(ctx, batch...)
+	if err := firstError(resps); err != nil {
+		return fmt.Errorf("touch room activity: %w", err)
+	}
+	s.reportReactivated(ctx, roomID, resps[0], "message")
+	return nil
+}
+
+// GetActivity: 방의 활동 기록을 조회합니다. 기록이 없으면 nil을 반환한다.
+func (s *Service) GetActivity(ctx context.Context, roomID string) (*Activity, error) {
+	fields, err := s.cache.HGetAll(ctx, activityKey(roomID))
+	if err != nil {
+		return nil, fmt.Errorf("get room activity: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	activity := &Activity{
+		RoomID:    roomID,
+		RoomName:  fields[activityFieldName],
+		FirstSeen: parseUnix(fields[activityFieldFirst]),
+		LastSeen:  parseUnix(fields[activityFieldUpdate]),
+	}
+	activity.Messages, _ = strconv.ParseInt(fields[activityFieldMsgs], 10, 64)
+	activity.Commands, _ = strconv.ParseInt(fields[activityFieldCmds], 10, 64)
+	return activity, nil
+}
+
+// InactiveRooms: 기준 시각(before) 이전이 마지막 메시지인 방 ID 목록을 반환합니다.
+func (s *Service) InactiveRooms(ctx context.Context, before time.Time) ([]string, error) {
+	client := s.cache.GetClient()
+	cmd := client.B().Zrangebyscore().Key(lastSeenKey).Min("-inf").Max(strconv.FormatInt(before.Unix(), 10)).Build()
+	rooms, err := client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list inactive rooms: %w", err)
+	}
+	return rooms, nil
+}
+
+// forgetActivity: 방의 활동 기록과 전송 결과 기록을 삭제합니다.
+func (s *Service) forgetActivity(ctx context.Context, roomID string) error {
+	client := s.cache.GetClient()
+	for _, resp := range client.DoMulti(ctx,
+		client.B().Zrem().Key(lastSeenKey).Member(roomID).Build(),
+		client.B().Del().Key(activityKey(roomID)).Build(),
+		client.B().Zrem().Key(lastDeliveredKey).Member(roomID).Build(),
+		client.B().Zrem().Key(deliveryFailingKey).Member(roomID).Build(),
+	) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("forget room activity: %w", err)
+		}
+	}
+	return nil
+}
+
+func parseUnix(raw string) *time.Time {
+	if raw == "" {
+		return nil
+	}
+	unix, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil
+	}
+	t := time.Unix(unix, 0)
+	return &t
+}
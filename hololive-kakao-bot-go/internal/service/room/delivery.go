@@ -0,0 +1,129 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+const (
+	// Valkey 키
+	lastDeliveredKey   = "room:last_delivered"   // ZSET: roomID → 마지막 전송 성공 시각 (unix 초)
+	deliveryFailingKey = "room:delivery_failing" // ZSET: roomID → 마지막 성공 이후 첫 전송 실패 시각 (unix 초)
+)
+
+// AdminNotifier: 휴면 전환/복귀 같은 운영 이벤트를 관리자에게 알리는 함수
+type AdminNotifier func(ctx context.Context, summary string, details map[string]any)
+
+// SetAdminNotifier: 운영 이벤트 알림 함수를 설정합니다. nil이면 로그만 남깁니다.
+func (s *Service) SetAdminNotifier(notify AdminNotifier) {
+	if s == nil {
+		return
+	}
+	s.notifyAdmins = notify
+}
+
+// RecordDelivery: Iris 전송 결과를 기록합니다. 성공하면 실패 기록을 지우고 휴면 방을 복귀시킨다.
+// 실패는 성공 이후 첫 실패 시각만 남겨, 실패가 얼마나 오래 이어졌는지 판단할 수 있게 한다.
+func (s *Service) RecordDelivery(ctx context.Context, roomID string, sendErr error) error {
+	roomID = util.TrimSpace(roomID)
+	if s == nil || roomID == "" {
+		return nil
+	}
+
+	client := s.cache.GetClient()
+	unix := float64(s.now().Unix())
+	cmds := client.B()
+
+	if sendErr != nil {
+		cmd := cmds.Zadd().Key(deliveryFailingKey).Nx().ScoreMember().ScoreMember(unix, roomID).Build()
+		if err := client.Do(ctx, cmd).Error(); err != nil {
+			return fmt.Errorf("record delivery failure: %w", err)
+		}
+		return nil
+	}
+
+	resps := client.DoMulti(ctx,
+		cmds.Zadd().Key(lastDeliveredKey).ScoreMember().ScoreMember(unix, roomID).Build(),
+		cmds.Zrem().Key(deliveryFailingKey).Member(roomID).Build(),
+		cmds.Hdel().Key(notification.DormantRoomsKey).Field(roomID).Build(),
+	)
+	if err := firstError(resps); err != nil {
+		return fmt.Errorf("record delivery success: %w", err)
+	}
+	s.reportReactivated(ctx, roomID, resps[2], "delivery")
+	return nil
+}
+
+// LastDelivered: 방에 마지막으로 전송에 성공한 시각을 반환합니다. 기록이 없으면 nil.
+func (s *Service) LastDelivered(ctx context.Context, roomID string) (*time.Time, error) {
+	client := s.cache.GetClient()
+	score, err := client.Do(ctx, client.B().Zscore().Key(lastDeliveredKey).Member(roomID).Build()).AsFloat64()
+	if valkey.IsValkeyNil(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get last delivery: %w", err)
+	}
+	t := time.Unix(int64(score), 0)
+	return &t, nil
+}
+
+// failingRooms: 기준 시각(before) 이전부터 전송 실패가 이어지고 있는 방 ID 목록을 반환합니다.
+func (s *Service) failingRooms(ctx context.Context, before time.Time) ([]string, error) {
+	client := s.cache.GetClient()
+	cmd := client.B().Zrangebyscore().Key(deliveryFailingKey).Min("-inf").Max(strconv.FormatInt(before.Unix(), 10)).Build()
+	rooms, err := client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("list failing rooms: %w", err)
+	}
+	return rooms, nil
+}
+
+// lastSeen: 방의 마지막 메시지 시각을 반환합니다. 기록이 없으면 nil.
+func (s *Service) lastSeen(ctx context.Context, roomID string) (*time.Time, error) {
+	client := s.cache.GetClient()
+	score, err := client.Do(ctx, client.B().Zscore().Key(lastSeenKey).Member(roomID).Build()).AsFloat64()
+	if valkey.IsValkeyNil(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get room last seen: %w", err)
+	}
+	t := time.Unix(int64(score), 0)
+	return &t, nil
+}
+
+// reportReactivated: HDEL 응답으로 휴면 해제 여부를 확인하고, 해제됐으면 관리자에게 알립니다.
+func (s *Service) reportReactivated(ctx context.Context, roomID string, hdel valkey.ValkeyResult, trigger string) {
+	if removed, err := hdel.AsInt64(); err != nil || removed == 0 {
+		return
+	}
+	s.logger.Info("Dormant room reactivated", slog.String("room_id", roomID), slog.String("trigger", trigger))
+	s.notify(ctx, "휴면 알람 방 복귀: "+roomID, map[string]any{
+		"roomId":  roomID,
+		"trigger": trigger,
+	})
+}
+
+func (s *Service) notify(ctx context.Context, summary string, details map[string]any) {
+	if s.notifyAdmins != nil {
+		s.notifyAdmins(ctx, summary, details)
+	}
+}
+
+func firstError(resps []valkey.ValkeyResult) error {
+	for _, resp := range resps {
+		if err := resp.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
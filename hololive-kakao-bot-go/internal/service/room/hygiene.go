@@ -0,0 +1,169 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// DormantRoom: 알람 구독이 휴면 처리된 방
+type DormantRoom struct {
+	RoomID          string     `json:"roomId"`
+	RoomName        string     `json:"roomName,omitempty"`
+	DormantSince    time.Time  `json:"dormantSince"`
+	LastSeenAt      *time.Time `json:"lastSeenAt,omitempty"`
+	LastDeliveredAt *time.Time `json:"lastDeliveredAt,omitempty"`
+}
+
+// DormantRooms: 휴면 처리된 방 목록을 휴면 전환 시각 최신순으로 반환합니다.
+func (s *Service) DormantRooms(ctx context.Context) ([]DormantRoom, error) {
+	dormant, err := s.alarm.DormantRooms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms := make([]DormantRoom, 0, len(dormant))
+	for roomID, since := range dormant {
+		entry := DormantRoom{RoomID: roomID, DormantSince: since.UTC()}
+		entry.RoomName, _ = s.cache.HGet(ctx, notification.RoomNamesCacheKey, roomID)
+		if entry.LastSeenAt, err = s.lastSeen(ctx, roomID); err != nil {
+			return nil, err
+		}
+		if entry.LastDeliveredAt, err = s.LastDelivered(ctx, roomID); err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, entry)
+	}
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].DormantSince.After(rooms[j].DormantSince)
+	})
+	return rooms, nil
+}
+
+// Reactivate: 관리자 요청으로 휴면 방을 복귀시킵니다. 휴면이 아니었으면 false를 반환합니다.
+// 실패 기록도 지워서 다음 검사 주기에 바로 다시 휴면 처리되지 않게 한다.
+func (s *Service) Reactivate(ctx context.Context, roomID string) (bool, error) {
+	roomID = util.TrimSpace(roomID)
+	if roomID == "" {
+		return false, fmt.Errorf("room id is required")
+	}
+	client := s.cache.GetClient()
+	if err := client.Do(ctx, client.B().Zrem().Key(deliveryFailingKey).Member(roomID).Build()).Error(); err != nil {
+		return false, fmt.Errorf("clear delivery failures: %w", err)
+	}
+	return s.alarm.ReactivateRoom(ctx, roomID)
+}
+
+// AlarmHygiene: 봇이 내보내진 방의 알람 구독을 휴면 처리하는 백그라운드 작업
+// 전송 실패가 dormantAfter 이상 이어지고 그동안 메시지도 없었던 방은 알람 체크에서 제외해 Holodex 호출을 아낀다.
+// 방송이 없어 보낼 알림이 없었던 방은 실패 기록이 없으므로 휴면 대상이 아니다.
+type AlarmHygiene struct {
+	rooms        *Service
+	dormantAfter time.Duration
+	interval     time.Duration
+	logger       *slog.Logger
+}
+
+// NewAlarmHygiene: 알람 구독 휴면 처리 작업을 생성합니다. dormantDays가 0 이하면 nil을 반환한다.
+func NewAlarmHygiene(rooms *Service, dormantDays int, interval time.Duration, logger *slog.Logger) *AlarmHygiene {
+	if rooms == nil || dormantDays <= 0 {
+		return nil
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &AlarmHygiene{
+		rooms:        rooms,
+		dormantAfter: time.Duration(dormantDays) * 24 * time.Hour,
+		interval:     interval,
+		logger:       logger.With(slog.String("service", "alarm_hygiene")),
+	}
+}
+
+// Start: ctx가 종료될 때까지 주기적으로 휴면 대상 방을 찾습니다.
+func (h *AlarmHygiene) Start(ctx context.Context) {
+	h.logger.Info("Starting alarm hygiene job",
+		slog.Duration("dormant_after", h.dormantAfter),
+		slog.Duration("interval", h.interval),
+	)
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.logger.Info("Alarm hygiene job stopped")
+			return
+		case <-ticker.C:
+			h.sweep(ctx, h.rooms.now())
+		}
+	}
+}
+
+// sweep: 기준 시각(now)에서 dormantAfter 이전부터 전송에 실패하고 있는 알람 방을 휴면 처리하고, 처리한 방 수를 반환합니다.
+func (h *AlarmHygiene) sweep(ctx context.Context, now time.Time) int {
+	cutoff := now.Add(-h.dormantAfter)
+	roomIDs, err := h.rooms.failingRooms(ctx, cutoff)
+	if err != nil {
+		h.logger.Warn("Failed to list failing rooms", slog.Any("error", err))
+		return 0
+	}
+	if len(roomIDs) == 0 {
+		return 0
+	}
+
+	alarmRooms, err := h.rooms.alarm.GetDistinctRooms(ctx)
+	if err != nil {
+		h.logger.Warn("Failed to list alarm rooms", slog.Any("error", err))
+		return 0
+	}
+
+	marked := 0
+	for _, roomID := range roomIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		if !slices.Contains(alarmRooms, roomID) {
+			continue
+		}
+		// 전송은 실패해도 메시지가 들어오고 있으면 Iris 쪽 일시 장애로 보고 건너뜀
+		seen, err := h.rooms.lastSeen(ctx, roomID)
+		if err != nil {
+			h.logger.Warn("Failed to read room last seen", slog.String("room_id", roomID), slog.Any("error", err))
+			continue
+		}
+		if seen != nil && seen.After(cutoff) {
+			continue
+		}
+
+		created, err := h.rooms.alarm.MarkRoomDormant(ctx, roomID, now)
+		if err != nil {
+			h.logger.Warn("Failed to mark room dormant", slog.String("room_id", roomID), slog.Any("error", err))
+			continue
+		}
+		if !created {
+			continue
+		}
+		marked++
+
+		roomName, _ := h.rooms.cache.HGet(ctx, notification.RoomNamesCacheKey, roomID)
+		h.logger.Info("Alarm room marked dormant", slog.String("room_id", roomID), slog.String("room_name", roomName))
+		h.rooms.notify(ctx, "알람 방 휴면 처리: "+resolveRoomName("", nil, roomName, roomID), map[string]any{
+			"roomId":       roomID,
+			"roomName":     roomName,
+			"dormantAfter": h.dormantAfter.String(),
+		})
+	}
+
+	if marked > 0 {
+		h.logger.Info("Alarm rooms marked dormant", slog.Int("count", marked))
+	}
+	return marked
+}
@@ -0,0 +1,315 @@
+package room
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/acl"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// ErrRoomNotFound: 활동 기록도 알람도 없는 방에 대해 떠나기를 요청했을 때 반환되는 오류
+var ErrRoomNotFound = stdErrors.New("room not found")
+
+// Reason: 떠나기 처리 사유
+type Reason string
+
+// Reason 상수 목록.
+const (
+	// ReasonCommand: 방 안에서 떠나기 명령어로 요청됨
+	ReasonCommand Reason = "command"
+	// ReasonInactive: 장기간 메시지가 없어 자동으로 정리됨
+	ReasonInactive Reason = "inactive"
+	// ReasonAdmin: 관리자 API로 수동 실행됨
+	ReasonAdmin Reason = "admin"
+)
+
+// LeaveRequest: 떠나기 처리 요청
+type LeaveRequest struct {
+	RoomID      string
+	RoomName    string // 비어 있으면 활동 기록/알람 캐시의 방 이름을 사용
+	Reason      Reason
+	RequestedBy string // 명령어를 입력한 사용자 이름 또는 "system"/"admin"
+}
+
+// Stats: 떠나기 직전에 보관하는 방 통계
+type Stats struct {
+	FirstSeenAt   *time.Time     `json:"firstSeenAt,omitempty"`
+	LastActiveAt  *time.Time     `json:"lastActiveAt,omitempty"`
+	Messages      int64          `json:"messages"`
+	Commands      int64          `json:"commands"`
+	AlarmUsers    int            `json:"alarmUsers"`
+	Alarms        int            `json:"alarms"`
+	ChannelCounts map[string]int `json:"channelCounts,omitempty"`
+	ReleasedNames int            `json:"releasedNames"`
+	Translation   bool           `json:"translation"`
+	ACLRemoved    bool           `json:"aclRemoved"`
+}
+
+// Departure: 떠난 방의 보관 기록
+type Departure struct {
+	ID          string    `json:"id"`
+	RoomID      string    `json:"roomId"`
+	RoomName    string    `json:"roomName"`
+	Reason      Reason    `json:"reason"`
+	RequestedBy string    `json:"requestedBy"`
+	Stats       Stats     `json:"stats"`
+	DepartedAt  time.Time `json:"departedAt"`
+}
+
+// departureModel: room_departures 테이블 GORM 모델 (통계는 JSON 텍스트로 저장)
+type departureModel struct {
+	ID          string `gorm:"primaryKey"`
+	RoomID      string
+	RoomName    string
+	Reason      string
+	RequestedBy string
+	Stats       string
+	DepartedAt  time.Time
+}
+
+// TableName: 떠나기 기록 테이블의 이름을 반환한다. ("room_departures")
+func (departureModel) TableName() string {
+	return "room_departures"
+}
+
+// Service: 방 활동 추적과 떠나기(정리) 흐름을 담당하는 서비스
+// 활동 기록은 Valkey에, 떠난 방의 보관 기록은 PostgreSQL에 저장한다.
+type Service struct {
+	db          *gorm.DB
+	cache       *cache.Service
+	alarm       *notification.AlarmService
+	translation *translation.Service // nil이면 번역 설정 정리 생략
+	acl         *acl.Service         // nil이면 ACL 정리 생략
+	logger      *slog.Logger
+
+	notifyAdmins AdminNotifier // nil이면 휴면 전환/복귀를 로그로만 남김
+
+	leaveMu sync.Mutex
+	now     func() time.Time
+}
+
+// NewService: 방 서비스를 생성하고 떠나기 기록 테이블을 준비합니다.
+func NewService(
+	ctx context.Context,
+	db *gorm.DB,
+	cacheSvc *cache.Service,
+	alarmSvc *notification.AlarmService,
+	titleTranslator *translation.Service,
+	aclSvc *acl.Service,
+	logger *slog.Logger,
+) (*Service, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db must not be nil")
+	}
+	if cacheSvc == nil || alarmSvc == nil {
+		return nil, fmt.Errorf("cache and alarm services are required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	svc := &Service{
+		db:          db,
+		cache:       cacheSvc,
+		alarm:       alarmSvc,
+		translation: titleTranslator,
+		acl:         aclSvc,
+		logger:      logger,
+		now:         time.Now,
+	}
+
+	if err := svc.createTablesIfNotExist(ctx); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+func (s *Service) createTablesIfNotExist(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS room_departures (
+			id TEXT PRIMARY KEY,
+			room_id TEXT NOT NULL,
+			room_name TEXT NOT NULL DEFAULT '',
+			reason TEXT NOT NULL,
+			requested_by TEXT NOT NULL DEFAULT '',
+			stats TEXT NOT NULL,
+			departed_at TIMESTAMP NOT NULL
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create room_departures table: %w", err)
+	}
+
+	if err := db.Exec(`CREATE INDEX IF NOT EXISTS idx_room_departures_room_id ON room_departures (room_id)`).Error; err != nil {
+		return fmt.Errorf("failed to create room_departures index: %w", err)
+	}
+
+	return nil
+}
+
+// Leave: 방의 통계를 보관하고 알람, 알림 시점, 이름 매핑, 번역 설정, ACL 항목, 활동 기록을 정리한 뒤 떠나기 기록을 남깁니다.
+// 정리 단계가 일부 실패해도 기록은 남기며, 기록 저장에 실패하면 통계를 로그로 남기고 오류를 반환한다.
+func (s *Service) Leave(ctx context.Context, req LeaveRequest) (*Departure, error) {
+	roomID := util.TrimSpace(req.RoomID)
+	if roomID == "" {
+		return nil, fmt.Errorf("room id is required")
+	}
+
+	s.leaveMu.Lock()
+	defer s.leaveMu.Unlock()
+
+	activity, err := s.GetActivity(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	cachedRoomName, _ := s.cache.HGet(ctx, notification.RoomNamesCacheKey, roomID)
+	alarmRooms, err := s.alarm.GetDistinctRooms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list alarm rooms: %w", err)
+	}
+	if activity == nil && cachedRoomName == "" && !slices.Contains(alarmRooms, roomID) {
+		return nil, ErrRoomNotFound
+	}
+
+	departure := &Departure{
+		ID:          uuid.NewString(),
+		RoomID:      roomID,
+		RoomName:    resolveRoomName(req.RoomName, activity, cachedRoomName, roomID),
+		Reason:      req.Reason,
+		RequestedBy: req.RequestedBy,
+		DepartedAt:  s.now().UTC(),
+	}
+	if activity != nil {
+		departure.Stats.FirstSeenAt = activity.FirstSeen
+		departure.Stats.LastActiveAt = activity.LastSeen
+		departure.Stats.Messages = activity.Messages
+		departure.Stats.Commands = activity.Commands
+	}
+
+	cleanup, err := s.alarm.ClearRoomAlarms(ctx, roomID)
+	if err != nil {
+		s.logger.Warn("Failed to clear room alarms on leave", slog.String("room_id", roomID), slog.Any("error", err))
+	} else {
+		departure.Stats.AlarmUsers = cleanup.Users
+		departure.Stats.Alarms = cleanup.Alarms
+		departure.Stats.ChannelCounts = cleanup.ChannelCounts
+		departure.Stats.ReleasedNames = cleanup.ReleasedNames
+	}
+
+	if s.translation != nil {
+		disabled, err := s.translation.DisableRoom(ctx, roomID)
+		if err != nil {
+			s.logger.Warn("Failed to disable title translation on leave", slog.String("room_id", roomID), slog.Any("error", err))
+		}
+		departure.Stats.Translation = disabled
+	}
+
+	if s.acl != nil {
+		for _, entry := range []string{roomID, departure.RoomName} {
+			removed, err := s.acl.RemoveRoom(ctx, entry)
+			if err != nil {
+				s.logger.Warn("Failed to remove room from ACL on leave", slog.String("room", entry), slog.Any("error", err))
+				continue
+			}
+			departure.Stats.ACLRemoved = departure.Stats.ACLRemoved || removed
+		}
+	}
+
+	if err := s.forgetActivity(ctx, roomID); err != nil {
+		s.logger.Warn("Failed to forget room activity on leave", slog.String("room_id", roomID), slog.Any("error", err))
+	}
+
+	if err := s.saveDeparture(ctx, departure); err != nil {
+		s.logger.Error("Failed to record room departure",
+			slog.String("room_id", roomID),
+			slog.Any("stats", departure.Stats),
+			slog.Any("error", err),
+		)
+		return departure, err
+	}
+
+	s.logger.Info("Room departed",
+		slog.String("room_id", roomID),
+		slog.String("room_name", departure.RoomName),
+		slog.String("reason", string(departure.Reason)),
+		slog.Int("alarms", departure.Stats.Alarms),
+	)
+	return departure, nil
+}
+
+// ListDepartures: 최근 떠나기 기록을 최신순으로 반환합니다.
+func (s *Service) ListDepartures(ctx context.Context, limit int) ([]*Departure, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	var rows []departureModel
+	if err := s.db.WithContext(ctx).Order("departed_at DESC").Limit(limit).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("list room departures: %w", err)
+	}
+
+	departures := make([]*Departure, 0, len(rows))
+	for _, row := range rows {
+		departure := &Departure{
+			ID:          row.ID,
+			RoomID:      row.RoomID,
+			RoomName:    row.RoomName,
+			Reason:      Reason(row.Reason),
+			RequestedBy: row.RequestedBy,
+			DepartedAt:  row.DepartedAt,
+		}
+		if err := json.Unmarshal([]byte(row.Stats), &departure.Stats); err != nil {
+			s.logger.Warn("Failed to decode departure stats", slog.String("id", row.ID), slog.Any("error", err))
+		}
+		departures = append(departures, departure)
+	}
+	return departures, nil
+}
+
+func (s *Service) saveDeparture(ctx context.Context, departure *Departure) error {
+	stats, err := json.Marshal(departure.Stats)
+	if err != nil {
+		return fmt.Errorf("marshal departure stats: %w", err)
+	}
+
+	row := &departureModel{
+		ID:          departure.ID,
+		RoomID:      departure.RoomID,
+		RoomName:    departure.RoomName,
+		Reason:      string(departure.Reason),
+		RequestedBy: departure.RequestedBy,
+		Stats:       string(stats),
+		DepartedAt:  departure.DepartedAt,
+	}
+	if err := s.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("insert room departure: %w", err)
+	}
+	return nil
+}
+
+func resolveRoomName(requested string, activity *Activity, cached, roomID string) string {
+	if name := util.TrimSpace(requested); name != "" {
+		return name
+	}
+	if activity != nil && activity.RoomName != "" {
+		return activity.RoomName
+	}
+	if cached != "" {
+		return cached
+	}
+	return roomID
+}
@@ -0,0 +1,270 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/notification"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/translation"
+)
+
+type testEnv struct {
+	svc         *Service
+	cache       *cache.Service
+	alarm       *notification.AlarmService
+	translation *translation.Service
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+
+	dbName := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	db, err := gorm.Open(sqlite.Open("file:"+dbName+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	alarmSvc := notification.NewAlarmService(cacheSvc, nil, nil, logger, nil)
+	titleTranslator := translation.NewService(nil, cacheSvc, 0, logger)
+
+	svc, err := NewService(context.Background(), db, cacheSvc, alarmSvc, titleTranslator, nil, logger)
+	if err != nil {
+		t.Fatalf("failed to create room service: %v", err)
+	}
+
+	return &testEnv{svc: svc, cache: cacheSvc, alarm: alarmSvc, translation: titleTranslator}
+}
+
+func TestLeave_ArchivesStatsAndCleansRoom(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	mustAddAlarm := func(roomID, userID, channelID, userName string) {
+		t.Helper()
+		if _, err := env.alarm.AddAlarm(ctx, roomID, userID, channelID, "멤버", "방"+roomID, userName); err != nil {
+			t.Fatalf("add alarm: %v", err)
+		}
+	}
+	mustAddAlarm("r1", "u1", "ch-a", "혼자")
+	mustAddAlarm("r1", "u1", "ch-b", "혼자")
+	mustAddAlarm("r1", "u2", "ch-a", "공유")
+	mustAddAlarm("r2", "u2", "ch-a", "공유")
+	if _, err := env.alarm.SetUserAdvanceMinutes(ctx, "r1", "u1", []int{30}); err != nil {
+		t.Fatalf("set advance minutes: %v", err)
+	}
+	if _, err := env.translation.EnableRoom(ctx, "r1"); err != nil {
+		t.Fatalf("enable translation: %v", err)
+	}
+	if err := env.svc.Touch(ctx, "r1", "테스트방", true); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+	if err := env.svc.Touch(ctx, "r1", "테스트방", false); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+
+	departure, err := env.svc.Leave(ctx, LeaveRequest{RoomID: "r1", Reason: ReasonCommand, RequestedBy: "혼자"})
+	if err != nil {
+		t.Fatalf("leave: %v", err)
+	}
+
+	stats := departure.Stats
+	if departure.RoomName != "테스트방" || stats.Messages != 2 || stats.Commands != 1 || stats.LastActiveAt == nil {
+		t.Fatalf("unexpected activity snapshot: %+v", departure)
+	}
+	if stats.AlarmUsers != 2 || stats.Alarms != 3 || stats.ChannelCounts["ch-a"] != 2 || !stats.Translation {
+		t.Fatalf("unexpected cleanup stats: %+v", stats)
+	}
+	if stats.ReleasedNames != 1 {
+		t.Fatalf("expected only the single-room user name to be released, got %d", stats.ReleasedNames)
+	}
+
+	// r1 정리, r2 유지
+	if alarms, _ := env.alarm.GetUserAlarms(ctx, "r1", "u1"); len(alarms) != 0 {
+		t.Fatalf("expected r1 alarms removed, got %v", alarms)
+	}
+	if alarms, _ := env.alarm.GetUserAlarms(ctx, "r2", "u2"); len(alarms) != 1 {
+		t.Fatalf("expected r2 alarms kept, got %v", alarms)
+	}
+	if name, _ := env.cache.HGet(ctx, notification.UserNamesCacheKey, "u1"); name != "" {
+		t.Fatalf("expected u1 name mapping removed, got %q", name)
+	}
+	if name, _ := env.cache.HGet(ctx, notification.UserNamesCacheKey, "u2"); name != "공유" {
+		t.Fatalf("expected u2 name mapping kept, got %q", name)
+	}
+	if name, _ := env.cache.HGet(ctx, notification.RoomNamesCacheKey, "r1"); name != "" {
+		t.Fatalf("expected r1 room name removed, got %q", name)
+	}
+	if minutes, _ := env.cache.HGet(ctx, notification.AdvanceMinutesKey, "r1:u1"); minutes != "" {
+		t.Fatalf("expected advance minutes removed, got %q", minutes)
+	}
+	if env.translation.RoomEnabled(ctx, "r1") {
+		t.Fatal("expected translation disabled")
+	}
+	if activity, _ := env.svc.GetActivity(ctx, "r1"); activity != nil {
+		t.Fatalf("expected activity forgotten, got %+v", activity)
+	}
+
+	departures, err := env.svc.ListDepartures(ctx, 10)
+	if err != nil {
+		t.Fatalf("list departures: %v", err)
+	}
+	if len(departures) != 1 || departures[0].Reason != ReasonCommand || departures[0].Stats.Alarms != 3 {
+		t.Fatalf("unexpected departures: %+v", departures)
+	}
+
+	// 이미 정리된 방은 다시 떠날 수 없음
+	if _, err := env.svc.Leave(ctx, LeaveRequest{RoomID: "r1", Reason: ReasonAdmin}); !errors.Is(err, ErrRoomNotFound) {
+		t.Fatalf("expected ErrRoomNotFound, got %v", err)
+	}
+}
+
+func TestSweeper_LeavesOnlyInactiveRooms(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	env.svc.now = func() time.Time { return base }
+	if err := env.svc.Touch(ctx, "old", "", false); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+	env.svc.now = func() time.Time { return base.Add(20 * 24 * time.Hour) }
+	if err := env.svc.Touch(ctx, "recent", "", false); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+
+	sweeper := NewSweeper(env.svc, 30, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if got := sweeper.sweep(ctx, base.Add(31*24*time.Hour)); got != 1 {
+		t.Fatalf("expected 1 room departed, got %d", got)
+	}
+
+	if activity, _ := env.svc.GetActivity(ctx, "old"); activity != nil {
+		t.Fatalf("expected old room cleaned, got %+v", activity)
+	}
+	if activity, _ := env.svc.GetActivity(ctx, "recent"); activity == nil {
+		t.Fatal("expected recent room kept")
+	}
+	departures, _ := env.svc.ListDepartures(ctx, 10)
+	if len(departures) != 1 || departures[0].RoomID != "old" || departures[0].Reason != ReasonInactive {
+		t.Fatalf("unexpected departures: %+v", departures)
+	}
+
+	if NewSweeper(env.svc, 0, time.Hour, nil) != nil {
+		t.Fatal("expected disabled sweeper when inactive days is zero")
+	}
+}
+
+func TestAlarmHygiene_MarksFailingRoomsDormantAndReactivates(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	sendErr := errors.New("iris unavailable")
+
+	var notified []string
+	env.svc.SetAdminNotifier(func(_ context.Context, summary string, _ map[string]any) {
+		notified = append(notified, summary)
+	})
+
+	for _, roomID := range []string{"kicked", "flaky", "quiet"} {
+		if _, err := env.alarm.AddAlarm(ctx, roomID, "u1", "ch-a", "멤버", "방"+roomID, "유저"); err != nil {
+			t.Fatalf("add alarm: %v", err)
+		}
+	}
+
+	// kicked: 첫 실패 이후 계속 실패 / flaky: 실패했지만 메시지는 계속 들어옴 / quiet: 전송 기록 없음
+	env.svc.now = func() time.Time { return base }
+	_ = env.svc.RecordDelivery(ctx, "kicked", sendErr)
+	_ = env.svc.RecordDelivery(ctx, "flaky", sendErr)
+	env.svc.now = func() time.Time { return base.Add(10 * 24 * time.Hour) }
+	_ = env.svc.RecordDelivery(ctx, "kicked", sendErr)
+	if err := env.svc.Touch(ctx, "flaky", "", false); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+
+	hygiene := NewAlarmHygiene(env.svc, 7, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := base.Add(12 * 24 * time.Hour)
+	if got := hygiene.sweep(ctx, now); got != 1 {
+		t.Fatalf("expected 1 room marked dormant, got %d", got)
+	}
+	if got := hygiene.sweep(ctx, now); got != 0 {
+		t.Fatalf("expected already dormant room skipped, got %d", got)
+	}
+
+	dormant, err := env.svc.DormantRooms(ctx)
+	if err != nil {
+		t.Fatalf("dormant rooms: %v", err)
+	}
+	if len(dormant) != 1 || dormant[0].RoomID != "kicked" || !dormant[0].DormantSince.Equal(now) {
+		t.Fatalf("unexpected dormant rooms: %+v", dormant)
+	}
+	if len(notified) != 1 {
+		t.Fatalf("expected 1 admin notification, got %v", notified)
+	}
+
+	// 다시 메시지가 들어오면 자동 복귀
+	if err := env.svc.Touch(ctx, "kicked", "", false); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+	if dormant, _ := env.svc.DormantRooms(ctx); len(dormant) != 0 {
+		t.Fatalf("expected room reactivated, got %+v", dormant)
+	}
+	if len(notified) != 2 {
+		t.Fatalf("expected reactivation notification, got %v", notified)
+	}
+
+	// 전송 성공은 실패 기록을 지워 다음 검사에서 다시 휴면 처리되지 않음
+	if _, err := env.alarm.MarkRoomDormant(ctx, "kicked", now); err != nil {
+		t.Fatalf("mark dormant: %v", err)
+	}
+	if err := env.svc.RecordDelivery(ctx, "kicked", nil); err != nil {
+		t.Fatalf("record delivery: %v", err)
+	}
+	if dormant, _ := env.svc.DormantRooms(ctx); len(dormant) != 0 {
+		t.Fatalf("expected room reactivated by delivery, got %+v", dormant)
+	}
+	hygiene.sweep(ctx, now.Add(30*24*time.Hour))
+	dormant, _ = env.svc.DormantRooms(ctx)
+	for _, room := range dormant {
+		if room.RoomID == "kicked" {
+			t.Fatalf("expected kicked room to stay active after delivery success, got %+v", dormant)
+		}
+	}
+
+	if NewAlarmHygiene(env.svc, 0, time.Hour, nil) != nil {
+		t.Fatal("expected disabled hygiene job when dormant days is zero")
+	}
+}
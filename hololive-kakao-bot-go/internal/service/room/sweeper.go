@@ -0,0 +1,90 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// Sweeper: 마지막 메시지 이후 일정 기간이 지난 방을 찾아 자동으로 떠나기 처리하는 백그라운드 작업
+// 봇이 방에서 내보내지면 더 이상 메시지가 오지 않으므로, 장기 미사용을 떠난 것으로 간주한다.
+type Sweeper struct {
+	rooms       *Service
+	inactiveFor time.Duration
+	interval    time.Duration
+	logger      *slog.Logger
+}
+
+// NewSweeper: 미사용 방 정리 작업을 생성합니다. inactiveDays가 0 이하면 nil을 반환한다.
+func NewSweeper(rooms *Service, inactiveDays int, interval time.Duration, logger *slog.Logger) *Sweeper {
+	if rooms == nil || inactiveDays <= 0 {
+		return nil
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Sweeper{
+		rooms:       rooms,
+		inactiveFor: time.Duration(inactiveDays) * 24 * time.Hour,
+		interval:    interval,
+		logger:      logger.With(slog.String("service", "room_sweeper")),
+	}
+}
+
+// Start: ctx가 종료될 때까지 주기적으로 미사용 방을 정리합니다.
+func (sw *Sweeper) Start(ctx context.Context) {
+	sw.logger.Info("Starting room sweeper",
+		slog.Duration("inactive_for", sw.inactiveFor),
+		slog.Duration("interval", sw.interval),
+	)
+
+	ticker := time.NewTicker(sw.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			sw.logger.Info("Room sweeper stopped")
+			return
+		case <-ticker.C:
+			sw.sweep(ctx, sw.rooms.now())
+		}
+	}
+}
+
+// sweep: 기준 시각(now)에서 inactiveFor 이전이 마지막 메시지인 방을 모두 떠나기 처리하고, 처리한 방 수를 반환합니다.
+func (sw *Sweeper) sweep(ctx context.Context, now time.Time) int {
+	roomIDs, err := sw.rooms.InactiveRooms(ctx, now.Add(-sw.inactiveFor))
+	if err != nil {
+		sw.logger.Warn("Failed to list inactive rooms", slog.Any("error", err))
+		return 0
+	}
+
+	departed := 0
+	for _, roomID := range roomIDs {
+		if ctx.Err() != nil {
+			break
+		}
+		_, err := sw.rooms.Leave(ctx, LeaveRequest{
+			RoomID:      roomID,
+			Reason:      ReasonInactive,
+			RequestedBy: "system",
+		})
+		if errors.Is(err, ErrRoomNotFound) {
+			// 활동 해시 없이 ZSET 항목만 남은 경우: 다음 주기에 다시 잡히지 않도록 정리만 한다.
+			_ = sw.rooms.forgetActivity(ctx, roomID)
+			continue
+		}
+		if err != nil {
+			sw.logger.Warn("Failed to leave inactive room", slog.String("room_id", roomID), slog.Any("error", err))
+			continue
+		}
+		departed++
+	}
+
+	if departed > 0 {
+		sw.logger.Info("Inactive rooms departed", slog.Int("count", departed))
+	}
+	return departed
+}
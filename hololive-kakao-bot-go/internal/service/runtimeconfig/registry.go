@@ -0,0 +1,137 @@
+// Package runtimeconfig: 관리 대시보드가 재시작 없이 바꾸는 런타임 설정을 관리합니다.
+package runtimeconfig
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TypeInt: 정수 설정 타입
+const TypeInt = "int"
+
+// ErrInvalidValue: 알 수 없는 키이거나 값이 범위를 벗어남
+var ErrInvalidValue = errors.New("invalid runtime config value")
+
+// Entry: 설정 정의와 현재 적용 값 (Default는 환경 변수로 정해진 기동 시 값)
+type Entry struct {
+	Key         string   `json:"key"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Default     string   `json:"default"`
+	Value       string   `json:"value"`
+	Overridden  bool     `json:"overridden"`
+}
+
+type intSetting struct {
+	key         string
+	description string
+	def         int
+	minValue    int
+	maxValue    int
+	value       int
+	apply       func(int)
+}
+
+// Registry: 런타임 설정 모음입니다. 대시보드는 전체 값을 보내므로 빠진 키는 기본값으로 되돌립니다.
+type Registry struct {
+	mu        sync.Mutex
+	settings  map[string]*intSetting
+	order     []string
+	updatedAt time.Time
+}
+
+// NewRegistry: 빈 레지스트리를 생성합니다.
+func NewRegistry() *Registry {
+	return &Registry{settings: make(map[string]*intSetting)}
+}
+
+// Int: 정수 설정을 등록합니다. 값이 바뀔 때마다 apply가 호출됩니다.
+func (r *Registry) Int(key, description string, def, minValue, maxValue int, apply func(int)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.settings[key]; exists {
+		panic("runtimeconfig: duplicate key " + key)
+	}
+	r.settings[key] = &intSetting{
+		key:         key,
+		description: description,
+		def:         def,
+		minValue:    minValue,
+		maxValue:    maxValue,
+		value:       def,
+		apply:       apply,
+	}
+	r.order = append(r.order, key)
+}
+
+// Apply: 설정 전체를 교체합니다. 하나라도 잘못되면 아무것도 바꾸지 않고, 값이 바뀐 키 목록을 반환합니다.
+func (r *Registry) Apply(values map[string]string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next := make(map[string]int, len(values))
+	for key, raw := range values {
+		s, ok := r.settings[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidValue, key)
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || v < s.minValue || v > s.maxValue {
+			return nil, fmt.Errorf("%w: %s must be an integer between %d and %d", ErrInvalidValue, key, s.minValue, s.maxValue)
+		}
+		next[key] = v
+	}
+
+	var changed []string
+	for _, key := range r.order {
+		s := r.settings[key]
+		v, ok := next[key]
+		if !ok {
+			v = s.def
+		}
+		if v == s.value {
+			continue
+		}
+		s.value = v
+		s.apply(v)
+		changed = append(changed, key)
+	}
+	r.updatedAt = time.Now()
+	return changed, nil
+}
+
+// Entries: 등록 순서대로 설정 정의와 현재 값을 반환합니다.
+func (r *Registry) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]Entry, 0, len(r.order))
+	for _, key := range r.order {
+		s := r.settings[key]
+		minValue, maxValue := float64(s.minValue), float64(s.maxValue)
+		entries = append(entries, Entry{
+			Key:         s.key,
+			Type:        TypeInt,
+			Description: s.description,
+			Min:         &minValue,
+			Max:         &maxValue,
+			Default:     strconv.Itoa(s.def),
+			Value:       strconv.Itoa(s.value),
+			Overridden:  s.value != s.def,
+		})
+	}
+	return entries
+}
+
+// UpdatedAt: 마지막으로 설정을 적용한 시각 (적용한 적 없으면 zero)
+func (r *Registry) UpdatedAt() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.updatedAt
+}
@@ -0,0 +1,37 @@
+package runtimeconfig
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegistryApply(t *testing.T) {
+	interval := 60
+	r := NewRegistry()
+	r.Int("alarm.checkIntervalSeconds", "알람 확인 주기(초)", 60, 10, 3600, func(v int) { interval = v })
+
+	changed, err := r.Apply(map[string]string{"alarm.checkIntervalSeconds": "30"})
+	if err != nil || len(changed) != 1 || interval != 30 {
+		t.Fatalf("unexpected apply: changed=%v interval=%d err=%v", changed, interval, err)
+	}
+	if entries := r.Entries(); entries[0].Value != "30" || !entries[0].Overridden || r.UpdatedAt().IsZero() {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	for _, values := range []map[string]string{
+		{"alarm.checkIntervalSeconds": "5"},
+		{"alarm.checkIntervalSeconds": "abc"},
+		{"unknown": "1"},
+	} {
+		if _, err := r.Apply(values); !errors.Is(err, ErrInvalidValue) {
+			t.Fatalf("Apply(%v) expected invalid value, got %v", values, err)
+		}
+	}
+	if interval != 30 {
+		t.Fatalf("failed apply must not change values, got %d", interval)
+	}
+
+	if changed, err := r.Apply(nil); err != nil || len(changed) != 1 || interval != 60 {
+		t.Fatalf("expected reset to default: changed=%v interval=%d err=%v", changed, interval, err)
+	}
+}
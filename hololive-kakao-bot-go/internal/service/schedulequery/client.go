@@ -0,0 +1,108 @@
+package schedulequery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+	"github.com/kapu/hololive-kakao-bot-go/pkg/errors"
+)
+
+// intentSchedule: LLM 서버가 일정 질문으로 판단했을 때의 intent 값
+const intentSchedule = "schedule"
+
+// Parser: 자연어 질문을 해석하는 인터페이스 (ok=false면 일정 질문이 아님)
+type Parser interface {
+	Parse(ctx context.Context, text string, today time.Time) (Query, bool, error)
+}
+
+// LLMClient: mcp-llm-server-go의 /api/hololive/schedule-queries로 질문을 해석하는 클라이언트
+type LLMClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+// NewLLMClient: 새로운 일정 질문 해석 클라이언트를 생성합니다.
+func NewLLMClient(httpClient *http.Client, baseURL, apiKey string) *LLMClient {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: constants.ScheduleQueryConfig.RequestTimeout}
+	}
+	return &LLMClient{
+		httpClient: httpClient,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+	}
+}
+
+type scheduleQueryRequest struct {
+	Query string `json:"query"`
+	Today string `json:"today"`
+}
+
+type scheduleQueryResponse struct {
+	Intent    string `json:"intent"`
+	Member    string `json:"member"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// Parse: 질문과 오늘 날짜(KST)를 보내 멤버와 날짜 범위를 받습니다.
+func (c *LLMClient) Parse(ctx context.Context, text string, today time.Time) (Query, bool, error) {
+	body, err := json.Marshal(scheduleQueryRequest{Query: text, Today: today.Format(dateLayout)})
+	if err != nil {
+		return Query{}, false, fmt.Errorf("marshal schedule query request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/hololive/schedule-queries", bytes.NewReader(body))
+	if err != nil {
+		return Query{}, false, fmt.Errorf("create schedule query request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("X-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return Query{}, false, fmt.Errorf("schedule query request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 16*1024))
+	if err != nil {
+		return Query{}, false, fmt.Errorf("read schedule query response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Query{}, false, errors.NewAPIError("LLM schedule query request failed", resp.StatusCode, map[string]any{
+			"body": util.TruncateString(string(payload), 200),
+		})
+	}
+
+	var decoded scheduleQueryResponse
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return Query{}, false, fmt.Errorf("unmarshal schedule query response: %w", err)
+	}
+	member := util.TrimSpace(decoded.Member)
+	if decoded.Intent != intentSchedule || member == "" {
+		return Query{}, false, nil
+	}
+
+	start, err := time.ParseInLocation(dateLayout, decoded.StartDate, today.Location())
+	if err != nil {
+		return Query{}, false, fmt.Errorf("invalid start_date %q: %w", decoded.StartDate, err)
+	}
+	end, err := time.ParseInLocation(dateLayout, decoded.EndDate, today.Location())
+	if err != nil {
+		return Query{}, false, fmt.Errorf("invalid end_date %q: %w", decoded.EndDate, err)
+	}
+	return newQuery(member, start, end, today), true, nil
+}
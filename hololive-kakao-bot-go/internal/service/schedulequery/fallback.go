@@ -0,0 +1,167 @@
+package schedulequery
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+)
+
+// scheduleKeywords: 일정 질문으로 판단하는 단어 (멤버 이름 후보에서도 제외)
+var scheduleKeywords = []string{"언제", "방송", "뱅송", "일정", "스케줄", "스케쥴"}
+
+// fillerWords: 멤버 이름 후보에서 제외하는 서술어/부사
+var fillerWords = map[string]struct{}{
+	"해": {}, "하냐": {}, "하니": {}, "해요": {}, "함": {}, "있어": {}, "있냐": {}, "있나": {}, "있어요": {},
+	"뭐야": {}, "알려줘": {}, "좀": {}, "혹시": {}, "쯤": {}, "몇시": {}, "몇시에": {},
+}
+
+// trailingParticles: 멤버 이름 뒤에 붙는 조사 (긴 것부터 검사)
+// "이"는 "무메이"처럼 이름 끝 글자와 겹치는 경우가 많아 제외
+var trailingParticles = []string{"님은", "님이", "님", "은", "는", "가", "의"}
+
+// dateParticles: 날짜 표현 뒤에 붙는 조사 ("이번주에", "내일은")
+var dateParticles = []string{"에는", "에", "은", "는"}
+
+// FallbackParser: LLM 서버 없이 규칙으로 질문을 해석합니다.
+// 오늘/내일/모레/이번주/다음주/주말/N일간 표현과 첫 번째 이름 후보 단어만 인식합니다.
+type FallbackParser struct{}
+
+// Parse: 일정 키워드와 멤버 후보가 모두 있을 때만 ok=true를 반환합니다.
+func (FallbackParser) Parse(_ context.Context, text string, today time.Time) (Query, bool, error) {
+	if !LooksLikeScheduleQuestion(text) {
+		return Query{}, false, nil
+	}
+	today = startOfDay(today)
+	start := today
+	end := today.AddDate(0, 0, constants.ScheduleQueryConfig.DefaultDays-1)
+
+	member := ""
+	for _, token := range tokenize(text) {
+		if s, e, ok := parseDateToken(token, today); ok {
+			start, end = s, e
+			continue
+		}
+		if member == "" && isMemberCandidate(token) {
+			member = trimParticle(token)
+		}
+	}
+	if member == "" {
+		return Query{}, false, nil
+	}
+	return newQuery(member, start, end, today), true, nil
+}
+
+// LooksLikeScheduleQuestion: 일정 관련 단어가 들어 있는지 확인합니다. (명령어 파싱 단계의 1차 판별)
+func LooksLikeScheduleQuestion(text string) bool {
+	for _, keyword := range scheduleKeywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDateToken: 날짜 표현 단어를 포함 범위로 바꿉니다.
+func parseDateToken(token string, today time.Time) (time.Time, time.Time, bool) {
+	for _, particle := range dateParticles {
+		if trimmed, ok := strings.CutSuffix(token, particle); ok && trimmed != "" {
+			if start, end, ok := parseDateToken(trimmed, today); ok {
+				return start, end, true
+			}
+		}
+	}
+
+	switch token {
+	case "오늘":
+		return today, today, true
+	case "내일":
+		return today.AddDate(0, 0, 1), today.AddDate(0, 0, 1), true
+	case "모레":
+		return today.AddDate(0, 0, 2), today.AddDate(0, 0, 2), true
+	case "이번주", "금주":
+		return today, endOfWeek(today), true
+	case "다음주", "담주":
+		nextMonday := endOfWeek(today).AddDate(0, 0, 1)
+		return nextMonday, nextMonday.AddDate(0, 0, 6), true
+	case "주말", "이번주말":
+		sunday := endOfWeek(today)
+		saturday := sunday.AddDate(0, 0, -1)
+		if today.After(saturday) {
+			saturday = today
+		}
+		return saturday, sunday, true
+	case "다음주말":
+		nextSunday := endOfWeek(today).AddDate(0, 0, 7)
+		return nextSunday.AddDate(0, 0, -1), nextSunday, true
+	}
+
+	for _, suffix := range []string{"일간", "일동안"} {
+		if digits, ok := strings.CutSuffix(token, suffix); ok {
+			if days, err := strconv.Atoi(digits); err == nil && days > 0 {
+				return today, today.AddDate(0, 0, days-1), true
+			}
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// endOfWeek: 월요일 시작 기준으로 이번 주 일요일을 반환합니다.
+func endOfWeek(today time.Time) time.Time {
+	offset := (7 - int(today.Weekday())) % 7
+	return today.AddDate(0, 0, offset)
+}
+
+func isMemberCandidate(token string) bool {
+	if token == "" {
+		return false
+	}
+	if _, filler := fillerWords[token]; filler {
+		return false
+	}
+	for _, keyword := range scheduleKeywords {
+		if strings.Contains(token, keyword) {
+			return false
+		}
+	}
+	return true
+}
+
+func trimParticle(token string) string {
+	runes := []rune(token)
+	for _, particle := range trailingParticles {
+		// 두 글자 이름("스이" 등)이 조사로 잘리지 않도록 세 글자 이상일 때만 제거
+		if len(runes)-len([]rune(particle)) >= 2 && strings.HasSuffix(token, particle) {
+			return strings.TrimSuffix(token, particle)
+		}
+	}
+	return token
+}
+
+// tokenize: 공백으로 나누고 문장부호를 제거합니다. "이번 주"/"다음 주말"은 한 단어로 합칩니다.
+func tokenize(text string) []string {
+	fields := strings.Fields(text)
+	tokens := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		token := cleanToken(fields[i])
+		if (token == "이번" || token == "다음") && i+1 < len(fields) {
+			if next := cleanToken(fields[i+1]); strings.HasPrefix(next, "주") {
+				token += next
+				i++
+			}
+		}
+		if token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+func cleanToken(field string) string {
+	return strings.ToLower(strings.TrimFunc(field, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
@@ -0,0 +1,69 @@
+package schedulequery
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFallbackParser(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	today := time.Date(2026, 3, 4, 21, 0, 0, 0, kst) // 수요일
+	day := func(d int) string { return time.Date(2026, 3, d, 0, 0, 0, 0, kst).Format(dateLayout) }
+
+	cases := []struct {
+		text   string
+		ok     bool
+		member string
+		start  string
+		end    string
+	}{
+		{text: "페코라 이번주 언제 방송해?", ok: true, member: "페코라", start: day(4), end: day(8)},
+		{text: "페코라는 이번 주에 방송 있어?", ok: true, member: "페코라", start: day(4), end: day(8)},
+		{text: "마린 내일 방송 있냐", ok: true, member: "마린", start: day(5), end: day(5)},
+		{text: "다음주 스이세이 일정", ok: true, member: "스이세이", start: day(9), end: day(15)},
+		{text: "무메이 주말에 방송해?", ok: true, member: "무메이", start: day(7), end: day(8)},
+		{text: "미코 3일간 스케줄", ok: true, member: "미코", start: day(4), end: day(6)},
+		{text: "구라 언제 방송함", ok: true, member: "구라", start: day(4), end: day(10)},
+		{text: "오늘 방송 언제야?", ok: false},
+		{text: "페코라 귀여워", ok: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.text, func(t *testing.T) {
+			query, ok, err := FallbackParser{}.Parse(context.Background(), tc.text, today)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != tc.ok {
+				t.Fatalf("ok = %v, want %v (%+v)", ok, tc.ok, query)
+			}
+			if !ok {
+				return
+			}
+			if query.Member != tc.member || query.Start.Format(dateLayout) != tc.start || query.End.Format(dateLayout) != tc.end {
+				t.Fatalf("got %s %s~%s, want %s %s~%s",
+					query.Member, query.Start.Format(dateLayout), query.End.Format(dateLayout), tc.member, tc.start, tc.end)
+			}
+		})
+	}
+}
+
+func TestQueryDaysAndContains(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	today := time.Date(2026, 3, 4, 0, 0, 0, 0, kst)
+	query := newQuery("페코라", today.AddDate(0, 0, 5), today.AddDate(0, 0, 11), today)
+
+	if days := query.Days(today); days != 12 {
+		t.Fatalf("expected 12 days, got %d", days)
+	}
+	if query.Contains(today.AddDate(0, 0, 4).Add(23 * time.Hour)) {
+		t.Fatalf("time before start must not be contained")
+	}
+	if !query.Contains(today.AddDate(0, 0, 11).Add(23 * time.Hour)) {
+		t.Fatalf("last day must be contained")
+	}
+	if query.Contains(today.AddDate(0, 0, 12)) {
+		t.Fatalf("day after end must not be contained")
+	}
+}
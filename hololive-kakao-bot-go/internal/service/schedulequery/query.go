@@ -0,0 +1,52 @@
+// Package schedulequery: "페코라 이번주 언제 방송해?" 같은 자연어 일정 질문을 멤버와 날짜 범위로 해석합니다.
+package schedulequery
+
+import (
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+)
+
+// dateLayout: LLM 서버와 주고받는 날짜 형식
+const dateLayout = "2006-01-02"
+
+// Query: 해석된 일정 질문. Start와 End는 KST 자정 기준의 포함 범위입니다.
+type Query struct {
+	Member string
+	Start  time.Time
+	End    time.Time
+}
+
+// Days: today부터 End까지 포함한 일수 (조회 범위와 "N일 이내" 안내에 사용)
+func (q Query) Days(today time.Time) int {
+	days := int(q.End.Sub(startOfDay(today)).Hours()/24) + 1
+	return min(max(days, 1), constants.ScheduleQueryConfig.MaxSpanDays)
+}
+
+// Contains: 방송 예정 시각이 질문한 날짜 범위 안에 있는지 확인합니다.
+func (q Query) Contains(t time.Time) bool {
+	t = t.In(q.Start.Location())
+	return !t.Before(q.Start) && t.Before(q.End.AddDate(0, 0, 1))
+}
+
+// newQuery: 범위를 오늘 이후, 최대 기간 이내로 정리한 Query를 만듭니다.
+func newQuery(member string, start, end, today time.Time) Query {
+	today = startOfDay(today)
+	start = startOfDay(start)
+	end = startOfDay(end)
+	if start.Before(today) {
+		start = today
+	}
+	if end.Before(start) {
+		end = start
+	}
+	if limit := start.AddDate(0, 0, constants.ScheduleQueryConfig.MaxSpanDays-1); end.After(limit) {
+		end = limit
+	}
+	return Query{Member: member, Start: start, End: end}
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
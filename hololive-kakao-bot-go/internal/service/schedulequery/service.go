@@ -0,0 +1,51 @@
+package schedulequery
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+// Service: LLM 서버로 질문을 해석하고, 서버가 없거나 실패하면 규칙 기반 해석으로 대신합니다.
+type Service struct {
+	llm      Parser // nil이면 규칙 기반 해석만 사용
+	fallback Parser
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewService: 새로운 일정 질문 해석 서비스를 생성합니다.
+func NewService(llm Parser, logger *slog.Logger) *Service {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		llm:      llm,
+		fallback: FallbackParser{},
+		logger:   logger,
+		now:      util.NowKST,
+	}
+}
+
+// Parse: 질문을 멤버와 날짜 범위로 해석합니다. 일정 질문이 아니면 ok=false입니다.
+// LLM 서버가 일정 질문이 아니라고 답한 경우에는 규칙 기반 해석을 다시 시도하지 않습니다.
+func (s *Service) Parse(ctx context.Context, text string) (Query, bool) {
+	today := startOfDay(s.now())
+	if s.llm != nil {
+		query, ok, err := s.llm.Parse(ctx, text, today)
+		if err == nil {
+			return query, ok
+		}
+		s.logger.Warn("Schedule query LLM parse failed; using rule-based fallback", slog.Any("error", err))
+	}
+
+	query, ok, _ := s.fallback.Parse(ctx, text, today)
+	return query, ok
+}
+
+// Today: 해석 기준 날짜(KST 자정)를 반환합니다.
+func (s *Service) Today() time.Time {
+	return startOfDay(s.now())
+}
@@ -0,0 +1,60 @@
+package schedulequery
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestService(t *testing.T, handler http.HandlerFunc) *Service {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	svc := NewService(NewLLMClient(server.Client(), server.URL, "secret"), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	svc.now = func() time.Time { return time.Date(2026, 3, 4, 21, 0, 0, 0, time.FixedZone("KST", 9*60*60)) }
+	return svc
+}
+
+func TestService_ParseUsesLLM(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/hololive/schedule-queries" || r.Header.Get("X-API-Key") != "secret" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, `{"intent":"schedule","member":"pekora","start_date":"2026-03-06","end_date":"2026-03-07"}`)
+	})
+
+	query, ok := svc.Parse(context.Background(), "페코라 금요일이랑 토요일 방송해?")
+	if !ok {
+		t.Fatalf("expected schedule query")
+	}
+	if query.Member != "pekora" || query.Start.Format(dateLayout) != "2026-03-06" || query.End.Format(dateLayout) != "2026-03-07" {
+		t.Fatalf("unexpected query: %+v", query)
+	}
+}
+
+func TestService_ParseFallsBackOnLLMFailure(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	query, ok := svc.Parse(context.Background(), "페코라 내일 방송해?")
+	if !ok || query.Member != "페코라" || query.Start.Format(dateLayout) != "2026-03-05" {
+		t.Fatalf("expected rule-based fallback, got ok=%v %+v", ok, query)
+	}
+}
+
+func TestService_ParseTrustsLLMUnknown(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"intent":"unknown","member":"","start_date":"","end_date":""}`)
+	})
+
+	if _, ok := svc.Parse(context.Background(), "페코라 방송 재밌었다"); ok {
+		t.Fatalf("LLM unknown must not fall back to rule-based parsing")
+	}
+}
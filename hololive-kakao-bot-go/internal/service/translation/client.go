@@ -0,0 +1,111 @@
+package translation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+	"github.com/kapu/hololive-kakao-bot-go/pkg/errors"
+)
+
+// titlePrompt: mcp-llm-server-go /api/llm/chat은 system_prompt를 받지 않으므로 지시문을 본문에 포함한다.
+const titlePrompt = `다음은 홀로라이브 VTuber의 방송 제목입니다. 자연스러운 한국어로 번역하세요.
+- 멤버 이름과 게임 제목은 한국 팬들이 흔히 쓰는 표기를 사용합니다.
+- 이모지, 기호, 해시태그, 【】 같은 괄호는 그대로 둡니다.
+- 설명 없이 번역문 한 줄만 출력합니다.
+
+제목: %s`
+
+// maxTranslatedRunes: 비정상적으로 긴 응답(설명 포함 등)을 잘라내는 상한
+const maxTranslatedRunes = 200
+
+// Translator: 방송 제목 번역기 인터페이스
+type Translator interface {
+	TranslateTitle(ctx context.Context, title string) (string, error)
+}
+
+// LLMClient: mcp-llm-server-go의 채팅 API로 제목을 번역하는 클라이언트
+type LLMClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+// NewLLMClient: 새로운 LLM 번역 클라이언트를 생성합니다.
+func NewLLMClient(httpClient *http.Client, baseURL, apiKey string) *LLMClient {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: constants.TitleTranslationConfig.RequestTimeout}
+	}
+	return &LLMClient{
+		httpClient: httpClient,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+	}
+}
+
+type chatRequest struct {
+	Prompt string `json:"prompt"`
+	Task   string `json:"task"`
+}
+
+type chatResponse struct {
+	Response string `json:"response"`
+}
+
+// TranslateTitle: 제목 하나를 한국어로 번역합니다.
+func (c *LLMClient) TranslateTitle(ctx context.Context, title string) (string, error) {
+	body, err := json.Marshal(chatRequest{
+		Prompt: fmt.Sprintf(titlePrompt, title),
+		Task:   constants.TitleTranslationConfig.Task,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal translation request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/llm/chat", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("create translation request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("X-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("translation request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", fmt.Errorf("read translation response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", errors.NewAPIError("LLM translation request failed", resp.StatusCode, map[string]any{
+			"body": util.TruncateString(string(payload), 200),
+		})
+	}
+
+	var decoded chatResponse
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return "", fmt.Errorf("unmarshal translation response: %w", err)
+	}
+	return cleanTranslation(decoded.Response), nil
+}
+
+// cleanTranslation: 응답에서 첫 줄만 남기고 감싼 따옴표를 제거합니다.
+func cleanTranslation(raw string) string {
+	line, _, _ := strings.Cut(util.TrimSpace(raw), "\n")
+	line = util.TrimSpace(line)
+	line = strings.TrimPrefix(line, "제목:")
+	line = strings.Trim(util.TrimSpace(line), "\"'“”")
+	return util.TruncateString(util.TrimSpace(line), maxTranslatedRunes)
+}
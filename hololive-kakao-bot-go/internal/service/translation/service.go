@@ -0,0 +1,240 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+	"unicode"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/util"
+)
+
+const (
+	cacheKeyTitle  = "hololive:title:ko:%s"        // 영상 ID별 번역 결과
+	cacheKeyBudget = "hololive:title:ko:budget:%s" // KST 날짜별 LLM 호출 수
+	cacheKeyRooms  = "hololive:title:ko:rooms"     // 번역을 켠 방 목록
+)
+
+// cachedTitle: 영상 ID별 번역 캐시 항목
+type cachedTitle struct {
+	Title  string `json:"title"`  // 번역 당시 원문 (제목이 바뀌면 다시 번역)
+	Korean string `json:"korean"` // 비어 있으면 실패했거나 번역할 필요가 없는 제목
+}
+
+// Service: 방송 제목 한국어 번역 서비스
+// 방별로 켜고 끌 수 있으며, 결과는 영상 ID 단위로 캐시하고 하루 호출 수를 제한한다.
+// 번역이 실패하거나 시간 안에 끝나지 않으면 번역 없이 원문만 표시된다.
+type Service struct {
+	translator Translator
+	cache      *cache.Service
+	dailyLimit int
+	logger     *slog.Logger
+
+	group singleflight.Group
+	now   func() time.Time
+}
+
+// NewService: 번역 서비스를 생성합니다.
+func NewService(translator Translator, cacheSvc *cache.Service, dailyLimit int, logger *slog.Logger) *Service {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		translator: translator,
+		cache:      cacheSvc,
+		dailyLimit: dailyLimit,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// RoomEnabled: 방에 제목 번역이 켜져 있는지 확인합니다. 조회 실패 시 꺼진 것으로 본다.
+func (s *Service) RoomEnabled(ctx context.Context, room string) bool {
+	if s == nil || util.TrimSpace(room) == "" {
+		return false
+	}
+	enabled, err := s.cache.SIsMember(ctx, cacheKeyRooms, room)
+	return err == nil && enabled
+}
+
+// EnableRoom: 방에 제목 번역을 켭니다.
+func (s *Service) EnableRoom(ctx context.Context, room string) (bool, error) {
+	added, err := s.cache.SAdd(ctx, cacheKeyRooms, []string{room})
+	if err != nil {
+		return false, fmt.Errorf("enable title translation: %w", err)
+	}
+	return added > 0, nil
+}
+
+// DisableRoom: 방의 제목 번역을 끕니다.
+func (s *Service) DisableRoom(ctx context.Context, room string) (bool, error) {
+	removed, err := s.cache.SRem(ctx, cacheKeyRooms, []string{room})
+	if err != nil {
+		return false, fmt.Errorf("disable title translation: %w", err)
+	}
+	return removed > 0, nil
+}
+
+// Rooms: 제목 번역이 켜진 방 목록을 반환합니다.
+func (s *Service) Rooms(ctx context.Context) ([]string, error) {
+	rooms, err := s.cache.SMembers(ctx, cacheKeyRooms)
+	if err != nil {
+		return nil, fmt.Errorf("list title translation rooms: %w", err)
+	}
+	return rooms, nil
+}
+
+// Glosses: 방에 번역이 켜져 있으면 방송 ID → 한국어 제목 맵을 반환합니다.
+// 캐시에 없는 제목은 메시지당 최대 개수까지 새로 번역하며, 시간 예산을 넘기면 그때까지의 결과만 반환한다.
+func (s *Service) Glosses(ctx context.Context, room string, streams []*domain.Stream) map[string]string {
+	if s == nil || len(streams) == 0 || !s.RoomEnabled(ctx, room) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constants.TitleTranslationConfig.MessageBudget)
+	defer cancel()
+
+	titles := make(map[string]string, len(streams))
+	for _, stream := range streams {
+		if stream == nil || stream.ID == "" {
+			continue
+		}
+		if title := util.TrimSpace(stream.Title); needsTranslation(title) {
+			titles[stream.ID] = title
+		}
+	}
+	if len(titles) == 0 {
+		return nil
+	}
+
+	glosses := make(map[string]string, len(titles))
+	var pending []string
+	for id, title := range titles {
+		var entry cachedTitle
+		if err := s.cache.Get(ctx, fmt.Sprintf(cacheKeyTitle, id), &entry); err == nil && entry.Title == title {
+			if entry.Korean != "" {
+				glosses[id] = entry.Korean
+			}
+			continue
+		}
+		pending = append(pending, id)
+	}
+
+	if len(pending) > constants.TitleTranslationConfig.MaxPerMessage {
+		pending = pending[:constants.TitleTranslationConfig.MaxPerMessage]
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, id := range pending {
+		wg.Go(func() {
+			korean := s.translate(ctx, id, titles[id])
+			if korean == "" {
+				return
+			}
+			mu.Lock()
+			glosses[id] = korean
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return glosses
+}
+
+// translate: 같은 영상의 동시 번역은 한 번만 호출한다.
+// 호출 자체는 메시지 시간 예산과 분리해 끝까지 진행하므로, 늦게 끝난 결과도 다음 메시지부터 캐시로 쓰인다.
+func (s *Service) translate(ctx context.Context, id, title string) string {
+	ch := s.group.DoChan(id+"\x00"+title, func() (any, error) {
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), constants.TitleTranslationConfig.RequestTimeout)
+		defer cancel()
+		return s.translateAndCache(callCtx, id, title), nil
+	})
+
+	select {
+	case result := <-ch:
+		korean, _ := result.Val.(string)
+		return korean
+	case <-ctx.Done():
+		return ""
+	}
+}
+
+func (s *Service) translateAndCache(ctx context.Context, id, title string) string {
+	if !s.reserveBudget(ctx) {
+		return ""
+	}
+
+	key := fmt.Sprintf(cacheKeyTitle, id)
+	korean, err := s.translator.TranslateTitle(ctx, title)
+	if err != nil || korean == "" {
+		s.logger.Warn("Title translation failed",
+			slog.String("stream_id", id),
+			slog.Any("error", err),
+		)
+		// 같은 제목을 계속 재시도하지 않도록 짧게 실패를 기록
+		_ = s.cache.Set(ctx, key, cachedTitle{Title: title}, constants.TitleTranslationConfig.FailureCacheTTL)
+		return ""
+	}
+
+	if korean == title {
+		korean = ""
+	}
+	_ = s.cache.Set(ctx, key, cachedTitle{Title: title, Korean: korean}, constants.TitleTranslationConfig.CacheTTL)
+	return korean
+}
+
+// reserveBudget: 오늘(KST) 호출 수를 하나 늘리고 한도 안인지 확인합니다.
+func (s *Service) reserveBudget(ctx context.Context) bool {
+	if s.dailyLimit <= 0 {
+		return true
+	}
+
+	key := fmt.Sprintf(cacheKeyBudget, util.FormatKST(s.now(), "20060102"))
+	count, err := incrWithTTL(ctx, s.cache, key, 48*time.Hour)
+	if err != nil {
+		s.logger.Warn("Title translation budget check failed", slog.Any("error", err))
+		return false
+	}
+	if count > int64(s.dailyLimit) {
+		if count == int64(s.dailyLimit)+1 {
+			s.logger.Warn("Title translation daily limit reached", slog.Int("limit", s.dailyLimit))
+		}
+		return false
+	}
+	return true
+}
+
+func incrWithTTL(ctx context.Context, cacheSvc *cache.Service, key string, ttl time.Duration) (int64, error) {
+	client := cacheSvc.GetClient()
+	resp := client.Do(ctx, client.B().Incr().Key(key).Build())
+	if resp.Error() != nil {
+		return 0, resp.Error()
+	}
+	count, err := resp.AsInt64()
+	if err != nil {
+		return 0, err
+	}
+	// 최초 생성 시에만 TTL 부여
+	if count == 1 && ttl > 0 {
+		_ = cacheSvc.Expire(ctx, key, ttl)
+	}
+	return count, nil
+}
+
+// needsTranslation: 가나/한자가 포함된 제목만 번역 대상으로 본다. (영어/한국어 제목은 건너뜀)
+func needsTranslation(title string) bool {
+	for _, r := range title {
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Han) {
+			return true
+		}
+	}
+	return false
+}
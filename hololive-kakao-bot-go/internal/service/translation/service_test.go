@@ -0,0 +1,176 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+)
+
+type fakeTranslator struct {
+	mu     sync.Mutex
+	calls  map[string]int
+	result string
+	err    error
+}
+
+func (f *fakeTranslator) TranslateTitle(_ context.Context, title string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.calls == nil {
+		f.calls = make(map[string]int)
+	}
+	f.calls[title]++
+	return f.result, f.err
+}
+
+func (f *fakeTranslator) total() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, c := range f.calls {
+		n += c
+	}
+	return n
+}
+
+func newTestService(t *testing.T, translator Translator, dailyLimit int) *Service {
+	t.Helper()
+
+	mini := miniredis.RunT(t)
+	host, portStr, err := net.SplitHostPort(mini.Addr())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cacheSvc, err := cache.NewCacheService(cache.Config{Host: host, Port: port, DisableCache: true}, logger)
+	if err != nil {
+		t.Fatalf("failed to create cache service: %v", err)
+	}
+	t.Cleanup(func() { _ = cacheSvc.Close() })
+
+	return NewService(translator, cacheSvc, dailyLimit, logger)
+}
+
+func TestGlossesRequiresEnabledRoom(t *testing.T) {
+	translator := &fakeTranslator{result: "새 의상 공개"}
+	svc := newTestService(t, translator, 0)
+	ctx := context.Background()
+	streams := []*domain.Stream{{ID: "v1", Title: "新衣装お披露目"}}
+
+	if glosses := svc.Glosses(ctx, "room1", streams); glosses != nil {
+		t.Fatalf("expected no glosses for disabled room, got %v", glosses)
+	}
+	if translator.total() != 0 {
+		t.Fatalf("disabled room must not call translator")
+	}
+
+	if _, err := svc.EnableRoom(ctx, "room1"); err != nil {
+		t.Fatalf("enable room: %v", err)
+	}
+	glosses := svc.Glosses(ctx, "room1", streams)
+	if glosses["v1"] != "새 의상 공개" {
+		t.Fatalf("unexpected glosses: %v", glosses)
+	}
+
+	// 같은 제목은 캐시에서 가져와야 함
+	svc.Glosses(ctx, "room1", streams)
+	if translator.total() != 1 {
+		t.Fatalf("expected cached translation, got %d calls", translator.total())
+	}
+
+	// 제목이 바뀌면 다시 번역
+	svc.Glosses(ctx, "room1", []*domain.Stream{{ID: "v1", Title: "新衣装お披露目！！"}})
+	if translator.total() != 2 {
+		t.Fatalf("expected retranslation after title change, got %d calls", translator.total())
+	}
+
+	if _, err := svc.DisableRoom(ctx, "room1"); err != nil {
+		t.Fatalf("disable room: %v", err)
+	}
+	if svc.RoomEnabled(ctx, "room1") {
+		t.Fatalf("expected room disabled")
+	}
+}
+
+func TestGlossesSkipsNonJapaneseAndCachesFailures(t *testing.T) {
+	translator := &fakeTranslator{err: errors.New("upstream down")}
+	svc := newTestService(t, translator, 0)
+	ctx := context.Background()
+	if _, err := svc.EnableRoom(ctx, "room1"); err != nil {
+		t.Fatalf("enable room: %v", err)
+	}
+
+	streams := []*domain.Stream{
+		{ID: "en", Title: "MINECRAFT with friends"},
+		{ID: "ko", Title: "한국어 방송"},
+		{ID: "jp", Title: "【雑談】おはよう"},
+	}
+	if glosses := svc.Glosses(ctx, "room1", streams); len(glosses) != 0 {
+		t.Fatalf("expected original titles on failure, got %v", glosses)
+	}
+	svc.Glosses(ctx, "room1", streams)
+	if translator.total() != 1 {
+		t.Fatalf("expected one call for the japanese title only, got %v", translator.calls)
+	}
+}
+
+func TestGlossesDailyLimit(t *testing.T) {
+	translator := &fakeTranslator{result: "번역"}
+	svc := newTestService(t, translator, 1)
+	ctx := context.Background()
+	if _, err := svc.EnableRoom(ctx, "room1"); err != nil {
+		t.Fatalf("enable room: %v", err)
+	}
+
+	glosses := svc.Glosses(ctx, "room1", []*domain.Stream{
+		{ID: "a", Title: "歌枠"},
+		{ID: "b", Title: "雑談"},
+	})
+	if len(glosses) != 1 || translator.total() != 1 {
+		t.Fatalf("expected a single translation within the daily limit, got %v (%d calls)", glosses, translator.total())
+	}
+}
+
+func TestLLMClientTranslateTitle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/llm/chat" || r.Header.Get("X-API-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req chatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Task != "title_translation" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(chatResponse{Response: "\"【잡담】 좋은 아침\"\n(설명)"})
+	}))
+	defer srv.Close()
+
+	client := NewLLMClient(srv.Client(), srv.URL+"/", "secret")
+	got, err := client.TranslateTitle(context.Background(), "【雑談】おはよう")
+	if err != nil {
+		t.Fatalf("translate: %v", err)
+	}
+	if got != "【잡담】 좋은 아침" {
+		t.Fatalf("unexpected translation: %q", got)
+	}
+
+	client = NewLLMClient(srv.Client(), srv.URL, "wrong")
+	if _, err := client.TranslateTitle(context.Background(), "x"); err == nil {
+		t.Fatalf("expected error for rejected request")
+	}
+}
@@ -0,0 +1,274 @@
+package twitch
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/pkg/errors"
+)
+
+// UserRaw: Helix /users 응답의 사용자 정보
+type UserRaw struct {
+	ID              string `json:"id"`
+	Login           string `json:"login"`
+	DisplayName     string `json:"display_name"`
+	ProfileImageURL string `json:"profile_image_url"`
+}
+
+// StreamRaw: Helix /streams 응답의 라이브 방송 정보
+type StreamRaw struct {
+	ID           string `json:"id"`
+	UserID       string `json:"user_id"`
+	UserLogin    string `json:"user_login"`
+	UserName     string `json:"user_name"`
+	GameName     string `json:"game_name"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	StartedAt    string `json:"started_at"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// ScheduleSegmentRaw: Helix /schedule 응답의 방송 일정 항목
+type ScheduleSegmentRaw struct {
+	ID            string  `json:"id"`
+	StartTime     string  `json:"start_time"`
+	EndTime       *string `json:"end_time"`
+	Title         string  `json:"title"`
+	CanceledUntil *string `json:"canceled_until"`
+	Category      *struct {
+		Name string `json:"name"`
+	} `json:"category"`
+}
+
+// ScheduleRaw: Helix /schedule 응답 본문
+type ScheduleRaw struct {
+	Segments         []ScheduleSegmentRaw `json:"segments"`
+	BroadcasterID    string               `json:"broadcaster_id"`
+	BroadcasterName  string               `json:"broadcaster_name"`
+	BroadcasterLogin string               `json:"broadcaster_login"`
+}
+
+// Client: Twitch Helix API 클라이언트
+// 앱 액세스 토큰(client credentials)을 발급받아 재사용하고, 401 응답 시 한 번 재발급 후 재시도한다.
+type Client struct {
+	httpClient   *http.Client
+	clientID     string
+	clientSecret string
+	helixBaseURL string
+	tokenURL     string
+	logger       *slog.Logger
+
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewTwitchClient: 새로운 Twitch Helix API 클라이언트를 생성합니다.
+func NewTwitchClient(httpClient *http.Client, clientID, clientSecret string, logger *slog.Logger) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: constants.TwitchAPIConfig.Timeout}
+	}
+	return &Client{
+		httpClient:   httpClient,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		helixBaseURL: constants.TwitchAPIConfig.HelixBaseURL,
+		tokenURL:     constants.TwitchAPIConfig.TokenURL,
+		logger:       logger,
+	}
+}
+
+// GetUsers: 로그인 이름 목록으로 사용자 정보를 조회합니다. (최대 100개)
+func (c *Client) GetUsers(ctx context.Context, logins []string) ([]UserRaw, error) {
+	params := url.Values{}
+	for _, login := range logins {
+		params.Add("login", login)
+	}
+
+	var resp struct {
+		Data []UserRaw `json:"data"`
+	}
+	if err := c.get(ctx, "/users", params, &resp); err != nil {
+		return nil, fmt.Errorf("get twitch users: %w", err)
+	}
+	return resp.Data, nil
+}
+
+// GetStreams: 로그인 이름 목록 중 현재 방송 중인 채널의 방송 정보를 조회합니다. (최대 100개)
+func (c *Client) GetStreams(ctx context.Context, logins []string) ([]StreamRaw, error) {
+	params := url.Values{}
+	for _, login := range logins {
+		params.Add("user_login", login)
+	}
+	params.Set("type", "live")
+	params.Set("first", "100")
+
+	var resp struct {
+		Data []StreamRaw `json:"data"`
+	}
+	if err := c.get(ctx, "/streams", params, &resp); err != nil {
+		return nil, fmt.Errorf("get twitch streams: %w", err)
+	}
+	return resp.Data, nil
+}
+
+// GetSchedule: 방송인의 일정 중 startTime 이후 항목을 조회합니다.
+// 일정을 등록하지 않은 채널은 Helix가 404를 반환하므로 빈 일정으로 처리한다.
+func (c *Client) GetSchedule(ctx context.Context, broadcasterID string, startTime time.Time) (*ScheduleRaw, error) {
+	params := url.Values{}
+	params.Set("broadcaster_id", broadcasterID)
+	params.Set("start_time", startTime.UTC().Format(time.RFC3339))
+	params.Set("first", "25")
+
+	var resp struct {
+		Data ScheduleRaw `json:"data"`
+	}
+	if err := c.get(ctx, "/schedule", params, &resp); err != nil {
+		apiErr := &errors.APIError{}
+		if stdErrors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return &ScheduleRaw{BroadcasterID: broadcasterID}, nil
+		}
+		return nil, fmt.Errorf("get twitch schedule: %w", err)
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, params url.Values, dest any) error {
+	for attempt := 0; attempt < 2; attempt++ {
+		token, err := c.accessToken(ctx)
+		if err != nil {
+			return err
+		}
+
+		status, body, err := c.doGet(ctx, path, params, token)
+		if err != nil {
+			return err
+		}
+
+		if status == http.StatusUnauthorized && attempt == 0 {
+			c.logger.Warn("Twitch token rejected, refreshing", slog.String("path", path))
+			c.invalidateToken()
+			continue
+		}
+
+		if status < 200 || status >= 300 {
+			return errors.NewAPIError("Twitch API request failed", status, map[string]any{
+				"path": path,
+				"body": truncateBody(body),
+			})
+		}
+
+		if err := json.Unmarshal(body, dest); err != nil {
+			return fmt.Errorf("failed to unmarshal twitch response (%s): %w", path, err)
+		}
+		return nil
+	}
+
+	return errors.NewAPIError("Twitch API unauthorized", http.StatusUnauthorized, map[string]any{"path": path})
+}
+
+func (c *Client) doGet(ctx context.Context, path string, params url.Values, token string) (int, []byte, error) {
+	reqURL := c.helixBaseURL + path
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("create twitch request: %w", err)
+	}
+	req.Header.Set("Client-Id", c.clientID)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("twitch request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("read twitch response: %w", err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// accessToken: 유효한 앱 액세스 토큰을 반환합니다. 만료가 가까우면 새로 발급한다.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != "" && time.Until(c.tokenExpiry) > constants.TwitchAPIConfig.TokenRefresh {
+		return c.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+	form.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("create twitch token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("twitch token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read twitch token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.NewAPIError("Twitch token request failed", resp.StatusCode, map[string]any{
+			"body": truncateBody(body),
+		})
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("failed to unmarshal twitch token: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("twitch token response missing access_token")
+	}
+
+	c.token = tokenResp.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	c.logger.Debug("Twitch app access token issued", slog.Time("expires_at", c.tokenExpiry))
+
+	return c.token, nil
+}
+
+func (c *Client) invalidateToken() {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.token = ""
+	c.tokenExpiry = time.Time{}
+}
+
+func truncateBody(body []byte) string {
+	const maxLen = 200
+	if len(body) > maxLen {
+		return string(body[:maxLen])
+	}
+	return string(body)
+}
@@ -0,0 +1,326 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/constants"
+	"github.com/kapu/hololive-kakao-bot-go/internal/domain"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+)
+
+const (
+	// streamIDPrefix: YouTube 영상 ID와 충돌하지 않도록 Twitch 방송 ID에 붙이는 접두사
+	streamIDPrefix = "twitch:"
+	// scheduleConcurrency: 일정 조회 동시 요청 수
+	scheduleConcurrency = 4
+	hololiveOrg         = "Hololive"
+)
+
+// Service: Twitch에서 방송하는 멤버의 라이브/예정 방송을 domain.Stream 형태로 제공하는 서비스
+// 멤버는 YouTube 채널 ID로 식별되므로, 반환되는 방송의 ChannelID도 매핑된 YouTube 채널 ID를 사용한다.
+// 알람 구독, 채널 스케줄 조회 등 기존 YouTube 기반 흐름에 그대로 합쳐질 수 있다.
+type Service struct {
+	client   *Client
+	cache    *cache.Service
+	channels map[string]string // YouTube 채널 ID → Twitch 로그인
+	byLogin  map[string]string // Twitch 로그인 → YouTube 채널 ID
+	logger   *slog.Logger
+
+	usersMu sync.RWMutex
+	users   map[string]UserRaw // Twitch 로그인 → 사용자 정보 (변하지 않으므로 메모리 캐시)
+}
+
+// NewTwitchService: Twitch 서비스 인스턴스를 생성합니다.
+func NewTwitchService(client *Client, cacheSvc *cache.Service, channels map[string]string, logger *slog.Logger) (*Service, error) {
+	if client == nil {
+		return nil, fmt.Errorf("twitch client is required")
+	}
+	if len(channels) == 0 {
+		return nil, fmt.Errorf("at least one twitch channel mapping is required")
+	}
+
+	normalized := make(map[string]string, len(channels))
+	byLogin := make(map[string]string, len(channels))
+	for channelID, login := range channels {
+		login = strings.ToLower(strings.TrimSpace(login))
+		normalized[channelID] = login
+		byLogin[login] = channelID
+	}
+
+	logger.Info("Twitch service configured", slog.Int("channels", len(normalized)))
+
+	return &Service{
+		client:   client,
+		cache:    cacheSvc,
+		channels: normalized,
+		byLogin:  byLogin,
+		logger:   logger,
+		users:    make(map[string]UserRaw, len(normalized)),
+	}, nil
+}
+
+// HasChannel: YouTube 채널 ID가 Twitch 채널과 매핑되어 있는지 확인합니다.
+func (s *Service) HasChannel(channelID string) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.channels[channelID]
+	return ok
+}
+
+// GetLiveStreams: 매핑된 모든 Twitch 채널 중 현재 방송 중인 방송 목록을 조회합니다. (캐시 적용)
+func (s *Service) GetLiveStreams(ctx context.Context) ([]*domain.Stream, error) {
+	const cacheKey = "twitch_live_streams"
+
+	var cached []*domain.Stream
+	if err := s.cache.Get(ctx, cacheKey, &cached); err == nil && cached != nil {
+		return cached, nil
+	}
+
+	logins := make([]string, 0, len(s.channels))
+	for _, login := range s.channels {
+		logins = append(logins, login)
+	}
+	slices.Sort(logins)
+
+	raws, err := s.client.GetStreams(ctx, logins)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]*domain.Stream, 0, len(raws))
+	for i := range raws {
+		if stream := s.mapLiveStream(&raws[i]); stream != nil {
+			streams = append(streams, stream)
+		}
+	}
+
+	_ = s.cache.Set(ctx, cacheKey, streams, constants.CacheTTL.LiveStreams)
+
+	return streams, nil
+}
+
+// GetUpcomingStreams: 매핑된 모든 Twitch 채널의 예정 방송 중 hours 시간 이내 항목을 조회합니다.
+// 일부 채널 조회에 실패해도 나머지 결과는 반환한다.
+func (s *Service) GetUpcomingStreams(ctx context.Context, hours int) ([]*domain.Stream, error) {
+	channelIDs := make([]string, 0, len(s.channels))
+	for channelID := range s.channels {
+		channelIDs = append(channelIDs, channelID)
+	}
+	slices.Sort(channelIDs)
+
+	var mu sync.Mutex
+	result := make([]*domain.Stream, 0)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(scheduleConcurrency)
+	for _, channelID := range channelIDs {
+		g.Go(func() error {
+			streams, err := s.getChannelUpcoming(gctx, channelID, hours)
+			if err != nil {
+				s.logger.Warn("Failed to get twitch schedule",
+					slog.String("channel_id", channelID),
+					slog.Any("error", err),
+				)
+				return nil
+			}
+			mu.Lock()
+			result = append(result, streams...)
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	domain.SortStreamsByStart(result)
+	return result, nil
+}
+
+// GetChannelSchedule: 특정 멤버(YouTube 채널 ID)의 Twitch 예정 방송을 조회합니다.
+// includeLive가 true이면 현재 진행 중인 방송도 포함한다. 매핑되지 않은 채널은 빈 목록을 반환한다.
+func (s *Service) GetChannelSchedule(ctx context.Context, channelID string, hours int, includeLive bool) ([]*domain.Stream, error) {
+	if !s.HasChannel(channelID) {
+		return []*domain.Stream{}, nil
+	}
+
+	result := make([]*domain.Stream, 0)
+	if includeLive {
+		live, err := s.GetLiveStreams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, stream := range live {
+			if stream.ChannelID == channelID {
+				result = append(result, stream)
+			}
+		}
+	}
+
+	upcoming, err := s.getChannelUpcoming(ctx, channelID, hours)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, upcoming...)
+
+	domain.SortStreamsByStart(result)
+	return result, nil
+}
+
+func (s *Service) getChannelUpcoming(ctx context.Context, channelID string, hours int) ([]*domain.Stream, error) {
+	cacheKey := fmt.Sprintf("twitch_schedule_%s", channelID)
+
+	var all []*domain.Stream
+	if err := s.cache.Get(ctx, cacheKey, &all); err != nil || all == nil {
+		user, err := s.getUser(ctx, s.channels[channelID])
+		if err != nil {
+			return nil, err
+		}
+
+		schedule, err := s.client.GetSchedule(ctx, user.ID, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		all = make([]*domain.Stream, 0, len(schedule.Segments))
+		for i := range schedule.Segments {
+			if stream := s.mapScheduleSegment(channelID, user, &schedule.Segments[i]); stream != nil {
+				all = append(all, stream)
+			}
+		}
+		_ = s.cache.Set(ctx, cacheKey, all, constants.CacheTTL.ChannelSchedule)
+	}
+
+	return filterUpcomingWithin(all, time.Now(), hours), nil
+}
+
+// getUser: Twitch 로그인으로 사용자 정보를 조회합니다. 첫 조회 시 매핑된 전체 사용자를 한 번에 가져온다.
+func (s *Service) getUser(ctx context.Context, login string) (UserRaw, error) {
+	s.usersMu.RLock()
+	user, ok := s.users[login]
+	s.usersMu.RUnlock()
+	if ok {
+		return user, nil
+	}
+
+	logins := make([]string, 0, len(s.byLogin))
+	for l := range s.byLogin {
+		logins = append(logins, l)
+	}
+	slices.Sort(logins)
+
+	users, err := s.client.GetUsers(ctx, logins)
+	if err != nil {
+		return UserRaw{}, err
+	}
+
+	s.usersMu.Lock()
+	for _, u := range users {
+		s.users[strings.ToLower(u.Login)] = u
+	}
+	user, ok = s.users[login]
+	s.usersMu.Unlock()
+
+	if !ok {
+		return UserRaw{}, fmt.Errorf("twitch user not found: %s", login)
+	}
+	return user, nil
+}
+
+func (s *Service) mapLiveStream(raw *StreamRaw) *domain.Stream {
+	login := strings.ToLower(raw.UserLogin)
+	channelID, ok := s.byLogin[login]
+	if !ok {
+		return nil
+	}
+
+	stream := &domain.Stream{
+		ID:          streamIDPrefix + raw.ID,
+		Title:       raw.Title,
+		ChannelID:   channelID,
+		ChannelName: raw.UserName,
+		Status:      domain.StreamStatusLive,
+		Link:        channelLink(login),
+		Channel:     newChannel(channelID, raw.UserName),
+		Platform:    domain.StreamPlatformTwitch,
+	}
+	if raw.ThumbnailURL != "" {
+		thumb := strings.NewReplacer("{width}", "320", "{height}", "180").Replace(raw.ThumbnailURL)
+		stream.Thumbnail = &thumb
+	}
+	if t, err := time.Parse(time.RFC3339, raw.StartedAt); err == nil {
+		stream.StartActual = &t
+	}
+	return stream
+}
+
+func (s *Service) mapScheduleSegment(channelID string, user UserRaw, raw *ScheduleSegmentRaw) *domain.Stream {
+	// 휴방 처리된 회차는 제외
+	if raw.CanceledUntil != nil && *raw.CanceledUntil != "" {
+		return nil
+	}
+
+	start, err := time.Parse(time.RFC3339, raw.StartTime)
+	if err != nil {
+		s.logger.Debug("Invalid twitch segment start time",
+			slog.String("segment_id", raw.ID),
+			slog.String("start_time", raw.StartTime),
+		)
+		return nil
+	}
+
+	title := strings.TrimSpace(raw.Title)
+	if title == "" && raw.Category != nil {
+		title = raw.Category.Name
+	}
+	if title == "" {
+		title = "Twitch 방송"
+	}
+
+	return &domain.Stream{
+		ID:             streamIDPrefix + raw.ID,
+		Title:          title,
+		ChannelID:      channelID,
+		ChannelName:    user.DisplayName,
+		Status:         domain.StreamStatusUpcoming,
+		StartScheduled: &start,
+		Link:           channelLink(strings.ToLower(user.Login)),
+		Channel:        newChannel(channelID, user.DisplayName),
+		Platform:       domain.StreamPlatformTwitch,
+	}
+}
+
+func filterUpcomingWithin(streams []*domain.Stream, now time.Time, hours int) []*domain.Stream {
+	deadline := now.Add(time.Duration(hours) * time.Hour)
+	filtered := make([]*domain.Stream, 0, len(streams))
+	for _, stream := range streams {
+		if stream.StartScheduled == nil {
+			continue
+		}
+		if stream.StartScheduled.After(now) && !stream.StartScheduled.After(deadline) {
+			filtered = append(filtered, stream)
+		}
+	}
+	return filtered
+}
+
+func channelLink(login string) *string {
+	link := constants.TwitchAPIConfig.ChannelBaseURL + login
+	return &link
+}
+
+func newChannel(channelID, name string) *domain.Channel {
+	org := hololiveOrg
+	return &domain.Channel{
+		ID:   channelID,
+		Name: name,
+		Org:  &org,
+	}
+}
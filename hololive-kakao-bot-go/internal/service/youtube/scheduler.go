@@ -19,7 +19,7 @@ import (
 // Scheduler: YouTube 데이터 수집(통계, 영상 등) 작업을 주기적으로 실행하는 스케줄러
 type Scheduler struct {
 	youtube              *Service
-	holodex              *holodex.Service
+	holodex              holodex.StreamProvider
 	cache                *cache.Service
 	statsRepo            *StatsRepository
 	membersData          domain.MemberDataProvider
@@ -53,7 +53,7 @@ var SubscriberMilestones = []uint64{
 // NewScheduler: YouTube 데이터 수집 스케줄러를 생성합니다.
 func NewScheduler(
 	youtubeSvc *Service,
-	holodexSvc *holodex.Service,
+	holodexSvc holodex.StreamProvider,
 	cacheSvc *cache.Service,
 	statsRepo *StatsRepository,
 	membersData domain.MemberDataProvider,
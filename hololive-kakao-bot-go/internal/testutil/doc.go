@@ -0,0 +1,3 @@
+// Package testutil: 실제 Valkey/Postgres 컨테이너를 사용하는 통합 테스트 하네스를 제공한다.
+// 하네스 본체는 integration 빌드 태그가 있을 때만 컴파일된다. (make test-integration)
+package testutil
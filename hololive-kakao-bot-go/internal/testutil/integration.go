@@ -0,0 +1,339 @@
+//go:build integration
+
+package testutil
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/cache"
+	"github.com/kapu/hololive-kakao-bot-go/internal/service/database"
+)
+
+// 통합 테스트 컨테이너 설정 (docker-compose.prod.yml 이미지와 동일 버전 유지)
+const (
+	valkeyRepo     = "valkey/valkey"
+	valkeyTag      = "9.0.1-alpine3.23"
+	postgresRepo   = "postgres"
+	postgresTag    = "18-alpine"
+	postgresUser   = "integration"
+	postgresPass   = "integration"
+	postgresDB     = "integration"
+	startupTimeout = 2 * time.Minute
+	// containerTTLSeconds: 테스트 프로세스가 비정상 종료되어도 컨테이너가 남지 않도록 하는 만료 시간
+	containerTTLSeconds = 600
+)
+
+// 외부 인스턴스 지정 환경 변수 (nightly CI의 service container 등)
+// 설정되면 컨테이너를 띄우지 않고 해당 인스턴스를 사용한다.
+// 외부 인스턴스는 테스트 전용이어야 하며, 패키지 병렬 실행 시 -p 1 사용을 권장한다.
+const (
+	EnvValkeyAddr  = "INTEGRATION_VALKEY_ADDR"
+	EnvPostgresDSN = "INTEGRATION_POSTGRES_DSN"
+)
+
+var nonIdentChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Env: 통합 테스트용 Valkey/Postgres 접속 정보
+type Env struct {
+	ValkeyAddr  string
+	PostgresDSN string
+
+	pool      *dockertest.Pool
+	resources []*dockertest.Resource
+}
+
+var sharedEnv *Env
+
+// RunMain: TestMain에서 호출하여 패키지 단위로 컨테이너를 공유한다.
+//
+//	func TestMain(m *testing.M) { os.Exit(testutil.RunMain(m)) }
+func RunMain(m *testing.M) int {
+	env, err := StartEnv()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "integration env start failed: %v\n", err)
+		return 1
+	}
+	sharedEnv = env
+	defer env.Close()
+
+	return m.Run()
+}
+
+// StartEnv: Valkey/Postgres 컨테이너를 기동하고 준비될 때까지 대기한다.
+func StartEnv() (*Env, error) {
+	env := &Env{
+		ValkeyAddr:  strings.TrimSpace(os.Getenv(EnvValkeyAddr)),
+		PostgresDSN: strings.TrimSpace(os.Getenv(EnvPostgresDSN)),
+	}
+
+	if env.ValkeyAddr == "" || env.PostgresDSN == "" {
+		pool, err := dockertest.NewPool("")
+		if err != nil {
+			return nil, fmt.Errorf("docker pool init failed: %w", err)
+		}
+		pool.MaxWait = startupTimeout
+		if err := pool.Client.Ping(); err != nil {
+			return nil, fmt.Errorf("docker ping failed: %w", err)
+		}
+		env.pool = pool
+	}
+
+	if env.ValkeyAddr == "" {
+		if err := env.startValkey(); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+	if env.PostgresDSN == "" {
+		if err := env.startPostgres(); err != nil {
+			env.Close()
+			return nil, err
+		}
+	}
+
+	return env, nil
+}
+
+func (e *Env) runContainer(opts *dockertest.RunOptions) (*dockertest.Resource, error) {
+	resource, err := e.pool.RunWithOptions(opts, func(hc *docker.HostConfig) {
+		hc.AutoRemove = true
+		hc.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run %s:%s failed: %w", opts.Repository, opts.Tag, err)
+	}
+	e.resources = append(e.resources, resource)
+
+	if err := resource.Expire(containerTTLSeconds); err != nil {
+		return nil, fmt.Errorf("set container expiry failed: %w", err)
+	}
+	return resource, nil
+}
+
+func (e *Env) startValkey() error {
+	resource, err := e.runContainer(&dockertest.RunOptions{Repository: valkeyRepo, Tag: valkeyTag})
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort("127.0.0.1", resource.GetPort("6379/tcp"))
+	if err := e.pool.Retry(func() error {
+		svc, err := newCacheService(addr)
+		if err != nil {
+			return err
+		}
+		return svc.Close()
+	}); err != nil {
+		return fmt.Errorf("valkey not ready: %w", err)
+	}
+
+	e.ValkeyAddr = addr
+	return nil
+}
+
+func (e *Env) startPostgres() error {
+	resource, err := e.runContainer(&dockertest.RunOptions{
+		Repository: postgresRepo,
+		Tag:        postgresTag,
+		Env: []string{
+			"POSTGRES_USER=" + postgresUser,
+			"POSTGRES_PASSWORD=" + postgresPass,
+			"POSTGRES_DB=" + postgresDB,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	dsn := (&url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(postgresUser, postgresPass),
+		Host:     net.JoinHostPort("127.0.0.1", resource.GetPort("5432/tcp")),
+		Path:     "/" + postgresDB,
+		RawQuery: "sslmode=disable",
+	}).String()
+
+	if err := e.pool.Retry(func() error {
+		svc, err := newPostgresService(dsn)
+		if err != nil {
+			return err
+		}
+		return svc.Close()
+	}); err != nil {
+		return fmt.Errorf("postgres not ready: %w", err)
+	}
+
+	e.PostgresDSN = dsn
+	return nil
+}
+
+// Close: 기동한 컨테이너를 모두 정리한다. (외부 인스턴스는 건드리지 않음)
+func (e *Env) Close() {
+	if e == nil || e.pool == nil {
+		return
+	}
+	for _, resource := range e.resources {
+		_ = e.pool.Purge(resource)
+	}
+	e.resources = nil
+}
+
+// SharedEnv: RunMain으로 기동된 공유 환경을 반환한다.
+func SharedEnv(t *testing.T) *Env {
+	t.Helper()
+
+	if sharedEnv == nil {
+		t.Fatal("integration env not initialized: call testutil.RunMain from TestMain")
+	}
+	return sharedEnv
+}
+
+// NewCacheService: 통합 테스트용 캐시 서비스를 생성한다.
+// 테스트 종료 시 DB를 비우고 연결을 닫는다.
+func NewCacheService(t *testing.T) *cache.Service {
+	t.Helper()
+
+	env := SharedEnv(t)
+	svc, err := newCacheService(env.ValkeyAddr)
+	if err != nil {
+		t.Fatalf("failed to create cache service (addr=%s): %v", env.ValkeyAddr, err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		client := svc.GetClient()
+		if err := client.Do(ctx, client.B().Flushdb().Build()).Error(); err != nil {
+			t.Logf("warning: valkey flushdb failed: %v", err)
+		}
+		_ = svc.Close()
+	})
+
+	return svc
+}
+
+// NewPostgresService: 테스트 전용 데이터베이스를 생성하고 연결된 서비스를 반환한다.
+// 테스트 종료 시 데이터베이스를 삭제하므로 테스트 간 스키마/데이터가 격리된다.
+func NewPostgresService(t *testing.T) *database.PostgresService {
+	t.Helper()
+
+	env := SharedEnv(t)
+	admin, err := newPostgresService(env.PostgresDSN)
+	if err != nil {
+		t.Fatalf("failed to connect postgres: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = admin.Close()
+	})
+
+	dbName, err := databaseName(t)
+	if err != nil {
+		t.Fatalf("failed to build database name: %v", err)
+	}
+	if _, err := admin.GetDB().Exec("CREATE DATABASE " + dbName); err != nil {
+		t.Fatalf("failed to create database %s: %v", dbName, err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.GetDB().Exec("DROP DATABASE IF EXISTS " + dbName + " WITH (FORCE)"); err != nil {
+			t.Logf("warning: failed to drop database %s: %v", dbName, err)
+		}
+	})
+
+	parsed, err := url.Parse(env.PostgresDSN)
+	if err != nil {
+		t.Fatalf("invalid postgres dsn: %v", err)
+	}
+	parsed.Path = "/" + dbName
+
+	svc, err := newPostgresService(parsed.String())
+	if err != nil {
+		t.Fatalf("failed to connect test database %s: %v", dbName, err)
+	}
+	// DROP DATABASE 이전에 연결이 닫히도록 Cleanup 등록 순서(LIFO)에 의존
+	t.Cleanup(func() {
+		_ = svc.Close()
+	})
+	return svc
+}
+
+// DiscardLogger: 테스트 출력 노이즈를 줄이기 위한 로거
+func DiscardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func newCacheService(addr string) (*cache.Service, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid valkey addr %q: %w", addr, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid valkey port %q: %w", portStr, err)
+	}
+
+	svc, err := cache.NewCacheService(cache.Config{
+		Host:         host,
+		Port:         port,
+		DisableCache: true,
+	}, DiscardLogger())
+	if err != nil {
+		return nil, fmt.Errorf("cache service init failed: %w", err)
+	}
+	return svc, nil
+}
+
+// newPostgresService: URL 형식 DSN을 database.PostgresConfig로 변환하여 연결한다.
+func newPostgresService(dsn string) (*database.PostgresService, error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid postgres dsn: %w", err)
+	}
+	port, err := strconv.Atoi(parsed.Port())
+	if err != nil {
+		return nil, fmt.Errorf("invalid postgres port %q: %w", parsed.Port(), err)
+	}
+	password, _ := parsed.User.Password()
+
+	svc, err := database.NewPostgresService(database.PostgresConfig{
+		Host:     parsed.Hostname(),
+		Port:     port,
+		User:     parsed.User.Username(),
+		Password: password,
+		Database: strings.TrimPrefix(parsed.Path, "/"),
+	}, DiscardLogger())
+	if err != nil {
+		return nil, fmt.Errorf("postgres service init failed: %w", err)
+	}
+	return svc, nil
+}
+
+// databaseName: 테스트 이름 기반의 유효한 Postgres 식별자를 생성한다. (최대 63자)
+func databaseName(t *testing.T) (string, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("rand read failed: %w", err)
+	}
+
+	base := nonIdentChars.ReplaceAllString(strings.ToLower(t.Name()), "_")
+	const maxBaseLen = 40
+	if len(base) > maxBaseLen {
+		base = base[:maxBaseLen]
+	}
+	return "it_" + strings.Trim(base, "_") + "_" + hex.EncodeToString(b[:]), nil
+}
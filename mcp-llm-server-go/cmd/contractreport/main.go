@@ -0,0 +1,43 @@
+// contractreport: 서버/클라이언트 계약 테스트 결과를 모아 호환성 매트릭스(Markdown)를 출력합니다.
+// `make contract-report`에서 양쪽 테스트를 CONTRACT_REPORT_DIR로 실행한 뒤 호출됩니다.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/contract"
+)
+
+func main() {
+	contractDir := flag.String("contract", contract.Dir, "골든 디스크립터/픽스처 디렉터리")
+	reportDir := flag.String("reports", "", "계약 테스트 결과 디렉터리 (CONTRACT_REPORT_DIR)")
+	flag.Parse()
+
+	if *reportDir == "" {
+		fmt.Fprintln(os.Stderr, "-reports is required")
+		os.Exit(2)
+	}
+
+	desc, err := contract.LoadDescriptor(*contractDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	reports, err := contract.LoadSideReports(*reportDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if len(reports) == 0 {
+		fmt.Fprintf(os.Stderr, "no contract reports in %s\n", *reportDir)
+		os.Exit(1)
+	}
+
+	matrix, failed := contract.RenderMatrix(desc, reports)
+	fmt.Printf("## gRPC contract compatibility (%s)\n\n%s", desc.Service, matrix)
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -174,3 +174,90 @@ func containsSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestParseTaskProviders(t *testing.T) {
+	providers := parseTaskProviders("verify=OpenAI, hints=gemini,broken,=x")
+	if len(providers) != 2 || providers["verify"] != "openai" || providers["hints"] != "gemini" {
+		t.Fatalf("unexpected task providers: %+v", providers)
+	}
+
+	routing := LLMRoutingConfig{DefaultProvider: "gemini", TaskProviders: providers}
+	if routing.ProviderForTask("verify") != "openai" || routing.ProviderForTask("answer") != "gemini" {
+		t.Fatalf("unexpected provider selection")
+	}
+}
+
+func TestValidateLLMRouting(t *testing.T) {
+	cfg := &Config{LLMRouting: LLMRoutingConfig{DefaultProvider: "unknown"}}
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected unknown provider error")
+	}
+
+	cfg = &Config{LLMRouting: LLMRoutingConfig{
+		DefaultProvider:   "gemini",
+		FallbackProviders: parseProviderList("openai, openai"),
+	}, OpenAI: OpenAIConfig{BaseURL: "http://localhost:8000/v1"}}
+	if len(cfg.LLMRouting.FallbackProviders) != 1 {
+		t.Fatalf("expected deduplicated fallback list")
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected missing openai model error")
+	}
+
+	cfg.OpenAI.DefaultModel = "gpt-test"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateSessionStandby(t *testing.T) {
+	cfg := &Config{SessionStore: SessionStoreConfig{Active: "standby"}}
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected missing standby url error")
+	}
+
+	cfg.SessionStore.StandbyURL = "redis://valkey-standby:6379"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg.SessionStore.Active = "secondary"
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected invalid active target error")
+	}
+}
+
+func TestValidateGeminiEndpoints(t *testing.T) {
+	cfg := &Config{Gemini: GeminiConfig{Endpoints: splitKeys("https://us.example.com, asia.example.com")}}
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected invalid endpoint error")
+	}
+
+	cfg.Gemini.Endpoints = splitKeys("https://us.example.com,https://asia.example.com/")
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateHistoryCompression(t *testing.T) {
+	cfg := &Config{Session: SessionConfig{HistoryMaxPairs: 50, HistoryCompressThreshold: 40, HistoryCompressKeep: 20}}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg.Session.HistoryCompressKeep = 40
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected keep >= threshold error")
+	}
+
+	cfg.Session.HistoryCompressKeep = 10
+	cfg.Session.HistoryMaxPairs = 20
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected threshold >= max entries error")
+	}
+
+	cfg.Session.HistoryCompressThreshold = 0
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("disabled compression should not be validated: %v", err)
+	}
+}
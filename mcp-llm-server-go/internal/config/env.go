@@ -32,6 +32,47 @@ func splitKeys(value string) []string {
 	return result
 }
 
+// 공급자 식별자 (llm 패키지 상수와 동일 값, config -> llm 의존 방지)
+const (
+	providerGemini = "gemini"
+	providerOpenAI = "openai"
+)
+
+func isKnownProvider(provider string) bool {
+	return provider == providerGemini || provider == providerOpenAI
+}
+
+// parseTaskProviders: "verify=openai,hints=gemini" 형식을 task -> provider 맵으로 변환합니다.
+func parseTaskProviders(value string) map[string]string {
+	result := make(map[string]string)
+	for _, item := range splitKeys(value) {
+		task, provider, ok := strings.Cut(item, "=")
+		task = strings.TrimSpace(task)
+		provider = strings.ToLower(strings.TrimSpace(provider))
+		if !ok || task == "" || provider == "" {
+			continue
+		}
+		result[task] = provider
+	}
+	return result
+}
+
+// parseProviderList: "openai,gemini" 형식을 중복 없는 공급자 목록으로 변환합니다.
+func parseProviderList(value string) []string {
+	items := splitKeys(value)
+	result := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		provider := strings.ToLower(item)
+		if _, ok := seen[provider]; ok {
+			continue
+		}
+		seen[provider] = struct{}{}
+		result = append(result, provider)
+	}
+	return result
+}
+
 func isGemini3(model string) bool {
 	return strings.Contains(strings.ToLower(model), "gemini-3")
 }
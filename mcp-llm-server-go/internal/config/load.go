@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"strings"
 	"sync"
 
@@ -49,6 +50,7 @@ func (c *Config) Validate() error {
 		c.Gemini.HintsModel,
 		c.Gemini.AnswerModel,
 		c.Gemini.VerifyModel,
+		c.Gemini.FallbackModel,
 	}
 	for _, model := range models {
 		if model == "" {
@@ -58,6 +60,81 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("gemini 3 only: model=%s", model)
 		}
 	}
+	if err := c.validateGeminiEndpoints(); err != nil {
+		return err
+	}
+	if ratio := c.Gemini.RoutingBudgetRatio; ratio < 0 || ratio >= 1 {
+		return fmt.Errorf("invalid GEMINI_ROUTING_BUDGET_RATIO: %v (0 <= ratio < 1)", ratio)
+	}
+	if err := c.validateSessionStandby(); err != nil {
+		return err
+	}
+	if err := c.validateHistoryCompression(); err != nil {
+		return err
+	}
+	return c.validateLLMRouting()
+}
+
+func (c *Config) validateGeminiEndpoints() error {
+	for _, endpoint := range c.Gemini.Endpoints {
+		parsed, err := url.Parse(endpoint)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid GEMINI_ENDPOINTS entry: %s", endpoint)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateSessionStandby() error {
+	switch c.SessionStore.Active {
+	case "", "primary":
+		return nil
+	case "standby":
+		if strings.TrimSpace(c.SessionStore.StandbyURL) == "" {
+			return errors.New("SESSION_STORE_ACTIVE=standby requires SESSION_STORE_STANDBY_URL")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid SESSION_STORE_ACTIVE: %s (primary|standby)", c.SessionStore.Active)
+	}
+}
+
+func (c *Config) validateHistoryCompression() error {
+	threshold := c.Session.HistoryCompressThreshold
+	if threshold == 0 {
+		return nil
+	}
+	if c.Session.HistoryCompressKeep >= threshold {
+		return fmt.Errorf("SESSION_HISTORY_COMPRESS_KEEP (%d) must be less than SESSION_HISTORY_COMPRESS_THRESHOLD (%d)", c.Session.HistoryCompressKeep, threshold)
+	}
+	// 최대 보관 개수에 먼저 걸리면 요약 항목이 잘려 나가므로 임계값은 그보다 작아야 함
+	if maxEntries := c.Session.HistoryMaxPairs * 2; maxEntries > 0 && threshold >= maxEntries {
+		return fmt.Errorf("SESSION_HISTORY_COMPRESS_THRESHOLD (%d) must be less than 2*SESSION_HISTORY_MAX_PAIRS (%d)", threshold, maxEntries)
+	}
+	return nil
+}
+
+func (c *Config) validateLLMRouting() error {
+	providers := []string{c.LLMRouting.DefaultProvider}
+	for _, provider := range c.LLMRouting.TaskProviders {
+		providers = append(providers, provider)
+	}
+	providers = append(providers, c.LLMRouting.FallbackProviders...)
+	for _, provider := range providers {
+		// 빈 값은 기본 공급자(gemini)로 간주
+		if provider != "" && !isKnownProvider(provider) {
+			return fmt.Errorf("unknown llm provider: %s", provider)
+		}
+	}
+
+	if c.LLMRouting.Uses(providerOpenAI) {
+		if strings.TrimSpace(c.OpenAI.BaseURL) == "" {
+			return errors.New("openai provider requires OPENAI_BASE_URL")
+		}
+		if strings.TrimSpace(c.OpenAI.DefaultModel) == "" {
+			return errors.New("openai provider requires OPENAI_MODEL")
+		}
+	}
 	return nil
 }
 
@@ -76,15 +153,23 @@ func LogEnvStatus(cfg *Config, logger *slog.Logger) {
 		"primary_key", primaryKey,
 		"model", cfg.Gemini.DefaultModel,
 		"timeout", cfg.Gemini.TimeoutSeconds,
+		"gemini_endpoints", len(cfg.Gemini.Endpoints),
+		"gemini_max_concurrent", cfg.Gemini.MaxConcurrent,
+		"gemini_queue_size", cfg.Gemini.QueueSize,
+		"gemini_fallback_model", cfg.Gemini.FallbackModel,
 		"session_store_enabled", cfg.SessionStore.Enabled,
 		"db_host", cfg.Database.Host,
 		"db_name", cfg.Database.Name,
 		"session_ttl", cfg.Session.SessionTTLMinutes,
 		"history_pairs", cfg.Session.HistoryMaxPairs,
+		"history_compress_threshold", cfg.Session.HistoryCompressThreshold,
 		"grpc_enabled", cfg.GRPC.Enabled,
 		"grpc_host", cfg.GRPC.Host,
 		"grpc_port", cfg.GRPC.Port,
 		"grpc_socket_path", cfg.GRPC.SocketPath,
+		"llm_default_provider", cfg.LLMRouting.DefaultProvider,
+		"llm_task_providers", cfg.LLMRouting.TaskProviders,
+		"llm_fallback_providers", cfg.LLMRouting.FallbackProviders,
 	)
 
 	if len(cfg.Gemini.APIKeys) == 0 {
@@ -111,6 +196,35 @@ func buildConfig() *Config {
 			MaxRetries:       max(1, getEnvInt("GEMINI_MAX_RETRIES", 6)),
 			TimeoutSeconds:   getEnvInt("GEMINI_TIMEOUT", 60),
 			FailoverAttempts: max(1, getEnvInt("GEMINI_FAILOVER_ATTEMPTS", 2)),
+
+			Endpoints:                splitKeys(getEnvString("GEMINI_ENDPOINTS", "")),
+			EndpointProbeSeconds:     max(1, getEnvInt("GEMINI_ENDPOINT_PROBE_SECONDS", 30)),
+			EndpointFailureThreshold: max(1, getEnvInt("GEMINI_ENDPOINT_FAILURE_THRESHOLD", 3)),
+
+			MaxConcurrent:  getEnvNonNegativeInt("GEMINI_MAX_CONCURRENT", 16),
+			QueueSize:      getEnvNonNegativeInt("GEMINI_QUEUE_SIZE", 64),
+			BatchQueueSize: getEnvNonNegativeInt("GEMINI_BATCH_QUEUE_SIZE", 16),
+
+			FallbackModel:      getEnvString("GEMINI_FALLBACK_MODEL", ""),
+			RoutingP95Millis:   getEnvNonNegativeInt("GEMINI_ROUTING_P95_MS", 20000),
+			RoutingBudgetRatio: getEnvFloat("GEMINI_ROUTING_BUDGET_RATIO", 0.2),
+		},
+		OpenAI: OpenAIConfig{
+			BaseURL:         getEnvString("OPENAI_BASE_URL", "https://api.openai.com/v1"),
+			APIKey:          getEnvString("OPENAI_API_KEY", ""),
+			DefaultModel:    getEnvString("OPENAI_MODEL", ""),
+			HintsModel:      getEnvString("OPENAI_HINTS_MODEL", ""),
+			AnswerModel:     getEnvString("OPENAI_ANSWER_MODEL", ""),
+			VerifyModel:     getEnvString("OPENAI_VERIFY_MODEL", ""),
+			Temperature:     getEnvFloat("OPENAI_TEMPERATURE", 0.7),
+			MaxOutputTokens: getEnvInt("OPENAI_MAX_TOKENS", 8192),
+			MaxRetries:      max(1, getEnvInt("OPENAI_MAX_RETRIES", 3)),
+			TimeoutSeconds:  getEnvInt("OPENAI_TIMEOUT", 60),
+		},
+		LLMRouting: LLMRoutingConfig{
+			DefaultProvider:   strings.ToLower(getEnvString("LLM_DEFAULT_PROVIDER", "gemini")),
+			TaskProviders:     parseTaskProviders(getEnvString("LLM_TASK_PROVIDERS", "")),
+			FallbackProviders: parseProviderList(getEnvString("LLM_FALLBACK_PROVIDERS", "")),
 		},
 		Session: SessionConfig{
 			MaxSessions:       getEnvInt("MAX_SESSIONS", 50),
@@ -119,6 +233,10 @@ func buildConfig() *Config {
 			// 게임은 보통 10-20턴에 종료되므로 50쌍(100개 메시지)이면 충분
 			// 슬라이딩 윈도우 → 캐시 무효화 방지
 			HistoryMaxPairs: getEnvNonNegativeInt("SESSION_HISTORY_MAX_PAIRS", 50),
+			// 긴 바다거북 게임 대비: 40개(20쌍)를 넘으면 최근 20개만 남기고 앞부분을 요약 (압축 시점에만 캐시 접두사가 바뀜)
+			HistoryCompressThreshold: getEnvNonNegativeInt("SESSION_HISTORY_COMPRESS_THRESHOLD", 40),
+			HistoryCompressKeep:      getEnvNonNegativeInt("SESSION_HISTORY_COMPRESS_KEEP", 20),
+			HistorySummaryModel:      getEnvString("SESSION_HISTORY_SUMMARY_MODEL", ""),
 		},
 		SessionStore: SessionStoreConfig{
 			URL:                 getEnvString("SESSION_STORE_URL", "redis://localhost:6379"),
@@ -127,6 +245,11 @@ func buildConfig() *Config {
 			DisableCache:        getEnvBool("SESSION_STORE_DISABLE_CACHE", false),
 			ConnectMaxAttempts:  max(1, getEnvNonNegativeInt("SESSION_STORE_CONNECT_MAX_ATTEMPTS", 6)),
 			ConnectRetrySeconds: getEnvNonNegativeInt("SESSION_STORE_CONNECT_RETRY_SECONDS", 5),
+
+			StandbyURL:            getEnvString("SESSION_STORE_STANDBY_URL", ""),
+			Active:                strings.ToLower(getEnvString("SESSION_STORE_ACTIVE", "primary")),
+			ReconcileSeconds:      max(1, getEnvNonNegativeInt("SESSION_STORE_RECONCILE_SECONDS", 60)),
+			ReplicationMaxPending: max(1, getEnvNonNegativeInt("SESSION_STORE_REPLICATION_MAX_PENDING", 1024)),
 		},
 		Guard: GuardConfig{
 			Enabled:         getEnvBool("GUARD_ENABLED", true),
@@ -134,6 +257,18 @@ func buildConfig() *Config {
 			RulepacksDir:    getEnvString("RULEPACKS_DIR", "rulepacks"),
 			CacheMaxSize:    getEnvInt("GUARD_CACHE_SIZE", 10000),
 			CacheTTLSeconds: getEnvInt("GUARD_CACHE_TTL", 3600),
+			AuditEnabled:    getEnvBool("GUARD_AUDIT_ENABLED", true),
+		},
+		Language: LanguageConfig{
+			Enabled:        getEnvBool("LANG_ENFORCE_ENABLED", true),
+			MaxRetries:     getEnvNonNegativeInt("LANG_ENFORCE_MAX_RETRIES", 1),
+			MinLetters:     max(1, getEnvNonNegativeInt("LANG_ENFORCE_MIN_LETTERS", 12)),
+			MinHangulRatio: getEnvFloat("LANG_ENFORCE_MIN_HANGUL_RATIO", 0.5),
+			LocalizeNames:  getEnvBool("LANG_LOCALIZE_TALENT_NAMES", true),
+		},
+		Repair: StructuredRepairConfig{
+			Enabled:     getEnvBool("STRUCTURED_REPAIR_ENABLED", true),
+			MaxAttempts: getEnvNonNegativeInt("STRUCTURED_REPAIR_MAX_ATTEMPTS", 2),
 		},
 		Logging: LoggingConfig{
 			Level:      getEnvString("LOG_LEVEL", "info"),
@@ -163,6 +298,28 @@ func buildConfig() *Config {
 			CacheSize:         max(1, getEnvNonNegativeInt("HTTP_RATE_LIMIT_CACHE_SIZE", 10000)),
 			CacheTTLSeconds:   max(1, getEnvNonNegativeInt("HTTP_RATE_LIMIT_CACHE_TTL_SECONDS", 120)),
 		},
+		Quota: QuotaConfig{
+			Enabled: getEnvBool("QUOTA_ENABLED", false),
+			Bots: map[string]BotQuota{
+				"twentyq":    readBotQuota("TWENTYQ"),
+				"turtlesoup": readBotQuota("TURTLESOUP"),
+				"holo":       readBotQuota("HOLO"),
+			},
+		},
+		Shadow: ShadowConfig{
+			Enabled:        getEnvBool("SHADOW_VERIFY_ENABLED", false),
+			SampleRate:     min(1, max(0, getEnvFloat("SHADOW_VERIFY_SAMPLE_RATE", 0.1))),
+			CandidateLabel: getEnvString("SHADOW_VERIFY_CANDIDATE_LABEL", "verify-answer-candidate"),
+			MaxInFlight:    max(1, getEnvNonNegativeInt("SHADOW_VERIFY_MAX_IN_FLIGHT", 4)),
+			TimeoutSeconds: max(1, getEnvNonNegativeInt("SHADOW_VERIFY_TIMEOUT", 60)),
+		},
+		Embedding: EmbeddingConfig{
+			Model:              getEnvString("EMBEDDING_MODEL", "gemini-embedding-001"),
+			Dimensions:         getEnvNonNegativeInt("EMBEDDING_DIMENSIONS", 768),
+			MaxTexts:           max(1, getEnvNonNegativeInt("EMBEDDING_MAX_TEXTS", 100)),
+			CacheTTLSeconds:    max(1, getEnvNonNegativeInt("EMBEDDING_CACHE_TTL_SECONDS", 7*24*3600)),
+			CacheMemoryMaxSize: max(1, getEnvNonNegativeInt("EMBEDDING_CACHE_MEMORY_MAX_SIZE", 10000)),
+		},
 		Database: DatabaseConfig{
 			Host:                                 getEnvString("DB_HOST", "localhost"),
 			Port:                                 getEnvInt("DB_PORT", 5432),
@@ -184,3 +341,11 @@ func buildConfig() *Config {
 		Telemetry: readTelemetryConfig(),
 	}
 }
+
+// readBotQuota: QUOTA_<BOT>_DAILY_REQUESTS / QUOTA_<BOT>_DAILY_TOKENS를 읽습니다.
+func readBotQuota(bot string) BotQuota {
+	return BotQuota{
+		DailyRequests: int64(getEnvNonNegativeInt("QUOTA_"+bot+"_DAILY_REQUESTS", 0)),
+		DailyTokens:   int64(getEnvNonNegativeInt("QUOTA_"+bot+"_DAILY_TOKENS", 0)),
+	}
+}
@@ -43,6 +43,21 @@ type GeminiConfig struct {
 	MaxRetries       int
 	TimeoutSeconds   int
 	FailoverAttempts int
+
+	// 리전별 엔드포인트 (비어 있으면 SDK 기본 엔드포인트)
+	Endpoints                []string
+	EndpointProbeSeconds     int // 엔드포인트 지연 측정 주기
+	EndpointFailureThreshold int // 연속 실패 몇 번이면 다른 엔드포인트로 전환할지
+
+	// 호출 대기열 (MaxConcurrent가 0이면 제한 없음)
+	MaxConcurrent  int // 동시에 보내는 Gemini 호출 수
+	QueueSize      int // 슬롯을 기다릴 수 있는 전체 호출 수 (넘으면 429)
+	BatchQueueSize int // 그중 batch 우선순위 호출이 차지할 수 있는 수
+
+	// 모델 라우팅 (FallbackModel이 비어 있으면 작업별 모델을 그대로 사용)
+	FallbackModel      string  // 지연이나 예산 조건에 걸리면 대신 쓰는 저가 모델
+	RoutingP95Millis   int     // 작업 모델의 최근 p95 지연이 이 값을 넘으면 대체 모델 사용 (0이면 지연 조건 끔)
+	RoutingBudgetRatio float64 // 호출 봇의 남은 일일 예산 비율이 이 값 이하이면 대체 모델 사용 (0이면 예산 조건 끔)
 }
 
 // PrimaryKey: 기본 API 키를 반환합니다.
@@ -80,11 +95,82 @@ func (g GeminiConfig) TemperatureForModel(model string) float64 {
 	return g.Temperature
 }
 
+// OpenAIConfig: OpenAI 호환 API(OpenAI, vLLM, Ollama 등) 설정입니다.
+type OpenAIConfig struct {
+	BaseURL         string
+	APIKey          string
+	DefaultModel    string
+	HintsModel      string
+	AnswerModel     string
+	VerifyModel     string
+	Temperature     float64
+	MaxOutputTokens int
+	MaxRetries      int
+	TimeoutSeconds  int
+}
+
+// ModelForTask: 작업 유형별 모델을 반환합니다.
+func (o OpenAIConfig) ModelForTask(task string) string {
+	switch task {
+	case "hints":
+		if o.HintsModel != "" {
+			return o.HintsModel
+		}
+	case "answer":
+		if o.AnswerModel != "" {
+			return o.AnswerModel
+		}
+	case "verify":
+		if o.VerifyModel != "" {
+			return o.VerifyModel
+		}
+	}
+	return o.DefaultModel
+}
+
+// LLMRoutingConfig: 작업별 LLM 공급자 선택 및 폴백 설정입니다.
+type LLMRoutingConfig struct {
+	DefaultProvider   string
+	TaskProviders     map[string]string // task -> provider (예: verify -> openai)
+	FallbackProviders []string          // 주 공급자 실패 시 순서대로 시도
+}
+
+// ProviderForTask: 작업 유형별 주 공급자를 반환합니다.
+func (r LLMRoutingConfig) ProviderForTask(task string) string {
+	if provider, ok := r.TaskProviders[task]; ok && provider != "" {
+		return provider
+	}
+	return r.DefaultProvider
+}
+
+// Uses: 라우팅 설정에서 해당 공급자를 참조하는지 확인합니다.
+func (r LLMRoutingConfig) Uses(provider string) bool {
+	if r.DefaultProvider == provider {
+		return true
+	}
+	for _, p := range r.TaskProviders {
+		if p == provider {
+			return true
+		}
+	}
+	for _, p := range r.FallbackProviders {
+		if p == provider {
+			return true
+		}
+	}
+	return false
+}
+
 // SessionConfig: 세션 관련 설정입니다.
 type SessionConfig struct {
 	MaxSessions       int
 	SessionTTLMinutes int
 	HistoryMaxPairs   int
+
+	// 히스토리 요약 압축 (HistoryCompressThreshold가 0이면 비활성)
+	HistoryCompressThreshold int    // 항목 수가 이 값을 넘으면 오래된 항목을 요약 항목 하나로 압축
+	HistoryCompressKeep      int    // 압축 후 원문으로 남기는 최근 항목 수
+	HistorySummaryModel      string // 요약에 쓰는 모델 (비어 있으면 history_summary 작업 기본 모델)
 }
 
 // SessionStoreConfig: 세션 저장소 연결 설정입니다.
@@ -95,6 +181,12 @@ type SessionStoreConfig struct {
 	DisableCache        bool
 	ConnectMaxAttempts  int
 	ConnectRetrySeconds int
+
+	// 대기 노드 복제 (StandbyURL이 비어 있으면 비활성)
+	StandbyURL            string
+	Active                string // 기동 시 활성 노드: primary | standby
+	ReconcileSeconds      int    // 전체 동기화(누락분 보정) 주기
+	ReplicationMaxPending int    // 복제 대기 세션 수 상한 (초과분은 다음 전체 동기화로 보정)
 }
 
 // GuardConfig: 입력 검증 설정입니다.
@@ -104,6 +196,22 @@ type GuardConfig struct {
 	RulepacksDir    string
 	CacheMaxSize    int
 	CacheTTLSeconds int
+	AuditEnabled    bool // 차단된 입력을 Postgres 감사 테이블에 기록
+}
+
+// LanguageConfig: LLM 출력 언어 검사/재시도 설정입니다.
+type LanguageConfig struct {
+	Enabled        bool
+	MaxRetries     int     // 언어 위반 시 교정 지시를 붙여 재요청하는 최대 횟수
+	MinLetters     int     // 이보다 글자 수가 적은 출력은 판정하지 않음
+	MinHangulRatio float64 // 한국어로 인정하는 최소 한글 비율 (0.0 ~ 1.0)
+	LocalizeNames  bool    // 출력 속 탤런트 이름을 한국어 표기로 치환
+}
+
+// StructuredRepairConfig: 스키마 검증에 실패한 구조화 응답의 교정 재요청 설정입니다.
+type StructuredRepairConfig struct {
+	Enabled     bool
+	MaxAttempts int // 검증 오류를 알려 주며 재요청하는 최대 횟수 (0이면 검증만 하고 바로 실패)
 }
 
 // LoggingConfig: 로깅 설정입니다.
@@ -144,6 +252,36 @@ type HTTPRateLimitConfig struct {
 	CacheTTLSeconds   int
 }
 
+// BotQuota: 봇 하나의 일일 예산입니다. 0이면 해당 항목은 무제한입니다.
+type BotQuota struct {
+	DailyRequests int64
+	DailyTokens   int64
+}
+
+// QuotaConfig: 호출 봇(x-bot-id)별 일일 요청/토큰 예산 설정입니다.
+type QuotaConfig struct {
+	Enabled bool
+	Bots    map[string]BotQuota // bot id -> 예산 (twentyq, turtlesoup, holo)
+}
+
+// ShadowConfig: 20Q 정답 판정 후보 프롬프트의 섀도 평가 설정입니다.
+type ShadowConfig struct {
+	Enabled        bool
+	SampleRate     float64 // LLM 판정 요청 중 후보 프롬프트를 함께 실행할 비율 (0.0 ~ 1.0)
+	CandidateLabel string  // 보고서에서 후보 프롬프트 버전을 구분하는 라벨
+	MaxInFlight    int     // 동시에 실행할 섀도 평가 수 (초과분은 건너뜀)
+	TimeoutSeconds int
+}
+
+// EmbeddingConfig: 의미 유사도 비교용 Gemini 임베딩 설정입니다.
+type EmbeddingConfig struct {
+	Model              string
+	Dimensions         int // 출력 차원 (0이면 모델 기본값)
+	MaxTexts           int // 요청 하나에 담을 수 있는 텍스트 수
+	CacheTTLSeconds    int // 임베딩 벡터 캐시 유지 시간
+	CacheMemoryMaxSize int // 세션 저장소가 메모리 백엔드일 때 캐시 항목 수 상한
+}
+
 // DatabaseConfig: DB 연결 및 저장 설정입니다.
 type DatabaseConfig struct {
 	Host                                 string
@@ -199,14 +337,21 @@ func (d DatabaseConfig) DSN() string {
 // Config: 애플리케이션 전체 설정입니다.
 type Config struct {
 	Gemini        GeminiConfig
+	OpenAI        OpenAIConfig
+	LLMRouting    LLMRoutingConfig
 	Session       SessionConfig
 	SessionStore  SessionStoreConfig
 	Guard         GuardConfig
+	Language      LanguageConfig
+	Repair        StructuredRepairConfig
 	Logging       LoggingConfig
 	HTTP          HTTPConfig
 	GRPC          GRPCConfig
 	HTTPAuth      HTTPAuthConfig
 	HTTPRateLimit HTTPRateLimitConfig
+	Quota         QuotaConfig
+	Shadow        ShadowConfig
+	Embedding     EmbeddingConfig
 	Database      DatabaseConfig
 	Telemetry     TelemetryConfig
 }
@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guardaudit"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/session"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/shadow"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
 )
 
@@ -23,6 +25,9 @@ type App struct {
 	SessionStore    *session.Store
 	UsageRepository *usage.Repository
 	UsageRecorder   *usage.Recorder
+	UsageLiveFeed   *usage.LiveFeed
+	ShadowEvaluator *shadow.Evaluator
+	GuardAudit      *guardaudit.Recorder
 }
 
 // NewApp: App 인스턴스를 생성합니다.
@@ -36,6 +41,9 @@ func NewApp(
 	sessionStore *session.Store,
 	usageRepository *usage.Repository,
 	usageRecorder *usage.Recorder,
+	usageLiveFeed *usage.LiveFeed,
+	shadowEvaluator *shadow.Evaluator,
+	guardAudit *guardaudit.Recorder,
 ) *App {
 	return &App{
 		Server:          server,
@@ -47,6 +55,9 @@ func NewApp(
 		SessionStore:    sessionStore,
 		UsageRepository: usageRepository,
 		UsageRecorder:   usageRecorder,
+		UsageLiveFeed:   usageLiveFeed,
+		ShadowEvaluator: shadowEvaluator,
+		GuardAudit:      guardAudit,
 	}
 }
 
@@ -64,6 +75,10 @@ func (a *App) Close() {
 	if a.SessionStore != nil {
 		a.SessionStore.Close()
 	}
+	a.UsageLiveFeed.Stop()
+	// 진행 중인 섀도 평가 기록이 DB 연결 종료 전에 끝나도록 먼저 정리
+	a.ShadowEvaluator.Close()
+	a.GuardAudit.Close()
 	if a.UsageRecorder != nil {
 		a.UsageRecorder.Close()
 	}
@@ -1,21 +1,28 @@
 package di
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/reflection"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/turtlesoup"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/twentyq"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/embedding"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver"
 	llmv1 "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/pb/llm/v1"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guardaudit"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/handler"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/server"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/session"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/shadow"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/topicpack"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
 )
 
@@ -36,27 +43,79 @@ func InitializeApp() (*App, error) {
 	usageRepository := usage.NewRepository(cfg, logger)
 	usageRecorder := usage.NewRecorder(cfg, usageRepository, logger)
 
+	quotaTracker := quota.NewTracker(cfg.Quota)
+	usageRecorder.SetTokenObserver(quotaTracker)
+
+	usageLiveFeed := usage.NewLiveFeed(usage.DefaultLiveInterval)
+	usageLiveFeed.Start()
+	usageRecorder.SetLiveFeed(usageLiveFeed)
+
 	geminiClient, err := gemini.NewClient(cfg, metricsStore, usageRecorder)
 	if err != nil {
 		return nil, fmt.Errorf("gemini client: %w", err)
 	}
+	geminiClient.SetQuotaTracker(quotaTracker)
+	geminiClient.StartEndpointProbing()
+
+	llmRouter, err := provideLLMRouter(cfg, logger, metricsStore, usageRecorder, geminiClient)
+	if err != nil {
+		return nil, fmt.Errorf("llm router: %w", err)
+	}
+
+	// 스키마 교정이 먼저 끝난 응답에 언어 검사를 적용 (Enforcer → Repairer → Router)
+	structuredRepairer := provideStructuredRepairer(cfg, logger, metricsStore, llmRouter)
+
+	llmProvider, err := provideLanguageEnforcer(cfg, logger, metricsStore, structuredRepairer)
+	if err != nil {
+		return nil, fmt.Errorf("language enforcer: %w", err)
+	}
 
 	injectionGuard, err := guard.NewGuard(cfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("guard: %w", err)
 	}
 
-	llmHandler := handler.NewLLMHandler(cfg, geminiClient, injectionGuard, metricsStore, usageRepository, logger)
+	llmHandler := handler.NewLLMHandler(cfg, llmProvider, injectionGuard, metricsStore, usageRepository, logger)
 
 	sessionStore, err := session.NewStore(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("session store: %w", err)
 	}
+	sessionStore.StartReplication()
+	// 긴 세션은 오래된 히스토리를 요약 항목으로 압축 (SESSION_HISTORY_COMPRESS_THRESHOLD=0이면 비활성)
+	sessionStore.EnableHistorySummary(llmProvider, logger)
+
+	// 호출 봇별 가드 프로필은 세션 저장소(Valkey)에 보관하며, 불러오기 실패 시 기본 동작으로 시작
+	profileCtx, cancelProfiles := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := injectionGuard.AttachProfileStore(profileCtx, sessionStore); err != nil {
+		logger.Warn("guard_profiles_unavailable", "err", err)
+	}
+	// 관리 API로 추가한 규칙과 공통 allowlist도 같은 저장소에서 불러옴
+	if err := injectionGuard.AttachRuleStore(profileCtx, sessionStore); err != nil {
+		logger.Warn("guard_rules_unavailable", "err", err)
+	}
+	cancelProfiles()
+
+	// 임베딩 벡터는 세션 저장소(Valkey)에 캐시해 같은 텍스트를 다시 계산하지 않음
+	embeddingService := embedding.NewService(cfg.Embedding, geminiClient, sessionStore, logger)
+	embeddingHandler := handler.NewEmbeddingHandler(embeddingService, logger)
 
-	sessionManager := session.NewManager(sessionStore, geminiClient, cfg, logger)
+	sessionManager := session.NewManager(sessionStore, llmProvider, cfg, logger)
 	sessionHandler := handler.NewSessionHandler(sessionManager, injectionGuard, logger)
-	guardHandler := handler.NewGuardHandler(injectionGuard)
-	usageHandler := handler.NewUsageHandler(cfg, usageRepository, logger)
+	sessionStoreHandler := handler.NewSessionStoreHandler(sessionStore, logger)
+	// 차단된 입력은 해시와 적중 규칙만 감사 테이블에 남겨 관리자가 오탐을 검토
+	guardAuditRepository := guardaudit.NewRepository(usageRepository)
+	var guardAuditRecorder *guardaudit.Recorder
+	if cfg.Guard.AuditEnabled {
+		guardAuditRecorder = guardaudit.NewRecorder(guardAuditRepository, logger)
+		injectionGuard.SetAuditSink(guardAuditRecorder)
+	}
+	guardHandler := handler.NewGuardHandler(injectionGuard, guardAuditRepository, logger)
+	usageHandler := handler.NewUsageHandler(cfg, usageRepository, usageLiveFeed, logger)
+
+	shadowRepository := shadow.NewRepository(usageRepository)
+	shadowEvaluator := shadow.NewEvaluator(cfg.Shadow, shadowRepository, logger)
+	shadowHandler := handler.NewShadowHandler(cfg, shadowRepository, shadowEvaluator, logger)
 
 	twentyqPrompts, err := twentyq.NewPrompts()
 	if err != nil {
@@ -68,7 +127,17 @@ func InitializeApp() (*App, error) {
 		return nil, fmt.Errorf("topic loader: %w", err)
 	}
 
-	twentyQHandler := handler.NewTwentyQHandler(cfg, geminiClient, injectionGuard, sessionStore, twentyqPrompts, topicLoader, logger)
+	// 관리자가 등록한 카테고리 팩을 내장 토픽에 합침 (DB를 쓸 수 없으면 내장 토픽만으로 시작)
+	topicPackRepository := topicpack.NewRepository(usageRepository)
+	topicPackRefresher := topicpack.NewRefresher(topicPackRepository, topicLoader, logger)
+	packCtx, cancelPacks := context.WithTimeout(context.Background(), 5*time.Second)
+	if _, err := topicPackRefresher.Refresh(packCtx); err != nil {
+		logger.Warn("topic_packs_unavailable", "err", err)
+	}
+	cancelPacks()
+	topicPackHandler := handler.NewTopicPackHandler(topicPackRepository, topicPackRefresher, logger)
+
+	twentyQHandler := handler.NewTwentyQHandler(cfg, geminiClient, llmProvider, injectionGuard, sessionStore, twentyqPrompts, topicLoader, shadowEvaluator, logger)
 
 	turtlesoupPrompts, err := turtlesoup.NewPrompts()
 	if err != nil {
@@ -80,22 +149,27 @@ func InitializeApp() (*App, error) {
 		return nil, fmt.Errorf("puzzle loader: %w", err)
 	}
 
-	turtleSoupHandler := handler.NewTurtleSoupHandler(cfg, geminiClient, injectionGuard, sessionStore, turtlesoupPrompts, puzzleLoader, logger)
+	turtleSoupHandler := handler.NewTurtleSoupHandler(cfg, llmProvider, injectionGuard, sessionStore, turtlesoupPrompts, puzzleLoader, logger)
+	hololiveScheduleHandler := handler.NewHololiveScheduleHandler(llmProvider, injectionGuard, logger)
 
 	grpcLLMService := grpcserver.NewLLMService(
 		cfg,
 		logger,
 		geminiClient,
+		llmProvider,
 		injectionGuard,
 		sessionStore,
 		usageRepository,
+		quotaTracker,
 		twentyqPrompts,
 		topicLoader,
+		shadowEvaluator,
 		turtlesoupPrompts,
 		puzzleLoader,
+		embeddingService,
 	)
 
-	grpcServer, grpcListener, grpcUDSListener, err := grpcserver.NewServer(cfg, logger)
+	grpcServer, grpcListener, grpcUDSListener, err := grpcserver.NewServer(cfg, logger, quotaTracker)
 	if err != nil {
 		return nil, fmt.Errorf("grpc server: %w", err)
 	}
@@ -104,8 +178,11 @@ func InitializeApp() (*App, error) {
 		reflection.Register(grpcServer) // grpcurl 등 도구 지원
 	}
 
-	router := handler.NewRouter(cfg, logger, llmHandler, sessionHandler, guardHandler, usageHandler, twentyQHandler, turtleSoupHandler)
+	router := handler.NewRouter(cfg, logger, quotaTracker, llmHandler, embeddingHandler, sessionHandler, sessionStoreHandler, guardHandler, usageHandler, shadowHandler, twentyQHandler, topicPackHandler, turtleSoupHandler, hololiveScheduleHandler)
 	httpServer := server.NewHTTPServer(cfg, router)
+	// Shutdown이 열린 SSE 연결을 타임아웃까지 기다리지 않도록 구독을 먼저 닫음
+	httpServer.RegisterOnShutdown(usageLiveFeed.Stop)
+	httpServer.RegisterOnShutdown(geminiClient.Close)
 
-	return NewApp(httpServer, grpcServer, grpcListener, grpcUDSListener, logger, cfg, sessionStore, usageRepository, usageRecorder), nil
+	return NewApp(httpServer, grpcServer, grpcListener, grpcUDSListener, logger, cfg, sessionStore, usageRepository, usageRecorder, usageLiveFeed, shadowEvaluator, guardAuditRecorder), nil
 }
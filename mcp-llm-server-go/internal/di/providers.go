@@ -5,7 +5,14 @@ import (
 	"log/slog"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/langcheck"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/logging"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/openai"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/structured"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
 )
 
 // ProvideLogger: 로거를 구성해 반환합니다.
@@ -17,3 +24,67 @@ func ProvideLogger(cfg *config.Config) (*slog.Logger, error) {
 	}
 	return logger, nil
 }
+
+// provideLLMRouter: 라우팅 설정이 참조하는 공급자만 생성해 Router로 묶습니다.
+// Gemini는 검색/합의 기능 때문에 항상 등록됩니다.
+func provideLLMRouter(
+	cfg *config.Config,
+	logger *slog.Logger,
+	metricsStore *metrics.Store,
+	usageRecorder *usage.Recorder,
+	geminiClient *gemini.Client,
+) (*llm.Router, error) {
+	providers := []llm.Provider{geminiClient}
+
+	if cfg.LLMRouting.Uses(llm.ProviderOpenAI) {
+		openaiClient, err := openai.NewClient(cfg, metricsStore, usageRecorder)
+		if err != nil {
+			return nil, fmt.Errorf("openai client: %w", err)
+		}
+		providers = append(providers, openaiClient)
+	}
+
+	router, err := llm.NewRouter(cfg.LLMRouting, logger, providers...)
+	if err != nil {
+		return nil, fmt.Errorf("new router: %w", err)
+	}
+	return router, nil
+}
+
+// provideStructuredRepairer: Router의 Structured 응답을 스키마로 검증하고 실패 시 교정 재요청하도록 감쌉니다.
+func provideStructuredRepairer(
+	cfg *config.Config,
+	logger *slog.Logger,
+	metricsStore *metrics.Store,
+	router *llm.Router,
+) *structured.Repairer {
+	logger.Debug("structured_repairer_configured",
+		"enabled", cfg.Repair.Enabled,
+		"max_attempts", cfg.Repair.MaxAttempts,
+	)
+	return structured.NewRepairer(cfg.Repair, router, metricsStore, logger)
+}
+
+// provideLanguageEnforcer: 공급자 응답에 출력 언어 검사와 탤런트 이름 한국어 치환을 적용합니다.
+func provideLanguageEnforcer(
+	cfg *config.Config,
+	logger *slog.Logger,
+	metricsStore *metrics.Store,
+	next llm.Provider,
+) (*langcheck.Enforcer, error) {
+	var names *langcheck.NameLocalizer
+	if cfg.Language.LocalizeNames {
+		loaded, err := langcheck.NewNameLocalizer()
+		if err != nil {
+			return nil, fmt.Errorf("talent names: %w", err)
+		}
+		names = loaded
+	}
+
+	logger.Debug("language_enforcer_configured",
+		"enabled", cfg.Language.Enabled,
+		"max_retries", cfg.Language.MaxRetries,
+		"talent_names", names.Size(),
+	)
+	return langcheck.NewEnforcer(cfg.Language, next, names, metricsStore, logger), nil
+}
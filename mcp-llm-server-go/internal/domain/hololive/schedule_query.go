@@ -0,0 +1,114 @@
+// Package hololive: 홀로라이브 봇이 사용하는 자연어 질의 해석 도메인입니다.
+package hololive
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 질의 의도
+const (
+	IntentSchedule = "schedule"
+	IntentUnknown  = "unknown"
+)
+
+const (
+	// DateLayout: 요청/응답에서 사용하는 날짜 형식
+	DateLayout = "2006-01-02"
+	// MaxScheduleSpanDays: 한 번에 조회할 수 있는 최대 기간 (봇의 !일정 상한과 동일)
+	MaxScheduleSpanDays = 30
+	// DefaultScheduleSpanDays: 기간을 알 수 없을 때 사용하는 기본 기간
+	DefaultScheduleSpanDays = 7
+	// maxMemberRunes: 멤버 이름으로 받아들이는 최대 길이
+	maxMemberRunes = 40
+)
+
+// scheduleQueryPrompt: 오늘 날짜와 요일을 함께 주어 "이번주", "다음주 토요일" 같은 상대 표현을 절대 날짜로 바꾸게 합니다.
+const scheduleQueryPrompt = `당신은 홀로라이브 VTuber 방송 일정 질문을 해석하는 파서입니다.
+오늘은 %s (%s)이고 시간대는 KST입니다. 주의 시작은 월요일입니다.
+
+규칙:
+- 특정 멤버의 방송 일정을 묻는 질문이면 intent를 "schedule"로, 그 밖의 질문이면 "unknown"으로 둡니다.
+- member에는 질문에 나온 멤버 이름이나 별명을 그대로 적습니다. (예: "페코라", "마린", "pekora")
+- start_date와 end_date는 YYYY-MM-DD 형식이며 둘 다 포함 범위입니다.
+- "오늘"은 오늘 하루, "내일"은 내일 하루, "이번주"는 오늘부터 이번 주 일요일까지, "다음주"는 다음 주 월요일부터 일요일까지, "주말"은 가장 가까운 토요일과 일요일입니다.
+- 기간이 없으면 오늘부터 %d일간으로 둡니다.
+- intent가 "unknown"이면 나머지 필드는 빈 문자열로 둡니다.
+
+질문: %s`
+
+var scheduleQuerySchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"intent": map[string]any{
+			"type": "string",
+			"enum": []string{IntentSchedule, IntentUnknown},
+		},
+		"member":     map[string]any{"type": "string"},
+		"start_date": map[string]any{"type": "string"},
+		"end_date":   map[string]any{"type": "string"},
+	},
+	"required": []string{"intent", "member", "start_date", "end_date"},
+}
+
+// ScheduleQuerySchema: 일정 질의 해석 JSON 스키마를 반환합니다.
+func ScheduleQuerySchema() map[string]any {
+	return scheduleQuerySchema
+}
+
+// ScheduleQueryPrompt: 질문과 기준 날짜로 해석 프롬프트를 만듭니다.
+func ScheduleQueryPrompt(query string, today time.Time) string {
+	return fmt.Sprintf(scheduleQueryPrompt, today.Format(DateLayout), koreanWeekday(today.Weekday()), DefaultScheduleSpanDays, query)
+}
+
+// ScheduleQuery: 해석된 일정 질의입니다.
+type ScheduleQuery struct {
+	Intent    string `json:"intent"`
+	Member    string `json:"member"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// NormalizeScheduleQuery: LLM 출력을 검증해 봇이 그대로 쓸 수 있는 형태로 정리합니다.
+// 멤버가 없으면 unknown으로, 날짜가 잘못되었으면 기본 기간으로, 너무 긴 기간은 상한까지로 바꿉니다.
+func NormalizeScheduleQuery(raw ScheduleQuery, today time.Time) ScheduleQuery {
+	member := strings.TrimSpace(raw.Member)
+	if raw.Intent != IntentSchedule || member == "" || len([]rune(member)) > maxMemberRunes {
+		return ScheduleQuery{Intent: IntentUnknown}
+	}
+
+	today = truncateDay(today)
+	start, startErr := time.ParseInLocation(DateLayout, strings.TrimSpace(raw.StartDate), today.Location())
+	end, endErr := time.ParseInLocation(DateLayout, strings.TrimSpace(raw.EndDate), today.Location())
+	if startErr != nil || endErr != nil || end.Before(start) {
+		start = today
+		end = today.AddDate(0, 0, DefaultScheduleSpanDays-1)
+	}
+	// 지난 날짜는 조회할 수 없으므로 오늘부터로 당김
+	if start.Before(today) {
+		start = today
+	}
+	if end.Before(start) {
+		end = start
+	}
+	if limit := start.AddDate(0, 0, MaxScheduleSpanDays-1); end.After(limit) {
+		end = limit
+	}
+
+	return ScheduleQuery{
+		Intent:    IntentSchedule,
+		Member:    member,
+		StartDate: start.Format(DateLayout),
+		EndDate:   end.Format(DateLayout),
+	}
+}
+
+func truncateDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func koreanWeekday(weekday time.Weekday) string {
+	return [...]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"}[weekday]
+}
@@ -0,0 +1,65 @@
+package hololive
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeScheduleQuery(t *testing.T) {
+	today := time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC) // 수요일
+
+	cases := []struct {
+		name string
+		raw  ScheduleQuery
+		want ScheduleQuery
+	}{
+		{
+			name: "valid range kept",
+			raw:  ScheduleQuery{Intent: IntentSchedule, Member: " 페코라 ", StartDate: "2026-03-04", EndDate: "2026-03-08"},
+			want: ScheduleQuery{Intent: IntentSchedule, Member: "페코라", StartDate: "2026-03-04", EndDate: "2026-03-08"},
+		},
+		{
+			name: "unknown intent clears fields",
+			raw:  ScheduleQuery{Intent: IntentUnknown, Member: "페코라", StartDate: "2026-03-04", EndDate: "2026-03-04"},
+			want: ScheduleQuery{Intent: IntentUnknown},
+		},
+		{
+			name: "missing member is unknown",
+			raw:  ScheduleQuery{Intent: IntentSchedule, StartDate: "2026-03-04", EndDate: "2026-03-04"},
+			want: ScheduleQuery{Intent: IntentUnknown},
+		},
+		{
+			name: "invalid dates fall back to default span",
+			raw:  ScheduleQuery{Intent: IntentSchedule, Member: "마린", StartDate: "next week", EndDate: ""},
+			want: ScheduleQuery{Intent: IntentSchedule, Member: "마린", StartDate: "2026-03-04", EndDate: "2026-03-10"},
+		},
+		{
+			name: "past start clamped to today",
+			raw:  ScheduleQuery{Intent: IntentSchedule, Member: "마린", StartDate: "2026-03-01", EndDate: "2026-03-05"},
+			want: ScheduleQuery{Intent: IntentSchedule, Member: "마린", StartDate: "2026-03-04", EndDate: "2026-03-05"},
+		},
+		{
+			name: "long span clamped",
+			raw:  ScheduleQuery{Intent: IntentSchedule, Member: "마린", StartDate: "2026-03-10", EndDate: "2026-06-01"},
+			want: ScheduleQuery{Intent: IntentSchedule, Member: "마린", StartDate: "2026-03-10", EndDate: "2026-04-08"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NormalizeScheduleQuery(tc.raw, today); got != tc.want {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestScheduleQueryPromptIncludesToday(t *testing.T) {
+	prompt := ScheduleQueryPrompt("페코라 이번주 언제 방송해?", time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC))
+	for _, want := range []string{"2026-03-04", "수요일", "페코라 이번주 언제 방송해?"} {
+		if !strings.Contains(prompt, want) {
+			t.Fatalf("prompt missing %q", want)
+		}
+	}
+}
@@ -100,6 +100,40 @@ type HintOutput struct {
 	Hint string `json:"hint"`
 }
 
+// EpilogueOutput: 후일담(보너스 라운드) 출력 스키마다.
+type EpilogueOutput struct {
+	Epilogue string `json:"epilogue"`
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+// 후일담 응답 길이 상한(rune). 프롬프트 지시보다 약간 여유를 두고 넘치면 잘라냅니다.
+const (
+	EpilogueMaxRunes         = 240
+	EpilogueQuestionMaxRunes = 100
+	EpilogueAnswerMaxRunes   = 50
+)
+
+// Clamp: 공백을 정리하고 각 필드를 길이 상한에 맞게 잘라냅니다.
+// 카카오톡 한 메시지에 정답 해설과 함께 실리므로 모델이 지시를 어겨도 길이가 늘어나지 않게 합니다.
+func (o EpilogueOutput) Clamp() EpilogueOutput {
+	return EpilogueOutput{
+		Epilogue: clampRunes(o.Epilogue, EpilogueMaxRunes),
+		Question: clampRunes(o.Question, EpilogueQuestionMaxRunes),
+		Answer:   clampRunes(o.Answer, EpilogueAnswerMaxRunes),
+	}
+}
+
+// clampRunes: limit 글자를 넘으면 말줄임표를 포함해 limit 글자로 자릅니다.
+func clampRunes(s string, limit int) string {
+	s = strings.TrimSpace(s)
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
+
 // PuzzleOutput: 퍼즐 생성 출력 스키마다.
 type PuzzleOutput struct {
 	Title      string   `json:"title"`
@@ -118,6 +152,16 @@ type RewriteOutput struct {
 
 var hintSchema = domainmodels.RequiredStringFieldSchema("hint")
 
+var epilogueSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"epilogue": map[string]any{"type": "string"},
+		"question": map[string]any{"type": "string"},
+		"answer":   map[string]any{"type": "string"},
+	},
+	"required": []string{"epilogue", "question", "answer"},
+}
+
 var puzzleSchema = map[string]any{
 	"type": "object",
 	"properties": map[string]any{
@@ -148,6 +192,11 @@ func HintSchema() map[string]any {
 	return hintSchema
 }
 
+// EpilogueSchema: 후일담 JSON 스키마를 반환합니다.
+func EpilogueSchema() map[string]any {
+	return epilogueSchema
+}
+
 // PuzzleSchema: 퍼즐 JSON 스키마를 반환합니다.
 func PuzzleSchema() map[string]any {
 	return puzzleSchema
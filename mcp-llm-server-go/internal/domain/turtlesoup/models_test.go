@@ -1,6 +1,9 @@
 package turtlesoup
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestParseBaseAnswer(t *testing.T) {
 	base, ok := ParseBaseAnswer("예, 중요한 질문입니다!")
@@ -29,3 +32,18 @@ func TestParseValidationResult(t *testing.T) {
 		t.Fatalf("unexpected validation parse: %v %v", result, ok)
 	}
 }
+
+func TestEpilogueOutputClamp(t *testing.T) {
+	out := EpilogueOutput{
+		Epilogue: strings.Repeat("가", EpilogueMaxRunes+10),
+		Question: "  그는 어디로 갔을까요?  ",
+		Answer:   "바다",
+	}.Clamp()
+
+	if n := len([]rune(out.Epilogue)); n != EpilogueMaxRunes || !strings.HasSuffix(out.Epilogue, "…") {
+		t.Fatalf("epilogue must be clamped to %d runes, got %d", EpilogueMaxRunes, n)
+	}
+	if out.Question != "그는 어디로 갔을까요?" || out.Answer != "바다" {
+		t.Fatalf("short fields must only be trimmed: %+v", out)
+	}
+}
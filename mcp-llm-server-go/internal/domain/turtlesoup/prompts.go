@@ -152,6 +152,34 @@ func (p *Prompts) RevealUser(puzzle string) (string, error) {
 	return formatted, nil
 }
 
+// EpilogueSystem: 후일담(보너스 라운드) 시스템 프롬프트를 반환합니다.
+func (p *Prompts) EpilogueSystem() (string, error) {
+	data, err := p.getPrompt("epilogue")
+	if err != nil {
+		return "", err
+	}
+	return p.field(data, "system", "epilogue.system")
+}
+
+// EpilogueUser: 후일담(보너스 라운드) 유저 프롬프트를 반환합니다.
+func (p *Prompts) EpilogueUser(puzzle string) (string, error) {
+	data, err := p.getPrompt("epilogue")
+	if err != nil {
+		return "", err
+	}
+	template, err := p.field(data, "user", "epilogue.user")
+	if err != nil {
+		return "", err
+	}
+	formatted, err := prompt.FormatTemplate(template, map[string]string{
+		"puzzle": prompt.WrapXML("puzzle", puzzle),
+	})
+	if err != nil {
+		return "", fmt.Errorf("format epilogue.user: %w", err)
+	}
+	return formatted, nil
+}
+
 // GenerateSystem: 퍼즐 생성 시스템 프롬프트를 반환합니다.
 func (p *Prompts) GenerateSystem() (string, error) {
 	data, err := p.getPrompt("generate")
@@ -74,3 +74,19 @@ func TestAnswerUserMinimal(t *testing.T) {
 		t.Fatalf("user prompt should not contain history header")
 	}
 }
+
+func TestEpiloguePrompts(t *testing.T) {
+	prompts, err := NewPrompts()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	system, err := prompts.EpilogueSystem()
+	if err != nil || !strings.Contains(system, "epilogue") {
+		t.Fatalf("unexpected epilogue system prompt: %q, %v", system, err)
+	}
+	user, err := prompts.EpilogueUser("scenario: 시나리오")
+	if err != nil || !strings.Contains(user, "<puzzle>") {
+		t.Fatalf("unexpected epilogue user prompt: %q, %v", user, err)
+	}
+}
@@ -161,6 +161,24 @@ var verifySchema = map[string]any{
 
 var synonymSchema = domainmodels.RequiredStringFieldSchema("result")
 
+// summarySchema: 진행 요약 스키마 (요약 문장 + 배제된 가능성 목록)
+var summarySchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"summary": map[string]any{
+			"type":        "string",
+			"description": "2-3 sentence Korean recap of the traits confirmed so far",
+		},
+		"eliminated": map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type": "string",
+			},
+		},
+	},
+	"required": []string{"summary", "eliminated"},
+}
+
 // HintsSchema: 힌트 JSON 스키마를 반환합니다.
 func HintsSchema() map[string]any {
 	return hintsSchema
@@ -176,6 +194,11 @@ func VerifySchema() map[string]any {
 	return verifySchema
 }
 
+// SummarySchema: 진행 요약 JSON 스키마를 반환합니다.
+func SummarySchema() map[string]any {
+	return summarySchema
+}
+
 // SynonymSchema: 유사어 JSON 스키마를 반환합니다.
 func SynonymSchema() map[string]any {
 	return synonymSchema
@@ -118,20 +118,38 @@ func (p *Prompts) AnswerUser(question string) (string, error) {
 
 // VerifySystem: 검증 시스템 프롬프트를 반환합니다.
 func (p *Prompts) VerifySystem() (string, error) {
-	data, err := p.getPrompt("verify-answer")
+	return p.verifySystem("verify-answer")
+}
+
+// VerifyUser: 검증 유저 프롬프트를 반환합니다.
+func (p *Prompts) VerifyUser(target string, guess string) (string, error) {
+	return p.verifyUser("verify-answer", target, guess)
+}
+
+// VerifyCandidateSystem: 섀도 평가 중인 후보 검증 시스템 프롬프트를 반환합니다.
+func (p *Prompts) VerifyCandidateSystem() (string, error) {
+	return p.verifySystem("verify-answer-candidate")
+}
+
+// VerifyCandidateUser: 섀도 평가 중인 후보 검증 유저 프롬프트를 반환합니다.
+func (p *Prompts) VerifyCandidateUser(target string, guess string) (string, error) {
+	return p.verifyUser("verify-answer-candidate", target, guess)
+}
+
+func (p *Prompts) verifySystem(name string) (string, error) {
+	data, err := p.getPrompt(name)
 	if err != nil {
 		return "", err
 	}
-	return p.field(data, "system", "verify-answer.system")
+	return p.field(data, "system", name+".system")
 }
 
-// VerifyUser: 검증 유저 프롬프트를 반환합니다.
-func (p *Prompts) VerifyUser(target string, guess string) (string, error) {
-	data, err := p.getPrompt("verify-answer")
+func (p *Prompts) verifyUser(name string, target string, guess string) (string, error) {
+	data, err := p.getPrompt(name)
 	if err != nil {
 		return "", err
 	}
-	template, err := p.field(data, "user", "verify-answer.user")
+	template, err := p.field(data, "user", name+".user")
 	if err != nil {
 		return "", err
 	}
@@ -140,7 +158,7 @@ func (p *Prompts) VerifyUser(target string, guess string) (string, error) {
 		"guess":  prompt.WrapXML("guess", guess),
 	})
 	if err != nil {
-		return "", fmt.Errorf("format verify-answer.user: %w", err)
+		return "", fmt.Errorf("format %s.user: %w", name, err)
 	}
 	return formatted, nil
 }
@@ -202,6 +220,37 @@ func (p *Prompts) SynonymUser(target string, guess string) (string, error) {
 	return formatted, nil
 }
 
+// SummarySystem: 관전자용 진행 요약 시스템 프롬프트를 반환합니다.
+func (p *Prompts) SummarySystem() (string, error) {
+	data, err := p.getPrompt("summary")
+	if err != nil {
+		return "", err
+	}
+	return p.field(data, "system", "summary.system")
+}
+
+// SummaryUser: 진행 요약 유저 프롬프트를 반환합니다. 각 목록은 줄 단위로 미리 포맷된 문자열입니다.
+func (p *Prompts) SummaryUser(category string, history string, hints string, wrongGuesses string) (string, error) {
+	data, err := p.getPrompt("summary")
+	if err != nil {
+		return "", err
+	}
+	template, err := p.field(data, "user", "summary.user")
+	if err != nil {
+		return "", err
+	}
+	formatted, err := prompt.FormatTemplate(template, map[string]string{
+		"category":      prompt.WrapXML("category", category),
+		"history":       prompt.WrapXML("history", history),
+		"hints":         prompt.WrapXML("hints", hints),
+		"wrong_guesses": prompt.WrapXML("wrong_guesses", wrongGuesses),
+	})
+	if err != nil {
+		return "", fmt.Errorf("format summary.user: %w", err)
+	}
+	return formatted, nil
+}
+
 func (p *Prompts) getPrompt(name string) (map[string]string, error) {
 	if p == nil {
 		return nil, fmt.Errorf("twentyq prompts not initialized")
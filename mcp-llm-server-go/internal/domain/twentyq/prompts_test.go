@@ -58,3 +58,30 @@ func TestAnswerPrompts(t *testing.T) {
 		t.Fatalf("expected question in user prompt")
 	}
 }
+
+func TestVerifyCandidatePrompts(t *testing.T) {
+	prompts, err := NewPrompts()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	system, err := prompts.VerifyCandidateSystem()
+	if err != nil {
+		t.Fatalf("VerifyCandidateSystem error: %v", err)
+	}
+	current, err := prompts.VerifySystem()
+	if err != nil {
+		t.Fatalf("VerifySystem error: %v", err)
+	}
+	if system == "" || system == current {
+		t.Fatalf("expected distinct candidate system prompt")
+	}
+
+	user, err := prompts.VerifyCandidateUser("휴대폰", "핸드폰")
+	if err != nil {
+		t.Fatalf("VerifyCandidateUser error: %v", err)
+	}
+	if !strings.Contains(user, "휴대폰") || !strings.Contains(user, "핸드폰") {
+		t.Fatalf("expected target and guess in user prompt: %s", user)
+	}
+}
@@ -24,10 +24,26 @@ type TopicEntry struct {
 }
 
 // TopicLoader: 카테고리별 토픽을 로드하고 선택합니다.
+// 내장 토픽(builtin)에 관리자가 등록한 팩(ApplyPacks)을 합친 목록에서 선택합니다.
 type TopicLoader struct {
-	mu     sync.RWMutex
-	topics map[string][]TopicEntry
-	rng    *randx.LockedRand
+	mu      sync.RWMutex
+	builtin map[string][]TopicEntry
+	topics  map[string][]TopicEntry
+	rng     *randx.LockedRand
+}
+
+// TopicPack: 내장 토픽에 합칠 카테고리 팩입니다.
+type TopicPack struct {
+	Category string
+	Topics   []TopicEntry
+}
+
+// PackMergeStats: ApplyPacks 결과 요약입니다.
+type PackMergeStats struct {
+	Packs       int `json:"packs"`
+	Categories  int `json:"categories"`
+	Topics      int `json:"topics"`
+	AddedTopics int `json:"added_topics"`
 }
 
 // topicItemRaw JSON 파싱용 중간 구조체.
@@ -61,8 +77,8 @@ var AllCategories = []string{
 // NewTopicLoader: TopicLoader를 생성하고 토픽을 로드합니다.
 func NewTopicLoader() (*TopicLoader, error) {
 	loader := &TopicLoader{
-		topics: make(map[string][]TopicEntry),
-		rng:    randx.New(rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))),
+		builtin: make(map[string][]TopicEntry),
+		rng:     randx.New(rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))),
 	}
 	if err := loader.load(); err != nil {
 		return nil, err
@@ -77,15 +93,67 @@ func (l *TopicLoader) load() error {
 		if err != nil {
 			return fmt.Errorf("load category %s: %w", category, err)
 		}
-		l.topics[category] = entries
+		l.builtin[category] = entries
 		totalCount += len(entries)
 	}
 	if totalCount == 0 {
 		return fmt.Errorf("no topics loaded")
 	}
+	l.topics = l.builtin
 	return nil
 }
 
+// ApplyPacks: 내장 토픽에 packs를 합친 목록으로 교체합니다.
+// 같은 카테고리면 내장 토픽 뒤에 이어 붙이고(이름 중복 제외), 새 카테고리는 그대로 추가합니다.
+// 빈 packs를 넘기면 내장 토픽만 남습니다.
+func (l *TopicLoader) ApplyPacks(packs []TopicPack) PackMergeStats {
+	merged := make(map[string][]TopicEntry, len(l.builtin)+len(packs))
+	for category, entries := range l.builtin {
+		merged[category] = entries
+	}
+
+	stats := PackMergeStats{Packs: len(packs)}
+	for _, pack := range packs {
+		category := strings.ToLower(strings.TrimSpace(pack.Category))
+		if category == "" {
+			continue
+		}
+
+		existing := merged[category]
+		seen := make(map[string]struct{}, len(existing)+len(pack.Topics))
+		for _, entry := range existing {
+			seen[strings.ToLower(entry.Name)] = struct{}{}
+		}
+		// 내장 슬라이스를 건드리지 않도록 새로 할당
+		combined := make([]TopicEntry, len(existing), len(existing)+len(pack.Topics))
+		copy(combined, existing)
+		for _, entry := range pack.Topics {
+			name := strings.TrimSpace(entry.Name)
+			key := strings.ToLower(name)
+			if name == "" {
+				continue
+			}
+			if _, dup := seen[key]; dup {
+				continue
+			}
+			seen[key] = struct{}{}
+			combined = append(combined, TopicEntry{Name: name, Details: entry.Details, Category: category})
+			stats.AddedTopics++
+		}
+		merged[category] = combined
+	}
+
+	for _, entries := range merged {
+		stats.Topics += len(entries)
+	}
+	stats.Categories = len(merged)
+
+	l.mu.Lock()
+	l.topics = merged
+	l.mu.Unlock()
+	return stats
+}
+
 func (l *TopicLoader) loadCategory(category string, filename string) ([]TopicEntry, error) {
 	path := "topics/" + filename
 	data, err := fs.ReadFile(topicsFS, path)
@@ -157,6 +225,12 @@ func (l *TopicLoader) SelectTopic(category string, bannedTopics []string, exclud
 func (l *TopicLoader) Categories() []string {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
+	return l.categoriesLocked()
+}
+
+// categoriesLocked: 읽기 잠금을 이미 잡은 상태에서 카테고리 목록을 반환합니다.
+// (RWMutex는 재진입이 안 되므로 SelectTopic 안에서 Categories를 다시 잠그지 않음)
+func (l *TopicLoader) categoriesLocked() []string {
 	out := make([]string, 0, len(l.topics))
 	for cat := range l.topics {
 		out = append(out, cat)
@@ -165,7 +239,7 @@ func (l *TopicLoader) Categories() []string {
 }
 
 func (l *TopicLoader) selectRandomCategoryExcluding(excludedSet map[string]struct{}) string {
-	categories := l.Categories()
+	categories := l.categoriesLocked()
 	if len(categories) == 0 {
 		return ""
 	}
@@ -0,0 +1,48 @@
+package twentyq
+
+import "testing"
+
+func TestTopicLoaderApplyPacks(t *testing.T) {
+	loader, err := NewTopicLoader()
+	if err != nil {
+		t.Fatalf("NewTopicLoader: %v", err)
+	}
+	builtinFood := len(loader.builtin["food"])
+	builtinCategories := len(loader.Categories())
+
+	existing := loader.builtin["food"][0].Name
+	stats := loader.ApplyPacks([]TopicPack{
+		{Category: "Food", Topics: []TopicEntry{{Name: existing}, {Name: " 두바이 쫀득 쿠키 "}, {Name: "두바이 쫀득 쿠키"}}},
+		{Category: "kpop", Topics: []TopicEntry{{Name: "아이돌", Details: map[string]any{"hint": "무대"}}}},
+	})
+
+	if stats.AddedTopics != 2 {
+		t.Fatalf("expected 2 added topics, got %+v", stats)
+	}
+	if got := len(loader.topics["food"]); got != builtinFood+1 {
+		t.Fatalf("expected %d food topics, got %d", builtinFood+1, got)
+	}
+	if got := len(loader.Categories()); got != builtinCategories+1 {
+		t.Fatalf("expected new category to be added, got %d categories", got)
+	}
+	if len(loader.builtin["food"]) != builtinFood {
+		t.Fatalf("builtin topics must not be modified")
+	}
+
+	topic, err := loader.SelectTopic("kpop", nil, nil)
+	if err != nil {
+		t.Fatalf("SelectTopic: %v", err)
+	}
+	if topic.Name != "아이돌" || topic.Category != "kpop" || topic.Details["hint"] != "무대" {
+		t.Fatalf("unexpected topic: %+v", topic)
+	}
+
+	// 빈 팩 목록이면 내장 토픽으로 되돌아감
+	loader.ApplyPacks(nil)
+	if _, ok := loader.topics["kpop"]; ok {
+		t.Fatalf("expected pack category to be removed")
+	}
+	if got := len(loader.topics["food"]); got != builtinFood {
+		t.Fatalf("expected builtin food topics only, got %d", got)
+	}
+}
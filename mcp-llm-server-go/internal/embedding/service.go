@@ -0,0 +1,204 @@
+// Package embedding: 텍스트 임베딩을 캐시와 함께 제공합니다. 유사도 계산(코사인)은 호출 측에서 한다.
+package embedding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+)
+
+const (
+	// DefaultTaskType: 작업 유형을 지정하지 않았을 때 쓰는 값 (동의어/중복 질문 판정용)
+	DefaultTaskType = "SEMANTIC_SIMILARITY"
+	// maxDimensions: gemini-embedding-001의 최대 출력 차원
+	maxDimensions = 3072
+	// maxTextRunes: 텍스트 하나의 최대 글자 수
+	maxTextRunes = 2048
+)
+
+// taskTypes: Gemini 임베딩 API가 받는 작업 유형
+var taskTypes = map[string]struct{}{
+	"SEMANTIC_SIMILARITY": {},
+	"CLASSIFICATION":      {},
+	"CLUSTERING":          {},
+	"RETRIEVAL_DOCUMENT":  {},
+	"RETRIEVAL_QUERY":     {},
+	"QUESTION_ANSWERING":  {},
+	"FACT_VERIFICATION":   {},
+}
+
+// Embedder: 텍스트 목록을 입력 순서대로 임베딩합니다. (gemini.Client)
+type Embedder interface {
+	Embed(ctx context.Context, texts []string, opts gemini.EmbedOptions) ([][]float32, string, error)
+}
+
+// Cache: 임베딩 벡터 캐시입니다. (session.Store)
+type Cache interface {
+	LoadEmbeddings(ctx context.Context, keys []string) (map[string][]float32, error)
+	SaveEmbeddings(ctx context.Context, vectors map[string][]float32, ttl time.Duration) error
+}
+
+// Request: 임베딩 요청입니다.
+type Request struct {
+	Texts      []string
+	TaskType   string // 비어 있으면 DefaultTaskType
+	Dimensions int    // 0이면 EMBEDDING_DIMENSIONS
+}
+
+// Vector: 텍스트 하나의 임베딩입니다.
+type Vector struct {
+	Values []float32
+	Cached bool
+}
+
+// Result: 요청 텍스트 순서대로 정렬된 임베딩 결과입니다.
+type Result struct {
+	Embeddings []Vector
+	Model      string
+	Dimensions int
+}
+
+// Service: 캐시를 먼저 조회하고 없는 텍스트만 모아 한 번에 임베딩합니다.
+type Service struct {
+	cfg      config.EmbeddingConfig
+	embedder Embedder
+	cache    Cache
+	logger   *slog.Logger
+}
+
+// NewService: 임베딩 서비스를 생성합니다. cache가 nil이면 매번 새로 계산합니다.
+func NewService(cfg config.EmbeddingConfig, embedder Embedder, cache Cache, logger *slog.Logger) *Service {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{cfg: cfg, embedder: embedder, cache: cache, logger: logger}
+}
+
+// Embed: 텍스트 목록의 임베딩을 반환합니다. 같은 텍스트는 한 번만 계산한다.
+// 캐시 조회/저장 실패는 경고만 남기고 계속 진행한다.
+func (s *Service) Embed(ctx context.Context, req Request) (Result, error) {
+	taskType, dimensions, err := s.validate(req)
+	if err != nil {
+		return Result{}, err
+	}
+	model := s.cfg.Model
+
+	keys := make([]string, len(req.Texts))
+	unique := make([]string, 0, len(req.Texts))
+	textByKey := make(map[string]string, len(req.Texts))
+	for i, text := range req.Texts {
+		keys[i] = cacheKey(model, taskType, dimensions, text)
+		if _, ok := textByKey[keys[i]]; !ok {
+			textByKey[keys[i]] = text
+			unique = append(unique, keys[i])
+		}
+	}
+
+	cached := map[string][]float32{}
+	if s.cache != nil {
+		loaded, err := s.cache.LoadEmbeddings(ctx, unique)
+		if err != nil {
+			s.logger.WarnContext(ctx, "embedding_cache_load_failed", "err", err)
+		} else {
+			cached = loaded
+		}
+	}
+
+	var missTexts, missKeys []string
+	for _, key := range unique {
+		if _, ok := cached[key]; !ok {
+			missKeys = append(missKeys, key)
+			missTexts = append(missTexts, textByKey[key])
+		}
+	}
+
+	fresh := make(map[string][]float32, len(missKeys))
+	if len(missTexts) > 0 {
+		vectors, usedModel, err := s.embedder.Embed(ctx, missTexts, gemini.EmbedOptions{
+			Model:      model,
+			TaskType:   taskType,
+			Dimensions: dimensions,
+		})
+		if err != nil {
+			return Result{}, fmt.Errorf("embed texts: %w", err)
+		}
+		if len(vectors) != len(missKeys) {
+			return Result{}, fmt.Errorf("embed texts: expected %d vectors, got %d", len(missKeys), len(vectors))
+		}
+		if usedModel != "" {
+			model = usedModel
+		}
+		for i, key := range missKeys {
+			fresh[key] = vectors[i]
+		}
+		if s.cache != nil {
+			ttl := time.Duration(s.cfg.CacheTTLSeconds) * time.Second
+			if err := s.cache.SaveEmbeddings(ctx, fresh, ttl); err != nil {
+				s.logger.WarnContext(ctx, "embedding_cache_save_failed", "err", err)
+			}
+		}
+	}
+
+	result := Result{Embeddings: make([]Vector, len(keys)), Model: model, Dimensions: dimensions}
+	for i, key := range keys {
+		if vector, ok := cached[key]; ok {
+			result.Embeddings[i] = Vector{Values: vector, Cached: true}
+			continue
+		}
+		result.Embeddings[i] = Vector{Values: fresh[key]}
+	}
+	if dimensions == 0 && len(result.Embeddings) > 0 {
+		result.Dimensions = len(result.Embeddings[0].Values)
+	}
+	return result, nil
+}
+
+func (s *Service) validate(req Request) (string, int, error) {
+	if len(req.Texts) == 0 {
+		return "", 0, httperror.NewInvalidInput("texts required")
+	}
+	if limit := max(1, s.cfg.MaxTexts); len(req.Texts) > limit {
+		return "", 0, httperror.NewInvalidInput(fmt.Sprintf("too many texts (max %d): %d", limit, len(req.Texts)))
+	}
+	for i, text := range req.Texts {
+		if strings.TrimSpace(text) == "" {
+			return "", 0, httperror.NewInvalidInput(fmt.Sprintf("texts[%d] is empty", i))
+		}
+		if utf8.RuneCountInString(text) > maxTextRunes {
+			return "", 0, httperror.NewInvalidInput(fmt.Sprintf("texts[%d] too long (max %d)", i, maxTextRunes))
+		}
+	}
+
+	taskType := strings.ToUpper(strings.TrimSpace(req.TaskType))
+	if taskType == "" {
+		taskType = DefaultTaskType
+	}
+	if _, ok := taskTypes[taskType]; !ok {
+		return "", 0, httperror.NewInvalidInput(fmt.Sprintf("unknown task_type %q", req.TaskType))
+	}
+
+	dimensions := req.Dimensions
+	if dimensions == 0 {
+		dimensions = s.cfg.Dimensions
+	}
+	if dimensions < 0 || dimensions > maxDimensions {
+		return "", 0, httperror.NewInvalidInput(fmt.Sprintf("dimensions out of range (1-%d): %d", maxDimensions, dimensions))
+	}
+	return taskType, dimensions, nil
+}
+
+// cacheKey: 모델/작업 유형/차원이 같을 때만 벡터를 재사용하도록 모두 키에 넣는다.
+func cacheKey(model, taskType string, dimensions int, text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return model + ":" + taskType + ":" + strconv.Itoa(dimensions) + ":" + hex.EncodeToString(sum[:])
+}
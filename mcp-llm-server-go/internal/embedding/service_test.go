@@ -0,0 +1,135 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+)
+
+type fakeEmbedder struct {
+	calls [][]string
+	opts  gemini.EmbedOptions
+	err   error
+}
+
+func (f *fakeEmbedder) Embed(_ context.Context, texts []string, opts gemini.EmbedOptions) ([][]float32, string, error) {
+	if f.err != nil {
+		return nil, "", f.err
+	}
+	f.calls = append(f.calls, slices.Clone(texts))
+	f.opts = opts
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text)), 1}
+	}
+	return vectors, opts.Model, nil
+}
+
+type memoryCache struct {
+	vectors map[string][]float32
+	ttl     time.Duration
+}
+
+func (m *memoryCache) LoadEmbeddings(_ context.Context, keys []string) (map[string][]float32, error) {
+	result := map[string][]float32{}
+	for _, key := range keys {
+		if v, ok := m.vectors[key]; ok {
+			result[key] = v
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryCache) SaveEmbeddings(_ context.Context, vectors map[string][]float32, ttl time.Duration) error {
+	for key, v := range vectors {
+		m.vectors[key] = v
+	}
+	m.ttl = ttl
+	return nil
+}
+
+func newTestService(embedder Embedder, cache Cache) *Service {
+	return NewService(config.EmbeddingConfig{
+		Model:           "gemini-embedding-001",
+		Dimensions:      768,
+		MaxTexts:        3,
+		CacheTTLSeconds: 60,
+	}, embedder, cache, nil)
+}
+
+func TestServiceEmbed_CachesAndDedupes(t *testing.T) {
+	embedder := &fakeEmbedder{}
+	cache := &memoryCache{vectors: map[string][]float32{}}
+	svc := newTestService(embedder, cache)
+	ctx := context.Background()
+
+	first, err := svc.Embed(ctx, Request{Texts: []string{"사과", "배", "사과"}})
+	if err != nil {
+		t.Fatalf("embed: %v", err)
+	}
+	if len(embedder.calls) != 1 || !slices.Equal(embedder.calls[0], []string{"사과", "배"}) {
+		t.Fatalf("duplicate texts must be embedded once: %v", embedder.calls)
+	}
+	if embedder.opts.TaskType != DefaultTaskType || embedder.opts.Dimensions != 768 {
+		t.Fatalf("unexpected options: %+v", embedder.opts)
+	}
+	if len(first.Embeddings) != 3 || !slices.Equal(first.Embeddings[0].Values, first.Embeddings[2].Values) {
+		t.Fatalf("results must follow request order: %+v", first.Embeddings)
+	}
+	if first.Embeddings[0].Cached || cache.ttl != time.Minute {
+		t.Fatalf("first call must miss the cache and store with ttl: %+v, %v", first.Embeddings[0], cache.ttl)
+	}
+
+	second, err := svc.Embed(ctx, Request{Texts: []string{"배", "귤"}})
+	if err != nil {
+		t.Fatalf("embed: %v", err)
+	}
+	if len(embedder.calls) != 2 || !slices.Equal(embedder.calls[1], []string{"귤"}) {
+		t.Fatalf("only cache misses must be embedded: %v", embedder.calls)
+	}
+	if !second.Embeddings[0].Cached || second.Embeddings[1].Cached {
+		t.Fatalf("unexpected cached flags: %+v", second.Embeddings)
+	}
+
+	// 차원이 다르면 캐시를 공유하지 않음
+	if _, err := svc.Embed(ctx, Request{Texts: []string{"배"}, Dimensions: 256}); err != nil {
+		t.Fatalf("embed: %v", err)
+	}
+	if len(embedder.calls) != 3 {
+		t.Fatalf("different dimensions must not reuse cached vectors")
+	}
+}
+
+func TestServiceEmbed_Validation(t *testing.T) {
+	svc := newTestService(&fakeEmbedder{}, nil)
+	cases := []Request{
+		{},
+		{Texts: []string{"a", "b", "c", "d"}},
+		{Texts: []string{" "}},
+		{Texts: []string{"a"}, TaskType: "RANDOM"},
+		{Texts: []string{"a"}, Dimensions: 4096},
+	}
+	for _, req := range cases {
+		if _, err := svc.Embed(context.Background(), req); httperror.FromError(err) == nil || httperror.FromError(err).Status != http.StatusBadRequest {
+			t.Errorf("Embed(%+v) expected invalid input, got %v", req, err)
+		}
+	}
+
+	if _, err := svc.Embed(context.Background(), Request{Texts: []string{"a"}, TaskType: "clustering"}); err != nil {
+		t.Fatalf("task type must be case-insensitive: %v", err)
+	}
+}
+
+func TestServiceEmbed_EmbedderError(t *testing.T) {
+	svc := newTestService(&fakeEmbedder{err: errors.New("boom")}, nil)
+	if _, err := svc.Embed(context.Background(), Request{Texts: []string{"a"}}); err == nil || httperror.FromError(err).Status == http.StatusBadRequest {
+		t.Fatalf("expected upstream error, got %v", err)
+	}
+}
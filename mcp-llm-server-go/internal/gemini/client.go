@@ -20,6 +20,7 @@ import (
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
 )
 
@@ -30,14 +31,8 @@ var (
 	ErrInvalidModel = errors.New("invalid model")
 )
 
-// Request: Gemini 요청 데이터입니다.
-type Request struct {
-	Prompt       string
-	SystemPrompt string
-	History      []llm.HistoryEntry
-	Model        string
-	Task         string
-}
+// Request: Gemini 요청 데이터입니다. (llm.Request와 동일 타입)
+type Request = llm.Request
 
 // Client: Gemini API 호출을 담당하는 클라이언트입니다.
 type Client struct {
@@ -48,6 +43,9 @@ type Client struct {
 	clients       map[string]*genai.Client
 	apiKeys       []string
 	apiKeyIdx     int
+	endpoints     *endpointPool // 리전별 엔드포인트 선택 (미설정 시 nil)
+	queue         *callQueue    // 동시 호출 제한/우선순위 대기열 (GEMINI_MAX_CONCURRENT=0이면 nil)
+	router        *modelRouter  // 지연/예산 기반 모델 선택 (GEMINI_FALLBACK_MODEL 미설정 시 nil)
 }
 
 // NewClient: Gemini 클라이언트를 생성합니다.
@@ -64,9 +62,38 @@ func NewClient(cfg *config.Config, metricsStore *metrics.Store, usageRecorder *u
 		usageRecorder: usageRecorder,
 		clients:       make(map[string]*genai.Client),
 		apiKeys:       cfg.Gemini.APIKeys,
+		endpoints: newEndpointPool(
+			cfg.Gemini.Endpoints,
+			time.Duration(cfg.Gemini.EndpointProbeSeconds)*time.Second,
+			cfg.Gemini.EndpointFailureThreshold,
+		),
+		queue:  newCallQueue(cfg.Gemini.MaxConcurrent, cfg.Gemini.QueueSize, cfg.Gemini.BatchQueueSize),
+		router: newModelRouter(cfg.Gemini),
 	}, nil
 }
 
+// SetQuotaTracker: 모델 라우팅이 호출 봇의 남은 예산을 볼 수 있도록 Tracker를 연결합니다. 요청을 받기 전에 호출해야 합니다.
+func (c *Client) SetQuotaTracker(tracker *quota.Tracker) {
+	if c.router != nil {
+		c.router.quota = tracker
+	}
+}
+
+// StartEndpointProbing: 리전별 엔드포인트 지연 측정을 시작합니다. 엔드포인트를 설정하지 않았으면 아무 일도 하지 않습니다.
+func (c *Client) StartEndpointProbing() {
+	c.endpoints.start()
+}
+
+// Close: 엔드포인트 측정 루프를 멈춥니다.
+func (c *Client) Close() {
+	c.endpoints.stop()
+}
+
+// Name: 공급자 식별자를 반환합니다.
+func (c *Client) Name() string {
+	return llm.ProviderGemini
+}
+
 // Chat: 텍스트 채팅 요청을 수행합니다.
 func (c *Client) Chat(ctx context.Context, req Request) (string, string, error) {
 	start := time.Now()
@@ -78,7 +105,7 @@ func (c *Client) Chat(ctx context.Context, req Request) (string, string, error)
 
 	usageStats := extractUsage(response)
 	c.metrics.RecordSuccess(time.Since(start), usageStats)
-	c.recordUsage(ctx, usageStats)
+	c.recordUsage(ctx, model, usageStats)
 	return response.Text(), model, nil
 }
 
@@ -103,7 +130,7 @@ func (c *Client) ChatWithUsage(ctx context.Context, req Request) (llm.ChatResult
 	}
 
 	c.metrics.RecordSuccess(time.Since(start), usageStats)
-	c.recordUsage(ctx, usageStats)
+	c.recordUsage(ctx, model, usageStats)
 	return result, model, nil
 }
 
@@ -311,9 +338,11 @@ func (c *Client) StructuredWithConsensus(
 	var model string
 	successCount := 0
 
+	var lastErr error
 	for i := 0; i < numCalls; i++ {
 		cr := <-results
 		if cr.err != nil {
+			lastErr = cr.err
 			continue
 		}
 		successCount++
@@ -331,7 +360,7 @@ func (c *Client) StructuredWithConsensus(
 	}
 
 	if successCount == 0 {
-		return ConsensusResult{}, errors.New("all consensus calls failed")
+		return ConsensusResult{}, fmt.Errorf("all consensus calls failed: %w", lastErr)
 	}
 
 	// 다수결
@@ -533,16 +562,18 @@ func (c *Client) structuredWithConsensusWeightedMulti(
 	}
 
 	collector := newWeightedConsensusCollector(numCalls)
+	var lastErr error
 	for i := 0; i < numCalls; i++ {
 		cr := <-results
 		if cr.err != nil {
+			lastErr = cr.err
 			continue
 		}
 		collector.add(cr.result, fieldName)
 	}
 
 	if collector.successCount == 0 {
-		return ConsensusResult{}, errors.New("all consensus calls failed")
+		return ConsensusResult{}, fmt.Errorf("all consensus calls failed: %w", lastErr)
 	}
 	return collector.toResult(fieldName, numCalls), nil
 }
@@ -557,25 +588,27 @@ func (c *Client) structuredInternal(ctx context.Context, req Request, schema map
 
 	usageStats := extractUsage(response)
 	c.metrics.RecordSuccess(time.Since(start), usageStats)
-	c.recordUsage(ctx, usageStats)
+	c.recordUsage(ctx, model, usageStats)
 
 	// grounding metadata에서 검색 쿼리 추출
 	searchQueries := extractSearchQueries(response)
 
 	payload := response.Text()
 	if strings.TrimSpace(payload) == "" {
-		return nil, model, searchQueries, errors.New("empty structured response")
+		return nil, model, searchQueries, &llm.StructuredOutputError{Raw: payload, Err: errors.New("empty structured response")}
 	}
 
 	var parsed map[string]any
 	if err := json.Unmarshal([]byte(payload), &parsed); err != nil {
-		return nil, model, searchQueries, fmt.Errorf("decode structured response: %w", err)
+		return nil, model, searchQueries, &llm.StructuredOutputError{Raw: payload, Err: fmt.Errorf("decode structured response: %w", err)}
 	}
 
 	return parsed, model, searchQueries, nil
 }
 
-func (c *Client) recordUsage(ctx context.Context, usageStats llm.Usage) {
+func (c *Client) recordUsage(ctx context.Context, model string, usageStats llm.Usage) {
+	usage.AttributeCall(ctx, model, int64(usageStats.InputTokens), int64(usageStats.OutputTokens), int64(usageStats.ReasoningTokens))
+
 	// 캐시 적중 시 DEBUG 로그 출력
 	if usageStats.CachedTokens > 0 {
 		slog.DebugContext(ctx, "cache_hit",
@@ -602,6 +635,7 @@ func (c *Client) generateWithTools(
 	if err != nil {
 		return nil, model, err
 	}
+	model = c.router.route(ctx, req.Task, model, req.Model != "")
 
 	genConfig := c.buildGenerateConfig(req.SystemPrompt, req.Task, model, responseMimeType, responseSchema)
 
@@ -614,6 +648,13 @@ func (c *Client) generateWithTools(
 
 	contents := buildContents(req.Prompt, req.History)
 
+	// 재시도 대기까지 슬롯을 쥐고 있어 한 요청이 동시에 여러 슬롯을 차지하지 않는다
+	release, err := c.queue.acquire(ctx)
+	if err != nil {
+		return nil, model, err
+	}
+	defer release()
+
 	maxAttempts := max(1, c.cfg.Gemini.MaxRetries)
 	if c.cfg.Gemini.FailoverAttempts > 0 && len(c.apiKeys) > 0 {
 		maxAttempts = min(maxAttempts, c.cfg.Gemini.FailoverAttempts*len(c.apiKeys))
@@ -627,12 +668,20 @@ func (c *Client) generateWithTools(
 			}
 		}
 
-		client, err := c.selectClient(ctx)
+		endpoint := c.endpoints.selected()
+		client, err := c.selectClient(ctx, endpoint)
 		if err != nil {
 			return nil, model, err
 		}
 
+		attemptStart := time.Now()
 		response, err := client.Models.GenerateContent(ctx, model, contents, genConfig)
+		elapsed := time.Since(attemptStart)
+		c.endpoints.report(endpoint, elapsed, err)
+		// 타임아웃도 지연 표본으로 남겨야 계속 시간 초과되는 모델에서 벗어날 수 있음
+		if err == nil || isTimeoutError(err) {
+			c.router.observe(model, elapsed)
+		}
 		if err == nil {
 			return response, model, nil
 		}
@@ -658,9 +707,10 @@ func (c *Client) generate(
 	return c.generateWithTools(ctx, req, responseMimeType, responseSchema, false)
 }
 
-// selectClient는 라운드로빈으로 API 키를 선택하고 해당 클라이언트를 반환한다.
+// selectClient는 라운드로빈으로 API 키를 선택하고 해당 키/엔드포인트 조합의 클라이언트를 반환한다.
+// endpoint가 비어 있으면 SDK 기본 엔드포인트를 사용한다.
 // Double-checked locking 패턴으로 읽기 경로 최적화.
-func (c *Client) selectClient(ctx context.Context) (*genai.Client, error) {
+func (c *Client) selectClient(ctx context.Context, endpoint string) (*genai.Client, error) {
 	if len(c.apiKeys) == 0 {
 		return nil, ErrMissingAPIKey
 	}
@@ -672,10 +722,11 @@ func (c *Client) selectClient(ctx context.Context) (*genai.Client, error) {
 	c.mu.Unlock()
 
 	key := c.apiKeys[keyIdx%len(c.apiKeys)]
+	cacheKey := key + "|" + endpoint
 
 	// 읽기 락으로 캐시 히트 확인
 	c.mu.RLock()
-	if client, ok := c.clients[key]; ok {
+	if client, ok := c.clients[cacheKey]; ok {
 		c.mu.RUnlock()
 		return client, nil
 	}
@@ -686,7 +737,7 @@ func (c *Client) selectClient(ctx context.Context) (*genai.Client, error) {
 	defer c.mu.Unlock()
 
 	// 다른 goroutine이 이미 생성했을 수 있으므로 재확인
-	if client, ok := c.clients[key]; ok {
+	if client, ok := c.clients[cacheKey]; ok {
 		return client, nil
 	}
 
@@ -710,6 +761,7 @@ func (c *Client) selectClient(ctx context.Context) (*genai.Client, error) {
 		Backend:    genai.BackendGeminiAPI,
 		HTTPClient: httpClient, // nil이면 SDK가 기본 클라이언트 사용
 		HTTPOptions: genai.HTTPOptions{
+			BaseURL: endpoint,
 			Timeout: genai.Ptr(timeout),
 		},
 	})
@@ -717,7 +769,7 @@ func (c *Client) selectClient(ctx context.Context) (*genai.Client, error) {
 		return nil, fmt.Errorf("create genai client: %w", err)
 	}
 
-	c.clients[key] = client
+	c.clients[cacheKey] = client
 	return client, nil
 }
 
@@ -852,6 +904,15 @@ func extractSearchQueries(response *genai.GenerateContentResponse) []string {
 	return gm.WebSearchQueries
 }
 
+// isTimeoutError: 호출이 시간 초과로 끝났는지 확인합니다.
+func isTimeoutError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 func isRetryableGenerateError(err error) bool {
 	if err == nil {
 		return false
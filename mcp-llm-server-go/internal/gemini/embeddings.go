@@ -0,0 +1,123 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genai"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+// embedBatchLimit: Gemini 임베딩 API가 한 번에 받는 텍스트 수 상한
+const embedBatchLimit = 100
+
+// EmbedOptions: 임베딩 요청 옵션입니다.
+type EmbedOptions struct {
+	Model      string // 비어 있으면 설정의 EMBEDDING_MODEL
+	TaskType   string // 예: SEMANTIC_SIMILARITY
+	Dimensions int    // 0이면 모델 기본 차원
+}
+
+// Embed: 텍스트 목록의 임베딩 벡터를 입력 순서대로 반환합니다.
+// 생성 모델과 달리 Gemini 3 제한을 적용하지 않으며, 100개를 넘으면 나눠서 호출한다.
+func (c *Client) Embed(ctx context.Context, texts []string, opts EmbedOptions) ([][]float32, string, error) {
+	model := opts.Model
+	if model == "" {
+		model = c.cfg.Embedding.Model
+	}
+	if model == "" {
+		return nil, model, ErrInvalidModel
+	}
+	if len(texts) == 0 {
+		return nil, model, nil
+	}
+
+	embedConfig := &genai.EmbedContentConfig{TaskType: opts.TaskType}
+	if opts.Dimensions > 0 {
+		embedConfig.OutputDimensionality = genai.Ptr(int32(opts.Dimensions))
+	}
+
+	start := time.Now()
+	vectors := make([][]float32, 0, len(texts))
+	for offset := 0; offset < len(texts); offset += embedBatchLimit {
+		batch := texts[offset:min(offset+embedBatchLimit, len(texts))]
+		contents := make([]*genai.Content, 0, len(batch))
+		for _, text := range batch {
+			contents = append(contents, genai.NewContentFromText(text, genai.RoleUser))
+		}
+
+		response, err := c.embedContent(ctx, model, contents, embedConfig)
+		if err != nil {
+			c.metrics.RecordError(time.Since(start))
+			return nil, model, err
+		}
+		if len(response.Embeddings) != len(batch) {
+			c.metrics.RecordError(time.Since(start))
+			return nil, model, fmt.Errorf("embed content: expected %d embeddings, got %d", len(batch), len(response.Embeddings))
+		}
+		for _, embedding := range response.Embeddings {
+			if embedding == nil {
+				vectors = append(vectors, nil)
+				continue
+			}
+			vectors = append(vectors, embedding.Values)
+		}
+	}
+
+	c.metrics.RecordSuccess(time.Since(start), llm.Usage{})
+	return vectors, model, nil
+}
+
+// embedContent: 대기열 슬롯을 잡고 재시도/엔드포인트 전환 규칙을 적용해 임베딩 API를 호출합니다.
+func (c *Client) embedContent(
+	ctx context.Context,
+	model string,
+	contents []*genai.Content,
+	embedConfig *genai.EmbedContentConfig,
+) (*genai.EmbedContentResponse, error) {
+	release, err := c.queue.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	maxAttempts := max(1, c.cfg.Gemini.MaxRetries)
+	if c.cfg.Gemini.FailoverAttempts > 0 && len(c.apiKeys) > 0 {
+		maxAttempts = min(maxAttempts, c.cfg.Gemini.FailoverAttempts*len(c.apiKeys))
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, retryDelay(attempt)); err != nil {
+				return nil, err
+			}
+		}
+
+		endpoint := c.endpoints.selected()
+		client, err := c.selectClient(ctx, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		attemptStart := time.Now()
+		response, err := client.Models.EmbedContent(ctx, model, contents, embedConfig)
+		c.endpoints.report(endpoint, time.Since(attemptStart), err)
+		if err == nil {
+			return response, nil
+		}
+
+		lastErr = err
+		if !isRetryableGenerateError(err) {
+			break
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("unknown embed content error")
+	}
+	return nil, fmt.Errorf("embed content: %w", lastErr)
+}
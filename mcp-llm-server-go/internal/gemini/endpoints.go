@@ -0,0 +1,303 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/genai"
+)
+
+const (
+	// endpointProbeTimeout: 엔드포인트 하나를 측정할 때의 최대 대기 시간
+	endpointProbeTimeout = 5 * time.Second
+	// endpointLatencyAlpha: 측정 지연의 지수 이동 평균 가중치 (일시적인 튐을 완화)
+	endpointLatencyAlpha = 0.3
+	// endpointSwitchRatio: 현재 엔드포인트보다 이 비율 이하로 빨라야 전환 (잦은 전환 방지)
+	endpointSwitchRatio = 0.8
+)
+
+var (
+	endpointProbeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mcp_llm_gemini_endpoint_probe_latency_seconds",
+		Help: "Smoothed probe latency of each Gemini endpoint.",
+	}, []string{"endpoint"})
+	endpointRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mcp_llm_gemini_endpoint_request_duration_seconds",
+		Help:    "Duration of Gemini generate requests per endpoint.",
+		Buckets: []float64{0.25, 0.5, 1, 2, 4, 8, 15, 30, 60},
+	}, []string{"endpoint"})
+	endpointErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mcp_llm_gemini_endpoint_errors_total",
+		Help: "Number of failed Gemini requests and probes per endpoint.",
+	}, []string{"endpoint", "source"})
+	endpointSelected = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mcp_llm_gemini_endpoint_selected",
+		Help: "1 for the Gemini endpoint currently receiving requests.",
+	}, []string{"endpoint"})
+)
+
+// endpointState: 엔드포인트 하나의 측정 결과
+type endpointState struct {
+	url      string
+	healthy  bool
+	latency  time.Duration // 측정 지연 이동 평균 (0이면 아직 측정 전)
+	failures int           // 연속 요청 실패 수
+}
+
+// endpointPool: 설정된 리전별 엔드포인트의 지연을 주기적으로 측정하고 가장 빠른 정상 엔드포인트를 고정 선택합니다.
+// 선택은 더 빠른 엔드포인트가 충분히 빠르거나 현재 엔드포인트가 비정상이 될 때만 바뀝니다.
+type endpointPool struct {
+	logger           *slog.Logger
+	httpClient       *http.Client
+	interval         time.Duration
+	failureThreshold int
+
+	mu        sync.Mutex
+	endpoints []*endpointState
+	current   int
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// newEndpointPool: 엔드포인트가 없으면 nil을 반환합니다. (SDK 기본 엔드포인트 사용)
+func newEndpointPool(urls []string, interval time.Duration, failureThreshold int) *endpointPool {
+	if len(urls) == 0 {
+		return nil
+	}
+	endpoints := make([]*endpointState, 0, len(urls))
+	for _, url := range urls {
+		endpoints = append(endpoints, &endpointState{url: url, healthy: true})
+	}
+	p := &endpointPool{
+		logger:           slog.Default().With("component", "gemini_endpoints"),
+		httpClient:       &http.Client{Timeout: endpointProbeTimeout},
+		interval:         max(interval, time.Second),
+		failureThreshold: max(1, failureThreshold),
+		endpoints:        endpoints,
+		stopCh:           make(chan struct{}),
+		doneCh:           make(chan struct{}),
+	}
+	p.publishSelected()
+	return p
+}
+
+// selected: 요청을 보낼 엔드포인트를 반환합니다. nil 풀이면 빈 문자열(SDK 기본값)입니다.
+func (p *endpointPool) selected() string {
+	if p == nil {
+		return ""
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.endpoints[p.current].url
+}
+
+// report: 요청 결과를 기록합니다. 엔드포인트 문제로 연속 실패하면 다른 엔드포인트로 넘깁니다.
+func (p *endpointPool) report(url string, duration time.Duration, err error) {
+	if p == nil {
+		return
+	}
+	endpointRequestDuration.WithLabelValues(url).Observe(duration.Seconds())
+	if err != nil {
+		endpointErrors.WithLabelValues(url, "request").Inc()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	state := p.find(url)
+	if state == nil {
+		return
+	}
+	if err == nil {
+		state.failures = 0
+		state.healthy = true
+		return
+	}
+	if !isEndpointFault(err) {
+		return
+	}
+	state.failures++
+	if state.failures >= p.failureThreshold && state.healthy {
+		state.healthy = false
+		p.logger.Warn("gemini_endpoint_unhealthy", "endpoint", url, "failures", state.failures, "err", err)
+		p.reselect()
+	}
+}
+
+// start: 주기적인 지연 측정을 시작합니다.
+func (p *endpointPool) start() {
+	if p == nil {
+		return
+	}
+	p.startOnce.Do(func() {
+		go p.loop()
+	})
+}
+
+// stop: 측정 루프를 멈추고 끝날 때까지 기다립니다.
+func (p *endpointPool) stop() {
+	if p == nil {
+		return
+	}
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+		p.startOnce.Do(func() { close(p.doneCh) })
+		<-p.doneCh
+	})
+}
+
+func (p *endpointPool) loop() {
+	defer close(p.doneCh)
+
+	p.probeAll(context.Background())
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			p.probeAll(context.Background())
+		}
+	}
+}
+
+// probeAll: 모든 엔드포인트를 동시에 측정한 뒤 선택을 갱신합니다.
+func (p *endpointPool) probeAll(ctx context.Context) {
+	p.mu.Lock()
+	urls := make([]string, 0, len(p.endpoints))
+	for _, state := range p.endpoints {
+		urls = append(urls, state.url)
+	}
+	p.mu.Unlock()
+
+	type probeResult struct {
+		latency time.Duration
+		err     error
+	}
+	results := make([]probeResult, len(urls))
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		wg.Go(func() {
+			latency, err := p.probe(ctx, url)
+			results[i] = probeResult{latency: latency, err: err}
+		})
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, state := range p.endpoints {
+		result := results[i]
+		if result.err != nil {
+			endpointErrors.WithLabelValues(state.url, "probe").Inc()
+			if state.healthy {
+				p.logger.Warn("gemini_endpoint_probe_failed", "endpoint", state.url, "err", result.err)
+			}
+			state.healthy = false
+			continue
+		}
+		if state.latency == 0 {
+			state.latency = result.latency
+		} else {
+			state.latency = time.Duration(endpointLatencyAlpha*float64(result.latency) + (1-endpointLatencyAlpha)*float64(state.latency))
+		}
+		state.healthy = true
+		state.failures = 0
+		endpointProbeLatency.WithLabelValues(state.url).Set(state.latency.Seconds())
+	}
+	p.reselect()
+}
+
+// probe: 엔드포인트 루트에 요청을 보내 응답 시간을 잽니다. 5xx나 연결 실패는 비정상으로 봅니다.
+func (p *endpointPool) probe(ctx context.Context, url string) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	latency := time.Since(start)
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return latency, errors.New(resp.Status)
+	}
+	return latency, nil
+}
+
+// reselect: 가장 빠른 정상 엔드포인트로 전환할지 결정합니다. 호출 시 mu를 잡고 있어야 합니다.
+// 정상 엔드포인트가 하나도 없으면 현재 선택을 유지합니다.
+func (p *endpointPool) reselect() {
+	best := -1
+	for i, state := range p.endpoints {
+		if !state.healthy {
+			continue
+		}
+		if best < 0 || fasterThan(state, p.endpoints[best]) {
+			best = i
+		}
+	}
+	if best < 0 || best == p.current {
+		return
+	}
+
+	current := p.endpoints[p.current]
+	candidate := p.endpoints[best]
+	if current.healthy && (candidate.latency == 0 || current.latency == 0 ||
+		float64(candidate.latency) > float64(current.latency)*endpointSwitchRatio) {
+		return
+	}
+
+	p.logger.Info("gemini_endpoint_switched", "from", current.url, "to", candidate.url, "latency_ms", candidate.latency.Milliseconds())
+	p.current = best
+	p.publishSelected()
+}
+
+// fasterThan: 측정값이 있는 쪽을 우선하고, 둘 다 있으면 지연이 짧은 쪽을 고릅니다.
+func fasterThan(a *endpointState, b *endpointState) bool {
+	if a.latency == 0 {
+		return false
+	}
+	return b.latency == 0 || a.latency < b.latency
+}
+
+func (p *endpointPool) find(url string) *endpointState {
+	for _, state := range p.endpoints {
+		if state.url == url {
+			return state
+		}
+	}
+	return nil
+}
+
+func (p *endpointPool) publishSelected() {
+	for i, state := range p.endpoints {
+		value := 0.0
+		if i == p.current {
+			value = 1
+		}
+		endpointSelected.WithLabelValues(state.url).Set(value)
+	}
+}
+
+// isEndpointFault: 엔드포인트 자체의 문제로 볼 수 있는 실패인지 확인합니다.
+// 429는 API 키 할당량 문제이므로 엔드포인트 전환 사유가 아닙니다.
+func isEndpointFault(err error) bool {
+	var apiErr genai.APIError
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusTooManyRequests {
+		return false
+	}
+	return isRetryableGenerateError(err)
+}
@@ -0,0 +1,102 @@
+package gemini
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/genai"
+)
+
+func newProbeServer(t *testing.T, delay time.Duration, status int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(delay)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEndpointPool_NilWithoutEndpoints(t *testing.T) {
+	pool := newEndpointPool(nil, time.Second, 3)
+	if pool != nil {
+		t.Fatalf("expected nil pool")
+	}
+	if got := pool.selected(); got != "" {
+		t.Fatalf("expected default endpoint, got %q", got)
+	}
+	pool.report("", time.Second, nil)
+	pool.start()
+	pool.stop()
+}
+
+func TestEndpointPool_ProbeSelectsFastestHealthy(t *testing.T) {
+	slow := newProbeServer(t, 60*time.Millisecond, http.StatusNotFound)
+	fast := newProbeServer(t, 0, http.StatusNotFound)
+	broken := newProbeServer(t, 0, http.StatusBadGateway)
+
+	pool := newEndpointPool([]string{broken.URL, slow.URL, fast.URL}, time.Second, 3)
+	pool.probeAll(context.Background())
+
+	if got := pool.selected(); got != fast.URL {
+		t.Fatalf("expected fastest endpoint %s, got %s", fast.URL, got)
+	}
+}
+
+func TestEndpointPool_StickyUntilClearlyFaster(t *testing.T) {
+	pool := newEndpointPool([]string{"https://a.example", "https://b.example"}, time.Second, 3)
+	pool.endpoints[0].latency = 100 * time.Millisecond
+	pool.endpoints[1].latency = 90 * time.Millisecond
+
+	pool.mu.Lock()
+	pool.reselect()
+	pool.mu.Unlock()
+	if got := pool.selected(); got != "https://a.example" {
+		t.Fatalf("expected selection to stay on a, got %s", got)
+	}
+
+	pool.endpoints[1].latency = 50 * time.Millisecond
+	pool.mu.Lock()
+	pool.reselect()
+	pool.mu.Unlock()
+	if got := pool.selected(); got != "https://b.example" {
+		t.Fatalf("expected switch to b, got %s", got)
+	}
+}
+
+func TestEndpointPool_FailoverAfterConsecutiveFailures(t *testing.T) {
+	pool := newEndpointPool([]string{"https://a.example", "https://b.example"}, time.Second, 2)
+	unavailable := genai.APIError{Code: http.StatusServiceUnavailable}
+	rateLimited := genai.APIError{Code: http.StatusTooManyRequests}
+
+	// 할당량 초과는 엔드포인트 문제가 아님
+	for range 3 {
+		pool.report("https://a.example", time.Second, rateLimited)
+	}
+	if got := pool.selected(); got != "https://a.example" {
+		t.Fatalf("rate limit must not trigger failover, got %s", got)
+	}
+
+	pool.report("https://a.example", time.Second, unavailable)
+	pool.report("https://a.example", time.Second, nil)
+	pool.report("https://a.example", time.Second, unavailable)
+	if got := pool.selected(); got != "https://a.example" {
+		t.Fatalf("success must reset the failure count, got %s", got)
+	}
+
+	pool.report("https://a.example", time.Second, unavailable)
+	if got := pool.selected(); got != "https://b.example" {
+		t.Fatalf("expected failover to b, got %s", got)
+	}
+}
+
+func TestEndpointPool_StartStop(t *testing.T) {
+	server := newProbeServer(t, 0, http.StatusOK)
+	pool := newEndpointPool([]string{server.URL}, time.Second, 3)
+	pool.start()
+	pool.stop()
+	pool.stop()
+}
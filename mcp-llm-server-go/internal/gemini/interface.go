@@ -19,5 +19,8 @@ type LLM interface {
 	Structured(ctx context.Context, req Request, schema map[string]any) (map[string]any, string, error)
 }
 
-// Client가 LLM 인터페이스를 구현하는지 컴파일 타임 확인
-var _ LLM = (*Client)(nil)
+// Client가 LLM/llm.Provider 인터페이스를 구현하는지 컴파일 타임 확인
+var (
+	_ LLM          = (*Client)(nil)
+	_ llm.Provider = (*Client)(nil)
+)
@@ -0,0 +1,247 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+const (
+	// queueServiceAlpha: 호출 처리 시간 이동 평균 가중치 (Retry-After 추정용)
+	queueServiceAlpha = 0.2
+	// queueRetryAfterMin/Max: 거절 응답에 싣는 재시도 대기 시간 범위
+	queueRetryAfterMin = time.Second
+	queueRetryAfterMax = 30 * time.Second
+)
+
+var (
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mcp_llm_gemini_queue_depth",
+		Help: "Number of Gemini calls waiting for a worker slot per priority.",
+	}, []string{"priority"})
+	queueActive = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "mcp_llm_gemini_queue_active",
+		Help: "Number of Gemini calls currently holding a worker slot.",
+	})
+	queueWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mcp_llm_gemini_queue_wait_seconds",
+		Help:    "Time Gemini calls spent waiting for a worker slot.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+	}, []string{"priority"})
+	queueRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mcp_llm_gemini_queue_rejected_total",
+		Help: "Number of Gemini calls rejected or shed because the queue was saturated.",
+	}, []string{"priority"})
+)
+
+// ErrQueueFull: 호출 대기열이 가득 차 요청을 거절했을 때 반환됩니다.
+var ErrQueueFull = errors.New("llm queue saturated")
+
+// QueueFullError: 거절된 호출의 우선순위와 대기열 상태, 재시도 권장 시간을 담는 오류입니다.
+type QueueFullError struct {
+	Priority   llm.Priority
+	Depth      int
+	Limit      int
+	RetryAfter time.Duration
+}
+
+// Error: 오류 메시지를 반환합니다.
+func (e *QueueFullError) Error() string {
+	return fmt.Sprintf("llm queue saturated (%s, %d/%d waiting)", e.Priority, e.Depth, e.Limit)
+}
+
+// Is: errors.Is(err, ErrQueueFull) 비교를 지원합니다.
+func (e *QueueFullError) Is(target error) bool {
+	return target == ErrQueueFull
+}
+
+// queueWaiter: 슬롯을 기다리는 호출 하나. ready로 nil(슬롯 양도) 또는 거절 오류를 한 번 받습니다.
+type queueWaiter struct {
+	ready chan error
+}
+
+// callQueue: Gemini 동시 호출 수를 제한하고, 슬롯이 없으면 우선순위별 FIFO 대기열에 세웁니다.
+// 슬롯이 비면 interactive 대기자를 먼저 깨우고, 대기열이 가득 차면 새 요청을 거절한다.
+// 대기열이 가득 찬 상태에서 interactive 요청이 오면 가장 늦게 들어온 batch 대기자를 밀어낸다.
+type callQueue struct {
+	maxActive  int
+	queueSize  int // 전체 대기 한도
+	batchLimit int // batch 대기 한도 (전체 한도보다 먼저 거절)
+
+	mu         sync.Mutex
+	active     int
+	waiting    map[llm.Priority][]*queueWaiter
+	avgService time.Duration
+}
+
+// newCallQueue: maxActive가 0 이하이면 nil을 반환합니다. (제한 없음)
+func newCallQueue(maxActive, queueSize, batchLimit int) *callQueue {
+	if maxActive <= 0 {
+		return nil
+	}
+	queueSize = max(0, queueSize)
+	return &callQueue{
+		maxActive:  maxActive,
+		queueSize:  queueSize,
+		batchLimit: min(max(0, batchLimit), queueSize),
+		waiting:    make(map[llm.Priority][]*queueWaiter, len(llm.Priorities)),
+	}
+}
+
+// acquire: 컨텍스트 우선순위로 슬롯을 얻고 반납 함수를 반환합니다. nil 큐면 바로 통과합니다.
+func (q *callQueue) acquire(ctx context.Context) (func(), error) {
+	if q == nil {
+		return func() {}, nil
+	}
+	priority := llm.PriorityFromContext(ctx)
+
+	q.mu.Lock()
+	if q.active < q.maxActive {
+		q.active++
+		q.publish()
+		q.mu.Unlock()
+		return q.releaser(), nil
+	}
+	if err := q.admit(priority); err != nil {
+		q.mu.Unlock()
+		queueRejected.WithLabelValues(priority.String()).Inc()
+		return nil, err
+	}
+	waiter := &queueWaiter{ready: make(chan error, 1)}
+	q.waiting[priority] = append(q.waiting[priority], waiter)
+	q.publish()
+	q.mu.Unlock()
+
+	start := time.Now()
+	select {
+	case err := <-waiter.ready:
+		queueWait.WithLabelValues(priority.String()).Observe(time.Since(start).Seconds())
+		if err != nil {
+			return nil, err
+		}
+		return q.releaser(), nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		removed := q.remove(priority, waiter)
+		q.mu.Unlock()
+		// 취소와 동시에 슬롯을 넘겨받았으면 바로 반납
+		if !removed {
+			if err := <-waiter.ready; err == nil {
+				q.release(0)
+			}
+		}
+		return nil, fmt.Errorf("wait for llm slot: %w", ctx.Err())
+	}
+}
+
+// admit: 대기열에 들어갈 수 있는지 확인합니다. 호출 시 mu를 잡고 있어야 합니다.
+func (q *callQueue) admit(priority llm.Priority) error {
+	depth := q.depth()
+	if priority == llm.PriorityBatch {
+		if len(q.waiting[llm.PriorityBatch]) >= q.batchLimit || depth >= q.queueSize {
+			return q.fullError(priority, depth, q.batchLimit)
+		}
+		return nil
+	}
+	if depth < q.queueSize {
+		return nil
+	}
+	// 가득 찼으면 가장 최근 batch 대기자를 밀어내고 그 자리를 쓴다
+	batch := q.waiting[llm.PriorityBatch]
+	if len(batch) == 0 {
+		return q.fullError(priority, depth, q.queueSize)
+	}
+	shed := batch[len(batch)-1]
+	q.waiting[llm.PriorityBatch] = batch[:len(batch)-1]
+	queueRejected.WithLabelValues(llm.PriorityBatch.String()).Inc()
+	shed.ready <- q.fullError(llm.PriorityBatch, depth, q.batchLimit)
+	return nil
+}
+
+// releaser: 처리 시간을 재서 반납하는 함수를 만듭니다. 여러 번 호출해도 한 번만 반납합니다.
+func (q *callQueue) releaser() func() {
+	start := time.Now()
+	var once sync.Once
+	return func() {
+		once.Do(func() { q.release(time.Since(start)) })
+	}
+}
+
+// release: 슬롯을 다음 대기자(interactive 우선)에게 넘기거나 반납합니다.
+func (q *callQueue) release(service time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if service > 0 {
+		if q.avgService == 0 {
+			q.avgService = service
+		} else {
+			q.avgService = time.Duration(queueServiceAlpha*float64(service) + (1-queueServiceAlpha)*float64(q.avgService))
+		}
+	}
+
+	for _, priority := range llm.Priorities {
+		waiters := q.waiting[priority]
+		if len(waiters) == 0 {
+			continue
+		}
+		next := waiters[0]
+		q.waiting[priority] = waiters[1:]
+		next.ready <- nil
+		q.publish()
+		return
+	}
+	q.active--
+	q.publish()
+}
+
+// remove: 취소된 대기자를 대기열에서 뺍니다. 이미 깨워졌으면 false.
+func (q *callQueue) remove(priority llm.Priority, waiter *queueWaiter) bool {
+	waiters := q.waiting[priority]
+	for i, w := range waiters {
+		if w == waiter {
+			q.waiting[priority] = append(waiters[:i:i], waiters[i+1:]...)
+			q.publish()
+			return true
+		}
+	}
+	return false
+}
+
+func (q *callQueue) depth() int {
+	total := 0
+	for _, waiters := range q.waiting {
+		total += len(waiters)
+	}
+	return total
+}
+
+// fullError: 현재 대기열 길이와 평균 처리 시간으로 재시도 대기 시간을 추정해 거절 오류를 만듭니다.
+func (q *callQueue) fullError(priority llm.Priority, depth, limit int) *QueueFullError {
+	retryAfter := queueRetryAfterMin
+	if q.avgService > 0 {
+		rounds := float64(depth)/float64(q.maxActive) + 1
+		retryAfter = time.Duration(math.Ceil(rounds*q.avgService.Seconds())) * time.Second
+	}
+	return &QueueFullError{
+		Priority:   priority,
+		Depth:      depth,
+		Limit:      limit,
+		RetryAfter: min(max(retryAfter, queueRetryAfterMin), queueRetryAfterMax),
+	}
+}
+
+func (q *callQueue) publish() {
+	queueActive.Set(float64(q.active))
+	for _, priority := range llm.Priorities {
+		queueDepth.WithLabelValues(priority.String()).Set(float64(len(q.waiting[priority])))
+	}
+}
@@ -0,0 +1,150 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+// waitQueued: 대기열 길이가 want가 될 때까지 기다립니다.
+func waitQueued(t *testing.T, q *callQueue, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		q.mu.Lock()
+		depth := q.depth()
+		q.mu.Unlock()
+		if depth == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("queue depth did not reach %d", want)
+}
+
+func TestCallQueue_NilPassesThrough(t *testing.T) {
+	q := newCallQueue(0, 10, 5)
+	if q != nil {
+		t.Fatalf("expected nil queue when concurrency is unlimited")
+	}
+	release, err := q.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire on nil queue: %v", err)
+	}
+	release()
+}
+
+func TestCallQueue_InteractiveBeforeBatch(t *testing.T) {
+	q := newCallQueue(1, 4, 2)
+	ctx := context.Background()
+	batchCtx := llm.WithPriority(ctx, llm.PriorityBatch)
+
+	release, err := q.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	order := make(chan string, 2)
+	go func() {
+		r, err := q.acquire(batchCtx)
+		if err == nil {
+			order <- "batch"
+			r()
+		}
+	}()
+	waitQueued(t, q, 1)
+	go func() {
+		r, err := q.acquire(ctx)
+		if err == nil {
+			order <- "interactive"
+			r()
+		}
+	}()
+	waitQueued(t, q, 2)
+
+	release()
+	if first, second := <-order, <-order; first != "interactive" || second != "batch" {
+		t.Fatalf("expected interactive before batch, got %s, %s", first, second)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.active != 0 || q.depth() != 0 {
+		t.Fatalf("expected empty queue, active=%d depth=%d", q.active, q.depth())
+	}
+}
+
+func TestCallQueue_RejectsWhenSaturated(t *testing.T) {
+	q := newCallQueue(1, 2, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	batchCtx := llm.WithPriority(ctx, llm.PriorityBatch)
+
+	release, err := q.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	defer release()
+
+	shed := make(chan error, 1)
+	go func() {
+		_, err := q.acquire(batchCtx)
+		shed <- err
+	}()
+	waitQueued(t, q, 1)
+
+	// batch 한도(1)를 넘으면 batch는 바로 거절
+	if _, err := q.acquire(batchCtx); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected batch rejection, got %v", err)
+	}
+
+	go func() { _, _ = q.acquire(ctx) }()
+	waitQueued(t, q, 2)
+
+	// 전체 한도가 차면 interactive는 batch 대기자를 밀어낸다
+	go func() { _, _ = q.acquire(ctx) }()
+	var full *QueueFullError
+	if err := <-shed; !errors.As(err, &full) || full.Priority != llm.PriorityBatch {
+		t.Fatalf("expected queued batch call to be shed, got %v", err)
+	}
+	if full.RetryAfter < queueRetryAfterMin || full.RetryAfter > queueRetryAfterMax {
+		t.Fatalf("retry after out of range: %s", full.RetryAfter)
+	}
+	waitQueued(t, q, 2)
+
+	// interactive만 남아 가득 차면 거절
+	if _, err := q.acquire(ctx); !errors.As(err, &full) || full.Priority != llm.PriorityInteractive {
+		t.Fatalf("expected interactive rejection, got %v", err)
+	}
+}
+
+func TestCallQueue_CanceledWaiterLeavesQueue(t *testing.T) {
+	q := newCallQueue(1, 2, 2)
+	release, err := q.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := q.acquire(ctx)
+		done <- err
+	}()
+	waitQueued(t, q, 1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+	waitQueued(t, q, 0)
+
+	release()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.active != 0 {
+		t.Fatalf("slot leaked after cancel: active=%d", q.active)
+	}
+}
@@ -0,0 +1,189 @@
+package gemini
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+const (
+	// routeLatencyWindow: 모델별로 보관하는 최근 호출 지연 표본 수
+	routeLatencyWindow = 50
+	// routeLatencyMinSamples: p95를 판단에 쓰기 위한 최소 표본 수
+	routeLatencyMinSamples = 10
+	// routeLatencyMaxAge: 이보다 오래된 표본은 버림 (대체 모델로 넘긴 뒤 작업 모델을 다시 시험하기 위함)
+	routeLatencyMaxAge = 5 * time.Minute
+)
+
+// 라우팅 사유 (지표 reason 라벨과 trace 속성 값)
+const (
+	routeReasonPrimary  = "primary"
+	routeReasonOverride = "override"
+	routeReasonLatency  = "latency"
+	routeReasonBudget   = "budget"
+)
+
+var (
+	modelRouteTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mcp_llm_gemini_model_route_total",
+		Help: "Number of Gemini model routing decisions per task, chosen model and reason.",
+	}, []string{"task", "model", "reason"})
+	modelLatencyP95 = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mcp_llm_gemini_model_latency_p95_seconds",
+		Help: "Recent p95 latency of Gemini generate calls per model used for routing.",
+	}, []string{"model"})
+)
+
+// latencySample: 호출 한 번의 지연과 기록 시각
+type latencySample struct {
+	at       time.Time
+	duration time.Duration
+}
+
+// modelRouter: 작업별 모델의 최근 p95 지연과 호출 봇의 남은 일일 예산을 보고 요청마다 대체 모델로 넘길지 결정합니다.
+// 예산 부족이 지연보다 우선하며, 요청에 모델을 직접 지정하면 라우팅하지 않습니다.
+type modelRouter struct {
+	fallback     string
+	p95Threshold time.Duration
+	budgetRatio  float64
+	quota        *quota.Tracker
+	now          func() time.Time
+
+	mu      sync.Mutex
+	samples map[string][]latencySample
+}
+
+// newModelRouter: 대체 모델이 없으면 nil을 반환합니다. (작업별 모델 그대로 사용)
+func newModelRouter(cfg config.GeminiConfig) *modelRouter {
+	if cfg.FallbackModel == "" {
+		return nil
+	}
+	return &modelRouter{
+		fallback:     cfg.FallbackModel,
+		p95Threshold: time.Duration(cfg.RoutingP95Millis) * time.Millisecond,
+		budgetRatio:  cfg.RoutingBudgetRatio,
+		now:          time.Now,
+		samples:      make(map[string][]latencySample),
+	}
+}
+
+// route: 이번 요청에 쓸 모델을 고르고 결정을 지표와 현재 span에 남깁니다. nil 라우터면 model을 그대로 반환합니다.
+func (r *modelRouter) route(ctx context.Context, task string, model string, pinned bool) string {
+	if r == nil {
+		return model
+	}
+
+	chosen, reason := r.decide(ctx, model, pinned)
+	if task == "" {
+		task = "default"
+	}
+	modelRouteTotal.WithLabelValues(task, chosen, reason).Inc()
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("llm.route.task", task),
+		attribute.String("llm.route.requested_model", model),
+		attribute.String("llm.route.model", chosen),
+		attribute.String("llm.route.reason", reason),
+	)
+	return chosen
+}
+
+func (r *modelRouter) decide(ctx context.Context, model string, pinned bool) (string, string) {
+	if pinned {
+		return model, routeReasonOverride
+	}
+	if model == r.fallback {
+		return model, routeReasonPrimary
+	}
+	if r.budgetLow(quota.CallerFromContext(ctx)) {
+		return r.fallback, routeReasonBudget
+	}
+	if r.p95Threshold > 0 {
+		primary, ok := r.p95(model)
+		if ok && primary > r.p95Threshold {
+			// 대체 모델도 더 느리다면 넘겨도 이득이 없음
+			if fallback, known := r.p95(r.fallback); !known || fallback < primary {
+				return r.fallback, routeReasonLatency
+			}
+		}
+	}
+	return model, routeReasonPrimary
+}
+
+// budgetLow: 호출 봇의 요청/토큰 예산 중 하나라도 남은 비율이 기준 이하인지 확인합니다. 무제한 예산은 보지 않습니다.
+func (r *modelRouter) budgetLow(botID string) bool {
+	if r.budgetRatio <= 0 || botID == "" {
+		return false
+	}
+	status, ok := r.quota.Status(botID)
+	if !ok {
+		return false
+	}
+	return remainingBelow(status.RequestsUsed, status.RequestsLimit, r.budgetRatio) ||
+		remainingBelow(status.TokensUsed, status.TokensLimit, r.budgetRatio)
+}
+
+func remainingBelow(used int64, limit int64, ratio float64) bool {
+	if limit <= 0 {
+		return false
+	}
+	return float64(limit-used)/float64(limit) <= ratio
+}
+
+// observe: 모델 호출 지연을 기록하고 p95 지표를 갱신합니다.
+func (r *modelRouter) observe(model string, duration time.Duration) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	samples := append(r.samples[model], latencySample{at: r.now(), duration: duration})
+	if len(samples) > routeLatencyWindow {
+		samples = samples[len(samples)-routeLatencyWindow:]
+	}
+	r.samples[model] = samples
+	p95, ok := r.p95Locked(model)
+	r.mu.Unlock()
+
+	if ok {
+		modelLatencyP95.WithLabelValues(model).Set(p95.Seconds())
+	}
+}
+
+// p95: 최근 표본의 p95 지연을 반환합니다. 표본이 부족하면 ok가 false입니다.
+func (r *modelRouter) p95(model string) (time.Duration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.p95Locked(model)
+}
+
+func (r *modelRouter) p95Locked(model string) (time.Duration, bool) {
+	cutoff := r.now().Add(-routeLatencyMaxAge)
+	samples := r.samples[model]
+	fresh := slices.IndexFunc(samples, func(s latencySample) bool { return s.at.After(cutoff) })
+	if fresh < 0 {
+		delete(r.samples, model)
+		return 0, false
+	}
+	samples = samples[fresh:]
+	r.samples[model] = samples
+	if len(samples) < routeLatencyMinSamples {
+		return 0, false
+	}
+
+	durations := make([]time.Duration, len(samples))
+	for i, s := range samples {
+		durations[i] = s.duration
+	}
+	slices.Sort(durations)
+	// nearest-rank 방식
+	return durations[(len(durations)*95+99)/100-1], true
+}
@@ -0,0 +1,141 @@
+package gemini
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+const (
+	testPrimaryModel  = "gemini-3-pro-preview"
+	testFallbackModel = "gemini-3-flash-preview"
+)
+
+func newTestRouter(now *time.Time) *modelRouter {
+	router := newModelRouter(config.GeminiConfig{
+		FallbackModel:      testFallbackModel,
+		RoutingP95Millis:   1000,
+		RoutingBudgetRatio: 0.2,
+	})
+	router.now = func() time.Time { return *now }
+	return router
+}
+
+func observeN(router *modelRouter, model string, n int, duration time.Duration) {
+	for range n {
+		router.observe(model, duration)
+	}
+}
+
+func TestModelRouter_NilWithoutFallback(t *testing.T) {
+	router := newModelRouter(config.GeminiConfig{})
+	if router != nil {
+		t.Fatalf("expected nil router")
+	}
+	if got := router.route(context.Background(), "answer", testPrimaryModel, false); got != testPrimaryModel {
+		t.Fatalf("expected model unchanged, got %s", got)
+	}
+	router.observe(testPrimaryModel, time.Second)
+}
+
+func TestModelRouter_FallsBackWhenPrimarySlow(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	router := newTestRouter(&now)
+	ctx := context.Background()
+
+	// 표본이 부족하면 판단하지 않음
+	observeN(router, testPrimaryModel, routeLatencyMinSamples-1, 3*time.Second)
+	if got, reason := router.decide(ctx, testPrimaryModel, false); got != testPrimaryModel || reason != routeReasonPrimary {
+		t.Fatalf("expected primary with few samples, got %s (%s)", got, reason)
+	}
+
+	observeN(router, testPrimaryModel, 1, 3*time.Second)
+	if got, reason := router.decide(ctx, testPrimaryModel, false); got != testFallbackModel || reason != routeReasonLatency {
+		t.Fatalf("expected latency fallback, got %s (%s)", got, reason)
+	}
+
+	// 요청에 모델을 직접 지정하면 그대로 사용
+	if got, reason := router.decide(ctx, testPrimaryModel, true); got != testPrimaryModel || reason != routeReasonOverride {
+		t.Fatalf("expected pinned model, got %s (%s)", got, reason)
+	}
+
+	// 대체 모델이 더 느리면 넘기지 않음
+	observeN(router, testFallbackModel, routeLatencyMinSamples, 4*time.Second)
+	if got, _ := router.decide(ctx, testPrimaryModel, false); got != testPrimaryModel {
+		t.Fatalf("expected primary when fallback is slower, got %s", got)
+	}
+}
+
+func TestModelRouter_StaleSamplesExpire(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	router := newTestRouter(&now)
+
+	observeN(router, testPrimaryModel, routeLatencyMinSamples, 3*time.Second)
+	if got, _ := router.decide(context.Background(), testPrimaryModel, false); got != testFallbackModel {
+		t.Fatalf("expected latency fallback, got %s", got)
+	}
+
+	now = now.Add(routeLatencyMaxAge + time.Second)
+	if got, _ := router.decide(context.Background(), testPrimaryModel, false); got != testPrimaryModel {
+		t.Fatalf("expected primary to be retried after samples expire, got %s", got)
+	}
+}
+
+func TestModelRouter_P95NearestRank(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	router := newTestRouter(&now)
+
+	for i := 1; i <= 20; i++ {
+		router.observe(testPrimaryModel, time.Duration(i)*100*time.Millisecond)
+	}
+	if got, ok := router.p95(testPrimaryModel); !ok || got != 1900*time.Millisecond {
+		t.Fatalf("expected p95 1.9s, got %s (%v)", got, ok)
+	}
+
+	// 창 크기를 넘으면 오래된 표본부터 버림
+	observeN(router, testPrimaryModel, routeLatencyWindow, 50*time.Millisecond)
+	if got, _ := router.p95(testPrimaryModel); got != 50*time.Millisecond {
+		t.Fatalf("expected window to drop old samples, got %s", got)
+	}
+}
+
+func TestModelRouter_FallsBackWhenBudgetLow(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	router := newTestRouter(&now)
+	tracker := quota.NewTracker(config.QuotaConfig{
+		Enabled: true,
+		Bots: map[string]config.BotQuota{
+			quota.BotTwentyQ: {DailyRequests: 10},
+		},
+	})
+	router.quota = tracker
+
+	ctx := quota.WithCaller(context.Background(), quota.BotTwentyQ)
+	for range 7 {
+		if err := tracker.Acquire(quota.BotTwentyQ); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, _ := router.decide(ctx, testPrimaryModel, false); got != testPrimaryModel {
+		t.Fatalf("expected primary with 30%% budget left, got %s", got)
+	}
+
+	if err := tracker.Acquire(quota.BotTwentyQ); err != nil {
+		t.Fatal(err)
+	}
+	if got, reason := router.decide(ctx, testPrimaryModel, false); got != testFallbackModel || reason != routeReasonBudget {
+		t.Fatalf("expected budget fallback, got %s (%s)", got, reason)
+	}
+
+	// 무제한 봇과 호출 봇이 없는 요청은 예산 조건을 보지 않음
+	holo := quota.WithCaller(context.Background(), quota.BotHolo)
+	if got, _ := router.decide(holo, testPrimaryModel, false); got != testPrimaryModel {
+		t.Fatalf("expected unlimited bot to stay on primary, got %s", got)
+	}
+	if got, _ := router.decide(context.Background(), testPrimaryModel, false); got != testPrimaryModel {
+		t.Fatalf("expected anonymous caller to stay on primary, got %s", got)
+	}
+}
@@ -0,0 +1,348 @@
+// Package contract: game-bot-go 클라이언트와 공유하는 gRPC 계약(골든 디스크립터, 요청/응답 픽스처)을 다룹니다.
+//
+// 픽스처와 골든 디스크립터는 proto/llm/v1/contract에 있으며, 양쪽 저장소의 계약 테스트가 같은 파일을 읽습니다.
+// 서버 측은 이 패키지를, 클라이언트 측은 별도 모듈이므로 테스트 내 동일 형식 구현을 사용합니다.
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 공유 파일 위치 (모듈 루트 기준)
+const (
+	Dir            = "proto/llm/v1/contract"
+	DescriptorFile = "descriptor.golden.json"
+	FixturesDir    = "fixtures"
+)
+
+// 계약 검증 결과 상태
+const (
+	StatusPass     = "pass"
+	StatusFail     = "fail"
+	StatusUntested = "untested"
+	StatusMatch    = "match"
+	StatusDrift    = "drift"
+)
+
+// Field: 메시지 필드 계약 (번호/이름/타입/cardinality)
+type Field struct {
+	Number   int32  `json:"number"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Repeated bool   `json:"repeated,omitempty"`
+	Optional bool   `json:"optional,omitempty"` // proto3 optional (presence 추적)
+	Message  string `json:"message,omitempty"`  // message/enum 타입의 전체 이름
+}
+
+// Method: RPC 입출력 타입 계약
+type Method struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+// Descriptor: proto 파일에서 추출한 비교용 계약 요약
+type Descriptor struct {
+	Package  string             `json:"package"`
+	Service  string             `json:"service"`
+	Methods  map[string]Method  `json:"methods"`
+	Messages map[string][]Field `json:"messages"`
+}
+
+// Describe: 생성된 pb 파일 디스크립터에서 계약 요약을 만듭니다. go_package 등 언어별 옵션은 비교 대상에서 제외합니다.
+func Describe(fd protoreflect.FileDescriptor) Descriptor {
+	out := Descriptor{
+		Package:  string(fd.Package()),
+		Methods:  make(map[string]Method),
+		Messages: make(map[string][]Field),
+	}
+
+	if fd.Services().Len() > 0 {
+		svc := fd.Services().Get(0)
+		out.Service = string(svc.FullName())
+		for i := range svc.Methods().Len() {
+			m := svc.Methods().Get(i)
+			out.Methods[string(m.Name())] = Method{
+				Input:  string(m.Input().FullName()),
+				Output: string(m.Output().FullName()),
+			}
+		}
+	}
+
+	var walk func(msgs protoreflect.MessageDescriptors)
+	walk = func(msgs protoreflect.MessageDescriptors) {
+		for i := range msgs.Len() {
+			md := msgs.Get(i)
+			fields := make([]Field, 0, md.Fields().Len())
+			for j := range md.Fields().Len() {
+				f := md.Fields().Get(j)
+				field := Field{
+					Number:   int32(f.Number()),
+					Name:     string(f.Name()),
+					Kind:     f.Kind().String(),
+					Repeated: f.Cardinality() == protoreflect.Repeated,
+					Optional: f.HasOptionalKeyword(),
+				}
+				switch {
+				case f.Message() != nil:
+					field.Message = string(f.Message().FullName())
+				case f.Enum() != nil:
+					field.Message = string(f.Enum().FullName())
+				}
+				fields = append(fields, field)
+			}
+			out.Messages[string(md.FullName())] = fields
+			walk(md.Messages())
+		}
+	}
+	walk(fd.Messages())
+	return out
+}
+
+// Diff: 두 계약 요약의 차이를 사람이 읽을 수 있는 목록으로 반환합니다. (같으면 빈 목록)
+func Diff(want Descriptor, got Descriptor) []string {
+	var diffs []string
+	if want.Package != got.Package {
+		diffs = append(diffs, fmt.Sprintf("package: %s -> %s", want.Package, got.Package))
+	}
+	if want.Service != got.Service {
+		diffs = append(diffs, fmt.Sprintf("service: %s -> %s", want.Service, got.Service))
+	}
+
+	for _, name := range unionKeys(want.Methods, got.Methods) {
+		w, wok := want.Methods[name]
+		g, gok := got.Methods[name]
+		switch {
+		case !gok:
+			diffs = append(diffs, "method removed: "+name)
+		case !wok:
+			diffs = append(diffs, "method added: "+name)
+		case w != g:
+			diffs = append(diffs, fmt.Sprintf("method %s: %s -> %s", name, describeMethod(w), describeMethod(g)))
+		}
+	}
+
+	for _, name := range unionKeys(want.Messages, got.Messages) {
+		w, wok := want.Messages[name]
+		g, gok := got.Messages[name]
+		switch {
+		case !gok:
+			diffs = append(diffs, "message removed: "+name)
+		case !wok:
+			diffs = append(diffs, "message added: "+name)
+		default:
+			diffs = append(diffs, diffFields(name, w, g)...)
+		}
+	}
+	return diffs
+}
+
+func diffFields(message string, want []Field, got []Field) []string {
+	byNumber := func(fields []Field) map[int32]Field {
+		out := make(map[int32]Field, len(fields))
+		for _, f := range fields {
+			out[f.Number] = f
+		}
+		return out
+	}
+	w, g := byNumber(want), byNumber(got)
+
+	var diffs []string
+	for _, number := range unionKeys(w, g) {
+		wf, wok := w[number]
+		gf, gok := g[number]
+		switch {
+		case !gok:
+			diffs = append(diffs, fmt.Sprintf("%s: field %d (%s) removed", message, number, wf.Name))
+		case !wok:
+			diffs = append(diffs, fmt.Sprintf("%s: field %d (%s) added", message, number, gf.Name))
+		case wf != gf:
+			diffs = append(diffs, fmt.Sprintf("%s: field %d %+v -> %+v", message, number, wf, gf))
+		}
+	}
+	return diffs
+}
+
+func describeMethod(m Method) string {
+	return m.Input + " => " + m.Output
+}
+
+func unionKeys[K string | int32, V any](a map[K]V, b map[K]V) []K {
+	keys := make([]K, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// LoadDescriptor: 골든 디스크립터를 읽습니다.
+func LoadDescriptor(dir string) (Descriptor, error) {
+	var out Descriptor
+	raw, err := os.ReadFile(filepath.Join(dir, DescriptorFile))
+	if err != nil {
+		return out, fmt.Errorf("read golden descriptor: %w", err)
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return out, fmt.Errorf("decode golden descriptor: %w", err)
+	}
+	return out, nil
+}
+
+// WriteDescriptor: 골든 디스크립터를 갱신합니다. (proto 변경 후 `go test ./internal/grpcserver/... -run Contract -update`)
+func WriteDescriptor(dir string, desc Descriptor) error {
+	raw, err := json.MarshalIndent(desc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode golden descriptor: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, DescriptorFile), append(raw, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write golden descriptor: %w", err)
+	}
+	return nil
+}
+
+// Fixture: RPC 한 건의 골든 요청/응답 (protojson 형식)
+type Fixture struct {
+	Name     string          `json:"-"` // 파일명 (확장자 제외)
+	Method   string          `json:"method"`
+	Required []string        `json:"required,omitempty"` // 비어 있으면 서버가 거부하는 요청 필드 (proto 필드명)
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+// LoadFixtures: fixtures 디렉터리의 *.json 픽스처를 이름순으로 읽습니다.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, FixturesDir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("glob fixtures: %w", err)
+	}
+	sort.Strings(paths)
+
+	fixtures := make([]Fixture, 0, len(paths))
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read fixture %s: %w", path, err)
+		}
+		var f Fixture
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, fmt.Errorf("decode fixture %s: %w", path, err)
+		}
+		f.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+		fixtures = append(fixtures, f)
+	}
+	return fixtures, nil
+}
+
+// SideReport: 한쪽(서버 또는 클라이언트) 계약 테스트 결과. 호환성 매트릭스의 한 열이 됩니다.
+type SideReport struct {
+	Side       string            `json:"side"`
+	Descriptor string            `json:"descriptor"` // match | drift
+	Methods    map[string]string `json:"methods"`    // RPC 이름 -> pass | fail (없으면 untested)
+}
+
+// WriteSideReport: CONTRACT_REPORT_DIR에 결과를 기록합니다.
+func WriteSideReport(dir string, report SideReport) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create report dir: %w", err)
+	}
+	raw, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode side report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, report.Side+".json"), raw, 0o644); err != nil {
+		return fmt.Errorf("write side report: %w", err)
+	}
+	return nil
+}
+
+// LoadSideReports: 보고서 디렉터리의 결과를 모두 읽습니다.
+func LoadSideReports(dir string) ([]SideReport, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("glob side reports: %w", err)
+	}
+	sort.Strings(paths)
+
+	reports := make([]SideReport, 0, len(paths))
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read side report %s: %w", path, err)
+		}
+		var r SideReport
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return nil, fmt.Errorf("decode side report %s: %w", path, err)
+		}
+		reports = append(reports, r)
+	}
+	return reports, nil
+}
+
+// RenderMatrix: RPC x 참여자 호환성 매트릭스를 Markdown 표로 만들고 실패 여부를 함께 반환합니다.
+func RenderMatrix(desc Descriptor, reports []SideReport) (string, bool) {
+	var b strings.Builder
+	failed := false
+
+	b.WriteString("| RPC |")
+	for _, r := range reports {
+		b.WriteString(" " + r.Side + " |")
+	}
+	b.WriteString("\n|---|")
+	for range reports {
+		b.WriteString("---|")
+	}
+	b.WriteString("\n| (descriptor) |")
+	for _, r := range reports {
+		if r.Descriptor != StatusMatch {
+			failed = true
+		}
+		b.WriteString(" " + statusMark(r.Descriptor) + " |")
+	}
+	b.WriteString("\n")
+
+	methods := make([]string, 0, len(desc.Methods))
+	for name := range desc.Methods {
+		methods = append(methods, name)
+	}
+	sort.Strings(methods)
+
+	for _, name := range methods {
+		b.WriteString("| " + name + " |")
+		for _, r := range reports {
+			status := r.Methods[name]
+			if status == "" {
+				status = StatusUntested
+			}
+			if status == StatusFail {
+				failed = true
+			}
+			b.WriteString(" " + statusMark(status) + " |")
+		}
+		b.WriteString("\n")
+	}
+	return b.String(), failed
+}
+
+func statusMark(status string) string {
+	switch status {
+	case StatusPass, StatusMatch:
+		return "✅ " + status
+	case StatusFail, StatusDrift:
+		return "❌ " + status
+	default:
+		return "➖ " + status
+	}
+}
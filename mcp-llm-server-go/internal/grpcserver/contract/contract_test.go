@@ -0,0 +1,49 @@
+package contract
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	want := Descriptor{
+		Package:  "llm.v1",
+		Methods:  map[string]Method{"EndSession": {Input: "llm.v1.EndSessionRequest", Output: "llm.v1.EndSessionResponse"}},
+		Messages: map[string][]Field{"llm.v1.EndSessionRequest": {{Number: 1, Name: "session_id", Kind: "string"}}},
+	}
+	if diffs := Diff(want, want); len(diffs) != 0 {
+		t.Fatalf("expected no diff, got %v", diffs)
+	}
+
+	got := Descriptor{
+		Package:  "llm.v1",
+		Methods:  map[string]Method{"EndSession": {Input: "llm.v1.EndSessionRequest", Output: "llm.v1.EndSessionResponse"}, "Ping": {}},
+		Messages: map[string][]Field{"llm.v1.EndSessionRequest": {{Number: 1, Name: "session_key", Kind: "string"}, {Number: 2, Name: "force", Kind: "bool"}}},
+	}
+	diffs := strings.Join(Diff(want, got), "\n")
+	for _, expected := range []string{"method added: Ping", "field 1", "field 2 (force) added"} {
+		if !strings.Contains(diffs, expected) {
+			t.Errorf("expected %q in diffs:\n%s", expected, diffs)
+		}
+	}
+}
+
+func TestRenderMatrix(t *testing.T) {
+	desc := Descriptor{Methods: map[string]Method{"EndSession": {}, "GetQuotaStatus": {}}}
+
+	matrix, failed := RenderMatrix(desc, []SideReport{
+		{Side: "client", Descriptor: StatusMatch, Methods: map[string]string{"EndSession": StatusPass}},
+		{Side: "server", Descriptor: StatusMatch, Methods: map[string]string{"EndSession": StatusPass, "GetQuotaStatus": StatusPass}},
+	})
+	if failed {
+		t.Fatalf("untested rpc must not fail the matrix:\n%s", matrix)
+	}
+	if !strings.Contains(matrix, "| GetQuotaStatus | ➖ untested | ✅ pass |") {
+		t.Errorf("unexpected matrix:\n%s", matrix)
+	}
+
+	_, failed = RenderMatrix(desc, []SideReport{{Side: "client", Descriptor: StatusDrift}})
+	if !failed {
+		t.Error("descriptor drift must fail the matrix")
+	}
+}
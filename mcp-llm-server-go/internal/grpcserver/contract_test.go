@@ -0,0 +1,174 @@
+package grpcserver
+
+import (
+	"context"
+	"flag"
+	"os"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/contract"
+	llmv1 "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/pb/llm/v1"
+)
+
+var updateContract = flag.Bool("update", false, "골든 디스크립터(proto/llm/v1/contract)를 현재 pb 기준으로 갱신")
+
+const contractDir = "../../" + contract.Dir
+
+// contractResults: CONTRACT_REPORT_DIR 지정 시 매트릭스 보고서로 기록할 결과
+var contractResults = struct {
+	sync.Mutex
+	report contract.SideReport
+}{report: contract.SideReport{Side: "mcp-llm-server-go", Descriptor: contract.StatusDrift, Methods: map[string]string{}}}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if dir := os.Getenv("CONTRACT_REPORT_DIR"); dir != "" {
+		if err := contract.WriteSideReport(dir, contractResults.report); err != nil {
+			println("contract report:", err.Error())
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+func recordContract(method string, t *testing.T) {
+	contractResults.Lock()
+	defer contractResults.Unlock()
+	status := contract.StatusPass
+	if t.Failed() || contractResults.report.Methods[method] == contract.StatusFail {
+		status = contract.StatusFail
+	}
+	contractResults.report.Methods[method] = status
+}
+
+func TestContract_Descriptor(t *testing.T) {
+	got := contract.Describe(llmv1.File_llm_v1_llm_service_proto)
+	if *updateContract {
+		if err := contract.WriteDescriptor(contractDir, got); err != nil {
+			t.Fatalf("update golden descriptor: %v", err)
+		}
+	}
+
+	want, err := contract.LoadDescriptor(contractDir)
+	if err != nil {
+		t.Fatalf("load golden descriptor: %v", err)
+	}
+	if diffs := contract.Diff(want, got); len(diffs) > 0 {
+		t.Fatalf("server pb drifted from golden descriptor (proto 변경 시 -update로 갱신하고 클라이언트 pb도 재생성):\n%v", diffs)
+	}
+
+	contractResults.Lock()
+	contractResults.report.Descriptor = contract.StatusMatch
+	contractResults.Unlock()
+}
+
+func TestContract_Fixtures(t *testing.T) {
+	fixtures, err := contract.LoadFixtures(contractDir)
+	if err != nil {
+		t.Fatalf("load fixtures: %v", err)
+	}
+
+	svc := llmv1.File_llm_v1_llm_service_proto.Services().Get(0)
+	covered := make(map[string]bool)
+
+	for _, fx := range fixtures {
+		t.Run(fx.Name, func(t *testing.T) {
+			defer recordContract(fx.Method, t)
+
+			md := svc.Methods().ByName(protoreflect.Name(fx.Method))
+			if md == nil {
+				t.Fatalf("unknown rpc %q", fx.Method)
+			}
+			covered[fx.Method] = true
+
+			req := decodeFixtureMessage(t, md.Input(), fx.Request)
+			decodeFixtureMessage(t, md.Output(), fx.Response)
+
+			// 필수 필드는 proto3 일반 스칼라여야 하고 (optional/repeated는 "비어 있음"을 표현할 수 없음) 픽스처에 채워져 있어야 함
+			for _, name := range fx.Required {
+				fd := md.Input().Fields().ByName(protoreflect.Name(name))
+				if fd == nil {
+					t.Errorf("required field %q not in %s", name, md.Input().FullName())
+					continue
+				}
+				if fd.HasOptionalKeyword() || fd.Cardinality() == protoreflect.Repeated {
+					t.Errorf("required field %q must be a plain scalar", name)
+				}
+				if !req.ProtoReflect().Has(fd) {
+					t.Errorf("fixture request must set required field %q", name)
+				}
+			}
+		})
+	}
+
+	for i := range svc.Methods().Len() {
+		if name := string(svc.Methods().Get(i).Name()); !covered[name] {
+			t.Errorf("rpc %s has no contract fixture", name)
+		}
+	}
+}
+
+// TestContract_RequiredFieldsRejected: gRPC 계층에서 검증하는 필수 필드는 비우면 InvalidArgument여야 합니다.
+// 유스케이스에서 검증하는 필드는 픽스처의 required 목록으로만 고정합니다.
+func TestContract_RequiredFieldsRejected(t *testing.T) {
+	svc := &LLMService{}
+	calls := map[string]func(context.Context, proto.Message) error{
+		"GuardIsMalicious": func(ctx context.Context, m proto.Message) error {
+			_, err := svc.GuardIsMalicious(ctx, m.(*llmv1.GuardIsMaliciousRequest))
+			return err
+		},
+		"EndSession": func(ctx context.Context, m proto.Message) error {
+			_, err := svc.EndSession(ctx, m.(*llmv1.EndSessionRequest))
+			return err
+		},
+	}
+
+	fixtures, err := contract.LoadFixtures(contractDir)
+	if err != nil {
+		t.Fatalf("load fixtures: %v", err)
+	}
+	methods := llmv1.File_llm_v1_llm_service_proto.Services().Get(0).Methods()
+
+	for _, fx := range fixtures {
+		call, ok := calls[fx.Method]
+		if !ok {
+			continue
+		}
+		t.Run(fx.Name, func(t *testing.T) {
+			defer recordContract(fx.Method, t)
+
+			md := methods.ByName(protoreflect.Name(fx.Method))
+			for _, name := range fx.Required {
+				req := decodeFixtureMessage(t, md.Input(), fx.Request)
+				req.ProtoReflect().Clear(md.Input().Fields().ByName(protoreflect.Name(name)))
+
+				err := call(context.Background(), req)
+				if status.Code(err) != codes.InvalidArgument {
+					t.Errorf("empty %s: expected InvalidArgument, got %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+// decodeFixtureMessage: 픽스처 JSON을 엄격 모드(알 수 없는 필드 거부)로 pb 메시지에 디코딩합니다.
+func decodeFixtureMessage(t *testing.T, md protoreflect.MessageDescriptor, raw []byte) proto.Message {
+	t.Helper()
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		t.Fatalf("message type %s: %v", md.FullName(), err)
+	}
+	msg := mt.New().Interface()
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		t.Fatalf("decode %s: %v", md.FullName(), err)
+	}
+	return msg
+}
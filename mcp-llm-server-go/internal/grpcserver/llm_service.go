@@ -8,20 +8,26 @@ import (
 	"strings"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/turtlesoup"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/twentyq"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/embedding"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
 	llmv1 "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/pb/llm/v1"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/handler/shared"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/session"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/shadow"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
 	turtlesoupuc "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usecase/turtlesoup"
 	twentyquc "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usecase/twentyq"
@@ -44,9 +50,11 @@ type LLMService struct {
 	store *session.Store
 
 	usageRepo *usage.Repository
+	quota     *quota.Tracker
 
 	twentyqUsecase    *twentyquc.Service
 	turtlesoupUsecase *turtlesoupuc.Service
+	embeddings        *embedding.Service
 }
 
 // NewLLMService: gRPC LLMService를 생성합니다.
@@ -54,13 +62,17 @@ func NewLLMService(
 	cfg *config.Config,
 	logger *slog.Logger,
 	client *gemini.Client,
+	provider llm.Provider,
 	injectionGuard *guard.InjectionGuard,
 	store *session.Store,
 	usageRepo *usage.Repository,
+	quotaTracker *quota.Tracker,
 	twentyqPrompts *twentyq.Prompts,
 	topicLoader *twentyq.TopicLoader,
+	shadowEvaluator *shadow.Evaluator,
 	turtlesoupPrompts *turtlesoup.Prompts,
 	puzzleLoader *turtlesoup.PuzzleLoader,
+	embeddingService *embedding.Service,
 ) *LLMService {
 	return &LLMService{
 		cfg:               cfg,
@@ -68,8 +80,10 @@ func NewLLMService(
 		guard:             injectionGuard,
 		store:             store,
 		usageRepo:         usageRepo,
-		twentyqUsecase:    twentyquc.New(cfg, client, injectionGuard, store, twentyqPrompts, topicLoader, logger),
-		turtlesoupUsecase: turtlesoupuc.New(cfg, client, injectionGuard, store, turtlesoupPrompts, puzzleLoader, logger),
+		quota:             quotaTracker,
+		twentyqUsecase:    twentyquc.New(cfg, client, provider, injectionGuard, store, twentyqPrompts, topicLoader, shadowEvaluator, logger),
+		turtlesoupUsecase: turtlesoupuc.New(cfg, provider, injectionGuard, store, turtlesoupPrompts, puzzleLoader, logger),
+		embeddings:        embeddingService,
 	}
 }
 
@@ -124,7 +138,7 @@ func (s *LLMService) GuardIsMalicious(ctx context.Context, req *llmv1.GuardIsMal
 	}
 
 	return &llmv1.GuardIsMaliciousResponse{
-		Malicious: s.guard.IsMalicious(req.InputText),
+		Malicious: s.guard.IsMalicious(ctx, req.InputText),
 	}, nil
 }
 
@@ -159,6 +173,35 @@ func (s *LLMService) EndSession(ctx context.Context, req *llmv1.EndSessionReques
 	}, nil
 }
 
+// Embed: 텍스트 목록의 임베딩을 반환합니다. 유사도 비교(코사인)는 호출 측에서 한다.
+func (s *LLMService) Embed(ctx context.Context, req *llmv1.EmbedRequest) (*llmv1.EmbedResponse, error) {
+	if req == nil {
+		return nil, httperror.NewInvalidInput("request required")
+	}
+	if s.embeddings == nil {
+		return nil, httperror.NewInternalError("embedding service not configured")
+	}
+
+	result, err := s.embeddings.Embed(ctx, embedding.Request{
+		Texts:      req.Texts,
+		TaskType:   req.GetTaskType(),
+		Dimensions: int(req.GetDimensions()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("embed: %w", err)
+	}
+
+	embeddings := make([]*llmv1.Embedding, len(result.Embeddings))
+	for i, vector := range result.Embeddings {
+		embeddings[i] = &llmv1.Embedding{Values: vector.Values, Cached: vector.Cached}
+	}
+	return &llmv1.EmbedResponse{
+		Embeddings: embeddings,
+		Model:      result.Model,
+		Dimensions: int32(result.Dimensions),
+	}, nil
+}
+
 func (s *LLMService) TwentyQSelectTopic(ctx context.Context, req *llmv1.TwentyQSelectTopicRequest) (*llmv1.TwentyQSelectTopicResponse, error) {
 	if req == nil {
 		return nil, httperror.NewInvalidInput("request required")
@@ -209,10 +252,19 @@ func (s *LLMService) TwentyQGenerateHints(ctx context.Context, req *llmv1.Twenty
 	if req.Details != nil {
 		details = req.Details.AsMap()
 	}
+	var feedback *twentyquc.HintFeedback
+	if fb := req.GetFeedback(); fb != nil {
+		feedback = &twentyquc.HintFeedback{
+			Useful:          int(fb.GetUseful()),
+			Useless:         int(fb.GetUseless()),
+			UselessExamples: fb.GetUselessExamples(),
+		}
+	}
 	hints, err := s.twentyqUsecase.GenerateHints(ctx, RequestIDFromContext(ctx), twentyquc.HintsRequest{
 		Target:   req.Target,
 		Category: req.Category,
 		Details:  details,
+		Feedback: feedback,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("generate hints: %w", err)
@@ -224,6 +276,64 @@ func (s *LLMService) TwentyQGenerateHints(ctx context.Context, req *llmv1.Twenty
 	}, nil
 }
 
+func (s *LLMService) TwentyQBatchGenerateHints(ctx context.Context, req *llmv1.TwentyQBatchGenerateHintsRequest) (*llmv1.TwentyQBatchGenerateHintsResponse, error) {
+	if req == nil {
+		return nil, httperror.NewInvalidInput("request required")
+	}
+	if s.twentyqUsecase == nil {
+		return nil, httperror.NewInternalError("service not configured")
+	}
+
+	items := make([]twentyquc.HintsRequest, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		var details map[string]any
+		if item.GetDetails() != nil {
+			details = item.GetDetails().AsMap()
+		}
+		var feedback *twentyquc.HintFeedback
+		if fb := item.GetFeedback(); fb != nil {
+			feedback = &twentyquc.HintFeedback{
+				Useful:          int(fb.GetUseful()),
+				Useless:         int(fb.GetUseless()),
+				UselessExamples: fb.GetUselessExamples(),
+			}
+		}
+		items[i] = twentyquc.HintsRequest{
+			Target:   item.GetTarget(),
+			Category: item.GetCategory(),
+			Details:  details,
+			Feedback: feedback,
+		}
+	}
+
+	results, err := s.twentyqUsecase.BatchGenerateHints(ctx, RequestIDFromContext(ctx), items)
+	if err != nil {
+		return nil, fmt.Errorf("batch generate hints: %w", err)
+	}
+
+	resp := &llmv1.TwentyQBatchGenerateHintsResponse{
+		Results: make([]*llmv1.TwentyQBatchHintResult, len(results)),
+	}
+	for i, result := range results {
+		item := &llmv1.TwentyQBatchHintResult{
+			Target:   result.Target,
+			Category: result.Category,
+			Hints:    result.Hints,
+		}
+		if result.Err != nil {
+			apiErr := httperror.FromError(result.Err)
+			code, message := string(apiErr.Code), apiErr.Message
+			item.ErrorCode = &code
+			item.ErrorMessage = &message
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Results[i] = item
+	}
+	return resp, nil
+}
+
 func (s *LLMService) TwentyQAnswerQuestion(ctx context.Context, req *llmv1.TwentyQAnswerQuestionRequest) (*llmv1.TwentyQAnswerQuestionResponse, error) {
 	if req == nil {
 		return nil, httperror.NewInvalidInput("request required")
@@ -328,6 +438,38 @@ func (s *LLMService) TwentyQCheckSynonym(ctx context.Context, req *llmv1.TwentyQ
 	}, nil
 }
 
+func (s *LLMService) TwentyQSummarizeGame(ctx context.Context, req *llmv1.TwentyQSummarizeGameRequest) (*llmv1.TwentyQSummarizeGameResponse, error) {
+	if req == nil {
+		return nil, httperror.NewInvalidInput("request required")
+	}
+	if s.twentyqUsecase == nil {
+		return nil, httperror.NewInternalError("service not configured")
+	}
+
+	history := make([]twentyquc.SummaryEntry, 0, len(req.History))
+	for _, entry := range req.History {
+		if entry == nil {
+			continue
+		}
+		history = append(history, twentyquc.SummaryEntry{Question: entry.Question, Answer: entry.Answer})
+	}
+
+	result, err := s.twentyqUsecase.SummarizeGame(ctx, RequestIDFromContext(ctx), twentyquc.SummaryRequest{
+		Category:     req.Category,
+		History:      history,
+		Hints:        req.Hints,
+		WrongGuesses: req.WrongGuesses,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("summarize game: %w", err)
+	}
+
+	return &llmv1.TwentyQSummarizeGameResponse{
+		Summary:    result.Summary,
+		Eliminated: result.Eliminated,
+	}, nil
+}
+
 func (s *LLMService) TurtleSoupGeneratePuzzle(ctx context.Context, req *llmv1.TurtleSoupGeneratePuzzleRequest) (*llmv1.TurtleSoupGeneratePuzzleResponse, error) {
 	if req == nil {
 		req = &llmv1.TurtleSoupGeneratePuzzleRequest{}
@@ -503,6 +645,29 @@ func (s *LLMService) TurtleSoupGenerateHint(ctx context.Context, req *llmv1.Turt
 	}, nil
 }
 
+func (s *LLMService) TurtleSoupGenerateEpilogue(ctx context.Context, req *llmv1.TurtleSoupGenerateEpilogueRequest) (*llmv1.TurtleSoupGenerateEpilogueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request required")
+	}
+	if s.turtlesoupUsecase == nil {
+		return nil, status.Error(codes.Internal, "service not configured")
+	}
+
+	out, err := s.turtlesoupUsecase.GenerateEpilogue(ctx, turtlesoupuc.EpilogueRequest{
+		Scenario: req.Scenario,
+		Solution: req.Solution,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate epilogue: %w", err)
+	}
+
+	return &llmv1.TurtleSoupGenerateEpilogueResponse{
+		Epilogue: out.Epilogue,
+		Question: out.Question,
+		Answer:   out.Answer,
+	}, nil
+}
+
 func (s *LLMService) GetDailyUsage(ctx context.Context, _ *emptypb.Empty) (*llmv1.DailyUsageResponse, error) {
 	if s.usageRepo == nil {
 		return nil, status.Error(codes.Internal, "usage repository not configured")
@@ -626,6 +791,115 @@ func (s *LLMService) GetTotalUsage(ctx context.Context, req *llmv1.GetTotalUsage
 	}, nil
 }
 
+// GetSessionUsage: 세션(게임)에서 쓴 토큰을 작업(RPC)별로 반환합니다. 기록이 없으면 0으로 채운 응답.
+func (s *LLMService) GetSessionUsage(ctx context.Context, req *llmv1.GetSessionUsageRequest) (*llmv1.SessionUsageResponse, error) {
+	sessionID := strings.TrimSpace(req.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id required")
+	}
+	if s.usageRepo == nil {
+		return nil, status.Error(codes.Internal, "usage repository not configured")
+	}
+
+	sessionUsage, err := s.usageRepo.GetSessionUsage(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get session usage: %w", err)
+	}
+
+	out := &llmv1.SessionUsageResponse{SessionId: sessionID}
+	if sessionUsage == nil {
+		return out, nil
+	}
+
+	total := sessionUsage.Total()
+	out.InputTokens = total.InputTokens
+	out.OutputTokens = total.OutputTokens
+	out.TotalTokens = total.TotalTokens()
+	out.ReasoningTokens = total.ReasoningTokens
+	out.RequestCount = total.RequestCount
+	out.Tasks = toTaskUsageMessages(sessionUsage.Tasks)
+	firstUsedAt := sessionUsage.FirstUsedAt.Format(time.RFC3339)
+	lastUsedAt := sessionUsage.LastUsedAt.Format(time.RFC3339)
+	out.FirstUsedAt = &firstUsedAt
+	out.LastUsedAt = &lastUsedAt
+	return out, nil
+}
+
+// GetUsageByTask: 최근 N일(기본 7일) 사용량을 작업(RPC)별로 합산해 토큰이 많은 순으로 반환합니다.
+func (s *LLMService) GetUsageByTask(ctx context.Context, req *llmv1.GetUsageByTaskRequest) (*llmv1.TaskUsageListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request required")
+	}
+	if s.usageRepo == nil {
+		return nil, status.Error(codes.Internal, "usage repository not configured")
+	}
+
+	days := int(req.Days)
+	if days <= 0 {
+		days = 7
+	}
+
+	rows, err := s.usageRepo.GetUsageByTask(ctx, days)
+	if err != nil {
+		return nil, fmt.Errorf("get usage by task: %w", err)
+	}
+	return &llmv1.TaskUsageListResponse{
+		Days:  int32(days), //nolint:gosec // days는 요청의 int32에서 온 값
+		Tasks: toTaskUsageMessages(rows),
+	}, nil
+}
+
+func toTaskUsageMessages(rows []usage.TaskUsage) []*llmv1.TaskUsage {
+	out := make([]*llmv1.TaskUsage, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &llmv1.TaskUsage{
+			Task:            row.Task,
+			InputTokens:     row.InputTokens,
+			OutputTokens:    row.OutputTokens,
+			TotalTokens:     row.TotalTokens(),
+			ReasoningTokens: row.ReasoningTokens,
+			RequestCount:    row.RequestCount,
+		})
+	}
+	return out
+}
+
+// GetQuotaStatus: 봇별 당일 요청/토큰 사용량과 예산을 반환합니다. bot_id가 비어 있으면 전체 봇을 반환한다.
+func (s *LLMService) GetQuotaStatus(ctx context.Context, req *llmv1.GetQuotaStatusRequest) (*llmv1.QuotaStatusResponse, error) {
+	if s.quota == nil {
+		return nil, status.Error(codes.Internal, "quota tracker not configured")
+	}
+
+	var statuses []quota.Status
+	if raw := strings.TrimSpace(req.GetBotId()); raw != "" {
+		botID := quota.NormalizeBotID(raw)
+		st, ok := s.quota.Status(botID)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "unknown bot_id: %s", raw)
+		}
+		statuses = []quota.Status{st}
+	} else {
+		statuses = s.quota.Statuses()
+	}
+
+	out := &llmv1.QuotaStatusResponse{
+		Enabled: s.quota.Enabled(),
+		Quotas:  make([]*llmv1.QuotaStatus, 0, len(statuses)),
+	}
+	for _, st := range statuses {
+		out.Quotas = append(out.Quotas, &llmv1.QuotaStatus{
+			BotId:         st.BotID,
+			Date:          st.Date,
+			RequestsUsed:  st.RequestsUsed,
+			RequestsLimit: st.RequestsLimit,
+			TokensUsed:    st.TokensUsed,
+			TokensLimit:   st.TokensLimit,
+			ResetsAtUnix:  st.ResetsAt.Unix(),
+		})
+	}
+	return out, nil
+}
+
 func statusFromError(err error) error {
 	if err == nil {
 		return nil
@@ -635,6 +909,10 @@ func statusFromError(err error) error {
 	if errors.As(err, &blocked) {
 		return status.Error(codes.InvalidArgument, blocked.Error())
 	}
+	var queueFull *gemini.QueueFullError
+	if errors.As(err, &queueFull) {
+		return queueFullStatus(queueFull)
+	}
 	if errors.Is(err, context.DeadlineExceeded) {
 		return status.Error(codes.DeadlineExceeded, "llm request timed out")
 	}
@@ -666,6 +944,16 @@ func statusFromError(err error) error {
 	return status.Error(codes.Internal, err.Error())
 }
 
+// queueFullStatus: 대기열 포화 거절을 ResourceExhausted와 RetryInfo(재시도 대기 시간)로 변환합니다.
+func queueFullStatus(err *gemini.QueueFullError) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(err.RetryAfter)})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 func (s *LLMService) logError(event string, err error) {
 	if s.logger == nil || err == nil {
 		return
@@ -323,6 +323,178 @@ func (x *EndSessionResponse) GetId() string {
 	return ""
 }
 
+type EmbedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Texts         []string               `protobuf:"bytes,1,rep,name=texts,proto3" json:"texts,omitempty"`
+	TaskType      *string                `protobuf:"bytes,2,opt,name=task_type,json=taskType,proto3,oneof" json:"task_type,omitempty"`
+	Dimensions    *int32                 `protobuf:"varint,3,opt,name=dimensions,proto3,oneof" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbedRequest) Reset() {
+	*x = EmbedRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbedRequest) ProtoMessage() {}
+
+func (x *EmbedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbedRequest.ProtoReflect.Descriptor instead.
+func (*EmbedRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EmbedRequest) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+func (x *EmbedRequest) GetTaskType() string {
+	if x != nil && x.TaskType != nil {
+		return *x.TaskType
+	}
+	return ""
+}
+
+func (x *EmbedRequest) GetDimensions() int32 {
+	if x != nil && x.Dimensions != nil {
+		return *x.Dimensions
+	}
+	return 0
+}
+
+type Embedding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float32              `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Cached        bool                   `protobuf:"varint,2,opt,name=cached,proto3" json:"cached,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Embedding) Reset() {
+	*x = Embedding{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Embedding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Embedding) ProtoMessage() {}
+
+func (x *Embedding) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Embedding.ProtoReflect.Descriptor instead.
+func (*Embedding) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Embedding) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Embedding) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+type EmbedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Embeddings    []*Embedding           `protobuf:"bytes,1,rep,name=embeddings,proto3" json:"embeddings,omitempty"`
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Dimensions    int32                  `protobuf:"varint,3,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbedResponse) Reset() {
+	*x = EmbedResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbedResponse) ProtoMessage() {}
+
+func (x *EmbedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbedResponse.ProtoReflect.Descriptor instead.
+func (*EmbedResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EmbedResponse) GetEmbeddings() []*Embedding {
+	if x != nil {
+		return x.Embeddings
+	}
+	return nil
+}
+
+func (x *EmbedResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *EmbedResponse) GetDimensions() int32 {
+	if x != nil {
+		return x.Dimensions
+	}
+	return 0
+}
+
 type TwentyQSelectTopicRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Category           string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -334,7 +506,7 @@ type TwentyQSelectTopicRequest struct {
 
 func (x *TwentyQSelectTopicRequest) Reset() {
 	*x = TwentyQSelectTopicRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[5]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -346,7 +518,7 @@ func (x *TwentyQSelectTopicRequest) String() string {
 func (*TwentyQSelectTopicRequest) ProtoMessage() {}
 
 func (x *TwentyQSelectTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[5]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +531,7 @@ func (x *TwentyQSelectTopicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQSelectTopicRequest.ProtoReflect.Descriptor instead.
 func (*TwentyQSelectTopicRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{5}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TwentyQSelectTopicRequest) GetCategory() string {
@@ -394,7 +566,7 @@ type TwentyQSelectTopicResponse struct {
 
 func (x *TwentyQSelectTopicResponse) Reset() {
 	*x = TwentyQSelectTopicResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[6]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +578,7 @@ func (x *TwentyQSelectTopicResponse) String() string {
 func (*TwentyQSelectTopicResponse) ProtoMessage() {}
 
 func (x *TwentyQSelectTopicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[6]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +591,7 @@ func (x *TwentyQSelectTopicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQSelectTopicResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQSelectTopicResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{6}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TwentyQSelectTopicResponse) GetName() string {
@@ -452,7 +624,7 @@ type TwentyQGetCategoriesResponse struct {
 
 func (x *TwentyQGetCategoriesResponse) Reset() {
 	*x = TwentyQGetCategoriesResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[7]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +636,7 @@ func (x *TwentyQGetCategoriesResponse) String() string {
 func (*TwentyQGetCategoriesResponse) ProtoMessage() {}
 
 func (x *TwentyQGetCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[7]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +649,7 @@ func (x *TwentyQGetCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQGetCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQGetCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{7}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TwentyQGetCategoriesResponse) GetCategories() []string {
@@ -492,13 +664,14 @@ type TwentyQGenerateHintsRequest struct {
 	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
 	Details       *structpb.Struct       `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	Feedback      *TwentyQHintFeedback   `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TwentyQGenerateHintsRequest) Reset() {
 	*x = TwentyQGenerateHintsRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[8]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +683,7 @@ func (x *TwentyQGenerateHintsRequest) String() string {
 func (*TwentyQGenerateHintsRequest) ProtoMessage() {}
 
 func (x *TwentyQGenerateHintsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[8]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +696,7 @@ func (x *TwentyQGenerateHintsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQGenerateHintsRequest.ProtoReflect.Descriptor instead.
 func (*TwentyQGenerateHintsRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{8}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TwentyQGenerateHintsRequest) GetTarget() string {
@@ -547,6 +720,73 @@ func (x *TwentyQGenerateHintsRequest) GetDetails() *structpb.Struct {
 	return nil
 }
 
+func (x *TwentyQGenerateHintsRequest) GetFeedback() *TwentyQHintFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+type TwentyQHintFeedback struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Useful          int32                  `protobuf:"varint,1,opt,name=useful,proto3" json:"useful,omitempty"`
+	Useless         int32                  `protobuf:"varint,2,opt,name=useless,proto3" json:"useless,omitempty"`
+	UselessExamples []string               `protobuf:"bytes,3,rep,name=useless_examples,json=uselessExamples,proto3" json:"useless_examples,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TwentyQHintFeedback) Reset() {
+	*x = TwentyQHintFeedback{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwentyQHintFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwentyQHintFeedback) ProtoMessage() {}
+
+func (x *TwentyQHintFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwentyQHintFeedback.ProtoReflect.Descriptor instead.
+func (*TwentyQHintFeedback) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TwentyQHintFeedback) GetUseful() int32 {
+	if x != nil {
+		return x.Useful
+	}
+	return 0
+}
+
+func (x *TwentyQHintFeedback) GetUseless() int32 {
+	if x != nil {
+		return x.Useless
+	}
+	return 0
+}
+
+func (x *TwentyQHintFeedback) GetUselessExamples() []string {
+	if x != nil {
+		return x.UselessExamples
+	}
+	return nil
+}
+
 type TwentyQGenerateHintsResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Hints            []string               `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
@@ -557,7 +797,7 @@ type TwentyQGenerateHintsResponse struct {
 
 func (x *TwentyQGenerateHintsResponse) Reset() {
 	*x = TwentyQGenerateHintsResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[9]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +809,7 @@ func (x *TwentyQGenerateHintsResponse) String() string {
 func (*TwentyQGenerateHintsResponse) ProtoMessage() {}
 
 func (x *TwentyQGenerateHintsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[9]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +822,7 @@ func (x *TwentyQGenerateHintsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQGenerateHintsResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQGenerateHintsResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{9}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TwentyQGenerateHintsResponse) GetHints() []string {
@@ -599,34 +839,28 @@ func (x *TwentyQGenerateHintsResponse) GetThoughtSignature() string {
 	return ""
 }
 
-type TwentyQAnswerQuestionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
-	ChatId        *string                `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"`
-	Namespace     *string                `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
-	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
-	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	Question      string                 `protobuf:"bytes,6,opt,name=question,proto3" json:"question,omitempty"`
-	Details       *structpb.Struct       `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+type TwentyQBatchGenerateHintsRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Items         []*TwentyQGenerateHintsRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TwentyQAnswerQuestionRequest) Reset() {
-	*x = TwentyQAnswerQuestionRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[10]
+func (x *TwentyQBatchGenerateHintsRequest) Reset() {
+	*x = TwentyQBatchGenerateHintsRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TwentyQAnswerQuestionRequest) String() string {
+func (x *TwentyQBatchGenerateHintsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TwentyQAnswerQuestionRequest) ProtoMessage() {}
+func (*TwentyQBatchGenerateHintsRequest) ProtoMessage() {}
 
-func (x *TwentyQAnswerQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[10]
+func (x *TwentyQBatchGenerateHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,40 +871,226 @@ func (x *TwentyQAnswerQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TwentyQAnswerQuestionRequest.ProtoReflect.Descriptor instead.
-func (*TwentyQAnswerQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TwentyQBatchGenerateHintsRequest.ProtoReflect.Descriptor instead.
+func (*TwentyQBatchGenerateHintsRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TwentyQAnswerQuestionRequest) GetSessionId() string {
-	if x != nil && x.SessionId != nil {
-		return *x.SessionId
+func (x *TwentyQBatchGenerateHintsRequest) GetItems() []*TwentyQGenerateHintsRequest {
+	if x != nil {
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *TwentyQAnswerQuestionRequest) GetChatId() string {
-	if x != nil && x.ChatId != nil {
-		return *x.ChatId
-	}
-	return ""
+type TwentyQBatchHintResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Hints         []string               `protobuf:"bytes,3,rep,name=hints,proto3" json:"hints,omitempty"`
+	ErrorCode     *string                `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+	ErrorMessage  *string                `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TwentyQAnswerQuestionRequest) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
-	}
-	return ""
+func (x *TwentyQBatchHintResult) Reset() {
+	*x = TwentyQBatchHintResult{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TwentyQAnswerQuestionRequest) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
+func (x *TwentyQBatchHintResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TwentyQAnswerQuestionRequest) GetCategory() string {
+func (*TwentyQBatchHintResult) ProtoMessage() {}
+
+func (x *TwentyQBatchHintResult) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwentyQBatchHintResult.ProtoReflect.Descriptor instead.
+func (*TwentyQBatchHintResult) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TwentyQBatchHintResult) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TwentyQBatchHintResult) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TwentyQBatchHintResult) GetHints() []string {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+func (x *TwentyQBatchHintResult) GetErrorCode() string {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return ""
+}
+
+func (x *TwentyQBatchHintResult) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+type TwentyQBatchGenerateHintsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*TwentyQBatchHintResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                     `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                     `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwentyQBatchGenerateHintsResponse) Reset() {
+	*x = TwentyQBatchGenerateHintsResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwentyQBatchGenerateHintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwentyQBatchGenerateHintsResponse) ProtoMessage() {}
+
+func (x *TwentyQBatchGenerateHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwentyQBatchGenerateHintsResponse.ProtoReflect.Descriptor instead.
+func (*TwentyQBatchGenerateHintsResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TwentyQBatchGenerateHintsResponse) GetResults() []*TwentyQBatchHintResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TwentyQBatchGenerateHintsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *TwentyQBatchGenerateHintsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type TwentyQAnswerQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	ChatId        *string                `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"`
+	Namespace     *string                `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Question      string                 `protobuf:"bytes,6,opt,name=question,proto3" json:"question,omitempty"`
+	Details       *structpb.Struct       `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwentyQAnswerQuestionRequest) Reset() {
+	*x = TwentyQAnswerQuestionRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwentyQAnswerQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwentyQAnswerQuestionRequest) ProtoMessage() {}
+
+func (x *TwentyQAnswerQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwentyQAnswerQuestionRequest.ProtoReflect.Descriptor instead.
+func (*TwentyQAnswerQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TwentyQAnswerQuestionRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *TwentyQAnswerQuestionRequest) GetChatId() string {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return ""
+}
+
+func (x *TwentyQAnswerQuestionRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *TwentyQAnswerQuestionRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TwentyQAnswerQuestionRequest) GetCategory() string {
 	if x != nil {
 		return x.Category
 	}
@@ -702,7 +1122,7 @@ type TwentyQAnswerQuestionResponse struct {
 
 func (x *TwentyQAnswerQuestionResponse) Reset() {
 	*x = TwentyQAnswerQuestionResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[11]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +1134,7 @@ func (x *TwentyQAnswerQuestionResponse) String() string {
 func (*TwentyQAnswerQuestionResponse) ProtoMessage() {}
 
 func (x *TwentyQAnswerQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[11]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +1147,7 @@ func (x *TwentyQAnswerQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQAnswerQuestionResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQAnswerQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{11}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TwentyQAnswerQuestionResponse) GetScale() string {
@@ -761,7 +1181,7 @@ type TwentyQVerifyGuessRequest struct {
 
 func (x *TwentyQVerifyGuessRequest) Reset() {
 	*x = TwentyQVerifyGuessRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[12]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1193,7 @@ func (x *TwentyQVerifyGuessRequest) String() string {
 func (*TwentyQVerifyGuessRequest) ProtoMessage() {}
 
 func (x *TwentyQVerifyGuessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[12]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1206,7 @@ func (x *TwentyQVerifyGuessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQVerifyGuessRequest.ProtoReflect.Descriptor instead.
 func (*TwentyQVerifyGuessRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{12}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TwentyQVerifyGuessRequest) GetTarget() string {
@@ -813,7 +1233,7 @@ type TwentyQVerifyGuessResponse struct {
 
 func (x *TwentyQVerifyGuessResponse) Reset() {
 	*x = TwentyQVerifyGuessResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[13]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -825,7 +1245,7 @@ func (x *TwentyQVerifyGuessResponse) String() string {
 func (*TwentyQVerifyGuessResponse) ProtoMessage() {}
 
 func (x *TwentyQVerifyGuessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[13]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1258,7 @@ func (x *TwentyQVerifyGuessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQVerifyGuessResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQVerifyGuessResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{13}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TwentyQVerifyGuessResponse) GetResult() string {
@@ -864,7 +1284,7 @@ type TwentyQNormalizeQuestionRequest struct {
 
 func (x *TwentyQNormalizeQuestionRequest) Reset() {
 	*x = TwentyQNormalizeQuestionRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[14]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -876,7 +1296,7 @@ func (x *TwentyQNormalizeQuestionRequest) String() string {
 func (*TwentyQNormalizeQuestionRequest) ProtoMessage() {}
 
 func (x *TwentyQNormalizeQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[14]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1309,7 @@ func (x *TwentyQNormalizeQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQNormalizeQuestionRequest.ProtoReflect.Descriptor instead.
 func (*TwentyQNormalizeQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{14}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TwentyQNormalizeQuestionRequest) GetQuestion() string {
@@ -909,7 +1329,7 @@ type TwentyQNormalizeQuestionResponse struct {
 
 func (x *TwentyQNormalizeQuestionResponse) Reset() {
 	*x = TwentyQNormalizeQuestionResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[15]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1341,7 @@ func (x *TwentyQNormalizeQuestionResponse) String() string {
 func (*TwentyQNormalizeQuestionResponse) ProtoMessage() {}
 
 func (x *TwentyQNormalizeQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[15]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1354,7 @@ func (x *TwentyQNormalizeQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQNormalizeQuestionResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQNormalizeQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{15}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TwentyQNormalizeQuestionResponse) GetNormalized() string {
@@ -961,7 +1381,7 @@ type TwentyQCheckSynonymRequest struct {
 
 func (x *TwentyQCheckSynonymRequest) Reset() {
 	*x = TwentyQCheckSynonymRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[16]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -973,7 +1393,7 @@ func (x *TwentyQCheckSynonymRequest) String() string {
 func (*TwentyQCheckSynonymRequest) ProtoMessage() {}
 
 func (x *TwentyQCheckSynonymRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[16]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1406,7 @@ func (x *TwentyQCheckSynonymRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQCheckSynonymRequest.ProtoReflect.Descriptor instead.
 func (*TwentyQCheckSynonymRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{16}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TwentyQCheckSynonymRequest) GetTarget() string {
@@ -1013,7 +1433,7 @@ type TwentyQCheckSynonymResponse struct {
 
 func (x *TwentyQCheckSynonymResponse) Reset() {
 	*x = TwentyQCheckSynonymResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[17]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1025,7 +1445,7 @@ func (x *TwentyQCheckSynonymResponse) String() string {
 func (*TwentyQCheckSynonymResponse) ProtoMessage() {}
 
 func (x *TwentyQCheckSynonymResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[17]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +1458,7 @@ func (x *TwentyQCheckSynonymResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TwentyQCheckSynonymResponse.ProtoReflect.Descriptor instead.
 func (*TwentyQCheckSynonymResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{17}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TwentyQCheckSynonymResponse) GetResult() string {
@@ -1055,30 +1475,29 @@ func (x *TwentyQCheckSynonymResponse) GetRawText() string {
 	return ""
 }
 
-type TurtleSoupGeneratePuzzleRequest struct {
+type TwentyQHistoryEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	Difficulty    *int32                 `protobuf:"varint,2,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	Theme         *string                `protobuf:"bytes,3,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TurtleSoupGeneratePuzzleRequest) Reset() {
-	*x = TurtleSoupGeneratePuzzleRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[18]
+func (x *TwentyQHistoryEntry) Reset() {
+	*x = TwentyQHistoryEntry{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TurtleSoupGeneratePuzzleRequest) String() string {
+func (x *TwentyQHistoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TurtleSoupGeneratePuzzleRequest) ProtoMessage() {}
+func (*TwentyQHistoryEntry) ProtoMessage() {}
 
-func (x *TurtleSoupGeneratePuzzleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[18]
+func (x *TwentyQHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,59 +1508,51 @@ func (x *TurtleSoupGeneratePuzzleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TurtleSoupGeneratePuzzleRequest.ProtoReflect.Descriptor instead.
-func (*TurtleSoupGeneratePuzzleRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TwentyQHistoryEntry.ProtoReflect.Descriptor instead.
+func (*TwentyQHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *TurtleSoupGeneratePuzzleRequest) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
+func (x *TwentyQHistoryEntry) GetQuestion() string {
+	if x != nil {
+		return x.Question
 	}
 	return ""
 }
 
-func (x *TurtleSoupGeneratePuzzleRequest) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
-	}
-	return 0
-}
-
-func (x *TurtleSoupGeneratePuzzleRequest) GetTheme() string {
-	if x != nil && x.Theme != nil {
-		return *x.Theme
+func (x *TwentyQHistoryEntry) GetAnswer() string {
+	if x != nil {
+		return x.Answer
 	}
 	return ""
 }
 
-type TurtleSoupGeneratePuzzleResponse struct {
+type TwentyQSummarizeGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Scenario      string                 `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
-	Solution      string                 `protobuf:"bytes,3,opt,name=solution,proto3" json:"solution,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Hints         []string               `protobuf:"bytes,6,rep,name=hints,proto3" json:"hints,omitempty"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	History       []*TwentyQHistoryEntry `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	Hints         []string               `protobuf:"bytes,4,rep,name=hints,proto3" json:"hints,omitempty"`
+	WrongGuesses  []string               `protobuf:"bytes,5,rep,name=wrong_guesses,json=wrongGuesses,proto3" json:"wrong_guesses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TurtleSoupGeneratePuzzleResponse) Reset() {
-	*x = TurtleSoupGeneratePuzzleResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[19]
+func (x *TwentyQSummarizeGameRequest) Reset() {
+	*x = TwentyQSummarizeGameRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TurtleSoupGeneratePuzzleResponse) String() string {
+func (x *TwentyQSummarizeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TurtleSoupGeneratePuzzleResponse) ProtoMessage() {}
+func (*TwentyQSummarizeGameRequest) ProtoMessage() {}
 
-func (x *TurtleSoupGeneratePuzzleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[19]
+func (x *TwentyQSummarizeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,14 +1563,203 @@ func (x *TurtleSoupGeneratePuzzleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TurtleSoupGeneratePuzzleResponse.ProtoReflect.Descriptor instead.
-func (*TurtleSoupGeneratePuzzleResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use TwentyQSummarizeGameRequest.ProtoReflect.Descriptor instead.
+func (*TwentyQSummarizeGameRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TurtleSoupGeneratePuzzleResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *TwentyQSummarizeGameRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *TwentyQSummarizeGameRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TwentyQSummarizeGameRequest) GetHistory() []*TwentyQHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *TwentyQSummarizeGameRequest) GetHints() []string {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+func (x *TwentyQSummarizeGameRequest) GetWrongGuesses() []string {
+	if x != nil {
+		return x.WrongGuesses
+	}
+	return nil
+}
+
+type TwentyQSummarizeGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Eliminated    []string               `protobuf:"bytes,2,rep,name=eliminated,proto3" json:"eliminated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwentyQSummarizeGameResponse) Reset() {
+	*x = TwentyQSummarizeGameResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwentyQSummarizeGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwentyQSummarizeGameResponse) ProtoMessage() {}
+
+func (x *TwentyQSummarizeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwentyQSummarizeGameResponse.ProtoReflect.Descriptor instead.
+func (*TwentyQSummarizeGameResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TwentyQSummarizeGameResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *TwentyQSummarizeGameResponse) GetEliminated() []string {
+	if x != nil {
+		return x.Eliminated
+	}
+	return nil
+}
+
+type TurtleSoupGeneratePuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Difficulty    *int32                 `protobuf:"varint,2,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	Theme         *string                `protobuf:"bytes,3,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurtleSoupGeneratePuzzleRequest) Reset() {
+	*x = TurtleSoupGeneratePuzzleRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurtleSoupGeneratePuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurtleSoupGeneratePuzzleRequest) ProtoMessage() {}
+
+func (x *TurtleSoupGeneratePuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurtleSoupGeneratePuzzleRequest.ProtoReflect.Descriptor instead.
+func (*TurtleSoupGeneratePuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TurtleSoupGeneratePuzzleRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *TurtleSoupGeneratePuzzleRequest) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+func (x *TurtleSoupGeneratePuzzleRequest) GetTheme() string {
+	if x != nil && x.Theme != nil {
+		return *x.Theme
+	}
+	return ""
+}
+
+type TurtleSoupGeneratePuzzleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Scenario      string                 `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Solution      string                 `protobuf:"bytes,3,opt,name=solution,proto3" json:"solution,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Hints         []string               `protobuf:"bytes,6,rep,name=hints,proto3" json:"hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurtleSoupGeneratePuzzleResponse) Reset() {
+	*x = TurtleSoupGeneratePuzzleResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurtleSoupGeneratePuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurtleSoupGeneratePuzzleResponse) ProtoMessage() {}
+
+func (x *TurtleSoupGeneratePuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurtleSoupGeneratePuzzleResponse.ProtoReflect.Descriptor instead.
+func (*TurtleSoupGeneratePuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TurtleSoupGeneratePuzzleResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
@@ -1208,7 +1808,7 @@ type TurtleSoupGetRandomPuzzleRequest struct {
 
 func (x *TurtleSoupGetRandomPuzzleRequest) Reset() {
 	*x = TurtleSoupGetRandomPuzzleRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[20]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +1820,7 @@ func (x *TurtleSoupGetRandomPuzzleRequest) String() string {
 func (*TurtleSoupGetRandomPuzzleRequest) ProtoMessage() {}
 
 func (x *TurtleSoupGetRandomPuzzleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[20]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1833,7 @@ func (x *TurtleSoupGetRandomPuzzleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TurtleSoupGetRandomPuzzleRequest.ProtoReflect.Descriptor instead.
 func (*TurtleSoupGetRandomPuzzleRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{20}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TurtleSoupGetRandomPuzzleRequest) GetDifficulty() int32 {
@@ -1256,7 +1856,7 @@ type TurtleSoupGetRandomPuzzleResponse struct {
 
 func (x *TurtleSoupGetRandomPuzzleResponse) Reset() {
 	*x = TurtleSoupGetRandomPuzzleResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[21]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1268,7 +1868,7 @@ func (x *TurtleSoupGetRandomPuzzleResponse) String() string {
 func (*TurtleSoupGetRandomPuzzleResponse) ProtoMessage() {}
 
 func (x *TurtleSoupGetRandomPuzzleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[21]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,7 +1881,7 @@ func (x *TurtleSoupGetRandomPuzzleResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TurtleSoupGetRandomPuzzleResponse.ProtoReflect.Descriptor instead.
 func (*TurtleSoupGetRandomPuzzleResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{21}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TurtleSoupGetRandomPuzzleResponse) GetId() int32 {
@@ -1331,7 +1931,7 @@ type TurtleSoupRewriteScenarioRequest struct {
 
 func (x *TurtleSoupRewriteScenarioRequest) Reset() {
 	*x = TurtleSoupRewriteScenarioRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[22]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1343,7 +1943,7 @@ func (x *TurtleSoupRewriteScenarioRequest) String() string {
 func (*TurtleSoupRewriteScenarioRequest) ProtoMessage() {}
 
 func (x *TurtleSoupRewriteScenarioRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[22]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,7 +1956,7 @@ func (x *TurtleSoupRewriteScenarioRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TurtleSoupRewriteScenarioRequest.ProtoReflect.Descriptor instead.
 func (*TurtleSoupRewriteScenarioRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{22}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TurtleSoupRewriteScenarioRequest) GetTitle() string {
@@ -1399,7 +1999,7 @@ type TurtleSoupRewriteScenarioResponse struct {
 
 func (x *TurtleSoupRewriteScenarioResponse) Reset() {
 	*x = TurtleSoupRewriteScenarioResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[23]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1411,7 +2011,7 @@ func (x *TurtleSoupRewriteScenarioResponse) String() string {
 func (*TurtleSoupRewriteScenarioResponse) ProtoMessage() {}
 
 func (x *TurtleSoupRewriteScenarioResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[23]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1424,7 +2024,7 @@ func (x *TurtleSoupRewriteScenarioResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TurtleSoupRewriteScenarioResponse.ProtoReflect.Descriptor instead.
 func (*TurtleSoupRewriteScenarioResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{23}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TurtleSoupRewriteScenarioResponse) GetScenario() string {
@@ -1465,7 +2065,7 @@ type TurtleSoupHistoryItem struct {
 
 func (x *TurtleSoupHistoryItem) Reset() {
 	*x = TurtleSoupHistoryItem{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[24]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1477,7 +2077,7 @@ func (x *TurtleSoupHistoryItem) String() string {
 func (*TurtleSoupHistoryItem) ProtoMessage() {}
 
 func (x *TurtleSoupHistoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[24]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +2090,7 @@ func (x *TurtleSoupHistoryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TurtleSoupHistoryItem.ProtoReflect.Descriptor instead.
 func (*TurtleSoupHistoryItem) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{24}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *TurtleSoupHistoryItem) GetQuestion() string {
@@ -1521,7 +2121,7 @@ type TurtleSoupAnswerQuestionRequest struct {
 
 func (x *TurtleSoupAnswerQuestionRequest) Reset() {
 	*x = TurtleSoupAnswerQuestionRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[25]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1533,7 +2133,7 @@ func (x *TurtleSoupAnswerQuestionRequest) String() string {
 func (*TurtleSoupAnswerQuestionRequest) ProtoMessage() {}
 
 func (x *TurtleSoupAnswerQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[25]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +2146,7 @@ func (x *TurtleSoupAnswerQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TurtleSoupAnswerQuestionRequest.ProtoReflect.Descriptor instead.
 func (*TurtleSoupAnswerQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{25}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TurtleSoupAnswerQuestionRequest) GetSessionId() string {
@@ -1603,7 +2203,7 @@ type TurtleSoupAnswerQuestionResponse struct {
 
 func (x *TurtleSoupAnswerQuestionResponse) Reset() {
 	*x = TurtleSoupAnswerQuestionResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[26]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1615,7 +2215,7 @@ func (x *TurtleSoupAnswerQuestionResponse) String() string {
 func (*TurtleSoupAnswerQuestionResponse) ProtoMessage() {}
 
 func (x *TurtleSoupAnswerQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[26]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,7 +2228,7 @@ func (x *TurtleSoupAnswerQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TurtleSoupAnswerQuestionResponse.ProtoReflect.Descriptor instead.
 func (*TurtleSoupAnswerQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{26}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TurtleSoupAnswerQuestionResponse) GetAnswer() string {
@@ -1672,7 +2272,7 @@ type TurtleSoupValidateSolutionRequest struct {
 
 func (x *TurtleSoupValidateSolutionRequest) Reset() {
 	*x = TurtleSoupValidateSolutionRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[27]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1684,7 +2284,7 @@ func (x *TurtleSoupValidateSolutionRequest) String() string {
 func (*TurtleSoupValidateSolutionRequest) ProtoMessage() {}
 
 func (x *TurtleSoupValidateSolutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[27]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,7 +2297,7 @@ func (x *TurtleSoupValidateSolutionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TurtleSoupValidateSolutionRequest.ProtoReflect.Descriptor instead.
 func (*TurtleSoupValidateSolutionRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{27}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TurtleSoupValidateSolutionRequest) GetSessionId() string {
@@ -1745,7 +2345,7 @@ type TurtleSoupValidateSolutionResponse struct {
 
 func (x *TurtleSoupValidateSolutionResponse) Reset() {
 	*x = TurtleSoupValidateSolutionResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[28]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1757,7 +2357,7 @@ func (x *TurtleSoupValidateSolutionResponse) String() string {
 func (*TurtleSoupValidateSolutionResponse) ProtoMessage() {}
 
 func (x *TurtleSoupValidateSolutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[28]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1770,7 +2370,7 @@ func (x *TurtleSoupValidateSolutionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TurtleSoupValidateSolutionResponse.ProtoReflect.Descriptor instead.
 func (*TurtleSoupValidateSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{28}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *TurtleSoupValidateSolutionResponse) GetResult() string {
@@ -1801,7 +2401,7 @@ type TurtleSoupGenerateHintRequest struct {
 
 func (x *TurtleSoupGenerateHintRequest) Reset() {
 	*x = TurtleSoupGenerateHintRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[29]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1813,7 +2413,7 @@ func (x *TurtleSoupGenerateHintRequest) String() string {
 func (*TurtleSoupGenerateHintRequest) ProtoMessage() {}
 
 func (x *TurtleSoupGenerateHintRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[29]
+	mi := &file_llm_v1_llm_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,7 +2426,7 @@ func (x *TurtleSoupGenerateHintRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TurtleSoupGenerateHintRequest.ProtoReflect.Descriptor instead.
 func (*TurtleSoupGenerateHintRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{29}
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *TurtleSoupGenerateHintRequest) GetSessionId() string {
@@ -1836,64 +2436,675 @@ func (x *TurtleSoupGenerateHintRequest) GetSessionId() string {
 	return ""
 }
 
-func (x *TurtleSoupGenerateHintRequest) GetChatId() string {
-	if x != nil && x.ChatId != nil {
-		return *x.ChatId
+func (x *TurtleSoupGenerateHintRequest) GetChatId() string {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateHintRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateHintRequest) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateHintRequest) GetSolution() string {
+	if x != nil {
+		return x.Solution
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateHintRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type TurtleSoupGenerateHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hint          string                 `protobuf:"bytes,1,opt,name=hint,proto3" json:"hint,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurtleSoupGenerateHintResponse) Reset() {
+	*x = TurtleSoupGenerateHintResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurtleSoupGenerateHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurtleSoupGenerateHintResponse) ProtoMessage() {}
+
+func (x *TurtleSoupGenerateHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurtleSoupGenerateHintResponse.ProtoReflect.Descriptor instead.
+func (*TurtleSoupGenerateHintResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TurtleSoupGenerateHintResponse) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateHintResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type TurtleSoupGenerateEpilogueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	ChatId        *string                `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"`
+	Namespace     *string                `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Scenario      string                 `protobuf:"bytes,4,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Solution      string                 `protobuf:"bytes,5,opt,name=solution,proto3" json:"solution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) Reset() {
+	*x = TurtleSoupGenerateEpilogueRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurtleSoupGenerateEpilogueRequest) ProtoMessage() {}
+
+func (x *TurtleSoupGenerateEpilogueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurtleSoupGenerateEpilogueRequest.ProtoReflect.Descriptor instead.
+func (*TurtleSoupGenerateEpilogueRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) GetChatId() string {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateEpilogueRequest) GetSolution() string {
+	if x != nil {
+		return x.Solution
+	}
+	return ""
+}
+
+type TurtleSoupGenerateEpilogueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epilogue      string                 `protobuf:"bytes,1,opt,name=epilogue,proto3" json:"epilogue,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurtleSoupGenerateEpilogueResponse) Reset() {
+	*x = TurtleSoupGenerateEpilogueResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurtleSoupGenerateEpilogueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurtleSoupGenerateEpilogueResponse) ProtoMessage() {}
+
+func (x *TurtleSoupGenerateEpilogueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurtleSoupGenerateEpilogueResponse.ProtoReflect.Descriptor instead.
+func (*TurtleSoupGenerateEpilogueResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TurtleSoupGenerateEpilogueResponse) GetEpilogue() string {
+	if x != nil {
+		return x.Epilogue
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateEpilogueResponse) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *TurtleSoupGenerateEpilogueResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type DailyUsageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UsageDate       string                 `protobuf:"bytes,1,opt,name=usage_date,json=usageDate,proto3" json:"usage_date,omitempty"`
+	InputTokens     int64                  `protobuf:"varint,2,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
+	OutputTokens    int64                  `protobuf:"varint,3,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
+	TotalTokens     int64                  `protobuf:"varint,4,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	ReasoningTokens int64                  `protobuf:"varint,5,opt,name=reasoning_tokens,json=reasoningTokens,proto3" json:"reasoning_tokens,omitempty"`
+	RequestCount    int64                  `protobuf:"varint,6,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	Model           string                 `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DailyUsageResponse) Reset() {
+	*x = DailyUsageResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyUsageResponse) ProtoMessage() {}
+
+func (x *DailyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyUsageResponse.ProtoReflect.Descriptor instead.
+func (*DailyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DailyUsageResponse) GetUsageDate() string {
+	if x != nil {
+		return x.UsageDate
+	}
+	return ""
+}
+
+func (x *DailyUsageResponse) GetInputTokens() int64 {
+	if x != nil {
+		return x.InputTokens
+	}
+	return 0
+}
+
+func (x *DailyUsageResponse) GetOutputTokens() int64 {
+	if x != nil {
+		return x.OutputTokens
+	}
+	return 0
+}
+
+func (x *DailyUsageResponse) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *DailyUsageResponse) GetReasoningTokens() int64 {
+	if x != nil {
+		return x.ReasoningTokens
+	}
+	return 0
+}
+
+func (x *DailyUsageResponse) GetRequestCount() int64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *DailyUsageResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type UsageResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InputTokens     int64                  `protobuf:"varint,1,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
+	OutputTokens    int64                  `protobuf:"varint,2,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
+	TotalTokens     int64                  `protobuf:"varint,3,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	ReasoningTokens int64                  `protobuf:"varint,4,opt,name=reasoning_tokens,json=reasoningTokens,proto3" json:"reasoning_tokens,omitempty"`
+	Model           string                 `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UsageResponse) Reset() {
+	*x = UsageResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageResponse) ProtoMessage() {}
+
+func (x *UsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageResponse.ProtoReflect.Descriptor instead.
+func (*UsageResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UsageResponse) GetInputTokens() int64 {
+	if x != nil {
+		return x.InputTokens
+	}
+	return 0
+}
+
+func (x *UsageResponse) GetOutputTokens() int64 {
+	if x != nil {
+		return x.OutputTokens
+	}
+	return 0
+}
+
+func (x *UsageResponse) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *UsageResponse) GetReasoningTokens() int64 {
+	if x != nil {
+		return x.ReasoningTokens
+	}
+	return 0
+}
+
+func (x *UsageResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type GetRecentUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentUsageRequest) Reset() {
+	*x = GetRecentUsageRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentUsageRequest) ProtoMessage() {}
+
+func (x *GetRecentUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentUsageRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRecentUsageRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type UsageListResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Usages            []*DailyUsageResponse  `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	TotalInputTokens  int64                  `protobuf:"varint,2,opt,name=total_input_tokens,json=totalInputTokens,proto3" json:"total_input_tokens,omitempty"`
+	TotalOutputTokens int64                  `protobuf:"varint,3,opt,name=total_output_tokens,json=totalOutputTokens,proto3" json:"total_output_tokens,omitempty"`
+	TotalTokens       int64                  `protobuf:"varint,4,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	TotalRequestCount int64                  `protobuf:"varint,5,opt,name=total_request_count,json=totalRequestCount,proto3" json:"total_request_count,omitempty"`
+	Model             string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UsageListResponse) Reset() {
+	*x = UsageListResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageListResponse) ProtoMessage() {}
+
+func (x *UsageListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageListResponse.ProtoReflect.Descriptor instead.
+func (*UsageListResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UsageListResponse) GetUsages() []*DailyUsageResponse {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+func (x *UsageListResponse) GetTotalInputTokens() int64 {
+	if x != nil {
+		return x.TotalInputTokens
+	}
+	return 0
+}
+
+func (x *UsageListResponse) GetTotalOutputTokens() int64 {
+	if x != nil {
+		return x.TotalOutputTokens
+	}
+	return 0
+}
+
+func (x *UsageListResponse) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *UsageListResponse) GetTotalRequestCount() int64 {
+	if x != nil {
+		return x.TotalRequestCount
+	}
+	return 0
+}
+
+func (x *UsageListResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type GetTotalUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTotalUsageRequest) Reset() {
+	*x = GetTotalUsageRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTotalUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalUsageRequest) ProtoMessage() {}
+
+func (x *GetTotalUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalUsageRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTotalUsageRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type TaskUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Task            string                 `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	InputTokens     int64                  `protobuf:"varint,2,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
+	OutputTokens    int64                  `protobuf:"varint,3,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
+	TotalTokens     int64                  `protobuf:"varint,4,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	ReasoningTokens int64                  `protobuf:"varint,5,opt,name=reasoning_tokens,json=reasoningTokens,proto3" json:"reasoning_tokens,omitempty"`
+	RequestCount    int64                  `protobuf:"varint,6,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskUsage) Reset() {
+	*x = TaskUsage{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUsage) ProtoMessage() {}
+
+func (x *TaskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUsage.ProtoReflect.Descriptor instead.
+func (*TaskUsage) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TaskUsage) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *TaskUsage) GetInputTokens() int64 {
+	if x != nil {
+		return x.InputTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *TurtleSoupGenerateHintRequest) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+func (x *TaskUsage) GetOutputTokens() int64 {
+	if x != nil {
+		return x.OutputTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *TurtleSoupGenerateHintRequest) GetScenario() string {
+func (x *TaskUsage) GetTotalTokens() int64 {
 	if x != nil {
-		return x.Scenario
+		return x.TotalTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *TurtleSoupGenerateHintRequest) GetSolution() string {
+func (x *TaskUsage) GetReasoningTokens() int64 {
 	if x != nil {
-		return x.Solution
+		return x.ReasoningTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *TurtleSoupGenerateHintRequest) GetLevel() int32 {
+func (x *TaskUsage) GetRequestCount() int64 {
 	if x != nil {
-		return x.Level
+		return x.RequestCount
 	}
 	return 0
 }
 
-type TurtleSoupGenerateHintResponse struct {
+type GetSessionUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hint          string                 `protobuf:"bytes,1,opt,name=hint,proto3" json:"hint,omitempty"`
-	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TurtleSoupGenerateHintResponse) Reset() {
-	*x = TurtleSoupGenerateHintResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[30]
+func (x *GetSessionUsageRequest) Reset() {
+	*x = GetSessionUsageRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TurtleSoupGenerateHintResponse) String() string {
+func (x *GetSessionUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TurtleSoupGenerateHintResponse) ProtoMessage() {}
+func (*GetSessionUsageRequest) ProtoMessage() {}
 
-func (x *TurtleSoupGenerateHintResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[30]
+func (x *GetSessionUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,53 +3115,48 @@ func (x *TurtleSoupGenerateHintResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TurtleSoupGenerateHintResponse.ProtoReflect.Descriptor instead.
-func (*TurtleSoupGenerateHintResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetSessionUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionUsageRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *TurtleSoupGenerateHintResponse) GetHint() string {
+func (x *GetSessionUsageRequest) GetSessionId() string {
 	if x != nil {
-		return x.Hint
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *TurtleSoupGenerateHintResponse) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
-
-type DailyUsageResponse struct {
+type SessionUsageResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	UsageDate       string                 `protobuf:"bytes,1,opt,name=usage_date,json=usageDate,proto3" json:"usage_date,omitempty"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	InputTokens     int64                  `protobuf:"varint,2,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
 	OutputTokens    int64                  `protobuf:"varint,3,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
 	TotalTokens     int64                  `protobuf:"varint,4,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
 	ReasoningTokens int64                  `protobuf:"varint,5,opt,name=reasoning_tokens,json=reasoningTokens,proto3" json:"reasoning_tokens,omitempty"`
 	RequestCount    int64                  `protobuf:"varint,6,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	Model           string                 `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	Tasks           []*TaskUsage           `protobuf:"bytes,7,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	FirstUsedAt     *string                `protobuf:"bytes,8,opt,name=first_used_at,json=firstUsedAt,proto3,oneof" json:"first_used_at,omitempty"`
+	LastUsedAt      *string                `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DailyUsageResponse) Reset() {
-	*x = DailyUsageResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[31]
+func (x *SessionUsageResponse) Reset() {
+	*x = SessionUsageResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DailyUsageResponse) String() string {
+func (x *SessionUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DailyUsageResponse) ProtoMessage() {}
+func (*SessionUsageResponse) ProtoMessage() {}
 
-func (x *DailyUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[31]
+func (x *SessionUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1961,86 +3167,96 @@ func (x *DailyUsageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DailyUsageResponse.ProtoReflect.Descriptor instead.
-func (*DailyUsageResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SessionUsageResponse.ProtoReflect.Descriptor instead.
+func (*SessionUsageResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DailyUsageResponse) GetUsageDate() string {
+func (x *SessionUsageResponse) GetSessionId() string {
 	if x != nil {
-		return x.UsageDate
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *DailyUsageResponse) GetInputTokens() int64 {
+func (x *SessionUsageResponse) GetInputTokens() int64 {
 	if x != nil {
 		return x.InputTokens
 	}
 	return 0
 }
 
-func (x *DailyUsageResponse) GetOutputTokens() int64 {
+func (x *SessionUsageResponse) GetOutputTokens() int64 {
 	if x != nil {
 		return x.OutputTokens
 	}
 	return 0
 }
 
-func (x *DailyUsageResponse) GetTotalTokens() int64 {
+func (x *SessionUsageResponse) GetTotalTokens() int64 {
 	if x != nil {
 		return x.TotalTokens
 	}
 	return 0
 }
 
-func (x *DailyUsageResponse) GetReasoningTokens() int64 {
+func (x *SessionUsageResponse) GetReasoningTokens() int64 {
 	if x != nil {
 		return x.ReasoningTokens
 	}
 	return 0
 }
 
-func (x *DailyUsageResponse) GetRequestCount() int64 {
+func (x *SessionUsageResponse) GetRequestCount() int64 {
 	if x != nil {
 		return x.RequestCount
 	}
 	return 0
 }
 
-func (x *DailyUsageResponse) GetModel() string {
+func (x *SessionUsageResponse) GetTasks() []*TaskUsage {
 	if x != nil {
-		return x.Model
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *SessionUsageResponse) GetFirstUsedAt() string {
+	if x != nil && x.FirstUsedAt != nil {
+		return *x.FirstUsedAt
 	}
 	return ""
 }
 
-type UsageResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InputTokens     int64                  `protobuf:"varint,1,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
-	OutputTokens    int64                  `protobuf:"varint,2,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
-	TotalTokens     int64                  `protobuf:"varint,3,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
-	ReasoningTokens int64                  `protobuf:"varint,4,opt,name=reasoning_tokens,json=reasoningTokens,proto3" json:"reasoning_tokens,omitempty"`
-	Model           string                 `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SessionUsageResponse) GetLastUsedAt() string {
+	if x != nil && x.LastUsedAt != nil {
+		return *x.LastUsedAt
+	}
+	return ""
 }
 
-func (x *UsageResponse) Reset() {
-	*x = UsageResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[32]
+type GetUsageByTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageByTaskRequest) Reset() {
+	*x = GetUsageByTaskRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsageResponse) String() string {
+func (x *GetUsageByTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsageResponse) ProtoMessage() {}
+func (*GetUsageByTaskRequest) ProtoMessage() {}
 
-func (x *UsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[32]
+func (x *GetUsageByTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,68 +3267,92 @@ func (x *UsageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsageResponse.ProtoReflect.Descriptor instead.
-func (*UsageResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetUsageByTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageByTaskRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UsageResponse) GetInputTokens() int64 {
+func (x *GetUsageByTaskRequest) GetDays() int32 {
 	if x != nil {
-		return x.InputTokens
+		return x.Days
 	}
 	return 0
 }
 
-func (x *UsageResponse) GetOutputTokens() int64 {
-	if x != nil {
-		return x.OutputTokens
-	}
-	return 0
+type TaskUsageListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Tasks         []*TaskUsage           `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsageResponse) GetTotalTokens() int64 {
+func (x *TaskUsageListResponse) Reset() {
+	*x = TaskUsageListResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskUsageListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUsageListResponse) ProtoMessage() {}
+
+func (x *TaskUsageListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[52]
 	if x != nil {
-		return x.TotalTokens
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UsageResponse) GetReasoningTokens() int64 {
+// Deprecated: Use TaskUsageListResponse.ProtoReflect.Descriptor instead.
+func (*TaskUsageListResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TaskUsageListResponse) GetDays() int32 {
 	if x != nil {
-		return x.ReasoningTokens
+		return x.Days
 	}
 	return 0
 }
 
-func (x *UsageResponse) GetModel() string {
+func (x *TaskUsageListResponse) GetTasks() []*TaskUsage {
 	if x != nil {
-		return x.Model
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-type GetRecentUsageRequest struct {
+type GetQuotaStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	BotId         *string                `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3,oneof" json:"bot_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRecentUsageRequest) Reset() {
-	*x = GetRecentUsageRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[33]
+func (x *GetQuotaStatusRequest) Reset() {
+	*x = GetQuotaStatusRequest{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRecentUsageRequest) String() string {
+func (x *GetQuotaStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecentUsageRequest) ProtoMessage() {}
+func (*GetQuotaStatusRequest) ProtoMessage() {}
 
-func (x *GetRecentUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[33]
+func (x *GetQuotaStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,45 +3363,46 @@ func (x *GetRecentUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecentUsageRequest.ProtoReflect.Descriptor instead.
-func (*GetRecentUsageRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetQuotaStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaStatusRequest) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetRecentUsageRequest) GetDays() int32 {
-	if x != nil {
-		return x.Days
+func (x *GetQuotaStatusRequest) GetBotId() string {
+	if x != nil && x.BotId != nil {
+		return *x.BotId
 	}
-	return 0
+	return ""
 }
 
-type UsageListResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Usages            []*DailyUsageResponse  `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
-	TotalInputTokens  int64                  `protobuf:"varint,2,opt,name=total_input_tokens,json=totalInputTokens,proto3" json:"total_input_tokens,omitempty"`
-	TotalOutputTokens int64                  `protobuf:"varint,3,opt,name=total_output_tokens,json=totalOutputTokens,proto3" json:"total_output_tokens,omitempty"`
-	TotalTokens       int64                  `protobuf:"varint,4,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
-	TotalRequestCount int64                  `protobuf:"varint,5,opt,name=total_request_count,json=totalRequestCount,proto3" json:"total_request_count,omitempty"`
-	Model             string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type QuotaStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	RequestsUsed  int64                  `protobuf:"varint,3,opt,name=requests_used,json=requestsUsed,proto3" json:"requests_used,omitempty"`
+	RequestsLimit int64                  `protobuf:"varint,4,opt,name=requests_limit,json=requestsLimit,proto3" json:"requests_limit,omitempty"`
+	TokensUsed    int64                  `protobuf:"varint,5,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	TokensLimit   int64                  `protobuf:"varint,6,opt,name=tokens_limit,json=tokensLimit,proto3" json:"tokens_limit,omitempty"`
+	ResetsAtUnix  int64                  `protobuf:"varint,7,opt,name=resets_at_unix,json=resetsAtUnix,proto3" json:"resets_at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UsageListResponse) Reset() {
-	*x = UsageListResponse{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[34]
+func (x *QuotaStatus) Reset() {
+	*x = QuotaStatus{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UsageListResponse) String() string {
+func (x *QuotaStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsageListResponse) ProtoMessage() {}
+func (*QuotaStatus) ProtoMessage() {}
 
-func (x *UsageListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[34]
+func (x *QuotaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,75 +3413,83 @@ func (x *UsageListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsageListResponse.ProtoReflect.Descriptor instead.
-func (*UsageListResponse) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use QuotaStatus.ProtoReflect.Descriptor instead.
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UsageListResponse) GetUsages() []*DailyUsageResponse {
+func (x *QuotaStatus) GetBotId() string {
 	if x != nil {
-		return x.Usages
+		return x.BotId
 	}
-	return nil
+	return ""
 }
 
-func (x *UsageListResponse) GetTotalInputTokens() int64 {
+func (x *QuotaStatus) GetDate() string {
 	if x != nil {
-		return x.TotalInputTokens
+		return x.Date
+	}
+	return ""
+}
+
+func (x *QuotaStatus) GetRequestsUsed() int64 {
+	if x != nil {
+		return x.RequestsUsed
 	}
 	return 0
 }
 
-func (x *UsageListResponse) GetTotalOutputTokens() int64 {
+func (x *QuotaStatus) GetRequestsLimit() int64 {
 	if x != nil {
-		return x.TotalOutputTokens
+		return x.RequestsLimit
 	}
 	return 0
 }
 
-func (x *UsageListResponse) GetTotalTokens() int64 {
+func (x *QuotaStatus) GetTokensUsed() int64 {
 	if x != nil {
-		return x.TotalTokens
+		return x.TokensUsed
 	}
 	return 0
 }
 
-func (x *UsageListResponse) GetTotalRequestCount() int64 {
+func (x *QuotaStatus) GetTokensLimit() int64 {
 	if x != nil {
-		return x.TotalRequestCount
+		return x.TokensLimit
 	}
 	return 0
 }
 
-func (x *UsageListResponse) GetModel() string {
+func (x *QuotaStatus) GetResetsAtUnix() int64 {
 	if x != nil {
-		return x.Model
+		return x.ResetsAtUnix
 	}
-	return ""
+	return 0
 }
 
-type GetTotalUsageRequest struct {
+type QuotaStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Quotas        []*QuotaStatus         `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTotalUsageRequest) Reset() {
-	*x = GetTotalUsageRequest{}
-	mi := &file_llm_v1_llm_service_proto_msgTypes[35]
+func (x *QuotaStatusResponse) Reset() {
+	*x = QuotaStatusResponse{}
+	mi := &file_llm_v1_llm_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTotalUsageRequest) String() string {
+func (x *QuotaStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTotalUsageRequest) ProtoMessage() {}
+func (*QuotaStatusResponse) ProtoMessage() {}
 
-func (x *GetTotalUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_llm_v1_llm_service_proto_msgTypes[35]
+func (x *QuotaStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llm_v1_llm_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2251,16 +3500,23 @@ func (x *GetTotalUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTotalUsageRequest.ProtoReflect.Descriptor instead.
-func (*GetTotalUsageRequest) Descriptor() ([]byte, []int) {
-	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use QuotaStatusResponse.ProtoReflect.Descriptor instead.
+func (*QuotaStatusResponse) Descriptor() ([]byte, []int) {
+	return file_llm_v1_llm_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetTotalUsageRequest) GetDays() int32 {
+func (x *QuotaStatusResponse) GetEnabled() bool {
 	if x != nil {
-		return x.Days
+		return x.Enabled
 	}
-	return 0
+	return false
+}
+
+func (x *QuotaStatusResponse) GetQuotas() []*QuotaStatus {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
 }
 
 var File_llm_v1_llm_service_proto protoreflect.FileDescriptor
@@ -2297,7 +3553,27 @@ const file_llm_v1_llm_service_proto_rawDesc = "" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\">\n" +
 	"\x12EndSessionResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"\x8d\x01\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x88\x01\n" +
+	"\fEmbedRequest\x12\x14\n" +
+	"\x05texts\x18\x01 \x03(\tR\x05texts\x12 \n" +
+	"\ttask_type\x18\x02 \x01(\tH\x00R\btaskType\x88\x01\x01\x12#\n" +
+	"\n" +
+	"dimensions\x18\x03 \x01(\x05H\x01R\n" +
+	"dimensions\x88\x01\x01B\f\n" +
+	"\n" +
+	"_task_typeB\r\n" +
+	"\v_dimensions\";\n" +
+	"\tEmbedding\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x02R\x06values\x12\x16\n" +
+	"\x06cached\x18\x02 \x01(\bR\x06cached\"x\n" +
+	"\rEmbedResponse\x121\n" +
+	"\n" +
+	"embeddings\x18\x01 \x03(\v2\x11.llm.v1.EmbeddingR\n" +
+	"embeddings\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1e\n" +
+	"\n" +
+	"dimensions\x18\x03 \x01(\x05R\n" +
+	"dimensions\"\x8d\x01\n" +
 	"\x19TwentyQSelectTopicRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12#\n" +
 	"\rbanned_topics\x18\x02 \x03(\tR\fbannedTopics\x12/\n" +
@@ -2309,15 +3585,35 @@ const file_llm_v1_llm_service_proto_rawDesc = "" +
 	"\x1cTwentyQGetCategoriesResponse\x12\x1e\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\tR\n" +
-	"categories\"\x84\x01\n" +
+	"categories\"\xbd\x01\n" +
 	"\x1bTwentyQGenerateHintsRequest\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x121\n" +
-	"\adetails\x18\x03 \x01(\v2\x17.google.protobuf.StructR\adetails\"|\n" +
+	"\adetails\x18\x03 \x01(\v2\x17.google.protobuf.StructR\adetails\x127\n" +
+	"\bfeedback\x18\x04 \x01(\v2\x1b.llm.v1.TwentyQHintFeedbackR\bfeedback\"r\n" +
+	"\x13TwentyQHintFeedback\x12\x16\n" +
+	"\x06useful\x18\x01 \x01(\x05R\x06useful\x12\x18\n" +
+	"\auseless\x18\x02 \x01(\x05R\auseless\x12)\n" +
+	"\x10useless_examples\x18\x03 \x03(\tR\x0fuselessExamples\"|\n" +
 	"\x1cTwentyQGenerateHintsResponse\x12\x14\n" +
 	"\x05hints\x18\x01 \x03(\tR\x05hints\x120\n" +
 	"\x11thought_signature\x18\x02 \x01(\tH\x00R\x10thoughtSignature\x88\x01\x01B\x14\n" +
-	"\x12_thought_signature\"\xaf\x02\n" +
+	"\x12_thought_signature\"]\n" +
+	" TwentyQBatchGenerateHintsRequest\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.llm.v1.TwentyQGenerateHintsRequestR\x05items\"\xd1\x01\n" +
+	"\x16TwentyQBatchHintResult\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05hints\x18\x03 \x03(\tR\x05hints\x12\"\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\tH\x00R\terrorCode\x88\x01\x01\x12(\n" +
+	"\rerror_message\x18\x05 \x01(\tH\x01R\ferrorMessage\x88\x01\x01B\r\n" +
+	"\v_error_codeB\x10\n" +
+	"\x0e_error_message\"\x93\x01\n" +
+	"!TwentyQBatchGenerateHintsResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.llm.v1.TwentyQBatchHintResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\xaf\x02\n" +
 	"\x1cTwentyQAnswerQuestionRequest\x12\"\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x1c\n" +
@@ -2358,7 +3654,23 @@ const file_llm_v1_llm_service_proto_rawDesc = "" +
 	"\x1bTwentyQCheckSynonymResponse\x12\x1b\n" +
 	"\x06result\x18\x01 \x01(\tH\x00R\x06result\x88\x01\x01\x12\x19\n" +
 	"\braw_text\x18\x02 \x01(\tR\arawTextB\t\n" +
-	"\a_result\"\xa8\x01\n" +
+	"\a_result\"I\n" +
+	"\x13TwentyQHistoryEntry\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\"\xde\x01\n" +
+	"\x1bTwentyQSummarizeGameRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x125\n" +
+	"\ahistory\x18\x03 \x03(\v2\x1b.llm.v1.TwentyQHistoryEntryR\ahistory\x12\x14\n" +
+	"\x05hints\x18\x04 \x03(\tR\x05hints\x12#\n" +
+	"\rwrong_guesses\x18\x05 \x03(\tR\fwrongGuessesB\r\n" +
+	"\v_session_id\"X\n" +
+	"\x1cTwentyQSummarizeGameResponse\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x02 \x03(\tR\n" +
+	"eliminated\"\xa8\x01\n" +
 	"\x1fTurtleSoupGeneratePuzzleRequest\x12\x1f\n" +
 	"\bcategory\x18\x01 \x01(\tH\x00R\bcategory\x88\x01\x01\x12#\n" +
 	"\n" +
@@ -2458,7 +3770,23 @@ const file_llm_v1_llm_service_proto_rawDesc = "" +
 	"_namespace\"J\n" +
 	"\x1eTurtleSoupGenerateHintResponse\x12\x12\n" +
 	"\x04hint\x18\x01 \x01(\tR\x04hint\x12\x14\n" +
-	"\x05level\x18\x02 \x01(\x05R\x05level\"\x84\x02\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\"\xe9\x01\n" +
+	"!TurtleSoupGenerateEpilogueRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x1c\n" +
+	"\achat_id\x18\x02 \x01(\tH\x01R\x06chatId\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x02R\tnamespace\x88\x01\x01\x12\x1a\n" +
+	"\bscenario\x18\x04 \x01(\tR\bscenario\x12\x1a\n" +
+	"\bsolution\x18\x05 \x01(\tR\bsolutionB\r\n" +
+	"\v_session_idB\n" +
+	"\n" +
+	"\b_chat_idB\f\n" +
+	"\n" +
+	"_namespace\"t\n" +
+	"\"TurtleSoupGenerateEpilogueResponse\x12\x1a\n" +
+	"\bepilogue\x18\x01 \x01(\tR\bepilogue\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\"\x84\x02\n" +
 	"\x12DailyUsageResponse\x12\x1d\n" +
 	"\n" +
 	"usage_date\x18\x01 \x01(\tR\tusageDate\x12!\n" +
@@ -2484,29 +3812,80 @@ const file_llm_v1_llm_service_proto_rawDesc = "" +
 	"\x13total_request_count\x18\x05 \x01(\x03R\x11totalRequestCount\x12\x14\n" +
 	"\x05model\x18\x06 \x01(\tR\x05model\"*\n" +
 	"\x14GetTotalUsageRequest\x12\x12\n" +
-	"\x04days\x18\x01 \x01(\x05R\x04days2\x8e\x0e\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"\xda\x01\n" +
+	"\tTaskUsage\x12\x12\n" +
+	"\x04task\x18\x01 \x01(\tR\x04task\x12!\n" +
+	"\finput_tokens\x18\x02 \x01(\x03R\vinputTokens\x12#\n" +
+	"\routput_tokens\x18\x03 \x01(\x03R\foutputTokens\x12!\n" +
+	"\ftotal_tokens\x18\x04 \x01(\x03R\vtotalTokens\x12)\n" +
+	"\x10reasoning_tokens\x18\x05 \x01(\x03R\x0freasoningTokens\x12#\n" +
+	"\rrequest_count\x18\x06 \x01(\x03R\frequestCount\"7\n" +
+	"\x16GetSessionUsageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x8c\x03\n" +
+	"\x14SessionUsageResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\finput_tokens\x18\x02 \x01(\x03R\vinputTokens\x12#\n" +
+	"\routput_tokens\x18\x03 \x01(\x03R\foutputTokens\x12!\n" +
+	"\ftotal_tokens\x18\x04 \x01(\x03R\vtotalTokens\x12)\n" +
+	"\x10reasoning_tokens\x18\x05 \x01(\x03R\x0freasoningTokens\x12#\n" +
+	"\rrequest_count\x18\x06 \x01(\x03R\frequestCount\x12'\n" +
+	"\x05tasks\x18\a \x03(\v2\x11.llm.v1.TaskUsageR\x05tasks\x12'\n" +
+	"\rfirst_used_at\x18\b \x01(\tH\x00R\vfirstUsedAt\x88\x01\x01\x12%\n" +
+	"\flast_used_at\x18\t \x01(\tH\x01R\n" +
+	"lastUsedAt\x88\x01\x01B\x10\n" +
+	"\x0e_first_used_atB\x0f\n" +
+	"\r_last_used_at\"+\n" +
+	"\x15GetUsageByTaskRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"T\n" +
+	"\x15TaskUsageListResponse\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12'\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x11.llm.v1.TaskUsageR\x05tasks\">\n" +
+	"\x15GetQuotaStatusRequest\x12\x1a\n" +
+	"\x06bot_id\x18\x01 \x01(\tH\x00R\x05botId\x88\x01\x01B\t\n" +
+	"\a_bot_id\"\xee\x01\n" +
+	"\vQuotaStatus\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12#\n" +
+	"\rrequests_used\x18\x03 \x01(\x03R\frequestsUsed\x12%\n" +
+	"\x0erequests_limit\x18\x04 \x01(\x03R\rrequestsLimit\x12\x1f\n" +
+	"\vtokens_used\x18\x05 \x01(\x03R\n" +
+	"tokensUsed\x12!\n" +
+	"\ftokens_limit\x18\x06 \x01(\x03R\vtokensLimit\x12$\n" +
+	"\x0eresets_at_unix\x18\a \x01(\x03R\fresetsAtUnix\"\\\n" +
+	"\x13QuotaStatusResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12+\n" +
+	"\x06quotas\x18\x02 \x03(\v2\x13.llm.v1.QuotaStatusR\x06quotas2\xfd\x12\n" +
 	"\n" +
 	"LLMService\x12E\n" +
 	"\x0eGetModelConfig\x12\x16.google.protobuf.Empty\x1a\x1b.llm.v1.ModelConfigResponse\x12U\n" +
 	"\x10GuardIsMalicious\x12\x1f.llm.v1.GuardIsMaliciousRequest\x1a .llm.v1.GuardIsMaliciousResponse\x12C\n" +
 	"\n" +
-	"EndSession\x12\x19.llm.v1.EndSessionRequest\x1a\x1a.llm.v1.EndSessionResponse\x12[\n" +
+	"EndSession\x12\x19.llm.v1.EndSessionRequest\x1a\x1a.llm.v1.EndSessionResponse\x124\n" +
+	"\x05Embed\x12\x14.llm.v1.EmbedRequest\x1a\x15.llm.v1.EmbedResponse\x12[\n" +
 	"\x12TwentyQSelectTopic\x12!.llm.v1.TwentyQSelectTopicRequest\x1a\".llm.v1.TwentyQSelectTopicResponse\x12T\n" +
 	"\x14TwentyQGetCategories\x12\x16.google.protobuf.Empty\x1a$.llm.v1.TwentyQGetCategoriesResponse\x12a\n" +
-	"\x14TwentyQGenerateHints\x12#.llm.v1.TwentyQGenerateHintsRequest\x1a$.llm.v1.TwentyQGenerateHintsResponse\x12d\n" +
+	"\x14TwentyQGenerateHints\x12#.llm.v1.TwentyQGenerateHintsRequest\x1a$.llm.v1.TwentyQGenerateHintsResponse\x12p\n" +
+	"\x19TwentyQBatchGenerateHints\x12(.llm.v1.TwentyQBatchGenerateHintsRequest\x1a).llm.v1.TwentyQBatchGenerateHintsResponse\x12d\n" +
 	"\x15TwentyQAnswerQuestion\x12$.llm.v1.TwentyQAnswerQuestionRequest\x1a%.llm.v1.TwentyQAnswerQuestionResponse\x12[\n" +
 	"\x12TwentyQVerifyGuess\x12!.llm.v1.TwentyQVerifyGuessRequest\x1a\".llm.v1.TwentyQVerifyGuessResponse\x12m\n" +
 	"\x18TwentyQNormalizeQuestion\x12'.llm.v1.TwentyQNormalizeQuestionRequest\x1a(.llm.v1.TwentyQNormalizeQuestionResponse\x12^\n" +
-	"\x13TwentyQCheckSynonym\x12\".llm.v1.TwentyQCheckSynonymRequest\x1a#.llm.v1.TwentyQCheckSynonymResponse\x12m\n" +
+	"\x13TwentyQCheckSynonym\x12\".llm.v1.TwentyQCheckSynonymRequest\x1a#.llm.v1.TwentyQCheckSynonymResponse\x12a\n" +
+	"\x14TwentyQSummarizeGame\x12#.llm.v1.TwentyQSummarizeGameRequest\x1a$.llm.v1.TwentyQSummarizeGameResponse\x12m\n" +
 	"\x18TurtleSoupGeneratePuzzle\x12'.llm.v1.TurtleSoupGeneratePuzzleRequest\x1a(.llm.v1.TurtleSoupGeneratePuzzleResponse\x12p\n" +
 	"\x19TurtleSoupGetRandomPuzzle\x12(.llm.v1.TurtleSoupGetRandomPuzzleRequest\x1a).llm.v1.TurtleSoupGetRandomPuzzleResponse\x12p\n" +
 	"\x19TurtleSoupRewriteScenario\x12(.llm.v1.TurtleSoupRewriteScenarioRequest\x1a).llm.v1.TurtleSoupRewriteScenarioResponse\x12m\n" +
 	"\x18TurtleSoupAnswerQuestion\x12'.llm.v1.TurtleSoupAnswerQuestionRequest\x1a(.llm.v1.TurtleSoupAnswerQuestionResponse\x12s\n" +
 	"\x1aTurtleSoupValidateSolution\x12).llm.v1.TurtleSoupValidateSolutionRequest\x1a*.llm.v1.TurtleSoupValidateSolutionResponse\x12g\n" +
-	"\x16TurtleSoupGenerateHint\x12%.llm.v1.TurtleSoupGenerateHintRequest\x1a&.llm.v1.TurtleSoupGenerateHintResponse\x12C\n" +
+	"\x16TurtleSoupGenerateHint\x12%.llm.v1.TurtleSoupGenerateHintRequest\x1a&.llm.v1.TurtleSoupGenerateHintResponse\x12s\n" +
+	"\x1aTurtleSoupGenerateEpilogue\x12).llm.v1.TurtleSoupGenerateEpilogueRequest\x1a*.llm.v1.TurtleSoupGenerateEpilogueResponse\x12C\n" +
 	"\rGetDailyUsage\x12\x16.google.protobuf.Empty\x1a\x1a.llm.v1.DailyUsageResponse\x12J\n" +
 	"\x0eGetRecentUsage\x12\x1d.llm.v1.GetRecentUsageRequest\x1a\x19.llm.v1.UsageListResponse\x12D\n" +
-	"\rGetTotalUsage\x12\x1c.llm.v1.GetTotalUsageRequest\x1a\x15.llm.v1.UsageResponseBEZCgithub.com/park285/llm-kakao-bots/llm-kakao-bots-proto/llm/v1;llmv1b\x06proto3"
+	"\rGetTotalUsage\x12\x1c.llm.v1.GetTotalUsageRequest\x1a\x15.llm.v1.UsageResponse\x12O\n" +
+	"\x0fGetSessionUsage\x12\x1e.llm.v1.GetSessionUsageRequest\x1a\x1c.llm.v1.SessionUsageResponse\x12N\n" +
+	"\x0eGetUsageByTask\x12\x1d.llm.v1.GetUsageByTaskRequest\x1a\x1d.llm.v1.TaskUsageListResponse\x12L\n" +
+	"\x0eGetQuotaStatus\x12\x1d.llm.v1.GetQuotaStatusRequest\x1a\x1b.llm.v1.QuotaStatusResponseBEZCgithub.com/park285/llm-kakao-bots/llm-kakao-bots-proto/llm/v1;llmv1b\x06proto3"
 
 var (
 	file_llm_v1_llm_service_proto_rawDescOnce sync.Once
@@ -2520,96 +3899,138 @@ func file_llm_v1_llm_service_proto_rawDescGZIP() []byte {
 	return file_llm_v1_llm_service_proto_rawDescData
 }
 
-var file_llm_v1_llm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_llm_v1_llm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_llm_v1_llm_service_proto_goTypes = []any{
 	(*ModelConfigResponse)(nil),                // 0: llm.v1.ModelConfigResponse
 	(*GuardIsMaliciousRequest)(nil),            // 1: llm.v1.GuardIsMaliciousRequest
 	(*GuardIsMaliciousResponse)(nil),           // 2: llm.v1.GuardIsMaliciousResponse
 	(*EndSessionRequest)(nil),                  // 3: llm.v1.EndSessionRequest
 	(*EndSessionResponse)(nil),                 // 4: llm.v1.EndSessionResponse
-	(*TwentyQSelectTopicRequest)(nil),          // 5: llm.v1.TwentyQSelectTopicRequest
-	(*TwentyQSelectTopicResponse)(nil),         // 6: llm.v1.TwentyQSelectTopicResponse
-	(*TwentyQGetCategoriesResponse)(nil),       // 7: llm.v1.TwentyQGetCategoriesResponse
-	(*TwentyQGenerateHintsRequest)(nil),        // 8: llm.v1.TwentyQGenerateHintsRequest
-	(*TwentyQGenerateHintsResponse)(nil),       // 9: llm.v1.TwentyQGenerateHintsResponse
-	(*TwentyQAnswerQuestionRequest)(nil),       // 10: llm.v1.TwentyQAnswerQuestionRequest
-	(*TwentyQAnswerQuestionResponse)(nil),      // 11: llm.v1.TwentyQAnswerQuestionResponse
-	(*TwentyQVerifyGuessRequest)(nil),          // 12: llm.v1.TwentyQVerifyGuessRequest
-	(*TwentyQVerifyGuessResponse)(nil),         // 13: llm.v1.TwentyQVerifyGuessResponse
-	(*TwentyQNormalizeQuestionRequest)(nil),    // 14: llm.v1.TwentyQNormalizeQuestionRequest
-	(*TwentyQNormalizeQuestionResponse)(nil),   // 15: llm.v1.TwentyQNormalizeQuestionResponse
-	(*TwentyQCheckSynonymRequest)(nil),         // 16: llm.v1.TwentyQCheckSynonymRequest
-	(*TwentyQCheckSynonymResponse)(nil),        // 17: llm.v1.TwentyQCheckSynonymResponse
-	(*TurtleSoupGeneratePuzzleRequest)(nil),    // 18: llm.v1.TurtleSoupGeneratePuzzleRequest
-	(*TurtleSoupGeneratePuzzleResponse)(nil),   // 19: llm.v1.TurtleSoupGeneratePuzzleResponse
-	(*TurtleSoupGetRandomPuzzleRequest)(nil),   // 20: llm.v1.TurtleSoupGetRandomPuzzleRequest
-	(*TurtleSoupGetRandomPuzzleResponse)(nil),  // 21: llm.v1.TurtleSoupGetRandomPuzzleResponse
-	(*TurtleSoupRewriteScenarioRequest)(nil),   // 22: llm.v1.TurtleSoupRewriteScenarioRequest
-	(*TurtleSoupRewriteScenarioResponse)(nil),  // 23: llm.v1.TurtleSoupRewriteScenarioResponse
-	(*TurtleSoupHistoryItem)(nil),              // 24: llm.v1.TurtleSoupHistoryItem
-	(*TurtleSoupAnswerQuestionRequest)(nil),    // 25: llm.v1.TurtleSoupAnswerQuestionRequest
-	(*TurtleSoupAnswerQuestionResponse)(nil),   // 26: llm.v1.TurtleSoupAnswerQuestionResponse
-	(*TurtleSoupValidateSolutionRequest)(nil),  // 27: llm.v1.TurtleSoupValidateSolutionRequest
-	(*TurtleSoupValidateSolutionResponse)(nil), // 28: llm.v1.TurtleSoupValidateSolutionResponse
-	(*TurtleSoupGenerateHintRequest)(nil),      // 29: llm.v1.TurtleSoupGenerateHintRequest
-	(*TurtleSoupGenerateHintResponse)(nil),     // 30: llm.v1.TurtleSoupGenerateHintResponse
-	(*DailyUsageResponse)(nil),                 // 31: llm.v1.DailyUsageResponse
-	(*UsageResponse)(nil),                      // 32: llm.v1.UsageResponse
-	(*GetRecentUsageRequest)(nil),              // 33: llm.v1.GetRecentUsageRequest
-	(*UsageListResponse)(nil),                  // 34: llm.v1.UsageListResponse
-	(*GetTotalUsageRequest)(nil),               // 35: llm.v1.GetTotalUsageRequest
-	(*structpb.Struct)(nil),                    // 36: google.protobuf.Struct
-	(*emptypb.Empty)(nil),                      // 37: google.protobuf.Empty
+	(*EmbedRequest)(nil),                       // 5: llm.v1.EmbedRequest
+	(*Embedding)(nil),                          // 6: llm.v1.Embedding
+	(*EmbedResponse)(nil),                      // 7: llm.v1.EmbedResponse
+	(*TwentyQSelectTopicRequest)(nil),          // 8: llm.v1.TwentyQSelectTopicRequest
+	(*TwentyQSelectTopicResponse)(nil),         // 9: llm.v1.TwentyQSelectTopicResponse
+	(*TwentyQGetCategoriesResponse)(nil),       // 10: llm.v1.TwentyQGetCategoriesResponse
+	(*TwentyQGenerateHintsRequest)(nil),        // 11: llm.v1.TwentyQGenerateHintsRequest
+	(*TwentyQHintFeedback)(nil),                // 12: llm.v1.TwentyQHintFeedback
+	(*TwentyQGenerateHintsResponse)(nil),       // 13: llm.v1.TwentyQGenerateHintsResponse
+	(*TwentyQBatchGenerateHintsRequest)(nil),   // 14: llm.v1.TwentyQBatchGenerateHintsRequest
+	(*TwentyQBatchHintResult)(nil),             // 15: llm.v1.TwentyQBatchHintResult
+	(*TwentyQBatchGenerateHintsResponse)(nil),  // 16: llm.v1.TwentyQBatchGenerateHintsResponse
+	(*TwentyQAnswerQuestionRequest)(nil),       // 17: llm.v1.TwentyQAnswerQuestionRequest
+	(*TwentyQAnswerQuestionResponse)(nil),      // 18: llm.v1.TwentyQAnswerQuestionResponse
+	(*TwentyQVerifyGuessRequest)(nil),          // 19: llm.v1.TwentyQVerifyGuessRequest
+	(*TwentyQVerifyGuessResponse)(nil),         // 20: llm.v1.TwentyQVerifyGuessResponse
+	(*TwentyQNormalizeQuestionRequest)(nil),    // 21: llm.v1.TwentyQNormalizeQuestionRequest
+	(*TwentyQNormalizeQuestionResponse)(nil),   // 22: llm.v1.TwentyQNormalizeQuestionResponse
+	(*TwentyQCheckSynonymRequest)(nil),         // 23: llm.v1.TwentyQCheckSynonymRequest
+	(*TwentyQCheckSynonymResponse)(nil),        // 24: llm.v1.TwentyQCheckSynonymResponse
+	(*TwentyQHistoryEntry)(nil),                // 25: llm.v1.TwentyQHistoryEntry
+	(*TwentyQSummarizeGameRequest)(nil),        // 26: llm.v1.TwentyQSummarizeGameRequest
+	(*TwentyQSummarizeGameResponse)(nil),       // 27: llm.v1.TwentyQSummarizeGameResponse
+	(*TurtleSoupGeneratePuzzleRequest)(nil),    // 28: llm.v1.TurtleSoupGeneratePuzzleRequest
+	(*TurtleSoupGeneratePuzzleResponse)(nil),   // 29: llm.v1.TurtleSoupGeneratePuzzleResponse
+	(*TurtleSoupGetRandomPuzzleRequest)(nil),   // 30: llm.v1.TurtleSoupGetRandomPuzzleRequest
+	(*TurtleSoupGetRandomPuzzleResponse)(nil),  // 31: llm.v1.TurtleSoupGetRandomPuzzleResponse
+	(*TurtleSoupRewriteScenarioRequest)(nil),   // 32: llm.v1.TurtleSoupRewriteScenarioRequest
+	(*TurtleSoupRewriteScenarioResponse)(nil),  // 33: llm.v1.TurtleSoupRewriteScenarioResponse
+	(*TurtleSoupHistoryItem)(nil),              // 34: llm.v1.TurtleSoupHistoryItem
+	(*TurtleSoupAnswerQuestionRequest)(nil),    // 35: llm.v1.TurtleSoupAnswerQuestionRequest
+	(*TurtleSoupAnswerQuestionResponse)(nil),   // 36: llm.v1.TurtleSoupAnswerQuestionResponse
+	(*TurtleSoupValidateSolutionRequest)(nil),  // 37: llm.v1.TurtleSoupValidateSolutionRequest
+	(*TurtleSoupValidateSolutionResponse)(nil), // 38: llm.v1.TurtleSoupValidateSolutionResponse
+	(*TurtleSoupGenerateHintRequest)(nil),      // 39: llm.v1.TurtleSoupGenerateHintRequest
+	(*TurtleSoupGenerateHintResponse)(nil),     // 40: llm.v1.TurtleSoupGenerateHintResponse
+	(*TurtleSoupGenerateEpilogueRequest)(nil),  // 41: llm.v1.TurtleSoupGenerateEpilogueRequest
+	(*TurtleSoupGenerateEpilogueResponse)(nil), // 42: llm.v1.TurtleSoupGenerateEpilogueResponse
+	(*DailyUsageResponse)(nil),                 // 43: llm.v1.DailyUsageResponse
+	(*UsageResponse)(nil),                      // 44: llm.v1.UsageResponse
+	(*GetRecentUsageRequest)(nil),              // 45: llm.v1.GetRecentUsageRequest
+	(*UsageListResponse)(nil),                  // 46: llm.v1.UsageListResponse
+	(*GetTotalUsageRequest)(nil),               // 47: llm.v1.GetTotalUsageRequest
+	(*TaskUsage)(nil),                          // 48: llm.v1.TaskUsage
+	(*GetSessionUsageRequest)(nil),             // 49: llm.v1.GetSessionUsageRequest
+	(*SessionUsageResponse)(nil),               // 50: llm.v1.SessionUsageResponse
+	(*GetUsageByTaskRequest)(nil),              // 51: llm.v1.GetUsageByTaskRequest
+	(*TaskUsageListResponse)(nil),              // 52: llm.v1.TaskUsageListResponse
+	(*GetQuotaStatusRequest)(nil),              // 53: llm.v1.GetQuotaStatusRequest
+	(*QuotaStatus)(nil),                        // 54: llm.v1.QuotaStatus
+	(*QuotaStatusResponse)(nil),                // 55: llm.v1.QuotaStatusResponse
+	(*structpb.Struct)(nil),                    // 56: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                      // 57: google.protobuf.Empty
 }
 var file_llm_v1_llm_service_proto_depIdxs = []int32{
-	36, // 0: llm.v1.TwentyQSelectTopicResponse.details:type_name -> google.protobuf.Struct
-	36, // 1: llm.v1.TwentyQGenerateHintsRequest.details:type_name -> google.protobuf.Struct
-	36, // 2: llm.v1.TwentyQAnswerQuestionRequest.details:type_name -> google.protobuf.Struct
-	24, // 3: llm.v1.TurtleSoupAnswerQuestionResponse.history:type_name -> llm.v1.TurtleSoupHistoryItem
-	31, // 4: llm.v1.UsageListResponse.usages:type_name -> llm.v1.DailyUsageResponse
-	37, // 5: llm.v1.LLMService.GetModelConfig:input_type -> google.protobuf.Empty
-	1,  // 6: llm.v1.LLMService.GuardIsMalicious:input_type -> llm.v1.GuardIsMaliciousRequest
-	3,  // 7: llm.v1.LLMService.EndSession:input_type -> llm.v1.EndSessionRequest
-	5,  // 8: llm.v1.LLMService.TwentyQSelectTopic:input_type -> llm.v1.TwentyQSelectTopicRequest
-	37, // 9: llm.v1.LLMService.TwentyQGetCategories:input_type -> google.protobuf.Empty
-	8,  // 10: llm.v1.LLMService.TwentyQGenerateHints:input_type -> llm.v1.TwentyQGenerateHintsRequest
-	10, // 11: llm.v1.LLMService.TwentyQAnswerQuestion:input_type -> llm.v1.TwentyQAnswerQuestionRequest
-	12, // 12: llm.v1.LLMService.TwentyQVerifyGuess:input_type -> llm.v1.TwentyQVerifyGuessRequest
-	14, // 13: llm.v1.LLMService.TwentyQNormalizeQuestion:input_type -> llm.v1.TwentyQNormalizeQuestionRequest
-	16, // 14: llm.v1.LLMService.TwentyQCheckSynonym:input_type -> llm.v1.TwentyQCheckSynonymRequest
-	18, // 15: llm.v1.LLMService.TurtleSoupGeneratePuzzle:input_type -> llm.v1.TurtleSoupGeneratePuzzleRequest
-	20, // 16: llm.v1.LLMService.TurtleSoupGetRandomPuzzle:input_type -> llm.v1.TurtleSoupGetRandomPuzzleRequest
-	22, // 17: llm.v1.LLMService.TurtleSoupRewriteScenario:input_type -> llm.v1.TurtleSoupRewriteScenarioRequest
-	25, // 18: llm.v1.LLMService.TurtleSoupAnswerQuestion:input_type -> llm.v1.TurtleSoupAnswerQuestionRequest
-	27, // 19: llm.v1.LLMService.TurtleSoupValidateSolution:input_type -> llm.v1.TurtleSoupValidateSolutionRequest
-	29, // 20: llm.v1.LLMService.TurtleSoupGenerateHint:input_type -> llm.v1.TurtleSoupGenerateHintRequest
-	37, // 21: llm.v1.LLMService.GetDailyUsage:input_type -> google.protobuf.Empty
-	33, // 22: llm.v1.LLMService.GetRecentUsage:input_type -> llm.v1.GetRecentUsageRequest
-	35, // 23: llm.v1.LLMService.GetTotalUsage:input_type -> llm.v1.GetTotalUsageRequest
-	0,  // 24: llm.v1.LLMService.GetModelConfig:output_type -> llm.v1.ModelConfigResponse
-	2,  // 25: llm.v1.LLMService.GuardIsMalicious:output_type -> llm.v1.GuardIsMaliciousResponse
-	4,  // 26: llm.v1.LLMService.EndSession:output_type -> llm.v1.EndSessionResponse
-	6,  // 27: llm.v1.LLMService.TwentyQSelectTopic:output_type -> llm.v1.TwentyQSelectTopicResponse
-	7,  // 28: llm.v1.LLMService.TwentyQGetCategories:output_type -> llm.v1.TwentyQGetCategoriesResponse
-	9,  // 29: llm.v1.LLMService.TwentyQGenerateHints:output_type -> llm.v1.TwentyQGenerateHintsResponse
-	11, // 30: llm.v1.LLMService.TwentyQAnswerQuestion:output_type -> llm.v1.TwentyQAnswerQuestionResponse
-	13, // 31: llm.v1.LLMService.TwentyQVerifyGuess:output_type -> llm.v1.TwentyQVerifyGuessResponse
-	15, // 32: llm.v1.LLMService.TwentyQNormalizeQuestion:output_type -> llm.v1.TwentyQNormalizeQuestionResponse
-	17, // 33: llm.v1.LLMService.TwentyQCheckSynonym:output_type -> llm.v1.TwentyQCheckSynonymResponse
-	19, // 34: llm.v1.LLMService.TurtleSoupGeneratePuzzle:output_type -> llm.v1.TurtleSoupGeneratePuzzleResponse
-	21, // 35: llm.v1.LLMService.TurtleSoupGetRandomPuzzle:output_type -> llm.v1.TurtleSoupGetRandomPuzzleResponse
-	23, // 36: llm.v1.LLMService.TurtleSoupRewriteScenario:output_type -> llm.v1.TurtleSoupRewriteScenarioResponse
-	26, // 37: llm.v1.LLMService.TurtleSoupAnswerQuestion:output_type -> llm.v1.TurtleSoupAnswerQuestionResponse
-	28, // 38: llm.v1.LLMService.TurtleSoupValidateSolution:output_type -> llm.v1.TurtleSoupValidateSolutionResponse
-	30, // 39: llm.v1.LLMService.TurtleSoupGenerateHint:output_type -> llm.v1.TurtleSoupGenerateHintResponse
-	31, // 40: llm.v1.LLMService.GetDailyUsage:output_type -> llm.v1.DailyUsageResponse
-	34, // 41: llm.v1.LLMService.GetRecentUsage:output_type -> llm.v1.UsageListResponse
-	32, // 42: llm.v1.LLMService.GetTotalUsage:output_type -> llm.v1.UsageResponse
-	24, // [24:43] is the sub-list for method output_type
-	5,  // [5:24] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	6,  // 0: llm.v1.EmbedResponse.embeddings:type_name -> llm.v1.Embedding
+	56, // 1: llm.v1.TwentyQSelectTopicResponse.details:type_name -> google.protobuf.Struct
+	56, // 2: llm.v1.TwentyQGenerateHintsRequest.details:type_name -> google.protobuf.Struct
+	12, // 3: llm.v1.TwentyQGenerateHintsRequest.feedback:type_name -> llm.v1.TwentyQHintFeedback
+	11, // 4: llm.v1.TwentyQBatchGenerateHintsRequest.items:type_name -> llm.v1.TwentyQGenerateHintsRequest
+	15, // 5: llm.v1.TwentyQBatchGenerateHintsResponse.results:type_name -> llm.v1.TwentyQBatchHintResult
+	56, // 6: llm.v1.TwentyQAnswerQuestionRequest.details:type_name -> google.protobuf.Struct
+	25, // 7: llm.v1.TwentyQSummarizeGameRequest.history:type_name -> llm.v1.TwentyQHistoryEntry
+	34, // 8: llm.v1.TurtleSoupAnswerQuestionResponse.history:type_name -> llm.v1.TurtleSoupHistoryItem
+	43, // 9: llm.v1.UsageListResponse.usages:type_name -> llm.v1.DailyUsageResponse
+	48, // 10: llm.v1.SessionUsageResponse.tasks:type_name -> llm.v1.TaskUsage
+	48, // 11: llm.v1.TaskUsageListResponse.tasks:type_name -> llm.v1.TaskUsage
+	54, // 12: llm.v1.QuotaStatusResponse.quotas:type_name -> llm.v1.QuotaStatus
+	57, // 13: llm.v1.LLMService.GetModelConfig:input_type -> google.protobuf.Empty
+	1,  // 14: llm.v1.LLMService.GuardIsMalicious:input_type -> llm.v1.GuardIsMaliciousRequest
+	3,  // 15: llm.v1.LLMService.EndSession:input_type -> llm.v1.EndSessionRequest
+	5,  // 16: llm.v1.LLMService.Embed:input_type -> llm.v1.EmbedRequest
+	8,  // 17: llm.v1.LLMService.TwentyQSelectTopic:input_type -> llm.v1.TwentyQSelectTopicRequest
+	57, // 18: llm.v1.LLMService.TwentyQGetCategories:input_type -> google.protobuf.Empty
+	11, // 19: llm.v1.LLMService.TwentyQGenerateHints:input_type -> llm.v1.TwentyQGenerateHintsRequest
+	14, // 20: llm.v1.LLMService.TwentyQBatchGenerateHints:input_type -> llm.v1.TwentyQBatchGenerateHintsRequest
+	17, // 21: llm.v1.LLMService.TwentyQAnswerQuestion:input_type -> llm.v1.TwentyQAnswerQuestionRequest
+	19, // 22: llm.v1.LLMService.TwentyQVerifyGuess:input_type -> llm.v1.TwentyQVerifyGuessRequest
+	21, // 23: llm.v1.LLMService.TwentyQNormalizeQuestion:input_type -> llm.v1.TwentyQNormalizeQuestionRequest
+	23, // 24: llm.v1.LLMService.TwentyQCheckSynonym:input_type -> llm.v1.TwentyQCheckSynonymRequest
+	26, // 25: llm.v1.LLMService.TwentyQSummarizeGame:input_type -> llm.v1.TwentyQSummarizeGameRequest
+	28, // 26: llm.v1.LLMService.TurtleSoupGeneratePuzzle:input_type -> llm.v1.TurtleSoupGeneratePuzzleRequest
+	30, // 27: llm.v1.LLMService.TurtleSoupGetRandomPuzzle:input_type -> llm.v1.TurtleSoupGetRandomPuzzleRequest
+	32, // 28: llm.v1.LLMService.TurtleSoupRewriteScenario:input_type -> llm.v1.TurtleSoupRewriteScenarioRequest
+	35, // 29: llm.v1.LLMService.TurtleSoupAnswerQuestion:input_type -> llm.v1.TurtleSoupAnswerQuestionRequest
+	37, // 30: llm.v1.LLMService.TurtleSoupValidateSolution:input_type -> llm.v1.TurtleSoupValidateSolutionRequest
+	39, // 31: llm.v1.LLMService.TurtleSoupGenerateHint:input_type -> llm.v1.TurtleSoupGenerateHintRequest
+	41, // 32: llm.v1.LLMService.TurtleSoupGenerateEpilogue:input_type -> llm.v1.TurtleSoupGenerateEpilogueRequest
+	57, // 33: llm.v1.LLMService.GetDailyUsage:input_type -> google.protobuf.Empty
+	45, // 34: llm.v1.LLMService.GetRecentUsage:input_type -> llm.v1.GetRecentUsageRequest
+	47, // 35: llm.v1.LLMService.GetTotalUsage:input_type -> llm.v1.GetTotalUsageRequest
+	49, // 36: llm.v1.LLMService.GetSessionUsage:input_type -> llm.v1.GetSessionUsageRequest
+	51, // 37: llm.v1.LLMService.GetUsageByTask:input_type -> llm.v1.GetUsageByTaskRequest
+	53, // 38: llm.v1.LLMService.GetQuotaStatus:input_type -> llm.v1.GetQuotaStatusRequest
+	0,  // 39: llm.v1.LLMService.GetModelConfig:output_type -> llm.v1.ModelConfigResponse
+	2,  // 40: llm.v1.LLMService.GuardIsMalicious:output_type -> llm.v1.GuardIsMaliciousResponse
+	4,  // 41: llm.v1.LLMService.EndSession:output_type -> llm.v1.EndSessionResponse
+	7,  // 42: llm.v1.LLMService.Embed:output_type -> llm.v1.EmbedResponse
+	9,  // 43: llm.v1.LLMService.TwentyQSelectTopic:output_type -> llm.v1.TwentyQSelectTopicResponse
+	10, // 44: llm.v1.LLMService.TwentyQGetCategories:output_type -> llm.v1.TwentyQGetCategoriesResponse
+	13, // 45: llm.v1.LLMService.TwentyQGenerateHints:output_type -> llm.v1.TwentyQGenerateHintsResponse
+	16, // 46: llm.v1.LLMService.TwentyQBatchGenerateHints:output_type -> llm.v1.TwentyQBatchGenerateHintsResponse
+	18, // 47: llm.v1.LLMService.TwentyQAnswerQuestion:output_type -> llm.v1.TwentyQAnswerQuestionResponse
+	20, // 48: llm.v1.LLMService.TwentyQVerifyGuess:output_type -> llm.v1.TwentyQVerifyGuessResponse
+	22, // 49: llm.v1.LLMService.TwentyQNormalizeQuestion:output_type -> llm.v1.TwentyQNormalizeQuestionResponse
+	24, // 50: llm.v1.LLMService.TwentyQCheckSynonym:output_type -> llm.v1.TwentyQCheckSynonymResponse
+	27, // 51: llm.v1.LLMService.TwentyQSummarizeGame:output_type -> llm.v1.TwentyQSummarizeGameResponse
+	29, // 52: llm.v1.LLMService.TurtleSoupGeneratePuzzle:output_type -> llm.v1.TurtleSoupGeneratePuzzleResponse
+	31, // 53: llm.v1.LLMService.TurtleSoupGetRandomPuzzle:output_type -> llm.v1.TurtleSoupGetRandomPuzzleResponse
+	33, // 54: llm.v1.LLMService.TurtleSoupRewriteScenario:output_type -> llm.v1.TurtleSoupRewriteScenarioResponse
+	36, // 55: llm.v1.LLMService.TurtleSoupAnswerQuestion:output_type -> llm.v1.TurtleSoupAnswerQuestionResponse
+	38, // 56: llm.v1.LLMService.TurtleSoupValidateSolution:output_type -> llm.v1.TurtleSoupValidateSolutionResponse
+	40, // 57: llm.v1.LLMService.TurtleSoupGenerateHint:output_type -> llm.v1.TurtleSoupGenerateHintResponse
+	42, // 58: llm.v1.LLMService.TurtleSoupGenerateEpilogue:output_type -> llm.v1.TurtleSoupGenerateEpilogueResponse
+	43, // 59: llm.v1.LLMService.GetDailyUsage:output_type -> llm.v1.DailyUsageResponse
+	46, // 60: llm.v1.LLMService.GetRecentUsage:output_type -> llm.v1.UsageListResponse
+	44, // 61: llm.v1.LLMService.GetTotalUsage:output_type -> llm.v1.UsageResponse
+	50, // 62: llm.v1.LLMService.GetSessionUsage:output_type -> llm.v1.SessionUsageResponse
+	52, // 63: llm.v1.LLMService.GetUsageByTask:output_type -> llm.v1.TaskUsageListResponse
+	55, // 64: llm.v1.LLMService.GetQuotaStatus:output_type -> llm.v1.QuotaStatusResponse
+	39, // [39:65] is the sub-list for method output_type
+	13, // [13:39] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_llm_v1_llm_service_proto_init() }
@@ -2618,24 +4039,30 @@ func file_llm_v1_llm_service_proto_init() {
 		return
 	}
 	file_llm_v1_llm_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[10].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_llm_v1_llm_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[15].OneofWrappers = []any{}
 	file_llm_v1_llm_service_proto_msgTypes[17].OneofWrappers = []any{}
 	file_llm_v1_llm_service_proto_msgTypes[18].OneofWrappers = []any{}
 	file_llm_v1_llm_service_proto_msgTypes[20].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[21].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[25].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[27].OneofWrappers = []any{}
-	file_llm_v1_llm_service_proto_msgTypes[29].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[31].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[35].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[39].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[41].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_llm_v1_llm_service_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llm_v1_llm_service_proto_rawDesc), len(file_llm_v1_llm_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
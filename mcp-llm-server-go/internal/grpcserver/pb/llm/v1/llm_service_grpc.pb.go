@@ -23,22 +23,29 @@ const (
 	LLMService_GetModelConfig_FullMethodName             = "/llm.v1.LLMService/GetModelConfig"
 	LLMService_GuardIsMalicious_FullMethodName           = "/llm.v1.LLMService/GuardIsMalicious"
 	LLMService_EndSession_FullMethodName                 = "/llm.v1.LLMService/EndSession"
+	LLMService_Embed_FullMethodName                      = "/llm.v1.LLMService/Embed"
 	LLMService_TwentyQSelectTopic_FullMethodName         = "/llm.v1.LLMService/TwentyQSelectTopic"
 	LLMService_TwentyQGetCategories_FullMethodName       = "/llm.v1.LLMService/TwentyQGetCategories"
 	LLMService_TwentyQGenerateHints_FullMethodName       = "/llm.v1.LLMService/TwentyQGenerateHints"
+	LLMService_TwentyQBatchGenerateHints_FullMethodName  = "/llm.v1.LLMService/TwentyQBatchGenerateHints"
 	LLMService_TwentyQAnswerQuestion_FullMethodName      = "/llm.v1.LLMService/TwentyQAnswerQuestion"
 	LLMService_TwentyQVerifyGuess_FullMethodName         = "/llm.v1.LLMService/TwentyQVerifyGuess"
 	LLMService_TwentyQNormalizeQuestion_FullMethodName   = "/llm.v1.LLMService/TwentyQNormalizeQuestion"
 	LLMService_TwentyQCheckSynonym_FullMethodName        = "/llm.v1.LLMService/TwentyQCheckSynonym"
+	LLMService_TwentyQSummarizeGame_FullMethodName       = "/llm.v1.LLMService/TwentyQSummarizeGame"
 	LLMService_TurtleSoupGeneratePuzzle_FullMethodName   = "/llm.v1.LLMService/TurtleSoupGeneratePuzzle"
 	LLMService_TurtleSoupGetRandomPuzzle_FullMethodName  = "/llm.v1.LLMService/TurtleSoupGetRandomPuzzle"
 	LLMService_TurtleSoupRewriteScenario_FullMethodName  = "/llm.v1.LLMService/TurtleSoupRewriteScenario"
 	LLMService_TurtleSoupAnswerQuestion_FullMethodName   = "/llm.v1.LLMService/TurtleSoupAnswerQuestion"
 	LLMService_TurtleSoupValidateSolution_FullMethodName = "/llm.v1.LLMService/TurtleSoupValidateSolution"
 	LLMService_TurtleSoupGenerateHint_FullMethodName     = "/llm.v1.LLMService/TurtleSoupGenerateHint"
+	LLMService_TurtleSoupGenerateEpilogue_FullMethodName = "/llm.v1.LLMService/TurtleSoupGenerateEpilogue"
 	LLMService_GetDailyUsage_FullMethodName              = "/llm.v1.LLMService/GetDailyUsage"
 	LLMService_GetRecentUsage_FullMethodName             = "/llm.v1.LLMService/GetRecentUsage"
 	LLMService_GetTotalUsage_FullMethodName              = "/llm.v1.LLMService/GetTotalUsage"
+	LLMService_GetSessionUsage_FullMethodName            = "/llm.v1.LLMService/GetSessionUsage"
+	LLMService_GetUsageByTask_FullMethodName             = "/llm.v1.LLMService/GetUsageByTask"
+	LLMService_GetQuotaStatus_FullMethodName             = "/llm.v1.LLMService/GetQuotaStatus"
 )
 
 // LLMServiceClient is the client API for LLMService service.
@@ -48,22 +55,29 @@ type LLMServiceClient interface {
 	GetModelConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ModelConfigResponse, error)
 	GuardIsMalicious(ctx context.Context, in *GuardIsMaliciousRequest, opts ...grpc.CallOption) (*GuardIsMaliciousResponse, error)
 	EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*EndSessionResponse, error)
+	Embed(ctx context.Context, in *EmbedRequest, opts ...grpc.CallOption) (*EmbedResponse, error)
 	TwentyQSelectTopic(ctx context.Context, in *TwentyQSelectTopicRequest, opts ...grpc.CallOption) (*TwentyQSelectTopicResponse, error)
 	TwentyQGetCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TwentyQGetCategoriesResponse, error)
 	TwentyQGenerateHints(ctx context.Context, in *TwentyQGenerateHintsRequest, opts ...grpc.CallOption) (*TwentyQGenerateHintsResponse, error)
+	TwentyQBatchGenerateHints(ctx context.Context, in *TwentyQBatchGenerateHintsRequest, opts ...grpc.CallOption) (*TwentyQBatchGenerateHintsResponse, error)
 	TwentyQAnswerQuestion(ctx context.Context, in *TwentyQAnswerQuestionRequest, opts ...grpc.CallOption) (*TwentyQAnswerQuestionResponse, error)
 	TwentyQVerifyGuess(ctx context.Context, in *TwentyQVerifyGuessRequest, opts ...grpc.CallOption) (*TwentyQVerifyGuessResponse, error)
 	TwentyQNormalizeQuestion(ctx context.Context, in *TwentyQNormalizeQuestionRequest, opts ...grpc.CallOption) (*TwentyQNormalizeQuestionResponse, error)
 	TwentyQCheckSynonym(ctx context.Context, in *TwentyQCheckSynonymRequest, opts ...grpc.CallOption) (*TwentyQCheckSynonymResponse, error)
+	TwentyQSummarizeGame(ctx context.Context, in *TwentyQSummarizeGameRequest, opts ...grpc.CallOption) (*TwentyQSummarizeGameResponse, error)
 	TurtleSoupGeneratePuzzle(ctx context.Context, in *TurtleSoupGeneratePuzzleRequest, opts ...grpc.CallOption) (*TurtleSoupGeneratePuzzleResponse, error)
 	TurtleSoupGetRandomPuzzle(ctx context.Context, in *TurtleSoupGetRandomPuzzleRequest, opts ...grpc.CallOption) (*TurtleSoupGetRandomPuzzleResponse, error)
 	TurtleSoupRewriteScenario(ctx context.Context, in *TurtleSoupRewriteScenarioRequest, opts ...grpc.CallOption) (*TurtleSoupRewriteScenarioResponse, error)
 	TurtleSoupAnswerQuestion(ctx context.Context, in *TurtleSoupAnswerQuestionRequest, opts ...grpc.CallOption) (*TurtleSoupAnswerQuestionResponse, error)
 	TurtleSoupValidateSolution(ctx context.Context, in *TurtleSoupValidateSolutionRequest, opts ...grpc.CallOption) (*TurtleSoupValidateSolutionResponse, error)
 	TurtleSoupGenerateHint(ctx context.Context, in *TurtleSoupGenerateHintRequest, opts ...grpc.CallOption) (*TurtleSoupGenerateHintResponse, error)
+	TurtleSoupGenerateEpilogue(ctx context.Context, in *TurtleSoupGenerateEpilogueRequest, opts ...grpc.CallOption) (*TurtleSoupGenerateEpilogueResponse, error)
 	GetDailyUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DailyUsageResponse, error)
 	GetRecentUsage(ctx context.Context, in *GetRecentUsageRequest, opts ...grpc.CallOption) (*UsageListResponse, error)
 	GetTotalUsage(ctx context.Context, in *GetTotalUsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
+	GetSessionUsage(ctx context.Context, in *GetSessionUsageRequest, opts ...grpc.CallOption) (*SessionUsageResponse, error)
+	GetUsageByTask(ctx context.Context, in *GetUsageByTaskRequest, opts ...grpc.CallOption) (*TaskUsageListResponse, error)
+	GetQuotaStatus(ctx context.Context, in *GetQuotaStatusRequest, opts ...grpc.CallOption) (*QuotaStatusResponse, error)
 }
 
 type lLMServiceClient struct {
@@ -104,6 +118,16 @@ func (c *lLMServiceClient) EndSession(ctx context.Context, in *EndSessionRequest
 	return out, nil
 }
 
+func (c *lLMServiceClient) Embed(ctx context.Context, in *EmbedRequest, opts ...grpc.CallOption) (*EmbedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbedResponse)
+	err := c.cc.Invoke(ctx, LLMService_Embed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lLMServiceClient) TwentyQSelectTopic(ctx context.Context, in *TwentyQSelectTopicRequest, opts ...grpc.CallOption) (*TwentyQSelectTopicResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TwentyQSelectTopicResponse)
@@ -134,6 +158,16 @@ func (c *lLMServiceClient) TwentyQGenerateHints(ctx context.Context, in *TwentyQ
 	return out, nil
 }
 
+func (c *lLMServiceClient) TwentyQBatchGenerateHints(ctx context.Context, in *TwentyQBatchGenerateHintsRequest, opts ...grpc.CallOption) (*TwentyQBatchGenerateHintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TwentyQBatchGenerateHintsResponse)
+	err := c.cc.Invoke(ctx, LLMService_TwentyQBatchGenerateHints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lLMServiceClient) TwentyQAnswerQuestion(ctx context.Context, in *TwentyQAnswerQuestionRequest, opts ...grpc.CallOption) (*TwentyQAnswerQuestionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TwentyQAnswerQuestionResponse)
@@ -174,6 +208,16 @@ func (c *lLMServiceClient) TwentyQCheckSynonym(ctx context.Context, in *TwentyQC
 	return out, nil
 }
 
+func (c *lLMServiceClient) TwentyQSummarizeGame(ctx context.Context, in *TwentyQSummarizeGameRequest, opts ...grpc.CallOption) (*TwentyQSummarizeGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TwentyQSummarizeGameResponse)
+	err := c.cc.Invoke(ctx, LLMService_TwentyQSummarizeGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lLMServiceClient) TurtleSoupGeneratePuzzle(ctx context.Context, in *TurtleSoupGeneratePuzzleRequest, opts ...grpc.CallOption) (*TurtleSoupGeneratePuzzleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TurtleSoupGeneratePuzzleResponse)
@@ -234,6 +278,16 @@ func (c *lLMServiceClient) TurtleSoupGenerateHint(ctx context.Context, in *Turtl
 	return out, nil
 }
 
+func (c *lLMServiceClient) TurtleSoupGenerateEpilogue(ctx context.Context, in *TurtleSoupGenerateEpilogueRequest, opts ...grpc.CallOption) (*TurtleSoupGenerateEpilogueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TurtleSoupGenerateEpilogueResponse)
+	err := c.cc.Invoke(ctx, LLMService_TurtleSoupGenerateEpilogue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lLMServiceClient) GetDailyUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DailyUsageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DailyUsageResponse)
@@ -264,6 +318,36 @@ func (c *lLMServiceClient) GetTotalUsage(ctx context.Context, in *GetTotalUsageR
 	return out, nil
 }
 
+func (c *lLMServiceClient) GetSessionUsage(ctx context.Context, in *GetSessionUsageRequest, opts ...grpc.CallOption) (*SessionUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionUsageResponse)
+	err := c.cc.Invoke(ctx, LLMService_GetSessionUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMServiceClient) GetUsageByTask(ctx context.Context, in *GetUsageByTaskRequest, opts ...grpc.CallOption) (*TaskUsageListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskUsageListResponse)
+	err := c.cc.Invoke(ctx, LLMService_GetUsageByTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMServiceClient) GetQuotaStatus(ctx context.Context, in *GetQuotaStatusRequest, opts ...grpc.CallOption) (*QuotaStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaStatusResponse)
+	err := c.cc.Invoke(ctx, LLMService_GetQuotaStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LLMServiceServer is the server API for LLMService service.
 // All implementations must embed UnimplementedLLMServiceServer
 // for forward compatibility.
@@ -271,22 +355,29 @@ type LLMServiceServer interface {
 	GetModelConfig(context.Context, *emptypb.Empty) (*ModelConfigResponse, error)
 	GuardIsMalicious(context.Context, *GuardIsMaliciousRequest) (*GuardIsMaliciousResponse, error)
 	EndSession(context.Context, *EndSessionRequest) (*EndSessionResponse, error)
+	Embed(context.Context, *EmbedRequest) (*EmbedResponse, error)
 	TwentyQSelectTopic(context.Context, *TwentyQSelectTopicRequest) (*TwentyQSelectTopicResponse, error)
 	TwentyQGetCategories(context.Context, *emptypb.Empty) (*TwentyQGetCategoriesResponse, error)
 	TwentyQGenerateHints(context.Context, *TwentyQGenerateHintsRequest) (*TwentyQGenerateHintsResponse, error)
+	TwentyQBatchGenerateHints(context.Context, *TwentyQBatchGenerateHintsRequest) (*TwentyQBatchGenerateHintsResponse, error)
 	TwentyQAnswerQuestion(context.Context, *TwentyQAnswerQuestionRequest) (*TwentyQAnswerQuestionResponse, error)
 	TwentyQVerifyGuess(context.Context, *TwentyQVerifyGuessRequest) (*TwentyQVerifyGuessResponse, error)
 	TwentyQNormalizeQuestion(context.Context, *TwentyQNormalizeQuestionRequest) (*TwentyQNormalizeQuestionResponse, error)
 	TwentyQCheckSynonym(context.Context, *TwentyQCheckSynonymRequest) (*TwentyQCheckSynonymResponse, error)
+	TwentyQSummarizeGame(context.Context, *TwentyQSummarizeGameRequest) (*TwentyQSummarizeGameResponse, error)
 	TurtleSoupGeneratePuzzle(context.Context, *TurtleSoupGeneratePuzzleRequest) (*TurtleSoupGeneratePuzzleResponse, error)
 	TurtleSoupGetRandomPuzzle(context.Context, *TurtleSoupGetRandomPuzzleRequest) (*TurtleSoupGetRandomPuzzleResponse, error)
 	TurtleSoupRewriteScenario(context.Context, *TurtleSoupRewriteScenarioRequest) (*TurtleSoupRewriteScenarioResponse, error)
 	TurtleSoupAnswerQuestion(context.Context, *TurtleSoupAnswerQuestionRequest) (*TurtleSoupAnswerQuestionResponse, error)
 	TurtleSoupValidateSolution(context.Context, *TurtleSoupValidateSolutionRequest) (*TurtleSoupValidateSolutionResponse, error)
 	TurtleSoupGenerateHint(context.Context, *TurtleSoupGenerateHintRequest) (*TurtleSoupGenerateHintResponse, error)
+	TurtleSoupGenerateEpilogue(context.Context, *TurtleSoupGenerateEpilogueRequest) (*TurtleSoupGenerateEpilogueResponse, error)
 	GetDailyUsage(context.Context, *emptypb.Empty) (*DailyUsageResponse, error)
 	GetRecentUsage(context.Context, *GetRecentUsageRequest) (*UsageListResponse, error)
 	GetTotalUsage(context.Context, *GetTotalUsageRequest) (*UsageResponse, error)
+	GetSessionUsage(context.Context, *GetSessionUsageRequest) (*SessionUsageResponse, error)
+	GetUsageByTask(context.Context, *GetUsageByTaskRequest) (*TaskUsageListResponse, error)
+	GetQuotaStatus(context.Context, *GetQuotaStatusRequest) (*QuotaStatusResponse, error)
 	mustEmbedUnimplementedLLMServiceServer()
 }
 
@@ -306,6 +397,9 @@ func (UnimplementedLLMServiceServer) GuardIsMalicious(context.Context, *GuardIsM
 func (UnimplementedLLMServiceServer) EndSession(context.Context, *EndSessionRequest) (*EndSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndSession not implemented")
 }
+func (UnimplementedLLMServiceServer) Embed(context.Context, *EmbedRequest) (*EmbedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Embed not implemented")
+}
 func (UnimplementedLLMServiceServer) TwentyQSelectTopic(context.Context, *TwentyQSelectTopicRequest) (*TwentyQSelectTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TwentyQSelectTopic not implemented")
 }
@@ -315,6 +409,9 @@ func (UnimplementedLLMServiceServer) TwentyQGetCategories(context.Context, *empt
 func (UnimplementedLLMServiceServer) TwentyQGenerateHints(context.Context, *TwentyQGenerateHintsRequest) (*TwentyQGenerateHintsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TwentyQGenerateHints not implemented")
 }
+func (UnimplementedLLMServiceServer) TwentyQBatchGenerateHints(context.Context, *TwentyQBatchGenerateHintsRequest) (*TwentyQBatchGenerateHintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwentyQBatchGenerateHints not implemented")
+}
 func (UnimplementedLLMServiceServer) TwentyQAnswerQuestion(context.Context, *TwentyQAnswerQuestionRequest) (*TwentyQAnswerQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TwentyQAnswerQuestion not implemented")
 }
@@ -327,6 +424,9 @@ func (UnimplementedLLMServiceServer) TwentyQNormalizeQuestion(context.Context, *
 func (UnimplementedLLMServiceServer) TwentyQCheckSynonym(context.Context, *TwentyQCheckSynonymRequest) (*TwentyQCheckSynonymResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TwentyQCheckSynonym not implemented")
 }
+func (UnimplementedLLMServiceServer) TwentyQSummarizeGame(context.Context, *TwentyQSummarizeGameRequest) (*TwentyQSummarizeGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwentyQSummarizeGame not implemented")
+}
 func (UnimplementedLLMServiceServer) TurtleSoupGeneratePuzzle(context.Context, *TurtleSoupGeneratePuzzleRequest) (*TurtleSoupGeneratePuzzleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TurtleSoupGeneratePuzzle not implemented")
 }
@@ -345,6 +445,9 @@ func (UnimplementedLLMServiceServer) TurtleSoupValidateSolution(context.Context,
 func (UnimplementedLLMServiceServer) TurtleSoupGenerateHint(context.Context, *TurtleSoupGenerateHintRequest) (*TurtleSoupGenerateHintResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TurtleSoupGenerateHint not implemented")
 }
+func (UnimplementedLLMServiceServer) TurtleSoupGenerateEpilogue(context.Context, *TurtleSoupGenerateEpilogueRequest) (*TurtleSoupGenerateEpilogueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TurtleSoupGenerateEpilogue not implemented")
+}
 func (UnimplementedLLMServiceServer) GetDailyUsage(context.Context, *emptypb.Empty) (*DailyUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDailyUsage not implemented")
 }
@@ -354,6 +457,15 @@ func (UnimplementedLLMServiceServer) GetRecentUsage(context.Context, *GetRecentU
 func (UnimplementedLLMServiceServer) GetTotalUsage(context.Context, *GetTotalUsageRequest) (*UsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalUsage not implemented")
 }
+func (UnimplementedLLMServiceServer) GetSessionUsage(context.Context, *GetSessionUsageRequest) (*SessionUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionUsage not implemented")
+}
+func (UnimplementedLLMServiceServer) GetUsageByTask(context.Context, *GetUsageByTaskRequest) (*TaskUsageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageByTask not implemented")
+}
+func (UnimplementedLLMServiceServer) GetQuotaStatus(context.Context, *GetQuotaStatusRequest) (*QuotaStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaStatus not implemented")
+}
 func (UnimplementedLLMServiceServer) mustEmbedUnimplementedLLMServiceServer() {}
 func (UnimplementedLLMServiceServer) testEmbeddedByValue()                    {}
 
@@ -429,6 +541,24 @@ func _LLMService_EndSession_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LLMService_Embed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmbedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).Embed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_Embed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).Embed(ctx, req.(*EmbedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LLMService_TwentyQSelectTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TwentyQSelectTopicRequest)
 	if err := dec(in); err != nil {
@@ -483,6 +613,24 @@ func _LLMService_TwentyQGenerateHints_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LLMService_TwentyQBatchGenerateHints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwentyQBatchGenerateHintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).TwentyQBatchGenerateHints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_TwentyQBatchGenerateHints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).TwentyQBatchGenerateHints(ctx, req.(*TwentyQBatchGenerateHintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LLMService_TwentyQAnswerQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TwentyQAnswerQuestionRequest)
 	if err := dec(in); err != nil {
@@ -555,6 +703,24 @@ func _LLMService_TwentyQCheckSynonym_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LLMService_TwentyQSummarizeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwentyQSummarizeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).TwentyQSummarizeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_TwentyQSummarizeGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).TwentyQSummarizeGame(ctx, req.(*TwentyQSummarizeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LLMService_TurtleSoupGeneratePuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TurtleSoupGeneratePuzzleRequest)
 	if err := dec(in); err != nil {
@@ -663,6 +829,24 @@ func _LLMService_TurtleSoupGenerateHint_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LLMService_TurtleSoupGenerateEpilogue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TurtleSoupGenerateEpilogueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).TurtleSoupGenerateEpilogue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_TurtleSoupGenerateEpilogue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).TurtleSoupGenerateEpilogue(ctx, req.(*TurtleSoupGenerateEpilogueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LLMService_GetDailyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -717,6 +901,60 @@ func _LLMService_GetTotalUsage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LLMService_GetSessionUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).GetSessionUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_GetSessionUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).GetSessionUsage(ctx, req.(*GetSessionUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMService_GetUsageByTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageByTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).GetUsageByTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_GetUsageByTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).GetUsageByTask(ctx, req.(*GetUsageByTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMService_GetQuotaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMServiceServer).GetQuotaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMService_GetQuotaStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMServiceServer).GetQuotaStatus(ctx, req.(*GetQuotaStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LLMService_ServiceDesc is the grpc.ServiceDesc for LLMService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -736,6 +974,10 @@ var LLMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EndSession",
 			Handler:    _LLMService_EndSession_Handler,
 		},
+		{
+			MethodName: "Embed",
+			Handler:    _LLMService_Embed_Handler,
+		},
 		{
 			MethodName: "TwentyQSelectTopic",
 			Handler:    _LLMService_TwentyQSelectTopic_Handler,
@@ -748,6 +990,10 @@ var LLMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TwentyQGenerateHints",
 			Handler:    _LLMService_TwentyQGenerateHints_Handler,
 		},
+		{
+			MethodName: "TwentyQBatchGenerateHints",
+			Handler:    _LLMService_TwentyQBatchGenerateHints_Handler,
+		},
 		{
 			MethodName: "TwentyQAnswerQuestion",
 			Handler:    _LLMService_TwentyQAnswerQuestion_Handler,
@@ -764,6 +1010,10 @@ var LLMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TwentyQCheckSynonym",
 			Handler:    _LLMService_TwentyQCheckSynonym_Handler,
 		},
+		{
+			MethodName: "TwentyQSummarizeGame",
+			Handler:    _LLMService_TwentyQSummarizeGame_Handler,
+		},
 		{
 			MethodName: "TurtleSoupGeneratePuzzle",
 			Handler:    _LLMService_TurtleSoupGeneratePuzzle_Handler,
@@ -788,6 +1038,10 @@ var LLMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TurtleSoupGenerateHint",
 			Handler:    _LLMService_TurtleSoupGenerateHint_Handler,
 		},
+		{
+			MethodName: "TurtleSoupGenerateEpilogue",
+			Handler:    _LLMService_TurtleSoupGenerateEpilogue_Handler,
+		},
 		{
 			MethodName: "GetDailyUsage",
 			Handler:    _LLMService_GetDailyUsage_Handler,
@@ -800,6 +1054,18 @@ var LLMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTotalUsage",
 			Handler:    _LLMService_GetTotalUsage_Handler,
 		},
+		{
+			MethodName: "GetSessionUsage",
+			Handler:    _LLMService_GetSessionUsage_Handler,
+		},
+		{
+			MethodName: "GetUsageByTask",
+			Handler:    _LLMService_GetUsageByTask_Handler,
+		},
+		{
+			MethodName: "GetQuotaStatus",
+			Handler:    _LLMService_GetQuotaStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "llm/v1/llm_service.proto",
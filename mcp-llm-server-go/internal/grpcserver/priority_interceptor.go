@@ -0,0 +1,30 @@
+package grpcserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+// llmPriorityHeader: 호출자가 LLM 대기열 우선순위를 지정하는 메타데이터 키 ("batch"면 일괄 호출, 없으면 interactive)
+const llmPriorityHeader = "x-llm-priority"
+
+// priorityInterceptor: x-llm-priority 메타데이터를 읽어 LLM 호출 우선순위를 컨텍스트에 저장합니다.
+func priorityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(llmPriorityHeader); len(values) > 0 {
+				ctx = llm.WithPriority(ctx, llm.ParsePriority(values[0]))
+			}
+		}
+		return handler(ctx, req)
+	}
+}
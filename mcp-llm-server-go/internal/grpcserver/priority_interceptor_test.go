@@ -0,0 +1,54 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+func TestPriorityInterceptor(t *testing.T) {
+	interceptor := priorityInterceptor()
+	capture := func(ctx context.Context) llm.Priority {
+		var got llm.Priority
+		_, _ = interceptor(ctx, nil, nil, func(ctx context.Context, _ any) (any, error) {
+			got = llm.PriorityFromContext(ctx)
+			return nil, nil
+		})
+		return got
+	}
+
+	if got := capture(context.Background()); got != llm.PriorityInteractive {
+		t.Fatalf("expected interactive without metadata, got %s", got)
+	}
+	batch := metadata.NewIncomingContext(context.Background(), metadata.Pairs(llmPriorityHeader, "batch"))
+	if got := capture(batch); got != llm.PriorityBatch {
+		t.Fatalf("expected batch from metadata, got %s", got)
+	}
+}
+
+func TestStatusFromError_QueueFullCarriesRetryInfo(t *testing.T) {
+	err := statusFromError(fmt.Errorf("hints: %w", &gemini.QueueFullError{Priority: llm.PriorityBatch, RetryAfter: 4 * time.Second}))
+
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %s", st.Code())
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			if got := info.GetRetryDelay().AsDuration(); got != 4*time.Second {
+				t.Fatalf("unexpected retry delay: %s", got)
+			}
+			return
+		}
+	}
+	t.Fatalf("expected RetryInfo detail, got %v", st.Details())
+}
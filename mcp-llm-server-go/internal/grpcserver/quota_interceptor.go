@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	llmv1 "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/pb/llm/v1"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+// quotaExemptMethods: LLM을 호출하지 않아 예산을 차감하지 않는 RPC입니다.
+var quotaExemptMethods = map[string]struct{}{
+	llmv1.LLMService_GetModelConfig_FullMethodName:       {},
+	llmv1.LLMService_GuardIsMalicious_FullMethodName:     {},
+	llmv1.LLMService_EndSession_FullMethodName:           {},
+	llmv1.LLMService_TwentyQGetCategories_FullMethodName: {},
+	llmv1.LLMService_GetDailyUsage_FullMethodName:        {},
+	llmv1.LLMService_GetRecentUsage_FullMethodName:       {},
+	llmv1.LLMService_GetTotalUsage_FullMethodName:        {},
+	llmv1.LLMService_GetQuotaStatus_FullMethodName:       {},
+}
+
+// quotaInterceptor: x-bot-id 메타데이터로 호출 봇을 식별해 컨텍스트에 저장하고, 일일 예산을 차감합니다.
+// 식별자가 없거나 관리 대상 봇이 아니면 제한 없이 통과시킨다.
+func quotaInterceptor(logger *slog.Logger, tracker *quota.Tracker) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		botID := extractBotID(ctx)
+		if botID == "" {
+			return handler(ctx, req)
+		}
+		ctx = quota.WithCaller(ctx, botID)
+
+		if info != nil {
+			if _, exempt := quotaExemptMethods[info.FullMethod]; exempt {
+				return handler(ctx, req)
+			}
+		}
+
+		if err := tracker.Acquire(botID); err != nil {
+			var exhausted *quota.ExhaustedError
+			if errors.As(err, &exhausted) && logger != nil {
+				logger.Warn("quota_exhausted",
+					"bot_id", exhausted.BotID,
+					"resource", exhausted.Resource,
+					"used", exhausted.Used,
+					"limit", exhausted.Limit,
+				)
+			}
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+func extractBotID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get("x-bot-id"); len(values) > 0 {
+		return quota.NormalizeBotID(strings.TrimSpace(values[0]))
+	}
+	return ""
+}
@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 )
 
 const (
@@ -35,7 +36,8 @@ type ctxKey string
 
 // NewServer: gRPC 서버와 listener들을 생성합니다.
 // TCP listener는 항상 생성되며, UDS listener는 SocketPath가 설정된 경우에만 생성됩니다.
-func NewServer(cfg *config.Config, logger *slog.Logger) (*grpc.Server, net.Listener, net.Listener, error) {
+// 인증을 통과한 요청만 봇별 할당량(tracker)을 차감합니다.
+func NewServer(cfg *config.Config, logger *slog.Logger, tracker *quota.Tracker) (*grpc.Server, net.Listener, net.Listener, error) {
 	host := defaultHost
 	port := defaultPort
 	enabled := true
@@ -97,6 +99,9 @@ func NewServer(cfg *config.Config, logger *slog.Logger) (*grpc.Server, net.Liste
 		grpc.MaxRecvMsgSize(maxRecvMsgSizeBytes),
 		grpc.ChainUnaryInterceptor(
 			unaryInterceptor(logger, apiKey, apiKeyRequired),
+			quotaInterceptor(logger, tracker),
+			usageInterceptor(logger),
+			priorityInterceptor(),
 			errorMapperInterceptor(),
 		),
 	}
@@ -0,0 +1,109 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
+)
+
+// usage trailer 키: RPC 한 건에서 발생한 LLM 사용량을 호출자에게 돌려줍니다.
+const (
+	usageAttributionHeader  = "x-usage-attribution"
+	usageTrailerInput       = "x-usage-input-tokens"
+	usageTrailerOutput      = "x-usage-output-tokens"
+	usageTrailerReasoning   = "x-usage-reasoning-tokens"
+	usageTrailerCalls       = "x-usage-calls"
+	usageTrailerModel       = "x-usage-model"
+	maxUsageAttributionSize = 128
+)
+
+// usageInterceptor: RPC마다 사용량 누적기를 붙이고, LLM 호출이 있었으면 trailer로 사용량을 보냅니다.
+// 호출자가 x-usage-attribution(게임 세션 등)을 보내면 로그에 함께 남깁니다.
+// 요청의 session_id(없으면 x-usage-attribution)와 RPC 이름을 사용량 라벨로 붙여 세션별/작업별 집계에 쓴다.
+func usageInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, acc := usage.WithCallUsage(ctx)
+		ctx = usage.WithLabels(ctx, usageLabels(ctx, req, info))
+		resp, err := handler(ctx, req)
+
+		snap := acc.Snapshot()
+		if snap.Calls == 0 {
+			return resp, err
+		}
+
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(
+			usageTrailerInput, strconv.FormatInt(snap.InputTokens, 10),
+			usageTrailerOutput, strconv.FormatInt(snap.OutputTokens, 10),
+			usageTrailerReasoning, strconv.FormatInt(snap.ReasoningTokens, 10),
+			usageTrailerCalls, strconv.FormatInt(snap.Calls, 10),
+			usageTrailerModel, snap.Model,
+		))
+
+		if logger != nil {
+			if attribution := extractUsageAttribution(ctx); attribution != "" {
+				method := ""
+				if info != nil {
+					method = info.FullMethod
+				}
+				logger.Debug("grpc_usage_attributed",
+					"attribution", attribution,
+					"method", method,
+					"input_tokens", snap.InputTokens,
+					"output_tokens", snap.OutputTokens,
+					"calls", snap.Calls,
+				)
+			}
+		}
+		return resp, err
+	}
+}
+
+func extractUsageAttribution(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(usageAttributionHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	value := strings.TrimSpace(values[0])
+	if len(value) > maxUsageAttributionSize {
+		value = value[:maxUsageAttributionSize]
+	}
+	return value
+}
+
+// sessionIDGetter: session_id 필드가 있는 요청 메시지 (생성된 getter)
+type sessionIDGetter interface {
+	GetSessionId() string
+}
+
+// usageLabels: 요청의 세션 ID(없으면 귀속 헤더)와 RPC 이름으로 사용량 라벨을 만듭니다.
+func usageLabels(ctx context.Context, req any, info *grpc.UnaryServerInfo) usage.Labels {
+	var labels usage.Labels
+	if getter, ok := req.(sessionIDGetter); ok {
+		labels.SessionID = strings.TrimSpace(getter.GetSessionId())
+	}
+	if labels.SessionID == "" {
+		labels.SessionID = extractUsageAttribution(ctx)
+	}
+	if len(labels.SessionID) > maxUsageAttributionSize {
+		labels.SessionID = labels.SessionID[:maxUsageAttributionSize]
+	}
+	if info != nil {
+		labels.Task = info.FullMethod[strings.LastIndexByte(info.FullMethod, '/')+1:]
+	}
+	return labels
+}
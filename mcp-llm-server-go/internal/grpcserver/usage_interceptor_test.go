@@ -0,0 +1,88 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	llmv1 "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/grpcserver/pb/llm/v1"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
+)
+
+// trailerStream: SetTrailer 결과를 확인하기 위한 ServerTransportStream
+type trailerStream struct {
+	trailer metadata.MD
+}
+
+func (s *trailerStream) Method() string               { return "/llm.v1.LLMService/TwentyQAnswerQuestion" }
+func (s *trailerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *trailerStream) SendHeader(metadata.MD) error { return nil }
+func (s *trailerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestUsageInterceptor(t *testing.T) {
+	interceptor := usageInterceptor(nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/llm.v1.LLMService/TwentyQAnswerQuestion"}
+
+	stream := &trailerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+		usage.AttributeCall(ctx, "gemini-2.5-flash", 1200, 40, 10)
+		usage.AttributeCall(ctx, "gemini-2.5-flash", 300, 10, 0)
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("interceptor error: %v", err)
+	}
+
+	want := map[string]string{
+		usageTrailerInput:     "1500",
+		usageTrailerOutput:    "50",
+		usageTrailerReasoning: "10",
+		usageTrailerCalls:     "2",
+		usageTrailerModel:     "gemini-2.5-flash",
+	}
+	for key, value := range want {
+		if got := stream.trailer.Get(key); len(got) != 1 || got[0] != value {
+			t.Errorf("trailer %s = %v, want %s", key, got, value)
+		}
+	}
+
+	// LLM 호출이 없으면 trailer를 붙이지 않음
+	empty := &trailerStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), empty)
+	if _, err := interceptor(ctx, nil, info, func(context.Context, any) (any, error) { return "ok", nil }); err != nil {
+		t.Fatalf("interceptor error: %v", err)
+	}
+	if len(empty.trailer) != 0 {
+		t.Fatalf("unexpected trailer without LLM calls: %v", empty.trailer)
+	}
+}
+
+func TestUsageLabels(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/llm.v1.LLMService/TwentyQAnswerQuestion"}
+	sessionID := " twentyq:room-1 "
+	attributed := metadata.NewIncomingContext(context.Background(), metadata.Pairs(usageAttributionHeader, "room-9"))
+
+	// 요청의 session_id가 귀속 헤더보다 우선
+	got := usageLabels(attributed, &llmv1.TwentyQAnswerQuestionRequest{SessionId: &sessionID}, info)
+	if want := (usage.Labels{SessionID: "twentyq:room-1", Task: "TwentyQAnswerQuestion"}); got != want {
+		t.Fatalf("usageLabels() = %+v, want %+v", got, want)
+	}
+
+	// session_id가 없으면 귀속 헤더 사용
+	got = usageLabels(attributed, &llmv1.TwentyQAnswerQuestionRequest{}, info)
+	if got.SessionID != "room-9" {
+		t.Fatalf("expected attribution fallback, got %+v", got)
+	}
+
+	// 세션 정보가 없으면 작업 라벨만
+	got = usageLabels(context.Background(), &llmv1.GuardIsMaliciousRequest{}, &grpc.UnaryServerInfo{FullMethod: "/llm.v1.LLMService/GuardIsMalicious"})
+	if want := (usage.Labels{Task: "GuardIsMalicious"}); got != want {
+		t.Fatalf("usageLabels() = %+v, want %+v", got, want)
+	}
+}
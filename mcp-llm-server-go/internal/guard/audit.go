@@ -0,0 +1,92 @@
+package guard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
+)
+
+// BlockEvent: 차단된 입력 한 건의 감사 기록입니다. 원문은 남기지 않고 해시와 길이만 담습니다.
+type BlockEvent struct {
+	At        time.Time
+	Caller    string // 호출 봇 (X-Bot-ID, 없으면 빈 문자열)
+	Profile   string // 적용된 프로필 이름 (호출 봇 전용 프로필이 없으면 default)
+	SessionID string // gRPC 사용량 라벨의 세션 (없으면 빈 문자열)
+	Task      string // gRPC 메서드 이름 (없으면 빈 문자열)
+	InputHash string // 원문 SHA-256 (hex)
+	InputLen  int    // 원문 글자(rune) 수
+	Score     float64
+	Threshold float64
+	Hits      []Match
+}
+
+// AuditSink: 차단 이벤트를 받아 기록합니다. 요청 경로에서 호출되므로 기다리지 않고 반환해야 합니다.
+type AuditSink interface {
+	RecordBlock(event BlockEvent)
+}
+
+// SetAuditSink: 차단 감사 기록 대상을 연결합니다. nil이면 기록하지 않습니다.
+// EnsureSafe/IsMalicious 차단만 기록하며, 관리 화면의 사전 평가(Evaluate)는 기록하지 않습니다.
+func (g *InjectionGuard) SetAuditSink(sink AuditSink) {
+	if g == nil {
+		return
+	}
+	g.profileMu.Lock()
+	g.audit = sink
+	g.profileMu.Unlock()
+}
+
+// HashInput: 감사 기록과 같은 방식으로 입력 원문의 해시를 계산합니다. (관리자가 제보받은 문장과 대조할 때 사용)
+func HashInput(input string) string {
+	sum := sha256.Sum256([]byte(input))
+	return hex.EncodeToString(sum[:])
+}
+
+// auditBlock: 차단된 평가를 감사 기록 대상으로 넘깁니다.
+func (g *InjectionGuard) auditBlock(ctx context.Context, profile string, input string, evaluation Evaluation) {
+	g.profileMu.RLock()
+	sink := g.audit
+	g.profileMu.RUnlock()
+	if sink == nil {
+		return
+	}
+
+	labels := usage.LabelsFromContext(ctx)
+	sink.RecordBlock(BlockEvent{
+		At:        time.Now(),
+		Caller:    quota.CallerFromContext(ctx),
+		Profile:   profile,
+		SessionID: labels.SessionID,
+		Task:      labels.Task,
+		InputHash: HashInput(input),
+		InputLen:  utf8.RuneCountInString(input),
+		Score:     evaluation.Score,
+		Threshold: evaluation.Threshold,
+		Hits:      slices.Clone(evaluation.Hits),
+	})
+}
+
+// AllowRules: 프로필 allowlist에 규칙 ID를 더해 저장합니다. (감사 기록에서 오탐으로 판정한 규칙을 바로 허용할 때 사용)
+// 아직 전용 프로필이 없는 호출 봇이면 지금까지 적용되던 default 프로필을 복사해 만듭니다.
+func (g *InjectionGuard) AllowRules(ctx context.Context, name string, rules []string) (Profile, error) {
+	status, ok := g.Profile(name)
+	if !ok {
+		return Profile{}, ErrProfileNotFound
+	}
+	profile := status.Profile
+	if !status.Stored && profile.Caller != DefaultProfile {
+		if fallback, stored := g.Profile(DefaultProfile); stored {
+			profile.Allowlist = fallback.Profile.Allowlist
+			profile.Denylist = fallback.Profile.Denylist
+			profile.Sensitivity = fallback.Profile.Sensitivity
+		}
+	}
+	profile.Allowlist = append(slices.Clone(profile.Allowlist), rules...)
+	return g.PutProfile(ctx, profile)
+}
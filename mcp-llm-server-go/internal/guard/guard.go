@@ -1,18 +1,23 @@
 package guard
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/singleflight"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/cache"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 )
 
 // InjectionGuard: 입력 문자열을 검사하는 보안 가드입니다.
@@ -22,6 +27,19 @@ type InjectionGuard struct {
 	packs  []compiledPack
 	cache  *cache.TTLCache[string, Evaluation]
 	group  singleflight.Group
+
+	// 호출 봇별 프로필: 캐시는 규칙팩 기본 평가만 보관하고 프로필은 조회 시점에 덧씌움
+	profileMu sync.RWMutex
+	profiles  map[string]Profile
+	store     ProfileStore
+	counters  map[string]*profileCounters
+	audit     AuditSink // 차단 감사 기록 (선택)
+
+	// 관리 API로 추가한 규칙과 공통 allowlist: 변경은 rulesMu로 직렬화하고 평가는 스냅샷을 읽음
+	rulesMu   sync.Mutex
+	runtime   atomic.Pointer[runtimeState]
+	ruleStore RuleStore
+	ruleHits  sync.Map // 적중 ID → *atomic.Int64 (서버 기동 이후 실제 요청 기준)
 }
 
 // NewGuard: 입력 검증 가드를 생성합니다.
@@ -35,6 +53,12 @@ func NewGuard(cfg *config.Config, logger *slog.Logger) (*InjectionGuard, error)
 		cfg:    cfg,
 		logger: logger,
 		cache:  cache.NewTTLCache[string, Evaluation](cfg.Guard.CacheMaxSize, cacheTTL),
+
+		profiles: make(map[string]Profile),
+		counters: make(map[string]*profileCounters, len(quota.Bots)+1),
+	}
+	for _, name := range append([]string{DefaultProfile}, quota.Bots...) {
+		guard.counters[name] = &profileCounters{}
 	}
 
 	if cfg.Guard.Enabled {
@@ -44,19 +68,50 @@ func NewGuard(cfg *config.Config, logger *slog.Logger) (*InjectionGuard, error)
 	return guard, nil
 }
 
-// Evaluate: 입력 문자열을 평가합니다.
-func (g *InjectionGuard) Evaluate(input string) Evaluation {
+// Evaluate: 입력 문자열을 평가합니다. 컨텍스트의 호출 봇(quota.CallerFromContext)에 해당하는 프로필을 적용합니다.
+func (g *InjectionGuard) Evaluate(ctx context.Context, input string) Evaluation {
 	if g == nil || g.cfg == nil || !g.cfg.Guard.Enabled {
 		return Evaluation{Score: 0, Hits: nil, Threshold: math.Inf(1)}
 	}
 
-	if cached, ok := g.cache.Get(input); ok {
+	_, evaluation := g.evaluate(ctx, input)
+	return evaluation
+}
+
+// evaluate: 프로필을 적용해 평가하고, 적용된 프로필 이름과 결과를 반환합니다.
+func (g *InjectionGuard) evaluate(ctx context.Context, input string) (string, Evaluation) {
+	name, profile := g.profileFor(ctx)
+	base := g.applyAllowlist(g.evaluateBase(input), input)
+	evaluation := profile.apply(base, input)
+	g.recordProfile(name, evaluation.Malicious())
+	return name, evaluation
+}
+
+// evaluateAudited: 실제 요청 입력을 평가하고 차단되면 감사 기록을 남깁니다.
+func (g *InjectionGuard) evaluateAudited(ctx context.Context, input string) Evaluation {
+	if g == nil || g.cfg == nil || !g.cfg.Guard.Enabled {
+		return Evaluation{Score: 0, Hits: nil, Threshold: math.Inf(1)}
+	}
+
+	name, evaluation := g.evaluate(ctx, input)
+	g.recordRuleHits(evaluation.Hits)
+	if evaluation.Malicious() {
+		g.auditBlock(ctx, name, input, evaluation)
+	}
+	return evaluation
+}
+
+// evaluateBase: 프로필과 무관한 규칙팩 평가 결과를 캐시와 함께 반환합니다.
+// 캐시 키에 런타임 규칙 세대를 붙여 규칙이 바뀌면 이전 결과를 쓰지 않습니다.
+func (g *InjectionGuard) evaluateBase(input string) Evaluation {
+	key := strconv.FormatUint(g.runtimeGeneration(), 10) + "\x00" + input
+	if cached, ok := g.cache.Get(key); ok {
 		return cached
 	}
 
-	value, _, _ := g.group.Do(input, func() (any, error) {
+	value, _, _ := g.group.Do(key, func() (any, error) {
 		result := g.evaluateInternal(input)
-		g.cache.Set(input, result)
+		g.cache.Set(key, result)
 		return result, nil
 	})
 
@@ -67,8 +122,8 @@ func (g *InjectionGuard) Evaluate(input string) Evaluation {
 }
 
 // EnsureSafe: 위험 입력을 오류로 반환합니다.
-func (g *InjectionGuard) EnsureSafe(input string) error {
-	evaluation := g.Evaluate(input)
+func (g *InjectionGuard) EnsureSafe(ctx context.Context, input string) error {
+	evaluation := g.evaluateAudited(ctx, input)
 	if evaluation.Malicious() {
 		return &BlockedError{Score: evaluation.Score, Threshold: evaluation.Threshold}
 	}
@@ -76,8 +131,8 @@ func (g *InjectionGuard) EnsureSafe(input string) error {
 }
 
 // IsMalicious: 입력이 위험한지 여부를 반환합니다.
-func (g *InjectionGuard) IsMalicious(input string) bool {
-	return g.Evaluate(input).Malicious()
+func (g *InjectionGuard) IsMalicious(ctx context.Context, input string) bool {
+	return g.evaluateAudited(ctx, input).Malicious()
 }
 
 func (g *InjectionGuard) loadRulepacks() {
@@ -131,6 +186,11 @@ func (g *InjectionGuard) threshold() float64 {
 }
 
 func (g *InjectionGuard) evaluateInternal(input string) Evaluation {
+	return g.evaluateWith(input, g.activePacks())
+}
+
+// evaluateWith: 내장 검사 후 주어진 규칙 묶음으로 점수를 계산합니다.
+func (g *InjectionGuard) evaluateWith(input string, packs []compiledPack) Evaluation {
 	threshold := g.threshold()
 
 	if isJamoOnly(input) {
@@ -171,16 +231,16 @@ func (g *InjectionGuard) evaluateInternal(input string) Evaluation {
 	// 2. Homoglyph + NFKC 정규화
 	composed := composeJamoSequences(input)
 	normalized := normalizeText(composed)
-	score, hits := g.evaluatePacks(normalized)
+	score, hits := evaluatePacks(packs, normalized)
 	return Evaluation{Score: score, Hits: hits, Threshold: threshold}
 }
 
-func (g *InjectionGuard) evaluatePacks(text string) (float64, []Match) {
+func evaluatePacks(packs []compiledPack, text string) (float64, []Match) {
 	total := 0.0
 	hits := make([]Match, 0)
 	textLower := strings.ToLower(text)
 
-	for _, pack := range g.packs {
+	for _, pack := range packs {
 		for _, rule := range pack.RegexRules {
 			if rule.Pattern.MatchString(text) {
 				total += rule.Weight
@@ -1,6 +1,7 @@
 package guard
 
 import (
+	"context"
 	"io"
 	"log/slog"
 	"os"
@@ -34,15 +35,15 @@ func TestGuardEvaluateAndEnsureSafe(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	evaluation := guard.Evaluate("evil payload")
+	evaluation := guard.Evaluate(context.Background(), "evil payload")
 	if !evaluation.Malicious() {
 		t.Fatalf("expected malicious evaluation")
 	}
-	if err := guard.EnsureSafe("evil payload"); err == nil {
+	if err := guard.EnsureSafe(context.Background(), "evil payload"); err == nil {
 		t.Fatalf("expected blocked error")
 	}
 
-	safeEval := guard.Evaluate("hello")
+	safeEval := guard.Evaluate(context.Background(), "hello")
 	if safeEval.Malicious() {
 		t.Fatalf("expected safe evaluation")
 	}
@@ -62,7 +63,7 @@ func TestGuardDisabled(t *testing.T) {
 	}
 
 	// Guard 비활성화 시 모든 입력이 safe
-	eval := guard.Evaluate("evil payload base64 jailbreak")
+	eval := guard.Evaluate(context.Background(), "evil payload base64 jailbreak")
 	if eval.Malicious() {
 		t.Errorf("disabled guard should not block any input")
 	}
@@ -97,9 +98,9 @@ func TestGuardCaching(t *testing.T) {
 	guard, _ := NewGuard(cfg, nil)
 
 	// 첫 번째 호출
-	eval1 := guard.Evaluate("evil payload")
+	eval1 := guard.Evaluate(context.Background(), "evil payload")
 	// 두 번째 호출 (캐시 히트)
-	eval2 := guard.Evaluate("evil payload")
+	eval2 := guard.Evaluate(context.Background(), "evil payload")
 
 	if eval1.Score != eval2.Score {
 		t.Errorf("cached result should match: got %f vs %f", eval1.Score, eval2.Score)
@@ -164,7 +165,7 @@ func TestGuardPreChecks(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			eval := guard.Evaluate(tc.input)
+			eval := guard.Evaluate(context.Background(), tc.input)
 			if tc.wantBlock != eval.Malicious() {
 				t.Errorf("Evaluate(%q) malicious=%v, want %v", tc.input, eval.Malicious(), tc.wantBlock)
 			}
@@ -239,7 +240,7 @@ rules:
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			eval := guard.Evaluate(tc.input)
+			eval := guard.Evaluate(context.Background(), tc.input)
 			if tc.wantBlock != eval.Malicious() {
 				t.Errorf("Evaluate(%q) malicious=%v, want %v (score=%.2f, threshold=%.2f)",
 					tc.input, eval.Malicious(), tc.wantBlock, eval.Score, eval.Threshold)
@@ -304,7 +305,7 @@ rules:
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			eval := guard.Evaluate(tc.input)
+			eval := guard.Evaluate(context.Background(), tc.input)
 			if tc.wantBlock != eval.Malicious() {
 				t.Errorf("Evaluate(%q) malicious=%v, want %v", tc.input, eval.Malicious(), tc.wantBlock)
 			}
@@ -332,10 +333,10 @@ func TestIsMalicious(t *testing.T) {
 
 	guard, _ := NewGuard(cfg, nil)
 
-	if !guard.IsMalicious("evil input") {
+	if !guard.IsMalicious(context.Background(), "evil input") {
 		t.Errorf("IsMalicious should return true for evil input")
 	}
-	if guard.IsMalicious("safe input") {
+	if guard.IsMalicious(context.Background(), "safe input") {
 		t.Errorf("IsMalicious should return false for safe input")
 	}
 }
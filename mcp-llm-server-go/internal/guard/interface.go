@@ -1,16 +1,18 @@
 package guard
 
+import "context"
+
 // Guard: 입력 검증 인터페이스입니다.
 // 테스트에서 mock 구현을 주입할 수 있습니다.
 type Guard interface {
-	// Evaluate 입력 문자열 평가
-	Evaluate(input string) Evaluation
+	// Evaluate 입력 문자열 평가 (호출 봇 프로필 적용)
+	Evaluate(ctx context.Context, input string) Evaluation
 
 	// EnsureSafe 위험 입력을 에러로 반환
-	EnsureSafe(input string) error
+	EnsureSafe(ctx context.Context, input string) error
 
 	// IsMalicious 입력이 위험한지 여부
-	IsMalicious(input string) bool
+	IsMalicious(ctx context.Context, input string) bool
 }
 
 // InjectionGuard가 Guard 인터페이스를 구현하는지 컴파일 타임 확인
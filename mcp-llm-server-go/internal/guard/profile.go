@@ -0,0 +1,307 @@
+package guard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+// DefaultProfile: 호출 봇을 알 수 없거나 전용 프로필이 없을 때 적용하는 프로필 이름입니다.
+const DefaultProfile = "default"
+
+// 민감도 허용 범위 (0이면 1.0으로 간주)
+const (
+	minSensitivity = 0.1
+	maxSensitivity = 10
+)
+
+var (
+	// ErrProfileNotFound: 프로필 미존재 오류입니다.
+	ErrProfileNotFound = errors.New("guard profile not found")
+	// ErrInvalidProfile: 프로필 값 검증 오류입니다.
+	ErrInvalidProfile = errors.New("invalid guard profile")
+)
+
+// Profile: 호출 봇별 가드 조정값입니다.
+// 규칙팩 평가 결과에 덧씌워 적용되므로 규칙팩을 봇마다 따로 둘 필요가 없습니다.
+type Profile struct {
+	Caller string `json:"caller"`
+	// Allowlist: 점수에서 제외할 규칙 ID (예: "r1", "emoji_detected") 또는 phrase 규칙의 문구
+	Allowlist []string `json:"allowlist"`
+	// Denylist: 정규화된 입력에 포함되면 점수와 무관하게 차단하는 문구
+	Denylist []string `json:"denylist"`
+	// Sensitivity: 임계값을 나누는 배율. 1보다 크면 더 엄격하고, 작으면 더 관대합니다.
+	Sensitivity float64   `json:"sensitivity"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ProfileStats: 프로필별 평가/차단 누계입니다.
+type ProfileStats struct {
+	Evaluations int64 `json:"evaluations"`
+	Blocked     int64 `json:"blocked"`
+}
+
+// ProfileStatus: 관리 API 응답용 프로필과 누계입니다. 저장된 프로필이 없으면 Stored가 false입니다.
+type ProfileStatus struct {
+	Profile
+	Stored bool         `json:"stored"`
+	Stats  ProfileStats `json:"stats"`
+}
+
+// ProfileStore: 프로필 영속화 저장소입니다. session.Store가 Valkey 해시로 구현합니다.
+type ProfileStore interface {
+	LoadGuardProfiles(ctx context.Context) (map[string]string, error)
+	SaveGuardProfile(ctx context.Context, caller string, data string) error
+	DeleteGuardProfile(ctx context.Context, caller string) error
+}
+
+type profileCounters struct {
+	evaluations atomic.Int64
+	blocked     atomic.Int64
+}
+
+// NormalizeProfileName: 프로필 이름을 정규화합니다. 관리 대상 봇 또는 default만 허용하며, 그 외는 빈 문자열입니다.
+func NormalizeProfileName(raw string) string {
+	name := strings.ToLower(strings.TrimSpace(raw))
+	if name == DefaultProfile {
+		return name
+	}
+	return quota.NormalizeBotID(name)
+}
+
+// normalize: 목록을 소문자/중복 제거로 정리하고 민감도 범위를 검증합니다.
+func (p Profile) normalize() (Profile, error) {
+	p.Caller = NormalizeProfileName(p.Caller)
+	if p.Caller == "" {
+		return Profile{}, fmt.Errorf("%w: caller must be one of %s, %s", ErrInvalidProfile, strings.Join(quota.Bots, ", "), DefaultProfile)
+	}
+	if p.Sensitivity == 0 {
+		p.Sensitivity = 1
+	}
+	if p.Sensitivity < minSensitivity || p.Sensitivity > maxSensitivity {
+		return Profile{}, fmt.Errorf("%w: sensitivity must be between %.1f and %.0f", ErrInvalidProfile, float64(minSensitivity), float64(maxSensitivity))
+	}
+	p.Allowlist = normalizeTerms(p.Allowlist, false)
+	p.Denylist = normalizeTerms(p.Denylist, true)
+	return p, nil
+}
+
+// normalizeTerms: 입력 정규화(normalizeText)와 같은 기준으로 맞춰야 denylist가 우회되지 않습니다.
+func normalizeTerms(terms []string, asText bool) []string {
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if asText {
+			term = normalizeText(composeJamoSequences(term))
+		}
+		term = strings.ToLower(strings.TrimSpace(term))
+		if term != "" && !slices.Contains(out, term) {
+			out = append(out, term)
+		}
+	}
+	return out
+}
+
+func (p Profile) isNeutral() bool {
+	return len(p.Allowlist) == 0 && len(p.Denylist) == 0 && (p.Sensitivity == 0 || p.Sensitivity == 1)
+}
+
+// apply: 기본 평가 결과에 프로필을 적용합니다.
+// allowlist 규칙은 점수에서 빼고, denylist 문구가 있으면 임계값만큼 가산해 차단을 보장합니다.
+func (p Profile) apply(base Evaluation, input string) Evaluation {
+	if p.isNeutral() {
+		return base
+	}
+
+	threshold := base.Threshold
+	if p.Sensitivity > 0 {
+		threshold /= p.Sensitivity
+	}
+
+	score := 0.0
+	hits := make([]Match, 0, len(base.Hits))
+	for _, hit := range base.Hits {
+		if p.allows(hit.ID) {
+			continue
+		}
+		score += hit.Weight
+		hits = append(hits, hit)
+	}
+
+	if len(p.Denylist) > 0 {
+		text := strings.ToLower(normalizeText(composeJamoSequences(input)))
+		for _, term := range p.Denylist {
+			if strings.Contains(text, term) {
+				score += threshold
+				hits = append(hits, Match{ID: "deny:" + term, Weight: threshold})
+			}
+		}
+	}
+
+	return Evaluation{Score: score, Hits: hits, Threshold: threshold}
+}
+
+func (p Profile) allows(id string) bool {
+	id = strings.ToLower(id)
+	phrase, isPhrase := strings.CutPrefix(id, "phrase:")
+	for _, entry := range p.Allowlist {
+		if entry == id || (isPhrase && entry == phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// profileFor: 호출자에게 적용할 프로필과 그 이름을 반환합니다.
+func (g *InjectionGuard) profileFor(ctx context.Context) (string, Profile) {
+	caller := quota.CallerFromContext(ctx)
+
+	g.profileMu.RLock()
+	defer g.profileMu.RUnlock()
+	if profile, ok := g.profiles[caller]; ok && caller != "" {
+		return caller, profile
+	}
+	return DefaultProfile, g.profiles[DefaultProfile]
+}
+
+func (g *InjectionGuard) recordProfile(name string, blocked bool) {
+	counters := g.counters[name]
+	if counters == nil {
+		return
+	}
+	counters.evaluations.Add(1)
+	if blocked {
+		counters.blocked.Add(1)
+	}
+}
+
+// AttachProfileStore: 프로필 저장소를 연결하고 저장된 프로필을 불러옵니다.
+// 불러오기에 실패해도 저장소는 연결된 상태로 두어 이후 관리 API 변경은 저장되며, 해석할 수 없는 항목은 건너뜁니다.
+func (g *InjectionGuard) AttachProfileStore(ctx context.Context, store ProfileStore) error {
+	g.profileMu.Lock()
+	g.store = store
+	g.profileMu.Unlock()
+
+	raw, err := store.LoadGuardProfiles(ctx)
+	if err != nil {
+		return fmt.Errorf("load guard profiles: %w", err)
+	}
+
+	loaded := make(map[string]Profile, len(raw))
+	for caller, data := range raw {
+		var profile Profile
+		if err := json.Unmarshal([]byte(data), &profile); err != nil {
+			g.warn("guard_profile_skipped", "caller", caller, "err", err)
+			continue
+		}
+		profile.Caller = caller
+		normalized, err := profile.normalize()
+		if err != nil {
+			g.warn("guard_profile_skipped", "caller", caller, "err", err)
+			continue
+		}
+		loaded[normalized.Caller] = normalized
+	}
+
+	g.profileMu.Lock()
+	g.profiles = loaded
+	g.profileMu.Unlock()
+
+	if g.logger != nil {
+		g.logger.Info("guard_profiles_loaded", "count", len(loaded))
+	}
+	return nil
+}
+
+// Profiles: 모든 프로필 이름(default와 관리 대상 봇)의 현재 설정과 누계를 반환합니다.
+func (g *InjectionGuard) Profiles() []ProfileStatus {
+	names := append([]string{DefaultProfile}, quota.Bots...)
+	statuses := make([]ProfileStatus, 0, len(names))
+	for _, name := range names {
+		status, _ := g.Profile(name)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Profile: 단일 프로필의 설정과 누계를 반환합니다. 이름이 유효하지 않으면 false입니다.
+func (g *InjectionGuard) Profile(name string) (ProfileStatus, bool) {
+	name = NormalizeProfileName(name)
+	if name == "" {
+		return ProfileStatus{}, false
+	}
+
+	g.profileMu.RLock()
+	profile, stored := g.profiles[name]
+	g.profileMu.RUnlock()
+	if !stored {
+		profile = Profile{Caller: name, Allowlist: []string{}, Denylist: []string{}, Sensitivity: 1}
+	}
+
+	status := ProfileStatus{Profile: profile, Stored: stored}
+	if counters := g.counters[name]; counters != nil {
+		status.Stats = ProfileStats{
+			Evaluations: counters.evaluations.Load(),
+			Blocked:     counters.blocked.Load(),
+		}
+	}
+	return status, true
+}
+
+// PutProfile: 프로필을 검증해 저장하고 즉시 적용합니다.
+func (g *InjectionGuard) PutProfile(ctx context.Context, profile Profile) (Profile, error) {
+	normalized, err := profile.normalize()
+	if err != nil {
+		return Profile{}, err
+	}
+	normalized.UpdatedAt = time.Now().UTC()
+
+	g.profileMu.Lock()
+	defer g.profileMu.Unlock()
+
+	if g.store != nil {
+		data, err := json.Marshal(normalized)
+		if err != nil {
+			return Profile{}, fmt.Errorf("marshal guard profile: %w", err)
+		}
+		if err := g.store.SaveGuardProfile(ctx, normalized.Caller, string(data)); err != nil {
+			return Profile{}, err
+		}
+	}
+
+	g.profiles[normalized.Caller] = normalized
+	return normalized, nil
+}
+
+// DeleteProfile: 프로필을 삭제해 기본 동작으로 되돌립니다.
+func (g *InjectionGuard) DeleteProfile(ctx context.Context, name string) error {
+	name = NormalizeProfileName(name)
+
+	g.profileMu.Lock()
+	defer g.profileMu.Unlock()
+
+	if _, ok := g.profiles[name]; !ok {
+		return ErrProfileNotFound
+	}
+	if g.store != nil {
+		if err := g.store.DeleteGuardProfile(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	delete(g.profiles, name)
+	return nil
+}
+
+func (g *InjectionGuard) warn(msg string, args ...any) {
+	if g.logger != nil {
+		g.logger.Warn(msg, args...)
+	}
+}
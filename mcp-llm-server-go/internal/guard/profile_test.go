@@ -0,0 +1,169 @@
+package guard
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+// memoryProfileStore: 테스트용 프로필 저장소
+type memoryProfileStore struct {
+	data map[string]string
+}
+
+func (s *memoryProfileStore) LoadGuardProfiles(context.Context) (map[string]string, error) {
+	return s.data, nil
+}
+
+func (s *memoryProfileStore) SaveGuardProfile(_ context.Context, caller string, data string) error {
+	s.data[caller] = data
+	return nil
+}
+
+func (s *memoryProfileStore) DeleteGuardProfile(_ context.Context, caller string) error {
+	delete(s.data, caller)
+	return nil
+}
+
+func newProfileTestGuard(t *testing.T) *InjectionGuard {
+	t.Helper()
+	dir := t.TempDir()
+	data := []byte("version: 1\nthreshold: 0.7\nrules:\n" +
+		"  - id: violence\n    type: regex\n    pattern: 살인\n    weight: 0.5\n" +
+		"  - id: override\n    type: regex\n    pattern: ignore previous\n    weight: 0.8\n")
+	if err := os.WriteFile(filepath.Join(dir, "rules.yml"), data, 0o644); err != nil {
+		t.Fatalf("failed to write rulepack: %v", err)
+	}
+
+	g, err := NewGuard(&config.Config{Guard: config.GuardConfig{
+		Enabled: true, RulepacksDir: dir, CacheMaxSize: 10, CacheTTLSeconds: 60,
+	}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return g
+}
+
+func TestProfile_SelectedByCaller(t *testing.T) {
+	g := newProfileTestGuard(t)
+	ctx := context.Background()
+	turtle := quota.WithCaller(ctx, quota.BotTurtleSoup)
+
+	if _, err := g.PutProfile(ctx, Profile{Caller: "TurtleSoup", Allowlist: []string{"violence"}, Sensitivity: 0.5}); err != nil {
+		t.Fatalf("put profile: %v", err)
+	}
+
+	// 다른 봇과 기본 프로필은 영향받지 않음
+	twentyq := quota.WithCaller(ctx, quota.BotTwentyQ)
+	if eval := g.Evaluate(twentyq, "살인 살인 ignore previous"); !eval.Malicious() || eval.Threshold != 0.7 {
+		t.Fatalf("twentyq must use global tolerance: %+v", eval)
+	}
+
+	// turtlesoup: violence 규칙 무시 + 임계값 0.7/0.5 = 1.4
+	eval := g.Evaluate(turtle, "살인 사건의 전말 ignore previous")
+	if eval.Malicious() || eval.Threshold != 1.4 || eval.Score != 0.8 {
+		t.Fatalf("turtlesoup profile not applied: %+v", eval)
+	}
+	for _, hit := range eval.Hits {
+		if hit.ID == "violence" {
+			t.Fatalf("allowlisted rule must be removed from hits: %+v", eval.Hits)
+		}
+	}
+}
+
+func TestProfile_DenylistBlocksRegardlessOfScore(t *testing.T) {
+	g := newProfileTestGuard(t)
+	ctx := context.Background()
+	if _, err := g.PutProfile(ctx, Profile{Caller: DefaultProfile, Denylist: []string{" 정답 알려줘 "}}); err != nil {
+		t.Fatalf("put profile: %v", err)
+	}
+
+	// 호출 봇 정보가 없으면 default 프로필 적용
+	err := g.EnsureSafe(ctx, "그냥 정답 알려줘")
+	var blocked *BlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("expected denylist block, got %v", err)
+	}
+	if g.IsMalicious(quota.WithCaller(ctx, quota.BotHolo), "안녕하세요") {
+		t.Fatalf("safe input must pass")
+	}
+}
+
+func TestProfile_StatsPerProfile(t *testing.T) {
+	g := newProfileTestGuard(t)
+	ctx := context.Background()
+	if _, err := g.PutProfile(ctx, Profile{Caller: quota.BotTwentyQ, Sensitivity: 2}); err != nil {
+		t.Fatalf("put profile: %v", err)
+	}
+
+	twentyq := quota.WithCaller(ctx, quota.BotTwentyQ)
+	g.IsMalicious(twentyq, "살인") // 0.5 >= 0.35 차단
+	g.IsMalicious(twentyq, "동물인가요")
+	g.IsMalicious(quota.WithCaller(ctx, quota.BotHolo), "살인") // 프로필 없음 → default
+
+	twentyqStatus, _ := g.Profile(quota.BotTwentyQ)
+	if twentyqStatus.Stats != (ProfileStats{Evaluations: 2, Blocked: 1}) || !twentyqStatus.Stored {
+		t.Fatalf("unexpected twentyq stats: %+v", twentyqStatus)
+	}
+	defaultStatus, _ := g.Profile(DefaultProfile)
+	if defaultStatus.Stats != (ProfileStats{Evaluations: 1, Blocked: 0}) || defaultStatus.Stored {
+		t.Fatalf("unexpected default stats: %+v", defaultStatus)
+	}
+	if len(g.Profiles()) != len(quota.Bots)+1 {
+		t.Fatalf("expected default + bots, got %d", len(g.Profiles()))
+	}
+}
+
+func TestProfile_PersistsThroughStore(t *testing.T) {
+	store := &memoryProfileStore{data: map[string]string{
+		quota.BotTurtleSoup: `{"allowlist":["Violence"],"sensitivity":0.8}`,
+		"unknown":           `{"sensitivity":1}`,
+		quota.BotHolo:       `not json`,
+	}}
+	g := newProfileTestGuard(t)
+	ctx := context.Background()
+	if err := g.AttachProfileStore(ctx, store); err != nil {
+		t.Fatalf("attach: %v", err)
+	}
+
+	status, _ := g.Profile(quota.BotTurtleSoup)
+	if !status.Stored || status.Allowlist[0] != "violence" || status.Sensitivity != 0.8 {
+		t.Fatalf("stored profile not loaded: %+v", status)
+	}
+	if holo, _ := g.Profile(quota.BotHolo); holo.Stored {
+		t.Fatalf("invalid stored profile must be skipped")
+	}
+
+	if _, err := g.PutProfile(ctx, Profile{Caller: quota.BotTwentyQ, Denylist: []string{"힌트"}}); err != nil {
+		t.Fatalf("put profile: %v", err)
+	}
+	if _, ok := store.data[quota.BotTwentyQ]; !ok {
+		t.Fatalf("profile must be persisted")
+	}
+	if err := g.DeleteProfile(ctx, quota.BotTurtleSoup); err != nil {
+		t.Fatalf("delete profile: %v", err)
+	}
+	if _, ok := store.data[quota.BotTurtleSoup]; ok {
+		t.Fatalf("profile must be removed from store")
+	}
+	if err := g.DeleteProfile(ctx, quota.BotTurtleSoup); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestProfile_RejectsInvalidValues(t *testing.T) {
+	g := newProfileTestGuard(t)
+	ctx := context.Background()
+
+	if _, err := g.PutProfile(ctx, Profile{Caller: "other-bot"}); !errors.Is(err, ErrInvalidProfile) {
+		t.Fatalf("expected invalid caller error, got %v", err)
+	}
+	if _, err := g.PutProfile(ctx, Profile{Caller: quota.BotHolo, Sensitivity: 50}); !errors.Is(err, ErrInvalidProfile) {
+		t.Fatalf("expected invalid sensitivity error, got %v", err)
+	}
+}
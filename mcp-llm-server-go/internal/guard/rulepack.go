@@ -39,6 +39,7 @@ type compiledPack struct {
 	PhraseMatcher *ahocorasick.Matcher
 	Phrases       []string
 	PhraseWeights map[string]float64
+	Rules         []RuleStatus // 관리 API 목록용 규칙 설명 (적중 수 제외)
 }
 
 func loadRulepacks(dir string, logger *slog.Logger) []compiledPack {
@@ -106,6 +107,7 @@ func compileRulepack(raw rawRulepack, logger *slog.Logger) (compiledPack, error)
 	var regexes []regexRule
 	phrases := make([]string, 0)
 	phraseWeights := make(map[string]float64)
+	rules := make([]RuleStatus, 0, len(raw.Rules))
 
 	for _, rule := range raw.Rules {
 		switch strings.ToLower(strings.TrimSpace(rule.Type)) {
@@ -125,6 +127,7 @@ func compileRulepack(raw rawRulepack, logger *slog.Logger) (compiledPack, error)
 				Pattern: pattern,
 				Weight:  rule.Weight,
 			})
+			rules = append(rules, RuleStatus{ID: rule.ID, Type: RuleTypeRegex, Pattern: rule.Pattern, Weight: rule.Weight})
 		case "phrases":
 			if rule.ID == "" || len(rule.Phrases) == 0 {
 				return compiledPack{}, fmt.Errorf("invalid phrases rule")
@@ -134,6 +137,7 @@ func compileRulepack(raw rawRulepack, logger *slog.Logger) (compiledPack, error)
 				phrases = append(phrases, value)
 				phraseWeights[value] = rule.Weight
 			}
+			rules = append(rules, RuleStatus{ID: rule.ID, Type: RuleTypePhrases, Phrases: rule.Phrases, Weight: rule.Weight})
 		default:
 			return compiledPack{}, fmt.Errorf("unknown rule type: %s", rule.Type)
 		}
@@ -154,5 +158,6 @@ func compileRulepack(raw rawRulepack, logger *slog.Logger) (compiledPack, error)
 		PhraseMatcher: matcher,
 		Phrases:       phrases,
 		PhraseWeights: phraseWeights,
+		Rules:         rules,
 	}, nil
 }
@@ -0,0 +1,508 @@
+package guard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// 규칙 유형 (규칙팩 YAML의 type과 같음)
+const (
+	RuleTypeRegex   = "regex"
+	RuleTypePhrases = "phrases"
+)
+
+// 규칙 출처
+const (
+	RuleSourceBuiltin  = "builtin"  // 코드에 내장된 검사 (자모, 이모지, base64)
+	RuleSourceRulepack = "rulepack" // RULEPACKS_DIR의 YAML
+	RuleSourceRuntime  = "runtime"  // 관리 API로 추가 (Valkey 보관)
+)
+
+const (
+	maxRuleWeight        = 10
+	maxRulePatternLength = 512
+	maxAllowEntryLength  = 200
+)
+
+var (
+	// ErrRuleNotFound: 런타임 규칙 미존재 오류입니다.
+	ErrRuleNotFound = errors.New("guard rule not found")
+	// ErrInvalidRule: 런타임 규칙 값 검증 오류입니다.
+	ErrInvalidRule = errors.New("invalid guard rule")
+	// ErrAllowEntryNotFound: 공통 allowlist 항목 미존재 오류입니다.
+	ErrAllowEntryNotFound = errors.New("guard allowlist entry not found")
+
+	ruleIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+	// builtinRuleIDs: evaluateInternal이 규칙팩 전에 내는 적중 ID
+	builtinRuleIDs = []string{"jamo_only", "emoji_detected", "base64_payload"}
+)
+
+// RuntimeRule: 재배포 없이 관리 API로 추가하는 가드 규칙입니다. 규칙팩 규칙과 같은 방식으로 점수에 더해집니다.
+type RuntimeRule struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Pattern   string    `json:"pattern,omitempty"` // regex: 대소문자 무시, 정규화된 입력에 적용
+	Phrases   []string  `json:"phrases,omitempty"` // phrases: 정규화된 입력에 포함되면 적중 (적중 ID는 "phrase:<문구>")
+	Weight    float64   `json:"weight"`
+	Note      string    `json:"note,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AllowEntry: 모든 프로필에 공통 적용하는 allowlist 항목입니다. (규칙 ID 또는 phrase 문구)
+type AllowEntry struct {
+	Entry     string    `json:"entry"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RuleStatus: 관리 API 응답용 규칙 설명과 서버 기동 이후 실제 요청 적중 수입니다.
+type RuleStatus struct {
+	ID        string     `json:"id"`
+	Source    string     `json:"source"`
+	Type      string     `json:"type,omitempty"`
+	Pattern   string     `json:"pattern,omitempty"`
+	Phrases   []string   `json:"phrases,omitempty"`
+	Weight    float64    `json:"weight"`
+	Note      string     `json:"note,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Hits      int64      `json:"hits"`
+}
+
+// RuleStore: 런타임 규칙과 공통 allowlist 영속화 저장소입니다. session.Store가 Valkey 해시로 구현합니다.
+type RuleStore interface {
+	LoadGuardRules(ctx context.Context) (map[string]string, error)
+	SaveGuardRule(ctx context.Context, id string, data string) error
+	DeleteGuardRule(ctx context.Context, id string) error
+	LoadGuardAllowlist(ctx context.Context) (map[string]string, error)
+	SaveGuardAllowlist(ctx context.Context, entry string, data string) error
+	DeleteGuardAllowlist(ctx context.Context, entry string) error
+}
+
+// runtimeState: 평가 경로가 잠금 없이 읽는 런타임 규칙 스냅샷입니다. 변경 시 통째로 교체합니다.
+type runtimeState struct {
+	generation uint64 // 평가 캐시 키에 붙여 규칙 변경 전 결과를 재사용하지 않음
+	rules      map[string]RuntimeRule
+	pack       *compiledPack
+	allow      map[string]AllowEntry
+	allowlist  Profile // 공통 allowlist를 프로필 적용 로직으로 처리하기 위한 값
+}
+
+func newRuntimeState(generation uint64, rules map[string]RuntimeRule, allow map[string]AllowEntry) (*runtimeState, error) {
+	state := &runtimeState{generation: generation, rules: rules, allow: allow}
+
+	if len(rules) > 0 {
+		raw := rawRulepack{Rules: make([]rawRule, 0, len(rules))}
+		for _, id := range sortedKeys(rules) {
+			rule := rules[id]
+			raw.Rules = append(raw.Rules, rawRule{ID: rule.ID, Type: rule.Type, Pattern: rule.Pattern, Phrases: rule.Phrases, Weight: rule.Weight})
+		}
+		pack, err := compileRulepack(raw, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+		state.pack = &pack
+	}
+
+	state.allowlist = Profile{Allowlist: sortedKeys(allow)}
+	return state, nil
+}
+
+// normalize: 런타임 규칙을 검증하고 phrase를 입력 정규화 기준에 맞춥니다.
+func (r RuntimeRule) normalize() (RuntimeRule, error) {
+	r.ID = strings.ToLower(strings.TrimSpace(r.ID))
+	if !ruleIDPattern.MatchString(r.ID) {
+		return RuntimeRule{}, fmt.Errorf("%w: id must match %s", ErrInvalidRule, ruleIDPattern.String())
+	}
+	if slices.Contains(builtinRuleIDs, r.ID) {
+		return RuntimeRule{}, fmt.Errorf("%w: id %q is reserved", ErrInvalidRule, r.ID)
+	}
+	if r.Weight <= 0 || r.Weight > maxRuleWeight {
+		return RuntimeRule{}, fmt.Errorf("%w: weight must be greater than 0 and at most %d", ErrInvalidRule, maxRuleWeight)
+	}
+	r.Note = strings.TrimSpace(r.Note)
+
+	r.Type = strings.ToLower(strings.TrimSpace(r.Type))
+	switch r.Type {
+	case RuleTypeRegex:
+		r.Pattern = strings.TrimSpace(r.Pattern)
+		r.Phrases = nil
+		if r.Pattern == "" || len(r.Pattern) > maxRulePatternLength {
+			return RuntimeRule{}, fmt.Errorf("%w: pattern must be 1-%d bytes", ErrInvalidRule, maxRulePatternLength)
+		}
+		if _, err := regexp.Compile("(?i)" + r.Pattern); err != nil {
+			return RuntimeRule{}, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+	case RuleTypePhrases:
+		r.Pattern = ""
+		r.Phrases = normalizeTerms(r.Phrases, true)
+		if len(r.Phrases) == 0 {
+			return RuntimeRule{}, fmt.Errorf("%w: phrases must not be empty", ErrInvalidRule)
+		}
+	default:
+		return RuntimeRule{}, fmt.Errorf("%w: type must be %s or %s", ErrInvalidRule, RuleTypeRegex, RuleTypePhrases)
+	}
+	return r, nil
+}
+
+func normalizeAllowEntry(raw string) (string, error) {
+	terms := normalizeTerms([]string{raw}, false)
+	if len(terms) == 0 || len(terms[0]) > maxAllowEntryLength {
+		return "", fmt.Errorf("%w: allowlist entry must be 1-%d bytes", ErrInvalidRule, maxAllowEntryLength)
+	}
+	return terms[0], nil
+}
+
+// AttachRuleStore: 런타임 규칙 저장소를 연결하고 저장된 규칙과 공통 allowlist를 불러옵니다.
+// 규칙팩 ID와 겹치거나 검증에 실패한 항목은 건너뜁니다.
+func (g *InjectionGuard) AttachRuleStore(ctx context.Context, store RuleStore) error {
+	g.rulesMu.Lock()
+	defer g.rulesMu.Unlock()
+	g.ruleStore = store
+
+	rawRules, err := store.LoadGuardRules(ctx)
+	if err != nil {
+		return fmt.Errorf("load guard rules: %w", err)
+	}
+	rawAllow, err := store.LoadGuardAllowlist(ctx)
+	if err != nil {
+		return fmt.Errorf("load guard allowlist: %w", err)
+	}
+
+	rules := make(map[string]RuntimeRule, len(rawRules))
+	for id, data := range rawRules {
+		var rule RuntimeRule
+		if err := json.Unmarshal([]byte(data), &rule); err != nil {
+			g.warn("guard_rule_skipped", "id", id, "err", err)
+			continue
+		}
+		rule.ID = id
+		normalized, err := g.validateRule(rule)
+		if err != nil {
+			g.warn("guard_rule_skipped", "id", id, "err", err)
+			continue
+		}
+		rules[normalized.ID] = normalized
+	}
+
+	allow := make(map[string]AllowEntry, len(rawAllow))
+	for key, data := range rawAllow {
+		var entry AllowEntry
+		if err := json.Unmarshal([]byte(data), &entry); err != nil {
+			g.warn("guard_allowlist_skipped", "entry", key, "err", err)
+			continue
+		}
+		normalized, err := normalizeAllowEntry(key)
+		if err != nil {
+			g.warn("guard_allowlist_skipped", "entry", key, "err", err)
+			continue
+		}
+		entry.Entry = normalized
+		allow[normalized] = entry
+	}
+
+	if err := g.swapRuntime(rules, allow); err != nil {
+		return err
+	}
+	if g.logger != nil {
+		g.logger.Info("guard_runtime_rules_loaded", "rules", len(rules), "allowlist", len(allow))
+	}
+	return nil
+}
+
+// validateRule: 규칙 값 검증에 더해 규칙팩 규칙과 ID가 겹치지 않는지 확인합니다.
+func (g *InjectionGuard) validateRule(rule RuntimeRule) (RuntimeRule, error) {
+	normalized, err := rule.normalize()
+	if err != nil {
+		return RuntimeRule{}, err
+	}
+	for _, pack := range g.packs {
+		for _, existing := range pack.Rules {
+			if strings.EqualFold(existing.ID, normalized.ID) {
+				return RuntimeRule{}, fmt.Errorf("%w: id %q is defined in rulepack", ErrInvalidRule, normalized.ID)
+			}
+		}
+	}
+	return normalized, nil
+}
+
+// Rules: 내장 검사, 규칙팩, 런타임 규칙 순으로 설명과 적중 수를 반환합니다.
+func (g *InjectionGuard) Rules() []RuleStatus {
+	statuses := make([]RuleStatus, 0, len(builtinRuleIDs))
+	threshold := g.threshold()
+	for _, id := range builtinRuleIDs {
+		statuses = append(statuses, RuleStatus{ID: id, Source: RuleSourceBuiltin, Weight: threshold, Hits: g.ruleHitCount(id)})
+	}
+	for _, pack := range g.packs {
+		for _, rule := range pack.Rules {
+			rule.Source = RuleSourceRulepack
+			rule.Hits = g.statusHits(rule)
+			statuses = append(statuses, rule)
+		}
+	}
+
+	state := g.runtime.Load()
+	if state == nil {
+		return statuses
+	}
+	for _, id := range sortedKeys(state.rules) {
+		rule := state.rules[id]
+		updatedAt := rule.UpdatedAt
+		status := RuleStatus{
+			ID:        rule.ID,
+			Source:    RuleSourceRuntime,
+			Type:      rule.Type,
+			Pattern:   rule.Pattern,
+			Phrases:   rule.Phrases,
+			Weight:    rule.Weight,
+			Note:      rule.Note,
+			UpdatedAt: &updatedAt,
+		}
+		status.Hits = g.statusHits(status)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Rule: 런타임 규칙 하나를 반환합니다.
+func (g *InjectionGuard) Rule(id string) (RuntimeRule, bool) {
+	state := g.runtime.Load()
+	if state == nil {
+		return RuntimeRule{}, false
+	}
+	rule, ok := state.rules[strings.ToLower(strings.TrimSpace(id))]
+	return rule, ok
+}
+
+// PutRule: 런타임 규칙을 검증해 저장하고 즉시 적용합니다. 같은 ID가 있으면 교체합니다.
+func (g *InjectionGuard) PutRule(ctx context.Context, rule RuntimeRule) (RuntimeRule, error) {
+	normalized, err := g.validateRule(rule)
+	if err != nil {
+		return RuntimeRule{}, err
+	}
+	normalized.UpdatedAt = time.Now().UTC()
+
+	g.rulesMu.Lock()
+	defer g.rulesMu.Unlock()
+
+	if g.ruleStore != nil {
+		data, err := json.Marshal(normalized)
+		if err != nil {
+			return RuntimeRule{}, fmt.Errorf("marshal guard rule: %w", err)
+		}
+		if err := g.ruleStore.SaveGuardRule(ctx, normalized.ID, string(data)); err != nil {
+			return RuntimeRule{}, err
+		}
+	}
+
+	state := g.runtimeOrEmpty()
+	rules := cloneMap(state.rules)
+	rules[normalized.ID] = normalized
+	if err := g.swapRuntime(rules, state.allow); err != nil {
+		return RuntimeRule{}, err
+	}
+	return normalized, nil
+}
+
+// DeleteRule: 런타임 규칙을 삭제합니다. 규칙팩 규칙은 삭제할 수 없습니다.
+func (g *InjectionGuard) DeleteRule(ctx context.Context, id string) error {
+	id = strings.ToLower(strings.TrimSpace(id))
+
+	g.rulesMu.Lock()
+	defer g.rulesMu.Unlock()
+
+	state := g.runtimeOrEmpty()
+	if _, ok := state.rules[id]; !ok {
+		return ErrRuleNotFound
+	}
+	if g.ruleStore != nil {
+		if err := g.ruleStore.DeleteGuardRule(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	rules := cloneMap(state.rules)
+	delete(rules, id)
+	return g.swapRuntime(rules, state.allow)
+}
+
+// Allowlist: 공통 allowlist 항목을 반환합니다.
+func (g *InjectionGuard) Allowlist() []AllowEntry {
+	state := g.runtimeOrEmpty()
+	entries := make([]AllowEntry, 0, len(state.allow))
+	for _, key := range sortedKeys(state.allow) {
+		entries = append(entries, state.allow[key])
+	}
+	return entries
+}
+
+// AddAllowlist: 모든 프로필에 적용할 allowlist 항목(규칙 ID 또는 phrase 문구)을 추가합니다.
+func (g *InjectionGuard) AddAllowlist(ctx context.Context, raw string, note string) (AllowEntry, error) {
+	key, err := normalizeAllowEntry(raw)
+	if err != nil {
+		return AllowEntry{}, err
+	}
+	entry := AllowEntry{Entry: key, Note: strings.TrimSpace(note), CreatedAt: time.Now().UTC()}
+
+	g.rulesMu.Lock()
+	defer g.rulesMu.Unlock()
+
+	if g.ruleStore != nil {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return AllowEntry{}, fmt.Errorf("marshal guard allowlist entry: %w", err)
+		}
+		if err := g.ruleStore.SaveGuardAllowlist(ctx, key, string(data)); err != nil {
+			return AllowEntry{}, err
+		}
+	}
+
+	state := g.runtimeOrEmpty()
+	allow := cloneMap(state.allow)
+	allow[key] = entry
+	if err := g.swapRuntime(state.rules, allow); err != nil {
+		return AllowEntry{}, err
+	}
+	return entry, nil
+}
+
+// RemoveAllowlist: 공통 allowlist 항목을 삭제합니다.
+func (g *InjectionGuard) RemoveAllowlist(ctx context.Context, raw string) error {
+	key := strings.ToLower(strings.TrimSpace(raw))
+
+	g.rulesMu.Lock()
+	defer g.rulesMu.Unlock()
+
+	state := g.runtimeOrEmpty()
+	if _, ok := state.allow[key]; !ok {
+		return ErrAllowEntryNotFound
+	}
+	if g.ruleStore != nil {
+		if err := g.ruleStore.DeleteGuardAllowlist(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	allow := cloneMap(state.allow)
+	delete(allow, key)
+	return g.swapRuntime(state.rules, allow)
+}
+
+// DryRun: 입력을 현재 규칙(과 선택적으로 추가할 후보 규칙)으로 평가합니다.
+// 캐시, 프로필 누계, 규칙 적중 수, 감사 기록에 영향을 주지 않습니다.
+func (g *InjectionGuard) DryRun(ctx context.Context, input string, candidate *RuntimeRule) (Evaluation, error) {
+	if g == nil || g.cfg == nil || !g.cfg.Guard.Enabled {
+		return Evaluation{Score: 0, Hits: nil, Threshold: math.Inf(1)}, nil
+	}
+
+	packs := g.activePacks()
+	if candidate != nil {
+		normalized, err := g.validateRule(*candidate)
+		if err != nil {
+			return Evaluation{}, err
+		}
+		// 같은 ID의 기존 런타임 규칙은 후보로 대체해 평가
+		rules := cloneMap(g.runtimeOrEmpty().rules)
+		rules[normalized.ID] = normalized
+		state, err := newRuntimeState(0, rules, nil)
+		if err != nil {
+			return Evaluation{}, err
+		}
+		packs = append(slices.Clip(g.packs), *state.pack)
+	}
+
+	_, profile := g.profileFor(ctx)
+	base := g.evaluateWith(input, packs)
+	return profile.apply(g.applyAllowlist(base, input), input), nil
+}
+
+// activePacks: 규칙팩과 런타임 규칙을 합친 평가 대상입니다.
+func (g *InjectionGuard) activePacks() []compiledPack {
+	state := g.runtime.Load()
+	if state == nil || state.pack == nil {
+		return g.packs
+	}
+	return append(slices.Clip(g.packs), *state.pack)
+}
+
+// applyAllowlist: 공통 allowlist에 있는 적중을 기본 평가에서 뺍니다.
+func (g *InjectionGuard) applyAllowlist(base Evaluation, input string) Evaluation {
+	state := g.runtime.Load()
+	if state == nil {
+		return base
+	}
+	return state.allowlist.apply(base, input)
+}
+
+// runtimeGeneration: 평가 캐시 키에 쓰는 런타임 규칙 세대입니다.
+func (g *InjectionGuard) runtimeGeneration() uint64 {
+	if state := g.runtime.Load(); state != nil {
+		return state.generation
+	}
+	return 0
+}
+
+func (g *InjectionGuard) runtimeOrEmpty() *runtimeState {
+	if state := g.runtime.Load(); state != nil {
+		return state
+	}
+	return &runtimeState{}
+}
+
+// swapRuntime: 새 스냅샷을 만들어 교체합니다. 호출자는 rulesMu를 잡고 있어야 합니다.
+func (g *InjectionGuard) swapRuntime(rules map[string]RuntimeRule, allow map[string]AllowEntry) error {
+	state, err := newRuntimeState(g.runtimeGeneration()+1, rules, allow)
+	if err != nil {
+		return err
+	}
+	g.runtime.Store(state)
+	return nil
+}
+
+// recordRuleHits: 실제 요청에서 적중한 규칙 수를 누적합니다.
+func (g *InjectionGuard) recordRuleHits(hits []Match) {
+	for _, hit := range hits {
+		counter, _ := g.ruleHits.LoadOrStore(hit.ID, new(atomic.Int64))
+		counter.(*atomic.Int64).Add(1)
+	}
+}
+
+func (g *InjectionGuard) ruleHitCount(id string) int64 {
+	if counter, ok := g.ruleHits.Load(id); ok {
+		return counter.(*atomic.Int64).Load()
+	}
+	return 0
+}
+
+// statusHits: regex 규칙은 ID, phrases 규칙은 문구별 적중 ID("phrase:<문구>")의 합계입니다.
+func (g *InjectionGuard) statusHits(rule RuleStatus) int64 {
+	if rule.Type != RuleTypePhrases {
+		return g.ruleHitCount(rule.ID)
+	}
+	total := int64(0)
+	for _, phrase := range rule.Phrases {
+		total += g.ruleHitCount("phrase:" + strings.ToLower(phrase))
+	}
+	return total
+}
+
+// cloneMap: nil이어도 항목을 추가할 수 있는 사본을 만듭니다.
+func cloneMap[V any](src map[string]V) map[string]V {
+	dst := make(map[string]V, len(src)+1)
+	maps.Copy(dst, src)
+	return dst
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,62 @@
+package guardaudit
+
+import (
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+)
+
+// Record: 가드가 차단한 입력 한 건의 감사 기록 DB 모델입니다. 원문 대신 SHA-256 해시만 보관합니다.
+type Record struct {
+	ID          int64         `gorm:"column:id;primaryKey" json:"id"`
+	CreatedAt   time.Time     `gorm:"column:created_at" json:"created_at"`
+	Caller      string        `gorm:"column:caller" json:"caller"`
+	Profile     string        `gorm:"column:profile" json:"profile"` // 적용된 프로필
+	SessionID   string        `gorm:"column:session_id" json:"session_id"`
+	Task        string        `gorm:"column:task" json:"task"`
+	InputHash   string        `gorm:"column:input_hash" json:"input_hash"`
+	InputLen    int           `gorm:"column:input_len" json:"input_len"`
+	Score       float64       `gorm:"column:score" json:"score"`
+	Threshold   float64       `gorm:"column:threshold" json:"threshold"`
+	Hits        []guard.Match `gorm:"column:hits;serializer:json" json:"hits"`
+	Allowlisted []string      `gorm:"column:allowlisted;serializer:json" json:"allowlisted"` // 오탐으로 판정해 허용한 규칙 ID
+	ReviewedAt  *time.Time    `gorm:"column:reviewed_at" json:"reviewed_at,omitempty"`
+}
+
+// TableName: GORM에서 사용할 테이블명을 반환합니다.
+func (Record) TableName() string {
+	return "guard_block_audit"
+}
+
+// Filter: 감사 기록 목록 조회 조건입니다. 빈 값은 조건에서 제외합니다.
+type Filter struct {
+	Caller    string
+	Profile   string
+	SessionID string
+	InputHash string
+	Rule      string // 이 규칙 ID에 걸린 기록만
+	Since     time.Time
+	Limit     int
+	Offset    int
+}
+
+// fromEvent: 가드 차단 이벤트를 저장용 기록으로 변환합니다.
+func fromEvent(event guard.BlockEvent) *Record {
+	hits := event.Hits
+	if hits == nil {
+		hits = []guard.Match{}
+	}
+	return &Record{
+		CreatedAt:   event.At,
+		Caller:      event.Caller,
+		Profile:     event.Profile,
+		SessionID:   event.SessionID,
+		Task:        event.Task,
+		InputHash:   event.InputHash,
+		InputLen:    event.InputLen,
+		Score:       event.Score,
+		Threshold:   event.Threshold,
+		Hits:        hits,
+		Allowlisted: []string{},
+	}
+}
@@ -0,0 +1,117 @@
+// Package guardaudit: 인젝션 가드가 차단한 입력을 Postgres에 기록하고 조회합니다.
+package guardaudit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+)
+
+const (
+	defaultQueueSize     = 256
+	defaultInsertTimeout = 5 * time.Second
+)
+
+// Store: 감사 기록 저장 인터페이스입니다.
+type Store interface {
+	Insert(ctx context.Context, record *Record) error
+}
+
+// Stats: 감사 기록 누적 통계입니다.
+type Stats struct {
+	Recorded int64 `json:"recorded"`
+	Dropped  int64 `json:"dropped"` // 대기열이 가득 차 버린 건수
+	Failed   int64 `json:"failed"`  // 저장 실패
+}
+
+// Recorder: guard.AuditSink 구현체로, 차단 이벤트를 대기열에 넣고 백그라운드에서 저장합니다.
+type Recorder struct {
+	store    Store
+	logger   *slog.Logger
+	queue    chan guard.BlockEvent
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	closed   bool
+	recorded atomic.Int64
+	dropped  atomic.Int64
+	failed   atomic.Int64
+}
+
+// NewRecorder: 감사 기록기를 생성하고 저장 워커를 시작합니다. store가 nil이면 nil을 반환합니다.
+func NewRecorder(store Store, logger *slog.Logger) *Recorder {
+	if store == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	r := &Recorder{
+		store:  store,
+		logger: logger,
+		queue:  make(chan guard.BlockEvent, defaultQueueSize),
+	}
+	r.wg.Go(r.run)
+	return r
+}
+
+// RecordBlock: 차단 이벤트를 대기열에 넣습니다. 가득 차면 버리고 요청 경로를 막지 않습니다.
+func (r *Recorder) RecordBlock(event guard.BlockEvent) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.queue <- event:
+	default:
+		r.dropped.Add(1)
+		r.logger.Debug("guard_audit_dropped", "profile", event.Profile, "reason", "queue_full")
+	}
+}
+
+// Stats: 누적 통계를 반환합니다.
+func (r *Recorder) Stats() Stats {
+	if r == nil {
+		return Stats{}
+	}
+	return Stats{
+		Recorded: r.recorded.Load(),
+		Dropped:  r.dropped.Load(),
+		Failed:   r.failed.Load(),
+	}
+}
+
+// Close: 새 이벤트를 받지 않고 대기열에 남은 기록을 모두 저장한 뒤 반환합니다.
+func (r *Recorder) Close() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	r.wg.Wait()
+}
+
+func (r *Recorder) run() {
+	for event := range r.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultInsertTimeout)
+		err := r.store.Insert(ctx, fromEvent(event))
+		cancel()
+		if err != nil {
+			r.failed.Add(1)
+			r.logger.Warn("guard_audit_store_failed", "profile", event.Profile, "err", err)
+			continue
+		}
+		r.recorded.Add(1)
+	}
+}
@@ -0,0 +1,101 @@
+package guardaudit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records []Record
+	err     error
+	block   chan struct{}
+}
+
+func (m *memoryStore) Insert(_ context.Context, record *Record) error {
+	if m.block != nil {
+		<-m.block
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.records = append(m.records, *record)
+	return nil
+}
+
+func TestNewRecorderNilStore(t *testing.T) {
+	if r := NewRecorder(nil, nil); r != nil {
+		t.Fatalf("expected nil recorder without store")
+	}
+
+	// nil 기록기는 호출해도 안전해야 함
+	var r *Recorder
+	r.RecordBlock(guard.BlockEvent{})
+	r.Close()
+	if r.Stats() != (Stats{}) {
+		t.Fatalf("expected zero stats for nil recorder")
+	}
+}
+
+func TestRecorderFlushesOnClose(t *testing.T) {
+	store := &memoryStore{}
+	r := NewRecorder(store, nil)
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	r.RecordBlock(guard.BlockEvent{
+		At:        at,
+		Caller:    "twentyq",
+		Profile:   guard.DefaultProfile,
+		SessionID: "room-1",
+		InputHash: guard.HashInput("ignore previous"),
+		InputLen:  15,
+		Score:     0.9,
+		Threshold: 0.85,
+		Hits:      []guard.Match{{ID: "override", Weight: 0.9}},
+	})
+	r.RecordBlock(guard.BlockEvent{At: at, Profile: guard.DefaultProfile})
+	r.Close()
+	// 닫힌 뒤의 이벤트는 무시
+	r.RecordBlock(guard.BlockEvent{At: at})
+
+	if len(store.records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(store.records))
+	}
+	first := store.records[0]
+	if first.Caller != "twentyq" || first.SessionID != "room-1" || first.Hits[0].ID != "override" || !first.CreatedAt.Equal(at) {
+		t.Fatalf("unexpected record: %+v", first)
+	}
+	if second := store.records[1]; second.Hits == nil || second.Allowlisted == nil {
+		t.Fatalf("expected empty slices instead of nil: %+v", second)
+	}
+	if stats := r.Stats(); stats.Recorded != 2 || stats.Failed != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestRecorderCountsFailuresAndDrops(t *testing.T) {
+	store := &memoryStore{err: errors.New("db down"), block: make(chan struct{})}
+	r := NewRecorder(store, nil)
+
+	// 워커가 첫 저장에서 막혀 있는 동안 대기열을 넘치게 채움
+	for range defaultQueueSize + 2 {
+		r.RecordBlock(guard.BlockEvent{Profile: guard.DefaultProfile})
+	}
+	close(store.block)
+	r.Close()
+
+	stats := r.Stats()
+	if stats.Dropped == 0 {
+		t.Fatalf("expected dropped events, got %+v", stats)
+	}
+	if stats.Failed+stats.Dropped != defaultQueueSize+2 || stats.Recorded != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
@@ -0,0 +1,190 @@
+package guardaudit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// ErrRecordNotFound: 해당 ID의 감사 기록이 없습니다.
+var ErrRecordNotFound = errors.New("guard audit record not found")
+
+// DBProvider: 공용 Postgres 연결을 제공합니다. (usage.Repository)
+type DBProvider interface {
+	DB(ctx context.Context) (*gorm.DB, error)
+}
+
+// Repository: 가드 차단 감사 기록 저장소입니다.
+type Repository struct {
+	provider DBProvider
+	mu       sync.Mutex
+	ready    bool
+}
+
+// NewRepository: 감사 기록 저장소를 생성합니다.
+func NewRepository(provider DBProvider) *Repository {
+	return &Repository{provider: provider}
+}
+
+// Insert: 차단 기록 한 건을 저장합니다.
+func (r *Repository) Insert(ctx context.Context, record *Record) error {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
+	}
+	if err := db.WithContext(ctx).Create(record).Error; err != nil {
+		return fmt.Errorf("insert guard audit record: %w", err)
+	}
+	return nil
+}
+
+// List: 조건에 맞는 기록을 최신순으로 조회합니다.
+func (r *Repository) List(ctx context.Context, filter Filter) ([]Record, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.WithContext(ctx).Model(&Record{})
+	if filter.Caller != "" {
+		query = query.Where("caller = ?", filter.Caller)
+	}
+	if filter.Profile != "" {
+		query = query.Where("profile = ?", filter.Profile)
+	}
+	if filter.SessionID != "" {
+		query = query.Where("session_id = ?", filter.SessionID)
+	}
+	if filter.InputHash != "" {
+		query = query.Where("input_hash = ?", filter.InputHash)
+	}
+	if filter.Rule != "" {
+		// weight가 달라도 id만 같으면 포함되도록 id 필드만 비교
+		contains, err := json.Marshal([]map[string]string{{"id": filter.Rule}})
+		if err != nil {
+			return nil, fmt.Errorf("marshal rule filter: %w", err)
+		}
+		query = query.Where("hits @> ?::jsonb", string(contains))
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	limit = min(limit, maxListLimit)
+
+	var records []Record
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(max(filter.Offset, 0)).Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("list guard audit records: %w", err)
+	}
+	return records, nil
+}
+
+// Get: ID로 기록을 조회합니다.
+func (r *Repository) Get(ctx context.Context, id int64) (*Record, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var record Record
+	if err := db.WithContext(ctx).First(&record, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("get guard audit record: %w", err)
+	}
+	return &record, nil
+}
+
+// MarkAllowlisted: 기록을 검토 완료로 표시하고 허용한 규칙을 남깁니다.
+func (r *Repository) MarkAllowlisted(ctx context.Context, id int64, rules []string) (*Record, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		return nil, fmt.Errorf("marshal allowlisted rules: %w", err)
+	}
+
+	result := db.WithContext(ctx).Model(&Record{}).Where("id = ?", id).Updates(map[string]any{
+		"allowlisted": string(encoded),
+		"reviewed_at": time.Now(),
+	})
+	if result.Error != nil {
+		return nil, fmt.Errorf("mark guard audit record: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrRecordNotFound
+	}
+	return r.Get(ctx, id)
+}
+
+func (r *Repository) getDB(ctx context.Context) (*gorm.DB, error) {
+	if r == nil || r.provider == nil {
+		return nil, errors.New("guard audit repository not configured")
+	}
+	db, err := r.provider.DB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("guard audit db: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.ready {
+		if err := ensureAuditSchema(ctx, db); err != nil {
+			return nil, fmt.Errorf("prepare guard audit db: %w", err)
+		}
+		r.ready = true
+	}
+	return db, nil
+}
+
+func ensureAuditSchema(ctx context.Context, db *gorm.DB) error {
+	if err := db.WithContext(ctx).Exec(`
+			CREATE TABLE IF NOT EXISTS guard_block_audit (
+				id BIGSERIAL PRIMARY KEY,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				caller TEXT NOT NULL DEFAULT '',
+				profile TEXT NOT NULL,
+				session_id TEXT NOT NULL DEFAULT '',
+				task TEXT NOT NULL DEFAULT '',
+				input_hash TEXT NOT NULL,
+				input_len INTEGER NOT NULL DEFAULT 0,
+				score DOUBLE PRECISION NOT NULL DEFAULT 0,
+				threshold DOUBLE PRECISION NOT NULL DEFAULT 0,
+				hits JSONB NOT NULL DEFAULT '[]'::jsonb,
+				allowlisted JSONB NOT NULL DEFAULT '[]'::jsonb,
+				reviewed_at TIMESTAMPTZ
+			)
+		`).Error; err != nil {
+		return fmt.Errorf("create guard_block_audit table: %w", err)
+	}
+
+	for _, stmt := range []string{
+		`CREATE INDEX IF NOT EXISTS idx_guard_block_audit_created ON guard_block_audit (created_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_guard_block_audit_profile_created ON guard_block_audit (profile, created_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_guard_block_audit_input_hash ON guard_block_audit (input_hash)`,
+	} {
+		if err := db.WithContext(ctx).Exec(stmt).Error; err != nil {
+			return fmt.Errorf("create guard_block_audit index: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/embedding"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+)
+
+// EmbeddingsRequest: 임베딩 요청 본문입니다.
+type EmbeddingsRequest struct {
+	Texts      []string `json:"texts" binding:"required"`
+	TaskType   string   `json:"task_type"`
+	Dimensions int      `json:"dimensions"`
+}
+
+// EmbeddingItem: 텍스트 하나의 임베딩입니다.
+type EmbeddingItem struct {
+	Values []float32 `json:"values"`
+	Cached bool      `json:"cached"`
+}
+
+// EmbeddingsResponse: 요청 텍스트 순서대로 정렬된 임베딩 응답입니다.
+type EmbeddingsResponse struct {
+	Embeddings []EmbeddingItem `json:"embeddings"`
+	Model      string          `json:"model"`
+	Dimensions int             `json:"dimensions"`
+}
+
+// EmbeddingHandler: 임베딩 API 핸들러입니다.
+type EmbeddingHandler struct {
+	service *embedding.Service
+	logger  *slog.Logger
+}
+
+// NewEmbeddingHandler: 임베딩 핸들러를 생성합니다.
+func NewEmbeddingHandler(service *embedding.Service, logger *slog.Logger) *EmbeddingHandler {
+	return &EmbeddingHandler{service: service, logger: logger}
+}
+
+// RegisterRoutes: 임베딩 라우트를 등록합니다.
+func (h *EmbeddingHandler) RegisterRoutes(router *gin.Engine) {
+	router.POST("/api/llm/embeddings", h.handleEmbeddings)
+}
+
+func (h *EmbeddingHandler) handleEmbeddings(c *gin.Context) {
+	var req EmbeddingsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	if h.service == nil {
+		writeError(c, httperror.NewInternalError("embedding service not configured"))
+		return
+	}
+
+	result, err := h.service.Embed(c.Request.Context(), embedding.Request{
+		Texts:      req.Texts,
+		TaskType:   req.TaskType,
+		Dimensions: req.Dimensions,
+	})
+	if err != nil {
+		if h.logger != nil {
+			h.logger.Warn("embeddings_failed", "err", err)
+		}
+		writeError(c, err)
+		return
+	}
+
+	items := make([]EmbeddingItem, len(result.Embeddings))
+	for i, vector := range result.Embeddings {
+		items[i] = EmbeddingItem{Values: vector.Values, Cached: vector.Cached}
+	}
+	c.JSON(http.StatusOK, EmbeddingsResponse{
+		Embeddings: items,
+		Model:      result.Model,
+		Dimensions: result.Dimensions,
+	})
+}
@@ -1,16 +1,35 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guardaudit"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 )
 
 // GuardRequest: 가드 검사 요청입니다.
+// Profile을 지정하면 호출 봇(X-Bot-ID) 대신 해당 프로필로 평가합니다 (관리 화면의 사전 점검용).
 type GuardRequest struct {
 	InputText string `json:"input_text" binding:"required"`
+	Profile   string `json:"profile,omitempty"`
+}
+
+// GuardProfileRequest: 가드 프로필 저장 요청입니다.
+type GuardProfileRequest struct {
+	Allowlist   []string `json:"allowlist"`
+	Denylist    []string `json:"denylist"`
+	Sensitivity float64  `json:"sensitivity"`
 }
 
 // GuardResponse: 가드 평가 응답입니다.
@@ -21,14 +40,50 @@ type GuardResponse struct {
 	Hits      []guard.Match `json:"hits"`
 }
 
+// GuardRuleRequest: 런타임 가드 규칙 저장 요청입니다. (ID는 경로로 지정)
+type GuardRuleRequest struct {
+	Type    string   `json:"type" binding:"required"`
+	Pattern string   `json:"pattern,omitempty"`
+	Phrases []string `json:"phrases,omitempty"`
+	Weight  float64  `json:"weight"`
+	Note    string   `json:"note,omitempty"`
+}
+
+// GuardDryRunRequest: 규칙 사전 평가 요청입니다. Candidate를 주면 추가하기 전의 규칙을 함께 적용해 봅니다.
+type GuardDryRunRequest struct {
+	InputText string             `json:"input_text" binding:"required"`
+	Profile   string             `json:"profile,omitempty"`
+	Candidate *guard.RuntimeRule `json:"candidate,omitempty"`
+}
+
+// GuardAllowEntryRequest: 공통 allowlist 항목 추가 요청입니다.
+type GuardAllowEntryRequest struct {
+	Entry string `json:"entry" binding:"required"`
+	Note  string `json:"note,omitempty"`
+}
+
+// GuardAuditAllowlistRequest: 감사 기록의 규칙을 allowlist에 추가하는 요청입니다.
+// Rules를 생략하면 기록에 걸린 규칙 전부(프로필 denylist 제외)를 허용합니다.
+type GuardAuditAllowlistRequest struct {
+	Rules []string `json:"rules"`
+}
+
+// GuardAuditAllowlistResponse: allowlist 추가 결과입니다.
+type GuardAuditAllowlistResponse struct {
+	Record  *guardaudit.Record  `json:"record"`
+	Profile guard.ProfileStatus `json:"profile"`
+}
+
 // GuardHandler: 가드 API 핸들러입니다.
 type GuardHandler struct {
-	guard *guard.InjectionGuard
+	guard  *guard.InjectionGuard
+	audit  *guardaudit.Repository
+	logger *slog.Logger
 }
 
-// NewGuardHandler: 가드 핸들러를 생성합니다.
-func NewGuardHandler(injectionGuard *guard.InjectionGuard) *GuardHandler {
-	return &GuardHandler{guard: injectionGuard}
+// NewGuardHandler: 가드 핸들러를 생성합니다. audit이 nil이면 감사 기록 API를 등록하지 않습니다.
+func NewGuardHandler(injectionGuard *guard.InjectionGuard, audit *guardaudit.Repository, logger *slog.Logger) *GuardHandler {
+	return &GuardHandler{guard: injectionGuard, audit: audit, logger: logger}
 }
 
 // RegisterRoutes: 가드 라우트를 등록합니다.
@@ -36,6 +91,28 @@ func (h *GuardHandler) RegisterRoutes(router *gin.Engine) {
 	group := router.Group("/api/guard")
 	group.POST("/evaluations", h.handleEvaluate)
 	group.POST("/checks", h.handleCheck)
+
+	// 호출 봇별 프로필 관리 (allowlist/denylist/민감도)
+	group.GET("/profiles", h.handleListProfiles)
+	group.GET("/profiles/:caller", h.handleGetProfile)
+	group.PUT("/profiles/:caller", h.handlePutProfile)
+	group.DELETE("/profiles/:caller", h.handleDeleteProfile)
+
+	// 재배포 없이 추가하는 규칙과 모든 프로필 공통 allowlist
+	group.GET("/rules", h.handleListRules)
+	group.POST("/rules/dry-run", h.handleDryRun)
+	group.PUT("/rules/:id", h.handlePutRule)
+	group.DELETE("/rules/:id", h.handleDeleteRule)
+	group.GET("/allowlist", h.handleListAllowlist)
+	group.POST("/allowlist", h.handleAddAllowlist)
+	group.DELETE("/allowlist/:entry", h.handleRemoveAllowlist)
+
+	// 차단 감사 기록 검토 (오탐 규칙은 바로 프로필 allowlist에 추가)
+	if h.audit != nil {
+		group.GET("/audit", h.handleListAudit)
+		group.GET("/audit/:id", h.handleGetAudit)
+		group.POST("/audit/:id/allowlist", h.handleAllowlistAudit)
+	}
 }
 
 func (h *GuardHandler) handleEvaluate(c *gin.Context) {
@@ -44,7 +121,7 @@ func (h *GuardHandler) handleEvaluate(c *gin.Context) {
 		return
 	}
 
-	evaluation := h.guard.Evaluate(req.InputText)
+	evaluation := h.guard.Evaluate(h.evaluationContext(c, req.Profile), req.InputText)
 	c.JSON(http.StatusOK, GuardResponse{
 		Score:     evaluation.Score,
 		Malicious: evaluation.Malicious(),
@@ -59,6 +136,368 @@ func (h *GuardHandler) handleCheck(c *gin.Context) {
 		return
 	}
 
-	malicious := h.guard.IsMalicious(req.InputText)
+	malicious := h.guard.IsMalicious(h.evaluationContext(c, req.Profile), req.InputText)
 	c.JSON(http.StatusOK, gin.H{"malicious": malicious})
 }
+
+// evaluationContext: 요청에 프로필이 지정되면 해당 프로필을 호출자로 덮어쓴 컨텍스트를 반환합니다.
+func (h *GuardHandler) evaluationContext(c *gin.Context, profile string) context.Context {
+	ctx := c.Request.Context()
+	if name := guard.NormalizeProfileName(profile); name != "" {
+		return quota.WithCaller(ctx, name)
+	}
+	return ctx
+}
+
+func (h *GuardHandler) handleListProfiles(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"profiles": h.guard.Profiles()})
+}
+
+func (h *GuardHandler) handleGetProfile(c *gin.Context) {
+	status, ok := h.guard.Profile(c.Param("caller"))
+	if !ok {
+		writeError(c, profileNotFound(c.Param("caller")))
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
+
+func (h *GuardHandler) handlePutProfile(c *gin.Context) {
+	var req GuardProfileRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	profile, err := h.guard.PutProfile(c.Request.Context(), guard.Profile{
+		Caller:      c.Param("caller"),
+		Allowlist:   req.Allowlist,
+		Denylist:    req.Denylist,
+		Sensitivity: req.Sensitivity,
+	})
+	if err != nil {
+		if errors.Is(err, guard.ErrInvalidProfile) {
+			writeError(c, httperror.NewInvalidInput(err.Error()))
+			return
+		}
+		h.logger.Error("guard_profile_save_failed", "caller", c.Param("caller"), "err", err)
+		writeError(c, err)
+		return
+	}
+
+	h.logger.Info("guard_profile_updated",
+		"caller", profile.Caller,
+		"allowlist", len(profile.Allowlist),
+		"denylist", len(profile.Denylist),
+		"sensitivity", profile.Sensitivity,
+	)
+	status, _ := h.guard.Profile(profile.Caller)
+	c.JSON(http.StatusOK, status)
+}
+
+func (h *GuardHandler) handleDeleteProfile(c *gin.Context) {
+	caller := c.Param("caller")
+	if err := h.guard.DeleteProfile(c.Request.Context(), caller); err != nil {
+		if errors.Is(err, guard.ErrProfileNotFound) {
+			writeError(c, profileNotFound(caller))
+			return
+		}
+		h.logger.Error("guard_profile_delete_failed", "caller", caller, "err", err)
+		writeError(c, err)
+		return
+	}
+
+	h.logger.Info("guard_profile_deleted", "caller", caller)
+	c.Status(http.StatusNoContent)
+}
+
+func profileNotFound(caller string) *httperror.Error {
+	return &httperror.Error{
+		Code:    httperror.ErrorCodeInvalidInput,
+		Status:  http.StatusNotFound,
+		Type:    "NotFoundError",
+		Message: fmt.Sprintf("guard profile %q not found", caller),
+		Details: map[string]any{"caller": caller},
+	}
+}
+
+func (h *GuardHandler) handleListRules(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"rules": h.guard.Rules(), "allowlist": h.guard.Allowlist()})
+}
+
+func (h *GuardHandler) handlePutRule(c *gin.Context) {
+	var req GuardRuleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	rule, err := h.guard.PutRule(c.Request.Context(), guard.RuntimeRule{
+		ID:      c.Param("id"),
+		Type:    req.Type,
+		Pattern: req.Pattern,
+		Phrases: req.Phrases,
+		Weight:  req.Weight,
+		Note:    req.Note,
+	})
+	if err != nil {
+		if errors.Is(err, guard.ErrInvalidRule) {
+			writeError(c, httperror.NewInvalidInput(err.Error()))
+			return
+		}
+		h.logger.Error("guard_rule_save_failed", "id", c.Param("id"), "err", err)
+		writeError(c, err)
+		return
+	}
+
+	h.logger.Info("guard_rule_updated", "id", rule.ID, "type", rule.Type, "weight", rule.Weight)
+	c.JSON(http.StatusOK, rule)
+}
+
+func (h *GuardHandler) handleDeleteRule(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.guard.DeleteRule(c.Request.Context(), id); err != nil {
+		if errors.Is(err, guard.ErrRuleNotFound) {
+			writeError(c, guardNotFound("guard rule", "id", id))
+			return
+		}
+		h.logger.Error("guard_rule_delete_failed", "id", id, "err", err)
+		writeError(c, err)
+		return
+	}
+
+	h.logger.Info("guard_rule_deleted", "id", id)
+	c.Status(http.StatusNoContent)
+}
+
+// handleDryRun: 캐시·누계·감사 기록에 남기지 않고 현재 규칙(과 후보 규칙)으로 입력을 평가합니다.
+func (h *GuardHandler) handleDryRun(c *gin.Context) {
+	var req GuardDryRunRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	evaluation, err := h.guard.DryRun(h.evaluationContext(c, req.Profile), req.InputText, req.Candidate)
+	if err != nil {
+		if errors.Is(err, guard.ErrInvalidRule) {
+			writeError(c, httperror.NewInvalidInput(err.Error()))
+			return
+		}
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, GuardResponse{
+		Score:     evaluation.Score,
+		Malicious: evaluation.Malicious(),
+		Threshold: evaluation.Threshold,
+		Hits:      evaluation.Hits,
+	})
+}
+
+func (h *GuardHandler) handleListAllowlist(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"allowlist": h.guard.Allowlist()})
+}
+
+func (h *GuardHandler) handleAddAllowlist(c *gin.Context) {
+	var req GuardAllowEntryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	entry, err := h.guard.AddAllowlist(c.Request.Context(), req.Entry, req.Note)
+	if err != nil {
+		if errors.Is(err, guard.ErrInvalidRule) {
+			writeError(c, httperror.NewInvalidInput(err.Error()))
+			return
+		}
+		h.logger.Error("guard_allowlist_save_failed", "entry", req.Entry, "err", err)
+		writeError(c, err)
+		return
+	}
+
+	h.logger.Info("guard_allowlist_added", "entry", entry.Entry)
+	c.JSON(http.StatusOK, entry)
+}
+
+func (h *GuardHandler) handleRemoveAllowlist(c *gin.Context) {
+	entry := c.Param("entry")
+	if err := h.guard.RemoveAllowlist(c.Request.Context(), entry); err != nil {
+		if errors.Is(err, guard.ErrAllowEntryNotFound) {
+			writeError(c, guardNotFound("guard allowlist entry", "entry", entry))
+			return
+		}
+		h.logger.Error("guard_allowlist_delete_failed", "entry", entry, "err", err)
+		writeError(c, err)
+		return
+	}
+
+	h.logger.Info("guard_allowlist_removed", "entry", entry)
+	c.Status(http.StatusNoContent)
+}
+
+func guardNotFound(kind string, field string, value string) *httperror.Error {
+	return &httperror.Error{
+		Code:    httperror.ErrorCodeInvalidInput,
+		Status:  http.StatusNotFound,
+		Type:    "NotFoundError",
+		Message: fmt.Sprintf("%s %q not found", kind, value),
+		Details: map[string]any{field: value},
+	}
+}
+
+// handleListAudit: 최근 N일(기본 7일) 차단 기록을 최신순으로 반환합니다.
+// input_text를 주면 해시로 바꿔 제보받은 문장의 차단 기록을 찾습니다.
+func (h *GuardHandler) handleListAudit(c *gin.Context) {
+	days, ok := parseDays(c, 7)
+	if !ok {
+		return
+	}
+	limit, ok := parseNonNegativeQuery(c, "limit")
+	if !ok {
+		return
+	}
+	offset, ok := parseNonNegativeQuery(c, "offset")
+	if !ok {
+		return
+	}
+
+	filter := guardaudit.Filter{
+		Caller:    strings.ToLower(strings.TrimSpace(c.Query("caller"))),
+		Profile:   guard.NormalizeProfileName(c.Query("profile")),
+		SessionID: strings.TrimSpace(c.Query("session_id")),
+		InputHash: strings.ToLower(strings.TrimSpace(c.Query("input_hash"))),
+		Rule:      strings.ToLower(strings.TrimSpace(c.Query("rule"))),
+		Since:     time.Now().AddDate(0, 0, -days),
+		Limit:     limit,
+		Offset:    offset,
+	}
+	if text := c.Query("input_text"); text != "" {
+		filter.InputHash = guard.HashInput(text)
+	}
+
+	records, err := h.audit.List(c.Request.Context(), filter)
+	if err != nil {
+		h.logger.Warn("guard_audit_list_failed", "err", err)
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"records": records})
+}
+
+func (h *GuardHandler) handleGetAudit(c *gin.Context) {
+	id, ok := parseAuditID(c)
+	if !ok {
+		return
+	}
+	record, err := h.audit.Get(c.Request.Context(), id)
+	if err != nil {
+		h.writeAuditError(c, id, err)
+		return
+	}
+	c.JSON(http.StatusOK, record)
+}
+
+// handleAllowlistAudit: 오탐으로 판정한 기록의 규칙을 해당 프로필 allowlist에 추가하고 기록을 검토 완료로 표시합니다.
+func (h *GuardHandler) handleAllowlistAudit(c *gin.Context) {
+	id, ok := parseAuditID(c)
+	if !ok {
+		return
+	}
+	var req GuardAuditAllowlistRequest
+	if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	record, err := h.audit.Get(ctx, id)
+	if err != nil {
+		h.writeAuditError(c, id, err)
+		return
+	}
+
+	rules := req.Rules
+	if len(rules) == 0 {
+		rules = allowableRules(record.Hits)
+	}
+	if len(rules) == 0 {
+		writeError(c, httperror.NewInvalidInput("no allowlistable rules in record"))
+		return
+	}
+
+	// 호출 봇이 있으면 그 봇의 프로필만 완화 (default를 바꾸면 모든 봇에 적용됨)
+	target := record.Profile
+	if record.Caller != "" {
+		target = record.Caller
+	}
+	profile, err := h.guard.AllowRules(ctx, target, rules)
+	if err != nil {
+		if errors.Is(err, guard.ErrInvalidProfile) {
+			writeError(c, httperror.NewInvalidInput(err.Error()))
+			return
+		}
+		if errors.Is(err, guard.ErrProfileNotFound) {
+			writeError(c, profileNotFound(target))
+			return
+		}
+		h.logger.Error("guard_audit_allowlist_failed", "id", id, "err", err)
+		writeError(c, err)
+		return
+	}
+
+	updated, err := h.audit.MarkAllowlisted(ctx, id, rules)
+	if err != nil {
+		h.writeAuditError(c, id, err)
+		return
+	}
+
+	h.logger.Info("guard_audit_allowlisted", "id", id, "caller", profile.Caller, "rules", rules)
+	status, _ := h.guard.Profile(profile.Caller)
+	c.JSON(http.StatusOK, GuardAuditAllowlistResponse{Record: updated, Profile: status})
+}
+
+// allowableRules: 기록에 걸린 규칙 ID 중 allowlist로 풀 수 있는 것만 고릅니다. (프로필 denylist 적중은 제외)
+func allowableRules(hits []guard.Match) []string {
+	rules := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		if strings.HasPrefix(hit.ID, "deny:") {
+			continue
+		}
+		rules = append(rules, hit.ID)
+	}
+	return rules
+}
+
+func (h *GuardHandler) writeAuditError(c *gin.Context, id int64, err error) {
+	if errors.Is(err, guardaudit.ErrRecordNotFound) {
+		writeError(c, &httperror.Error{
+			Code:    httperror.ErrorCodeInvalidInput,
+			Status:  http.StatusNotFound,
+			Type:    "NotFoundError",
+			Message: fmt.Sprintf("guard audit record %d not found", id),
+			Details: map[string]any{"id": id},
+		})
+		return
+	}
+	h.logger.Warn("guard_audit_request_failed", "id", id, "err", err)
+	writeError(c, err)
+}
+
+func parseAuditID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(c, httperror.NewInvalidInput("id must be a positive integer"))
+		return 0, false
+	}
+	return id, true
+}
+
+func parseNonNegativeQuery(c *gin.Context, name string) (int, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return 0, true
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed < 0 {
+		writeError(c, httperror.NewInvalidInput(name+" must be a non-negative integer"))
+		return 0, false
+	}
+	return parsed, true
+}
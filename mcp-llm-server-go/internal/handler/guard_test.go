@@ -33,7 +33,7 @@ func TestGuardHandler(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	handler := NewGuardHandler(g)
+	handler := NewGuardHandler(g, nil, logger)
 	router := gin.New()
 	handler.RegisterRoutes(router)
 
@@ -70,3 +70,147 @@ func TestGuardHandler(t *testing.T) {
 		t.Fatalf("expected evaluation to be malicious")
 	}
 }
+
+func TestGuardHandler_Profiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	dir := t.TempDir()
+	data := []byte("version: 1\nthreshold: 0.5\nrules:\n  - id: r1\n    type: regex\n    pattern: evil\n    weight: 0.6\n")
+	if err := os.WriteFile(filepath.Join(dir, "rules.yml"), data, 0o644); err != nil {
+		t.Fatalf("failed to write rulepack: %v", err)
+	}
+
+	cfg := &config.Config{Guard: config.GuardConfig{Enabled: true, Threshold: 0.5, RulepacksDir: dir}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	g, err := guard.NewGuard(cfg, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	router := gin.New()
+	NewGuardHandler(g, nil, logger).RegisterRoutes(router)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	if resp := serve(http.MethodPut, "/api/guard/profiles/turtlesoup", `{"allowlist":["r1"]}`); resp.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	if resp := serve(http.MethodPut, "/api/guard/profiles/unknown", `{}`); resp.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown caller, got %d", resp.Code)
+	}
+
+	// profile 지정 평가: turtlesoup에서는 r1이 무시됨
+	var eval GuardResponse
+	resp := serve(http.MethodPost, "/api/guard/evaluations", `{"input_text":"evil","profile":"turtlesoup"}`)
+	if err := json.Unmarshal(resp.Body.Bytes(), &eval); err != nil || eval.Malicious {
+		t.Fatalf("expected allowlisted evaluation, got %s (%v)", resp.Body.String(), err)
+	}
+
+	var list struct {
+		Profiles []guard.ProfileStatus `json:"profiles"`
+	}
+	resp = serve(http.MethodGet, "/api/guard/profiles", "")
+	if err := json.Unmarshal(resp.Body.Bytes(), &list); err != nil {
+		t.Fatalf("failed to decode profiles: %v", err)
+	}
+	for _, status := range list.Profiles {
+		if status.Caller == "turtlesoup" && (!status.Stored || status.Stats.Evaluations != 1) {
+			t.Fatalf("unexpected turtlesoup status: %+v", status)
+		}
+	}
+
+	if resp := serve(http.MethodDelete, "/api/guard/profiles/turtlesoup", ""); resp.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.Code)
+	}
+	if resp := serve(http.MethodDelete, "/api/guard/profiles/turtlesoup", ""); resp.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.Code)
+	}
+}
+
+func TestGuardHandler_RuntimeRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Guard: config.GuardConfig{Enabled: true, Threshold: 0.5, RulepacksDir: t.TempDir()}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	g, err := guard.NewGuard(cfg, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	router := gin.New()
+	NewGuardHandler(g, nil, logger).RegisterRoutes(router)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	// 후보 규칙으로 미리 평가 (저장하지 않음)
+	var eval GuardResponse
+	resp := serve(http.MethodPost, "/api/guard/rules/dry-run", `{"input_text":"evil","candidate":{"id":"r2","type":"regex","pattern":"evil","weight":0.7}}`)
+	if err := json.Unmarshal(resp.Body.Bytes(), &eval); err != nil || !eval.Malicious {
+		t.Fatalf("expected malicious dry run, got %s (%v)", resp.Body.String(), err)
+	}
+	if resp := serve(http.MethodPost, "/api/guard/checks", `{"input_text":"evil"}`); !bytes.Contains(resp.Body.Bytes(), []byte(`"malicious":false`)) {
+		t.Fatalf("dry run must not add rule: %s", resp.Body.String())
+	}
+
+	if resp := serve(http.MethodPut, "/api/guard/rules/r2", `{"type":"regex","pattern":"evil","weight":0.7}`); resp.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	if resp := serve(http.MethodPut, "/api/guard/rules/r3", `{"type":"regex","pattern":"(","weight":0.7}`); resp.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid pattern, got %d", resp.Code)
+	}
+	if resp := serve(http.MethodPost, "/api/guard/checks", `{"input_text":"evil"}`); !bytes.Contains(resp.Body.Bytes(), []byte(`"malicious":true`)) {
+		t.Fatalf("rule should apply immediately: %s", resp.Body.String())
+	}
+
+	var list struct {
+		Rules []guard.RuleStatus `json:"rules"`
+	}
+	resp = serve(http.MethodGet, "/api/guard/rules", "")
+	if err := json.Unmarshal(resp.Body.Bytes(), &list); err != nil {
+		t.Fatalf("failed to decode rules: %v", err)
+	}
+	found := false
+	for _, rule := range list.Rules {
+		if rule.ID == "r2" {
+			found = rule.Source == guard.RuleSourceRuntime && rule.Hits == 1
+		}
+	}
+	if !found {
+		t.Fatalf("expected runtime rule with one hit: %s", resp.Body.String())
+	}
+
+	if resp := serve(http.MethodPost, "/api/guard/allowlist", `{"entry":"r2"}`); resp.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.Code)
+	}
+	if resp := serve(http.MethodPost, "/api/guard/checks", `{"input_text":"evil"}`); !bytes.Contains(resp.Body.Bytes(), []byte(`"malicious":false`)) {
+		t.Fatalf("allowlisted rule should not block: %s", resp.Body.String())
+	}
+	if resp := serve(http.MethodDelete, "/api/guard/allowlist/r2", ""); resp.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.Code)
+	}
+	if resp := serve(http.MethodDelete, "/api/guard/rules/r2", ""); resp.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.Code)
+	}
+	if resp := serve(http.MethodDelete, "/api/guard/rules/r2", ""); resp.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.Code)
+	}
+}
+
+func TestAllowableRules_SkipsDenylistHits(t *testing.T) {
+	rules := allowableRules([]guard.Match{
+		{ID: "override", Weight: 0.8},
+		{ID: "deny:금지어", Weight: 1},
+		{ID: "jamo_only", Weight: 0.3},
+	})
+	if len(rules) != 2 || rules[0] != "override" || rules[1] != "jamo_only" {
+		t.Fatalf("unexpected rules: %v", rules)
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/hololive"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/handler/shared"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+)
+
+// maxScheduleQueryRunes: 일정 질문으로 받아들이는 최대 길이
+const maxScheduleQueryRunes = 200
+
+// kst: 기준 날짜를 받지 못했을 때 오늘을 계산하는 시간대 (봇 사용자가 한국 기준으로 질문함)
+var kst = time.FixedZone("KST", 9*60*60)
+
+// ScheduleQueryRequest: 자연어 일정 질문 해석 요청입니다.
+type ScheduleQueryRequest struct {
+	Query string `json:"query" binding:"required"`
+	Today string `json:"today"` // YYYY-MM-DD (비어 있으면 서버의 KST 날짜)
+}
+
+// HololiveScheduleHandler: 홀로라이브 봇의 자연어 일정 질문을 멤버와 날짜 범위로 바꾸는 API 핸들러입니다.
+type HololiveScheduleHandler struct {
+	client gemini.LLM
+	guard  *guard.InjectionGuard
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewHololiveScheduleHandler: 일정 질문 해석 핸들러를 생성합니다.
+func NewHololiveScheduleHandler(client gemini.LLM, injectionGuard *guard.InjectionGuard, logger *slog.Logger) *HololiveScheduleHandler {
+	return &HololiveScheduleHandler{
+		client: client,
+		guard:  injectionGuard,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// RegisterRoutes: 홀로라이브 라우트를 등록합니다.
+func (h *HololiveScheduleHandler) RegisterRoutes(router *gin.Engine) {
+	group := router.Group("/api/hololive")
+	group.POST("/schedule-queries", h.handleScheduleQuery)
+}
+
+func (h *HololiveScheduleHandler) handleScheduleQuery(c *gin.Context) {
+	var req ScheduleQueryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		writeError(c, httperror.NewMissingField("query"))
+		return
+	}
+	if len([]rune(query)) > maxScheduleQueryRunes {
+		writeError(c, httperror.NewInvalidInput("query is too long"))
+		return
+	}
+
+	today := h.now().In(kst)
+	if req.Today != "" {
+		parsed, err := time.ParseInLocation(hololive.DateLayout, strings.TrimSpace(req.Today), kst)
+		if err != nil {
+			writeError(c, httperror.NewInvalidInput("today must be YYYY-MM-DD"))
+			return
+		}
+		today = parsed
+	}
+
+	if err := h.guard.EnsureSafe(c.Request.Context(), query); err != nil {
+		h.logError(err)
+		writeError(c, err)
+		return
+	}
+
+	payload, _, err := h.client.Structured(c.Request.Context(), gemini.Request{
+		Prompt: hololive.ScheduleQueryPrompt(query, today),
+		Task:   "schedule_query",
+	}, hololive.ScheduleQuerySchema())
+	if err != nil {
+		h.logError(err)
+		writeError(c, err)
+		return
+	}
+
+	var raw hololive.ScheduleQuery
+	if err := shared.Decode(payload, &raw); err != nil {
+		h.logError(err)
+		writeError(c, httperror.NewInternalError("invalid schedule query output"))
+		return
+	}
+
+	result := hololive.NormalizeScheduleQuery(raw, today)
+	h.logger.Info("schedule_query_parsed", "intent", result.Intent, "member", result.Member, "start", result.StartDate, "end", result.EndDate)
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *HololiveScheduleHandler) logError(err error) {
+	shared.LogError(h.logger, "hololive", err)
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/hololive"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
+)
+
+func newTestHololiveScheduleRouter(t *testing.T, client gemini.LLM) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	injectionGuard, err := guard.NewGuard(&config.Config{Guard: config.GuardConfig{Enabled: false}}, logger)
+	if err != nil {
+		t.Fatalf("failed to create guard: %v", err)
+	}
+
+	router := gin.New()
+	NewHololiveScheduleHandler(client, injectionGuard, logger).RegisterRoutes(router)
+	return router
+}
+
+func postScheduleQuery(router *gin.Engine, body map[string]any) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/api/hololive/schedule-queries", bytes.NewBuffer(payload))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestHololiveScheduleQuery(t *testing.T) {
+	var prompt string
+	client := fakeLLMClient{
+		structuredFn: func(ctx context.Context, req gemini.Request, schema map[string]any) (map[string]any, string, error) {
+			prompt = req.Prompt
+			return map[string]any{
+				"intent":     "schedule",
+				"member":     "페코라",
+				"start_date": "2026-03-04",
+				"end_date":   "2026-03-08",
+			}, "gemini-3-test", nil
+		},
+	}
+	router := newTestHololiveScheduleRouter(t, client)
+
+	resp := postScheduleQuery(router, map[string]any{"query": "페코라 이번주 언제 방송해?", "today": "2026-03-04"})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var out hololive.ScheduleQuery
+	if err := json.Unmarshal(resp.Body.Bytes(), &out); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	want := hololive.ScheduleQuery{Intent: "schedule", Member: "페코라", StartDate: "2026-03-04", EndDate: "2026-03-08"}
+	if out != want {
+		t.Fatalf("got %+v, want %+v", out, want)
+	}
+	if !strings.Contains(prompt, "2026-03-04 (수요일)") {
+		t.Fatalf("prompt must carry the reference date: %s", prompt)
+	}
+}
+
+func TestHololiveScheduleQuery_InvalidToday(t *testing.T) {
+	router := newTestHololiveScheduleRouter(t, fakeLLMClient{})
+
+	resp := postScheduleQuery(router, map[string]any{"query": "마린 내일 방송해?", "today": "03/04"})
+	if resp.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.Code)
+	}
+}
@@ -60,7 +60,7 @@ type UsageResponse struct {
 // LLMHandler: LLM API 핸들러입니다.
 type LLMHandler struct {
 	cfg       *config.Config
-	client    *gemini.Client
+	client    llm.Provider
 	guard     *guard.InjectionGuard
 	metrics   *metrics.Store
 	usageRepo *usage.Repository
@@ -70,7 +70,7 @@ type LLMHandler struct {
 // NewLLMHandler: LLM 핸들러를 생성합니다.
 func NewLLMHandler(
 	cfg *config.Config,
-	client *gemini.Client,
+	client llm.Provider,
 	injectionGuard *guard.InjectionGuard,
 	metricsStore *metrics.Store,
 	usageRepo *usage.Repository,
@@ -108,7 +108,7 @@ func (h *LLMHandler) handleChat(c *gin.Context) {
 		return
 	}
 
-	if err := h.guard.EnsureSafe(req.Prompt); err != nil {
+	if err := h.guard.EnsureSafe(c.Request.Context(), req.Prompt); err != nil {
 		h.logError(err)
 		writeError(c, err)
 		return
@@ -135,7 +135,7 @@ func (h *LLMHandler) handleChatWithUsage(c *gin.Context) {
 		return
 	}
 
-	if err := h.guard.EnsureSafe(req.Prompt); err != nil {
+	if err := h.guard.EnsureSafe(c.Request.Context(), req.Prompt); err != nil {
 		h.logError(err)
 		writeError(c, err)
 		return
@@ -172,7 +172,7 @@ func (h *LLMHandler) handleStructured(c *gin.Context) {
 		return
 	}
 
-	if err := h.guard.EnsureSafe(req.Prompt); err != nil {
+	if err := h.guard.EnsureSafe(c.Request.Context(), req.Prompt); err != nil {
 		h.logError(err)
 		writeError(c, err)
 		return
@@ -10,18 +10,25 @@ import (
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/middleware"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
 )
 
 // NewRouter: HTTP 라우터를 구성합니다.
 func NewRouter(
 	cfg *config.Config,
 	logger *slog.Logger,
+	quotaTracker *quota.Tracker,
 	llmHandler *LLMHandler,
+	embeddingHandler *EmbeddingHandler,
 	sessionHandler *SessionHandler,
+	sessionStoreHandler *SessionStoreHandler,
 	guardHandler *GuardHandler,
 	usageHandler *UsageHandler,
+	shadowHandler *ShadowHandler,
 	twentyqHandler *TwentyQHandler,
+	topicPackHandler *TopicPackHandler,
 	turtleSoupHandler *TurtleSoupHandler,
+	hololiveScheduleHandler *HololiveScheduleHandler,
 ) *gin.Engine {
 	setGinMode(cfg.Logging.Level)
 
@@ -32,9 +39,12 @@ func NewRouter(
 		middleware.RequestID(),
 		middleware.RequestLogger(logger),
 		gin.Recovery(),
-		gzip.Gzip(gzip.DefaultCompression),
+		// 실시간 사용량 스트림은 압축 버퍼링 없이 즉시 플러시되어야 함
+		gzip.Gzip(gzip.DefaultCompression, gzip.WithExcludedPaths([]string{"/api/usage/live"})),
 		middleware.APIKeyAuth(cfg),
 		middleware.RateLimit(cfg),
+		middleware.Quota(quotaTracker),
+		middleware.LLMPriority(),
 	}
 
 	// OTel 미들웨어: 활성화된 경우에만 추가 (가장 앞에 배치)
@@ -48,11 +58,16 @@ func NewRouter(
 
 	RegisterHealthRoutes(router, cfg)
 	llmHandler.RegisterRoutes(router)
+	embeddingHandler.RegisterRoutes(router)
 	sessionHandler.RegisterRoutes(router)
+	sessionStoreHandler.RegisterRoutes(router)
 	guardHandler.RegisterRoutes(router)
 	usageHandler.RegisterRoutes(router)
+	shadowHandler.RegisterRoutes(router)
 	twentyqHandler.RegisterRoutes(router)
+	topicPackHandler.RegisterRoutes(router)
 	turtleSoupHandler.RegisterRoutes(router)
+	hololiveScheduleHandler.RegisterRoutes(router)
 
 	return router
 }
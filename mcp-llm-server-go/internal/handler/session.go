@@ -89,7 +89,7 @@ func (h *SessionHandler) handleChat(c *gin.Context) {
 		return
 	}
 
-	if err := h.guard.EnsureSafe(req.Message); err != nil {
+	if err := h.guard.EnsureSafe(c.Request.Context(), req.Message); err != nil {
 		h.logError(err)
 		writeError(c, err)
 		return
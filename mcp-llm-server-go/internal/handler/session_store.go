@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/session"
+)
+
+// SessionStoreFailoverRequest: 세션 저장소 활성 노드 전환 요청입니다.
+type SessionStoreFailoverRequest struct {
+	Target string `json:"target" binding:"required"` // primary | standby
+}
+
+// SessionStoreHandler: 세션 저장소 복제 상태/전환 API 핸들러입니다.
+type SessionStoreHandler struct {
+	store  *session.Store
+	logger *slog.Logger
+}
+
+// NewSessionStoreHandler: 세션 저장소 핸들러를 생성합니다.
+func NewSessionStoreHandler(store *session.Store, logger *slog.Logger) *SessionStoreHandler {
+	return &SessionStoreHandler{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// RegisterRoutes: 세션 저장소 라우트를 등록합니다.
+func (h *SessionStoreHandler) RegisterRoutes(router *gin.Engine) {
+	group := router.Group("/api/session-store")
+	group.GET("/replication", h.handleReplicationStatus)
+	group.POST("/failover", h.handleFailover)
+}
+
+// handleReplicationStatus: 활성 노드와 복제 지연/실패 현황을 반환합니다.
+func (h *SessionStoreHandler) handleReplicationStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, h.store.ReplicationStatus())
+}
+
+// handleFailover: 활성 노드를 primary/standby로 전환합니다.
+// primary 복귀 시 대기 노드 세션을 먼저 동기화하므로 응답까지 시간이 걸릴 수 있습니다.
+func (h *SessionStoreHandler) handleFailover(c *gin.Context) {
+	var req SessionStoreFailoverRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	target := strings.ToLower(strings.TrimSpace(req.Target))
+	if err := h.store.Failover(c.Request.Context(), target); err != nil {
+		switch {
+		case errors.Is(err, session.ErrNoStandby), errors.Is(err, session.ErrInvalidTarget):
+			writeError(c, httperror.NewInvalidInput(err.Error()))
+		default:
+			h.logger.Error("session_store_failover_failed", "target", target, "err", err)
+			writeError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, h.store.ReplicationStatus())
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/shadow"
+)
+
+// ShadowVerifyReportResponse: 정답 판정 후보 프롬프트 일치도 보고서 응답입니다.
+type ShadowVerifyReportResponse struct {
+	shadow.Report
+	Enabled    bool         `json:"enabled"`
+	SampleRate float64      `json:"sample_rate"`
+	Runtime    shadow.Stats `json:"runtime"` // 서버 기동 이후 누적 (재시작 시 초기화)
+}
+
+// ShadowHandler: 섀도 평가 보고서 API 핸들러입니다.
+type ShadowHandler struct {
+	cfg       *config.Config
+	repo      *shadow.Repository
+	evaluator *shadow.Evaluator
+	logger    *slog.Logger
+}
+
+// NewShadowHandler: 섀도 평가 핸들러를 생성합니다.
+func NewShadowHandler(cfg *config.Config, repo *shadow.Repository, evaluator *shadow.Evaluator, logger *slog.Logger) *ShadowHandler {
+	return &ShadowHandler{
+		cfg:       cfg,
+		repo:      repo,
+		evaluator: evaluator,
+		logger:    logger,
+	}
+}
+
+// RegisterRoutes: 섀도 평가 라우트를 등록합니다.
+func (h *ShadowHandler) RegisterRoutes(router *gin.Engine) {
+	group := router.Group("/api/shadow")
+	group.GET("/verify/report", h.handleVerifyReport)
+}
+
+// handleVerifyReport: 최근 N일(기본 7일) 현재/후보 판정 일치도를 반환합니다.
+// label을 지정하면 이전 후보 프롬프트의 기록도 조회할 수 있습니다.
+func (h *ShadowHandler) handleVerifyReport(c *gin.Context) {
+	days, ok := parseDays(c, 7)
+	if !ok {
+		return
+	}
+
+	label := strings.TrimSpace(c.Query("label"))
+	if label == "" {
+		label = h.cfg.Shadow.CandidateLabel
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	report, err := h.repo.Report(c.Request.Context(), label, since)
+	if err != nil {
+		h.logger.Warn("shadow_report_failed", "err", err)
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ShadowVerifyReportResponse{
+		Report:     report,
+		Enabled:    h.evaluator != nil,
+		SampleRate: h.cfg.Shadow.SampleRate,
+		Runtime:    h.evaluator.Stats(),
+	})
+}
@@ -3,6 +3,7 @@ package shared
 import (
 	"errors"
 	"io"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
@@ -15,6 +16,9 @@ func WriteError(c *gin.Context, err error) {
 	if c == nil {
 		return
 	}
+	if seconds, ok := httperror.FromError(err).RetryAfterSeconds(); ok {
+		c.Header("Retry-After", strconv.Itoa(seconds))
+	}
 	status, payload := httperror.Response(err, middleware.GetRequestID(c))
 	c.JSON(status, payload)
 }
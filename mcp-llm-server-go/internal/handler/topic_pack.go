@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/topicpack"
+)
+
+// TopicPackRequest: 카테고리 팩 생성/수정 요청입니다.
+type TopicPackRequest struct {
+	Category       string            `json:"category" binding:"required"`
+	Topics         []topicpack.Topic `json:"topics" binding:"required"`
+	DifficultyTags []string          `json:"difficulty_tags"`
+	Enabled        *bool             `json:"enabled"`
+}
+
+// TopicPackHandler: TwentyQ 카테고리 팩 관리 API 핸들러입니다.
+type TopicPackHandler struct {
+	repo      *topicpack.Repository
+	refresher *topicpack.Refresher
+	logger    *slog.Logger
+}
+
+// NewTopicPackHandler: 카테고리 팩 핸들러를 생성합니다.
+func NewTopicPackHandler(repo *topicpack.Repository, refresher *topicpack.Refresher, logger *slog.Logger) *TopicPackHandler {
+	return &TopicPackHandler{repo: repo, refresher: refresher, logger: logger}
+}
+
+// RegisterRoutes: 카테고리 팩 라우트를 등록합니다.
+// 변경 사항은 /refresh 호출 뒤에 토픽 선택에 반영됩니다.
+func (h *TopicPackHandler) RegisterRoutes(router *gin.Engine) {
+	group := router.Group("/api/twentyq/topic-packs")
+	group.GET("", h.handleList)
+	group.POST("", h.handleCreate)
+	group.POST("/refresh", h.handleRefresh)
+	group.GET("/:id", h.handleGet)
+	group.PUT("/:id", h.handleUpdate)
+	group.DELETE("/:id", h.handleDelete)
+}
+
+func (h *TopicPackHandler) handleList(c *gin.Context) {
+	packs, err := h.repo.List(c.Request.Context())
+	if err != nil {
+		h.logger.Warn("topic_pack_list_failed", "err", err)
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"packs": packs, "last_refresh": h.refresher.Last()})
+}
+
+func (h *TopicPackHandler) handleGet(c *gin.Context) {
+	id, ok := parsePackID(c)
+	if !ok {
+		return
+	}
+	pack, err := h.repo.Get(c.Request.Context(), id)
+	if err != nil {
+		h.writePackError(c, id, err)
+		return
+	}
+	c.JSON(http.StatusOK, pack)
+}
+
+func (h *TopicPackHandler) handleCreate(c *gin.Context) {
+	var req TopicPackRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	pack := req.toPack()
+	if err := topicpack.Normalize(pack); err != nil {
+		writeError(c, httperror.NewInvalidInput(err.Error()))
+		return
+	}
+	if err := h.repo.Create(c.Request.Context(), pack); err != nil {
+		h.writePackError(c, 0, err)
+		return
+	}
+
+	h.logger.Info("topic_pack_created", "id", pack.ID, "category", pack.Category, "topics", len(pack.Topics))
+	c.JSON(http.StatusCreated, pack)
+}
+
+func (h *TopicPackHandler) handleUpdate(c *gin.Context) {
+	id, ok := parsePackID(c)
+	if !ok {
+		return
+	}
+	var req TopicPackRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	pack := req.toPack()
+	pack.ID = id
+	if err := topicpack.Normalize(pack); err != nil {
+		writeError(c, httperror.NewInvalidInput(err.Error()))
+		return
+	}
+	if err := h.repo.Update(c.Request.Context(), pack); err != nil {
+		h.writePackError(c, id, err)
+		return
+	}
+
+	h.logger.Info("topic_pack_updated", "id", pack.ID, "category", pack.Category, "topics", len(pack.Topics), "enabled", pack.Enabled)
+	c.JSON(http.StatusOK, pack)
+}
+
+func (h *TopicPackHandler) handleDelete(c *gin.Context) {
+	id, ok := parsePackID(c)
+	if !ok {
+		return
+	}
+	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
+		h.writePackError(c, id, err)
+		return
+	}
+
+	h.logger.Info("topic_pack_deleted", "id", id)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TopicPackHandler) handleRefresh(c *gin.Context) {
+	result, err := h.refresher.Refresh(c.Request.Context())
+	if err != nil {
+		h.logger.Warn("topic_pack_refresh_failed", "err", err)
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *TopicPackHandler) writePackError(c *gin.Context, id int64, err error) {
+	switch {
+	case errors.Is(err, topicpack.ErrPackNotFound):
+		writeError(c, &httperror.Error{
+			Code:    httperror.ErrorCodeInvalidInput,
+			Status:  http.StatusNotFound,
+			Type:    "NotFoundError",
+			Message: fmt.Sprintf("topic pack %d not found", id),
+			Details: map[string]any{"id": id},
+		})
+	case errors.Is(err, topicpack.ErrDuplicateCategory):
+		writeError(c, &httperror.Error{
+			Code:    httperror.ErrorCodeInvalidInput,
+			Status:  http.StatusConflict,
+			Type:    "ConflictError",
+			Message: err.Error(),
+		})
+	default:
+		h.logger.Error("topic_pack_store_failed", "id", id, "err", err)
+		writeError(c, err)
+	}
+}
+
+// toPack: 요청을 저장용 팩으로 변환합니다. enabled를 생략하면 활성 상태로 저장합니다.
+func (r TopicPackRequest) toPack() *topicpack.Pack {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return &topicpack.Pack{
+		Category:       r.Category,
+		Topics:         r.Topics,
+		DifficultyTags: r.DifficultyTags,
+		Enabled:        enabled,
+	}
+}
+
+func parsePackID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(c, httperror.NewInvalidInput("id must be a positive integer"))
+		return 0, false
+	}
+	return id, true
+}
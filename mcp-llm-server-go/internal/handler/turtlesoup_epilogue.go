@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	turtlesoupuc "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usecase/turtlesoup"
+)
+
+func (h *TurtleSoupHandler) handleEpilogue(c *gin.Context) {
+	var req TurtleSoupEpilogueRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	out, err := h.usecase.GenerateEpilogue(c.Request.Context(), turtlesoupuc.EpilogueRequest{
+		Scenario: req.Scenario,
+		Solution: req.Solution,
+	})
+	if err != nil {
+		h.logError(err)
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TurtleSoupEpilogueResponse{
+		Epilogue: out.Epilogue,
+		Question: out.Question,
+		Answer:   out.Answer,
+	})
+}
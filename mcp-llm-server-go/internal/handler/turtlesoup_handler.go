@@ -45,6 +45,7 @@ func (h *TurtleSoupHandler) RegisterRoutes(router *gin.Engine) {
 	group.POST("/hints", h.handleHint)
 	group.POST("/validations", h.handleValidate)
 	group.POST("/reveals", h.handleReveal)
+	group.POST("/epilogues", h.handleEpilogue)
 	group.POST("/puzzles", h.handleGenerate)
 	group.POST("/rewrites", h.handleRewrite)
 	group.GET("/puzzles", h.handlePuzzles)
@@ -199,6 +199,39 @@ func TestTurtleSoupHintStructured(t *testing.T) {
 	}
 }
 
+func TestTurtleSoupEpilogueClampsLength(t *testing.T) {
+	client := fakeLLMClient{
+		structuredFn: func(ctx context.Context, req gemini.Request, schema map[string]any) (map[string]any, string, error) {
+			return map[string]any{
+				"epilogue": strings.Repeat("후", domain.EpilogueMaxRunes*2),
+				"question": "그는 어디로 갔을까요?",
+				"answer":   "바다",
+			}, "gemini-3-test", nil
+		},
+	}
+	_, router := newTestTurtleSoupHandler(t, client)
+
+	reqBody, _ := json.Marshal(map[string]any{
+		"scenario": "scenario",
+		"solution": "solution",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/turtle-soup/epilogues", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.Code)
+	}
+
+	var out TurtleSoupEpilogueResponse
+	if err := json.Unmarshal(resp.Body.Bytes(), &out); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len([]rune(out.Epilogue)) != domain.EpilogueMaxRunes || out.Question != "그는 어디로 갔을까요?" || out.Answer != "바다" {
+		t.Fatalf("unexpected response: %+v", out)
+	}
+}
+
 func TestTurtleSoupValidateReturnsEnum(t *testing.T) {
 	client := fakeLLMClient{
 		structuredFn: func(ctx context.Context, req gemini.Request, schema map[string]any) (map[string]any, string, error) {
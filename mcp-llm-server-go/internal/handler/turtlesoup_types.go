@@ -69,6 +69,22 @@ type TurtleSoupRevealResponse struct {
 	Narrative string `json:"narrative"`
 }
 
+// TurtleSoupEpilogueRequest: 후일담(보너스 라운드) 요청 본문입니다.
+type TurtleSoupEpilogueRequest struct {
+	SessionID *string `json:"session_id"`
+	ChatID    *string `json:"chat_id"`
+	Namespace *string `json:"namespace"`
+	Scenario  string  `json:"scenario" binding:"required"`
+	Solution  string  `json:"solution" binding:"required"`
+}
+
+// TurtleSoupEpilogueResponse: 후일담 응답 본문입니다. answer는 봇이 보너스 답을 판정할 때만 씁니다.
+type TurtleSoupEpilogueResponse struct {
+	Epilogue string `json:"epilogue"`
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
 // TurtleSoupPuzzleGenerationRequest: 퍼즐 생성 요청 본문입니다.
 type TurtleSoupPuzzleGenerationRequest struct {
 	Category   *string `json:"category,omitempty"`
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/middleware"
+	twentyquc "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usecase/twentyq"
+)
+
+func (h *TwentyQHandler) handleBatchHints(c *gin.Context) {
+	var req TwentyQBatchHintsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	items := make([]twentyquc.HintsRequest, len(req.Items))
+	for i, item := range req.Items {
+		var feedback *twentyquc.HintFeedback
+		if item.Feedback != nil {
+			feedback = &twentyquc.HintFeedback{
+				Useful:          item.Feedback.Useful,
+				Useless:         item.Feedback.Useless,
+				UselessExamples: item.Feedback.UselessExamples,
+			}
+		}
+		items[i] = twentyquc.HintsRequest{
+			Target:   item.Target,
+			Category: item.Category,
+			Details:  item.Details,
+			Feedback: feedback,
+		}
+	}
+
+	results, err := h.usecase.BatchGenerateHints(c.Request.Context(), middleware.GetRequestID(c), items)
+	if err != nil {
+		h.logError(err)
+		writeError(c, err)
+		return
+	}
+
+	resp := TwentyQBatchHintsResponse{Results: make([]TwentyQBatchHintResult, len(results))}
+	for i, result := range results {
+		item := TwentyQBatchHintResult{
+			Target:   result.Target,
+			Category: result.Category,
+			Hints:    result.Hints,
+		}
+		if item.Hints == nil {
+			item.Hints = []string{}
+		}
+		if result.Err != nil {
+			apiErr := httperror.FromError(result.Err)
+			code, message := string(apiErr.Code), apiErr.Message
+			item.ErrorCode = &code
+			item.ErrorMessage = &message
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Results[i] = item
+	}
+	c.JSON(http.StatusOK, resp)
+}
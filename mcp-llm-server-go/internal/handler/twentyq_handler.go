@@ -10,7 +10,9 @@ import (
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/handler/shared"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/session"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/shadow"
 	twentyquc "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usecase/twentyq"
 )
 
@@ -30,10 +32,12 @@ type TwentyQHandler struct {
 func NewTwentyQHandler(
 	cfg *config.Config,
 	client *gemini.Client,
+	provider llm.Provider,
 	injectionGuard *guard.InjectionGuard,
 	store *session.Store,
 	prompts *twentyq.Prompts,
 	topicLoader *twentyq.TopicLoader,
+	shadowEvaluator *shadow.Evaluator,
 	logger *slog.Logger,
 ) *TwentyQHandler {
 	h := &TwentyQHandler{
@@ -45,7 +49,7 @@ func NewTwentyQHandler(
 		topicLoader: topicLoader,
 		logger:      logger,
 	}
-	h.usecase = twentyquc.New(cfg, client, injectionGuard, store, prompts, topicLoader, logger)
+	h.usecase = twentyquc.New(cfg, client, provider, injectionGuard, store, prompts, topicLoader, shadowEvaluator, logger)
 	return h
 }
 
@@ -53,10 +57,12 @@ func NewTwentyQHandler(
 func (h *TwentyQHandler) RegisterRoutes(router *gin.Engine) {
 	group := router.Group("/api/twentyq")
 	group.POST("/hints", h.handleHints)
+	group.POST("/hints/batch", h.handleBatchHints)
 	group.POST("/answers", h.handleAnswer)
 	group.POST("/verifications", h.handleVerify)
 	group.POST("/normalizations", h.handleNormalize)
 	group.POST("/synonym-checks", h.handleSynonym)
+	group.POST("/summaries", h.handleSummary)
 	group.POST("/topics/select", h.handleSelectTopic)
 	group.GET("/topics/categories", h.handleCategories)
 }
@@ -15,10 +15,19 @@ func (h *TwentyQHandler) handleHints(c *gin.Context) {
 		return
 	}
 
+	var feedback *twentyquc.HintFeedback
+	if req.Feedback != nil {
+		feedback = &twentyquc.HintFeedback{
+			Useful:          req.Feedback.Useful,
+			Useless:         req.Feedback.Useless,
+			UselessExamples: req.Feedback.UselessExamples,
+		}
+	}
 	hints, err := h.usecase.GenerateHints(c.Request.Context(), middleware.GetRequestID(c), twentyquc.HintsRequest{
 		Target:   req.Target,
 		Category: req.Category,
 		Details:  req.Details,
+		Feedback: feedback,
 	})
 	if err != nil {
 		h.logError(err)
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/middleware"
+	twentyquc "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usecase/twentyq"
+)
+
+func (h *TwentyQHandler) handleSummary(c *gin.Context) {
+	var req TwentyQSummaryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	history := make([]twentyquc.SummaryEntry, 0, len(req.History))
+	for _, entry := range req.History {
+		history = append(history, twentyquc.SummaryEntry{Question: entry.Question, Answer: entry.Answer})
+	}
+	result, err := h.usecase.SummarizeGame(c.Request.Context(), middleware.GetRequestID(c), twentyquc.SummaryRequest{
+		Category:     req.Category,
+		History:      history,
+		Hints:        req.Hints,
+		WrongGuesses: req.WrongGuesses,
+	})
+	if err != nil {
+		h.logError(err)
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TwentyQSummaryResponse{
+		Summary:    result.Summary,
+		Eliminated: result.Eliminated,
+	})
+}
@@ -5,6 +5,15 @@ type TwentyQHintsRequest struct {
 	Target   string         `json:"target" binding:"required"`
 	Category string         `json:"category" binding:"required"`
 	Details  map[string]any `json:"details"`
+	// Feedback: 같은 카테고리 지난 힌트의 플레이어 평가 집계 (선택)
+	Feedback *TwentyQHintFeedback `json:"feedback,omitempty"`
+}
+
+// TwentyQHintFeedback: 지난 힌트 평가 집계입니다.
+type TwentyQHintFeedback struct {
+	Useful          int      `json:"useful"`
+	Useless         int      `json:"useless"`
+	UselessExamples []string `json:"useless_examples,omitempty"`
 }
 
 // TwentyQHintsResponse: 힌트 응답 본문입니다.
@@ -13,6 +22,35 @@ type TwentyQHintsResponse struct {
 	ThoughtSignature *string  `json:"thought_signature"`
 }
 
+// TwentyQBatchHintsRequest: 일괄 힌트 요청 본문입니다. 항목 검증은 항목별 결과로 돌려줍니다.
+type TwentyQBatchHintsRequest struct {
+	Items []TwentyQHintsItem `json:"items" binding:"required"`
+}
+
+// TwentyQHintsItem: 일괄 힌트 요청의 항목입니다.
+type TwentyQHintsItem struct {
+	Target   string               `json:"target"`
+	Category string               `json:"category"`
+	Details  map[string]any       `json:"details"`
+	Feedback *TwentyQHintFeedback `json:"feedback,omitempty"`
+}
+
+// TwentyQBatchHintResult: 일괄 힌트 항목별 결과입니다.
+type TwentyQBatchHintResult struct {
+	Target       string   `json:"target"`
+	Category     string   `json:"category"`
+	Hints        []string `json:"hints"`
+	ErrorCode    *string  `json:"error_code,omitempty"`
+	ErrorMessage *string  `json:"error_message,omitempty"`
+}
+
+// TwentyQBatchHintsResponse: 일괄 힌트 응답 본문입니다. (요청 items 순서)
+type TwentyQBatchHintsResponse struct {
+	Results   []TwentyQBatchHintResult `json:"results"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+}
+
 // TwentyQAnswerRequest: 정답 요청 본문입니다.
 type TwentyQAnswerRequest struct {
 	SessionID *string        `json:"session_id"`
@@ -65,3 +103,23 @@ type TwentyQSynonymResponse struct {
 	Result  *string `json:"result"`
 	RawText string  `json:"raw_text"`
 }
+
+// TwentyQHistoryEntry: 요약 요청의 질문/답변 한 쌍입니다.
+type TwentyQHistoryEntry struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+// TwentyQSummaryRequest: 진행 요약 요청 본문입니다. 정답은 받지 않습니다.
+type TwentyQSummaryRequest struct {
+	Category     string                `json:"category"`
+	History      []TwentyQHistoryEntry `json:"history"`
+	Hints        []string              `json:"hints"`
+	WrongGuesses []string              `json:"wrong_guesses"`
+}
+
+// TwentyQSummaryResponse: 진행 요약 응답 본문입니다.
+type TwentyQSummaryResponse struct {
+	Summary    string   `json:"summary"`
+	Eliminated []string `json:"eliminated"`
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -34,18 +36,26 @@ type UsageListResponse struct {
 	Model             string               `json:"model"`
 }
 
+// LiveUsageTick: 실시간 사용량 스트림의 단일 이벤트입니다.
+type LiveUsageTick struct {
+	usage.LiveTick
+	Model string `json:"model"`
+}
+
 // UsageHandler: 사용량 API 핸들러입니다.
 type UsageHandler struct {
 	cfg    *config.Config
 	repo   *usage.Repository
+	live   *usage.LiveFeed
 	logger *slog.Logger
 }
 
 // NewUsageHandler: 사용량 핸들러를 생성합니다.
-func NewUsageHandler(cfg *config.Config, repo *usage.Repository, logger *slog.Logger) *UsageHandler {
+func NewUsageHandler(cfg *config.Config, repo *usage.Repository, live *usage.LiveFeed, logger *slog.Logger) *UsageHandler {
 	return &UsageHandler{
 		cfg:    cfg,
 		repo:   repo,
+		live:   live,
 		logger: logger,
 	}
 }
@@ -56,6 +66,7 @@ func (h *UsageHandler) RegisterRoutes(router *gin.Engine) {
 	group.GET("/daily", h.handleDaily)
 	group.GET("/recent", h.handleRecent)
 	group.GET("/total", h.handleTotal)
+	group.GET("/live", h.handleLive)
 }
 
 func (h *UsageHandler) handleDaily(c *gin.Context) {
@@ -107,6 +118,57 @@ func (h *UsageHandler) handleTotal(c *gin.Context) {
 	})
 }
 
+// handleLive: 집계 주기마다 토큰 사용량 증분을 SSE(text/event-stream)로 푸시합니다.
+func (h *UsageHandler) handleLive(c *gin.Context) {
+	ticks, unsubscribe := h.live.Subscribe()
+	defer unsubscribe()
+
+	// 서버 WriteTimeout이 장시간 스트림을 끊지 않도록 해제
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	model := h.cfg.Gemini.DefaultModel
+	retryMillis := (2 * h.live.Interval()).Milliseconds()
+	if _, err := fmt.Fprintf(c.Writer, "retry: %d\n\n", retryMillis); err != nil {
+		return
+	}
+	c.Writer.Flush()
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case tick, ok := <-ticks:
+			if !ok {
+				return
+			}
+			if err := writeLiveTick(c.Writer, LiveUsageTick{LiveTick: tick, Model: model}); err != nil {
+				h.logger.Debug("usage_live_write_failed", "err", err)
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+func writeLiveTick(w http.ResponseWriter, tick LiveUsageTick) error {
+	payload, err := json.Marshal(tick)
+	if err != nil {
+		return fmt.Errorf("marshal live tick: %w", err)
+	}
+	if _, err := fmt.Fprintf(w, "event: usage\ndata: %s\n\n", payload); err != nil {
+		return fmt.Errorf("write live tick: %w", err)
+	}
+	return nil
+}
+
 func (h *UsageHandler) buildDailyResponse(usageRow *usage.DailyUsage) DailyUsageResponse {
 	model := h.cfg.Gemini.DefaultModel
 	if usageRow == nil {
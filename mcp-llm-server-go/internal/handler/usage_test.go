@@ -1,8 +1,13 @@
 package handler
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -74,3 +79,57 @@ func TestBuildUsageListResponse(t *testing.T) {
 		t.Fatalf("unexpected totals: %+v", resp)
 	}
 }
+
+func TestHandleLiveStreamsTicks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	feed := usage.NewLiveFeed(20 * time.Millisecond)
+	feed.Start()
+	defer feed.Stop()
+
+	cfg := &config.Config{Gemini: config.GeminiConfig{DefaultModel: "gemini-3-test"}}
+	handler := NewUsageHandler(cfg, nil, feed, slog.New(slog.DiscardHandler))
+	router := gin.New()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/usage/live")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("unexpected content type: %q", ct)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	if line, _ := reader.ReadString('\n'); !strings.HasPrefix(line, "retry:") {
+		t.Fatalf("expected retry preamble, got %q", line)
+	}
+
+	// 프리앰블 이후에는 구독이 등록되어 있으므로 다음 구간에 반영됨
+	recorder := usage.NewRecorder(nil, nil, nil)
+	recorder.SetLiveFeed(feed)
+	recorder.Record(context.Background(), 7, 3, 1)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("stream ended before usage tick: %v", err)
+		}
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var tick LiveUsageTick
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &tick); err != nil {
+			t.Fatalf("invalid payload %q: %v", line, err)
+		}
+		if tick.RequestCount == 0 {
+			continue
+		}
+		if tick.TotalTokens != 10 || tick.ReasoningTokens != 1 || tick.Model != "gemini-3-test" {
+			t.Fatalf("unexpected tick: %+v", tick)
+		}
+		return
+	}
+}
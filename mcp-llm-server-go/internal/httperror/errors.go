@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 
@@ -25,12 +27,16 @@ const (
 	ErrorCodeUnauthorized ErrorCode = "UNAUTHORIZED"
 	// ErrorCodeHTTPRateLimit 는 요청 제한 오류 코드다.
 	ErrorCodeHTTPRateLimit ErrorCode = "HTTP_RATE_LIMIT"
+	// ErrorCodeQuotaExhausted 는 봇별 일일 할당량 소진 코드다.
+	ErrorCodeQuotaExhausted ErrorCode = "QUOTA_EXHAUSTED"
 	// ErrorCodeLLM 는 LLM 오류 코드다.
 	ErrorCodeLLM ErrorCode = "LLM_ERROR"
 	// ErrorCodeLLMTimeout 는 LLM 타임아웃 코드다.
 	ErrorCodeLLMTimeout ErrorCode = "LLM_TIMEOUT"
 	// ErrorCodeLLMParsing 는 LLM 파싱 오류 코드다.
 	ErrorCodeLLMParsing ErrorCode = "LLM_PARSING_ERROR"
+	// ErrorCodeLLMOverloaded 는 LLM 호출 대기열 포화 코드다.
+	ErrorCodeLLMOverloaded ErrorCode = "LLM_OVERLOADED"
 	// ErrorCodeLLMModel 는 LLM 모델 오류 코드다.
 	ErrorCodeLLMModel ErrorCode = "LLM_MODEL_ERROR"
 	// ErrorCodeSession 는 세션 오류 코드다.
@@ -117,6 +123,11 @@ func FromError(err error) *Error {
 		return NewSessionError("Session not found", http.StatusNotFound)
 	}
 
+	var queueFull *gemini.QueueFullError
+	if errors.As(err, &queueFull) {
+		return NewLLMOverloaded(queueFull.Priority.String(), queueFull.RetryAfter)
+	}
+
 	if errors.Is(err, gemini.ErrInvalidModel) {
 		return NewLLMModelError("Invalid model")
 	}
@@ -203,6 +214,40 @@ func NewRateLimitExceeded(details map[string]any) *Error {
 	}
 }
 
+// NewQuotaExhausted: 봇별 일일 할당량 소진 오류를 생성합니다.
+func NewQuotaExhausted(details map[string]any) *Error {
+	return &Error{
+		Code:    ErrorCodeQuotaExhausted,
+		Status:  http.StatusTooManyRequests,
+		Type:    "QuotaExhaustedError",
+		Message: "Daily quota exhausted",
+		Details: details,
+	}
+}
+
+// NewLLMOverloaded: LLM 호출 대기열 포화 오류를 생성합니다. retry_after_seconds는 Retry-After 헤더로도 내보낸다.
+func NewLLMOverloaded(priority string, retryAfter time.Duration) *Error {
+	return &Error{
+		Code:    ErrorCodeLLMOverloaded,
+		Status:  http.StatusTooManyRequests,
+		Type:    "LLMOverloadedError",
+		Message: "LLM request queue is full",
+		Details: map[string]any{
+			"priority":            priority,
+			"retry_after_seconds": int(math.Ceil(retryAfter.Seconds())),
+		},
+	}
+}
+
+// RetryAfterSeconds: 오류에 재시도 대기 시간이 있으면 초 단위로 반환합니다.
+func (e *Error) RetryAfterSeconds() (int, bool) {
+	if e == nil {
+		return 0, false
+	}
+	seconds, ok := e.Details["retry_after_seconds"].(int)
+	return seconds, ok && seconds > 0
+}
+
 // NewGuardBlocked: 가드 차단 오류를 생성합니다.
 func NewGuardBlocked(score float64, threshold float64) *Error {
 	return &Error{
@@ -3,8 +3,10 @@ package httperror
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/guard"
@@ -31,6 +33,17 @@ func TestFromErrorMapping(t *testing.T) {
 	if apiErr == nil || apiErr.Code != ErrorCodeLLMTimeout {
 		t.Fatalf("expected timeout error")
 	}
+
+	apiErr = FromError(fmt.Errorf("wrapped: %w", &gemini.QueueFullError{RetryAfter: 2500 * time.Millisecond}))
+	if apiErr == nil || apiErr.Code != ErrorCodeLLMOverloaded || apiErr.Status != http.StatusTooManyRequests {
+		t.Fatalf("expected overloaded error with 429, got %+v", apiErr)
+	}
+	if seconds, ok := apiErr.RetryAfterSeconds(); !ok || seconds != 3 {
+		t.Fatalf("expected retry after 3s, got %d (%v)", seconds, ok)
+	}
+	if _, ok := NewInternalError("x").RetryAfterSeconds(); ok {
+		t.Fatalf("internal error must not carry retry after")
+	}
 }
 
 func TestResponseIncludesRequestID(t *testing.T) {
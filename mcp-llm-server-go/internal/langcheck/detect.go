@@ -0,0 +1,73 @@
+package langcheck
+
+import "unicode"
+
+// 판정 결과 언어 코드입니다.
+const (
+	LangKorean   = "ko"
+	LangJapanese = "ja"
+	LangChinese  = "zh"
+	LangEnglish  = "en"
+	LangUnknown  = "unknown"
+)
+
+// kanaMixRatio: 가나 비율이 이 값 이상이면 한국어 위주라도 일본어 혼용으로 판정합니다.
+const kanaMixRatio = 0.1
+
+// Detection: 문자 체계(스크립트)별 글자 수와 판정 언어입니다.
+type Detection struct {
+	Lang    string
+	Letters int
+	Hangul  int
+	Kana    int
+	Han     int
+	Latin   int
+}
+
+// HangulRatio: 전체 글자 중 한글 비율을 반환합니다. (0.0 ~ 1.0)
+func (d Detection) HangulRatio() float64 {
+	if d.Letters == 0 {
+		return 0
+	}
+	return float64(d.Hangul) / float64(d.Letters)
+}
+
+// Detect: 문자 체계 분포로 텍스트 언어를 판정합니다.
+// 모델 호출 없이 한 번의 순회로 끝나며, minLetters보다 글자가 적으면 LangUnknown을 반환합니다.
+func Detect(text string, minLetters int, minHangulRatio float64) Detection {
+	var d Detection
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Hangul, r):
+			d.Hangul++
+		case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+			d.Kana++
+		case unicode.Is(unicode.Han, r):
+			d.Han++
+		case unicode.Is(unicode.Latin, r):
+			d.Latin++
+		default:
+			continue
+		}
+		d.Letters++
+	}
+
+	switch {
+	case d.Letters < minLetters:
+		d.Lang = LangUnknown
+	case float64(d.Kana) >= float64(d.Letters)*kanaMixRatio:
+		d.Lang = LangJapanese
+	case d.HangulRatio() >= minHangulRatio:
+		d.Lang = LangKorean
+	case d.Han > d.Latin:
+		d.Lang = LangChinese
+	default:
+		d.Lang = LangEnglish
+	}
+	return d
+}
+
+// Acceptable: 한국어이거나 판정 불가(짧은 출력)이면 true를 반환합니다.
+func (d Detection) Acceptable() bool {
+	return d.Lang == LangKorean || d.Lang == LangUnknown
+}
@@ -0,0 +1,62 @@
+package langcheck
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "korean", text: "오늘 방송은 저녁 여덟 시에 시작합니다.", want: LangKorean},
+		{name: "korean with short english", text: "이번 힌트는 ASMR 방송과 관련이 있어요.", want: LangKorean},
+		{name: "english", text: "The stream starts at eight in the evening.", want: LangEnglish},
+		{name: "japanese", text: "今日の配信は夜八時から始まります。", want: LangJapanese},
+		{name: "korean mixed with kana", text: "정답은 ぺこらの配信 입니다", want: LangJapanese},
+		{name: "chinese", text: "今天的直播晚上八点开始了大家来看", want: LangChinese},
+		{name: "too short", text: "Yes", want: LangUnknown},
+		{name: "empty", text: "", want: LangUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Detect(tt.text, 8, 0.5)
+			if got.Lang != tt.want {
+				t.Fatalf("Detect(%q) = %s (%+v), want %s", tt.text, got.Lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameLocalizer(t *testing.T) {
+	localizer, err := NewNameLocalizer()
+	if err != nil {
+		t.Fatalf("new name localizer: %v", err)
+	}
+	if localizer.Size() == 0 {
+		t.Fatal("expected embedded talent names")
+	}
+
+	got, changed := localizer.Localize("오늘은 Houshou Marine와 宝鐘マリン 이야기")
+	if !changed {
+		t.Fatal("expected names to be localized")
+	}
+	if want := "오늘은 호쇼 마린와 호쇼 마린 이야기"; got != want {
+		t.Fatalf("Localize() = %q, want %q", got, want)
+	}
+
+	if _, changed := localizer.Localize("이름이 없는 문장"); changed {
+		t.Fatal("expected no change")
+	}
+}
+
+func TestNameLocalizer_PrefersLongestName(t *testing.T) {
+	localizer := newNameLocalizer([]talentNameEntry{
+		{Ko: "나키리 아야메", Names: []string{"Nakiri Ayame", "Nakiri Ayame 百鬼あやめ"}},
+	})
+
+	got, _ := localizer.Localize("Nakiri Ayame 百鬼あやめ 방송")
+	if want := "나키리 아야메 방송"; got != want {
+		t.Fatalf("Localize() = %q, want %q", got, want)
+	}
+}
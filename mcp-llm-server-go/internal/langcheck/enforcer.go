@@ -0,0 +1,226 @@
+package langcheck
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+)
+
+// repairInstruction: 언어 위반 시 재요청 프롬프트 끝에 붙이는 교정 지시입니다.
+const repairInstruction = "[언어 교정] 답변은 한국어로 작성하세요. 영어나 일본어 문장을 섞지 말고, 인물 이름도 한국어 표기로 쓰세요."
+
+// exemptFields: Structured 응답에서 언어 검사/이름 치환을 하지 않는 필드입니다. (사용자에게 노출되지 않는 추론 과정)
+var exemptFields = map[string]struct{}{
+	"reasoning": {},
+}
+
+// Enforcer: 공급자 응답의 출력 언어를 검사하고, 한국어가 아니면 교정 지시를 붙여 재요청하는 Provider 래퍼입니다.
+// 검사 전에 탤런트 이름을 한국어 표기로 치환하므로, 이름만 외국어로 남은 응답은 위반으로 보지 않습니다.
+type Enforcer struct {
+	next    llm.Provider
+	cfg     config.LanguageConfig
+	names   *NameLocalizer
+	metrics *metrics.Store
+	logger  *slog.Logger
+}
+
+// Enforcer가 Provider 인터페이스를 구현하는지 컴파일 타임 확인
+var _ llm.Provider = (*Enforcer)(nil)
+
+// NewEnforcer: next 공급자를 감싸는 Enforcer를 생성합니다. names가 nil이면 이름 치환을 하지 않습니다.
+func NewEnforcer(
+	cfg config.LanguageConfig,
+	next llm.Provider,
+	names *NameLocalizer,
+	metricsStore *metrics.Store,
+	logger *slog.Logger,
+) *Enforcer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if metricsStore == nil {
+		metricsStore = metrics.NewStore()
+	}
+	return &Enforcer{
+		next:    next,
+		cfg:     cfg,
+		names:   names,
+		metrics: metricsStore,
+		logger:  logger,
+	}
+}
+
+// Name: 감싼 공급자의 식별자를 반환합니다.
+func (e *Enforcer) Name() string {
+	return e.next.Name()
+}
+
+// Chat: 텍스트 채팅 응답의 언어를 검사합니다.
+func (e *Enforcer) Chat(ctx context.Context, req llm.Request) (string, string, error) {
+	return enforce(ctx, e, req, "chat",
+		func(req llm.Request) (string, string, error) { return e.next.Chat(ctx, req) },
+		func(text string) (string, string) {
+			text = e.localize(text)
+			return text, text
+		},
+	)
+}
+
+// ChatWithUsage: 채팅 응답(Text)의 언어를 검사합니다. 추론(Reasoning)은 검사하지 않습니다.
+func (e *Enforcer) ChatWithUsage(ctx context.Context, req llm.Request) (llm.ChatResult, string, error) {
+	return enforce(ctx, e, req, "chat_with_usage",
+		func(req llm.Request) (llm.ChatResult, string, error) { return e.next.ChatWithUsage(ctx, req) },
+		func(result llm.ChatResult) (llm.ChatResult, string) {
+			result.Text = e.localize(result.Text)
+			return result, result.Text
+		},
+	)
+}
+
+// Structured: 스키마에서 자유 텍스트인 문자열 필드만 골라 언어를 검사합니다.
+// enum 제약 필드와 추론 필드는 제외합니다.
+func (e *Enforcer) Structured(ctx context.Context, req llm.Request, schema map[string]any) (map[string]any, string, error) {
+	fields := textFields(schema)
+	return enforce(ctx, e, req, "structured",
+		func(req llm.Request) (map[string]any, string, error) { return e.next.Structured(ctx, req, schema) },
+		func(payload map[string]any) (map[string]any, string) {
+			return payload, e.localizeFields(payload, fields)
+		},
+	)
+}
+
+// enforce: 응답을 받아 이름 치환 → 언어 판정을 하고, 위반이면 MaxRetries까지 교정 지시를 붙여 재요청합니다.
+// 재요청이 실패하거나 끝까지 위반이면 마지막 응답을 그대로 반환합니다. (언어 위반으로 요청 자체를 실패시키지 않음)
+func enforce[T any](
+	ctx context.Context,
+	e *Enforcer,
+	req llm.Request,
+	op string,
+	call func(req llm.Request) (T, string, error),
+	inspect func(result T) (T, string),
+) (T, string, error) {
+	result, model, err := call(req)
+	if err != nil {
+		return result, model, err
+	}
+	result, text := inspect(result)
+	if !e.cfg.Enabled {
+		return result, model, nil
+	}
+
+	for attempt := 0; ; attempt++ {
+		detection := Detect(text, e.cfg.MinLetters, e.cfg.MinHangulRatio)
+		ok := detection.Acceptable()
+		e.metrics.RecordLanguageCheck(!ok)
+		if attempt > 0 {
+			e.metrics.RecordLanguageRetry(ok)
+		}
+		if ok {
+			if attempt > 0 {
+				e.logger.Info("llm_language_recovered", "op", op, "task", req.Task, "attempts", attempt)
+			}
+			return result, model, nil
+		}
+
+		if attempt >= e.cfg.MaxRetries {
+			e.logger.Warn("llm_language_enforcement_exhausted",
+				"op", op, "task", req.Task, "lang", detection.Lang, "hangul_ratio", detection.HangulRatio(), "retries", attempt)
+			return result, model, nil
+		}
+
+		e.logger.Info("llm_language_violation",
+			"op", op, "task", req.Task, "lang", detection.Lang, "hangul_ratio", detection.HangulRatio(), "attempt", attempt+1)
+
+		retried, retriedModel, retryErr := call(withRepairInstruction(req))
+		if retryErr != nil {
+			e.metrics.RecordLanguageRetry(false)
+			if ctx.Err() == nil {
+				e.logger.Warn("llm_language_retry_failed", "op", op, "task", req.Task, "err", retryErr)
+			}
+			return result, model, nil
+		}
+		result, model = retried, retriedModel
+		result, text = inspect(result)
+	}
+}
+
+func withRepairInstruction(req llm.Request) llm.Request {
+	req.Prompt = strings.TrimRight(req.Prompt, "\n") + "\n\n" + repairInstruction
+	return req
+}
+
+func (e *Enforcer) localize(text string) string {
+	if !e.cfg.LocalizeNames {
+		return text
+	}
+	localized, changed := e.names.Localize(text)
+	if changed {
+		e.metrics.RecordNamesLocalized()
+	}
+	return localized
+}
+
+// localizeFields: 검사 대상 필드의 이름을 치환하고(payload를 직접 수정), 판정에 쓸 텍스트를 이어 붙여 반환합니다.
+func (e *Enforcer) localizeFields(payload map[string]any, fields []string) string {
+	if len(payload) == 0 || len(fields) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch value := payload[field].(type) {
+		case string:
+			value = e.localize(value)
+			payload[field] = value
+			parts = append(parts, value)
+		case []any:
+			for i, item := range value {
+				text, ok := item.(string)
+				if !ok {
+					continue
+				}
+				text = e.localize(text)
+				value[i] = text
+				parts = append(parts, text)
+			}
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// textFields: 스키마 최상위 속성 중 자유 텍스트(enum 없는 string 또는 string 배열) 필드 이름을 반환합니다.
+func textFields(schema map[string]any) []string {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	fields := make([]string, 0, len(properties))
+	for name, raw := range properties {
+		if _, exempt := exemptFields[name]; exempt {
+			continue
+		}
+		prop, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if prop["type"] == "array" {
+			prop, ok = prop["items"].(map[string]any)
+			if !ok {
+				continue
+			}
+		}
+		if prop["type"] != "string" {
+			continue
+		}
+		if _, hasEnum := prop["enum"]; hasEnum {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
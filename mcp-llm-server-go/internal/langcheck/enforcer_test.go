@@ -0,0 +1,177 @@
+package langcheck
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+)
+
+type scriptedProvider struct {
+	replies []string
+	err     error
+	prompts []string
+}
+
+func (p *scriptedProvider) Name() string { return "scripted" }
+
+func (p *scriptedProvider) next(req llm.Request) (string, error) {
+	p.prompts = append(p.prompts, req.Prompt)
+	idx := len(p.prompts) - 1
+	if p.err != nil && idx > 0 {
+		return "", p.err
+	}
+	if idx >= len(p.replies) {
+		idx = len(p.replies) - 1
+	}
+	return p.replies[idx], nil
+}
+
+func (p *scriptedProvider) Chat(_ context.Context, req llm.Request) (string, string, error) {
+	text, err := p.next(req)
+	return text, "model", err
+}
+
+func (p *scriptedProvider) ChatWithUsage(ctx context.Context, req llm.Request) (llm.ChatResult, string, error) {
+	text, model, err := p.Chat(ctx, req)
+	return llm.ChatResult{Text: text, Reasoning: "thinking in English is fine"}, model, err
+}
+
+func (p *scriptedProvider) Structured(_ context.Context, req llm.Request, _ map[string]any) (map[string]any, string, error) {
+	text, err := p.next(req)
+	if err != nil {
+		return nil, "model", err
+	}
+	payload := map[string]any{
+		"reasoning": "The user asked about a cat, so the answer is yes.",
+		"answer":    "예",
+		"hints":     []any{text},
+	}
+	return payload, "model", nil
+}
+
+func newTestEnforcer(t *testing.T, provider llm.Provider, store *metrics.Store) *Enforcer {
+	t.Helper()
+	names := newNameLocalizer([]talentNameEntry{
+		{Ko: "우사다 페코라", Names: []string{"Usada Pekora", "兎田ぺこら"}},
+	})
+	cfg := config.LanguageConfig{
+		Enabled:        true,
+		MaxRetries:     1,
+		MinLetters:     8,
+		MinHangulRatio: 0.5,
+		LocalizeNames:  true,
+	}
+	return NewEnforcer(cfg, provider, names, store, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestEnforcerRetriesWithRepairInstruction(t *testing.T) {
+	provider := &scriptedProvider{replies: []string{
+		"The answer is related to a rabbit.",
+		"정답은 토끼와 관련이 있습니다.",
+	}}
+	store := metrics.NewStore()
+	enforcer := newTestEnforcer(t, provider, store)
+
+	text, _, err := enforcer.Chat(context.Background(), llm.Request{Prompt: "힌트 주세요"})
+	if err != nil {
+		t.Fatalf("chat: %v", err)
+	}
+	if text != "정답은 토끼와 관련이 있습니다." {
+		t.Fatalf("unexpected text: %q", text)
+	}
+	if len(provider.prompts) != 2 || !strings.HasSuffix(provider.prompts[1], repairInstruction) {
+		t.Fatalf("expected retry with repair instruction, got %q", provider.prompts)
+	}
+
+	snapshot := store.Snapshot()
+	if snapshot["language_checks"] != 2 || snapshot["language_violations"] != 1 {
+		t.Fatalf("unexpected check metrics: %v", snapshot)
+	}
+	if snapshot["language_retries"] != 1 || snapshot["language_recovered"] != 1 {
+		t.Fatalf("unexpected retry metrics: %v", snapshot)
+	}
+}
+
+func TestEnforcerLocalizesNamesBeforeCheck(t *testing.T) {
+	provider := &scriptedProvider{replies: []string{"오늘 兎田ぺこら 방송은 아홉 시예요."}}
+	store := metrics.NewStore()
+	enforcer := newTestEnforcer(t, provider, store)
+
+	result, _, err := enforcer.ChatWithUsage(context.Background(), llm.Request{Prompt: "방송 언제?"})
+	if err != nil {
+		t.Fatalf("chat with usage: %v", err)
+	}
+	if result.Text != "오늘 우사다 페코라 방송은 아홉 시예요." {
+		t.Fatalf("unexpected text: %q", result.Text)
+	}
+	if len(provider.prompts) != 1 {
+		t.Fatalf("expected no retry, got %d calls", len(provider.prompts))
+	}
+	if store.Snapshot()["talent_names_localized"] != 1 {
+		t.Fatalf("expected localized metric, got %v", store.Snapshot())
+	}
+}
+
+func TestEnforcerReturnsLastReplyWhenRetriesExhausted(t *testing.T) {
+	provider := &scriptedProvider{replies: []string{"This is English.", "Still answering in English."}}
+	enforcer := newTestEnforcer(t, provider, metrics.NewStore())
+
+	text, _, err := enforcer.Chat(context.Background(), llm.Request{Prompt: "질문"})
+	if err != nil {
+		t.Fatalf("chat: %v", err)
+	}
+	if text != "Still answering in English." {
+		t.Fatalf("unexpected text: %q", text)
+	}
+	if len(provider.prompts) != 2 {
+		t.Fatalf("expected 2 calls, got %d", len(provider.prompts))
+	}
+}
+
+func TestEnforcerKeepsFirstReplyWhenRetryFails(t *testing.T) {
+	provider := &scriptedProvider{replies: []string{"This is English."}, err: errors.New("boom")}
+	enforcer := newTestEnforcer(t, provider, metrics.NewStore())
+
+	text, _, err := enforcer.Chat(context.Background(), llm.Request{Prompt: "질문"})
+	if err != nil {
+		t.Fatalf("chat: %v", err)
+	}
+	if text != "This is English." {
+		t.Fatalf("unexpected text: %q", text)
+	}
+}
+
+func TestEnforcerStructuredChecksOnlyTextFields(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"reasoning": map[string]any{"type": "string"},
+			"answer":    map[string]any{"type": "string", "enum": []string{"예", "아니오"}},
+			"hints": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "string"},
+			},
+		},
+	}
+	provider := &scriptedProvider{replies: []string{"Usada Pekora가 좋아하는 당근"}}
+	enforcer := newTestEnforcer(t, provider, metrics.NewStore())
+
+	payload, _, err := enforcer.Structured(context.Background(), llm.Request{Prompt: "힌트"}, schema)
+	if err != nil {
+		t.Fatalf("structured: %v", err)
+	}
+	if len(provider.prompts) != 1 {
+		t.Fatalf("english reasoning must not trigger retry, got %d calls", len(provider.prompts))
+	}
+	hints, _ := payload["hints"].([]any)
+	if len(hints) != 1 || hints[0] != "우사다 페코라가 좋아하는 당근" {
+		t.Fatalf("unexpected hints: %v", payload["hints"])
+	}
+}
@@ -0,0 +1,85 @@
+package langcheck
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+//go:embed talent_names.json
+var talentNamesJSON []byte
+
+// talentNameEntry: 한국어 표기와 대응하는 영문/일본어 표기 목록입니다.
+type talentNameEntry struct {
+	Ko    string   `json:"ko"`
+	Names []string `json:"names"`
+}
+
+// NameLocalizer: 답변 속 탤런트 이름의 영문 로마자/일본어 표기를 한국어 표기로 바꿉니다.
+type NameLocalizer struct {
+	replacer *strings.Replacer
+	size     int
+}
+
+// NewNameLocalizer: 내장된 탤런트 이름 사전으로 NameLocalizer를 생성합니다.
+func NewNameLocalizer() (*NameLocalizer, error) {
+	var entries []talentNameEntry
+	if err := json.Unmarshal(talentNamesJSON, &entries); err != nil {
+		return nil, fmt.Errorf("parse talent names: %w", err)
+	}
+	return newNameLocalizer(entries), nil
+}
+
+func newNameLocalizer(entries []talentNameEntry) *NameLocalizer {
+	type pair struct{ from, to string }
+	pairs := make([]pair, 0, len(entries)*3)
+	seen := make(map[string]struct{})
+	for _, entry := range entries {
+		for _, name := range entry.Names {
+			name = strings.TrimSpace(name)
+			if name == "" || name == entry.Ko {
+				continue
+			}
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			pairs = append(pairs, pair{from: name, to: entry.Ko})
+		}
+	}
+
+	// Replacer는 같은 위치에서 먼저 등록된 후보를 우선하므로 긴 표기부터 등록
+	// (예: "Nakiri Ayame 百鬼あやめ"가 "Nakiri Ayame"보다 먼저 매칭되어야 함)
+	slices.SortStableFunc(pairs, func(a, b pair) int {
+		return len(b.from) - len(a.from)
+	})
+
+	oldnew := make([]string, 0, len(pairs)*2)
+	for _, p := range pairs {
+		oldnew = append(oldnew, p.from, p.to)
+	}
+	return &NameLocalizer{
+		replacer: strings.NewReplacer(oldnew...),
+		size:     len(pairs),
+	}
+}
+
+// Localize: 텍스트 속 탤런트 이름을 한국어 표기로 치환하고, 치환 여부를 함께 반환합니다.
+func (l *NameLocalizer) Localize(text string) (string, bool) {
+	if l == nil || text == "" {
+		return text, false
+	}
+	localized := l.replacer.Replace(text)
+	return localized, localized != text
+}
+
+// Size: 사전에 등록된 외국어 표기 수를 반환합니다.
+func (l *NameLocalizer) Size() int {
+	if l == nil {
+		return 0
+	}
+	return l.size
+}
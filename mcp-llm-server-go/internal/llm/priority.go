@@ -0,0 +1,53 @@
+package llm
+
+import (
+	"context"
+	"strings"
+)
+
+// Priority: LLM 호출 대기열에서의 우선순위입니다. 값이 작을수록 먼저 처리됩니다.
+type Priority int
+
+const (
+	// PriorityInteractive: 사용자가 응답을 기다리는 게임 진행 호출 (기본값)
+	PriorityInteractive Priority = iota
+	// PriorityBatch: 힌트 미리 생성 등 지연돼도 되는 일괄 호출
+	PriorityBatch
+)
+
+// Priorities: 처리 순서대로 나열한 우선순위 목록입니다.
+var Priorities = []Priority{PriorityInteractive, PriorityBatch}
+
+// String: 지표 라벨과 헤더 값에 쓰는 이름을 반환합니다.
+func (p Priority) String() string {
+	if p == PriorityBatch {
+		return "batch"
+	}
+	return "interactive"
+}
+
+// ParsePriority: 헤더/메타데이터 값을 우선순위로 변환합니다. "batch"가 아니면 interactive입니다.
+func ParsePriority(raw string) Priority {
+	if strings.EqualFold(strings.TrimSpace(raw), "batch") {
+		return PriorityBatch
+	}
+	return PriorityInteractive
+}
+
+type priorityKey struct{}
+
+// WithPriority: 이후 이 컨텍스트로 보내는 LLM 호출의 우선순위를 지정합니다.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext: 컨텍스트의 우선순위를 반환합니다. 지정하지 않았으면 interactive입니다.
+func PriorityFromContext(ctx context.Context) Priority {
+	if ctx == nil {
+		return PriorityInteractive
+	}
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return priority
+	}
+	return PriorityInteractive
+}
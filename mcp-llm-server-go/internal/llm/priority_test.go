@@ -0,0 +1,21 @@
+package llm
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParsePriority(t *testing.T) {
+	if got := ParsePriority(" Batch "); got != PriorityBatch {
+		t.Fatalf("expected batch, got %s", got)
+	}
+	if got := ParsePriority("urgent"); got != PriorityInteractive {
+		t.Fatalf("expected interactive fallback, got %s", got)
+	}
+	if got := PriorityFromContext(context.Background()); got != PriorityInteractive {
+		t.Fatalf("expected interactive default, got %s", got)
+	}
+	if got := PriorityFromContext(WithPriority(context.Background(), PriorityBatch)); got != PriorityBatch {
+		t.Fatalf("expected batch from context, got %s", got)
+	}
+}
@@ -0,0 +1,35 @@
+package llm
+
+import "context"
+
+// 공급자 식별자입니다. (LLM_TASK_PROVIDERS 등 설정 값과 일치)
+const (
+	ProviderGemini = "gemini"
+	ProviderOpenAI = "openai"
+)
+
+// Request: 공급자 중립 LLM 요청입니다.
+// Model이 비어 있으면 각 공급자가 Task 기준으로 모델을 선택합니다.
+type Request struct {
+	Prompt       string
+	SystemPrompt string
+	History      []HistoryEntry
+	Model        string
+	Task         string
+}
+
+// Provider: LLM 백엔드 인터페이스입니다.
+// 반환값의 string은 실제 사용된 모델명입니다.
+type Provider interface {
+	// Name 공급자 식별자 (ProviderGemini 등)
+	Name() string
+
+	// Chat 텍스트 채팅 요청
+	Chat(ctx context.Context, req Request) (string, string, error)
+
+	// ChatWithUsage 채팅 + 사용량 반환
+	ChatWithUsage(ctx context.Context, req Request) (ChatResult, string, error)
+
+	// Structured JSON 스키마 기반 응답
+	Structured(ctx context.Context, req Request, schema map[string]any) (map[string]any, string, error)
+}
@@ -0,0 +1,164 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+// ErrNoProvider: 라우팅 가능한 공급자가 없을 때 반환됩니다.
+var ErrNoProvider = errors.New("no llm provider available")
+
+// Router: 작업(Task)별로 공급자를 선택하고, 실패 시 폴백 공급자로 재시도하는 Provider 구현입니다.
+type Router struct {
+	providers map[string]Provider
+	routing   config.LLMRoutingConfig
+	logger    *slog.Logger
+}
+
+// Router가 Provider 인터페이스를 구현하는지 컴파일 타임 확인
+var _ Provider = (*Router)(nil)
+
+// NewRouter: 공급자 목록과 라우팅 설정으로 Router를 생성합니다.
+// 라우팅 설정이 참조하는 공급자는 모두 등록되어 있어야 합니다.
+func NewRouter(routing config.LLMRoutingConfig, logger *slog.Logger, providers ...Provider) (*Router, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if routing.DefaultProvider == "" {
+		routing.DefaultProvider = ProviderGemini
+	}
+
+	registered := make(map[string]Provider, len(providers))
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+		registered[provider.Name()] = provider
+	}
+
+	required := []string{routing.DefaultProvider}
+	for _, name := range routing.TaskProviders {
+		required = append(required, name)
+	}
+	required = append(required, routing.FallbackProviders...)
+	for _, name := range required {
+		if _, ok := registered[name]; !ok {
+			return nil, fmt.Errorf("llm provider not registered: %s", name)
+		}
+	}
+
+	return &Router{
+		providers: registered,
+		routing:   routing,
+		logger:    logger,
+	}, nil
+}
+
+// Name: 공급자 식별자를 반환합니다.
+func (r *Router) Name() string {
+	return "router"
+}
+
+// Chat: 작업별 공급자로 텍스트 채팅을 수행합니다.
+func (r *Router) Chat(ctx context.Context, req Request) (string, string, error) {
+	var text string
+	model, err := r.do(ctx, req, "chat", func(p Provider, req Request) (string, error) {
+		var (
+			model string
+			err   error
+		)
+		text, model, err = p.Chat(ctx, req)
+		return model, err
+	})
+	return text, model, err
+}
+
+// ChatWithUsage: 작업별 공급자로 채팅하고 사용량을 반환합니다.
+func (r *Router) ChatWithUsage(ctx context.Context, req Request) (ChatResult, string, error) {
+	var result ChatResult
+	model, err := r.do(ctx, req, "chat_with_usage", func(p Provider, req Request) (string, error) {
+		var (
+			model string
+			err   error
+		)
+		result, model, err = p.ChatWithUsage(ctx, req)
+		return model, err
+	})
+	return result, model, err
+}
+
+// Structured: 작업별 공급자로 JSON 스키마 기반 응답을 요청합니다.
+func (r *Router) Structured(ctx context.Context, req Request, schema map[string]any) (map[string]any, string, error) {
+	var payload map[string]any
+	model, err := r.do(ctx, req, "structured", func(p Provider, req Request) (string, error) {
+		var (
+			model string
+			err   error
+		)
+		payload, model, err = p.Structured(ctx, req, schema)
+		return model, err
+	})
+	return payload, model, err
+}
+
+// ProvidersForTask: 작업에 대해 시도할 공급자 순서를 반환합니다. (주 공급자 + 폴백, 중복 제거)
+func (r *Router) ProvidersForTask(task string) []string {
+	order := make([]string, 0, 1+len(r.routing.FallbackProviders))
+	seen := make(map[string]struct{}, cap(order))
+	for _, name := range append([]string{r.routing.ProviderForTask(task)}, r.routing.FallbackProviders...) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		if _, ok := r.providers[name]; !ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		order = append(order, name)
+	}
+	return order
+}
+
+func (r *Router) do(
+	ctx context.Context,
+	req Request,
+	op string,
+	call func(p Provider, req Request) (string, error),
+) (string, error) {
+	order := r.ProvidersForTask(req.Task)
+	if len(order) == 0 {
+		return "", ErrNoProvider
+	}
+
+	var (
+		model   string
+		lastErr error
+	)
+	for i, name := range order {
+		attemptReq := req
+		// 모델 override는 주 공급자 기준이므로 폴백 시에는 공급자 기본 모델을 사용
+		if i > 0 {
+			attemptReq.Model = ""
+		}
+
+		model, lastErr = call(r.providers[name], attemptReq)
+		if lastErr == nil {
+			if i > 0 {
+				r.logger.Info("llm_provider_fallback_succeeded",
+					"op", op, "task", req.Task, "provider", name, "primary", order[0], "model", model)
+			}
+			return model, nil
+		}
+		if ctx.Err() != nil {
+			return model, lastErr
+		}
+		if i < len(order)-1 {
+			r.logger.Warn("llm_provider_failed",
+				"op", op, "task", req.Task, "provider", name, "next", order[i+1], "err", lastErr)
+		}
+	}
+	return model, lastErr
+}
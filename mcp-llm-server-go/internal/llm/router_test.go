@@ -0,0 +1,144 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+type fakeProvider struct {
+	name   string
+	err    error
+	calls  int
+	models []string
+}
+
+func (f *fakeProvider) Name() string { return f.name }
+
+func (f *fakeProvider) Chat(_ context.Context, req Request) (string, string, error) {
+	f.calls++
+	f.models = append(f.models, req.Model)
+	if f.err != nil {
+		return "", "", f.err
+	}
+	return f.name + ":" + req.Prompt, f.name + "-model", nil
+}
+
+func (f *fakeProvider) ChatWithUsage(ctx context.Context, req Request) (ChatResult, string, error) {
+	text, model, err := f.Chat(ctx, req)
+	return ChatResult{Text: text}, model, err
+}
+
+func (f *fakeProvider) Structured(_ context.Context, req Request, _ map[string]any) (map[string]any, string, error) {
+	f.calls++
+	f.models = append(f.models, req.Model)
+	if f.err != nil {
+		return nil, "", f.err
+	}
+	return map[string]any{"provider": f.name}, f.name + "-model", nil
+}
+
+func newTestRouter(t *testing.T, routing config.LLMRoutingConfig, providers ...Provider) *Router {
+	t.Helper()
+	router, err := NewRouter(routing, slog.New(slog.NewTextHandler(io.Discard, nil)), providers...)
+	if err != nil {
+		t.Fatalf("new router: %v", err)
+	}
+	return router
+}
+
+func TestRouterSelectsProviderPerTask(t *testing.T) {
+	gemini := &fakeProvider{name: ProviderGemini}
+	openai := &fakeProvider{name: ProviderOpenAI}
+	router := newTestRouter(t, config.LLMRoutingConfig{
+		DefaultProvider: ProviderGemini,
+		TaskProviders:   map[string]string{"verify": ProviderOpenAI},
+	}, gemini, openai)
+
+	payload, model, err := router.Structured(context.Background(), Request{Task: "verify"}, nil)
+	if err != nil {
+		t.Fatalf("structured: %v", err)
+	}
+	if payload["provider"] != ProviderOpenAI || model != "openai-model" {
+		t.Fatalf("expected openai for verify, got %v (%s)", payload, model)
+	}
+
+	text, _, err := router.Chat(context.Background(), Request{Prompt: "p", Task: "hints"})
+	if err != nil {
+		t.Fatalf("chat: %v", err)
+	}
+	if text != "gemini:p" {
+		t.Fatalf("expected gemini for hints, got %q", text)
+	}
+}
+
+func TestRouterFallsBackOnProviderError(t *testing.T) {
+	gemini := &fakeProvider{name: ProviderGemini}
+	openai := &fakeProvider{name: ProviderOpenAI, err: errors.New("boom")}
+	router := newTestRouter(t, config.LLMRoutingConfig{
+		DefaultProvider:   ProviderOpenAI,
+		FallbackProviders: []string{ProviderGemini},
+	}, gemini, openai)
+
+	result, model, err := router.ChatWithUsage(context.Background(), Request{Prompt: "p", Model: "gpt-x"})
+	if err != nil {
+		t.Fatalf("expected fallback success, got %v", err)
+	}
+	if result.Text != "gemini:p" || model != "gemini-model" {
+		t.Fatalf("unexpected fallback result: %q (%s)", result.Text, model)
+	}
+	if openai.calls != 1 || gemini.calls != 1 {
+		t.Fatalf("unexpected calls: openai=%d gemini=%d", openai.calls, gemini.calls)
+	}
+	// 주 공급자용 모델 override는 폴백 공급자에 전달하지 않는다
+	if gemini.models[0] != "" {
+		t.Fatalf("expected model override cleared on fallback, got %q", gemini.models[0])
+	}
+}
+
+func TestRouterReturnsLastErrorWhenAllFail(t *testing.T) {
+	errGemini := errors.New("gemini down")
+	router := newTestRouter(t, config.LLMRoutingConfig{
+		DefaultProvider:   ProviderOpenAI,
+		FallbackProviders: []string{ProviderGemini, ProviderOpenAI},
+	}, &fakeProvider{name: ProviderGemini, err: errGemini}, &fakeProvider{name: ProviderOpenAI, err: errors.New("openai down")})
+
+	if got := router.ProvidersForTask(""); len(got) != 2 {
+		t.Fatalf("expected deduplicated order, got %v", got)
+	}
+	if _, _, err := router.Chat(context.Background(), Request{}); !errors.Is(err, errGemini) {
+		t.Fatalf("expected last provider error, got %v", err)
+	}
+}
+
+func TestRouterStopsOnContextCancel(t *testing.T) {
+	gemini := &fakeProvider{name: ProviderGemini}
+	openai := &fakeProvider{name: ProviderOpenAI, err: context.Canceled}
+	router := newTestRouter(t, config.LLMRoutingConfig{
+		DefaultProvider:   ProviderOpenAI,
+		FallbackProviders: []string{ProviderGemini},
+	}, gemini, openai)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := router.Chat(ctx, Request{}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if gemini.calls != 0 {
+		t.Fatalf("expected no fallback after cancel, got %d calls", gemini.calls)
+	}
+}
+
+func TestNewRouterRequiresRoutedProviders(t *testing.T) {
+	_, err := NewRouter(config.LLMRoutingConfig{
+		DefaultProvider: ProviderGemini,
+		TaskProviders:   map[string]string{"verify": ProviderOpenAI},
+	}, nil, &fakeProvider{name: ProviderGemini})
+	if err == nil {
+		t.Fatalf("expected error for unregistered provider")
+	}
+}
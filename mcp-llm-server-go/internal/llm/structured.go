@@ -0,0 +1,16 @@
+package llm
+
+// StructuredOutputError: 공급자 응답을 JSON 객체로 해석하지 못했을 때 반환됩니다.
+// Raw에는 모델이 실제로 출력한 텍스트가 담겨 있어, 재요청 시 교정 근거로 쓸 수 있습니다.
+type StructuredOutputError struct {
+	Raw string
+	Err error
+}
+
+func (e *StructuredOutputError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StructuredOutputError) Unwrap() error {
+	return e.Err
+}
@@ -4,6 +4,8 @@ package llm
 type HistoryEntry struct {
 	Role    string `json:"role"`
 	Content string `json:"content"`
+	// SummaryOf: 요약 항목이면 이 항목으로 대체된 원래 항목 수 (일반 항목은 0)
+	SummaryOf int `json:"summary_of,omitempty"`
 }
 
 // Usage: 토큰 사용량 정보를 담습니다.
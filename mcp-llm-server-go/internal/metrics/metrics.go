@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -16,6 +17,24 @@ type Store struct {
 	totalReasoningTokens int64
 	totalCachedTokens    int64 // 암시적 캐싱된 토큰 누적
 	totalDurationMs      int64
+
+	// 출력 언어 검사 통계
+	languageChecks     int64 // 검사한 응답 수
+	languageViolations int64 // 한국어가 아니라고 판정된 응답 수 (재시도 응답 포함)
+	languageRetries    int64 // 교정 지시를 붙여 재요청한 횟수
+	languageRecovered  int64 // 재요청으로 한국어 응답을 얻은 횟수
+	namesLocalized     int64 // 탤런트 이름을 한국어 표기로 치환한 응답 수
+
+	// 구조화 응답 교정 통계 (작업별)
+	repairMu sync.Mutex
+	repairs  map[string]*repairCounts
+}
+
+// repairCounts: 작업 하나의 구조화 응답 검증/교정 집계
+type repairCounts struct {
+	invalid  int64 // 스키마 검증에 실패한 최초 응답 수
+	attempts int64 // 교정 재요청 횟수
+	repaired int64 // 교정 재요청으로 유효한 응답을 얻은 수
 }
 
 // NewStore: 통계 저장소를 생성합니다.
@@ -40,6 +59,59 @@ func (s *Store) RecordError(duration time.Duration) {
 	atomic.AddInt64(&s.totalDurationMs, duration.Milliseconds())
 }
 
+// RecordLanguageCheck: 출력 언어 검사 결과를 기록합니다.
+func (s *Store) RecordLanguageCheck(violated bool) {
+	atomic.AddInt64(&s.languageChecks, 1)
+	if violated {
+		atomic.AddInt64(&s.languageViolations, 1)
+	}
+}
+
+// RecordLanguageRetry: 언어 교정 재요청 결과를 기록합니다.
+func (s *Store) RecordLanguageRetry(recovered bool) {
+	atomic.AddInt64(&s.languageRetries, 1)
+	if recovered {
+		atomic.AddInt64(&s.languageRecovered, 1)
+	}
+}
+
+// RecordNamesLocalized: 탤런트 이름 치환이 일어난 응답을 기록합니다.
+func (s *Store) RecordNamesLocalized() {
+	atomic.AddInt64(&s.namesLocalized, 1)
+}
+
+// RecordStructuredInvalid: 작업의 구조화 응답이 스키마 검증에 실패했음을 기록합니다.
+func (s *Store) RecordStructuredInvalid(task string) {
+	s.withRepairCounts(task, func(c *repairCounts) { c.invalid++ })
+}
+
+// RecordStructuredRepair: 교정 재요청 결과를 기록합니다.
+func (s *Store) RecordStructuredRepair(task string, repaired bool) {
+	s.withRepairCounts(task, func(c *repairCounts) {
+		c.attempts++
+		if repaired {
+			c.repaired++
+		}
+	})
+}
+
+func (s *Store) withRepairCounts(task string, fn func(c *repairCounts)) {
+	if task == "" {
+		task = "default"
+	}
+	s.repairMu.Lock()
+	defer s.repairMu.Unlock()
+	if s.repairs == nil {
+		s.repairs = make(map[string]*repairCounts)
+	}
+	counts, ok := s.repairs[task]
+	if !ok {
+		counts = &repairCounts{}
+		s.repairs[task] = counts
+	}
+	fn(counts)
+}
+
 // UsageTotals: 누적 사용량을 반환합니다.
 func (s *Store) UsageTotals() llm.Usage {
 	input := atomic.LoadInt64(&s.totalInputTokens)
@@ -64,6 +136,10 @@ func (s *Store) Snapshot() map[string]float64 {
 	reasoning := atomic.LoadInt64(&s.totalReasoningTokens)
 	cached := atomic.LoadInt64(&s.totalCachedTokens)
 	durationMs := atomic.LoadInt64(&s.totalDurationMs)
+	langChecks := atomic.LoadInt64(&s.languageChecks)
+	langViolations := atomic.LoadInt64(&s.languageViolations)
+	langRetries := atomic.LoadInt64(&s.languageRetries)
+	langRecovered := atomic.LoadInt64(&s.languageRecovered)
 
 	avgDuration := 0.0
 	if totalCalls > 0 {
@@ -76,7 +152,17 @@ func (s *Store) Snapshot() map[string]float64 {
 		cacheHitRatio = float64(cached) / float64(input)
 	}
 
-	return map[string]float64{
+	// 언어 위반 비율 / 재요청 성공 비율
+	langViolationRatio := 0.0
+	if langChecks > 0 {
+		langViolationRatio = float64(langViolations) / float64(langChecks)
+	}
+	langRecoveryRatio := 0.0
+	if langRetries > 0 {
+		langRecoveryRatio = float64(langRecovered) / float64(langRetries)
+	}
+
+	snapshot := map[string]float64{
 		"total_calls":            float64(totalCalls),
 		"total_errors":           float64(totalErrors),
 		"total_input_tokens":     float64(input),
@@ -87,5 +173,44 @@ func (s *Store) Snapshot() map[string]float64 {
 		"total_tokens":           float64(input + output),
 		"total_duration_ms":      float64(durationMs),
 		"avg_duration_ms":        avgDuration,
+
+		"language_checks":          float64(langChecks),
+		"language_violations":      float64(langViolations),
+		"language_violation_ratio": langViolationRatio,
+		"language_retries":         float64(langRetries),
+		"language_recovered":       float64(langRecovered),
+		"language_recovery_ratio":  langRecoveryRatio,
+		"talent_names_localized":   float64(atomic.LoadInt64(&s.namesLocalized)),
+	}
+	s.addRepairSnapshot(snapshot)
+	return snapshot
+}
+
+// addRepairSnapshot: 구조화 응답 교정 통계를 전체 합계와 작업별(structured_*.{task}) 항목으로 추가합니다.
+// repair_rate는 검증에 실패한 응답 중 교정 재요청으로 복구된 비율입니다.
+func (s *Store) addRepairSnapshot(snapshot map[string]float64) {
+	s.repairMu.Lock()
+	defer s.repairMu.Unlock()
+
+	var total repairCounts
+	for task, c := range s.repairs {
+		snapshot["structured_invalid."+task] = float64(c.invalid)
+		snapshot["structured_repair_attempts."+task] = float64(c.attempts)
+		snapshot["structured_repaired."+task] = float64(c.repaired)
+		snapshot["structured_repair_rate."+task] = ratio(c.repaired, c.invalid)
+		total.invalid += c.invalid
+		total.attempts += c.attempts
+		total.repaired += c.repaired
+	}
+	snapshot["structured_invalid"] = float64(total.invalid)
+	snapshot["structured_repair_attempts"] = float64(total.attempts)
+	snapshot["structured_repaired"] = float64(total.repaired)
+	snapshot["structured_repair_rate"] = ratio(total.repaired, total.invalid)
+}
+
+func ratio(n, d int64) float64 {
+	if d == 0 {
+		return 0
 	}
+	return float64(n) / float64(d)
 }
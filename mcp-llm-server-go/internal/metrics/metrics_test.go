@@ -25,3 +25,24 @@ func TestStoreRecordsMetrics(t *testing.T) {
 		t.Fatalf("expected total_errors 1, got %v", snapshot["total_errors"])
 	}
 }
+
+func TestStoreRecordsStructuredRepairPerTask(t *testing.T) {
+	store := NewStore()
+	store.RecordStructuredInvalid("answer")
+	store.RecordStructuredRepair("answer", false)
+	store.RecordStructuredRepair("answer", true)
+	store.RecordStructuredInvalid("answer")
+	store.RecordStructuredRepair("answer", false)
+	store.RecordStructuredInvalid("hints")
+
+	snapshot := store.Snapshot()
+	if snapshot["structured_invalid.answer"] != 2 || snapshot["structured_repair_attempts.answer"] != 3 {
+		t.Fatalf("unexpected answer counts: %v", snapshot)
+	}
+	if snapshot["structured_repair_rate.answer"] != 0.5 {
+		t.Fatalf("expected answer repair rate 0.5, got %v", snapshot["structured_repair_rate.answer"])
+	}
+	if snapshot["structured_repair_rate.hints"] != 0 || snapshot["structured_invalid"] != 3 {
+		t.Fatalf("unexpected totals: %v", snapshot)
+	}
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+// LLMPriorityHeader: LLM 호출 대기열 우선순위 헤더 키다. "batch"면 일괄 호출, 없으면 interactive.
+const LLMPriorityHeader = "X-LLM-Priority"
+
+// LLMPriority: 우선순위 헤더를 요청 컨텍스트에 저장하는 미들웨어다.
+func LLMPriority() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if raw := c.GetHeader(LLMPriorityHeader); raw != "" {
+			c.Request = c.Request.WithContext(llm.WithPriority(c.Request.Context(), llm.ParsePriority(raw)))
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+// Quota: X-Bot-ID 헤더로 호출 봇을 식별해 요청 컨텍스트에 저장하고, LLM 호출 요청마다 일일 예산을 차감한다.
+// 헤더가 없거나 관리 대상 봇이 아니면 제한 없이 통과시킨다.
+func Quota(tracker *quota.Tracker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		botID := quota.NormalizeBotID(c.GetHeader("X-Bot-ID"))
+		if botID == "" || !shouldProtectPath(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+		c.Request = c.Request.WithContext(quota.WithCaller(c.Request.Context(), botID))
+
+		if !consumesQuota(c.Request) {
+			c.Next()
+			return
+		}
+
+		if err := tracker.Acquire(botID); err != nil {
+			details := map[string]any{"bot_id": botID}
+			var exhausted *quota.ExhaustedError
+			if errors.As(err, &exhausted) {
+				details["resource"] = exhausted.Resource
+				details["used"] = exhausted.Used
+				details["limit"] = exhausted.Limit
+				details["resets_at"] = exhausted.ResetsAt.Unix()
+			}
+			status, payload := httperror.Response(httperror.NewQuotaExhausted(details), GetRequestID(c))
+			c.AbortWithStatusJSON(status, payload)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// consumesQuota: LLM을 호출하는 요청(POST)인지 판단합니다. 가드 검사와 퍼즐 재적재는 제외한다.
+func consumesQuota(r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		return false
+	}
+	path := r.URL.Path
+	return !strings.HasPrefix(path, "/api/guard/") && path != "/api/turtle-soup/puzzles/reload"
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/quota"
+)
+
+func TestQuota(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tracker := quota.NewTracker(config.QuotaConfig{
+		Enabled: true,
+		Bots:    map[string]config.BotQuota{quota.BotHolo: {DailyRequests: 1}},
+	})
+
+	var caller string
+	router := gin.New()
+	router.Use(Quota(tracker))
+	router.POST("/api/llm/chat", func(c *gin.Context) {
+		caller = quota.CallerFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+	router.GET("/api/usage/daily", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(method, path, botID string) int {
+		req := httptest.NewRequest(method, path, nil)
+		if botID != "" {
+			req.Header.Set("X-Bot-ID", botID)
+		}
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	if code := do(http.MethodPost, "/api/llm/chat", "holo"); code != http.StatusOK || caller != quota.BotHolo {
+		t.Fatalf("expected ok with caller, got %d caller=%q", code, caller)
+	}
+	if code := do(http.MethodPost, "/api/llm/chat", "holo"); code != http.StatusTooManyRequests {
+		t.Fatalf("expected quota exhausted, got %d", code)
+	}
+	if code := do(http.MethodGet, "/api/usage/daily", "holo"); code != http.StatusOK {
+		t.Fatalf("read-only request should not consume quota, got %d", code)
+	}
+	if code := do(http.MethodPost, "/api/llm/chat", ""); code != http.StatusOK {
+		t.Fatalf("request without bot id should pass, got %d", code)
+	}
+}
@@ -0,0 +1,299 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
+)
+
+var (
+	// ErrMissingBaseURL: OpenAI 호환 API 주소가 없을 때 반환됩니다.
+	ErrMissingBaseURL = errors.New("missing openai base url")
+	// ErrMissingModel: 사용할 모델이 지정되지 않았을 때 반환됩니다.
+	ErrMissingModel = errors.New("missing openai model")
+)
+
+// maxErrorBodyBytes: 에러 응답 본문 중 보관할 최대 바이트 수
+const maxErrorBodyBytes = 2048
+
+// APIError: OpenAI 호환 API의 비정상 HTTP 응답입니다.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("openai api error: status=%d body=%s", e.StatusCode, e.Body)
+}
+
+// Client: OpenAI 호환 Chat Completions API 호출을 담당하는 클라이언트입니다.
+type Client struct {
+	cfg           *config.Config
+	metrics       *metrics.Store
+	usageRecorder *usage.Recorder
+	httpClient    *http.Client
+	endpoint      string
+}
+
+// Client가 llm.Provider 인터페이스를 구현하는지 컴파일 타임 확인
+var _ llm.Provider = (*Client)(nil)
+
+// NewClient: OpenAI 호환 클라이언트를 생성합니다.
+func NewClient(cfg *config.Config, metricsStore *metrics.Store, usageRecorder *usage.Recorder) (*Client, error) {
+	if cfg == nil {
+		return nil, errors.New("config is nil")
+	}
+	if metricsStore == nil {
+		return nil, errors.New("metrics store is nil")
+	}
+	baseURL := strings.TrimRight(strings.TrimSpace(cfg.OpenAI.BaseURL), "/")
+	if baseURL == "" {
+		return nil, ErrMissingBaseURL
+	}
+
+	transport := http.DefaultTransport
+	if cfg.Telemetry.Enabled {
+		transport = otelhttp.NewTransport(transport,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return "OpenAI." + r.Method
+			}),
+		)
+	}
+
+	return &Client{
+		cfg:           cfg,
+		metrics:       metricsStore,
+		usageRecorder: usageRecorder,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   time.Duration(cfg.OpenAI.TimeoutSeconds) * time.Second,
+		},
+		endpoint: baseURL + "/chat/completions",
+	}, nil
+}
+
+// Name: 공급자 식별자를 반환합니다.
+func (c *Client) Name() string {
+	return llm.ProviderOpenAI
+}
+
+// Chat: 텍스트 채팅 요청을 수행합니다.
+func (c *Client) Chat(ctx context.Context, req llm.Request) (string, string, error) {
+	result, model, err := c.ChatWithUsage(ctx, req)
+	if err != nil {
+		return "", model, err
+	}
+	return result.Text, model, nil
+}
+
+// ChatWithUsage: 텍스트 응답과 토큰 사용량을 함께 반환합니다.
+func (c *Client) ChatWithUsage(ctx context.Context, req llm.Request) (llm.ChatResult, string, error) {
+	start := time.Now()
+	response, model, err := c.complete(ctx, req, nil)
+	if err != nil {
+		c.metrics.RecordError(time.Since(start))
+		return llm.ChatResult{}, model, err
+	}
+
+	usageStats := response.usage()
+	c.metrics.RecordSuccess(time.Since(start), usageStats)
+	c.recordUsage(ctx, model, usageStats)
+
+	message := response.firstMessage()
+	return llm.ChatResult{
+		Text:         message.Content,
+		Usage:        usageStats,
+		Reasoning:    message.ReasoningContent,
+		HasReasoning: message.ReasoningContent != "",
+	}, model, nil
+}
+
+// Structured: JSON 스키마 기반 응답을 반환합니다. (response_format=json_schema)
+func (c *Client) Structured(ctx context.Context, req llm.Request, schema map[string]any) (map[string]any, string, error) {
+	start := time.Now()
+	format := &responseFormat{Type: "json_object"}
+	if schema != nil {
+		format = &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchemaFormat{
+				Name:   "response",
+				Schema: schema,
+			},
+		}
+	}
+
+	response, model, err := c.complete(ctx, req, format)
+	if err != nil {
+		c.metrics.RecordError(time.Since(start))
+		return nil, model, err
+	}
+
+	usageStats := response.usage()
+	c.metrics.RecordSuccess(time.Since(start), usageStats)
+	c.recordUsage(ctx, model, usageStats)
+
+	payload := strings.TrimSpace(response.firstMessage().Content)
+	if payload == "" {
+		return nil, model, &llm.StructuredOutputError{Raw: payload, Err: errors.New("empty structured response")}
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(payload), &parsed); err != nil {
+		return nil, model, &llm.StructuredOutputError{Raw: payload, Err: fmt.Errorf("decode structured response: %w", err)}
+	}
+	return parsed, model, nil
+}
+
+func (c *Client) complete(ctx context.Context, req llm.Request, format *responseFormat) (*chatCompletionResponse, string, error) {
+	model := req.Model
+	if model == "" {
+		model = c.cfg.OpenAI.ModelForTask(req.Task)
+	}
+	if model == "" {
+		return nil, model, ErrMissingModel
+	}
+
+	temperature := c.cfg.OpenAI.Temperature
+	body, err := json.Marshal(chatCompletionRequest{
+		Model:          model,
+		Messages:       buildMessages(req),
+		Temperature:    &temperature,
+		MaxTokens:      c.cfg.OpenAI.MaxOutputTokens,
+		ResponseFormat: format,
+	})
+	if err != nil {
+		return nil, model, fmt.Errorf("encode chat completion request: %w", err)
+	}
+
+	maxAttempts := max(1, c.cfg.OpenAI.MaxRetries)
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, retryDelay(attempt)); err != nil {
+				return nil, model, err
+			}
+		}
+
+		response, err := c.send(ctx, body)
+		if err == nil {
+			return response, model, nil
+		}
+
+		lastErr = err
+		if !isRetryableError(err) {
+			break
+		}
+	}
+	return nil, model, fmt.Errorf("chat completion: %w", lastErr)
+}
+
+func (c *Client) send(ctx context.Context, body []byte) (*chatCompletionResponse, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if apiKey := strings.TrimSpace(c.cfg.OpenAI.APIKey); apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(errBody))}
+	}
+
+	var decoded chatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if len(decoded.Choices) == 0 {
+		return nil, errors.New("empty choices in response")
+	}
+	return &decoded, nil
+}
+
+func (c *Client) recordUsage(ctx context.Context, model string, usageStats llm.Usage) {
+	usage.AttributeCall(ctx, model, int64(usageStats.InputTokens), int64(usageStats.OutputTokens), int64(usageStats.ReasoningTokens))
+	if c.usageRecorder == nil {
+		return
+	}
+	c.usageRecorder.Record(ctx, int64(usageStats.InputTokens), int64(usageStats.OutputTokens), int64(usageStats.ReasoningTokens))
+}
+
+func buildMessages(req llm.Request) []chatMessage {
+	messages := make([]chatMessage, 0, len(req.History)+2)
+	if req.SystemPrompt != "" {
+		messages = append(messages, chatMessage{Role: "system", Content: req.SystemPrompt})
+	}
+	for _, entry := range req.History {
+		role := "user"
+		if strings.EqualFold(entry.Role, "assistant") {
+			role = "assistant"
+		}
+		messages = append(messages, chatMessage{Role: role, Content: entry.Content})
+	}
+	return append(messages, chatMessage{Role: "user", Content: req.Prompt})
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case 408, 429, 500, 502, 503, 504:
+			return true
+		default:
+			return false
+		}
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func retryDelay(attempt int) time.Duration {
+	const (
+		base     = 250 * time.Millisecond
+		maxDelay = 2 * time.Second
+	)
+	delay := base << min(attempt-1, 4)
+	return min(delay, maxDelay)
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("sleep canceled: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,152 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := &config.Config{OpenAI: config.OpenAIConfig{
+		BaseURL:        server.URL + "/v1/",
+		APIKey:         "sk-test",
+		DefaultModel:   "gpt-default",
+		VerifyModel:    "gpt-verify",
+		MaxRetries:     3,
+		TimeoutSeconds: 5,
+	}}
+	client, err := NewClient(cfg, metrics.NewStore(), nil)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	return client
+}
+
+func writeCompletion(t *testing.T, w http.ResponseWriter, content string) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, `{"choices":[{"message":{"content":`+string(mustJSON(t, content))+`,"reasoning_content":"think"}}],`+
+		`"usage":{"prompt_tokens":10,"completion_tokens":7,"total_tokens":17,"completion_tokens_details":{"reasoning_tokens":3}}}`)
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return data
+}
+
+func TestChatWithUsageBuildsRequest(t *testing.T) {
+	var captured chatCompletionRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer sk-test" {
+			t.Errorf("missing bearer token")
+		}
+		if err := json.NewDecoder(r.Body).Decode(&captured); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		writeCompletion(t, w, "hello")
+	})
+
+	result, model, err := client.ChatWithUsage(context.Background(), llm.Request{
+		Prompt:       "question",
+		SystemPrompt: "system",
+		History:      []llm.HistoryEntry{{Role: "assistant", Content: "a1"}, {Role: "user", Content: "q1"}},
+		Task:         "verify",
+	})
+	if err != nil {
+		t.Fatalf("chat: %v", err)
+	}
+	if model != "gpt-verify" || captured.Model != "gpt-verify" {
+		t.Fatalf("expected task model, got %s / %s", model, captured.Model)
+	}
+	roles := make([]string, 0, len(captured.Messages))
+	for _, m := range captured.Messages {
+		roles = append(roles, m.Role)
+	}
+	if len(roles) != 4 || roles[0] != "system" || roles[1] != "assistant" || roles[3] != "user" {
+		t.Fatalf("unexpected roles: %v", roles)
+	}
+	if result.Text != "hello" || !result.HasReasoning {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Usage.InputTokens != 10 || result.Usage.OutputTokens != 7 || result.Usage.ReasoningTokens != 3 {
+		t.Fatalf("unexpected usage: %+v", result.Usage)
+	}
+}
+
+func TestStructuredUsesJSONSchema(t *testing.T) {
+	var captured chatCompletionRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&captured)
+		writeCompletion(t, w, `{"result":"정답"}`)
+	})
+
+	schema := map[string]any{"type": "object"}
+	payload, _, err := client.Structured(context.Background(), llm.Request{Prompt: "p"}, schema)
+	if err != nil {
+		t.Fatalf("structured: %v", err)
+	}
+	if payload["result"] != "정답" {
+		t.Fatalf("unexpected payload: %v", payload)
+	}
+	if captured.ResponseFormat == nil || captured.ResponseFormat.Type != "json_schema" || captured.ResponseFormat.JSONSchema == nil {
+		t.Fatalf("expected json_schema response format, got %+v", captured.ResponseFormat)
+	}
+}
+
+func TestRetriesRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeCompletion(t, w, "ok")
+	})
+
+	text, _, err := client.Chat(context.Background(), llm.Request{Prompt: "p"})
+	if err != nil || text != "ok" {
+		t.Fatalf("expected retry success, got %q err=%v", text, err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestDoesNotRetryClientError(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"error":"bad"}`)
+	})
+
+	_, _, err := client.Chat(context.Background(), llm.Request{Prompt: "p"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected APIError 400, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected single call, got %d", calls.Load())
+	}
+}
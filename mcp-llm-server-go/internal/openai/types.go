@@ -0,0 +1,70 @@
+package openai
+
+import "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type jsonSchemaFormat struct {
+	Name   string         `json:"name"`
+	Schema map[string]any `json:"schema"`
+}
+
+type responseFormat struct {
+	Type       string            `json:"type"`
+	JSONSchema *jsonSchemaFormat `json:"json_schema,omitempty"`
+}
+
+type chatCompletionRequest struct {
+	Model          string          `json:"model"`
+	Messages       []chatMessage   `json:"messages"`
+	Temperature    *float64        `json:"temperature,omitempty"`
+	MaxTokens      int             `json:"max_tokens,omitempty"`
+	ResponseFormat *responseFormat `json:"response_format,omitempty"`
+}
+
+type responseMessage struct {
+	Content string `json:"content"`
+	// ReasoningContent: vLLM/DeepSeek 등 일부 호환 서버가 제공하는 추론 내용
+	ReasoningContent string `json:"reasoning_content"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message responseMessage `json:"message"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens        int `json:"prompt_tokens"`
+		CompletionTokens    int `json:"completion_tokens"`
+		TotalTokens         int `json:"total_tokens"`
+		PromptTokensDetails struct {
+			CachedTokens int `json:"cached_tokens"`
+		} `json:"prompt_tokens_details"`
+		CompletionTokensDetails struct {
+			ReasoningTokens int `json:"reasoning_tokens"`
+		} `json:"completion_tokens_details"`
+	} `json:"usage"`
+}
+
+func (r *chatCompletionResponse) firstMessage() responseMessage {
+	if r == nil || len(r.Choices) == 0 {
+		return responseMessage{}
+	}
+	return r.Choices[0].Message
+}
+
+// usage: completion_tokens는 reasoning 토큰을 포함하므로 Gemini 집계 방식과 동일하게 그대로 사용
+func (r *chatCompletionResponse) usage() llm.Usage {
+	if r == nil {
+		return llm.Usage{}
+	}
+	return llm.Usage{
+		InputTokens:     r.Usage.PromptTokens,
+		OutputTokens:    r.Usage.CompletionTokens,
+		TotalTokens:     r.Usage.TotalTokens,
+		ReasoningTokens: r.Usage.CompletionTokensDetails.ReasoningTokens,
+		CachedTokens:    r.Usage.PromptTokensDetails.CachedTokens,
+	}
+}
@@ -0,0 +1,247 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+// 호출 봇 식별자 (gRPC 메타데이터 x-bot-id / HTTP 헤더 X-Bot-ID)
+const (
+	BotTwentyQ    = "twentyq"
+	BotTurtleSoup = "turtlesoup"
+	BotHolo       = "holo"
+)
+
+// Bots: 예산을 관리하는 봇 목록입니다.
+var Bots = []string{BotTwentyQ, BotTurtleSoup, BotHolo}
+
+// 예산 종류
+const (
+	ResourceRequests = "requests"
+	ResourceTokens   = "tokens"
+)
+
+// ErrExhausted: 일일 예산 소진 오류입니다.
+var ErrExhausted = errors.New("quota exhausted")
+
+// ExhaustedError: 어떤 봇의 어떤 예산이 소진되었는지 담는 오류입니다.
+type ExhaustedError struct {
+	BotID    string
+	Resource string
+	Used     int64
+	Limit    int64
+	ResetsAt time.Time
+}
+
+// Error: 오류 메시지를 반환합니다.
+func (e *ExhaustedError) Error() string {
+	return fmt.Sprintf("daily %s quota exhausted for %s (%d/%d)", e.Resource, e.BotID, e.Used, e.Limit)
+}
+
+// Is: errors.Is(err, ErrExhausted) 비교를 지원합니다.
+func (e *ExhaustedError) Is(target error) bool {
+	return target == ErrExhausted
+}
+
+// Status: 봇 하나의 당일 사용량과 예산입니다. Limit이 0이면 무제한입니다.
+type Status struct {
+	BotID         string
+	Date          string
+	RequestsUsed  int64
+	RequestsLimit int64
+	TokensUsed    int64
+	TokensLimit   int64
+	ResetsAt      time.Time
+}
+
+type counter struct {
+	requests int64
+	tokens   int64
+}
+
+// Tracker: 봇별 일일 요청/토큰 사용량을 메모리에서 집계하고 예산을 검사합니다.
+// 날짜는 서버 로컬 시간 기준이며(usage 테이블과 동일), 날짜가 바뀌면 사용량을 초기화한다.
+type Tracker struct {
+	mu       sync.Mutex
+	enabled  bool
+	budgets  map[string]config.BotQuota
+	counters map[string]*counter
+	day      string
+	now      func() time.Time
+}
+
+// NewTracker: 설정으로 Tracker를 생성합니다.
+func NewTracker(cfg config.QuotaConfig) *Tracker {
+	budgets := make(map[string]config.BotQuota, len(cfg.Bots))
+	for bot, budget := range cfg.Bots {
+		budgets[strings.ToLower(bot)] = budget
+	}
+	counters := make(map[string]*counter, len(Bots))
+	for _, bot := range Bots {
+		counters[bot] = &counter{}
+	}
+	return &Tracker{
+		enabled:  cfg.Enabled,
+		budgets:  budgets,
+		counters: counters,
+		now:      time.Now,
+	}
+}
+
+// Enabled: 예산 강제 여부를 반환합니다. 비활성화 상태에서도 사용량은 집계한다.
+func (t *Tracker) Enabled() bool {
+	return t != nil && t.enabled
+}
+
+// NormalizeBotID: 식별자를 소문자로 정규화하고, 관리 대상 봇이 아니면 빈 문자열을 반환합니다.
+func NormalizeBotID(raw string) string {
+	botID := strings.ToLower(strings.TrimSpace(raw))
+	if slices.Contains(Bots, botID) {
+		return botID
+	}
+	return ""
+}
+
+// Acquire: 요청 1회를 차감합니다. 요청 또는 토큰 예산이 이미 소진되었으면 ExhaustedError를 반환한다.
+// 토큰은 응답 후에 집계되므로, 예산 직전에 시작된 요청은 토큰 예산을 약간 넘길 수 있다.
+func (t *Tracker) Acquire(botID string) error {
+	if t == nil || botID == "" {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c := t.counterLocked(botID)
+	if c == nil {
+		return nil
+	}
+	if t.enabled {
+		budget := t.budgets[botID]
+		if budget.DailyRequests > 0 && c.requests >= budget.DailyRequests {
+			return t.exhaustedLocked(botID, ResourceRequests, c.requests, budget.DailyRequests)
+		}
+		if budget.DailyTokens > 0 && c.tokens >= budget.DailyTokens {
+			return t.exhaustedLocked(botID, ResourceTokens, c.tokens, budget.DailyTokens)
+		}
+	}
+	c.requests++
+	return nil
+}
+
+// AddTokens: 봇의 당일 토큰 사용량을 더합니다.
+func (t *Tracker) AddTokens(botID string, tokens int64) {
+	if t == nil || botID == "" || tokens <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if c := t.counterLocked(botID); c != nil {
+		c.tokens += tokens
+	}
+}
+
+// ObserveTokens: usage.Recorder 토큰 관찰자 구현입니다. 컨텍스트의 호출 봇에 토큰을 집계한다.
+func (t *Tracker) ObserveTokens(ctx context.Context, tokens int64) {
+	t.AddTokens(CallerFromContext(ctx), tokens)
+}
+
+// Status: 봇 하나의 현재 상태를 반환합니다.
+func (t *Tracker) Status(botID string) (Status, bool) {
+	if t == nil {
+		return Status{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c := t.counterLocked(botID)
+	if c == nil {
+		return Status{}, false
+	}
+	return t.statusLocked(botID, c), true
+}
+
+// Statuses: 모든 봇의 현재 상태를 Bots 순서로 반환합니다.
+func (t *Tracker) Statuses() []Status {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]Status, 0, len(Bots))
+	for _, bot := range Bots {
+		result = append(result, t.statusLocked(bot, t.counterLocked(bot)))
+	}
+	return result
+}
+
+// counterLocked: 날짜가 바뀌었으면 사용량을 초기화한 뒤 봇 카운터를 반환합니다.
+func (t *Tracker) counterLocked(botID string) *counter {
+	if day := t.now().Format(time.DateOnly); day != t.day {
+		t.day = day
+		for _, c := range t.counters {
+			*c = counter{}
+		}
+	}
+	return t.counters[botID]
+}
+
+func (t *Tracker) statusLocked(botID string, c *counter) Status {
+	budget := t.budgets[botID]
+	return Status{
+		BotID:         botID,
+		Date:          t.day,
+		RequestsUsed:  c.requests,
+		RequestsLimit: budget.DailyRequests,
+		TokensUsed:    c.tokens,
+		TokensLimit:   budget.DailyTokens,
+		ResetsAt:      t.resetsAt(),
+	}
+}
+
+func (t *Tracker) exhaustedLocked(botID, resource string, used, limit int64) error {
+	return &ExhaustedError{
+		BotID:    botID,
+		Resource: resource,
+		Used:     used,
+		Limit:    limit,
+		ResetsAt: t.resetsAt(),
+	}
+}
+
+// resetsAt: 다음 날 0시(로컬)를 반환합니다.
+func (t *Tracker) resetsAt() time.Time {
+	now := t.now()
+	return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+}
+
+type callerKey struct{}
+
+// WithCaller: 호출 봇 식별자를 컨텍스트에 저장합니다.
+func WithCaller(ctx context.Context, botID string) context.Context {
+	if botID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, callerKey{}, botID)
+}
+
+// CallerFromContext: 컨텍스트의 호출 봇 식별자를 반환합니다. 없으면 빈 문자열입니다.
+func CallerFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	botID, _ := ctx.Value(callerKey{}).(string)
+	return botID
+}
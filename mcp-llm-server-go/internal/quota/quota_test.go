@@ -0,0 +1,100 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+func newTestTracker(now *time.Time) *Tracker {
+	tracker := NewTracker(config.QuotaConfig{
+		Enabled: true,
+		Bots: map[string]config.BotQuota{
+			BotTwentyQ:    {DailyRequests: 2},
+			BotTurtleSoup: {DailyTokens: 100},
+		},
+	})
+	tracker.now = func() time.Time { return *now }
+	return tracker
+}
+
+func TestTrackerRequestBudget(t *testing.T) {
+	now := time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now)
+
+	for i := range 2 {
+		if err := tracker.Acquire(BotTwentyQ); err != nil {
+			t.Fatalf("acquire %d: %v", i, err)
+		}
+	}
+	err := tracker.Acquire(BotTwentyQ)
+	var exhausted *ExhaustedError
+	if !errors.Is(err, ErrExhausted) || !errors.As(err, &exhausted) || exhausted.Resource != ResourceRequests {
+		t.Fatalf("expected request budget exhausted, got %v", err)
+	}
+	if want := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC); !exhausted.ResetsAt.Equal(want) {
+		t.Fatalf("resets_at = %v, want %v", exhausted.ResetsAt, want)
+	}
+
+	// 무제한 봇은 영향 없음
+	if err := tracker.Acquire(BotHolo); err != nil {
+		t.Fatalf("holo should be unlimited: %v", err)
+	}
+
+	// 날짜가 바뀌면 초기화
+	now = now.Add(2 * time.Hour)
+	if err := tracker.Acquire(BotTwentyQ); err != nil {
+		t.Fatalf("budget should reset on new day: %v", err)
+	}
+	st, _ := tracker.Status(BotTwentyQ)
+	if st.Date != "2026-01-02" || st.RequestsUsed != 1 || st.RequestsLimit != 2 {
+		t.Fatalf("unexpected status: %+v", st)
+	}
+}
+
+func TestTrackerTokenBudgetFromContext(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now)
+
+	ctx := WithCaller(context.Background(), BotTurtleSoup)
+	if err := tracker.Acquire(BotTurtleSoup); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	tracker.ObserveTokens(ctx, 120)
+	tracker.ObserveTokens(context.Background(), 1000) // 호출 봇 없음: 집계 안 함
+
+	err := tracker.Acquire(BotTurtleSoup)
+	var exhausted *ExhaustedError
+	if !errors.As(err, &exhausted) || exhausted.Resource != ResourceTokens || exhausted.Used != 120 {
+		t.Fatalf("expected token budget exhausted, got %v", err)
+	}
+
+	statuses := tracker.Statuses()
+	if len(statuses) != len(Bots) || statuses[1].BotID != BotTurtleSoup || statuses[1].TokensUsed != 120 {
+		t.Fatalf("unexpected statuses: %+v", statuses)
+	}
+}
+
+func TestTrackerDisabledOnlyCounts(t *testing.T) {
+	tracker := NewTracker(config.QuotaConfig{Bots: map[string]config.BotQuota{BotHolo: {DailyRequests: 1}}})
+	for range 3 {
+		if err := tracker.Acquire(BotHolo); err != nil {
+			t.Fatalf("disabled tracker should not enforce: %v", err)
+		}
+	}
+	if st, _ := tracker.Status(BotHolo); st.RequestsUsed != 3 {
+		t.Fatalf("disabled tracker should still count, got %d", st.RequestsUsed)
+	}
+}
+
+func TestNormalizeBotID(t *testing.T) {
+	if got := NormalizeBotID(" TwentyQ "); got != BotTwentyQ {
+		t.Fatalf("NormalizeBotID = %q", got)
+	}
+	if got := NormalizeBotID("unknown"); got != "" {
+		t.Fatalf("unknown bot should normalize to empty, got %q", got)
+	}
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// embeddingKeyPrefix: 임베딩 벡터 캐시 키 접두사 (값은 float32 little-endian 바이트)
+const embeddingKeyPrefix = "embedding:"
+
+// LoadEmbeddings: 캐시된 임베딩 벡터를 키별로 반환합니다. 없는 키는 결과에서 빠진다.
+func (s *Store) LoadEmbeddings(ctx context.Context, keys []string) (map[string][]float32, error) {
+	if !s.enabled {
+		return nil, ErrStoreDisabled
+	}
+	result := make(map[string][]float32, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	if s.backend == storeBackendMemory {
+		for _, key := range keys {
+			if raw, ok := s.embeddings.Get(key); ok {
+				if vector, ok := decodeEmbedding(raw); ok {
+					result[key] = vector
+				}
+			}
+		}
+		return result, nil
+	}
+
+	// 키마다 슬롯이 달라 MGET 대신 GET 파이프라인으로 조회
+	client := s.active()
+	cmds := make(valkey.Commands, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, client.B().Get().Key(embeddingKeyPrefix+key).Build())
+	}
+	for i, resp := range client.DoMulti(ctx, cmds...) {
+		raw, err := resp.ToString()
+		if err != nil {
+			if valkey.IsValkeyNil(err) {
+				continue
+			}
+			return nil, fmt.Errorf("load embedding %s: %w", keys[i], err)
+		}
+		if vector, ok := decodeEmbedding(raw); ok {
+			result[keys[i]] = vector
+		}
+	}
+	return result, nil
+}
+
+// SaveEmbeddings: 임베딩 벡터를 TTL과 함께 저장합니다.
+// 다시 계산할 수 있는 캐시이므로 대기 노드로는 복제하지 않는다.
+func (s *Store) SaveEmbeddings(ctx context.Context, vectors map[string][]float32, ttl time.Duration) error {
+	if !s.enabled {
+		return ErrStoreDisabled
+	}
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	if s.backend == storeBackendMemory {
+		for key, vector := range vectors {
+			s.embeddings.Set(key, encodeEmbedding(vector))
+		}
+		return nil
+	}
+
+	client := s.active()
+	cmds := make(valkey.Commands, 0, len(vectors))
+	for key, vector := range vectors {
+		cmds = append(cmds, client.B().Set().Key(embeddingKeyPrefix+key).Value(encodeEmbedding(vector)).Ex(ttl).Build())
+	}
+	for _, resp := range client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("save embeddings: %w", err)
+		}
+	}
+	return nil
+}
+
+func encodeEmbedding(vector []float32) string {
+	buf := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	return string(buf)
+}
+
+func decodeEmbedding(raw string) ([]float32, bool) {
+	if raw == "" || len(raw)%4 != 0 {
+		return nil, false
+	}
+	data := []byte(raw)
+	vector := make([]float32, len(data)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return vector, true
+}
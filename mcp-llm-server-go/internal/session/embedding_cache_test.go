@@ -0,0 +1,53 @@
+package session
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+func TestStoreEmbeddings_Valkey(t *testing.T) {
+	store, primary, standby := newReplicatedTestStore(t)
+	ctx := context.Background()
+
+	vector := []float32{0.25, -1.5, 3}
+	if err := store.SaveEmbeddings(ctx, map[string][]float32{"m:a": vector}, time.Hour); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if ttl := primary.TTL(embeddingKeyPrefix + "m:a"); ttl != time.Hour {
+		t.Fatalf("expected 1h ttl, got %v", ttl)
+	}
+	if standby.Exists(embeddingKeyPrefix + "m:a") {
+		t.Fatalf("embedding cache must not be replicated")
+	}
+
+	loaded, err := store.LoadEmbeddings(ctx, []string{"m:a", "m:missing"})
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(loaded) != 1 || !slices.Equal(loaded["m:a"], vector) {
+		t.Fatalf("unexpected embeddings: %v", loaded)
+	}
+}
+
+func TestStoreEmbeddings_MemoryBackend(t *testing.T) {
+	store, err := NewStore(&config.Config{
+		SessionStore: config.SessionStoreConfig{Enabled: false},
+		Embedding:    config.EmbeddingConfig{CacheTTLSeconds: 60, CacheMemoryMaxSize: 10},
+	})
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.SaveEmbeddings(ctx, map[string][]float32{"m:a": {1, 2}}, time.Minute); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	loaded, err := store.LoadEmbeddings(ctx, []string{"m:a", "m:b"})
+	if err != nil || len(loaded) != 1 || !slices.Equal(loaded["m:a"], []float32{1, 2}) {
+		t.Fatalf("unexpected embeddings: %v, %v", loaded, err)
+	}
+}
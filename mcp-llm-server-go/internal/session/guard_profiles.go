@@ -0,0 +1,130 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	// guardProfilesKey: 호출 봇별 가드 프로필을 보관하는 해시 키 (field=호출자, value=JSON)
+	guardProfilesKey = "guard:profiles"
+	// guardRulesKey: 관리 API로 추가한 가드 규칙을 보관하는 해시 키 (field=규칙 ID, value=JSON)
+	guardRulesKey = "guard:rules"
+	// guardAllowlistKey: 모든 프로필에 공통 적용하는 allowlist 해시 키 (field=항목, value=JSON)
+	guardAllowlistKey = "guard:allowlist"
+)
+
+// LoadGuardProfiles: 저장된 가드 프로필 원문(JSON)을 호출자별로 반환합니다.
+func (s *Store) LoadGuardProfiles(ctx context.Context) (map[string]string, error) {
+	return s.loadGuardHash(ctx, guardProfilesKey)
+}
+
+// SaveGuardProfile: 가드 프로필을 저장합니다.
+func (s *Store) SaveGuardProfile(ctx context.Context, caller string, data string) error {
+	return s.saveGuardHash(ctx, guardProfilesKey, caller, data)
+}
+
+// DeleteGuardProfile: 가드 프로필을 삭제합니다.
+func (s *Store) DeleteGuardProfile(ctx context.Context, caller string) error {
+	return s.deleteGuardHash(ctx, guardProfilesKey, caller)
+}
+
+// LoadGuardRules: 저장된 런타임 가드 규칙 원문(JSON)을 규칙 ID별로 반환합니다.
+func (s *Store) LoadGuardRules(ctx context.Context) (map[string]string, error) {
+	return s.loadGuardHash(ctx, guardRulesKey)
+}
+
+// SaveGuardRule: 런타임 가드 규칙을 저장합니다.
+func (s *Store) SaveGuardRule(ctx context.Context, id string, data string) error {
+	return s.saveGuardHash(ctx, guardRulesKey, id, data)
+}
+
+// DeleteGuardRule: 런타임 가드 규칙을 삭제합니다.
+func (s *Store) DeleteGuardRule(ctx context.Context, id string) error {
+	return s.deleteGuardHash(ctx, guardRulesKey, id)
+}
+
+// LoadGuardAllowlist: 저장된 공통 allowlist 항목 원문(JSON)을 항목별로 반환합니다.
+func (s *Store) LoadGuardAllowlist(ctx context.Context) (map[string]string, error) {
+	return s.loadGuardHash(ctx, guardAllowlistKey)
+}
+
+// SaveGuardAllowlist: 공통 allowlist 항목을 저장합니다.
+func (s *Store) SaveGuardAllowlist(ctx context.Context, entry string, data string) error {
+	return s.saveGuardHash(ctx, guardAllowlistKey, entry, data)
+}
+
+// DeleteGuardAllowlist: 공통 allowlist 항목을 삭제합니다.
+func (s *Store) DeleteGuardAllowlist(ctx context.Context, entry string) error {
+	return s.deleteGuardHash(ctx, guardAllowlistKey, entry)
+}
+
+func (s *Store) loadGuardHash(ctx context.Context, key string) (map[string]string, error) {
+	if !s.enabled {
+		return nil, ErrStoreDisabled
+	}
+	if s.backend == storeBackendMemory {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return maps.Clone(s.guardHashes[key]), nil
+	}
+
+	client := s.active()
+	result, err := client.Do(ctx, client.B().Hgetall().Key(key).Build()).AsStrMap()
+	if err != nil && !valkey.IsValkeyNil(err) {
+		return nil, fmt.Errorf("load %s: %w", key, err)
+	}
+	return result, nil
+}
+
+// saveGuardHash: 가드 설정은 세션과 달리 복제 대기열을 거치지 않으므로 대기 노드에도 즉시 기록합니다.
+func (s *Store) saveGuardHash(ctx context.Context, key string, field string, data string) error {
+	if !s.enabled {
+		return ErrStoreDisabled
+	}
+	if s.backend == storeBackendMemory {
+		s.mu.Lock()
+		if s.guardHashes == nil {
+			s.guardHashes = make(map[string]map[string]string)
+		}
+		if s.guardHashes[key] == nil {
+			s.guardHashes[key] = make(map[string]string)
+		}
+		s.guardHashes[key][field] = data
+		s.mu.Unlock()
+		return nil
+	}
+
+	client := s.active()
+	if err := client.Do(ctx, client.B().Hset().Key(key).FieldValue().FieldValue(field, data).Build()).Error(); err != nil {
+		return fmt.Errorf("save %s: %w", key, err)
+	}
+	if passive := s.passive(); passive != nil {
+		_ = passive.Do(ctx, passive.B().Hset().Key(key).FieldValue().FieldValue(field, data).Build()).Error()
+	}
+	return nil
+}
+
+func (s *Store) deleteGuardHash(ctx context.Context, key string, field string) error {
+	if !s.enabled {
+		return ErrStoreDisabled
+	}
+	if s.backend == storeBackendMemory {
+		s.mu.Lock()
+		delete(s.guardHashes[key], field)
+		s.mu.Unlock()
+		return nil
+	}
+
+	client := s.active()
+	if err := client.Do(ctx, client.B().Hdel().Key(key).Field(field).Build()).Error(); err != nil {
+		return fmt.Errorf("delete %s: %w", key, err)
+	}
+	if passive := s.passive(); passive != nil {
+		_ = passive.Do(ctx, passive.B().Hdel().Key(key).Field(field).Build()).Error()
+	}
+	return nil
+}
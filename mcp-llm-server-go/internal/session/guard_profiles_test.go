@@ -0,0 +1,92 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+func TestStoreGuardProfiles_WritesBothNodes(t *testing.T) {
+	store, primary, standby := newReplicatedTestStore(t)
+	ctx := context.Background()
+
+	if err := store.SaveGuardProfile(ctx, "turtlesoup", `{"sensitivity":0.8}`); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if primary.HGet(guardProfilesKey, "turtlesoup") == "" || standby.HGet(guardProfilesKey, "turtlesoup") == "" {
+		t.Fatalf("profile must be written to both nodes")
+	}
+
+	// 대기 노드로 전환해도 프로필이 그대로 보임
+	if err := store.Failover(ctx, StoreTargetStandby); err != nil {
+		t.Fatalf("failover: %v", err)
+	}
+	loaded, err := store.LoadGuardProfiles(ctx)
+	if err != nil || loaded["turtlesoup"] != `{"sensitivity":0.8}` {
+		t.Fatalf("unexpected profiles after failover: %v, %v", loaded, err)
+	}
+
+	if err := store.DeleteGuardProfile(ctx, "turtlesoup"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if primary.Exists(guardProfilesKey) || standby.Exists(guardProfilesKey) {
+		t.Fatalf("profile must be removed from both nodes")
+	}
+}
+
+func TestStoreGuardProfiles_MemoryBackend(t *testing.T) {
+	store, err := NewStore(&config.Config{SessionStore: config.SessionStoreConfig{Enabled: false}})
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.SaveGuardProfile(ctx, "twentyq", `{}`); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	loaded, err := store.LoadGuardProfiles(ctx)
+	if err != nil || len(loaded) != 1 {
+		t.Fatalf("unexpected profiles: %v, %v", loaded, err)
+	}
+	if err := store.DeleteGuardProfile(ctx, "twentyq"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if loaded, _ := store.LoadGuardProfiles(ctx); len(loaded) != 0 {
+		t.Fatalf("expected empty profiles, got %v", loaded)
+	}
+}
+
+func TestStoreGuardRulesAndAllowlist(t *testing.T) {
+	store, primary, standby := newReplicatedTestStore(t)
+	ctx := context.Background()
+
+	if err := store.SaveGuardRule(ctx, "leak", `{"type":"regex"}`); err != nil {
+		t.Fatalf("save rule: %v", err)
+	}
+	if err := store.SaveGuardAllowlist(ctx, "r1", `{"entry":"r1"}`); err != nil {
+		t.Fatalf("save allowlist: %v", err)
+	}
+	if primary.HGet(guardRulesKey, "leak") == "" || standby.HGet(guardAllowlistKey, "r1") == "" {
+		t.Fatalf("rules and allowlist must be written to both nodes")
+	}
+
+	rules, err := store.LoadGuardRules(ctx)
+	if err != nil || rules["leak"] != `{"type":"regex"}` {
+		t.Fatalf("unexpected rules: %v, %v", rules, err)
+	}
+	// 프로필 해시와 섞이지 않음
+	if profiles, _ := store.LoadGuardProfiles(ctx); len(profiles) != 0 {
+		t.Fatalf("expected no profiles, got %v", profiles)
+	}
+
+	if err := store.DeleteGuardRule(ctx, "leak"); err != nil {
+		t.Fatalf("delete rule: %v", err)
+	}
+	if err := store.DeleteGuardAllowlist(ctx, "r1"); err != nil {
+		t.Fatalf("delete allowlist: %v", err)
+	}
+	if primary.Exists(guardRulesKey) || standby.Exists(guardAllowlistKey) {
+		t.Fatalf("rules and allowlist must be removed from both nodes")
+	}
+}
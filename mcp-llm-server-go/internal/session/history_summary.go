@@ -0,0 +1,220 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+const (
+	// HistorySummaryTask: 히스토리 요약 호출의 작업명 (LLM_TASK_PROVIDERS로 공급자 지정 가능)
+	HistorySummaryTask = "history_summary"
+
+	historySummaryTimeout = 60 * time.Second
+	historySummaryLockTTL = 2 * historySummaryTimeout
+
+	historySummarySystemPrompt = `당신은 게임 진행 기록을 압축하는 도우미입니다.
+주어진 이전 질문과 답변에서 이후 진행에 필요한 사실(확인된 것, 부정된 것, 중요 단서)을 빠짐없이 뽑아
+짧은 한국어 목록으로 요약하세요. 추측이나 새로운 정보는 덧붙이지 마세요.`
+)
+
+// EnableHistorySummary: 히스토리 항목 수가 SESSION_HISTORY_COMPRESS_THRESHOLD를 넘으면
+// 오래된 항목을 provider로 요약한 항목 하나로 압축하도록 설정합니다. (추가 시 백그라운드로 실행)
+func (s *Store) EnableHistorySummary(provider llm.Provider, logger *slog.Logger) {
+	if s == nil || provider == nil {
+		return
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	s.summarizer = provider
+	s.summaryLogger = logger
+}
+
+// maybeCompactHistory: 추가 후 항목 수가 임계값을 넘었으면 세션당 하나씩 백그라운드 압축을 시작합니다.
+func (s *Store) maybeCompactHistory(sessionID string, length int) {
+	if s.summarizer == nil || s.cfg == nil {
+		return
+	}
+	threshold := s.cfg.Session.HistoryCompressThreshold
+	if threshold <= 0 || length <= threshold {
+		return
+	}
+	if _, running := s.compacting.LoadOrStore(sessionID, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		defer s.compacting.Delete(sessionID)
+		ctx, cancel := context.WithTimeout(context.Background(), historySummaryTimeout)
+		defer cancel()
+		if _, err := s.CompactHistory(ctx, sessionID); err != nil {
+			s.summaryLogger.Warn("history_compact_failed", "session_id", sessionID, "err", err)
+		}
+	}()
+}
+
+// CompactHistory: 최근 HistoryCompressKeep개를 제외한 앞부분을 LLM 요약 항목 하나로 바꿉니다.
+// 임계값 이하이거나 요약이 비활성이면 false를 반환합니다.
+func (s *Store) CompactHistory(ctx context.Context, sessionID string) (bool, error) {
+	if !s.enabled {
+		return false, ErrStoreDisabled
+	}
+	if s.summarizer == nil || s.cfg == nil || s.cfg.Session.HistoryCompressThreshold <= 0 {
+		return false, nil
+	}
+
+	unlock, ok, err := s.lockCompaction(ctx, sessionID)
+	if err != nil || !ok {
+		return false, err
+	}
+	defer unlock()
+
+	history, err := s.GetHistory(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+	keep := max(s.cfg.Session.HistoryCompressKeep, 0)
+	if len(history) <= s.cfg.Session.HistoryCompressThreshold || len(history) <= keep {
+		return false, nil
+	}
+
+	head := history[:len(history)-keep]
+	summary, err := s.summarizeHistory(ctx, head)
+	if err != nil {
+		return false, err
+	}
+
+	if s.backend == storeBackendMemory {
+		if !s.replaceHistoryHeadMemory(sessionID, head, summary) {
+			return false, nil
+		}
+	} else if err := s.replaceHistoryHead(ctx, sessionID, len(head), summary); err != nil {
+		return false, err
+	}
+
+	s.replicate(sessionID)
+	s.summaryLogger.Info("history_compacted",
+		"session_id", sessionID,
+		"compacted", len(head),
+		"kept", keep,
+		"summary_of", summary.SummaryOf,
+	)
+	return true, nil
+}
+
+// summarizeHistory: head를 요약 항목 하나로 만듭니다. 이전 요약 항목도 다시 접어 넣는다.
+func (s *Store) summarizeHistory(ctx context.Context, head []llm.HistoryEntry) (llm.HistoryEntry, error) {
+	summaryOf := 0
+	var b strings.Builder
+	for _, entry := range head {
+		if entry.SummaryOf > 0 {
+			summaryOf += entry.SummaryOf
+			b.WriteString("(이전 요약)\n")
+		} else {
+			summaryOf++
+			b.WriteString(entry.Role)
+			b.WriteString(": ")
+		}
+		b.WriteString(strings.TrimSpace(entry.Content))
+		b.WriteString("\n")
+	}
+
+	text, _, err := s.summarizer.Chat(ctx, llm.Request{
+		Prompt:       b.String(),
+		SystemPrompt: historySummarySystemPrompt,
+		Model:        s.cfg.Session.HistorySummaryModel,
+		Task:         HistorySummaryTask,
+	})
+	if err != nil {
+		return llm.HistoryEntry{}, fmt.Errorf("summarize history: %w", err)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return llm.HistoryEntry{}, fmt.Errorf("summarize history: empty summary")
+	}
+
+	return llm.HistoryEntry{
+		Role:      "user",
+		Content:   fmt.Sprintf("[이전 대화 요약: %d개 메시지]\n%s", summaryOf, text),
+		SummaryOf: summaryOf,
+	}, nil
+}
+
+// lockCompaction: 같은 세션을 여러 인스턴스가 동시에 압축하지 않도록 잠급니다. (메모리 백엔드는 maybeCompactHistory의 세션별 표시로 충분)
+func (s *Store) lockCompaction(ctx context.Context, sessionID string) (func(), bool, error) {
+	if s.backend == storeBackendMemory {
+		return func() {}, true, nil
+	}
+
+	client := s.active()
+	key := fmt.Sprintf("session:%s:compact_lock", sessionID)
+	cmd := client.B().Set().Key(key).Value("1").Nx().Ex(historySummaryLockTTL).Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("lock history compaction: %w", err)
+	}
+	return func() {
+		_ = client.Do(context.WithoutCancel(ctx), client.B().Del().Key(key).Build()).Error()
+	}, true, nil
+}
+
+// replaceHistoryHead: 리스트 앞쪽 count개를 요약 항목으로 바꿉니다. (요약 중 뒤에 추가된 항목은 그대로 남는다)
+func (s *Store) replaceHistoryHead(ctx context.Context, sessionID string, count int, summary llm.HistoryEntry) error {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("marshal history summary: %w", err)
+	}
+	compressed, err := compressZstd(data)
+	if err != nil {
+		return fmt.Errorf("compress history summary: %w", err)
+	}
+
+	client := s.active()
+	historyKey := s.historyKey(sessionID)
+	cmds := []valkey.Completed{
+		client.B().Ltrim().Key(historyKey).Start(int64(count)).Stop(-1).Build(),
+		client.B().Lpush().Key(historyKey).Element(string(compressed)).Build(),
+	}
+	// 전부 잘려 키가 사라졌다가 새로 만들어진 경우를 대비해 TTL을 다시 건다
+	if ttl := s.ttl(); ttl > 0 {
+		cmds = append(cmds, client.B().Expire().Key(historyKey).Seconds(int64(ttl.Seconds())).Build())
+	}
+	for _, result := range client.DoMulti(ctx, cmds...)[:2] {
+		if err := result.Error(); err != nil {
+			return fmt.Errorf("replace history head: %w", err)
+		}
+	}
+	return nil
+}
+
+// replaceHistoryHeadMemory: 메모리 백엔드에서 앞쪽 head를 요약 항목으로 바꿉니다. 그사이 앞부분이 바뀌었으면 false
+func (s *Store) replaceHistoryHeadMemory(sessionID string, head []llm.HistoryEntry, summary llm.HistoryEntry) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.history[sessionID]
+	if len(current) < len(head) {
+		return false
+	}
+	for i := range head {
+		if current[i] != head[i] {
+			return false
+		}
+	}
+
+	compacted := make([]llm.HistoryEntry, 0, len(current)-len(head)+1)
+	compacted = append(compacted, summary)
+	compacted = append(compacted, current[len(head):]...)
+	s.history[sessionID] = compacted
+	return true
+}
@@ -0,0 +1,154 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+type fakeSummarizer struct {
+	prompts []string
+	tasks   []string
+}
+
+func (f *fakeSummarizer) Name() string { return "fake" }
+
+func (f *fakeSummarizer) Chat(_ context.Context, req llm.Request) (string, string, error) {
+	f.prompts = append(f.prompts, req.Prompt)
+	f.tasks = append(f.tasks, req.Task)
+	return fmt.Sprintf("요약%d", len(f.prompts)), "fake-model", nil
+}
+
+func (f *fakeSummarizer) ChatWithUsage(ctx context.Context, req llm.Request) (llm.ChatResult, string, error) {
+	text, model, err := f.Chat(ctx, req)
+	return llm.ChatResult{Text: text}, model, err
+}
+
+func (f *fakeSummarizer) Structured(context.Context, llm.Request, map[string]any) (map[string]any, string, error) {
+	return nil, "", nil
+}
+
+func qaPair(n int) []llm.HistoryEntry {
+	return []llm.HistoryEntry{
+		{Role: "user", Content: fmt.Sprintf("Q: 질문%d", n)},
+		{Role: "assistant", Content: fmt.Sprintf("A: 답%d", n)},
+	}
+}
+
+// compactionSession: s1 세션에 Q/A 쌍을 pairs개 추가합니다.
+func compactionSession(t *testing.T, store *Store, pairs int) {
+	t.Helper()
+	for i := 1; i <= pairs; i++ {
+		if err := store.AppendHistory(context.Background(), "s1", qaPair(i)...); err != nil {
+			t.Fatalf("append history: %v", err)
+		}
+	}
+}
+
+func assertCompacted(t *testing.T, store *Store, summarizer *fakeSummarizer) {
+	t.Helper()
+	ctx := context.Background()
+
+	compacted, err := store.CompactHistory(ctx, "s1")
+	if err != nil || !compacted {
+		t.Fatalf("expected compaction, got %v %v", compacted, err)
+	}
+	history, err := store.GetHistory(ctx, "s1")
+	if err != nil {
+		t.Fatalf("get history: %v", err)
+	}
+	if len(history) != 5 || history[0].SummaryOf != 6 || !strings.Contains(history[0].Content, "요약") {
+		t.Fatalf("unexpected compacted history: %+v", history)
+	}
+	if history[1].Content != "Q: 질문4" || history[4].Content != "A: 답5" {
+		t.Fatalf("recent entries should be kept verbatim: %+v", history)
+	}
+	if summarizer.tasks[0] != HistorySummaryTask || !strings.Contains(summarizer.prompts[0], "Q: 질문1") {
+		t.Fatalf("unexpected summary request: %v %v", summarizer.tasks, summarizer.prompts)
+	}
+
+	// 다시 임계값을 넘으면 이전 요약도 함께 접어 넣음
+	if err := store.AppendHistory(ctx, "s1", append(qaPair(6), qaPair(7)...)...); err != nil {
+		t.Fatalf("append history: %v", err)
+	}
+	if compacted, err := store.CompactHistory(ctx, "s1"); err != nil || !compacted {
+		t.Fatalf("expected second compaction, got %v %v", compacted, err)
+	}
+	history, _ = store.GetHistory(ctx, "s1")
+	if len(history) != 5 || history[0].SummaryOf != 10 || history[1].Content != "Q: 질문6" {
+		t.Fatalf("unexpected history after second compaction: %+v", history)
+	}
+	if !strings.Contains(summarizer.prompts[len(summarizer.prompts)-1], "(이전 요약)") {
+		t.Fatalf("previous summary should be folded into the prompt")
+	}
+}
+
+func TestCompactHistory_Valkey(t *testing.T) {
+	store, _ := newTestStore(t, 0)
+	store.cfg.Session.HistoryCompressThreshold = 8
+	store.cfg.Session.HistoryCompressKeep = 4
+	compactionSession(t, store, 5)
+
+	summarizer := &fakeSummarizer{}
+	store.EnableHistorySummary(summarizer, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	assertCompacted(t, store, summarizer)
+}
+
+func TestCompactHistory_Memory(t *testing.T) {
+	store := newMemoryStore(&config.Config{Session: config.SessionConfig{
+		SessionTTLMinutes:        1,
+		HistoryCompressThreshold: 8,
+		HistoryCompressKeep:      4,
+	}})
+	compactionSession(t, store, 5)
+
+	summarizer := &fakeSummarizer{}
+	store.EnableHistorySummary(summarizer, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	assertCompacted(t, store, summarizer)
+}
+
+func TestCompactHistory_BelowThresholdOrDisabled(t *testing.T) {
+	store := newMemoryStore(&config.Config{Session: config.SessionConfig{
+		SessionTTLMinutes:        1,
+		HistoryCompressThreshold: 8,
+		HistoryCompressKeep:      4,
+	}})
+	compactionSession(t, store, 4)
+
+	if compacted, err := store.CompactHistory(context.Background(), "s1"); err != nil || compacted {
+		t.Fatalf("summary disabled: expected no compaction, got %v %v", compacted, err)
+	}
+	store.EnableHistorySummary(&fakeSummarizer{}, nil)
+	if compacted, err := store.CompactHistory(context.Background(), "s1"); err != nil || compacted {
+		t.Fatalf("below threshold: expected no compaction, got %v %v", compacted, err)
+	}
+}
+
+func TestAppendHistory_TriggersBackgroundCompaction(t *testing.T) {
+	store := newMemoryStore(&config.Config{Session: config.SessionConfig{
+		SessionTTLMinutes:        1,
+		HistoryCompressThreshold: 8,
+		HistoryCompressKeep:      4,
+	}})
+	store.EnableHistorySummary(&fakeSummarizer{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	compactionSession(t, store, 5)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		history, _ := store.GetHistory(context.Background(), "s1")
+		if len(history) > 0 && history[0].SummaryOf > 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("history was not compacted in background: %+v", history)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
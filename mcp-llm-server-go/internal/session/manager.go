@@ -9,30 +9,29 @@ import (
 	"time"
 
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
-	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/gemini"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
 )
 
 // Manager 세션 관리자
 type Manager struct {
-	store  *Store
-	gemini *gemini.Client
-	cfg    *config.Config
-	logger *slog.Logger
+	store    *Store
+	provider llm.Provider
+	cfg      *config.Config
+	logger   *slog.Logger
 }
 
 // NewManager 세션 관리자 생성
 func NewManager(
 	store *Store,
-	geminiClient *gemini.Client,
+	provider llm.Provider,
 	cfg *config.Config,
 	logger *slog.Logger,
 ) *Manager {
 	return &Manager{
-		store:  store,
-		gemini: geminiClient,
-		cfg:    cfg,
-		logger: logger,
+		store:    store,
+		provider: provider,
+		cfg:      cfg,
+		logger:   logger,
 	}
 }
 
@@ -134,15 +133,15 @@ func (m *Manager) Chat(ctx context.Context, sessionID string, req ChatRequest) (
 		history = make([]llm.HistoryEntry, 0)
 	}
 
-	// Gemini 요청
-	geminiReq := gemini.Request{
+	// LLM 요청 (작업별 공급자 라우팅)
+	llmReq := llm.Request{
 		Prompt:       req.Message,
 		SystemPrompt: meta.SystemPrompt,
 		History:      history,
 		Model:        meta.Model,
 	}
 
-	result, model, err := m.gemini.ChatWithUsage(ctx, geminiReq)
+	result, model, err := m.provider.ChatWithUsage(ctx, llmReq)
 	if err != nil {
 		return nil, fmt.Errorf("chat with usage: %w", err)
 	}
@@ -0,0 +1,391 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+// 복제 대상 노드 이름
+const (
+	StoreTargetPrimary = "primary"
+	StoreTargetStandby = "standby"
+)
+
+const (
+	replicationSyncTimeout = 5 * time.Second
+	failbackSyncTimeout    = 2 * time.Minute
+)
+
+var (
+	// ErrNoStandby: 대기 노드가 설정되지 않음
+	ErrNoStandby = errors.New("session store standby not configured")
+	// ErrInvalidTarget: primary/standby 외의 전환 대상
+	ErrInvalidTarget = errors.New("invalid session store target")
+)
+
+// ReplicationStatus: 세션 저장소 복제/전환 상태입니다.
+type ReplicationStatus struct {
+	Enabled          bool       `json:"enabled"`
+	Active           string     `json:"active"`
+	Pending          int        `json:"pending"`
+	Replicated       int64      `json:"replicated"`
+	Failed           int64      `json:"failed"`
+	Dropped          int64      `json:"dropped"` // 대기열 초과로 전체 동기화에 맡긴 건수
+	ReconcileSeconds int        `json:"reconcile_seconds"`
+	LastReconcileAt  *time.Time `json:"last_reconcile_at,omitempty"`
+	LastError        string     `json:"last_error,omitempty"`
+}
+
+// replicator: 활성 노드의 세션 쓰기를 다른 노드로 비동기 복제합니다.
+// 세션 단위로 최신 상태를 통째로 복사하므로 순서가 뒤바뀌어도 마지막 동기화 결과가 최종 상태가 됩니다.
+type replicator struct {
+	store      *Store
+	logger     *slog.Logger
+	interval   time.Duration
+	maxPending int
+
+	mu              sync.Mutex
+	pending         map[string]struct{}
+	lastReconcileAt time.Time
+	lastError       string
+
+	replicated atomic.Int64
+	failed     atomic.Int64
+	dropped    atomic.Int64
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wake      chan struct{}
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+func newReplicator(store *Store, cfg config.SessionStoreConfig) *replicator {
+	return &replicator{
+		store:      store,
+		logger:     slog.Default().With("component", "session_replication"),
+		interval:   time.Duration(max(1, cfg.ReconcileSeconds)) * time.Second,
+		maxPending: max(1, cfg.ReplicationMaxPending),
+		pending:    make(map[string]struct{}),
+		wake:       make(chan struct{}, 1),
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+	}
+}
+
+// StartReplication: 대기 노드 복제 루프를 시작합니다. 대기 노드가 없으면 아무 일도 하지 않습니다.
+func (s *Store) StartReplication() {
+	if s == nil || s.repl == nil {
+		return
+	}
+	s.repl.startOnce.Do(func() {
+		go s.repl.loop()
+	})
+}
+
+// active: 현재 요청을 처리하는 노드
+func (s *Store) active() valkey.Client {
+	if s.standby != nil && s.useStandby.Load() {
+		return s.standby
+	}
+	return s.client
+}
+
+// passive: 복제를 받는 노드 (대기 노드가 없으면 nil)
+func (s *Store) passive() valkey.Client {
+	if s.standby == nil {
+		return nil
+	}
+	if s.useStandby.Load() {
+		return s.client
+	}
+	return s.standby
+}
+
+// ActiveTarget: 현재 활성 노드 이름을 반환합니다.
+func (s *Store) ActiveTarget() string {
+	if s != nil && s.standby != nil && s.useStandby.Load() {
+		return StoreTargetStandby
+	}
+	return StoreTargetPrimary
+}
+
+// replicate: 세션 쓰기 후 복제 대기열에 추가합니다.
+func (s *Store) replicate(sessionID string) {
+	if s.repl == nil {
+		return
+	}
+	s.repl.enqueue(sessionID)
+}
+
+// Failover: 활성 노드를 전환합니다.
+// standby 전환은 즉시 이뤄지며 마지막 복제 이후의 쓰기(복제 지연, 최대 전체 동기화 주기)는 유실될 수 있습니다.
+// primary 복귀(failback) 시에는 대기 노드의 세션을 primary로 전체 동기화한 뒤 전환합니다.
+func (s *Store) Failover(ctx context.Context, target string) error {
+	if s == nil || s.standby == nil {
+		return ErrNoStandby
+	}
+	if target != StoreTargetPrimary && target != StoreTargetStandby {
+		return fmt.Errorf("%w: %s", ErrInvalidTarget, target)
+	}
+	if s.ActiveTarget() == target {
+		return nil
+	}
+
+	if target == StoreTargetPrimary {
+		syncCtx, cancel := context.WithTimeout(ctx, failbackSyncTimeout)
+		defer cancel()
+		if err := s.repl.reconcile(syncCtx, s.standby, s.client); err != nil {
+			return fmt.Errorf("failback sync: %w", err)
+		}
+	}
+
+	s.useStandby.Store(target == StoreTargetStandby)
+	s.repl.logger.Warn("session_store_failover", "active", target)
+	s.repl.signal()
+	return nil
+}
+
+// ReplicationStatus: 복제 상태를 반환합니다.
+func (s *Store) ReplicationStatus() ReplicationStatus {
+	status := ReplicationStatus{Active: s.ActiveTarget()}
+	if s == nil || s.repl == nil {
+		return status
+	}
+
+	r := s.repl
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status.Enabled = true
+	status.Pending = len(r.pending)
+	status.Replicated = r.replicated.Load()
+	status.Failed = r.failed.Load()
+	status.Dropped = r.dropped.Load()
+	status.ReconcileSeconds = int(r.interval / time.Second)
+	status.LastError = r.lastError
+	if !r.lastReconcileAt.IsZero() {
+		at := r.lastReconcileAt
+		status.LastReconcileAt = &at
+	}
+	return status
+}
+
+func (r *replicator) enqueue(sessionID string) {
+	r.mu.Lock()
+	if _, ok := r.pending[sessionID]; !ok && len(r.pending) >= r.maxPending {
+		r.mu.Unlock()
+		r.dropped.Add(1)
+		return
+	}
+	r.pending[sessionID] = struct{}{}
+	r.mu.Unlock()
+	r.signal()
+}
+
+func (r *replicator) signal() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *replicator) stop() {
+	if r == nil {
+		return
+	}
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+	})
+	started := true
+	r.startOnce.Do(func() { started = false })
+	if started {
+		<-r.doneCh
+	}
+}
+
+func (r *replicator) loop() {
+	defer close(r.doneCh)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopCh:
+			// 종료 전 남은 대기열만 한 번 더 반영
+			r.flush()
+			return
+		case <-r.wake:
+			r.flush()
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), r.interval)
+			err := r.reconcile(ctx, r.store.active(), r.store.passive())
+			cancel()
+			r.mu.Lock()
+			r.lastReconcileAt = time.Now()
+			r.mu.Unlock()
+			if err != nil {
+				r.recordError(err)
+			}
+		}
+	}
+}
+
+// flush: 대기열의 세션을 활성 노드에서 대기 노드로 복사합니다. 실패한 세션은 전체 동기화가 보정합니다.
+func (r *replicator) flush() {
+	r.mu.Lock()
+	ids := make([]string, 0, len(r.pending))
+	for id := range r.pending {
+		ids = append(ids, id)
+	}
+	clear(r.pending)
+	r.mu.Unlock()
+
+	src, dst := r.store.active(), r.store.passive()
+	for _, id := range ids {
+		ctx, cancel := context.WithTimeout(context.Background(), replicationSyncTimeout)
+		err := r.store.syncSession(ctx, src, dst, id)
+		cancel()
+		if err != nil {
+			r.failed.Add(1)
+			r.recordError(err)
+			continue
+		}
+		r.replicated.Add(1)
+	}
+}
+
+// reconcile: src의 모든 세션을 dst로 복사하고, src에 없는 dst 세션은 삭제합니다.
+func (r *replicator) reconcile(ctx context.Context, src valkey.Client, dst valkey.Client) error {
+	if src == nil || dst == nil {
+		return nil
+	}
+
+	srcIDs, err := scanSessionIDs(ctx, src)
+	if err != nil {
+		return fmt.Errorf("scan source sessions: %w", err)
+	}
+	dstIDs, err := scanSessionIDs(ctx, dst)
+	if err != nil {
+		return fmt.Errorf("scan target sessions: %w", err)
+	}
+
+	var firstErr error
+	for id := range srcIDs {
+		if err := r.store.syncSession(ctx, src, dst, id); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	// 원본이 통째로 비어 있으면 재시작으로 데이터가 사라진 것일 수 있으므로 대상 삭제는 보류
+	if len(srcIDs) == 0 && len(dstIDs) > 0 {
+		r.logger.Warn("session_reconcile_delete_skipped", "reason", "source_empty", "target_sessions", len(dstIDs))
+		return firstErr
+	}
+	for id := range dstIDs {
+		if _, ok := srcIDs[id]; ok {
+			continue
+		}
+		if err := r.store.syncSession(ctx, src, dst, id); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (r *replicator) recordError(err error) {
+	r.mu.Lock()
+	r.lastError = err.Error()
+	r.mu.Unlock()
+	r.logger.Warn("session_replication_failed", "err", err)
+}
+
+// syncSession: 세션 메타/히스토리를 TTL과 함께 src에서 dst로 복사합니다. src에 없으면 dst에서도 삭제합니다.
+func (s *Store) syncSession(ctx context.Context, src valkey.Client, dst valkey.Client, sessionID string) error {
+	if src == nil || dst == nil {
+		return nil
+	}
+	metaKey := s.metaKey(sessionID)
+	historyKey := s.historyKey(sessionID)
+
+	reads := src.DoMulti(ctx,
+		src.B().Get().Key(metaKey).Build(),
+		src.B().Pttl().Key(metaKey).Build(),
+		src.B().Lrange().Key(historyKey).Start(0).Stop(-1).Build(),
+		src.B().Pttl().Key(historyKey).Build(),
+	)
+	meta, err := reads[0].ToString()
+	if err != nil && !valkey.IsValkeyNil(err) {
+		return fmt.Errorf("read session %s: %w", sessionID, err)
+	}
+	if valkey.IsValkeyNil(err) {
+		for _, result := range dst.DoMulti(ctx,
+			dst.B().Del().Key(metaKey).Build(),
+			dst.B().Del().Key(historyKey).Build(),
+		) {
+			if delErr := result.Error(); delErr != nil {
+				return fmt.Errorf("delete replica session %s: %w", sessionID, delErr)
+			}
+		}
+		return nil
+	}
+	metaTTL, _ := reads[1].AsInt64()
+	history, err := reads[2].AsStrSlice()
+	if err != nil {
+		return fmt.Errorf("read session history %s: %w", sessionID, err)
+	}
+	historyTTL, _ := reads[3].AsInt64()
+
+	// 메타와 히스토리 키는 슬롯이 달라 MULTI로 묶을 수 없으므로 히스토리를 먼저 쓰고 메타를 마지막에 씀
+	cmds := make(valkey.Commands, 0, 4)
+	cmds = append(cmds, dst.B().Del().Key(historyKey).Build())
+	if len(history) > 0 {
+		cmds = append(cmds, dst.B().Rpush().Key(historyKey).Element(history...).Build())
+		if historyTTL > 0 {
+			cmds = append(cmds, dst.B().Pexpire().Key(historyKey).Milliseconds(historyTTL).Build())
+		}
+	}
+	if metaTTL > 0 {
+		cmds = append(cmds, dst.B().Set().Key(metaKey).Value(meta).Px(time.Duration(metaTTL)*time.Millisecond).Build())
+	} else {
+		cmds = append(cmds, dst.B().Set().Key(metaKey).Value(meta).Build())
+	}
+
+	for _, result := range dst.DoMulti(ctx, cmds...) {
+		if err := result.Error(); err != nil {
+			return fmt.Errorf("write replica session %s: %w", sessionID, err)
+		}
+	}
+	return nil
+}
+
+// scanSessionIDs: 노드에 존재하는 세션 ID 집합
+func scanSessionIDs(ctx context.Context, client valkey.Client) (map[string]struct{}, error) {
+	ids := make(map[string]struct{})
+	var cursor uint64
+	for {
+		cmd := client.B().Scan().Cursor(cursor).Match("session:*:meta").Count(100).Build()
+		entry, err := client.Do(ctx, cmd).AsScanEntry()
+		if err != nil {
+			return nil, fmt.Errorf("scan sessions: %w", err)
+		}
+		for _, key := range entry.Elements {
+			id := strings.TrimSuffix(strings.TrimPrefix(key, "session:"), ":meta")
+			ids[id] = struct{}{}
+		}
+		cursor = entry.Cursor
+		if cursor == 0 {
+			return ids, nil
+		}
+	}
+}
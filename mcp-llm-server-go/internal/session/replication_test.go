@@ -0,0 +1,168 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+func newReplicatedTestStore(t *testing.T) (*Store, *miniredis.Miniredis, *miniredis.Miniredis) {
+	primary := miniredis.RunT(t)
+	standby := miniredis.RunT(t)
+	cfg := &config.Config{
+		SessionStore: config.SessionStoreConfig{
+			URL:                   "redis://" + primary.Addr(),
+			StandbyURL:            "redis://" + standby.Addr(),
+			Enabled:               true,
+			DisableCache:          true,
+			Active:                StoreTargetPrimary,
+			ReconcileSeconds:      60,
+			ReplicationMaxPending: 16,
+		},
+		Session: config.SessionConfig{
+			SessionTTLMinutes: 1,
+			HistoryMaxPairs:   5,
+		},
+	}
+	store, err := NewStore(cfg)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	t.Cleanup(store.Close)
+	return store, primary, standby
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met before deadline")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReplicationCopiesWritesToStandby(t *testing.T) {
+	store, _, standby := newReplicatedTestStore(t)
+	store.StartReplication()
+	ctx := context.Background()
+
+	if err := store.CreateSession(ctx, Meta{ID: "s1", Model: "m1", CreatedAt: time.Now(), UpdatedAt: time.Now()}); err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	if err := store.AppendHistory(ctx, "s1", llm.HistoryEntry{Role: "user", Content: "one"}); err != nil {
+		t.Fatalf("append history: %v", err)
+	}
+
+	waitFor(t, func() bool {
+		items, err := standby.List("session:s1:history")
+		return err == nil && len(items) == 1 && standby.Exists("session:s1:meta")
+	})
+	if ttl := standby.TTL("session:s1:meta"); ttl <= 0 {
+		t.Fatalf("expected replicated ttl, got %v", ttl)
+	}
+
+	if err := store.DeleteSession(ctx, "s1"); err != nil {
+		t.Fatalf("delete session: %v", err)
+	}
+	waitFor(t, func() bool { return !standby.Exists("session:s1:meta") && !standby.Exists("session:s1:history") })
+
+	if status := store.ReplicationStatus(); !status.Enabled || status.Replicated < 2 {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+}
+
+func TestReconcileDeletesStaleReplicaSessions(t *testing.T) {
+	store, _, standby := newReplicatedTestStore(t)
+	ctx := context.Background()
+
+	if err := store.CreateSession(ctx, Meta{ID: "live", CreatedAt: time.Now(), UpdatedAt: time.Now()}); err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	if err := standby.Set("session:stale:meta", "{}"); err != nil {
+		t.Fatalf("seed standby: %v", err)
+	}
+
+	if err := store.repl.reconcile(ctx, store.client, store.standby); err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if !standby.Exists("session:live:meta") {
+		t.Fatalf("expected live session on standby")
+	}
+	if standby.Exists("session:stale:meta") {
+		t.Fatalf("expected stale session removed from standby")
+	}
+}
+
+func TestReconcileKeepsReplicaWhenSourceEmpty(t *testing.T) {
+	store, _, standby := newReplicatedTestStore(t)
+
+	if err := standby.Set("session:s1:meta", "{}"); err != nil {
+		t.Fatalf("seed standby: %v", err)
+	}
+	if err := store.repl.reconcile(context.Background(), store.client, store.standby); err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if !standby.Exists("session:s1:meta") {
+		t.Fatalf("replica must survive an empty source")
+	}
+}
+
+func TestFailoverAndFailback(t *testing.T) {
+	store, primary, _ := newReplicatedTestStore(t)
+	ctx := context.Background()
+
+	if err := store.CreateSession(ctx, Meta{ID: "s1", Model: "m1", CreatedAt: time.Now(), UpdatedAt: time.Now()}); err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	if err := store.repl.reconcile(ctx, store.client, store.standby); err != nil {
+		t.Fatalf("initial sync: %v", err)
+	}
+
+	if err := store.Failover(ctx, StoreTargetStandby); err != nil {
+		t.Fatalf("failover: %v", err)
+	}
+	if store.ActiveTarget() != StoreTargetStandby {
+		t.Fatalf("expected standby active")
+	}
+	if _, err := store.GetSession(ctx, "s1"); err != nil {
+		t.Fatalf("expected replicated session on standby: %v", err)
+	}
+
+	// 대기 노드에서 진행된 턴은 복귀 시 primary로 옮겨져야 함
+	if err := store.AppendHistory(ctx, "s1", llm.HistoryEntry{Role: "user", Content: "during failover"}); err != nil {
+		t.Fatalf("append history: %v", err)
+	}
+	if err := store.Failover(ctx, StoreTargetPrimary); err != nil {
+		t.Fatalf("failback: %v", err)
+	}
+	items, err := primary.List("session:s1:history")
+	if err != nil || len(items) != 1 {
+		t.Fatalf("expected history synced back to primary, got %v (%v)", items, err)
+	}
+	if store.ActiveTarget() != StoreTargetPrimary {
+		t.Fatalf("expected primary active")
+	}
+}
+
+func TestFailoverValidation(t *testing.T) {
+	plain, _ := newTestStore(t, 1)
+	if err := plain.Failover(context.Background(), StoreTargetStandby); !errors.Is(err, ErrNoStandby) {
+		t.Fatalf("expected ErrNoStandby, got %v", err)
+	}
+	if status := plain.ReplicationStatus(); status.Enabled || status.Active != StoreTargetPrimary {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	store, _, _ := newReplicatedTestStore(t)
+	if err := store.Failover(context.Background(), "tertiary"); !errors.Is(err, ErrInvalidTarget) {
+		t.Fatalf("expected ErrInvalidTarget, got %v", err)
+	}
+}
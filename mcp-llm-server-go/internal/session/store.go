@@ -5,13 +5,16 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/valkey-io/valkey-go"
 
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/cache"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
 )
@@ -47,11 +50,22 @@ type Store struct {
 	enabled bool
 	backend storeBackend
 
+	standby    valkey.Client // 대기 노드 (nil이면 복제 비활성)
+	useStandby atomic.Bool   // true면 대기 노드가 활성 저장소
+	repl       *replicator
+
 	mu              sync.RWMutex
 	meta            map[string]Meta
 	history         map[string][]llm.HistoryEntry
 	metaExpiresAt   map[string]time.Time
 	historyExpireAt map[string]time.Time
+	guardHashes     map[string]map[string]string    // 메모리 백엔드의 가드 설정 해시 (키 → field → JSON)
+	embeddings      *cache.TTLCache[string, string] // 메모리 백엔드의 임베딩 벡터 캐시
+
+	// 히스토리 요약 압축 (EnableHistorySummary로 설정, nil이면 비활성)
+	summarizer    llm.Provider
+	summaryLogger *slog.Logger
+	compacting    sync.Map // 압축 중인 세션 ID
 }
 
 // NewStore: 세션 저장소를 생성합니다.
@@ -67,7 +81,37 @@ func NewStore(cfg *config.Config) (*Store, error) {
 		return newMemoryStore(cfg), nil
 	}
 
-	conn, err := parseStoreURL(cfg.SessionStore.URL)
+	client, err := newValkeyClient(cfg.SessionStore.URL, cfg.SessionStore.DisableCache)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Store{
+		client:  client,
+		cfg:     cfg,
+		enabled: true,
+		backend: storeBackendValkey,
+	}
+
+	// 대기(standby) 노드: 설정된 경우 세션 쓰기를 비동기로 복제
+	if cfg.SessionStore.StandbyURL != "" {
+		standby, err := newValkeyClient(cfg.SessionStore.StandbyURL, cfg.SessionStore.DisableCache)
+		if err != nil {
+			client.Close()
+			return nil, fmt.Errorf("standby: %w", err)
+		}
+		store.standby = standby
+		store.repl = newReplicator(store, cfg.SessionStore)
+		if cfg.SessionStore.Active == StoreTargetStandby {
+			store.useStandby.Store(true)
+		}
+	}
+
+	return store, nil
+}
+
+func newValkeyClient(rawURL string, disableCache bool) (valkey.Client, error) {
+	conn, err := parseStoreURL(rawURL)
 	if err != nil {
 		return nil, fmt.Errorf("parse session store url: %w", err)
 	}
@@ -87,18 +131,12 @@ func NewStore(cfg *config.Config) (*Store, error) {
 		Password:     conn.password,
 		InitAddress:  []string{conn.addr},
 		SelectDB:     conn.selectDB,
-		DisableCache: cfg.SessionStore.DisableCache,
+		DisableCache: disableCache,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("connect to valkey: %w", err)
 	}
-
-	return &Store{
-		client:  client,
-		cfg:     cfg,
-		enabled: true,
-		backend: storeBackendValkey,
-	}, nil
+	return client, nil
 }
 
 func newMemoryStore(cfg *config.Config) *Store {
@@ -110,6 +148,10 @@ func newMemoryStore(cfg *config.Config) *Store {
 		history:         make(map[string][]llm.HistoryEntry),
 		metaExpiresAt:   make(map[string]time.Time),
 		historyExpireAt: make(map[string]time.Time),
+		embeddings: cache.NewTTLCache[string, string](
+			cfg.Embedding.CacheMemoryMaxSize,
+			time.Duration(cfg.Embedding.CacheTTLSeconds)*time.Second,
+		),
 	}
 }
 
@@ -123,9 +165,13 @@ func (s *Store) Close() {
 	if s == nil {
 		return
 	}
+	s.repl.stop()
 	if s.backend == storeBackendValkey && s.client != nil {
 		s.client.Close()
 	}
+	if s.standby != nil {
+		s.standby.Close()
+	}
 }
 
 // metaKey 세션 메타데이터 키
@@ -157,11 +203,13 @@ func (s *Store) CreateSession(ctx context.Context, meta Meta) error {
 		return fmt.Errorf("marshal session meta: %w", err)
 	}
 
-	cmd := s.client.B().Set().Key(s.metaKey(meta.ID)).Value(string(data)).Ex(s.ttl()).Build()
-	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+	client := s.active()
+	cmd := client.B().Set().Key(s.metaKey(meta.ID)).Value(string(data)).Ex(s.ttl()).Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
 		return fmt.Errorf("create session: %w", err)
 	}
 
+	s.replicate(meta.ID)
 	return nil
 }
 
@@ -174,8 +222,9 @@ func (s *Store) GetSession(ctx context.Context, sessionID string) (*Meta, error)
 		return s.getSessionMemory(sessionID)
 	}
 
-	cmd := s.client.B().Get().Key(s.metaKey(sessionID)).Build()
-	result, err := s.client.Do(ctx, cmd).ToString()
+	client := s.active()
+	cmd := client.B().Get().Key(s.metaKey(sessionID)).Build()
+	result, err := client.Do(ctx, cmd).ToString()
 	if err != nil {
 		if valkey.IsValkeyNil(err) {
 			return nil, ErrSessionNotFound
@@ -206,11 +255,13 @@ func (s *Store) UpdateSession(ctx context.Context, meta Meta) error {
 		return fmt.Errorf("marshal session meta: %w", err)
 	}
 
-	cmd := s.client.B().Set().Key(s.metaKey(meta.ID)).Value(string(data)).Ex(s.ttl()).Build()
-	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+	client := s.active()
+	cmd := client.B().Set().Key(s.metaKey(meta.ID)).Value(string(data)).Ex(s.ttl()).Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
 		return fmt.Errorf("update session: %w", err)
 	}
 
+	s.replicate(meta.ID)
 	return nil
 }
 
@@ -224,10 +275,11 @@ func (s *Store) DeleteSession(ctx context.Context, sessionID string) error {
 		return s.deleteSessionMemory(sessionID)
 	}
 
-	metaCmd := s.client.B().Del().Key(s.metaKey(sessionID)).Build()
-	historyCmd := s.client.B().Del().Key(s.historyKey(sessionID)).Build()
+	client := s.active()
+	metaCmd := client.B().Del().Key(s.metaKey(sessionID)).Build()
+	historyCmd := client.B().Del().Key(s.historyKey(sessionID)).Build()
 
-	results := s.client.DoMulti(ctx, metaCmd, historyCmd)
+	results := client.DoMulti(ctx, metaCmd, historyCmd)
 	for i, result := range results {
 		if err := result.Error(); err != nil && !valkey.IsValkeyNil(err) {
 			if i == 0 {
@@ -236,6 +288,7 @@ func (s *Store) DeleteSession(ctx context.Context, sessionID string) error {
 			return fmt.Errorf("delete session history: %w", err)
 		}
 	}
+	s.replicate(sessionID)
 	return nil
 }
 
@@ -249,8 +302,9 @@ func (s *Store) GetHistory(ctx context.Context, sessionID string) ([]llm.History
 		return s.getHistoryMemory(sessionID), nil
 	}
 
-	cmd := s.client.B().Lrange().Key(s.historyKey(sessionID)).Start(0).Stop(-1).Build()
-	results, err := s.client.Do(ctx, cmd).AsStrSlice()
+	client := s.active()
+	cmd := client.B().Lrange().Key(s.historyKey(sessionID)).Start(0).Stop(-1).Build()
+	results, err := client.Do(ctx, cmd).AsStrSlice()
 	if err != nil {
 		return nil, fmt.Errorf("get history: %w", err)
 	}
@@ -306,29 +360,33 @@ func (s *Store) AppendHistory(ctx context.Context, sessionID string, entries ...
 	}
 
 	// 명령어 배치 구성: RPUSH + EXPIRE + (optional) LTRIM
+	client := s.active()
 	cmds := make([]valkey.Completed, 0, 3)
 
 	// 단일 RPUSH로 모든 요소 추가
-	rpushCmd := s.client.B().Rpush().Key(historyKey).Element(elements...).Build()
+	rpushCmd := client.B().Rpush().Key(historyKey).Element(elements...).Build()
 	cmds = append(cmds, rpushCmd)
 
 	// TTL 갱신
-	expireCmd := s.client.B().Expire().Key(historyKey).Seconds(int64(s.ttl().Seconds())).Build()
+	expireCmd := client.B().Expire().Key(historyKey).Seconds(int64(s.ttl().Seconds())).Build()
 	cmds = append(cmds, expireCmd)
 
 	// 히스토리 크기 제한
 	maxPairs := s.cfg.Session.HistoryMaxPairs
 	if maxPairs > 0 {
-		trimCmd := s.client.B().Ltrim().Key(historyKey).Start(int64(-maxPairs * 2)).Stop(-1).Build()
+		trimCmd := client.B().Ltrim().Key(historyKey).Start(int64(-maxPairs * 2)).Stop(-1).Build()
 		cmds = append(cmds, trimCmd)
 	}
 
 	// 모든 명령을 단일 RTT로 실행
-	results := s.client.DoMulti(ctx, cmds...)
-	if err := results[0].Error(); err != nil {
+	results := client.DoMulti(ctx, cmds...)
+	length, err := results[0].AsInt64()
+	if err != nil {
 		return fmt.Errorf("append history: %w", err)
 	}
 
+	s.replicate(sessionID)
+	s.maybeCompactHistory(sessionID, int(length))
 	return nil
 }
 
@@ -342,11 +400,12 @@ func (s *Store) SessionCount(ctx context.Context) (int, error) {
 		return s.sessionCountMemory(), nil
 	}
 
+	client := s.active()
 	var count int
 	var cursor uint64
 	for {
-		cmd := s.client.B().Scan().Cursor(cursor).Match("session:*:meta").Count(100).Build()
-		result, err := s.client.Do(ctx, cmd).AsScanEntry()
+		cmd := client.B().Scan().Cursor(cursor).Match("session:*:meta").Count(100).Build()
+		result, err := client.Do(ctx, cmd).AsScanEntry()
 		if err != nil {
 			return 0, fmt.Errorf("scan sessions: %w", err)
 		}
@@ -368,8 +427,9 @@ func (s *Store) Ping(ctx context.Context) error {
 		return nil
 	}
 
-	cmd := s.client.B().Ping().Build()
-	if err := s.client.Do(ctx, cmd).Error(); err != nil {
+	client := s.active()
+	cmd := client.B().Ping().Build()
+	if err := client.Do(ctx, cmd).Error(); err != nil {
 		return fmt.Errorf("ping valkey: %w", err)
 	}
 	return nil
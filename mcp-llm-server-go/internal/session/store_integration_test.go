@@ -0,0 +1,98 @@
+//go:build integration
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/testutil"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunMain(m))
+}
+
+func newIntegrationStore(t *testing.T, historyMaxPairs int) *Store {
+	t.Helper()
+
+	store, err := NewStore(&config.Config{
+		SessionStore: testutil.SessionStoreConfig(t),
+		Session: config.SessionConfig{
+			SessionTTLMinutes: 5,
+			HistoryMaxPairs:   historyMaxPairs,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	t.Cleanup(store.Close)
+	return store
+}
+
+func TestStoreSessionCount_ScansAllPages(t *testing.T) {
+	store := newIntegrationStore(t, 0)
+	ctx := context.Background()
+	now := time.Now()
+
+	// SCAN COUNT(100)보다 많은 세션으로 커서 순회 검증
+	const sessions = 230
+	for i := range sessions {
+		meta := Meta{ID: fmt.Sprintf("s-%03d", i), CreatedAt: now, UpdatedAt: now}
+		if err := store.CreateSession(ctx, meta); err != nil {
+			t.Fatalf("create session failed: %v", err)
+		}
+		// history 키는 meta 패턴에 걸리지 않아야 한다
+		if err := store.AppendHistory(ctx, meta.ID, llm.HistoryEntry{Role: "user", Content: "q"}); err != nil {
+			t.Fatalf("append history failed: %v", err)
+		}
+	}
+
+	count, err := store.SessionCount(ctx)
+	if err != nil {
+		t.Fatalf("session count failed: %v", err)
+	}
+	if count != sessions {
+		t.Fatalf("SessionCount = %d, want %d", count, sessions)
+	}
+}
+
+func TestStoreAppendHistory_TrimAndTTL(t *testing.T) {
+	store := newIntegrationStore(t, 2)
+	ctx := context.Background()
+	now := time.Now()
+
+	if err := store.CreateSession(ctx, Meta{ID: "s1", CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatalf("create session failed: %v", err)
+	}
+	for i := range 5 {
+		entries := []llm.HistoryEntry{
+			{Role: "user", Content: fmt.Sprintf("q%d", i)},
+			{Role: "assistant", Content: fmt.Sprintf("a%d", i)},
+		}
+		if err := store.AppendHistory(ctx, "s1", entries...); err != nil {
+			t.Fatalf("append history failed: %v", err)
+		}
+	}
+
+	history, err := store.GetHistory(ctx, "s1")
+	if err != nil {
+		t.Fatalf("get history failed: %v", err)
+	}
+	if len(history) != 4 || history[0].Content != "q3" || history[3].Content != "a4" {
+		t.Fatalf("unexpected trimmed history: %+v", history)
+	}
+
+	ttl, err := store.client.Do(ctx, store.client.B().Ttl().Key(store.historyKey("s1")).Build()).AsInt64()
+	if err != nil {
+		t.Fatalf("ttl failed: %v", err)
+	}
+	if ttl <= 0 || ttl > int64(store.ttl().Seconds()) {
+		t.Fatalf("unexpected history ttl: %d", ttl)
+	}
+}
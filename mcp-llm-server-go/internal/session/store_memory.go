@@ -133,7 +133,10 @@ func (s *Store) appendHistoryMemory(sessionID string, entries ...llm.HistoryEntr
 	} else {
 		delete(s.historyExpireAt, sessionID)
 	}
+	length := len(existing)
 	s.mu.Unlock()
+
+	s.maybeCompactHistory(sessionID, length)
 	return nil
 }
 
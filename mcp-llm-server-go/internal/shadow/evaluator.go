@@ -0,0 +1,184 @@
+// Package shadow: 새 프롬프트를 실제 응답에 반영하지 않고 현재 프롬프트와 나란히 실행해 비교합니다.
+package shadow
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+const defaultInsertTimeout = 5 * time.Second
+
+// Judge: 후보 프롬프트로 판정을 수행해 결과 코드(ACCEPT/CLOSE/REJECT)를 반환합니다.
+type Judge func(ctx context.Context) (string, error)
+
+// Store: 섀도 평가 결과 저장 인터페이스입니다.
+type Store interface {
+	Insert(ctx context.Context, run *VerifyRun) error
+}
+
+// Observation: 현재 프롬프트의 판정 정보입니다.
+type Observation struct {
+	RequestID      string
+	Target         string
+	Guess          string
+	PrimaryResult  string
+	PrimaryLatency time.Duration
+}
+
+// Stats: 섀도 평가 실행 통계입니다.
+type Stats struct {
+	Sampled   int64 `json:"sampled"`
+	Skipped   int64 `json:"skipped"` // 동시 실행 한도 초과로 건너뛴 건수
+	Completed int64 `json:"completed"`
+	Failed    int64 `json:"failed"` // 후보 판정 또는 저장 실패
+}
+
+// Evaluator: 표본 추출된 판정 요청에 대해 후보 프롬프트를 비동기로 실행하고 결과를 기록합니다.
+type Evaluator struct {
+	cfg     config.ShadowConfig
+	store   Store
+	logger  *slog.Logger
+	sample  func() float64
+	slots   chan struct{}
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	closed  bool
+	sampled atomic.Int64
+	skipped atomic.Int64
+	done    atomic.Int64
+	failed  atomic.Int64
+}
+
+// NewEvaluator: 섀도 평가기를 생성합니다. 비활성 설정이면 nil을 반환합니다.
+func NewEvaluator(cfg config.ShadowConfig, store Store, logger *slog.Logger) *Evaluator {
+	if !cfg.Enabled || cfg.SampleRate <= 0 || store == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Evaluator{
+		cfg:    cfg,
+		store:  store,
+		logger: logger,
+		sample: rand.Float64,
+		slots:  make(chan struct{}, max(1, cfg.MaxInFlight)),
+	}
+}
+
+// Label: 후보 프롬프트 라벨을 반환합니다.
+func (e *Evaluator) Label() string {
+	if e == nil {
+		return ""
+	}
+	return e.cfg.CandidateLabel
+}
+
+// Observe: 표본에 뽑히면 후보 판정을 백그라운드에서 실행합니다. 호출자는 기다리지 않습니다.
+func (e *Evaluator) Observe(obs Observation, candidate Judge) {
+	if e == nil || candidate == nil {
+		return
+	}
+	if e.sample() >= e.cfg.SampleRate {
+		return
+	}
+	e.sampled.Add(1)
+
+	select {
+	case e.slots <- struct{}{}:
+	default:
+		e.skipped.Add(1)
+		e.logger.Debug("twentyq_verify_shadow_skipped", "request_id", obs.RequestID, "reason", "max_in_flight")
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		<-e.slots
+		return
+	}
+	e.wg.Go(func() {
+		defer func() { <-e.slots }()
+		e.run(obs, candidate)
+	})
+}
+
+// Stats: 누적 실행 통계를 반환합니다.
+func (e *Evaluator) Stats() Stats {
+	if e == nil {
+		return Stats{}
+	}
+	return Stats{
+		Sampled:   e.sampled.Load(),
+		Skipped:   e.skipped.Load(),
+		Completed: e.done.Load(),
+		Failed:    e.failed.Load(),
+	}
+}
+
+// Close: 새 평가를 받지 않고 진행 중인 평가가 끝날 때까지 기다립니다.
+func (e *Evaluator) Close() {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	e.closed = true
+	e.mu.Unlock()
+	e.wg.Wait()
+}
+
+func (e *Evaluator) run(obs Observation, candidate Judge) {
+	// 원 요청이 끝나도 평가는 계속되어야 하므로 요청 컨텍스트와 분리
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(e.cfg.TimeoutSeconds)*time.Second)
+	startedAt := time.Now()
+	candidateResult, err := candidate(ctx)
+	cancel()
+
+	run := &VerifyRun{
+		CreatedAt:          time.Now(),
+		CandidateLabel:     e.cfg.CandidateLabel,
+		RequestID:          obs.RequestID,
+		Target:             obs.Target,
+		Guess:              obs.Guess,
+		PrimaryResult:      obs.PrimaryResult,
+		PrimaryLatencyMs:   obs.PrimaryLatency.Milliseconds(),
+		CandidateLatencyMs: time.Since(startedAt).Milliseconds(),
+	}
+	if err != nil {
+		run.CandidateError = err.Error()
+	} else {
+		run.CandidateResult = candidateResult
+		run.Agreed = candidateResult == obs.PrimaryResult
+	}
+
+	insertCtx, insertCancel := context.WithTimeout(context.Background(), defaultInsertTimeout)
+	defer insertCancel()
+	if insertErr := e.store.Insert(insertCtx, run); insertErr != nil {
+		e.failed.Add(1)
+		e.logger.Warn("twentyq_verify_shadow_store_failed", "request_id", obs.RequestID, "err", insertErr)
+		return
+	}
+	if err != nil {
+		e.failed.Add(1)
+		e.logger.Warn("twentyq_verify_shadow_candidate_failed", "request_id", obs.RequestID, "err", err)
+		return
+	}
+
+	e.done.Add(1)
+	e.logger.Info(
+		"twentyq_verify_shadow",
+		"request_id", obs.RequestID,
+		"label", e.cfg.CandidateLabel,
+		"primary", obs.PrimaryResult,
+		"candidate", candidateResult,
+		"agreed", run.Agreed,
+		"candidate_latency_ms", run.CandidateLatencyMs,
+	)
+}
@@ -0,0 +1,24 @@
+package shadow
+
+import "time"
+
+// VerifyRun: 현재/후보 프롬프트 판정 한 쌍을 저장하는 DB 모델입니다.
+type VerifyRun struct {
+	ID                 int64     `gorm:"column:id;primaryKey"`
+	CreatedAt          time.Time `gorm:"column:created_at"`
+	CandidateLabel     string    `gorm:"column:candidate_label"`
+	RequestID          string    `gorm:"column:request_id"`
+	Target             string    `gorm:"column:target"`
+	Guess              string    `gorm:"column:guess"`
+	PrimaryResult      string    `gorm:"column:primary_result"`
+	CandidateResult    string    `gorm:"column:candidate_result"` // 실패 시 빈 문자열
+	Agreed             bool      `gorm:"column:agreed"`
+	PrimaryLatencyMs   int64     `gorm:"column:primary_latency_ms"`
+	CandidateLatencyMs int64     `gorm:"column:candidate_latency_ms"`
+	CandidateError     string    `gorm:"column:candidate_error"`
+}
+
+// TableName: GORM에서 사용할 테이블명을 반환합니다.
+func (VerifyRun) TableName() string {
+	return "twentyq_verify_shadow"
+}
@@ -0,0 +1,74 @@
+package shadow
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// PairCount: (현재 판정, 후보 판정) 조합별 건수입니다. 후보 실패 건은 제외됩니다.
+type PairCount struct {
+	Primary   string `json:"primary" gorm:"column:primary_result"`
+	Candidate string `json:"candidate" gorm:"column:candidate_result"`
+	Count     int64  `json:"count" gorm:"column:count"`
+}
+
+// Report: 후보 프롬프트의 현재 프롬프트 대비 일치도 보고서입니다.
+type Report struct {
+	CandidateLabel        string      `json:"candidate_label"`
+	Since                 time.Time   `json:"since"`
+	Total                 int64       `json:"total"`  // 판정 비교가 가능한 건수
+	Agreed                int64       `json:"agreed"` // 판정이 일치한 건수
+	AgreementRate         float64     `json:"agreement_rate"`
+	Kappa                 float64     `json:"kappa"` // Cohen's kappa (우연 일치 보정)
+	CandidateErrors       int64       `json:"candidate_errors"`
+	AvgPrimaryLatencyMs   float64     `json:"avg_primary_latency_ms"`
+	AvgCandidateLatencyMs float64     `json:"avg_candidate_latency_ms"`
+	Matrix                []PairCount `json:"matrix"`
+}
+
+// BuildReport: 조합별 건수로 일치율과 kappa를 계산합니다.
+func BuildReport(label string, since time.Time, pairs []PairCount) Report {
+	report := Report{
+		CandidateLabel: label,
+		Since:          since,
+		Matrix:         make([]PairCount, 0, len(pairs)),
+	}
+
+	primaryTotals := make(map[string]int64)
+	candidateTotals := make(map[string]int64)
+	for _, pair := range pairs {
+		if pair.Count <= 0 {
+			continue
+		}
+		report.Matrix = append(report.Matrix, pair)
+		report.Total += pair.Count
+		if pair.Primary == pair.Candidate {
+			report.Agreed += pair.Count
+		}
+		primaryTotals[pair.Primary] += pair.Count
+		candidateTotals[pair.Candidate] += pair.Count
+	}
+	slices.SortFunc(report.Matrix, func(a, b PairCount) int {
+		return cmp.Or(cmp.Compare(a.Primary, b.Primary), cmp.Compare(a.Candidate, b.Candidate))
+	})
+	if report.Total == 0 {
+		return report
+	}
+
+	total := float64(report.Total)
+	observed := float64(report.Agreed) / total
+	var expected float64
+	for result, count := range primaryTotals {
+		expected += float64(count) / total * float64(candidateTotals[result]) / total
+	}
+
+	report.AgreementRate = observed
+	if expected < 1 {
+		report.Kappa = (observed - expected) / (1 - expected)
+	} else {
+		// 양쪽 모두 한 가지 판정만 낸 경우: 완전 일치면 1로 취급
+		report.Kappa = 1
+	}
+	return report
+}
@@ -0,0 +1,132 @@
+package shadow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DBProvider: 공용 Postgres 연결을 제공합니다. (usage.Repository)
+type DBProvider interface {
+	DB(ctx context.Context) (*gorm.DB, error)
+}
+
+// Repository: 섀도 평가 결과 저장소입니다.
+type Repository struct {
+	provider DBProvider
+	mu       sync.Mutex
+	ready    bool
+}
+
+// NewRepository: 섀도 평가 저장소를 생성합니다.
+func NewRepository(provider DBProvider) *Repository {
+	return &Repository{provider: provider}
+}
+
+// Insert: 판정 비교 결과 한 건을 저장합니다.
+func (r *Repository) Insert(ctx context.Context, run *VerifyRun) error {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now()
+	}
+	if err := db.WithContext(ctx).Create(run).Error; err != nil {
+		return fmt.Errorf("insert verify shadow run: %w", err)
+	}
+	return nil
+}
+
+// Report: since 이후 label 후보 프롬프트의 일치도 보고서를 계산합니다.
+func (r *Repository) Report(ctx context.Context, label string, since time.Time) (Report, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var pairs []PairCount
+	if err := db.WithContext(ctx).Raw(`
+			SELECT primary_result, candidate_result, COUNT(*) AS count
+			FROM twentyq_verify_shadow
+			WHERE candidate_label = ? AND created_at >= ? AND candidate_error = ''
+			GROUP BY primary_result, candidate_result`, label, since).Scan(&pairs).Error; err != nil {
+		return Report{}, fmt.Errorf("query verify shadow pairs: %w", err)
+	}
+
+	type aggregate struct {
+		CandidateErrors       int64
+		AvgPrimaryLatencyMs   float64
+		AvgCandidateLatencyMs float64
+	}
+	var agg aggregate
+	if err := db.WithContext(ctx).Raw(`
+			SELECT
+				COUNT(*) FILTER (WHERE candidate_error <> '') AS candidate_errors,
+				COALESCE(AVG(primary_latency_ms), 0) AS avg_primary_latency_ms,
+				COALESCE(AVG(candidate_latency_ms) FILTER (WHERE candidate_error = ''), 0) AS avg_candidate_latency_ms
+			FROM twentyq_verify_shadow
+			WHERE candidate_label = ? AND created_at >= ?`, label, since).Scan(&agg).Error; err != nil {
+		return Report{}, fmt.Errorf("query verify shadow aggregate: %w", err)
+	}
+
+	report := BuildReport(label, since, pairs)
+	report.CandidateErrors = agg.CandidateErrors
+	report.AvgPrimaryLatencyMs = agg.AvgPrimaryLatencyMs
+	report.AvgCandidateLatencyMs = agg.AvgCandidateLatencyMs
+	return report, nil
+}
+
+func (r *Repository) getDB(ctx context.Context) (*gorm.DB, error) {
+	if r == nil || r.provider == nil {
+		return nil, errors.New("shadow repository not configured")
+	}
+	db, err := r.provider.DB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("shadow db: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.ready {
+		if err := ensureShadowSchema(ctx, db); err != nil {
+			return nil, fmt.Errorf("prepare shadow db: %w", err)
+		}
+		r.ready = true
+	}
+	return db, nil
+}
+
+func ensureShadowSchema(ctx context.Context, db *gorm.DB) error {
+	if err := db.WithContext(ctx).Exec(`
+			CREATE TABLE IF NOT EXISTS twentyq_verify_shadow (
+				id BIGSERIAL PRIMARY KEY,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				candidate_label TEXT NOT NULL,
+				request_id TEXT NOT NULL DEFAULT '',
+				target TEXT NOT NULL,
+				guess TEXT NOT NULL,
+				primary_result TEXT NOT NULL,
+				candidate_result TEXT NOT NULL DEFAULT '',
+				agreed BOOLEAN NOT NULL DEFAULT FALSE,
+				primary_latency_ms BIGINT NOT NULL DEFAULT 0,
+				candidate_latency_ms BIGINT NOT NULL DEFAULT 0,
+				candidate_error TEXT NOT NULL DEFAULT ''
+			)
+		`).Error; err != nil {
+		return fmt.Errorf("create twentyq_verify_shadow table: %w", err)
+	}
+
+	if err := db.WithContext(ctx).Exec(`
+			CREATE INDEX IF NOT EXISTS idx_twentyq_verify_shadow_label_created
+			ON twentyq_verify_shadow (candidate_label, created_at)
+		`).Error; err != nil {
+		return fmt.Errorf("create twentyq_verify_shadow label index: %w", err)
+	}
+
+	return nil
+}
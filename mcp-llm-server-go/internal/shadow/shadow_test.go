@@ -0,0 +1,152 @@
+package shadow
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+)
+
+type memoryStore struct {
+	mu   sync.Mutex
+	runs []VerifyRun
+}
+
+func (m *memoryStore) Insert(_ context.Context, run *VerifyRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.runs = append(m.runs, *run)
+	return nil
+}
+
+func testConfig() config.ShadowConfig {
+	return config.ShadowConfig{
+		Enabled:        true,
+		SampleRate:     0.5,
+		CandidateLabel: "candidate-v2",
+		MaxInFlight:    2,
+		TimeoutSeconds: 5,
+	}
+}
+
+func TestNewEvaluatorDisabled(t *testing.T) {
+	cfg := testConfig()
+	cfg.Enabled = false
+	if e := NewEvaluator(cfg, &memoryStore{}, nil); e != nil {
+		t.Fatalf("expected nil evaluator when disabled")
+	}
+
+	// nil 평가기는 호출해도 안전해야 함
+	var e *Evaluator
+	e.Observe(Observation{}, func(context.Context) (string, error) { return "", nil })
+	e.Close()
+	if e.Stats() != (Stats{}) {
+		t.Fatalf("expected zero stats for nil evaluator")
+	}
+}
+
+func TestEvaluatorRecordsSampledRuns(t *testing.T) {
+	store := &memoryStore{}
+	e := NewEvaluator(testConfig(), store, nil)
+
+	// 표본 비율(0.5) 미만만 실행
+	e.sample = func() float64 { return 0.9 }
+	e.Observe(Observation{RequestID: "skip"}, func(context.Context) (string, error) {
+		t.Error("unsampled request must not run candidate")
+		return "", nil
+	})
+
+	e.sample = func() float64 { return 0.1 }
+	e.Observe(Observation{
+		RequestID:      "r1",
+		Target:         "사과",
+		Guess:          "능금",
+		PrimaryResult:  "ACCEPT",
+		PrimaryLatency: 1500 * time.Millisecond,
+	}, func(context.Context) (string, error) { return "CLOSE", nil })
+	e.Observe(Observation{RequestID: "r2", PrimaryResult: "REJECT"}, func(context.Context) (string, error) {
+		return "", errors.New("upstream timeout")
+	})
+	e.Close()
+
+	if len(store.runs) != 2 {
+		t.Fatalf("expected 2 runs, got %d", len(store.runs))
+	}
+	byID := make(map[string]VerifyRun)
+	for _, run := range store.runs {
+		byID[run.RequestID] = run
+	}
+
+	first := byID["r1"]
+	if first.Agreed || first.CandidateResult != "CLOSE" || first.PrimaryLatencyMs != 1500 || first.CandidateLabel != "candidate-v2" {
+		t.Fatalf("unexpected run: %+v", first)
+	}
+	second := byID["r2"]
+	if second.CandidateError == "" || second.CandidateResult != "" || second.Agreed {
+		t.Fatalf("expected failed candidate run, got %+v", second)
+	}
+
+	stats := e.Stats()
+	if stats.Sampled != 2 || stats.Completed != 1 || stats.Failed != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestEvaluatorSkipsWhenSaturated(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxInFlight = 1
+	e := NewEvaluator(cfg, &memoryStore{}, nil)
+	e.sample = func() float64 { return 0 }
+
+	release := make(chan struct{})
+	e.Observe(Observation{RequestID: "slow"}, func(context.Context) (string, error) {
+		<-release
+		return "ACCEPT", nil
+	})
+	e.Observe(Observation{RequestID: "dropped"}, func(context.Context) (string, error) {
+		t.Error("saturated evaluator must not run candidate")
+		return "", nil
+	})
+	close(release)
+	e.Close()
+
+	if stats := e.Stats(); stats.Skipped != 1 || stats.Completed != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestBuildReport(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := BuildReport("candidate-v2", since, []PairCount{
+		{Primary: "REJECT", Candidate: "REJECT", Count: 40},
+		{Primary: "ACCEPT", Candidate: "ACCEPT", Count: 45},
+		{Primary: "ACCEPT", Candidate: "REJECT", Count: 5},
+		{Primary: "REJECT", Candidate: "ACCEPT", Count: 10},
+		{Primary: "CLOSE", Candidate: "CLOSE", Count: 0},
+	})
+
+	if report.Total != 100 || report.Agreed != 85 {
+		t.Fatalf("unexpected totals: %+v", report)
+	}
+	if math.Abs(report.AgreementRate-0.85) > 1e-9 {
+		t.Fatalf("agreement rate = %v, want 0.85", report.AgreementRate)
+	}
+	// p_e = 0.5*0.55 + 0.5*0.45 = 0.5 → kappa = (0.85-0.5)/0.5 = 0.7
+	if math.Abs(report.Kappa-0.7) > 1e-9 {
+		t.Fatalf("kappa = %v, want 0.7", report.Kappa)
+	}
+	if len(report.Matrix) != 4 || report.Matrix[0].Primary != "ACCEPT" || report.Matrix[0].Candidate != "ACCEPT" {
+		t.Fatalf("expected sorted matrix without empty cells, got %+v", report.Matrix)
+	}
+}
+
+func TestBuildReportEmpty(t *testing.T) {
+	report := BuildReport("candidate-v2", time.Time{}, nil)
+	if report.Total != 0 || report.AgreementRate != 0 || report.Kappa != 0 || report.Matrix == nil {
+		t.Fatalf("unexpected empty report: %+v", report)
+	}
+}
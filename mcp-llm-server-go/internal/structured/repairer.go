@@ -0,0 +1,142 @@
+package structured
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+)
+
+// maxEchoedOutput: 재요청 프롬프트에 되돌려 주는 이전 출력의 최대 길이 (룬 기준)
+const maxEchoedOutput = 500
+
+// Repairer: Structured 응답이 JSON으로 해석되지 않거나 스키마를 만족하지 않으면
+// 검증 오류를 프롬프트에 덧붙여 재요청하는 Provider 래퍼입니다.
+// 형식이 잘못된 응답 하나 때문에 게임 턴 전체가 실패하는 것을 막기 위한 것으로, Chat 계열 요청은 그대로 전달합니다.
+type Repairer struct {
+	next    llm.Provider
+	cfg     config.StructuredRepairConfig
+	metrics *metrics.Store
+	logger  *slog.Logger
+}
+
+// Repairer가 Provider 인터페이스를 구현하는지 컴파일 타임 확인
+var _ llm.Provider = (*Repairer)(nil)
+
+// NewRepairer: next 공급자를 감싸는 Repairer를 생성합니다.
+func NewRepairer(cfg config.StructuredRepairConfig, next llm.Provider, metricsStore *metrics.Store, logger *slog.Logger) *Repairer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if metricsStore == nil {
+		metricsStore = metrics.NewStore()
+	}
+	return &Repairer{
+		next:    next,
+		cfg:     cfg,
+		metrics: metricsStore,
+		logger:  logger,
+	}
+}
+
+// Name: 감싼 공급자의 식별자를 반환합니다.
+func (r *Repairer) Name() string {
+	return r.next.Name()
+}
+
+// Chat: 텍스트 채팅 요청을 그대로 전달합니다.
+func (r *Repairer) Chat(ctx context.Context, req llm.Request) (string, string, error) {
+	return r.next.Chat(ctx, req)
+}
+
+// ChatWithUsage: 사용량 포함 채팅 요청을 그대로 전달합니다.
+func (r *Repairer) ChatWithUsage(ctx context.Context, req llm.Request) (llm.ChatResult, string, error) {
+	return r.next.ChatWithUsage(ctx, req)
+}
+
+// Structured: 응답을 스키마로 검증하고, 실패하면 MaxAttempts까지 교정 재요청합니다.
+// 끝까지 유효한 응답을 얻지 못하면 마지막 검증 오류를 담은 *llm.StructuredOutputError를 반환합니다.
+// 네트워크/공급자 오류는 교정 대상이 아니므로 그대로 반환합니다.
+func (r *Repairer) Structured(ctx context.Context, req llm.Request, schema map[string]any) (map[string]any, string, error) {
+	payload, model, err := r.next.Structured(ctx, req, schema)
+	if !r.cfg.Enabled {
+		return payload, model, err
+	}
+
+	problem, raw, err := inspect(payload, err, schema)
+	if err != nil {
+		return nil, model, err
+	}
+	if problem == nil {
+		return payload, model, nil
+	}
+	r.metrics.RecordStructuredInvalid(req.Task)
+
+	for attempt := 1; attempt <= r.cfg.MaxAttempts; attempt++ {
+		r.logger.Info("llm_structured_invalid", "task", req.Task, "attempt", attempt, "err", problem)
+
+		payload, model, err = r.next.Structured(ctx, withRepairPrompt(req, problem, raw), schema)
+		problem, raw, err = inspect(payload, err, schema)
+		if err != nil {
+			r.metrics.RecordStructuredRepair(req.Task, false)
+			return nil, model, err
+		}
+		r.metrics.RecordStructuredRepair(req.Task, problem == nil)
+		if problem == nil {
+			r.logger.Info("llm_structured_repaired", "task", req.Task, "attempts", attempt)
+			return payload, model, nil
+		}
+	}
+
+	r.logger.Warn("llm_structured_repair_exhausted", "task", req.Task, "attempts", r.cfg.MaxAttempts, "err", problem)
+	return nil, model, &llm.StructuredOutputError{
+		Raw: raw,
+		Err: fmt.Errorf("structured output invalid after %d repair attempts: %w", r.cfg.MaxAttempts, problem),
+	}
+}
+
+// inspect: 공급자 결과를 검증 문제(problem)와 교정 불가 오류(err)로 나눕니다.
+// 해석 실패(*llm.StructuredOutputError)와 스키마 위반은 problem으로, 그 외 오류는 err로 반환합니다.
+func inspect(payload map[string]any, err error, schema map[string]any) (problem error, raw string, fatal error) {
+	if err != nil {
+		var outputErr *llm.StructuredOutputError
+		if errors.As(err, &outputErr) {
+			return outputErr.Err, outputErr.Raw, nil
+		}
+		return nil, "", err
+	}
+	if validationErr := Validate(schema, payload); validationErr != nil {
+		encoded, _ := json.Marshal(payload)
+		return validationErr, string(encoded), nil
+	}
+	return nil, "", nil
+}
+
+func withRepairPrompt(req llm.Request, problem error, raw string) llm.Request {
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(req.Prompt, "\n"))
+	b.WriteString("\n\n[형식 교정] 직전 응답이 요구한 JSON 스키마를 만족하지 않았습니다: ")
+	b.WriteString(problem.Error())
+	if raw = strings.TrimSpace(raw); raw != "" {
+		b.WriteString("\n직전 응답: ")
+		b.WriteString(truncateRunes(raw, maxEchoedOutput))
+	}
+	b.WriteString("\n스키마의 필수 필드와 허용 값을 지켜 JSON 객체 하나만 다시 출력하세요.")
+	req.Prompt = b.String()
+	return req
+}
+
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit]) + "…"
+}
@@ -0,0 +1,167 @@
+package structured
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/metrics"
+)
+
+var answerSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"answer":     map[string]any{"type": "string", "enum": []string{"예", "아니오"}},
+		"confidence": map[string]any{"type": "number", "minimum": 0.0, "maximum": 1.0},
+		"hints":      map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+	},
+	"required": []string{"answer", "confidence"},
+}
+
+// rawProvider: 미리 정한 원문을 순서대로 JSON 해석해 돌려주는 테스트용 공급자
+type rawProvider struct {
+	replies []string
+	err     error
+	prompts []string
+}
+
+func (p *rawProvider) Name() string { return "raw" }
+
+func (p *rawProvider) Chat(context.Context, llm.Request) (string, string, error) {
+	return "", "model", nil
+}
+
+func (p *rawProvider) ChatWithUsage(context.Context, llm.Request) (llm.ChatResult, string, error) {
+	return llm.ChatResult{}, "model", nil
+}
+
+func (p *rawProvider) Structured(_ context.Context, req llm.Request, _ map[string]any) (map[string]any, string, error) {
+	p.prompts = append(p.prompts, req.Prompt)
+	call := len(p.prompts) - 1
+	if p.err != nil && call > 0 {
+		return nil, "model", p.err
+	}
+	raw := p.replies[min(call, len(p.replies)-1)]
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		return nil, "model", &llm.StructuredOutputError{Raw: raw, Err: err}
+	}
+	return parsed, "model", nil
+}
+
+func newTestRepairer(provider llm.Provider, store *metrics.Store, maxAttempts int) *Repairer {
+	cfg := config.StructuredRepairConfig{Enabled: true, MaxAttempts: maxAttempts}
+	return NewRepairer(cfg, provider, store, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		wantErr string
+	}{
+		{"valid", `{"answer":"예","confidence":0.8,"hints":["a"],"extra":1}`, ""},
+		{"missing required", `{"answer":"예"}`, "$.confidence: required field is missing"},
+		{"enum violation", `{"answer":"maybe","confidence":0.5}`, "$.answer: maybe is not one of"},
+		{"type mismatch", `{"answer":"예","confidence":"high"}`, "$.confidence: expected number, got string"},
+		{"out of range", `{"answer":"예","confidence":1.5}`, "greater than maximum"},
+		{"array item", `{"answer":"예","confidence":0.1,"hints":["a",3]}`, "$.hints[1]: expected string"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var payload map[string]any
+			if err := json.Unmarshal([]byte(tc.payload), &payload); err != nil {
+				t.Fatal(err)
+			}
+			err := Validate(answerSchema, payload)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected valid, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRepairer_RepromptsWithValidationError(t *testing.T) {
+	provider := &rawProvider{replies: []string{
+		`{"answer":"maybe","confidence":0.5}`,
+		`{"answer":"예","confidence":0.5}`,
+	}}
+	store := metrics.NewStore()
+
+	payload, _, err := newTestRepairer(provider, store, 2).Structured(context.Background(), llm.Request{Prompt: "질문", Task: "answer"}, answerSchema)
+	if err != nil {
+		t.Fatalf("expected repaired payload, got %v", err)
+	}
+	if payload["answer"] != "예" || len(provider.prompts) != 2 {
+		t.Fatalf("unexpected result: %v (calls=%d)", payload, len(provider.prompts))
+	}
+	if !strings.Contains(provider.prompts[1], "$.answer: maybe is not one of") || !strings.Contains(provider.prompts[1], `"maybe"`) {
+		t.Fatalf("repair prompt must carry validation error and previous output: %q", provider.prompts[1])
+	}
+
+	snapshot := store.Snapshot()
+	if snapshot["structured_invalid.answer"] != 1 || snapshot["structured_repair_rate.answer"] != 1 {
+		t.Fatalf("unexpected metrics: %v", snapshot)
+	}
+}
+
+func TestRepairer_RepairsMalformedJSON(t *testing.T) {
+	provider := &rawProvider{replies: []string{`{"answer":"예",`, `{"answer":"아니오","confidence":0.9}`}}
+
+	payload, _, err := newTestRepairer(provider, nil, 1).Structured(context.Background(), llm.Request{Prompt: "질문"}, answerSchema)
+	if err != nil || payload["answer"] != "아니오" {
+		t.Fatalf("expected repaired payload, got %v, %v", payload, err)
+	}
+}
+
+func TestRepairer_SurfacesErrorAfterMaxAttempts(t *testing.T) {
+	provider := &rawProvider{replies: []string{`{"answer":"maybe","confidence":0.5}`}}
+	store := metrics.NewStore()
+
+	_, _, err := newTestRepairer(provider, store, 2).Structured(context.Background(), llm.Request{Prompt: "질문", Task: "verify"}, answerSchema)
+	var outputErr *llm.StructuredOutputError
+	if !errors.As(err, &outputErr) || !strings.Contains(err.Error(), "after 2 repair attempts") {
+		t.Fatalf("expected structured output error, got %v", err)
+	}
+	if len(provider.prompts) != 3 {
+		t.Fatalf("expected 1 call + 2 repairs, got %d", len(provider.prompts))
+	}
+	if snapshot := store.Snapshot(); snapshot["structured_repair_attempts.verify"] != 2 || snapshot["structured_repair_rate.verify"] != 0 {
+		t.Fatalf("unexpected metrics: %v", snapshot)
+	}
+}
+
+func TestRepairer_PassesThroughProviderErrors(t *testing.T) {
+	provider := &rawProvider{replies: []string{`{"answer":"maybe","confidence":0.5}`}, err: errors.New("quota exceeded")}
+
+	_, _, err := newTestRepairer(provider, nil, 3).Structured(context.Background(), llm.Request{Prompt: "질문"}, answerSchema)
+	if err == nil || err.Error() != "quota exceeded" {
+		t.Fatalf("provider error must be returned as is, got %v", err)
+	}
+	if len(provider.prompts) != 2 {
+		t.Fatalf("must stop after provider error, calls=%d", len(provider.prompts))
+	}
+}
+
+func TestRepairer_DisabledPassesThrough(t *testing.T) {
+	provider := &rawProvider{replies: []string{`{"answer":"maybe","confidence":0.5}`}}
+	repairer := NewRepairer(config.StructuredRepairConfig{Enabled: false, MaxAttempts: 2}, provider, nil, nil)
+
+	payload, _, err := repairer.Structured(context.Background(), llm.Request{Prompt: "질문"}, answerSchema)
+	if err != nil || payload["answer"] != "maybe" || len(provider.prompts) != 1 {
+		t.Fatalf("disabled repairer must not validate: %v, %v", payload, err)
+	}
+}
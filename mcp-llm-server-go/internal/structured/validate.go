@@ -0,0 +1,162 @@
+package structured
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Validate: payload가 JSON 스키마를 만족하는지 검사합니다.
+// 도메인 스키마에서 실제로 쓰는 키워드(type, properties, required, enum, items, minimum, maximum)만 확인하고
+// 나머지 키워드(description 등)는 무시합니다. 오류 메시지는 "$.field" 경로를 포함해 재요청 프롬프트에 그대로 쓸 수 있습니다.
+func Validate(schema map[string]any, payload map[string]any) error {
+	return validateValue("$", schema, payload)
+}
+
+func validateValue(path string, schema map[string]any, value any) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	if typ, ok := schema["type"].(string); ok && !matchesType(typ, value) {
+		return fmt.Errorf("%s: expected %s, got %s", path, typ, typeName(value))
+	}
+
+	if enum := toAnySlice(schema["enum"]); enum != nil && !slices.Contains(enum, value) {
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, name := range toStringSlice(schema["required"]) {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s.%s: required field is missing", path, name)
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for _, name := range sortedKeys(properties) {
+			field, ok := v[name]
+			if !ok {
+				continue
+			}
+			sub, _ := properties[name].(map[string]any)
+			if err := validateValue(path+"."+name, sub, field); err != nil {
+				return err
+			}
+		}
+	case []any:
+		items, _ := schema["items"].(map[string]any)
+		for i, item := range v {
+			if err := validateValue(fmt.Sprintf("%s[%d]", path, i), items, item); err != nil {
+				return err
+			}
+		}
+	case float64:
+		if minimum, ok := toFloat(schema["minimum"]); ok && v < minimum {
+			return fmt.Errorf("%s: %v is less than minimum %v", path, v, minimum)
+		}
+		if maximum, ok := toFloat(schema["maximum"]); ok && v > maximum {
+			return fmt.Errorf("%s: %v is greater than maximum %v", path, v, maximum)
+		}
+	}
+	return nil
+}
+
+func matchesType(typ string, value any) bool {
+	switch typ {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		f, ok := value.(float64)
+		return ok && f == math.Trunc(f)
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+func typeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// toAnySlice: 스키마의 enum은 []string으로 선언되는 경우가 많아 비교를 위해 []any로 맞춥니다.
+func toAnySlice(raw any) []any {
+	switch v := raw.(type) {
+	case []any:
+		return v
+	case []string:
+		out := make([]any, len(v))
+		for i, s := range v {
+			out[i] = s
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func toStringSlice(raw any) []string {
+	switch v := raw.(type) {
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func toFloat(raw any) (float64, bool) {
+	switch v := raw.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,3 @@
+// Package testutil: 실제 Valkey/Postgres 컨테이너 기반 통합 테스트 하네스입니다.
+// 하네스 본체는 integration 빌드 태그로만 컴파일됩니다. (make test-integration)
+package testutil
@@ -0,0 +1,25 @@
+package topicpack
+
+import "time"
+
+// Topic: 팩에 담긴 토픽 하나입니다. Details는 내장 토픽과 같은 형식으로 답변 프롬프트에 전달됩니다.
+type Topic struct {
+	Name    string         `json:"name"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Pack: 관리자가 등록한 TwentyQ 카테고리 팩 DB 모델입니다. (카테고리당 1개)
+type Pack struct {
+	ID             int64     `gorm:"column:id;primaryKey" json:"id"`
+	Category       string    `gorm:"column:category" json:"category"`
+	Topics         []Topic   `gorm:"column:topics;serializer:json" json:"topics"`
+	DifficultyTags []string  `gorm:"column:difficulty_tags;serializer:json" json:"difficulty_tags"`
+	Enabled        bool      `gorm:"column:enabled" json:"enabled"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// TableName: GORM에서 사용할 테이블명을 반환합니다.
+func (Pack) TableName() string {
+	return "twentyq_topic_packs"
+}
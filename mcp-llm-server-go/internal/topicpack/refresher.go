@@ -0,0 +1,82 @@
+package topicpack
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/twentyq"
+)
+
+// PackSource: 활성화된 팩 목록을 제공합니다. (Repository)
+type PackSource interface {
+	ListEnabled(ctx context.Context) ([]Pack, error)
+}
+
+// RefreshResult: 마지막 캐시 갱신 결과입니다.
+type RefreshResult struct {
+	twentyq.PackMergeStats
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
+
+// Refresher: DB의 활성 팩을 읽어 TopicLoader의 토픽 목록을 다시 만듭니다.
+// 팩 CRUD는 DB에만 반영되고, Refresh를 호출해야 토픽 선택에 적용됩니다.
+type Refresher struct {
+	source PackSource
+	loader *twentyq.TopicLoader
+	logger *slog.Logger
+
+	mu   sync.Mutex
+	last RefreshResult
+}
+
+// NewRefresher: 카테고리 팩 갱신기를 생성합니다.
+func NewRefresher(source PackSource, loader *twentyq.TopicLoader, logger *slog.Logger) *Refresher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Refresher{source: source, loader: loader, logger: logger}
+}
+
+// Refresh: 활성 팩을 내장 토픽과 합쳐 적용합니다.
+// 조회에 실패하면 기존 목록을 그대로 유지합니다.
+func (r *Refresher) Refresh(ctx context.Context) (RefreshResult, error) {
+	packs, err := r.source.ListEnabled(ctx)
+	if err != nil {
+		return RefreshResult{}, fmt.Errorf("load topic packs: %w", err)
+	}
+
+	domainPacks := make([]twentyq.TopicPack, 0, len(packs))
+	for _, pack := range packs {
+		topics := make([]twentyq.TopicEntry, 0, len(pack.Topics))
+		for _, topic := range pack.Topics {
+			topics = append(topics, twentyq.TopicEntry{Name: topic.Name, Details: topic.Details})
+		}
+		domainPacks = append(domainPacks, twentyq.TopicPack{Category: pack.Category, Topics: topics})
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := RefreshResult{
+		PackMergeStats: r.loader.ApplyPacks(domainPacks),
+		RefreshedAt:    time.Now(),
+	}
+	r.last = result
+
+	r.logger.Info("topic_packs_refreshed",
+		"packs", result.Packs,
+		"categories", result.Categories,
+		"topics", result.Topics,
+		"added_topics", result.AddedTopics,
+	)
+	return result, nil
+}
+
+// Last: 마지막으로 성공한 갱신 결과를 반환합니다. (한 번도 갱신하지 않았으면 zero value)
+func (r *Refresher) Last() RefreshResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
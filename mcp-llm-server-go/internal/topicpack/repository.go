@@ -0,0 +1,192 @@
+package topicpack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrPackNotFound: 해당 ID의 팩이 없습니다.
+	ErrPackNotFound = errors.New("topic pack not found")
+	// ErrDuplicateCategory: 같은 카테고리의 팩이 이미 있습니다.
+	ErrDuplicateCategory = errors.New("topic pack category already exists")
+)
+
+// DBProvider: 공용 Postgres 연결을 제공합니다. (usage.Repository)
+type DBProvider interface {
+	DB(ctx context.Context) (*gorm.DB, error)
+}
+
+// Repository: 카테고리 팩 저장소입니다.
+type Repository struct {
+	provider DBProvider
+	mu       sync.Mutex
+	ready    bool
+}
+
+// NewRepository: 카테고리 팩 저장소를 생성합니다.
+func NewRepository(provider DBProvider) *Repository {
+	return &Repository{provider: provider}
+}
+
+// List: 모든 팩을 카테고리 이름순으로 반환합니다.
+func (r *Repository) List(ctx context.Context) ([]Pack, error) {
+	return r.find(ctx, false)
+}
+
+// ListEnabled: 활성화된 팩만 카테고리 이름순으로 반환합니다.
+func (r *Repository) ListEnabled(ctx context.Context) ([]Pack, error) {
+	return r.find(ctx, true)
+}
+
+func (r *Repository) find(ctx context.Context, enabledOnly bool) ([]Pack, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := db.WithContext(ctx).Order("category")
+	if enabledOnly {
+		query = query.Where("enabled = ?", true)
+	}
+	var packs []Pack
+	if err := query.Find(&packs).Error; err != nil {
+		return nil, fmt.Errorf("list topic packs: %w", err)
+	}
+	return packs, nil
+}
+
+// Get: ID로 팩을 조회합니다.
+func (r *Repository) Get(ctx context.Context, id int64) (*Pack, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pack Pack
+	if err := db.WithContext(ctx).First(&pack, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPackNotFound
+		}
+		return nil, fmt.Errorf("get topic pack: %w", err)
+	}
+	return &pack, nil
+}
+
+// Create: 새 팩을 저장합니다. pack은 Normalize를 거친 값이어야 합니다.
+func (r *Repository) Create(ctx context.Context, pack *Pack) error {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	pack.ID = 0
+	pack.CreatedAt = now
+	pack.UpdatedAt = now
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureCategoryFree(tx, pack.Category, 0); err != nil {
+			return err
+		}
+		if err := tx.Create(pack).Error; err != nil {
+			return fmt.Errorf("create topic pack: %w", err)
+		}
+		return nil
+	})
+}
+
+// Update: 기존 팩을 통째로 교체합니다. 생성 시각은 유지합니다.
+func (r *Repository) Update(ctx context.Context, pack *Pack) error {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	pack.UpdatedAt = time.Now()
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current Pack
+		if err := tx.First(&current, pack.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPackNotFound
+			}
+			return fmt.Errorf("get topic pack: %w", err)
+		}
+		if err := ensureCategoryFree(tx, pack.Category, pack.ID); err != nil {
+			return err
+		}
+		pack.CreatedAt = current.CreatedAt
+		if err := tx.Select("*").Updates(pack).Error; err != nil {
+			return fmt.Errorf("update topic pack: %w", err)
+		}
+		return nil
+	})
+}
+
+// Delete: 팩을 삭제합니다.
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	result := db.WithContext(ctx).Delete(&Pack{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("delete topic pack: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrPackNotFound
+	}
+	return nil
+}
+
+// ensureCategoryFree: exceptID가 아닌 다른 팩이 category를 쓰고 있으면 ErrDuplicateCategory를 반환합니다.
+// 동시 요청은 category UNIQUE 제약이 최종적으로 막습니다.
+func ensureCategoryFree(tx *gorm.DB, category string, exceptID int64) error {
+	var count int64
+	if err := tx.Model(&Pack{}).Where("category = ? AND id <> ?", category, exceptID).Count(&count).Error; err != nil {
+		return fmt.Errorf("check topic pack category: %w", err)
+	}
+	if count > 0 {
+		return ErrDuplicateCategory
+	}
+	return nil
+}
+
+func (r *Repository) getDB(ctx context.Context) (*gorm.DB, error) {
+	if r == nil || r.provider == nil {
+		return nil, errors.New("topic pack repository not configured")
+	}
+	db, err := r.provider.DB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("topic pack db: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.ready {
+		if err := ensureTopicPackSchema(ctx, db); err != nil {
+			return nil, fmt.Errorf("prepare topic pack db: %w", err)
+		}
+		r.ready = true
+	}
+	return db, nil
+}
+
+func ensureTopicPackSchema(ctx context.Context, db *gorm.DB) error {
+	if err := db.WithContext(ctx).Exec(`
+			CREATE TABLE IF NOT EXISTS twentyq_topic_packs (
+				id BIGSERIAL PRIMARY KEY,
+				category TEXT NOT NULL UNIQUE,
+				topics JSONB NOT NULL DEFAULT '[]',
+				difficulty_tags JSONB NOT NULL DEFAULT '[]',
+				enabled BOOLEAN NOT NULL DEFAULT TRUE,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			)
+		`).Error; err != nil {
+		return fmt.Errorf("create twentyq_topic_packs table: %w", err)
+	}
+	return nil
+}
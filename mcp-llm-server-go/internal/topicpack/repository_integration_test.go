@@ -0,0 +1,74 @@
+//go:build integration
+
+package topicpack
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/testutil"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/usage"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunMain(m))
+}
+
+func newIntegrationRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	provider := usage.NewRepository(&config.Config{Database: testutil.DatabaseConfig(t)}, nil)
+	t.Cleanup(provider.Close)
+	return NewRepository(provider)
+}
+
+func TestRepository_CRUD(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+
+	pack := &Pack{Category: "kpop", Topics: []Topic{{Name: "아이돌"}}, DifficultyTags: []string{"easy"}, Enabled: true}
+	if err := repo.Create(ctx, pack); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := repo.Create(ctx, &Pack{Category: "kpop", Topics: []Topic{{Name: "x"}}}); !errors.Is(err, ErrDuplicateCategory) {
+		t.Fatalf("expected ErrDuplicateCategory, got %v", err)
+	}
+
+	got, err := repo.Get(ctx, pack.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Category != "kpop" || len(got.Topics) != 1 || got.DifficultyTags[0] != "easy" {
+		t.Fatalf("unexpected pack: %+v", got)
+	}
+
+	got.Enabled = false
+	got.Topics = append(got.Topics, Topic{Name: "응원봉"})
+	if err := repo.Update(ctx, got); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	enabled, err := repo.ListEnabled(ctx)
+	if err != nil {
+		t.Fatalf("ListEnabled: %v", err)
+	}
+	if len(enabled) != 0 {
+		t.Fatalf("expected disabled pack to be excluded, got %+v", enabled)
+	}
+	all, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 1 || len(all[0].Topics) != 2 {
+		t.Fatalf("unexpected packs: %+v", all)
+	}
+
+	if err := repo.Delete(ctx, pack.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.Get(ctx, pack.ID); !errors.Is(err, ErrPackNotFound) {
+		t.Fatalf("expected ErrPackNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package topicpack
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/twentyq"
+)
+
+func TestNormalize(t *testing.T) {
+	pack := &Pack{
+		Category:       "  KPop ",
+		Topics:         []Topic{{Name: " 아이돌 "}, {Name: "아이돌"}, {Name: ""}, {Name: "응원봉", Details: map[string]any{"color": "various"}}},
+		DifficultyTags: []string{"Easy", "easy", " "},
+	}
+	if err := Normalize(pack); err != nil {
+		t.Fatalf("Normalize: %v", err)
+	}
+	if pack.Category != "kpop" {
+		t.Fatalf("expected lowercase category, got %q", pack.Category)
+	}
+	if len(pack.Topics) != 2 || pack.Topics[0].Name != "아이돌" || pack.Topics[1].Details["color"] != "various" {
+		t.Fatalf("unexpected topics: %+v", pack.Topics)
+	}
+	if len(pack.DifficultyTags) != 1 || pack.DifficultyTags[0] != "easy" {
+		t.Fatalf("unexpected tags: %+v", pack.DifficultyTags)
+	}
+}
+
+func TestNormalizeRejectsInvalid(t *testing.T) {
+	cases := map[string]*Pack{
+		"bad category":  {Category: "k-pop!", Topics: []Topic{{Name: "a"}}},
+		"no topics":     {Category: "kpop", Topics: []Topic{{Name: " "}}},
+		"long name":     {Category: "kpop", Topics: []Topic{{Name: strings.Repeat("가", maxTopicNameRunes+1)}}},
+		"too many tags": {Category: "kpop", Topics: []Topic{{Name: "a"}}, DifficultyTags: strings.Split("a,b,c,d,e,f,g,h,i,j,k", ",")},
+	}
+	for name, pack := range cases {
+		if err := Normalize(pack); !errors.Is(err, ErrInvalidPack) {
+			t.Errorf("%s: expected ErrInvalidPack, got %v", name, err)
+		}
+	}
+}
+
+type staticSource struct {
+	packs []Pack
+	err   error
+}
+
+func (s staticSource) ListEnabled(context.Context) ([]Pack, error) {
+	return s.packs, s.err
+}
+
+func TestRefresherAppliesPacks(t *testing.T) {
+	loader, err := twentyq.NewTopicLoader()
+	if err != nil {
+		t.Fatalf("NewTopicLoader: %v", err)
+	}
+
+	source := staticSource{packs: []Pack{{Category: "kpop", Topics: []Topic{{Name: "아이돌"}}, Enabled: true}}}
+	refresher := NewRefresher(source, loader, nil)
+	result, err := refresher.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if result.AddedTopics != 1 || result.RefreshedAt.IsZero() {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if refresher.Last() != result {
+		t.Fatalf("expected last result to be recorded")
+	}
+
+	topic, err := loader.SelectTopic("kpop", nil, nil)
+	if err != nil || topic.Name != "아이돌" {
+		t.Fatalf("expected pack topic, got %+v (%v)", topic, err)
+	}
+
+	// 조회 실패 시 기존 목록 유지
+	failing := NewRefresher(staticSource{err: errors.New("db down")}, loader, nil)
+	if _, err := failing.Refresh(context.Background()); err == nil {
+		t.Fatalf("expected refresh error")
+	}
+	if topic, err := loader.SelectTopic("kpop", nil, nil); err != nil || topic.Name != "아이돌" {
+		t.Fatalf("expected previous packs to remain, got %+v (%v)", topic, err)
+	}
+}
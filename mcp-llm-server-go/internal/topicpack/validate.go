@@ -0,0 +1,70 @@
+package topicpack
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// MaxTopicsPerPack: 팩 하나에 담을 수 있는 최대 토픽 수
+	MaxTopicsPerPack = 2000
+	// MaxDifficultyTags: 팩 하나에 붙일 수 있는 최대 난이도 태그 수
+	MaxDifficultyTags = 10
+	maxTopicNameRunes = 50
+)
+
+// ErrInvalidPack: 팩 입력값이 올바르지 않습니다.
+var ErrInvalidPack = errors.New("invalid topic pack")
+
+// categoryPattern: 카테고리 이름은 내장 카테고리(idiom_proverb 등)와 같은 형식으로 제한합니다.
+var categoryPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,39}$`)
+
+// Normalize: 저장 전에 팩을 정리하고 검증합니다.
+// 카테고리/태그는 소문자로 바꾸고, 토픽 이름의 앞뒤 공백과 중복(대소문자 무시)을 제거합니다.
+func Normalize(pack *Pack) error {
+	pack.Category = strings.ToLower(strings.TrimSpace(pack.Category))
+	if !categoryPattern.MatchString(pack.Category) {
+		return fmt.Errorf("%w: category must match %s", ErrInvalidPack, categoryPattern.String())
+	}
+
+	topics := make([]Topic, 0, len(pack.Topics))
+	seen := make(map[string]struct{}, len(pack.Topics))
+	for _, topic := range pack.Topics {
+		topic.Name = strings.TrimSpace(topic.Name)
+		if topic.Name == "" {
+			continue
+		}
+		if len([]rune(topic.Name)) > maxTopicNameRunes {
+			return fmt.Errorf("%w: topic name %q exceeds %d characters", ErrInvalidPack, topic.Name, maxTopicNameRunes)
+		}
+		key := strings.ToLower(topic.Name)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		topics = append(topics, topic)
+	}
+	if len(topics) == 0 {
+		return fmt.Errorf("%w: at least one topic is required", ErrInvalidPack)
+	}
+	if len(topics) > MaxTopicsPerPack {
+		return fmt.Errorf("%w: at most %d topics per pack", ErrInvalidPack, MaxTopicsPerPack)
+	}
+	pack.Topics = topics
+
+	tags := make([]string, 0, len(pack.DifficultyTags))
+	for _, tag := range pack.DifficultyTags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > MaxDifficultyTags {
+		return fmt.Errorf("%w: at most %d difficulty tags", ErrInvalidPack, MaxDifficultyTags)
+	}
+	pack.DifficultyTags = tags
+	return nil
+}
@@ -0,0 +1,101 @@
+package usage
+
+import (
+	"context"
+	"sync"
+)
+
+// CallUsage: RPC 한 건 동안 발생한 LLM 호출 사용량 누적값입니다.
+// 게임 봇이 게임 단위 비용을 집계할 수 있도록 gRPC trailer로 돌려줍니다.
+type CallUsage struct {
+	mu              sync.Mutex
+	inputTokens     int64
+	outputTokens    int64
+	reasoningTokens int64
+	calls           int64
+	model           string
+}
+
+// CallUsageSnapshot: CallUsage의 읽기 전용 사본입니다.
+type CallUsageSnapshot struct {
+	InputTokens     int64
+	OutputTokens    int64
+	ReasoningTokens int64
+	Calls           int64
+	// Model: 마지막으로 호출한 모델 (RPC 하나는 보통 한 모델만 사용)
+	Model string
+}
+
+type callUsageKey struct{}
+
+// WithCallUsage: 사용량 누적기를 컨텍스트에 붙여 반환합니다.
+func WithCallUsage(ctx context.Context) (context.Context, *CallUsage) {
+	acc := &CallUsage{}
+	return context.WithValue(ctx, callUsageKey{}, acc), acc
+}
+
+// AttributeCall: 컨텍스트에 누적기가 있으면 LLM 호출 1회의 사용량을 더합니다.
+func AttributeCall(ctx context.Context, model string, inputTokens int64, outputTokens int64, reasoningTokens int64) {
+	if ctx == nil {
+		return
+	}
+	acc, ok := ctx.Value(callUsageKey{}).(*CallUsage)
+	if !ok || acc == nil {
+		return
+	}
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	acc.inputTokens += inputTokens
+	acc.outputTokens += outputTokens
+	acc.reasoningTokens += reasoningTokens
+	acc.calls++
+	if model != "" {
+		acc.model = model
+	}
+}
+
+// Snapshot: 현재까지의 누적값을 반환합니다.
+func (c *CallUsage) Snapshot() CallUsageSnapshot {
+	if c == nil {
+		return CallUsageSnapshot{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CallUsageSnapshot{
+		InputTokens:     c.inputTokens,
+		OutputTokens:    c.outputTokens,
+		ReasoningTokens: c.reasoningTokens,
+		Calls:           c.calls,
+		Model:           c.model,
+	}
+}
+
+// Labels: 사용량을 게임 세션/작업(RPC)별로 나눠 집계하기 위한 라벨입니다. 빈 값은 해당 집계를 건너뜁니다.
+type Labels struct {
+	SessionID string
+	Task      string
+}
+
+// IsZero: 라벨이 하나도 없으면 true (일별 합계만 기록)
+func (l Labels) IsZero() bool {
+	return l.SessionID == "" && l.Task == ""
+}
+
+type labelsKey struct{}
+
+// WithLabels: 이후 이 컨텍스트로 기록되는 사용량에 라벨을 붙입니다.
+func WithLabels(ctx context.Context, labels Labels) context.Context {
+	if labels.IsZero() {
+		return ctx
+	}
+	return context.WithValue(ctx, labelsKey{}, labels)
+}
+
+// LabelsFromContext: 컨텍스트의 사용량 라벨을 반환합니다. 없으면 빈 라벨.
+func LabelsFromContext(ctx context.Context) Labels {
+	if ctx == nil {
+		return Labels{}
+	}
+	labels, _ := ctx.Value(labelsKey{}).(Labels)
+	return labels
+}
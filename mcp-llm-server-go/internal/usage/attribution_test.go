@@ -0,0 +1,43 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestAttributeCall(t *testing.T) {
+	// 누적기가 없는 컨텍스트는 무시
+	AttributeCall(context.Background(), "gemini-2.5-flash", 10, 5, 0)
+
+	ctx, acc := WithCallUsage(context.Background())
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			AttributeCall(ctx, "gemini-2.5-flash", 100, 20, 5)
+		})
+	}
+	wg.Wait()
+
+	got := acc.Snapshot()
+	want := CallUsageSnapshot{InputTokens: 400, OutputTokens: 80, ReasoningTokens: 20, Calls: 4, Model: "gemini-2.5-flash"}
+	if got != want {
+		t.Fatalf("Snapshot() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLabelsFromContext(t *testing.T) {
+	if got := LabelsFromContext(context.Background()); !got.IsZero() {
+		t.Fatalf("expected zero labels, got %+v", got)
+	}
+	// 빈 라벨은 컨텍스트를 바꾸지 않음
+	base := context.Background()
+	if WithLabels(base, Labels{}) != base {
+		t.Fatal("WithLabels with zero labels should return the same context")
+	}
+
+	want := Labels{SessionID: "twentyq:room-1", Task: "TwentyQAnswerQuestion"}
+	if got := LabelsFromContext(WithLabels(base, want)); got != want {
+		t.Fatalf("LabelsFromContext() = %+v, want %+v", got, want)
+	}
+}
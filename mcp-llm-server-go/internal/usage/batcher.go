@@ -9,7 +9,7 @@ import (
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 )
 
-// usageDelta 일별 토큰 사용량 델타
+// usageDelta 일별(라벨별) 토큰 사용량 델타
 type usageDelta struct {
 	inputTokens     int64
 	outputTokens    int64
@@ -17,6 +17,12 @@ type usageDelta struct {
 	requestCount    int64
 }
 
+// usageKey: 배치 집계 단위 (날짜 + 세션/작업 라벨)
+type usageKey struct {
+	date   time.Time
+	labels Labels
+}
+
 const defaultFlushTimeout = 5 * time.Second
 
 // batcher: 토큰 사용량을 배치로 DB에 플러시합니다.
@@ -29,7 +35,7 @@ type batcher struct {
 	maxBackoff               time.Duration
 	errorLogMaxInterval      time.Duration
 	mu                       sync.Mutex
-	pending                  map[time.Time]*usageDelta
+	pending                  map[usageKey]*usageDelta
 	pendingRequestsTotal     int
 	wakeup                   chan struct{}
 	stopCh                   chan struct{}
@@ -72,7 +78,7 @@ func newBatcher(cfg *config.Config, repo *Repository, logger *slog.Logger) *batc
 		maxPendingRequests:  maxPending,
 		maxBackoff:          maxBackoff,
 		errorLogMaxInterval: time.Duration(cfg.Database.UsageBatchErrorLogMaxIntervalSeconds) * time.Second,
-		pending:             make(map[time.Time]*usageDelta),
+		pending:             make(map[usageKey]*usageDelta),
 		wakeup:              make(chan struct{}, 1),
 		stopCh:              make(chan struct{}),
 		doneCh:              make(chan struct{}),
@@ -88,17 +94,17 @@ func (b *batcher) stop() {
 	<-b.doneCh
 }
 
-func (b *batcher) add(inputTokens int64, outputTokens int64, reasoningTokens int64, requestCount int64) {
+func (b *batcher) add(labels Labels, inputTokens int64, outputTokens int64, reasoningTokens int64, requestCount int64) {
 	if inputTokens <= 0 && outputTokens <= 0 {
 		return
 	}
 
-	targetDate := todayDate()
+	key := usageKey{date: todayDate(), labels: labels}
 	b.mu.Lock()
-	delta := b.pending[targetDate]
+	delta := b.pending[key]
 	if delta == nil {
 		delta = &usageDelta{}
-		b.pending[targetDate] = delta
+		b.pending[key] = delta
 	}
 	delta.inputTokens += inputTokens
 	delta.outputTokens += outputTokens
@@ -169,34 +175,35 @@ func (b *batcher) shouldSkipFlush(isShutdown bool) bool {
 	return time.Now().Before(b.nextFlushAllowedAt)
 }
 
-func (b *batcher) takeSnapshot() map[time.Time]usageDelta {
-	snapshot := make(map[time.Time]usageDelta)
+func (b *batcher) takeSnapshot() map[usageKey]usageDelta {
+	snapshot := make(map[usageKey]usageDelta)
 	b.mu.Lock()
-	for date, delta := range b.pending {
-		snapshot[date] = *delta
+	for key, delta := range b.pending {
+		snapshot[key] = *delta
 	}
-	b.pending = make(map[time.Time]*usageDelta)
+	b.pending = make(map[usageKey]*usageDelta)
 	b.pendingRequestsTotal = 0
 	b.mu.Unlock()
 	return snapshot
 }
 
-func (b *batcher) applySnapshot(snapshot map[time.Time]usageDelta, isShutdown bool) (bool, error) {
+func (b *batcher) applySnapshot(snapshot map[usageKey]usageDelta, isShutdown bool) (bool, error) {
 	hadFailure := false
 	var firstErr error
-	for date, delta := range snapshot {
+	for key, delta := range snapshot {
 		ctx := context.Background()
 		cancel := func() {}
 		if b.flushTimeout > 0 {
 			ctx, cancel = context.WithTimeout(ctx, b.flushTimeout)
 		}
-		err := b.repo.RecordUsage(
+		err := b.repo.RecordLabeledUsage(
 			ctx,
+			key.labels,
 			delta.inputTokens,
 			delta.outputTokens,
 			delta.reasoningTokens,
 			delta.requestCount,
-			date,
+			key.date,
 		)
 		cancel()
 		if err != nil {
@@ -209,7 +216,7 @@ func (b *batcher) applySnapshot(snapshot map[time.Time]usageDelta, isShutdown bo
 				b.flushDroppedTotal++
 				continue
 			}
-			b.requeue(date, delta)
+			b.requeue(key, delta)
 			b.flushRequeuedTotal++
 			continue
 		}
@@ -218,12 +225,12 @@ func (b *batcher) applySnapshot(snapshot map[time.Time]usageDelta, isShutdown bo
 	return hadFailure, firstErr
 }
 
-func (b *batcher) requeue(date time.Time, delta usageDelta) {
+func (b *batcher) requeue(key usageKey, delta usageDelta) {
 	b.mu.Lock()
-	existing := b.pending[date]
+	existing := b.pending[key]
 	if existing == nil {
 		existing = &usageDelta{}
-		b.pending[date] = existing
+		b.pending[key] = existing
 	}
 	existing.inputTokens += delta.inputTokens
 	existing.outputTokens += delta.outputTokens
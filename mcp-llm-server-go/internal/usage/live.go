@@ -0,0 +1,168 @@
+package usage
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultLiveInterval: 실시간 사용량 틱 집계 주기 기본값입니다.
+const DefaultLiveInterval = 5 * time.Second
+
+const liveSubscriberBuffer = 8
+
+// LiveTick: 집계 구간 동안 누적된 토큰 사용량 증분입니다.
+type LiveTick struct {
+	WindowStart     time.Time `json:"window_start"`
+	WindowEnd       time.Time `json:"window_end"`
+	InputTokens     int64     `json:"input_tokens"`
+	OutputTokens    int64     `json:"output_tokens"`
+	ReasoningTokens int64     `json:"reasoning_tokens"`
+	TotalTokens     int64     `json:"total_tokens"`
+	RequestCount    int64     `json:"request_count"`
+}
+
+// LiveFeed: 기록되는 사용량을 주기별로 합산해 구독자에게 푸시합니다.
+// 느린 구독자는 틱을 건너뛰며 기록 경로를 막지 않습니다.
+type LiveFeed struct {
+	interval    time.Duration
+	mu          sync.Mutex
+	pending     usageDelta
+	windowStart time.Time
+	subscribers map[chan LiveTick]struct{}
+	stopCh      chan struct{}
+	doneCh      chan struct{}
+	stopOnce    sync.Once
+}
+
+// NewLiveFeed: 주어진 주기로 집계하는 LiveFeed를 생성합니다. 0 이하면 기본값을 사용합니다.
+func NewLiveFeed(interval time.Duration) *LiveFeed {
+	if interval <= 0 {
+		interval = DefaultLiveInterval
+	}
+	return &LiveFeed{
+		interval:    interval,
+		windowStart: time.Now(),
+		subscribers: make(map[chan LiveTick]struct{}),
+		stopCh:      make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// Interval: 집계 주기를 반환합니다.
+func (f *LiveFeed) Interval() time.Duration {
+	if f == nil {
+		return DefaultLiveInterval
+	}
+	return f.interval
+}
+
+// Start: 주기 플러시 루프를 시작합니다.
+func (f *LiveFeed) Start() {
+	if f == nil {
+		return
+	}
+	go f.run()
+}
+
+// Stop: 플러시 루프를 멈추고 모든 구독 채널을 닫습니다.
+func (f *LiveFeed) Stop() {
+	if f == nil {
+		return
+	}
+	f.stopOnce.Do(func() {
+		close(f.stopCh)
+		<-f.doneCh
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for ch := range f.subscribers {
+			close(ch)
+			delete(f.subscribers, ch)
+		}
+	})
+}
+
+// Subscribe: 틱 수신 채널과 구독 해제 함수를 반환합니다. Stop 이후에는 닫힌 채널을 돌려줍니다.
+func (f *LiveFeed) Subscribe() (<-chan LiveTick, func()) {
+	ch := make(chan LiveTick, liveSubscriberBuffer)
+	if f == nil {
+		close(ch)
+		return ch, func() {}
+	}
+
+	f.mu.Lock()
+	select {
+	case <-f.stopCh:
+		f.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	default:
+	}
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			if _, ok := f.subscribers[ch]; ok {
+				delete(f.subscribers, ch)
+				close(ch)
+			}
+		})
+	}
+}
+
+func (f *LiveFeed) add(inputTokens int64, outputTokens int64, reasoningTokens int64, requestCount int64) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	f.pending.inputTokens += inputTokens
+	f.pending.outputTokens += outputTokens
+	f.pending.reasoningTokens += reasoningTokens
+	f.pending.requestCount += requestCount
+	f.mu.Unlock()
+}
+
+func (f *LiveFeed) run() {
+	defer close(f.doneCh)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.stopCh:
+			return
+		case now := <-ticker.C:
+			f.flush(now)
+		}
+	}
+}
+
+// flush: 현재 구간을 마감하고 구독자에게 틱을 전달합니다. 빈 구간도 하트비트로 전송합니다.
+func (f *LiveFeed) flush(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tick := LiveTick{
+		WindowStart:     f.windowStart,
+		WindowEnd:       now,
+		InputTokens:     f.pending.inputTokens,
+		OutputTokens:    f.pending.outputTokens,
+		ReasoningTokens: f.pending.reasoningTokens,
+		TotalTokens:     f.pending.inputTokens + f.pending.outputTokens,
+		RequestCount:    f.pending.requestCount,
+	}
+	f.pending = usageDelta{}
+	f.windowStart = now
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- tick:
+		default:
+		}
+	}
+}
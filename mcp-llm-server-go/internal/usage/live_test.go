@@ -0,0 +1,79 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLiveFeedFlushAggregatesWindow(t *testing.T) {
+	feed := NewLiveFeed(time.Hour)
+	ticks, unsubscribe := feed.Subscribe()
+	defer unsubscribe()
+
+	recorder := &Recorder{}
+	recorder.SetLiveFeed(feed)
+	recorder.Record(context.Background(), 10, 5, 2)
+	recorder.Record(context.Background(), 3, 0, 0)
+	recorder.Record(context.Background(), 0, 0, 0) // 무시됨
+
+	start := feed.windowStart
+	end := start.Add(5 * time.Second)
+	feed.flush(end)
+
+	tick := <-ticks
+	if tick.InputTokens != 13 || tick.OutputTokens != 5 || tick.ReasoningTokens != 2 {
+		t.Fatalf("unexpected tokens: %+v", tick)
+	}
+	if tick.TotalTokens != 18 || tick.RequestCount != 2 {
+		t.Fatalf("unexpected totals: %+v", tick)
+	}
+	if !tick.WindowStart.Equal(start) || !tick.WindowEnd.Equal(end) {
+		t.Fatalf("unexpected window: %+v", tick)
+	}
+
+	// 다음 구간은 증분만 담은 빈 하트비트
+	feed.flush(end.Add(5 * time.Second))
+	tick = <-ticks
+	if tick.TotalTokens != 0 || tick.RequestCount != 0 || !tick.WindowStart.Equal(end) {
+		t.Fatalf("expected empty incremental tick, got %+v", tick)
+	}
+}
+
+func TestLiveFeedSlowSubscriberDoesNotBlock(t *testing.T) {
+	feed := NewLiveFeed(time.Hour)
+	ticks, unsubscribe := feed.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < liveSubscriberBuffer*2; i++ {
+		feed.add(1, 1, 0, 1)
+		feed.flush(time.Now())
+	}
+	if len(ticks) != liveSubscriberBuffer {
+		t.Fatalf("expected buffer to cap at %d, got %d", liveSubscriberBuffer, len(ticks))
+	}
+}
+
+func TestLiveFeedStopClosesSubscribers(t *testing.T) {
+	feed := NewLiveFeed(10 * time.Millisecond)
+	feed.Start()
+	ticks, unsubscribe := feed.Subscribe()
+
+	select {
+	case <-ticks:
+	case <-time.After(time.Second):
+		t.Fatal("expected periodic tick")
+	}
+
+	feed.Stop()
+	feed.Stop()
+	unsubscribe()
+
+	for range ticks {
+	}
+
+	late, _ := feed.Subscribe()
+	if _, ok := <-late; ok {
+		t.Fatal("expected closed channel after stop")
+	}
+}
@@ -31,3 +31,47 @@ type DailyUsage struct {
 func (d DailyUsage) TotalTokens() int64 {
 	return d.InputTokens + d.OutputTokens
 }
+
+// TaskUsage: 작업(RPC)별 사용량 뷰 모델입니다.
+type TaskUsage struct {
+	Task            string
+	InputTokens     int64
+	OutputTokens    int64
+	ReasoningTokens int64
+	RequestCount    int64
+}
+
+// TotalTokens: 입력+출력 토큰 합계를 반환합니다.
+func (t TaskUsage) TotalTokens() int64 {
+	return t.InputTokens + t.OutputTokens
+}
+
+// unknownTask: 세션 라벨만 있고 작업 라벨이 없는 사용량의 작업명
+const unknownTask = "unknown"
+
+// sessionUsageRow: token_usage_session 조회 결과 행
+type sessionUsageRow struct {
+	TaskUsage
+	FirstUsedAt time.Time
+	LastUsedAt  time.Time
+}
+
+// SessionUsage: 세션 하나의 작업별 사용량과 합계입니다.
+type SessionUsage struct {
+	SessionID   string
+	Tasks       []TaskUsage
+	FirstUsedAt time.Time
+	LastUsedAt  time.Time
+}
+
+// Total: 세션의 전체 작업 합계를 반환합니다.
+func (s SessionUsage) Total() TaskUsage {
+	var total TaskUsage
+	for _, task := range s.Tasks {
+		total.InputTokens += task.InputTokens
+		total.OutputTokens += task.OutputTokens
+		total.ReasoningTokens += task.ReasoningTokens
+		total.RequestCount += task.RequestCount
+	}
+	return total
+}
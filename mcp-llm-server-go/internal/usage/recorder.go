@@ -8,11 +8,18 @@ import (
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
 )
 
+// TokenObserver: 기록되는 토큰 사용량을 요청 컨텍스트와 함께 전달받습니다. (예: 봇별 할당량 집계)
+type TokenObserver interface {
+	ObserveTokens(ctx context.Context, tokens int64)
+}
+
 // Recorder: 요청별 토큰 사용량을 저장하거나 배치로 적재합니다.
 type Recorder struct {
-	repo    *Repository
-	batcher *batcher
-	logger  *slog.Logger
+	repo     *Repository
+	batcher  *batcher
+	logger   *slog.Logger
+	observer TokenObserver
+	live     *LiveFeed
 }
 
 // NewRecorder: 설정에 따라 배치 사용 여부를 결정해 Recorder를 생성합니다.
@@ -40,21 +47,46 @@ func NewRecorder(cfg *config.Config, repo *Repository, logger *slog.Logger) *Rec
 	return recorder
 }
 
+// SetTokenObserver: 토큰 관찰자를 등록합니다. DB 저장 여부와 관계없이 호출됩니다.
+func (r *Recorder) SetTokenObserver(observer TokenObserver) {
+	if r == nil {
+		return
+	}
+	r.observer = observer
+}
+
+// SetLiveFeed: 실시간 사용량 피드를 등록합니다. DB 저장 여부와 관계없이 누적됩니다.
+func (r *Recorder) SetLiveFeed(feed *LiveFeed) {
+	if r == nil {
+		return
+	}
+	r.live = feed
+}
+
 // Record: 1회 요청의 토큰 사용량을 기록합니다.
+// 컨텍스트에 사용량 라벨(WithLabels)이 있으면 세션별/작업별 사용량도 함께 누적합니다.
 func (r *Recorder) Record(ctx context.Context, inputTokens int64, outputTokens int64, reasoningTokens int64) {
-	if r == nil || r.repo == nil {
+	if r == nil {
 		return
 	}
 	if inputTokens <= 0 && outputTokens <= 0 {
 		return
 	}
+	if r.observer != nil {
+		r.observer.ObserveTokens(ctx, inputTokens+outputTokens)
+	}
+	r.live.add(inputTokens, outputTokens, reasoningTokens, 1)
+	if r.repo == nil {
+		return
+	}
 
+	labels := LabelsFromContext(ctx)
 	if r.batcher != nil {
-		r.batcher.add(inputTokens, outputTokens, reasoningTokens, 1)
+		r.batcher.add(labels, inputTokens, outputTokens, reasoningTokens, 1)
 		return
 	}
 
-	if err := r.repo.RecordUsage(ctx, inputTokens, outputTokens, reasoningTokens, 1, time.Time{}); err != nil {
+	if err := r.repo.RecordLabeledUsage(ctx, labels, inputTokens, outputTokens, reasoningTokens, 1, time.Time{}); err != nil {
 		if r.logger != nil {
 			r.logger.Warn("usage_db_save_failed", "err", err)
 		}
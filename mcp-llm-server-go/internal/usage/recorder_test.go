@@ -99,3 +99,26 @@ func TestTodayDate(t *testing.T) {
 		t.Fatalf("unexpected date: %v", got)
 	}
 }
+
+func TestBatcherAddGroupsByLabels(t *testing.T) {
+	b := &batcher{pending: make(map[usageKey]*usageDelta), maxPendingRequests: 100}
+	hint := Labels{SessionID: "twentyq:room-1", Task: "TwentyQGenerateHints"}
+	answer := Labels{SessionID: "twentyq:room-1", Task: "TwentyQAnswerQuestion"}
+
+	b.add(hint, 100, 10, 0, 1)
+	b.add(hint, 50, 5, 2, 1)
+	b.add(answer, 30, 3, 0, 1)
+	b.add(Labels{}, 0, 0, 0, 1) // 토큰이 없으면 무시
+
+	snapshot := b.takeSnapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("expected 2 pending keys, got %d", len(snapshot))
+	}
+	today := todayDate()
+	if got := snapshot[usageKey{date: today, labels: hint}]; got != (usageDelta{inputTokens: 150, outputTokens: 15, reasoningTokens: 2, requestCount: 2}) {
+		t.Fatalf("unexpected hint delta: %+v", got)
+	}
+	if got := snapshot[usageKey{date: today, labels: answer}]; got.requestCount != 1 || got.inputTokens != 30 {
+		t.Fatalf("unexpected answer delta: %+v", got)
+	}
+}
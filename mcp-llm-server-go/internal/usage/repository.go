@@ -54,6 +54,86 @@ func (r *Repository) RecordUsage(
 		return err
 	}
 
+	return upsertDailyUsage(db.WithContext(ctx), inputTokens, outputTokens, reasoningTokens, requestCount, usageDate)
+}
+
+// RecordLabeledUsage: 일별 합계와 함께 세션별/작업별 사용량을 한 트랜잭션으로 누적 저장합니다.
+// 라벨이 비어 있으면 RecordUsage와 같습니다.
+func (r *Repository) RecordLabeledUsage(
+	ctx context.Context,
+	labels Labels,
+	inputTokens int64,
+	outputTokens int64,
+	reasoningTokens int64,
+	requestCount int64,
+	usageDate time.Time,
+) error {
+	if labels.IsZero() {
+		return r.RecordUsage(ctx, inputTokens, outputTokens, reasoningTokens, requestCount, usageDate)
+	}
+	if requestCount <= 0 && inputTokens <= 0 && outputTokens <= 0 {
+		return nil
+	}
+
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return err
+	}
+
+	targetDate := usageDate
+	if targetDate.IsZero() {
+		targetDate = todayDate()
+	}
+	task := labels.Task
+	if task == "" {
+		task = unknownTask
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := upsertDailyUsage(tx, inputTokens, outputTokens, reasoningTokens, requestCount, targetDate); err != nil {
+			return err
+		}
+		if labels.Task != "" {
+			if err := tx.Exec(`
+				INSERT INTO token_usage_task (usage_date, task, input_tokens, output_tokens, reasoning_tokens, request_count)
+				VALUES (?, ?, ?, ?, ?, ?)
+				ON CONFLICT (usage_date, task) DO UPDATE SET
+					input_tokens = token_usage_task.input_tokens + EXCLUDED.input_tokens,
+					output_tokens = token_usage_task.output_tokens + EXCLUDED.output_tokens,
+					reasoning_tokens = token_usage_task.reasoning_tokens + EXCLUDED.reasoning_tokens,
+					request_count = token_usage_task.request_count + EXCLUDED.request_count`,
+				targetDate, task, inputTokens, outputTokens, reasoningTokens, requestCount,
+			).Error; err != nil {
+				return fmt.Errorf("record task usage: %w", err)
+			}
+		}
+		if labels.SessionID != "" {
+			if err := tx.Exec(`
+				INSERT INTO token_usage_session (session_id, task, input_tokens, output_tokens, reasoning_tokens, request_count)
+				VALUES (?, ?, ?, ?, ?, ?)
+				ON CONFLICT (session_id, task) DO UPDATE SET
+					input_tokens = token_usage_session.input_tokens + EXCLUDED.input_tokens,
+					output_tokens = token_usage_session.output_tokens + EXCLUDED.output_tokens,
+					reasoning_tokens = token_usage_session.reasoning_tokens + EXCLUDED.reasoning_tokens,
+					request_count = token_usage_session.request_count + EXCLUDED.request_count,
+					last_used_at = NOW()`,
+				labels.SessionID, task, inputTokens, outputTokens, reasoningTokens, requestCount,
+			).Error; err != nil {
+				return fmt.Errorf("record session usage: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func upsertDailyUsage(
+	db *gorm.DB,
+	inputTokens int64,
+	outputTokens int64,
+	reasoningTokens int64,
+	requestCount int64,
+	usageDate time.Time,
+) error {
 	targetDate := usageDate
 	if targetDate.IsZero() {
 		targetDate = todayDate()
@@ -68,7 +148,7 @@ func (r *Repository) RecordUsage(
 		Version:         0,
 	}
 
-	return db.WithContext(ctx).Clauses(clause.OnConflict{
+	return db.Clauses(clause.OnConflict{
 		Columns: []clause.Column{{Name: "usage_date"}},
 		DoUpdates: clause.Assignments(map[string]any{
 			"input_tokens":     gorm.Expr("token_usage.input_tokens + EXCLUDED.input_tokens"),
@@ -176,6 +256,70 @@ func (r *Repository) GetTotalUsage(ctx context.Context, days int) (DailyUsage, e
 	}, nil
 }
 
+// GetSessionUsage: 세션의 작업별 사용량을 조회합니다. 기록이 없으면 nil.
+func (r *Repository) GetSessionUsage(ctx context.Context, sessionID string) (*SessionUsage, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sessionUsageRow
+	if err := db.WithContext(ctx).Raw(`
+			SELECT task, input_tokens, output_tokens, reasoning_tokens, request_count, first_used_at, last_used_at
+			FROM token_usage_session
+			WHERE session_id = ?
+			ORDER BY input_tokens + output_tokens DESC, task`, sessionID).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	result := &SessionUsage{SessionID: sessionID, Tasks: make([]TaskUsage, 0, len(rows))}
+	for _, row := range rows {
+		result.Tasks = append(result.Tasks, row.TaskUsage)
+		if result.FirstUsedAt.IsZero() || row.FirstUsedAt.Before(result.FirstUsedAt) {
+			result.FirstUsedAt = row.FirstUsedAt
+		}
+		if row.LastUsedAt.After(result.LastUsedAt) {
+			result.LastUsedAt = row.LastUsedAt
+		}
+	}
+	return result, nil
+}
+
+// GetUsageByTask: 최근 N일 사용량을 작업별로 합산해 토큰이 많은 순으로 반환합니다.
+func (r *Repository) GetUsageByTask(ctx context.Context, days int) ([]TaskUsage, error) {
+	db, err := r.getDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if days <= 0 {
+		days = 7
+	}
+
+	var rows []TaskUsage
+	if err := db.WithContext(ctx).Raw(`
+			SELECT
+				task,
+				COALESCE(SUM(input_tokens), 0) as input_tokens,
+				COALESCE(SUM(output_tokens), 0) as output_tokens,
+				COALESCE(SUM(reasoning_tokens), 0) as reasoning_tokens,
+				COALESCE(SUM(request_count), 0) as request_count
+			FROM token_usage_task
+			WHERE usage_date >= CURRENT_DATE - (?::int)
+			GROUP BY task
+			ORDER BY SUM(input_tokens + output_tokens) DESC, task`, days).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// DB: usage DB와 같은 연결 풀을 반환합니다. 다른 저장소(섀도 평가 등)가 공유합니다.
+func (r *Repository) DB(ctx context.Context) (*gorm.DB, error) {
+	return r.getDB(ctx)
+}
+
 // Close: DB 연결을 닫습니다.
 func (r *Repository) Close() {
 	r.mu.Lock()
@@ -281,6 +425,43 @@ func ensureUsageSchema(ctx context.Context, db *gorm.DB) error {
 		return fmt.Errorf("create token_usage usage_date unique index: %w", err)
 	}
 
+	if err := db.WithContext(ctx).Exec(`
+			CREATE TABLE IF NOT EXISTS token_usage_task (
+				usage_date DATE NOT NULL,
+				task TEXT NOT NULL,
+				input_tokens BIGINT NOT NULL DEFAULT 0,
+				output_tokens BIGINT NOT NULL DEFAULT 0,
+				reasoning_tokens BIGINT NOT NULL DEFAULT 0,
+				request_count BIGINT NOT NULL DEFAULT 0,
+				PRIMARY KEY (usage_date, task)
+			)
+		`).Error; err != nil {
+		return fmt.Errorf("create token_usage_task table: %w", err)
+	}
+
+	if err := db.WithContext(ctx).Exec(`
+			CREATE TABLE IF NOT EXISTS token_usage_session (
+				session_id TEXT NOT NULL,
+				task TEXT NOT NULL,
+				input_tokens BIGINT NOT NULL DEFAULT 0,
+				output_tokens BIGINT NOT NULL DEFAULT 0,
+				reasoning_tokens BIGINT NOT NULL DEFAULT 0,
+				request_count BIGINT NOT NULL DEFAULT 0,
+				first_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+				PRIMARY KEY (session_id, task)
+			)
+		`).Error; err != nil {
+		return fmt.Errorf("create token_usage_session table: %w", err)
+	}
+
+	if err := db.WithContext(ctx).Exec(`
+			CREATE INDEX IF NOT EXISTS idx_token_usage_session_last_used_at
+			ON token_usage_session (last_used_at)
+		`).Error; err != nil {
+		return fmt.Errorf("create token_usage_session last_used_at index: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,151 @@
+//go:build integration
+
+package usage
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/config"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/testutil"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunMain(m))
+}
+
+func newIntegrationRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	repo := NewRepository(&config.Config{Database: testutil.DatabaseConfig(t)}, nil)
+	// DROP DATABASE 이전에 연결이 닫히도록 DatabaseConfig 이후 등록
+	t.Cleanup(repo.Close)
+	return repo
+}
+
+func TestRecordUsage_ConcurrentUpsertAccumulates(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+	today := todayDate()
+
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repo.RecordUsage(ctx, 10, 5, 2, 1, today)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("record usage failed: %v", err)
+		}
+	}
+
+	daily, err := repo.GetDailyUsage(ctx, today)
+	if err != nil {
+		t.Fatalf("get daily usage failed: %v", err)
+	}
+	if daily == nil {
+		t.Fatal("expected daily usage row")
+	}
+	if daily.InputTokens != 10*workers || daily.OutputTokens != 5*workers ||
+		daily.ReasoningTokens != 2*workers || daily.RequestCount != workers {
+		t.Fatalf("lost updates: %+v", daily)
+	}
+}
+
+func TestGetTotalUsage_RespectsDayWindow(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+	today := todayDate()
+
+	if err := repo.RecordUsage(ctx, 100, 10, 0, 1, today); err != nil {
+		t.Fatalf("record today failed: %v", err)
+	}
+	if err := repo.RecordUsage(ctx, 200, 20, 0, 2, today.AddDate(0, 0, -3)); err != nil {
+		t.Fatalf("record 3 days ago failed: %v", err)
+	}
+	if err := repo.RecordUsage(ctx, 400, 40, 0, 4, today.AddDate(0, 0, -40)); err != nil {
+		t.Fatalf("record 40 days ago failed: %v", err)
+	}
+
+	total, err := repo.GetTotalUsage(ctx, 7)
+	if err != nil {
+		t.Fatalf("get total usage failed: %v", err)
+	}
+	if total.InputTokens != 300 || total.RequestCount != 3 {
+		t.Fatalf("unexpected 7-day total: %+v", total)
+	}
+
+	recent, err := repo.GetRecentUsage(ctx, 2)
+	if err != nil {
+		t.Fatalf("get recent usage failed: %v", err)
+	}
+	if len(recent) != 2 || !recent[0].UsageDate.After(recent[1].UsageDate) {
+		t.Fatalf("expected 2 rows ordered desc, got %+v", recent)
+	}
+}
+
+func TestRecordLabeledUsage_SessionAndTaskBreakdown(t *testing.T) {
+	repo := newIntegrationRepository(t)
+	ctx := context.Background()
+	today := todayDate()
+
+	hint := Labels{SessionID: "twentyq:room-1", Task: "TwentyQGenerateHints"}
+	answer := Labels{SessionID: "twentyq:room-1", Task: "TwentyQAnswerQuestion"}
+	for _, tc := range []struct {
+		labels Labels
+		input  int64
+	}{
+		{hint, 100},
+		{answer, 30},
+		{answer, 30},
+		{Labels{Task: "GuardIsMalicious"}, 5},
+	} {
+		if err := repo.RecordLabeledUsage(ctx, tc.labels, tc.input, 1, 0, 1, today); err != nil {
+			t.Fatalf("record labeled usage failed: %v", err)
+		}
+	}
+
+	daily, err := repo.GetDailyUsage(ctx, today)
+	if err != nil || daily == nil {
+		t.Fatalf("get daily usage failed: %v", err)
+	}
+	if daily.InputTokens != 165 || daily.RequestCount != 4 {
+		t.Fatalf("daily aggregate must include labeled usage: %+v", daily)
+	}
+
+	session, err := repo.GetSessionUsage(ctx, "twentyq:room-1")
+	if err != nil {
+		t.Fatalf("get session usage failed: %v", err)
+	}
+	if session == nil || len(session.Tasks) != 2 {
+		t.Fatalf("expected 2 tasks for session, got %+v", session)
+	}
+	if total := session.Total(); total.InputTokens != 160 || total.RequestCount != 3 {
+		t.Fatalf("unexpected session total: %+v", total)
+	}
+	if session.FirstUsedAt.After(session.LastUsedAt) {
+		t.Fatalf("first_used_at after last_used_at: %+v", session)
+	}
+
+	missing, err := repo.GetSessionUsage(ctx, "twentyq:none")
+	if err != nil || missing != nil {
+		t.Fatalf("expected nil for unknown session, got %+v (%v)", missing, err)
+	}
+
+	tasks, err := repo.GetUsageByTask(ctx, 7)
+	if err != nil {
+		t.Fatalf("get usage by task failed: %v", err)
+	}
+	if len(tasks) != 3 || tasks[0].Task != "TwentyQGenerateHints" || tasks[2].Task != "GuardIsMalicious" {
+		t.Fatalf("expected tasks ordered by total desc, got %+v", tasks)
+	}
+}
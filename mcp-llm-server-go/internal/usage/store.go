@@ -18,6 +18,17 @@ type Store interface {
 		usageDate time.Time,
 	) error
 
+	// RecordLabeledUsage 일별 합계 + 세션/작업별 토큰 사용량 기록
+	RecordLabeledUsage(
+		ctx context.Context,
+		labels Labels,
+		inputTokens int64,
+		outputTokens int64,
+		reasoningTokens int64,
+		requestCount int64,
+		usageDate time.Time,
+	) error
+
 	// GetDailyUsage 일별 사용량 조회
 	GetDailyUsage(ctx context.Context, usageDate time.Time) (*DailyUsage, error)
 
@@ -27,6 +38,12 @@ type Store interface {
 	// GetTotalUsage 최근 N일 합계 조회
 	GetTotalUsage(ctx context.Context, days int) (DailyUsage, error)
 
+	// GetSessionUsage 세션의 작업별 사용량 조회
+	GetSessionUsage(ctx context.Context, sessionID string) (*SessionUsage, error)
+
+	// GetUsageByTask 최근 N일 작업별 사용량 조회
+	GetUsageByTask(ctx context.Context, days int) ([]TaskUsage, error)
+
 	// Close 리소스 정리
 	Close()
 }
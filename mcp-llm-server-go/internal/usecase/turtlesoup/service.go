@@ -93,7 +93,7 @@ func (s *Service) AnswerQuestion(ctx context.Context, requestID string, req Answ
 		return AnswerResult{}, httperror.NewInvalidInput("question required")
 	}
 
-	if err := s.guard.EnsureSafe(question); err != nil {
+	if err := s.guard.EnsureSafe(ctx, question); err != nil {
 		s.logError("turtlesoup_question_guard_failed", err)
 		return AnswerResult{}, fmt.Errorf("guard question: %w", err)
 	}
@@ -185,7 +185,7 @@ func (s *Service) ValidateSolution(ctx context.Context, req ValidateRequest) (Va
 		return ValidateResult{}, httperror.NewInvalidInput("player_answer required")
 	}
 
-	if err := s.guard.EnsureSafe(playerAnswer); err != nil {
+	if err := s.guard.EnsureSafe(ctx, playerAnswer); err != nil {
 		s.logError("turtlesoup_answer_guard_failed", err)
 		return ValidateResult{}, fmt.Errorf("guard player answer: %w", err)
 	}
@@ -297,6 +297,54 @@ func (s *Service) Reveal(ctx context.Context, req RevealRequest) (string, error)
 	return strings.TrimSpace(narrative), nil
 }
 
+type EpilogueRequest struct {
+	Scenario string
+	Solution string
+}
+
+// GenerateEpilogue: 풀린 퍼즐의 후일담과 보너스 미니 질문(예상 정답 포함)을 생성합니다.
+func (s *Service) GenerateEpilogue(ctx context.Context, req EpilogueRequest) (turtlesoupdomain.EpilogueOutput, error) {
+	if s == nil || s.prompts == nil || s.client == nil {
+		return turtlesoupdomain.EpilogueOutput{}, httperror.NewInternalError("service not configured")
+	}
+
+	scenario := strings.TrimSpace(req.Scenario)
+	solution := strings.TrimSpace(req.Solution)
+	if scenario == "" || solution == "" {
+		return turtlesoupdomain.EpilogueOutput{}, httperror.NewInvalidInput("scenario, solution required")
+	}
+
+	puzzleToon := toon.EncodePuzzle(scenario, solution, "", nil)
+	system, err := s.prompts.EpilogueSystem()
+	if err != nil {
+		s.logError("turtlesoup_epilogue_system_prompt_failed", err)
+		return turtlesoupdomain.EpilogueOutput{}, httperror.NewInternalError("load epilogue system prompt failed")
+	}
+	userContent, err := s.prompts.EpilogueUser(puzzleToon)
+	if err != nil {
+		s.logError("turtlesoup_epilogue_user_prompt_failed", err)
+		return turtlesoupdomain.EpilogueOutput{}, httperror.NewInternalError("format epilogue user prompt failed")
+	}
+
+	payload, _, err := s.client.Structured(ctx, gemini.Request{
+		Prompt:       userContent,
+		SystemPrompt: system,
+		Task:         "hints",
+	}, turtlesoupdomain.EpilogueSchema())
+	if err != nil {
+		return turtlesoupdomain.EpilogueOutput{}, fmt.Errorf("epilogue structured: %w", err)
+	}
+
+	epilogue, eErr := shared.ParseStringField(payload, "epilogue")
+	question, qErr := shared.ParseStringField(payload, "question")
+	answer, aErr := shared.ParseStringField(payload, "answer")
+	out := turtlesoupdomain.EpilogueOutput{Epilogue: epilogue, Question: question, Answer: answer}.Clamp()
+	if eErr != nil || qErr != nil || aErr != nil || out.Epilogue == "" || out.Question == "" || out.Answer == "" {
+		return turtlesoupdomain.EpilogueOutput{}, httperror.NewInternalError("epilogue response invalid")
+	}
+	return out, nil
+}
+
 type RewriteRequest struct {
 	Title      string
 	Scenario   string
@@ -426,7 +474,7 @@ func (s *Service) GeneratePuzzle(ctx context.Context, req GeneratePuzzleRequest)
 
 	theme := strings.TrimSpace(req.Theme)
 	if theme != "" {
-		if err := s.guard.EnsureSafe(theme); err != nil {
+		if err := s.guard.EnsureSafe(ctx, theme); err != nil {
 			s.logError("turtlesoup_theme_guard_failed", err)
 			return GeneratePuzzleResult{}, fmt.Errorf("guard theme: %w", err)
 		}
@@ -652,7 +700,15 @@ func (s *Service) appendTurtleHistory(ctx context.Context, sessionID string, que
 
 func countQAPairs(history []llm.HistoryEntry) int {
 	pairs := 0
-	for i := 0; i+1 < len(history); i++ {
+	for i := 0; i < len(history); i++ {
+		// 요약으로 압축된 앞부분의 질문도 질문 수에 포함
+		if history[i].SummaryOf > 0 {
+			pairs += history[i].SummaryOf / 2
+			continue
+		}
+		if i+1 >= len(history) {
+			break
+		}
 		q := strings.TrimSpace(history[i].Content)
 		a := strings.TrimSpace(history[i+1].Content)
 		if strings.HasPrefix(q, "Q:") && strings.HasPrefix(a, "A:") {
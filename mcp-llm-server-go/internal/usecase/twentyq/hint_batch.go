@@ -0,0 +1,98 @@
+package twentyq
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+const (
+	// maxBatchHintItems: 한 번의 일괄 힌트 요청에 담을 수 있는 최대 항목 수입니다.
+	maxBatchHintItems = 50
+	// batchHintConcurrency: 일괄 힌트 요청에서 동시에 진행하는 LLM 호출 수입니다.
+	batchHintConcurrency = 4
+)
+
+// BatchHintResult: 일괄 힌트 생성의 항목별 결과입니다. 실패한 항목은 Err가 채워집니다.
+type BatchHintResult struct {
+	Target   string
+	Category string
+	Hints    []string
+	Err      error
+}
+
+// BatchGenerateHints: 여러 정답의 힌트를 batch 우선순위로 생성합니다.
+// 항목별 실패는 결과의 Err로 돌려주며, 요청 자체가 잘못된 경우에만 오류를 반환합니다.
+func (s *Service) BatchGenerateHints(ctx context.Context, requestID string, items []HintsRequest) ([]BatchHintResult, error) {
+	if len(items) == 0 {
+		return nil, httperror.NewInvalidInput("items required")
+	}
+	if len(items) > maxBatchHintItems {
+		return nil, httperror.NewInvalidInput(fmt.Sprintf("too many items (max %d)", maxBatchHintItems))
+	}
+
+	// 미리 생성은 사용자가 기다리지 않으므로 게임 진행 호출에 자리를 양보함
+	ctx = llm.WithPriority(ctx, llm.PriorityBatch)
+	results := runHintBatch(ctx, items, batchHintConcurrency, func(ctx context.Context, req HintsRequest) ([]string, error) {
+		return s.GenerateHints(ctx, requestID, req)
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	s.logInfo(
+		"twentyq_batch_hints_generated",
+		"request_id", requestID,
+		"items", len(items),
+		"failed", failed,
+	)
+	return results, nil
+}
+
+// runHintBatch: 최대 concurrency개씩 힌트를 생성하고 요청 순서대로 결과를 모읍니다.
+// 컨텍스트가 끝나면 아직 시작하지 않은 항목은 호출하지 않고 컨텍스트 오류로 채웁니다.
+func runHintBatch(
+	ctx context.Context,
+	items []HintsRequest,
+	concurrency int,
+	generate func(context.Context, HintsRequest) ([]string, error),
+) []BatchHintResult {
+	results := make([]BatchHintResult, len(items))
+	sem := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		results[i] = BatchHintResult{
+			Target:   strings.TrimSpace(item.Target),
+			Category: strings.TrimSpace(item.Category),
+		}
+
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].Hints, results[i].Err = generate(ctx, item)
+		}()
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,81 @@
+package twentyq
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/httperror"
+)
+
+func TestRunHintBatch_PartialFailureKeepsOrder(t *testing.T) {
+	items := []HintsRequest{
+		{Target: " 사과 ", Category: "food"},
+		{Target: "", Category: "food"},
+		{Target: "코끼리", Category: "organism"},
+		{Target: "바나나", Category: "food"},
+	}
+
+	var inFlight, peak atomic.Int32
+	results := runHintBatch(context.Background(), items, 2, func(_ context.Context, req HintsRequest) ([]string, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		if req.Target == "" {
+			return nil, httperror.NewInvalidInput("target required")
+		}
+		return []string{req.Category + " 힌트"}, nil
+	})
+
+	if len(results) != len(items) {
+		t.Fatalf("expected %d results, got %d", len(items), len(results))
+	}
+	if results[0].Target != "사과" || len(results[0].Hints) != 1 || results[0].Err != nil {
+		t.Fatalf("unexpected first result: %+v", results[0])
+	}
+	if apiErr := httperror.FromError(results[1].Err); apiErr == nil || apiErr.Code != httperror.ErrorCodeInvalidInput {
+		t.Fatalf("expected invalid input for empty target, got %v", results[1].Err)
+	}
+	if results[2].Target != "코끼리" || results[2].Hints[0] != "organism 힌트" {
+		t.Fatalf("results out of order: %+v", results[2])
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("expected at most 2 concurrent calls, got %d", peak.Load())
+	}
+}
+
+func TestRunHintBatch_CanceledContextSkipsCalls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	results := runHintBatch(ctx, []HintsRequest{{Target: "a"}, {Target: "b"}, {Target: "c"}}, 1, func(ctx context.Context, _ HintsRequest) ([]string, error) {
+		calls.Add(1)
+		return nil, ctx.Err()
+	})
+
+	for i, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Fatalf("result %d: expected context canceled, got %v", i, result.Err)
+		}
+	}
+	if calls.Load() != 0 {
+		t.Fatalf("expected canceled batch to skip calls, got %d", calls.Load())
+	}
+}
+
+func TestBatchGenerateHints_ValidatesItemCount(t *testing.T) {
+	svc := &Service{}
+	if _, err := svc.BatchGenerateHints(context.Background(), "req", nil); err == nil {
+		t.Fatal("expected error for empty batch")
+	}
+	if _, err := svc.BatchGenerateHints(context.Background(), "req", make([]HintsRequest, maxBatchHintItems+1)); err == nil {
+		t.Fatal("expected error for oversized batch")
+	}
+}
@@ -0,0 +1,58 @@
+package twentyq
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/prompt"
+)
+
+const (
+	// maxFeedbackExamples: 프롬프트에 넣는 '도움이 안 된 힌트' 예시 최대 개수
+	maxFeedbackExamples = 5
+	// maxFeedbackExampleRunes: 예시 한 개의 최대 글자 수 (넘으면 잘라냄)
+	maxFeedbackExampleRunes = 120
+)
+
+// HintFeedback: 같은 카테고리의 지난 힌트에 대한 플레이어 평가 집계입니다.
+type HintFeedback struct {
+	Useful          int
+	Useless         int
+	UselessExamples []string // 도움이 안 된다는 평가를 많이 받은 힌트 원문
+}
+
+// hintFeedbackSection: 평가 집계를 힌트 프롬프트에 덧붙일 안내 문단으로 만듭니다. 평가가 없으면 빈 문자열을 반환합니다.
+func hintFeedbackSection(feedback *HintFeedback) string {
+	if feedback == nil {
+		return ""
+	}
+	useful := max(feedback.Useful, 0)
+	total := useful + max(feedback.Useless, 0)
+	if total == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("[이전 힌트 평가]\n")
+	fmt.Fprintf(&b, "플레이어들은 이 카테고리의 지난 힌트 %d개 중 %d개(%d%%)를 유용하다고 평가했습니다.", total, useful, useful*100/total)
+
+	examples := make([]string, 0, maxFeedbackExamples)
+	for _, example := range feedback.UselessExamples {
+		example = strings.TrimSpace(example)
+		if example == "" {
+			continue
+		}
+		if runes := []rune(example); len(runes) > maxFeedbackExampleRunes {
+			example = string(runes[:maxFeedbackExampleRunes]) + "…"
+		}
+		examples = append(examples, "- "+example)
+		if len(examples) == maxFeedbackExamples {
+			break
+		}
+	}
+	if len(examples) > 0 {
+		b.WriteString("\n아래 힌트들은 도움이 안 된다는 평가를 받았습니다. 비슷한 관점이나 표현은 피하고 다른 방향의 단서를 주세요.\n")
+		b.WriteString(prompt.WrapXML("useless_hints", strings.Join(examples, "\n")))
+	}
+	return b.String()
+}
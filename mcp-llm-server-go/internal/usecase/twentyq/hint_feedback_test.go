@@ -0,0 +1,31 @@
+package twentyq
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHintFeedbackSection(t *testing.T) {
+	if got := hintFeedbackSection(nil); got != "" {
+		t.Fatalf("nil feedback should be empty, got %q", got)
+	}
+	if got := hintFeedbackSection(&HintFeedback{}); got != "" {
+		t.Fatalf("empty feedback should be empty, got %q", got)
+	}
+
+	section := hintFeedbackSection(&HintFeedback{
+		Useful:          3,
+		Useless:         1,
+		UselessExamples: []string{" 색깔이 <있습니다> ", "", strings.Repeat("가", 200)},
+	})
+	for _, want := range []string{"4개 중 3개(75%)", "<useless_hints>", "- 색깔이 &lt;있습니다&gt;", strings.Repeat("가", maxFeedbackExampleRunes) + "…"} {
+		if !strings.Contains(section, want) {
+			t.Errorf("section missing %q:\n%s", want, section)
+		}
+	}
+
+	section = hintFeedbackSection(&HintFeedback{Useful: 2})
+	if strings.Contains(section, "useless_hints") {
+		t.Fatalf("examples block should be omitted without examples:\n%s", section)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/prompt"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/session"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/shadow"
 	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/toon"
 )
 
@@ -25,10 +26,12 @@ import (
 type Service struct {
 	cfg         *config.Config
 	client      *gemini.Client
+	provider    llm.Provider // 검색/합의가 필요 없는 단순 Structured 호출용 (작업별 공급자 라우팅)
 	guard       *guard.InjectionGuard
 	store       *session.Store
 	prompts     *twentyqdomain.Prompts
 	topicLoader *twentyqdomain.TopicLoader
+	shadow      *shadow.Evaluator // 후보 판정 프롬프트 섀도 평가 (nil이면 비활성)
 	logger      *slog.Logger
 }
 
@@ -36,22 +39,29 @@ type Service struct {
 func New(
 	cfg *config.Config,
 	client *gemini.Client,
+	provider llm.Provider,
 	injectionGuard *guard.InjectionGuard,
 	store *session.Store,
 	prompts *twentyqdomain.Prompts,
 	topicLoader *twentyqdomain.TopicLoader,
+	shadowEvaluator *shadow.Evaluator,
 	logger *slog.Logger,
 ) *Service {
 	if logger == nil {
 		logger = slog.Default()
 	}
+	if provider == nil {
+		provider = client
+	}
 	return &Service{
 		cfg:         cfg,
 		client:      client,
+		provider:    provider,
 		guard:       injectionGuard,
 		store:       store,
 		prompts:     prompts,
 		topicLoader: topicLoader,
+		shadow:      shadowEvaluator,
 		logger:      logger,
 	}
 }
@@ -75,8 +85,13 @@ type HintsRequest struct {
 	Target   string
 	Category string
 	Details  map[string]any
+	// Feedback: 같은 카테고리 지난 힌트의 플레이어 평가 집계 (없으면 nil)
+	Feedback *HintFeedback
 }
 
+// verifyConsensusCalls: 정답 판정 합의에 사용하는 LLM 호출 수입니다.
+const verifyConsensusCalls = 3
+
 type VerifyResult struct {
 	Result  *string
 	RawText string
@@ -157,14 +172,17 @@ func (s *Service) GenerateHints(ctx context.Context, requestID string, req Hints
 		s.logError("twentyq_details_serialize_failed", err)
 		return nil, httperror.NewInvalidInput("details must be a JSON object")
 	}
-	if safeErr := s.ensureSafeDetails(requestID, detailsJSON); safeErr != nil {
+	if safeErr := s.ensureSafeDetails(ctx, requestID, detailsJSON); safeErr != nil {
 		return nil, safeErr
 	}
 	if detailsJSON != "" {
 		userContent = userContent + "\n\n[추가 정보(JSON)]\n" + prompt.WrapXML("details_json", detailsJSON)
 	}
+	if section := hintFeedbackSection(req.Feedback); section != "" {
+		userContent = userContent + "\n\n" + section
+	}
 
-	payload, _, err := s.client.Structured(ctx, gemini.Request{
+	payload, _, err := s.provider.Structured(ctx, gemini.Request{
 		Prompt:       userContent,
 		SystemPrompt: system,
 		Task:         "hints",
@@ -201,7 +219,7 @@ func (s *Service) AnswerQuestion(ctx context.Context, requestID string, req Answ
 		return AnswerResult{}, httperror.NewInvalidInput("question required")
 	}
 
-	if err := s.guard.EnsureSafe(question); err != nil {
+	if err := s.guard.EnsureSafe(ctx, question); err != nil {
 		s.logError("twentyq_question_guard_failed", err)
 		return AnswerResult{}, fmt.Errorf("guard question: %w", err)
 	}
@@ -211,7 +229,7 @@ func (s *Service) AnswerQuestion(ctx context.Context, requestID string, req Answ
 		s.logError("twentyq_details_serialize_failed", err)
 		return AnswerResult{}, httperror.NewInvalidInput("details must be a JSON object")
 	}
-	if safeErr := s.ensureSafeDetails(requestID, detailsJSON); safeErr != nil {
+	if safeErr := s.ensureSafeDetails(ctx, requestID, detailsJSON); safeErr != nil {
 		return AnswerResult{}, safeErr
 	}
 
@@ -288,7 +306,7 @@ func (s *Service) VerifyGuess(ctx context.Context, requestID string, target stri
 		return VerifyResult{Result: &resultStr, RawText: resultStr}, nil
 	}
 
-	if err := s.guard.EnsureSafe(guess); err != nil {
+	if err := s.guard.EnsureSafe(ctx, guess); err != nil {
 		s.logError("twentyq_guess_guard_failed", err)
 		return VerifyResult{}, fmt.Errorf("guard guess: %w", err)
 	}
@@ -298,12 +316,12 @@ func (s *Service) VerifyGuess(ctx context.Context, requestID string, target stri
 		return VerifyResult{}, err
 	}
 
-	const consensusCalls = 3
+	startedAt := time.Now()
 	consensus, err := s.client.StructuredWithConsensusWeighted(ctx, gemini.Request{
 		Prompt:       userContent,
 		SystemPrompt: system,
 		Task:         "verify",
-	}, twentyqdomain.VerifySchema(), "result", consensusCalls)
+	}, twentyqdomain.VerifySchema(), "result", verifyConsensusCalls)
 	if err != nil {
 		return VerifyResult{}, fmt.Errorf("verify structured: %w", err)
 	}
@@ -311,7 +329,50 @@ func (s *Service) VerifyGuess(ctx context.Context, requestID string, target stri
 	// LLM 호출이 필요 없었을 경우 위에서 반환했으므로 여기서는 기존 흐름 유지
 	s.logVerifyConsensus(requestID, consensus)
 
-	return s.parseVerifyGuessPayload(consensus.Payload), nil
+	result := s.parseVerifyGuessPayload(consensus.Payload)
+
+	// 섀도 평가: 표본으로 뽑힌 요청만 후보 프롬프트를 비동기로 실행 (응답에는 영향 없음)
+	s.shadow.Observe(shadow.Observation{
+		RequestID:      requestID,
+		Target:         target,
+		Guess:          guess,
+		PrimaryResult:  verifyResultCode(result),
+		PrimaryLatency: time.Since(startedAt),
+	}, func(shadowCtx context.Context) (string, error) {
+		return s.verifyWithCandidate(shadowCtx, target, guess)
+	})
+
+	return result, nil
+}
+
+// verifyWithCandidate: 후보 프롬프트로 동일한 합의 판정을 수행해 결과 코드를 반환합니다.
+func (s *Service) verifyWithCandidate(ctx context.Context, target string, guess string) (string, error) {
+	system, err := s.prompts.VerifyCandidateSystem()
+	if err != nil {
+		return "", fmt.Errorf("candidate system prompt: %w", err)
+	}
+	userContent, err := s.prompts.VerifyCandidateUser(target, guess)
+	if err != nil {
+		return "", fmt.Errorf("candidate user prompt: %w", err)
+	}
+
+	consensus, err := s.client.StructuredWithConsensusWeighted(ctx, gemini.Request{
+		Prompt:       userContent,
+		SystemPrompt: system,
+		Task:         "verify",
+	}, twentyqdomain.VerifySchema(), "result", verifyConsensusCalls)
+	if err != nil {
+		return "", fmt.Errorf("candidate verify structured: %w", err)
+	}
+	return verifyResultCode(s.parseVerifyGuessPayload(consensus.Payload)), nil
+}
+
+// verifyResultCode: 판정 결과 코드(ACCEPT/CLOSE/REJECT)를 반환합니다. 해석 실패 시 원문을 사용합니다.
+func verifyResultCode(result VerifyResult) string {
+	if result.Result != nil {
+		return *result.Result
+	}
+	return result.RawText
 }
 
 func (s *Service) buildVerifyPrompts(target string, guess string) (string, string, error) {
@@ -408,7 +469,7 @@ func (s *Service) NormalizeQuestion(ctx context.Context, requestID string, quest
 		return NormalizeResult{}, httperror.NewInvalidInput("question required")
 	}
 
-	if err := s.guard.EnsureSafe(question); err != nil {
+	if err := s.guard.EnsureSafe(ctx, question); err != nil {
 		s.logError("twentyq_question_guard_failed", err)
 		return NormalizeResult{}, fmt.Errorf("guard question: %w", err)
 	}
@@ -425,7 +486,7 @@ func (s *Service) NormalizeQuestion(ctx context.Context, requestID string, quest
 	}
 
 	normalized := question
-	payload, _, err := s.client.Structured(ctx, gemini.Request{
+	payload, _, err := s.provider.Structured(ctx, gemini.Request{
 		Prompt:       userContent,
 		SystemPrompt: system,
 	}, twentyqdomain.NormalizeSchema())
@@ -457,7 +518,7 @@ func (s *Service) CheckSynonym(ctx context.Context, requestID string, target str
 		return SynonymResult{}, httperror.NewInvalidInput("guess required")
 	}
 
-	if err := s.guard.EnsureSafe(guess); err != nil {
+	if err := s.guard.EnsureSafe(ctx, guess); err != nil {
 		s.logError("twentyq_guess_guard_failed", err)
 		return SynonymResult{}, fmt.Errorf("guard guess: %w", err)
 	}
@@ -473,7 +534,7 @@ func (s *Service) CheckSynonym(ctx context.Context, requestID string, target str
 		return SynonymResult{}, httperror.NewInternalError("format synonym user prompt failed")
 	}
 
-	payload, _, err := s.client.Structured(ctx, gemini.Request{
+	payload, _, err := s.provider.Structured(ctx, gemini.Request{
 		Prompt:       userContent,
 		SystemPrompt: system,
 		Task:         "synonym",
@@ -497,6 +558,99 @@ func (s *Service) CheckSynonym(ctx context.Context, requestID string, target str
 	return SynonymResult{Result: result, RawText: rawValue}, nil
 }
 
+// SummaryEntry: 요약에 넣을 질문/답변 한 쌍입니다.
+type SummaryEntry struct {
+	Question string
+	Answer   string
+}
+
+// SummaryRequest: 진행 중인 게임의 요약 요청입니다. 정답은 받지 않는다. (요약에 새어 나가지 않도록)
+type SummaryRequest struct {
+	Category     string
+	History      []SummaryEntry
+	Hints        []string
+	WrongGuesses []string
+}
+
+// SummaryResult: 관전자용 진행 요약입니다.
+type SummaryResult struct {
+	Summary    string
+	Eliminated []string
+}
+
+// maxSummaryEntries: 요약 프롬프트에 넣는 최대 질문 수 (오래된 질문부터 버림)
+const maxSummaryEntries = 60
+
+// SummarizeGame: 지금까지의 질문/답변, 힌트, 오답으로 중간 참여자를 위한 짧은 요약을 만듭니다.
+func (s *Service) SummarizeGame(ctx context.Context, requestID string, req SummaryRequest) (SummaryResult, error) {
+	if s == nil || s.provider == nil || s.prompts == nil {
+		return SummaryResult{}, httperror.NewInternalError("service not configured")
+	}
+
+	history := req.History
+	if len(history) > maxSummaryEntries {
+		history = history[len(history)-maxSummaryEntries:]
+	}
+	historyLines := make([]string, 0, len(history))
+	for _, entry := range history {
+		question := strings.TrimSpace(entry.Question)
+		if question == "" {
+			continue
+		}
+		historyLines = append(historyLines, fmt.Sprintf("%d. %s → %s", len(historyLines)+1, question, strings.TrimSpace(entry.Answer)))
+	}
+
+	category := strings.TrimSpace(req.Category)
+	if category == "" {
+		category = "(없음)"
+	}
+	system, err := s.prompts.SummarySystem()
+	if err != nil {
+		s.logError("twentyq_summary_system_prompt_failed", err)
+		return SummaryResult{}, httperror.NewInternalError("load summary system prompt failed")
+	}
+	userContent, err := s.prompts.SummaryUser(category, summaryList(historyLines), summaryList(req.Hints), summaryList(req.WrongGuesses))
+	if err != nil {
+		s.logError("twentyq_summary_user_prompt_failed", err)
+		return SummaryResult{}, httperror.NewInternalError("format summary user prompt failed")
+	}
+
+	payload, _, err := s.provider.Structured(ctx, gemini.Request{
+		Prompt:       userContent,
+		SystemPrompt: system,
+		Task:         "summary",
+	}, twentyqdomain.SummarySchema())
+	if err != nil {
+		return SummaryResult{}, fmt.Errorf("summary structured: %w", err)
+	}
+
+	summary, err := shared.ParseStringField(payload, "summary")
+	if err != nil {
+		s.logError("twentyq_summary_parse_failed", err)
+		return SummaryResult{}, httperror.NewInternalError("invalid summary response")
+	}
+	eliminated, err := shared.ParseStringSlice(payload, "eliminated")
+	if err != nil {
+		eliminated = nil
+	}
+	s.logInfo("twentyq_summary", "request_id", requestID, "questions", len(historyLines), "eliminated", len(eliminated))
+	return SummaryResult{Summary: strings.TrimSpace(summary), Eliminated: eliminated}, nil
+}
+
+// summaryList: 목록을 줄 단위 문자열로 만듭니다. 비어 있으면 "(없음)".
+func summaryList(items []string) string {
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			lines = append(lines, item)
+		}
+	}
+	if len(lines) == 0 {
+		return "(없음)"
+	}
+	return strings.Join(lines, "\n")
+}
+
 func (s *Service) serializeDetails(details map[string]any) (string, error) {
 	if len(details) == 0 {
 		return "", nil
@@ -508,14 +662,14 @@ func (s *Service) serializeDetails(details map[string]any) (string, error) {
 	return value, nil
 }
 
-func (s *Service) ensureSafeDetails(requestID string, detailsJSON string) error {
+func (s *Service) ensureSafeDetails(ctx context.Context, requestID string, detailsJSON string) error {
 	if detailsJSON == "" {
 		return nil
 	}
 	if s.guard == nil {
 		return httperror.NewInternalError("guard not configured")
 	}
-	if err := s.guard.EnsureSafe(detailsJSON); err != nil {
+	if err := s.guard.EnsureSafe(ctx, detailsJSON); err != nil {
 		s.logError("twentyq_details_guard_failed", err)
 		return httperror.NewInvalidInput("details blocked")
 	}
@@ -0,0 +1,70 @@
+package twentyq
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	twentyqdomain "github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/domain/twentyq"
+	"github.com/park285/llm-kakao-bots/mcp-llm-server-go/internal/llm"
+)
+
+// summaryProvider: 받은 요청을 기록하고 고정된 요약을 돌려주는 가짜 공급자
+type summaryProvider struct {
+	req llm.Request
+}
+
+func (p *summaryProvider) Name() string { return "fake" }
+
+func (p *summaryProvider) Chat(context.Context, llm.Request) (string, string, error) {
+	return "", "", nil
+}
+
+func (p *summaryProvider) ChatWithUsage(context.Context, llm.Request) (llm.ChatResult, string, error) {
+	return llm.ChatResult{}, "", nil
+}
+
+func (p *summaryProvider) Structured(_ context.Context, req llm.Request, _ map[string]any) (map[string]any, string, error) {
+	p.req = req
+	return map[string]any{
+		"summary":    "  살아있지 않은 전자기기입니다.  ",
+		"eliminated": []any{"살아있는 것", "스마트폰"},
+	}, "fake-model", nil
+}
+
+func TestSummarizeGame(t *testing.T) {
+	prompts, err := twentyqdomain.NewPrompts()
+	if err != nil {
+		t.Fatalf("load prompts: %v", err)
+	}
+	provider := &summaryProvider{}
+	svc := New(nil, nil, provider, nil, nil, prompts, nil, nil, nil)
+
+	result, err := svc.SummarizeGame(context.Background(), "req-1", SummaryRequest{
+		Category: "사물",
+		History: []SummaryEntry{
+			{Question: "살아있나요?", Answer: "아니오"},
+			{Question: " ", Answer: "무시"},
+			{Question: "전자기기인가요?", Answer: "예"},
+		},
+		WrongGuesses: []string{"스마트폰"},
+	})
+	if err != nil {
+		t.Fatalf("SummarizeGame: %v", err)
+	}
+	if result.Summary != "살아있지 않은 전자기기입니다." || len(result.Eliminated) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if provider.req.Task != "summary" {
+		t.Fatalf("expected summary task, got %q", provider.req.Task)
+	}
+	for _, want := range []string{"1. 살아있나요? → 아니오", "2. 전자기기인가요? → 예", "스마트폰", "(없음)"} {
+		if !strings.Contains(provider.req.Prompt, want) {
+			t.Errorf("prompt missing %q:\n%s", want, provider.req.Prompt)
+		}
+	}
+	if strings.Contains(provider.req.Prompt, "무시") {
+		t.Errorf("blank question should be skipped:\n%s", provider.req.Prompt)
+	}
+}